@@ -3,19 +3,56 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
-
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kneutral-org/alerting-system/internal/analytics"
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/comment"
+	"github.com/kneutral-org/alerting-system/internal/correlation"
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	"github.com/kneutral-org/alerting-system/internal/dbmetrics"
+	"github.com/kneutral-org/alerting-system/internal/escalation"
+	"github.com/kneutral-org/alerting-system/internal/forwarding"
+	"github.com/kneutral-org/alerting-system/internal/geo"
+	grpcsvc "github.com/kneutral-org/alerting-system/internal/grpc"
+	"github.com/kneutral-org/alerting-system/internal/ingestion"
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+	"github.com/kneutral-org/alerting-system/internal/notification"
+	"github.com/kneutral-org/alerting-system/internal/outage"
+	"github.com/kneutral-org/alerting-system/internal/retention"
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	"github.com/kneutral-org/alerting-system/internal/schedule"
+	"github.com/kneutral-org/alerting-system/internal/silence"
+	"github.com/kneutral-org/alerting-system/internal/sla"
 	"github.com/kneutral-org/alerting-system/internal/store"
+	"github.com/kneutral-org/alerting-system/internal/timeline"
+	"github.com/kneutral-org/alerting-system/internal/tracing"
 	"github.com/kneutral-org/alerting-system/internal/webhook"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
@@ -31,10 +68,36 @@ func main() {
 	if port == "" {
 		port = "8080"
 	}
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+
+	// Export OpenTelemetry traces to the OTLP collector at
+	// TRACING_EXPORTER_ENDPOINT (host:port), if set
+	if endpoint := os.Getenv("TRACING_EXPORTER_ENDPOINT"); endpoint != "" {
+		tracerProvider, err := tracing.NewProvider("alerting-system", endpoint)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to configure tracing")
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := tracerProvider.Shutdown(ctx); err != nil {
+				logger.Error().Err(err).Msg("failed to flush traces")
+			}
+		}()
+		logger.Info().Str("endpoint", endpoint).Msg("tracing enabled")
+	}
 
 	// Initialize stores (in-memory for now, replace with real implementations)
-	alertStore := NewInMemoryAlertStore()
-	serviceStore := NewInMemoryServiceStore()
+	alertFilter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to create alert filter")
+	}
+	alertStore := NewInMemoryAlertStore(alertFilter)
+	routingStore := routing.NewInMemoryStore()
+	serviceStore := NewInMemoryServiceStore(alertStore, routingStore)
 
 	// Create a default service for testing
 	_, _ = serviceStore.Create(context.Background(), &store.Service{
@@ -43,6 +106,19 @@ func main() {
 		IntegrationKey: "default-key",
 	})
 
+	// Schedules are only stored in PostgreSQL, so on-call scheduling is
+	// enabled when DATABASE_URL is set
+	var db *sql.DB
+	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
+		db, err = sql.Open("postgres", databaseURL)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to open database")
+		}
+		defer func() { _ = db.Close() }()
+	} else {
+		logger.Warn().Msg("DATABASE_URL is not set, on-call schedules are disabled")
+	}
+
 	// Setup Gin router
 	if os.Getenv("GIN_MODE") == "" {
 		gin.SetMode(gin.ReleaseMode)
@@ -57,12 +133,262 @@ func main() {
 		c.JSON(http.StatusOK, gin.H{"status": "healthy"})
 	})
 
-	// API v1 routes
+	// Prometheus metrics for alert ingestion and routing
+	metricsRegistry := metrics.NewRegistry(prometheus.DefaultRegisterer)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// API v1 routes. Webhooks authenticate with integration keys, the other
+	// endpoints with JWT bearer tokens when JWT_SIGNING_KEY is set.
 	apiV1 := router.Group("/api/v1")
+	userAPI := router.Group("/api/v1")
+	adminAPI := userAPI
+	var tokenVerifier auth.Verifier
+	if signingKey := os.Getenv("JWT_SIGNING_KEY"); signingKey != "" {
+		tokenVerifier = auth.NewVerifier([]byte(signingKey))
+		userAPI.Use(auth.VerifierMiddleware(tokenVerifier))
+		adminAPI = userAPI.Group("", auth.RequireRole("admin"))
+	} else {
+		logger.Warn().Msg("JWT_SIGNING_KEY is not set, API endpoints are unauthenticated")
+	}
+
+	// Receipt log backing the deduplication analytics
+	receiptStore := analytics.NewInMemoryStore()
+
+	// Per-service alert counts for dashboards, cached between alert changes
+	summaryCache := analytics.NewSummaryCache(analytics.NewAlertStoreSummaryStore(alertStore, serviceStore), analytics.DefaultSummaryCacheTTL)
+
+	// Conditions slower than ROUTING_SLOW_CONDITION_THRESHOLD_MS are logged.
+	conditionProfiler := routing.NewConditionProfiler(routing.ProfilerConfigFromEnv(), logger, nil)
+
+	// Silences suppress matching alerts at ingestion and are managed over gRPC
+	silences := silence.NewInMemoryStore()
+
+	// Maintenance windows are managed over gRPC and recorded on alerts
+	// created while they are active
+	maintenanceStore := maintenance.NewInMemoryStore()
+
+	// Customer tiers are managed over gRPC and set the SLA deadline of new
+	// alerts of their customers
+	var customers customer.Store = customer.NewInMemoryStore()
+	var tiers customer.TierStore = customer.NewInMemoryTierStore()
+	if db != nil {
+		customers = customer.NewPostgresStore(db)
+		tiers = customer.NewPostgresTierStore(db)
+	}
+
+	// Inhibition rules suppress alerts while a matching source alert is firing.
+	// They are read from the alert_inhibitions table; without a database no
+	// rules apply.
+	var inhibitionRules inhibition.Store = inhibition.NewInMemoryStore()
+	if db != nil {
+		inhibitionRules = inhibition.NewPostgresStore(db)
+	}
+
+	webhookOpts := []webhook.HandlerOption{
+		webhook.WithMaintenanceStore(maintenanceStore),
+		webhook.WithCustomerTiers(customers, tiers),
+		webhook.WithReceiptStore(receiptStore),
+		webhook.WithSummaryCache(summaryCache),
+		webhook.WithCorrelationEngine(correlation.NewEngine(), webhook.DefaultCorrelationWindow),
+		webhook.WithSilencer(silence.NewSilencer(silences, logger, nil)),
+		webhook.WithInhibitor(inhibition.NewInhibitor(inhibitionRules, alertStore, logger, nil)),
+		webhook.WithForwarder(forwarding.NewForwarder(forwarding.NewInMemoryStore(), logger, nil)),
+		webhook.WithFingerprintMigrator(store.NewFingerprintMigrator(alertStore)),
+		webhook.WithMetricsRegistry(metricsRegistry),
+	}
+
+	// Label-set alert grouping for GROUP_SIZE routing conditions (requires
+	// ALERT_GROUP_BY, a comma-separated list of label keys)
+	routingEvaluatorOpts := []routing.EvaluatorOption{routing.WithConditionProfiler(conditionProfiler), routing.WithLogger(logger)}
+	if groupBy := os.Getenv("ALERT_GROUP_BY"); groupBy != "" {
+		labelCorrelator, err := correlation.NewCorrelator(alertStore, strings.Split(groupBy, ","), webhook.DefaultCorrelationWindow)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to create label correlator")
+		}
+		webhookOpts = append(webhookOpts, webhook.WithLabelCorrelator(labelCorrelator))
+		routingEvaluatorOpts = append(routingEvaluatorOpts, routing.WithGroupSizer(labelCorrelator))
+	}
+
+	// Geolocation enrichment from alert source IPs (requires GEOLITE2_DB_PATH)
+	geoResolver, err := geo.NewMaxMindResolverFromEnv()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to open geolite2 database, geo enrichment disabled")
+	} else if geoResolver != nil {
+		defer func() { _ = geoResolver.Close() }()
+		webhookOpts = append(webhookOpts, webhook.WithGeoEnricher(geo.NewGeoEnricher(geoResolver, logger)))
+	}
+
+	// Alertmanager v2 compatible API for a single service (requires DEFAULT_INTEGRATION_KEY)
+	if key := os.Getenv("DEFAULT_INTEGRATION_KEY"); key != "" {
+		webhookOpts = append(webhookOpts, webhook.WithDefaultIntegrationKey(key))
+	}
+
+	// The routing service invalidates its engine when rules change, so the
+	// webhook shares it. Load routing rules before accepting requests so the
+	// first alert is not delayed by the rule query. A failed warmup falls back
+	// to lazy loading.
+	routingService := grpcsvc.NewRoutingService(routingStore, logger,
+		grpcsvc.WithRoutingEvaluatorOptions(routingEvaluatorOpts...),
+		grpcsvc.WithRoutingEngineOptions(routing.WithMetricsRegistry(metricsRegistry)),
+	)
+	routingEngine := routingService.Engine()
+	warmupCtx, cancelWarmup := context.WithTimeout(context.Background(), routing.DefaultWarmupTimeout)
+	if err := routingEngine.Warmup(warmupCtx); err != nil {
+		logger.Error().Err(err).Msg("routing engine warmup failed, rules will be loaded on first alert")
+	}
+	cancelWarmup()
+
+	// Notifications are only logged until a notification service exists
+	notifier := NewLogNotifier(logger)
+
+	// Outage mode skips notification actions and escalation notifications
+	outageStore := outage.NewInMemoryStore()
+	actionMetrics := action.NewMetrics()
+
+	// Escalation policies are managed over gRPC and started by ESCALATE
+	// actions. Acknowledging or resolving an alert cancels its running
+	// escalation.
+	escalationPolicies := escalation.NewInMemoryStore()
+	escalationEngine := escalation.NewEngine(escalationPolicies, alertStore, notifier, nil, logger,
+		escalation.WithOutageMode(outageStore, actionMetrics),
+	)
+
+	// Execute the actions of routing rules for stored alerts and rule replays.
+	// Only PagerDuty forwarding and escalations have a service implementation
+	// so far; other actions fail as unregistered.
+	// With a database, actions that keep failing are dead-lettered and retried
+	// later by the retry worker.
+	executorOpts := []action.ExecutorOption{action.WithMetricsRegistry(metricsRegistry)}
+	var deadLetters action.DeadLetterQueue
+	if db != nil {
+		deadLetters = action.NewPostgresDeadLetterQueue(db)
+		executorOpts = append(executorOpts, action.WithDeadLetterQueue(deadLetters))
+	}
+	actionExecutor := action.NewDefaultExecutor(nil, logger, actionMetrics, executorOpts...)
+	action.RegisterAllHandlers(actionExecutor, &action.ActionHandlers{
+		EscalationService: escalationEngine,
+		ForwardingService: notification.NewPagerDutyForwarder(notification.DefaultPagerDutyConfig(), logger, nil),
+		OutageMode:        outageStore,
+	})
+
+	// Stored alerts are routed asynchronously, critical alerts and alerts of
+	// high-tier customers first. Webhook requests with include_routing=true
+	// also get the routing decisions of their alerts in the response.
+	workerPool := ingestion.NewWorkerPool(nil, routeAlert(routingEngine, actionExecutor), ingestion.DefaultWorkerPoolConfig(), logger)
+	workerPool.Start()
+	webhookOpts = append(webhookOpts,
+		webhook.WithRoutingEngine(routingEngine),
+		webhook.WithWorkerPool(workerPool),
+	)
 
 	// Register webhook handlers
-	webhookHandler := webhook.NewHandler(alertStore, serviceStore, logger)
+	webhookHandler := webhook.NewHandler(alertStore, serviceStore, logger, webhookOpts...)
 	webhookHandler.RegisterRoutes(apiV1)
+	webhookHandler.RegisterUserRoutes(userAPI)
+	webhookHandler.RegisterAdminRoutes(adminAPI)
+	webhookHandler.RegisterAlertmanagerRoutes(router.Group("/api"))
+
+	// Register outage mode admin endpoints
+	outage.NewHandler(outageStore, logger).RegisterRoutes(adminAPI)
+
+	// Register alert timeline endpoint
+	timeline.NewHandler(alertStore, logger).RegisterRoutes(userAPI)
+
+	// Register analytics endpoints
+	// Label value autocomplete for the routing UI, cached for five minutes
+	labelValues := analytics.NewLabelValueCache(analytics.NewAlertStoreLabelValueStore(alertStore), analytics.DefaultLabelValuesCacheTTL)
+	analytics.NewHandler(receiptStore, alertStore, logger,
+		analytics.WithAlertSummaries(summaryCache),
+		analytics.WithLabelValues(labelValues),
+	).RegisterRoutes(userAPI)
+
+	// Register routing rule replay
+	routing.NewHandler(routingStore, routing.NewReplayer(routingStore, alertStore, routing.NewEvaluator(), actionExecutor, logger), logger).RegisterRoutes(userAPI)
+
+	// Register the schedule change stream. Schedule changes made over gRPC are
+	// published to its subscribers through the shared event bus.
+	var scheduleStore schedule.Store
+	scheduleEvents := schedule.NewEventBus(0)
+	if db != nil {
+		scheduleStore = schedule.NewPostgresStore(db)
+		schedule.NewStreamHandler(scheduleStore, scheduleEvents, logger).RegisterRoutes(userAPI)
+
+		// Calendar clients cannot send bearer tokens, so the feeds are public
+		// and authenticate with feed tokens signed with ICAL_SIGNING_KEY
+		if signingKey := os.Getenv("ICAL_SIGNING_KEY"); signingKey != "" {
+			schedule.NewICalHandler(scheduleStore, []byte(signingKey), logger).RegisterRoutes(apiV1)
+		} else {
+			logger.Warn().Msg("ICAL_SIGNING_KEY is not set, schedule calendar feeds are disabled")
+		}
+	}
+
+	// Background workers stop when the server shuts down
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// Export database connection pool statistics. Postgres-backed stores
+	// (alerts, routing, schedules) register their *sql.DB with the collector.
+	dbPools := dbmetrics.NewCollector(dbmetrics.NewMetrics(), dbmetrics.DefaultInterval, logger)
+	go dbPools.Run(backgroundCtx)
+
+	// Remind the outgoing and incoming users of upcoming rotation handoffs
+	if db != nil {
+		dbPools.Register("schedules", db)
+		handoffNotifier := schedule.NewHandoffNotifier(scheduleStore, notifier, logger,
+			schedule.WithHandoffReminderStore(schedule.NewPostgresHandoffReminderStore(db)),
+		)
+		go handoffNotifier.Run(backgroundCtx)
+	}
+
+	// Retry dead-lettered actions
+	if deadLetters != nil {
+		go action.NewRetryWorker(deadLetters, actionExecutor, action.DefaultRetryInterval, actionMetrics, logger,
+			action.WithRetryMetricsRegistry(metricsRegistry),
+		).Start(backgroundCtx)
+	}
+
+	// Purge routing audit logs and alert receipts past their retention period daily
+	purger := retention.NewPurger(retention.ConfigFromEnv(), nil, logger)
+	purger.Register("routing_audit_logs", routing.PurgeAllAuditLogs(routingStore))
+	purger.Register("alert_receipt_log", receiptStore.Purge)
+	go purger.Run(backgroundCtx)
+
+	// Watch Kubernetes warning events when running in-cluster, raising alerts
+	// for the service KUBERNETES_SERVICE_ID
+	if restConfig, err := rest.InClusterConfig(); err != nil {
+		logger.Debug().Err(err).Msg("not running in-cluster, kubernetes event watcher disabled")
+	} else if k8sConfig := webhook.KubernetesEventConfigFromEnv(); k8sConfig.ServiceID == "" {
+		logger.Warn().Msg("KUBERNETES_SERVICE_ID is not set, kubernetes event watcher disabled")
+	} else {
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to create kubernetes client")
+		}
+
+		watcher := webhook.NewKubernetesEventWatcher(webhookHandler, clientset, k8sConfig, logger)
+		go func() {
+			if err := watcher.Run(backgroundCtx); err != nil {
+				logger.Error().Err(err).Msg("kubernetes event watcher stopped")
+			}
+		}()
+	}
+
+	// Mark alerts past their SLA deadline as breached, notifying the dedicated
+	// team of the customer's tier, and raise one meta-alert per team per SLA
+	// window for the breaches on the service SLA_META_ALERT_SERVICE_ID
+	slaBreaches := sla.NewEventBus()
+	go sla.NewSLABreachWorker(alertStore, customers, tiers, actionExecutor, sla.DefaultScanInterval, nil, logger,
+		sla.WithBreachEvents(slaBreaches),
+	).Run(backgroundCtx)
+	if serviceID := os.Getenv("SLA_META_ALERT_SERVICE_ID"); serviceID == "" {
+		logger.Warn().Msg("SLA_META_ALERT_SERVICE_ID is not set, sla breach meta-alerts disabled")
+	} else {
+		go sla.NewSLABreachAlerter(slaBreaches, webhookHandler, serviceID, logger, nil).Run(backgroundCtx)
+	}
+
+	// Move maintenance windows from scheduled to active to completed as their
+	// start and end times pass
+	go maintenance.NewMaintenanceStatusWorker(maintenanceStore, maintenance.DefaultStatusWorkerInterval, nil, logger).Start(backgroundCtx)
 
 	// Create server
 	srv := &http.Server{
@@ -73,6 +399,36 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Serve the gRPC API, with mutual TLS when GRPC_TLS_CA_CERT, GRPC_TLS_CERT
+	// and GRPC_TLS_KEY are set, and with the same bearer tokens as the HTTP API
+	grpcServer, err := newGRPCServer(grpcsvc.TLSConfigFromEnv(), tokenVerifier, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to configure gRPC server")
+	}
+	routingv1.RegisterRoutingServiceServer(grpcServer, routingService)
+	alertingv1.RegisterAlertServiceServer(grpcServer, grpcsvc.NewAlertService(alertStore, comment.NewInMemoryStore(), escalationEngine, logger))
+	alertingv1.RegisterSilenceServiceServer(grpcServer, grpcsvc.NewSilenceService(silences, logger))
+	alertingv1.RegisterServiceServiceServer(grpcServer, grpcsvc.NewServiceService(serviceStore, logger))
+	customerResolver := customer.NewResolver(customers, tiers, customer.DefaultResolverConfig())
+	defer customerResolver.Stop()
+	routingv1.RegisterCustomerTierServiceServer(grpcServer, grpcsvc.NewCustomerTierService(tiers, customers, customerResolver, logger))
+	routingv1.RegisterMaintenanceServiceServer(grpcServer, grpcsvc.NewMaintenanceService(maintenanceStore, logger))
+	routingv1.RegisterEscalationServiceServer(grpcServer, grpcsvc.NewEscalationService(escalationPolicies, logger))
+	if scheduleStore != nil {
+		routingv1.RegisterScheduleServiceServer(grpcServer, grpcsvc.NewScheduleService(scheduleStore, logger, grpcsvc.WithScheduleEventBus(scheduleEvents)))
+	}
+
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		logger.Fatal().Err(err).Str("port", grpcPort).Msg("failed to listen for gRPC")
+	}
+	go func() {
+		logger.Info().Str("port", grpcPort).Msg("starting gRPC server")
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			logger.Fatal().Err(err).Msg("failed to start gRPC server")
+		}
+	}()
+
 	// Start server in a goroutine
 	go func() {
 		logger.Info().Str("port", port).Msg("starting HTTP server")
@@ -87,18 +443,58 @@ func main() {
 	<-quit
 
 	logger.Info().Msg("shutting down server...")
+	stopBackground()
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	grpcServer.GracefulStop()
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Fatal().Err(err).Msg("server forced to shutdown")
 	}
+	if err := workerPool.Shutdown(ctx); err != nil {
+		logger.Error().Err(err).Msg("failed to drain ingestion worker pool")
+	}
+	escalationEngine.Stop()
 
 	logger.Info().Msg("server exited properly")
 }
 
+// newGRPCServer creates the gRPC server, requiring and verifying client
+// certificates when mutual TLS is configured and bearer tokens when verifier is
+// not nil. Every RPC is traced.
+func newGRPCServer(tlsConfig grpcsvc.TLSConfig, verifier auth.Verifier, logger zerolog.Logger) (*grpc.Server, error) {
+	if err := tlsConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	// otelgrpc has replaced its interceptors with a stats handler
+	opts := []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler())}
+	if verifier != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(grpcsvc.UnaryAuthInterceptor(verifier)))
+	}
+	if !tlsConfig.Enabled() {
+		logger.Warn().Msg("gRPC mutual TLS is not configured, serving plaintext")
+		return grpc.NewServer(opts...), nil
+	}
+
+	creds, err := tlsConfig.Credentials()
+	if err != nil {
+		return nil, fmt.Errorf("load gRPC mTLS credentials: %w", err)
+	}
+	logger.Info().Msg("gRPC mutual TLS enabled")
+	return grpc.NewServer(append(opts, grpc.Creds(creds))...), nil
+}
+
+// routeAlert returns a worker pool ProcessFunc that executes the actions of
+// the routing rules matching each triggered or resolved alert.
+func routeAlert(engine *routing.Engine, executor action.Executor) ingestion.ProcessFunc {
+	return func(ctx context.Context, alert *alertingv1.Alert) error {
+		return routing.RouteAlert(ctx, engine, executor, alert)
+	}
+}
+
 // ginLogger returns a Gin middleware that logs requests using zerolog.
 func ginLogger(logger zerolog.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -139,19 +535,24 @@ type InMemoryAlertStore struct {
 	alerts     map[string]*alertingv1.Alert
 	alertsByFP map[string]*alertingv1.Alert
 	counter    int64
+	celFilter  *cel.AlertFilter
 }
 
 // NewInMemoryAlertStore creates a new in-memory alert store.
-func NewInMemoryAlertStore() *InMemoryAlertStore {
+func NewInMemoryAlertStore(celFilter *cel.AlertFilter) *InMemoryAlertStore {
 	return &InMemoryAlertStore{
 		alerts:     make(map[string]*alertingv1.Alert),
 		alertsByFP: make(map[string]*alertingv1.Alert),
+		celFilter:  celFilter,
 	}
 }
 
 func (s *InMemoryAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
 	s.counter++
 	alert.Id = fmt.Sprintf("alert-%d", s.counter)
+	if alert.CreatedAt == nil {
+		alert.CreatedAt = timestamppb.Now()
+	}
 	s.alerts[alert.Id] = alert
 	s.alertsByFP[alert.Fingerprint] = alert
 	return alert, nil
@@ -174,6 +575,10 @@ func (s *InMemoryAlertStore) GetByFingerprint(ctx context.Context, fingerprint s
 }
 
 func (s *InMemoryAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	// Drop the previous fingerprint when it changed, e.g. during a fingerprint migration
+	if existing, ok := s.alerts[alert.Id]; ok && existing.Fingerprint != alert.Fingerprint && s.alertsByFP[existing.Fingerprint] == existing {
+		delete(s.alertsByFP, existing.Fingerprint)
+	}
 	s.alerts[alert.Id] = alert
 	s.alertsByFP[alert.Fingerprint] = alert
 	return alert, nil
@@ -192,23 +597,28 @@ func (s *InMemoryAlertStore) CreateOrUpdate(ctx context.Context, alert *alerting
 }
 
 func (s *InMemoryAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
-	var alerts []*alertingv1.Alert
+	alerts := make([]*alertingv1.Alert, 0, len(s.alerts))
 	for _, a := range s.alerts {
 		alerts = append(alerts, a)
 	}
-	return &alertingv1.ListAlertsResponse{Alerts: alerts, TotalCount: int32(len(alerts))}, nil
+	return store.ListAlerts(s.celFilter, req, alerts)
 }
 
 // InMemoryServiceStore is a simple in-memory implementation of store.ServiceStore.
+// The alert and routing stores are consulted when a service is deleted.
 type InMemoryServiceStore struct {
-	services map[string]*store.Service
-	counter  int64
+	services     map[string]*store.Service
+	counter      int64
+	alertStore   *InMemoryAlertStore
+	routingStore routing.Store
 }
 
 // NewInMemoryServiceStore creates a new in-memory service store.
-func NewInMemoryServiceStore() *InMemoryServiceStore {
+func NewInMemoryServiceStore(alertStore *InMemoryAlertStore, routingStore routing.Store) *InMemoryServiceStore {
 	return &InMemoryServiceStore{
-		services: make(map[string]*store.Service),
+		services:     make(map[string]*store.Service),
+		alertStore:   alertStore,
+		routingStore: routingStore,
 	}
 }
 
@@ -237,3 +647,109 @@ func (s *InMemoryServiceStore) GetByID(ctx context.Context, id string) (*store.S
 	}
 	return svc, nil
 }
+
+func (s *InMemoryServiceStore) CanDeleteService(ctx context.Context, serviceID string) (*store.DeletionImpact, error) {
+	if _, ok := s.services[serviceID]; !ok {
+		return nil, store.ErrServiceNotFound
+	}
+
+	impact := &store.DeletionImpact{
+		ActiveAlertCount: len(s.activeAlerts(serviceID)),
+	}
+
+	rules, err := s.routingStore.ListRules(ctx, &routingv1.ListRoutingRulesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list routing rules: %w", err)
+	}
+	for _, rule := range rules.Rules {
+		if routing.RuleReferencesService(rule, serviceID) {
+			impact.RoutingRulesCount++
+		}
+	}
+
+	// Escalation policies are not stored yet, so none can reference the service
+	return impact, nil
+}
+
+func (s *InMemoryServiceStore) Delete(ctx context.Context, serviceID string, force bool) error {
+	if _, ok := s.services[serviceID]; !ok {
+		return store.ErrServiceNotFound
+	}
+
+	if force {
+		for _, alert := range s.activeAlerts(serviceID) {
+			alert.ServiceId = store.DeletedServiceID
+		}
+	}
+
+	delete(s.services, serviceID)
+	return nil
+}
+
+// activeAlerts returns the triggered and acknowledged alerts of a service.
+func (s *InMemoryServiceStore) activeAlerts(serviceID string) []*alertingv1.Alert {
+	var active []*alertingv1.Alert
+	for _, alert := range s.alertStore.alerts {
+		if alert.ServiceId != serviceID {
+			continue
+		}
+		if alert.Status == alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED ||
+			alert.Status == alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED {
+			active = append(active, alert)
+		}
+	}
+	return active
+}
+
+// LogNotifier logs notifications instead of delivering them.
+// Replace with a real notification service in production.
+type LogNotifier struct {
+	logger zerolog.Logger
+}
+
+// NewLogNotifier creates a new logging notifier.
+func NewLogNotifier(logger zerolog.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger.With().Str("component", "notifier").Logger()}
+}
+
+func (n *LogNotifier) NotifyTeam(ctx context.Context, teamID string, scope routingv1.TeamNotifyScope, templateID string, alert *routingv1.Alert) error {
+	n.logger.Info().
+		Str("teamId", teamID).
+		Str("scope", scope.String()).
+		Str("templateId", templateID).
+		Str("summary", alert.GetSummary()).
+		Msg("team notification")
+	return nil
+}
+
+func (n *LogNotifier) NotifyChannel(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error {
+	n.logger.Info().
+		Str("channel", target.GetChannel().String()).
+		Str("templateId", templateID).
+		Str("summary", alert.GetSummary()).
+		Msg("channel notification")
+	return nil
+}
+
+func (n *LogNotifier) NotifyUser(ctx context.Context, userID string, templateID string, channelOverride routingv1.ChannelType, alert *routingv1.Alert) error {
+	n.logger.Info().
+		Str("userId", userID).
+		Str("templateId", templateID).
+		Str("channel", channelOverride.String()).
+		Str("summary", alert.GetSummary()).
+		Msg("user notification")
+	return nil
+}
+
+func (n *LogNotifier) NotifyOnCall(ctx context.Context, scheduleID string, templateID string, level routingv1.OnCallLevel, alert *routingv1.Alert) error {
+	n.logger.Info().
+		Str("scheduleId", scheduleID).
+		Str("level", level.String()).
+		Str("templateId", templateID).
+		Str("summary", alert.GetSummary()).
+		Msg("on-call notification")
+	return nil
+}
+
+// Ensure LogNotifier implements action.NotificationService
+var _ action.NotificationService = (*LogNotifier)(nil)
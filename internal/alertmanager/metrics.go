@@ -0,0 +1,75 @@
+package alertmanager
+
+import (
+	"sync"
+)
+
+// Metrics tracks Alertmanager silence sync metrics.
+// Exposed as the silences_synced_total, silences_created_total and
+// silences_expired_total counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// silencesSynced counts silences processed by successful syncs.
+	silencesSynced int64
+	// silencesCreated counts maintenance windows created from silences.
+	silencesCreated int64
+	// silencesExpired counts maintenance windows expired with their silence.
+	silencesExpired int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordSynced adds count to the silences synced counter.
+func (m *Metrics) RecordSynced(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencesSynced += int64(count)
+}
+
+// RecordCreated increments the silences created counter.
+func (m *Metrics) RecordCreated() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencesCreated++
+}
+
+// RecordExpired increments the silences expired counter.
+func (m *Metrics) RecordExpired() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencesExpired++
+}
+
+// SilencesSyncedTotal returns the number of silences synced.
+func (m *Metrics) SilencesSyncedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.silencesSynced
+}
+
+// SilencesCreatedTotal returns the number of maintenance windows created from silences.
+func (m *Metrics) SilencesCreatedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.silencesCreated
+}
+
+// SilencesExpiredTotal returns the number of maintenance windows expired with their silence.
+func (m *Metrics) SilencesExpiredTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.silencesExpired
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencesSynced = 0
+	m.silencesCreated = 0
+	m.silencesExpired = 0
+}
@@ -0,0 +1,320 @@
+// Package alertmanager integrates the alerting system with Prometheus Alertmanager.
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultSyncInterval is how often silences are fetched from Alertmanager.
+	DefaultSyncInterval = 60 * time.Second
+	// DefaultRequestTimeout is the HTTP timeout for Alertmanager API requests.
+	DefaultRequestTimeout = 10 * time.Second
+
+	// SourceLabel is the maintenance window label recording where a window came from.
+	SourceLabel = "source"
+	// SourceAlertmanager is the SourceLabel value of windows synced from silences.
+	SourceAlertmanager = "alertmanager"
+	// ExternalIDLabel is the maintenance window label holding the silence ID.
+	ExternalIDLabel = "external_id"
+	// CreatedByLabel is the maintenance window label holding the silence author.
+	CreatedByLabel = "created_by"
+
+	silencesPath       = "/api/v2/silences"
+	windowListPageSize = 100
+)
+
+// Silence states reported by the Alertmanager API.
+const (
+	SilenceStateActive  = "active"
+	SilenceStatePending = "pending"
+	SilenceStateExpired = "expired"
+)
+
+// Silence is a silence returned by the Alertmanager v2 API.
+type Silence struct {
+	ID        string        `json:"id"`
+	Status    SilenceStatus `json:"status"`
+	Matchers  []Matcher     `json:"matchers"`
+	StartsAt  time.Time     `json:"startsAt"`
+	EndsAt    time.Time     `json:"endsAt"`
+	CreatedBy string        `json:"createdBy"`
+	Comment   string        `json:"comment"`
+}
+
+// SilenceStatus is the state of a silence.
+type SilenceStatus struct {
+	State string `json:"state"`
+}
+
+// Matcher is a silence label matcher.
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	// IsEqual is false for negative matchers; Alertmanager versions before 0.22 omit it.
+	IsEqual *bool `json:"isEqual,omitempty"`
+}
+
+// SilenceSyncer mirrors Alertmanager silences as maintenance windows labelled
+// source=alertmanager. Active and pending silences create or update a window and
+// windows whose silence expired or disappeared are completed.
+type SilenceSyncer struct {
+	baseURL  string
+	client   *http.Client
+	store    maintenance.Store
+	logger   zerolog.Logger
+	metrics  *Metrics
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewSilenceSyncer creates a syncer polling the Alertmanager at baseURL every minute.
+func NewSilenceSyncer(baseURL string, store maintenance.Store, logger zerolog.Logger, metrics *Metrics) *SilenceSyncer {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &SilenceSyncer{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		client:   &http.Client{Timeout: DefaultRequestTimeout},
+		store:    store,
+		logger:   logger.With().Str("component", "alertmanager_silence_syncer").Logger(),
+		metrics:  metrics,
+		interval: DefaultSyncInterval,
+		now:      time.Now,
+	}
+}
+
+// Metrics returns the metrics recorder for this syncer.
+func (s *SilenceSyncer) Metrics() *Metrics {
+	return s.metrics
+}
+
+// Run syncs silences immediately and then every interval until the context is cancelled.
+func (s *SilenceSyncer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.logger.Error().Err(err).Msg("failed to sync alertmanager silences")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync reconciles the synced maintenance windows with the current Alertmanager silences.
+func (s *SilenceSyncer) Sync(ctx context.Context) error {
+	silences, err := s.fetchSilences(ctx)
+	if err != nil {
+		return err
+	}
+
+	windows, err := s.syncedWindows(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	live := make(map[string]bool, len(silences))
+
+	for _, silence := range silences {
+		if silence.Status.State != SilenceStateActive && silence.Status.State != SilenceStatePending {
+			continue
+		}
+
+		desired, ok := windowFromSilence(silence, now)
+		if !ok {
+			s.logger.Warn().
+				Str("silenceId", silence.ID).
+				Msg("skipping silence with regex or negative matchers")
+			continue
+		}
+		live[silence.ID] = true
+
+		existing, ok := windows[silence.ID]
+		if !ok {
+			if _, err := s.store.Create(ctx, desired); err != nil {
+				return fmt.Errorf("create maintenance window for silence %s: %w", silence.ID, err)
+			}
+			s.metrics.RecordCreated()
+			continue
+		}
+
+		if windowMatches(existing, desired) {
+			continue
+		}
+		desired.Id = existing.Id
+		desired.CreatedAt = existing.CreatedAt
+		if _, err := s.store.Update(ctx, desired); err != nil {
+			return fmt.Errorf("update maintenance window for silence %s: %w", silence.ID, err)
+		}
+	}
+
+	for silenceID, window := range windows {
+		if live[silenceID] || windowFinished(window) {
+			continue
+		}
+		if err := s.expireWindow(ctx, window, now); err != nil {
+			return fmt.Errorf("expire maintenance window for silence %s: %w", silenceID, err)
+		}
+		s.metrics.RecordExpired()
+	}
+
+	s.metrics.RecordSynced(len(silences))
+	return nil
+}
+
+// fetchSilences calls GET /api/v2/silences.
+func (s *SilenceSyncer) fetchSilences(ctx context.Context) ([]Silence, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+silencesPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build alertmanager request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("alertmanager request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("alertmanager returned status %d", resp.StatusCode)
+	}
+
+	var silences []Silence
+	if err := json.NewDecoder(resp.Body).Decode(&silences); err != nil {
+		return nil, fmt.Errorf("decode alertmanager silences: %w", err)
+	}
+	return silences, nil
+}
+
+// syncedWindows returns the maintenance windows created from silences, keyed by silence ID.
+func (s *SilenceSyncer) syncedWindows(ctx context.Context) (map[string]*routingv1.MaintenanceWindow, error) {
+	windows := make(map[string]*routingv1.MaintenanceWindow)
+
+	pageToken := ""
+	for {
+		resp, err := s.store.List(ctx, &routingv1.ListMaintenanceWindowsRequest{
+			PageSize:  windowListPageSize,
+			PageToken: pageToken,
+			Labels:    map[string]string{SourceLabel: SourceAlertmanager},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list maintenance windows: %w", err)
+		}
+
+		for _, window := range resp.Windows {
+			silenceID := window.Labels[ExternalIDLabel]
+			if window.Labels[SourceLabel] != SourceAlertmanager || silenceID == "" {
+				continue
+			}
+			windows[silenceID] = window
+		}
+
+		if resp.NextPageToken == "" {
+			return windows, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// expireWindow ends a window whose silence expired or was removed.
+func (s *SilenceSyncer) expireWindow(ctx context.Context, window *routingv1.MaintenanceWindow, now time.Time) error {
+	if window.EndTime == nil || window.EndTime.AsTime().After(now) {
+		window.EndTime = timestamppb.New(now)
+	}
+	if window.StartTime == nil || window.StartTime.AsTime().After(now) {
+		window.StartTime = window.EndTime
+	}
+	window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+
+	_, err := s.store.Update(ctx, window)
+	return err
+}
+
+// windowFromSilence builds the maintenance window for a silence. Maintenance windows
+// only support equality label matchers, so silences using other matchers are rejected.
+func windowFromSilence(silence Silence, now time.Time) (*routingv1.MaintenanceWindow, bool) {
+	affectedLabels := make([]string, 0, len(silence.Matchers))
+	for _, m := range silence.Matchers {
+		if m.IsRegex || (m.IsEqual != nil && !*m.IsEqual) {
+			return nil, false
+		}
+		affectedLabels = append(affectedLabels, fmt.Sprintf("%s=%s", m.Name, m.Value))
+	}
+	slices.Sort(affectedLabels)
+
+	labels := map[string]string{
+		SourceLabel:     SourceAlertmanager,
+		ExternalIDLabel: silence.ID,
+	}
+	if silence.CreatedBy != "" {
+		labels[CreatedByLabel] = silence.CreatedBy
+	}
+
+	return &routingv1.MaintenanceWindow{
+		Name:           fmt.Sprintf("Alertmanager silence %s", silence.ID),
+		Description:    silence.Comment,
+		StartTime:      timestamppb.New(silence.StartsAt),
+		EndTime:        timestamppb.New(silence.EndsAt),
+		AffectedLabels: affectedLabels,
+		Action:         routingv1.MaintenanceAction_MAINTENANCE_ACTION_SUPPRESS,
+		Status:         statusAt(silence.StartsAt, silence.EndsAt, now),
+		Labels:         labels,
+	}, true
+}
+
+// windowMatches reports whether an existing window already reflects the desired one.
+func windowMatches(existing, desired *routingv1.MaintenanceWindow) bool {
+	affectedLabels := slices.Clone(existing.AffectedLabels)
+	slices.Sort(affectedLabels)
+
+	return existing.Name == desired.Name &&
+		existing.Description == desired.Description &&
+		existing.Action == desired.Action &&
+		existing.Status == desired.Status &&
+		existing.StartTime.AsTime().Equal(desired.StartTime.AsTime()) &&
+		existing.EndTime.AsTime().Equal(desired.EndTime.AsTime()) &&
+		slices.Equal(affectedLabels, desired.AffectedLabels) &&
+		existing.Labels[CreatedByLabel] == desired.Labels[CreatedByLabel]
+}
+
+// windowFinished reports whether a window no longer needs expiring.
+func windowFinished(window *routingv1.MaintenanceWindow) bool {
+	return window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED ||
+		window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED
+}
+
+// statusAt returns the status of a window spanning start to end at now.
+func statusAt(start, end, now time.Time) routingv1.MaintenanceStatus {
+	switch {
+	case !now.Before(end):
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+	case !now.Before(start):
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
+	default:
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+	}
+}
@@ -0,0 +1,332 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// windowStore is an in-memory maintenance.Store.
+type windowStore struct {
+	windows []*routingv1.MaintenanceWindow
+	counter int
+	updates int
+}
+
+func (s *windowStore) Create(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	s.counter++
+	window.Id = fmt.Sprintf("mw-%d", s.counter)
+	s.windows = append(s.windows, window)
+	return window, nil
+}
+
+func (s *windowStore) Get(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	for _, w := range s.windows {
+		if w.Id == id {
+			return w, nil
+		}
+	}
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) List(ctx context.Context, req *routingv1.ListMaintenanceWindowsRequest) (*routingv1.ListMaintenanceWindowsResponse, error) {
+	var windows []*routingv1.MaintenanceWindow
+	for _, w := range s.windows {
+		matches := true
+		for k, v := range req.Labels {
+			if w.Labels[k] != v {
+				matches = false
+			}
+		}
+		if matches {
+			windows = append(windows, w)
+		}
+	}
+	return &routingv1.ListMaintenanceWindowsResponse{Windows: windows, TotalCount: int32(len(windows))}, nil
+}
+
+func (s *windowStore) Update(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	for i, w := range s.windows {
+		if w.Id == window.Id {
+			s.updates++
+			s.windows[i] = window
+			return window, nil
+		}
+	}
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (s *windowStore) ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (s *windowStore) ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (s *windowStore) UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error {
+	return nil
+}
+
+func (s *windowStore) TransitionStatuses(ctx context.Context) ([]maintenance.StatusTransition, error) {
+	return nil, nil
+}
+
+func (s *windowStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) bySilence(silenceID string) *routingv1.MaintenanceWindow {
+	for _, w := range s.windows {
+		if w.Labels[ExternalIDLabel] == silenceID {
+			return w
+		}
+	}
+	return nil
+}
+
+// fakeAlertmanager serves a mutable list of silences from GET /api/v2/silences.
+type fakeAlertmanager struct {
+	mu       sync.Mutex
+	silences []Silence
+	status   int
+}
+
+func (f *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method != http.MethodGet || r.URL.Path != "/api/v2/silences" {
+		http.NotFound(w, r)
+		return
+	}
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(f.silences)
+}
+
+func (f *fakeAlertmanager) setSilences(silences ...Silence) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.silences = silences
+}
+
+func newTestSyncer(t *testing.T, now time.Time) (*SilenceSyncer, *fakeAlertmanager, *windowStore) {
+	t.Helper()
+
+	am := &fakeAlertmanager{}
+	server := httptest.NewServer(am)
+	t.Cleanup(server.Close)
+
+	store := &windowStore{}
+	syncer := NewSilenceSyncer(server.URL, store, zerolog.Nop(), nil)
+	syncer.now = func() time.Time { return now }
+	return syncer, am, store
+}
+
+func silence(id, state string, start, end time.Time, matchers ...Matcher) Silence {
+	return Silence{
+		ID:        id,
+		Status:    SilenceStatus{State: state},
+		Matchers:  matchers,
+		StartsAt:  start,
+		EndsAt:    end,
+		CreatedBy: "alice",
+		Comment:   "rack maintenance",
+	}
+}
+
+func TestSilenceSyncer_CreatesWindows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, am, store := newTestSyncer(t, now)
+
+	notEqual := false
+	am.setSilences(
+		silence("s-active", SilenceStateActive, now.Add(-time.Hour), now.Add(time.Hour),
+			Matcher{Name: "site", Value: "nyc1"}, Matcher{Name: "alertname", Value: "LinkDown"}),
+		silence("s-pending", SilenceStatePending, now.Add(time.Hour), now.Add(2*time.Hour),
+			Matcher{Name: "site", Value: "lon1"}),
+		silence("s-expired", SilenceStateExpired, now.Add(-2*time.Hour), now.Add(-time.Hour),
+			Matcher{Name: "site", Value: "ams1"}),
+		silence("s-regex", SilenceStateActive, now.Add(-time.Hour), now.Add(time.Hour),
+			Matcher{Name: "site", Value: "nyc.*", IsRegex: true}),
+		silence("s-negative", SilenceStateActive, now.Add(-time.Hour), now.Add(time.Hour),
+			Matcher{Name: "site", Value: "nyc1", IsEqual: &notEqual}),
+	)
+
+	if err := syncer.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	if len(store.windows) != 2 {
+		t.Fatalf("expected 2 windows, got %d", len(store.windows))
+	}
+
+	active := store.bySilence("s-active")
+	if active == nil {
+		t.Fatal("expected a window for s-active")
+	}
+	if active.Labels[SourceLabel] != SourceAlertmanager || active.Labels[CreatedByLabel] != "alice" {
+		t.Errorf("unexpected labels %v", active.Labels)
+	}
+	if active.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		t.Errorf("expected in progress status, got %v", active.Status)
+	}
+	if active.Action != routingv1.MaintenanceAction_MAINTENANCE_ACTION_SUPPRESS {
+		t.Errorf("expected suppress action, got %v", active.Action)
+	}
+	if got := active.AffectedLabels; len(got) != 2 || got[0] != "alertname=LinkDown" || got[1] != "site=nyc1" {
+		t.Errorf("unexpected affected labels %v", got)
+	}
+
+	pending := store.bySilence("s-pending")
+	if pending == nil || pending.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED {
+		t.Errorf("expected a scheduled window for s-pending, got %v", pending)
+	}
+
+	metrics := syncer.Metrics()
+	if metrics.SilencesSyncedTotal() != 5 {
+		t.Errorf("expected 5 silences synced, got %d", metrics.SilencesSyncedTotal())
+	}
+	if metrics.SilencesCreatedTotal() != 2 {
+		t.Errorf("expected 2 silences created, got %d", metrics.SilencesCreatedTotal())
+	}
+	if metrics.SilencesExpiredTotal() != 0 {
+		t.Errorf("expected no silences expired, got %d", metrics.SilencesExpiredTotal())
+	}
+}
+
+func TestSilenceSyncer_UpdatesChangedSilences(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, am, store := newTestSyncer(t, now)
+	ctx := context.Background()
+
+	s := silence("s-1", SilenceStateActive, now.Add(-time.Hour), now.Add(time.Hour), Matcher{Name: "site", Value: "nyc1"})
+	am.setSilences(s)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	windowID := store.windows[0].Id
+
+	// An unchanged silence does not touch the window
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if store.updates != 0 {
+		t.Errorf("expected no updates for an unchanged silence, got %d", store.updates)
+	}
+
+	s.EndsAt = now.Add(3 * time.Hour)
+	am.setSilences(s)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	if len(store.windows) != 1 {
+		t.Fatalf("expected the window to be updated in place, got %d windows", len(store.windows))
+	}
+	window := store.windows[0]
+	if window.Id != windowID {
+		t.Errorf("expected window %s to be kept, got %s", windowID, window.Id)
+	}
+	if !window.EndTime.AsTime().Equal(now.Add(3 * time.Hour)) {
+		t.Errorf("expected extended end time, got %v", window.EndTime.AsTime())
+	}
+	if syncer.Metrics().SilencesCreatedTotal() != 1 {
+		t.Errorf("expected 1 silence created, got %d", syncer.Metrics().SilencesCreatedTotal())
+	}
+}
+
+func TestSilenceSyncer_ExpiresWindows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, am, store := newTestSyncer(t, now)
+	ctx := context.Background()
+
+	expiring := silence("s-expiring", SilenceStateActive, now.Add(-time.Hour), now.Add(time.Hour), Matcher{Name: "site", Value: "nyc1"})
+	removed := silence("s-removed", SilenceStatePending, now.Add(time.Hour), now.Add(2*time.Hour), Matcher{Name: "site", Value: "lon1"})
+	am.setSilences(expiring, removed)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	// The first silence is expired early and the second is garbage collected
+	expiring.Status.State = SilenceStateExpired
+	expiring.EndsAt = now
+	am.setSilences(expiring)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	for _, id := range []string{"s-expiring", "s-removed"} {
+		window := store.bySilence(id)
+		if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED {
+			t.Errorf("expected window for %s to be completed, got %v", id, window.Status)
+		}
+		if window.EndTime.AsTime().After(now) {
+			t.Errorf("expected window for %s to end by %v, got %v", id, now, window.EndTime.AsTime())
+		}
+	}
+	if syncer.Metrics().SilencesExpiredTotal() != 2 {
+		t.Errorf("expected 2 silences expired, got %d", syncer.Metrics().SilencesExpiredTotal())
+	}
+
+	// Completed windows are not expired again
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if syncer.Metrics().SilencesExpiredTotal() != 2 {
+		t.Errorf("expected expired count to stay at 2, got %d", syncer.Metrics().SilencesExpiredTotal())
+	}
+}
+
+func TestSilenceSyncer_IgnoresOtherWindows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, _, store := newTestSyncer(t, now)
+
+	manual := &routingv1.MaintenanceWindow{
+		Id:     "manual",
+		Status: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		Labels: map[string]string{ExternalIDLabel: "CHG-1"},
+	}
+	store.windows = append(store.windows, manual)
+
+	if err := syncer.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if manual.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS || store.updates != 0 {
+		t.Error("expected windows not created from silences to be left alone")
+	}
+}
+
+func TestSilenceSyncer_AlertmanagerError(t *testing.T) {
+	syncer, am, store := newTestSyncer(t, time.Now())
+	am.status = http.StatusServiceUnavailable
+
+	if err := syncer.Sync(context.Background()); err == nil {
+		t.Fatal("expected an error when alertmanager is unavailable")
+	}
+	if len(store.windows) != 0 || syncer.Metrics().SilencesSyncedTotal() != 0 {
+		t.Error("expected nothing to be synced")
+	}
+}
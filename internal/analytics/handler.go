@@ -0,0 +1,196 @@
+package analytics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+// DefaultDeduplicationWindow is the window used when the request does not set one.
+const DefaultDeduplicationWindow = 24 * time.Hour
+
+// Handler serves the alert analytics endpoints.
+type Handler struct {
+	receipts   ReceiptStore
+	alertStore store.AlertStore
+	logger     zerolog.Logger
+
+	// summaries serves the per-service alert summary endpoint (optional)
+	summaries SummaryStore
+
+	// labelValues serves the label value autocomplete endpoint (optional)
+	labelValues LabelValueStore
+}
+
+// HandlerOption configures optional Handler dependencies.
+type HandlerOption func(*Handler)
+
+// WithAlertSummaries enables GET /alerts/summary backed by the given store.
+func WithAlertSummaries(summaries SummaryStore) HandlerOption {
+	return func(h *Handler) {
+		h.summaries = summaries
+	}
+}
+
+// WithLabelValues enables GET /labels/:key/values backed by the given store.
+func WithLabelValues(labelValues LabelValueStore) HandlerOption {
+	return func(h *Handler) {
+		h.labelValues = labelValues
+	}
+}
+
+// NewHandler creates a new analytics handler. The alert store provides the
+// summaries of the most deduplicated fingerprints.
+func NewHandler(receipts ReceiptStore, alertStore store.AlertStore, logger zerolog.Logger, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		receipts:   receipts,
+		alertStore: alertStore,
+		logger:     logger.With().Str("component", "analytics").Logger(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// RegisterRoutes registers the analytics routes on the provided router group.
+func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
+	analytics := router.Group("/analytics")
+	analytics.GET("/deduplication", h.GetDeduplicationStats)
+
+	if h.summaries != nil {
+		router.GET("/alerts/summary", h.GetAlertSummary)
+	}
+	if h.labelValues != nil {
+		router.GET("/labels/:key/values", h.GetLabelValues)
+	}
+}
+
+// DeduplicatedFingerprint is a fingerprint received more than once in the window.
+type DeduplicatedFingerprint struct {
+	Fingerprint string `json:"fingerprint"`
+	Count       int64  `json:"count"`
+	Summary     string `json:"summary"`
+}
+
+// DeduplicationStatsResponse is the body of GET /analytics/deduplication.
+type DeduplicationStatsResponse struct {
+	ServiceID           string                    `json:"service_id,omitempty"`
+	Window              string                    `json:"window"`
+	TotalReceived       int64                     `json:"total_received"`
+	UniqueFingerprints  int64                     `json:"unique_fingerprints"`
+	DedupRatio          float64                   `json:"dedup_ratio"`
+	TopMostDeduplicated []DeduplicatedFingerprint `json:"top_10_most_deduplicated"`
+}
+
+// GetDeduplicationStats handles GET /api/v1/analytics/deduplication?service_id=<s>&window=24h
+func (h *Handler) GetDeduplicationStats(c *gin.Context) {
+	ctx := c.Request.Context()
+	serviceID := c.Query("service_id")
+
+	window := DefaultDeduplicationWindow
+	if raw := c.Query("window"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid window: expected a positive duration such as 24h"})
+			return
+		}
+		window = parsed
+	}
+
+	stats, err := h.receipts.Stats(ctx, serviceID, time.Now().Add(-window))
+	if err != nil {
+		h.logger.Error().Err(err).Str("serviceId", serviceID).Msg("failed to compute deduplication stats")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to compute deduplication stats"})
+		return
+	}
+
+	top := make([]DeduplicatedFingerprint, 0, len(stats.TopDeduplicated))
+	for _, fc := range stats.TopDeduplicated {
+		entry := DeduplicatedFingerprint{Fingerprint: fc.Fingerprint, Count: fc.Count}
+
+		// A missing summary does not fail the report
+		alert, err := h.alertStore.GetByFingerprint(ctx, fc.Fingerprint)
+		if err != nil {
+			h.logger.Warn().Err(err).Str("fingerprint", fc.Fingerprint).Msg("failed to look up alert summary")
+		} else if alert != nil {
+			entry.Summary = alert.Summary
+		}
+
+		top = append(top, entry)
+	}
+
+	c.JSON(http.StatusOK, DeduplicationStatsResponse{
+		ServiceID:           serviceID,
+		Window:              window.String(),
+		TotalReceived:       stats.TotalReceived,
+		UniqueFingerprints:  stats.UniqueFingerprints,
+		DedupRatio:          stats.DedupRatio(),
+		TopMostDeduplicated: top,
+	})
+}
+
+// AlertSummaryResponse is the body of GET /alerts/summary.
+type AlertSummaryResponse struct {
+	Services []AlertSummary `json:"services"`
+}
+
+// GetAlertSummary handles GET /api/v1/alerts/summary?site_id=<s>&team_id=<t>
+func (h *Handler) GetAlertSummary(c *gin.Context) {
+	filter := SummaryFilter{
+		SiteID: c.Query("site_id"),
+		TeamID: c.Query("team_id"),
+	}
+
+	summaries, err := h.summaries.Summarize(c.Request.Context(), filter)
+	if err != nil {
+		h.logger.Error().Err(err).Str("siteId", filter.SiteID).Str("teamId", filter.TeamID).Msg("failed to summarize alerts")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to summarize alerts"})
+		return
+	}
+
+	if summaries == nil {
+		summaries = []AlertSummary{}
+	}
+	c.JSON(http.StatusOK, AlertSummaryResponse{Services: summaries})
+}
+
+// LabelValuesResponse is the body of GET /labels/:key/values.
+type LabelValuesResponse struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// GetLabelValues handles GET /api/v1/labels/:key/values?service_id=<s>&limit=20
+func (h *Handler) GetLabelValues(c *gin.Context) {
+	query := LabelValuesQuery{
+		Key:       c.Param("key"),
+		ServiceID: c.Query("service_id"),
+		Limit:     DefaultLabelValuesLimit,
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 || limit > MaxLabelValuesLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit: expected a number between 1 and " + strconv.Itoa(MaxLabelValuesLimit)})
+			return
+		}
+		query.Limit = limit
+	}
+
+	values, err := h.labelValues.LabelValues(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error().Err(err).Str("key", query.Key).Str("serviceId", query.ServiceID).Msg("failed to look up label values")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to look up label values"})
+		return
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+	c.JSON(http.StatusOK, LabelValuesResponse{Key: query.Key, Values: values})
+}
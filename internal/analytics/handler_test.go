@@ -0,0 +1,120 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// mockAlertStore serves alerts by fingerprint.
+type mockAlertStore struct {
+	byFingerprint map[string]*alertingv1.Alert
+}
+
+func (m *mockAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return m.byFingerprint[fingerprint], nil
+}
+
+func (m *mockAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	return alert, true, nil
+}
+
+func (m *mockAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	return &alertingv1.ListAlertsResponse{}, nil
+}
+
+func setupTestRouter() (*gin.Engine, *InMemoryStore) {
+	gin.SetMode(gin.TestMode)
+
+	receipts := NewInMemoryStore()
+	alerts := &mockAlertStore{byFingerprint: map[string]*alertingv1.Alert{
+		"fp-a": {Fingerprint: "fp-a", Summary: "Disk full on db-1"},
+	}}
+
+	router := gin.New()
+	NewHandler(receipts, alerts, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+	return router, receipts
+}
+
+func TestHandler_GetDeduplicationStats(t *testing.T) {
+	router, receipts := setupTestRouter()
+	now := time.Now()
+
+	seedReceipts(t, receipts, "svc-1", "fp-a", 6, now.Add(-time.Hour))
+	seedReceipts(t, receipts, "svc-1", "fp-b", 2, now.Add(-time.Hour))
+	seedReceipts(t, receipts, "svc-1", "fp-c", 1, now.Add(-time.Hour))
+	seedReceipts(t, receipts, "svc-1", "fp-d", 1, now.Add(-3*time.Hour))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/deduplication?service_id=svc-1&window=2h", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp DeduplicationStatsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	assert.Equal(t, "svc-1", resp.ServiceID)
+	assert.Equal(t, "2h0m0s", resp.Window)
+	assert.Equal(t, int64(9), resp.TotalReceived)
+	assert.Equal(t, int64(3), resp.UniqueFingerprints)
+	assert.InDelta(t, 1-3.0/9.0, resp.DedupRatio, 1e-9)
+	assert.Equal(t, []DeduplicatedFingerprint{
+		{Fingerprint: "fp-a", Count: 6, Summary: "Disk full on db-1"},
+		{Fingerprint: "fp-b", Count: 2},
+	}, resp.TopMostDeduplicated)
+}
+
+func TestHandler_GetDeduplicationStats_DefaultWindow(t *testing.T) {
+	router, receipts := setupTestRouter()
+	now := time.Now()
+
+	seedReceipts(t, receipts, "svc-1", "fp-a", 2, now.Add(-23*time.Hour))
+	seedReceipts(t, receipts, "svc-1", "fp-b", 2, now.Add(-25*time.Hour))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/deduplication", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp DeduplicationStatsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, int64(2), resp.TotalReceived)
+	assert.Equal(t, 0.5, resp.DedupRatio)
+}
+
+func TestHandler_GetDeduplicationStats_InvalidWindow(t *testing.T) {
+	router, _ := setupTestRouter()
+
+	for _, window := range []string{"yesterday", "-1h", "0s"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/deduplication?window="+window, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "window %q", window)
+	}
+}
@@ -0,0 +1,203 @@
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// LabelValuesLookback is how far back alerts are searched for label values.
+	LabelValuesLookback = 7 * 24 * time.Hour
+	// DefaultLabelValuesLimit is the number of values returned when the request does not set a limit.
+	DefaultLabelValuesLimit = 20
+	// MaxLabelValuesLimit caps the number of values a single request can return.
+	MaxLabelValuesLimit = 100
+	// DefaultLabelValuesCacheTTL is how long label values are served from the cache.
+	DefaultLabelValuesCacheTTL = 5 * time.Minute
+)
+
+// LabelValuesQuery selects the values of one label key. An empty ServiceID
+// matches alerts of every service.
+type LabelValuesQuery struct {
+	Key       string
+	ServiceID string
+	Limit     int
+}
+
+// LabelValueStore looks up the distinct values of an alert label.
+type LabelValueStore interface {
+	// LabelValues returns up to query.Limit distinct values of the label on alerts
+	// triggered in the last LabelValuesLookback, in ascending order.
+	LabelValues(ctx context.Context, query LabelValuesQuery) ([]string, error)
+}
+
+// PostgresLabelValueStore implements LabelValueStore using PostgreSQL.
+type PostgresLabelValueStore struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// NewPostgresLabelValueStore creates a new PostgresLabelValueStore.
+func NewPostgresLabelValueStore(db *sql.DB) *PostgresLabelValueStore {
+	return &PostgresLabelValueStore{db: db, now: time.Now}
+}
+
+// LabelValues selects the distinct values of the label key from the alerts table.
+func (s *PostgresLabelValueStore) LabelValues(ctx context.Context, query LabelValuesQuery) ([]string, error) {
+	args := []interface{}{query.Key, s.now().Add(-LabelValuesLookback), query.Limit}
+
+	serviceCondition := ""
+	if query.ServiceID != "" {
+		args = append(args, query.ServiceID)
+		serviceCondition = fmt.Sprintf("AND service_id = $%d", len(args))
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT DISTINCT labels->>$1 AS value
+		FROM alerts
+		WHERE labels->>$1 IS NOT NULL AND triggered_at >= $2 %s
+		ORDER BY value
+		LIMIT $3
+	`, serviceCondition), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query label values: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("scan label value: %w", err)
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
+
+// Ensure PostgresLabelValueStore implements LabelValueStore
+var _ LabelValueStore = (*PostgresLabelValueStore)(nil)
+
+// AlertStoreLabelValueStore implements LabelValueStore by scanning the alerts of
+// an AlertStore in memory. It backs the in-memory alert store.
+type AlertStoreLabelValueStore struct {
+	alertStore store.AlertStore
+	now        func() time.Time
+}
+
+// NewAlertStoreLabelValueStore creates a new AlertStoreLabelValueStore.
+func NewAlertStoreLabelValueStore(alertStore store.AlertStore) *AlertStoreLabelValueStore {
+	return &AlertStoreLabelValueStore{alertStore: alertStore, now: time.Now}
+}
+
+// LabelValues collects the distinct values of the label key from every alert in the store.
+func (s *AlertStoreLabelValueStore) LabelValues(ctx context.Context, query LabelValuesQuery) ([]string, error) {
+	resp, err := s.alertStore.List(ctx, &alertingv1.ListAlertsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list alerts: %w", err)
+	}
+
+	since := s.now().Add(-LabelValuesLookback)
+	seen := make(map[string]bool)
+	for _, alert := range resp.Alerts {
+		if query.ServiceID != "" && alert.ServiceId != query.ServiceID {
+			continue
+		}
+		if alert.TriggeredAt != nil && alert.TriggeredAt.AsTime().Before(since) {
+			continue
+		}
+		if value, ok := alert.Labels[query.Key]; ok {
+			seen[value] = true
+		}
+	}
+
+	values := make([]string, 0, len(seen))
+	for value := range seen {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	if query.Limit > 0 && len(values) > query.Limit {
+		values = values[:query.Limit]
+	}
+
+	return values, nil
+}
+
+// Ensure AlertStoreLabelValueStore implements LabelValueStore
+var _ LabelValueStore = (*AlertStoreLabelValueStore)(nil)
+
+// LabelValueCache caches the label values of another LabelValueStore per query.
+// Entries expire after the TTL or when Invalidate is called.
+type LabelValueCache struct {
+	next LabelValueStore
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[LabelValuesQuery]labelValueCacheEntry
+	// generation changes on every Invalidate so that values looked up
+	// before an invalidation are not cached after it
+	generation uint64
+}
+
+type labelValueCacheEntry struct {
+	values    []string
+	expiresAt time.Time
+}
+
+// NewLabelValueCache creates a LabelValueCache in front of next. A non-positive
+// TTL uses DefaultLabelValuesCacheTTL.
+func NewLabelValueCache(next LabelValueStore, ttl time.Duration) *LabelValueCache {
+	if ttl <= 0 {
+		ttl = DefaultLabelValuesCacheTTL
+	}
+	return &LabelValueCache{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[LabelValuesQuery]labelValueCacheEntry),
+	}
+}
+
+// LabelValues returns the cached values for the query, looking them up on a miss.
+func (c *LabelValueCache) LabelValues(ctx context.Context, query LabelValuesQuery) ([]string, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[query]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.values, nil
+	}
+
+	values, err := c.next.LabelValues(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[query] = labelValueCacheEntry{values: values, expiresAt: c.now().Add(c.ttl)}
+	}
+	c.mu.Unlock()
+
+	return values, nil
+}
+
+// Invalidate drops every cached label value.
+func (c *LabelValueCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[LabelValuesQuery]labelValueCacheEntry)
+	c.generation++
+}
+
+// Ensure LabelValueCache implements LabelValueStore
+var _ LabelValueStore = (*LabelValueCache)(nil)
@@ -0,0 +1,153 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+var labelTestNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func labelAlert(serviceID string, age time.Duration, labels map[string]string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		ServiceId:   serviceID,
+		Labels:      labels,
+		TriggeredAt: timestamppb.New(labelTestNow.Add(-age)),
+	}
+}
+
+func newLabelTestStore() (*listAlertStore, *AlertStoreLabelValueStore) {
+	alerts := &listAlertStore{alerts: []*alertingv1.Alert{
+		labelAlert("svc-api", time.Hour, map[string]string{"site": "nyc1", "env": "prod"}),
+		labelAlert("svc-api", 2*time.Hour, map[string]string{"site": "ams1"}),
+		labelAlert("svc-api", 3*time.Hour, map[string]string{"site": "nyc1"}),
+		labelAlert("svc-db", time.Hour, map[string]string{"site": "lon1"}),
+		labelAlert("svc-db", 8*24*time.Hour, map[string]string{"site": "fra1"}),
+	}}
+	values := NewAlertStoreLabelValueStore(alerts)
+	values.now = func() time.Time { return labelTestNow }
+	return alerts, values
+}
+
+func TestAlertStoreLabelValueStore_LabelValues(t *testing.T) {
+	_, values := newLabelTestStore()
+	ctx := context.Background()
+
+	got, err := values.LabelValues(ctx, LabelValuesQuery{Key: "site", Limit: DefaultLabelValuesLimit})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ams1", "lon1", "nyc1"}, got, "values should be distinct, sorted and from the last 7 days")
+
+	got, err = values.LabelValues(ctx, LabelValuesQuery{Key: "site", ServiceID: "svc-api", Limit: DefaultLabelValuesLimit})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ams1", "nyc1"}, got)
+
+	got, err = values.LabelValues(ctx, LabelValuesQuery{Key: "site", Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ams1", "lon1"}, got)
+
+	got, err = values.LabelValues(ctx, LabelValuesQuery{Key: "missing", Limit: DefaultLabelValuesLimit})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestPostgresLabelValueStore_LabelValues(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	values := NewPostgresLabelValueStore(db)
+	values.now = func() time.Time { return labelTestNow }
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT labels->>$1 AS value")).
+		WithArgs("site", labelTestNow.Add(-LabelValuesLookback), 20, "svc-api").
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow("ams1").AddRow("nyc1"))
+
+	got, err := values.LabelValues(context.Background(), LabelValuesQuery{Key: "site", ServiceID: "svc-api", Limit: 20})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ams1", "nyc1"}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLabelValueCache_ExpiresAndInvalidates(t *testing.T) {
+	alerts, values := newLabelTestStore()
+	cache := NewLabelValueCache(values, 0)
+	now := labelTestNow
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+	query := LabelValuesQuery{Key: "site", Limit: DefaultLabelValuesLimit}
+
+	_, _ = cache.LabelValues(ctx, query)
+	_, _ = cache.LabelValues(ctx, query)
+	assert.Equal(t, 1, alerts.lists, "second request should be served from the cache")
+
+	_, _ = cache.LabelValues(ctx, LabelValuesQuery{Key: "env", Limit: DefaultLabelValuesLimit})
+	assert.Equal(t, 2, alerts.lists, "keys are cached separately")
+
+	alerts.alerts = append(alerts.alerts, labelAlert("svc-db", time.Minute, map[string]string{"site": "sfo1"}))
+	now = now.Add(DefaultLabelValuesCacheTTL - time.Second)
+	got, err := cache.LabelValues(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ams1", "lon1", "nyc1"}, got, "new values are not visible until the entry expires")
+
+	now = now.Add(time.Second)
+	got, err = cache.LabelValues(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, 3, alerts.lists, "expired entries should be looked up again")
+	assert.Equal(t, []string{"ams1", "lon1", "nyc1", "sfo1"}, got)
+
+	alerts.alerts = append(alerts.alerts, labelAlert("svc-db", time.Minute, map[string]string{"site": "ber1"}))
+	cache.Invalidate()
+	got, err = cache.LabelValues(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, 4, alerts.lists)
+	assert.Equal(t, []string{"ams1", "ber1", "lon1", "nyc1", "sfo1"}, got)
+}
+
+func TestHandler_GetLabelValues(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alerts, values := newLabelTestStore()
+	router := gin.New()
+	NewHandler(NewInMemoryStore(), alerts, zerolog.Nop(),
+		WithLabelValues(NewLabelValueCache(values, DefaultLabelValuesCacheTTL)),
+	).RegisterRoutes(router.Group("/api/v1"))
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := get("/api/v1/labels/site/values?service_id=svc-api")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp LabelValuesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, LabelValuesResponse{Key: "site", Values: []string{"ams1", "nyc1"}}, resp)
+
+	w = get("/api/v1/labels/site/values?limit=1")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"ams1"}, resp.Values)
+
+	w = get("/api/v1/labels/unknown/values")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"key":"unknown","values":[]}`, w.Body.String())
+
+	for _, limit := range []string{"0", "abc", "101"} {
+		w = get("/api/v1/labels/site/values?limit=" + limit)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "limit=%s", limit)
+	}
+}
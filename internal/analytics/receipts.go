@@ -0,0 +1,248 @@
+// Package analytics provides operational statistics about alert ingestion.
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TopDeduplicatedLimit is the number of fingerprints reported as most deduplicated.
+const TopDeduplicatedLimit = 10
+
+// ErrInvalidReceipt is returned when a receipt cannot be recorded.
+var ErrInvalidReceipt = errors.New("invalid alert receipt")
+
+// Receipt records a single alert received by a webhook.
+type Receipt struct {
+	Fingerprint string
+	ServiceID   string
+	WasNew      bool
+	CreatedAt   time.Time
+}
+
+// FingerprintCount is the number of receipts for a single fingerprint.
+type FingerprintCount struct {
+	Fingerprint string
+	Count       int64
+}
+
+// ReceiptStats summarises the receipts of a time window.
+type ReceiptStats struct {
+	TotalReceived      int64
+	UniqueFingerprints int64
+	// TopDeduplicated holds the fingerprints received more than once, most received first.
+	TopDeduplicated []FingerprintCount
+}
+
+// DedupRatio returns the share of receipts that were deduplicated, 1 - unique/total.
+func (s *ReceiptStats) DedupRatio() float64 {
+	if s.TotalReceived == 0 {
+		return 0
+	}
+	return 1 - float64(s.UniqueFingerprints)/float64(s.TotalReceived)
+}
+
+// ReceiptStore defines the interface for alert receipt log persistence.
+type ReceiptStore interface {
+	// Record appends a receipt to the log.
+	Record(ctx context.Context, receipt *Receipt) error
+
+	// Stats summarises receipts created at or after since. An empty serviceID
+	// includes every service.
+	Stats(ctx context.Context, serviceID string, since time.Time) (*ReceiptStats, error)
+
+	// Purge deletes receipts created before olderThan and returns the number deleted.
+	Purge(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+func validateReceipt(receipt *Receipt) error {
+	if receipt == nil {
+		return ErrInvalidReceipt
+	}
+	if receipt.Fingerprint == "" || receipt.ServiceID == "" {
+		return fmt.Errorf("%w: fingerprint and service_id are required", ErrInvalidReceipt)
+	}
+	return nil
+}
+
+// PostgresStore implements ReceiptStore using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Record inserts a receipt into alert_receipt_log.
+func (s *PostgresStore) Record(ctx context.Context, receipt *Receipt) error {
+	if err := validateReceipt(receipt); err != nil {
+		return err
+	}
+
+	if receipt.CreatedAt.IsZero() {
+		receipt.CreatedAt = time.Now()
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO alert_receipt_log (fingerprint, service_id, was_new, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, receipt.Fingerprint, receipt.ServiceID, receipt.WasNew, receipt.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert alert receipt: %w", err)
+	}
+
+	return nil
+}
+
+// Stats summarises receipts in alert_receipt_log.
+func (s *PostgresStore) Stats(ctx context.Context, serviceID string, since time.Time) (*ReceiptStats, error) {
+	where := "created_at >= $1"
+	args := []interface{}{since}
+	if serviceID != "" {
+		where += " AND service_id = $2"
+		args = append(args, serviceID)
+	}
+
+	stats := &ReceiptStats{}
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COUNT(*), COUNT(DISTINCT fingerprint) FROM alert_receipt_log WHERE "+where,
+		args...,
+	).Scan(&stats.TotalReceived, &stats.UniqueFingerprints)
+	if err != nil {
+		return nil, fmt.Errorf("query receipt totals: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT fingerprint, COUNT(*) AS received
+		FROM alert_receipt_log
+		WHERE %s
+		GROUP BY fingerprint
+		HAVING COUNT(*) > 1
+		ORDER BY received DESC, fingerprint
+		LIMIT %d
+	`, where, TopDeduplicatedLimit), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query top deduplicated fingerprints: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var fc FingerprintCount
+		if err := rows.Scan(&fc.Fingerprint, &fc.Count); err != nil {
+			return nil, fmt.Errorf("scan fingerprint count: %w", err)
+		}
+		stats.TopDeduplicated = append(stats.TopDeduplicated, fc)
+	}
+
+	return stats, rows.Err()
+}
+
+// Purge deletes receipts from alert_receipt_log created before olderThan.
+func (s *PostgresStore) Purge(ctx context.Context, olderThan time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM alert_receipt_log WHERE created_at < $1`, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("purge alert receipts: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("purge alert receipts: %w", err)
+	}
+	return deleted, nil
+}
+
+// Ensure PostgresStore implements ReceiptStore
+var _ ReceiptStore = (*PostgresStore)(nil)
+
+// InMemoryStore implements ReceiptStore in memory.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	receipts []Receipt
+}
+
+// NewInMemoryStore creates a new InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{}
+}
+
+// Record appends a receipt to the log.
+func (s *InMemoryStore) Record(ctx context.Context, receipt *Receipt) error {
+	if err := validateReceipt(receipt); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if receipt.CreatedAt.IsZero() {
+		receipt.CreatedAt = time.Now()
+	}
+	s.receipts = append(s.receipts, *receipt)
+	return nil
+}
+
+// Stats summarises the recorded receipts.
+func (s *InMemoryStore) Stats(ctx context.Context, serviceID string, since time.Time) (*ReceiptStats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	stats := &ReceiptStats{}
+	for _, receipt := range s.receipts {
+		if receipt.CreatedAt.Before(since) {
+			continue
+		}
+		if serviceID != "" && receipt.ServiceID != serviceID {
+			continue
+		}
+		stats.TotalReceived++
+		counts[receipt.Fingerprint]++
+	}
+	stats.UniqueFingerprints = int64(len(counts))
+
+	for fingerprint, count := range counts {
+		if count > 1 {
+			stats.TopDeduplicated = append(stats.TopDeduplicated, FingerprintCount{Fingerprint: fingerprint, Count: count})
+		}
+	}
+	sort.Slice(stats.TopDeduplicated, func(i, j int) bool {
+		a, b := stats.TopDeduplicated[i], stats.TopDeduplicated[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+	if len(stats.TopDeduplicated) > TopDeduplicatedLimit {
+		stats.TopDeduplicated = stats.TopDeduplicated[:TopDeduplicatedLimit]
+	}
+
+	return stats, nil
+}
+
+// Purge deletes receipts created before olderThan.
+func (s *InMemoryStore) Purge(ctx context.Context, olderThan time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.receipts[:0]
+	for _, receipt := range s.receipts {
+		if receipt.CreatedAt.Before(olderThan) {
+			continue
+		}
+		kept = append(kept, receipt)
+	}
+
+	deleted := int64(len(s.receipts) - len(kept))
+	s.receipts = kept
+	return deleted, nil
+}
+
+// Ensure InMemoryStore implements ReceiptStore
+var _ ReceiptStore = (*InMemoryStore)(nil)
@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedReceipts records count receipts of a fingerprint, the first one as new.
+func seedReceipts(t *testing.T, store ReceiptStore, serviceID, fingerprint string, count int, at time.Time) {
+	t.Helper()
+
+	for i := 0; i < count; i++ {
+		require.NoError(t, store.Record(context.Background(), &Receipt{
+			Fingerprint: fingerprint,
+			ServiceID:   serviceID,
+			WasNew:      i == 0,
+			CreatedAt:   at,
+		}))
+	}
+}
+
+func TestInMemoryStore_Stats(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Now()
+
+	seedReceipts(t, store, "svc-1", "fp-a", 5, now.Add(-time.Hour))
+	seedReceipts(t, store, "svc-1", "fp-b", 3, now.Add(-time.Hour))
+	seedReceipts(t, store, "svc-1", "fp-c", 1, now.Add(-time.Hour))
+	seedReceipts(t, store, "svc-1", "fp-d", 1, now.Add(-time.Hour))
+	// Outside the window and another service
+	seedReceipts(t, store, "svc-1", "fp-old", 4, now.Add(-48*time.Hour))
+	seedReceipts(t, store, "svc-2", "fp-a", 6, now.Add(-time.Hour))
+
+	stats, err := store.Stats(context.Background(), "svc-1", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(10), stats.TotalReceived)
+	assert.Equal(t, int64(4), stats.UniqueFingerprints)
+	assert.InDelta(t, 0.6, stats.DedupRatio(), 1e-9)
+	assert.Equal(t, []FingerprintCount{
+		{Fingerprint: "fp-a", Count: 5},
+		{Fingerprint: "fp-b", Count: 3},
+	}, stats.TopDeduplicated)
+
+	all, err := store.Stats(context.Background(), "", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), all.TotalReceived)
+	assert.Equal(t, FingerprintCount{Fingerprint: "fp-a", Count: 11}, all.TopDeduplicated[0])
+}
+
+func TestInMemoryStore_Stats_TopLimit(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Now()
+
+	for i := 0; i < TopDeduplicatedLimit+5; i++ {
+		seedReceipts(t, store, "svc-1", string(rune('a'+i)), i+2, now)
+	}
+
+	stats, err := store.Stats(context.Background(), "svc-1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, stats.TopDeduplicated, TopDeduplicatedLimit)
+	assert.Equal(t, int64(TopDeduplicatedLimit+6), stats.TopDeduplicated[0].Count)
+}
+
+func TestReceiptStats_DedupRatio_Empty(t *testing.T) {
+	stats := &ReceiptStats{}
+	assert.Equal(t, 0.0, stats.DedupRatio())
+}
+
+func TestInMemoryStore_Record_Invalid(t *testing.T) {
+	store := NewInMemoryStore()
+
+	assert.ErrorIs(t, store.Record(context.Background(), nil), ErrInvalidReceipt)
+	assert.ErrorIs(t, store.Record(context.Background(), &Receipt{ServiceID: "svc-1"}), ErrInvalidReceipt)
+}
+
+func TestPostgresStore_Stats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*), COUNT(DISTINCT fingerprint) FROM alert_receipt_log WHERE created_at >= $1 AND service_id = $2")).
+		WithArgs(since, "svc-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(10, 4))
+	mock.ExpectQuery(regexp.QuoteMeta("HAVING COUNT(*) > 1")).
+		WithArgs(since, "svc-1").
+		WillReturnRows(sqlmock.NewRows([]string{"fingerprint", "received"}).
+			AddRow("fp-a", 5).
+			AddRow("fp-b", 3))
+
+	stats, err := store.Stats(context.Background(), "svc-1", since)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(10), stats.TotalReceived)
+	assert.Equal(t, int64(4), stats.UniqueFingerprints)
+	assert.InDelta(t, 0.6, stats.DedupRatio(), 1e-9)
+	assert.Len(t, stats.TopDeduplicated, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_Record(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO alert_receipt_log")).
+		WithArgs("fp-a", "svc-1", true, at).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, store.Record(context.Background(), &Receipt{
+		Fingerprint: "fp-a",
+		ServiceID:   "svc-1",
+		WasNew:      true,
+		CreatedAt:   at,
+	}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInMemoryStore_Purge(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	seedReceipts(t, store, "svc-1", "fp-old", 2, now.Add(-31*24*time.Hour))
+	seedReceipts(t, store, "svc-1", "fp-new", 1, now.Add(-time.Hour))
+
+	deleted, err := store.Purge(context.Background(), now.Add(-30*24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	stats, err := store.Stats(context.Background(), "", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.TotalReceived)
+}
+
+func TestPostgresStore_Purge(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	cutoff := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM alert_receipt_log WHERE created_at < $1")).
+		WithArgs(cutoff).
+		WillReturnResult(sqlmock.NewResult(0, 42))
+
+	deleted, err := store.Purge(context.Background(), cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,250 @@
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// DefaultSummaryCacheTTL is how long alert summaries are served from the cache.
+const DefaultSummaryCacheTTL = 30 * time.Second
+
+// AlertSummary holds the alert counts of a single service.
+type AlertSummary struct {
+	ServiceID         string `json:"service_id"`
+	ServiceName       string `json:"service_name"`
+	TriggeredCount    int64  `json:"triggered_count"`
+	AcknowledgedCount int64  `json:"acknowledged_count"`
+	ResolvedCount     int64  `json:"resolved_count"`
+	CriticalCount     int64  `json:"critical_count"`
+	HighCount         int64  `json:"high_count"`
+}
+
+// SummaryFilter narrows the alerts included in a summary. Sites and teams are
+// matched on the alert's site_id and team_id labels; empty fields match all alerts.
+type SummaryFilter struct {
+	SiteID string
+	TeamID string
+}
+
+// SummaryStore computes per-service alert summaries.
+type SummaryStore interface {
+	// Summarize returns one summary per service with matching alerts, ordered by service ID.
+	Summarize(ctx context.Context, filter SummaryFilter) ([]AlertSummary, error)
+}
+
+// PostgresSummaryStore implements SummaryStore using PostgreSQL.
+type PostgresSummaryStore struct {
+	db *sql.DB
+}
+
+// NewPostgresSummaryStore creates a new PostgresSummaryStore.
+func NewPostgresSummaryStore(db *sql.DB) *PostgresSummaryStore {
+	return &PostgresSummaryStore{db: db}
+}
+
+// Summarize aggregates the alerts table with a single GROUP BY query.
+func (s *PostgresSummaryStore) Summarize(ctx context.Context, filter SummaryFilter) ([]AlertSummary, error) {
+	args := []interface{}{
+		alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED.String(),
+		alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED.String(),
+		alertingv1.AlertStatus_ALERT_STATUS_RESOLVED.String(),
+		alertingv1.Severity_SEVERITY_CRITICAL.String(),
+		alertingv1.Severity_SEVERITY_HIGH.String(),
+	}
+
+	var conditions []string
+	if filter.SiteID != "" {
+		args = append(args, filter.SiteID)
+		conditions = append(conditions, fmt.Sprintf("a.labels->>'site_id' = $%d", len(args)))
+	}
+	if filter.TeamID != "" {
+		args = append(args, filter.TeamID)
+		conditions = append(conditions, fmt.Sprintf("a.labels->>'team_id' = $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT a.service_id, COALESCE(s.name, ''),
+			COUNT(*) FILTER (WHERE a.status = $1),
+			COUNT(*) FILTER (WHERE a.status = $2),
+			COUNT(*) FILTER (WHERE a.status = $3),
+			COUNT(*) FILTER (WHERE a.severity = $4),
+			COUNT(*) FILTER (WHERE a.severity = $5)
+		FROM alerts a
+		LEFT JOIN services s ON s.id = a.service_id
+		%s
+		GROUP BY a.service_id, s.name
+		ORDER BY a.service_id
+	`, where), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query alert summary: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var summaries []AlertSummary
+	for rows.Next() {
+		var summary AlertSummary
+		if err := rows.Scan(&summary.ServiceID, &summary.ServiceName,
+			&summary.TriggeredCount, &summary.AcknowledgedCount, &summary.ResolvedCount,
+			&summary.CriticalCount, &summary.HighCount); err != nil {
+			return nil, fmt.Errorf("scan alert summary: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, rows.Err()
+}
+
+// Ensure PostgresSummaryStore implements SummaryStore
+var _ SummaryStore = (*PostgresSummaryStore)(nil)
+
+// AlertStoreSummaryStore implements SummaryStore by aggregating the alerts of
+// an AlertStore in memory. It backs the in-memory alert store.
+type AlertStoreSummaryStore struct {
+	alertStore   store.AlertStore
+	serviceStore store.ServiceStore
+}
+
+// NewAlertStoreSummaryStore creates a new AlertStoreSummaryStore. Service names
+// are looked up in the service store.
+func NewAlertStoreSummaryStore(alertStore store.AlertStore, serviceStore store.ServiceStore) *AlertStoreSummaryStore {
+	return &AlertStoreSummaryStore{alertStore: alertStore, serviceStore: serviceStore}
+}
+
+// Summarize aggregates every alert in the alert store.
+func (s *AlertStoreSummaryStore) Summarize(ctx context.Context, filter SummaryFilter) ([]AlertSummary, error) {
+	resp, err := s.alertStore.List(ctx, &alertingv1.ListAlertsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list alerts: %w", err)
+	}
+
+	byService := make(map[string]*AlertSummary)
+	for _, alert := range resp.Alerts {
+		if filter.SiteID != "" && alert.Labels["site_id"] != filter.SiteID {
+			continue
+		}
+		if filter.TeamID != "" && alert.Labels["team_id"] != filter.TeamID {
+			continue
+		}
+
+		summary, ok := byService[alert.ServiceId]
+		if !ok {
+			summary = &AlertSummary{ServiceID: alert.ServiceId}
+			byService[alert.ServiceId] = summary
+		}
+
+		switch alert.Status {
+		case alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED:
+			summary.TriggeredCount++
+		case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+			summary.AcknowledgedCount++
+		case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+			summary.ResolvedCount++
+		}
+		switch alert.Severity {
+		case alertingv1.Severity_SEVERITY_CRITICAL:
+			summary.CriticalCount++
+		case alertingv1.Severity_SEVERITY_HIGH:
+			summary.HighCount++
+		}
+	}
+
+	summaries := make([]AlertSummary, 0, len(byService))
+	for serviceID, summary := range byService {
+		if s.serviceStore != nil && serviceID != "" {
+			// A missing service leaves the name empty, as the LEFT JOIN does
+			if service, err := s.serviceStore.GetByID(ctx, serviceID); err == nil && service != nil {
+				summary.ServiceName = service.Name
+			}
+		}
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ServiceID < summaries[j].ServiceID
+	})
+
+	return summaries, nil
+}
+
+// Ensure AlertStoreSummaryStore implements SummaryStore
+var _ SummaryStore = (*AlertStoreSummaryStore)(nil)
+
+// SummaryCache caches the summaries of another SummaryStore per filter.
+// Entries expire after the TTL or when Invalidate is called.
+type SummaryCache struct {
+	next SummaryStore
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[SummaryFilter]summaryCacheEntry
+	// generation changes on every Invalidate so that summaries computed
+	// before an invalidation are not cached after it
+	generation uint64
+}
+
+type summaryCacheEntry struct {
+	summaries []AlertSummary
+	expiresAt time.Time
+}
+
+// NewSummaryCache creates a SummaryCache in front of next. A non-positive TTL
+// uses DefaultSummaryCacheTTL.
+func NewSummaryCache(next SummaryStore, ttl time.Duration) *SummaryCache {
+	if ttl <= 0 {
+		ttl = DefaultSummaryCacheTTL
+	}
+	return &SummaryCache{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[SummaryFilter]summaryCacheEntry),
+	}
+}
+
+// Summarize returns the cached summaries for the filter, computing them on a miss.
+func (c *SummaryCache) Summarize(ctx context.Context, filter SummaryFilter) ([]AlertSummary, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[filter]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.summaries, nil
+	}
+
+	summaries, err := c.next.Summarize(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[filter] = summaryCacheEntry{summaries: summaries, expiresAt: c.now().Add(c.ttl)}
+	}
+	c.mu.Unlock()
+
+	return summaries, nil
+}
+
+// Invalidate drops every cached summary. It is called whenever an alert is
+// created or updated.
+func (c *SummaryCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[SummaryFilter]summaryCacheEntry)
+	c.generation++
+}
+
+// Ensure SummaryCache implements SummaryStore
+var _ SummaryStore = (*SummaryCache)(nil)
@@ -0,0 +1,204 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// listAlertStore serves a fixed set of alerts from List.
+type listAlertStore struct {
+	mockAlertStore
+	alerts []*alertingv1.Alert
+	lists  int
+}
+
+func (m *listAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	m.lists++
+	return &alertingv1.ListAlertsResponse{Alerts: m.alerts, TotalCount: int32(len(m.alerts))}, nil
+}
+
+// mockServiceStore serves services by ID.
+type mockServiceStore struct {
+	services map[string]*store.Service
+}
+
+func (m *mockServiceStore) GetByIntegrationKey(ctx context.Context, integrationKey string) (*store.Service, error) {
+	return nil, errors.New("not found")
+}
+
+func (m *mockServiceStore) Create(ctx context.Context, service *store.Service) (*store.Service, error) {
+	return service, nil
+}
+
+func (m *mockServiceStore) GetByID(ctx context.Context, id string) (*store.Service, error) {
+	return m.services[id], nil
+}
+
+func (m *mockServiceStore) CanDeleteService(ctx context.Context, serviceID string) (*store.DeletionImpact, error) {
+	return &store.DeletionImpact{}, nil
+}
+
+func (m *mockServiceStore) Delete(ctx context.Context, serviceID string, force bool) error {
+	return nil
+}
+
+func seedAlerts(serviceID string, status alertingv1.AlertStatus, severity alertingv1.Severity, count int, labels map[string]string) []*alertingv1.Alert {
+	alerts := make([]*alertingv1.Alert, 0, count)
+	for i := 0; i < count; i++ {
+		alerts = append(alerts, &alertingv1.Alert{ServiceId: serviceID, Status: status, Severity: severity, Labels: labels})
+	}
+	return alerts
+}
+
+func newSummaryTestStores() (*listAlertStore, *mockServiceStore) {
+	nyc := map[string]string{"site_id": "nyc", "team_id": "network"}
+	lon := map[string]string{"site_id": "lon", "team_id": "storage"}
+
+	var alerts []*alertingv1.Alert
+	alerts = append(alerts, seedAlerts("svc-api", alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.Severity_SEVERITY_CRITICAL, 3, nyc)...)
+	alerts = append(alerts, seedAlerts("svc-api", alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, alertingv1.Severity_SEVERITY_HIGH, 2, nyc)...)
+	alerts = append(alerts, seedAlerts("svc-api", alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, alertingv1.Severity_SEVERITY_LOW, 4, lon)...)
+	alerts = append(alerts, seedAlerts("svc-db", alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.Severity_SEVERITY_HIGH, 1, lon)...)
+	alerts = append(alerts, seedAlerts("svc-db", alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED, alertingv1.Severity_SEVERITY_CRITICAL, 2, lon)...)
+
+	services := &mockServiceStore{services: map[string]*store.Service{
+		"svc-api": {ID: "svc-api", Name: "API Gateway"},
+		"svc-db":  {ID: "svc-db", Name: "Database"},
+	}}
+
+	return &listAlertStore{alerts: alerts}, services
+}
+
+func TestAlertStoreSummaryStore_Summarize(t *testing.T) {
+	alerts, services := newSummaryTestStores()
+	summaries := NewAlertStoreSummaryStore(alerts, services)
+
+	got, err := summaries.Summarize(context.Background(), SummaryFilter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []AlertSummary{
+		{ServiceID: "svc-api", ServiceName: "API Gateway", TriggeredCount: 3, AcknowledgedCount: 2, ResolvedCount: 4, CriticalCount: 3, HighCount: 2},
+		{ServiceID: "svc-db", ServiceName: "Database", TriggeredCount: 1, CriticalCount: 2, HighCount: 1},
+	}, got)
+}
+
+func TestAlertStoreSummaryStore_Filters(t *testing.T) {
+	alerts, services := newSummaryTestStores()
+	summaries := NewAlertStoreSummaryStore(alerts, services)
+	ctx := context.Background()
+
+	bySite, err := summaries.Summarize(ctx, SummaryFilter{SiteID: "lon"})
+	require.NoError(t, err)
+	assert.Equal(t, []AlertSummary{
+		{ServiceID: "svc-api", ServiceName: "API Gateway", ResolvedCount: 4},
+		{ServiceID: "svc-db", ServiceName: "Database", TriggeredCount: 1, CriticalCount: 2, HighCount: 1},
+	}, bySite)
+
+	byTeam, err := summaries.Summarize(ctx, SummaryFilter{TeamID: "network"})
+	require.NoError(t, err)
+	assert.Equal(t, []AlertSummary{
+		{ServiceID: "svc-api", ServiceName: "API Gateway", TriggeredCount: 3, AcknowledgedCount: 2, CriticalCount: 3, HighCount: 2},
+	}, byTeam)
+
+	none, err := summaries.Summarize(ctx, SummaryFilter{SiteID: "nyc", TeamID: "storage"})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestPostgresSummaryStore_Summarize(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	columns := []string{"service_id", "name", "triggered", "acknowledged", "resolved", "critical", "high"}
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE a.labels->>'site_id' = $6 AND a.labels->>'team_id' = $7")).
+		WithArgs("ALERT_STATUS_TRIGGERED", "ALERT_STATUS_ACKNOWLEDGED", "ALERT_STATUS_RESOLVED",
+			"SEVERITY_CRITICAL", "SEVERITY_HIGH", "nyc", "network").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("svc-api", "API Gateway", 3, 2, 0, 3, 2))
+
+	got, err := NewPostgresSummaryStore(db).Summarize(context.Background(), SummaryFilter{SiteID: "nyc", TeamID: "network"})
+	require.NoError(t, err)
+	assert.Equal(t, []AlertSummary{
+		{ServiceID: "svc-api", ServiceName: "API Gateway", TriggeredCount: 3, AcknowledgedCount: 2, CriticalCount: 3, HighCount: 2},
+	}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta("GROUP BY a.service_id, s.name")).
+		WithArgs("ALERT_STATUS_TRIGGERED", "ALERT_STATUS_ACKNOWLEDGED", "ALERT_STATUS_RESOLVED",
+			"SEVERITY_CRITICAL", "SEVERITY_HIGH").
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	_, err = NewPostgresSummaryStore(db).Summarize(context.Background(), SummaryFilter{})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSummaryCache_ExpiresAndInvalidates(t *testing.T) {
+	alerts, services := newSummaryTestStores()
+	cache := NewSummaryCache(NewAlertStoreSummaryStore(alerts, services), 0)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = cache.Summarize(ctx, SummaryFilter{})
+	_, _ = cache.Summarize(ctx, SummaryFilter{})
+	assert.Equal(t, 1, alerts.lists, "second request should be served from the cache")
+
+	_, _ = cache.Summarize(ctx, SummaryFilter{SiteID: "nyc"})
+	assert.Equal(t, 2, alerts.lists, "filters are cached separately")
+
+	now = now.Add(DefaultSummaryCacheTTL)
+	_, _ = cache.Summarize(ctx, SummaryFilter{})
+	assert.Equal(t, 3, alerts.lists, "expired entries should be recomputed")
+
+	alerts.alerts = append(alerts.alerts, seedAlerts("svc-db", alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.Severity_SEVERITY_CRITICAL, 1, nil)...)
+	cache.Invalidate()
+	got, err := cache.Summarize(ctx, SummaryFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, alerts.lists)
+	assert.Equal(t, int64(2), got[1].TriggeredCount)
+}
+
+func TestHandler_GetAlertSummary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alerts, services := newSummaryTestStores()
+	router := gin.New()
+	NewHandler(NewInMemoryStore(), alerts, zerolog.Nop(),
+		WithAlertSummaries(NewAlertStoreSummaryStore(alerts, services)),
+	).RegisterRoutes(router.Group("/api/v1"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts/summary?site_id=nyc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp AlertSummaryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []AlertSummary{
+		{ServiceID: "svc-api", ServiceName: "API Gateway", TriggeredCount: 3, AcknowledgedCount: 2, CriticalCount: 3, HighCount: 2},
+	}, resp.Services)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/alerts/summary?team_id=unknown", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"services": []}`, w.Body.String())
+}
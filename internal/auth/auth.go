@@ -0,0 +1,152 @@
+// Package auth authenticates API callers with JWT bearer tokens.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrMissingToken is returned when a request carries no bearer token.
+	ErrMissingToken = errors.New("bearer token is required")
+	// ErrInvalidToken is returned when a bearer token fails verification.
+	ErrInvalidToken = errors.New("invalid bearer token")
+	// ErrMissingTenant is returned when an authenticated user has no tenant.
+	ErrMissingTenant = errors.New("tenant is required")
+	// ErrInvalidTenant is returned when a tenant ID is not a UUID.
+	ErrInvalidTenant = errors.New("tenant ID must be a UUID")
+)
+
+// DefaultTenantID is the tenant of unauthenticated callers, such as webhooks
+// and background jobs, and of the data created before tenants were introduced.
+const DefaultTenantID = "00000000-0000-0000-0000-000000000000"
+
+// UserContext identifies the authenticated caller of a request.
+type UserContext struct {
+	// UserID is the subject of the token.
+	UserID string
+	// Roles are the roles granted to the user.
+	Roles []string
+	// TenantID is the tenant the user belongs to, if any.
+	TenantID string
+}
+
+// HasRole reports whether the user was granted role.
+func (u *UserContext) HasRole(role string) bool {
+	return u != nil && slices.Contains(u.Roles, role)
+}
+
+// Claims are the JWT claims of an API token. The subject is the user ID.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles    []string `json:"roles,omitempty"`
+	TenantID string   `json:"tenant_id,omitempty"`
+}
+
+// Verifier verifies bearer tokens.
+type Verifier interface {
+	// Verify returns the user a token was issued to, or an error wrapping
+	// ErrInvalidToken.
+	Verify(token string) (*UserContext, error)
+}
+
+// HMACVerifier verifies HS256 tokens signed with a shared key.
+type HMACVerifier struct {
+	signingKey []byte
+	parser     *jwt.Parser
+}
+
+// NewVerifier creates a verifier of tokens signed with signingKey.
+func NewVerifier(signingKey []byte) *HMACVerifier {
+	return &HMACVerifier{
+		signingKey: signingKey,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithExpirationRequired(),
+		),
+	}
+}
+
+// Verify checks the signature and expiry of token and returns its user.
+func (v *HMACVerifier) Verify(token string) (*UserContext, error) {
+	claims := &Claims{}
+	if _, err := v.parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return v.signingKey, nil
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sub claim is required", ErrInvalidToken)
+	}
+
+	return &UserContext{
+		UserID:   claims.Subject,
+		Roles:    claims.Roles,
+		TenantID: claims.TenantID,
+	}, nil
+}
+
+// BearerToken extracts the token of an "Authorization: Bearer <token>" header value.
+func BearerToken(header string) (string, error) {
+	if header == "" {
+		return "", ErrMissingToken
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("%w: expected a Bearer authorization header", ErrInvalidToken)
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// userContextKey is the context key of the authenticated user.
+type userContextKey struct{}
+
+// WithUser returns a copy of ctx carrying user.
+func WithUser(ctx context.Context, user *UserContext) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user of ctx, if any.
+func UserFromContext(ctx context.Context) (*UserContext, bool) {
+	user, ok := ctx.Value(userContextKey{}).(*UserContext)
+	return user, ok && user != nil
+}
+
+// tenantContextKey is the context key of the tenant set by WithTenant.
+type tenantContextKey struct{}
+
+// WithTenant returns a copy of ctx scoped to tenantID, overriding the tenant
+// of the authenticated user.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant stores scope their data to: the tenant
+// set by WithTenant, else the tenant of the authenticated user. Contexts
+// without a user belong to DefaultTenantID. The tenant ID is returned in its
+// canonical UUID form.
+func TenantFromContext(ctx context.Context) (string, error) {
+	tenantID, ok := ctx.Value(tenantContextKey{}).(string)
+	if !ok {
+		user, authenticated := UserFromContext(ctx)
+		switch {
+		case !authenticated:
+			return DefaultTenantID, nil
+		case user.TenantID == "":
+			return "", ErrMissingTenant
+		}
+		tenantID = user.TenantID
+	}
+
+	id, err := uuid.Parse(tenantID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTenant, tenantID)
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSigningKey = []byte("test-signing-key")
+
+const testTenantID = "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01"
+
+// signToken returns an HS256 token for claims signed with key.
+func signToken(t *testing.T, key []byte, claims Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+// validClaims returns the claims of an unexpired token of user-1.
+func validClaims() Claims {
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles:    []string{"responder", "admin"},
+		TenantID: testTenantID,
+	}
+}
+
+func TestHMACVerifier_Verify(t *testing.T) {
+	verifier := NewVerifier(testSigningKey)
+
+	user, err := verifier.Verify(signToken(t, testSigningKey, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", user.UserID)
+	assert.Equal(t, []string{"responder", "admin"}, user.Roles)
+	assert.Equal(t, testTenantID, user.TenantID)
+	assert.True(t, user.HasRole("admin"))
+	assert.False(t, user.HasRole("owner"))
+}
+
+func TestHMACVerifier_Rejects(t *testing.T) {
+	verifier := NewVerifier(testSigningKey)
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+	noSubject := validClaims()
+	noSubject.Subject = ""
+
+	noneToken, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"wrong key", signToken(t, []byte("other-key"), validClaims())},
+		{"expired", signToken(t, testSigningKey, expired)},
+		{"no expiry", signToken(t, testSigningKey, noExpiry)},
+		{"no subject", signToken(t, testSigningKey, noSubject)},
+		{"unsigned", noneToken},
+		{"malformed", "not-a-jwt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.Verify(tt.token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	token, err := BearerToken("Bearer abc.def.ghi")
+	require.NoError(t, err)
+	assert.Equal(t, "abc.def.ghi", token)
+
+	_, err = BearerToken("")
+	assert.ErrorIs(t, err, ErrMissingToken)
+
+	_, err = BearerToken("Basic dXNlcjpwYXNz")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = BearerToken("Bearer ")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestUserFromContext(t *testing.T) {
+	_, ok := UserFromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := WithUser(context.Background(), &UserContext{UserID: "user-1"})
+	user, ok := UserFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "user-1", user.UserID)
+}
+
+func TestTenantFromContext(t *testing.T) {
+	ctx := context.Background()
+
+	// Unauthenticated callers belong to the default tenant
+	tenantID, err := TenantFromContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTenantID, tenantID)
+
+	tenantID, err = TenantFromContext(WithUser(ctx, &UserContext{UserID: "user-1", TenantID: strings.ToUpper(testTenantID)}))
+	require.NoError(t, err)
+	assert.Equal(t, testTenantID, tenantID)
+
+	_, err = TenantFromContext(WithUser(ctx, &UserContext{UserID: "user-1"}))
+	assert.ErrorIs(t, err, ErrMissingTenant)
+
+	_, err = TenantFromContext(WithUser(ctx, &UserContext{UserID: "user-1", TenantID: "acme"}))
+	assert.ErrorIs(t, err, ErrInvalidTenant)
+
+	// WithTenant overrides the tenant of the user
+	other := "0b8f4a8e-5d0c-4f3f-9a51-0c7d2e6b4f10"
+	tenantID, err = TenantFromContext(WithTenant(WithUser(ctx, &UserContext{UserID: "user-1", TenantID: testTenantID}), other))
+	require.NoError(t, err)
+	assert.Equal(t, other, tenantID)
+}
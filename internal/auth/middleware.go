@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorResponse is the body of authentication failures.
+type errorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// JWTMiddleware returns a middleware that rejects requests without a valid
+// HS256 bearer token signed with signingKey. The request context of accepted
+// requests carries the token's user, see UserFromContext. Tokens must carry a
+// tenant_id claim holding a UUID, see TenantFromContext.
+func JWTMiddleware(signingKey []byte) gin.HandlerFunc {
+	return VerifierMiddleware(NewVerifier(signingKey))
+}
+
+// VerifierMiddleware is JWTMiddleware with a custom token verifier.
+func VerifierMiddleware(verifier Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := BearerToken(c.GetHeader("Authorization"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{Error: "unauthorized", Message: err.Error()})
+			return
+		}
+
+		user, err := verifier.Verify(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{Error: "unauthorized", Message: ErrInvalidToken.Error()})
+			return
+		}
+
+		ctx := WithUser(c.Request.Context(), user)
+		if _, err := TenantFromContext(ctx); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, errorResponse{Error: "forbidden", Message: err.Error()})
+			return
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// RequireRole returns a middleware that only lets users granted role through.
+// It must run after JWTMiddleware.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := UserFromContext(c.Request.Context())
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{Error: "unauthorized", Message: ErrMissingToken.Error()})
+			return
+		}
+		if !user.HasRole(role) {
+			c.AbortWithStatusJSON(http.StatusForbidden, errorResponse{Error: "forbidden", Message: "role " + role + " is required"})
+			return
+		}
+		c.Next()
+	}
+}
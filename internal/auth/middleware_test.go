@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	api := router.Group("/api/v1", JWTMiddleware(testSigningKey))
+	api.GET("/me", func(c *gin.Context) {
+		user, _ := UserFromContext(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{"user_id": user.UserID, "tenant_id": user.TenantID})
+	})
+	api.GET("/admin", RequireRole("admin"), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	return router
+}
+
+func serve(router *gin.Engine, path, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	router := setupTestRouter()
+
+	w := serve(router, "/api/v1/me", "Bearer "+signToken(t, testSigningKey, validClaims()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"user_id":"user-1","tenant_id":"`+testTenantID+`"}`, w.Body.String())
+
+	w = serve(router, "/api/v1/me", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = serve(router, "/api/v1/me", "Bearer "+signToken(t, []byte("other-key"), validClaims()))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "unauthorized")
+
+	// Tokens must name the tenant of the user
+	noTenant := validClaims()
+	noTenant.TenantID = ""
+	w = serve(router, "/api/v1/me", "Bearer "+signToken(t, testSigningKey, noTenant))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRequireRole(t *testing.T) {
+	router := setupTestRouter()
+
+	w := serve(router, "/api/v1/admin", "Bearer "+signToken(t, testSigningKey, validClaims()))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	responder := validClaims()
+	responder.Roles = []string{"responder"}
+	w = serve(router, "/api/v1/admin", "Bearer "+signToken(t, testSigningKey, responder))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// Without JWTMiddleware there is no user to check the role of
+	unauthenticated := gin.New()
+	unauthenticated.GET("/admin", RequireRole("admin"), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	w = serve(unauthenticated, "/admin", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,128 @@
+package comment
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// storedComment is a comment and the time it was deleted, zero while visible.
+type storedComment struct {
+	comment   *alertingv1.AlertComment
+	deletedAt time.Time
+}
+
+// InMemoryStore is an in-memory implementation of Store for testing.
+type InMemoryStore struct {
+	mu sync.RWMutex
+	// comments are kept in creation order
+	comments []*storedComment
+	byID     map[string]*storedComment
+	now      func() time.Time
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		byID: make(map[string]*storedComment),
+		now:  time.Now,
+	}
+}
+
+// Create creates a new comment in memory.
+func (s *InMemoryStore) Create(ctx context.Context, comment *alertingv1.AlertComment) (*alertingv1.AlertComment, error) {
+	if err := Validate(comment); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Generate ID if not provided
+	if comment.Id == "" {
+		comment.Id = uuid.New().String()
+	}
+	comment.CreatedAt = timestamppb.New(s.now())
+
+	stored := &storedComment{comment: proto.Clone(comment).(*alertingv1.AlertComment)}
+	s.comments = append(s.comments, stored)
+	s.byID[comment.Id] = stored
+
+	return comment, nil
+}
+
+// List retrieves a page of the visible comments of an alert, oldest first.
+func (s *InMemoryStore) List(ctx context.Context, alertID string, filter *ListFilter) ([]*alertingv1.AlertComment, string, error) {
+	pageSize, offset := pageBounds(filter)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var comments []*alertingv1.AlertComment
+	for _, stored := range s.comments {
+		if stored.deletedAt.IsZero() && stored.comment.AlertId == alertID {
+			comments = append(comments, stored.comment)
+		}
+	}
+
+	if offset >= len(comments) {
+		return nil, "", nil
+	}
+	comments = cloneComments(comments[offset:min(len(comments), offset+pageSize+1)])
+
+	comments, nextPageToken := paginate(comments, pageSize, offset)
+	return comments, nextPageToken, nil
+}
+
+// ListSince retrieves up to limit visible comments created at or after since, newest first.
+func (s *InMemoryStore) ListSince(ctx context.Context, since time.Time, limit int) ([]*alertingv1.AlertComment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var comments []*alertingv1.AlertComment
+	for _, stored := range slices.Backward(s.comments) {
+		if len(comments) >= limit {
+			break
+		}
+		if stored.deletedAt.IsZero() && !stored.comment.CreatedAt.AsTime().Before(since) {
+			comments = append(comments, stored.comment)
+		}
+	}
+
+	return cloneComments(comments), nil
+}
+
+// Delete soft-deletes a comment by ID, keeping the time it was first deleted.
+func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.byID[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if stored.deletedAt.IsZero() {
+		stored.deletedAt = s.now()
+	}
+	return nil
+}
+
+// cloneComments returns deep copies of comments to avoid external modifications.
+func cloneComments(comments []*alertingv1.AlertComment) []*alertingv1.AlertComment {
+	clones := make([]*alertingv1.AlertComment, len(comments))
+	for i, comment := range comments {
+		clones[i] = proto.Clone(comment).(*alertingv1.AlertComment)
+	}
+	return clones
+}
+
+// Ensure InMemoryStore implements Store
+var _ Store = (*InMemoryStore)(nil)
@@ -0,0 +1,198 @@
+// Package comment provides the comments engineers leave on alerts during an incident.
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+var (
+	// ErrNotFound is returned when a comment is not found.
+	ErrNotFound = errors.New("comment not found")
+
+	// ErrInvalidComment is returned when a comment is invalid.
+	ErrInvalidComment = errors.New("invalid comment")
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// Validate checks that a comment belongs to an alert, has an author and is not blank.
+func Validate(comment *alertingv1.AlertComment) error {
+	if comment == nil {
+		return ErrInvalidComment
+	}
+	if comment.AlertId == "" {
+		return fmt.Errorf("%w: alert id is required", ErrInvalidComment)
+	}
+	if comment.AuthorId == "" {
+		return fmt.Errorf("%w: author id is required", ErrInvalidComment)
+	}
+	if strings.TrimSpace(comment.Body) == "" {
+		return fmt.Errorf("%w: body is required", ErrInvalidComment)
+	}
+	return nil
+}
+
+// ListFilter defines paging for listing the comments of an alert.
+type ListFilter struct {
+	PageSize  int
+	PageToken string
+}
+
+// Store defines the interface for alert comment persistence. Deleted comments
+// are soft-deleted: they are kept but no longer returned.
+type Store interface {
+	// Create creates a new comment.
+	Create(ctx context.Context, comment *alertingv1.AlertComment) (*alertingv1.AlertComment, error)
+
+	// List retrieves a page of the comments of an alert, oldest first, and the
+	// token of the next page, which is empty on the last page.
+	List(ctx context.Context, alertID string, filter *ListFilter) ([]*alertingv1.AlertComment, string, error)
+
+	// ListSince retrieves up to limit comments on any alert created at or
+	// after since, newest first.
+	ListSince(ctx context.Context, since time.Time, limit int) ([]*alertingv1.AlertComment, error)
+
+	// Delete soft-deletes a comment by ID. Deleting a deleted comment succeeds.
+	Delete(ctx context.Context, id string) error
+}
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Create creates a new comment in the database.
+func (s *PostgresStore) Create(ctx context.Context, comment *alertingv1.AlertComment) (*alertingv1.AlertComment, error) {
+	if err := Validate(comment); err != nil {
+		return nil, err
+	}
+
+	// Generate ID if not provided
+	if comment.Id == "" {
+		comment.Id = uuid.New().String()
+	}
+	now := time.Now()
+	comment.CreatedAt = timestamppb.New(now)
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO alert_comments (id, alert_id, author_id, body, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, comment.Id, comment.AlertId, comment.AuthorId, comment.Body, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// List retrieves a page of the visible comments of an alert, oldest first.
+func (s *PostgresStore) List(ctx context.Context, alertID string, filter *ListFilter) ([]*alertingv1.AlertComment, string, error) {
+	pageSize, offset := pageBounds(filter)
+
+	comments, err := s.query(ctx, `
+		SELECT id, alert_id, author_id, body, created_at
+		FROM alert_comments
+		WHERE alert_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2 OFFSET $3
+	`, alertID, pageSize+1, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	comments, nextPageToken := paginate(comments, pageSize, offset)
+	return comments, nextPageToken, nil
+}
+
+// ListSince retrieves up to limit visible comments created at or after since, newest first.
+func (s *PostgresStore) ListSince(ctx context.Context, since time.Time, limit int) ([]*alertingv1.AlertComment, error) {
+	return s.query(ctx, `
+		SELECT id, alert_id, author_id, body, created_at
+		FROM alert_comments
+		WHERE created_at >= $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, since, limit)
+}
+
+// Delete soft-deletes a comment by ID, keeping the time it was first deleted.
+func (s *PostgresStore) Delete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE alert_comments SET deleted_at = COALESCE(deleted_at, $1) WHERE id = $2
+	`, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("delete comment: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) query(ctx context.Context, query string, args ...any) ([]*alertingv1.AlertComment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query comments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var comments []*alertingv1.AlertComment
+	for rows.Next() {
+		comment := &alertingv1.AlertComment{}
+		var createdAt time.Time
+		if err := rows.Scan(&comment.Id, &comment.AlertId, &comment.AuthorId, &comment.Body, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan comment: %w", err)
+		}
+		comment.CreatedAt = timestamppb.New(createdAt)
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+// Helper functions
+
+// pageBounds returns the page size and offset requested by filter.
+func pageBounds(filter *ListFilter) (int, int) {
+	pageSize := defaultPageSize
+	if filter != nil && filter.PageSize > 0 && filter.PageSize <= maxPageSize {
+		pageSize = filter.PageSize
+	}
+
+	offset := 0
+	if filter != nil && filter.PageToken != "" {
+		_, _ = fmt.Sscanf(filter.PageToken, "%d", &offset)
+	}
+	return pageSize, offset
+}
+
+// paginate trims comments, fetched with one extra row, to pageSize and returns
+// the token of the next page if there is one.
+func paginate(comments []*alertingv1.AlertComment, pageSize, offset int) ([]*alertingv1.AlertComment, string) {
+	if len(comments) <= pageSize {
+		return comments, ""
+	}
+	return comments[:pageSize], fmt.Sprintf("%d", offset+pageSize)
+}
+
+// Ensure PostgresStore implements Store
+var _ Store = (*PostgresStore)(nil)
@@ -0,0 +1,212 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// newTestStore returns an in-memory store whose clock advances a minute per comment.
+func newTestStore(start time.Time) *InMemoryStore {
+	s := NewInMemoryStore()
+	now := start
+	s.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+	return s
+}
+
+func createComments(t *testing.T, s Store, alertID string, n int) []*alertingv1.AlertComment {
+	t.Helper()
+
+	comments := make([]*alertingv1.AlertComment, n)
+	for i := range n {
+		comment, err := s.Create(context.Background(), &alertingv1.AlertComment{
+			AlertId:  alertID,
+			AuthorId: "user-1",
+			Body:     fmt.Sprintf("note %d", i+1),
+		})
+		require.NoError(t, err)
+		comments[i] = comment
+	}
+	return comments
+}
+
+func commentBodies(comments []*alertingv1.AlertComment) []string {
+	bodies := make([]string, len(comments))
+	for i, comment := range comments {
+		bodies[i] = comment.Body
+	}
+	return bodies
+}
+
+func TestValidate(t *testing.T) {
+	valid := &alertingv1.AlertComment{AlertId: "alert-1", AuthorId: "user-1", Body: "Restarted the BGP session"}
+	assert.NoError(t, Validate(valid))
+
+	for name, comment := range map[string]*alertingv1.AlertComment{
+		"nil":            nil,
+		"missing alert":  {AuthorId: "user-1", Body: "note"},
+		"missing author": {AlertId: "alert-1", Body: "note"},
+		"blank body":     {AlertId: "alert-1", AuthorId: "user-1", Body: "  \n"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, Validate(comment), ErrInvalidComment)
+		})
+	}
+}
+
+func TestInMemoryStore_ListPagination(t *testing.T) {
+	s := newTestStore(time.Now())
+	ctx := context.Background()
+
+	createComments(t, s, "alert-1", 5)
+	createComments(t, s, "alert-2", 1)
+
+	page, token, err := s.List(ctx, "alert-1", &ListFilter{PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 1", "note 2"}, commentBodies(page))
+	assert.Equal(t, "2", token)
+
+	page, token, err = s.List(ctx, "alert-1", &ListFilter{PageSize: 2, PageToken: token})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 3", "note 4"}, commentBodies(page))
+
+	page, token, err = s.List(ctx, "alert-1", &ListFilter{PageSize: 2, PageToken: token})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 5"}, commentBodies(page))
+	assert.Empty(t, token)
+
+	page, token, err = s.List(ctx, "alert-1", &ListFilter{PageSize: 2, PageToken: "10"})
+	require.NoError(t, err)
+	assert.Empty(t, page)
+	assert.Empty(t, token)
+
+	// Listed comments are copies
+	page, _, err = s.List(ctx, "alert-2", nil)
+	require.NoError(t, err)
+	page[0].Body = "changed"
+	page, _, _ = s.List(ctx, "alert-2", nil)
+	assert.Equal(t, "note 1", page[0].Body)
+}
+
+func TestInMemoryStore_SoftDelete(t *testing.T) {
+	start := time.Now()
+	s := newTestStore(start)
+	ctx := context.Background()
+
+	comments := createComments(t, s, "alert-1", 3)
+
+	require.NoError(t, s.Delete(ctx, comments[1].Id))
+	require.NoError(t, s.Delete(ctx, comments[1].Id), "deleting a deleted comment must succeed")
+	assert.ErrorIs(t, s.Delete(ctx, "missing"), ErrNotFound)
+
+	page, _, err := s.List(ctx, "alert-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 1", "note 3"}, commentBodies(page))
+
+	recent, err := s.ListSince(ctx, start, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 3", "note 1"}, commentBodies(recent))
+
+	// The deleted comment is kept
+	assert.False(t, s.byID[comments[1].Id].deletedAt.IsZero())
+}
+
+func TestInMemoryStore_ListSince(t *testing.T) {
+	start := time.Now()
+	s := newTestStore(start)
+	ctx := context.Background()
+
+	createComments(t, s, "alert-1", 2)
+	createComments(t, s, "alert-2", 2)
+
+	recent, err := s.ListSince(ctx, start.Add(2*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 2", "note 1", "note 2"}, commentBodies(recent))
+	assert.Equal(t, "alert-2", recent[0].AlertId)
+
+	recent, err = s.ListSince(ctx, start, 1)
+	require.NoError(t, err)
+	require.Len(t, recent, 1)
+	assert.Equal(t, "alert-2", recent[0].AlertId)
+}
+
+func TestPostgresStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+
+	mock.ExpectExec("INSERT INTO alert_comments").
+		WithArgs(sqlmock.AnyArg(), "alert-1", "user-1", "Failing over to the backup link", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	comment, err := s.Create(context.Background(), &alertingv1.AlertComment{
+		AlertId:  "alert-1",
+		AuthorId: "user-1",
+		Body:     "Failing over to the backup link",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, comment.Id)
+	assert.NotNil(t, comment.CreatedAt)
+
+	_, err = s.Create(context.Background(), &alertingv1.AlertComment{AlertId: "alert-1"})
+	assert.ErrorIs(t, err, ErrInvalidComment)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "alert_id", "author_id", "body", "created_at"}).
+		AddRow("comment-1", "alert-1", "user-1", "note 1", now).
+		AddRow("comment-2", "alert-1", "user-2", "note 2", now.Add(time.Minute)).
+		AddRow("comment-3", "alert-1", "user-1", "note 3", now.Add(2*time.Minute))
+	mock.ExpectQuery("SELECT (.+) FROM alert_comments WHERE alert_id = \\$1 AND deleted_at IS NULL").
+		WithArgs("alert-1", 3, 2).
+		WillReturnRows(rows)
+
+	comments, token, err := s.List(context.Background(), "alert-1", &ListFilter{PageSize: 2, PageToken: "2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note 1", "note 2"}, commentBodies(comments))
+	assert.Equal(t, "4", token)
+	assert.True(t, comments[1].CreatedAt.AsTime().Equal(now.Add(time.Minute)))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+
+	mock.ExpectExec("UPDATE alert_comments SET deleted_at = COALESCE\\(deleted_at, \\$1\\)").
+		WithArgs(sqlmock.AnyArg(), "comment-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE alert_comments").
+		WithArgs(sqlmock.AnyArg(), "missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, s.Delete(context.Background(), "comment-1"))
+	assert.ErrorIs(t, s.Delete(context.Background(), "missing"), ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,157 @@
+package correlation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ErrNoGroupBy is returned when a Correlator has no label keys to group by.
+var ErrNoGroupBy = errors.New("at least one group_by label key is required")
+
+// groupIDPrefix prefixes the IDs of label groups.
+const groupIDPrefix = "grp-"
+
+// openStatuses are the statuses of alerts that can be grouped.
+var openStatuses = []alertingv1.AlertStatus{
+	alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+	alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED,
+}
+
+// LabelGroup is a set of open alerts with the same values for the group_by
+// label keys of a Correlator.
+type LabelGroup struct {
+	// ID is a stable hash of the shared label values.
+	ID string
+	// AlertIDs contains the IDs of the alerts in the group, sorted.
+	AlertIDs []string
+}
+
+// Correlator groups open alerts that have the same values for a set of label
+// keys, such as every host of a cluster going down, and were created within a
+// correlation window of each other. Unlike Engine, which links alerts sharing
+// any labels, groups are keyed by their label values so they stay stable as
+// alerts arrive.
+type Correlator struct {
+	alerts  store.AlertStore
+	groupBy []string
+	window  time.Duration
+}
+
+// NewCorrelator creates a Correlator grouping the open alerts of alerts by the
+// groupBy label keys within window.
+func NewCorrelator(alerts store.AlertStore, groupBy []string, window time.Duration) (*Correlator, error) {
+	if window <= 0 {
+		return nil, ErrInvalidWindow
+	}
+
+	keys := slices.Clone(groupBy)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+	keys = slices.DeleteFunc(keys, func(key string) bool { return key == "" })
+	if len(keys) == 0 {
+		return nil, ErrNoGroupBy
+	}
+
+	return &Correlator{alerts: alerts, groupBy: keys, window: window}, nil
+}
+
+// GroupID returns the ID of the group of an alert, a hash of its values for the
+// group_by label keys. It returns false if the alert lacks any of the labels.
+func (c *Correlator) GroupID(alert *alertingv1.Alert) (string, bool) {
+	h := sha256.New()
+	for _, key := range c.groupBy {
+		value, ok := alert.Labels[key]
+		if !ok {
+			return "", false
+		}
+		fmt.Fprintf(h, "%s=%s\x00", key, value)
+	}
+	return groupIDPrefix + hex.EncodeToString(h.Sum(nil))[:16], true
+}
+
+// Correlate returns the group of an incoming alert: the open alerts with its
+// values for the group_by label keys created within the correlation window of
+// it, including the alert itself. It returns nil if the alert lacks any of the
+// group_by labels.
+func (c *Correlator) Correlate(ctx context.Context, alert *alertingv1.Alert) (*LabelGroup, error) {
+	groupID, ok := c.GroupID(alert)
+	if !ok {
+		return nil, nil
+	}
+
+	selectors := make(map[string]string, len(c.groupBy))
+	for _, key := range c.groupBy {
+		selectors[key] = alert.Labels[key]
+	}
+
+	at := creationTime(alert)
+	resp, err := c.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+		Statuses:       openStatuses,
+		LabelSelectors: selectors,
+		TriggeredAfter: timestamppb.New(at.Add(-c.window)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list open alerts: %w", err)
+	}
+
+	group := &LabelGroup{ID: groupID, AlertIDs: []string{alert.Id}}
+	for _, candidate := range resp.Alerts {
+		if candidate.Id == alert.Id || !isOpen(candidate) || !c.sameGroup(alert, candidate) {
+			continue
+		}
+		if gap := creationTime(candidate).Sub(at).Abs(); gap > c.window {
+			continue
+		}
+		group.AlertIDs = append(group.AlertIDs, candidate.Id)
+	}
+	slices.Sort(group.AlertIDs)
+
+	return group, nil
+}
+
+// GroupSize returns the number of open alerts in a group. It implements
+// routing.GroupSizer for GROUP_SIZE routing conditions.
+func (c *Correlator) GroupSize(ctx context.Context, groupID string) (int, error) {
+	if groupID == "" {
+		return 0, nil
+	}
+
+	resp, err := c.alerts.List(ctx, &alertingv1.ListAlertsRequest{Statuses: openStatuses})
+	if err != nil {
+		return 0, fmt.Errorf("list open alerts: %w", err)
+	}
+
+	size := 0
+	for _, alert := range resp.Alerts {
+		if alert.GroupId == groupID && isOpen(alert) {
+			size++
+		}
+	}
+	return size, nil
+}
+
+// sameGroup reports whether two alerts have the same values for every
+// group_by label key.
+func (c *Correlator) sameGroup(a, b *alertingv1.Alert) bool {
+	for _, key := range c.groupBy {
+		value, ok := b.Labels[key]
+		if !ok || value != a.Labels[key] {
+			return false
+		}
+	}
+	return true
+}
+
+func isOpen(alert *alertingv1.Alert) bool {
+	return slices.Contains(openStatuses, alert.Status)
+}
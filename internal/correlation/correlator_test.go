@@ -0,0 +1,209 @@
+package correlation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// listAlertStore is a store.AlertStore whose List returns every alert,
+// ignoring filters like the in-memory store of the server.
+type listAlertStore struct {
+	alerts  []*alertingv1.Alert
+	listErr error
+}
+
+func (s *listAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	s.alerts = append(s.alerts, alert)
+	return alert, nil
+}
+
+func (s *listAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	for _, alert := range s.alerts {
+		if alert.Id == id {
+			return alert, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *listAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (s *listAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (s *listAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	created, err := s.Create(ctx, alert)
+	return created, true, err
+}
+
+func (s *listAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	return &alertingv1.ListAlertsResponse{Alerts: s.alerts}, nil
+}
+
+func newOpenAlert(id string, createdAt time.Time, labels map[string]string) *alertingv1.Alert {
+	alert := newTestAlert(id, createdAt, labels)
+	alert.Status = alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED
+	return alert
+}
+
+func TestNewCorrelator_Validation(t *testing.T) {
+	_, err := NewCorrelator(&listAlertStore{}, []string{"cluster"}, 0)
+	assert.ErrorIs(t, err, ErrInvalidWindow)
+
+	_, err = NewCorrelator(&listAlertStore{}, []string{"", ""}, time.Minute)
+	assert.ErrorIs(t, err, ErrNoGroupBy)
+}
+
+func TestCorrelator_GroupID(t *testing.T) {
+	a, err := NewCorrelator(&listAlertStore{}, []string{"cluster", "alertname"}, time.Minute)
+	require.NoError(t, err)
+	b, err := NewCorrelator(&listAlertStore{}, []string{"alertname", "cluster", "cluster"}, time.Minute)
+	require.NoError(t, err)
+
+	alert := &alertingv1.Alert{Labels: map[string]string{"cluster": "ams1", "alertname": "HostDown", "host": "db-1"}}
+	id, ok := a.GroupID(alert)
+	require.True(t, ok)
+	assert.Regexp(t, `^grp-[0-9a-f]{16}$`, id)
+
+	// The order of the group_by keys and labels outside them do not matter
+	other, _ := b.GroupID(&alertingv1.Alert{Labels: map[string]string{"cluster": "ams1", "alertname": "HostDown", "host": "db-2"}})
+	assert.Equal(t, id, other)
+
+	different, _ := a.GroupID(&alertingv1.Alert{Labels: map[string]string{"cluster": "fra1", "alertname": "HostDown"}})
+	assert.NotEqual(t, id, different)
+
+	_, ok = a.GroupID(&alertingv1.Alert{Labels: map[string]string{"cluster": "ams1"}})
+	assert.False(t, ok)
+}
+
+func TestCorrelator_Correlate(t *testing.T) {
+	base := time.Now()
+	labels := func(cluster, host string) map[string]string {
+		return map[string]string{"cluster": cluster, "alertname": "HostDown", "host": host}
+	}
+
+	resolved := newOpenAlert("alert-resolved", base, labels("ams1", "db-5"))
+	resolved.Status = alertingv1.AlertStatus_ALERT_STATUS_RESOLVED
+	acknowledged := newOpenAlert("alert-b", base.Add(-2*time.Minute), labels("ams1", "db-2"))
+	acknowledged.Status = alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED
+
+	alerts := &listAlertStore{alerts: []*alertingv1.Alert{
+		newOpenAlert("alert-c", base.Add(-time.Minute), labels("ams1", "db-3")),
+		acknowledged,
+		newOpenAlert("alert-other-cluster", base, labels("fra1", "db-1")),
+		newOpenAlert("alert-old", base.Add(-10*time.Minute), labels("ams1", "db-4")),
+		resolved,
+	}}
+	correlator, err := NewCorrelator(alerts, []string{"cluster", "alertname"}, 5*time.Minute)
+	require.NoError(t, err)
+
+	incoming := newOpenAlert("alert-a", base, labels("ams1", "db-1"))
+	group, err := correlator.Correlate(context.Background(), incoming)
+	require.NoError(t, err)
+	require.NotNil(t, group)
+
+	groupID, _ := correlator.GroupID(incoming)
+	assert.Equal(t, groupID, group.ID)
+	assert.Equal(t, []string{"alert-a", "alert-b", "alert-c"}, group.AlertIDs)
+
+	// Alerts without every group_by label are not grouped
+	group, err = correlator.Correlate(context.Background(), newOpenAlert("alert-d", base, map[string]string{"cluster": "ams1"}))
+	require.NoError(t, err)
+	assert.Nil(t, group)
+
+	alerts.listErr = errors.New("database unavailable")
+	_, err = correlator.Correlate(context.Background(), incoming)
+	assert.Error(t, err)
+}
+
+func TestCorrelator_GroupSize(t *testing.T) {
+	base := time.Now()
+	alerts := &listAlertStore{}
+	for i, status := range []alertingv1.AlertStatus{
+		alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED,
+		alertingv1.AlertStatus_ALERT_STATUS_RESOLVED,
+	} {
+		alert := newOpenAlert(fmt.Sprintf("alert-%d", i), base, nil)
+		alert.Status = status
+		alert.GroupId = "grp-1"
+		alerts.alerts = append(alerts.alerts, alert)
+	}
+	alerts.alerts = append(alerts.alerts, &alertingv1.Alert{Id: "alert-other", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, GroupId: "grp-2"})
+
+	correlator, err := NewCorrelator(alerts, []string{"cluster"}, time.Minute)
+	require.NoError(t, err)
+
+	size, err := correlator.GroupSize(context.Background(), "grp-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, size)
+
+	size, err = correlator.GroupSize(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, 0, size)
+}
+
+// newBenchmarkCorrelator returns a correlator over 10k open alerts spread
+// across 100 clusters, and an incoming alert of one of them.
+func newBenchmarkCorrelator(b *testing.B) (*Correlator, *alertingv1.Alert) {
+	b.Helper()
+
+	base := time.Now()
+	alerts := &listAlertStore{}
+	for i := range 10000 {
+		alert := newOpenAlert(fmt.Sprintf("alert-%d", i), base.Add(-time.Duration(i)*time.Second), map[string]string{
+			"cluster":   fmt.Sprintf("cluster-%d", i%100),
+			"alertname": "HostDown",
+			"host":      fmt.Sprintf("host-%d", i),
+		})
+		alerts.alerts = append(alerts.alerts, alert)
+	}
+
+	correlator, err := NewCorrelator(alerts, []string{"cluster", "alertname"}, time.Hour)
+	require.NoError(b, err)
+	for _, alert := range alerts.alerts {
+		alert.GroupId, _ = correlator.GroupID(alert)
+	}
+
+	incoming := newOpenAlert("alert-incoming", base, map[string]string{"cluster": "cluster-42", "alertname": "HostDown", "host": "host-new"})
+	return correlator, incoming
+}
+
+func BenchmarkCorrelator_Correlate(b *testing.B) {
+	correlator, incoming := newBenchmarkCorrelator(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for range b.N {
+		if _, err := correlator.Correlate(ctx, incoming); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCorrelator_GroupSize(b *testing.B) {
+	correlator, incoming := newBenchmarkCorrelator(b)
+	groupID, _ := correlator.GroupID(incoming)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for range b.N {
+		if _, err := correlator.GroupSize(ctx, groupID); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+// Package correlation groups related alerts to help identify the root cause
+// of an incident.
+package correlation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// Annotation keys set on alerts that belong to a correlation group.
+const (
+	// AnnotationGroupID holds the ID of the correlation group.
+	AnnotationGroupID = "correlation_group_id"
+	// AnnotationRootCause holds the ID of the alert ranked as the likely root cause.
+	AnnotationRootCause = "correlation_root_cause_id"
+)
+
+// DefaultMinSharedLabels is the default number of identical label key-value
+// pairs two alerts must share to be considered related.
+const DefaultMinSharedLabels = 2
+
+var (
+	// ErrInvalidWindow is returned when the correlation window is not positive.
+	ErrInvalidWindow = errors.New("correlation window must be positive")
+)
+
+// CorrelationGroup is a set of related alerts, ordered from most to least
+// likely root cause.
+type CorrelationGroup struct {
+	// ID uniquely identifies the group. It is derived from the root cause alert.
+	ID string
+	// RootCause is the earliest alert in the group.
+	RootCause *alertingv1.Alert
+	// Alerts contains every alert in the group ranked by creation time.
+	Alerts []*alertingv1.Alert
+}
+
+// Engine correlates alerts using a label-overlap graph.
+type Engine struct {
+	minSharedLabels int
+	metrics         *Metrics
+}
+
+// Option configures an Engine.
+type Option func(*Engine)
+
+// WithMinSharedLabels sets the number of shared label pairs required for an edge.
+func WithMinSharedLabels(n int) Option {
+	return func(e *Engine) {
+		if n > 0 {
+			e.minSharedLabels = n
+		}
+	}
+}
+
+// WithMetrics sets the metrics recorder used by the engine.
+func WithMetrics(metrics *Metrics) Option {
+	return func(e *Engine) {
+		e.metrics = metrics
+	}
+}
+
+// NewEngine creates a new correlation engine.
+func NewEngine(opts ...Option) *Engine {
+	e := &Engine{
+		minSharedLabels: DefaultMinSharedLabels,
+		metrics:         NewMetrics(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Metrics returns the metrics recorder for this engine.
+func (e *Engine) Metrics() *Metrics {
+	return e.metrics
+}
+
+// CorrelateAlerts builds a graph where alerts are connected when they share at
+// least minSharedLabels label pairs and were created within window of each
+// other. Each connected component with more than one alert becomes a
+// CorrelationGroup. Within a group the earliest alert is the root cause.
+func (e *Engine) CorrelateAlerts(ctx context.Context, alerts []*alertingv1.Alert, window time.Duration) ([]*CorrelationGroup, error) {
+	if window <= 0 {
+		return nil, ErrInvalidWindow
+	}
+
+	n := len(alerts)
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for j := i + 1; j < n; j++ {
+			if !e.connected(alerts[i], alerts[j], window) {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			if ri != rj {
+				parent[rj] = ri
+			}
+		}
+	}
+
+	components := make(map[int][]*alertingv1.Alert)
+	var roots []int
+	for i, alert := range alerts {
+		root := find(i)
+		if _, ok := components[root]; !ok {
+			roots = append(roots, root)
+		}
+		components[root] = append(components[root], alert)
+	}
+
+	var groups []*CorrelationGroup
+	for _, root := range roots {
+		members := components[root]
+		if len(members) < 2 {
+			continue
+		}
+
+		sortByCreationTime(members)
+
+		groups = append(groups, &CorrelationGroup{
+			ID:        "corr-" + members[0].Id,
+			RootCause: members[0],
+			Alerts:    members,
+		})
+	}
+
+	if e.metrics != nil {
+		e.metrics.RecordGroupsCreated(len(groups))
+	}
+
+	return groups, nil
+}
+
+// CorrelateAlert correlates a new alert with the recent alerts, comparing it
+// with each of them once instead of rebuilding the graph of every alert. Recent
+// alerts belong to the group in their AnnotationGroupID annotation.
+//
+// The alert joins the group of the connected alert with the earliest root
+// cause, along with the connected alerts that are not in a group yet. If none
+// of the connected alerts is in a group, a new group is created from the alert
+// and the connected alerts, and created is true. The returned group holds the
+// recent members of the group, or is nil if no recent alert is connected to
+// the alert.
+func (e *Engine) CorrelateAlert(ctx context.Context, alert *alertingv1.Alert, recent []*alertingv1.Alert, window time.Duration) (group *CorrelationGroup, created bool, err error) {
+	if window <= 0 {
+		return nil, false, ErrInvalidWindow
+	}
+
+	var connected []*alertingv1.Alert
+	rootCauses := make(map[string]time.Time)
+	for _, other := range recent {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+		if other.Id == alert.Id {
+			continue
+		}
+		if groupID := other.Annotations[AnnotationGroupID]; groupID != "" && other.Id == other.Annotations[AnnotationRootCause] {
+			rootCauses[groupID] = creationTime(other)
+		}
+		if !e.connected(alert, other, window) {
+			continue
+		}
+		connected = append(connected, other)
+	}
+	if len(connected) == 0 {
+		return nil, false, nil
+	}
+
+	// Join the group whose root cause is earliest; root causes that have left
+	// the recent alerts are the earliest of all
+	var joined *alertingv1.Alert
+	for _, other := range connected {
+		groupID := other.Annotations[AnnotationGroupID]
+		if groupID == "" {
+			continue
+		}
+		if joined == nil || groupEarlier(rootCauses, groupID, joined.Annotations[AnnotationGroupID]) {
+			joined = other
+		}
+	}
+
+	// The alert comes last so that recent alerts created at the same time rank first
+	var members []*alertingv1.Alert
+	for _, other := range connected {
+		if other.Annotations[AnnotationGroupID] == "" {
+			members = append(members, other)
+		}
+	}
+	members = append(members, alert)
+
+	if joined == nil {
+		sortByCreationTime(members)
+		if e.metrics != nil {
+			e.metrics.RecordGroupsCreated(1)
+		}
+		return &CorrelationGroup{
+			ID:        "corr-" + members[0].Id,
+			RootCause: members[0],
+			Alerts:    members,
+		}, true, nil
+	}
+
+	groupID := joined.Annotations[AnnotationGroupID]
+	rootCauseID := joined.Annotations[AnnotationRootCause]
+	rootCause := &alertingv1.Alert{Id: rootCauseID}
+	for _, other := range recent {
+		if other.Id != alert.Id && other.Annotations[AnnotationGroupID] == groupID {
+			members = append(members, other)
+		}
+		if other.Id == rootCauseID {
+			rootCause = other
+		}
+	}
+	sortByCreationTime(members)
+
+	return &CorrelationGroup{
+		ID:        groupID,
+		RootCause: rootCause,
+		Alerts:    members,
+	}, false, nil
+}
+
+// groupEarlier reports whether the root cause of group a was created before
+// the root cause of group b. Groups without a known root cause come first.
+func groupEarlier(rootCauses map[string]time.Time, a, b string) bool {
+	ta, okA := rootCauses[a]
+	tb, okB := rootCauses[b]
+	if !okA || !okB {
+		return !okA && okB
+	}
+	return ta.Before(tb)
+}
+
+// sortByCreationTime orders alerts from earliest to latest created.
+func sortByCreationTime(alerts []*alertingv1.Alert) {
+	sort.SliceStable(alerts, func(a, b int) bool {
+		return creationTime(alerts[a]).Before(creationTime(alerts[b]))
+	})
+}
+
+// connected reports whether two alerts should share an edge in the graph.
+func (e *Engine) connected(a, b *alertingv1.Alert, window time.Duration) bool {
+	delta := creationTime(a).Sub(creationTime(b))
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta > window {
+		return false
+	}
+
+	return sharedLabelCount(a.Labels, b.Labels) >= e.minSharedLabels
+}
+
+// sharedLabelCount counts label pairs with identical keys and values.
+func sharedLabelCount(a, b map[string]string) int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	count := 0
+	for k, v := range a {
+		if other, ok := b[k]; ok && other == v {
+			count++
+		}
+	}
+	return count
+}
+
+// creationTime returns the alert creation time, falling back to triggered_at.
+func creationTime(alert *alertingv1.Alert) time.Time {
+	if alert.CreatedAt != nil {
+		return alert.CreatedAt.AsTime()
+	}
+	if alert.TriggeredAt != nil {
+		return alert.TriggeredAt.AsTime()
+	}
+	return time.Time{}
+}
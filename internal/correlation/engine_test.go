@@ -0,0 +1,162 @@
+package correlation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func newTestAlert(id string, createdAt time.Time, labels map[string]string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Id:        id,
+		Labels:    labels,
+		CreatedAt: timestamppb.New(createdAt),
+	}
+}
+
+func TestEngine_CorrelateAlerts_ThreeAlertCluster(t *testing.T) {
+	engine := NewEngine()
+	base := time.Now()
+
+	// a-b share {site, rack}, b-c share {host, service}; a and c are linked through b.
+	alerts := []*alertingv1.Alert{
+		newTestAlert("alert-c", base.Add(2*time.Minute), map[string]string{"host": "db-1", "service": "postgres"}),
+		newTestAlert("alert-a", base, map[string]string{"site": "ams1", "rack": "r12"}),
+		newTestAlert("alert-b", base.Add(time.Minute), map[string]string{"site": "ams1", "rack": "r12", "host": "db-1", "service": "postgres"}),
+	}
+
+	groups, err := engine.CorrelateAlerts(context.Background(), alerts, 5*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	group := groups[0]
+	assert.Equal(t, "corr-alert-a", group.ID)
+	assert.Equal(t, "alert-a", group.RootCause.Id)
+	require.Len(t, group.Alerts, 3)
+	assert.Equal(t, "alert-a", group.Alerts[0].Id)
+	assert.Equal(t, "alert-b", group.Alerts[1].Id)
+	assert.Equal(t, "alert-c", group.Alerts[2].Id)
+
+	assert.Equal(t, int64(1), engine.Metrics().GroupsCreatedTotal())
+}
+
+func TestEngine_CorrelateAlerts_TwoDisjointPairs(t *testing.T) {
+	engine := NewEngine()
+	base := time.Now()
+
+	alerts := []*alertingv1.Alert{
+		newTestAlert("net-1", base, map[string]string{"site": "ams1", "device": "core-sw-1"}),
+		newTestAlert("db-1", base.Add(30*time.Second), map[string]string{"cluster": "pg-main", "role": "primary"}),
+		newTestAlert("net-2", base.Add(time.Minute), map[string]string{"site": "ams1", "device": "core-sw-1"}),
+		newTestAlert("db-2", base.Add(10*time.Second), map[string]string{"cluster": "pg-main", "role": "primary"}),
+	}
+
+	groups, err := engine.CorrelateAlerts(context.Background(), alerts, 5*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	byRoot := make(map[string]*CorrelationGroup)
+	for _, g := range groups {
+		byRoot[g.RootCause.Id] = g
+	}
+
+	require.Contains(t, byRoot, "net-1")
+	assert.Len(t, byRoot["net-1"].Alerts, 2)
+	assert.Equal(t, "net-2", byRoot["net-1"].Alerts[1].Id)
+
+	require.Contains(t, byRoot, "db-2")
+	assert.Len(t, byRoot["db-2"].Alerts, 2)
+	assert.Equal(t, "db-1", byRoot["db-2"].Alerts[1].Id)
+
+	assert.Equal(t, int64(2), engine.Metrics().GroupsCreatedTotal())
+}
+
+func TestEngine_CorrelateAlerts_OutsideWindow(t *testing.T) {
+	engine := NewEngine()
+	base := time.Now()
+	labels := map[string]string{"site": "ams1", "rack": "r12"}
+
+	alerts := []*alertingv1.Alert{
+		newTestAlert("alert-1", base, labels),
+		newTestAlert("alert-2", base.Add(10*time.Minute), labels),
+	}
+
+	groups, err := engine.CorrelateAlerts(context.Background(), alerts, 5*time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestEngine_CorrelateAlerts_InvalidWindow(t *testing.T) {
+	engine := NewEngine()
+
+	_, err := engine.CorrelateAlerts(context.Background(), nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidWindow)
+}
+
+func TestEngine_CorrelateAlert_CreatesGroup(t *testing.T) {
+	engine := NewEngine()
+	base := time.Now()
+	labels := map[string]string{"site": "ams1", "rack": "r12"}
+
+	first := newTestAlert("alert-a", base, labels)
+	group, created, err := engine.CorrelateAlert(context.Background(), first, []*alertingv1.Alert{first}, 5*time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, group)
+	assert.False(t, created)
+
+	second := newTestAlert("alert-b", base.Add(time.Minute), labels)
+	group, created, err = engine.CorrelateAlert(context.Background(), second, []*alertingv1.Alert{first, second}, 5*time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	assert.True(t, created)
+	assert.Equal(t, "corr-alert-a", group.ID)
+	assert.Equal(t, "alert-a", group.RootCause.Id)
+	require.Len(t, group.Alerts, 2)
+	assert.Equal(t, "alert-a", group.Alerts[0].Id)
+	assert.Equal(t, "alert-b", group.Alerts[1].Id)
+
+	assert.Equal(t, int64(1), engine.Metrics().GroupsCreatedTotal())
+}
+
+func TestEngine_CorrelateAlert_JoinsExistingGroup(t *testing.T) {
+	engine := NewEngine()
+	base := time.Now()
+	grouped := map[string]string{AnnotationGroupID: "corr-alert-a", AnnotationRootCause: "alert-a"}
+
+	// alert-c is related to the members of the group of alert-a and to alert-d,
+	// which is not grouped yet
+	a := newTestAlert("alert-a", base, map[string]string{"site": "ams1", "rack": "r12"})
+	a.Annotations = grouped
+	b := newTestAlert("alert-b", base.Add(time.Minute), map[string]string{"site": "ams1", "rack": "r12"})
+	b.Annotations = grouped
+	d := newTestAlert("alert-d", base.Add(2*time.Minute), map[string]string{"host": "db-1", "service": "postgres"})
+	c := newTestAlert("alert-c", base.Add(3*time.Minute), map[string]string{"site": "ams1", "rack": "r12", "host": "db-1", "service": "postgres"})
+
+	group, created, err := engine.CorrelateAlert(context.Background(), c, []*alertingv1.Alert{a, b, d, c}, 5*time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	assert.False(t, created)
+	assert.Equal(t, "corr-alert-a", group.ID)
+	assert.Equal(t, "alert-a", group.RootCause.Id)
+
+	ids := make([]string, len(group.Alerts))
+	for i, alert := range group.Alerts {
+		ids[i] = alert.Id
+	}
+	assert.Equal(t, []string{"alert-a", "alert-b", "alert-d", "alert-c"}, ids)
+
+	assert.Equal(t, int64(0), engine.Metrics().GroupsCreatedTotal())
+}
+
+func TestEngine_CorrelateAlert_InvalidWindow(t *testing.T) {
+	engine := NewEngine()
+
+	_, _, err := engine.CorrelateAlert(context.Background(), newTestAlert("alert-a", time.Now(), nil), nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidWindow)
+}
@@ -0,0 +1,40 @@
+package correlation
+
+import (
+	"sync"
+)
+
+// Metrics tracks correlation engine metrics.
+// Exposed as the correlation_groups_created_total counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// groupsCreated counts correlation groups produced by the engine.
+	groupsCreated int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordGroupsCreated increments the groups created counter.
+func (m *Metrics) RecordGroupsCreated(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groupsCreated += int64(count)
+}
+
+// GroupsCreatedTotal returns the number of correlation groups created.
+func (m *Metrics) GroupsCreatedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.groupsCreated
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groupsCreated = 0
+}
@@ -0,0 +1,166 @@
+package customer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+)
+
+// benchCustomerCount is the number of customers with IP ranges used by the benchmarks.
+const benchCustomerCount = 10000
+
+// benchLookupIP falls inside exactly one benchmark customer's range.
+const benchLookupIP = "10.20.30.40"
+
+func benchCIDR(i int) string {
+	return fmt.Sprintf("10.%d.%d.0/24", i/256, i%256)
+}
+
+func BenchmarkInMemoryStore_GetByIPRange(b *testing.B) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	for i := 0; i < benchCustomerCount; i++ {
+		_, err := store.Create(ctx, &Customer{
+			Name:      fmt.Sprintf("Customer %d", i),
+			AccountID: fmt.Sprintf("acct-%d", i),
+			TierID:    "tier-1",
+			IPRanges:  []string{benchCIDR(i)},
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		customers, err := store.GetByIPRange(ctx, benchLookupIP)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(customers) != 1 {
+			b.Fatalf("expected 1 customer, got %d", len(customers))
+		}
+	}
+}
+
+// BenchmarkPostgres_GetByIPRange compares the previous jsonb lookup, which loaded
+// every customer with IP ranges and filtered in Go, with the inet[] containment
+// query. It needs a PostgreSQL database in CUSTOMER_BENCH_DATABASE_URL and only
+// creates temporary tables. Query plans are written with -v.
+func BenchmarkPostgres_GetByIPRange(b *testing.B) {
+	dsn := os.Getenv("CUSTOMER_BENCH_DATABASE_URL")
+	if dsn == "" {
+		b.Skip("CUSTOMER_BENCH_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	// Temporary tables are only visible to the connection that created them
+	db.SetMaxOpenConns(1)
+	ctx := context.Background()
+
+	setup := []string{
+		`CREATE TEMP TABLE bench_customers_jsonb (id INTEGER PRIMARY KEY, ip_ranges JSONB NOT NULL DEFAULT '[]')`,
+		`CREATE TEMP TABLE bench_customers_inet (id INTEGER PRIMARY KEY, ip_ranges INET[] NOT NULL DEFAULT '{}')`,
+		`CREATE INDEX ON bench_customers_jsonb USING GIN (ip_ranges)`,
+		fmt.Sprintf(`INSERT INTO bench_customers_jsonb
+			SELECT i, jsonb_build_array(format('10.%%s.%%s.0/24', i / 256, i %% 256))
+			FROM generate_series(0, %d) AS i`, benchCustomerCount-1),
+		fmt.Sprintf(`INSERT INTO bench_customers_inet
+			SELECT i, ARRAY[format('10.%%s.%%s.0/24', i / 256, i %% 256)::inet]
+			FROM generate_series(0, %d) AS i`, benchCustomerCount-1),
+		`ANALYZE bench_customers_jsonb`,
+		`ANALYZE bench_customers_inet`,
+	}
+	for _, stmt := range setup {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			b.Fatalf("setup %q: %v", stmt, err)
+		}
+	}
+
+	jsonbQuery := `SELECT id, ip_ranges FROM bench_customers_jsonb WHERE ip_ranges != '[]'::jsonb`
+	inetQuery := `SELECT id, ip_ranges FROM bench_customers_inet WHERE $1::inet <<= ANY(ip_ranges)`
+
+	b.Run("jsonb_scan", func(b *testing.B) {
+		logQueryPlan(b, db, jsonbQuery)
+		for i := 0; i < b.N; i++ {
+			matched := 0
+			rows, err := db.QueryContext(ctx, jsonbQuery)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for rows.Next() {
+				var id int
+				var data []byte
+				if err := rows.Scan(&id, &data); err != nil {
+					b.Fatal(err)
+				}
+				var cidrs []string
+				_ = json.Unmarshal(data, &cidrs)
+				ranges, err := ParseIPRanges(cidrs)
+				if err == nil && ContainsIP(ranges, benchLookupIP) {
+					matched++
+				}
+			}
+			_ = rows.Close()
+			if matched != 1 {
+				b.Fatalf("expected 1 customer, got %d", matched)
+			}
+		}
+	})
+
+	b.Run("inet_containment", func(b *testing.B) {
+		logQueryPlan(b, db, inetQuery, benchLookupIP)
+		for i := 0; i < b.N; i++ {
+			matched := 0
+			rows, err := db.QueryContext(ctx, inetQuery, benchLookupIP)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for rows.Next() {
+				var id int
+				var data []byte
+				if err := rows.Scan(&id, &data); err != nil {
+					b.Fatal(err)
+				}
+				matched++
+			}
+			_ = rows.Close()
+			if matched != 1 {
+				b.Fatalf("expected 1 customer, got %d", matched)
+			}
+		}
+	})
+}
+
+// logQueryPlan logs the EXPLAIN ANALYZE output for a query.
+func logQueryPlan(b *testing.B, db *sql.DB, query string, args ...interface{}) {
+	b.Helper()
+
+	rows, err := db.Query("EXPLAIN ANALYZE "+query, args...)
+	if err != nil {
+		b.Fatalf("explain: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var plan []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			b.Fatalf("scan plan: %v", err)
+		}
+		plan = append(plan, line)
+	}
+	b.Logf("query plan:\n%s", strings.Join(plan, "\n"))
+}
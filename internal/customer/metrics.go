@@ -0,0 +1,49 @@
+package customer
+
+import (
+	"sync"
+)
+
+// Webhook verification results.
+const (
+	VerificationResultSuccess = "success"
+	VerificationResultFailure = "failure"
+	VerificationResultTimeout = "timeout"
+)
+
+// Metrics tracks customer portal metrics.
+// Exposed as the webhook_verification_attempts_total{result} counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// verificationAttempts counts webhook endpoint verifications by result.
+	verificationAttempts map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		verificationAttempts: make(map[string]int64),
+	}
+}
+
+// RecordVerificationAttempt increments the verification attempts counter for a result.
+func (m *Metrics) RecordVerificationAttempt(result string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.verificationAttempts[result]++
+}
+
+// VerificationAttemptsTotal returns the number of verification attempts with a result.
+func (m *Metrics) VerificationAttemptsTotal(result string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.verificationAttempts[result]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.verificationAttempts = make(map[string]int64)
+}
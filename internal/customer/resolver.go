@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
 )
 
 var (
@@ -101,6 +103,13 @@ func (r *DefaultResolver) Resolve(ctx context.Context, labels map[string]string)
 		return nil, ErrNoCustomerResolved
 	}
 
+	// Customers are cached per tenant
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cachePrefix := tenantID + "/"
+
 	// Resolution attempts in priority order
 	resolutionAttempts := []struct {
 		method    ResolutionMethod
@@ -111,12 +120,13 @@ func (r *DefaultResolver) Resolve(ctx context.Context, labels map[string]string)
 			extractFn: func() (*Customer, error) {
 				if customerID, ok := labels["customer"]; ok && customerID != "" {
 					// Check cache first
-					if customer := r.getCached(customerID); customer != nil {
+					cacheKey := cachePrefix + customerID
+					if customer := r.getCached(cacheKey); customer != nil {
 						return customer, nil
 					}
 					customer, err := r.customerStore.GetByID(ctx, customerID)
 					if err == nil {
-						r.setCache(customerID, customer)
+						r.setCache(cacheKey, customer)
 					}
 					return customer, err
 				}
@@ -127,7 +137,7 @@ func (r *DefaultResolver) Resolve(ctx context.Context, labels map[string]string)
 			method: ResolutionMethodAccountID,
 			extractFn: func() (*Customer, error) {
 				if accountID, ok := labels["account_id"]; ok && accountID != "" {
-					cacheKey := "account:" + accountID
+					cacheKey := cachePrefix + "account:" + accountID
 					if customer := r.getCached(cacheKey); customer != nil {
 						return customer, nil
 					}
@@ -144,7 +154,7 @@ func (r *DefaultResolver) Resolve(ctx context.Context, labels map[string]string)
 			method: ResolutionMethodDomain,
 			extractFn: func() (*Customer, error) {
 				if domain, ok := labels["domain"]; ok && domain != "" {
-					cacheKey := "domain:" + domain
+					cacheKey := cachePrefix + "domain:" + domain
 					if customer := r.getCached(cacheKey); customer != nil {
 						return customer, nil
 					}
@@ -161,7 +171,7 @@ func (r *DefaultResolver) Resolve(ctx context.Context, labels map[string]string)
 			method: ResolutionMethodIPRange,
 			extractFn: func() (*Customer, error) {
 				if clientIP, ok := labels["client_ip"]; ok && clientIP != "" {
-					cacheKey := "ip:" + clientIP
+					cacheKey := cachePrefix + "ip:" + clientIP
 					if customer := r.getCached(cacheKey); customer != nil {
 						return customer, nil
 					}
@@ -301,10 +311,7 @@ func (r *DefaultResolver) InvalidateCache(customerID string) {
 	r.cacheMu.Lock()
 	defer r.cacheMu.Unlock()
 
-	// Remove direct ID cache
-	delete(r.cache, customerID)
-
-	// Remove all entries pointing to this customer
+	// Remove all entries pointing to this customer, in any tenant
 	for key, entry := range r.cache {
 		if entry.customer != nil && entry.customer.ID == customerID {
 			delete(r.cache, key)
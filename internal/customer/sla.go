@@ -0,0 +1,46 @@
+package customer
+
+import (
+	"context"
+	"time"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ResponseTime returns the time the tier commits to respond to an alert of the
+// given severity, or zero for severities without an SLA such as info.
+func (t *CustomerTier) ResponseTime(severity alertingv1.Severity) time.Duration {
+	switch severity {
+	case alertingv1.Severity_SEVERITY_CRITICAL:
+		return t.CriticalResponseTime
+	case alertingv1.Severity_SEVERITY_HIGH:
+		return t.HighResponseTime
+	case alertingv1.Severity_SEVERITY_MEDIUM:
+		return t.MediumResponseTime
+	case alertingv1.Severity_SEVERITY_LOW:
+		return t.LowResponseTime
+	default:
+		return 0
+	}
+}
+
+// SLADeadline returns when an alert of the given severity triggered at
+// triggeredAt must be responded to. It returns false if the tier has no
+// response time for the severity.
+func (t *CustomerTier) SLADeadline(severity alertingv1.Severity, triggeredAt time.Time) (time.Time, bool) {
+	responseTime := t.ResponseTime(severity)
+	if responseTime <= 0 {
+		return time.Time{}, false
+	}
+	return triggeredAt.Add(responseTime), true
+}
+
+// ResolveTier resolves the tier of the customer identified by alert labels.
+// It returns ErrCustomerNotFound or ErrTierNotFound if either cannot be found.
+func ResolveTier(ctx context.Context, customers Store, tiers TierStore, labels map[string]string) (*CustomerTier, error) {
+	customer, err := customers.GetByAlertLabels(ctx, labels)
+	if err != nil {
+		return nil, err
+	}
+	return tiers.GetByID(ctx, customer.TierID)
+}
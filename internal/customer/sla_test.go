@@ -0,0 +1,98 @@
+package customer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func slaTestTiers() []*CustomerTier {
+	return []*CustomerTier{
+		{
+			Name:                 "Enterprise",
+			Level:                1,
+			CriticalResponseTime: 5 * time.Minute,
+			HighResponseTime:     15 * time.Minute,
+			MediumResponseTime:   time.Hour,
+			LowResponseTime:      4 * time.Hour,
+		},
+		{
+			Name:                 "Premium",
+			Level:                2,
+			CriticalResponseTime: 15 * time.Minute,
+			HighResponseTime:     time.Hour,
+			MediumResponseTime:   4 * time.Hour,
+			LowResponseTime:      24 * time.Hour,
+		},
+		{
+			Name:                 "Standard",
+			Level:                3,
+			CriticalResponseTime: time.Hour,
+			HighResponseTime:     4 * time.Hour,
+			MediumResponseTime:   24 * time.Hour,
+			LowResponseTime:      72 * time.Hour,
+		},
+	}
+}
+
+func TestCustomerTier_SLADeadline(t *testing.T) {
+	triggeredAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, tier := range slaTestTiers() {
+		expected := map[alertingv1.Severity]time.Duration{
+			alertingv1.Severity_SEVERITY_CRITICAL: tier.CriticalResponseTime,
+			alertingv1.Severity_SEVERITY_HIGH:     tier.HighResponseTime,
+			alertingv1.Severity_SEVERITY_MEDIUM:   tier.MediumResponseTime,
+			alertingv1.Severity_SEVERITY_LOW:      tier.LowResponseTime,
+		}
+		for severity, responseTime := range expected {
+			t.Run(tier.Name+"/"+severity.String(), func(t *testing.T) {
+				deadline, ok := tier.SLADeadline(severity, triggeredAt)
+				require.True(t, ok)
+				assert.Equal(t, triggeredAt.Add(responseTime), deadline)
+			})
+		}
+
+		t.Run(tier.Name+"/no SLA", func(t *testing.T) {
+			for _, severity := range []alertingv1.Severity{alertingv1.Severity_SEVERITY_INFO, alertingv1.Severity_SEVERITY_UNSPECIFIED} {
+				_, ok := tier.SLADeadline(severity, triggeredAt)
+				assert.False(t, ok, "severity %s", severity)
+			}
+		})
+	}
+}
+
+func TestCustomerTier_SLADeadline_NoResponseTime(t *testing.T) {
+	tier := &CustomerTier{Name: "Basic", CriticalResponseTime: time.Hour}
+
+	_, ok := tier.SLADeadline(alertingv1.Severity_SEVERITY_LOW, time.Now())
+	assert.False(t, ok)
+}
+
+func TestResolveTier(t *testing.T) {
+	ctx := context.Background()
+	customers := NewInMemoryStore()
+	tiers := NewInMemoryTierStore()
+
+	tier, err := tiers.Create(ctx, slaTestTiers()[0])
+	require.NoError(t, err)
+	_, err = customers.Create(ctx, &Customer{Name: "Acme Corp", AccountID: "acme-001", TierID: tier.ID})
+	require.NoError(t, err)
+	_, err = customers.Create(ctx, &Customer{Name: "Globex", AccountID: "globex-001", TierID: "missing"})
+	require.NoError(t, err)
+
+	resolved, err := ResolveTier(ctx, customers, tiers, map[string]string{"account_id": "acme-001"})
+	require.NoError(t, err)
+	assert.Equal(t, tier.ID, resolved.ID)
+
+	_, err = ResolveTier(ctx, customers, tiers, map[string]string{"account_id": "globex-001"})
+	assert.ErrorIs(t, err, ErrTierNotFound)
+
+	_, err = ResolveTier(ctx, customers, tiers, map[string]string{"account_id": "unknown"})
+	assert.ErrorIs(t, err, ErrCustomerNotFound)
+}
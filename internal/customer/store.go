@@ -7,9 +7,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
 )
 
 var (
@@ -21,7 +25,9 @@ var (
 	ErrDuplicateAccountID = errors.New("duplicate account ID")
 )
 
-// Store defines the interface for customer persistence.
+// Store defines the interface for customer persistence. Customers belong to
+// the tenant of the context they are created with, see auth.TenantFromContext,
+// and are only visible to that tenant. Account IDs are unique per tenant.
 type Store interface {
 	// Create creates a new customer.
 	Create(ctx context.Context, customer *Customer) (*Customer, error)
@@ -38,6 +44,10 @@ type Store interface {
 	// GetByIPRange retrieves customers that contain the given IP in their ranges.
 	GetByIPRange(ctx context.Context, ip string) ([]*Customer, error)
 
+	// GetByAlertLabels retrieves the customer identified by the account_id,
+	// customer_domain or client_ip label of an alert.
+	GetByAlertLabels(ctx context.Context, labels map[string]string) (*Customer, error)
+
 	// List retrieves customers with optional filters.
 	List(ctx context.Context, filter *ListCustomersFilter) ([]*Customer, string, error)
 
@@ -64,6 +74,15 @@ func (s *PostgresStore) Create(ctx context.Context, customer *Customer) (*Custom
 		return nil, ErrInvalidCustomer
 	}
 
+	if _, err := ParseIPRanges(customer.IPRanges); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCustomer, err)
+	}
+
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if customer.ID == "" {
 		customer.ID = uuid.New().String()
 	}
@@ -73,20 +92,20 @@ func (s *PostgresStore) Create(ctx context.Context, customer *Customer) (*Custom
 	customer.UpdatedAt = now
 
 	domainsJSON, _ := json.Marshal(customer.Domains)
-	ipRangesJSON, _ := json.Marshal(customer.IPRanges)
+	ipRanges := inetArray(customer.IPRanges)
 	contactsJSON, _ := json.Marshal(customer.Contacts)
 	metadataJSON, _ := json.Marshal(customer.Metadata)
 
-	_, err := s.db.ExecContext(ctx, `
+	_, err = s.db.ExecContext(ctx, `
 		INSERT INTO customers (
 			id, name, account_id, tier_id, description,
 			domains, ip_ranges, contacts, metadata,
-			created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			created_at, updated_at, tenant_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7::inet[], $8, $9, $10, $11, $12)
 	`,
 		customer.ID, customer.Name, customer.AccountID, customer.TierID, customer.Description,
-		domainsJSON, ipRangesJSON, contactsJSON, metadataJSON,
-		customer.CreatedAt, customer.UpdatedAt,
+		domainsJSON, ipRanges, contactsJSON, metadataJSON,
+		customer.CreatedAt, customer.UpdatedAt, tenantID,
 	)
 	if err != nil {
 		if isUniqueViolation(err) {
@@ -110,19 +129,25 @@ func (s *PostgresStore) GetByAccountID(ctx context.Context, accountID string) (*
 
 // GetByDomain retrieves a customer by domain.
 func (s *PostgresStore) GetByDomain(ctx context.Context, domain string) (*Customer, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	customer := &Customer{}
 	var description sql.NullString
-	var domainsJSON, ipRangesJSON, contactsJSON, metadataJSON []byte
+	var domainsJSON, contactsJSON, metadataJSON []byte
+	var ipRanges pq.StringArray
 
-	err := s.db.QueryRowContext(ctx, `
+	err = s.db.QueryRowContext(ctx, `
 		SELECT id, name, account_id, tier_id, description,
-			   domains, ip_ranges, contacts, metadata,
+			   domains, ip_ranges::text[], contacts, metadata,
 			   created_at, updated_at
 		FROM customers
-		WHERE domains @> $1::jsonb
-	`, fmt.Sprintf(`["%s"]`, domain)).Scan(
+		WHERE domains @> $1::jsonb AND tenant_id = $2
+	`, fmt.Sprintf(`["%s"]`, domain), tenantID).Scan(
 		&customer.ID, &customer.Name, &customer.AccountID, &customer.TierID, &description,
-		&domainsJSON, &ipRangesJSON, &contactsJSON, &metadataJSON,
+		&domainsJSON, &ipRanges, &contactsJSON, &metadataJSON,
 		&customer.CreatedAt, &customer.UpdatedAt,
 	)
 	if err != nil {
@@ -133,23 +158,28 @@ func (s *PostgresStore) GetByDomain(ctx context.Context, domain string) (*Custom
 	}
 
 	customer.Description = description.String
-	s.parseJSONFields(customer, domainsJSON, ipRangesJSON, contactsJSON, metadataJSON)
+	s.parseJSONFields(customer, domainsJSON, ipRanges, contactsJSON, metadataJSON)
 
 	return customer, nil
 }
 
 // GetByIPRange retrieves customers that contain the given IP in their ranges.
 func (s *PostgresStore) GetByIPRange(ctx context.Context, ip string) ([]*Customer, error) {
-	// For PostgreSQL, we need to check each customer's IP ranges
-	// This is a simplified implementation that loads all customers and filters in memory
-	// A production implementation might use PostgreSQL's inet type for better performance
+	if net.ParseIP(ip) == nil {
+		return nil, nil
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, name, account_id, tier_id, description,
-			   domains, ip_ranges, contacts, metadata,
+			   domains, ip_ranges::text[], contacts, metadata,
 			   created_at, updated_at
 		FROM customers
-		WHERE ip_ranges IS NOT NULL AND ip_ranges != '[]'::jsonb
-	`)
+		WHERE $1::inet <<= ANY(ip_ranges) AND tenant_id = $2
+	`, ip, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("query customers by IP range: %w", err)
 	}
@@ -159,27 +189,20 @@ func (s *PostgresStore) GetByIPRange(ctx context.Context, ip string) ([]*Custome
 	for rows.Next() {
 		customer := &Customer{}
 		var description sql.NullString
-		var domainsJSON, ipRangesJSON, contactsJSON, metadataJSON []byte
+		var domainsJSON, contactsJSON, metadataJSON []byte
+		var ipRanges pq.StringArray
 
 		if err := rows.Scan(
 			&customer.ID, &customer.Name, &customer.AccountID, &customer.TierID, &description,
-			&domainsJSON, &ipRangesJSON, &contactsJSON, &metadataJSON,
+			&domainsJSON, &ipRanges, &contactsJSON, &metadataJSON,
 			&customer.CreatedAt, &customer.UpdatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("scan customer: %w", err)
 		}
 
 		customer.Description = description.String
-		s.parseJSONFields(customer, domainsJSON, ipRangesJSON, contactsJSON, metadataJSON)
-
-		// Check if IP is in any of the customer's ranges
-		ranges, err := ParseIPRanges(customer.IPRanges)
-		if err != nil {
-			continue // Skip invalid ranges
-		}
-		if ContainsIP(ranges, ip) {
-			customers = append(customers, customer)
-		}
+		s.parseJSONFields(customer, domainsJSON, ipRanges, contactsJSON, metadataJSON)
+		customers = append(customers, customer)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -189,22 +212,33 @@ func (s *PostgresStore) GetByIPRange(ctx context.Context, ip string) ([]*Custome
 	return customers, nil
 }
 
+// GetByAlertLabels retrieves the customer identified by the labels of an alert.
+func (s *PostgresStore) GetByAlertLabels(ctx context.Context, labels map[string]string) (*Customer, error) {
+	return getByAlertLabels(ctx, s, labels)
+}
+
 // getByField retrieves a customer by a specific field.
 func (s *PostgresStore) getByField(ctx context.Context, field, value string) (*Customer, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	customer := &Customer{}
 	var description sql.NullString
-	var domainsJSON, ipRangesJSON, contactsJSON, metadataJSON []byte
+	var domainsJSON, contactsJSON, metadataJSON []byte
+	var ipRanges pq.StringArray
 
 	query := fmt.Sprintf(`
 		SELECT id, name, account_id, tier_id, description,
-			   domains, ip_ranges, contacts, metadata,
+			   domains, ip_ranges::text[], contacts, metadata,
 			   created_at, updated_at
-		FROM customers WHERE %s = $1
+		FROM customers WHERE %s = $1 AND tenant_id = $2
 	`, field)
 
-	err := s.db.QueryRowContext(ctx, query, value).Scan(
+	err = s.db.QueryRowContext(ctx, query, value, tenantID).Scan(
 		&customer.ID, &customer.Name, &customer.AccountID, &customer.TierID, &description,
-		&domainsJSON, &ipRangesJSON, &contactsJSON, &metadataJSON,
+		&domainsJSON, &ipRanges, &contactsJSON, &metadataJSON,
 		&customer.CreatedAt, &customer.UpdatedAt,
 	)
 	if err != nil {
@@ -215,18 +249,18 @@ func (s *PostgresStore) getByField(ctx context.Context, field, value string) (*C
 	}
 
 	customer.Description = description.String
-	s.parseJSONFields(customer, domainsJSON, ipRangesJSON, contactsJSON, metadataJSON)
+	s.parseJSONFields(customer, domainsJSON, ipRanges, contactsJSON, metadataJSON)
 
 	return customer, nil
 }
 
-// parseJSONFields parses JSON fields into the customer struct.
-func (s *PostgresStore) parseJSONFields(customer *Customer, domainsJSON, ipRangesJSON, contactsJSON, metadataJSON []byte) {
+// parseJSONFields parses JSON fields and the IP ranges into the customer struct.
+func (s *PostgresStore) parseJSONFields(customer *Customer, domainsJSON []byte, ipRanges pq.StringArray, contactsJSON, metadataJSON []byte) {
 	if domainsJSON != nil {
 		_ = json.Unmarshal(domainsJSON, &customer.Domains)
 	}
-	if ipRangesJSON != nil {
-		_ = json.Unmarshal(ipRangesJSON, &customer.IPRanges)
+	if len(ipRanges) > 0 {
+		customer.IPRanges = []string(ipRanges)
 	}
 	if contactsJSON != nil {
 		_ = json.Unmarshal(contactsJSON, &customer.Contacts)
@@ -239,15 +273,30 @@ func (s *PostgresStore) parseJSONFields(customer *Customer, domainsJSON, ipRange
 	}
 }
 
+// inetArray returns CIDR strings as a text array for a $n::inet[] parameter.
+// Ranges are selected as ip_ranges::text[], which keeps the prefix length that
+// PostgreSQL omits when printing single-host ranges.
+func inetArray(cidrs []string) pq.StringArray {
+	if cidrs == nil {
+		return pq.StringArray{}
+	}
+	return pq.StringArray(cidrs)
+}
+
 // List retrieves customers with optional filters.
 func (s *PostgresStore) List(ctx context.Context, filter *ListCustomersFilter) ([]*Customer, string, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
 	query := `
 		SELECT id, name, account_id, tier_id, description,
-			   domains, ip_ranges, contacts, metadata,
+			   domains, ip_ranges::text[], contacts, metadata,
 			   created_at, updated_at
-		FROM customers WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
+		FROM customers WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIndex := 2
 
 	if filter != nil {
 		if filter.TierID != "" {
@@ -297,18 +346,19 @@ func (s *PostgresStore) List(ctx context.Context, filter *ListCustomersFilter) (
 	for rows.Next() {
 		customer := &Customer{}
 		var description sql.NullString
-		var domainsJSON, ipRangesJSON, contactsJSON, metadataJSON []byte
+		var domainsJSON, contactsJSON, metadataJSON []byte
+		var ipRanges pq.StringArray
 
 		if err := rows.Scan(
 			&customer.ID, &customer.Name, &customer.AccountID, &customer.TierID, &description,
-			&domainsJSON, &ipRangesJSON, &contactsJSON, &metadataJSON,
+			&domainsJSON, &ipRanges, &contactsJSON, &metadataJSON,
 			&customer.CreatedAt, &customer.UpdatedAt,
 		); err != nil {
 			return nil, "", fmt.Errorf("scan customer: %w", err)
 		}
 
 		customer.Description = description.String
-		s.parseJSONFields(customer, domainsJSON, ipRangesJSON, contactsJSON, metadataJSON)
+		s.parseJSONFields(customer, domainsJSON, ipRanges, contactsJSON, metadataJSON)
 
 		customers = append(customers, customer)
 	}
@@ -337,23 +387,32 @@ func (s *PostgresStore) Update(ctx context.Context, customer *Customer) (*Custom
 		return nil, ErrInvalidCustomer
 	}
 
+	if _, err := ParseIPRanges(customer.IPRanges); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCustomer, err)
+	}
+
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	customer.UpdatedAt = time.Now()
 
 	domainsJSON, _ := json.Marshal(customer.Domains)
-	ipRangesJSON, _ := json.Marshal(customer.IPRanges)
+	ipRanges := inetArray(customer.IPRanges)
 	contactsJSON, _ := json.Marshal(customer.Contacts)
 	metadataJSON, _ := json.Marshal(customer.Metadata)
 
 	result, err := s.db.ExecContext(ctx, `
 		UPDATE customers SET
 			name = $1, account_id = $2, tier_id = $3, description = $4,
-			domains = $5, ip_ranges = $6, contacts = $7, metadata = $8,
+			domains = $5, ip_ranges = $6::inet[], contacts = $7, metadata = $8,
 			updated_at = $9
-		WHERE id = $10
+		WHERE id = $10 AND tenant_id = $11
 	`,
 		customer.Name, customer.AccountID, customer.TierID, customer.Description,
-		domainsJSON, ipRangesJSON, contactsJSON, metadataJSON,
-		customer.UpdatedAt, customer.ID,
+		domainsJSON, ipRanges, contactsJSON, metadataJSON,
+		customer.UpdatedAt, customer.ID, tenantID,
 	)
 	if err != nil {
 		if isUniqueViolation(err) {
@@ -372,7 +431,12 @@ func (s *PostgresStore) Update(ctx context.Context, customer *Customer) (*Custom
 
 // Delete deletes a customer by ID.
 func (s *PostgresStore) Delete(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM customers WHERE id = $1", id)
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM customers WHERE id = $1 AND tenant_id = $2", id, tenantID)
 	if err != nil {
 		return fmt.Errorf("delete customer: %w", err)
 	}
@@ -388,13 +452,16 @@ func (s *PostgresStore) Delete(ctx context.Context, id string) error {
 // InMemoryStore is an in-memory implementation of Store for testing.
 type InMemoryStore struct {
 	customers map[string]*Customer
-	counter   int64
+	// tenants holds the tenant of each customer
+	tenants map[string]string
+	counter int64
 }
 
 // NewInMemoryStore creates a new in-memory store.
 func NewInMemoryStore() *InMemoryStore {
 	return &InMemoryStore{
 		customers: make(map[string]*Customer),
+		tenants:   make(map[string]string),
 	}
 }
 
@@ -403,9 +470,13 @@ func (s *InMemoryStore) Create(ctx context.Context, customer *Customer) (*Custom
 	if customer == nil || customer.Name == "" || customer.AccountID == "" {
 		return nil, ErrInvalidCustomer
 	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check for duplicate account ID
-	for _, c := range s.customers {
+	for _, c := range s.tenantCustomers(tenantID) {
 		if c.AccountID == customer.AccountID {
 			return nil, ErrDuplicateAccountID
 		}
@@ -441,14 +512,20 @@ func (s *InMemoryStore) Create(ctx context.Context, customer *Customer) (*Custom
 		}
 	}
 	s.customers[customer.ID] = &stored
+	s.tenants[customer.ID] = tenantID
 
 	return customer, nil
 }
 
 // GetByID retrieves a customer by ID.
 func (s *InMemoryStore) GetByID(ctx context.Context, id string) (*Customer, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	customer, ok := s.customers[id]
-	if !ok {
+	if !ok || s.tenants[id] != tenantID {
 		return nil, ErrCustomerNotFound
 	}
 	return customer, nil
@@ -456,7 +533,12 @@ func (s *InMemoryStore) GetByID(ctx context.Context, id string) (*Customer, erro
 
 // GetByAccountID retrieves a customer by account ID.
 func (s *InMemoryStore) GetByAccountID(ctx context.Context, accountID string) (*Customer, error) {
-	for _, customer := range s.customers {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customer := range s.tenantCustomers(tenantID) {
 		if customer.AccountID == accountID {
 			return customer, nil
 		}
@@ -466,7 +548,12 @@ func (s *InMemoryStore) GetByAccountID(ctx context.Context, accountID string) (*
 
 // GetByDomain retrieves a customer by domain.
 func (s *InMemoryStore) GetByDomain(ctx context.Context, domain string) (*Customer, error) {
-	for _, customer := range s.customers {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customer := range s.tenantCustomers(tenantID) {
 		for _, d := range customer.Domains {
 			if d == domain {
 				return customer, nil
@@ -477,24 +564,47 @@ func (s *InMemoryStore) GetByDomain(ctx context.Context, domain string) (*Custom
 }
 
 // GetByIPRange retrieves customers that contain the given IP in their ranges.
+// Invalid ranges are ignored rather than excluding the whole customer.
 func (s *InMemoryStore) GetByIPRange(ctx context.Context, ip string) ([]*Customer, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, nil
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var customers []*Customer
-	for _, customer := range s.customers {
-		ranges, err := ParseIPRanges(customer.IPRanges)
-		if err != nil {
-			continue
-		}
-		if ContainsIP(ranges, ip) {
-			customers = append(customers, customer)
+	for _, customer := range s.tenantCustomers(tenantID) {
+		for _, cidr := range customer.IPRanges {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				continue
+			}
+			if network.Contains(addr) {
+				customers = append(customers, customer)
+				break
+			}
 		}
 	}
 	return customers, nil
 }
 
+// GetByAlertLabels retrieves the customer identified by the labels of an alert.
+func (s *InMemoryStore) GetByAlertLabels(ctx context.Context, labels map[string]string) (*Customer, error) {
+	return getByAlertLabels(ctx, s, labels)
+}
+
 // List retrieves customers with optional filters.
 func (s *InMemoryStore) List(ctx context.Context, filter *ListCustomersFilter) ([]*Customer, string, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
 	var customers []*Customer
-	for _, customer := range s.customers {
+	for _, customer := range s.tenantCustomers(tenantID) {
 		if filter != nil {
 			if filter.TierID != "" && customer.TierID != filter.TierID {
 				continue
@@ -536,13 +646,18 @@ func (s *InMemoryStore) Update(ctx context.Context, customer *Customer) (*Custom
 		return nil, ErrInvalidCustomer
 	}
 
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	existing, ok := s.customers[customer.ID]
-	if !ok {
+	if !ok || s.tenants[customer.ID] != tenantID {
 		return nil, ErrCustomerNotFound
 	}
 
 	// Check for duplicate account ID (excluding this customer)
-	for _, c := range s.customers {
+	for _, c := range s.tenantCustomers(tenantID) {
 		if c.ID != customer.ID && c.AccountID == customer.AccountID {
 			return nil, ErrDuplicateAccountID
 		}
@@ -578,13 +693,84 @@ func (s *InMemoryStore) Update(ctx context.Context, customer *Customer) (*Custom
 
 // Delete deletes a customer by ID.
 func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
-	if _, ok := s.customers[id]; !ok {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.customers[id]; !ok || s.tenants[id] != tenantID {
 		return ErrCustomerNotFound
 	}
 	delete(s.customers, id)
+	delete(s.tenants, id)
 	return nil
 }
 
+// tenantCustomers returns the customers of tenantID.
+func (s *InMemoryStore) tenantCustomers(tenantID string) []*Customer {
+	var customers []*Customer
+	for id, customer := range s.customers {
+		if s.tenants[id] == tenantID {
+			customers = append(customers, customer)
+		}
+	}
+	return customers
+}
+
+// getByAlertLabels looks a customer up by the account_id label, then the
+// customer_domain label and then the client_ip label, so that when labels
+// identify different customers the most specific one wins. Of several customers
+// whose IP ranges contain client_ip, the one with the narrowest range wins.
+func getByAlertLabels(ctx context.Context, s Store, labels map[string]string) (*Customer, error) {
+	if accountID := labels["account_id"]; accountID != "" {
+		customer, err := s.GetByAccountID(ctx, accountID)
+		if !errors.Is(err, ErrCustomerNotFound) {
+			return customer, err
+		}
+	}
+
+	if domain := labels["customer_domain"]; domain != "" {
+		customer, err := s.GetByDomain(ctx, domain)
+		if !errors.Is(err, ErrCustomerNotFound) {
+			return customer, err
+		}
+	}
+
+	if clientIP := labels["client_ip"]; clientIP != "" {
+		customers, err := s.GetByIPRange(ctx, clientIP)
+		if err != nil {
+			return nil, err
+		}
+		if customer := narrowestIPRangeMatch(customers, net.ParseIP(clientIP)); customer != nil {
+			return customer, nil
+		}
+	}
+
+	return nil, ErrCustomerNotFound
+}
+
+// narrowestIPRangeMatch returns the customer with the longest-prefix range
+// containing ip, or nil if customers is empty.
+func narrowestIPRangeMatch(customers []*Customer, ip net.IP) *Customer {
+	var best *Customer
+	bestPrefix := -1
+	for _, customer := range customers {
+		for _, cidr := range customer.IPRanges {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil || !network.Contains(ip) {
+				continue
+			}
+			if prefix, _ := network.Mask.Size(); prefix > bestPrefix {
+				best, bestPrefix = customer, prefix
+			}
+		}
+	}
+	if best == nil && len(customers) > 0 {
+		return customers[0]
+	}
+	return best
+}
+
 // Ensure interfaces are implemented
 var _ Store = (*PostgresStore)(nil)
 var _ Store = (*InMemoryStore)(nil)
@@ -3,9 +3,14 @@ package customer
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
 )
 
 func TestInMemoryStore_Create(t *testing.T) {
@@ -45,6 +50,30 @@ func TestInMemoryStore_Create_DuplicateAccountID(t *testing.T) {
 	assert.ErrorIs(t, err, ErrDuplicateAccountID)
 }
 
+func TestInMemoryStore_TenantIsolation(t *testing.T) {
+	store := NewInMemoryStore()
+	tenantA := auth.WithTenant(context.Background(), "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01")
+	tenantB := auth.WithTenant(context.Background(), "0b3e7c1d-5a2f-4c8e-9d6b-1e4f7a2c3b5d")
+
+	created, err := store.Create(tenantA, &Customer{Name: "Acme Corp", AccountID: "acme-001", TierID: "tier-1", Domains: []string{"acme.com"}})
+	require.NoError(t, err)
+
+	// Account IDs are unique per tenant only
+	_, err = store.Create(tenantB, &Customer{Name: "Acme Corp", AccountID: "acme-001", TierID: "tier-1"})
+	require.NoError(t, err)
+
+	_, err = store.GetByID(tenantB, created.ID)
+	assert.ErrorIs(t, err, ErrCustomerNotFound)
+	_, err = store.GetByDomain(tenantB, "acme.com")
+	assert.ErrorIs(t, err, ErrCustomerNotFound)
+	assert.ErrorIs(t, store.Delete(tenantB, created.ID), ErrCustomerNotFound)
+
+	customers, _, err := store.List(tenantB, &ListCustomersFilter{})
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+	assert.NotEqual(t, created.ID, customers[0].ID)
+}
+
 func TestInMemoryStore_Create_Invalid(t *testing.T) {
 	store := NewInMemoryStore()
 	ctx := context.Background()
@@ -369,3 +398,133 @@ func TestContainsIP(t *testing.T) {
 		})
 	}
 }
+
+func TestInMemoryStore_GetByIPRange_IgnoresInvalidRanges(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	_, err := store.Create(ctx, &Customer{
+		Name:      "Acme Corp",
+		AccountID: "acme-001",
+		TierID:    "tier-1",
+		IPRanges:  []string{"not-a-cidr", "10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	customers, err := store.GetByIPRange(ctx, "10.1.2.3")
+	require.NoError(t, err)
+	assert.Len(t, customers, 1)
+
+	customers, err = store.GetByIPRange(ctx, "not-an-ip")
+	require.NoError(t, err)
+	assert.Len(t, customers, 0)
+}
+
+func TestPostgresStore_GetByIPRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "account_id", "tier_id", "description",
+		"domains", "ip_ranges", "contacts", "metadata", "created_at", "updated_at",
+	}).AddRow(
+		"cust-1", "Acme Corp", "acme-001", "tier-1", nil,
+		[]byte(`[]`), []byte(`{10.0.0.0/8,192.168.1.10/32}`), []byte(`[]`), []byte(`{}`), now, now,
+	)
+
+	mock.ExpectQuery(`(?s)ip_ranges::text\[\].*FROM customers\s+WHERE \$1::inet <<= ANY\(ip_ranges\)`).
+		WithArgs("10.1.2.3", auth.DefaultTenantID).
+		WillReturnRows(rows)
+
+	customers, err := store.GetByIPRange(context.Background(), "10.1.2.3")
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.10/32"}, customers[0].IPRanges)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_Create_InvalidIPRange(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	_, err = NewPostgresStore(db).Create(context.Background(), &Customer{
+		Name:      "Acme Corp",
+		AccountID: "acme-001",
+		TierID:    "tier-1",
+		IPRanges:  []string{"10.0.0.0/33"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidCustomer)
+}
+
+func TestPostgresStore_Create_IPRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		ipRanges []string
+		expected pq.StringArray
+	}{
+		{name: "ranges", ipRanges: []string{"10.0.0.0/8", "192.168.1.10/32"}, expected: pq.StringArray{"10.0.0.0/8", "192.168.1.10/32"}},
+		{name: "none", ipRanges: nil, expected: pq.StringArray{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+
+			mock.ExpectExec(`(?s)INSERT INTO customers .* \$7::inet\[\]`).
+				WithArgs(
+					sqlmock.AnyArg(), "Acme Corp", "acme-001", "tier-1", "",
+					sqlmock.AnyArg(), tt.expected, sqlmock.AnyArg(), sqlmock.AnyArg(),
+					sqlmock.AnyArg(), sqlmock.AnyArg(), auth.DefaultTenantID,
+				).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			_, err = NewPostgresStore(db).Create(context.Background(), &Customer{
+				Name:      "Acme Corp",
+				AccountID: "acme-001",
+				TierID:    "tier-1",
+				IPRanges:  tt.ipRanges,
+			})
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgresStore_GetByAlertLabels_FallsBackToDomain(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+	columns := []string{
+		"id", "name", "account_id", "tier_id", "description",
+		"domains", "ip_ranges", "contacts", "metadata", "created_at", "updated_at",
+	}
+
+	mock.ExpectQuery(`FROM customers WHERE account_id = \$1 AND tenant_id = \$2`).
+		WithArgs("unknown", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(`WHERE domains @> \$1::jsonb AND tenant_id = \$2`).
+		WithArgs(`["globex.com"]`, auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			"cust-2", "Globex", "globex-001", "tier-2", nil,
+			[]byte(`["globex.com"]`), nil, []byte(`[]`), []byte(`{}`), now, now,
+		))
+
+	customer, err := store.GetByAlertLabels(context.Background(), map[string]string{
+		"account_id":      "unknown",
+		"customer_domain": "globex.com",
+		"client_ip":       "10.1.2.3",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cust-2", customer.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,128 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrWebhookSubscriptionNotFound is returned when a webhook subscription cannot be found.
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	// ErrInvalidWebhookSubscription is returned when a webhook subscription is invalid.
+	ErrInvalidWebhookSubscription = errors.New("invalid webhook subscription")
+)
+
+// WebhookSubscription is an outbound webhook a customer registered through the
+// customer portal. Subscriptions start disabled and are only enabled once their
+// endpoint passes verification.
+type WebhookSubscription struct {
+	ID         string     `json:"id"`
+	CustomerID string     `json:"customerId"`
+	URL        string     `json:"url"`
+	Enabled    bool       `json:"enabled"`
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// WebhookSubscriptionStore defines the interface for webhook subscription persistence.
+type WebhookSubscriptionStore interface {
+	// Create creates a new webhook subscription.
+	Create(ctx context.Context, sub *WebhookSubscription) (*WebhookSubscription, error)
+
+	// GetByID retrieves a webhook subscription by ID.
+	GetByID(ctx context.Context, id string) (*WebhookSubscription, error)
+
+	// Update updates an existing webhook subscription.
+	Update(ctx context.Context, sub *WebhookSubscription) (*WebhookSubscription, error)
+}
+
+// validateWebhookSubscription checks that a subscription has a customer and an absolute HTTP(S) URL.
+func validateWebhookSubscription(sub *WebhookSubscription) error {
+	if sub == nil || sub.CustomerID == "" {
+		return ErrInvalidWebhookSubscription
+	}
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookSubscription
+	}
+	return nil
+}
+
+// InMemoryWebhookSubscriptionStore is an in-memory implementation of WebhookSubscriptionStore.
+type InMemoryWebhookSubscriptionStore struct {
+	mu   sync.RWMutex
+	subs map[string]*WebhookSubscription
+}
+
+// NewInMemoryWebhookSubscriptionStore creates a new in-memory webhook subscription store.
+func NewInMemoryWebhookSubscriptionStore() *InMemoryWebhookSubscriptionStore {
+	return &InMemoryWebhookSubscriptionStore{
+		subs: make(map[string]*WebhookSubscription),
+	}
+}
+
+// Create creates a new webhook subscription in memory.
+func (s *InMemoryWebhookSubscriptionStore) Create(ctx context.Context, sub *WebhookSubscription) (*WebhookSubscription, error) {
+	if err := validateWebhookSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sub.ID == "" {
+		sub.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+
+	stored := *sub
+	s.subs[sub.ID] = &stored
+	return sub, nil
+}
+
+// GetByID retrieves a webhook subscription by ID.
+func (s *InMemoryWebhookSubscriptionStore) GetByID(ctx context.Context, id string) (*WebhookSubscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sub, ok := s.subs[id]
+	if !ok {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+	result := *sub
+	return &result, nil
+}
+
+// Update updates an existing webhook subscription.
+func (s *InMemoryWebhookSubscriptionStore) Update(ctx context.Context, sub *WebhookSubscription) (*WebhookSubscription, error) {
+	if err := validateWebhookSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.subs[sub.ID]
+	if !ok {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+
+	sub.CreatedAt = existing.CreatedAt
+	sub.UpdatedAt = time.Now()
+
+	stored := *sub
+	s.subs[sub.ID] = &stored
+	return sub, nil
+}
+
+// Ensure interfaces are implemented
+var _ WebhookSubscriptionStore = (*InMemoryWebhookSubscriptionStore)(nil)
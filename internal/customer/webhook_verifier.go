@@ -0,0 +1,134 @@
+package customer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultWebhookVerificationTimeout is how long an endpoint has to answer the test event.
+const DefaultWebhookVerificationTimeout = 5 * time.Second
+
+// WebhookTestEventType is the type of the event sent to verify an endpoint.
+const WebhookTestEventType = "webhook.test"
+
+// VerificationResult is the outcome of sending a test event to a webhook endpoint.
+type VerificationResult struct {
+	// Reachable is true when the endpoint answered with HTTP 200 in time.
+	Reachable bool `json:"reachable"`
+	// ResponseCode is the HTTP status returned, or 0 if there was no response.
+	ResponseCode int `json:"response_code"`
+	// LatencyMs is how long the endpoint took to respond, in milliseconds.
+	LatencyMs int `json:"latency_ms"`
+}
+
+// webhookTestEvent is the payload POSTed to an endpoint under verification.
+type webhookTestEvent struct {
+	Type      string `json:"type"`
+	Timestamp string `json:"timestamp"`
+}
+
+// WebhookVerifier checks that customer webhook endpoints are reachable before
+// their subscriptions are enabled.
+type WebhookVerifier struct {
+	store   WebhookSubscriptionStore
+	client  *http.Client
+	logger  zerolog.Logger
+	metrics *Metrics
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewWebhookVerifier creates a new webhook verifier.
+func NewWebhookVerifier(store WebhookSubscriptionStore, logger zerolog.Logger, metrics *Metrics) *WebhookVerifier {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &WebhookVerifier{
+		store:   store,
+		client:  &http.Client{},
+		logger:  logger.With().Str("component", "webhook_verifier").Logger(),
+		metrics: metrics,
+		timeout: DefaultWebhookVerificationTimeout,
+		now:     time.Now,
+	}
+}
+
+// Metrics returns the metrics recorder for this verifier.
+func (v *WebhookVerifier) Metrics() *Metrics {
+	return v.metrics
+}
+
+// VerifyWebhookEndpoint sends a webhook.test event to the subscription's URL and
+// enables the subscription if the endpoint answers with HTTP 200 within the
+// verification timeout. An unreachable endpoint is reported in the result, not
+// as an error, and leaves the subscription unchanged.
+func (v *WebhookVerifier) VerifyWebhookEndpoint(ctx context.Context, subscriptionID string) (*VerificationResult, error) {
+	sub, err := v.store.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(webhookTestEvent{
+		Type:      WebhookTestEventType,
+		Timestamp: v.now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal webhook test event: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build webhook test request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := v.client.Do(req)
+	result := &VerificationResult{LatencyMs: int(time.Since(start).Milliseconds())}
+
+	switch {
+	case err != nil && errors.Is(reqCtx.Err(), context.DeadlineExceeded):
+		v.metrics.RecordVerificationAttempt(VerificationResultTimeout)
+		v.logger.Warn().Str("subscriptionId", sub.ID).Msg("webhook endpoint verification timed out")
+		return result, nil
+	case err != nil:
+		v.metrics.RecordVerificationAttempt(VerificationResultFailure)
+		v.logger.Warn().Err(err).Str("subscriptionId", sub.ID).Msg("webhook endpoint unreachable")
+		return result, nil
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	_ = resp.Body.Close()
+
+	result.ResponseCode = resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		v.metrics.RecordVerificationAttempt(VerificationResultFailure)
+		v.logger.Warn().
+			Str("subscriptionId", sub.ID).
+			Int("responseCode", resp.StatusCode).
+			Msg("webhook endpoint verification failed")
+		return result, nil
+	}
+
+	result.Reachable = true
+	v.metrics.RecordVerificationAttempt(VerificationResultSuccess)
+
+	verifiedAt := v.now()
+	sub.Enabled = true
+	sub.VerifiedAt = &verifiedAt
+	if _, err := v.store.Update(ctx, sub); err != nil {
+		return nil, fmt.Errorf("enable webhook subscription: %w", err)
+	}
+	return result, nil
+}
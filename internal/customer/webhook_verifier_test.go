@@ -0,0 +1,118 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerifier(t *testing.T, url string) (*WebhookVerifier, *InMemoryWebhookSubscriptionStore, string) {
+	t.Helper()
+	store := NewInMemoryWebhookSubscriptionStore()
+	sub, err := store.Create(context.Background(), &WebhookSubscription{CustomerID: "cust-1", URL: url})
+	require.NoError(t, err)
+
+	verifier := NewWebhookVerifier(store, zerolog.Nop(), nil)
+	verifier.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return verifier, store, sub.ID
+}
+
+func TestVerifyWebhookEndpoint_Success(t *testing.T) {
+	var event map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	verifier, store, id := newTestVerifier(t, server.URL)
+
+	result, err := verifier.VerifyWebhookEndpoint(context.Background(), id)
+	require.NoError(t, err)
+	assert.True(t, result.Reachable)
+	assert.Equal(t, http.StatusOK, result.ResponseCode)
+	assert.GreaterOrEqual(t, result.LatencyMs, 0)
+	assert.Equal(t, map[string]string{"type": "webhook.test", "timestamp": "2026-03-01T12:00:00Z"}, event)
+
+	sub, err := store.GetByID(context.Background(), id)
+	require.NoError(t, err)
+	assert.True(t, sub.Enabled)
+	require.NotNil(t, sub.VerifiedAt)
+	assert.Equal(t, int64(1), verifier.Metrics().VerificationAttemptsTotal(VerificationResultSuccess))
+}
+
+func TestVerifyWebhookEndpoint_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	verifier, store, id := newTestVerifier(t, server.URL)
+
+	result, err := verifier.VerifyWebhookEndpoint(context.Background(), id)
+	require.NoError(t, err)
+	assert.False(t, result.Reachable)
+	assert.Equal(t, http.StatusInternalServerError, result.ResponseCode)
+
+	sub, err := store.GetByID(context.Background(), id)
+	require.NoError(t, err)
+	assert.False(t, sub.Enabled)
+	assert.Nil(t, sub.VerifiedAt)
+	assert.Equal(t, int64(1), verifier.Metrics().VerificationAttemptsTotal(VerificationResultFailure))
+}
+
+func TestVerifyWebhookEndpoint_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	verifier, store, id := newTestVerifier(t, server.URL)
+	verifier.timeout = 50 * time.Millisecond
+
+	result, err := verifier.VerifyWebhookEndpoint(context.Background(), id)
+	require.NoError(t, err)
+	assert.False(t, result.Reachable)
+	assert.Zero(t, result.ResponseCode)
+	assert.GreaterOrEqual(t, result.LatencyMs, 50)
+
+	sub, err := store.GetByID(context.Background(), id)
+	require.NoError(t, err)
+	assert.False(t, sub.Enabled)
+	assert.Equal(t, int64(1), verifier.Metrics().VerificationAttemptsTotal(VerificationResultTimeout))
+}
+
+func TestVerifyWebhookEndpoint_NotFound(t *testing.T) {
+	verifier := NewWebhookVerifier(NewInMemoryWebhookSubscriptionStore(), zerolog.Nop(), nil)
+
+	_, err := verifier.VerifyWebhookEndpoint(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrWebhookSubscriptionNotFound)
+}
+
+func TestInMemoryWebhookSubscriptionStore_Validation(t *testing.T) {
+	store := NewInMemoryWebhookSubscriptionStore()
+	ctx := context.Background()
+
+	for _, sub := range []*WebhookSubscription{
+		{URL: "https://example.com/hook"},
+		{CustomerID: "cust-1", URL: "example.com/hook"},
+		{CustomerID: "cust-1", URL: "ftp://example.com/hook"},
+	} {
+		_, err := store.Create(ctx, sub)
+		assert.ErrorIs(t, err, ErrInvalidWebhookSubscription, sub.URL)
+	}
+
+	_, err := store.Update(ctx, &WebhookSubscription{ID: "missing", CustomerID: "cust-1", URL: "https://example.com/hook"})
+	assert.ErrorIs(t, err, ErrWebhookSubscriptionNotFound)
+}
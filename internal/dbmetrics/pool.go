@@ -0,0 +1,138 @@
+// Package dbmetrics exports database connection pool statistics.
+package dbmetrics
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultInterval is how often pool statistics are collected.
+const DefaultInterval = 15 * time.Second
+
+// StatsProvider is implemented by *sql.DB.
+type StatsProvider interface {
+	Stats() sql.DBStats
+}
+
+// PoolStats is a snapshot of the gauges for a single connection pool.
+type PoolStats struct {
+	// ConnectionsOpen is exposed as the db_connections_open gauge.
+	ConnectionsOpen int64
+	// ConnectionsInUse is exposed as the db_connections_in_use gauge.
+	ConnectionsInUse int64
+	// ConnectionsIdle is exposed as the db_connections_idle gauge.
+	ConnectionsIdle int64
+	// WaitCountTotal is exposed as the db_wait_count_total gauge.
+	WaitCountTotal int64
+	// WaitDurationSecondsTotal is exposed as the db_wait_duration_seconds_total gauge.
+	WaitDurationSecondsTotal float64
+}
+
+// Metrics tracks connection pool gauges by pool name.
+// Exposed as the db_connections_open, db_connections_in_use, db_connections_idle,
+// db_wait_count_total and db_wait_duration_seconds_total gauges, labelled by db.
+type Metrics struct {
+	mu    sync.RWMutex
+	pools map[string]PoolStats
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		pools: make(map[string]PoolStats),
+	}
+}
+
+// Observe updates the gauges for a pool from its sql.DBStats.
+func (m *Metrics) Observe(name string, stats sql.DBStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pools[name] = PoolStats{
+		ConnectionsOpen:          int64(stats.OpenConnections),
+		ConnectionsInUse:         int64(stats.InUse),
+		ConnectionsIdle:          int64(stats.Idle),
+		WaitCountTotal:           stats.WaitCount,
+		WaitDurationSecondsTotal: stats.WaitDuration.Seconds(),
+	}
+}
+
+// Pool returns the last observed gauges for a pool.
+func (m *Metrics) Pool(name string) (PoolStats, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats, ok := m.pools[name]
+	return stats, ok
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pools = make(map[string]PoolStats)
+}
+
+// Collector periodically records the statistics of registered connection pools.
+type Collector struct {
+	mu       sync.RWMutex
+	pools    map[string]StatsProvider
+	metrics  *Metrics
+	interval time.Duration
+	logger   zerolog.Logger
+}
+
+// NewCollector creates a collector that records pool statistics every interval.
+// A non-positive interval uses DefaultInterval.
+func NewCollector(metrics *Metrics, interval time.Duration, logger zerolog.Logger) *Collector {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Collector{
+		pools:    make(map[string]StatsProvider),
+		metrics:  metrics,
+		interval: interval,
+		logger:   logger.With().Str("component", "db_pool_metrics").Logger(),
+	}
+}
+
+// Register adds a connection pool under the given name, e.g. "alerts" or "routing".
+func (c *Collector) Register(name string, db StatsProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pools[name] = db
+}
+
+// Collect records the current statistics of every registered pool.
+func (c *Collector) Collect() {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for name, db := range c.pools {
+		c.metrics.Observe(name, db.Stats())
+	}
+}
+
+// Run collects pool statistics until the context is cancelled.
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.logger.Info().Dur("interval", c.interval).Msg("starting database pool metrics collector")
+
+	c.Collect()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Collect()
+		}
+	}
+}
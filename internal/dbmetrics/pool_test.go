@@ -0,0 +1,109 @@
+package dbmetrics
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector_Collect(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	metrics := NewMetrics()
+	collector := NewCollector(metrics, time.Minute, zerolog.Nop())
+	collector.Register("alerts", db)
+
+	// Hold one connection and return another to the idle pool
+	inUse, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer func() { _ = inUse.Close() }()
+
+	idle, err := db.Conn(ctx)
+	require.NoError(t, err)
+	require.NoError(t, idle.Close())
+
+	collector.Collect()
+
+	stats, ok := metrics.Pool("alerts")
+	require.True(t, ok)
+	assert.Equal(t, int64(2), stats.ConnectionsOpen)
+	assert.Equal(t, int64(1), stats.ConnectionsInUse)
+	assert.Equal(t, int64(1), stats.ConnectionsIdle)
+	assert.Equal(t, int64(0), stats.WaitCountTotal)
+}
+
+func TestCollector_Collect_WaitStats(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+	metrics := NewMetrics()
+	collector := NewCollector(metrics, time.Minute, zerolog.Nop())
+	collector.Register("routing", db)
+
+	held, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	// A second caller waits for the only connection until it is released
+	acquired := make(chan *sql.Conn)
+	go func() {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			close(acquired)
+			return
+		}
+		acquired <- conn
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, held.Close())
+
+	conn := <-acquired
+	require.NotNil(t, conn)
+	defer func() { _ = conn.Close() }()
+
+	collector.Collect()
+
+	stats, ok := metrics.Pool("routing")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), stats.ConnectionsOpen)
+	assert.Equal(t, int64(1), stats.ConnectionsInUse)
+	assert.Equal(t, int64(1), stats.WaitCountTotal)
+	assert.Greater(t, stats.WaitDurationSecondsTotal, 0.0)
+}
+
+func TestCollector_Run(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	metrics := NewMetrics()
+	collector := NewCollector(metrics, 10*time.Millisecond, zerolog.Nop())
+	collector.Register("schedules", db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		collector.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, ok := metrics.Pool("schedules")
+		return ok
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,341 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/outage"
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ErrStepOutOfRange is returned when an escalation is started at a step the
+// policy does not have.
+var ErrStepOutOfRange = errors.New("escalation step out of range")
+
+// stepRetryDelay is how long a step waits before it is retried when its alert
+// cannot be read.
+const stepRetryDelay = 30 * time.Second
+
+// Cancellation reasons recorded in the escalation_cancelled_total counter.
+const (
+	CancelReasonAcknowledged = "acknowledged"
+	CancelReasonResolved     = "resolved"
+	CancelReasonStopped      = "stopped"
+)
+
+// execution is the state of a running escalation of one alert.
+type execution struct {
+	alertID string
+	policy  *EscalationPolicy
+	// step is the index of the next step to fire
+	step int
+	// repeats is how many times the policy has started over
+	repeats int32
+	// stop cancels the pending timer of the next step
+	stop func() bool
+}
+
+// Engine walks alerts through the steps of their escalation policy. Steps are
+// scheduled on in-process timers, so running escalations do not survive a
+// restart. An escalation stops when its alert is acknowledged or resolved,
+// which is checked each time a step is due, or when the policy is exhausted.
+type Engine struct {
+	policies     EscalationStore
+	alerts       store.AlertStore
+	notifyTeam   action.ActionHandler
+	notifyOnCall action.ActionHandler
+	notifyUser   action.ActionHandler
+	metrics      *Metrics
+	logger       zerolog.Logger
+
+	// afterFunc schedules f after d and returns a function stopping it
+	afterFunc func(d time.Duration, f func()) func() bool
+
+	mu          sync.Mutex
+	escalations map[string]*execution
+}
+
+// EngineOption configures optional Engine dependencies.
+type EngineOption func(*Engine)
+
+// WithOutageMode skips the notifications of escalation steps while outage mode
+// is active, as it does for the notification actions of routing rules. Skipped
+// notifications are recorded in actionMetrics if it is not nil.
+func WithOutageMode(store outage.OutageModeStore, actionMetrics *action.Metrics) EngineOption {
+	return func(e *Engine) {
+		e.notifyTeam = action.NewOutageModeHandler(routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM, store, actionMetrics, e.notifyTeam)
+		e.notifyOnCall = action.NewOutageModeHandler(routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL, store, actionMetrics, e.notifyOnCall)
+		e.notifyUser = action.NewOutageModeHandler(routingv1.ActionType_ACTION_TYPE_NOTIFY_USER, store, actionMetrics, e.notifyUser)
+	}
+}
+
+// NewEngine creates an escalation engine notifying step targets through
+// notifications.
+func NewEngine(policies EscalationStore, alerts store.AlertStore, notifications action.NotificationService, metrics *Metrics, logger zerolog.Logger, opts ...EngineOption) *Engine {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	e := &Engine{
+		policies:     policies,
+		alerts:       alerts,
+		notifyTeam:   action.NewNotifyTeamHandler(notifications),
+		notifyOnCall: action.NewNotifyOnCallHandler(notifications),
+		notifyUser:   action.NewNotifyUserHandler(notifications),
+		metrics:      metrics,
+		logger:       logger.With().Str("component", "escalation_engine").Logger(),
+		afterFunc: func(d time.Duration, f func()) func() bool {
+			return time.AfterFunc(d, f).Stop
+		},
+		escalations: make(map[string]*execution),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Metrics returns the metrics recorder for this engine.
+func (e *Engine) Metrics() *Metrics {
+	return e.metrics
+}
+
+// Start escalates an alert with a policy, beginning at the given 1-based step
+// number; 0 starts at the first step. The step fires once its delay has
+// passed. Starting the policy an alert is already escalating with is a no-op,
+// while a different policy replaces the running escalation.
+func (e *Engine) Start(ctx context.Context, alertID, policyID string, startAtStep int32) error {
+	return e.start(ctx, alertID, policyID, startAtStep, false)
+}
+
+// Escalate implements action.EscalationService. Urgent escalations fire their
+// first step immediately, ignoring its delay.
+func (e *Engine) Escalate(ctx context.Context, alertID string, policyID string, startAtStep int32, urgent bool) error {
+	return e.start(ctx, alertID, policyID, startAtStep, urgent)
+}
+
+func (e *Engine) start(ctx context.Context, alertID, policyID string, startAtStep int32, immediate bool) error {
+	policy, err := e.policies.GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return fmt.Errorf("get escalation policy %s: %w", policyID, err)
+	}
+
+	step := max(int(startAtStep)-1, 0)
+	if step >= len(policy.Steps) {
+		return fmt.Errorf("%w: policy %s has %d steps, cannot start at step %d", ErrStepOutOfRange, policyID, len(policy.Steps), startAtStep)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if running, ok := e.escalations[alertID]; ok {
+		if running.policy.ID == policy.ID {
+			return nil
+		}
+		running.stop()
+	}
+
+	exec := &execution{alertID: alertID, policy: policy, step: step}
+	e.escalations[alertID] = exec
+
+	delay := policy.Steps[step].Delay
+	if immediate {
+		delay = 0
+	}
+	e.schedule(exec, delay)
+
+	e.logger.Info().
+		Str("alert_id", alertID).
+		Str("policy_id", policy.ID).
+		Int("step", step+1).
+		Dur("delay", delay).
+		Msg("escalation started")
+
+	return nil
+}
+
+// Cancel stops the escalation of an alert and reports whether it was running.
+func (e *Engine) Cancel(alertID string) bool {
+	if !e.remove(alertID, nil) {
+		return false
+	}
+	e.metrics.RecordCancelled(CancelReasonStopped)
+	e.logger.Info().Str("alert_id", alertID).Msg("escalation stopped")
+	return true
+}
+
+// Stop cancels all running escalations without recording them as cancelled,
+// e.g. on shutdown.
+func (e *Engine) Stop() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for alertID, exec := range e.escalations {
+		exec.stop()
+		delete(e.escalations, alertID)
+	}
+}
+
+// schedule fires the next step of exec after delay. The caller must hold the lock.
+func (e *Engine) schedule(exec *execution, delay time.Duration) {
+	exec.stop = e.afterFunc(delay, func() { e.fire(exec) })
+}
+
+// remove stops the escalation of an alert if it is exec, or whichever
+// escalation is running when exec is nil, and reports whether it was removed.
+func (e *Engine) remove(alertID string, exec *execution) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	running, ok := e.escalations[alertID]
+	if !ok || (exec != nil && running != exec) {
+		return false
+	}
+	running.stop()
+	delete(e.escalations, alertID)
+	return true
+}
+
+// running reports whether exec is still the escalation of its alert. The
+// caller must hold the lock.
+func (e *Engine) running(exec *execution) bool {
+	return e.escalations[exec.alertID] == exec
+}
+
+// fire notifies the targets of the due step of exec, unless its alert has been
+// acknowledged or resolved, and schedules the next step.
+func (e *Engine) fire(exec *execution) {
+	ctx := context.Background()
+
+	e.mu.Lock()
+	if !e.running(exec) {
+		e.mu.Unlock()
+		return
+	}
+	e.mu.Unlock()
+
+	alert, err := e.alerts.GetByID(ctx, exec.alertID)
+	if err != nil {
+		e.logger.Warn().Err(err).Str("alert_id", exec.alertID).Msg("failed to get alert for escalation step, retrying")
+		e.mu.Lock()
+		if e.running(exec) {
+			e.schedule(exec, stepRetryDelay)
+		}
+		e.mu.Unlock()
+		return
+	}
+	if alert == nil {
+		e.remove(exec.alertID, exec)
+		e.logger.Warn().Str("alert_id", exec.alertID).Msg("alert of escalation not found, stopping escalation")
+		return
+	}
+
+	switch alert.Status {
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		e.cancel(exec, CancelReasonAcknowledged)
+		return
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		e.cancel(exec, CancelReasonResolved)
+		return
+	}
+
+	stepNumber := int32(exec.step + 1)
+	e.notify(ctx, routing.AlertFromStore(alert), exec.policy.Steps[exec.step])
+	e.metrics.RecordStepFired(exec.policy.ID, stepNumber)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.running(exec) {
+		return
+	}
+
+	steps := exec.policy.Steps
+	exec.step++
+	switch {
+	case exec.step < len(steps):
+		e.schedule(exec, steps[exec.step].Delay)
+	case exec.repeats < exec.policy.RepeatCount:
+		exec.repeats++
+		exec.step = 0
+		e.schedule(exec, exec.policy.RepeatInterval+steps[0].Delay)
+	default:
+		delete(e.escalations, exec.alertID)
+		e.logger.Info().
+			Str("alert_id", exec.alertID).
+			Str("policy_id", exec.policy.ID).
+			Msg("escalation policy exhausted")
+	}
+}
+
+// cancel stops exec because of the status of its alert.
+func (e *Engine) cancel(exec *execution, reason string) {
+	if !e.remove(exec.alertID, exec) {
+		return
+	}
+	e.metrics.RecordCancelled(reason)
+	e.logger.Info().
+		Str("alert_id", exec.alertID).
+		Str("policy_id", exec.policy.ID).
+		Str("reason", reason).
+		Msg("escalation cancelled")
+}
+
+// notify notifies every target of a step: the on-call of teams, the primary
+// on-call of schedules and users directly. Failed notifications are logged and
+// do not stop the escalation.
+func (e *Engine) notify(ctx context.Context, alert *routingv1.Alert, step EscalationStep) {
+	for _, target := range step.Targets {
+		var handler action.ActionHandler
+		var notify *routingv1.RoutingAction
+
+		switch target.Type {
+		case TargetTypeTeam:
+			handler = e.notifyTeam
+			notify = &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM,
+				NotifyTeam: &routingv1.NotifyTeamAction{
+					TeamId: target.ID,
+					Scope:  routingv1.TeamNotifyScope_TEAM_NOTIFY_SCOPE_ONCALL,
+				},
+			}
+		case TargetTypeSchedule:
+			handler = e.notifyOnCall
+			notify = &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL,
+				NotifyOncall: &routingv1.NotifyOnCallAction{
+					ScheduleId: target.ID,
+					Level:      routingv1.OnCallLevel_ONCALL_LEVEL_PRIMARY,
+				},
+			}
+		case TargetTypeUser:
+			handler = e.notifyUser
+			notify = &routingv1.RoutingAction{
+				Type:       routingv1.ActionType_ACTION_TYPE_NOTIFY_USER,
+				NotifyUser: &routingv1.NotifyUserAction{UserId: target.ID},
+			}
+		default:
+			continue
+		}
+
+		if _, err := handler(ctx, alert, notify); err != nil {
+			e.logger.Warn().
+				Err(err).
+				Str("alert_id", alert.Id).
+				Str("target_type", string(target.Type)).
+				Str("target_id", target.ID).
+				Msg("failed to notify escalation target")
+		}
+	}
+}
+
+// Ensure Engine implements action.EscalationService
+var _ action.EscalationService = (*Engine)(nil)
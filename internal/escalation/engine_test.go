@@ -0,0 +1,325 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kneutral-org/alerting-system/internal/outage"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// memoryAlertStore is a minimal store.AlertStore holding alerts by ID.
+type memoryAlertStore struct {
+	mu     sync.Mutex
+	alerts map[string]*alertingv1.Alert
+	getErr error
+}
+
+func (s *memoryAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (s *memoryAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.getErr != nil {
+		return nil, s.getErr
+	}
+	return s.alerts[id], nil
+}
+
+func (s *memoryAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (s *memoryAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return s.Create(ctx, alert)
+}
+
+func (s *memoryAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	created, err := s.Create(ctx, alert)
+	return created, true, err
+}
+
+func (s *memoryAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	return &alertingv1.ListAlertsResponse{}, nil
+}
+
+func (s *memoryAlertStore) setStatus(id string, status alertingv1.AlertStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alerts[id].Status = status
+}
+
+// recordingNotifier records the targets it is asked to notify.
+type recordingNotifier struct {
+	mu       sync.Mutex
+	notified []string
+}
+
+func (n *recordingNotifier) record(target string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notified = append(n.notified, target)
+}
+
+func (n *recordingNotifier) NotifyTeam(ctx context.Context, teamID string, scope routingv1.TeamNotifyScope, templateID string, alert *routingv1.Alert) error {
+	n.record("team:" + teamID)
+	return nil
+}
+
+func (n *recordingNotifier) NotifyChannel(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error {
+	return nil
+}
+
+func (n *recordingNotifier) NotifyUser(ctx context.Context, userID string, templateID string, channelOverride routingv1.ChannelType, alert *routingv1.Alert) error {
+	n.record("user:" + userID)
+	return nil
+}
+
+func (n *recordingNotifier) NotifyOnCall(ctx context.Context, scheduleID string, templateID string, level routingv1.OnCallLevel, alert *routingv1.Alert) error {
+	n.record("schedule:" + scheduleID)
+	return nil
+}
+
+func (n *recordingNotifier) targets() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.notified...)
+}
+
+// fakeTimers replaces time.AfterFunc so tests decide when steps fire.
+type fakeTimers struct {
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	delay   time.Duration
+	f       func()
+	stopped bool
+}
+
+func (t *fakeTimers) afterFunc(d time.Duration, f func()) func() bool {
+	timer := &fakeTimer{delay: d, f: f}
+	t.timers = append(t.timers, timer)
+	return func() bool {
+		timer.stopped = true
+		return true
+	}
+}
+
+// fireNext runs the latest scheduled timer and returns its delay.
+func (t *fakeTimers) fireNext(tb testing.TB) time.Duration {
+	tb.Helper()
+	require.NotEmpty(tb, t.timers, "no timer scheduled")
+	timer := t.timers[len(t.timers)-1]
+	require.False(tb, timer.stopped, "latest timer was stopped")
+	timer.f()
+	return timer.delay
+}
+
+func setupEngine(t *testing.T) (*Engine, *memoryAlertStore, *recordingNotifier, *fakeTimers, *EscalationPolicy) {
+	t.Helper()
+
+	policies := NewInMemoryStore()
+	policy, err := policies.CreatePolicy(context.Background(), &EscalationPolicy{
+		Name: "Network Critical",
+		Steps: []EscalationStep{
+			{Targets: []EscalationTarget{{Type: TargetTypeSchedule, ID: "schedule-1"}}},
+			{Delay: 15 * time.Minute, Targets: []EscalationTarget{
+				{Type: TargetTypeTeam, ID: "team-1"},
+				{Type: TargetTypeUser, ID: "user-1"},
+			}},
+		},
+		RepeatCount:    1,
+		RepeatInterval: time.Hour,
+	})
+	require.NoError(t, err)
+
+	alerts := &memoryAlertStore{alerts: map[string]*alertingv1.Alert{
+		"alert-1": {Id: "alert-1", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	}}
+	notifier := &recordingNotifier{}
+	timers := &fakeTimers{}
+
+	engine := NewEngine(policies, alerts, notifier, nil, zerolog.Nop())
+	engine.afterFunc = timers.afterFunc
+
+	return engine, alerts, notifier, timers, policy
+}
+
+func TestEngine_WalksStepsAndRepeats(t *testing.T) {
+	engine, _, notifier, timers, policy := setupEngine(t)
+
+	require.NoError(t, engine.Start(context.Background(), "alert-1", policy.ID, 0))
+
+	assert.Equal(t, time.Duration(0), timers.fireNext(t))
+	assert.Equal(t, []string{"schedule:schedule-1"}, notifier.targets())
+
+	assert.Equal(t, 15*time.Minute, timers.fireNext(t))
+	assert.Equal(t, []string{"schedule:schedule-1", "team:team-1", "user:user-1"}, notifier.targets())
+
+	// The policy starts over once after its repeat interval
+	assert.Equal(t, time.Hour, timers.fireNext(t))
+	assert.Equal(t, 15*time.Minute, timers.fireNext(t))
+	assert.Len(t, notifier.targets(), 6)
+
+	assert.Len(t, timers.timers, 4, "exhausted policy must not schedule more steps")
+	assert.Empty(t, engine.escalations)
+
+	metrics := engine.Metrics()
+	assert.Equal(t, int64(2), metrics.StepFiredTotal(policy.ID, 1))
+	assert.Equal(t, int64(2), metrics.StepFiredTotal(policy.ID, 2))
+}
+
+func TestEngine_CancelsOnAlertStatus(t *testing.T) {
+	for _, tt := range []struct {
+		status alertingv1.AlertStatus
+		reason string
+	}{
+		{status: alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, reason: CancelReasonAcknowledged},
+		{status: alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, reason: CancelReasonResolved},
+	} {
+		t.Run(tt.reason, func(t *testing.T) {
+			engine, alerts, notifier, timers, policy := setupEngine(t)
+
+			require.NoError(t, engine.Start(context.Background(), "alert-1", policy.ID, 0))
+			timers.fireNext(t)
+
+			alerts.setStatus("alert-1", tt.status)
+			timers.fireNext(t)
+
+			assert.Equal(t, []string{"schedule:schedule-1"}, notifier.targets())
+			assert.Len(t, timers.timers, 2)
+			assert.Empty(t, engine.escalations)
+			assert.Equal(t, int64(1), engine.Metrics().CancelledTotal(tt.reason))
+			assert.Equal(t, int64(0), engine.Metrics().StepFiredTotal(policy.ID, 2))
+		})
+	}
+}
+
+func TestEngine_OutageModeSkipsNotifications(t *testing.T) {
+	engine, _, notifier, timers, policy := setupEngine(t)
+	outageMode := outage.NewInMemoryStore()
+	WithOutageMode(outageMode, nil)(engine)
+	ctx := context.Background()
+
+	require.NoError(t, outageMode.SetOutageMode(ctx, "datacenter outage", time.Hour))
+	require.NoError(t, engine.Start(ctx, "alert-1", policy.ID, 0))
+	timers.fireNext(t)
+	assert.Empty(t, notifier.targets())
+
+	// The escalation keeps walking its steps and notifies once outage mode ends
+	require.NoError(t, outageMode.ClearOutageMode(ctx))
+	timers.fireNext(t)
+	assert.Equal(t, []string{"team:team-1", "user:user-1"}, notifier.targets())
+}
+
+func TestEngine_StartAtStep(t *testing.T) {
+	engine, _, notifier, timers, policy := setupEngine(t)
+
+	err := engine.Start(context.Background(), "alert-1", policy.ID, 3)
+	assert.ErrorIs(t, err, ErrStepOutOfRange)
+
+	require.NoError(t, engine.Start(context.Background(), "alert-1", policy.ID, 2))
+	assert.Equal(t, 15*time.Minute, timers.fireNext(t))
+	assert.Equal(t, []string{"team:team-1", "user:user-1"}, notifier.targets())
+
+	err = NewEngine(NewInMemoryStore(), nil, notifier, nil, zerolog.Nop()).
+		Start(context.Background(), "alert-1", "missing", 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEngine_UrgentEscalationSkipsDelay(t *testing.T) {
+	engine, _, _, timers, policy := setupEngine(t)
+
+	require.NoError(t, engine.Escalate(context.Background(), "alert-1", policy.ID, 2, true))
+	require.Len(t, timers.timers, 1)
+	assert.Equal(t, time.Duration(0), timers.timers[0].delay)
+}
+
+func TestEngine_RestartAndCancel(t *testing.T) {
+	engine, _, _, timers, policy := setupEngine(t)
+	ctx := context.Background()
+
+	require.NoError(t, engine.Start(ctx, "alert-1", policy.ID, 0))
+	require.NoError(t, engine.Start(ctx, "alert-1", policy.ID, 0))
+	assert.Len(t, timers.timers, 1, "starting the running policy again must be a no-op")
+
+	other := &EscalationPolicy{
+		Name:  "DC Ops",
+		Steps: []EscalationStep{{Targets: []EscalationTarget{{Type: TargetTypeTeam, ID: "team-2"}}}},
+	}
+	_, err := engine.policies.CreatePolicy(ctx, other)
+	require.NoError(t, err)
+
+	require.NoError(t, engine.Start(ctx, "alert-1", other.ID, 0))
+	require.Len(t, timers.timers, 2)
+	assert.True(t, timers.timers[0].stopped, "replaced escalation must be stopped")
+
+	assert.True(t, engine.Cancel("alert-1"))
+	assert.False(t, engine.Cancel("alert-1"))
+	assert.True(t, timers.timers[1].stopped)
+	assert.Equal(t, int64(1), engine.Metrics().CancelledTotal(CancelReasonStopped))
+
+	// A stale timer firing after cancellation does nothing
+	timers.timers[1].f()
+	assert.Len(t, timers.timers, 2)
+}
+
+func TestEngine_AlertErrors(t *testing.T) {
+	engine, alerts, notifier, timers, policy := setupEngine(t)
+
+	require.NoError(t, engine.Start(context.Background(), "alert-1", policy.ID, 0))
+
+	alerts.getErr = errors.New("database unavailable")
+	timers.fireNext(t)
+	assert.Empty(t, notifier.targets())
+	require.Len(t, timers.timers, 2)
+	assert.Equal(t, stepRetryDelay, timers.timers[1].delay)
+
+	alerts.getErr = nil
+	timers.fireNext(t)
+	assert.Equal(t, []string{"schedule:schedule-1"}, notifier.targets())
+
+	delete(alerts.alerts, "alert-1")
+	timers.fireNext(t)
+	assert.Len(t, notifier.targets(), 1)
+	assert.Empty(t, engine.escalations)
+}
+
+func TestEngine_RealTimer(t *testing.T) {
+	policies := NewInMemoryStore()
+	policy, err := policies.CreatePolicy(context.Background(), &EscalationPolicy{
+		Name: "Fast",
+		Steps: []EscalationStep{
+			{Delay: 10 * time.Millisecond, Targets: []EscalationTarget{{Type: TargetTypeUser, ID: "user-1"}}},
+		},
+	})
+	require.NoError(t, err)
+
+	alerts := &memoryAlertStore{alerts: map[string]*alertingv1.Alert{
+		"alert-1": {Id: "alert-1", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	}}
+	notifier := &recordingNotifier{}
+	engine := NewEngine(policies, alerts, notifier, nil, zerolog.Nop())
+	defer engine.Stop()
+
+	require.NoError(t, engine.Start(context.Background(), "alert-1", policy.ID, 0))
+
+	assert.Eventually(t, func() bool {
+		return fmt.Sprint(notifier.targets()) == "[user:user-1]"
+	}, 5*time.Second, 5*time.Millisecond)
+}
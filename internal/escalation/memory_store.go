@@ -0,0 +1,155 @@
+package escalation
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryStore is an in-memory implementation of EscalationStore for testing.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	policies map[string]*EscalationPolicy
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		policies: make(map[string]*EscalationPolicy),
+	}
+}
+
+// CreatePolicy creates a new escalation policy in memory.
+func (s *InMemoryStore) CreatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nameTaken(policy.Name, "") {
+		return nil, ErrDuplicateName
+	}
+
+	// Generate ID if not provided
+	if policy.ID == "" {
+		policy.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	s.policies[policy.ID] = clonePolicy(policy)
+
+	return policy, nil
+}
+
+// GetPolicyByID retrieves an escalation policy by its ID.
+func (s *InMemoryStore) GetPolicyByID(ctx context.Context, id string) (*EscalationPolicy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	policy, ok := s.policies[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return clonePolicy(policy), nil
+}
+
+// ListPolicies retrieves a page of escalation policies ordered by name.
+func (s *InMemoryStore) ListPolicies(ctx context.Context, filter *ListPoliciesFilter) ([]*EscalationPolicy, string, error) {
+	pageSize, offset := pageBounds(filter)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	policies := make([]*EscalationPolicy, 0, len(s.policies))
+	for _, policy := range s.policies {
+		policies = append(policies, clonePolicy(policy))
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	if offset >= len(policies) {
+		return nil, "", nil
+	}
+	policies = policies[offset:min(len(policies), offset+pageSize+1)]
+
+	policies, nextPageToken := paginate(policies, pageSize, offset)
+	return policies, nextPageToken, nil
+}
+
+// UpdatePolicy updates an existing escalation policy.
+func (s *InMemoryStore) UpdatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	if policy == nil || policy.ID == "" {
+		return nil, ErrInvalidPolicy
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.policies[policy.ID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if s.nameTaken(policy.Name, policy.ID) {
+		return nil, ErrDuplicateName
+	}
+
+	policy.CreatedAt = existing.CreatedAt
+	policy.UpdatedAt = time.Now()
+	s.policies[policy.ID] = clonePolicy(policy)
+
+	return policy, nil
+}
+
+// DeletePolicy deletes an escalation policy by ID.
+func (s *InMemoryStore) DeletePolicy(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.policies[id]; !ok {
+		return ErrNotFound
+	}
+
+	delete(s.policies, id)
+	return nil
+}
+
+// nameTaken reports whether a policy other than exceptID already uses name.
+// The caller must hold the lock.
+func (s *InMemoryStore) nameTaken(name, exceptID string) bool {
+	for id, policy := range s.policies {
+		if id != exceptID && policy.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// clonePolicy returns a deep copy of policy to avoid external modifications.
+func clonePolicy(policy *EscalationPolicy) *EscalationPolicy {
+	clone := *policy
+	clone.Steps = make([]EscalationStep, len(policy.Steps))
+	for i, step := range policy.Steps {
+		clone.Steps[i] = EscalationStep{
+			Delay:   step.Delay,
+			Targets: slices.Clone(step.Targets),
+		}
+	}
+	return &clone
+}
+
+// Ensure InMemoryStore implements EscalationStore
+var _ EscalationStore = (*InMemoryStore)(nil)
@@ -0,0 +1,63 @@
+package escalation
+
+import "sync"
+
+// stepKey identifies a step of an escalation policy by its 1-based number.
+type stepKey struct {
+	policyID string
+	step     int32
+}
+
+// Metrics tracks escalation step executions and cancellations.
+// Exposed as the escalation_step_fired_total{policy_id,step} and
+// escalation_cancelled_total{reason} counters.
+type Metrics struct {
+	mu            sync.RWMutex
+	stepsFired    map[stepKey]int64
+	cancellations map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		stepsFired:    make(map[stepKey]int64),
+		cancellations: make(map[string]int64),
+	}
+}
+
+// RecordStepFired increments the counter of fired steps for a policy step.
+func (m *Metrics) RecordStepFired(policyID string, step int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stepsFired[stepKey{policyID: policyID, step: step}]++
+}
+
+// StepFiredTotal returns how many times a policy step has fired.
+func (m *Metrics) StepFiredTotal(policyID string, step int32) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stepsFired[stepKey{policyID: policyID, step: step}]
+}
+
+// RecordCancelled increments the counter of escalations cancelled for a reason,
+// e.g. "acknowledged".
+func (m *Metrics) RecordCancelled(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cancellations[reason]++
+}
+
+// CancelledTotal returns how many escalations have been cancelled for a reason.
+func (m *Metrics) CancelledTotal(reason string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.cancellations[reason]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stepsFired = make(map[stepKey]int64)
+	m.cancellations = make(map[string]int64)
+}
@@ -0,0 +1,376 @@
+// Package escalation provides escalation policy management for the on-call system.
+package escalation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when an escalation policy is not found.
+	ErrNotFound = errors.New("escalation policy not found")
+	// ErrInvalidPolicy is returned when an escalation policy is invalid.
+	ErrInvalidPolicy = errors.New("invalid escalation policy")
+	// ErrDuplicateName is returned when an escalation policy name already exists.
+	ErrDuplicateName = errors.New("escalation policy name already exists")
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// TargetType represents who an escalation step notifies.
+type TargetType string
+
+const (
+	TargetTypeUser     TargetType = "user"
+	TargetTypeTeam     TargetType = "team"
+	TargetTypeSchedule TargetType = "schedule"
+)
+
+// EscalationTarget is a user, team or schedule notified by an escalation step.
+type EscalationTarget struct {
+	Type TargetType `json:"type"`
+	ID   string     `json:"id"`
+}
+
+// EscalationStep notifies its targets once its delay has passed since the
+// previous step, or since the escalation started for the first step.
+type EscalationStep struct {
+	Delay   time.Duration      `json:"delay"`
+	Targets []EscalationTarget `json:"targets"`
+}
+
+// EscalationPolicy is an ordered list of escalation steps. Once the last step
+// has run, the policy starts over after RepeatInterval, up to RepeatCount times.
+type EscalationPolicy struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	Description    string           `json:"description"`
+	Steps          []EscalationStep `json:"steps"`
+	RepeatCount    int32            `json:"repeatCount"`
+	RepeatInterval time.Duration    `json:"repeatInterval"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+// Validate checks that the policy has a name and at least one step, and that
+// every step notifies at least one valid target.
+func (p *EscalationPolicy) Validate() error {
+	if p == nil {
+		return ErrInvalidPolicy
+	}
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPolicy)
+	}
+	if len(p.Steps) == 0 {
+		return fmt.Errorf("%w: at least one step is required", ErrInvalidPolicy)
+	}
+	if p.RepeatCount < 0 {
+		return fmt.Errorf("%w: repeat count must not be negative", ErrInvalidPolicy)
+	}
+	if p.RepeatInterval < 0 {
+		return fmt.Errorf("%w: repeat interval must not be negative", ErrInvalidPolicy)
+	}
+
+	for i, step := range p.Steps {
+		if step.Delay < 0 {
+			return fmt.Errorf("%w: step %d: delay must not be negative", ErrInvalidPolicy, i+1)
+		}
+		if len(step.Targets) == 0 {
+			return fmt.Errorf("%w: step %d: at least one target is required", ErrInvalidPolicy, i+1)
+		}
+		for _, target := range step.Targets {
+			switch target.Type {
+			case TargetTypeUser, TargetTypeTeam, TargetTypeSchedule:
+			default:
+				return fmt.Errorf("%w: step %d: unknown target type %q", ErrInvalidPolicy, i+1, target.Type)
+			}
+			if target.ID == "" {
+				return fmt.Errorf("%w: step %d: %s target id is required", ErrInvalidPolicy, i+1, target.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListPoliciesFilter defines paging for listing escalation policies.
+type ListPoliciesFilter struct {
+	PageSize  int
+	PageToken string
+}
+
+// EscalationStore defines the interface for escalation policy persistence.
+type EscalationStore interface {
+	// CreatePolicy creates a new escalation policy.
+	CreatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error)
+
+	// GetPolicyByID retrieves an escalation policy by its ID.
+	GetPolicyByID(ctx context.Context, id string) (*EscalationPolicy, error)
+
+	// ListPolicies retrieves a page of escalation policies ordered by name and
+	// the token of the next page, which is empty on the last page.
+	ListPolicies(ctx context.Context, filter *ListPoliciesFilter) ([]*EscalationPolicy, string, error)
+
+	// UpdatePolicy updates an existing escalation policy.
+	UpdatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error)
+
+	// DeletePolicy deletes an escalation policy by ID.
+	DeletePolicy(ctx context.Context, id string) error
+}
+
+// PostgresStore implements EscalationStore using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// stepRecord is the JSON form of an EscalationStep in the steps column, with
+// the delay stored in milliseconds.
+type stepRecord struct {
+	DelayMs int64              `json:"delayMs"`
+	Targets []EscalationTarget `json:"targets"`
+}
+
+// CreatePolicy creates a new escalation policy in the database.
+func (s *PostgresStore) CreatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Generate ID if not provided
+	if policy.ID == "" {
+		policy.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	stepsJSON, err := marshalSteps(policy.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO escalation_policies (
+			id, name, description, steps, repeat_count, repeat_interval_ms, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, policy.ID, policy.Name, nullableString(policy.Description), stepsJSON,
+		policy.RepeatCount, policy.RepeatInterval.Milliseconds(), policy.CreatedAt, policy.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateName
+		}
+		return nil, fmt.Errorf("insert escalation policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// GetPolicyByID retrieves an escalation policy by its ID.
+func (s *PostgresStore) GetPolicyByID(ctx context.Context, id string) (*EscalationPolicy, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, name, description, steps, repeat_count, repeat_interval_ms, created_at, updated_at
+		FROM escalation_policies WHERE id = $1
+	`, id)
+
+	policy, err := scanPolicy(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query escalation policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// ListPolicies retrieves a page of escalation policies ordered by name.
+func (s *PostgresStore) ListPolicies(ctx context.Context, filter *ListPoliciesFilter) ([]*EscalationPolicy, string, error) {
+	pageSize, offset := pageBounds(filter)
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, name, description, steps, repeat_count, repeat_interval_ms, created_at, updated_at
+		FROM escalation_policies
+		ORDER BY name ASC
+		LIMIT $1 OFFSET $2
+	`, pageSize+1, offset)
+	if err != nil {
+		return nil, "", fmt.Errorf("query escalation policies: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var policies []*EscalationPolicy
+	for rows.Next() {
+		policy, err := scanPolicy(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("scan escalation policy: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	policies, nextPageToken := paginate(policies, pageSize, offset)
+	return policies, nextPageToken, nil
+}
+
+// UpdatePolicy updates an existing escalation policy.
+func (s *PostgresStore) UpdatePolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	if policy == nil || policy.ID == "" {
+		return nil, ErrInvalidPolicy
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	policy.UpdatedAt = time.Now()
+
+	stepsJSON, err := marshalSteps(policy.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE escalation_policies SET
+			name = $1, description = $2, steps = $3, repeat_count = $4,
+			repeat_interval_ms = $5, updated_at = $6
+		WHERE id = $7
+	`, policy.Name, nullableString(policy.Description), stepsJSON, policy.RepeatCount,
+		policy.RepeatInterval.Milliseconds(), policy.UpdatedAt, policy.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateName
+		}
+		return nil, fmt.Errorf("update escalation policy: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return policy, nil
+}
+
+// DeletePolicy deletes an escalation policy by ID. Teams and sites using it as
+// their default escalation policy are left without one.
+func (s *PostgresStore) DeletePolicy(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM escalation_policies WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete escalation policy: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPolicy(row rowScanner) (*EscalationPolicy, error) {
+	policy := &EscalationPolicy{}
+	var description sql.NullString
+	var stepsJSON []byte
+	var repeatIntervalMs int64
+
+	if err := row.Scan(
+		&policy.ID, &policy.Name, &description, &stepsJSON, &policy.RepeatCount,
+		&repeatIntervalMs, &policy.CreatedAt, &policy.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	policy.Description = description.String
+	policy.RepeatInterval = time.Duration(repeatIntervalMs) * time.Millisecond
+
+	if stepsJSON != nil {
+		var records []stepRecord
+		if err := json.Unmarshal(stepsJSON, &records); err != nil {
+			return nil, fmt.Errorf("unmarshal steps: %w", err)
+		}
+		policy.Steps = make([]EscalationStep, len(records))
+		for i, record := range records {
+			policy.Steps[i] = EscalationStep{
+				Delay:   time.Duration(record.DelayMs) * time.Millisecond,
+				Targets: record.Targets,
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// Helper functions
+
+func marshalSteps(steps []EscalationStep) ([]byte, error) {
+	records := make([]stepRecord, len(steps))
+	for i, step := range steps {
+		records[i] = stepRecord{DelayMs: step.Delay.Milliseconds(), Targets: step.Targets}
+	}
+
+	stepsJSON, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("marshal steps: %w", err)
+	}
+	return stepsJSON, nil
+}
+
+func isUniqueViolation(err error) bool {
+	return strings.Contains(err.Error(), "unique") || strings.Contains(err.Error(), "duplicate")
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// pageBounds returns the page size and offset requested by filter.
+func pageBounds(filter *ListPoliciesFilter) (int, int) {
+	pageSize := defaultPageSize
+	if filter != nil && filter.PageSize > 0 && filter.PageSize <= maxPageSize {
+		pageSize = filter.PageSize
+	}
+
+	offset := 0
+	if filter != nil && filter.PageToken != "" {
+		_, _ = fmt.Sscanf(filter.PageToken, "%d", &offset)
+	}
+	return pageSize, offset
+}
+
+// paginate trims policies, fetched with one extra row, to pageSize and returns
+// the token of the next page if there is one.
+func paginate(policies []*EscalationPolicy, pageSize, offset int) ([]*EscalationPolicy, string) {
+	if len(policies) <= pageSize {
+		return policies, ""
+	}
+	return policies[:pageSize], fmt.Sprintf("%d", offset+pageSize)
+}
+
+// Ensure PostgresStore implements EscalationStore
+var _ EscalationStore = (*PostgresStore)(nil)
@@ -0,0 +1,269 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPolicy(name string) *EscalationPolicy {
+	return &EscalationPolicy{
+		Name: name,
+		Steps: []EscalationStep{
+			{Targets: []EscalationTarget{{Type: TargetTypeSchedule, ID: "schedule-1"}}},
+			{Delay: 15 * time.Minute, Targets: []EscalationTarget{
+				{Type: TargetTypeTeam, ID: "team-1"},
+				{Type: TargetTypeUser, ID: "user-1"},
+			}},
+		},
+		RepeatCount:    2,
+		RepeatInterval: 30 * time.Minute,
+	}
+}
+
+func TestEscalationPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *EscalationPolicy)
+		valid  bool
+	}{
+		{name: "valid policy", modify: func(p *EscalationPolicy) {}, valid: true},
+		{name: "missing name", modify: func(p *EscalationPolicy) { p.Name = "" }},
+		{name: "no steps", modify: func(p *EscalationPolicy) { p.Steps = nil }},
+		{name: "negative repeat count", modify: func(p *EscalationPolicy) { p.RepeatCount = -1 }},
+		{name: "negative repeat interval", modify: func(p *EscalationPolicy) { p.RepeatInterval = -time.Second }},
+		{name: "negative step delay", modify: func(p *EscalationPolicy) { p.Steps[1].Delay = -time.Second }},
+		{name: "step without targets", modify: func(p *EscalationPolicy) { p.Steps[0].Targets = nil }},
+		{name: "unknown target type", modify: func(p *EscalationPolicy) { p.Steps[0].Targets[0].Type = "channel" }},
+		{name: "target without id", modify: func(p *EscalationPolicy) { p.Steps[0].Targets[0].ID = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestPolicy("Network Critical")
+			tt.modify(policy)
+
+			err := policy.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidPolicy)
+			}
+		})
+	}
+
+	var policy *EscalationPolicy
+	assert.ErrorIs(t, policy.Validate(), ErrInvalidPolicy)
+}
+
+func TestInMemoryStore_CRUD(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	created, err := store.CreatePolicy(ctx, newTestPolicy("Network Critical"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.NotZero(t, created.CreatedAt)
+
+	_, err = store.CreatePolicy(ctx, newTestPolicy("Network Critical"))
+	assert.ErrorIs(t, err, ErrDuplicateName)
+
+	_, err = store.CreatePolicy(ctx, &EscalationPolicy{Name: "No Steps"})
+	assert.ErrorIs(t, err, ErrInvalidPolicy)
+
+	fetched, err := store.GetPolicyByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Name, fetched.Name)
+	assert.Equal(t, created.Steps, fetched.Steps)
+	assert.Equal(t, int32(2), fetched.RepeatCount)
+	assert.Equal(t, 30*time.Minute, fetched.RepeatInterval)
+
+	// Changes to a fetched policy must not leak into the store
+	fetched.Steps[0].Targets[0].ID = "schedule-2"
+	again, err := store.GetPolicyByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "schedule-1", again.Steps[0].Targets[0].ID)
+
+	other, err := store.CreatePolicy(ctx, newTestPolicy("DC Ops"))
+	require.NoError(t, err)
+
+	fetched.Name = "DC Ops"
+	_, err = store.UpdatePolicy(ctx, fetched)
+	assert.ErrorIs(t, err, ErrDuplicateName)
+
+	fetched.Name = "Network Critical v2"
+	fetched.RepeatCount = 0
+	updated, err := store.UpdatePolicy(ctx, fetched)
+	require.NoError(t, err)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+	assert.Equal(t, "schedule-2", updated.Steps[0].Targets[0].ID)
+
+	_, err = store.UpdatePolicy(ctx, &EscalationPolicy{ID: "missing", Name: "Missing", Steps: fetched.Steps})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.DeletePolicy(ctx, other.ID))
+	assert.ErrorIs(t, store.DeletePolicy(ctx, other.ID), ErrNotFound)
+
+	_, err = store.GetPolicyByID(ctx, other.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestInMemoryStore_ListPolicies(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	for _, name := range []string{"Charlie", "Alpha", "Echo", "Bravo", "Delta"} {
+		_, err := store.CreatePolicy(ctx, newTestPolicy(name))
+		require.NoError(t, err)
+	}
+
+	var names []string
+	token := ""
+	pages := 0
+	for {
+		policies, next, err := store.ListPolicies(ctx, &ListPoliciesFilter{PageSize: 2, PageToken: token})
+		require.NoError(t, err)
+		pages++
+		for _, p := range policies {
+			names = append(names, p.Name)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	assert.Equal(t, []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo"}, names)
+	assert.Equal(t, 3, pages)
+
+	policies, next, err := store.ListPolicies(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, policies, 5)
+	assert.Empty(t, next)
+}
+
+func TestPostgresStore_CreatePolicy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+
+	mock.ExpectExec(`INSERT INTO escalation_policies`).
+		WithArgs(sqlmock.AnyArg(), "Network Critical", nil,
+			[]byte(`[{"delayMs":0,"targets":[{"type":"schedule","id":"schedule-1"}]},`+
+				`{"delayMs":900000,"targets":[{"type":"team","id":"team-1"},{"type":"user","id":"user-1"}]}]`),
+			int32(2), int64(1800000), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	created, err := store.CreatePolicy(context.Background(), newTestPolicy("Network Critical"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	mock.ExpectExec(`INSERT INTO escalation_policies`).
+		WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "escalation_policies_name_key"`))
+
+	_, err = store.CreatePolicy(context.Background(), newTestPolicy("Network Critical"))
+	assert.ErrorIs(t, err, ErrDuplicateName)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_GetPolicyByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "description", "steps", "repeat_count", "repeat_interval_ms", "created_at", "updated_at",
+	}).AddRow(
+		"policy-1", "Network Critical", nil,
+		[]byte(`[{"delayMs":0,"targets":[{"type":"schedule","id":"schedule-1"}]},{"delayMs":900000,"targets":[{"type":"team","id":"team-1"}]}]`),
+		int32(1), int64(600000), now, now,
+	)
+	mock.ExpectQuery(`FROM escalation_policies WHERE id = \$1`).
+		WithArgs("policy-1").
+		WillReturnRows(rows)
+
+	policy, err := store.GetPolicyByID(context.Background(), "policy-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Network Critical", policy.Name)
+	assert.Empty(t, policy.Description)
+	assert.Equal(t, int32(1), policy.RepeatCount)
+	assert.Equal(t, 10*time.Minute, policy.RepeatInterval)
+	require.Len(t, policy.Steps, 2)
+	assert.Zero(t, policy.Steps[0].Delay)
+	assert.Equal(t, 15*time.Minute, policy.Steps[1].Delay)
+	assert.Equal(t, []EscalationTarget{{Type: TargetTypeTeam, ID: "team-1"}}, policy.Steps[1].Targets)
+
+	mock.ExpectQuery(`FROM escalation_policies WHERE id = \$1`).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = store.GetPolicyByID(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_ListPolicies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	columns := []string{"id", "name", "description", "steps", "repeat_count", "repeat_interval_ms", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow("policy-1", "Alpha", nil, []byte(`[]`), int32(0), int64(0), now, now).
+		AddRow("policy-2", "Bravo", "Second", []byte(`[]`), int32(0), int64(0), now, now).
+		AddRow("policy-3", "Charlie", nil, []byte(`[]`), int32(0), int64(0), now, now)
+
+	mock.ExpectQuery(`FROM escalation_policies\s+ORDER BY name ASC\s+LIMIT \$1 OFFSET \$2`).
+		WithArgs(3, 4).
+		WillReturnRows(rows)
+
+	policies, next, err := store.ListPolicies(context.Background(), &ListPoliciesFilter{PageSize: 2, PageToken: "4"})
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "Second", policies[1].Description)
+	assert.Equal(t, "6", next)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_UpdateAndDeletePolicy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	policy := newTestPolicy("Network Critical")
+	policy.ID = "policy-1"
+
+	mock.ExpectExec(`UPDATE escalation_policies SET`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err = store.UpdatePolicy(context.Background(), policy)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	mock.ExpectExec(`DELETE FROM escalation_policies WHERE id = \$1`).
+		WithArgs("policy-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.DeletePolicy(context.Background(), "policy-1"))
+
+	_, err = store.UpdatePolicy(context.Background(), &EscalationPolicy{Name: "No ID"})
+	assert.ErrorIs(t, err, ErrInvalidPolicy)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,97 @@
+package forwarding
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFailureThreshold is how many consecutive failures open a destination's circuit.
+	DefaultFailureThreshold = 5
+	// DefaultBreakerCooldown is how long an open circuit waits before allowing a trial request.
+	DefaultBreakerCooldown = time.Minute
+)
+
+// CircuitBreaker stops forwarding to a destination after consecutive failures.
+// Once the cooldown has elapsed a single trial request is allowed; a success
+// closes the circuit and another failure keeps it open for a further cooldown.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  map[string]int
+	openedAt  map[string]time.Time
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a breaker that opens after threshold consecutive failures.
+// Non-positive values use DefaultFailureThreshold and DefaultBreakerCooldown.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = DefaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultBreakerCooldown
+	}
+
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		failures:  make(map[string]int),
+		openedAt:  make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether a request may be sent to the destination.
+func (b *CircuitBreaker) Allow(destination string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	openedAt, open := b.openedAt[destination]
+	if !open {
+		return true
+	}
+
+	now := b.now()
+	if now.Sub(openedAt) < b.cooldown {
+		return false
+	}
+
+	// Half-open: let this request through and hold the others for another cooldown.
+	b.openedAt[destination] = now
+	return true
+}
+
+// RecordSuccess closes the destination's circuit.
+func (b *CircuitBreaker) RecordSuccess(destination string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.failures, destination)
+	delete(b.openedAt, destination)
+}
+
+// RecordFailure counts a failure and reports whether it opened the destination's circuit.
+func (b *CircuitBreaker) RecordFailure(destination string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures[destination]++
+	if b.failures[destination] < b.threshold {
+		return false
+	}
+
+	_, wasOpen := b.openedAt[destination]
+	b.openedAt[destination] = b.now()
+	return !wasOpen
+}
+
+// IsOpen reports whether forwarding to the destination is currently stopped.
+func (b *CircuitBreaker) IsOpen(destination string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, open := b.openedAt[destination]
+	return open
+}
@@ -0,0 +1,200 @@
+package forwarding
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// DefaultMaxLatency bounds forwarding requests of rules without MaxLatencyMs.
+	DefaultMaxLatency = 5 * time.Second
+
+	// SignatureHeader carries the hex HMAC-SHA256 of the request body, prefixed with "sha256=".
+	SignatureHeader = "X-Forwarding-Signature"
+	// RuleIDHeader carries the ID of the forwarding rule that matched the alert.
+	RuleIDHeader = "X-Forwarding-Rule-Id"
+)
+
+// Payload is the body POSTed to a destination. It follows the generic webhook
+// payload so the destination can be another instance's
+// /api/v1/webhook/generic/:integration_key endpoint. The fingerprint is kept so
+// the destination deduplicates repeated forwards of the same alert.
+type Payload struct {
+	Summary     string            `json:"summary"`
+	Details     string            `json:"details,omitempty"`
+	Severity    string            `json:"severity,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Fingerprint string            `json:"fingerprint,omitempty"`
+	Source      string            `json:"source,omitempty"`
+	Timestamp   *time.Time        `json:"timestamp,omitempty"`
+}
+
+// NewPayload converts an alert to the forwarded payload.
+func NewPayload(alert *alertingv1.Alert) *Payload {
+	payload := &Payload{
+		Summary:     alert.Summary,
+		Details:     alert.Details,
+		Severity:    strings.ToLower(strings.TrimPrefix(alert.Severity.String(), "SEVERITY_")),
+		Status:      strings.ToLower(strings.TrimPrefix(alert.Status.String(), "ALERT_STATUS_")),
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		Fingerprint: alert.Fingerprint,
+		Source:      strings.ToLower(strings.TrimPrefix(alert.Source.String(), "ALERT_SOURCE_")),
+	}
+	if alert.TriggeredAt != nil {
+		triggeredAt := alert.TriggeredAt.AsTime()
+		payload.Timestamp = &triggeredAt
+	}
+	return payload
+}
+
+// Sign returns the value of SignatureHeader for a body signed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Forwarder POSTs ingested alerts to the destinations of matching forwarding rules.
+// Requests are sent asynchronously so forwarding never delays ingestion, and each
+// destination is guarded by a circuit breaker.
+type Forwarder struct {
+	rules   Store
+	client  *http.Client
+	breaker *CircuitBreaker
+	metrics *Metrics
+	logger  zerolog.Logger
+
+	// inflight tracks asynchronous forwarding requests
+	inflight sync.WaitGroup
+}
+
+// NewForwarder creates a forwarder that stops forwarding to a destination after
+// DefaultFailureThreshold consecutive failures.
+func NewForwarder(rules Store, logger zerolog.Logger, metrics *Metrics) *Forwarder {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &Forwarder{
+		rules:   rules,
+		client:  &http.Client{},
+		breaker: NewCircuitBreaker(DefaultFailureThreshold, DefaultBreakerCooldown),
+		metrics: metrics,
+		logger:  logger.With().Str("component", "alert_forwarder").Logger(),
+	}
+}
+
+// Metrics returns the metrics recorder for this forwarder.
+func (f *Forwarder) Metrics() *Metrics {
+	return f.metrics
+}
+
+// Forward sends the alert to the destination of every active rule matching its
+// labels. It returns once the requests are started; use Wait to block until
+// they finish.
+func (f *Forwarder) Forward(ctx context.Context, alert *alertingv1.Alert) error {
+	if alert == nil {
+		return nil
+	}
+
+	rules, err := f.rules.ListActive(ctx)
+	if err != nil {
+		return fmt.Errorf("list forwarding rules: %w", err)
+	}
+
+	var body []byte
+	for _, rule := range rules {
+		if !rule.Matches(alert.Labels) {
+			continue
+		}
+		if body == nil {
+			if body, err = json.Marshal(NewPayload(alert)); err != nil {
+				return fmt.Errorf("marshal forwarded alert: %w", err)
+			}
+		}
+
+		f.inflight.Add(1)
+		go func(rule *ForwardingRule) {
+			defer f.inflight.Done()
+			// The ingestion request may finish before the forward does.
+			f.send(context.WithoutCancel(ctx), rule, alert.Fingerprint, body)
+		}(rule)
+	}
+	return nil
+}
+
+// Wait blocks until all started forwarding requests have finished.
+func (f *Forwarder) Wait() {
+	f.inflight.Wait()
+}
+
+// send POSTs the body to the rule's destination unless its circuit is open.
+func (f *Forwarder) send(ctx context.Context, rule *ForwardingRule, fingerprint string, body []byte) {
+	destination := rule.DestinationURL
+	if !f.breaker.Allow(destination) {
+		f.logger.Debug().
+			Str("destination", destination).
+			Str("fingerprint", fingerprint).
+			Msg("skipping forward to destination with open circuit")
+		return
+	}
+
+	f.metrics.RecordAttempt(destination)
+	if err := f.post(ctx, rule, body); err != nil {
+		f.metrics.RecordFailure(destination)
+		opened := f.breaker.RecordFailure(destination)
+		f.logger.Warn().Err(err).
+			Str("ruleId", rule.ID).
+			Str("destination", destination).
+			Str("fingerprint", fingerprint).
+			Bool("circuitOpened", opened).
+			Msg("failed to forward alert")
+		return
+	}
+	f.breaker.RecordSuccess(destination)
+}
+
+// post sends a single forwarding request bounded by the rule's max latency.
+func (f *Forwarder) post(ctx context.Context, rule *ForwardingRule, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, rule.Timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.DestinationURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build forwarding request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(RuleIDHeader, rule.ID)
+	if rule.HMACSecret != "" {
+		req.Header.Set(SignatureHeader, Sign(rule.HMACSecret, body))
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("forwarding request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("destination returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package forwarding
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// receivedRequest is a forwarding request captured by a test destination.
+type receivedRequest struct {
+	body      []byte
+	signature string
+	ruleID    string
+}
+
+// testDestination records forwarding requests and replies with a fixed status.
+type testDestination struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []receivedRequest
+}
+
+func newTestDestination(t *testing.T, status int) *testDestination {
+	t.Helper()
+	d := &testDestination{status: status}
+	d.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		d.mu.Lock()
+		d.requests = append(d.requests, receivedRequest{
+			body:      body,
+			signature: r.Header.Get(SignatureHeader),
+			ruleID:    r.Header.Get(RuleIDHeader),
+		})
+		status := d.status
+		d.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(d.Close)
+	return d
+}
+
+func (d *testDestination) received() []receivedRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]receivedRequest(nil), d.requests...)
+}
+
+func newTestForwarder(t *testing.T, rules ...*ForwardingRule) *Forwarder {
+	t.Helper()
+	store := NewInMemoryStore()
+	for _, rule := range rules {
+		_, err := store.Create(context.Background(), rule)
+		require.NoError(t, err)
+	}
+	return NewForwarder(store, zerolog.Nop(), nil)
+}
+
+func testAlert(labels map[string]string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Id:          "alert-1",
+		Fingerprint: "fp-1",
+		Summary:     "Disk full",
+		Severity:    alertingv1.Severity_SEVERITY_CRITICAL,
+		Status:      alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_GRAFANA,
+		ServiceId:   "svc-123",
+		Labels:      labels,
+		TriggeredAt: timestamppb.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestForwarder_ForwardsMatchingAlerts(t *testing.T) {
+	matching := newTestDestination(t, http.StatusOK)
+	other := newTestDestination(t, http.StatusOK)
+
+	forwarder := newTestForwarder(t,
+		&ForwardingRule{
+			ID:                  "rule-eu",
+			Name:                "eu replica",
+			SourceLabelMatchers: map[string]string{"region": "eu-west-1"},
+			DestinationURL:      matching.URL,
+			HMACSecret:          "s3cret",
+			Enabled:             true,
+		},
+		&ForwardingRule{
+			Name:                "us replica",
+			SourceLabelMatchers: map[string]string{"region": "us-east-1"},
+			DestinationURL:      other.URL,
+			Enabled:             true,
+		},
+	)
+
+	err := forwarder.Forward(context.Background(), testAlert(map[string]string{"region": "eu-west-1"}))
+	require.NoError(t, err)
+	forwarder.Wait()
+
+	requests := matching.received()
+	require.Len(t, requests, 1)
+	assert.Empty(t, other.received())
+
+	assert.Equal(t, "rule-eu", requests[0].ruleID)
+	assert.Equal(t, Sign("s3cret", requests[0].body), requests[0].signature)
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(requests[0].body, &payload))
+	assert.Equal(t, "Disk full", payload.Summary)
+	assert.Equal(t, "critical", payload.Severity)
+	assert.Equal(t, "triggered", payload.Status)
+	assert.Equal(t, "grafana", payload.Source)
+	assert.Equal(t, "fp-1", payload.Fingerprint)
+	assert.Equal(t, "eu-west-1", payload.Labels["region"])
+
+	assert.Equal(t, int64(1), forwarder.Metrics().AttemptsTotal(matching.URL))
+	assert.Equal(t, int64(0), forwarder.Metrics().FailuresTotal(matching.URL))
+	assert.Equal(t, int64(0), forwarder.Metrics().AttemptsTotal(other.URL))
+}
+
+func TestForwarder_SkipsDisabledRules(t *testing.T) {
+	destination := newTestDestination(t, http.StatusOK)
+	forwarder := newTestForwarder(t, &ForwardingRule{
+		Name:           "disabled",
+		DestinationURL: destination.URL,
+	})
+
+	require.NoError(t, forwarder.Forward(context.Background(), testAlert(nil)))
+	forwarder.Wait()
+
+	assert.Empty(t, destination.received())
+}
+
+func TestForwarder_CircuitOpensAfterConsecutiveFailures(t *testing.T) {
+	destination := newTestDestination(t, http.StatusServiceUnavailable)
+	forwarder := newTestForwarder(t, &ForwardingRule{
+		Name:           "flaky replica",
+		DestinationURL: destination.URL,
+		Enabled:        true,
+	})
+
+	for i := 0; i < DefaultFailureThreshold+3; i++ {
+		require.NoError(t, forwarder.Forward(context.Background(), testAlert(nil)))
+		forwarder.Wait()
+	}
+
+	assert.Len(t, destination.received(), DefaultFailureThreshold)
+	assert.Equal(t, int64(DefaultFailureThreshold), forwarder.Metrics().AttemptsTotal(destination.URL))
+	assert.Equal(t, int64(DefaultFailureThreshold), forwarder.Metrics().FailuresTotal(destination.URL))
+	assert.True(t, forwarder.breaker.IsOpen(destination.URL))
+}
+
+func TestForwarder_TimesOutSlowDestinations(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	forwarder := newTestForwarder(t, &ForwardingRule{
+		Name:           "slow replica",
+		DestinationURL: slow.URL,
+		MaxLatencyMs:   20,
+		Enabled:        true,
+	})
+
+	require.NoError(t, forwarder.Forward(context.Background(), testAlert(nil)))
+	forwarder.Wait()
+
+	assert.Equal(t, int64(1), forwarder.Metrics().FailuresTotal(slow.URL))
+}
+
+func TestCircuitBreaker_HalfOpensAfterCooldown(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	assert.False(t, breaker.RecordFailure("dest"))
+	assert.True(t, breaker.RecordFailure("dest"))
+	assert.False(t, breaker.Allow("dest"))
+
+	now = now.Add(time.Minute)
+	assert.True(t, breaker.Allow("dest"), "trial request after cooldown")
+	assert.False(t, breaker.Allow("dest"), "only one trial request")
+
+	assert.False(t, breaker.RecordFailure("dest"), "failed trial keeps the circuit open")
+	assert.False(t, breaker.Allow("dest"))
+
+	now = now.Add(time.Minute)
+	assert.True(t, breaker.Allow("dest"))
+	breaker.RecordSuccess("dest")
+	assert.False(t, breaker.IsOpen("dest"))
+	assert.True(t, breaker.Allow("dest"))
+}
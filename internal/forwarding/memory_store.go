@@ -0,0 +1,105 @@
+package forwarding
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryStore is an in-memory implementation of Store for testing.
+type InMemoryStore struct {
+	mu    sync.RWMutex
+	rules map[string]*ForwardingRule
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		rules: make(map[string]*ForwardingRule),
+	}
+}
+
+// Create creates a new forwarding rule in memory.
+func (s *InMemoryStore) Create(ctx context.Context, rule *ForwardingRule) (*ForwardingRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rule.ID == "" {
+		rule.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	s.rules[rule.ID] = rule
+	return rule, nil
+}
+
+// Get retrieves an forwarding rule by ID.
+func (s *InMemoryStore) Get(ctx context.Context, id string) (*ForwardingRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rule, ok := s.rules[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return rule, nil
+}
+
+// List retrieves all forwarding rules ordered by creation time.
+func (s *InMemoryStore) List(ctx context.Context) ([]*ForwardingRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rules := make([]*ForwardingRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+	sortRules(rules)
+	return rules, nil
+}
+
+// Delete deletes an forwarding rule by ID.
+func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rules[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.rules, id)
+	return nil
+}
+
+// ListActive retrieves all enabled forwarding rules.
+func (s *InMemoryStore) ListActive(ctx context.Context) ([]*ForwardingRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rules []*ForwardingRule
+	for _, rule := range s.rules {
+		if rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	sortRules(rules)
+	return rules, nil
+}
+
+func sortRules(rules []*ForwardingRule) {
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+	})
+}
+
+// Ensure InMemoryStore implements Store
+var _ Store = (*InMemoryStore)(nil)
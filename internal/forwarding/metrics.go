@@ -0,0 +1,61 @@
+package forwarding
+
+import (
+	"sync"
+)
+
+// Metrics tracks alert forwarding metrics.
+// Exposed as the forwarding_attempts_total{destination} and
+// forwarding_failures_total{destination} counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// attempts counts forwarding requests sent, by destination URL.
+	attempts map[string]int64
+	// failures counts forwarding requests that errored or were rejected, by destination URL.
+	failures map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		attempts: make(map[string]int64),
+		failures: make(map[string]int64),
+	}
+}
+
+// RecordAttempt increments the attempts counter for a destination.
+func (m *Metrics) RecordAttempt(destination string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempts[destination]++
+}
+
+// AttemptsTotal returns the number of forwarding attempts to a destination.
+func (m *Metrics) AttemptsTotal(destination string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.attempts[destination]
+}
+
+// RecordFailure increments the failures counter for a destination.
+func (m *Metrics) RecordFailure(destination string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures[destination]++
+}
+
+// FailuresTotal returns the number of failed forwarding attempts to a destination.
+func (m *Metrics) FailuresTotal(destination string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.failures[destination]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempts = make(map[string]int64)
+	m.failures = make(map[string]int64)
+}
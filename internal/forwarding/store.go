@@ -0,0 +1,231 @@
+// Package forwarding provides alert forwarding rules, which replicate matching
+// alerts to another alerting system instance for disaster recovery or
+// multi-region synchronization.
+package forwarding
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when a forwarding rule is not found.
+	ErrNotFound = errors.New("forwarding rule not found")
+	// ErrInvalidRule is returned when a forwarding rule is invalid.
+	ErrInvalidRule = errors.New("invalid forwarding rule")
+)
+
+// ForwardingRule forwards ingested alerts whose labels match SourceLabelMatchers
+// to DestinationURL. A rule without matchers forwards every alert.
+type ForwardingRule struct {
+	ID                  string            `json:"id"`
+	Name                string            `json:"name"`
+	SourceLabelMatchers map[string]string `json:"sourceLabelMatchers"`
+	DestinationURL      string            `json:"destinationUrl"`
+	// HMACSecret signs the forwarded payload; empty disables signing.
+	HMACSecret string `json:"-"`
+	// MaxLatencyMs bounds each forwarding request; zero uses DefaultMaxLatency.
+	MaxLatencyMs int64     `json:"maxLatencyMs"`
+	Enabled      bool      `json:"enabled"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Matches reports whether the alert labels contain every source label matcher.
+func (r *ForwardingRule) Matches(labels map[string]string) bool {
+	for k, v := range r.SourceLabelMatchers {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Timeout returns how long a forwarding request for this rule may take.
+func (r *ForwardingRule) Timeout() time.Duration {
+	if r.MaxLatencyMs <= 0 {
+		return DefaultMaxLatency
+	}
+	return time.Duration(r.MaxLatencyMs) * time.Millisecond
+}
+
+// Store defines the interface for forwarding rule persistence.
+type Store interface {
+	// Create creates a new forwarding rule.
+	Create(ctx context.Context, rule *ForwardingRule) (*ForwardingRule, error)
+
+	// Get retrieves a forwarding rule by ID.
+	Get(ctx context.Context, id string) (*ForwardingRule, error)
+
+	// List retrieves all forwarding rules.
+	List(ctx context.Context) ([]*ForwardingRule, error)
+
+	// Delete deletes a forwarding rule by ID.
+	Delete(ctx context.Context, id string) error
+
+	// ListActive retrieves all enabled forwarding rules.
+	ListActive(ctx context.Context) ([]*ForwardingRule, error)
+}
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// validateRule checks that a rule can be stored.
+func validateRule(rule *ForwardingRule) error {
+	if rule == nil {
+		return ErrInvalidRule
+	}
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	destination, err := url.Parse(rule.DestinationURL)
+	if err != nil || (destination.Scheme != "http" && destination.Scheme != "https") || destination.Host == "" {
+		return fmt.Errorf("%w: destination url must be an absolute http(s) url", ErrInvalidRule)
+	}
+	if rule.MaxLatencyMs < 0 {
+		return fmt.Errorf("%w: max latency must not be negative", ErrInvalidRule)
+	}
+	return nil
+}
+
+// Create creates a new forwarding rule in the database.
+func (s *PostgresStore) Create(ctx context.Context, rule *ForwardingRule) (*ForwardingRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if rule.ID == "" {
+		rule.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	matchers := rule.SourceLabelMatchers
+	if matchers == nil {
+		matchers = map[string]string{}
+	}
+	matchersJSON, err := json.Marshal(matchers)
+	if err != nil {
+		return nil, fmt.Errorf("marshal source label matchers: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO alert_forwarding_rules (
+			id, name, source_label_matchers, destination_url, hmac_secret, max_latency_ms,
+			enabled, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, rule.ID, rule.Name, matchersJSON, rule.DestinationURL, rule.HMACSecret, rule.MaxLatencyMs,
+		rule.Enabled, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert forwarding rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// Get retrieves a forwarding rule by ID.
+func (s *PostgresStore) Get(ctx context.Context, id string) (*ForwardingRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+` WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query forwarding rule: %w", err)
+	}
+	defer rows.Close()
+
+	rules, err := scanRules(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, ErrNotFound
+	}
+	return rules[0], nil
+}
+
+// List retrieves all forwarding rules.
+func (s *PostgresStore) List(ctx context.Context) ([]*ForwardingRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+` ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("list forwarding rules: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+// Delete deletes a forwarding rule by ID.
+func (s *PostgresStore) Delete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM alert_forwarding_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete forwarding rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListActive retrieves all enabled forwarding rules.
+func (s *PostgresStore) ListActive(ctx context.Context) ([]*ForwardingRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+`
+		WHERE enabled = true
+		ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("list active forwarding rules: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+const selectRuleColumns = `
+	SELECT id, name, source_label_matchers, destination_url, hmac_secret, max_latency_ms,
+		enabled, created_at, updated_at
+	FROM alert_forwarding_rules`
+
+// scanRules scans forwarding rules from query rows.
+func scanRules(rows *sql.Rows) ([]*ForwardingRule, error) {
+	rules := []*ForwardingRule{}
+	for rows.Next() {
+		rule := &ForwardingRule{}
+		var matchersJSON []byte
+
+		if err := rows.Scan(
+			&rule.ID, &rule.Name, &matchersJSON, &rule.DestinationURL, &rule.HMACSecret, &rule.MaxLatencyMs,
+			&rule.Enabled, &rule.CreatedAt, &rule.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan forwarding rule: %w", err)
+		}
+
+		if err := json.Unmarshal(matchersJSON, &rule.SourceLabelMatchers); err != nil {
+			return nil, fmt.Errorf("unmarshal source label matchers: %w", err)
+		}
+
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate forwarding rules: %w", err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,59 @@
+package forwarding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_ListActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "source_label_matchers", "destination_url", "hmac_secret", "max_latency_ms",
+		"enabled", "created_at", "updated_at",
+	}).AddRow(
+		"rule-1", "eu replica", []byte(`{"region":"eu-west-1"}`), "https://dr.example.com/api/v1/webhook/generic/key",
+		"s3cret", int64(250), true, now, now,
+	)
+
+	mock.ExpectQuery(`SELECT (.+) FROM alert_forwarding_rules\s+WHERE enabled = true`).
+		WillReturnRows(rows)
+
+	rules, err := store.ListActive(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "eu-west-1", rules[0].SourceLabelMatchers["region"])
+	assert.Equal(t, "s3cret", rules[0].HMACSecret)
+	assert.Equal(t, 250*time.Millisecond, rules[0].Timeout())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *ForwardingRule
+	}{
+		{"missing name", &ForwardingRule{DestinationURL: "https://dr.example.com"}},
+		{"relative destination", &ForwardingRule{Name: "dr", DestinationURL: "/api/v1/webhook"}},
+		{"unsupported scheme", &ForwardingRule{Name: "dr", DestinationURL: "ftp://dr.example.com"}},
+		{"negative latency", &ForwardingRule{Name: "dr", DestinationURL: "https://dr.example.com", MaxLatencyMs: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewInMemoryStore().Create(context.Background(), tt.rule)
+			assert.True(t, errors.Is(err, ErrInvalidRule), "expected ErrInvalidRule, got %v", err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// Labels added to enriched alerts.
+const (
+	RegionLabel  = "region"
+	CountryLabel = "country"
+)
+
+// GeoEnricher adds region and country labels to alerts based on the source IP
+// in the label named by the service's SourceIPLabel.
+type GeoEnricher struct {
+	resolver Resolver
+	metrics  *Metrics
+	logger   zerolog.Logger
+}
+
+// NewGeoEnricher creates a new enricher using the resolver.
+func NewGeoEnricher(resolver Resolver, logger zerolog.Logger) *GeoEnricher {
+	return &GeoEnricher{
+		resolver: resolver,
+		metrics:  NewMetrics(),
+		logger:   logger.With().Str("component", "geo_enricher").Logger(),
+	}
+}
+
+// Metrics returns the metrics recorder for this enricher.
+func (e *GeoEnricher) Metrics() *Metrics {
+	return e.metrics
+}
+
+// Enrich adds the region and country labels to the alert. Existing labels are kept
+// and lookup failures are logged, so enrichment never fails ingestion.
+func (e *GeoEnricher) Enrich(service *store.Service, alert *alertingv1.Alert) {
+	if service == nil || service.SourceIPLabel == "" {
+		return
+	}
+	ip := alert.Labels[service.SourceIPLabel]
+	if ip == "" {
+		return
+	}
+
+	e.metrics.RecordLookup()
+	region, country, err := e.resolver.ResolveIPToRegion(ip)
+	if err != nil {
+		e.metrics.RecordLookupError()
+		e.logger.Debug().Err(err).Str("ip", ip).Str("serviceId", service.ID).Msg("failed to resolve alert source IP")
+		return
+	}
+
+	if region != "" {
+		setLabelIfAbsent(alert, RegionLabel, region)
+	}
+	setLabelIfAbsent(alert, CountryLabel, country)
+}
+
+func setLabelIfAbsent(alert *alertingv1.Alert, key, value string) {
+	if _, ok := alert.Labels[key]; !ok {
+		alert.Labels[key] = value
+	}
+}
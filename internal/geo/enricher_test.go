@@ -0,0 +1,123 @@
+package geo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// mockResolver resolves IPs from a fixed table.
+type mockResolver struct {
+	locations map[string][2]string
+}
+
+func (m *mockResolver) ResolveIPToRegion(ip string) (string, string, error) {
+	location, ok := m.locations[ip]
+	if !ok {
+		return "", "", ErrLocationNotFound
+	}
+	return location[0], location[1], nil
+}
+
+func newTestEnricher() *GeoEnricher {
+	return NewGeoEnricher(&mockResolver{locations: map[string][2]string{
+		"203.0.113.10": {"California", "US"},
+		"198.51.100.7": {"", "DE"},
+	}}, zerolog.Nop())
+}
+
+func TestGeoEnricher_Enrich(t *testing.T) {
+	enricher := newTestEnricher()
+	service := &store.Service{ID: "svc-1", SourceIPLabel: "source_ip"}
+
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		wantRegion  string
+		wantCountry string
+	}{
+		{"region and country", map[string]string{"source_ip": "203.0.113.10"}, "California", "US"},
+		{"country only", map[string]string{"source_ip": "198.51.100.7"}, "", "DE"},
+		{"existing labels are kept", map[string]string{"source_ip": "203.0.113.10", "region": "us-west"}, "us-west", "US"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := &alertingv1.Alert{Labels: tt.labels}
+			enricher.Enrich(service, alert)
+
+			if alert.Labels[RegionLabel] != tt.wantRegion {
+				t.Errorf("region = %q, want %q", alert.Labels[RegionLabel], tt.wantRegion)
+			}
+			if alert.Labels[CountryLabel] != tt.wantCountry {
+				t.Errorf("country = %q, want %q", alert.Labels[CountryLabel], tt.wantCountry)
+			}
+		})
+	}
+
+	if got := enricher.Metrics().LookupsTotal(); got != 3 {
+		t.Errorf("LookupsTotal() = %d, want 3", got)
+	}
+	if got := enricher.Metrics().LookupErrorsTotal(); got != 0 {
+		t.Errorf("LookupErrorsTotal() = %d, want 0", got)
+	}
+}
+
+func TestGeoEnricher_Skips(t *testing.T) {
+	enricher := newTestEnricher()
+
+	// No source IP label configured on the service
+	alert := &alertingv1.Alert{Labels: map[string]string{"source_ip": "203.0.113.10"}}
+	enricher.Enrich(&store.Service{ID: "svc-1"}, alert)
+	if _, ok := alert.Labels[CountryLabel]; ok {
+		t.Error("expected no enrichment without SourceIPLabel")
+	}
+
+	// Alert does not carry the source IP label
+	alert = &alertingv1.Alert{Labels: map[string]string{"host": "web-1"}}
+	enricher.Enrich(&store.Service{ID: "svc-1", SourceIPLabel: "source_ip"}, alert)
+	if _, ok := alert.Labels[CountryLabel]; ok {
+		t.Error("expected no enrichment without a source IP")
+	}
+
+	if got := enricher.Metrics().LookupsTotal(); got != 0 {
+		t.Errorf("LookupsTotal() = %d, want 0", got)
+	}
+}
+
+func TestGeoEnricher_LookupError(t *testing.T) {
+	enricher := newTestEnricher()
+	alert := &alertingv1.Alert{Labels: map[string]string{"source_ip": "192.0.2.1"}}
+
+	enricher.Enrich(&store.Service{ID: "svc-1", SourceIPLabel: "source_ip"}, alert)
+
+	if len(alert.Labels) != 1 {
+		t.Errorf("expected labels to be unchanged, got %v", alert.Labels)
+	}
+	if got := enricher.Metrics().LookupErrorsTotal(); got != 1 {
+		t.Errorf("LookupErrorsTotal() = %d, want 1", got)
+	}
+}
+
+func TestNewMaxMindResolver_MissingDatabase(t *testing.T) {
+	if _, err := NewMaxMindResolver("/nonexistent/GeoLite2-City.mmdb"); err == nil {
+		t.Error("expected an error for a missing database")
+	}
+
+	t.Setenv(DBPathEnv, "")
+	resolver, err := NewMaxMindResolverFromEnv()
+	if err != nil || resolver != nil {
+		t.Errorf("NewMaxMindResolverFromEnv() = %v, %v; want nil, nil when unset", resolver, err)
+	}
+}
+
+func TestMaxMindResolver_InvalidIP(t *testing.T) {
+	resolver := &MaxMindResolver{}
+	if _, _, err := resolver.ResolveIPToRegion("not-an-ip"); !errors.Is(err, ErrInvalidIP) {
+		t.Errorf("ResolveIPToRegion() error = %v, want ErrInvalidIP", err)
+	}
+}
@@ -0,0 +1,57 @@
+package geo
+
+import (
+	"sync"
+)
+
+// Metrics tracks geolocation lookup metrics.
+// Exposed as the geo_lookups_total and geo_lookup_errors_total counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// lookups counts IP address lookups.
+	lookups int64
+	// lookupErrors counts IP address lookups that failed.
+	lookupErrors int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordLookup increments the lookups counter.
+func (m *Metrics) RecordLookup() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lookups++
+}
+
+// RecordLookupError increments the lookup errors counter.
+func (m *Metrics) RecordLookupError() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lookupErrors++
+}
+
+// LookupsTotal returns the number of lookups.
+func (m *Metrics) LookupsTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookups
+}
+
+// LookupErrorsTotal returns the number of failed lookups.
+func (m *Metrics) LookupErrorsTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookupErrors
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lookups = 0
+	m.lookupErrors = 0
+}
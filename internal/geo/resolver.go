@@ -0,0 +1,82 @@
+// Package geo resolves alert source IP addresses to geographic locations.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// DBPathEnv is the environment variable holding the GeoLite2 City database path.
+const DBPathEnv = "GEOLITE2_DB_PATH"
+
+var (
+	// ErrInvalidIP is returned when the address is not a valid IP.
+	ErrInvalidIP = errors.New("invalid IP address")
+	// ErrLocationNotFound is returned when the database has no country for the address.
+	ErrLocationNotFound = errors.New("location not found")
+)
+
+// Resolver resolves IP addresses to a region and country.
+type Resolver interface {
+	// ResolveIPToRegion returns the region (first subdivision, e.g. "California")
+	// and ISO country code (e.g. "US") of an IP address.
+	ResolveIPToRegion(ip string) (region, country string, err error)
+}
+
+// MaxMindResolver resolves IP addresses with a MaxMind GeoLite2 City database.
+type MaxMindResolver struct {
+	reader *geoip2.Reader
+}
+
+// NewMaxMindResolver opens the GeoLite2 City database at path.
+func NewMaxMindResolver(path string) (*MaxMindResolver, error) {
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open geolite2 database: %w", err)
+	}
+	return &MaxMindResolver{reader: reader}, nil
+}
+
+// NewMaxMindResolverFromEnv opens the database named by GEOLITE2_DB_PATH.
+// Returns nil without an error when the variable is unset.
+func NewMaxMindResolverFromEnv() (*MaxMindResolver, error) {
+	path := os.Getenv(DBPathEnv)
+	if path == "" {
+		return nil, nil
+	}
+	return NewMaxMindResolver(path)
+}
+
+// ResolveIPToRegion looks up the IP address in the database.
+func (r *MaxMindResolver) ResolveIPToRegion(ip string) (string, string, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidIP, ip)
+	}
+
+	record, err := r.reader.City(addr)
+	if err != nil {
+		return "", "", fmt.Errorf("lookup %s: %w", ip, err)
+	}
+	if record.Country.IsoCode == "" {
+		return "", "", fmt.Errorf("%w: %s", ErrLocationNotFound, ip)
+	}
+
+	region := ""
+	if len(record.Subdivisions) > 0 {
+		region = record.Subdivisions[0].Names["en"]
+	}
+	return region, record.Country.IsoCode, nil
+}
+
+// Close closes the underlying database.
+func (r *MaxMindResolver) Close() error {
+	return r.reader.Close()
+}
+
+// Ensure MaxMindResolver implements Resolver
+var _ Resolver = (*MaxMindResolver)(nil)
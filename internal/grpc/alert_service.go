@@ -0,0 +1,288 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/comment"
+	"github.com/kneutral-org/alerting-system/internal/escalation"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// EscalationCanceller stops the pending escalation of an alert. It is
+// implemented by *escalation.Engine.
+type EscalationCanceller interface {
+	Cancel(alertID string) bool
+}
+
+// AlertService implements the AlertServiceServer interface for manual alert
+// lifecycle changes and comments by operators.
+type AlertService struct {
+	alertingv1.UnimplementedAlertServiceServer
+	store       store.AlertStore
+	comments    comment.Store
+	escalations EscalationCanceller
+	logger      zerolog.Logger
+
+	// now returns the time lifecycle changes are recorded at
+	now func() time.Time
+}
+
+// NewAlertService creates a new AlertService. Acknowledging or resolving an
+// alert cancels its pending escalation when escalations is not nil.
+func NewAlertService(store store.AlertStore, comments comment.Store, escalations EscalationCanceller, logger zerolog.Logger) *AlertService {
+	return &AlertService{
+		store:       store,
+		comments:    comments,
+		escalations: escalations,
+		logger:      logger.With().Str("service", "alert").Logger(),
+		now:         time.Now,
+	}
+}
+
+// =============================================================================
+// Alert lifecycle (2 RPCs)
+// =============================================================================
+
+// AcknowledgeAlert acknowledges a triggered alert. Acknowledging an alert that
+// is already acknowledged returns it unchanged.
+func (s *AlertService) AcknowledgeAlert(ctx context.Context, req *alertingv1.AcknowledgeAlertRequest) (*alertingv1.Alert, error) {
+	if req.AlertId == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert_id is required")
+	}
+	req.AcknowledgedBy = authenticatedUserID(ctx, req.AcknowledgedBy)
+	if req.AcknowledgedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "acknowledged_by is required")
+	}
+
+	alert, err := s.getAlert(ctx, req.AlertId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch alert.Status {
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		return alert, nil
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		return nil, status.Error(codes.FailedPrecondition, "resolved alerts cannot be acknowledged")
+	}
+
+	now := s.now()
+	alert.Status = alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED
+	alert.AcknowledgedAt = timestamppb.New(now)
+	alert.AcknowledgedBy = req.AcknowledgedBy
+	alert.Events = append(alert.Events, newAlertEvent(
+		alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED, "Alert acknowledged", req.AcknowledgedBy, now,
+		"comment", req.Comment,
+	))
+
+	updated, err := s.updateAlert(ctx, alert, "acknowledge")
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := s.cancelEscalation(alert.Id)
+	s.logger.Info().
+		Str("alertId", alert.Id).
+		Str("acknowledgedBy", req.AcknowledgedBy).
+		Bool("escalationCancelled", cancelled).
+		Msg("alert acknowledged")
+
+	return updated, nil
+}
+
+// ResolveAlert resolves a triggered or acknowledged alert. Resolving an alert
+// that is already resolved returns it unchanged.
+func (s *AlertService) ResolveAlert(ctx context.Context, req *alertingv1.ResolveAlertRequest) (*alertingv1.Alert, error) {
+	if req.AlertId == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert_id is required")
+	}
+	req.ResolvedBy = authenticatedUserID(ctx, req.ResolvedBy)
+	if req.ResolvedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "resolved_by is required")
+	}
+
+	alert, err := s.getAlert(ctx, req.AlertId)
+	if err != nil {
+		return nil, err
+	}
+
+	if alert.Status == alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		return alert, nil
+	}
+
+	now := s.now()
+	alert.Status = alertingv1.AlertStatus_ALERT_STATUS_RESOLVED
+	alert.ResolvedAt = timestamppb.New(now)
+	alert.ResolvedBy = req.ResolvedBy
+	alert.Events = append(alert.Events, newAlertEvent(
+		alertingv1.AlertEventType_ALERT_EVENT_TYPE_RESOLVED, "Alert resolved", req.ResolvedBy, now,
+		"rootCause", req.RootCause,
+	))
+
+	updated, err := s.updateAlert(ctx, alert, "resolve")
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := s.cancelEscalation(alert.Id)
+	s.logger.Info().
+		Str("alertId", alert.Id).
+		Str("resolvedBy", req.ResolvedBy).
+		Bool("escalationCancelled", cancelled).
+		Msg("alert resolved")
+
+	return updated, nil
+}
+
+// =============================================================================
+// Alert comments (3 RPCs)
+// =============================================================================
+
+// CreateComment adds a comment to an alert.
+func (s *AlertService) CreateComment(ctx context.Context, req *alertingv1.CreateCommentRequest) (*alertingv1.AlertComment, error) {
+	if req.AlertId == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert_id is required")
+	}
+	req.AuthorId = authenticatedUserID(ctx, req.AuthorId)
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+
+	if _, err := s.getAlert(ctx, req.AlertId); err != nil {
+		return nil, err
+	}
+
+	created, err := s.comments.Create(ctx, &alertingv1.AlertComment{
+		AlertId:  req.AlertId,
+		AuthorId: req.AuthorId,
+		Body:     req.Body,
+	})
+	if err != nil {
+		if errors.Is(err, comment.ErrInvalidComment) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Str("alertId", req.AlertId).Msg("failed to create comment")
+		return nil, status.Error(codes.Internal, "failed to create comment")
+	}
+
+	s.logger.Info().
+		Str("alertId", req.AlertId).
+		Str("commentId", created.Id).
+		Str("authorId", req.AuthorId).
+		Msg("alert comment created")
+
+	return created, nil
+}
+
+// ListComments lists the comments of an alert, oldest first. Deleted comments
+// are not listed.
+func (s *AlertService) ListComments(ctx context.Context, req *alertingv1.ListCommentsRequest) (*alertingv1.ListCommentsResponse, error) {
+	if req.AlertId == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert_id is required")
+	}
+
+	if _, err := s.getAlert(ctx, req.AlertId); err != nil {
+		return nil, err
+	}
+
+	comments, nextPageToken, err := s.comments.List(ctx, req.AlertId, &comment.ListFilter{
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("alertId", req.AlertId).Msg("failed to list comments")
+		return nil, status.Error(codes.Internal, "failed to list comments")
+	}
+
+	return &alertingv1.ListCommentsResponse{
+		Comments:      comments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// DeleteComment deletes a comment. Deleting a comment that is already deleted
+// succeeds.
+func (s *AlertService) DeleteComment(ctx context.Context, req *alertingv1.DeleteCommentRequest) (*alertingv1.DeleteCommentResponse, error) {
+	if req.CommentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment_id is required")
+	}
+
+	if err := s.comments.Delete(ctx, req.CommentId); err != nil {
+		if errors.Is(err, comment.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+		s.logger.Error().Err(err).Str("commentId", req.CommentId).Msg("failed to delete comment")
+		return nil, status.Error(codes.Internal, "failed to delete comment")
+	}
+
+	s.logger.Info().Str("commentId", req.CommentId).Msg("alert comment deleted")
+
+	return &alertingv1.DeleteCommentResponse{Success: true}, nil
+}
+
+// =============================================================================
+// Helper functions
+// =============================================================================
+
+func (s *AlertService) getAlert(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	alert, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Str("alertId", id).Msg("failed to get alert")
+		return nil, status.Error(codes.Internal, "failed to get alert")
+	}
+	if alert == nil {
+		return nil, status.Error(codes.NotFound, "alert not found")
+	}
+	return alert, nil
+}
+
+func (s *AlertService) updateAlert(ctx context.Context, alert *alertingv1.Alert, op string) (*alertingv1.Alert, error) {
+	alert.UpdatedAt = timestamppb.New(s.now())
+
+	updated, err := s.store.Update(ctx, alert)
+	if err != nil {
+		s.logger.Error().Err(err).Str("alertId", alert.Id).Msgf("failed to %s alert", op)
+		return nil, status.Errorf(codes.Internal, "failed to %s alert", op)
+	}
+	return updated, nil
+}
+
+// cancelEscalation stops the pending escalation of an alert and reports
+// whether one was running.
+func (s *AlertService) cancelEscalation(alertID string) bool {
+	if s.escalations == nil {
+		return false
+	}
+	return s.escalations.Cancel(alertID)
+}
+
+// newAlertEvent creates an audit event of an alert, with the metadata key set
+// to value if value is not empty.
+func newAlertEvent(eventType alertingv1.AlertEventType, description, actorID string, at time.Time, key, value string) *alertingv1.AlertEvent {
+	event := &alertingv1.AlertEvent{
+		Id:          uuid.New().String(),
+		Type:        eventType,
+		Description: description,
+		ActorId:     actorID,
+		Timestamp:   timestamppb.New(at),
+	}
+	if value != "" {
+		event.Metadata = map[string]string{key: value}
+	}
+	return event
+}
+
+// Ensure AlertService implements the interface
+var _ alertingv1.AlertServiceServer = (*AlertService)(nil)
+
+// Ensure escalation.Engine can cancel escalations of acknowledged alerts
+var _ EscalationCanceller = (*escalation.Engine)(nil)
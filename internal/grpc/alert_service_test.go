@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kneutral-org/alerting-system/internal/comment"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// lifecycleAlertStore holds alerts by ID and counts updates.
+type lifecycleAlertStore struct {
+	store.AlertStore
+	alerts    map[string]*alertingv1.Alert
+	updates   int
+	updateErr error
+}
+
+func (s *lifecycleAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	alert, ok := s.alerts[id]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(alert).(*alertingv1.Alert), nil
+}
+
+func (s *lifecycleAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	if s.updateErr != nil {
+		return nil, s.updateErr
+	}
+	s.updates++
+	s.alerts[alert.Id] = proto.Clone(alert).(*alertingv1.Alert)
+	return alert, nil
+}
+
+// recordingCanceller records the alerts whose escalation is cancelled.
+type recordingCanceller struct {
+	cancelled []string
+}
+
+func (c *recordingCanceller) Cancel(alertID string) bool {
+	c.cancelled = append(c.cancelled, alertID)
+	return true
+}
+
+func setupAlertService(t *testing.T) (*AlertService, *lifecycleAlertStore, *recordingCanceller) {
+	alerts := &lifecycleAlertStore{alerts: map[string]*alertingv1.Alert{
+		"alert-1": {Id: "alert-1", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	}}
+	canceller := &recordingCanceller{}
+	svc := NewAlertService(alerts, comment.NewInMemoryStore(), canceller, zerolog.Nop())
+	svc.now = func() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+	return svc, alerts, canceller
+}
+
+func TestAlertService_AcknowledgeAlert(t *testing.T) {
+	svc, alerts, canceller := setupAlertService(t)
+	ctx := context.Background()
+
+	resp, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{
+		AlertId:        "alert-1",
+		AcknowledgedBy: "user-1",
+		Comment:        "looking into it",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, resp.Status)
+	assert.Equal(t, "user-1", resp.AcknowledgedBy)
+	assert.Equal(t, svc.now(), resp.AcknowledgedAt.AsTime())
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED, resp.Events[0].Type)
+	assert.Equal(t, "user-1", resp.Events[0].ActorId)
+	assert.Equal(t, "looking into it", resp.Events[0].Metadata["comment"])
+	assert.Equal(t, []string{"alert-1"}, canceller.cancelled)
+
+	t.Run("double acknowledge is idempotent", func(t *testing.T) {
+		again, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{
+			AlertId:        "alert-1",
+			AcknowledgedBy: "user-2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", again.AcknowledgedBy)
+		assert.Len(t, again.Events, 1)
+		assert.Equal(t, 1, alerts.updates)
+		assert.Len(t, canceller.cancelled, 1)
+	})
+
+	t.Run("missing alert", func(t *testing.T) {
+		_, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{AlertId: "missing", AcknowledgedBy: "user-1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{AcknowledgedBy: "user-1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{AlertId: "alert-1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("resolved alert", func(t *testing.T) {
+		alerts.alerts["alert-2"] = &alertingv1.Alert{Id: "alert-2", Status: alertingv1.AlertStatus_ALERT_STATUS_RESOLVED}
+		_, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{AlertId: "alert-2", AcknowledgedBy: "user-1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestAlertService_ResolveAlert(t *testing.T) {
+	svc, alerts, canceller := setupAlertService(t)
+	ctx := context.Background()
+
+	_, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{AlertId: "alert-1", AcknowledgedBy: "user-1"})
+	require.NoError(t, err)
+
+	resp, err := svc.ResolveAlert(ctx, &alertingv1.ResolveAlertRequest{
+		AlertId:    "alert-1",
+		ResolvedBy: "user-2",
+		RootCause:  "failed transceiver",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, resp.Status)
+	assert.Equal(t, "user-2", resp.ResolvedBy)
+	assert.NotNil(t, resp.ResolvedAt)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, alertingv1.AlertEventType_ALERT_EVENT_TYPE_RESOLVED, resp.Events[1].Type)
+	assert.Equal(t, "failed transceiver", resp.Events[1].Metadata["rootCause"])
+	assert.Equal(t, []string{"alert-1", "alert-1"}, canceller.cancelled)
+
+	again, err := svc.ResolveAlert(ctx, &alertingv1.ResolveAlertRequest{AlertId: "alert-1", ResolvedBy: "user-3"})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", again.ResolvedBy)
+	assert.Equal(t, 2, alerts.updates)
+
+	_, err = svc.ResolveAlert(ctx, &alertingv1.ResolveAlertRequest{AlertId: "missing", ResolvedBy: "user-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAlertService_UpdateFailure(t *testing.T) {
+	svc, alerts, canceller := setupAlertService(t)
+	alerts.updateErr = errors.New("database unavailable")
+
+	_, err := svc.ResolveAlert(context.Background(), &alertingv1.ResolveAlertRequest{AlertId: "alert-1", ResolvedBy: "user-1"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Empty(t, canceller.cancelled, "escalation must keep running if the alert was not resolved")
+}
+
+func TestAlertService_WithoutEscalations(t *testing.T) {
+	alerts := &lifecycleAlertStore{alerts: map[string]*alertingv1.Alert{
+		"alert-1": {Id: "alert-1", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	}}
+	svc := NewAlertService(alerts, comment.NewInMemoryStore(), nil, zerolog.Nop())
+
+	resp, err := svc.AcknowledgeAlert(context.Background(), &alertingv1.AcknowledgeAlertRequest{AlertId: "alert-1", AcknowledgedBy: "user-1"})
+	require.NoError(t, err)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, resp.Status)
+}
+
+func TestAlertService_CreateComment(t *testing.T) {
+	svc, _, _ := setupAlertService(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateComment(ctx, &alertingv1.CreateCommentRequest{
+		AlertId:  "alert-1",
+		AuthorId: "user-1",
+		Body:     "Carrier confirmed a fiber cut near AMS1",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.Id)
+	assert.Equal(t, "alert-1", created.AlertId)
+	assert.NotNil(t, created.CreatedAt)
+
+	tests := []struct {
+		name string
+		req  *alertingv1.CreateCommentRequest
+		code codes.Code
+	}{
+		{"missing alert id", &alertingv1.CreateCommentRequest{AuthorId: "user-1", Body: "note"}, codes.InvalidArgument},
+		{"missing author id", &alertingv1.CreateCommentRequest{AlertId: "alert-1", Body: "note"}, codes.InvalidArgument},
+		{"blank body", &alertingv1.CreateCommentRequest{AlertId: "alert-1", AuthorId: "user-1", Body: " "}, codes.InvalidArgument},
+		{"unknown alert", &alertingv1.CreateCommentRequest{AlertId: "missing", AuthorId: "user-1", Body: "note"}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateComment(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestAlertService_ListCommentsPagination(t *testing.T) {
+	svc, _, _ := setupAlertService(t)
+	ctx := context.Background()
+
+	for _, body := range []string{"first", "second", "third"} {
+		_, err := svc.CreateComment(ctx, &alertingv1.CreateCommentRequest{AlertId: "alert-1", AuthorId: "user-1", Body: body})
+		require.NoError(t, err)
+	}
+
+	var bodies []string
+	req := &alertingv1.ListCommentsRequest{AlertId: "alert-1", PageSize: 2}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3, "pagination must end")
+
+		resp, err := svc.ListComments(ctx, req)
+		require.NoError(t, err)
+		for _, c := range resp.Comments {
+			bodies = append(bodies, c.Body)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, bodies)
+
+	_, err := svc.ListComments(ctx, &alertingv1.ListCommentsRequest{AlertId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.ListComments(ctx, &alertingv1.ListCommentsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAlertService_DeleteComment(t *testing.T) {
+	svc, _, _ := setupAlertService(t)
+	ctx := context.Background()
+
+	kept, err := svc.CreateComment(ctx, &alertingv1.CreateCommentRequest{AlertId: "alert-1", AuthorId: "user-1", Body: "kept"})
+	require.NoError(t, err)
+	deleted, err := svc.CreateComment(ctx, &alertingv1.CreateCommentRequest{AlertId: "alert-1", AuthorId: "user-1", Body: "deleted"})
+	require.NoError(t, err)
+
+	for range 2 {
+		resp, err := svc.DeleteComment(ctx, &alertingv1.DeleteCommentRequest{CommentId: deleted.Id})
+		require.NoError(t, err, "deleting a comment must be idempotent")
+		assert.True(t, resp.Success)
+	}
+
+	list, err := svc.ListComments(ctx, &alertingv1.ListCommentsRequest{AlertId: "alert-1"})
+	require.NoError(t, err)
+	require.Len(t, list.Comments, 1)
+	assert.Equal(t, kept.Id, list.Comments[0].Id)
+
+	_, err = svc.DeleteComment(ctx, &alertingv1.DeleteCommentRequest{CommentId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.DeleteComment(ctx, &alertingv1.DeleteCommentRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
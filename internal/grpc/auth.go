@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+)
+
+// UnaryAuthInterceptor returns an interceptor that rejects calls without a
+// valid bearer token in the "authorization" metadata. The context of accepted
+// calls carries the token's user, see auth.UserFromContext. Tokens without a
+// valid tenant_id claim are denied.
+func UnaryAuthInterceptor(verifier auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var header string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+
+		token, err := auth.BearerToken(header)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		user, err := verifier.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+		}
+
+		ctx = auth.WithUser(ctx, user)
+		if _, err := auth.TenantFromContext(ctx); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// authenticatedUserID returns the ID of the authenticated caller of ctx, or
+// fallback when the call is unauthenticated.
+func authenticatedUserID(ctx context.Context, fallback string) string {
+	if user, ok := auth.UserFromContext(ctx); ok {
+		return user.UserID
+	}
+	return fallback
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const testTenantID = "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01"
+
+// staticVerifier accepts a single token.
+type staticVerifier struct {
+	token string
+	user  *auth.UserContext
+}
+
+func (v staticVerifier) Verify(token string) (*auth.UserContext, error) {
+	if token != v.token {
+		return nil, errors.New("unknown token")
+	}
+	return v.user, nil
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	interceptor := UnaryAuthInterceptor(staticVerifier{token: "good", user: &auth.UserContext{UserID: "user-1", TenantID: testTenantID}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/alerting.v1.SilenceService/CreateSilence"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		user, ok := auth.UserFromContext(ctx)
+		if !ok {
+			return nil, errors.New("no user in context")
+		}
+		return user.UserID, nil
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+		resp, err := interceptor(ctx, nil, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", resp)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("token without tenant", func(t *testing.T) {
+		noTenant := UnaryAuthInterceptor(staticVerifier{token: "good", user: &auth.UserContext{UserID: "user-1"}})
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+		_, err := noTenant(ctx, nil, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bad"))
+		_, err := interceptor(ctx, nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestCreateSilence_AuthenticatedCreator(t *testing.T) {
+	svc := setupSilenceService(t)
+	ctx := auth.WithUser(context.Background(), &auth.UserContext{UserID: "user-2"})
+
+	// The authenticated user overrides the client-supplied creator
+	resp, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{
+		Silence: newTestSilenceRule(time.Now()),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", resp.CreatedBy)
+}
+
+func TestApproveMaintenanceWindow_AuthenticatedApprover(t *testing.T) {
+	store := newMockMaintenanceStore()
+	store.addActiveWindow("window-1", "Core switch upgrade", nil, nil)
+	store.windows[0].RequiresApproval = true
+	store.windows[0].Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL
+	svc := NewMaintenanceService(store, zerolog.Nop())
+	ctx := auth.WithUser(context.Background(), &auth.UserContext{UserID: "user-2"})
+
+	// The authenticated user overrides the client-supplied approver
+	window, err := svc.ApproveMaintenanceWindow(ctx, &routingv1.ApproveMaintenanceWindowRequest{
+		Id:         "window-1",
+		ApprovedBy: "change-board",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", window.ApprovedBy)
+}
+
+func TestBulkCreateOverrides_AuthenticatedCreator(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := auth.WithUser(context.Background(), &auth.UserContext{UserID: "user-2"})
+
+	created, err := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := svc.BulkCreateOverrides(ctx, &routingv1.BulkCreateOverridesRequest{
+		ScheduleId: created.Id,
+		Overrides: []*routingv1.ScheduleOverride{
+			{UserId: "user-1", StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(time.Hour)), CreatedBy: "someone-else"},
+			{UserId: "user-1", StartTime: timestamppb.New(start.Add(2 * time.Hour)), EndTime: timestamppb.New(start.Add(3 * time.Hour))},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Overrides, 2)
+	for _, override := range resp.Overrides {
+		assert.Equal(t, "user-2", override.CreatedBy)
+	}
+}
+
+func TestAcknowledgeAndResolveAlert_AuthenticatedUser(t *testing.T) {
+	svc, _, _ := setupAlertService(t)
+	ctx := auth.WithUser(context.Background(), &auth.UserContext{UserID: "user-2"})
+
+	// The authenticated user overrides the client-supplied acknowledger and resolver
+	acked, err := svc.AcknowledgeAlert(ctx, &alertingv1.AcknowledgeAlertRequest{
+		AlertId:        "alert-1",
+		AcknowledgedBy: "someone-else",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", acked.AcknowledgedBy)
+	assert.Equal(t, "user-2", acked.Events[0].ActorId)
+
+	resolved, err := svc.ResolveAlert(ctx, &alertingv1.ResolveAlertRequest{
+		AlertId:    "alert-1",
+		ResolvedBy: "someone-else",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", resolved.ResolvedBy)
+}
@@ -0,0 +1,276 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/escalation"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// EscalationService implements the EscalationServiceServer interface.
+type EscalationService struct {
+	routingv1.UnimplementedEscalationServiceServer
+	store  escalation.EscalationStore
+	logger zerolog.Logger
+}
+
+// NewEscalationService creates a new EscalationService.
+func NewEscalationService(store escalation.EscalationStore, logger zerolog.Logger) *EscalationService {
+	return &EscalationService{
+		store:  store,
+		logger: logger.With().Str("service", "escalation").Logger(),
+	}
+}
+
+// =============================================================================
+// Escalation Policy CRUD (5 RPCs)
+// =============================================================================
+
+// CreateEscalationPolicy creates a new escalation policy.
+func (s *EscalationService) CreateEscalationPolicy(ctx context.Context, req *routingv1.CreateEscalationPolicyRequest) (*routingv1.EscalationPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	if req.Policy.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "policy name is required")
+	}
+
+	policy, err := protoToEscalationPolicy(req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid escalation policy: %v", err)
+	}
+
+	s.logger.Info().
+		Str("name", policy.Name).
+		Int("steps", len(policy.Steps)).
+		Msg("creating escalation policy")
+
+	created, err := s.store.CreatePolicy(ctx, policy)
+	if err != nil {
+		if errors.Is(err, escalation.ErrDuplicateName) {
+			return nil, status.Error(codes.AlreadyExists, "escalation policy name already exists")
+		}
+		if errors.Is(err, escalation.ErrInvalidPolicy) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Msg("failed to create escalation policy")
+		return nil, status.Error(codes.Internal, "failed to create escalation policy")
+	}
+
+	s.logger.Info().
+		Str("id", created.ID).
+		Str("name", created.Name).
+		Msg("escalation policy created")
+
+	return escalationPolicyToProto(created), nil
+}
+
+// GetEscalationPolicy retrieves an escalation policy by ID.
+func (s *EscalationService) GetEscalationPolicy(ctx context.Context, req *routingv1.GetEscalationPolicyRequest) (*routingv1.EscalationPolicy, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	policy, err := s.store.GetPolicyByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, escalation.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "escalation policy not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to get escalation policy")
+		return nil, status.Error(codes.Internal, "failed to get escalation policy")
+	}
+
+	return escalationPolicyToProto(policy), nil
+}
+
+// ListEscalationPolicies retrieves a page of escalation policies.
+func (s *EscalationService) ListEscalationPolicies(ctx context.Context, req *routingv1.ListEscalationPoliciesRequest) (*routingv1.ListEscalationPoliciesResponse, error) {
+	filter := &escalation.ListPoliciesFilter{
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+
+	policies, nextPageToken, err := s.store.ListPolicies(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list escalation policies")
+		return nil, status.Error(codes.Internal, "failed to list escalation policies")
+	}
+
+	resp := &routingv1.ListEscalationPoliciesResponse{
+		Policies:      make([]*routingv1.EscalationPolicy, 0, len(policies)),
+		NextPageToken: nextPageToken,
+	}
+
+	for _, p := range policies {
+		resp.Policies = append(resp.Policies, escalationPolicyToProto(p))
+	}
+
+	return resp, nil
+}
+
+// UpdateEscalationPolicy replaces an existing escalation policy.
+func (s *EscalationService) UpdateEscalationPolicy(ctx context.Context, req *routingv1.UpdateEscalationPolicyRequest) (*routingv1.EscalationPolicy, error) {
+	if req.Policy == nil || req.Policy.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "policy with id is required")
+	}
+
+	policy, err := protoToEscalationPolicy(req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid escalation policy: %v", err)
+	}
+
+	s.logger.Info().
+		Str("id", policy.ID).
+		Str("name", policy.Name).
+		Msg("updating escalation policy")
+
+	// Get existing policy to preserve created_at
+	existing, err := s.store.GetPolicyByID(ctx, policy.ID)
+	if err != nil {
+		if errors.Is(err, escalation.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "escalation policy not found")
+		}
+		s.logger.Error().Err(err).Str("id", policy.ID).Msg("failed to get escalation policy for update")
+		return nil, status.Error(codes.Internal, "failed to update escalation policy")
+	}
+	policy.CreatedAt = existing.CreatedAt
+
+	updated, err := s.store.UpdatePolicy(ctx, policy)
+	if err != nil {
+		if errors.Is(err, escalation.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "escalation policy not found")
+		}
+		if errors.Is(err, escalation.ErrDuplicateName) {
+			return nil, status.Error(codes.AlreadyExists, "escalation policy name already exists")
+		}
+		if errors.Is(err, escalation.ErrInvalidPolicy) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Str("id", policy.ID).Msg("failed to update escalation policy")
+		return nil, status.Error(codes.Internal, "failed to update escalation policy")
+	}
+
+	s.logger.Info().
+		Str("id", updated.ID).
+		Msg("escalation policy updated")
+
+	return escalationPolicyToProto(updated), nil
+}
+
+// DeleteEscalationPolicy deletes an escalation policy by ID.
+func (s *EscalationService) DeleteEscalationPolicy(ctx context.Context, req *routingv1.DeleteEscalationPolicyRequest) (*routingv1.DeleteEscalationPolicyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	s.logger.Info().Str("id", req.Id).Msg("deleting escalation policy")
+
+	err := s.store.DeletePolicy(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, escalation.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "escalation policy not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to delete escalation policy")
+		return nil, status.Error(codes.Internal, "failed to delete escalation policy")
+	}
+
+	s.logger.Info().Str("id", req.Id).Msg("escalation policy deleted")
+
+	return &routingv1.DeleteEscalationPolicyResponse{Success: true}, nil
+}
+
+// =============================================================================
+// Helper functions for proto conversion
+// =============================================================================
+
+// protoToEscalationPolicy converts a protobuf EscalationPolicy to the internal
+// model. Steps are kept in the order given; channel targets are not supported.
+func protoToEscalationPolicy(pb *routingv1.EscalationPolicy) (*escalation.EscalationPolicy, error) {
+	policy := &escalation.EscalationPolicy{
+		ID:             pb.Id,
+		Name:           pb.Name,
+		Description:    pb.Description,
+		RepeatCount:    pb.RepeatCount,
+		RepeatInterval: pb.RepeatInterval.AsDuration(),
+		Steps:          make([]escalation.EscalationStep, 0, len(pb.Steps)),
+	}
+
+	for i, step := range pb.Steps {
+		targets := make([]escalation.EscalationTarget, 0, len(step.Targets))
+		for _, target := range step.Targets {
+			switch target.Type {
+			case routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_USER:
+				targets = append(targets, escalation.EscalationTarget{Type: escalation.TargetTypeUser, ID: target.UserId})
+			case routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_TEAM:
+				targets = append(targets, escalation.EscalationTarget{Type: escalation.TargetTypeTeam, ID: target.TeamId})
+			case routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_SCHEDULE:
+				targets = append(targets, escalation.EscalationTarget{Type: escalation.TargetTypeSchedule, ID: target.ScheduleId})
+			default:
+				return nil, fmt.Errorf("step %d: unsupported target type %s", i+1, target.Type)
+			}
+		}
+
+		policy.Steps = append(policy.Steps, escalation.EscalationStep{
+			Delay:   step.Delay.AsDuration(),
+			Targets: targets,
+		})
+	}
+
+	return policy, nil
+}
+
+// escalationPolicyToProto converts an internal EscalationPolicy model to protobuf.
+func escalationPolicyToProto(p *escalation.EscalationPolicy) *routingv1.EscalationPolicy {
+	if p == nil {
+		return nil
+	}
+
+	pb := &routingv1.EscalationPolicy{
+		Id:             p.ID,
+		Name:           p.Name,
+		Description:    p.Description,
+		RepeatCount:    p.RepeatCount,
+		RepeatInterval: durationpb.New(p.RepeatInterval),
+		Steps:          make([]*routingv1.EscalationStep, 0, len(p.Steps)),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+		UpdatedAt:      timestamppb.New(p.UpdatedAt),
+	}
+
+	for i, step := range p.Steps {
+		pbStep := &routingv1.EscalationStep{
+			StepNumber: int32(i + 1),
+			Delay:      durationpb.New(step.Delay),
+			Targets:    make([]*routingv1.EscalationTarget, 0, len(step.Targets)),
+		}
+		for _, target := range step.Targets {
+			pbTarget := &routingv1.EscalationTarget{}
+			switch target.Type {
+			case escalation.TargetTypeUser:
+				pbTarget.Type = routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_USER
+				pbTarget.UserId = target.ID
+			case escalation.TargetTypeTeam:
+				pbTarget.Type = routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_TEAM
+				pbTarget.TeamId = target.ID
+			case escalation.TargetTypeSchedule:
+				pbTarget.Type = routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_SCHEDULE
+				pbTarget.ScheduleId = target.ID
+			}
+			pbStep.Targets = append(pbStep.Targets, pbTarget)
+		}
+		pb.Steps = append(pb.Steps, pbStep)
+	}
+
+	return pb
+}
+
+// Ensure EscalationService implements the interface
+var _ routingv1.EscalationServiceServer = (*EscalationService)(nil)
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kneutral-org/alerting-system/internal/escalation"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func setupEscalationService(t *testing.T) *EscalationService {
+	store := escalation.NewInMemoryStore()
+	logger := zerolog.Nop()
+	return NewEscalationService(store, logger)
+}
+
+func newTestEscalationPolicy(name string) *routingv1.EscalationPolicy {
+	return &routingv1.EscalationPolicy{
+		Name: name,
+		Steps: []*routingv1.EscalationStep{
+			{
+				Targets: []*routingv1.EscalationTarget{
+					{Type: routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_SCHEDULE, ScheduleId: "schedule-1"},
+				},
+			},
+			{
+				Delay: durationpb.New(15 * time.Minute),
+				Targets: []*routingv1.EscalationTarget{
+					{Type: routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_TEAM, TeamId: "team-1"},
+					{Type: routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_USER, UserId: "user-1"},
+				},
+			},
+		},
+		RepeatCount:    2,
+		RepeatInterval: durationpb.New(30 * time.Minute),
+	}
+}
+
+func TestEscalationService_CreateEscalationPolicy(t *testing.T) {
+	svc := setupEscalationService(t)
+	ctx := context.Background()
+
+	t.Run("create valid policy", func(t *testing.T) {
+		resp, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{
+			Policy: newTestEscalationPolicy("Network Critical"),
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.Id)
+		assert.Equal(t, "Network Critical", resp.Name)
+		assert.Equal(t, int32(2), resp.RepeatCount)
+		assert.Equal(t, 30*time.Minute, resp.RepeatInterval.AsDuration())
+		require.Len(t, resp.Steps, 2)
+		assert.Equal(t, int32(2), resp.Steps[1].StepNumber)
+		assert.Equal(t, 15*time.Minute, resp.Steps[1].Delay.AsDuration())
+		assert.Equal(t, "team-1", resp.Steps[1].Targets[0].TeamId)
+		assert.Equal(t, "user-1", resp.Steps[1].Targets[1].UserId)
+		assert.NotNil(t, resp.CreatedAt)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{
+			Policy: newTestEscalationPolicy("Network Critical"),
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("nil policy", func(t *testing.T) {
+		_, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("policy without steps", func(t *testing.T) {
+		_, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{
+			Policy: &routingv1.EscalationPolicy{Name: "Empty"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("channel target is not supported", func(t *testing.T) {
+		policy := newTestEscalationPolicy("Channels")
+		policy.Steps[0].Targets = []*routingv1.EscalationTarget{
+			{Type: routingv1.EscalationTargetType_ESCALATION_TARGET_TYPE_CHANNEL},
+		}
+		_, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{Policy: policy})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestEscalationService_GetUpdateDelete(t *testing.T) {
+	svc := setupEscalationService(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{
+		Policy: newTestEscalationPolicy("Network Critical"),
+	})
+	require.NoError(t, err)
+
+	fetched, err := svc.GetEscalationPolicy(ctx, &routingv1.GetEscalationPolicyRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.Equal(t, created.Name, fetched.Name)
+	assert.Equal(t, "schedule-1", fetched.Steps[0].Targets[0].ScheduleId)
+
+	_, err = svc.GetEscalationPolicy(ctx, &routingv1.GetEscalationPolicyRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.GetEscalationPolicy(ctx, &routingv1.GetEscalationPolicyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	fetched.Name = "Network Critical v2"
+	fetched.Steps = fetched.Steps[:1]
+	updated, err := svc.UpdateEscalationPolicy(ctx, &routingv1.UpdateEscalationPolicyRequest{Policy: fetched})
+	require.NoError(t, err)
+	assert.Equal(t, "Network Critical v2", updated.Name)
+	assert.Len(t, updated.Steps, 1)
+	assert.Equal(t, created.CreatedAt.AsTime(), updated.CreatedAt.AsTime())
+
+	missing := newTestEscalationPolicy("Missing")
+	missing.Id = "missing"
+	_, err = svc.UpdateEscalationPolicy(ctx, &routingv1.UpdateEscalationPolicyRequest{Policy: missing})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := svc.DeleteEscalationPolicy(ctx, &routingv1.DeleteEscalationPolicyRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = svc.DeleteEscalationPolicy(ctx, &routingv1.DeleteEscalationPolicyRequest{Id: created.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestEscalationService_ListEscalationPolicies(t *testing.T) {
+	svc := setupEscalationService(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Charlie", "Alpha", "Bravo"} {
+		_, err := svc.CreateEscalationPolicy(ctx, &routingv1.CreateEscalationPolicyRequest{
+			Policy: newTestEscalationPolicy(name),
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := svc.ListEscalationPolicies(ctx, &routingv1.ListEscalationPoliciesRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Policies, 2)
+	assert.Equal(t, "Alpha", resp.Policies[0].Name)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = svc.ListEscalationPolicies(ctx, &routingv1.ListEscalationPoliciesRequest{PageSize: 2, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.Policies, 1)
+	assert.Equal(t, "Charlie", resp.Policies[0].Name)
+	assert.Empty(t, resp.NextPageToken)
+}
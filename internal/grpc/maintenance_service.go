@@ -48,6 +48,7 @@ func (s *MaintenanceService) CreateMaintenanceWindow(ctx context.Context, req *r
 	if req.Window.EndTime == nil {
 		return nil, status.Error(codes.InvalidArgument, "end_time is required")
 	}
+	req.Window.CreatedBy = authenticatedUserID(ctx, req.Window.CreatedBy)
 
 	s.logger.Info().
 		Str("name", req.Window.Name).
@@ -107,6 +108,30 @@ func (s *MaintenanceService) UpdateMaintenanceWindow(ctx context.Context, req *r
 		return nil, status.Error(codes.InvalidArgument, "window with id is required")
 	}
 
+	existing, err := s.store.Get(ctx, req.Window.Id)
+	if err != nil {
+		if errors.Is(err, maintenance.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "maintenance window not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.Window.Id).Msg("failed to get maintenance window")
+		return nil, status.Error(codes.Internal, "failed to update maintenance window")
+	}
+
+	// A window awaiting approval can only be kept pending or cancelled; it is
+	// scheduled by ApproveMaintenanceWindow
+	if err := maintenance.CheckCanStart(existing); err != nil {
+		switch req.Window.Status {
+		case routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL,
+			routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED:
+		default:
+			s.logger.Warn().
+				Str("id", req.Window.Id).
+				Str("status", req.Window.Status.String()).
+				Msg("rejected transition of unapproved maintenance window")
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot transition window to %s: %v", req.Window.Status, err)
+		}
+	}
+
 	s.logger.Info().
 		Str("id", req.Window.Id).
 		Str("name", req.Window.Name).
@@ -208,6 +233,86 @@ func (s *MaintenanceService) CheckAlertMaintenance(ctx context.Context, req *rou
 	}, nil
 }
 
+// ExpandMaintenanceWindow adds sites and services to an in-progress maintenance
+// window and optionally extends its end time.
+func (s *MaintenanceService) ExpandMaintenanceWindow(ctx context.Context, req *routingv1.ExpandMaintenanceWindowRequest) (*routingv1.MaintenanceWindow, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var extendBy time.Duration
+	if req.ExtendBy != nil {
+		if err := req.ExtendBy.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid extend_by: %v", err)
+		}
+		extendBy = req.ExtendBy.AsDuration()
+	}
+
+	s.logger.Info().
+		Str("id", req.Id).
+		Strs("additionalSites", req.AdditionalSites).
+		Strs("additionalServices", req.AdditionalServices).
+		Dur("extendBy", extendBy).
+		Msg("expanding maintenance window")
+
+	window, err := s.store.ExpandMaintenanceWindow(ctx, req.Id, req.AdditionalSites, req.AdditionalServices, extendBy)
+	if err != nil {
+		if errors.Is(err, maintenance.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "maintenance window not found")
+		}
+		if errors.Is(err, maintenance.ErrInvalidStatus) {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot expand window: %v", err)
+		}
+		if errors.Is(err, maintenance.ErrInvalidWindow) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expansion: %v", err)
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to expand maintenance window")
+		return nil, status.Error(codes.Internal, "failed to expand maintenance window")
+	}
+
+	s.logger.Info().
+		Str("id", window.Id).
+		Time("endTime", window.EndTime.AsTime()).
+		Msg("maintenance window expanded")
+
+	return window, nil
+}
+
+// ApproveMaintenanceWindow records change-board approval of a window pending
+// approval and schedules it.
+func (s *MaintenanceService) ApproveMaintenanceWindow(ctx context.Context, req *routingv1.ApproveMaintenanceWindowRequest) (*routingv1.MaintenanceWindow, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	req.ApprovedBy = authenticatedUserID(ctx, req.ApprovedBy)
+
+	if req.ApprovedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "approved_by is required")
+	}
+
+	window, err := s.store.ApproveMaintenanceWindow(ctx, req.Id, req.ApprovedBy)
+	if err != nil {
+		if errors.Is(err, maintenance.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "maintenance window not found")
+		}
+		if errors.Is(err, maintenance.ErrInvalidStatus) {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot approve window: %v", err)
+		}
+		if errors.Is(err, maintenance.ErrInvalidWindow) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid approval: %v", err)
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to approve maintenance window")
+		return nil, status.Error(codes.Internal, "failed to approve maintenance window")
+	}
+
+	s.logger.Info().
+		Str("id", window.Id).
+		Str("approvedBy", window.ApprovedBy).
+		Msg("maintenance window approved")
+
+	return window, nil
+}
+
 // CancelMaintenanceWindow cancels an active or scheduled maintenance window.
 func (s *MaintenanceService) CancelMaintenanceWindow(ctx context.Context, id string) error {
 	s.logger.Info().Str("id", id).Msg("cancelling maintenance window")
@@ -218,8 +323,9 @@ func (s *MaintenanceService) CancelMaintenanceWindow(ctx context.Context, id str
 		return err
 	}
 
-	// Only scheduled or active windows can be cancelled
-	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED &&
+	// Only pending, scheduled or active windows can be cancelled
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL &&
+		window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED &&
 		window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
 		return maintenance.ErrInvalidStatus
 	}
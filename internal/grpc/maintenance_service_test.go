@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kneutral-org/alerting-system/internal/maintenance"
@@ -127,8 +128,40 @@ func (m *mockMaintenanceStore) UpdateStatus(ctx context.Context, id string, stat
 	return maintenance.ErrNotFound
 }
 
-func (m *mockMaintenanceStore) TransitionStatuses(ctx context.Context) error {
-	return nil
+func (m *mockMaintenanceStore) TransitionStatuses(ctx context.Context) ([]maintenance.StatusTransition, error) {
+	return nil, nil
+}
+
+func (m *mockMaintenanceStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	for _, w := range m.windows {
+		if w.Id != windowID {
+			continue
+		}
+		if w.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+			return nil, maintenance.ErrInvalidStatus
+		}
+		w.AffectedSites = append(w.AffectedSites, additionalSites...)
+		w.AffectedServices = append(w.AffectedServices, additionalServices...)
+		w.EndTime = timestamppb.New(w.EndTime.AsTime().Add(extendBy))
+		return w, nil
+	}
+	return nil, maintenance.ErrNotFound
+}
+
+func (m *mockMaintenanceStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	for _, w := range m.windows {
+		if w.Id != windowID {
+			continue
+		}
+		if w.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL {
+			return nil, maintenance.ErrInvalidStatus
+		}
+		w.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+		w.ApprovedBy = approvedBy
+		w.ApprovedAt = timestamppb.Now()
+		return w, nil
+	}
+	return nil, maintenance.ErrNotFound
 }
 
 func (m *mockMaintenanceStore) addActiveWindow(id, name string, sites, services []string) {
@@ -560,3 +593,133 @@ func TestMaintenanceService_CancelMaintenanceWindow(t *testing.T) {
 		t.Errorf("expected status CANCELLED, got %v", store.windows[0].Status)
 	}
 }
+
+func TestMaintenanceService_ExpandMaintenanceWindow(t *testing.T) {
+	store := newMockMaintenanceStore()
+	store.addActiveWindow("window-1", "Test Window", []string{"site-1"}, nil)
+	endTime := store.windows[0].EndTime.AsTime()
+
+	service := NewMaintenanceService(store, zerolog.Nop())
+
+	window, err := service.ExpandMaintenanceWindow(context.Background(), &routingv1.ExpandMaintenanceWindowRequest{
+		Id:                 "window-1",
+		AdditionalSites:    []string{"site-2"},
+		AdditionalServices: []string{"svc-1"},
+		ExtendBy:           durationpb.New(30 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(window.AffectedSites) != 2 || window.AffectedSites[1] != "site-2" {
+		t.Errorf("expected sites [site-1 site-2], got %v", window.AffectedSites)
+	}
+	if len(window.AffectedServices) != 1 || window.AffectedServices[0] != "svc-1" {
+		t.Errorf("expected services [svc-1], got %v", window.AffectedServices)
+	}
+	if want := endTime.Add(30 * time.Minute); !window.EndTime.AsTime().Equal(want) {
+		t.Errorf("expected end time %v, got %v", want, window.EndTime.AsTime())
+	}
+}
+
+func TestMaintenanceService_ExpandMaintenanceWindow_Errors(t *testing.T) {
+	store := newMockMaintenanceStore()
+	store.addActiveWindow("window-1", "Active", nil, nil)
+	store.addActiveWindow("window-2", "Scheduled", nil, nil)
+	store.windows[1].Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+
+	service := NewMaintenanceService(store, zerolog.Nop())
+
+	tests := []struct {
+		name string
+		req  *routingv1.ExpandMaintenanceWindowRequest
+		code codes.Code
+	}{
+		{"missing id", &routingv1.ExpandMaintenanceWindowRequest{}, codes.InvalidArgument},
+		{"not found", &routingv1.ExpandMaintenanceWindowRequest{Id: "missing"}, codes.NotFound},
+		{"not in progress", &routingv1.ExpandMaintenanceWindowRequest{Id: "window-2"}, codes.FailedPrecondition},
+		{"invalid duration", &routingv1.ExpandMaintenanceWindowRequest{
+			Id:       "window-1",
+			ExtendBy: &durationpb.Duration{Seconds: 1, Nanos: -1},
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ExpandMaintenanceWindow(context.Background(), tt.req)
+			if st, _ := status.FromError(err); st.Code() != tt.code {
+				t.Errorf("expected code %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceService_ApproveMaintenanceWindow(t *testing.T) {
+	store := newMockMaintenanceStore()
+	store.addActiveWindow("window-1", "Core switch upgrade", nil, nil)
+	store.windows[0].RequiresApproval = true
+	store.windows[0].Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL
+
+	service := NewMaintenanceService(store, zerolog.Nop())
+
+	start := &routingv1.UpdateMaintenanceWindowRequest{
+		Window: &routingv1.MaintenanceWindow{
+			Id:               "window-1",
+			Name:             "Core switch upgrade",
+			StartTime:        store.windows[0].StartTime,
+			EndTime:          store.windows[0].EndTime,
+			Status:           routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+			RequiresApproval: true,
+		},
+	}
+	_, err := service.UpdateMaintenanceWindow(context.Background(), start)
+	if st, _ := status.FromError(err); st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition starting an unapproved window, got %v", err)
+	}
+
+	window, err := service.ApproveMaintenanceWindow(context.Background(), &routingv1.ApproveMaintenanceWindowRequest{
+		Id:         "window-1",
+		ApprovedBy: "change-board",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED {
+		t.Errorf("expected status SCHEDULED, got %v", window.Status)
+	}
+	if window.ApprovedBy != "change-board" || window.ApprovedAt == nil {
+		t.Errorf("expected approval by change-board to be recorded, got %q at %v", window.ApprovedBy, window.ApprovedAt)
+	}
+
+	start.Window.ApprovedBy = window.ApprovedBy
+	if _, err := service.UpdateMaintenanceWindow(context.Background(), start); err != nil {
+		t.Errorf("expected approved window to start, got %v", err)
+	}
+}
+
+func TestMaintenanceService_ApproveMaintenanceWindow_Errors(t *testing.T) {
+	store := newMockMaintenanceStore()
+	store.addActiveWindow("window-1", "Active", nil, nil)
+
+	service := NewMaintenanceService(store, zerolog.Nop())
+
+	tests := []struct {
+		name string
+		req  *routingv1.ApproveMaintenanceWindowRequest
+		code codes.Code
+	}{
+		{"missing id", &routingv1.ApproveMaintenanceWindowRequest{ApprovedBy: "change-board"}, codes.InvalidArgument},
+		{"missing approver", &routingv1.ApproveMaintenanceWindowRequest{Id: "window-1"}, codes.InvalidArgument},
+		{"not found", &routingv1.ApproveMaintenanceWindowRequest{Id: "missing", ApprovedBy: "change-board"}, codes.NotFound},
+		{"not pending approval", &routingv1.ApproveMaintenanceWindowRequest{Id: "window-1", ApprovedBy: "change-board"}, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ApproveMaintenanceWindow(context.Background(), tt.req)
+			if st, _ := status.FromError(err); st.Code() != tt.code {
+				t.Errorf("expected code %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
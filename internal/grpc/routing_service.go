@@ -13,6 +13,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/site"
+	"github.com/kneutral-org/alerting-system/internal/store"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -21,16 +23,75 @@ type RoutingService struct {
 	routingv1.UnimplementedRoutingServiceServer
 	store     routing.Store
 	evaluator *routing.Evaluator
+	engine    *routing.Engine
+	dryRunner *routing.DryRunner
+	conflicts *routing.ConflictDetector
 	logger    zerolog.Logger
 }
 
+// RoutingServiceOption configures optional RoutingService dependencies.
+type RoutingServiceOption func(*routingServiceOptions)
+
+type routingServiceOptions struct {
+	evaluatorOpts []routing.EvaluatorOption
+	engineOpts    []routing.EngineOption
+	alertStore    store.AlertStore
+}
+
+// WithRoutingEvaluatorOptions configures the rule evaluator, e.g. with a condition profiler.
+func WithRoutingEvaluatorOptions(opts ...routing.EvaluatorOption) RoutingServiceOption {
+	return func(o *routingServiceOptions) {
+		o.evaluatorOpts = append(o.evaluatorOpts, opts...)
+	}
+}
+
+// WithRoutingEngineOptions configures the routing engine, e.g. with a metrics registry.
+func WithRoutingEngineOptions(opts ...routing.EngineOption) RoutingServiceOption {
+	return func(o *routingServiceOptions) {
+		o.engineOpts = append(o.engineOpts, opts...)
+	}
+}
+
+// WithRoutingSiteStore resolves alert sites for rules scoped to regions or site types.
+func WithRoutingSiteStore(store site.Store) RoutingServiceOption {
+	return func(o *routingServiceOptions) {
+		o.evaluatorOpts = append(o.evaluatorOpts, routing.WithSiteStore(store))
+	}
+}
+
+// WithRoutingAlertStore enables DryRunRoutingRule against the alerts in the store.
+func WithRoutingAlertStore(alertStore store.AlertStore) RoutingServiceOption {
+	return func(o *routingServiceOptions) {
+		o.alertStore = alertStore
+	}
+}
+
 // NewRoutingService creates a new RoutingService.
-func NewRoutingService(store routing.Store, logger zerolog.Logger) *RoutingService {
-	return &RoutingService{
+func NewRoutingService(store routing.Store, logger zerolog.Logger, opts ...RoutingServiceOption) *RoutingService {
+	options := &routingServiceOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	evaluator := routing.NewEvaluator(options.evaluatorOpts...)
+	svc := &RoutingService{
 		store:     store,
-		evaluator: routing.NewEvaluator(),
+		evaluator: evaluator,
+		engine:    routing.NewEngine(store, evaluator, logger, options.engineOpts...),
+		conflicts: routing.NewConflictDetector(store),
 		logger:    logger.With().Str("service", "routing").Logger(),
 	}
+	if options.alertStore != nil {
+		svc.dryRunner = routing.NewDryRunner(options.alertStore, evaluator)
+	}
+	return svc
+}
+
+// Engine returns the routing engine used by RouteAlert, e.g. to warm it up on
+// start. Rule changes made through the service invalidate it, so other users
+// of the rules should share it rather than create their own.
+func (s *RoutingService) Engine() *routing.Engine {
+	return s.engine
 }
 
 // CreateRoutingRule creates a new routing rule.
@@ -42,6 +103,7 @@ func (s *RoutingService) CreateRoutingRule(ctx context.Context, req *routingv1.C
 	if req.Rule.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "rule name is required")
 	}
+	req.Rule.CreatedBy = authenticatedUserID(ctx, req.Rule.CreatedBy)
 
 	s.logger.Info().
 		Str("name", req.Rule.Name).
@@ -50,6 +112,9 @@ func (s *RoutingService) CreateRoutingRule(ctx context.Context, req *routingv1.C
 
 	rule, err := s.store.CreateRule(ctx, req.Rule)
 	if err != nil {
+		if errors.Is(err, routing.ErrInvalidRule) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if errors.Is(err, routing.ErrDuplicatePriority) {
 			return nil, status.Error(codes.AlreadyExists, "priority already exists")
 		}
@@ -57,6 +122,8 @@ func (s *RoutingService) CreateRoutingRule(ctx context.Context, req *routingv1.C
 		return nil, status.Error(codes.Internal, "failed to create routing rule")
 	}
 
+	s.engine.Invalidate()
+
 	s.logger.Info().
 		Str("id", rule.Id).
 		Str("name", rule.Name).
@@ -110,6 +177,9 @@ func (s *RoutingService) UpdateRoutingRule(ctx context.Context, req *routingv1.U
 		if errors.Is(err, routing.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "routing rule not found")
 		}
+		if errors.Is(err, routing.ErrInvalidRule) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if errors.Is(err, routing.ErrDuplicatePriority) {
 			return nil, status.Error(codes.AlreadyExists, "priority already exists")
 		}
@@ -117,6 +187,8 @@ func (s *RoutingService) UpdateRoutingRule(ctx context.Context, req *routingv1.U
 		return nil, status.Error(codes.Internal, "failed to update routing rule")
 	}
 
+	s.engine.Invalidate()
+
 	s.logger.Info().
 		Str("id", rule.Id).
 		Msg("routing rule updated")
@@ -141,6 +213,8 @@ func (s *RoutingService) DeleteRoutingRule(ctx context.Context, req *routingv1.D
 		return nil, status.Error(codes.Internal, "failed to delete routing rule")
 	}
 
+	s.engine.Invalidate()
+
 	s.logger.Info().Str("id", req.Id).Msg("routing rule deleted")
 
 	return &routingv1.DeleteRoutingRuleResponse{Success: true}, nil
@@ -162,9 +236,29 @@ func (s *RoutingService) ReorderRoutingRules(ctx context.Context, req *routingv1
 		return nil, status.Error(codes.Internal, "failed to reorder routing rules")
 	}
 
+	s.engine.Invalidate()
+
 	return &routingv1.ReorderRoutingRulesResponse{UpdatedRules: rules}, nil
 }
 
+// CompactRulePriorities renumbers the priorities of all rules sequentially,
+// closing the gaps left by deleted rules.
+func (s *RoutingService) CompactRulePriorities(ctx context.Context, req *routingv1.CompactRulePrioritiesRequest) (*routingv1.CompactRulePrioritiesResponse, error) {
+	rules, err := s.store.CompactPriorities(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to compact routing rule priorities")
+		return nil, status.Error(codes.Internal, "failed to compact routing rule priorities")
+	}
+
+	s.engine.Invalidate()
+
+	s.logger.Info().
+		Int("count", len(rules)).
+		Msg("compacted routing rule priorities")
+
+	return &routingv1.CompactRulePrioritiesResponse{Rules: rules}, nil
+}
+
 // TestRoutingRule tests a routing rule against a sample alert (dry-run).
 func (s *RoutingService) TestRoutingRule(ctx context.Context, req *routingv1.TestRoutingRuleRequest) (*routingv1.TestRoutingRuleResponse, error) {
 	if req.Rule == nil {
@@ -187,7 +281,7 @@ func (s *RoutingService) TestRoutingRule(ctx context.Context, req *routingv1.Tes
 		Msg("testing routing rule")
 
 	// Evaluate the rule
-	eval := s.evaluator.EvaluateRule(req.Rule, req.SampleAlert, evalTime)
+	eval := s.evaluator.EvaluateRule(ctx, req.Rule, req.SampleAlert, evalTime)
 
 	resp := &routingv1.TestRoutingRuleResponse{
 		Matched:              eval.Matched,
@@ -203,6 +297,66 @@ func (s *RoutingService) TestRoutingRule(ctx context.Context, req *routingv1.Tes
 	return resp, nil
 }
 
+// DryRunRoutingRule tests a routing rule against the currently triggered alerts.
+// No actions are executed.
+func (s *RoutingService) DryRunRoutingRule(ctx context.Context, req *routingv1.DryRunRoutingRuleRequest) (*routingv1.DryRunRoutingRuleResponse, error) {
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	if s.dryRunner == nil {
+		return nil, status.Error(codes.FailedPrecondition, "alert store is not configured")
+	}
+
+	result, err := s.dryRunner.DryRunRule(ctx, req.Rule)
+	if err != nil {
+		s.logger.Error().Err(err).Str("rule_name", req.Rule.Name).Msg("failed to dry-run routing rule")
+		return nil, status.Error(codes.Internal, "failed to dry-run routing rule")
+	}
+
+	resp := &routingv1.DryRunRoutingRuleResponse{
+		MatchedCount:   int32(result.MatchedCount),
+		UnmatchedCount: int32(result.UnmatchedCount),
+	}
+	for _, match := range result.Samples {
+		resp.Samples = append(resp.Samples, &routingv1.DryRunMatch{
+			AlertId:           match.AlertID,
+			Summary:           match.Summary,
+			MatchedConditions: match.MatchedConditions,
+		})
+	}
+
+	return resp, nil
+}
+
+// DetectRuleConflicts lists the enabled rules that can fire for the same alerts
+// as a rule, causing duplicate notifications.
+func (s *RoutingService) DetectRuleConflicts(ctx context.Context, req *routingv1.DetectRuleConflictsRequest) (*routingv1.DetectRuleConflictsResponse, error) {
+	if req.RuleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rule_id is required")
+	}
+
+	reports, err := s.conflicts.DetectConflicts(ctx, req.RuleId)
+	if err != nil {
+		if errors.Is(err, routing.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "routing rule not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.RuleId).Msg("failed to detect routing rule conflicts")
+		return nil, status.Error(codes.Internal, "failed to detect routing rule conflicts")
+	}
+
+	resp := &routingv1.DetectRuleConflictsResponse{}
+	for _, report := range reports {
+		resp.Conflicts = append(resp.Conflicts, &routingv1.RuleConflict{
+			RuleId:      report.RuleID,
+			RuleName:    report.RuleName,
+			Priority:    report.Priority,
+			Description: report.Description,
+		})
+	}
+
+	return resp, nil
+}
+
 // SimulateRouting simulates the full routing pipeline for an alert.
 func (s *RoutingService) SimulateRouting(ctx context.Context, req *routingv1.SimulateRoutingRequest) (*routingv1.SimulateRoutingResponse, error) {
 	if req.Alert == nil {
@@ -241,7 +395,7 @@ func (s *RoutingService) SimulateRouting(ctx context.Context, req *routingv1.Sim
 	}
 
 	// Evaluate all rules
-	evaluations, matchedActions := s.evaluator.EvaluateRules(rules, req.Alert, evalTime)
+	evaluations, matchedActions := s.evaluator.EvaluateRules(ctx, rules, req.Alert, evalTime)
 
 	// Convert actions to ActionExecution for simulation
 	var actionExecs []*routingv1.ActionExecution
@@ -304,23 +458,21 @@ func (s *RoutingService) RouteAlert(ctx context.Context, req *routingv1.RouteAle
 		Str("fingerprint", req.Alert.Fingerprint).
 		Msg("routing alert")
 
-	// Get enabled rules
-	rules, err := s.store.GetEnabledRulesByPriority(ctx)
+	// Evaluate the enabled rules, cached by the engine
+	evalTime := time.Now()
+	evaluations, matchedActions, err := s.engine.Evaluate(ctx, req.Alert, evalTime)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("failed to get enabled rules")
 		return nil, status.Error(codes.Internal, "failed to get enabled rules")
 	}
 
-	// Evaluate rules
-	evalTime := time.Now()
-	evaluations, matchedActions := s.evaluator.EvaluateRules(rules, req.Alert, evalTime)
-
 	// Create audit log
 	auditLog := &routingv1.RoutingAuditLog{
-		AlertId:     req.Alert.Id,
-		Timestamp:   timestamppb.New(evalTime),
-		Evaluations: evaluations,
-		Executions:  make([]*routingv1.ActionExecution, 0),
+		AlertId:         req.Alert.Id,
+		Timestamp:       timestamppb.New(evalTime),
+		Evaluations:     evaluations,
+		Executions:      make([]*routingv1.ActionExecution, 0),
+		StoppedAtRuleId: routing.StoppedAtRuleID(evaluations),
 	}
 
 	// Create alert snapshot
@@ -381,10 +533,22 @@ func (s *RoutingService) RouteAlert(ctx context.Context, req *routingv1.RouteAle
 			// TODO: Create ticket via ticket integration
 			exec.Success = true
 
+		case routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY:
+			// TODO: Forward via notification.PagerDutyForwarder
+			exec.Success = true
+
+		case routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS:
+			// TODO: Publish via the SNS service
+			exec.Success = true
+
 		case routingv1.ActionType_ACTION_TYPE_SET_LABEL:
 			// TODO: Update alert labels
 			exec.Success = true
 
+		case routingv1.ActionType_ACTION_TYPE_APPLY_TIER_ROUTING:
+			// Applied by routing.Engine when evaluating the rules
+			exec.Success = true
+
 		default:
 			exec.Success = false
 			exec.ErrorMessage = "unknown action type"
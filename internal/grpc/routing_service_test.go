@@ -3,14 +3,18 @@ package grpc
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/store"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
 func newTestService() *RoutingService {
@@ -307,6 +311,33 @@ func TestRoutingService_ReorderRoutingRules(t *testing.T) {
 	}
 }
 
+func TestRoutingService_CompactRulePriorities(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	for _, priority := range []int32{1, 5, 8, 20} {
+		if _, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{
+			Rule: &routingv1.RoutingRule{Name: "Rule", Priority: priority, Enabled: true},
+		}); err != nil {
+			t.Fatalf("CreateRoutingRule() error = %v", err)
+		}
+	}
+
+	resp, err := svc.CompactRulePriorities(ctx, &routingv1.CompactRulePrioritiesRequest{})
+	if err != nil {
+		t.Fatalf("CompactRulePriorities() error = %v", err)
+	}
+
+	if len(resp.Rules) != 4 {
+		t.Fatalf("CompactRulePriorities() count = %d, want 4", len(resp.Rules))
+	}
+	for i, rule := range resp.Rules {
+		if rule.Priority != int32(i+1) {
+			t.Errorf("rule %d priority = %d, want %d", i, rule.Priority, i+1)
+		}
+	}
+}
+
 func TestRoutingService_ReorderRoutingRules_Empty(t *testing.T) {
 	svc := newTestService()
 	ctx := context.Background()
@@ -579,6 +610,100 @@ func TestRoutingService_RouteAlert(t *testing.T) {
 	}
 }
 
+func TestRoutingService_Engine_InvalidatedByRuleChanges(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+	alert := &routingv1.Alert{Id: "alert-1", Labels: map[string]string{"severity": "critical"}}
+
+	// Load the engine's rule cache before the rule exists
+	if _, actions, err := svc.Engine().Evaluate(ctx, alert, time.Now()); err != nil || len(actions) != 0 {
+		t.Fatalf("Evaluate() = %v, %v; want no actions", actions, err)
+	}
+
+	_, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{
+		Rule: &routingv1.RoutingRule{
+			Name:     "Critical",
+			Priority: 1,
+			Enabled:  true,
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "severity",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+					StringValue: "critical",
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_SUPPRESS, Suppress: &routingv1.SuppressAction{Reason: "test"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRoutingRule() error = %v", err)
+	}
+
+	_, actions, err := svc.Engine().Evaluate(ctx, alert, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(actions) != 1 {
+		t.Errorf("Evaluate() returned %d actions, want the action of the new rule", len(actions))
+	}
+}
+
+func TestRoutingService_RouteAlert_StopProcessing(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	rule := func(name string, priority int32, stop bool) *routingv1.RoutingRule {
+		return &routingv1.RoutingRule{
+			Name:           name,
+			Priority:       priority,
+			Enabled:        true,
+			StopProcessing: stop,
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "severity",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+					StringValue: "critical",
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+			},
+		}
+	}
+
+	first, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{Rule: rule("Page critical", 1, true)})
+	if err != nil {
+		t.Fatalf("CreateRoutingRule() error = %v", err)
+	}
+	if _, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{Rule: rule("Log critical", 2, false)}); err != nil {
+		t.Fatalf("CreateRoutingRule() error = %v", err)
+	}
+
+	resp, err := svc.RouteAlert(ctx, &routingv1.RouteAlertRequest{
+		Alert: &routingv1.Alert{
+			Id:     "alert-1",
+			Labels: map[string]string{"severity": "critical"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RouteAlert() error = %v", err)
+	}
+
+	if got := len(resp.AuditLog.Evaluations); got != 1 {
+		t.Errorf("RouteAlert() evaluated %d rules, want the lower-priority rule to be skipped", got)
+	}
+	if got := len(resp.AuditLog.Executions); got != 1 {
+		t.Errorf("RouteAlert() executed %d actions, want 1", got)
+	}
+	if resp.AuditLog.StoppedAtRuleId != first.Id {
+		t.Errorf("RouteAlert() stopped_at_rule_id = %q, want %q", resp.AuditLog.StoppedAtRuleId, first.Id)
+	}
+}
+
 func TestRoutingService_RouteAlert_NilAlert(t *testing.T) {
 	svc := newTestService()
 	ctx := context.Background()
@@ -629,3 +754,149 @@ func TestRoutingService_GetRoutingAuditLogs(t *testing.T) {
 		t.Errorf("GetRoutingAuditLogs() count = %d, want 1", len(resp.Logs))
 	}
 }
+
+func TestRoutingService_DryRunRoutingRule(t *testing.T) {
+	logger := zerolog.New(os.Stderr).Level(zerolog.Disabled)
+	alerts := []*alertingv1.Alert{
+		{Id: "a1", Summary: "Disk full", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, Labels: map[string]string{"severity": "critical"}},
+		{Id: "a2", Summary: "CPU high", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, Labels: map[string]string{"severity": "warning"}},
+		{Id: "a3", Summary: "Link down", Status: alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, Labels: map[string]string{"severity": "critical"}},
+	}
+	svc := NewRoutingService(routing.NewInMemoryStore(), logger, WithRoutingAlertStore(&dryRunAlertStore{alerts: alerts}))
+
+	resp, err := svc.DryRunRoutingRule(context.Background(), &routingv1.DryRunRoutingRuleRequest{
+		Rule: &routingv1.RoutingRule{
+			Name: "Critical alerts",
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "severity",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+					StringValue: "critical",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DryRunRoutingRule() error = %v", err)
+	}
+
+	if resp.MatchedCount != 1 || resp.UnmatchedCount != 1 {
+		t.Errorf("DryRunRoutingRule() matched = %d, unmatched = %d, want 1 and 1", resp.MatchedCount, resp.UnmatchedCount)
+	}
+	if len(resp.Samples) != 1 || resp.Samples[0].AlertId != "a1" || resp.Samples[0].Summary != "Disk full" {
+		t.Errorf("DryRunRoutingRule() samples = %v", resp.Samples)
+	}
+}
+
+func TestRoutingService_DryRunRoutingRule_Errors(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	_, err := svc.DryRunRoutingRule(ctx, &routingv1.DryRunRoutingRuleRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DryRunRoutingRule() nil rule error = %v, want InvalidArgument", err)
+	}
+
+	_, err = svc.DryRunRoutingRule(ctx, &routingv1.DryRunRoutingRuleRequest{Rule: &routingv1.RoutingRule{Name: "rule"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DryRunRoutingRule() without alert store error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestRoutingService_DetectRuleConflicts(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	teamCondition := func(team string) []*routingv1.RoutingCondition {
+		return []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "team",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: team,
+			},
+		}
+	}
+	for _, rule := range []*routingv1.RoutingRule{
+		{Id: "db-page", Name: "DB page", Priority: 1, Enabled: true, Conditions: teamCondition("db")},
+		{Id: "db-slack", Name: "DB slack", Priority: 2, Enabled: true, Conditions: teamCondition("db")},
+		{Id: "network", Name: "Network", Priority: 3, Enabled: true, Conditions: teamCondition("network")},
+	} {
+		if _, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{Rule: rule}); err != nil {
+			t.Fatalf("CreateRoutingRule() error = %v", err)
+		}
+	}
+
+	resp, err := svc.DetectRuleConflicts(ctx, &routingv1.DetectRuleConflictsRequest{RuleId: "db-page"})
+	if err != nil {
+		t.Fatalf("DetectRuleConflicts() error = %v", err)
+	}
+	if len(resp.Conflicts) != 1 || resp.Conflicts[0].RuleId != "db-slack" || resp.Conflicts[0].Description == "" {
+		t.Errorf("DetectRuleConflicts() conflicts = %v, want db-slack", resp.Conflicts)
+	}
+
+	resp, err = svc.DetectRuleConflicts(ctx, &routingv1.DetectRuleConflictsRequest{RuleId: "network"})
+	if err != nil {
+		t.Fatalf("DetectRuleConflicts() error = %v", err)
+	}
+	if len(resp.Conflicts) != 0 {
+		t.Errorf("DetectRuleConflicts() conflicts = %v, want none", resp.Conflicts)
+	}
+}
+
+func TestRoutingService_DetectRuleConflicts_Errors(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	_, err := svc.DetectRuleConflicts(ctx, &routingv1.DetectRuleConflictsRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DetectRuleConflicts() empty rule ID error = %v, want InvalidArgument", err)
+	}
+
+	_, err = svc.DetectRuleConflicts(ctx, &routingv1.DetectRuleConflictsRequest{RuleId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("DetectRuleConflicts() unknown rule error = %v, want NotFound", err)
+	}
+}
+
+// dryRunAlertStore serves a fixed set of alerts from List.
+type dryRunAlertStore struct {
+	store.AlertStore
+	alerts []*alertingv1.Alert
+}
+
+func (s *dryRunAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	return &alertingv1.ListAlertsResponse{Alerts: s.alerts}, nil
+}
+
+func TestRoutingService_CreateRoutingRule_InvalidRegex(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	_, err := svc.CreateRoutingRule(ctx, &routingv1.CreateRoutingRuleRequest{
+		Rule: &routingv1.RoutingRule{
+			Name:     "Bad regex",
+			Priority: 1,
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "host",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH,
+					StringValue: "web-(",
+				},
+			},
+		},
+	})
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("Expected gRPC status error, got %v", err)
+	}
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("CreateRoutingRule() code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+	if !strings.Contains(st.Message(), "invalid regex") {
+		t.Errorf("CreateRoutingRule() message = %q, want it to describe the invalid regex", st.Message())
+	}
+}
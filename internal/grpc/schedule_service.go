@@ -4,15 +4,23 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kneutral-org/alerting-system/internal/schedule"
+	"github.com/kneutral-org/alerting-system/internal/team"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
 // ScheduleService implements the ScheduleServiceServer interface.
@@ -21,15 +29,76 @@ type ScheduleService struct {
 	store      schedule.Store
 	calculator *schedule.Calculator
 	logger     zerolog.Logger
+
+	// events receives schedule changes for stream subscribers (optional)
+	events *schedule.EventBus
+
+	// availability warns when overrides are assigned to unavailable team members (optional)
+	availability AvailabilityChecker
+
+	// alertComments adds the alert comments of the outgoing shift to handoff summaries (optional)
+	alertComments AlertCommentLister
+}
+
+// AvailabilityWarningHeader is the response header set by CreateOverride when the
+// override user is marked unavailable during the override.
+const AvailabilityWarningHeader = "x-availability-warning"
+
+// AvailabilityChecker returns the availability of team members. It is satisfied by team.Store.
+type AvailabilityChecker interface {
+	GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error)
+}
+
+// AlertCommentLister returns recent alert comments. It is satisfied by comment.Store.
+type AlertCommentLister interface {
+	ListSince(ctx context.Context, since time.Time, limit int) ([]*alertingv1.AlertComment, error)
+}
+
+// ScheduleServiceOption configures optional ScheduleService dependencies.
+type ScheduleServiceOption func(*ScheduleService)
+
+// WithScheduleEventBus publishes rotation and override changes to the event bus.
+func WithScheduleEventBus(bus *schedule.EventBus) ScheduleServiceOption {
+	return func(s *ScheduleService) {
+		s.events = bus
+	}
+}
+
+// WithAvailabilityChecker makes CreateOverride warn, without failing, when the
+// override user is marked unavailable in the schedule's team.
+func WithAvailabilityChecker(checker AvailabilityChecker) ScheduleServiceOption {
+	return func(s *ScheduleService) {
+		s.availability = checker
+	}
+}
+
+// WithAlertComments includes the comments left on alerts during the outgoing
+// shift in handoff summaries.
+func WithAlertComments(comments AlertCommentLister) ScheduleServiceOption {
+	return func(s *ScheduleService) {
+		s.alertComments = comments
+	}
 }
 
 // NewScheduleService creates a new ScheduleService.
-func NewScheduleService(store schedule.Store, logger zerolog.Logger) *ScheduleService {
-	return &ScheduleService{
+func NewScheduleService(store schedule.Store, logger zerolog.Logger, opts ...ScheduleServiceOption) *ScheduleService {
+	s := &ScheduleService{
 		store:      store,
 		calculator: schedule.NewCalculator(),
 		logger:     logger.With().Str("service", "schedule").Logger(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// publish sends a schedule change to stream subscribers, if an event bus is configured.
+func (s *ScheduleService) publish(scheduleID string, eventType schedule.EventType, data proto.Message) {
+	if s.events == nil {
+		return
+	}
+	s.events.Publish(scheduleID, eventType, data)
 }
 
 // =============================================================================
@@ -118,6 +187,8 @@ func (s *ScheduleService) UpdateSchedule(ctx context.Context, req *routingv1.Upd
 		Str("id", sched.Id).
 		Msg("schedule updated")
 
+	s.publish(sched.Id, schedule.EventScheduleUpdated, sched)
+
 	return sched, nil
 }
 
@@ -175,6 +246,8 @@ func (s *ScheduleService) AddRotation(ctx context.Context, req *routingv1.AddRot
 		Str("schedule_id", req.ScheduleId).
 		Msg("rotation added")
 
+	s.publish(req.ScheduleId, schedule.EventRotationAdded, sched)
+
 	return sched, nil
 }
 
@@ -207,9 +280,49 @@ func (s *ScheduleService) UpdateRotation(ctx context.Context, req *routingv1.Upd
 		Str("rotation_id", req.Rotation.Id).
 		Msg("rotation updated")
 
+	s.publish(req.ScheduleId, schedule.EventRotationUpdated, sched)
+
 	return sched, nil
 }
 
+// ReorderRotationMembers changes the rotation order of a rotation's members.
+func (s *ScheduleService) ReorderRotationMembers(ctx context.Context, req *routingv1.ReorderRotationMembersRequest) (*routingv1.Rotation, error) {
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+
+	if req.RotationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rotation_id is required")
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Str("rotation_id", req.RotationId).
+		Strs("ordered_user_ids", req.OrderedUserIds).
+		Msg("reordering rotation members")
+
+	rotation, err := s.store.ReorderRotationMembers(ctx, req.ScheduleId, req.RotationId, req.OrderedUserIds)
+	if err != nil {
+		if errors.Is(err, schedule.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "schedule or rotation not found")
+		}
+		if errors.Is(err, schedule.ErrMemberMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		s.logger.Error().Err(err).Str("rotation_id", req.RotationId).Msg("failed to reorder rotation members")
+		return nil, status.Error(codes.Internal, "failed to reorder rotation members")
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Str("rotation_id", req.RotationId).
+		Msg("rotation members reordered")
+
+	s.publish(req.ScheduleId, schedule.EventRotationUpdated, rotation)
+
+	return rotation, nil
+}
+
 // RemoveRotation removes a rotation from a schedule.
 func (s *ScheduleService) RemoveRotation(ctx context.Context, req *routingv1.RemoveRotationRequest) (*routingv1.Schedule, error) {
 	if req.ScheduleId == "" {
@@ -239,11 +352,13 @@ func (s *ScheduleService) RemoveRotation(ctx context.Context, req *routingv1.Rem
 		Str("rotation_id", req.RotationId).
 		Msg("rotation removed")
 
+	s.publish(req.ScheduleId, schedule.EventRotationRemoved, sched)
+
 	return sched, nil
 }
 
 // =============================================================================
-// Override management (3 RPCs)
+// Override management (4 RPCs)
 // =============================================================================
 
 // CreateOverride creates a schedule override.
@@ -267,6 +382,18 @@ func (s *ScheduleService) CreateOverride(ctx context.Context, req *routingv1.Cre
 	if req.Override.StartTime.AsTime().After(req.Override.EndTime.AsTime()) {
 		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
 	}
+	req.Override.CreatedBy = authenticatedUserID(ctx, req.Override.CreatedBy)
+
+	if req.DetectConflicts && !req.Force {
+		conflicts, err := s.overlappingOverrides(ctx, req.ScheduleId, req.Override)
+		if err != nil {
+			s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to check override conflicts")
+			return nil, status.Error(codes.Internal, "failed to check override conflicts")
+		}
+		if len(conflicts) > 0 {
+			return nil, overrideConflictError(conflicts)
+		}
+	}
 
 	s.logger.Info().
 		Str("schedule_id", req.ScheduleId).
@@ -289,9 +416,132 @@ func (s *ScheduleService) CreateOverride(ctx context.Context, req *routingv1.Cre
 		Str("override_id", override.Id).
 		Msg("override created")
 
+	s.publish(req.ScheduleId, schedule.EventOverrideCreated, override)
+	s.warnIfUnavailable(ctx, req.ScheduleId, override)
+
 	return override, nil
 }
 
+// overlappingOverrides returns the IDs of the schedule's overrides that overlap
+// the override. Overrides that only touch it, ending as it starts or starting as
+// it ends, do not overlap.
+func (s *ScheduleService) overlappingOverrides(ctx context.Context, scheduleID string, override *routingv1.ScheduleOverride) ([]string, error) {
+	start, end := override.StartTime.AsTime(), override.EndTime.AsTime()
+
+	var conflicts []string
+	pageToken := ""
+	for {
+		resp, err := s.store.ListOverrides(ctx, scheduleID, override.StartTime, override.EndTime, 100, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range resp.Overrides {
+			if existing.StartTime.AsTime().Before(end) && existing.EndTime.AsTime().After(start) {
+				conflicts = append(conflicts, existing.Id)
+			}
+		}
+		if resp.NextPageToken == "" {
+			return conflicts, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// overrideConflictError builds the ALREADY_EXISTS status for conflicting
+// overrides, listing their IDs in OverrideConflictDetails.
+func overrideConflictError(conflicts []string) error {
+	st := status.Newf(codes.AlreadyExists, "override overlaps existing overrides: %s", strings.Join(conflicts, ", "))
+	detailed, err := st.WithDetails(&routingv1.OverrideConflictDetails{ConflictingOverrideIds: conflicts})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// warnIfUnavailable logs a warning and sets the AvailabilityWarningHeader when the
+// override user is marked unavailable during the override. Failures to look up
+// availability are logged and never fail the override.
+func (s *ScheduleService) warnIfUnavailable(ctx context.Context, scheduleID string, override *routingv1.ScheduleOverride) {
+	if s.availability == nil {
+		return
+	}
+
+	sched, err := s.store.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("schedule_id", scheduleID).Msg("failed to get schedule for availability check")
+		return
+	}
+	if sched.TeamId == "" {
+		return
+	}
+
+	start, end := override.StartTime.AsTime(), override.EndTime.AsTime()
+	availabilities, err := s.availability.GetTeamAvailability(ctx, sched.TeamId, start, end)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("team_id", sched.TeamId).Msg("failed to check override user availability")
+		return
+	}
+
+	unavailable := team.FindUnavailability(availabilities, override.UserId, start, end)
+	if unavailable == nil {
+		return
+	}
+
+	reason := strings.ToLower(strings.TrimPrefix(unavailable.UnavailableReason.String(), "UNAVAILABLE_REASON_"))
+	warning := fmt.Sprintf("user %s is unavailable (%s) from %s to %s", override.UserId, reason,
+		unavailable.PeriodStart.AsTime().Format(time.RFC3339), unavailable.PeriodEnd.AsTime().Format(time.RFC3339))
+
+	s.logger.Warn().
+		Str("schedule_id", scheduleID).
+		Str("override_id", override.Id).
+		Str("user_id", override.UserId).
+		Str("reason", reason).
+		Msg("override assigned to unavailable user")
+
+	// Fails outside of a gRPC call, e.g. when called directly in tests
+	_ = grpc.SetHeader(ctx, metadata.Pairs(AvailabilityWarningHeader, warning))
+}
+
+// BulkCreateOverrides creates a batch of schedule overrides atomically.
+func (s *ScheduleService) BulkCreateOverrides(ctx context.Context, req *routingv1.BulkCreateOverridesRequest) (*routingv1.BulkCreateOverridesResponse, error) {
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+	for _, override := range req.Overrides {
+		if override != nil {
+			override.CreatedBy = authenticatedUserID(ctx, override.CreatedBy)
+		}
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Int("count", len(req.Overrides)).
+		Msg("bulk creating overrides")
+
+	overrides, err := s.store.BulkCreateOverrides(ctx, req.ScheduleId, req.Overrides)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidOverride) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, schedule.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "schedule not found")
+		}
+		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to bulk create overrides")
+		return nil, status.Error(codes.Internal, "failed to create overrides")
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Int("created", len(overrides)).
+		Msg("overrides created")
+
+	for _, override := range overrides {
+		s.publish(req.ScheduleId, schedule.EventOverrideCreated, override)
+	}
+
+	return &routingv1.BulkCreateOverridesResponse{Overrides: overrides}, nil
+}
+
 // DeleteOverride deletes a schedule override.
 func (s *ScheduleService) DeleteOverride(ctx context.Context, req *routingv1.DeleteOverrideRequest) (*routingv1.DeleteOverrideResponse, error) {
 	if req.ScheduleId == "" {
@@ -321,9 +571,65 @@ func (s *ScheduleService) DeleteOverride(ctx context.Context, req *routingv1.Del
 		Str("override_id", req.OverrideId).
 		Msg("override deleted")
 
+	s.publish(req.ScheduleId, schedule.EventOverrideDeleted, &routingv1.ScheduleOverride{Id: req.OverrideId})
+
 	return &routingv1.DeleteOverrideResponse{Success: true}, nil
 }
 
+// SplitOverride replaces an override with two overrides split at split_time,
+// handing the second part to new_user_id.
+func (s *ScheduleService) SplitOverride(ctx context.Context, req *routingv1.SplitOverrideRequest) (*routingv1.SplitOverrideResponse, error) {
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+
+	if req.OverrideId == "" {
+		return nil, status.Error(codes.InvalidArgument, "override_id is required")
+	}
+
+	if req.SplitTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "split_time is required")
+	}
+
+	if req.NewUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_user_id is required")
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Str("override_id", req.OverrideId).
+		Str("new_user_id", req.NewUserId).
+		Time("split_time", req.SplitTime.AsTime()).
+		Msg("splitting override")
+
+	halves, err := s.store.SplitOverride(ctx, req.ScheduleId, req.OverrideId, req.SplitTime.AsTime(), req.NewUserId)
+	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidOverride) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, schedule.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "override not found")
+		}
+		s.logger.Error().Err(err).Str("override_id", req.OverrideId).Msg("failed to split override")
+		return nil, status.Error(codes.Internal, "failed to split override")
+	}
+
+	s.logger.Info().
+		Str("schedule_id", req.ScheduleId).
+		Str("override_id", req.OverrideId).
+		Str("first_override_id", halves[0].Id).
+		Str("second_override_id", halves[1].Id).
+		Msg("override split")
+
+	s.publish(req.ScheduleId, schedule.EventOverrideDeleted, &routingv1.ScheduleOverride{Id: req.OverrideId})
+	for _, half := range halves {
+		s.publish(req.ScheduleId, schedule.EventOverrideCreated, half)
+	}
+	s.warnIfUnavailable(ctx, req.ScheduleId, halves[1])
+
+	return &routingv1.SplitOverrideResponse{First: halves[0], Second: halves[1]}, nil
+}
+
 // ListOverrides lists overrides for a schedule.
 func (s *ScheduleService) ListOverrides(ctx context.Context, req *routingv1.ListOverridesRequest) (*routingv1.ListOverridesResponse, error) {
 	if req.ScheduleId == "" {
@@ -332,6 +638,9 @@ func (s *ScheduleService) ListOverrides(ctx context.Context, req *routingv1.List
 
 	resp, err := s.store.ListOverrides(ctx, req.ScheduleId, req.StartTime, req.EndTime, int(req.PageSize), req.PageToken)
 	if err != nil {
+		if errors.Is(err, schedule.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to list overrides")
 		return nil, status.Error(codes.Internal, "failed to list overrides")
 	}
@@ -340,7 +649,7 @@ func (s *ScheduleService) ListOverrides(ctx context.Context, req *routingv1.List
 }
 
 // =============================================================================
-// On-call queries (3 RPCs)
+// On-call queries (4 RPCs)
 // =============================================================================
 
 // GetCurrentOnCall returns the current on-call users for a schedule.
@@ -505,6 +814,146 @@ func (s *ScheduleService) ListUpcomingShifts(ctx context.Context, req *routingv1
 	}, nil
 }
 
+// maxCoverageDepthRange bounds the range of a GetCoverageDepth request.
+const maxCoverageDepthRange = 90 * 24 * time.Hour
+
+// GetCoverageDepth returns the number of on-call users for each hour of a range.
+func (s *ScheduleService) GetCoverageDepth(ctx context.Context, req *routingv1.GetCoverageDepthRequest) (*routingv1.GetCoverageDepthResponse, error) {
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+	if req.From == nil || req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and until are required")
+	}
+	from, until := req.From.AsTime(), req.Until.AsTime()
+	if !until.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "until must be after from")
+	}
+	if until.Sub(from) > maxCoverageDepthRange {
+		return nil, status.Error(codes.InvalidArgument, "range must not exceed 90 days")
+	}
+
+	sched, err := s.store.GetSchedule(ctx, req.ScheduleId)
+	if err != nil {
+		if errors.Is(err, schedule.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "schedule not found")
+		}
+		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to get schedule")
+		return nil, status.Error(codes.Internal, "failed to get schedule")
+	}
+
+	// Get overrides for the time range
+	overridesResp, err := s.store.ListOverrides(ctx, req.ScheduleId, req.From, req.Until, 100, "")
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to get overrides, continuing without")
+		overridesResp = &routingv1.ListOverridesResponse{}
+	}
+
+	entries := s.calculator.GetCoverageDepth(sched, overridesResp.Overrides, from, until)
+
+	resp := &routingv1.GetCoverageDepthResponse{
+		Entries: make([]*routingv1.CoverageDepthEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &routingv1.CoverageDepthEntry{
+			TimeSlot:  timestamppb.New(entry.TimeSlot),
+			UserCount: int32(entry.UserCount),
+			UserIds:   entry.UserIDs,
+		})
+	}
+
+	return resp, nil
+}
+
+const (
+	// maxPreviewRotationRange bounds the range of a PreviewRotation request.
+	maxPreviewRotationRange = 90 * 24 * time.Hour
+	// minPreviewShiftLength bounds the number of shifts a PreviewRotation request generates.
+	minPreviewShiftLength = time.Minute
+)
+
+// PreviewRotation generates the shifts of an unsaved schedule between from and
+// until without touching the store, so rotation changes can be previewed.
+func (s *ScheduleService) PreviewRotation(ctx context.Context, req *routingv1.PreviewRotationRequest) (*routingv1.ListUpcomingShiftsResponse, error) {
+	if req.Schedule == nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule is required")
+	}
+	if req.From == nil || req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and until are required")
+	}
+	from, until := req.From.AsTime(), req.Until.AsTime()
+	if !until.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "until must be after from")
+	}
+	if until.Sub(from) > maxPreviewRotationRange {
+		return nil, status.Error(codes.InvalidArgument, "range must not exceed 90 days")
+	}
+	for _, rotation := range req.Schedule.Rotations {
+		if rotation.StartTime == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rotation %q requires a start_time", rotation.Name)
+		}
+		if length := rotation.GetShiftConfig().GetShiftLength(); length != nil && length.AsDuration() < minPreviewShiftLength {
+			return nil, status.Errorf(codes.InvalidArgument, "rotation %q shift_length must be at least one minute", rotation.Name)
+		}
+	}
+
+	shifts := s.calculator.ListUpcomingShifts(req.Schedule, nil, from, until, "")
+	if shifts == nil {
+		shifts = []*routingv1.Shift{}
+	}
+
+	return &routingv1.ListUpcomingShiftsResponse{Shifts: shifts}, nil
+}
+
+// maxLoadBalanceRange bounds the range of a GetLoadBalance request.
+const maxLoadBalanceRange = 366 * 24 * time.Hour
+
+// GetLoadBalance reports how evenly a schedule's rotation shifts are spread across users.
+func (s *ScheduleService) GetLoadBalance(ctx context.Context, req *routingv1.GetLoadBalanceRequest) (*routingv1.GetLoadBalanceResponse, error) {
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+	if req.From == nil || req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and until are required")
+	}
+	from, until := req.From.AsTime(), req.Until.AsTime()
+	if !until.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "until must be after from")
+	}
+	if until.Sub(from) > maxLoadBalanceRange {
+		return nil, status.Error(codes.InvalidArgument, "range must not exceed 366 days")
+	}
+
+	sched, err := s.store.GetSchedule(ctx, req.ScheduleId)
+	if err != nil {
+		if errors.Is(err, schedule.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "schedule not found")
+		}
+		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to get schedule")
+		return nil, status.Error(codes.Internal, "failed to get schedule")
+	}
+
+	report := s.calculator.AnalyzeLoadBalance(sched, from, until)
+
+	resp := &routingv1.GetLoadBalanceResponse{
+		Users:               make([]*routingv1.UserLoad, 0, len(report.UserShiftHours)),
+		MeanHours:           report.MeanHours,
+		MaxDeviationPercent: report.MaxDeviationPercent,
+	}
+	for userID, hours := range report.UserShiftHours {
+		resp.Users = append(resp.Users, &routingv1.UserLoad{
+			UserId:           userID,
+			ShiftHours:       hours,
+			DeviationPercent: report.UserDeviationPercent[userID],
+		})
+	}
+	sort.Slice(resp.Users, func(i, j int) bool {
+		return resp.Users[i].UserId < resp.Users[j].UserId
+	})
+
+	return resp, nil
+}
+
 // =============================================================================
 // Handoff (2 RPCs)
 // =============================================================================
@@ -566,6 +1015,16 @@ func (s *ScheduleService) AcknowledgeHandoff(ctx context.Context, req *routingv1
 	}, nil
 }
 
+// recentHandoffNotesLimit is the number of handoff notes included in a handoff summary.
+const recentHandoffNotesLimit = 3
+
+// recentAlertCommentsLimit is the number of alert comments included in a handoff summary.
+const recentAlertCommentsLimit = 20
+
+// alertCommentsLookback is how far back alert comments are included in a
+// handoff summary when the outgoing shift has no start time.
+const alertCommentsLookback = 24 * time.Hour
+
 // GetHandoffSummary returns a summary of the upcoming handoff.
 func (s *ScheduleService) GetHandoffSummary(ctx context.Context, req *routingv1.GetHandoffSummaryRequest) (*routingv1.HandoffSummary, error) {
 	if req.ScheduleId == "" {
@@ -602,14 +1061,38 @@ func (s *ScheduleService) GetHandoffSummary(ctx context.Context, req *routingv1.
 		incomingUserID = nextResult.PrimaryUserID
 	}
 
+	// Get the most recent handoff notes
+	notes, err := s.store.GetHandoffNotes(ctx, req.ScheduleId, recentHandoffNotesLimit)
+	if err != nil {
+		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to get handoff notes")
+		return nil, status.Error(codes.Internal, "failed to get handoff notes")
+	}
+	if notes == nil {
+		notes = []*routingv1.HandoffNote{}
+	}
+
+	var handoffNotes string
+	if len(notes) > 0 {
+		handoffNotes = notes[0].Content
+	}
+
+	// Get the comments left on alerts during the outgoing shift
+	comments, err := s.recentAlertComments(ctx, currentResult.CurrentShift, now)
+	if err != nil {
+		s.logger.Error().Err(err).Str("schedule_id", req.ScheduleId).Msg("failed to get alert comments")
+		return nil, status.Error(codes.Internal, "failed to get alert comments")
+	}
+
 	summary := &routingv1.HandoffSummary{
-		ScheduleId:     req.ScheduleId,
-		OutgoingUserId: currentResult.PrimaryUserID,
-		IncomingUserId: incomingUserID,
-		ActiveAlerts:   []*routingv1.Alert{},   // Would be populated from alert service
-		OpenTickets:    []*routingv1.TicketSummary{}, // Would be populated from ticket service
-		RecentEvents:   []*routingv1.Event{},   // Would be populated from event service
-		HandoffNotes:   "",                     // Would be populated from handoff notes storage
+		ScheduleId:          req.ScheduleId,
+		OutgoingUserId:      currentResult.PrimaryUserID,
+		IncomingUserId:      incomingUserID,
+		ActiveAlerts:        []*routingv1.Alert{},   // Would be populated from alert service
+		OpenTickets:         []*routingv1.TicketSummary{}, // Would be populated from ticket service
+		RecentEvents:        []*routingv1.Event{},   // Would be populated from event service
+		HandoffNotes:        handoffNotes,
+		RecentHandoffNotes:  notes,
+		RecentAlertComments: comments,
 	}
 
 	if !nextHandoff.IsZero() {
@@ -619,5 +1102,27 @@ func (s *ScheduleService) GetHandoffSummary(ctx context.Context, req *routingv1.
 	return summary, nil
 }
 
+// recentAlertComments returns the alert comments left since the outgoing shift
+// started, newest first, or since alertCommentsLookback if the shift is unknown.
+func (s *ScheduleService) recentAlertComments(ctx context.Context, shift *routingv1.Shift, now time.Time) ([]*alertingv1.AlertComment, error) {
+	if s.alertComments == nil {
+		return []*alertingv1.AlertComment{}, nil
+	}
+
+	since := now.Add(-alertCommentsLookback)
+	if shift != nil && shift.StartTime != nil {
+		since = shift.StartTime.AsTime()
+	}
+
+	comments, err := s.alertComments.ListSince(ctx, since, recentAlertCommentsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if comments == nil {
+		comments = []*alertingv1.AlertComment{}
+	}
+	return comments, nil
+}
+
 // Ensure ScheduleService implements the interface
 var _ routingv1.ScheduleServiceServer = (*ScheduleService)(nil)
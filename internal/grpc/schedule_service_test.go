@@ -1,24 +1,33 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/comment"
 	"github.com/kneutral-org/alerting-system/internal/schedule"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
 // TestInMemoryStore is an in-memory implementation for testing.
 type TestInMemoryStore struct {
 	schedules map[string]*routingv1.Schedule
 	overrides map[string][]*routingv1.ScheduleOverride
+	notes     map[string][]*routingv1.HandoffNote
 	counter   int64
 }
 
@@ -26,6 +35,7 @@ func NewTestInMemoryStore() *TestInMemoryStore {
 	return &TestInMemoryStore{
 		schedules: make(map[string]*routingv1.Schedule),
 		overrides: make(map[string][]*routingv1.ScheduleOverride),
+		notes:     make(map[string][]*routingv1.HandoffNote),
 	}
 }
 
@@ -61,6 +71,10 @@ func (s *TestInMemoryStore) GetSchedule(ctx context.Context, id string) (*routin
 	return sched, nil
 }
 
+func (s *TestInMemoryStore) ListTenants(ctx context.Context) ([]string, error) {
+	return []string{auth.DefaultTenantID}, nil
+}
+
 func (s *TestInMemoryStore) ListSchedules(ctx context.Context, req *routingv1.ListSchedulesRequest) (*routingv1.ListSchedulesResponse, error) {
 	var schedules []*routingv1.Schedule
 
@@ -143,6 +157,42 @@ func (s *TestInMemoryStore) UpdateRotation(ctx context.Context, scheduleID strin
 	return sched, nil
 }
 
+func (s *TestInMemoryStore) ReorderRotationMembers(ctx context.Context, scheduleID, rotationID string, orderedUserIDs []string) (*routingv1.Rotation, error) {
+	sched, ok := s.schedules[scheduleID]
+	if !ok {
+		return nil, schedule.ErrNotFound
+	}
+
+	for _, rotation := range sched.Rotations {
+		if rotation.Id != rotationID {
+			continue
+		}
+
+		byUser := make(map[string]*routingv1.RotationMember, len(rotation.Members))
+		for _, member := range rotation.Members {
+			byUser[member.UserId] = member
+		}
+		if len(orderedUserIDs) != len(byUser) {
+			return nil, schedule.ErrMemberMismatch
+		}
+
+		members := make([]*routingv1.RotationMember, 0, len(orderedUserIDs))
+		for position, userID := range orderedUserIDs {
+			member, ok := byUser[userID]
+			if !ok {
+				return nil, schedule.ErrMemberMismatch
+			}
+			delete(byUser, userID)
+			member.Position = int32(position)
+			members = append(members, member)
+		}
+		rotation.Members = members
+		return rotation, nil
+	}
+
+	return nil, schedule.ErrNotFound
+}
+
 func (s *TestInMemoryStore) RemoveRotation(ctx context.Context, scheduleID, rotationID string) (*routingv1.Schedule, error) {
 	sched, ok := s.schedules[scheduleID]
 	if !ok {
@@ -184,6 +234,28 @@ func (s *TestInMemoryStore) CreateOverride(ctx context.Context, scheduleID strin
 	return override, nil
 }
 
+func (s *TestInMemoryStore) BulkCreateOverrides(ctx context.Context, scheduleID string, overrides []*routingv1.ScheduleOverride) ([]*routingv1.ScheduleOverride, error) {
+	overrides, err := schedule.PrepareBulkOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.schedules[scheduleID]; !ok {
+		return nil, schedule.ErrNotFound
+	}
+
+	for _, override := range overrides {
+		if override.Id == "" {
+			s.counter++
+			override.Id = fmt.Sprintf("override-%d", s.counter)
+		}
+		override.CreatedAt = timestamppb.Now()
+	}
+	s.overrides[scheduleID] = append(s.overrides[scheduleID], overrides...)
+
+	return overrides, nil
+}
+
 func (s *TestInMemoryStore) DeleteOverride(ctx context.Context, scheduleID, overrideID string) error {
 	overrides, ok := s.overrides[scheduleID]
 	if !ok {
@@ -208,6 +280,30 @@ func (s *TestInMemoryStore) DeleteOverride(ctx context.Context, scheduleID, over
 	return nil
 }
 
+func (s *TestInMemoryStore) SplitOverride(ctx context.Context, scheduleID, overrideID string, splitAt time.Time, newUserID string) ([]*routingv1.ScheduleOverride, error) {
+	overrides := s.overrides[scheduleID]
+	for i, o := range overrides {
+		if o.Id != overrideID {
+			continue
+		}
+
+		halves, err := schedule.SplitOverrideAt(o, splitAt, newUserID)
+		if err != nil {
+			return nil, err
+		}
+		for _, half := range halves {
+			half.CreatedAt = timestamppb.Now()
+		}
+
+		updated := append([]*routingv1.ScheduleOverride{}, overrides[:i]...)
+		updated = append(updated, halves...)
+		s.overrides[scheduleID] = append(updated, overrides[i+1:]...)
+		return halves, nil
+	}
+
+	return nil, schedule.ErrNotFound
+}
+
 func (s *TestInMemoryStore) ListOverrides(ctx context.Context, scheduleID string, startTime, endTime *timestamppb.Timestamp, pageSize int, pageToken string) (*routingv1.ListOverridesResponse, error) {
 	overrides := s.overrides[scheduleID]
 
@@ -222,9 +318,7 @@ func (s *TestInMemoryStore) ListOverrides(ctx context.Context, scheduleID string
 		filtered = append(filtered, o)
 	}
 
-	return &routingv1.ListOverridesResponse{
-		Overrides: filtered,
-	}, nil
+	return schedule.PageOverrides(filtered, pageSize, pageToken)
 }
 
 func (s *TestInMemoryStore) GetActiveOverrides(ctx context.Context, scheduleID string, at time.Time) ([]*routingv1.ScheduleOverride, error) {
@@ -247,6 +341,34 @@ func (s *TestInMemoryStore) RecordHandoffAck(ctx context.Context, scheduleID, us
 	return nil
 }
 
+func (s *TestInMemoryStore) CreateHandoffNote(ctx context.Context, note *routingv1.HandoffNote) (*routingv1.HandoffNote, error) {
+	if note == nil || note.ScheduleId == "" || note.Content == "" {
+		return nil, schedule.ErrInvalidHandoffNote
+	}
+	if _, ok := s.schedules[note.ScheduleId]; !ok {
+		return nil, schedule.ErrNotFound
+	}
+
+	if note.Id == "" {
+		s.counter++
+		note.Id = fmt.Sprintf("note-%d", s.counter)
+	}
+	note.CreatedAt = timestamppb.Now()
+
+	s.notes[note.ScheduleId] = append(s.notes[note.ScheduleId], note)
+	return note, nil
+}
+
+func (s *TestInMemoryStore) GetHandoffNotes(ctx context.Context, scheduleID string, limit int) ([]*routingv1.HandoffNote, error) {
+	stored := s.notes[scheduleID]
+
+	notes := []*routingv1.HandoffNote{}
+	for i := len(stored) - 1; i >= 0 && len(notes) < limit; i-- {
+		notes = append(notes, stored[i])
+	}
+	return notes, nil
+}
+
 // Ensure TestInMemoryStore implements schedule.Store
 var _ schedule.Store = (*TestInMemoryStore)(nil)
 
@@ -466,6 +588,98 @@ func TestScheduleService_AddRotation(t *testing.T) {
 	}
 }
 
+func TestScheduleService_ReorderRotationMembers(t *testing.T) {
+	ctx := context.Background()
+
+	newSchedule := func(t *testing.T, svc *ScheduleService) string {
+		t.Helper()
+		created, err := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+			Schedule: &routingv1.Schedule{
+				Name: "Test Schedule",
+				Rotations: []*routingv1.Rotation{
+					{
+						Id:   "rotation-1",
+						Name: "Primary",
+						Members: []*routingv1.RotationMember{
+							{UserId: "alice", Position: 0},
+							{UserId: "bob", Position: 1},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return created.Id
+	}
+
+	t.Run("valid reorder", func(t *testing.T) {
+		svc := newTestScheduleService()
+		scheduleID := newSchedule(t, svc)
+
+		rotation, err := svc.ReorderRotationMembers(ctx, &routingv1.ReorderRotationMembersRequest{
+			ScheduleId:     scheduleID,
+			RotationId:     "rotation-1",
+			OrderedUserIds: []string{"bob", "alice"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if rotation.Members[0].UserId != "bob" || rotation.Members[0].Position != 0 ||
+			rotation.Members[1].UserId != "alice" || rotation.Members[1].Position != 1 {
+			t.Errorf("unexpected member order: %v", rotation.Members)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		ordered []string
+		code    codes.Code
+	}{
+		{"missing user", []string{"bob"}, codes.InvalidArgument},
+		{"extra user", []string{"bob", "alice", "carol"}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestScheduleService()
+			scheduleID := newSchedule(t, svc)
+
+			_, err := svc.ReorderRotationMembers(ctx, &routingv1.ReorderRotationMembersRequest{
+				ScheduleId:     scheduleID,
+				RotationId:     "rotation-1",
+				OrderedUserIds: tt.ordered,
+			})
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+
+	t.Run("missing ids", func(t *testing.T) {
+		svc := newTestScheduleService()
+
+		_, err := svc.ReorderRotationMembers(ctx, &routingv1.ReorderRotationMembersRequest{RotationId: "rotation-1"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("rotation not found", func(t *testing.T) {
+		svc := newTestScheduleService()
+		scheduleID := newSchedule(t, svc)
+
+		_, err := svc.ReorderRotationMembers(ctx, &routingv1.ReorderRotationMembersRequest{
+			ScheduleId: scheduleID,
+			RotationId: "missing",
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+}
+
 func TestScheduleService_RemoveRotation(t *testing.T) {
 	svc := newTestScheduleService()
 	ctx := context.Background()
@@ -528,6 +742,130 @@ func TestScheduleService_CreateOverride(t *testing.T) {
 	}
 }
 
+func TestScheduleService_CreateOverride_PublishesEvent(t *testing.T) {
+	bus := schedule.NewEventBus(0)
+	svc := NewScheduleService(NewTestInMemoryStore(), zerolog.Nop(), WithScheduleEventBus(bus))
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+
+	_, events, unsubscribe := bus.Subscribe(created.Id, 0)
+	defer unsubscribe()
+
+	now := time.Now()
+	resp, err := svc.CreateOverride(ctx, &routingv1.CreateOverrideRequest{
+		ScheduleId: created.Id,
+		Override: &routingv1.ScheduleOverride{
+			UserId:    "user-1",
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(8 * time.Hour)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if event.Type != schedule.EventOverrideCreated {
+			t.Errorf("expected event type %s, got %s", schedule.EventOverrideCreated, event.Type)
+		}
+		if got := event.Data.(*routingv1.ScheduleOverride).Id; got != resp.Id {
+			t.Errorf("expected override '%s' in event, got '%s'", resp.Id, got)
+		}
+	default:
+		t.Fatal("expected override_created event to be published")
+	}
+}
+
+// staticAvailability returns fixed availabilities for every team.
+type staticAvailability struct {
+	availabilities []*routingv1.UserAvailability
+}
+
+func (s staticAvailability) GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error) {
+	return s.availabilities, nil
+}
+
+// headerStream captures the headers set during a unary call.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "/ScheduleService/CreateOverride" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(md metadata.MD) error { return nil }
+
+func TestScheduleService_CreateOverride_WarnsWhenUnavailable(t *testing.T) {
+	now := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	checker := staticAvailability{availabilities: []*routingv1.UserAvailability{
+		{
+			UserId:            "user-1",
+			Available:         false,
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION,
+			PeriodStart:       timestamppb.New(now),
+			PeriodEnd:         timestamppb.New(now.Add(7 * 24 * time.Hour)),
+		},
+	}}
+
+	tests := []struct {
+		name   string
+		userID string
+		warned bool
+	}{
+		{"unavailable user", "user-1", true},
+		{"available user", "user-2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			svc := NewScheduleService(NewTestInMemoryStore(), zerolog.New(&logs), WithAvailabilityChecker(checker))
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+			created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+				Schedule: &routingv1.Schedule{Name: "Test Schedule", TeamId: "team-1"},
+			})
+
+			resp, err := svc.CreateOverride(ctx, &routingv1.CreateOverrideRequest{
+				ScheduleId: created.Id,
+				Override: &routingv1.ScheduleOverride{
+					UserId:    tt.userID,
+					StartTime: timestamppb.New(now.Add(24 * time.Hour)),
+					EndTime:   timestamppb.New(now.Add(32 * time.Hour)),
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Id == "" {
+				t.Error("expected override to be created")
+			}
+
+			warning := stream.header.Get(AvailabilityWarningHeader)
+			if tt.warned != (len(warning) == 1) {
+				t.Fatalf("expected warned = %v, got header %v", tt.warned, warning)
+			}
+			if tt.warned && !strings.Contains(warning[0], "unavailable (vacation)") {
+				t.Errorf("unexpected warning %q", warning[0])
+			}
+			if got := strings.Contains(logs.String(), "override assigned to unavailable user"); got != tt.warned {
+				t.Errorf("expected warning logged = %v, got logs %s", tt.warned, logs.String())
+			}
+		})
+	}
+}
+
 func TestScheduleService_CreateOverride_InvalidInput(t *testing.T) {
 	svc := newTestScheduleService()
 	ctx := context.Background()
@@ -592,6 +930,107 @@ func TestScheduleService_CreateOverride_InvalidInput(t *testing.T) {
 	}
 }
 
+func TestScheduleService_BulkCreateOverrides(t *testing.T) {
+	bus := schedule.NewEventBus(0)
+	svc := NewScheduleService(NewTestInMemoryStore(), zerolog.Nop(), WithScheduleEventBus(bus))
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+
+	_, events, unsubscribe := bus.Subscribe(created.Id, 0)
+	defer unsubscribe()
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	var overrides []*routingv1.ScheduleOverride
+	for i := 0; i < 10; i++ {
+		day := start.AddDate(0, 0, i)
+		overrides = append(overrides, &routingv1.ScheduleOverride{
+			UserId:    "user-1",
+			StartTime: timestamppb.New(day),
+			EndTime:   timestamppb.New(day.Add(24 * time.Hour)),
+		})
+	}
+
+	resp, err := svc.BulkCreateOverrides(ctx, &routingv1.BulkCreateOverridesRequest{
+		ScheduleId: created.Id,
+		Overrides:  overrides,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Overrides) != 10 {
+		t.Fatalf("expected 10 overrides, got %d", len(resp.Overrides))
+	}
+	if len(events) != 10 {
+		t.Errorf("expected 10 override_created events, got %d", len(events))
+	}
+}
+
+func TestScheduleService_BulkCreateOverrides_InvalidInput(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *routingv1.BulkCreateOverridesRequest
+		code codes.Code
+	}{
+		{
+			name: "missing schedule_id",
+			req:  &routingv1.BulkCreateOverridesRequest{},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "empty batch",
+			req:  &routingv1.BulkCreateOverridesRequest{ScheduleId: created.Id},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "one invalid override",
+			req: &routingv1.BulkCreateOverridesRequest{
+				ScheduleId: created.Id,
+				Overrides: []*routingv1.ScheduleOverride{
+					{UserId: "user-1", StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(time.Hour))},
+					{StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(time.Hour))},
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown schedule",
+			req: &routingv1.BulkCreateOverridesRequest{
+				ScheduleId: "missing",
+				Overrides: []*routingv1.ScheduleOverride{
+					{UserId: "user-1", StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(time.Hour))},
+				},
+			},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.BulkCreateOverrides(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+
+	list, _ := svc.ListOverrides(ctx, &routingv1.ListOverridesRequest{ScheduleId: created.Id})
+	if len(list.Overrides) != 0 {
+		t.Errorf("expected no overrides after failed batches, got %d", len(list.Overrides))
+	}
+}
+
 func TestScheduleService_DeleteOverride(t *testing.T) {
 	svc := newTestScheduleService()
 	ctx := context.Background()
@@ -899,3 +1338,627 @@ func TestScheduleService_GetHandoffSummary(t *testing.T) {
 		t.Error("expected handoff time to be set")
 	}
 }
+
+func TestScheduleService_GetHandoffSummary_RecentHandoffNotes(t *testing.T) {
+	store := NewTestInMemoryStore()
+	svc := NewScheduleService(store, zerolog.Nop())
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{
+			Name:     "Test Schedule",
+			Timezone: "UTC",
+		},
+	})
+
+	for i := 1; i <= 5; i++ {
+		_, err := store.CreateHandoffNote(ctx, &routingv1.HandoffNote{
+			ScheduleId:   created.Id,
+			AuthorUserId: "user-1",
+			Content:      fmt.Sprintf("note %d", i),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating note: %v", err)
+		}
+	}
+
+	resp, err := svc.GetHandoffSummary(ctx, &routingv1.GetHandoffSummaryRequest{
+		ScheduleId: created.Id,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.RecentHandoffNotes) != 3 {
+		t.Fatalf("expected 3 recent handoff notes, got %d", len(resp.RecentHandoffNotes))
+	}
+
+	expected := []string{"note 5", "note 4", "note 3"}
+	for i, note := range resp.RecentHandoffNotes {
+		if note.Content != expected[i] {
+			t.Errorf("expected note %d to be '%s', got '%s'", i, expected[i], note.Content)
+		}
+	}
+
+	if resp.HandoffNotes != "note 5" {
+		t.Errorf("expected handoff_notes 'note 5', got '%s'", resp.HandoffNotes)
+	}
+}
+
+func TestScheduleService_GetHandoffSummary_NoHandoffNotes(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{
+			Name:     "Test Schedule",
+			Timezone: "UTC",
+		},
+	})
+
+	resp, err := svc.GetHandoffSummary(ctx, &routingv1.GetHandoffSummaryRequest{
+		ScheduleId: created.Id,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.RecentHandoffNotes == nil {
+		t.Error("expected empty slice, got nil")
+	}
+	if len(resp.RecentHandoffNotes) != 0 {
+		t.Errorf("expected no handoff notes, got %d", len(resp.RecentHandoffNotes))
+	}
+}
+
+// sinceRecordingLister records the time alert comments are listed since.
+type sinceRecordingLister struct {
+	*comment.InMemoryStore
+	since time.Time
+}
+
+func (l *sinceRecordingLister) ListSince(ctx context.Context, since time.Time, limit int) ([]*alertingv1.AlertComment, error) {
+	l.since = since
+	return l.InMemoryStore.ListSince(ctx, since, limit)
+}
+
+func TestScheduleService_GetHandoffSummary_AlertComments(t *testing.T) {
+	comments := &sinceRecordingLister{InMemoryStore: comment.NewInMemoryStore()}
+	svc := NewScheduleService(NewTestInMemoryStore(), zerolog.Nop(), WithAlertComments(comments))
+	ctx := context.Background()
+
+	rotationStart := time.Now().Add(-12 * time.Hour).Truncate(time.Second)
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{
+			Name:     "Test Schedule",
+			Timezone: "UTC",
+			Rotations: []*routingv1.Rotation{
+				{
+					Id:        "rotation-1",
+					Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+					Layer:     1,
+					StartTime: timestamppb.New(rotationStart),
+					ShiftConfig: &routingv1.ShiftConfig{
+						ShiftLength: durationpb.New(24 * time.Hour),
+					},
+					Members: []*routingv1.RotationMember{
+						{UserId: "user-1", Position: 0},
+						{UserId: "user-2", Position: 1},
+					},
+				},
+			},
+		},
+	})
+
+	var ids []string
+	for _, body := range []string{"BGP flapping on edge-1", "Opened carrier ticket", "Wrong alert, ignore"} {
+		c, err := comments.Create(ctx, &alertingv1.AlertComment{AlertId: "alert-1", AuthorId: "user-1", Body: body})
+		if err != nil {
+			t.Fatalf("unexpected error creating comment: %v", err)
+		}
+		ids = append(ids, c.Id)
+	}
+	if err := comments.Delete(ctx, ids[2]); err != nil {
+		t.Fatalf("unexpected error deleting comment: %v", err)
+	}
+
+	resp, err := svc.GetHandoffSummary(ctx, &routingv1.GetHandoffSummaryRequest{
+		ScheduleId: created.Id,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !comments.since.Equal(rotationStart) {
+		t.Errorf("expected comments since the shift start %v, got %v", rotationStart, comments.since)
+	}
+	if len(resp.RecentAlertComments) != 2 {
+		t.Fatalf("expected 2 alert comments, got %d", len(resp.RecentAlertComments))
+	}
+	if resp.RecentAlertComments[0].Body != "Opened carrier ticket" {
+		t.Errorf("expected the newest comment first, got '%s'", resp.RecentAlertComments[0].Body)
+	}
+}
+
+func TestScheduleService_GetCoverageDepth(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	rotationStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	created, err := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{
+			Name:     "Two Layers",
+			Timezone: "UTC",
+			Rotations: []*routingv1.Rotation{
+				{
+					Id:        "primary",
+					Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+					Layer:     1,
+					StartTime: timestamppb.New(rotationStart),
+					Members:   []*routingv1.RotationMember{{UserId: "user-1", Position: 0}},
+				},
+				{
+					Id:        "secondary",
+					Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+					Layer:     2,
+					StartTime: timestamppb.New(rotationStart),
+					Members:   []*routingv1.RotationMember{{UserId: "user-2", Position: 0}},
+					Restrictions: []*routingv1.TimeWindow{
+						{DaysOfWeek: []int32{1, 2, 3, 4, 5}, StartTime: "09:00", EndTime: "17:00"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	// Monday 08:00 to 10:00 UTC
+	from := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	resp, err := svc.GetCoverageDepth(ctx, &routingv1.GetCoverageDepthRequest{
+		ScheduleId: created.Id,
+		From:       timestamppb.New(from),
+		Until:      timestamppb.New(from.Add(2 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(resp.Entries))
+	}
+	if resp.Entries[0].UserCount != 1 {
+		t.Errorf("expected depth 1 at 08:00, got %d", resp.Entries[0].UserCount)
+	}
+	if resp.Entries[1].UserCount != 2 {
+		t.Errorf("expected depth 2 at 09:00, got %d", resp.Entries[1].UserCount)
+	}
+
+	tests := []struct {
+		name string
+		req  *routingv1.GetCoverageDepthRequest
+		code codes.Code
+	}{
+		{"missing schedule id", &routingv1.GetCoverageDepthRequest{From: timestamppb.New(from), Until: timestamppb.New(from.Add(time.Hour))}, codes.InvalidArgument},
+		{"until before from", &routingv1.GetCoverageDepthRequest{ScheduleId: created.Id, From: timestamppb.New(from), Until: timestamppb.New(from.Add(-time.Hour))}, codes.InvalidArgument},
+		{"range too long", &routingv1.GetCoverageDepthRequest{ScheduleId: created.Id, From: timestamppb.New(from), Until: timestamppb.New(from.Add(365 * 24 * time.Hour))}, codes.InvalidArgument},
+		{"unknown schedule", &routingv1.GetCoverageDepthRequest{ScheduleId: "missing", From: timestamppb.New(from), Until: timestamppb.New(from.Add(time.Hour))}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.GetCoverageDepth(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestScheduleService_PreviewRotation(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	rotationStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	members := []*routingv1.RotationMember{
+		{UserId: "user-1", Position: 0},
+		{UserId: "user-2", Position: 1},
+	}
+	from := rotationStart
+	until := rotationStart.Add(14 * 24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		rotation  *routingv1.Rotation
+		wantCount int
+		wantUsers []string
+	}{
+		{
+			name: "daily",
+			rotation: &routingv1.Rotation{
+				Id:        "daily",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(rotationStart),
+				Members:   members,
+			},
+			wantCount: 14,
+			wantUsers: []string{"user-1", "user-2", "user-1"},
+		},
+		{
+			name: "weekly",
+			rotation: &routingv1.Rotation{
+				Id:        "weekly",
+				Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				StartTime: timestamppb.New(rotationStart),
+				Members:   members,
+			},
+			wantCount: 2,
+			wantUsers: []string{"user-1", "user-2"},
+		},
+		{
+			name: "custom",
+			rotation: &routingv1.Rotation{
+				Id:          "custom",
+				Type:        routingv1.RotationType_ROTATION_TYPE_CUSTOM,
+				StartTime:   timestamppb.New(rotationStart),
+				Members:     members,
+				ShiftConfig: &routingv1.ShiftConfig{ShiftLength: durationpb.New(12 * time.Hour)},
+			},
+			wantCount: 28,
+			wantUsers: []string{"user-1", "user-2", "user-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.PreviewRotation(ctx, &routingv1.PreviewRotationRequest{
+				Schedule: &routingv1.Schedule{
+					Name:      "Preview",
+					Timezone:  "UTC",
+					Rotations: []*routingv1.Rotation{tt.rotation},
+				},
+				From:  timestamppb.New(from),
+				Until: timestamppb.New(until),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(resp.Shifts) != tt.wantCount {
+				t.Fatalf("expected %d shifts, got %d", tt.wantCount, len(resp.Shifts))
+			}
+			for i, want := range tt.wantUsers {
+				if resp.Shifts[i].UserId != want {
+					t.Errorf("shift %d: expected %s, got %s", i, want, resp.Shifts[i].UserId)
+				}
+			}
+		})
+	}
+
+	// Previewing must not persist anything
+	list, err := svc.ListSchedules(ctx, &routingv1.ListSchedulesRequest{})
+	if err != nil {
+		t.Fatalf("failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 0 {
+		t.Errorf("expected no saved schedules, got %d", len(list.Schedules))
+	}
+}
+
+func TestScheduleService_PreviewRotation_Validation(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := func(rotation *routingv1.Rotation) *routingv1.Schedule {
+		return &routingv1.Schedule{Name: "Preview", Rotations: []*routingv1.Rotation{rotation}}
+	}
+	valid := &routingv1.Rotation{
+		Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+		StartTime: timestamppb.New(from),
+		Members:   []*routingv1.RotationMember{{UserId: "user-1"}},
+	}
+
+	tests := []struct {
+		name string
+		req  *routingv1.PreviewRotationRequest
+	}{
+		{"missing schedule", &routingv1.PreviewRotationRequest{From: timestamppb.New(from), Until: timestamppb.New(from.Add(time.Hour))}},
+		{"missing range", &routingv1.PreviewRotationRequest{Schedule: schedule(valid)}},
+		{"until before from", &routingv1.PreviewRotationRequest{Schedule: schedule(valid), From: timestamppb.New(from), Until: timestamppb.New(from.Add(-time.Hour))}},
+		{"range too long", &routingv1.PreviewRotationRequest{Schedule: schedule(valid), From: timestamppb.New(from), Until: timestamppb.New(from.Add(365 * 24 * time.Hour))}},
+		{"missing start time", &routingv1.PreviewRotationRequest{
+			Schedule: schedule(&routingv1.Rotation{Type: routingv1.RotationType_ROTATION_TYPE_DAILY}),
+			From:     timestamppb.New(from),
+			Until:    timestamppb.New(from.Add(time.Hour)),
+		}},
+		{"zero shift length", &routingv1.PreviewRotationRequest{
+			Schedule: schedule(&routingv1.Rotation{
+				Type:        routingv1.RotationType_ROTATION_TYPE_CUSTOM,
+				StartTime:   timestamppb.New(from),
+				ShiftConfig: &routingv1.ShiftConfig{ShiftLength: durationpb.New(0)},
+			}),
+			From:  timestamppb.New(from),
+			Until: timestamppb.New(from.Add(time.Hour)),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.PreviewRotation(ctx, tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScheduleService_GetLoadBalance(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	created, err := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{
+			Name:     "Daily",
+			Timezone: "UTC",
+			Rotations: []*routingv1.Rotation{
+				{
+					Id:        "daily",
+					Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+					StartTime: timestamppb.New(from),
+					Members: []*routingv1.RotationMember{
+						{UserId: "user-3", Position: 0},
+						{UserId: "user-1", Position: 1},
+						{UserId: "user-2", Position: 2},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	resp, err := svc.GetLoadBalance(ctx, &routingv1.GetLoadBalanceRequest{
+		ScheduleId: created.Id,
+		From:       timestamppb.New(from),
+		Until:      timestamppb.New(from.Add(9 * 24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Users) != 3 {
+		t.Fatalf("expected 3 users, got %d", len(resp.Users))
+	}
+	for i, want := range []string{"user-1", "user-2", "user-3"} {
+		if resp.Users[i].UserId != want || resp.Users[i].ShiftHours != 72 {
+			t.Errorf("user %d: expected %s with 72 hours, got %s with %v", i, want, resp.Users[i].UserId, resp.Users[i].ShiftHours)
+		}
+	}
+	if resp.MeanHours != 72 || resp.MaxDeviationPercent != 0 {
+		t.Errorf("expected mean 72 and no deviation, got %v and %v", resp.MeanHours, resp.MaxDeviationPercent)
+	}
+
+	tests := []struct {
+		name string
+		req  *routingv1.GetLoadBalanceRequest
+		code codes.Code
+	}{
+		{"missing schedule id", &routingv1.GetLoadBalanceRequest{From: timestamppb.New(from), Until: timestamppb.New(from.Add(time.Hour))}, codes.InvalidArgument},
+		{"missing range", &routingv1.GetLoadBalanceRequest{ScheduleId: created.Id}, codes.InvalidArgument},
+		{"until before from", &routingv1.GetLoadBalanceRequest{ScheduleId: created.Id, From: timestamppb.New(from), Until: timestamppb.New(from.Add(-time.Hour))}, codes.InvalidArgument},
+		{"range too long", &routingv1.GetLoadBalanceRequest{ScheduleId: created.Id, From: timestamppb.New(from), Until: timestamppb.New(from.Add(400 * 24 * time.Hour))}, codes.InvalidArgument},
+		{"unknown schedule", &routingv1.GetLoadBalanceRequest{ScheduleId: "missing", From: timestamppb.New(from), Until: timestamppb.New(from.Add(time.Hour))}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.GetLoadBalance(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestScheduleService_CreateOverride_DetectConflicts(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		conflict bool
+	}{
+		{"partial overlap", base.Add(4 * time.Hour), base.Add(12 * time.Hour), true},
+		{"full containment", base.Add(2 * time.Hour), base.Add(4 * time.Hour), true},
+		{"contains existing", base.Add(-time.Hour), base.Add(9 * time.Hour), true},
+		{"adjacent after", base.Add(8 * time.Hour), base.Add(16 * time.Hour), false},
+		{"adjacent before", base.Add(-8 * time.Hour), base, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestScheduleService()
+			created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+				Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+			})
+			existing, err := svc.CreateOverride(ctx, &routingv1.CreateOverrideRequest{
+				ScheduleId: created.Id,
+				Override: &routingv1.ScheduleOverride{
+					UserId:    "user-1",
+					StartTime: timestamppb.New(base),
+					EndTime:   timestamppb.New(base.Add(8 * time.Hour)),
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to create existing override: %v", err)
+			}
+
+			req := &routingv1.CreateOverrideRequest{
+				ScheduleId: created.Id,
+				Override: &routingv1.ScheduleOverride{
+					UserId:    "user-2",
+					StartTime: timestamppb.New(tt.start),
+					EndTime:   timestamppb.New(tt.end),
+				},
+				DetectConflicts: true,
+			}
+			_, err = svc.CreateOverride(ctx, req)
+
+			if !tt.conflict {
+				if err != nil {
+					t.Errorf("expected no conflict, got %v", err)
+				}
+				return
+			}
+
+			st := status.Convert(err)
+			if st.Code() != codes.AlreadyExists {
+				t.Fatalf("expected AlreadyExists, got %v", err)
+			}
+			details := st.Details()
+			if len(details) != 1 {
+				t.Fatalf("expected conflict details, got %v", details)
+			}
+			conflict, ok := details[0].(*routingv1.OverrideConflictDetails)
+			if !ok || len(conflict.ConflictingOverrideIds) != 1 || conflict.ConflictingOverrideIds[0] != existing.Id {
+				t.Errorf("expected conflict with %s, got %v", existing.Id, details[0])
+			}
+
+			// force bypasses the check
+			req.Force = true
+			if _, err := svc.CreateOverride(ctx, req); err != nil {
+				t.Errorf("expected forced override to be created, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScheduleService_CreateOverride_ConflictsIgnoredByDefault(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		_, err := svc.CreateOverride(ctx, &routingv1.CreateOverrideRequest{
+			ScheduleId: created.Id,
+			Override: &routingv1.ScheduleOverride{
+				UserId:    "user-1",
+				StartTime: timestamppb.New(now),
+				EndTime:   timestamppb.New(now.Add(8 * time.Hour)),
+			},
+		})
+		if err != nil {
+			t.Fatalf("override %d: unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestScheduleService_SplitOverride(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(8 * time.Hour)
+
+	tests := []struct {
+		name      string
+		splitTime time.Time
+		code      codes.Code
+	}{
+		{"start", start, codes.InvalidArgument},
+		{"middle", start.Add(4 * time.Hour), codes.OK},
+		{"end", end, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestScheduleService()
+			created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+				Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+			})
+			original, err := svc.CreateOverride(ctx, &routingv1.CreateOverrideRequest{
+				ScheduleId: created.Id,
+				Override: &routingv1.ScheduleOverride{
+					UserId:    "user-1",
+					StartTime: timestamppb.New(start),
+					EndTime:   timestamppb.New(end),
+					Reason:    "Vacation cover",
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to create override: %v", err)
+			}
+
+			resp, err := svc.SplitOverride(ctx, &routingv1.SplitOverrideRequest{
+				ScheduleId: created.Id,
+				OverrideId: original.Id,
+				SplitTime:  timestamppb.New(tt.splitTime),
+				NewUserId:  "user-2",
+			})
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+
+			list, _ := svc.ListOverrides(ctx, &routingv1.ListOverridesRequest{ScheduleId: created.Id})
+			if tt.code != codes.OK {
+				if len(list.Overrides) != 1 || list.Overrides[0].Id != original.Id {
+					t.Errorf("expected a rejected split to keep the original override, got %v", list.Overrides)
+				}
+				return
+			}
+
+			if resp.First.UserId != "user-1" || !resp.First.StartTime.AsTime().Equal(start) || !resp.First.EndTime.AsTime().Equal(tt.splitTime) {
+				t.Errorf("unexpected first half: %v", resp.First)
+			}
+			if resp.Second.UserId != "user-2" || !resp.Second.StartTime.AsTime().Equal(tt.splitTime) || !resp.Second.EndTime.AsTime().Equal(end) {
+				t.Errorf("unexpected second half: %v", resp.Second)
+			}
+			if resp.First.Reason != "Vacation cover" || resp.Second.Reason != "Vacation cover" {
+				t.Errorf("expected both halves to keep the reason, got %q and %q", resp.First.Reason, resp.Second.Reason)
+			}
+			if len(list.Overrides) != 2 {
+				t.Fatalf("expected the original override to be replaced by 2 overrides, got %d", len(list.Overrides))
+			}
+			for _, o := range list.Overrides {
+				if o.Id == original.Id {
+					t.Error("expected the original override to be deleted")
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleService_SplitOverride_Validation(t *testing.T) {
+	svc := newTestScheduleService()
+	ctx := context.Background()
+
+	created, _ := svc.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: &routingv1.Schedule{Name: "Test Schedule"},
+	})
+	split := timestamppb.New(time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		req  *routingv1.SplitOverrideRequest
+		code codes.Code
+	}{
+		{"missing schedule id", &routingv1.SplitOverrideRequest{OverrideId: "o", SplitTime: split, NewUserId: "user-2"}, codes.InvalidArgument},
+		{"missing override id", &routingv1.SplitOverrideRequest{ScheduleId: created.Id, SplitTime: split, NewUserId: "user-2"}, codes.InvalidArgument},
+		{"missing split time", &routingv1.SplitOverrideRequest{ScheduleId: created.Id, OverrideId: "o", NewUserId: "user-2"}, codes.InvalidArgument},
+		{"missing new user", &routingv1.SplitOverrideRequest{ScheduleId: created.Id, OverrideId: "o", SplitTime: split}, codes.InvalidArgument},
+		{"unknown override", &routingv1.SplitOverrideRequest{ScheduleId: created.Id, OverrideId: "missing", SplitTime: split, NewUserId: "user-2"}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SplitOverride(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
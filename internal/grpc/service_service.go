@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ServiceService implements the ServiceServiceServer interface.
+type ServiceService struct {
+	alertingv1.UnimplementedServiceServiceServer
+	store  store.ServiceStore
+	logger zerolog.Logger
+}
+
+// NewServiceService creates a new ServiceService.
+func NewServiceService(store store.ServiceStore, logger zerolog.Logger) *ServiceService {
+	return &ServiceService{
+		store:  store,
+		logger: logger.With().Str("service", "service").Logger(),
+	}
+}
+
+// DeleteService deletes a service. Services with active alerts are only
+// deleted with force, which moves the alerts to the deleted-service sentinel.
+func (s *ServiceService) DeleteService(ctx context.Context, req *alertingv1.DeleteServiceRequest) (*alertingv1.DeleteServiceResponse, error) {
+	if req.ServiceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "service_id is required")
+	}
+	if req.ServiceId == store.DeletedServiceID {
+		return nil, status.Error(codes.InvalidArgument, "the deleted-service sentinel cannot be deleted")
+	}
+
+	impact, err := s.store.CanDeleteService(ctx, req.ServiceId)
+	if err != nil {
+		if errors.Is(err, store.ErrServiceNotFound) {
+			return nil, status.Error(codes.NotFound, "service not found")
+		}
+		s.logger.Error().Err(err).Str("serviceId", req.ServiceId).Msg("failed to check service deletion impact")
+		return nil, status.Error(codes.Internal, "failed to check service deletion impact")
+	}
+
+	if impact.ActiveAlertCount > 0 && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"service has %d active alerts; resolve them or delete with force", impact.ActiveAlertCount)
+	}
+
+	s.logger.Info().
+		Str("serviceId", req.ServiceId).
+		Bool("force", req.Force).
+		Int("activeAlerts", impact.ActiveAlertCount).
+		Int("routingRules", impact.RoutingRulesCount).
+		Int("escalationPolicies", impact.EscalationPoliciesCount).
+		Msg("deleting service")
+
+	if err := s.store.Delete(ctx, req.ServiceId, req.Force); err != nil {
+		if errors.Is(err, store.ErrServiceNotFound) {
+			return nil, status.Error(codes.NotFound, "service not found")
+		}
+		s.logger.Error().Err(err).Str("serviceId", req.ServiceId).Msg("failed to delete service")
+		return nil, status.Error(codes.Internal, "failed to delete service")
+	}
+
+	return &alertingv1.DeleteServiceResponse{
+		Success: true,
+		Impact: &alertingv1.ServiceDeletionImpact{
+			ActiveAlertCount:        int32(impact.ActiveAlertCount),
+			RoutingRulesCount:       int32(impact.RoutingRulesCount),
+			EscalationPoliciesCount: int32(impact.EscalationPoliciesCount),
+		},
+	}, nil
+}
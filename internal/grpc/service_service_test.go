@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// TestServiceStore is an in-memory ServiceStore that tracks the alerts of its services.
+type TestServiceStore struct {
+	services     map[string]*store.Service
+	alerts       []*alertingv1.Alert
+	routingRules int
+}
+
+func NewTestServiceStore() *TestServiceStore {
+	return &TestServiceStore{services: make(map[string]*store.Service)}
+}
+
+func (s *TestServiceStore) GetByIntegrationKey(ctx context.Context, integrationKey string) (*store.Service, error) {
+	for _, svc := range s.services {
+		if svc.IntegrationKey == integrationKey {
+			return svc, nil
+		}
+	}
+	return nil, store.ErrServiceNotFound
+}
+
+func (s *TestServiceStore) Create(ctx context.Context, service *store.Service) (*store.Service, error) {
+	s.services[service.ID] = service
+	return service, nil
+}
+
+func (s *TestServiceStore) GetByID(ctx context.Context, id string) (*store.Service, error) {
+	return s.services[id], nil
+}
+
+func (s *TestServiceStore) CanDeleteService(ctx context.Context, serviceID string) (*store.DeletionImpact, error) {
+	if _, ok := s.services[serviceID]; !ok {
+		return nil, store.ErrServiceNotFound
+	}
+	return &store.DeletionImpact{
+		ActiveAlertCount:  len(s.activeAlerts(serviceID)),
+		RoutingRulesCount: s.routingRules,
+	}, nil
+}
+
+func (s *TestServiceStore) Delete(ctx context.Context, serviceID string, force bool) error {
+	if _, ok := s.services[serviceID]; !ok {
+		return store.ErrServiceNotFound
+	}
+	if force {
+		for _, alert := range s.activeAlerts(serviceID) {
+			alert.ServiceId = store.DeletedServiceID
+		}
+	}
+	delete(s.services, serviceID)
+	return nil
+}
+
+func (s *TestServiceStore) activeAlerts(serviceID string) []*alertingv1.Alert {
+	var active []*alertingv1.Alert
+	for _, alert := range s.alerts {
+		if alert.ServiceId == serviceID &&
+			(alert.Status == alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED || alert.Status == alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED) {
+			active = append(active, alert)
+		}
+	}
+	return active
+}
+
+func newTestServiceStoreWithAlerts() *TestServiceStore {
+	s := NewTestServiceStore()
+	_, _ = s.Create(context.Background(), &store.Service{ID: "svc-api", Name: "API"})
+	s.alerts = []*alertingv1.Alert{
+		{Id: "a1", ServiceId: "svc-api", Status: alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+		{Id: "a2", ServiceId: "svc-api", Status: alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED},
+		{Id: "a3", ServiceId: "svc-api", Status: alertingv1.AlertStatus_ALERT_STATUS_RESOLVED},
+	}
+	s.routingRules = 2
+	return s
+}
+
+func TestServiceService_DeleteService_BlockedByActiveAlerts(t *testing.T) {
+	serviceStore := newTestServiceStoreWithAlerts()
+	svc := NewServiceService(serviceStore, zerolog.Nop())
+
+	_, err := svc.DeleteService(context.Background(), &alertingv1.DeleteServiceRequest{ServiceId: "svc-api"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	if _, ok := serviceStore.services["svc-api"]; !ok {
+		t.Error("expected service to remain after a blocked delete")
+	}
+	for _, alert := range serviceStore.alerts {
+		if alert.ServiceId != "svc-api" {
+			t.Errorf("expected alert %s to keep its service, got %s", alert.Id, alert.ServiceId)
+		}
+	}
+}
+
+func TestServiceService_DeleteService_Force(t *testing.T) {
+	serviceStore := newTestServiceStoreWithAlerts()
+	svc := NewServiceService(serviceStore, zerolog.Nop())
+
+	resp, err := svc.DeleteService(context.Background(), &alertingv1.DeleteServiceRequest{ServiceId: "svc-api", Force: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !resp.Success {
+		t.Error("expected success to be true")
+	}
+	if resp.Impact.ActiveAlertCount != 2 || resp.Impact.RoutingRulesCount != 2 {
+		t.Errorf("expected impact of 2 active alerts and 2 routing rules, got %+v", resp.Impact)
+	}
+	if _, ok := serviceStore.services["svc-api"]; ok {
+		t.Error("expected service to be deleted")
+	}
+
+	expected := map[string]string{
+		"a1": store.DeletedServiceID,
+		"a2": store.DeletedServiceID,
+		"a3": "svc-api", // resolved alerts keep their service
+	}
+	for _, alert := range serviceStore.alerts {
+		if alert.ServiceId != expected[alert.Id] {
+			t.Errorf("expected alert %s service %s, got %s", alert.Id, expected[alert.Id], alert.ServiceId)
+		}
+	}
+}
+
+func TestServiceService_DeleteService_NoImpact(t *testing.T) {
+	serviceStore := NewTestServiceStore()
+	_, _ = serviceStore.Create(context.Background(), &store.Service{ID: "svc-idle", Name: "Idle"})
+	svc := NewServiceService(serviceStore, zerolog.Nop())
+
+	resp, err := svc.DeleteService(context.Background(), &alertingv1.DeleteServiceRequest{ServiceId: "svc-idle"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !resp.Success {
+		t.Error("expected success to be true")
+	}
+	if resp.Impact.ActiveAlertCount != 0 {
+		t.Errorf("expected no active alerts, got %d", resp.Impact.ActiveAlertCount)
+	}
+	if _, ok := serviceStore.services["svc-idle"]; ok {
+		t.Error("expected service to be deleted")
+	}
+}
+
+func TestServiceService_DeleteService_InvalidInput(t *testing.T) {
+	svc := NewServiceService(NewTestServiceStore(), zerolog.Nop())
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *alertingv1.DeleteServiceRequest
+		code codes.Code
+	}{
+		{"missing service_id", &alertingv1.DeleteServiceRequest{}, codes.InvalidArgument},
+		{"sentinel service", &alertingv1.DeleteServiceRequest{ServiceId: store.DeletedServiceID}, codes.InvalidArgument},
+		{"unknown service", &alertingv1.DeleteServiceRequest{ServiceId: "missing"}, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.DeleteService(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Package grpc provides gRPC service implementations.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/silence"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// SilenceService implements the SilenceServiceServer interface.
+type SilenceService struct {
+	alertingv1.UnimplementedSilenceServiceServer
+	store  silence.Store
+	logger zerolog.Logger
+
+	// now returns the time active silences are listed at
+	now func() time.Time
+}
+
+// NewSilenceService creates a new SilenceService.
+func NewSilenceService(store silence.Store, logger zerolog.Logger) *SilenceService {
+	return &SilenceService{
+		store:  store,
+		logger: logger.With().Str("service", "silence").Logger(),
+		now:    time.Now,
+	}
+}
+
+// CreateSilence creates a new silence.
+func (s *SilenceService) CreateSilence(ctx context.Context, req *alertingv1.CreateSilenceRequest) (*alertingv1.SilenceRule, error) {
+	if req.Silence == nil {
+		return nil, status.Error(codes.InvalidArgument, "silence is required")
+	}
+	req.Silence.CreatedBy = authenticatedUserID(ctx, req.Silence.CreatedBy)
+
+	created, err := s.store.Create(ctx, req.Silence)
+	if err != nil {
+		if errors.Is(err, silence.ErrInvalidSilence) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Msg("failed to create silence")
+		return nil, status.Error(codes.Internal, "failed to create silence")
+	}
+
+	s.logger.Info().
+		Str("id", created.Id).
+		Str("createdBy", created.CreatedBy).
+		Time("startsAt", created.StartsAt.AsTime()).
+		Time("endsAt", created.EndsAt.AsTime()).
+		Msg("silence created")
+
+	return created, nil
+}
+
+// GetSilence retrieves a silence by ID.
+func (s *SilenceService) GetSilence(ctx context.Context, req *alertingv1.GetSilenceRequest) (*alertingv1.SilenceRule, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	found, err := s.store.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, silence.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "silence not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to get silence")
+		return nil, status.Error(codes.Internal, "failed to get silence")
+	}
+
+	return found, nil
+}
+
+// ListSilences lists all silences, or only the active ones.
+func (s *SilenceService) ListSilences(ctx context.Context, req *alertingv1.ListSilencesRequest) (*alertingv1.ListSilencesResponse, error) {
+	var silences []*alertingv1.SilenceRule
+	var err error
+	if req.ActiveOnly {
+		silences, err = s.store.ListActive(ctx, s.now())
+	} else {
+		silences, err = s.store.List(ctx)
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list silences")
+		return nil, status.Error(codes.Internal, "failed to list silences")
+	}
+
+	return &alertingv1.ListSilencesResponse{Silences: silences}, nil
+}
+
+// UpdateSilence updates an existing silence.
+func (s *SilenceService) UpdateSilence(ctx context.Context, req *alertingv1.UpdateSilenceRequest) (*alertingv1.SilenceRule, error) {
+	if req.Silence == nil || req.Silence.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "silence with id is required")
+	}
+
+	updated, err := s.store.Update(ctx, req.Silence)
+	if err != nil {
+		if errors.Is(err, silence.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "silence not found")
+		}
+		if errors.Is(err, silence.ErrInvalidSilence) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Str("id", req.Silence.Id).Msg("failed to update silence")
+		return nil, status.Error(codes.Internal, "failed to update silence")
+	}
+
+	s.logger.Info().Str("id", updated.Id).Msg("silence updated")
+
+	return updated, nil
+}
+
+// DeleteSilence deletes a silence by ID.
+func (s *SilenceService) DeleteSilence(ctx context.Context, req *alertingv1.DeleteSilenceRequest) (*alertingv1.DeleteSilenceResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.store.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, silence.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "silence not found")
+		}
+		s.logger.Error().Err(err).Str("id", req.Id).Msg("failed to delete silence")
+		return nil, status.Error(codes.Internal, "failed to delete silence")
+	}
+
+	s.logger.Info().Str("id", req.Id).Msg("silence deleted")
+
+	return &alertingv1.DeleteSilenceResponse{Success: true}, nil
+}
+
+// Ensure SilenceService implements the interface
+var _ alertingv1.SilenceServiceServer = (*SilenceService)(nil)
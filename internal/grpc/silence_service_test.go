@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/silence"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func setupSilenceService(t *testing.T) *SilenceService {
+	store := silence.NewInMemoryStore()
+	logger := zerolog.Nop()
+	return NewSilenceService(store, logger)
+}
+
+func newTestSilenceRule(start time.Time) *alertingv1.SilenceRule {
+	return &alertingv1.SilenceRule{
+		Matchers: []*alertingv1.LabelMatcher{
+			{Name: "env", Value: "staging", Operator: alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL},
+			{Name: "cluster", Value: "ams[0-9]+", Operator: alertingv1.MatchOperator_MATCH_OPERATOR_REGEX},
+		},
+		StartsAt:  timestamppb.New(start),
+		EndsAt:    timestamppb.New(start.Add(2 * time.Hour)),
+		Comment:   "Staging load test",
+		CreatedBy: "user-1",
+	}
+}
+
+func TestSilenceService_CreateSilence(t *testing.T) {
+	svc := setupSilenceService(t)
+	ctx := context.Background()
+
+	t.Run("create valid silence", func(t *testing.T) {
+		resp, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{
+			Silence: newTestSilenceRule(time.Now()),
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.Id)
+		assert.Equal(t, "user-1", resp.CreatedBy)
+		require.Len(t, resp.Matchers, 2)
+		assert.Equal(t, alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, resp.Matchers[1].Operator)
+		assert.NotNil(t, resp.CreatedAt)
+	})
+
+	t.Run("nil silence", func(t *testing.T) {
+		_, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		rule := newTestSilenceRule(time.Now())
+		rule.Matchers[1].Value = "ams[0-9"
+		_, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{Silence: rule})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ends before it starts", func(t *testing.T) {
+		rule := newTestSilenceRule(time.Now())
+		rule.EndsAt = timestamppb.New(time.Now().Add(-time.Hour))
+		_, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{Silence: rule})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSilenceService_GetUpdateDelete(t *testing.T) {
+	svc := setupSilenceService(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{
+		Silence: newTestSilenceRule(time.Now()),
+	})
+	require.NoError(t, err)
+
+	fetched, err := svc.GetSilence(ctx, &alertingv1.GetSilenceRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "Staging load test", fetched.Comment)
+
+	_, err = svc.GetSilence(ctx, &alertingv1.GetSilenceRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.GetSilence(ctx, &alertingv1.GetSilenceRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	fetched.Comment = "Extended load test"
+	fetched.EndsAt = timestamppb.New(fetched.EndsAt.AsTime().Add(time.Hour))
+	updated, err := svc.UpdateSilence(ctx, &alertingv1.UpdateSilenceRequest{Silence: fetched})
+	require.NoError(t, err)
+	assert.Equal(t, "Extended load test", updated.Comment)
+	assert.Equal(t, created.CreatedAt.AsTime(), updated.CreatedAt.AsTime())
+
+	_, err = svc.UpdateSilence(ctx, &alertingv1.UpdateSilenceRequest{Silence: newTestSilenceRule(time.Now())})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	missing := newTestSilenceRule(time.Now())
+	missing.Id = "missing"
+	_, err = svc.UpdateSilence(ctx, &alertingv1.UpdateSilenceRequest{Silence: missing})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := svc.DeleteSilence(ctx, &alertingv1.DeleteSilenceRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = svc.DeleteSilence(ctx, &alertingv1.DeleteSilenceRequest{Id: created.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSilenceService_ListSilences(t *testing.T) {
+	svc := setupSilenceService(t)
+	ctx := context.Background()
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+
+	active, err := svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{Silence: newTestSilenceRule(now.Add(-time.Hour))})
+	require.NoError(t, err)
+	_, err = svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{Silence: newTestSilenceRule(now.Add(time.Hour))})
+	require.NoError(t, err)
+	_, err = svc.CreateSilence(ctx, &alertingv1.CreateSilenceRequest{Silence: newTestSilenceRule(now.Add(-3 * time.Hour))})
+	require.NoError(t, err)
+
+	all, err := svc.ListSilences(ctx, &alertingv1.ListSilencesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.Silences, 3)
+
+	activeOnly, err := svc.ListSilences(ctx, &alertingv1.ListSilencesRequest{ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, activeOnly.Silences, 1)
+	assert.Equal(t, active.Id, activeOnly.Silences[0].Id)
+}
@@ -4,6 +4,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
@@ -17,18 +18,25 @@ import (
 // SiteService implements the SiteServiceServer interface.
 type SiteService struct {
 	routingv1.UnimplementedSiteServiceServer
-	store  site.Store
-	logger zerolog.Logger
+	store   site.Store
+	logger  zerolog.Logger
+	metrics *site.Metrics
 }
 
 // NewSiteService creates a new SiteService.
 func NewSiteService(store site.Store, logger zerolog.Logger) *SiteService {
 	return &SiteService{
-		store:  store,
-		logger: logger.With().Str("service", "site").Logger(),
+		store:   store,
+		logger:  logger.With().Str("service", "site").Logger(),
+		metrics: site.NewMetrics(),
 	}
 }
 
+// Metrics returns the metrics recorder for this service.
+func (s *SiteService) Metrics() *site.Metrics {
+	return s.metrics
+}
+
 // CreateSite creates a new site.
 func (s *SiteService) CreateSite(ctx context.Context, req *routingv1.CreateSiteRequest) (*routingv1.Site, error) {
 	if req.Site == nil {
@@ -200,6 +208,49 @@ func (s *SiteService) DeleteSite(ctx context.Context, req *routingv1.DeleteSiteR
 	return &routingv1.DeleteSiteResponse{Success: true}, nil
 }
 
+// UpdateSiteCapacity updates the capacity metrics of a site.
+func (s *SiteService) UpdateSiteCapacity(ctx context.Context, req *routingv1.UpdateSiteCapacityRequest) (*routingv1.Site, error) {
+	if req.SiteId == "" {
+		return nil, status.Error(codes.InvalidArgument, "site_id is required")
+	}
+	if req.Capacity == nil {
+		return nil, status.Error(codes.InvalidArgument, "capacity is required")
+	}
+	if req.Capacity.CurrentLoadPercent < 0 || req.Capacity.CurrentLoadPercent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "current_load_percent must be between 0 and 100")
+	}
+	if req.Capacity.TotalServers < 0 {
+		return nil, status.Error(codes.InvalidArgument, "total_servers must not be negative")
+	}
+
+	startTime := time.Now()
+	err := s.store.UpdateSiteCapacity(ctx, req.SiteId, protoToCapacity(req.Capacity))
+	s.metrics.RecordCapacityUpdateLatency(time.Since(startTime))
+	if err != nil {
+		if errors.Is(err, site.ErrSiteNotFound) {
+			return nil, status.Error(codes.NotFound, "site not found")
+		}
+		if errors.Is(err, site.ErrInvalidSite) {
+			return nil, status.Error(codes.InvalidArgument, "invalid capacity data")
+		}
+		s.logger.Error().Err(err).Str("id", req.SiteId).Msg("failed to update site capacity")
+		return nil, status.Error(codes.Internal, "failed to update site capacity")
+	}
+
+	s.logger.Info().
+		Str("id", req.SiteId).
+		Float32("current_load_percent", req.Capacity.CurrentLoadPercent).
+		Msg("site capacity updated")
+
+	updatedSite, err := s.store.GetByID(ctx, req.SiteId)
+	if err != nil {
+		s.logger.Error().Err(err).Str("id", req.SiteId).Msg("failed to get site")
+		return nil, status.Error(codes.Internal, "failed to get site")
+	}
+
+	return siteToProto(updatedSite), nil
+}
+
 // =============================================================================
 // Conversion helpers
 // =============================================================================
@@ -260,6 +311,11 @@ func protoToSite(p *routingv1.Site) *site.Site {
 		}
 	}
 
+	// Handle capacity
+	if p.Capacity != nil {
+		s.Capacity = protoToCapacity(p.Capacity)
+	}
+
 	// Handle timestamps
 	if p.CreatedAt != nil {
 		s.CreatedAt = p.CreatedAt.AsTime()
@@ -329,9 +385,32 @@ func siteToProto(s *site.Site) *routingv1.Site {
 		}
 	}
 
+	// Handle capacity
+	if s.Capacity != nil {
+		p.Capacity = &routingv1.CapacityMetrics{
+			TotalServers:       int32(s.Capacity.TotalServers),
+			CurrentLoadPercent: float32(s.Capacity.CurrentLoadPercent),
+			LastUpdated:        timestamppb.New(s.Capacity.LastUpdated),
+		}
+	}
+
 	return p
 }
 
+// protoToCapacity converts proto CapacityMetrics to internal site.CapacityMetrics.
+func protoToCapacity(p *routingv1.CapacityMetrics) *site.CapacityMetrics {
+	c := &site.CapacityMetrics{
+		TotalServers:       int(p.TotalServers),
+		CurrentLoadPercent: float64(p.CurrentLoadPercent),
+	}
+	if p.LastUpdated != nil {
+		c.LastUpdated = p.LastUpdated.AsTime()
+	} else {
+		c.LastUpdated = time.Now()
+	}
+	return c
+}
+
 // protoSiteTypeToInternal converts a proto SiteType to an internal site.SiteType.
 func protoSiteTypeToInternal(t routingv1.SiteType) site.SiteType {
 	switch t {
@@ -352,18 +431,7 @@ func protoSiteTypeToInternal(t routingv1.SiteType) site.SiteType {
 
 // internalSiteTypeToProto converts an internal site.SiteType to a proto SiteType.
 func internalSiteTypeToProto(t site.SiteType) routingv1.SiteType {
-	switch t {
-	case site.SiteTypeDatacenter:
-		return routingv1.SiteType_SITE_TYPE_DATACENTER
-	case site.SiteTypePOP:
-		return routingv1.SiteType_SITE_TYPE_POP
-	case site.SiteTypeHub:
-		return routingv1.SiteType_SITE_TYPE_COLOCATION
-	case site.SiteTypeCustomerPremise:
-		return routingv1.SiteType_SITE_TYPE_EDGE
-	default:
-		return routingv1.SiteType_SITE_TYPE_UNSPECIFIED
-	}
+	return t.ToProto()
 }
 
 // Ensure SiteService implements the interface
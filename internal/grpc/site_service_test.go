@@ -123,6 +123,18 @@ func (m *mockSiteStore) GetTeamByID(ctx context.Context, id string) (*site.Team,
 	return nil, site.ErrTeamNotFound
 }
 
+func (m *mockSiteStore) UpdateSiteCapacity(ctx context.Context, siteID string, metrics *site.CapacityMetrics) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	s, exists := m.sites[siteID]
+	if !exists {
+		return site.ErrSiteNotFound
+	}
+	s.Capacity = metrics
+	return nil
+}
+
 // Helper to add a site to the mock store
 func (m *mockSiteStore) addSite(s *site.Site) {
 	m.sites[s.ID] = s
@@ -551,6 +563,66 @@ func TestSiteService_DeleteSite(t *testing.T) {
 	})
 }
 
+func TestSiteService_UpdateSiteCapacity(t *testing.T) {
+	logger := zerolog.Nop()
+
+	t.Run("success", func(t *testing.T) {
+		store := newMockSiteStore()
+		now := time.Now()
+		store.addSite(&site.Site{
+			ID:        "site-123",
+			Name:      "Test Datacenter",
+			Code:      "NYC-DC1",
+			SiteType:  site.SiteTypeDatacenter,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		svc := NewSiteService(store, logger)
+
+		resp, err := svc.UpdateSiteCapacity(context.Background(), &routingv1.UpdateSiteCapacityRequest{
+			SiteId: "site-123",
+			Capacity: &routingv1.CapacityMetrics{
+				TotalServers:       200,
+				CurrentLoadPercent: 92.5,
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Capacity)
+		assert.Equal(t, int32(200), resp.Capacity.TotalServers)
+		assert.InDelta(t, 92.5, resp.Capacity.CurrentLoadPercent, 0.001)
+		assert.NotNil(t, resp.Capacity.LastUpdated)
+		assert.Len(t, svc.Metrics().GetCapacityUpdateLatencies(), 1)
+	})
+
+	t.Run("invalid load percent", func(t *testing.T) {
+		store := newMockSiteStore()
+		svc := NewSiteService(store, logger)
+
+		_, err := svc.UpdateSiteCapacity(context.Background(), &routingv1.UpdateSiteCapacityRequest{
+			SiteId:   "site-123",
+			Capacity: &routingv1.CapacityMetrics{CurrentLoadPercent: 150},
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		store := newMockSiteStore()
+		svc := NewSiteService(store, logger)
+
+		_, err := svc.UpdateSiteCapacity(context.Background(), &routingv1.UpdateSiteCapacityRequest{
+			SiteId:   "nonexistent",
+			Capacity: &routingv1.CapacityMetrics{CurrentLoadPercent: 50},
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
 func TestConversions(t *testing.T) {
 	t.Run("protoToSite", func(t *testing.T) {
 		now := time.Now()
@@ -4,6 +4,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
@@ -13,19 +14,51 @@ import (
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
+// ScheduleCreator creates on-call schedules. It is satisfied by ScheduleService.
+type ScheduleCreator interface {
+	CreateSchedule(ctx context.Context, req *routingv1.CreateScheduleRequest) (*routingv1.Schedule, error)
+}
+
 // TeamService implements the TeamServiceServer interface.
 type TeamService struct {
 	routingv1.UnimplementedTeamServiceServer
-	store  team.Store
-	logger zerolog.Logger
+	store   team.Store
+	logger  zerolog.Logger
+	metrics *team.Metrics
+
+	// schedules creates the default schedule of teams created with
+	// auto_create_schedule (optional)
+	schedules ScheduleCreator
+	now       func() time.Time
+}
+
+// TeamServiceOption configures optional TeamService dependencies.
+type TeamServiceOption func(*TeamService)
+
+// WithScheduleCreator enables auto-created default schedules for new teams.
+func WithScheduleCreator(schedules ScheduleCreator) TeamServiceOption {
+	return func(s *TeamService) {
+		s.schedules = schedules
+	}
 }
 
 // NewTeamService creates a new TeamService.
-func NewTeamService(store team.Store, logger zerolog.Logger) *TeamService {
-	return &TeamService{
-		store:  store,
-		logger: logger.With().Str("service", "team").Logger(),
+func NewTeamService(store team.Store, logger zerolog.Logger, opts ...TeamServiceOption) *TeamService {
+	s := &TeamService{
+		store:   store,
+		logger:  logger.With().Str("service", "team").Logger(),
+		metrics: team.NewMetrics(),
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// Metrics returns the metrics recorder for this service.
+func (s *TeamService) Metrics() *team.Metrics {
+	return s.metrics
 }
 
 // =============================================================================
@@ -42,6 +75,15 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *routingv1.CreateTeamR
 		return nil, status.Error(codes.InvalidArgument, "team name is required")
 	}
 
+	if req.AutoCreateSchedule {
+		if s.schedules == nil {
+			return nil, status.Error(codes.FailedPrecondition, "schedule auto-creation is not configured")
+		}
+		if _, err := time.LoadLocation(req.DefaultTimezone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid default_timezone: %v", err)
+		}
+	}
+
 	s.logger.Info().
 		Str("name", req.Team.Name).
 		Int("memberCount", len(req.Team.Members)).
@@ -64,9 +106,34 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *routingv1.CreateTeamR
 		Str("name", t.Name).
 		Msg("team created")
 
+	if req.AutoCreateSchedule {
+		s.createDefaultSchedule(ctx, t, req.DefaultTimezone)
+	}
+
 	return t, nil
 }
 
+// createDefaultSchedule creates the team's default weekly schedule. Failures are
+// logged and never fail team creation.
+func (s *TeamService) createDefaultSchedule(ctx context.Context, t *routingv1.Team, timezone string) {
+	sched, err := s.schedules.CreateSchedule(ctx, &routingv1.CreateScheduleRequest{
+		Schedule: team.DefaultSchedule(t, timezone, s.now()),
+	})
+	if err != nil {
+		s.logger.Warn().
+			Err(err).
+			Str("teamId", t.Id).
+			Msg("failed to auto-create team schedule, team was created without one")
+		return
+	}
+
+	s.metrics.RecordScheduleAutoCreated(t.Id)
+	s.logger.Info().
+		Str("teamId", t.Id).
+		Str("scheduleId", sched.Id).
+		Msg("team schedule auto-created")
+}
+
 // GetTeam retrieves a team by ID.
 func (s *TeamService) GetTeam(ctx context.Context, req *routingv1.GetTeamRequest) (*routingv1.Team, error) {
 	if req.Id == "" {
@@ -285,5 +352,58 @@ func (s *TeamService) GetUserTeams(ctx context.Context, req *routingv1.GetUserTe
 	}, nil
 }
 
+// =============================================================================
+// Availability (2 RPCs)
+// =============================================================================
+
+// SetUserAvailability records whether a user is available for on-call shifts during a period.
+func (s *TeamService) SetUserAvailability(ctx context.Context, req *routingv1.SetUserAvailabilityRequest) (*routingv1.UserAvailability, error) {
+	if req.Availability == nil || req.Availability.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "availability with user_id is required")
+	}
+
+	if err := s.store.SetAvailability(ctx, req.Availability.UserId, req.Availability); err != nil {
+		if errors.Is(err, team.ErrInvalidAvailability) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error().Err(err).Str("userId", req.Availability.UserId).Msg("failed to set user availability")
+		return nil, status.Error(codes.Internal, "failed to set user availability")
+	}
+
+	s.logger.Info().
+		Str("userId", req.Availability.UserId).
+		Bool("available", req.Availability.Available).
+		Str("reason", req.Availability.UnavailableReason.String()).
+		Msg("user availability set")
+
+	return req.Availability, nil
+}
+
+// GetTeamAvailability retrieves the availability periods of a team's members within a time range.
+func (s *TeamService) GetTeamAvailability(ctx context.Context, req *routingv1.GetTeamAvailabilityRequest) (*routingv1.GetTeamAvailabilityResponse, error) {
+	if req.TeamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_id is required")
+	}
+
+	if req.From == nil || req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and until are required")
+	}
+
+	if !req.Until.AsTime().After(req.From.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "until must be after from")
+	}
+
+	availabilities, err := s.store.GetTeamAvailability(ctx, req.TeamId, req.From.AsTime(), req.Until.AsTime())
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		s.logger.Error().Err(err).Str("teamId", req.TeamId).Msg("failed to get team availability")
+		return nil, status.Error(codes.Internal, "failed to get team availability")
+	}
+
+	return &routingv1.GetTeamAvailabilityResponse{Availabilities: availabilities}, nil
+}
+
 // Ensure TeamService implements the interface
 var _ routingv1.TeamServiceServer = (*TeamService)(nil)
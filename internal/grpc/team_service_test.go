@@ -3,10 +3,12 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kneutral-org/alerting-system/internal/team"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
@@ -14,8 +16,9 @@ import (
 
 // TestTeamStore is an in-memory implementation for testing the service.
 type TestTeamStore struct {
-	teams   map[string]*routingv1.Team
-	counter int64
+	teams          map[string]*routingv1.Team
+	availabilities []*routingv1.UserAvailability
+	counter        int64
 }
 
 func NewTestTeamStore() *TestTeamStore {
@@ -172,6 +175,43 @@ func (s *TestTeamStore) GetByUser(ctx context.Context, userID string) ([]*routin
 	return teams, nil
 }
 
+func (s *TestTeamStore) SetAvailability(ctx context.Context, userID string, availability *routingv1.UserAvailability) error {
+	if userID == "" {
+		return team.ErrInvalidAvailability
+	}
+	if err := team.ValidateAvailability(availability); err != nil {
+		return err
+	}
+
+	availability.UserId = userID
+	for i, existing := range s.availabilities {
+		if existing.UserId == userID && existing.PeriodStart.AsTime().Equal(availability.PeriodStart.AsTime()) &&
+			existing.PeriodEnd.AsTime().Equal(availability.PeriodEnd.AsTime()) {
+			s.availabilities[i] = availability
+			return nil
+		}
+	}
+	s.availabilities = append(s.availabilities, availability)
+	return nil
+}
+
+func (s *TestTeamStore) GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error) {
+	t, ok := s.teams[teamID]
+	if !ok {
+		return nil, team.ErrNotFound
+	}
+
+	var availabilities []*routingv1.UserAvailability
+	for _, m := range t.Members {
+		for _, availability := range s.availabilities {
+			if availability.UserId == m.UserId && availability.PeriodStart.AsTime().Before(until) && availability.PeriodEnd.AsTime().After(from) {
+				availabilities = append(availabilities, availability)
+			}
+		}
+	}
+	return availabilities, nil
+}
+
 // Ensure TestTeamStore implements Store
 var _ team.Store = (*TestTeamStore)(nil)
 
@@ -270,6 +310,134 @@ func TestTeamService_CreateTeam(t *testing.T) {
 	})
 }
 
+// failingScheduleCreator rejects every schedule.
+type failingScheduleCreator struct{}
+
+func (failingScheduleCreator) CreateSchedule(ctx context.Context, req *routingv1.CreateScheduleRequest) (*routingv1.Schedule, error) {
+	return nil, status.Error(codes.Internal, "failed to create schedule")
+}
+
+func TestTeamService_CreateTeam_AutoCreateSchedule(t *testing.T) {
+	ctx := context.Background()
+	scheduleStore := NewTestInMemoryStore()
+	svc := NewTeamService(NewTestTeamStore(), zerolog.Nop(),
+		WithScheduleCreator(NewScheduleService(scheduleStore, zerolog.Nop())))
+
+	resp, err := svc.CreateTeam(ctx, &routingv1.CreateTeamRequest{
+		Team: &routingv1.Team{
+			Name: "Network Ops",
+			Members: []*routingv1.TeamMember{
+				{UserId: "alice"},
+				{UserId: "bob"},
+			},
+		},
+		AutoCreateSchedule: true,
+		DefaultTimezone:    "Europe/Berlin",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	schedules, _ := scheduleStore.ListSchedules(ctx, &routingv1.ListSchedulesRequest{TeamId: resp.Id})
+	if len(schedules.Schedules) != 1 {
+		t.Fatalf("expected 1 schedule for the team, got %d", len(schedules.Schedules))
+	}
+
+	sched := schedules.Schedules[0]
+	if sched.Timezone != "Europe/Berlin" {
+		t.Errorf("expected timezone Europe/Berlin, got %q", sched.Timezone)
+	}
+	if len(sched.Rotations) != 1 || sched.Rotations[0].Type != routingv1.RotationType_ROTATION_TYPE_WEEKLY {
+		t.Fatalf("expected a single weekly rotation, got %v", sched.Rotations)
+	}
+	members := sched.Rotations[0].Members
+	if len(members) != 2 || members[0].UserId != "alice" || members[1].UserId != "bob" {
+		t.Errorf("expected rotation members alice, bob, got %v", members)
+	}
+
+	if got := svc.Metrics().ScheduleAutoCreatedTotal(resp.Id); got != 1 {
+		t.Errorf("expected 1 auto-created schedule, got %d", got)
+	}
+}
+
+func TestTeamService_CreateTeam_AutoCreateScheduleDisabled(t *testing.T) {
+	ctx := context.Background()
+	scheduleStore := NewTestInMemoryStore()
+	svc := NewTeamService(NewTestTeamStore(), zerolog.Nop(),
+		WithScheduleCreator(NewScheduleService(scheduleStore, zerolog.Nop())))
+
+	resp, err := svc.CreateTeam(ctx, &routingv1.CreateTeamRequest{
+		Team: &routingv1.Team{Name: "Network Ops"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(scheduleStore.schedules) != 0 {
+		t.Errorf("expected no schedules, got %d", len(scheduleStore.schedules))
+	}
+	if got := svc.Metrics().ScheduleAutoCreatedTotal(resp.Id); got != 0 {
+		t.Errorf("expected no auto-created schedules, got %d", got)
+	}
+}
+
+func TestTeamService_CreateTeam_AutoCreateScheduleFails(t *testing.T) {
+	ctx := context.Background()
+	teamStore := NewTestTeamStore()
+	svc := NewTeamService(teamStore, zerolog.Nop(), WithScheduleCreator(failingScheduleCreator{}))
+
+	resp, err := svc.CreateTeam(ctx, &routingv1.CreateTeamRequest{
+		Team:               &routingv1.Team{Name: "Network Ops"},
+		AutoCreateSchedule: true,
+	})
+	if err != nil {
+		t.Fatalf("expected team creation to succeed, got %v", err)
+	}
+
+	if _, err := teamStore.Get(ctx, resp.Id); err != nil {
+		t.Errorf("expected team to be stored, got %v", err)
+	}
+	if got := svc.Metrics().ScheduleAutoCreatedTotal(resp.Id); got != 0 {
+		t.Errorf("expected no auto-created schedules, got %d", got)
+	}
+}
+
+func TestTeamService_CreateTeam_AutoCreateScheduleInvalidInput(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		svc  *TeamService
+		tz   string
+		code codes.Code
+	}{
+		{
+			name: "invalid timezone",
+			svc:  NewTeamService(NewTestTeamStore(), zerolog.Nop(), WithScheduleCreator(failingScheduleCreator{})),
+			tz:   "Mars/Olympus",
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no schedule creator",
+			svc:  newTestTeamService(),
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.svc.CreateTeam(ctx, &routingv1.CreateTeamRequest{
+				Team:               &routingv1.Team{Name: "Network Ops"},
+				AutoCreateSchedule: true,
+				DefaultTimezone:    tt.tz,
+			})
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
 func TestTeamService_GetTeam(t *testing.T) {
 	ctx := context.Background()
 	svc := newTestTeamService()
@@ -836,6 +1004,118 @@ func TestTeamService_GetUserTeams(t *testing.T) {
 	})
 }
 
+func TestTeamService_Availability(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestTeamService()
+
+	_, _ = svc.CreateTeam(ctx, &routingv1.CreateTeamRequest{
+		Team: &routingv1.Team{
+			Id:      "team-1",
+			Name:    "Team A",
+			Members: []*routingv1.TeamMember{{UserId: "user-1"}, {UserId: "user-2"}},
+		},
+	})
+
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	period := func(from, until time.Duration) (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+		return timestamppb.New(start.Add(from)), timestamppb.New(start.Add(until))
+	}
+
+	vacationStart, vacationEnd := period(0, 7*24*time.Hour)
+	_, err := svc.SetUserAvailability(ctx, &routingv1.SetUserAvailabilityRequest{
+		Availability: &routingv1.UserAvailability{
+			UserId:            "user-1",
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION,
+			PeriodStart:       vacationStart,
+			PeriodEnd:         vacationEnd,
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetUserAvailability() error = %v", err)
+	}
+
+	// Outside of the queried range
+	trainingStart, trainingEnd := period(30*24*time.Hour, 31*24*time.Hour)
+	_, err = svc.SetUserAvailability(ctx, &routingv1.SetUserAvailabilityRequest{
+		Availability: &routingv1.UserAvailability{
+			UserId:            "user-2",
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_TRAINING,
+			PeriodStart:       trainingStart,
+			PeriodEnd:         trainingEnd,
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetUserAvailability() error = %v", err)
+	}
+
+	from, until := period(24*time.Hour, 14*24*time.Hour)
+	resp, err := svc.GetTeamAvailability(ctx, &routingv1.GetTeamAvailabilityRequest{TeamId: "team-1", From: from, Until: until})
+	if err != nil {
+		t.Fatalf("GetTeamAvailability() error = %v", err)
+	}
+	if len(resp.Availabilities) != 1 {
+		t.Fatalf("expected 1 availability, got %d", len(resp.Availabilities))
+	}
+	got := resp.Availabilities[0]
+	if got.UserId != "user-1" || got.Available || got.UnavailableReason != routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION {
+		t.Errorf("unexpected availability %v", got)
+	}
+}
+
+func TestTeamService_Availability_Errors(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestTeamService()
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *routingv1.SetUserAvailabilityRequest
+	}{
+		{"missing availability", &routingv1.SetUserAvailabilityRequest{}},
+		{"missing period", &routingv1.SetUserAvailabilityRequest{
+			Availability: &routingv1.UserAvailability{UserId: "user-1"},
+		}},
+		{"period end before start", &routingv1.SetUserAvailabilityRequest{
+			Availability: &routingv1.UserAvailability{
+				UserId:      "user-1",
+				PeriodStart: timestamppb.New(now),
+				PeriodEnd:   timestamppb.New(now.Add(-time.Hour)),
+			},
+		}},
+		{"reason while available", &routingv1.SetUserAvailabilityRequest{
+			Availability: &routingv1.UserAvailability{
+				UserId:            "user-1",
+				Available:         true,
+				UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_SICK,
+				PeriodStart:       timestamppb.New(now),
+				PeriodEnd:         timestamppb.New(now.Add(time.Hour)),
+			},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SetUserAvailability(ctx, tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+
+	_, err := svc.GetTeamAvailability(ctx, &routingv1.GetTeamAvailabilityRequest{
+		TeamId: "missing",
+		From:   timestamppb.New(now),
+		Until:  timestamppb.New(now.Add(time.Hour)),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for unknown team, got %v", err)
+	}
+
+	_, err = svc.GetTeamAvailability(ctx, &routingv1.GetTeamAvailabilityRequest{TeamId: "team-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without a range, got %v", err)
+	}
+}
+
 // Benchmark tests
 func BenchmarkTeamService_CreateTeam(b *testing.B) {
 	ctx := context.Background()
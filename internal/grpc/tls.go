@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// ErrIncompleteTLSConfig is returned when only some of the mTLS files are configured.
+var ErrIncompleteTLSConfig = errors.New("GRPC_TLS_CA_CERT, GRPC_TLS_CERT and GRPC_TLS_KEY must be set together")
+
+// TLSConfig holds the PEM files used for gRPC mutual TLS.
+type TLSConfig struct {
+	// CAFile is the CA bundle used to verify peer certificates.
+	CAFile string
+	// CertFile is the certificate presented to peers.
+	CertFile string
+	// KeyFile is the private key of CertFile.
+	KeyFile string
+}
+
+// TLSConfigFromEnv returns the mTLS configuration read from GRPC_TLS_CA_CERT,
+// GRPC_TLS_CERT and GRPC_TLS_KEY.
+func TLSConfigFromEnv() TLSConfig {
+	return TLSConfig{
+		CAFile:   os.Getenv("GRPC_TLS_CA_CERT"),
+		CertFile: os.Getenv("GRPC_TLS_CERT"),
+		KeyFile:  os.Getenv("GRPC_TLS_KEY"),
+	}
+}
+
+// Enabled reports whether all mTLS files are configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CAFile != "" && c.CertFile != "" && c.KeyFile != ""
+}
+
+// Validate checks that the mTLS files are either all set or all unset, so a
+// partial configuration never silently falls back to plaintext.
+func (c TLSConfig) Validate() error {
+	if c.Enabled() || (c.CAFile == "" && c.CertFile == "" && c.KeyFile == "") {
+		return nil
+	}
+	return ErrIncompleteTLSConfig
+}
+
+// Credentials returns the mTLS transport credentials for this configuration.
+func (c TLSConfig) Credentials() (credentials.TransportCredentials, error) {
+	return NewMTLSCredentials(c.CAFile, c.CertFile, c.KeyFile)
+}
+
+// NewMTLSCredentials creates transport credentials that present the certificate in
+// certFile and require peers to present a certificate signed by the CA in caFile.
+// The same credentials work for servers, which require and verify client
+// certificates, and for clients dialing other services.
+func NewMTLSCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls key pair: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read tls ca certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
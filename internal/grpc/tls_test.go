@@ -0,0 +1,246 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// testCA is a self-signed certificate authority issuing test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ca key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create ca certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse ca certificate: %v", err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue writes a certificate and key for name signed by the CA and returns their paths.
+func (ca *testCA) issue(t *testing.T, name string, serial int64) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	writeTestFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeTestFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certFile, keyFile
+}
+
+// writeCA writes the CA certificate and returns its path.
+func (ca *testCA) writeCA(t *testing.T) string {
+	t.Helper()
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	writeTestFile(t, caFile, ca.pem)
+	return caFile
+}
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+// startMTLSServer serves the routing service over an in-memory listener with mTLS.
+func startMTLSServer(t *testing.T, ca *testCA) *bufconn.Listener {
+	t.Helper()
+	certFile, keyFile := ca.issue(t, "bufnet", 2)
+	creds, err := NewMTLSCredentials(ca.writeCA(t), certFile, keyFile)
+	if err != nil {
+		t.Fatalf("failed to create server credentials: %v", err)
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.Creds(creds))
+	routingv1.RegisterRoutingServiceServer(server, NewRoutingService(routing.NewInMemoryStore(), zerolog.Nop()))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener
+}
+
+// listRules calls ListRoutingRules over the listener using the client credentials.
+func listRules(t *testing.T, listener *bufconn.Listener, creds credentials.TransportCredentials) error {
+	t.Helper()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = routingv1.NewRoutingServiceClient(conn).ListRoutingRules(ctx, &routingv1.ListRoutingRulesRequest{})
+	return err
+}
+
+func TestMTLSCredentials_AcceptsTrustedClient(t *testing.T) {
+	ca := newTestCA(t, "test-ca")
+	listener := startMTLSServer(t, ca)
+
+	certFile, keyFile := ca.issue(t, "notification-service", 3)
+	creds, err := NewMTLSCredentials(ca.writeCA(t), certFile, keyFile)
+	if err != nil {
+		t.Fatalf("failed to create client credentials: %v", err)
+	}
+
+	if err := listRules(t, listener, creds); err != nil {
+		t.Fatalf("expected call with a trusted client certificate to succeed, got %v", err)
+	}
+}
+
+func TestMTLSCredentials_RejectsClientWithoutCertificate(t *testing.T) {
+	ca := newTestCA(t, "test-ca")
+	listener := startMTLSServer(t, ca)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	creds := credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+
+	if err := listRules(t, listener, creds); err == nil {
+		t.Fatal("expected call without a client certificate to fail")
+	}
+}
+
+func TestMTLSCredentials_RejectsUntrustedClient(t *testing.T) {
+	ca := newTestCA(t, "test-ca")
+	listener := startMTLSServer(t, ca)
+
+	// The client trusts the server but presents a certificate from another CA.
+	rogue := newTestCA(t, "rogue-ca")
+	certFile, keyFile := rogue.issue(t, "rogue-service", 4)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("failed to load rogue key pair: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	if err := listRules(t, listener, creds); err == nil {
+		t.Fatal("expected call with an untrusted client certificate to fail")
+	}
+}
+
+func TestNewMTLSCredentials_InvalidFiles(t *testing.T) {
+	ca := newTestCA(t, "test-ca")
+	certFile, keyFile := ca.issue(t, "bufnet", 2)
+
+	if _, err := NewMTLSCredentials(ca.writeCA(t), certFile, "missing.key"); err == nil {
+		t.Error("expected error for a missing key file")
+	}
+
+	emptyCA := filepath.Join(t.TempDir(), "empty.crt")
+	writeTestFile(t, emptyCA, []byte("not a certificate"))
+	if _, err := NewMTLSCredentials(emptyCA, certFile, keyFile); err == nil {
+		t.Error("expected error for a CA file without certificates")
+	}
+}
+
+func TestTLSConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  TLSConfig
+		enabled bool
+		wantErr bool
+	}{
+		{"unset", TLSConfig{}, false, false},
+		{"complete", TLSConfig{CAFile: "ca.crt", CertFile: "tls.crt", KeyFile: "tls.key"}, true, false},
+		{"missing key", TLSConfig{CAFile: "ca.crt", CertFile: "tls.crt"}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.Enabled(); got != tt.enabled {
+				t.Errorf("expected Enabled() = %v, got %v", tt.enabled, got)
+			}
+			err := tt.config.Validate()
+			if tt.wantErr != errors.Is(err, ErrIncompleteTLSConfig) {
+				t.Errorf("unexpected Validate() error: %v", err)
+			}
+		})
+	}
+}
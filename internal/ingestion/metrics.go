@@ -0,0 +1,133 @@
+package ingestion
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Metrics tracks ingestion queue and worker pool metrics.
+// Exposed as the queue_depth{priority} gauge, the
+// queue_wait_time_seconds{priority} summary, the
+// worker_queue_depth{priority} gauge and the
+// worker_processing_time_seconds{priority} histogram.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// queueDepth is the number of alerts waiting in the queue, by priority.
+	queueDepth map[string]int64
+	// waitTimeSum is the total time dequeued alerts spent waiting, by priority.
+	waitTimeSum map[string]float64
+	// waitTimeCount counts dequeued alerts, by priority.
+	waitTimeCount map[string]int64
+
+	// workerQueueDepth is the number of alerts waiting in a worker pool queue, by pool priority.
+	workerQueueDepth map[WorkerPriority]int64
+	// processingTimes holds how long workers took to process each alert, by pool priority.
+	processingTimes map[WorkerPriority][]time.Duration
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		queueDepth:    make(map[string]int64),
+		waitTimeSum:   make(map[string]float64),
+		waitTimeCount: make(map[string]int64),
+
+		workerQueueDepth: make(map[WorkerPriority]int64),
+		processingTimes:  make(map[WorkerPriority][]time.Duration),
+	}
+}
+
+// priorityLabel formats a priority as a metric label value.
+func priorityLabel(priority int) string {
+	if priority == LowestPriority {
+		return "none"
+	}
+	return strconv.Itoa(priority)
+}
+
+// IncQueueDepth increments the queue depth for a priority.
+func (m *Metrics) IncQueueDepth(priority int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queueDepth[priorityLabel(priority)]++
+}
+
+// DecQueueDepth decrements the queue depth for a priority.
+func (m *Metrics) DecQueueDepth(priority int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queueDepth[priorityLabel(priority)]--
+}
+
+// QueueDepth returns the number of queued alerts for a priority.
+func (m *Metrics) QueueDepth(priority int) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.queueDepth[priorityLabel(priority)]
+}
+
+// ObserveWaitTime records how long a dequeued alert of a priority waited.
+func (m *Metrics) ObserveWaitTime(priority int, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	label := priorityLabel(priority)
+	m.waitTimeSum[label] += wait.Seconds()
+	m.waitTimeCount[label]++
+}
+
+// WaitTimeSecondsSum returns the total wait time of dequeued alerts for a priority.
+func (m *Metrics) WaitTimeSecondsSum(priority int) float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.waitTimeSum[priorityLabel(priority)]
+}
+
+// WaitTimeCount returns the number of dequeued alerts for a priority.
+func (m *Metrics) WaitTimeCount(priority int) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.waitTimeCount[priorityLabel(priority)]
+}
+
+// SetWorkerQueueDepth sets the number of alerts waiting in a worker pool queue.
+func (m *Metrics) SetWorkerQueueDepth(priority WorkerPriority, depth int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workerQueueDepth[priority] = int64(depth)
+}
+
+// WorkerQueueDepth returns the number of alerts waiting in a worker pool queue.
+func (m *Metrics) WorkerQueueDepth(priority WorkerPriority) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.workerQueueDepth[priority]
+}
+
+// ObserveProcessingTime records how long a worker took to process an alert.
+func (m *Metrics) ObserveProcessingTime(priority WorkerPriority, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.processingTimes[priority] = append(m.processingTimes[priority], duration)
+}
+
+// GetProcessingTimes returns a copy of the recorded processing times for a worker pool priority.
+func (m *Metrics) GetProcessingTimes(priority WorkerPriority) []time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]time.Duration, len(m.processingTimes[priority]))
+	copy(result, m.processingTimes[priority])
+	return result
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queueDepth = make(map[string]int64)
+	m.waitTimeSum = make(map[string]float64)
+	m.waitTimeCount = make(map[string]int64)
+	m.workerQueueDepth = make(map[WorkerPriority]int64)
+	m.processingTimes = make(map[WorkerPriority][]time.Duration)
+}
@@ -0,0 +1,245 @@
+// Package ingestion provides prioritized processing of ingested alerts.
+package ingestion
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ErrQueueClosed is returned when enqueueing to or dequeuing from a closed queue.
+var ErrQueueClosed = errors.New("ingestion queue closed")
+
+const (
+	// CriticalPriority is the priority of critical alerts, which are processed
+	// before alerts of any customer tier.
+	CriticalPriority = 0
+	// LowestPriority is the priority of alerts that cannot be attributed to a customer tier.
+	// Tier priorities follow the tier level, where 1 is the highest priority.
+	LowestPriority = 1 << 30
+)
+
+// Item is an alert waiting in the queue.
+type Item struct {
+	Alert      *alertingv1.Alert
+	Priority   int
+	EnqueuedAt time.Time
+	// TenantID is the tenant the alert was enqueued in. The alert is processed
+	// in the same tenant.
+	TenantID string
+
+	// seq keeps alerts of the same priority in arrival order
+	seq uint64
+}
+
+// context returns ctx scoped to the tenant the item was enqueued in.
+func (i *Item) context(ctx context.Context) context.Context {
+	if i.TenantID == "" {
+		return ctx
+	}
+	return auth.WithTenant(ctx, i.TenantID)
+}
+
+// itemHeap implements heap.Interface ordered by priority, then arrival.
+type itemHeap []*Item
+
+func (h itemHeap) Len() int { return len(h) }
+
+func (h itemHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority < h[j].Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h itemHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *itemHeap) Push(x any) { *h = append(*h, x.(*Item)) }
+
+func (h *itemHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// Queue is a priority queue of ingested alerts. Alerts from higher customer
+// tiers are dequeued first; alerts of equal priority are dequeued in arrival order.
+type Queue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	items   itemHeap
+	nextSeq uint64
+	closed  bool
+
+	resolver customer.Resolver
+	metrics  *Metrics
+	logger   zerolog.Logger
+}
+
+// NewQueue creates a new alert queue. The resolver is used to determine each
+// alert's customer tier; with a nil resolver every alert gets LowestPriority.
+func NewQueue(resolver customer.Resolver, logger zerolog.Logger) *Queue {
+	q := &Queue{
+		resolver: resolver,
+		metrics:  NewMetrics(),
+		logger:   logger.With().Str("component", "ingestion_queue").Logger(),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// Metrics returns the metrics recorder for this queue.
+func (q *Queue) Metrics() *Metrics {
+	return q.metrics
+}
+
+// Priority computes the queue priority of an alert from its customer's tier level.
+func (q *Queue) Priority(ctx context.Context, alert *alertingv1.Alert) int {
+	return tierPriority(ctx, q.resolver, q.logger, alert)
+}
+
+// tierPriority returns the tier level of the alert's customer, or LowestPriority
+// when the resolver is nil or no tier can be resolved.
+func tierPriority(ctx context.Context, resolver customer.Resolver, logger zerolog.Logger, alert *alertingv1.Alert) int {
+	if resolver == nil {
+		return LowestPriority
+	}
+
+	_, tierConfig, err := resolver.ResolveWithTier(ctx, alert.GetLabels())
+	if err != nil {
+		if !errors.Is(err, customer.ErrNoCustomerResolved) {
+			logger.Warn().Err(err).Str("alert_id", alert.GetId()).Msg("failed to resolve customer tier")
+		}
+		return LowestPriority
+	}
+	if tierConfig == nil || tierConfig.Tier == nil || tierConfig.Tier.Level <= 0 {
+		return LowestPriority
+	}
+
+	return tierConfig.Tier.Level
+}
+
+// Enqueue adds an alert to the queue at the priority of its customer's tier.
+func (q *Queue) Enqueue(ctx context.Context, alert *alertingv1.Alert) error {
+	return q.EnqueueWithPriority(ctx, alert, q.Priority(ctx, alert))
+}
+
+// EnqueueWithPriority adds an alert to the queue at an explicit priority. The
+// alert is processed in the tenant of ctx.
+func (q *Queue) EnqueueWithPriority(ctx context.Context, alert *alertingv1.Alert, priority int) error {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	q.nextSeq++
+	heap.Push(&q.items, &Item{
+		Alert:      alert,
+		Priority:   priority,
+		EnqueuedAt: time.Now(),
+		TenantID:   tenantID,
+		seq:        q.nextSeq,
+	})
+	q.metrics.IncQueueDepth(priority)
+	// Wake every waiting consumer, as some only take high-priority alerts
+	q.cond.Broadcast()
+
+	return nil
+}
+
+// Dequeue removes and returns the highest-priority alert, blocking until one
+// is available, the context is cancelled or the queue is closed and drained.
+func (q *Queue) Dequeue(ctx context.Context) (*Item, error) {
+	return q.DequeueAtMost(ctx, LowestPriority)
+}
+
+// DequeueAtMost removes and returns the highest-priority alert if its priority
+// is at most maxPriority, blocking until one is available, the context is
+// cancelled or the queue is closed and drained of such alerts.
+func (q *Queue) DequeueAtMost(ctx context.Context, maxPriority int) (*Item, error) {
+	stop := context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.cond.Broadcast()
+	})
+	defer stop()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 || q.items[0].Priority > maxPriority {
+		if q.closed {
+			return nil, ErrQueueClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		q.cond.Wait()
+	}
+
+	item := heap.Pop(&q.items).(*Item)
+	q.metrics.DecQueueDepth(item.Priority)
+	q.metrics.ObserveWaitTime(item.Priority, time.Since(item.EnqueuedAt))
+
+	return item, nil
+}
+
+// Len returns the number of alerts waiting in the queue.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// Close stops the queue from accepting alerts. Alerts already queued can
+// still be dequeued.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// ProcessFunc handles a dequeued alert.
+type ProcessFunc func(ctx context.Context, alert *alertingv1.Alert) error
+
+// Run dequeues alerts in priority order and passes them to process until the
+// context is cancelled or the queue is closed and drained. Processing errors
+// are logged and do not stop the loop.
+func (q *Queue) Run(ctx context.Context, process ProcessFunc) error {
+	for {
+		item, err := q.Dequeue(ctx)
+		if err != nil {
+			if errors.Is(err, ErrQueueClosed) {
+				return nil
+			}
+			return err
+		}
+
+		if err := process(item.context(ctx), item.Alert); err != nil {
+			q.logger.Error().
+				Err(err).
+				Str("alert_id", item.Alert.GetId()).
+				Int("priority", item.Priority).
+				Msg("failed to process queued alert")
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func newTestResolver(t *testing.T) customer.Resolver {
+	t.Helper()
+	ctx := context.Background()
+
+	tierStore := customer.NewInMemoryTierStore()
+	customerStore := customer.NewInMemoryStore()
+
+	for _, tier := range []*customer.CustomerTier{
+		{ID: "tier-platinum", Name: "Platinum", Level: 1},
+		{ID: "tier-bronze", Name: "Bronze", Level: 4},
+	} {
+		if _, err := tierStore.Create(ctx, tier); err != nil {
+			t.Fatalf("failed to create tier: %v", err)
+		}
+	}
+	for _, c := range []*customer.Customer{
+		{ID: "acme", Name: "Acme", AccountID: "ACC-1", TierID: "tier-platinum"},
+		{ID: "smallco", Name: "SmallCo", AccountID: "ACC-2", TierID: "tier-bronze"},
+	} {
+		if _, err := customerStore.Create(ctx, c); err != nil {
+			t.Fatalf("failed to create customer: %v", err)
+		}
+	}
+
+	resolver := customer.NewResolver(customerStore, tierStore, customer.DefaultResolverConfig())
+	t.Cleanup(resolver.Stop)
+
+	return resolver
+}
+
+func newTestQueue(t *testing.T) *Queue {
+	t.Helper()
+	return NewQueue(newTestResolver(t), zerolog.Nop())
+}
+
+func customerAlert(id, customerID string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Id:     id,
+		Labels: map[string]string{"customer": customerID},
+	}
+}
+
+func TestQueue_HighPriorityProcessedFirst(t *testing.T) {
+	queue := newTestQueue(t)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if err := queue.Enqueue(ctx, customerAlert(fmt.Sprintf("low-%d", i), "smallco")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := queue.Enqueue(ctx, customerAlert("high", "acme")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := queue.Metrics().QueueDepth(1); got != 1 {
+		t.Errorf("expected queue depth 1 for priority 1, got %d", got)
+	}
+	if got := queue.Metrics().QueueDepth(4); got != 5 {
+		t.Errorf("expected queue depth 5 for priority 4, got %d", got)
+	}
+
+	var processed []string
+	queue.Close()
+	err := queue.Run(ctx, func(ctx context.Context, alert *alertingv1.Alert) error {
+		processed = append(processed, alert.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "[high low-0 low-1 low-2 low-3 low-4]"
+	if got := fmt.Sprint(processed); got != expected {
+		t.Errorf("expected processing order %s, got %s", expected, got)
+	}
+
+	if got := queue.Metrics().QueueDepth(4); got != 0 {
+		t.Errorf("expected drained queue depth 0, got %d", got)
+	}
+	if got := queue.Metrics().WaitTimeCount(4); got != 5 {
+		t.Errorf("expected 5 wait time observations for priority 4, got %d", got)
+	}
+	if got := queue.Metrics().WaitTimeCount(1); got != 1 {
+		t.Errorf("expected 1 wait time observation for priority 1, got %d", got)
+	}
+}
+
+func TestQueue_UnresolvedCustomerGetsLowestPriority(t *testing.T) {
+	queue := newTestQueue(t)
+	ctx := context.Background()
+
+	_ = queue.Enqueue(ctx, &alertingv1.Alert{Id: "unknown"})
+	_ = queue.Enqueue(ctx, customerAlert("missing", "no-such-customer"))
+	_ = queue.Enqueue(ctx, customerAlert("low", "smallco"))
+
+	for _, expected := range []string{"low", "unknown", "missing"} {
+		item, err := queue.Dequeue(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.Alert.Id != expected {
+			t.Errorf("expected %s, got %s", expected, item.Alert.Id)
+		}
+	}
+}
+
+func TestQueue_DequeueBlocksUntilEnqueue(t *testing.T) {
+	queue := NewQueue(nil, zerolog.Nop())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result := make(chan *Item, 1)
+	go func() {
+		item, err := queue.Dequeue(ctx)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		result <- item
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	_ = queue.EnqueueWithPriority(ctx, &alertingv1.Alert{Id: "a"}, 2)
+
+	item := <-result
+	if item == nil || item.Alert.Id != "a" {
+		t.Fatalf("expected alert a, got %+v", item)
+	}
+	if item.Priority != 2 {
+		t.Errorf("expected priority 2, got %d", item.Priority)
+	}
+}
+
+func TestQueue_DequeueContextCancelled(t *testing.T) {
+	queue := NewQueue(nil, zerolog.Nop())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	if _, err := queue.Dequeue(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestQueue_DequeueAtMost(t *testing.T) {
+	queue := NewQueue(nil, zerolog.Nop())
+
+	_ = queue.EnqueueWithPriority(context.Background(), &alertingv1.Alert{Id: "low"}, 4)
+	_ = queue.EnqueueWithPriority(context.Background(), &alertingv1.Alert{Id: "high"}, 1)
+
+	item, err := queue.DequeueAtMost(context.Background(), 1)
+	if err != nil || item.Alert.Id != "high" {
+		t.Fatalf("expected alert high, got %+v, %v", item, err)
+	}
+
+	// Lower-priority alerts are left for other consumers
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := queue.DequeueAtMost(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	queue.Close()
+	if _, err := queue.DequeueAtMost(context.Background(), 1); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("expected ErrQueueClosed, got %v", err)
+	}
+	if queue.Len() != 1 {
+		t.Errorf("expected the low-priority alert to remain queued, got %d alerts", queue.Len())
+	}
+}
+
+func TestQueue_Closed(t *testing.T) {
+	queue := NewQueue(nil, zerolog.Nop())
+	ctx := context.Background()
+
+	_ = queue.EnqueueWithPriority(ctx, &alertingv1.Alert{Id: "a"}, 1)
+	queue.Close()
+
+	if err := queue.EnqueueWithPriority(ctx, &alertingv1.Alert{Id: "b"}, 1); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("expected ErrQueueClosed on enqueue, got %v", err)
+	}
+
+	if item, err := queue.Dequeue(ctx); err != nil || item.Alert.Id != "a" {
+		t.Fatalf("expected queued alert to drain after close, got %+v, %v", item, err)
+	}
+	if _, err := queue.Dequeue(ctx); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("expected ErrQueueClosed once drained, got %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+var (
+	// ErrWorkerQueueFull is returned when an alert is submitted to a full worker pool queue.
+	ErrWorkerQueueFull = errors.New("worker queue full")
+	// ErrWorkerPoolClosed is returned when an alert is submitted after shutdown started.
+	ErrWorkerPoolClosed = errors.New("worker pool closed")
+)
+
+// WorkerPriority is the class of workers an alert is processed by.
+type WorkerPriority string
+
+const (
+	// PriorityHigh is the class of critical alerts and alerts of the highest
+	// customer tier, which have dedicated workers.
+	PriorityHigh WorkerPriority = "high"
+	// PriorityNormal is the class of every other alert.
+	PriorityNormal WorkerPriority = "normal"
+
+	// highPriorityThreshold is the highest queue priority of PriorityHigh alerts.
+	highPriorityThreshold = 1
+)
+
+// workerPriority returns the worker class of a queue priority.
+func workerPriority(priority int) WorkerPriority {
+	if priority <= highPriorityThreshold {
+		return PriorityHigh
+	}
+	return PriorityNormal
+}
+
+// WorkerPoolConfig configures a WorkerPool. Zero values use the defaults.
+type WorkerPoolConfig struct {
+	// WorkerCount is the number of workers processing alerts of any priority.
+	WorkerCount int
+	// HighPriorityWorkerCount is the number of workers dedicated to high-priority alerts.
+	HighPriorityWorkerCount int
+	// QueueDepth is the capacity of the queue; submitting to a full queue fails.
+	QueueDepth int
+	// DrainTimeout bounds how long Shutdown waits for queued alerts to be processed.
+	DrainTimeout time.Duration
+}
+
+// DefaultWorkerPoolConfig returns the default worker pool configuration.
+func DefaultWorkerPoolConfig() WorkerPoolConfig {
+	return WorkerPoolConfig{
+		WorkerCount:             10,
+		HighPriorityWorkerCount: 2,
+		QueueDepth:              500,
+		DrainTimeout:            30 * time.Second,
+	}
+}
+
+// withDefaults fills zero values from DefaultWorkerPoolConfig.
+func (c WorkerPoolConfig) withDefaults() WorkerPoolConfig {
+	defaults := DefaultWorkerPoolConfig()
+	if c.WorkerCount <= 0 {
+		c.WorkerCount = defaults.WorkerCount
+	}
+	if c.HighPriorityWorkerCount <= 0 {
+		c.HighPriorityWorkerCount = defaults.HighPriorityWorkerCount
+	}
+	if c.QueueDepth <= 0 {
+		c.QueueDepth = defaults.QueueDepth
+	}
+	if c.DrainTimeout <= 0 {
+		c.DrainTimeout = defaults.DrainTimeout
+	}
+	return c
+}
+
+// WorkerPool processes ingested alerts asynchronously from a bounded priority
+// Queue. Every worker takes the highest-priority alert waiting; high-priority
+// alerts also have dedicated workers, so a backlog of slow normal alerts never
+// delays them.
+type WorkerPool struct {
+	config  WorkerPoolConfig
+	process ProcessFunc
+	queue   *Queue
+	logger  zerolog.Logger
+
+	// mu guards closed and depth, and serializes the queue capacity check
+	mu     sync.Mutex
+	closed bool
+	depth  map[WorkerPriority]int
+
+	// ctx is passed to process and cancelled when draining times out
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWorkerPool creates a worker pool passing alerts to process. The resolver
+// determines each alert's customer tier; with a nil resolver only critical
+// alerts are high priority. Call Start to begin processing.
+func NewWorkerPool(resolver customer.Resolver, process ProcessFunc, config WorkerPoolConfig, logger zerolog.Logger) *WorkerPool {
+	config = config.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WorkerPool{
+		config:  config,
+		process: process,
+		queue:   NewQueue(resolver, logger),
+		logger:  logger.With().Str("component", "ingestion_worker_pool").Logger(),
+		depth:   make(map[WorkerPriority]int),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Metrics returns the metrics recorder for this worker pool and its queue.
+func (p *WorkerPool) Metrics() *Metrics {
+	return p.queue.Metrics()
+}
+
+// Start launches the workers.
+func (p *WorkerPool) Start() {
+	for i := 0; i < p.config.HighPriorityWorkerCount; i++ {
+		p.wg.Add(1)
+		go p.worker(highPriorityThreshold)
+	}
+	for i := 0; i < p.config.WorkerCount; i++ {
+		p.wg.Add(1)
+		go p.worker(LowestPriority)
+	}
+
+	p.logger.Info().
+		Int("workers", p.config.WorkerCount).
+		Int("high_priority_workers", p.config.HighPriorityWorkerCount).
+		Int("queue_depth", p.config.QueueDepth).
+		Msg("ingestion worker pool started")
+}
+
+// Priority returns the queue priority of an alert: CriticalPriority for
+// critical alerts, otherwise the tier level of its customer.
+func (p *WorkerPool) Priority(ctx context.Context, alert *alertingv1.Alert) int {
+	if alert.GetSeverity() == alertingv1.Severity_SEVERITY_CRITICAL {
+		return CriticalPriority
+	}
+	return p.queue.Priority(ctx, alert)
+}
+
+// Submit queues an alert for processing without blocking. It returns
+// ErrWorkerQueueFull when QueueDepth alerts are waiting and
+// ErrWorkerPoolClosed once Shutdown has been called.
+func (p *WorkerPool) Submit(ctx context.Context, alert *alertingv1.Alert) error {
+	priority := p.Priority(ctx, alert)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrWorkerPoolClosed
+	}
+	if p.queue.Len() >= p.config.QueueDepth {
+		return fmt.Errorf("%w: queue has %d alerts", ErrWorkerQueueFull, p.config.QueueDepth)
+	}
+	if err := p.queue.EnqueueWithPriority(ctx, alert, priority); err != nil {
+		return err
+	}
+
+	class := workerPriority(priority)
+	p.depth[class]++
+	p.queue.Metrics().SetWorkerQueueDepth(class, p.depth[class])
+	return nil
+}
+
+// Shutdown stops accepting alerts and waits for the queued alerts to be
+// processed, for at most DrainTimeout or until ctx is done. Alerts still being
+// processed when draining times out see their context cancelled.
+func (p *WorkerPool) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		p.queue.Close()
+	}
+	p.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(drained)
+	}()
+
+	timer := time.NewTimer(p.config.DrainTimeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-drained:
+		p.logger.Info().Msg("ingestion worker pool drained")
+	case <-timer.C:
+		err = fmt.Errorf("drain worker pool: timed out after %s", p.config.DrainTimeout)
+	case <-ctx.Done():
+		err = fmt.Errorf("drain worker pool: %w", ctx.Err())
+	}
+
+	p.cancel()
+	if err != nil {
+		p.logger.Warn().
+			Err(err).
+			Int("remaining", p.queue.Len()).
+			Msg("ingestion worker pool shut down before draining")
+	}
+	return err
+}
+
+// worker processes queued alerts with a priority of at most maxPriority, in
+// priority order, until the queue is closed and drained of them or draining
+// times out.
+func (p *WorkerPool) worker(maxPriority int) {
+	defer p.wg.Done()
+	for {
+		item, err := p.queue.DequeueAtMost(p.ctx, maxPriority)
+		if err != nil {
+			return
+		}
+		p.handle(item)
+	}
+}
+
+// handle processes a dequeued alert. Processing errors are logged and do not
+// stop the worker.
+func (p *WorkerPool) handle(item *Item) {
+	class := workerPriority(item.Priority)
+	p.mu.Lock()
+	p.depth[class]--
+	depth := p.depth[class]
+	p.mu.Unlock()
+	p.queue.Metrics().SetWorkerQueueDepth(class, depth)
+
+	start := time.Now()
+	err := p.process(item.context(p.ctx), item.Alert)
+	p.queue.Metrics().ObserveProcessingTime(class, time.Since(start))
+
+	if err != nil {
+		p.logger.Error().
+			Err(err).
+			Str("alert_id", item.Alert.GetId()).
+			Int("priority", item.Priority).
+			Msg("failed to process alert")
+	}
+}
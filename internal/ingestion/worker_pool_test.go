@@ -0,0 +1,311 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// recorder is a ProcessFunc that records processed alert IDs. Processing an
+// alert blocks until it is released, individually or with releaseAll.
+type recorder struct {
+	mu        sync.Mutex
+	processed []string
+	gates     map[string]chan struct{}
+	started   chan string
+	all       chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		gates:   make(map[string]chan struct{}),
+		started: make(chan string, 100),
+		all:     make(chan struct{}),
+	}
+}
+
+func (r *recorder) gate(id string) chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	gate, ok := r.gates[id]
+	if !ok {
+		gate = make(chan struct{})
+		r.gates[id] = gate
+	}
+	return gate
+}
+
+func (r *recorder) release(id string) {
+	close(r.gate(id))
+}
+
+func (r *recorder) releaseAll() {
+	close(r.all)
+}
+
+func (r *recorder) process(ctx context.Context, alert *alertingv1.Alert) error {
+	r.started <- alert.Id
+	select {
+	case <-r.gate(alert.Id):
+	case <-r.all:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	r.mu.Lock()
+	r.processed = append(r.processed, alert.Id)
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *recorder) waitStarted(t *testing.T, want string) {
+	t.Helper()
+	select {
+	case id := <-r.started:
+		if id != want {
+			t.Fatalf("expected %s to be processed, got %s", want, id)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for %s to be processed", want)
+	}
+}
+
+func (r *recorder) processedIDs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.processed...)
+}
+
+func TestWorkerPool_Priority(t *testing.T) {
+	pool := NewWorkerPool(newTestResolver(t), nil, WorkerPoolConfig{}, zerolog.Nop())
+	ctx := context.Background()
+
+	critical := customerAlert("critical", "smallco")
+	critical.Severity = alertingv1.Severity_SEVERITY_CRITICAL
+
+	tests := []struct {
+		alert *alertingv1.Alert
+		want  int
+		class WorkerPriority
+	}{
+		{critical, CriticalPriority, PriorityHigh},
+		{customerAlert("platinum", "acme"), 1, PriorityHigh},
+		{customerAlert("bronze", "smallco"), 4, PriorityNormal},
+		{customerAlert("unknown", "nobody"), LowestPriority, PriorityNormal},
+	}
+	for _, tt := range tests {
+		got := pool.Priority(ctx, tt.alert)
+		if got != tt.want {
+			t.Errorf("Priority(%s) = %d, want %d", tt.alert.Id, got, tt.want)
+		}
+		if class := workerPriority(got); class != tt.class {
+			t.Errorf("workerPriority(%s) = %s, want %s", tt.alert.Id, class, tt.class)
+		}
+	}
+}
+
+func TestWorkerPool_QueueDepthEnforced(t *testing.T) {
+	rec := newRecorder()
+	pool := NewWorkerPool(newTestResolver(t), rec.process, WorkerPoolConfig{
+		WorkerCount:             1,
+		HighPriorityWorkerCount: 1,
+		QueueDepth:              2,
+	}, zerolog.Nop())
+	pool.Start()
+	ctx := context.Background()
+
+	// Occupy the only normal worker so later alerts stay queued
+	if err := pool.Submit(ctx, customerAlert("busy", "smallco")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "busy")
+
+	for i := 0; i < 2; i++ {
+		if err := pool.Submit(ctx, customerAlert(fmt.Sprintf("queued-%d", i), "smallco")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := pool.Metrics().WorkerQueueDepth(PriorityNormal); got != 2 {
+		t.Errorf("expected normal queue depth 2, got %d", got)
+	}
+
+	err := pool.Submit(ctx, customerAlert("overflow", "smallco"))
+	if !errors.Is(err, ErrWorkerQueueFull) {
+		t.Fatalf("expected ErrWorkerQueueFull, got %v", err)
+	}
+
+	// High-priority alerts share the queue capacity
+	err = pool.Submit(ctx, customerAlert("platinum", "acme"))
+	if !errors.Is(err, ErrWorkerQueueFull) {
+		t.Fatalf("expected ErrWorkerQueueFull, got %v", err)
+	}
+
+	rec.releaseAll()
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWorkerPool_HighPriorityNotBlockedByNormal(t *testing.T) {
+	rec := newRecorder()
+	pool := NewWorkerPool(newTestResolver(t), rec.process, WorkerPoolConfig{
+		WorkerCount:             1,
+		HighPriorityWorkerCount: 1,
+	}, zerolog.Nop())
+	pool.Start()
+	ctx := context.Background()
+
+	if err := pool.Submit(ctx, customerAlert("normal", "smallco")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "normal")
+
+	// The normal worker is busy; the dedicated worker takes the critical alert
+	critical := customerAlert("critical", "smallco")
+	critical.Severity = alertingv1.Severity_SEVERITY_CRITICAL
+	if err := pool.Submit(ctx, critical); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "critical")
+
+	rec.releaseAll()
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(pool.Metrics().GetProcessingTimes(PriorityHigh)); got != 1 {
+		t.Errorf("expected 1 high-priority processing time, got %d", got)
+	}
+	if got := len(pool.Metrics().GetProcessingTimes(PriorityNormal)); got != 1 {
+		t.Errorf("expected 1 normal processing time, got %d", got)
+	}
+}
+
+func TestWorkerPool_NormalWorkerPrefersHighPriority(t *testing.T) {
+	rec := newRecorder()
+	pool := NewWorkerPool(newTestResolver(t), rec.process, WorkerPoolConfig{
+		WorkerCount:             1,
+		HighPriorityWorkerCount: 1,
+	}, zerolog.Nop())
+	pool.Start()
+	ctx := context.Background()
+
+	// Occupy both workers
+	if err := pool.Submit(ctx, customerAlert("normal-busy", "smallco")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "normal-busy")
+	if err := pool.Submit(ctx, customerAlert("high-busy", "acme")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "high-busy")
+
+	// Queue a normal alert before a high-priority one
+	if err := pool.Submit(ctx, customerAlert("normal-queued", "smallco")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pool.Submit(ctx, customerAlert("high-queued", "acme")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Release only the normal worker, which must take the high-priority alert
+	rec.release("normal-busy")
+	rec.waitStarted(t, "high-queued")
+
+	rec.releaseAll()
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWorkerPool_ShutdownDrainsQueue(t *testing.T) {
+	rec := newRecorder()
+	rec.releaseAll()
+	pool := NewWorkerPool(nil, rec.process, WorkerPoolConfig{WorkerCount: 2, HighPriorityWorkerCount: 1}, zerolog.Nop())
+	ctx := context.Background()
+
+	// Queue alerts before starting so all of them are waiting at shutdown
+	for i := 0; i < 20; i++ {
+		if err := pool.Submit(ctx, customerAlert(fmt.Sprintf("alert-%d", i), "")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	pool.Start()
+
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(rec.processedIDs()); got != 20 {
+		t.Errorf("expected 20 processed alerts, got %d", got)
+	}
+
+	err := pool.Submit(ctx, customerAlert("late", ""))
+	if !errors.Is(err, ErrWorkerPoolClosed) {
+		t.Errorf("expected ErrWorkerPoolClosed, got %v", err)
+	}
+}
+
+func TestWorkerPool_ShutdownTimeout(t *testing.T) {
+	rec := newRecorder()
+	pool := NewWorkerPool(nil, rec.process, WorkerPoolConfig{
+		WorkerCount:             1,
+		HighPriorityWorkerCount: 1,
+		DrainTimeout:            50 * time.Millisecond,
+	}, zerolog.Nop())
+	pool.Start()
+	ctx := context.Background()
+
+	if err := pool.Submit(ctx, customerAlert("stuck", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec.waitStarted(t, "stuck")
+
+	if err := pool.Shutdown(ctx); err == nil {
+		t.Fatal("expected drain timeout error")
+	}
+}
+
+func TestWorkerPool_ProcessesInSubmittedTenant(t *testing.T) {
+	const tenantID = "6f1c2a8e-3b4d-4e5f-8a9b-0c1d2e3f4a5b"
+	tenants := make(chan string, 2)
+	process := func(ctx context.Context, alert *alertingv1.Alert) error {
+		tenant, err := auth.TenantFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		tenants <- alert.Id + "=" + tenant
+		return nil
+	}
+	pool := NewWorkerPool(nil, process, WorkerPoolConfig{WorkerCount: 1}, zerolog.Nop())
+
+	if err := pool.Submit(auth.WithTenant(context.Background(), tenantID), customerAlert("tenant", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pool.Submit(context.Background(), customerAlert("default", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pool.Submit(auth.WithTenant(context.Background(), "not-a-uuid"), customerAlert("invalid", "")); !errors.Is(err, auth.ErrInvalidTenant) {
+		t.Errorf("expected ErrInvalidTenant, got %v", err)
+	}
+	pool.Start()
+	if err := pool.Shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(tenants)
+
+	got := make(map[string]bool)
+	for tenant := range tenants {
+		got[tenant] = true
+	}
+	for _, want := range []string{"tenant=" + tenantID, "default=" + auth.DefaultTenantID} {
+		if !got[want] {
+			t.Errorf("expected %s to be processed, got %v", want, got)
+		}
+	}
+}
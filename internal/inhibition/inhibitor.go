@@ -0,0 +1,154 @@
+package inhibition
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// AnnotationSuppressionReason is the alert annotation recording why an alert was suppressed.
+const AnnotationSuppressionReason = "suppression_reason"
+
+// inhibitedByPrefix prefixes the source alert ID in the suppression reason.
+const inhibitedByPrefix = "inhibited_by:"
+
+// Result describes the rule and source alert that inhibit an alert.
+type Result struct {
+	Rule   *InhibitionRule
+	Source *alertingv1.Alert
+}
+
+// Reason returns the suppression reason recorded on the inhibited alert.
+func (r *Result) Reason() string {
+	return inhibitedByPrefix + r.Source.Id
+}
+
+// Inhibitor checks incoming alerts against inhibition rules and triggered alerts.
+type Inhibitor struct {
+	rules   Store
+	alerts  store.AlertStore
+	metrics *Metrics
+	logger  zerolog.Logger
+}
+
+// NewInhibitor creates a new Inhibitor.
+func NewInhibitor(rules Store, alerts store.AlertStore, logger zerolog.Logger, metrics *Metrics) *Inhibitor {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &Inhibitor{
+		rules:   rules,
+		alerts:  alerts,
+		metrics: metrics,
+		logger:  logger.With().Str("component", "inhibitor").Logger(),
+	}
+}
+
+// Metrics returns the metrics recorder for this inhibitor.
+func (i *Inhibitor) Metrics() *Metrics {
+	return i.metrics
+}
+
+// Check returns the first rule and triggered source alert that inhibit the alert,
+// or nil if the alert is not inhibited.
+func (i *Inhibitor) Check(ctx context.Context, alert *alertingv1.Alert) (*Result, error) {
+	if alert == nil {
+		return nil, nil
+	}
+
+	rules, err := i.rules.ListActive(ctx, alert.ServiceId)
+	if err != nil {
+		return nil, fmt.Errorf("list inhibition rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if !matchesAll(alert.Labels, rule.TargetMatchers) {
+			continue
+		}
+
+		triggered, err := i.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+			Statuses:       []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+			ServiceId:      rule.ServiceID,
+			LabelSelectors: rule.SourceMatchers,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list triggered alerts: %w", err)
+		}
+
+		for _, source := range triggered.Alerts {
+			if rule.Inhibits(source, alert) {
+				return &Result{Rule: rule, Source: source}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Apply suppresses the alert if it is inhibited, recording the source alert in the
+// suppression_reason annotation. Returns the inhibition result, or nil if not inhibited.
+func (i *Inhibitor) Apply(ctx context.Context, alert *alertingv1.Alert) (*Result, error) {
+	if alert == nil || alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		return nil, nil
+	}
+
+	result, err := i.Check(ctx, alert)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	alert.Status = alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED
+	if alert.Annotations == nil {
+		alert.Annotations = make(map[string]string)
+	}
+	alert.Annotations[AnnotationSuppressionReason] = result.Reason()
+	i.metrics.RecordInhibited()
+
+	i.logger.Info().
+		Str("fingerprint", alert.Fingerprint).
+		Str("ruleId", result.Rule.ID).
+		Str("sourceAlertId", result.Source.Id).
+		Msg("alert inhibited")
+
+	return result, nil
+}
+
+// Inhibits reports whether a triggered source alert inhibits the target alert under this rule.
+func (r *InhibitionRule) Inhibits(source, target *alertingv1.Alert) bool {
+	if source == nil || target == nil || source.Fingerprint == target.Fingerprint {
+		return false
+	}
+	if source.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		return false
+	}
+	if r.ServiceID != "" && (source.ServiceId != r.ServiceID || target.ServiceId != r.ServiceID) {
+		return false
+	}
+	if !matchesAll(source.Labels, r.SourceMatchers) || !matchesAll(target.Labels, r.TargetMatchers) {
+		return false
+	}
+
+	// Equal labels must have the same value on both alerts; a label missing from
+	// both counts as equal.
+	for _, name := range r.Equal {
+		if source.Labels[name] != target.Labels[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAll reports whether labels contain every matcher key with the same value.
+func matchesAll(labels, matchers map[string]string) bool {
+	for k, v := range matchers {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
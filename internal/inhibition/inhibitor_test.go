@@ -0,0 +1,156 @@
+package inhibition
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// mockAlertStore implements store.AlertStore, returning a fixed set of alerts from List.
+type mockAlertStore struct {
+	alerts  []*alertingv1.Alert
+	listErr error
+}
+
+func (m *mockAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	return alert, true, nil
+}
+
+func (m *mockAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	return &alertingv1.ListAlertsResponse{Alerts: m.alerts}, nil
+}
+
+func triggeredAlert(id, fingerprint string, labels map[string]string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Id:          id,
+		Fingerprint: fingerprint,
+		ServiceId:   "svc-123",
+		Status:      alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:      labels,
+	}
+}
+
+func newTestInhibitor(t *testing.T, alerts *mockAlertStore, rules ...*InhibitionRule) *Inhibitor {
+	t.Helper()
+	ruleStore := NewInMemoryStore()
+	for _, rule := range rules {
+		_, err := ruleStore.Create(context.Background(), rule)
+		require.NoError(t, err)
+	}
+	return NewInhibitor(ruleStore, alerts, zerolog.Nop(), nil)
+}
+
+func nodeDownRule() *InhibitionRule {
+	return &InhibitionRule{
+		Name:           "node down inhibits warnings",
+		ServiceID:      "svc-123",
+		SourceMatchers: map[string]string{"alertname": "NodeDown"},
+		TargetMatchers: map[string]string{"severity": "warning"},
+		Equal:          []string{"instance"},
+		Enabled:        true,
+	}
+}
+
+func TestInhibitor_Apply(t *testing.T) {
+	source := triggeredAlert("alert-source", "fp-source", map[string]string{"alertname": "NodeDown", "instance": "node-1"})
+
+	t.Run("suppresses target with matching equal labels", func(t *testing.T) {
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{source}}, nodeDownRule())
+		target := triggeredAlert("", "fp-target", map[string]string{"alertname": "HighLatency", "severity": "warning", "instance": "node-1"})
+
+		result, err := inhibitor.Apply(context.Background(), target)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, "alert-source", result.Source.Id)
+		assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED, target.Status)
+		assert.Equal(t, "inhibited_by:alert-source", target.Annotations[AnnotationSuppressionReason])
+		assert.Equal(t, int64(1), inhibitor.Metrics().InhibitedAlertsTotal())
+	})
+
+	t.Run("different equal label value is not inhibited", func(t *testing.T) {
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{source}}, nodeDownRule())
+		target := triggeredAlert("", "fp-target", map[string]string{"severity": "warning", "instance": "node-2"})
+
+		result, err := inhibitor.Apply(context.Background(), target)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, target.Status)
+		assert.Equal(t, int64(0), inhibitor.Metrics().InhibitedAlertsTotal())
+	})
+
+	t.Run("target matchers not satisfied", func(t *testing.T) {
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{source}}, nodeDownRule())
+		target := triggeredAlert("", "fp-target", map[string]string{"severity": "critical", "instance": "node-1"})
+
+		result, err := inhibitor.Apply(context.Background(), target)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("source alert not triggered", func(t *testing.T) {
+		resolved := triggeredAlert("alert-source", "fp-source", map[string]string{"alertname": "NodeDown", "instance": "node-1"})
+		resolved.Status = alertingv1.AlertStatus_ALERT_STATUS_RESOLVED
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{resolved}}, nodeDownRule())
+		target := triggeredAlert("", "fp-target", map[string]string{"severity": "warning", "instance": "node-1"})
+
+		result, err := inhibitor.Apply(context.Background(), target)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("disabled rule is ignored", func(t *testing.T) {
+		rule := nodeDownRule()
+		rule.Enabled = false
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{source}}, rule)
+		target := triggeredAlert("", "fp-target", map[string]string{"severity": "warning", "instance": "node-1"})
+
+		result, err := inhibitor.Apply(context.Background(), target)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("alert does not inhibit itself", func(t *testing.T) {
+		rule := nodeDownRule()
+		rule.TargetMatchers = map[string]string{"alertname": "NodeDown"}
+		inhibitor := newTestInhibitor(t, &mockAlertStore{alerts: []*alertingv1.Alert{source}}, rule)
+		repeat := triggeredAlert("", "fp-source", map[string]string{"alertname": "NodeDown", "instance": "node-1"})
+
+		result, err := inhibitor.Apply(context.Background(), repeat)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("alert store error", func(t *testing.T) {
+		inhibitor := newTestInhibitor(t, &mockAlertStore{listErr: errors.New("db down")}, nodeDownRule())
+		target := triggeredAlert("", "fp-target", map[string]string{"severity": "warning", "instance": "node-1"})
+
+		_, err := inhibitor.Apply(context.Background(), target)
+		assert.Error(t, err)
+		assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, target.Status)
+	})
+}
@@ -0,0 +1,109 @@
+package inhibition
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryStore is an in-memory implementation of Store for testing.
+type InMemoryStore struct {
+	mu    sync.RWMutex
+	rules map[string]*InhibitionRule
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		rules: make(map[string]*InhibitionRule),
+	}
+}
+
+// Create creates a new inhibition rule in memory.
+func (s *InMemoryStore) Create(ctx context.Context, rule *InhibitionRule) (*InhibitionRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rule.ID == "" {
+		rule.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	s.rules[rule.ID] = rule
+	return rule, nil
+}
+
+// Get retrieves an inhibition rule by ID.
+func (s *InMemoryStore) Get(ctx context.Context, id string) (*InhibitionRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rule, ok := s.rules[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return rule, nil
+}
+
+// List retrieves all inhibition rules ordered by creation time.
+func (s *InMemoryStore) List(ctx context.Context) ([]*InhibitionRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rules := make([]*InhibitionRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+	sortRules(rules)
+	return rules, nil
+}
+
+// Delete deletes an inhibition rule by ID.
+func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rules[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.rules, id)
+	return nil
+}
+
+// ListActive retrieves enabled rules for a service, including rules without a service.
+func (s *InMemoryStore) ListActive(ctx context.Context, serviceID string) ([]*InhibitionRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rules []*InhibitionRule
+	for _, rule := range s.rules {
+		if !rule.Enabled {
+			continue
+		}
+		if rule.ServiceID != "" && rule.ServiceID != serviceID {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	sortRules(rules)
+	return rules, nil
+}
+
+func sortRules(rules []*InhibitionRule) {
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+	})
+}
+
+// Ensure InMemoryStore implements Store
+var _ Store = (*InMemoryStore)(nil)
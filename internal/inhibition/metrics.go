@@ -0,0 +1,40 @@
+package inhibition
+
+import (
+	"sync"
+)
+
+// Metrics tracks inhibition metrics.
+// Exposed as the inhibited_alerts_total counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// inhibitedAlerts counts incoming alerts suppressed by an inhibition rule.
+	inhibitedAlerts int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordInhibited increments the inhibited alerts counter.
+func (m *Metrics) RecordInhibited() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inhibitedAlerts++
+}
+
+// InhibitedAlertsTotal returns the number of inhibited alerts.
+func (m *Metrics) InhibitedAlertsTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.inhibitedAlerts
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inhibitedAlerts = 0
+}
@@ -0,0 +1,228 @@
+// Package inhibition provides alert inhibition rules, which suppress alerts while a
+// related parent alert is firing.
+package inhibition
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when an inhibition rule is not found.
+	ErrNotFound = errors.New("inhibition rule not found")
+	// ErrInvalidRule is returned when an inhibition rule is invalid.
+	ErrInvalidRule = errors.New("invalid inhibition rule")
+)
+
+// InhibitionRule suppresses alerts matching TargetMatchers while an alert matching
+// SourceMatchers is triggered and both share the values of the Equal labels.
+type InhibitionRule struct {
+	ID             string            `json:"id"`
+	ServiceID      string            `json:"serviceId,omitempty"`
+	Name           string            `json:"name"`
+	SourceMatchers map[string]string `json:"sourceMatchers"`
+	TargetMatchers map[string]string `json:"targetMatchers"`
+	Equal          []string          `json:"equal"`
+	Enabled        bool              `json:"enabled"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+}
+
+// Store defines the interface for inhibition rule persistence.
+type Store interface {
+	// Create creates a new inhibition rule.
+	Create(ctx context.Context, rule *InhibitionRule) (*InhibitionRule, error)
+
+	// Get retrieves an inhibition rule by ID.
+	Get(ctx context.Context, id string) (*InhibitionRule, error)
+
+	// List retrieves all inhibition rules.
+	List(ctx context.Context) ([]*InhibitionRule, error)
+
+	// Delete deletes an inhibition rule by ID.
+	Delete(ctx context.Context, id string) error
+
+	// ListActive retrieves enabled rules for a service, including rules without a service.
+	ListActive(ctx context.Context, serviceID string) ([]*InhibitionRule, error)
+}
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// validateRule checks that a rule can be stored.
+func validateRule(rule *InhibitionRule) error {
+	if rule == nil {
+		return ErrInvalidRule
+	}
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	if len(rule.SourceMatchers) == 0 || len(rule.TargetMatchers) == 0 {
+		return fmt.Errorf("%w: source and target matchers are required", ErrInvalidRule)
+	}
+	return nil
+}
+
+// Create creates a new inhibition rule in the database.
+func (s *PostgresStore) Create(ctx context.Context, rule *InhibitionRule) (*InhibitionRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if rule.ID == "" {
+		rule.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	sourceJSON, err := json.Marshal(rule.SourceMatchers)
+	if err != nil {
+		return nil, fmt.Errorf("marshal source matchers: %w", err)
+	}
+	targetJSON, err := json.Marshal(rule.TargetMatchers)
+	if err != nil {
+		return nil, fmt.Errorf("marshal target matchers: %w", err)
+	}
+	equal := rule.Equal
+	if equal == nil {
+		equal = []string{}
+	}
+	equalJSON, err := json.Marshal(equal)
+	if err != nil {
+		return nil, fmt.Errorf("marshal equal labels: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO alert_inhibitions (
+			id, service_id, name, source_matchers, target_matchers, equal, enabled, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, rule.ID, nullableString(rule.ServiceID), rule.Name, sourceJSON, targetJSON, equalJSON,
+		rule.Enabled, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert inhibition rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// Get retrieves an inhibition rule by ID.
+func (s *PostgresStore) Get(ctx context.Context, id string) (*InhibitionRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+` WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query inhibition rule: %w", err)
+	}
+	defer rows.Close()
+
+	rules, err := scanRules(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, ErrNotFound
+	}
+	return rules[0], nil
+}
+
+// List retrieves all inhibition rules.
+func (s *PostgresStore) List(ctx context.Context) ([]*InhibitionRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+` ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("list inhibition rules: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+// Delete deletes an inhibition rule by ID.
+func (s *PostgresStore) Delete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM alert_inhibitions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete inhibition rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListActive retrieves enabled rules for a service, including rules without a service.
+func (s *PostgresStore) ListActive(ctx context.Context, serviceID string) ([]*InhibitionRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectRuleColumns+`
+		WHERE enabled = true AND (service_id IS NULL OR service_id = $1)
+		ORDER BY created_at`, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("list active inhibition rules: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+const selectRuleColumns = `
+	SELECT id, service_id, name, source_matchers, target_matchers, equal, enabled, created_at, updated_at
+	FROM alert_inhibitions`
+
+// scanRules scans inhibition rules from query rows.
+func scanRules(rows *sql.Rows) ([]*InhibitionRule, error) {
+	rules := []*InhibitionRule{}
+	for rows.Next() {
+		rule := &InhibitionRule{}
+		var serviceID sql.NullString
+		var sourceJSON, targetJSON, equalJSON []byte
+
+		if err := rows.Scan(
+			&rule.ID, &serviceID, &rule.Name, &sourceJSON, &targetJSON, &equalJSON,
+			&rule.Enabled, &rule.CreatedAt, &rule.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan inhibition rule: %w", err)
+		}
+
+		rule.ServiceID = serviceID.String
+		if err := json.Unmarshal(sourceJSON, &rule.SourceMatchers); err != nil {
+			return nil, fmt.Errorf("unmarshal source matchers: %w", err)
+		}
+		if err := json.Unmarshal(targetJSON, &rule.TargetMatchers); err != nil {
+			return nil, fmt.Errorf("unmarshal target matchers: %w", err)
+		}
+		if equalJSON != nil {
+			if err := json.Unmarshal(equalJSON, &rule.Equal); err != nil {
+				return nil, fmt.Errorf("unmarshal equal labels: %w", err)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate inhibition rules: %w", err)
+	}
+	return rules, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
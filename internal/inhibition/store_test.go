@@ -0,0 +1,66 @@
+package inhibition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_ListActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "service_id", "name", "source_matchers", "target_matchers", "equal", "enabled", "created_at", "updated_at",
+	}).AddRow(
+		"rule-1", "svc-123", "node down", []byte(`{"alertname":"NodeDown"}`), []byte(`{"severity":"warning"}`),
+		[]byte(`["instance"]`), true, now, now,
+	).AddRow(
+		"rule-2", nil, "global", []byte(`{"alertname":"ClusterDown"}`), []byte(`{"cluster":"eu-1"}`),
+		[]byte(`[]`), true, now, now,
+	)
+
+	mock.ExpectQuery(`SELECT (.+) FROM alert_inhibitions\s+WHERE enabled = true AND \(service_id IS NULL OR service_id = \$1\)`).
+		WithArgs("svc-123").
+		WillReturnRows(rows)
+
+	rules, err := store.ListActive(context.Background(), "svc-123")
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "NodeDown", rules[0].SourceMatchers["alertname"])
+	assert.Equal(t, []string{"instance"}, rules[0].Equal)
+	assert.Equal(t, "", rules[1].ServiceID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO alert_inhibitions`).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rule, err := store.Create(ctx, nodeDownRule())
+		require.NoError(t, err)
+		assert.NotEmpty(t, rule.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing matchers", func(t *testing.T) {
+		_, err := store.Create(ctx, &InhibitionRule{Name: "empty"})
+		assert.ErrorIs(t, err, ErrInvalidRule)
+	})
+}
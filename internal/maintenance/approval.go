@@ -0,0 +1,81 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// AuditActionApproved is the maintenance audit log action recorded when a
+// window is approved.
+const AuditActionApproved = "approved"
+
+// ApproveMaintenanceWindow records the approval of a window pending approval
+// and schedules it; TransitionStatuses starts it once its start time passes.
+// The window is updated and the audit log entry added in one transaction.
+// Windows that are not pending approval return ErrInvalidStatus.
+func (s *PostgresStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	if approvedBy == "" {
+		return nil, fmt.Errorf("%w: approved_by is required", ErrInvalidWindow)
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var status string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status FROM maintenance_windows WHERE id = $1 AND tenant_id = $2 FOR UPDATE
+	`, windowID, tenantID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query maintenance window: %w", err)
+	}
+
+	if parseStatus(status) != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL {
+		return nil, fmt.Errorf("%w: only windows pending approval can be approved, window is %s", ErrInvalidStatus, status)
+	}
+
+	now := time.Now()
+	_, err = tx.ExecContext(ctx, `
+		UPDATE maintenance_windows SET status = 'scheduled', approved_by = $1, approved_at = $2, updated_at = $2 WHERE id = $3 AND tenant_id = $4
+	`, approvedBy, now, windowID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("approve maintenance window: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO maintenance_window_audit_log (window_id, action, actor, created_at) VALUES ($1, $2, $3, $4)
+	`, windowID, AuditActionApproved, approvedBy, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert maintenance audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return s.Get(ctx, windowID)
+}
+
+// CheckCanStart returns ErrApprovalRequired if the window requires approval
+// and has not been approved yet.
+func CheckCanStart(window *routingv1.MaintenanceWindow) error {
+	if window.RequiresApproval && window.ApprovedBy == "" {
+		return ErrApprovalRequired
+	}
+	return nil
+}
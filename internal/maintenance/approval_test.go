@@ -0,0 +1,86 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestPostgresStore_ApproveMaintenanceWindow(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM maintenance_windows WHERE id = \\$1 AND tenant_id = \\$2 FOR UPDATE").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("pending_approval"))
+	mock.ExpectExec("UPDATE maintenance_windows SET status = 'scheduled', approved_by = \\$1").
+		WithArgs("change-board", sqlmock.AnyArg(), "window-1", auth.DefaultTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO maintenance_window_audit_log").
+		WithArgs("window-1", AuditActionApproved, "change-board", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("FROM maintenance_windows WHERE id").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(windowColumns).AddRow(
+			"window-1", "Core switch upgrade", nil, now, now.Add(time.Hour), "scheduled", "suppress",
+			[]byte(`{}`), []byte(`{}`), nil,
+			nil, nil, nil, "change-board", now, true, now, now))
+
+	window, err := store.ApproveMaintenanceWindow(context.Background(), "window-1", "change-board")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED {
+		t.Errorf("expected status SCHEDULED, got %v", window.Status)
+	}
+	if !window.RequiresApproval || window.ApprovedBy != "change-board" || window.ApprovedAt == nil {
+		t.Errorf("expected approval to be read back, got %v", window)
+	}
+	if err := CheckCanStart(window); err != nil {
+		t.Errorf("expected approved window to be startable, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_ApproveMaintenanceWindow_RequiresPendingApproval(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("scheduled"))
+	mock.ExpectRollback()
+
+	_, err := store.ApproveMaintenanceWindow(context.Background(), "window-1", "change-board")
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("expected ErrInvalidStatus, got %v", err)
+	}
+
+	if _, err := store.ApproveMaintenanceWindow(context.Background(), "window-1", ""); !errors.Is(err, ErrInvalidWindow) {
+		t.Errorf("expected ErrInvalidWindow without approver, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestCheckCanStart(t *testing.T) {
+	if err := CheckCanStart(&routingv1.MaintenanceWindow{}); err != nil {
+		t.Errorf("expected window without approval requirement to start, got %v", err)
+	}
+	if err := CheckCanStart(&routingv1.MaintenanceWindow{RequiresApproval: true}); !errors.Is(err, ErrApprovalRequired) {
+		t.Errorf("expected ErrApprovalRequired, got %v", err)
+	}
+}
@@ -85,8 +85,9 @@ func (c *DefaultChecker) Check(ctx context.Context, alert *routingv1.Alert) (*Ma
 	}
 
 	// Check each window
-	for _, window := range windows {
-		result := c.matcher.Match(alert, window)
+	for _, compiled := range c.compile(windows) {
+		window := compiled.Window
+		result := c.matcher.MatchCompiled(alert, compiled)
 		if result.Matched {
 			match := &Match{
 				Window:    window,
@@ -129,8 +130,9 @@ func (c *DefaultChecker) CheckAll(ctx context.Context, alert *routingv1.Alert) (
 
 	var matches []*Match
 
-	for _, window := range windows {
-		result := c.matcher.Match(alert, window)
+	for _, compiled := range c.compile(windows) {
+		window := compiled.Window
+		result := c.matcher.MatchCompiled(alert, compiled)
 		if result.Matched {
 			matches = append(matches, &Match{
 				Window:    window,
@@ -144,6 +146,20 @@ func (c *DefaultChecker) CheckAll(ctx context.Context, alert *routingv1.Alert) (
 	return matches, nil
 }
 
+// compile compiles the label patterns of windows loaded from the store.
+// Windows with invalid patterns are kept, but their patterns never match.
+func (c *DefaultChecker) compile(windows []*routingv1.MaintenanceWindow) []*CompiledWindow {
+	compiled := make([]*CompiledWindow, 0, len(windows))
+	for _, window := range windows {
+		cw, err := CompileWindow(window)
+		if err != nil {
+			c.logger.Warn().Err(err).Str("windowId", window.Id).Msg("invalid maintenance window label pattern")
+		}
+		compiled = append(compiled, cw)
+	}
+	return compiled
+}
+
 // ListActive lists currently active maintenance windows.
 func (c *DefaultChecker) ListActive(ctx context.Context) ([]*routingv1.MaintenanceWindow, error) {
 	return c.store.ListActive(ctx, nil, nil)
@@ -158,7 +174,7 @@ func (c *DefaultChecker) ListUpcoming(ctx context.Context, duration time.Duratio
 func (c *DefaultChecker) RefreshStatuses(ctx context.Context) error {
 	c.logger.Debug().Msg("refreshing maintenance window statuses")
 
-	if err := c.store.TransitionStatuses(ctx); err != nil {
+	if _, err := c.store.TransitionStatuses(ctx); err != nil {
 		c.logger.Error().Err(err).Msg("failed to transition maintenance window statuses")
 		return err
 	}
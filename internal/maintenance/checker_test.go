@@ -112,7 +112,7 @@ func (m *mockStore) UpdateStatus(ctx context.Context, id string, status routingv
 	return ErrNotFound
 }
 
-func (m *mockStore) TransitionStatuses(ctx context.Context) error {
+func (m *mockStore) TransitionStatuses(ctx context.Context) ([]StatusTransition, error) {
 	now := time.Now()
 	for _, w := range m.windows {
 		// scheduled -> active
@@ -126,7 +126,15 @@ func (m *mockStore) TransitionStatuses(ctx context.Context) error {
 			w.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
 		}
 	}
-	return nil
+	return nil, nil
+}
+
+func (m *mockStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	return nil, ErrNotFound
+}
+
+func (m *mockStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	return nil, ErrNotFound
 }
 
 // addActiveWindow adds an active window to the mock store.
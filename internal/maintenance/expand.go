@@ -0,0 +1,94 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// ExpandMaintenanceWindow adds sites and services to the scope of an in-progress
+// window and, if extendBy is positive, extends its end time. The window is read
+// and updated in one transaction so concurrent expansions are not lost. Windows
+// that are not in progress return ErrInvalidStatus.
+func (s *PostgresStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	if extendBy < 0 {
+		return nil, fmt.Errorf("%w: extend_by must not be negative", ErrInvalidWindow)
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var status string
+	var endTime time.Time
+	var scopeJSON []byte
+	err = tx.QueryRowContext(ctx, `
+		SELECT status, end_time, scope FROM maintenance_windows WHERE id = $1 AND tenant_id = $2 FOR UPDATE
+	`, windowID, tenantID).Scan(&status, &endTime, &scopeJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query maintenance window: %w", err)
+	}
+
+	if parseStatus(status) != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		return nil, fmt.Errorf("%w: only in-progress windows can be expanded, window is %s", ErrInvalidStatus, status)
+	}
+
+	var scope Scope
+	if scopeJSON != nil {
+		if err := json.Unmarshal(scopeJSON, &scope); err != nil {
+			return nil, fmt.Errorf("unmarshal scope: %w", err)
+		}
+	}
+	scope.Sites = appendMissing(scope.Sites, additionalSites)
+	scope.Services = appendMissing(scope.Services, additionalServices)
+
+	newScopeJSON, err := json.Marshal(scope)
+	if err != nil {
+		return nil, fmt.Errorf("marshal scope: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE maintenance_windows SET scope = $1, end_time = $2, updated_at = $3 WHERE id = $4 AND tenant_id = $5
+	`, newScopeJSON, endTime.Add(extendBy), time.Now(), windowID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("expand maintenance window: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return s.Get(ctx, windowID)
+}
+
+// appendMissing appends the values not already in list, skipping empty values
+// and duplicates.
+func appendMissing(list, values []string) []string {
+	seen := make(map[string]bool, len(list))
+	for _, v := range list {
+		seen[v] = true
+	}
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		list = append(list, v)
+	}
+	return list
+}
@@ -0,0 +1,162 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+)
+
+var windowColumns = []string{
+	"id", "name", "description", "start_time", "end_time", "status", "action", "scope", "labels", "recurrence",
+	"ticket_id", "ticket_url", "created_by", "approved_by", "approved_at", "requires_approval", "created_at", "updated_at",
+}
+
+func newExpandTestStore(t *testing.T) (*PostgresStore, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return NewPostgresStore(db), mock
+}
+
+// scopeArg matches a scope JSON argument with the expected sites and services.
+type scopeArg struct {
+	sites, services []string
+}
+
+func (a scopeArg) Match(v driver.Value) bool {
+	data, ok := v.([]byte)
+	if !ok {
+		return false
+	}
+	var scope Scope
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return false
+	}
+	return slices.Equal(scope.Sites, a.sites) && slices.Equal(scope.Services, a.services)
+}
+
+func TestPostgresStore_ExpandMaintenanceWindow(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	now := time.Now()
+	endTime := now.Add(time.Hour)
+	extendedEnd := endTime.Add(30 * time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, end_time, scope FROM maintenance_windows WHERE id = \\$1 AND tenant_id = \\$2 FOR UPDATE").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "end_time", "scope"}).
+			AddRow("active", endTime, []byte(`{"sites":["site-1"],"labels":{"env":"prod"}}`)))
+	mock.ExpectExec("UPDATE maintenance_windows SET scope").
+		WithArgs(scopeArg{sites: []string{"site-1", "site-2"}, services: []string{"svc-1"}}, extendedEnd, sqlmock.AnyArg(), "window-1", auth.DefaultTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("FROM maintenance_windows WHERE id").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(windowColumns).AddRow(
+			"window-1", "Upgrade", nil, now, extendedEnd, "active", "suppress",
+			[]byte(`{"sites":["site-1","site-2"],"services":["svc-1"]}`), []byte(`{}`), nil,
+			nil, nil, nil, nil, nil, false, now, now))
+
+	window, err := store.ExpandMaintenanceWindow(context.Background(), "window-1",
+		[]string{"site-1", "site-2", ""}, []string{"svc-1"}, 30*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(window.AffectedSites) != 2 {
+		t.Errorf("expected 2 affected sites, got %v", window.AffectedSites)
+	}
+	if !window.EndTime.AsTime().Equal(extendedEnd) {
+		t.Errorf("expected end time %v, got %v", extendedEnd, window.EndTime.AsTime())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_ExpandMaintenanceWindow_ScopeOnly(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	now := time.Now()
+	endTime := now.Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "end_time", "scope"}).
+			AddRow("active", endTime, []byte(`{}`)))
+	mock.ExpectExec("UPDATE maintenance_windows SET scope").
+		WithArgs(scopeArg{services: []string{"svc-1"}}, endTime, sqlmock.AnyArg(), "window-1", auth.DefaultTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("FROM maintenance_windows WHERE id").
+		WithArgs("window-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(windowColumns).AddRow(
+			"window-1", "Upgrade", nil, now, endTime, "active", "suppress",
+			[]byte(`{"services":["svc-1"]}`), []byte(`{}`), nil,
+			nil, nil, nil, nil, nil, false, now, now))
+
+	if _, err := store.ExpandMaintenanceWindow(context.Background(), "window-1", nil, []string{"svc-1"}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_ExpandMaintenanceWindow_RequiresInProgress(t *testing.T) {
+	for _, status := range []string{"scheduled", "completed", "cancelled"} {
+		t.Run(status, func(t *testing.T) {
+			store, mock := newExpandTestStore(t)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("FOR UPDATE").
+				WithArgs("window-1", auth.DefaultTenantID).
+				WillReturnRows(sqlmock.NewRows([]string{"status", "end_time", "scope"}).
+					AddRow(status, time.Now(), []byte(`{}`)))
+			mock.ExpectRollback()
+
+			_, err := store.ExpandMaintenanceWindow(context.Background(), "window-1", []string{"site-1"}, nil, time.Hour)
+			if !errors.Is(err, ErrInvalidStatus) {
+				t.Fatalf("expected ErrInvalidStatus, got %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPostgresStore_ExpandMaintenanceWindow_Errors(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+
+	if _, err := store.ExpandMaintenanceWindow(context.Background(), "window-1", nil, nil, -time.Minute); !errors.Is(err, ErrInvalidWindow) {
+		t.Errorf("expected ErrInvalidWindow for a negative extension, got %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE").
+		WithArgs("missing", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "end_time", "scope"}))
+	mock.ExpectRollback()
+
+	if _, err := store.ExpandMaintenanceWindow(context.Background(), "missing", nil, nil, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
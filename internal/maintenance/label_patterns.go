@@ -0,0 +1,89 @@
+package maintenance
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// labelPattern is a compiled "key=regex" entry of affected_label_patterns.
+type labelPattern struct {
+	key string
+	re  *regexp.Regexp
+}
+
+// CompiledWindow is a maintenance window with its label patterns compiled, so
+// that checking many alerts against it does not recompile them.
+type CompiledWindow struct {
+	Window *routingv1.MaintenanceWindow
+
+	patterns []labelPattern
+	// invalid is set when a pattern does not compile; the pattern scope then
+	// never matches
+	invalid bool
+}
+
+// CompileWindow compiles the label patterns of a window. Regexes are anchored,
+// so "app=frontend-.*" matches app=frontend-web but not app=legacy-frontend-web.
+// On error the returned window is still usable, but its label patterns never match.
+func CompileWindow(window *routingv1.MaintenanceWindow) (*CompiledWindow, error) {
+	compiled := &CompiledWindow{Window: window}
+	for _, entry := range window.AffectedLabelPatterns {
+		key, expr, ok := strings.Cut(entry, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			compiled.invalid = true
+			return compiled, fmt.Errorf("%w: label pattern %q must be key=regex", ErrInvalidWindow, entry)
+		}
+		re, err := regexp.Compile("^(?:" + strings.TrimSpace(expr) + ")$")
+		if err != nil {
+			compiled.invalid = true
+			return compiled, fmt.Errorf("%w: label pattern %q: %v", ErrInvalidWindow, entry, err)
+		}
+		compiled.patterns = append(compiled.patterns, labelPattern{key: key, re: re})
+	}
+	return compiled, nil
+}
+
+// HasLabelPatterns reports whether the window scopes alerts by label patterns.
+func (w *CompiledWindow) HasLabelPatterns() bool {
+	return len(w.Window.AffectedLabelPatterns) > 0
+}
+
+// MatchesLabelPatterns reports whether labels match every label pattern of the
+// window. A window without patterns never matches.
+func (w *CompiledWindow) MatchesLabelPatterns(labels map[string]string) bool {
+	if w.invalid || len(w.patterns) == 0 {
+		return false
+	}
+	for _, pattern := range w.patterns {
+		value, ok := labels[pattern.key]
+		if !ok || !pattern.re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesAlert reports whether the alert falls within the scope of the window,
+// including its label patterns. A recurring window only matches during one of
+// its occurrences.
+func MatchesAlert(window *routingv1.MaintenanceWindow, alert *routingv1.Alert) bool {
+	if window.Recurrence != nil {
+		occurrence, err := currentOccurrence(window, time.Now())
+		if err != nil || occurrence == nil || occurrence.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+			return false
+		}
+	}
+	compiled, _ := CompileWindow(window)
+	return NewMatcher().MatchCompiled(alert, compiled).Matched
+}
+
+// validateLabelPatterns checks that every label pattern of a window compiles.
+func validateLabelPatterns(window *routingv1.MaintenanceWindow) error {
+	_, err := CompileWindow(window)
+	return err
+}
@@ -0,0 +1,132 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestMatchesAlert_LabelPatterns(t *testing.T) {
+	window := &routingv1.MaintenanceWindow{
+		Id:                    "window-1",
+		AffectedLabelPatterns: []string{"app=frontend-.*", "env = prod|staging"},
+	}
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected bool
+	}{
+		{"all patterns match", map[string]string{"app": "frontend-web", "env": "prod"}, true},
+		{"alternation", map[string]string{"app": "frontend-api", "env": "staging"}, true},
+		{"pattern is anchored", map[string]string{"app": "legacy-frontend-web", "env": "prod"}, false},
+		{"one pattern does not match", map[string]string{"app": "frontend-web", "env": "dev"}, false},
+		{"label missing", map[string]string{"app": "frontend-web"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesAlert(window, &routingv1.Alert{Labels: tt.labels}); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchesAlert_LabelPatternsOrSites(t *testing.T) {
+	window := &routingv1.MaintenanceWindow{
+		AffectedSites:         []string{"nyc1"},
+		AffectedLabelPatterns: []string{"host=web-\\d+"},
+	}
+
+	if !MatchesAlert(window, &routingv1.Alert{Labels: map[string]string{"site": "nyc1"}}) {
+		t.Error("expected alert at an affected site to match")
+	}
+	if !MatchesAlert(window, &routingv1.Alert{Labels: map[string]string{"site": "ams1", "host": "web-12"}}) {
+		t.Error("expected alert matching the label patterns to match")
+	}
+	if MatchesAlert(window, &routingv1.Alert{Labels: map[string]string{"site": "ams1", "host": "db-1"}}) {
+		t.Error("expected alert outside the scope not to match")
+	}
+}
+
+func TestCompileWindow_Invalid(t *testing.T) {
+	for _, pattern := range []string{"app=frontend-(", "=frontend", "frontend"} {
+		compiled, err := CompileWindow(&routingv1.MaintenanceWindow{AffectedLabelPatterns: []string{pattern}})
+		if !errors.Is(err, ErrInvalidWindow) {
+			t.Errorf("expected ErrInvalidWindow for %q, got %v", pattern, err)
+		}
+		if compiled.MatchesLabelPatterns(map[string]string{"app": "frontend-web"}) {
+			t.Errorf("expected invalid pattern %q never to match", pattern)
+		}
+	}
+}
+
+func TestBuildScopeJSON_LabelPatterns(t *testing.T) {
+	scope := buildScopeJSON(&routingv1.MaintenanceWindow{AffectedLabelPatterns: []string{"app=frontend-.*"}})
+	if scope.LabelRegex["app"] != "frontend-.*" {
+		t.Errorf("expected label pattern to be stored as labelRegex, got %v", scope.LabelRegex)
+	}
+}
+
+// benchmarkWindows returns n windows scoped by exact labels or label patterns,
+// none of which match benchmarkAlert.
+func benchmarkWindows(n int, patterns bool) []*routingv1.MaintenanceWindow {
+	windows := make([]*routingv1.MaintenanceWindow, n)
+	for i := range windows {
+		windows[i] = &routingv1.MaintenanceWindow{Id: fmt.Sprintf("window-%d", i)}
+		if patterns {
+			windows[i].AffectedLabelPatterns = []string{fmt.Sprintf("app=frontend-%d-.*", i)}
+		} else {
+			windows[i].AffectedLabels = []string{fmt.Sprintf("app=frontend-%d-web", i)}
+		}
+	}
+	return windows
+}
+
+var benchmarkAlert = &routingv1.Alert{Labels: map[string]string{"app": "frontend-web", "host": "web-12"}}
+
+func BenchmarkMatcher_ExactLabels(b *testing.B) {
+	matcher := NewMatcher()
+	windows := benchmarkWindows(100, false)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, window := range windows {
+			matcher.Match(benchmarkAlert, window)
+		}
+	}
+}
+
+func BenchmarkMatcher_LabelPatternsCompiled(b *testing.B) {
+	matcher := NewMatcher()
+	compiled := make([]*CompiledWindow, 0, 100)
+	for _, window := range benchmarkWindows(100, true) {
+		cw, err := CompileWindow(window)
+		if err != nil {
+			b.Fatal(err)
+		}
+		compiled = append(compiled, cw)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, cw := range compiled {
+			matcher.MatchCompiled(benchmarkAlert, cw)
+		}
+	}
+}
+
+func BenchmarkMatcher_LabelPatternsUncompiled(b *testing.B) {
+	matcher := NewMatcher()
+	windows := benchmarkWindows(100, true)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, window := range windows {
+			matcher.Match(benchmarkAlert, window)
+		}
+	}
+}
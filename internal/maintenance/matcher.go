@@ -71,12 +71,23 @@ type MatchResult struct {
 	Details   map[string]string
 }
 
-// Match checks if an alert matches a maintenance window's scope.
+// Match checks if an alert matches a maintenance window's scope. Label
+// patterns are compiled on every call; use MatchCompiled when checking many
+// alerts against the same window.
 func (m *Matcher) Match(alert *routingv1.Alert, window *routingv1.MaintenanceWindow) *MatchResult {
+	compiled, _ := CompileWindow(window)
+	return m.MatchCompiled(alert, compiled)
+}
+
+// MatchCompiled checks if an alert matches the scope of a compiled maintenance window.
+func (m *Matcher) MatchCompiled(alert *routingv1.Alert, compiled *CompiledWindow) *MatchResult {
+	window := compiled.Window
+
 	// If no scope is defined, the window applies globally
 	if len(window.AffectedSites) == 0 &&
 		len(window.AffectedServices) == 0 &&
-		len(window.AffectedLabels) == 0 {
+		len(window.AffectedLabels) == 0 &&
+		!compiled.HasLabelPatterns() {
 		return &MatchResult{
 			Matched:   true,
 			MatchType: MatchTypeGlobal,
@@ -99,6 +110,18 @@ func (m *Matcher) Match(alert *routingv1.Alert, window *routingv1.MaintenanceWin
 		return result
 	}
 
+	// Check label pattern matching
+	if compiled.MatchesLabelPatterns(alert.Labels) {
+		return &MatchResult{
+			Matched:   true,
+			MatchType: MatchTypeLabel,
+			Reason:    "alert labels match maintenance window label patterns",
+			Details: map[string]string{
+				"patternCount": fmt.Sprintf("%d", len(window.AffectedLabelPatterns)),
+			},
+		}
+	}
+
 	return &MatchResult{
 		Matched: false,
 		Reason:  "alert does not match maintenance window scope",
@@ -271,14 +294,19 @@ func (m *Matcher) MatchEquipment(alert *routingv1.Alert, equipmentTypes []string
 
 // getAlertSite extracts the site identifier from an alert.
 func getAlertSite(alert *routingv1.Alert) string {
-	if alert.Labels == nil {
+	return SiteFromLabels(alert.Labels)
+}
+
+// SiteFromLabels extracts the site identifier from alert labels using common site label names.
+func SiteFromLabels(labels map[string]string) string {
+	if labels == nil {
 		return ""
 	}
 
 	// Try common site label names
 	siteLabels := []string{"site", "datacenter", "dc", "location", "pop", "site_id"}
 	for _, label := range siteLabels {
-		if value, ok := alert.Labels[label]; ok && value != "" {
+		if value, ok := labels[label]; ok && value != "" {
 			return value
 		}
 	}
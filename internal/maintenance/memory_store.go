@@ -0,0 +1,413 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// AuditLogEntry is an entry of the maintenance audit log kept by InMemoryStore.
+type AuditLogEntry struct {
+	WindowID  string
+	Action    string
+	Actor     string
+	CreatedAt time.Time
+}
+
+// InMemoryStore is an in-memory implementation of Store for testing and
+// single-instance deployments.
+type InMemoryStore struct {
+	mu      sync.RWMutex
+	windows map[string]*routingv1.MaintenanceWindow
+	// tenants holds the tenant of each window
+	tenants  map[string]string
+	auditLog []AuditLogEntry
+	now      func() time.Time
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		windows: make(map[string]*routingv1.MaintenanceWindow),
+		tenants: make(map[string]string),
+		now:     time.Now,
+	}
+}
+
+// Create creates a new maintenance window in memory.
+func (s *InMemoryStore) Create(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	if window == nil {
+		return nil, ErrInvalidWindow
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := prepareWindow(window, s.now()); err != nil {
+		return nil, err
+	}
+	if _, ok := s.windows[window.Id]; ok {
+		return nil, fmt.Errorf("%w: window %s already exists", ErrInvalidWindow, window.Id)
+	}
+
+	s.windows[window.Id] = cloneWindow(window)
+	s.tenants[window.Id] = tenantID
+	return window, nil
+}
+
+// Get retrieves a maintenance window by ID.
+func (s *InMemoryStore) Get(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	window, err := s.tenantWindow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return cloneWindow(window), nil
+}
+
+// List retrieves maintenance windows with optional filters, ordered by start
+// time descending. Like PostgresStore it pages over stored windows and expands
+// the recurring windows of each page.
+func (s *InMemoryStore) List(ctx context.Context, req *routingv1.ListMaintenanceWindowsRequest) (*routingv1.ListMaintenanceWindowsResponse, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	var windows []*routingv1.MaintenanceWindow
+	for _, window := range s.tenantWindows(tenantID) {
+		if listFilterMatches(window, req) {
+			windows = append(windows, cloneWindow(window))
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].StartTime.AsTime().After(windows[j].StartTime.AsTime())
+	})
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 50
+	}
+	offset := min(decodePageToken(req.PageToken), len(windows))
+
+	resp := &routingv1.ListMaintenanceWindowsResponse{
+		TotalCount: int32(len(windows)),
+	}
+	windows = windows[offset:]
+	if len(windows) > pageSize {
+		windows = windows[:pageSize]
+		resp.NextPageToken = encodePageToken(offset + pageSize)
+	}
+
+	resp.Windows, err = expandListedWindows(windows, req, s.now())
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// listFilterMatches applies the filters of a List request that PostgresStore
+// applies in SQL.
+func listFilterMatches(window *routingv1.MaintenanceWindow, req *routingv1.ListMaintenanceWindowsRequest) bool {
+	recurring := window.Recurrence != nil
+	if req.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED && window.Status != req.Status && !recurring {
+		return false
+	}
+	if req.StartTime != nil && window.EndTime.AsTime().Before(req.StartTime.AsTime()) && !recurring {
+		return false
+	}
+	if req.EndTime != nil && window.StartTime.AsTime().After(req.EndTime.AsTime()) {
+		return false
+	}
+	if req.SiteId != "" && !slices.Contains(window.AffectedSites, req.SiteId) {
+		return false
+	}
+	for key, value := range req.Labels {
+		if v, ok := window.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Update updates an existing maintenance window. Its creator and approval are kept.
+func (s *InMemoryStore) Update(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	if window == nil || window.Id == "" {
+		return nil, ErrInvalidWindow
+	}
+
+	if err := validateScope(window); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.tenantWindow(ctx, window.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := cloneWindow(window)
+	updated.CreatedBy = existing.CreatedBy
+	updated.CreatedAt = existing.CreatedAt
+	updated.ApprovedBy = existing.ApprovedBy
+	updated.ApprovedAt = existing.ApprovedAt
+	s.windows[window.Id] = updated
+
+	return cloneWindow(updated), nil
+}
+
+// Delete deletes a maintenance window by ID.
+func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.tenantWindow(ctx, id); err != nil {
+		return err
+	}
+	delete(s.windows, id)
+	delete(s.tenants, id)
+	return nil
+}
+
+// ListActive retrieves currently active maintenance windows. Windows without
+// sites or services apply to every site or service.
+func (s *InMemoryStore) ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	var windows []*routingv1.MaintenanceWindow
+	for _, window := range s.tenantWindows(tenantID) {
+		// Recurring windows are matched on their occurrence in progress below
+		if window.Recurrence == nil && (window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS ||
+			window.StartTime.AsTime().After(now) || !window.EndTime.AsTime().After(now)) {
+			continue
+		}
+		if !scopeIncludes(window.AffectedSites, siteIDs) || !scopeIncludes(window.AffectedServices, serviceIDs) {
+			continue
+		}
+		windows = append(windows, cloneWindow(window))
+	}
+
+	return activeOccurrences(windows, now)
+}
+
+// scopeIncludes reports whether a window scoped to scope applies to any of ids.
+func scopeIncludes(scope, ids []string) bool {
+	if len(ids) == 0 || len(scope) == 0 {
+		return true
+	}
+	for _, id := range ids {
+		if slices.Contains(scope, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListUpcoming retrieves maintenance windows starting within the given duration.
+func (s *InMemoryStore) ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	until := now.Add(duration)
+	var windows []*routingv1.MaintenanceWindow
+	for _, window := range s.tenantWindows(tenantID) {
+		start := window.StartTime.AsTime()
+		// Recurring windows are matched on their occurrences below
+		recurring := window.Recurrence != nil && !start.After(until)
+		if recurring || (window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED &&
+			start.After(now) && !start.After(until)) {
+			windows = append(windows, cloneWindow(window))
+		}
+	}
+
+	return upcomingOccurrences(windows, now, until)
+}
+
+// UpdateStatus updates the status of a maintenance window.
+func (s *InMemoryStore) UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	window, err := s.tenantWindow(ctx, id)
+	if err != nil {
+		return err
+	}
+	window.Status = status
+	return nil
+}
+
+// TransitionStatuses updates statuses based on current time, across all tenants.
+func (s *InMemoryStore) TransitionStatuses(ctx context.Context) ([]StatusTransition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	transitions := newStatusTransitions()
+	for _, window := range s.windows {
+		if window.Recurrence == nil && window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED && !window.StartTime.AsTime().After(now) {
+			window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
+			addTransition(transitions, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED, window.Status, 1)
+		}
+	}
+	for _, window := range s.windows {
+		if window.Recurrence == nil && window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS && !window.EndTime.AsTime().After(now) {
+			window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+			addTransition(transitions, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, window.Status, 1)
+		}
+	}
+
+	// Recurring windows take the status of their current occurrence
+	for _, window := range s.windows {
+		if window.Recurrence == nil || (window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED &&
+			window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS) {
+			continue
+		}
+		status, err := recurringStatus(window, now)
+		if err != nil {
+			return nil, fmt.Errorf("expand maintenance window %s: %w", window.Id, err)
+		}
+		if status != window.Status {
+			addTransition(transitions, window.Status, status, 1)
+			window.Status = status
+		}
+	}
+
+	return transitions, nil
+}
+
+// ExpandMaintenanceWindow adds sites and services to the scope of an in-progress
+// window and, if extendBy is positive, extends its end time.
+func (s *InMemoryStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	if extendBy < 0 {
+		return nil, fmt.Errorf("%w: extend_by must not be negative", ErrInvalidWindow)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	window, err := s.tenantWindow(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		return nil, fmt.Errorf("%w: only in-progress windows can be expanded, window is %s", ErrInvalidStatus, statusToString(window.Status))
+	}
+
+	window.AffectedSites = appendMissing(window.AffectedSites, additionalSites)
+	window.AffectedServices = appendMissing(window.AffectedServices, additionalServices)
+	window.EndTime = timestamppb.New(window.EndTime.AsTime().Add(extendBy))
+
+	return cloneWindow(window), nil
+}
+
+// ApproveMaintenanceWindow records the approval of a window pending approval,
+// schedules it and adds an entry to the audit log.
+func (s *InMemoryStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	if approvedBy == "" {
+		return nil, fmt.Errorf("%w: approved_by is required", ErrInvalidWindow)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	window, err := s.tenantWindow(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL {
+		return nil, fmt.Errorf("%w: only windows pending approval can be approved, window is %s", ErrInvalidStatus, statusToString(window.Status))
+	}
+
+	now := s.now()
+	window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+	window.ApprovedBy = approvedBy
+	window.ApprovedAt = timestamppb.New(now)
+	s.auditLog = append(s.auditLog, AuditLogEntry{
+		WindowID:  windowID,
+		Action:    AuditActionApproved,
+		Actor:     approvedBy,
+		CreatedAt: now,
+	})
+
+	return cloneWindow(window), nil
+}
+
+// AuditLog returns the audit log entries recorded so far, oldest first.
+func (s *InMemoryStore) AuditLog() []AuditLogEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.auditLog)
+}
+
+// tenantWindow returns the window id of the tenant of ctx. Windows of other
+// tenants are not found. The caller must hold s.mu.
+func (s *InMemoryStore) tenantWindow(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	window, ok := s.windows[id]
+	if !ok || s.tenants[id] != tenantID {
+		return nil, ErrNotFound
+	}
+	return window, nil
+}
+
+// tenantWindows returns the windows of tenantID. The caller must hold s.mu.
+func (s *InMemoryStore) tenantWindows(tenantID string) []*routingv1.MaintenanceWindow {
+	var windows []*routingv1.MaintenanceWindow
+	for id, window := range s.windows {
+		if s.tenants[id] == tenantID {
+			windows = append(windows, window)
+		}
+	}
+	return windows
+}
+
+// cloneWindow copies a window so callers cannot modify stored windows.
+func cloneWindow(window *routingv1.MaintenanceWindow) *routingv1.MaintenanceWindow {
+	return proto.Clone(window).(*routingv1.MaintenanceWindow)
+}
+
+// sortByStartTime orders windows by start time ascending.
+func sortByStartTime(windows []*routingv1.MaintenanceWindow) {
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].StartTime.AsTime().Before(windows[j].StartTime.AsTime())
+	})
+}
+
+// Ensure InMemoryStore implements Store
+var _ Store = (*InMemoryStore)(nil)
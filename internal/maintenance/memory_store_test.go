@@ -0,0 +1,129 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestInMemoryStore_CRUD(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := NewInMemoryStore()
+	store.now = func() time.Time { return now }
+
+	created, err := store.Create(ctx, &routingv1.MaintenanceWindow{
+		Name:          "DB upgrade",
+		StartTime:     timestamppb.New(now.Add(-time.Minute)),
+		EndTime:       timestamppb.New(now.Add(time.Hour)),
+		AffectedSites: []string{"site-1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Id == "" {
+		t.Error("expected an id to be generated")
+	}
+	if created.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		t.Errorf("expected in-progress status, got %v", created.Status)
+	}
+
+	fetched, err := store.Get(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fetched.Name = "changed"
+	if again, _ := store.Get(ctx, created.Id); again.Name != "DB upgrade" {
+		t.Error("expected Get to return a copy of the stored window")
+	}
+
+	active, err := store.ListActive(ctx, []string{"site-1"}, nil)
+	if err != nil || len(active) != 1 {
+		t.Fatalf("expected 1 active window for site-1, got %d (err %v)", len(active), err)
+	}
+	if active, _ := store.ListActive(ctx, []string{"site-2"}, nil); len(active) != 0 {
+		t.Errorf("expected no active windows for site-2, got %d", len(active))
+	}
+
+	resp, err := store.List(ctx, &routingv1.ListMaintenanceWindowsRequest{SiteId: "site-1"})
+	if err != nil || len(resp.Windows) != 1 || resp.TotalCount != 1 {
+		t.Fatalf("expected 1 listed window, got %v (err %v)", resp, err)
+	}
+
+	if err := store.Delete(ctx, created.Id); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := store.Get(ctx, created.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestInMemoryStore_TenantIsolation(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := NewInMemoryStore()
+	store.now = func() time.Time { return now }
+	tenantA := auth.WithTenant(context.Background(), "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01")
+	tenantB := auth.WithTenant(context.Background(), "0b3e7c1d-5a2f-4c8e-9d6b-1e4f7a2c3b5d")
+
+	created, err := store.Create(tenantA, &routingv1.MaintenanceWindow{
+		Name:          "DB upgrade",
+		StartTime:     timestamppb.New(now.Add(-time.Minute)),
+		EndTime:       timestamppb.New(now.Add(time.Hour)),
+		AffectedSites: []string{"site-1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := store.Get(tenantB, created.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound from another tenant, got %v", err)
+	}
+	if active, _ := store.ListActive(tenantB, []string{"site-1"}, nil); len(active) != 0 {
+		t.Errorf("expected no active windows for another tenant, got %d", len(active))
+	}
+	if resp, _ := store.List(tenantB, &routingv1.ListMaintenanceWindowsRequest{}); len(resp.Windows) != 0 {
+		t.Errorf("expected no listed windows for another tenant, got %d", len(resp.Windows))
+	}
+	if err := store.Delete(tenantB, created.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting from another tenant, got %v", err)
+	}
+	if active, _ := store.ListActive(tenantA, []string{"site-1"}, nil); len(active) != 1 {
+		t.Errorf("expected 1 active window for the owning tenant, got %d", len(active))
+	}
+}
+
+func TestInMemoryStore_ApproveMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+	now := time.Now()
+
+	created, err := store.Create(ctx, &routingv1.MaintenanceWindow{
+		StartTime:        timestamppb.New(now.Add(time.Hour)),
+		EndTime:          timestamppb.New(now.Add(2 * time.Hour)),
+		RequiresApproval: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	approved, err := store.ApproveMaintenanceWindow(ctx, created.Id, "lead")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if approved.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED || approved.ApprovedBy != "lead" {
+		t.Errorf("expected scheduled window approved by lead, got %v by %q", approved.Status, approved.ApprovedBy)
+	}
+	if log := store.AuditLog(); len(log) != 1 || log[0].Action != AuditActionApproved {
+		t.Errorf("expected one approval audit entry, got %v", log)
+	}
+
+	if _, err := store.ApproveMaintenanceWindow(ctx, created.Id, "lead"); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("expected ErrInvalidStatus approving twice, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package maintenance
+
+import "sync"
+
+// transitionKey identifies a status transition, e.g. scheduled -> active.
+type transitionKey struct {
+	from, to string
+}
+
+// Metrics tracks maintenance window status transitions.
+// Exposed as the maintenance_transitions_total{from,to} counter.
+type Metrics struct {
+	mu          sync.RWMutex
+	transitions map[transitionKey]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		transitions: make(map[transitionKey]int64),
+	}
+}
+
+// RecordTransitions adds count to the transitions counter from one status to another.
+func (m *Metrics) RecordTransitions(from, to string, count int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.transitions[transitionKey{from: from, to: to}] += count
+}
+
+// TransitionsTotal returns the number of windows moved from one status to another.
+func (m *Metrics) TransitionsTotal(from, to string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.transitions[transitionKey{from: from, to: to}]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.transitions = make(map[transitionKey]int64)
+}
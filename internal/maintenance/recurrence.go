@@ -0,0 +1,347 @@
+package maintenance
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultRecurrenceHorizon is how far ahead recurring windows are expanded
+	// when a list request has no end_time.
+	DefaultRecurrenceHorizon = 90 * 24 * time.Hour
+
+	// maxRecurrenceIterations bounds the periods stepped through for one window,
+	// so an unlimited rule listed far from its start cannot loop forever.
+	maxRecurrenceIterations = 100000
+)
+
+// recurrenceEnd bounds the expansion of a recurring window when looking for
+// its next occurrence, however far ahead it is.
+var recurrenceEnd = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// ExpandRecurrence returns the concrete occurrences of a window that overlap
+// [from, until], in chronological order. A window without a recurrence rule is
+// returned as is if it overlaps the range.
+//
+// Occurrences keep the ID of the window they were generated from, so they are
+// updated and deleted through it. Their status is derived from the current time
+// unless the window was cancelled or is pending approval.
+func ExpandRecurrence(window *routingv1.MaintenanceWindow, from, until time.Time) ([]*routingv1.MaintenanceWindow, error) {
+	return expandRecurrence(window, from, until, time.Now())
+}
+
+func expandRecurrence(window *routingv1.MaintenanceWindow, from, until, now time.Time) ([]*routingv1.MaintenanceWindow, error) {
+	return expandOccurrences(window, from, until, now, 0)
+}
+
+// expandOccurrences is expandRecurrence returning at most limit occurrences,
+// or all of them if limit is zero.
+func expandOccurrences(window *routingv1.MaintenanceWindow, from, until, now time.Time, limit int) ([]*routingv1.MaintenanceWindow, error) {
+	if window == nil || window.StartTime == nil || window.EndTime == nil {
+		return nil, fmt.Errorf("%w: start_time and end_time are required", ErrInvalidWindow)
+	}
+	if until.Before(from) {
+		return nil, fmt.Errorf("%w: until must not be before from", ErrInvalidWindow)
+	}
+
+	start := window.StartTime.AsTime()
+	duration := window.EndTime.AsTime().Sub(start)
+
+	rule := window.Recurrence
+	if rule == nil {
+		if start.After(until) || start.Add(duration).Before(from) {
+			return nil, nil
+		}
+		return []*routingv1.MaintenanceWindow{window}, nil
+	}
+	if err := validateRecurrence(rule); err != nil {
+		return nil, err
+	}
+
+	interval := int(rule.Interval)
+	if interval == 0 {
+		interval = 1
+	}
+	var ruleUntil time.Time
+	if rule.Until != nil {
+		ruleUntil = rule.Until.AsTime()
+	}
+
+	var occurrences []*routingv1.MaintenanceWindow
+	generated := 0
+	// emit records an occurrence starting at occStart and reports whether
+	// later occurrences can still be generated.
+	emit := func(occStart time.Time) bool {
+		if occStart.Before(start) {
+			// weekdays of the first week that fall before start_time
+			return true
+		}
+		if occStart.After(until) || (!ruleUntil.IsZero() && occStart.After(ruleUntil)) {
+			return false
+		}
+		generated++
+		if rule.Count > 0 && generated > int(rule.Count) {
+			return false
+		}
+		if !occStart.Add(duration).Before(from) {
+			occurrences = append(occurrences, newOccurrence(window, occStart, duration, now))
+		}
+		return limit == 0 || len(occurrences) < limit
+	}
+
+	days := weekdays(rule, start)
+	weekStart := start.AddDate(0, 0, -int(start.Weekday()))
+
+expand:
+	for i := 0; i < maxRecurrenceIterations; i++ {
+		switch rule.Frequency {
+		case routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:
+			if !emit(start.AddDate(0, 0, i*interval)) {
+				break expand
+			}
+		case routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:
+			week := weekStart.AddDate(0, 0, i*7*interval)
+			for _, day := range days {
+				if !emit(week.AddDate(0, 0, day)) {
+					break expand
+				}
+			}
+		case routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+			occStart := start.AddDate(0, i*interval, 0)
+			if occStart.Day() != start.Day() {
+				// like RRULE, months without the day of start_time are skipped
+				continue
+			}
+			if !emit(occStart) {
+				break expand
+			}
+		}
+	}
+
+	return occurrences, nil
+}
+
+// currentOccurrence returns the occurrence of a recurring window in progress
+// at now, or else its next occurrence. It returns nil once the recurrence rule
+// has run out.
+func currentOccurrence(window *routingv1.MaintenanceWindow, now time.Time) (*routingv1.MaintenanceWindow, error) {
+	// Occurrences ending at now are over
+	occurrences, err := expandOccurrences(window, now.Add(time.Nanosecond), recurrenceEnd, now, 1)
+	if err != nil || len(occurrences) == 0 {
+		return nil, err
+	}
+	return occurrences[0], nil
+}
+
+// recurringStatus returns the status of a recurring window at now: in progress
+// during one of its occurrences, scheduled between them and completed once its
+// recurrence rule has run out. Cancelled windows and windows pending approval
+// keep their status.
+func recurringStatus(window *routingv1.MaintenanceWindow, now time.Time) (routingv1.MaintenanceStatus, error) {
+	switch window.Status {
+	case routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED,
+		routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL:
+		return window.Status, nil
+	}
+
+	occurrence, err := currentOccurrence(window, now)
+	if err != nil {
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED, err
+	}
+	if occurrence == nil {
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED, nil
+	}
+	return occurrence.Status, nil
+}
+
+// activeOccurrences replaces the recurring windows of windows with their
+// occurrence in progress at now, dropping those between occurrences.
+func activeOccurrences(windows []*routingv1.MaintenanceWindow, now time.Time) ([]*routingv1.MaintenanceWindow, error) {
+	active := windows[:0]
+	for _, window := range windows {
+		if window.Recurrence == nil {
+			active = append(active, window)
+			continue
+		}
+		occurrence, err := currentOccurrence(window, now)
+		if err != nil {
+			return nil, fmt.Errorf("expand maintenance window %s: %w", window.Id, err)
+		}
+		if occurrence != nil && occurrence.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+			active = append(active, occurrence)
+		}
+	}
+	sortByStartTime(active)
+	return active, nil
+}
+
+// upcomingOccurrences replaces the recurring windows of windows with their
+// scheduled occurrences starting after now and up to until.
+func upcomingOccurrences(windows []*routingv1.MaintenanceWindow, now, until time.Time) ([]*routingv1.MaintenanceWindow, error) {
+	var upcoming []*routingv1.MaintenanceWindow
+	for _, window := range windows {
+		if window.Recurrence == nil {
+			upcoming = append(upcoming, window)
+			continue
+		}
+		occurrences, err := expandRecurrence(window, now, until, now)
+		if err != nil {
+			return nil, fmt.Errorf("expand maintenance window %s: %w", window.Id, err)
+		}
+		for _, occurrence := range occurrences {
+			if occurrence.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED && occurrence.StartTime.AsTime().After(now) {
+				upcoming = append(upcoming, occurrence)
+			}
+		}
+	}
+	sortByStartTime(upcoming)
+	return upcoming, nil
+}
+
+// newStatusTransitions returns the transitions reported by TransitionStatuses,
+// all with a zero count. Besides starting and completing, recurring windows go
+// back to scheduled between occurrences, and complete without starting when
+// their rule runs out between two transitions.
+func newStatusTransitions() []StatusTransition {
+	return []StatusTransition{
+		{From: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED, To: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS},
+		{From: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, To: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED},
+		{From: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, To: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED},
+		{From: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED, To: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED},
+	}
+}
+
+// addTransition adds count windows moved from one status to another to transitions.
+func addTransition(transitions []StatusTransition, from, to routingv1.MaintenanceStatus, count int64) {
+	for i := range transitions {
+		if transitions[i].From == from && transitions[i].To == to {
+			transitions[i].Count += count
+			return
+		}
+	}
+}
+
+// validateRecurrence checks that a recurrence rule can be expanded.
+func validateRecurrence(rule *routingv1.RecurrenceRule) error {
+	switch rule.Frequency {
+	case routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY,
+		routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY,
+		routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+	default:
+		return fmt.Errorf("%w: recurrence frequency is required", ErrInvalidWindow)
+	}
+	if rule.Interval < 0 {
+		return fmt.Errorf("%w: recurrence interval must not be negative", ErrInvalidWindow)
+	}
+	if rule.Count < 0 {
+		return fmt.Errorf("%w: recurrence count must not be negative", ErrInvalidWindow)
+	}
+	for _, day := range rule.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("%w: recurrence day of week %d out of range 0-6", ErrInvalidWindow, day)
+		}
+	}
+	return nil
+}
+
+// weekdays returns the sorted, distinct offsets from Sunday a weekly rule
+// repeats on, defaulting to the weekday of start.
+func weekdays(rule *routingv1.RecurrenceRule, start time.Time) []int {
+	if len(rule.DaysOfWeek) == 0 {
+		return []int{int(start.Weekday())}
+	}
+	seen := make(map[int]bool, len(rule.DaysOfWeek))
+	var days []int
+	for _, day := range rule.DaysOfWeek {
+		if !seen[int(day)] {
+			seen[int(day)] = true
+			days = append(days, int(day))
+		}
+	}
+	sort.Ints(days)
+	return days
+}
+
+// newOccurrence copies window with its time range moved to start.
+func newOccurrence(window *routingv1.MaintenanceWindow, start time.Time, duration time.Duration, now time.Time) *routingv1.MaintenanceWindow {
+	occurrence := proto.Clone(window).(*routingv1.MaintenanceWindow)
+	occurrence.StartTime = timestamppb.New(start)
+	occurrence.EndTime = timestamppb.New(start.Add(duration))
+
+	switch occurrence.Status {
+	case routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED,
+		routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL:
+	default:
+		switch {
+		case !now.Before(start.Add(duration)):
+			occurrence.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+		case !now.Before(start):
+			occurrence.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
+		default:
+			occurrence.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+		}
+	}
+	return occurrence
+}
+
+// expandListedWindows replaces the recurring windows of a List page with their
+// occurrences in the requested time range and status, keeping the page ordered
+// by start time descending.
+func expandListedWindows(windows []*routingv1.MaintenanceWindow, req *routingv1.ListMaintenanceWindowsRequest, now time.Time) ([]*routingv1.MaintenanceWindow, error) {
+	var from time.Time
+	if req.StartTime != nil {
+		from = req.StartTime.AsTime()
+	}
+	until := now.Add(DefaultRecurrenceHorizon)
+	if req.EndTime != nil {
+		until = req.EndTime.AsTime()
+	}
+
+	expanded := make([]*routingv1.MaintenanceWindow, 0, len(windows))
+	recurring := false
+	for _, window := range windows {
+		if window.Recurrence == nil {
+			expanded = append(expanded, window)
+			continue
+		}
+		recurring = true
+
+		occurrences, err := expandRecurrence(window, from, until, now)
+		if err != nil {
+			return nil, fmt.Errorf("expand maintenance window %s: %w", window.Id, err)
+		}
+		for _, occurrence := range occurrences {
+			if req.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED && occurrence.Status != req.Status {
+				continue
+			}
+			expanded = append(expanded, occurrence)
+		}
+	}
+
+	if recurring {
+		sort.SliceStable(expanded, func(i, j int) bool {
+			return expanded[i].StartTime.AsTime().After(expanded[j].StartTime.AsTime())
+		})
+	}
+	return expanded, nil
+}
+
+// marshalRecurrence encodes a recurrence rule for the recurrence column;
+// windows without a rule are stored as NULL.
+func marshalRecurrence(rule *routingv1.RecurrenceRule) ([]byte, error) {
+	if rule == nil {
+		return nil, nil
+	}
+	data, err := protojson.Marshal(rule)
+	if err != nil {
+		return nil, fmt.Errorf("marshal recurrence: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,395 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// recurrenceStart is a Sunday.
+var recurrenceStart = time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)
+
+func recurringWindow(start time.Time, rule *routingv1.RecurrenceRule) *routingv1.MaintenanceWindow {
+	return &routingv1.MaintenanceWindow{
+		Id:         "window-1",
+		Name:       "Weekly patching",
+		StartTime:  timestamppb.New(start),
+		EndTime:    timestamppb.New(start.Add(2 * time.Hour)),
+		Status:     routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED,
+		Recurrence: rule,
+	}
+}
+
+func occurrenceStarts(windows []*routingv1.MaintenanceWindow) []time.Time {
+	starts := make([]time.Time, len(windows))
+	for i, w := range windows {
+		starts[i] = w.StartTime.AsTime()
+	}
+	return starts
+}
+
+func TestExpandRecurrence(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 2, 0, 0, 0, time.UTC)
+	}
+	weekly := routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		rule     *routingv1.RecurrenceRule
+		from     time.Time
+		until    time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "weekly on sunday and wednesday",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: weekly, DaysOfWeek: []int32{3, 0}},
+			from:     recurrenceStart,
+			until:    day(3, 14),
+			expected: []time.Time{day(3, 1), day(3, 4), day(3, 8), day(3, 11)},
+		},
+		{
+			name:     "weekly defaults to weekday of start",
+			start:    day(3, 4),
+			rule:     &routingv1.RecurrenceRule{Frequency: weekly},
+			from:     recurrenceStart,
+			until:    day(3, 20),
+			expected: []time.Time{day(3, 4), day(3, 11), day(3, 18)},
+		},
+		{
+			name:     "weekly skips days before start",
+			start:    day(3, 4),
+			rule:     &routingv1.RecurrenceRule{Frequency: weekly, DaysOfWeek: []int32{0, 3}},
+			from:     recurrenceStart,
+			until:    day(3, 10),
+			expected: []time.Time{day(3, 4), day(3, 8)},
+		},
+		{
+			name:     "every other week",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: weekly, Interval: 2, DaysOfWeek: []int32{0, 3}},
+			from:     recurrenceStart,
+			until:    day(3, 20),
+			expected: []time.Time{day(3, 1), day(3, 4), day(3, 15), day(3, 18)},
+		},
+		{
+			name:     "count limits occurrences",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: weekly, DaysOfWeek: []int32{0, 3}, Count: 3},
+			from:     recurrenceStart,
+			until:    day(4, 30),
+			expected: []time.Time{day(3, 1), day(3, 4), day(3, 8)},
+		},
+		{
+			name:     "count includes occurrences before the range",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, Count: 5},
+			from:     day(3, 4),
+			until:    day(3, 31),
+			expected: []time.Time{day(3, 4), day(3, 5)},
+		},
+		{
+			name:     "daily until",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, Until: timestamppb.New(day(3, 3))},
+			from:     recurrenceStart,
+			until:    day(3, 31),
+			expected: []time.Time{day(3, 1), day(3, 2), day(3, 3)},
+		},
+		{
+			name:     "occurrence in progress at from is included",
+			start:    recurrenceStart,
+			rule:     &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY},
+			from:     day(3, 5).Add(time.Hour),
+			until:    day(3, 6).Add(time.Hour),
+			expected: []time.Time{day(3, 5), day(3, 6)},
+		},
+		{
+			name:     "monthly skips months without the day",
+			start:    day(1, 31),
+			rule:     &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY},
+			from:     day(1, 1),
+			until:    day(6, 30),
+			expected: []time.Time{day(1, 31), day(3, 31), day(5, 31)},
+		},
+		{
+			name:  "no recurrence outside range",
+			start: recurrenceStart,
+			from:  day(3, 2),
+			until: day(3, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window := recurringWindow(tt.start, tt.rule)
+			occurrences, err := ExpandRecurrence(window, tt.from, tt.until)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			starts := occurrenceStarts(occurrences)
+			if len(starts) != len(tt.expected) {
+				t.Fatalf("expected occurrences %v, got %v", tt.expected, starts)
+			}
+			for i := range starts {
+				if !starts[i].Equal(tt.expected[i]) {
+					t.Errorf("occurrence %d: expected %v, got %v", i, tt.expected[i], starts[i])
+				}
+				if occurrences[i].Id != window.Id {
+					t.Errorf("expected occurrence to keep id %s, got %s", window.Id, occurrences[i].Id)
+				}
+				if d := occurrences[i].EndTime.AsTime().Sub(starts[i]); d != 2*time.Hour {
+					t.Errorf("expected occurrence to last 2h, got %v", d)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandRecurrence_Status(t *testing.T) {
+	rule := &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY}
+	now := recurrenceStart.AddDate(0, 0, 1).Add(time.Hour)
+	until := recurrenceStart.AddDate(0, 0, 2)
+
+	occurrences, err := expandRecurrence(recurringWindow(recurrenceStart, rule), recurrenceStart, until, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []routingv1.MaintenanceStatus{
+		routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED,
+	}
+	if len(occurrences) != len(expected) {
+		t.Fatalf("expected %d occurrences, got %d", len(expected), len(occurrences))
+	}
+	for i, occurrence := range occurrences {
+		if occurrence.Status != expected[i] {
+			t.Errorf("occurrence %d: expected status %v, got %v", i, expected[i], occurrence.Status)
+		}
+	}
+
+	cancelled := recurringWindow(recurrenceStart, rule)
+	cancelled.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED
+	occurrences, err = expandRecurrence(cancelled, recurrenceStart, until, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, occurrence := range occurrences {
+		if occurrence.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED {
+			t.Errorf("expected occurrences of a cancelled window to be cancelled, got %v", occurrence.Status)
+		}
+	}
+}
+
+func TestExpandRecurrence_Invalid(t *testing.T) {
+	rules := []*routingv1.RecurrenceRule{
+		{},
+		{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY, DaysOfWeek: []int32{7}},
+		{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, Interval: -1},
+		{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, Count: -1},
+	}
+	for _, rule := range rules {
+		_, err := ExpandRecurrence(recurringWindow(recurrenceStart, rule), recurrenceStart, recurrenceStart.AddDate(0, 1, 0))
+		if !errors.Is(err, ErrInvalidWindow) {
+			t.Errorf("expected ErrInvalidWindow for %v, got %v", rule, err)
+		}
+	}
+}
+
+func TestPostgresStore_List_ExpandsRecurringWindows(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	from := recurrenceStart
+	until := recurrenceStart.AddDate(0, 0, 14)
+
+	mock.ExpectQuery(`WHERE tenant_id = \$1 AND \(end_time >= \$2 OR recurrence IS NOT NULL\) AND start_time <= \$3`).
+		WithArgs(auth.DefaultTenantID, from, until, 51).
+		WillReturnRows(sqlmock.NewRows(windowColumns).
+			AddRow("window-1", "Weekly patching", nil, recurrenceStart, recurrenceStart.Add(2*time.Hour), "completed", "suppress",
+				[]byte(`{}`), []byte(`{}`), []byte(`{"frequency":"RECURRENCE_FREQUENCY_WEEKLY","daysOfWeek":[0]}`),
+				nil, nil, nil, nil, nil, false, from, from).
+			AddRow("window-2", "Upgrade", nil, from.AddDate(0, 0, 3), from.AddDate(0, 0, 3).Add(time.Hour), "completed", "suppress",
+				[]byte(`{}`), []byte(`{}`), nil,
+				nil, nil, nil, nil, nil, false, from, from))
+
+	resp, err := store.List(context.Background(), &routingv1.ListMaintenanceWindowsRequest{
+		StartTime: timestamppb.New(from),
+		EndTime:   timestamppb.New(until),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []time.Time{recurrenceStart.AddDate(0, 0, 14), recurrenceStart.AddDate(0, 0, 7), from.AddDate(0, 0, 3), recurrenceStart}
+	starts := occurrenceStarts(resp.Windows)
+	if len(starts) != len(expected) {
+		t.Fatalf("expected windows starting at %v, got %v", expected, starts)
+	}
+	for i := range starts {
+		if !starts[i].Equal(expected[i]) {
+			t.Errorf("window %d: expected start %v, got %v", i, expected[i], starts[i])
+		}
+	}
+	if resp.Windows[0].Recurrence.GetFrequency() != routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY {
+		t.Errorf("expected occurrences to carry the recurrence rule, got %v", resp.Windows[0].Recurrence)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestInMemoryStore_RecurringWindowSecondOccurrence(t *testing.T) {
+	rule := &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY, Count: 2}
+	ctx := context.Background()
+	store := NewInMemoryStore()
+	now := recurrenceStart.Add(-time.Hour)
+	store.now = func() time.Time { return now }
+
+	window := recurringWindow(recurrenceStart, rule)
+	window.AffectedSites = []string{"site-1"}
+	if _, err := store.Create(ctx, window); err != nil {
+		t.Fatalf("failed to create window: %v", err)
+	}
+
+	// status of the stored window after each transition, and whether an
+	// occurrence is in progress
+	steps := []struct {
+		at     time.Time
+		status routingv1.MaintenanceStatus
+		active bool
+	}{
+		{recurrenceStart.Add(time.Hour), routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, true},
+		{recurrenceStart.Add(3 * time.Hour), routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED, false},
+		{recurrenceStart.AddDate(0, 0, 1).Add(time.Hour), routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, true},
+		{recurrenceStart.AddDate(0, 0, 1).Add(3 * time.Hour), routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED, false},
+	}
+	for _, step := range steps {
+		now = step.at
+		if _, err := store.TransitionStatuses(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		stored, err := store.Get(ctx, window.Id)
+		if err != nil {
+			t.Fatalf("failed to get window: %v", err)
+		}
+		if stored.Status != step.status {
+			t.Errorf("at %v: expected status %v, got %v", step.at, step.status, stored.Status)
+		}
+
+		active, err := store.ListActive(ctx, []string{"site-1"}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if step.active != (len(active) == 1) {
+			t.Errorf("at %v: expected active %v, got %d windows", step.at, step.active, len(active))
+		}
+	}
+
+	// During the first occurrence the second one is upcoming
+	now = recurrenceStart.Add(time.Hour)
+	active, _ := store.ListActive(ctx, nil, nil)
+	if len(active) != 1 || !active[0].StartTime.AsTime().Equal(recurrenceStart) {
+		t.Errorf("expected the first occurrence to be active, got %v", occurrenceStarts(active))
+	}
+	upcoming, err := store.ListUpcoming(ctx, 48*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(upcoming) != 1 || !upcoming[0].StartTime.AsTime().Equal(recurrenceStart.AddDate(0, 0, 1)) {
+		t.Errorf("expected the second occurrence to be upcoming, got %v", occurrenceStarts(upcoming))
+	}
+}
+
+func TestMatchesAlert_RecurringWindow(t *testing.T) {
+	rule := &routingv1.RecurrenceRule{Frequency: routingv1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY}
+	alert := &routingv1.Alert{Labels: map[string]string{"site": "site-1"}}
+
+	// The third occurrence of the window is in progress
+	inProgress := recurringWindow(time.Now().Add(-49*time.Hour), rule)
+	if !MatchesAlert(inProgress, alert) {
+		t.Error("expected a recurring window to match during an occurrence")
+	}
+
+	// The third occurrence of the window starts in an hour
+	between := recurringWindow(time.Now().Add(-47*time.Hour), rule)
+	if MatchesAlert(between, alert) {
+		t.Error("expected a recurring window not to match between occurrences")
+	}
+}
+
+func TestPostgresStore_TransitionStatuses_RecurringWindow(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	start := time.Now().Add(-49 * time.Hour)
+
+	mock.ExpectExec(`WHERE status = 'scheduled' AND start_time <= \$1 AND recurrence IS NULL`).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`WHERE status = 'active' AND end_time <= \$1 AND recurrence IS NULL`).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`WHERE recurrence IS NOT NULL AND status IN \('scheduled', 'active'\)`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id", "start_time", "end_time", "status", "recurrence"}).
+			AddRow("window-1", start, start.Add(2*time.Hour), "scheduled", []byte(`{"frequency":"RECURRENCE_FREQUENCY_DAILY"}`)))
+	mock.ExpectExec(`UPDATE maintenance_windows SET status = \$1, updated_at = \$2 WHERE id = \$3 AND status = \$4`).
+		WithArgs("active", sqlmock.AnyArg(), "window-1", "scheduled").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	transitions, err := store.TransitionStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, transition := range transitions {
+		expected := int64(0)
+		if transition.From == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED &&
+			transition.To == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+			expected = 1
+		}
+		if transition.Count != expected {
+			t.Errorf("%v -> %v: expected %d windows, got %d", transition.From, transition.To, expected, transition.Count)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_ListActive_RecurringWindow(t *testing.T) {
+	store, mock := newExpandTestStore(t)
+	start := time.Now().Add(-25 * time.Hour)
+
+	mock.ExpectQuery(`OR \(recurrence IS NOT NULL AND status NOT IN \('cancelled', 'pending_approval'\)\)\)`).
+		WithArgs(sqlmock.AnyArg(), auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows(windowColumns).
+			AddRow("window-1", "Daily restart", nil, start, start.Add(2*time.Hour), "completed", "suppress",
+				[]byte(`{}`), []byte(`{}`), []byte(`{"frequency":"RECURRENCE_FREQUENCY_DAILY"}`),
+				nil, nil, nil, nil, nil, false, start, start))
+
+	active, err := store.ListActive(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(active) != 1 || !active[0].StartTime.AsTime().Equal(start.AddDate(0, 0, 1)) {
+		t.Fatalf("expected the second occurrence to be active, got %v", occurrenceStarts(active))
+	}
+	if active[0].Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		t.Errorf("expected the occurrence to be in progress, got %v", active[0].Status)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultStatusWorkerInterval is how often the status worker transitions
+// maintenance window statuses.
+const DefaultStatusWorkerInterval = time.Minute
+
+// MaintenanceStatusWorker periodically moves maintenance windows from
+// scheduled to active and from active to completed.
+type MaintenanceStatusWorker struct {
+	store    Store
+	interval time.Duration
+	metrics  *Metrics
+	logger   zerolog.Logger
+
+	// afterFunc schedules the next tick and returns a function that cancels
+	// it; tests replace it to tick on demand
+	afterFunc func(d time.Duration, f func()) (stop func() bool)
+}
+
+// NewMaintenanceStatusWorker creates a worker that transitions statuses every
+// interval. A non-positive interval uses DefaultStatusWorkerInterval.
+func NewMaintenanceStatusWorker(store Store, interval time.Duration, metrics *Metrics, logger zerolog.Logger) *MaintenanceStatusWorker {
+	if interval <= 0 {
+		interval = DefaultStatusWorkerInterval
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &MaintenanceStatusWorker{
+		store:    store,
+		interval: interval,
+		metrics:  metrics,
+		logger:   logger.With().Str("component", "maintenance_status_worker").Logger(),
+		afterFunc: func(d time.Duration, f func()) func() bool {
+			return time.AfterFunc(d, f).Stop
+		},
+	}
+}
+
+// Metrics returns the metrics recorder for this worker.
+func (w *MaintenanceStatusWorker) Metrics() *Metrics {
+	return w.metrics
+}
+
+// Start transitions statuses immediately and then every interval until the
+// context is cancelled. A transition in progress when the context is
+// cancelled is finished before Start returns.
+func (w *MaintenanceStatusWorker) Start(ctx context.Context) {
+	w.logger.Info().Dur("interval", w.interval).Msg("starting maintenance status worker")
+
+	ticks := make(chan struct{}, 1)
+	schedule := func() func() bool {
+		return w.afterFunc(w.interval, func() {
+			select {
+			case ticks <- struct{}{}:
+			default:
+			}
+		})
+	}
+
+	w.Transition(ctx)
+	stop := schedule()
+	for {
+		select {
+		case <-ctx.Done():
+			stop()
+			w.logger.Info().Msg("maintenance status worker stopped")
+			return
+		case <-ticks:
+			w.Transition(ctx)
+			stop = schedule()
+		}
+	}
+}
+
+// Transition runs one status transition and records the windows moved.
+// Failures are logged and retried on the next tick.
+func (w *MaintenanceStatusWorker) Transition(ctx context.Context) {
+	transitions, err := w.store.TransitionStatuses(ctx)
+	if err != nil {
+		w.logger.Error().Err(err).Msg("failed to transition maintenance window statuses")
+		return
+	}
+
+	for _, transition := range transitions {
+		if transition.Count == 0 {
+			continue
+		}
+		from, to := statusToString(transition.From), statusToString(transition.To)
+		w.metrics.RecordTransitions(from, to, transition.Count)
+		w.logger.Info().
+			Str("from", from).
+			Str("to", to).
+			Int64("count", transition.Count).
+			Msg("transitioned maintenance windows")
+	}
+}
@@ -0,0 +1,213 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// fakeTimer replaces time.AfterFunc so tests decide when the worker ticks.
+type fakeTimer struct {
+	mu        sync.Mutex
+	pending   func()
+	durations []time.Duration
+	stopped   int
+	scheduled chan struct{}
+}
+
+func newFakeTimer() *fakeTimer {
+	return &fakeTimer{scheduled: make(chan struct{}, 16)}
+}
+
+func (t *fakeTimer) afterFunc(d time.Duration, f func()) func() bool {
+	t.mu.Lock()
+	t.pending = f
+	t.durations = append(t.durations, d)
+	t.mu.Unlock()
+	t.scheduled <- struct{}{}
+	return func() bool {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.stopped++
+		return true
+	}
+}
+
+// fire runs the pending tick and waits for the worker to schedule the next one.
+func (t *fakeTimer) fire(tb testing.TB) {
+	tb.Helper()
+	t.mu.Lock()
+	f := t.pending
+	t.mu.Unlock()
+	f()
+	t.waitScheduled(tb)
+}
+
+func (t *fakeTimer) waitScheduled(tb testing.TB) {
+	tb.Helper()
+	select {
+	case <-t.scheduled:
+	case <-time.After(5 * time.Second):
+		tb.Fatal("timed out waiting for the worker to schedule a tick")
+	}
+}
+
+// failingStore fails every status transition.
+type failingStore struct {
+	*InMemoryStore
+}
+
+func (s *failingStore) TransitionStatuses(ctx context.Context) ([]StatusTransition, error) {
+	return nil, errors.New("database unavailable")
+}
+
+func startWorker(t *testing.T, store Store, timer *fakeTimer) (*MaintenanceStatusWorker, func()) {
+	t.Helper()
+	worker := NewMaintenanceStatusWorker(store, 30*time.Second, nil, zerolog.Nop())
+	worker.afterFunc = timer.afterFunc
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		worker.Start(ctx)
+		close(done)
+	}()
+	timer.waitScheduled(t)
+
+	return worker, func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("worker did not stop after the context was cancelled")
+		}
+	}
+}
+
+func TestMaintenanceStatusWorker_Transitions(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := NewInMemoryStore()
+	store.now = func() time.Time { return now }
+
+	for _, window := range []*routingv1.MaintenanceWindow{
+		{Id: "starting", StartTime: timestamppb.New(now.Add(time.Minute)), EndTime: timestamppb.New(now.Add(time.Hour))},
+		{Id: "ending", StartTime: timestamppb.New(now.Add(-time.Hour)), EndTime: timestamppb.New(now.Add(90 * time.Second))},
+		{Id: "later", StartTime: timestamppb.New(now.Add(24 * time.Hour)), EndTime: timestamppb.New(now.Add(25 * time.Hour))},
+	} {
+		if _, err := store.Create(context.Background(), window); err != nil {
+			t.Fatalf("failed to create window: %v", err)
+		}
+	}
+
+	timer := newFakeTimer()
+	worker, stop := startWorker(t, store, timer)
+	metrics := worker.Metrics()
+
+	if got := metrics.TransitionsTotal("scheduled", "active"); got != 0 {
+		t.Errorf("expected no transitions before the first tick, got %d", got)
+	}
+
+	now = now.Add(2 * time.Minute)
+	timer.fire(t)
+
+	if got := metrics.TransitionsTotal("scheduled", "active"); got != 1 {
+		t.Errorf("expected 1 scheduled -> active transition, got %d", got)
+	}
+	if got := metrics.TransitionsTotal("active", "completed"); got != 1 {
+		t.Errorf("expected 1 active -> completed transition, got %d", got)
+	}
+
+	now = now.Add(time.Hour)
+	timer.fire(t)
+	stop()
+
+	if got := metrics.TransitionsTotal("active", "completed"); got != 2 {
+		t.Errorf("expected 2 active -> completed transitions, got %d", got)
+	}
+	for id, expected := range map[string]routingv1.MaintenanceStatus{
+		"starting": routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		"ending":   routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		"later":    routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED,
+	} {
+		window, err := store.Get(context.Background(), id)
+		if err != nil {
+			t.Fatalf("failed to get window %s: %v", id, err)
+		}
+		if window.Status != expected {
+			t.Errorf("window %s: expected status %v, got %v", id, expected, window.Status)
+		}
+	}
+
+	for _, d := range timer.durations {
+		if d != 30*time.Second {
+			t.Errorf("expected ticks every 30s, got %v", d)
+		}
+	}
+	if timer.stopped != 1 {
+		t.Errorf("expected the pending tick to be stopped once, got %d", timer.stopped)
+	}
+}
+
+func TestMaintenanceStatusWorker_ContinuesAfterError(t *testing.T) {
+	timer := newFakeTimer()
+	worker, stop := startWorker(t, &failingStore{InMemoryStore: NewInMemoryStore()}, timer)
+
+	timer.fire(t)
+	timer.fire(t)
+	stop()
+
+	if len(timer.durations) != 3 {
+		t.Errorf("expected the worker to keep ticking after errors, got %d ticks", len(timer.durations))
+	}
+	if got := worker.Metrics().TransitionsTotal("scheduled", "active"); got != 0 {
+		t.Errorf("expected no transitions to be recorded, got %d", got)
+	}
+}
+
+func TestNewMaintenanceStatusWorker_DefaultInterval(t *testing.T) {
+	worker := NewMaintenanceStatusWorker(NewInMemoryStore(), 0, nil, zerolog.Nop())
+	if worker.interval != DefaultStatusWorkerInterval {
+		t.Errorf("expected default interval %v, got %v", DefaultStatusWorkerInterval, worker.interval)
+	}
+	if worker.Metrics() == nil {
+		t.Error("expected metrics to be created")
+	}
+}
+
+func TestMaintenanceStatusWorker_RealTimer(t *testing.T) {
+	now := time.Now()
+	store := NewInMemoryStore()
+	if _, err := store.Create(context.Background(), &routingv1.MaintenanceWindow{
+		Id:        "window-1",
+		StartTime: timestamppb.New(now.Add(20 * time.Millisecond)),
+		EndTime:   timestamppb.New(now.Add(time.Hour)),
+	}); err != nil {
+		t.Fatalf("failed to create window: %v", err)
+	}
+
+	worker := NewMaintenanceStatusWorker(store, 10*time.Millisecond, nil, zerolog.Nop())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		worker.Start(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for worker.Metrics().TransitionsTotal("scheduled", "active") == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the window to start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+}
@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/auth"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -22,6 +24,9 @@ var (
 	ErrInvalidWindow = errors.New("invalid maintenance window")
 	// ErrInvalidStatus is returned when a status transition is invalid.
 	ErrInvalidStatus = errors.New("invalid status transition")
+	// ErrApprovalRequired is returned when a window that requires approval is
+	// started before it was approved.
+	ErrApprovalRequired = errors.New("maintenance window requires approval")
 )
 
 // Scope represents the scope of a maintenance window.
@@ -33,7 +38,17 @@ type Scope struct {
 	Equipment   []string          `json:"equipment,omitempty"`
 }
 
-// Store defines the interface for maintenance window persistence.
+// StatusTransition counts the windows moved from one status to another by
+// TransitionStatuses.
+type StatusTransition struct {
+	From  routingv1.MaintenanceStatus
+	To    routingv1.MaintenanceStatus
+	Count int64
+}
+
+// Store defines the interface for maintenance window persistence. Windows
+// belong to the tenant of the context they are created with, see
+// auth.TenantFromContext, and are only visible to that tenant.
 type Store interface {
 	// Create creates a new maintenance window.
 	Create(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error)
@@ -50,17 +65,30 @@ type Store interface {
 	// Delete deletes a maintenance window by ID.
 	Delete(ctx context.Context, id string) error
 
-	// ListActive retrieves currently active maintenance windows.
+	// ListActive retrieves currently active maintenance windows. Recurring
+	// windows are returned as their occurrence in progress.
 	ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error)
 
-	// ListUpcoming retrieves maintenance windows starting within the given duration.
+	// ListUpcoming retrieves maintenance windows starting within the given
+	// duration. Recurring windows are returned as their occurrences.
 	ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error)
 
 	// UpdateStatus updates the status of a maintenance window.
 	UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error
 
-	// TransitionStatuses updates statuses based on current time (scheduled->active, active->completed).
-	TransitionStatuses(ctx context.Context) error
+	// TransitionStatuses updates statuses based on current time (scheduled->active, active->completed)
+	// and returns how many windows took each transition. It spans all tenants.
+	// Recurring windows go back to scheduled between occurrences and complete
+	// once their recurrence rule has run out.
+	TransitionStatuses(ctx context.Context) ([]StatusTransition, error)
+
+	// ExpandMaintenanceWindow adds sites and services to the scope of an in-progress
+	// window and, if extendBy is positive, extends its end time.
+	ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error)
+
+	// ApproveMaintenanceWindow records the approval of a window pending approval,
+	// schedules it and adds an entry to the maintenance audit log.
+	ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error)
 }
 
 // PostgresStore implements Store using PostgreSQL.
@@ -79,39 +107,18 @@ func (s *PostgresStore) Create(ctx context.Context, window *routingv1.Maintenanc
 		return nil, ErrInvalidWindow
 	}
 
-	if window.StartTime == nil || window.EndTime == nil {
-		return nil, fmt.Errorf("%w: start_time and end_time are required", ErrInvalidWindow)
-	}
-
-	if window.EndTime.AsTime().Before(window.StartTime.AsTime()) {
-		return nil, fmt.Errorf("%w: end_time must be after start_time", ErrInvalidWindow)
-	}
-
-	// Generate ID if not provided
-	if window.Id == "" {
-		window.Id = uuid.New().String()
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
-	window.CreatedAt = timestamppb.New(now)
-
-	// Determine initial status
+	if err := prepareWindow(window, now); err != nil {
+		return nil, err
+	}
 	startTime := window.StartTime.AsTime()
 	endTime := window.EndTime.AsTime()
 
-	if now.After(endTime) {
-		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
-	} else if now.After(startTime) {
-		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
-	} else {
-		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
-	}
-
-	// Default action
-	if window.Action == routingv1.MaintenanceAction_MAINTENANCE_ACTION_UNSPECIFIED {
-		window.Action = routingv1.MaintenanceAction_MAINTENANCE_ACTION_ANNOTATE
-	}
-
 	// Build scope JSON
 	scope := buildScopeJSON(window)
 	scopeJSON, err := json.Marshal(scope)
@@ -119,19 +126,32 @@ func (s *PostgresStore) Create(ctx context.Context, window *routingv1.Maintenanc
 		return nil, fmt.Errorf("marshal scope: %w", err)
 	}
 
+	labelsJSON, err := marshalLabels(window.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrenceJSON, err := marshalRecurrence(window.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
 	// Insert the window
 	_, err = s.db.ExecContext(ctx, `
-		INSERT INTO maintenance_windows (id, name, description, start_time, end_time, status, action, scope, ticket_id, ticket_url, created_by, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		INSERT INTO maintenance_windows (id, name, description, start_time, end_time, status, action, scope, labels, recurrence, requires_approval, ticket_id, ticket_url, created_by, created_at, updated_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`, window.Id, window.Name, window.Description,
 		startTime, endTime,
 		statusToString(window.Status),
 		actionToString(window.Action),
 		scopeJSON,
+		labelsJSON,
+		recurrenceJSON,
+		window.RequiresApproval,
 		nullableString(window.ChangeTicketId),
 		nil, // ticket_url not in proto
 		nullableString(window.CreatedBy),
-		now, now)
+		now, now, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("insert maintenance window: %w", err)
 	}
@@ -141,22 +161,28 @@ func (s *PostgresStore) Create(ctx context.Context, window *routingv1.Maintenanc
 
 // Get retrieves a maintenance window by ID.
 func (s *PostgresStore) Get(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	window := &routingv1.MaintenanceWindow{}
 
 	var startTime, endTime, createdAt, updatedAt time.Time
+	var approvedAt sql.NullTime
 	var description, status, action sql.NullString
-	var scopeJSON []byte
+	var scopeJSON, labelsJSON, recurrenceJSON []byte
 	var ticketID, ticketURL, createdBy, approvedBy sql.NullString
 
-	err := s.db.QueryRowContext(ctx, `
-		SELECT id, name, description, start_time, end_time, status, action, scope,
-			ticket_id, ticket_url, created_by, approved_by, created_at, updated_at
-		FROM maintenance_windows WHERE id = $1
-	`, id).Scan(
+	err = s.db.QueryRowContext(ctx, `
+		SELECT id, name, description, start_time, end_time, status, action, scope, labels, recurrence,
+			ticket_id, ticket_url, created_by, approved_by, approved_at, requires_approval, created_at, updated_at
+		FROM maintenance_windows WHERE id = $1 AND tenant_id = $2
+	`, id, tenantID).Scan(
 		&window.Id, &window.Name, &description,
 		&startTime, &endTime,
-		&status, &action, &scopeJSON,
-		&ticketID, &ticketURL, &createdBy, &approvedBy,
+		&status, &action, &scopeJSON, &labelsJSON, &recurrenceJSON,
+		&ticketID, &ticketURL, &createdBy, &approvedBy, &approvedAt, &window.RequiresApproval,
 		&createdAt, &updatedAt,
 	)
 	if err != nil {
@@ -174,6 +200,10 @@ func (s *PostgresStore) Get(ctx context.Context, id string) (*routingv1.Maintena
 	window.ChangeTicketId = ticketID.String
 	window.CreatedBy = createdBy.String
 	window.CreatedAt = timestamppb.New(createdAt)
+	window.ApprovedBy = approvedBy.String
+	if approvedAt.Valid {
+		window.ApprovedAt = timestamppb.New(approvedAt.Time)
+	}
 
 	// Parse scope
 	if scopeJSON != nil {
@@ -182,6 +212,16 @@ func (s *PostgresStore) Get(ctx context.Context, id string) (*routingv1.Maintena
 			window.AffectedSites = scope.Sites
 			window.AffectedServices = scope.Services
 			window.AffectedLabels = scopeLabelsToStrings(scope.Labels)
+			window.AffectedLabelPatterns = scopeLabelsToStrings(scope.LabelRegex)
+		}
+	}
+	if labelsJSON != nil {
+		_ = json.Unmarshal(labelsJSON, &window.Labels)
+	}
+	if recurrenceJSON != nil {
+		window.Recurrence = &routingv1.RecurrenceRule{}
+		if err := protojson.Unmarshal(recurrenceJSON, window.Recurrence); err != nil {
+			return nil, fmt.Errorf("unmarshal recurrence: %w", err)
 		}
 	}
 
@@ -190,20 +230,26 @@ func (s *PostgresStore) Get(ctx context.Context, id string) (*routingv1.Maintena
 
 // List retrieves maintenance windows with optional filters.
 func (s *PostgresStore) List(ctx context.Context, req *routingv1.ListMaintenanceWindowsRequest) (*routingv1.ListMaintenanceWindowsResponse, error) {
-	query := `SELECT id, name, description, start_time, end_time, status, action, scope,
-		ticket_id, ticket_url, created_by, approved_by, created_at, updated_at
-		FROM maintenance_windows WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, name, description, start_time, end_time, status, action, scope, labels, recurrence,
+		ticket_id, ticket_url, created_by, approved_by, approved_at, requires_approval, created_at, updated_at
+		FROM maintenance_windows WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIndex := 2
 
+	// Recurring windows are matched on their occurrences after they are expanded
 	if req.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED {
-		query += fmt.Sprintf(" AND status = $%d", argIndex)
+		query += fmt.Sprintf(" AND (status = $%d OR recurrence IS NOT NULL)", argIndex)
 		args = append(args, statusToString(req.Status))
 		argIndex++
 	}
 
 	if req.StartTime != nil {
-		query += fmt.Sprintf(" AND end_time >= $%d", argIndex)
+		query += fmt.Sprintf(" AND (end_time >= $%d OR recurrence IS NOT NULL)", argIndex)
 		args = append(args, req.StartTime.AsTime())
 		argIndex++
 	}
@@ -221,6 +267,13 @@ func (s *PostgresStore) List(ctx context.Context, req *routingv1.ListMaintenance
 		argIndex++
 	}
 
+	if len(req.Labels) > 0 {
+		query += fmt.Sprintf(" AND labels @> $%d::jsonb", argIndex)
+		labelFilter, _ := json.Marshal(req.Labels)
+		args = append(args, labelFilter)
+		argIndex++
+	}
+
 	query += " ORDER BY start_time DESC"
 
 	pageSize := int(req.PageSize)
@@ -266,7 +319,10 @@ func (s *PostgresStore) List(ctx context.Context, req *routingv1.ListMaintenance
 		resp.NextPageToken = encodePageToken(offset + pageSize)
 	}
 
-	resp.Windows = windows
+	resp.Windows, err = expandListedWindows(windows, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
@@ -276,6 +332,14 @@ func (s *PostgresStore) Update(ctx context.Context, window *routingv1.Maintenanc
 		return nil, ErrInvalidWindow
 	}
 
+	if err := validateScope(window); err != nil {
+		return nil, err
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build scope JSON
 	scope := buildScopeJSON(window)
 	scopeJSON, err := json.Marshal(scope)
@@ -283,21 +347,35 @@ func (s *PostgresStore) Update(ctx context.Context, window *routingv1.Maintenanc
 		return nil, fmt.Errorf("marshal scope: %w", err)
 	}
 
+	labelsJSON, err := marshalLabels(window.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrenceJSON, err := marshalRecurrence(window.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	result, err := s.db.ExecContext(ctx, `
 		UPDATE maintenance_windows
 		SET name = $1, description = $2, start_time = $3, end_time = $4,
-			status = $5, action = $6, scope = $7, ticket_id = $8, updated_at = $9
-		WHERE id = $10
+			status = $5, action = $6, scope = $7, labels = $8, recurrence = $9, requires_approval = $10,
+			ticket_id = $11, updated_at = $12
+		WHERE id = $13 AND tenant_id = $14
 	`, window.Name, window.Description,
 		window.StartTime.AsTime(), window.EndTime.AsTime(),
 		statusToString(window.Status),
 		actionToString(window.Action),
 		scopeJSON,
+		labelsJSON,
+		recurrenceJSON,
+		window.RequiresApproval,
 		nullableString(window.ChangeTicketId),
 		now,
-		window.Id)
+		window.Id, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("update maintenance window: %w", err)
 	}
@@ -312,7 +390,12 @@ func (s *PostgresStore) Update(ctx context.Context, window *routingv1.Maintenanc
 
 // Delete deletes a maintenance window by ID.
 func (s *PostgresStore) Delete(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM maintenance_windows WHERE id = $1", id)
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM maintenance_windows WHERE id = $1 AND tenant_id = $2", id, tenantID)
 	if err != nil {
 		return fmt.Errorf("delete maintenance window: %w", err)
 	}
@@ -327,14 +410,22 @@ func (s *PostgresStore) Delete(ctx context.Context, id string) error {
 
 // ListActive retrieves currently active maintenance windows.
 func (s *PostgresStore) ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
-	query := `SELECT id, name, description, start_time, end_time, status, action, scope,
-		ticket_id, ticket_url, created_by, approved_by, created_at, updated_at
+	// Recurring windows are matched on their occurrence in progress after they are expanded
+	query := `SELECT id, name, description, start_time, end_time, status, action, scope, labels, recurrence,
+		ticket_id, ticket_url, created_by, approved_by, approved_at, requires_approval, created_at, updated_at
 		FROM maintenance_windows
-		WHERE status = 'active' AND start_time <= $1 AND end_time > $1`
-	args := []interface{}{now}
-	argIndex := 2
+		WHERE start_time <= $1 AND tenant_id = $2
+			AND ((recurrence IS NULL AND status = 'active' AND end_time > $1)
+				OR (recurrence IS NOT NULL AND status NOT IN ('cancelled', 'pending_approval')))`
+	args := []interface{}{now, tenantID}
+	argIndex := 3
 
 	// Filter by sites if provided
 	if len(siteIDs) > 0 {
@@ -367,22 +458,33 @@ func (s *PostgresStore) ListActive(ctx context.Context, siteIDs, serviceIDs []st
 		}
 		windows = append(windows, window)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return windows, rows.Err()
+	return activeOccurrences(windows, now)
 }
 
 // ListUpcoming retrieves maintenance windows starting within the given duration.
 func (s *PostgresStore) ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	until := now.Add(duration)
 
+	// Recurring windows are matched on their occurrences after they are expanded
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, name, description, start_time, end_time, status, action, scope,
-			ticket_id, ticket_url, created_by, approved_by, created_at, updated_at
+		SELECT id, name, description, start_time, end_time, status, action, scope, labels, recurrence,
+			ticket_id, ticket_url, created_by, approved_by, approved_at, requires_approval, created_at, updated_at
 		FROM maintenance_windows
-		WHERE status = 'scheduled' AND start_time > $1 AND start_time <= $2
+		WHERE start_time <= $2 AND tenant_id = $3
+			AND ((recurrence IS NULL AND status = 'scheduled' AND start_time > $1)
+				OR (recurrence IS NOT NULL AND status NOT IN ('cancelled', 'pending_approval')))
 		ORDER BY start_time ASC
-	`, now, until)
+	`, now, until, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("query upcoming maintenance windows: %w", err)
 	}
@@ -396,15 +498,23 @@ func (s *PostgresStore) ListUpcoming(ctx context.Context, duration time.Duration
 		}
 		windows = append(windows, window)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return windows, rows.Err()
+	return upcomingOccurrences(windows, now, until)
 }
 
 // UpdateStatus updates the status of a maintenance window.
 func (s *PostgresStore) UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	result, err := s.db.ExecContext(ctx, `
-		UPDATE maintenance_windows SET status = $1, updated_at = $2 WHERE id = $3
-	`, statusToString(status), time.Now(), id)
+		UPDATE maintenance_windows SET status = $1, updated_at = $2 WHERE id = $3 AND tenant_id = $4
+	`, statusToString(status), time.Now(), id, tenantID)
 	if err != nil {
 		return fmt.Errorf("update status: %w", err)
 	}
@@ -417,30 +527,98 @@ func (s *PostgresStore) UpdateStatus(ctx context.Context, id string, status rout
 	return nil
 }
 
-// TransitionStatuses updates statuses based on current time.
-func (s *PostgresStore) TransitionStatuses(ctx context.Context) error {
+// TransitionStatuses updates statuses based on current time. It is a
+// maintenance job over the windows of all tenants.
+func (s *PostgresStore) TransitionStatuses(ctx context.Context) ([]StatusTransition, error) {
 	now := time.Now()
+	transitions := newStatusTransitions()
 
 	// Transition scheduled -> active
-	_, err := s.db.ExecContext(ctx, `
+	result, err := s.db.ExecContext(ctx, `
 		UPDATE maintenance_windows
 		SET status = 'active', updated_at = $1
-		WHERE status = 'scheduled' AND start_time <= $1
+		WHERE status = 'scheduled' AND start_time <= $1 AND recurrence IS NULL
 	`, now)
 	if err != nil {
-		return fmt.Errorf("transition scheduled to active: %w", err)
+		return nil, fmt.Errorf("transition scheduled to active: %w", err)
 	}
+	started, _ := result.RowsAffected()
 
 	// Transition active -> completed
-	_, err = s.db.ExecContext(ctx, `
+	result, err = s.db.ExecContext(ctx, `
 		UPDATE maintenance_windows
 		SET status = 'completed', updated_at = $1
-		WHERE status = 'active' AND end_time <= $1
+		WHERE status = 'active' AND end_time <= $1 AND recurrence IS NULL
 	`, now)
 	if err != nil {
-		return fmt.Errorf("transition active to completed: %w", err)
+		return nil, fmt.Errorf("transition active to completed: %w", err)
+	}
+	completed, _ := result.RowsAffected()
+
+	addTransition(transitions, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, started)
+	addTransition(transitions, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS, routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED, completed)
+
+	if err := s.transitionRecurring(ctx, now, transitions); err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// transitionRecurring moves scheduled and active recurring windows to their
+// status at now, counting the windows moved in transitions.
+func (s *PostgresStore) transitionRecurring(ctx context.Context, now time.Time, transitions []StatusTransition) error {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, start_time, end_time, status, recurrence
+		FROM maintenance_windows
+		WHERE recurrence IS NOT NULL AND status IN ('scheduled', 'active')
+	`)
+	if err != nil {
+		return fmt.Errorf("query recurring maintenance windows: %w", err)
+	}
+
+	var windows []*routingv1.MaintenanceWindow
+	for rows.Next() {
+		var startTime, endTime time.Time
+		var status string
+		var recurrenceJSON []byte
+		window := &routingv1.MaintenanceWindow{Recurrence: &routingv1.RecurrenceRule{}}
+		if err := rows.Scan(&window.Id, &startTime, &endTime, &status, &recurrenceJSON); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("scan recurring maintenance window: %w", err)
+		}
+		if err := protojson.Unmarshal(recurrenceJSON, window.Recurrence); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("unmarshal recurrence: %w", err)
+		}
+		window.StartTime = timestamppb.New(startTime)
+		window.EndTime = timestamppb.New(endTime)
+		window.Status = parseStatus(status)
+		windows = append(windows, window)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
 	}
 
+	for _, window := range windows {
+		status, err := recurringStatus(window, now)
+		if err != nil {
+			return fmt.Errorf("expand maintenance window %s: %w", window.Id, err)
+		}
+		if status == window.Status {
+			continue
+		}
+
+		// The status guard skips windows updated since they were read
+		result, err := s.db.ExecContext(ctx, `
+			UPDATE maintenance_windows SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4
+		`, statusToString(status), now, window.Id, statusToString(window.Status))
+		if err != nil {
+			return fmt.Errorf("transition recurring maintenance window %s: %w", window.Id, err)
+		}
+		moved, _ := result.RowsAffected()
+		addTransition(transitions, window.Status, status, moved)
+	}
 	return nil
 }
 
@@ -449,15 +627,16 @@ func (s *PostgresStore) scanWindow(rows *sql.Rows) (*routingv1.MaintenanceWindow
 	window := &routingv1.MaintenanceWindow{}
 
 	var startTime, endTime, createdAt, updatedAt time.Time
+	var approvedAt sql.NullTime
 	var description, status, action sql.NullString
-	var scopeJSON []byte
+	var scopeJSON, labelsJSON, recurrenceJSON []byte
 	var ticketID, ticketURL, createdBy, approvedBy sql.NullString
 
 	if err := rows.Scan(
 		&window.Id, &window.Name, &description,
 		&startTime, &endTime,
-		&status, &action, &scopeJSON,
-		&ticketID, &ticketURL, &createdBy, &approvedBy,
+		&status, &action, &scopeJSON, &labelsJSON, &recurrenceJSON,
+		&ticketID, &ticketURL, &createdBy, &approvedBy, &approvedAt, &window.RequiresApproval,
 		&createdAt, &updatedAt,
 	); err != nil {
 		return nil, err
@@ -471,6 +650,10 @@ func (s *PostgresStore) scanWindow(rows *sql.Rows) (*routingv1.MaintenanceWindow
 	window.ChangeTicketId = ticketID.String
 	window.CreatedBy = createdBy.String
 	window.CreatedAt = timestamppb.New(createdAt)
+	window.ApprovedBy = approvedBy.String
+	if approvedAt.Valid {
+		window.ApprovedAt = timestamppb.New(approvedAt.Time)
+	}
 
 	// Parse scope
 	if scopeJSON != nil {
@@ -479,6 +662,16 @@ func (s *PostgresStore) scanWindow(rows *sql.Rows) (*routingv1.MaintenanceWindow
 			window.AffectedSites = scope.Sites
 			window.AffectedServices = scope.Services
 			window.AffectedLabels = scopeLabelsToStrings(scope.Labels)
+			window.AffectedLabelPatterns = scopeLabelsToStrings(scope.LabelRegex)
+		}
+	}
+	if labelsJSON != nil {
+		_ = json.Unmarshal(labelsJSON, &window.Labels)
+	}
+	if recurrenceJSON != nil {
+		window.Recurrence = &routingv1.RecurrenceRule{}
+		if err := protojson.Unmarshal(recurrenceJSON, window.Recurrence); err != nil {
+			return nil, fmt.Errorf("unmarshal recurrence: %w", err)
 		}
 	}
 
@@ -487,6 +680,61 @@ func (s *PostgresStore) scanWindow(rows *sql.Rows) (*routingv1.MaintenanceWindow
 
 // Helper functions
 
+// prepareWindow validates a new window and sets its ID, creation time, initial
+// status and default action.
+func prepareWindow(window *routingv1.MaintenanceWindow, now time.Time) error {
+	if window.StartTime == nil || window.EndTime == nil {
+		return fmt.Errorf("%w: start_time and end_time are required", ErrInvalidWindow)
+	}
+
+	if window.EndTime.AsTime().Before(window.StartTime.AsTime()) {
+		return fmt.Errorf("%w: end_time must be after start_time", ErrInvalidWindow)
+	}
+
+	if err := validateScope(window); err != nil {
+		return err
+	}
+
+	// Generate ID if not provided
+	if window.Id == "" {
+		window.Id = uuid.New().String()
+	}
+
+	window.CreatedAt = timestamppb.New(now)
+
+	// Approval is only granted through ApproveMaintenanceWindow
+	window.ApprovedBy = ""
+	window.ApprovedAt = nil
+
+	// Determine initial status
+	if window.RequiresApproval {
+		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL
+	} else if now.After(window.EndTime.AsTime()) {
+		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+	} else if now.After(window.StartTime.AsTime()) {
+		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
+	} else {
+		window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+	}
+
+	// Default action
+	if window.Action == routingv1.MaintenanceAction_MAINTENANCE_ACTION_UNSPECIFIED {
+		window.Action = routingv1.MaintenanceAction_MAINTENANCE_ACTION_ANNOTATE
+	}
+
+	return nil
+}
+
+// validateScope checks the recurrence rule and label patterns of a window.
+func validateScope(window *routingv1.MaintenanceWindow) error {
+	if window.Recurrence != nil {
+		if err := validateRecurrence(window.Recurrence); err != nil {
+			return err
+		}
+	}
+	return validateLabelPatterns(window)
+}
+
 func buildScopeJSON(window *routingv1.MaintenanceWindow) Scope {
 	scope := Scope{
 		Sites:    window.AffectedSites,
@@ -502,9 +750,32 @@ func buildScopeJSON(window *routingv1.MaintenanceWindow) Scope {
 		}
 	}
 
+	// affected_label_patterns are "key=regex" and stored as labelRegex
+	for _, pattern := range window.AffectedLabelPatterns {
+		key, expr := parseLabelMatcher(pattern)
+		if key != "" {
+			if scope.LabelRegex == nil {
+				scope.LabelRegex = make(map[string]string)
+			}
+			scope.LabelRegex[key] = expr
+		}
+	}
+
 	return scope
 }
 
+// marshalLabels encodes window metadata labels for the labels column.
+func marshalLabels(labels map[string]string) ([]byte, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsJSON, err := json.Marshal(labels)
+	if err != nil {
+		return nil, fmt.Errorf("marshal labels: %w", err)
+	}
+	return labelsJSON, nil
+}
+
 func scopeLabelsToStrings(labels map[string]string) []string {
 	var result []string
 	for k, v := range labels {
@@ -532,6 +803,8 @@ func statusToString(status routingv1.MaintenanceStatus) string {
 		return "completed"
 	case routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED:
 		return "cancelled"
+	case routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL:
+		return "pending_approval"
 	default:
 		return "scheduled"
 	}
@@ -547,6 +820,8 @@ func parseStatus(s string) routingv1.MaintenanceStatus {
 		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
 	case "cancelled":
 		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED
+	case "pending_approval":
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL
 	default:
 		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
 	}
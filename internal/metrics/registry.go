@@ -0,0 +1,151 @@
+// Package metrics exposes alert and routing statistics to Prometheus.
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Registry holds the Prometheus collectors for alert ingestion and routing.
+// A nil *Registry is valid and records nothing, so components can take one as
+// an optional dependency.
+type Registry struct {
+	// alertsReceived is exposed as the alerts_received_total counter, labelled
+	// by source and status.
+	alertsReceived *prometheus.CounterVec
+	// alertsActive is exposed as the alerts_active gauge.
+	alertsActive prometheus.Gauge
+	// rulesEvaluated is exposed as the routing_rules_evaluated_total counter,
+	// labelled by matched.
+	rulesEvaluated *prometheus.CounterVec
+	// actionDuration is exposed as the routing_action_duration_seconds
+	// histogram, labelled by action_type.
+	actionDuration *prometheus.HistogramVec
+	// webhookDuration is exposed as the webhook_request_duration_seconds
+	// histogram, labelled by source and status_code.
+	webhookDuration *prometheus.HistogramVec
+	// webhookRateLimited is exposed as the webhook_rate_limited_total counter,
+	// labelled by integration_key.
+	webhookRateLimited *prometheus.CounterVec
+	// actionRetries is exposed as the action_retry_total counter, labelled by
+	// action_type and attempt.
+	actionRetries *prometheus.CounterVec
+	// actionDeadLetterExhausted is exposed as the action_dlq_exhausted_total counter.
+	actionDeadLetterExhausted prometheus.Counter
+}
+
+// NewRegistry creates the collectors and registers them with reg. Pass
+// prometheus.DefaultRegisterer to serve them from promhttp.Handler().
+func NewRegistry(reg prometheus.Registerer) *Registry {
+	r := &Registry{
+		alertsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alerts_received_total",
+			Help: "Alerts received by webhooks, by source and resulting status.",
+		}, []string{"source", "status"}),
+		alertsActive: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alerts_active",
+			Help: "Alerts currently triggered or acknowledged.",
+		}),
+		rulesEvaluated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "routing_rules_evaluated_total",
+			Help: "Routing rules evaluated against alerts, by whether they matched.",
+		}, []string{"matched"}),
+		actionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "routing_action_duration_seconds",
+			Help:    "Duration of routing action executions, by action type.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"action_type"}),
+		webhookDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "webhook_request_duration_seconds",
+			Help:    "Duration of webhook requests, by source and HTTP status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"source", "status_code"}),
+		webhookRateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "webhook_rate_limited_total",
+			Help: "Webhook requests rejected by the rate limit of their integration key.",
+		}, []string{"integration_key"}),
+		actionRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "action_retry_total",
+			Help: "Retries of dead-lettered routing actions, by action type and attempt.",
+		}, []string{"action_type", "attempt"}),
+		actionDeadLetterExhausted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "action_dlq_exhausted_total",
+			Help: "Dead-lettered routing actions whose retries were exhausted.",
+		}),
+	}
+
+	reg.MustRegister(r.alertsReceived, r.alertsActive, r.rulesEvaluated, r.actionDuration, r.webhookDuration,
+		r.webhookRateLimited, r.actionRetries, r.actionDeadLetterExhausted)
+	return r
+}
+
+// RecordAlertReceived counts an alert received from source with the status it
+// was stored with.
+func (r *Registry) RecordAlertReceived(source, status string) {
+	if r == nil {
+		return
+	}
+	r.alertsReceived.WithLabelValues(source, status).Inc()
+}
+
+// SetAlertsActive sets the number of triggered or acknowledged alerts.
+func (r *Registry) SetAlertsActive(n int) {
+	if r == nil {
+		return
+	}
+	r.alertsActive.Set(float64(n))
+}
+
+// RecordRuleEvaluated counts a routing rule evaluated against an alert.
+func (r *Registry) RecordRuleEvaluated(matched bool) {
+	if r == nil {
+		return
+	}
+	r.rulesEvaluated.WithLabelValues(strconv.FormatBool(matched)).Inc()
+}
+
+// ObserveRoutingAction records how long a routing action took to execute.
+func (r *Registry) ObserveRoutingAction(actionType string, duration time.Duration) {
+	if r == nil {
+		return
+	}
+	r.actionDuration.WithLabelValues(actionType).Observe(duration.Seconds())
+}
+
+// ObserveWebhookRequest records how long a webhook request from source took to
+// serve and the HTTP status code it was answered with.
+func (r *Registry) ObserveWebhookRequest(source string, statusCode int, duration time.Duration) {
+	if r == nil {
+		return
+	}
+	r.webhookDuration.WithLabelValues(source, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+}
+
+// RecordWebhookRateLimited counts a webhook request rejected by the rate limit
+// of integrationKey.
+func (r *Registry) RecordWebhookRateLimited(integrationKey string) {
+	if r == nil {
+		return
+	}
+	r.webhookRateLimited.WithLabelValues(integrationKey).Inc()
+}
+
+// RecordActionRetry counts a retry of a dead-lettered action. attempt counts
+// the executions of the action including the retry.
+func (r *Registry) RecordActionRetry(actionType string, attempt int) {
+	if r == nil {
+		return
+	}
+	r.actionRetries.WithLabelValues(actionType, strconv.Itoa(attempt)).Inc()
+}
+
+// RecordActionDeadLetterExhausted counts a dead-lettered action whose retries
+// were exhausted.
+func (r *Registry) RecordActionDeadLetterExhausted() {
+	if r == nil {
+		return
+	}
+	r.actionDeadLetterExhausted.Inc()
+}
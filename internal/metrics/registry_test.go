@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRegistry_Counters(t *testing.T) {
+	r := NewRegistry(prometheus.NewRegistry())
+
+	r.RecordAlertReceived("generic", "triggered")
+	r.RecordAlertReceived("generic", "triggered")
+	r.RecordAlertReceived("grafana", "resolved")
+	r.SetAlertsActive(3)
+	r.RecordRuleEvaluated(true)
+	r.RecordRuleEvaluated(false)
+	r.RecordRuleEvaluated(false)
+
+	if got := testutil.ToFloat64(r.alertsReceived.WithLabelValues("generic", "triggered")); got != 2 {
+		t.Errorf("alerts_received_total{generic,triggered} = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(r.alertsReceived.WithLabelValues("grafana", "resolved")); got != 1 {
+		t.Errorf("alerts_received_total{grafana,resolved} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(r.alertsActive); got != 3 {
+		t.Errorf("alerts_active = %v, want 3", got)
+	}
+	if got := testutil.ToFloat64(r.rulesEvaluated.WithLabelValues("false")); got != 2 {
+		t.Errorf("routing_rules_evaluated_total{matched=false} = %v, want 2", got)
+	}
+	r.RecordWebhookRateLimited("key-1")
+	if got := testutil.ToFloat64(r.webhookRateLimited.WithLabelValues("key-1")); got != 1 {
+		t.Errorf("webhook_rate_limited_total{key-1} = %v, want 1", got)
+	}
+	r.RecordActionRetry("ACTION_TYPE_NOTIFY_TEAM", 2)
+	r.RecordActionRetry("ACTION_TYPE_NOTIFY_TEAM", 2)
+	r.RecordActionDeadLetterExhausted()
+	if got := testutil.ToFloat64(r.actionRetries.WithLabelValues("ACTION_TYPE_NOTIFY_TEAM", "2")); got != 2 {
+		t.Errorf("action_retry_total{ACTION_TYPE_NOTIFY_TEAM,2} = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(r.actionDeadLetterExhausted); got != 1 {
+		t.Errorf("action_dlq_exhausted_total = %v, want 1", got)
+	}
+}
+
+func TestRegistry_Histograms(t *testing.T) {
+	r := NewRegistry(prometheus.NewRegistry())
+
+	r.ObserveRoutingAction("ACTION_TYPE_NOTIFY_TEAM", 20*time.Millisecond)
+	r.ObserveWebhookRequest("generic", 200, 30*time.Millisecond)
+	r.ObserveWebhookRequest("generic", 401, time.Millisecond)
+
+	if got := testutil.CollectAndCount(r.webhookDuration); got != 2 {
+		t.Errorf("webhook_request_duration_seconds series = %d, want 2", got)
+	}
+	if got := testutil.CollectAndCount(r.actionDuration); got != 1 {
+		t.Errorf("routing_action_duration_seconds series = %d, want 1", got)
+	}
+}
+
+func TestRegistry_NilIsNoop(t *testing.T) {
+	var r *Registry
+
+	r.RecordAlertReceived("generic", "triggered")
+	r.SetAlertsActive(1)
+	r.RecordRuleEvaluated(true)
+	r.ObserveRoutingAction("ACTION_TYPE_NOTIFY_TEAM", time.Second)
+	r.ObserveWebhookRequest("generic", 200, time.Second)
+	r.RecordWebhookRateLimited("key-1")
+	r.RecordActionRetry("ACTION_TYPE_NOTIFY_TEAM", 2)
+	r.RecordActionDeadLetterExhausted()
+}
@@ -0,0 +1,252 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// MaxDiscordTitleLength is the maximum length of a Discord embed title.
+const MaxDiscordTitleLength = 256
+
+// Discord embed colors.
+const (
+	DiscordColorCritical = 0xED4245 // red
+	DiscordColorHigh     = 0xE67E22 // orange
+	DiscordColorMedium   = 0xFEE75C // yellow
+	DiscordColorResolved = 0x57F287 // green
+	DiscordColorDefault  = 0x95A5A6 // grey
+)
+
+var (
+	// ErrDiscordWebhookURLMissing is returned when a Discord target has no webhook URL.
+	ErrDiscordWebhookURLMissing = errors.New("discord webhook url is required")
+	// ErrDiscordRateLimited is returned when Discord keeps rejecting messages with HTTP 429.
+	ErrDiscordRateLimited = errors.New("discord rate limit exceeded")
+)
+
+// DiscordConfig holds configuration for the Discord notifier.
+type DiscordConfig struct {
+	// AlertURLBase is the base URL of the alert UI. Embeds link to
+	// AlertURLBase/alerts/<id>; empty omits the link.
+	AlertURLBase string
+	// MaxRetries is the maximum number of retries when Discord returns HTTP 429.
+	MaxRetries int
+	// RetryDelay is the base delay of the exponential backoff between retries
+	// when Discord does not say how long to wait.
+	RetryDelay time.Duration
+	// Timeout is the HTTP client timeout.
+	Timeout time.Duration
+}
+
+// DefaultDiscordConfig returns the default Discord notifier configuration.
+func DefaultDiscordConfig() DiscordConfig {
+	return DiscordConfig{
+		MaxRetries: 3,
+		RetryDelay: time.Second,
+		Timeout:    10 * time.Second,
+	}
+}
+
+// DiscordNotifier posts alert notifications to Discord channel webhooks.
+// Webhook URLs come from each notification target, so one notifier serves
+// every channel.
+type DiscordNotifier struct {
+	config  DiscordConfig
+	client  *http.Client
+	logger  zerolog.Logger
+	metrics *Metrics
+}
+
+// NewDiscordNotifier creates a new Discord notifier.
+func NewDiscordNotifier(config DiscordConfig, logger zerolog.Logger, metrics *Metrics) *DiscordNotifier {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &DiscordNotifier{
+		config:  config,
+		client:  &http.Client{Timeout: config.Timeout},
+		logger:  logger.With().Str("component", "discord_notifier").Logger(),
+		metrics: metrics,
+	}
+}
+
+// Metrics returns the metrics recorder for this notifier.
+func (n *DiscordNotifier) Metrics() *Metrics {
+	return n.metrics
+}
+
+// discordMessage is a Discord webhook execute request.
+type discordMessage struct {
+	Username  string         `json:"username,omitempty"`
+	AvatarURL string         `json:"avatar_url,omitempty"`
+	Embeds    []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed is a rich embed of a Discord message.
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// NotifyChannel posts the alert to a Discord notification target. The embed is
+// built from the alert, so templateID is not used.
+func (n *DiscordNotifier) NotifyChannel(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error {
+	if target.GetChannel() != routingv1.ChannelType_CHANNEL_TYPE_DISCORD {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, target.GetChannel())
+	}
+	return n.SendDiscord(ctx, target.GetDiscord(), alert)
+}
+
+// SendDiscord posts the alert as an embed to the target's webhook.
+func (n *DiscordNotifier) SendDiscord(ctx context.Context, target *routingv1.DiscordTarget, alert *routingv1.Alert) error {
+	err := ErrDiscordWebhookURLMissing
+	if target.GetWebhookUrl() != "" {
+		err = n.send(ctx, target.WebhookUrl, n.buildMessage(target, alert))
+	}
+	if err != nil {
+		n.metrics.RecordDiscordFailed()
+		n.logger.Error().Err(err).Str("alertId", alert.GetId()).Msg("failed to post alert to discord")
+		return err
+	}
+
+	n.metrics.RecordDiscordSent()
+	n.logger.Debug().Str("alertId", alert.GetId()).Msg("posted alert to discord")
+	return nil
+}
+
+// buildMessage converts an alert into a Discord message with a single embed.
+func (n *DiscordNotifier) buildMessage(target *routingv1.DiscordTarget, alert *routingv1.Alert) *discordMessage {
+	title := alert.Summary
+	if title == "" {
+		title = fmt.Sprintf("Alert %s", alert.Id)
+	}
+
+	severity := severityFromLabels(alert.Labels)
+	embed := discordEmbed{
+		Title:       truncateRunes(title, MaxDiscordTitleLength),
+		Description: alert.Details,
+		Color:       DiscordColor(severity, alert.Status),
+		Fields: []discordEmbedField{
+			{Name: "Severity", Value: severityName(severity), Inline: true},
+			{Name: "Status", Value: statusName(alert.Status), Inline: true},
+		},
+	}
+	if alert.ServiceId != "" {
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: "Service", Value: alert.ServiceId, Inline: true})
+	}
+	if alert.CreatedAt != nil {
+		embed.Timestamp = alert.CreatedAt.AsTime().Format(time.RFC3339)
+	}
+	if n.config.AlertURLBase != "" && alert.Id != "" {
+		link := strings.TrimSuffix(n.config.AlertURLBase, "/") + "/alerts/" + alert.Id
+		embed.URL = link
+		embed.Fields = append(embed.Fields, discordEmbedField{Name: "Alert", Value: fmt.Sprintf("[%s](%s)", alert.Id, link)})
+	}
+
+	return &discordMessage{
+		Username:  target.GetUsername(),
+		AvatarURL: target.GetAvatarUrl(),
+		Embeds:    []discordEmbed{embed},
+	}
+}
+
+// send posts a message to a webhook, retrying on HTTP 429.
+func (n *DiscordNotifier) send(ctx context.Context, webhookURL string, message *discordMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal discord message: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to build discord request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := n.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("discord request failed: %w", err)
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusTooManyRequests {
+			return fmt.Errorf("discord returned status %d", resp.StatusCode)
+		}
+		if attempt >= n.config.MaxRetries {
+			return ErrDiscordRateLimited
+		}
+
+		delay := n.retryDelay(resp.Header.Get("Retry-After"), attempt)
+		n.logger.Warn().
+			Int("attempt", attempt+1).
+			Dur("delay", delay).
+			Msg("discord rate limited, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryDelay returns the delay before the next attempt, honouring Retry-After
+// when present and backing off exponentially otherwise.
+func (n *DiscordNotifier) retryDelay(retryAfter string, attempt int) time.Duration {
+	if seconds, err := strconv.ParseFloat(retryAfter, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return n.config.RetryDelay * time.Duration(1<<attempt)
+}
+
+// DiscordColor returns the embed color of an alert: green once resolved,
+// otherwise red, orange or yellow for critical, high and medium severities.
+func DiscordColor(severity alertingv1.Severity, status routingv1.AlertStatus) int {
+	if status == routingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		return DiscordColorResolved
+	}
+
+	switch severity {
+	case alertingv1.Severity_SEVERITY_CRITICAL:
+		return DiscordColorCritical
+	case alertingv1.Severity_SEVERITY_HIGH:
+		return DiscordColorHigh
+	case alertingv1.Severity_SEVERITY_MEDIUM:
+		return DiscordColorMedium
+	default:
+		return DiscordColorDefault
+	}
+}
+
+// Ensure DiscordNotifier implements ChannelNotifier
+var _ ChannelNotifier = (*DiscordNotifier)(nil)
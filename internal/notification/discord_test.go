@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func newTestDiscordNotifier() *DiscordNotifier {
+	config := DefaultDiscordConfig()
+	config.AlertURLBase = "https://oncall.example.com/"
+	config.RetryDelay = time.Millisecond
+	return NewDiscordNotifier(config, zerolog.Nop(), NewMetrics())
+}
+
+func discordTarget(url string) *routingv1.NotificationTarget {
+	return &routingv1.NotificationTarget{
+		Channel: routingv1.ChannelType_CHANNEL_TYPE_DISCORD,
+		Discord: &routingv1.DiscordTarget{WebhookUrl: url, Username: "Alerting", AvatarUrl: "https://oncall.example.com/avatar.png"},
+	}
+}
+
+func TestDiscordNotifier_PostsEmbed(t *testing.T) {
+	var message discordMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := newTestDiscordNotifier()
+	alert := &routingv1.Alert{
+		Id:        "alert-1",
+		Summary:   "Database connection pool exhausted",
+		Details:   "All 100 connections in use",
+		ServiceId: "svc-db",
+		Status:    routingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:    map[string]string{"severity": "critical"},
+	}
+
+	require.NoError(t, notifier.NotifyChannel(context.Background(), discordTarget(server.URL), "", alert))
+
+	assert.Equal(t, "Alerting", message.Username)
+	assert.Equal(t, "https://oncall.example.com/avatar.png", message.AvatarURL)
+	require.Len(t, message.Embeds, 1)
+	embed := message.Embeds[0]
+	assert.Equal(t, "Database connection pool exhausted", embed.Title)
+	assert.Equal(t, "All 100 connections in use", embed.Description)
+	assert.Equal(t, DiscordColorCritical, embed.Color)
+	assert.Equal(t, "https://oncall.example.com/alerts/alert-1", embed.URL)
+	assert.Contains(t, embed.Fields, discordEmbedField{Name: "Severity", Value: "critical", Inline: true})
+	assert.Contains(t, embed.Fields, discordEmbedField{Name: "Alert", Value: "[alert-1](https://oncall.example.com/alerts/alert-1)"})
+	assert.Equal(t, int64(1), notifier.Metrics().DiscordSentTotal())
+}
+
+func TestDiscordNotifier_RetriesOnRateLimit(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0.001,"global":false}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := newTestDiscordNotifier()
+	require.NoError(t, notifier.NotifyChannel(context.Background(), discordTarget(server.URL), "", &routingv1.Alert{Id: "alert-1"}))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestDiscordNotifier_GivesUpWhenRateLimited(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	notifier := newTestDiscordNotifier()
+	err := notifier.NotifyChannel(context.Background(), discordTarget(server.URL), "", &routingv1.Alert{Id: "alert-1"})
+	assert.ErrorIs(t, err, ErrDiscordRateLimited)
+	assert.Equal(t, int32(DefaultDiscordConfig().MaxRetries+1), atomic.LoadInt32(&attempts))
+	assert.Equal(t, int64(1), notifier.Metrics().DiscordFailedTotal())
+}
+
+func TestDiscordNotifier_InvalidTarget(t *testing.T) {
+	notifier := newTestDiscordNotifier()
+	ctx := context.Background()
+
+	err := notifier.NotifyChannel(ctx, discordTarget(""), "", &routingv1.Alert{Id: "alert-1"})
+	assert.ErrorIs(t, err, ErrDiscordWebhookURLMissing)
+
+	err = notifier.NotifyChannel(ctx, &routingv1.NotificationTarget{Channel: routingv1.ChannelType_CHANNEL_TYPE_SLACK}, "", &routingv1.Alert{Id: "alert-1"})
+	assert.ErrorIs(t, err, ErrUnsupportedChannel)
+}
+
+func TestDiscordNotifier_RetryDelayBacksOff(t *testing.T) {
+	notifier := NewDiscordNotifier(DefaultDiscordConfig(), zerolog.Nop(), nil)
+
+	assert.Equal(t, time.Second, notifier.retryDelay("", 0))
+	assert.Equal(t, 4*time.Second, notifier.retryDelay("", 2))
+	assert.Equal(t, 1500*time.Millisecond, notifier.retryDelay("1.5", 2))
+}
+
+func TestDiscordColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity alertingv1.Severity
+		status   routingv1.AlertStatus
+		expected int
+	}{
+		{"critical", alertingv1.Severity_SEVERITY_CRITICAL, routingv1.AlertStatus_ALERT_STATUS_TRIGGERED, DiscordColorCritical},
+		{"high", alertingv1.Severity_SEVERITY_HIGH, routingv1.AlertStatus_ALERT_STATUS_TRIGGERED, DiscordColorHigh},
+		{"medium", alertingv1.Severity_SEVERITY_MEDIUM, routingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, DiscordColorMedium},
+		{"low", alertingv1.Severity_SEVERITY_LOW, routingv1.AlertStatus_ALERT_STATUS_TRIGGERED, DiscordColorDefault},
+		{"resolved critical", alertingv1.Severity_SEVERITY_CRITICAL, routingv1.AlertStatus_ALERT_STATUS_RESOLVED, DiscordColorResolved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DiscordColor(tt.severity, tt.status))
+		})
+	}
+}
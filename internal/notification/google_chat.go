@@ -0,0 +1,269 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// ErrGoogleChatWebhookURLMissing is returned when a Google Chat target has no webhook URL.
+var ErrGoogleChatWebhookURLMissing = errors.New("google chat webhook url is required")
+
+// GoogleChatConfig holds configuration for the Google Chat notifier.
+type GoogleChatConfig struct {
+	// AlertURLBase is the base URL of the alert UI. Cards get a button linking
+	// to AlertURLBase/alerts/<id>; empty omits the button.
+	AlertURLBase string
+	// Timeout is the HTTP client timeout.
+	Timeout time.Duration
+}
+
+// DefaultGoogleChatConfig returns the default Google Chat notifier configuration.
+func DefaultGoogleChatConfig() GoogleChatConfig {
+	return GoogleChatConfig{
+		Timeout: 10 * time.Second,
+	}
+}
+
+// GoogleChatNotifier posts alert cards to Google Chat space webhooks. Webhook
+// URLs come from each notification target, so one notifier serves every space.
+type GoogleChatNotifier struct {
+	config  GoogleChatConfig
+	client  *http.Client
+	logger  zerolog.Logger
+	metrics *Metrics
+}
+
+// NewGoogleChatNotifier creates a new Google Chat notifier.
+func NewGoogleChatNotifier(config GoogleChatConfig, logger zerolog.Logger, metrics *Metrics) *GoogleChatNotifier {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &GoogleChatNotifier{
+		config:  config,
+		client:  &http.Client{Timeout: config.Timeout},
+		logger:  logger.With().Str("component", "google_chat_notifier").Logger(),
+		metrics: metrics,
+	}
+}
+
+// Metrics returns the metrics recorder for this notifier.
+func (n *GoogleChatNotifier) Metrics() *Metrics {
+	return n.metrics
+}
+
+// NotifyChannel posts the alert to a Google Chat notification target. The card
+// is built from the alert, so templateID is not used.
+func (n *GoogleChatNotifier) NotifyChannel(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error {
+	if target.GetChannel() != routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, target.GetChannel())
+	}
+	return n.SendGoogleChat(ctx, target.GetGoogleChat(), alert)
+}
+
+// SendGoogleChat posts the alert as a card to the target's webhook, in the
+// cardsV2 format if the target asks for it and the legacy cards format otherwise.
+func (n *GoogleChatNotifier) SendGoogleChat(ctx context.Context, target *routingv1.GoogleChatTarget, alert *routingv1.Alert) error {
+	err := ErrGoogleChatWebhookURLMissing
+	if target.GetWebhookUrl() != "" {
+		err = n.send(ctx, target.WebhookUrl, n.buildMessage(target, alert))
+	}
+	if err != nil {
+		n.metrics.RecordGoogleChatFailed()
+		n.logger.Error().Err(err).Str("alertId", alert.GetId()).Msg("failed to post alert to google chat")
+		return err
+	}
+
+	n.metrics.RecordGoogleChatSent()
+	n.logger.Debug().Str("alertId", alert.GetId()).Msg("posted alert to google chat")
+	return nil
+}
+
+// googleChatCard holds the alert content shared by both card formats.
+type googleChatCard struct {
+	title    string
+	subtitle string
+	summary  string
+	severity string
+	labels   string
+	link     string
+}
+
+// buildMessage converts an alert into a Google Chat message.
+func (n *GoogleChatNotifier) buildMessage(target *routingv1.GoogleChatTarget, alert *routingv1.Alert) map[string]interface{} {
+	card := googleChatCard{
+		title:    alert.Summary,
+		subtitle: statusName(alert.Status),
+		summary:  alert.Details,
+		severity: severityName(severityFromLabels(alert.Labels)),
+		labels:   formatLabels(alert.Labels),
+	}
+	if card.title == "" {
+		card.title = fmt.Sprintf("Alert %s", alert.Id)
+	}
+	if card.summary == "" {
+		card.summary = card.title
+	}
+	if alert.ServiceId != "" {
+		card.subtitle += " · " + alert.ServiceId
+	}
+	if n.config.AlertURLBase != "" && alert.Id != "" {
+		card.link = strings.TrimSuffix(n.config.AlertURLBase, "/") + "/alerts/" + alert.Id
+	}
+
+	if target.GetUseCardsV2() {
+		return googleChatCardsV2(alert.Id, card)
+	}
+	return googleChatLegacyCards(card)
+}
+
+// googleChatLegacyCards renders the card in the legacy cards format.
+func googleChatLegacyCards(card googleChatCard) map[string]interface{} {
+	sections := []interface{}{
+		map[string]interface{}{
+			"header": "Summary",
+			"widgets": []interface{}{
+				map[string]interface{}{"textParagraph": map[string]interface{}{"text": card.summary}},
+			},
+		},
+		map[string]interface{}{
+			"widgets": []interface{}{
+				map[string]interface{}{"keyValue": map[string]interface{}{"topLabel": "Severity", "content": card.severity}},
+			},
+		},
+	}
+	if card.labels != "" {
+		sections = append(sections, map[string]interface{}{
+			"header": "Labels",
+			"widgets": []interface{}{
+				map[string]interface{}{"textParagraph": map[string]interface{}{"text": card.labels}},
+			},
+		})
+	}
+	if card.link != "" {
+		sections = append(sections, map[string]interface{}{
+			"widgets": []interface{}{
+				map[string]interface{}{"buttons": []interface{}{
+					map[string]interface{}{"textButton": map[string]interface{}{
+						"text":    "VIEW ALERT",
+						"onClick": map[string]interface{}{"openLink": map[string]interface{}{"url": card.link}},
+					}},
+				}},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"cards": []interface{}{
+			map[string]interface{}{
+				"header":   map[string]interface{}{"title": card.title, "subtitle": card.subtitle},
+				"sections": sections,
+			},
+		},
+	}
+}
+
+// googleChatCardsV2 renders the card in the cardsV2 format.
+func googleChatCardsV2(alertID string, card googleChatCard) map[string]interface{} {
+	sections := []interface{}{
+		map[string]interface{}{
+			"header": "Summary",
+			"widgets": []interface{}{
+				map[string]interface{}{"textParagraph": map[string]interface{}{"text": card.summary}},
+				map[string]interface{}{"decoratedText": map[string]interface{}{"topLabel": "Severity", "text": card.severity}},
+			},
+		},
+	}
+	if card.labels != "" {
+		sections = append(sections, map[string]interface{}{
+			"header":                    "Labels",
+			"collapsible":               true,
+			"uncollapsibleWidgetsCount": 0,
+			"widgets": []interface{}{
+				map[string]interface{}{"textParagraph": map[string]interface{}{"text": card.labels}},
+			},
+		})
+	}
+	if card.link != "" {
+		sections = append(sections, map[string]interface{}{
+			"widgets": []interface{}{
+				map[string]interface{}{"buttonList": map[string]interface{}{
+					"buttons": []interface{}{
+						map[string]interface{}{
+							"text":    "View alert",
+							"onClick": map[string]interface{}{"openLink": map[string]interface{}{"url": card.link}},
+						},
+					},
+				}},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"cardsV2": []interface{}{
+			map[string]interface{}{
+				"cardId": "alert-" + alertID,
+				"card": map[string]interface{}{
+					"header":   map[string]interface{}{"title": card.title, "subtitle": card.subtitle},
+					"sections": sections,
+				},
+			},
+		},
+	}
+}
+
+// send posts a message to a webhook.
+func (n *GoogleChatNotifier) send(ctx context.Context, webhookURL string, message map[string]interface{}) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal google chat message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build google chat request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("google chat request failed: %w", err)
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("google chat returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// formatLabels renders labels as sorted key=value lines.
+func formatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for i, k := range keys {
+		lines[i] = k + "=" + labels[k]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Ensure GoogleChatNotifier implements ChannelNotifier
+var _ ChannelNotifier = (*GoogleChatNotifier)(nil)
@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func googleChatAlert() *routingv1.Alert {
+	return &routingv1.Alert{
+		Id:        "alert-1",
+		Summary:   "Disk almost full on db-1",
+		Details:   "/var/lib/postgresql is 95% full",
+		ServiceId: "svc-db",
+		Status:    routingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:    map[string]string{"severity": "high", "host": "db-1"},
+	}
+}
+
+// postGoogleChat sends the alert to a test server and returns the decoded request body.
+func postGoogleChat(t *testing.T, useCardsV2 bool) map[string]interface{} {
+	t.Helper()
+	var message map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json; charset=UTF-8", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := DefaultGoogleChatConfig()
+	config.AlertURLBase = "https://oncall.example.com"
+	notifier := NewGoogleChatNotifier(config, zerolog.Nop(), nil)
+
+	target := &routingv1.NotificationTarget{
+		Channel:    routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT,
+		GoogleChat: &routingv1.GoogleChatTarget{WebhookUrl: server.URL, UseCardsV2: useCardsV2},
+	}
+	require.NoError(t, notifier.NotifyChannel(context.Background(), target, "", googleChatAlert()))
+	assert.Equal(t, int64(1), notifier.Metrics().GoogleChatSentTotal())
+	return message
+}
+
+func TestGoogleChatNotifier_LegacyCards(t *testing.T) {
+	const expected = `{
+	  "cards": [{
+	    "header": {"title": "Disk almost full on db-1", "subtitle": "triggered · svc-db"},
+	    "sections": [
+	      {"header": "Summary", "widgets": [{"textParagraph": {"text": "/var/lib/postgresql is 95% full"}}]},
+	      {"widgets": [{"keyValue": {"topLabel": "Severity", "content": "high"}}]},
+	      {"header": "Labels", "widgets": [{"textParagraph": {"text": "host=db-1\nseverity=high"}}]},
+	      {"widgets": [{"buttons": [{"textButton": {"text": "VIEW ALERT", "onClick": {"openLink": {"url": "https://oncall.example.com/alerts/alert-1"}}}}]}]}
+	    ]
+	  }]
+	}`
+
+	got, err := json.Marshal(postGoogleChat(t, false))
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, string(got))
+}
+
+func TestGoogleChatNotifier_CardsV2(t *testing.T) {
+	const expected = `{
+	  "cardsV2": [{
+	    "cardId": "alert-alert-1",
+	    "card": {
+	      "header": {"title": "Disk almost full on db-1", "subtitle": "triggered · svc-db"},
+	      "sections": [
+	        {"header": "Summary", "widgets": [
+	          {"textParagraph": {"text": "/var/lib/postgresql is 95% full"}},
+	          {"decoratedText": {"topLabel": "Severity", "text": "high"}}
+	        ]},
+	        {"header": "Labels", "collapsible": true, "uncollapsibleWidgetsCount": 0, "widgets": [{"textParagraph": {"text": "host=db-1\nseverity=high"}}]},
+	        {"widgets": [{"buttonList": {"buttons": [{"text": "View alert", "onClick": {"openLink": {"url": "https://oncall.example.com/alerts/alert-1"}}}]}}]}
+	      ]
+	    }
+	  }]
+	}`
+
+	got, err := json.Marshal(postGoogleChat(t, true))
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, string(got))
+}
+
+func TestGoogleChatNotifier_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	notifier := NewGoogleChatNotifier(DefaultGoogleChatConfig(), zerolog.Nop(), nil)
+	ctx := context.Background()
+	target := func(url string) *routingv1.NotificationTarget {
+		return &routingv1.NotificationTarget{
+			Channel:    routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT,
+			GoogleChat: &routingv1.GoogleChatTarget{WebhookUrl: url},
+		}
+	}
+
+	assert.ErrorIs(t, notifier.NotifyChannel(ctx, target(""), "", googleChatAlert()), ErrGoogleChatWebhookURLMissing)
+	assert.Error(t, notifier.NotifyChannel(ctx, target(server.URL), "", googleChatAlert()))
+	assert.Equal(t, int64(2), notifier.Metrics().GoogleChatFailedTotal())
+
+	err := notifier.NotifyChannel(ctx, &routingv1.NotificationTarget{Channel: routingv1.ChannelType_CHANNEL_TYPE_DISCORD}, "", googleChatAlert())
+	assert.ErrorIs(t, err, ErrUnsupportedChannel)
+}
@@ -0,0 +1,162 @@
+package notification
+
+import (
+	"sync"
+)
+
+// Metrics tracks notification delivery metrics.
+// Exposed as the sms_sent_total, sms_failed_total, pagerduty_events_sent_total,
+// pagerduty_events_failed_total, discord_messages_sent_total,
+// discord_messages_failed_total, google_chat_messages_sent_total and
+// google_chat_messages_failed_total counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// smsSent counts SMS messages accepted by the provider.
+	smsSent int64
+	// smsFailed counts SMS messages that could not be delivered.
+	smsFailed int64
+	// pagerDutySent counts events accepted by the PagerDuty Events API.
+	pagerDutySent int64
+	// pagerDutyFailed counts events that could not be forwarded to PagerDuty.
+	pagerDutyFailed int64
+	// discordSent counts messages accepted by Discord webhooks.
+	discordSent int64
+	// discordFailed counts messages that could not be posted to Discord.
+	discordFailed int64
+	// googleChatSent counts messages accepted by Google Chat webhooks.
+	googleChatSent int64
+	// googleChatFailed counts messages that could not be posted to Google Chat.
+	googleChatFailed int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordSMSSent increments the SMS sent counter.
+func (m *Metrics) RecordSMSSent() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.smsSent++
+}
+
+// RecordSMSFailed increments the SMS failed counter.
+func (m *Metrics) RecordSMSFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.smsFailed++
+}
+
+// SMSSentTotal returns the number of SMS messages sent.
+func (m *Metrics) SMSSentTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.smsSent
+}
+
+// SMSFailedTotal returns the number of SMS messages that failed.
+func (m *Metrics) SMSFailedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.smsFailed
+}
+
+// RecordPagerDutySent increments the PagerDuty events sent counter.
+func (m *Metrics) RecordPagerDutySent() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pagerDutySent++
+}
+
+// RecordPagerDutyFailed increments the PagerDuty events failed counter.
+func (m *Metrics) RecordPagerDutyFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pagerDutyFailed++
+}
+
+// PagerDutySentTotal returns the number of events forwarded to PagerDuty.
+func (m *Metrics) PagerDutySentTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pagerDutySent
+}
+
+// PagerDutyFailedTotal returns the number of events that failed to forward to PagerDuty.
+func (m *Metrics) PagerDutyFailedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.pagerDutyFailed
+}
+
+// RecordDiscordSent increments the Discord messages sent counter.
+func (m *Metrics) RecordDiscordSent() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.discordSent++
+}
+
+// RecordDiscordFailed increments the Discord messages failed counter.
+func (m *Metrics) RecordDiscordFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.discordFailed++
+}
+
+// DiscordSentTotal returns the number of messages posted to Discord.
+func (m *Metrics) DiscordSentTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.discordSent
+}
+
+// DiscordFailedTotal returns the number of messages that failed to post to Discord.
+func (m *Metrics) DiscordFailedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.discordFailed
+}
+
+// RecordGoogleChatSent increments the Google Chat messages sent counter.
+func (m *Metrics) RecordGoogleChatSent() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.googleChatSent++
+}
+
+// RecordGoogleChatFailed increments the Google Chat messages failed counter.
+func (m *Metrics) RecordGoogleChatFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.googleChatFailed++
+}
+
+// GoogleChatSentTotal returns the number of messages posted to Google Chat.
+func (m *Metrics) GoogleChatSentTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.googleChatSent
+}
+
+// GoogleChatFailedTotal returns the number of messages that failed to post to Google Chat.
+func (m *Metrics) GoogleChatFailedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.googleChatFailed
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.smsSent = 0
+	m.smsFailed = 0
+	m.pagerDutySent = 0
+	m.pagerDutyFailed = 0
+	m.discordSent = 0
+	m.discordFailed = 0
+	m.googleChatSent = 0
+	m.googleChatFailed = 0
+}
@@ -0,0 +1,82 @@
+// Package notification provides channel-specific notification delivery.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+var (
+	// ErrUnsupportedChannel is returned when a notifier is asked to deliver on a channel it does not handle.
+	ErrUnsupportedChannel = errors.New("unsupported notification channel")
+	// ErrNoContactAddress is returned when a user has no address for the requested channel.
+	ErrNoContactAddress = errors.New("user has no contact address for channel")
+)
+
+// UserNotifier delivers notifications to individual users.
+// It matches the NotifyUser method of action.NotificationService.
+type UserNotifier interface {
+	// NotifyUser sends a notification to a specific user.
+	NotifyUser(ctx context.Context, userID string, templateID string, channelOverride routingv1.ChannelType, alert *routingv1.Alert) error
+}
+
+// ChannelNotifier delivers notifications to channels.
+// It matches the NotifyChannel method of action.NotificationService.
+type ChannelNotifier interface {
+	// NotifyChannel sends a notification to a channel.
+	NotifyChannel(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error
+}
+
+// TemplateRenderer renders a notification template for a channel.
+type TemplateRenderer interface {
+	// Render returns the rendered message body for the alert.
+	Render(ctx context.Context, templateID string, channel routingv1.ChannelType, alert *routingv1.Alert) (string, error)
+}
+
+// DefaultBody returns a plain-text message body used when no template renderer is configured.
+func DefaultBody(alert *routingv1.Alert) string {
+	if alert == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	if severity := alert.Labels["severity"]; severity != "" {
+		sb.WriteString(fmt.Sprintf("[%s] ", strings.ToUpper(severity)))
+	}
+	sb.WriteString(alert.Summary)
+	if alert.Details != "" {
+		sb.WriteString(" - ")
+		sb.WriteString(alert.Details)
+	}
+	return sb.String()
+}
+
+// truncateRunes shortens s to at most n characters without splitting multi-byte runes.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+// severityName returns the lower-case name of a severity, e.g. "critical".
+func severityName(severity alertingv1.Severity) string {
+	if severity == alertingv1.Severity_SEVERITY_UNSPECIFIED {
+		return "unknown"
+	}
+	return strings.ToLower(strings.TrimPrefix(severity.String(), "SEVERITY_"))
+}
+
+// statusName returns the lower-case name of an alert status, e.g. "resolved".
+func statusName(status routingv1.AlertStatus) string {
+	if status == routingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED {
+		return "unknown"
+	}
+	return strings.ToLower(strings.TrimPrefix(status.String(), "ALERT_STATUS_"))
+}
@@ -0,0 +1,312 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// DefaultPagerDutyEventsURL is the PagerDuty Events API v2 enqueue endpoint.
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// MaxPagerDutySummaryLength is the maximum length of a PagerDuty event summary.
+const MaxPagerDutySummaryLength = 1024
+
+// PagerDuty Events API v2 event actions.
+const (
+	PagerDutyEventTrigger     = "trigger"
+	PagerDutyEventAcknowledge = "acknowledge"
+	PagerDutyEventResolve     = "resolve"
+)
+
+var (
+	// ErrPagerDutyRoutingKeyMissing is returned when a forward action has no routing key.
+	ErrPagerDutyRoutingKeyMissing = errors.New("pagerduty routing key is required")
+	// ErrPagerDutyRateLimited is returned when PagerDuty keeps rejecting events with HTTP 429.
+	ErrPagerDutyRateLimited = errors.New("pagerduty rate limit exceeded")
+)
+
+// PagerDutyConfig holds configuration for the PagerDuty forwarder.
+type PagerDutyConfig struct {
+	// EventsURL is the Events API v2 enqueue endpoint.
+	EventsURL string
+	// Source is the event source used when the alert has no instance label or service.
+	Source string
+	// MaxRetries is the maximum number of retries when PagerDuty returns HTTP 429.
+	MaxRetries int
+	// RetryDelay is the base delay between retries when no Retry-After header is returned.
+	RetryDelay time.Duration
+	// Timeout is the HTTP client timeout.
+	Timeout time.Duration
+}
+
+// DefaultPagerDutyConfig returns the default PagerDuty forwarder configuration.
+func DefaultPagerDutyConfig() PagerDutyConfig {
+	return PagerDutyConfig{
+		EventsURL:  DefaultPagerDutyEventsURL,
+		Source:     "alerting-system",
+		MaxRetries: 3,
+		RetryDelay: time.Second,
+		Timeout:    10 * time.Second,
+	}
+}
+
+// PagerDutyForwarder forwards alerts to customers' PagerDuty services through
+// the Events API v2. Routing keys come from each forward action, so one
+// forwarder serves every customer.
+type PagerDutyForwarder struct {
+	config  PagerDutyConfig
+	client  *http.Client
+	logger  zerolog.Logger
+	metrics *Metrics
+}
+
+// NewPagerDutyForwarder creates a new PagerDuty forwarder.
+func NewPagerDutyForwarder(config PagerDutyConfig, logger zerolog.Logger, metrics *Metrics) *PagerDutyForwarder {
+	if config.EventsURL == "" {
+		config.EventsURL = DefaultPagerDutyEventsURL
+	}
+	if config.Source == "" {
+		config.Source = DefaultPagerDutyConfig().Source
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &PagerDutyForwarder{
+		config:  config,
+		client:  &http.Client{Timeout: config.Timeout},
+		logger:  logger.With().Str("component", "pagerduty_forwarder").Logger(),
+		metrics: metrics,
+	}
+}
+
+// Metrics returns the metrics recorder for this forwarder.
+func (f *PagerDutyForwarder) Metrics() *Metrics {
+	return f.metrics
+}
+
+// pagerDutyEvent is a PagerDuty Events API v2 event.
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key,omitempty"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+// pagerDutyPayload is the payload of a trigger event.
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp,omitempty"`
+	Component     string            `json:"component,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// pagerDutyErrorResponse is the error body returned by the Events API.
+type pagerDutyErrorResponse struct {
+	Status  string   `json:"status"`
+	Message string   `json:"message"`
+	Errors  []string `json:"errors"`
+}
+
+// ForwardPagerDuty sends the alert to PagerDuty as a trigger event, or as an
+// acknowledge or resolve event once the alert's status has changed. Events for
+// the same alert share a dedup key so PagerDuty updates a single incident.
+func (f *PagerDutyForwarder) ForwardPagerDuty(ctx context.Context, action *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error {
+	event, err := f.buildEvent(action, alert)
+	if err == nil {
+		err = f.send(ctx, event)
+	}
+	if err != nil {
+		f.metrics.RecordPagerDutyFailed()
+		f.logger.Error().Err(err).Str("alertId", alert.GetId()).Msg("failed to forward alert to pagerduty")
+		return err
+	}
+
+	f.metrics.RecordPagerDutySent()
+	f.logger.Debug().
+		Str("alertId", alert.GetId()).
+		Str("eventAction", event.EventAction).
+		Str("dedupKey", event.DedupKey).
+		Msg("forwarded alert to pagerduty")
+	return nil
+}
+
+// buildEvent converts an alert into a PagerDuty event.
+func (f *PagerDutyForwarder) buildEvent(action *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) (*pagerDutyEvent, error) {
+	if action.GetRoutingKey() == "" {
+		return nil, ErrPagerDutyRoutingKeyMissing
+	}
+
+	event := &pagerDutyEvent{
+		RoutingKey:  action.RoutingKey,
+		EventAction: pagerDutyEventAction(alert.Status),
+		DedupKey:    pagerDutyDedupKey(action, alert),
+	}
+	if event.EventAction != PagerDutyEventTrigger {
+		return event, nil
+	}
+
+	summary := alert.Summary
+	if summary == "" {
+		summary = fmt.Sprintf("Alert %s", alert.Id)
+	}
+
+	payload := &pagerDutyPayload{
+		Summary:   truncateRunes(summary, MaxPagerDutySummaryLength),
+		Source:    f.eventSource(alert),
+		Severity:  PagerDutySeverity(severityFromLabels(alert.Labels)),
+		Component: alert.ServiceId,
+	}
+	if alert.CreatedAt != nil {
+		payload.Timestamp = alert.CreatedAt.AsTime().Format(time.RFC3339)
+	}
+	for _, label := range action.PayloadCustomDetailsLabels {
+		value, ok := alert.Labels[label]
+		if !ok {
+			continue
+		}
+		if payload.CustomDetails == nil {
+			payload.CustomDetails = make(map[string]string)
+		}
+		payload.CustomDetails[label] = value
+	}
+	event.Payload = payload
+
+	return event, nil
+}
+
+// eventSource returns the affected system for the event payload.
+func (f *PagerDutyForwarder) eventSource(alert *routingv1.Alert) string {
+	if instance := alert.Labels["instance"]; instance != "" {
+		return instance
+	}
+	if alert.ServiceId != "" {
+		return alert.ServiceId
+	}
+	return f.config.Source
+}
+
+// send posts an event to the Events API, retrying on HTTP 429.
+func (f *PagerDutyForwarder) send(ctx context.Context, event *pagerDutyEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pagerduty event: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.config.EventsURL, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to build pagerduty request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("pagerduty request failed: %w", err)
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if attempt >= f.config.MaxRetries {
+				return ErrPagerDutyRateLimited
+			}
+
+			delay := f.retryDelay(resp.Header.Get("Retry-After"), attempt)
+			f.logger.Warn().
+				Int("attempt", attempt+1).
+				Dur("delay", delay).
+				Msg("pagerduty rate limited, retrying")
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+
+		var apiErr pagerDutyErrorResponse
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			message := apiErr.Message
+			if len(apiErr.Errors) > 0 {
+				message += ": " + strings.Join(apiErr.Errors, "; ")
+			}
+			return fmt.Errorf("pagerduty returned status %d: %s", resp.StatusCode, message)
+		}
+		return fmt.Errorf("pagerduty returned status %d", resp.StatusCode)
+	}
+}
+
+// retryDelay returns the delay before the next attempt, honouring Retry-After when present.
+func (f *PagerDutyForwarder) retryDelay(retryAfter string, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return f.config.RetryDelay * time.Duration(1<<attempt)
+}
+
+// PagerDutySeverity maps an alert severity to a PagerDuty event severity.
+func PagerDutySeverity(severity alertingv1.Severity) string {
+	switch severity {
+	case alertingv1.Severity_SEVERITY_CRITICAL:
+		return "critical"
+	case alertingv1.Severity_SEVERITY_HIGH:
+		return "error"
+	case alertingv1.Severity_SEVERITY_MEDIUM, alertingv1.Severity_SEVERITY_LOW:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// severityFromLabels reads the severity label set by routing.AlertFromStore.
+func severityFromLabels(labels map[string]string) alertingv1.Severity {
+	name := "SEVERITY_" + strings.ToUpper(labels["severity"])
+	return alertingv1.Severity(alertingv1.Severity_value[name])
+}
+
+// pagerDutyEventAction returns the event action for an alert status.
+func pagerDutyEventAction(status routingv1.AlertStatus) string {
+	switch status {
+	case routingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		return PagerDutyEventResolve
+	case routingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		return PagerDutyEventAcknowledge
+	default:
+		return PagerDutyEventTrigger
+	}
+}
+
+// pagerDutyDedupKey returns the configured label's value, falling back to the
+// alert fingerprint and then its ID.
+func pagerDutyDedupKey(action *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) string {
+	if action.DedupKeyLabel != "" {
+		if key := alert.Labels[action.DedupKeyLabel]; key != "" {
+			return key
+		}
+	}
+	if alert.Fingerprint != "" {
+		return alert.Fingerprint
+	}
+	return alert.Id
+}
@@ -0,0 +1,188 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// pagerDutyServer records the events posted to a mock Events API.
+type pagerDutyServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	events []pagerDutyEvent
+}
+
+func newPagerDutyServer(t *testing.T) *pagerDutyServer {
+	t.Helper()
+	s := &pagerDutyServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/enqueue", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var event pagerDutyEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		s.mu.Lock()
+		s.events = append(s.events, event)
+		s.mu.Unlock()
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"` + event.DedupKey + `"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *pagerDutyServer) Events() []pagerDutyEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]pagerDutyEvent(nil), s.events...)
+}
+
+func newTestPagerDutyForwarder(serverURL string) *PagerDutyForwarder {
+	config := DefaultPagerDutyConfig()
+	config.EventsURL = serverURL + "/v2/enqueue"
+	config.RetryDelay = time.Millisecond
+	return NewPagerDutyForwarder(config, zerolog.Nop(), NewMetrics())
+}
+
+func TestPagerDutyForwarder_TriggerAndResolve(t *testing.T) {
+	server := newPagerDutyServer(t)
+	forwarder := newTestPagerDutyForwarder(server.URL)
+
+	action := &routingv1.ForwardPagerDutyAction{
+		RoutingKey:                 "R0UT1NGK3Y",
+		DedupKeyLabel:              "incident_key",
+		PayloadCustomDetailsLabels: []string{"customer", "region", "missing"},
+	}
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	alert := &routingv1.Alert{
+		Id:          "alert-1",
+		Summary:     "Core router down",
+		Fingerprint: "fp-1",
+		Status:      routingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		ServiceId:   "network",
+		CreatedAt:   timestamppb.New(createdAt),
+		Labels: map[string]string{
+			"severity":     "critical",
+			"instance":     "core-rtr-01",
+			"incident_key": "ACME-42",
+			"customer":     "acme",
+			"region":       "eu-west",
+		},
+	}
+
+	require.NoError(t, forwarder.ForwardPagerDuty(context.Background(), action, alert))
+
+	alert.Status = routingv1.AlertStatus_ALERT_STATUS_RESOLVED
+	require.NoError(t, forwarder.ForwardPagerDuty(context.Background(), action, alert))
+
+	events := server.Events()
+	require.Len(t, events, 2)
+
+	trigger := events[0]
+	assert.Equal(t, "R0UT1NGK3Y", trigger.RoutingKey)
+	assert.Equal(t, PagerDutyEventTrigger, trigger.EventAction)
+	assert.Equal(t, "ACME-42", trigger.DedupKey)
+	require.NotNil(t, trigger.Payload)
+	assert.Equal(t, "Core router down", trigger.Payload.Summary)
+	assert.Equal(t, "core-rtr-01", trigger.Payload.Source)
+	assert.Equal(t, "critical", trigger.Payload.Severity)
+	assert.Equal(t, "network", trigger.Payload.Component)
+	assert.Equal(t, "2026-03-01T12:00:00Z", trigger.Payload.Timestamp)
+	assert.Equal(t, map[string]string{"customer": "acme", "region": "eu-west"}, trigger.Payload.CustomDetails)
+
+	resolve := events[1]
+	assert.Equal(t, PagerDutyEventResolve, resolve.EventAction)
+	assert.Equal(t, "ACME-42", resolve.DedupKey)
+	assert.Nil(t, resolve.Payload)
+
+	assert.Equal(t, int64(2), forwarder.Metrics().PagerDutySentTotal())
+}
+
+func TestPagerDutyForwarder_DedupKeyFallback(t *testing.T) {
+	server := newPagerDutyServer(t)
+	forwarder := newTestPagerDutyForwarder(server.URL)
+	action := &routingv1.ForwardPagerDutyAction{RoutingKey: "key", DedupKeyLabel: "incident_key"}
+
+	require.NoError(t, forwarder.ForwardPagerDuty(context.Background(), action, &routingv1.Alert{Id: "alert-1", Fingerprint: "fp-1"}))
+	require.NoError(t, forwarder.ForwardPagerDuty(context.Background(), action, &routingv1.Alert{Id: "alert-2"}))
+
+	events := server.Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, "fp-1", events[0].DedupKey)
+	assert.Equal(t, "alert-2", events[1].DedupKey)
+	assert.Equal(t, "Alert alert-2", events[1].Payload.Summary)
+	assert.Equal(t, "alerting-system", events[1].Payload.Source)
+	assert.Equal(t, "info", events[1].Payload.Severity)
+}
+
+func TestPagerDutySeverity(t *testing.T) {
+	tests := map[alertingv1.Severity]string{
+		alertingv1.Severity_SEVERITY_CRITICAL:    "critical",
+		alertingv1.Severity_SEVERITY_HIGH:        "error",
+		alertingv1.Severity_SEVERITY_MEDIUM:      "warning",
+		alertingv1.Severity_SEVERITY_LOW:         "warning",
+		alertingv1.Severity_SEVERITY_INFO:        "info",
+		alertingv1.Severity_SEVERITY_UNSPECIFIED: "info",
+	}
+	for severity, want := range tests {
+		assert.Equal(t, want, PagerDutySeverity(severity), severity.String())
+	}
+}
+
+func TestPagerDutyForwarder_RetriesOnRateLimit(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	forwarder := newTestPagerDutyForwarder(server.URL)
+	action := &routingv1.ForwardPagerDutyAction{RoutingKey: "key"}
+
+	err := forwarder.ForwardPagerDuty(context.Background(), action, &routingv1.Alert{Id: "alert-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestPagerDutyForwarder_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"invalid event","message":"Event object is invalid","errors":["Length of 'routing_key' is incorrect (should be 32 characters)"]}`))
+	}))
+	defer server.Close()
+
+	forwarder := newTestPagerDutyForwarder(server.URL)
+	alert := &routingv1.Alert{Id: "alert-1"}
+
+	t.Run("api error", func(t *testing.T) {
+		err := forwarder.ForwardPagerDuty(context.Background(), &routingv1.ForwardPagerDutyAction{RoutingKey: "short"}, alert)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "routing_key")
+	})
+
+	t.Run("missing routing key", func(t *testing.T) {
+		err := forwarder.ForwardPagerDuty(context.Background(), &routingv1.ForwardPagerDutyAction{}, alert)
+		assert.ErrorIs(t, err, ErrPagerDutyRoutingKeyMissing)
+	})
+
+	assert.Equal(t, int64(2), forwarder.Metrics().PagerDutyFailedTotal())
+}
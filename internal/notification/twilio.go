@@ -0,0 +1,235 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// MaxSMSLength is the maximum number of characters sent in a single SMS.
+const MaxSMSLength = 160
+
+// DefaultTwilioBaseURL is the base URL of the Twilio REST API.
+const DefaultTwilioBaseURL = "https://api.twilio.com"
+
+var (
+	// ErrTwilioNotConfigured is returned when Twilio credentials are missing.
+	ErrTwilioNotConfigured = errors.New("twilio is not configured")
+	// ErrTwilioRateLimited is returned when Twilio keeps rejecting requests with HTTP 429.
+	ErrTwilioRateLimited = errors.New("twilio rate limit exceeded")
+)
+
+// UserPhoneResolver looks up the phone number for a user.
+type UserPhoneResolver interface {
+	// ResolvePhoneNumber returns the E.164 phone number for a user.
+	ResolvePhoneNumber(ctx context.Context, userID string) (string, error)
+}
+
+// TwilioConfig holds configuration for the Twilio SMS notifier.
+type TwilioConfig struct {
+	// AccountSID is the Twilio account SID.
+	AccountSID string
+	// AuthToken is the Twilio auth token.
+	AuthToken string
+	// FromNumber is the sender phone number.
+	FromNumber string
+	// BaseURL is the Twilio API base URL.
+	BaseURL string
+	// MaxRetries is the maximum number of retries when Twilio returns HTTP 429.
+	MaxRetries int
+	// RetryDelay is the base delay between retries when no Retry-After header is returned.
+	RetryDelay time.Duration
+	// Timeout is the HTTP client timeout.
+	Timeout time.Duration
+}
+
+// DefaultTwilioConfig returns the default Twilio configuration without credentials.
+func DefaultTwilioConfig() TwilioConfig {
+	return TwilioConfig{
+		BaseURL:    DefaultTwilioBaseURL,
+		MaxRetries: 3,
+		RetryDelay: time.Second,
+		Timeout:    10 * time.Second,
+	}
+}
+
+// TwilioConfigFromEnv returns the default configuration with credentials read from
+// TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN and TWILIO_FROM_NUMBER.
+func TwilioConfigFromEnv() TwilioConfig {
+	config := DefaultTwilioConfig()
+	config.AccountSID = os.Getenv("TWILIO_ACCOUNT_SID")
+	config.AuthToken = os.Getenv("TWILIO_AUTH_TOKEN")
+	config.FromNumber = os.Getenv("TWILIO_FROM_NUMBER")
+	return config
+}
+
+// Validate checks that the required credentials are set.
+func (c TwilioConfig) Validate() error {
+	if c.AccountSID == "" || c.AuthToken == "" || c.FromNumber == "" {
+		return ErrTwilioNotConfigured
+	}
+	return nil
+}
+
+// TwilioSMSNotifier delivers SMS notifications through the Twilio Messages API.
+type TwilioSMSNotifier struct {
+	config   TwilioConfig
+	client   *http.Client
+	phones   UserPhoneResolver
+	renderer TemplateRenderer
+	logger   zerolog.Logger
+	metrics  *Metrics
+}
+
+// NewTwilioSMSNotifier creates a new Twilio SMS notifier.
+// If renderer is nil, DefaultBody is used to build the message.
+func NewTwilioSMSNotifier(config TwilioConfig, phones UserPhoneResolver, renderer TemplateRenderer, logger zerolog.Logger, metrics *Metrics) *TwilioSMSNotifier {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultTwilioBaseURL
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &TwilioSMSNotifier{
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+		phones:   phones,
+		renderer: renderer,
+		logger:   logger.With().Str("component", "twilio_sms").Logger(),
+		metrics:  metrics,
+	}
+}
+
+// Metrics returns the metrics recorder for this notifier.
+func (n *TwilioSMSNotifier) Metrics() *Metrics {
+	return n.metrics
+}
+
+// NotifyUser sends an SMS to the user's phone number.
+// The message is the first MaxSMSLength characters of the rendered template body.
+func (n *TwilioSMSNotifier) NotifyUser(ctx context.Context, userID string, templateID string, channelOverride routingv1.ChannelType, alert *routingv1.Alert) error {
+	if channelOverride != routingv1.ChannelType_CHANNEL_TYPE_UNSPECIFIED && channelOverride != routingv1.ChannelType_CHANNEL_TYPE_SMS {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, channelOverride)
+	}
+
+	err := n.notifyUser(ctx, userID, templateID, alert)
+	if err != nil {
+		n.metrics.RecordSMSFailed()
+		n.logger.Error().Err(err).Str("userId", userID).Msg("failed to send sms")
+		return err
+	}
+
+	n.metrics.RecordSMSSent()
+	return nil
+}
+
+func (n *TwilioSMSNotifier) notifyUser(ctx context.Context, userID string, templateID string, alert *routingv1.Alert) error {
+	if err := n.config.Validate(); err != nil {
+		return err
+	}
+
+	phone, err := n.phones.ResolvePhoneNumber(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve phone number for user %s: %w", userID, err)
+	}
+	if phone == "" {
+		return fmt.Errorf("%w: user %s", ErrNoContactAddress, userID)
+	}
+
+	body := DefaultBody(alert)
+	if n.renderer != nil {
+		body, err = n.renderer.Render(ctx, templateID, routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+		if err != nil {
+			return fmt.Errorf("failed to render template %s: %w", templateID, err)
+		}
+	}
+
+	return n.sendMessage(ctx, phone, truncateRunes(body, MaxSMSLength))
+}
+
+// twilioErrorResponse is the error body returned by the Twilio API.
+type twilioErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// sendMessage posts a message to the Twilio Messages API, retrying on HTTP 429.
+func (n *TwilioSMSNotifier) sendMessage(ctx context.Context, to, body string) error {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json",
+		strings.TrimRight(n.config.BaseURL, "/"), url.PathEscape(n.config.AccountSID))
+
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", n.config.FromNumber)
+	form.Set("Body", body)
+	encoded := form.Encode()
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(encoded))
+		if err != nil {
+			return fmt.Errorf("failed to build twilio request: %w", err)
+		}
+		req.SetBasicAuth(n.config.AccountSID, n.config.AuthToken)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := n.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("twilio request failed: %w", err)
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if attempt >= n.config.MaxRetries {
+				return ErrTwilioRateLimited
+			}
+
+			delay := n.retryDelay(resp.Header.Get("Retry-After"), attempt)
+			n.logger.Warn().
+				Int("attempt", attempt+1).
+				Dur("delay", delay).
+				Msg("twilio rate limited, retrying")
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+
+		var apiErr twilioErrorResponse
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("twilio returned status %d (code %d): %s", resp.StatusCode, apiErr.Code, apiErr.Message)
+		}
+		return fmt.Errorf("twilio returned status %d", resp.StatusCode)
+	}
+}
+
+// retryDelay returns the delay before the next attempt, honouring Retry-After when present.
+func (n *TwilioSMSNotifier) retryDelay(retryAfter string, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return n.config.RetryDelay * time.Duration(1<<attempt)
+}
+
+// Ensure TwilioSMSNotifier implements UserNotifier
+var _ UserNotifier = (*TwilioSMSNotifier)(nil)
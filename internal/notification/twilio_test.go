@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+type staticPhoneResolver map[string]string
+
+func (r staticPhoneResolver) ResolvePhoneNumber(_ context.Context, userID string) (string, error) {
+	phone, ok := r[userID]
+	if !ok {
+		return "", errors.New("user not found")
+	}
+	return phone, nil
+}
+
+type staticRenderer string
+
+func (r staticRenderer) Render(_ context.Context, _ string, _ routingv1.ChannelType, _ *routingv1.Alert) (string, error) {
+	return string(r), nil
+}
+
+func newTestTwilioNotifier(serverURL string, renderer TemplateRenderer) *TwilioSMSNotifier {
+	config := DefaultTwilioConfig()
+	config.AccountSID = "AC123"
+	config.AuthToken = "secret"
+	config.FromNumber = "+15550000000"
+	config.BaseURL = serverURL
+	config.RetryDelay = time.Millisecond
+
+	phones := staticPhoneResolver{"user-1": "+15551234567"}
+	return NewTwilioSMSNotifier(config, phones, renderer, zerolog.Nop(), NewMetrics())
+}
+
+func TestTwilioSMSNotifier_NotifyUser(t *testing.T) {
+	var gotPath, gotTo, gotFrom, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+
+		require.NoError(t, r.ParseForm())
+		gotPath = r.URL.Path
+		gotTo = r.PostForm.Get("To")
+		gotFrom = r.PostForm.Get("From")
+		gotBody = r.PostForm.Get("Body")
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM1","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	notifier := newTestTwilioNotifier(server.URL, staticRenderer(strings.Repeat("x", 200)))
+	alert := &routingv1.Alert{Id: "alert-1", Summary: "Disk full"}
+
+	err := notifier.NotifyUser(context.Background(), "user-1", "tmpl-1", routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", gotPath)
+	assert.Equal(t, "+15551234567", gotTo)
+	assert.Equal(t, "+15550000000", gotFrom)
+	assert.Len(t, gotBody, MaxSMSLength)
+	assert.Equal(t, int64(1), notifier.Metrics().SMSSentTotal())
+	assert.Equal(t, int64(0), notifier.Metrics().SMSFailedTotal())
+}
+
+func TestTwilioSMSNotifier_RetriesOnRateLimit(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	notifier := newTestTwilioNotifier(server.URL, nil)
+	alert := &routingv1.Alert{Id: "alert-1", Summary: "Disk full"}
+
+	err := notifier.NotifyUser(context.Background(), "user-1", "", routingv1.ChannelType_CHANNEL_TYPE_UNSPECIFIED, alert)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, int64(1), notifier.Metrics().SMSSentTotal())
+}
+
+func TestTwilioSMSNotifier_RateLimitExhausted(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	notifier := newTestTwilioNotifier(server.URL, nil)
+	alert := &routingv1.Alert{Id: "alert-1", Summary: "Disk full"}
+
+	err := notifier.NotifyUser(context.Background(), "user-1", "", routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+	assert.ErrorIs(t, err, ErrTwilioRateLimited)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	assert.Equal(t, int64(1), notifier.Metrics().SMSFailedTotal())
+}
+
+func TestTwilioSMSNotifier_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":21211,"message":"Invalid 'To' Phone Number"}`))
+	}))
+	defer server.Close()
+
+	notifier := newTestTwilioNotifier(server.URL, nil)
+	alert := &routingv1.Alert{Id: "alert-1", Summary: "Disk full"}
+
+	err := notifier.NotifyUser(context.Background(), "user-1", "", routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "21211")
+	assert.Equal(t, int64(1), notifier.Metrics().SMSFailedTotal())
+}
+
+func TestTwilioSMSNotifier_Errors(t *testing.T) {
+	notifier := newTestTwilioNotifier("http://127.0.0.1:0", nil)
+	alert := &routingv1.Alert{Id: "alert-1", Summary: "Disk full"}
+
+	t.Run("unsupported channel", func(t *testing.T) {
+		err := notifier.NotifyUser(context.Background(), "user-1", "", routingv1.ChannelType_CHANNEL_TYPE_EMAIL, alert)
+		assert.ErrorIs(t, err, ErrUnsupportedChannel)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		err := notifier.NotifyUser(context.Background(), "user-404", "", routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+		assert.Error(t, err)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		unconfigured := NewTwilioSMSNotifier(DefaultTwilioConfig(), staticPhoneResolver{}, nil, zerolog.Nop(), nil)
+		err := unconfigured.NotifyUser(context.Background(), "user-1", "", routingv1.ChannelType_CHANNEL_TYPE_SMS, alert)
+		assert.ErrorIs(t, err, ErrTwilioNotConfigured)
+	})
+}
@@ -0,0 +1,94 @@
+package outage
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// Handler serves the outage mode admin endpoints.
+type Handler struct {
+	store  OutageModeStore
+	logger zerolog.Logger
+}
+
+// NewHandler creates a new outage mode admin handler.
+func NewHandler(store OutageModeStore, logger zerolog.Logger) *Handler {
+	return &Handler{
+		store:  store,
+		logger: logger.With().Str("component", "outage_mode").Logger(),
+	}
+}
+
+// RegisterRoutes registers the outage mode routes on the provided router group.
+func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
+	admin := router.Group("/admin")
+	admin.PUT("/outage-mode", h.SetOutageMode)
+	admin.DELETE("/outage-mode", h.ClearOutageMode)
+}
+
+// SetOutageModeRequest is the body of PUT /admin/outage-mode.
+type SetOutageModeRequest struct {
+	Reason string `json:"reason" binding:"required"`
+	// Duration is a Go duration string, e.g. "2h" or "30m".
+	Duration string `json:"duration" binding:"required"`
+}
+
+// SetOutageMode handles PUT /api/v1/admin/outage-mode
+func (h *Handler) SetOutageMode(c *gin.Context) {
+	var req SetOutageModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request: " + err.Error()})
+		return
+	}
+
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid duration: " + err.Error()})
+		return
+	}
+
+	if err := h.store.SetOutageMode(c.Request.Context(), req.Reason, duration); err != nil {
+		if errors.Is(err, ErrInvalidReason) || errors.Is(err, ErrInvalidDuration) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error().Err(err).Msg("failed to set outage mode")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set outage mode"})
+		return
+	}
+
+	h.logger.Warn().
+		Str("reason", req.Reason).
+		Dur("duration", duration).
+		Msg("outage mode activated, notifications disabled")
+
+	h.respondWithStatus(c)
+}
+
+// ClearOutageMode handles DELETE /api/v1/admin/outage-mode
+func (h *Handler) ClearOutageMode(c *gin.Context) {
+	if err := h.store.ClearOutageMode(c.Request.Context()); err != nil {
+		h.logger.Error().Err(err).Msg("failed to clear outage mode")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to clear outage mode"})
+		return
+	}
+
+	h.logger.Info().Msg("outage mode cleared, notifications enabled")
+
+	h.respondWithStatus(c)
+}
+
+// respondWithStatus writes the current outage mode state.
+func (h *Handler) respondWithStatus(c *gin.Context) {
+	status, err := h.store.GetOutageMode(c.Request.Context())
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get outage mode")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get outage mode"})
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
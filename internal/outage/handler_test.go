@@ -0,0 +1,87 @@
+package outage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter() (*gin.Engine, *InMemoryStore) {
+	gin.SetMode(gin.TestMode)
+
+	store := NewInMemoryStore()
+	router := gin.New()
+	NewHandler(store, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+	return router, store
+}
+
+func TestHandler_SetOutageMode(t *testing.T) {
+	router, store := setupTestRouter()
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/outage-mode",
+		strings.NewReader(`{"reason":"core router failure","duration":"2h"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var status Status
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.True(t, status.Active)
+	assert.Equal(t, "core router failure", status.Reason)
+
+	active, err := IsActive(context.Background(), store)
+	require.NoError(t, err)
+	assert.True(t, active)
+}
+
+func TestHandler_SetOutageMode_InvalidRequest(t *testing.T) {
+	router, _ := setupTestRouter()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing reason", body: `{"duration":"2h"}`},
+		{name: "missing duration", body: `{"reason":"outage"}`},
+		{name: "unparseable duration", body: `{"reason":"outage","duration":"soon"}`},
+		{name: "negative duration", body: `{"reason":"outage","duration":"-1h"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/outage-mode", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestHandler_ClearOutageMode(t *testing.T) {
+	router, store := setupTestRouter()
+	require.NoError(t, store.SetOutageMode(context.Background(), "core router failure", time.Hour))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/outage-mode", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var status Status
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.False(t, status.Active)
+}
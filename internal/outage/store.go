@@ -0,0 +1,106 @@
+// Package outage provides outage mode, which temporarily disables all notifications
+// during a known complete outage to avoid flooding responders.
+package outage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidReason is returned when outage mode is enabled without a reason.
+	ErrInvalidReason = errors.New("outage mode reason is required")
+	// ErrInvalidDuration is returned when outage mode is enabled with a non-positive duration.
+	ErrInvalidDuration = errors.New("outage mode duration must be positive")
+)
+
+// Status describes the current outage mode state.
+type Status struct {
+	Active      bool      `json:"active"`
+	Reason      string    `json:"reason,omitempty"`
+	ActivatedAt time.Time `json:"activatedAt,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt,omitempty"`
+}
+
+// OutageModeStore manages the outage mode state.
+type OutageModeStore interface {
+	// SetOutageMode activates outage mode for the given duration.
+	SetOutageMode(ctx context.Context, reason string, duration time.Duration) error
+
+	// ClearOutageMode deactivates outage mode.
+	ClearOutageMode(ctx context.Context) error
+
+	// GetOutageMode returns the current state. Expired outage mode is reported as inactive.
+	GetOutageMode(ctx context.Context) (*Status, error)
+}
+
+// InMemoryStore is an in-memory implementation of OutageModeStore.
+// State is local to the process.
+type InMemoryStore struct {
+	mu     sync.RWMutex
+	status Status
+	now    func() time.Time
+}
+
+// NewInMemoryStore creates a new in-memory outage mode store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{now: time.Now}
+}
+
+// SetOutageMode activates outage mode for the given duration.
+func (s *InMemoryStore) SetOutageMode(ctx context.Context, reason string, duration time.Duration) error {
+	if reason == "" {
+		return ErrInvalidReason
+	}
+	if duration <= 0 {
+		return ErrInvalidDuration
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.status = Status{
+		Active:      true,
+		Reason:      reason,
+		ActivatedAt: now,
+		ExpiresAt:   now.Add(duration),
+	}
+	return nil
+}
+
+// ClearOutageMode deactivates outage mode.
+func (s *InMemoryStore) ClearOutageMode(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = Status{}
+	return nil
+}
+
+// GetOutageMode returns the current state. Expired outage mode is reported as inactive.
+func (s *InMemoryStore) GetOutageMode(ctx context.Context) (*Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.status.Active || !s.now().Before(s.status.ExpiresAt) {
+		return &Status{}, nil
+	}
+
+	status := s.status
+	return &status, nil
+}
+
+// IsActive reports whether outage mode is currently active in the store.
+func IsActive(ctx context.Context, store OutageModeStore) (bool, error) {
+	status, err := store.GetOutageMode(ctx)
+	if err != nil {
+		return false, err
+	}
+	return status.Active, nil
+}
+
+// Ensure InMemoryStore implements OutageModeStore
+var _ OutageModeStore = (*InMemoryStore)(nil)
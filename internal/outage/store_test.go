@@ -0,0 +1,66 @@
+package outage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryStore_SetOutageMode(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+
+	status, err := store.GetOutageMode(ctx)
+	require.NoError(t, err)
+	assert.False(t, status.Active)
+
+	require.NoError(t, store.SetOutageMode(ctx, "core router failure", time.Hour))
+
+	status, err = store.GetOutageMode(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.Equal(t, "core router failure", status.Reason)
+	assert.Equal(t, time.Hour, status.ExpiresAt.Sub(status.ActivatedAt))
+}
+
+func TestInMemoryStore_SetOutageMode_Invalid(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+
+	assert.ErrorIs(t, store.SetOutageMode(ctx, "", time.Hour), ErrInvalidReason)
+	assert.ErrorIs(t, store.SetOutageMode(ctx, "outage", 0), ErrInvalidDuration)
+}
+
+func TestInMemoryStore_Expiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.SetOutageMode(ctx, "core router failure", 30*time.Minute))
+
+	now = now.Add(29 * time.Minute)
+	active, err := IsActive(ctx, store)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	now = now.Add(time.Minute)
+	active, err = IsActive(ctx, store)
+	require.NoError(t, err)
+	assert.False(t, active)
+}
+
+func TestInMemoryStore_ClearOutageMode(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+
+	require.NoError(t, store.SetOutageMode(ctx, "core router failure", time.Hour))
+	require.NoError(t, store.ClearOutageMode(ctx))
+
+	active, err := IsActive(ctx, store)
+	require.NoError(t, err)
+	assert.False(t, active)
+}
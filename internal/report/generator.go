@@ -0,0 +1,283 @@
+// Package report generates on-call statistics reports.
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/schedule"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// alertListPageSize is the page size used when loading the alerts of the report period.
+const alertListPageSize = 1000
+
+// ErrInvalidReport is returned when a report request is invalid.
+var ErrInvalidReport = errors.New("invalid report request")
+
+// CSVHeader is the header row of the CSV rendering of a report.
+var CSVHeader = []string{"user_id", "oncall_hours", "override_count", "handoff_ack_rate", "alert_count"}
+
+// HandoffAck is a user's acknowledgment of a handoff.
+type HandoffAck struct {
+	ScheduleID string
+	UserID     string
+	AckedAt    time.Time
+}
+
+// HandoffAckSource lists recorded handoff acknowledgments.
+type HandoffAckSource interface {
+	// ListHandoffAcks returns the acknowledgments recorded for a schedule within [from, until).
+	ListHandoffAcks(ctx context.Context, scheduleID string, from, until time.Time) ([]HandoffAck, error)
+}
+
+// UserStats holds the on-call statistics of a single user.
+type UserStats struct {
+	UserID string
+	// OnCallHours is the time spent in shifts within the report period.
+	OnCallHours float64
+	// OverrideCount is the number of overrides the user took over within the period.
+	OverrideCount int
+	// Handoffs is the number of shifts the user started within the period.
+	Handoffs int
+	// HandoffAcks is the number of handoffs the user acknowledged within the period.
+	HandoffAcks int
+	// AlertCount is the number of alerts triggered while the user was on call.
+	AlertCount int
+}
+
+// HandoffAckRate returns the share of the user's handoffs that were acknowledged.
+func (s UserStats) HandoffAckRate() float64 {
+	if s.Handoffs == 0 {
+		return 0
+	}
+	return min(float64(s.HandoffAcks)/float64(s.Handoffs), 1)
+}
+
+// Report holds the on-call statistics of the users of a set of schedules.
+type Report struct {
+	ScheduleIDs []string
+	From        time.Time
+	Until       time.Time
+	// Users is ordered by user ID.
+	Users []UserStats
+}
+
+// WriteCSV renders the report as CSV with one row per user.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, u := range r.Users {
+		if err := cw.Write([]string{
+			u.UserID,
+			strconv.FormatFloat(u.OnCallHours, 'f', 2, 64),
+			strconv.Itoa(u.OverrideCount),
+			strconv.FormatFloat(u.HandoffAckRate(), 'f', 2, 64),
+			strconv.Itoa(u.AlertCount),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Generator builds on-call reports from schedules and the alerts triggered during their shifts.
+type Generator struct {
+	schedules  schedule.Store
+	alerts     store.AlertStore
+	calculator *schedule.Calculator
+	metrics    *Metrics
+	logger     zerolog.Logger
+
+	// acks provides handoff acknowledgments (optional)
+	acks HandoffAckSource
+}
+
+// GeneratorOption configures optional Generator dependencies.
+type GeneratorOption func(*Generator)
+
+// WithHandoffAcks sets the source of handoff acknowledgments. Without it every
+// user's handoff ack rate is reported as 0.
+func WithHandoffAcks(acks HandoffAckSource) GeneratorOption {
+	return func(g *Generator) {
+		g.acks = acks
+	}
+}
+
+// NewGenerator creates a new report generator.
+func NewGenerator(schedules schedule.Store, alerts store.AlertStore, logger zerolog.Logger, metrics *Metrics, opts ...GeneratorOption) *Generator {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+	g := &Generator{
+		schedules:  schedules,
+		alerts:     alerts,
+		calculator: schedule.NewCalculator(),
+		metrics:    metrics,
+		logger:     logger.With().Str("component", "report_generator").Logger(),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Metrics returns the metrics recorder for this generator.
+func (g *Generator) Metrics() *Metrics {
+	return g.metrics
+}
+
+// shiftWindow is a shift clipped to the report period.
+type shiftWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// GenerateOnCallReport computes per-user on-call statistics for the schedules
+// within [from, until). Shifts of overlapping rotation layers and overrides
+// each count towards on-call hours. Returns schedule.ErrNotFound if a schedule
+// does not exist.
+func (g *Generator) GenerateOnCallReport(ctx context.Context, scheduleIDs []string, from, until time.Time) (*Report, error) {
+	if len(scheduleIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one schedule id is required", ErrInvalidReport)
+	}
+	if from.IsZero() || until.IsZero() || !until.After(from) {
+		return nil, fmt.Errorf("%w: until must be after from", ErrInvalidReport)
+	}
+
+	start := time.Now()
+	users := make(map[string]*UserStats)
+	userStats := func(userID string) *UserStats {
+		stats, ok := users[userID]
+		if !ok {
+			stats = &UserStats{UserID: userID}
+			users[userID] = stats
+		}
+		return stats
+	}
+	shifts := make(map[string][]shiftWindow)
+
+	for _, id := range scheduleIDs {
+		sched, err := g.schedules.GetSchedule(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get schedule %s: %w", id, err)
+		}
+
+		for _, override := range sched.Overrides {
+			if override.StartTime.AsTime().Before(until) && override.EndTime.AsTime().After(from) {
+				userStats(override.UserId).OverrideCount++
+			}
+		}
+
+		for _, shift := range g.calculator.ListUpcomingShifts(sched, sched.Overrides, from, until, "") {
+			shiftStart, shiftEnd := shift.StartTime.AsTime(), shift.EndTime.AsTime()
+			window := shiftWindow{start: maxTime(shiftStart, from), end: minTime(shiftEnd, until)}
+			if !window.end.After(window.start) {
+				continue
+			}
+
+			stats := userStats(shift.UserId)
+			stats.OnCallHours += window.end.Sub(window.start).Hours()
+			if !shiftStart.Before(from) {
+				stats.Handoffs++
+			}
+			shifts[shift.UserId] = append(shifts[shift.UserId], window)
+		}
+
+		if g.acks != nil {
+			acks, err := g.acks.ListHandoffAcks(ctx, id, from, until)
+			if err != nil {
+				return nil, fmt.Errorf("list handoff acks of schedule %s: %w", id, err)
+			}
+			for _, ack := range acks {
+				userStats(ack.UserID).HandoffAcks++
+			}
+		}
+	}
+
+	if err := g.countAlerts(ctx, from, until, shifts, users); err != nil {
+		return nil, err
+	}
+
+	report := &Report{ScheduleIDs: scheduleIDs, From: from, Until: until}
+	for _, stats := range users {
+		report.Users = append(report.Users, *stats)
+	}
+	sort.Slice(report.Users, func(i, j int) bool {
+		return report.Users[i].UserID < report.Users[j].UserID
+	})
+
+	duration := time.Since(start)
+	g.metrics.RecordGeneration(duration)
+	g.logger.Info().
+		Strs("scheduleIds", scheduleIDs).
+		Int("users", len(report.Users)).
+		Dur("duration", duration).
+		Msg("on-call report generated")
+
+	return report, nil
+}
+
+// countAlerts counts, for each user, the alerts triggered within the period
+// while the user was in one of their shifts.
+func (g *Generator) countAlerts(ctx context.Context, from, until time.Time, shifts map[string][]shiftWindow, users map[string]*UserStats) error {
+	pageToken := ""
+	for {
+		resp, err := g.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+			PageSize:        alertListPageSize,
+			PageToken:       pageToken,
+			TriggeredAfter:  timestamppb.New(from),
+			TriggeredBefore: timestamppb.New(until),
+		})
+		if err != nil {
+			return fmt.Errorf("list alerts: %w", err)
+		}
+
+		for _, alert := range resp.Alerts {
+			if alert.TriggeredAt == nil {
+				continue
+			}
+			triggeredAt := alert.TriggeredAt.AsTime()
+			for userID, windows := range shifts {
+				for _, w := range windows {
+					if !triggeredAt.Before(w.start) && triggeredAt.Before(w.end) {
+						users[userID].AlertCount++
+						break
+					}
+				}
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
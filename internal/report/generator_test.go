@@ -0,0 +1,215 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/schedule"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// mockScheduleStore serves schedules by ID. Only GetSchedule is implemented.
+type mockScheduleStore struct {
+	schedule.Store
+	schedules map[string]*routingv1.Schedule
+}
+
+func (m *mockScheduleStore) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	s, ok := m.schedules[id]
+	if !ok {
+		return nil, schedule.ErrNotFound
+	}
+	return s, nil
+}
+
+// mockAlertStore lists a fixed set of alerts. Only List is implemented.
+type mockAlertStore struct {
+	alerts []*alertingv1.Alert
+}
+
+func (m *mockAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (m *mockAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	return alert, true, nil
+}
+
+func (m *mockAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	return &alertingv1.ListAlertsResponse{Alerts: m.alerts}, nil
+}
+
+// mockAckSource returns fixed handoff acknowledgments.
+type mockAckSource struct {
+	acks []HandoffAck
+}
+
+func (m *mockAckSource) ListHandoffAcks(ctx context.Context, scheduleID string, from, until time.Time) ([]HandoffAck, error) {
+	var acks []HandoffAck
+	for _, ack := range m.acks {
+		if ack.ScheduleID == scheduleID && !ack.AckedAt.Before(from) && ack.AckedAt.Before(until) {
+			acks = append(acks, ack)
+		}
+	}
+	return acks, nil
+}
+
+var reportStart = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+// newTestGenerator seeds a daily rotation alternating alice and bob from
+// reportStart, with carol overriding bob on the afternoon of the second day.
+func newTestGenerator() *Generator {
+	schedules := &mockScheduleStore{schedules: map[string]*routingv1.Schedule{
+		"sched-1": {
+			Id:       "sched-1",
+			Timezone: "UTC",
+			Rotations: []*routingv1.Rotation{
+				{
+					Id:        "rot-1",
+					Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+					StartTime: timestamppb.New(reportStart),
+					Members: []*routingv1.RotationMember{
+						{UserId: "alice", Position: 0},
+						{UserId: "bob", Position: 1},
+					},
+				},
+			},
+			Overrides: []*routingv1.ScheduleOverride{
+				{
+					Id:        "override-1",
+					UserId:    "carol",
+					StartTime: timestamppb.New(reportStart.Add(36 * time.Hour)),
+					EndTime:   timestamppb.New(reportStart.Add(42 * time.Hour)),
+				},
+			},
+		},
+	}}
+
+	alerts := &mockAlertStore{}
+	for _, offset := range []time.Duration{10 * time.Hour, 37 * time.Hour, 49 * time.Hour} {
+		alerts.alerts = append(alerts.alerts, &alertingv1.Alert{TriggeredAt: timestamppb.New(reportStart.Add(offset))})
+	}
+
+	acks := &mockAckSource{acks: []HandoffAck{
+		{ScheduleID: "sched-1", UserID: "alice", AckedAt: reportStart.Add(time.Minute)},
+	}}
+
+	return NewGenerator(schedules, alerts, zerolog.Nop(), nil, WithHandoffAcks(acks))
+}
+
+func TestGenerateOnCallReport(t *testing.T) {
+	generator := newTestGenerator()
+
+	report, err := generator.GenerateOnCallReport(context.Background(), []string{"sched-1"}, reportStart, reportStart.Add(4*24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, report.Users, 3)
+
+	alice := report.Users[0]
+	assert.Equal(t, "alice", alice.UserID)
+	assert.Equal(t, 48.0, alice.OnCallHours)
+	assert.Equal(t, 0, alice.OverrideCount)
+	assert.Equal(t, 2, alice.Handoffs)
+	assert.Equal(t, 0.5, alice.HandoffAckRate())
+	assert.Equal(t, 2, alice.AlertCount)
+
+	carol := report.Users[2]
+	assert.Equal(t, "carol", carol.UserID)
+	assert.Equal(t, 6.0, carol.OnCallHours)
+	assert.Equal(t, 1, carol.OverrideCount)
+	assert.Equal(t, 1, carol.AlertCount)
+
+	assert.Len(t, generator.Metrics().GetGenerationDurations(), 1)
+}
+
+func TestGenerateOnCallReport_Errors(t *testing.T) {
+	generator := newTestGenerator()
+	ctx := context.Background()
+
+	_, err := generator.GenerateOnCallReport(ctx, nil, reportStart, reportStart.Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrInvalidReport))
+
+	_, err = generator.GenerateOnCallReport(ctx, []string{"sched-1"}, reportStart, reportStart)
+	assert.True(t, errors.Is(err, ErrInvalidReport))
+
+	_, err = generator.GenerateOnCallReport(ctx, []string{"missing"}, reportStart, reportStart.Add(time.Hour))
+	assert.True(t, errors.Is(err, schedule.ErrNotFound))
+}
+
+func postOnCallReport(t *testing.T, body map[string]any) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	NewHandler(newTestGenerator(), zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/reports/oncall", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandler_GenerateOnCallReportCSV(t *testing.T) {
+	w := postOnCallReport(t, map[string]any{
+		"scheduleIds": []string{"sched-1"},
+		"from":        reportStart,
+		"until":       reportStart.Add(4 * 24 * time.Hour),
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, CSVHeader, records[0])
+	assert.Equal(t, []string{"alice", "48.00", "0", "0.50", "2"}, records[1])
+	assert.Equal(t, []string{"bob", "48.00", "0", "0.00", "1"}, records[2])
+	assert.Equal(t, []string{"carol", "6.00", "1", "0.00", "1"}, records[3])
+}
+
+func TestHandler_GenerateOnCallReportErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     map[string]any
+		expected int
+	}{
+		{"missing schedules", map[string]any{"from": reportStart, "until": reportStart.Add(time.Hour)}, http.StatusBadRequest},
+		{"until before from", map[string]any{"scheduleIds": []string{"sched-1"}, "from": reportStart, "until": reportStart.Add(-time.Hour)}, http.StatusBadRequest},
+		{"unknown schedule", map[string]any{"scheduleIds": []string{"missing"}, "from": reportStart, "until": reportStart.Add(time.Hour)}, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postOnCallReport(t, tt.body)
+			assert.Equal(t, tt.expected, w.Code, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/schedule"
+)
+
+// Handler serves the report endpoints.
+type Handler struct {
+	generator *Generator
+	logger    zerolog.Logger
+}
+
+// NewHandler creates a new report handler.
+func NewHandler(generator *Generator, logger zerolog.Logger) *Handler {
+	return &Handler{
+		generator: generator,
+		logger:    logger.With().Str("component", "report_handler").Logger(),
+	}
+}
+
+// RegisterRoutes registers the report routes on the provided router group.
+func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/reports/oncall", h.GenerateOnCallReport)
+}
+
+// OnCallReportRequest is the body of POST /reports/oncall.
+type OnCallReportRequest struct {
+	ScheduleIDs []string  `json:"scheduleIds" binding:"required"`
+	From        time.Time `json:"from" binding:"required"`
+	Until       time.Time `json:"until" binding:"required"`
+}
+
+// GenerateOnCallReport handles POST /api/v1/reports/oncall and streams the report as CSV.
+func (h *Handler) GenerateOnCallReport(c *gin.Context) {
+	var req OnCallReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request: " + err.Error()})
+		return
+	}
+
+	report, err := h.generator.GenerateOnCallReport(c.Request.Context(), req.ScheduleIDs, req.From, req.Until)
+	switch {
+	case errors.Is(err, ErrInvalidReport):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, schedule.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+		return
+	case err != nil:
+		h.logger.Error().Err(err).Strs("scheduleIds", req.ScheduleIDs).Msg("failed to generate on-call report")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate on-call report"})
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="oncall-report.csv"`)
+	c.Status(http.StatusOK)
+	if err := report.WriteCSV(c.Writer); err != nil {
+		h.logger.Error().Err(err).Msg("failed to write on-call report")
+	}
+}
@@ -0,0 +1,44 @@
+package report
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics tracks report generation metrics.
+// Exposed as the report_generation_duration_seconds histogram.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// generationDuration tracks how long reports took to generate.
+	generationDuration []time.Duration
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordGeneration records how long a report took to generate.
+func (m *Metrics) RecordGeneration(duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.generationDuration = append(m.generationDuration, duration)
+}
+
+// GetGenerationDurations returns the recorded report generation durations.
+func (m *Metrics) GetGenerationDurations() []time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]time.Duration, len(m.generationDuration))
+	copy(result, m.generationDuration)
+	return result
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.generationDuration = nil
+}
@@ -0,0 +1,161 @@
+// Package retention purges audit and log tables past their retention period.
+package retention
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// DefaultRetentionDays is how long audit logs are kept when not configured.
+	DefaultRetentionDays = 30
+	// DefaultInterval is how often expired logs are purged.
+	DefaultInterval = 24 * time.Hour
+)
+
+// Config configures the retention purger.
+type Config struct {
+	// RetentionDays is how many days of logs are kept. Non-positive values use
+	// DefaultRetentionDays.
+	RetentionDays int
+}
+
+// ConfigFromEnv returns a configuration read from AUDIT_LOG_RETENTION_DAYS.
+func ConfigFromEnv() Config {
+	config := Config{RetentionDays: DefaultRetentionDays}
+	if days, err := strconv.Atoi(os.Getenv("AUDIT_LOG_RETENTION_DAYS")); err == nil && days > 0 {
+		config.RetentionDays = days
+	}
+	return config
+}
+
+// PurgeFunc deletes entries recorded before olderThan and returns how many were deleted.
+type PurgeFunc func(ctx context.Context, olderThan time.Time) (int64, error)
+
+// Metrics tracks purged log entries by table.
+// Exposed as the audit_logs_purged_total{table} counter.
+type Metrics struct {
+	mu     sync.RWMutex
+	purged map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		purged: make(map[string]int64),
+	}
+}
+
+// RecordPurged adds count to the purged counter for a table.
+func (m *Metrics) RecordPurged(table string, count int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purged[table] += count
+}
+
+// PurgedTotal returns the number of entries purged from a table.
+func (m *Metrics) PurgedTotal(table string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.purged[table]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purged = make(map[string]int64)
+}
+
+// Purger periodically deletes log entries older than the retention period from
+// every registered table.
+type Purger struct {
+	mu        sync.RWMutex
+	tables    map[string]PurgeFunc
+	retention time.Duration
+	interval  time.Duration
+	metrics   *Metrics
+	logger    zerolog.Logger
+	now       func() time.Time
+}
+
+// NewPurger creates a purger that runs every DefaultInterval.
+func NewPurger(config Config, metrics *Metrics, logger zerolog.Logger) *Purger {
+	days := config.RetentionDays
+	if days <= 0 {
+		days = DefaultRetentionDays
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &Purger{
+		tables:    make(map[string]PurgeFunc),
+		retention: time.Duration(days) * 24 * time.Hour,
+		interval:  DefaultInterval,
+		metrics:   metrics,
+		logger:    logger.With().Str("component", "retention_purger").Logger(),
+		now:       time.Now,
+	}
+}
+
+// Metrics returns the metrics recorder for this purger.
+func (p *Purger) Metrics() *Metrics {
+	return p.metrics
+}
+
+// Register adds a table to purge under the given name, e.g. "routing_audit_logs".
+func (p *Purger) Register(table string, purge PurgeFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tables[table] = purge
+}
+
+// Purge deletes entries older than the retention period from every registered table.
+// A failing table is logged and does not stop the others from being purged.
+func (p *Purger) Purge(ctx context.Context) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	cutoff := p.now().Add(-p.retention)
+	for table, purge := range p.tables {
+		deleted, err := purge(ctx, cutoff)
+		if err != nil {
+			p.logger.Error().Err(err).Str("table", table).Msg("failed to purge expired logs")
+			continue
+		}
+
+		p.metrics.RecordPurged(table, deleted)
+		if deleted > 0 {
+			p.logger.Info().
+				Str("table", table).
+				Int64("deleted", deleted).
+				Time("olderThan", cutoff).
+				Msg("purged expired logs")
+		}
+	}
+}
+
+// Run purges expired logs immediately and then every interval until the context is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.logger.Info().Dur("retention", p.retention).Msg("starting log retention purger")
+
+	p.Purge(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Purge(ctx)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/analytics"
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestPurger_DeletesOnlyExpiredLogs(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	routingStore := routing.NewInMemoryStore()
+	for _, age := range []time.Duration{40 * 24 * time.Hour, 31 * 24 * time.Hour, 29 * 24 * time.Hour, time.Hour} {
+		err := routingStore.CreateAuditLog(ctx, &routingv1.RoutingAuditLog{
+			AlertId:   "alert-1",
+			Timestamp: timestamppb.New(now.Add(-age)),
+		})
+		assert.NoError(t, err)
+	}
+
+	receipts := analytics.NewInMemoryStore()
+	for _, age := range []time.Duration{45 * 24 * time.Hour, 2 * 24 * time.Hour} {
+		err := receipts.Record(ctx, &analytics.Receipt{
+			Fingerprint: "fp-a",
+			ServiceID:   "svc-1",
+			CreatedAt:   now.Add(-age),
+		})
+		assert.NoError(t, err)
+	}
+
+	purger := NewPurger(Config{RetentionDays: 30}, nil, zerolog.Nop())
+	purger.now = func() time.Time { return now }
+	purger.Register("routing_audit_logs", routing.PurgeAllAuditLogs(routingStore))
+	purger.Register("alert_receipt_log", receipts.Purge)
+
+	purger.Purge(ctx)
+
+	logs, err := routingStore.GetAuditLogs(ctx, &routingv1.GetRoutingAuditLogsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, logs.Logs, 2)
+	for _, log := range logs.Logs {
+		assert.True(t, log.Timestamp.AsTime().After(now.Add(-30*24*time.Hour)))
+	}
+
+	stats, err := receipts.Stats(ctx, "", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), stats.TotalReceived)
+
+	assert.Equal(t, int64(2), purger.Metrics().PurgedTotal("routing_audit_logs"))
+	assert.Equal(t, int64(1), purger.Metrics().PurgedTotal("alert_receipt_log"))
+}
+
+func TestPurger_ContinuesAfterFailure(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	purger := NewPurger(Config{RetentionDays: 7}, nil, zerolog.Nop())
+	purger.now = func() time.Time { return now }
+
+	var cutoff time.Time
+	purger.Register("broken", func(context.Context, time.Time) (int64, error) {
+		return 0, errors.New("database unavailable")
+	})
+	purger.Register("working", func(_ context.Context, olderThan time.Time) (int64, error) {
+		cutoff = olderThan
+		return 3, nil
+	})
+
+	purger.Purge(context.Background())
+
+	assert.Equal(t, now.Add(-7*24*time.Hour), cutoff)
+	assert.Equal(t, int64(0), purger.Metrics().PurgedTotal("broken"))
+	assert.Equal(t, int64(3), purger.Metrics().PurgedTotal("working"))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("AUDIT_LOG_RETENTION_DAYS", "")
+	assert.Equal(t, DefaultRetentionDays, ConfigFromEnv().RetentionDays)
+
+	t.Setenv("AUDIT_LOG_RETENTION_DAYS", "90")
+	assert.Equal(t, 90, ConfigFromEnv().RetentionDays)
+
+	t.Setenv("AUDIT_LOG_RETENTION_DAYS", "-1")
+	assert.Equal(t, DefaultRetentionDays, ConfigFromEnv().RetentionDays)
+}
@@ -1,13 +1,22 @@
 package action
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
+	"text/template"
 	"time"
 
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kneutral-org/alerting-system/internal/outage"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
+// OutageModeSkipMessage is the result message for notifications skipped during outage mode.
+const OutageModeSkipMessage = "outage mode active - notification skipped"
+
 // NotificationService defines the interface for sending notifications.
 type NotificationService interface {
 	// NotifyTeam sends a notification to a team.
@@ -42,21 +51,46 @@ type TicketService interface {
 	CreateTicket(ctx context.Context, providerID, projectKey, ticketType, templateID string, fields map[string]string, alert *routingv1.Alert) (string, error)
 }
 
+// ForwardingService defines the interface for forwarding alerts to external incident management systems.
+type ForwardingService interface {
+	// ForwardPagerDuty sends an alert event to a PagerDuty service.
+	ForwardPagerDuty(ctx context.Context, config *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error
+}
+
+// SNSService defines the interface for publishing to AWS SNS topics. It is
+// implemented on top of an AWS SDK SNS client.
+type SNSService interface {
+	// Publish publishes a message to an SNS topic.
+	Publish(ctx context.Context, topicARN, subject, message string) error
+}
+
 // ActionHandlers holds the service dependencies for action handlers.
 type ActionHandlers struct {
 	NotificationService NotificationService
 	AlertService        AlertService
 	EscalationService   EscalationService
 	TicketService       TicketService
+	ForwardingService   ForwardingService
+	SNSService          SNSService
+	// OutageMode, when set, causes notification actions to be skipped while outage mode is active.
+	OutageMode outage.OutageModeStore
 }
 
 // RegisterAllHandlers registers all action handlers with the executor.
 func RegisterAllHandlers(executor *DefaultExecutor, handlers *ActionHandlers) {
 	if handlers.NotificationService != nil {
-		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM, NewNotifyTeamHandler(handlers.NotificationService))
-		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL, NewNotifyChannelHandler(handlers.NotificationService))
-		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_USER, NewNotifyUserHandler(handlers.NotificationService))
-		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL, NewNotifyOnCallHandler(handlers.NotificationService))
+		notify := map[routingv1.ActionType]ActionHandler{
+			routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM:    NewNotifyTeamHandler(handlers.NotificationService),
+			routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL: NewNotifyChannelHandler(handlers.NotificationService),
+			routingv1.ActionType_ACTION_TYPE_NOTIFY_USER:    NewNotifyUserHandler(handlers.NotificationService),
+			routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL:  NewNotifyOnCallHandler(handlers.NotificationService),
+		}
+		for actionType, handler := range notify {
+			if handlers.OutageMode != nil {
+				handler = NewOutageModeHandler(actionType, handlers.OutageMode, executor.metrics, handler)
+			}
+			executor.RegisterAction(actionType, handler)
+		}
 	}
 
 	if handlers.AlertService != nil {
@@ -72,6 +106,47 @@ func RegisterAllHandlers(executor *DefaultExecutor, handlers *ActionHandlers) {
 	if handlers.TicketService != nil {
 		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_CREATE_TICKET, NewCreateTicketHandler(handlers.TicketService))
 	}
+
+	if handlers.ForwardingService != nil {
+		handler := NewForwardPagerDutyHandler(handlers.ForwardingService)
+		if handlers.OutageMode != nil {
+			handler = NewOutageModeHandler(routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY, handlers.OutageMode, executor.metrics, handler)
+		}
+		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY, handler)
+	}
+
+	if handlers.SNSService != nil {
+		handler := NewNotifySNSHandler(handlers.SNSService)
+		if handlers.OutageMode != nil {
+			handler = NewOutageModeHandler(routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS, handlers.OutageMode, executor.metrics, handler)
+		}
+		executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS, handler)
+	}
+}
+
+// NewOutageModeHandler wraps a notification handler so that it is skipped while
+// outage mode is active. If the outage mode state cannot be read, the notification
+// is sent rather than dropped.
+func NewOutageModeHandler(actionType routingv1.ActionType, store outage.OutageModeStore, metrics *Metrics, next ActionHandler) ActionHandler {
+	return func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
+		startTime := time.Now()
+
+		active, err := outage.IsActive(ctx, store)
+		if err != nil || !active {
+			return next(ctx, alert, action)
+		}
+
+		if metrics != nil {
+			metrics.RecordOutageModeSkipped(actionType.String())
+		}
+
+		return &Result{
+			ActionType: actionType.String(),
+			Success:    true,
+			Message:    OutageModeSkipMessage,
+			Duration:   time.Since(startTime),
+		}, nil
+	}
 }
 
 // NewNotifyTeamHandler creates a handler for notify_team actions.
@@ -125,6 +200,22 @@ func NewNotifyTeamHandler(svc NotificationService) ActionHandler {
 	}
 }
 
+// validateNotificationTarget returns why a target cannot be notified, or an
+// empty string if it is valid.
+func validateNotificationTarget(target *routingv1.NotificationTarget) string {
+	switch target.Channel {
+	case routingv1.ChannelType_CHANNEL_TYPE_DISCORD:
+		if target.GetDiscord().GetWebhookUrl() == "" {
+			return "discord target requires a webhook_url"
+		}
+	case routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT:
+		if target.GetGoogleChat().GetWebhookUrl() == "" {
+			return "google chat target requires a webhook_url"
+		}
+	}
+	return ""
+}
+
 // NewNotifyChannelHandler creates a handler for notify_channel actions.
 func NewNotifyChannelHandler(svc NotificationService) ActionHandler {
 	return func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
@@ -153,6 +244,17 @@ func NewNotifyChannelHandler(svc NotificationService) ActionHandler {
 			}, ErrInvalidAction
 		}
 
+		if msg := validateNotificationTarget(config.Target); msg != "" {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL.String(),
+				Success:    false,
+				Message:    msg,
+				Error:      ErrInvalidAction,
+				Retryable:  false,
+				Duration:   time.Since(startTime),
+			}, ErrInvalidAction
+		}
+
 		err := svc.NotifyChannel(ctx, config.Target, config.TemplateId, alert)
 		duration := time.Since(startTime)
 
@@ -489,6 +591,165 @@ func NewCreateTicketHandler(svc TicketService) ActionHandler {
 	}
 }
 
+// NewForwardPagerDutyHandler creates a handler for forward_pagerduty actions.
+func NewForwardPagerDutyHandler(svc ForwardingService) ActionHandler {
+	return func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
+		startTime := time.Now()
+		config := action.GetForwardPagerduty()
+
+		if config == nil {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY.String(),
+				Success:    false,
+				Message:    "forward_pagerduty configuration is missing",
+				Error:      ErrInvalidAction,
+				Retryable:  false,
+				Duration:   time.Since(startTime),
+			}, ErrInvalidAction
+		}
+
+		if config.RoutingKey == "" {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY.String(),
+				Success:    false,
+				Message:    "routing_key is required",
+				Error:      ErrInvalidAction,
+				Retryable:  false,
+				Duration:   time.Since(startTime),
+			}, ErrInvalidAction
+		}
+
+		err := svc.ForwardPagerDuty(ctx, config, alert)
+		duration := time.Since(startTime)
+
+		if err != nil {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY.String(),
+				Success:    false,
+				Message:    fmt.Sprintf("failed to forward to pagerduty: %v", err),
+				Error:      err,
+				Retryable:  true,
+				Duration:   duration,
+			}, err
+		}
+
+		return &Result{
+			ActionType: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY.String(),
+			Success:    true,
+			Message:    "forwarded alert to pagerduty",
+			Duration:   duration,
+		}, nil
+	}
+}
+
+// MaxSNSSubjectLength is the maximum length of an SNS message subject.
+const MaxSNSSubjectLength = 100
+
+// NewNotifySNSHandler creates a handler for notify_sns actions.
+func NewNotifySNSHandler(svc SNSService) ActionHandler {
+	return func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
+		startTime := time.Now()
+		config := action.GetNotifySns()
+
+		invalid := func(message string) (*Result, error) {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS.String(),
+				Success:    false,
+				Message:    message,
+				Error:      ErrInvalidAction,
+				Retryable:  false,
+				Duration:   time.Since(startTime),
+			}, ErrInvalidAction
+		}
+
+		if config == nil {
+			return invalid("notify_sns configuration is missing")
+		}
+
+		region, ok := snsTopicRegion(config.TopicArn)
+		if !ok {
+			return invalid("topic_arn must be an SNS topic ARN")
+		}
+		if config.Region != "" && config.Region != region {
+			return invalid(fmt.Sprintf("region %s does not match topic_arn region %s", config.Region, region))
+		}
+
+		subject, err := renderSNSTemplate(config.SubjectTemplate, alert, alert.Summary)
+		if err != nil {
+			return invalid(fmt.Sprintf("invalid subject_template: %v", err))
+		}
+		defaultMessage, err := protojson.Marshal(alert)
+		if err != nil {
+			return invalid(fmt.Sprintf("failed to encode alert: %v", err))
+		}
+		message, err := renderSNSTemplate(config.MessageTemplate, alert, string(defaultMessage))
+		if err != nil {
+			return invalid(fmt.Sprintf("invalid message_template: %v", err))
+		}
+
+		err = svc.Publish(ctx, config.TopicArn, truncateSNSSubject(subject), message)
+		duration := time.Since(startTime)
+
+		if err != nil {
+			return &Result{
+				ActionType: routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS.String(),
+				Success:    false,
+				Message:    fmt.Sprintf("failed to publish to sns: %v", err),
+				Error:      err,
+				Retryable:  true,
+				Duration:   duration,
+			}, err
+		}
+
+		return &Result{
+			ActionType: routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS.String(),
+			Success:    true,
+			Message:    fmt.Sprintf("published alert to %s", config.TopicArn),
+			Duration:   duration,
+		}, nil
+	}
+}
+
+// snsTopicRegion returns the region of an arn:<partition>:sns:<region>:<account>:<topic> ARN.
+func snsTopicRegion(topicARN string) (string, bool) {
+	parts := strings.Split(topicARN, ":")
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "sns" || parts[3] == "" || parts[5] == "" {
+		return "", false
+	}
+	return parts[3], true
+}
+
+// renderSNSTemplate renders text as a Go template with the alert, returning
+// fallback if text is empty.
+func renderSNSTemplate(text string, alert *routingv1.Alert, fallback string) (string, error) {
+	if text == "" {
+		return fallback, nil
+	}
+
+	tmpl, err := template.New("sns").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, alert); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// truncateSNSSubject keeps the first line of subject within MaxSNSSubjectLength,
+// since SNS rejects subjects with line breaks or over 100 characters.
+func truncateSNSSubject(subject string) string {
+	if i := strings.IndexAny(subject, "\r\n"); i >= 0 {
+		subject = subject[:i]
+	}
+	runes := []rune(subject)
+	if len(runes) > MaxSNSSubjectLength {
+		subject = string(runes[:MaxSNSSubjectLength])
+	}
+	return subject
+}
+
 // NewSetLabelHandler creates a handler for set_label actions.
 func NewSetLabelHandler(svc AlertService) ActionHandler {
 	return func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
@@ -2,13 +2,16 @@ package action
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/kneutral-org/alerting-system/internal/outage"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -100,6 +103,30 @@ func (m *MockTicketService) CreateTicket(ctx context.Context, providerID, projec
 	return "TICKET-123", nil
 }
 
+// MockForwardingService is a mock implementation of ForwardingService.
+type MockForwardingService struct {
+	ForwardPagerDutyFunc func(ctx context.Context, config *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error
+}
+
+func (m *MockForwardingService) ForwardPagerDuty(ctx context.Context, config *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error {
+	if m.ForwardPagerDutyFunc != nil {
+		return m.ForwardPagerDutyFunc(ctx, config, alert)
+	}
+	return nil
+}
+
+// MockSNSService is a mock implementation of SNSService.
+type MockSNSService struct {
+	PublishFunc func(ctx context.Context, topicARN, subject, message string) error
+}
+
+func (m *MockSNSService) Publish(ctx context.Context, topicARN, subject, message string) error {
+	if m.PublishFunc != nil {
+		return m.PublishFunc(ctx, topicARN, subject, message)
+	}
+	return nil
+}
+
 func TestNewNotifyTeamHandler(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -223,6 +250,47 @@ func TestNewNotifyChannelHandler(t *testing.T) {
 			expectedResult: false,
 			expectedError:  true,
 		},
+		{
+			name: "discord notification",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL,
+				NotifyChannel: &routingv1.NotifyChannelAction{
+					Target: &routingv1.NotificationTarget{
+						Channel: routingv1.ChannelType_CHANNEL_TYPE_DISCORD,
+						Discord: &routingv1.DiscordTarget{WebhookUrl: "https://discord.com/api/webhooks/1/token"},
+					},
+				},
+			},
+			expectedResult: true,
+			expectedError:  false,
+		},
+		{
+			name: "google chat target without webhook url",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL,
+				NotifyChannel: &routingv1.NotifyChannelAction{
+					Target: &routingv1.NotificationTarget{
+						Channel:    routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT,
+						GoogleChat: &routingv1.GoogleChatTarget{UseCardsV2: true},
+					},
+				},
+			},
+			expectedResult: false,
+			expectedError:  true,
+		},
+		{
+			name: "discord target without webhook url",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL,
+				NotifyChannel: &routingv1.NotifyChannelAction{
+					Target: &routingv1.NotificationTarget{
+						Channel: routingv1.ChannelType_CHANNEL_TYPE_DISCORD,
+					},
+				},
+			},
+			expectedResult: false,
+			expectedError:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -244,6 +312,39 @@ func TestNewNotifyChannelHandler(t *testing.T) {
 	}
 }
 
+func TestNewNotifyChannelHandler_MissingWebhookURL(t *testing.T) {
+	targets := []*routingv1.NotificationTarget{
+		{Channel: routingv1.ChannelType_CHANNEL_TYPE_DISCORD, Discord: &routingv1.DiscordTarget{Username: "Alerting"}},
+		{Channel: routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT},
+		{Channel: routingv1.ChannelType_CHANNEL_TYPE_GOOGLE_CHAT, GoogleChat: &routingv1.GoogleChatTarget{UseCardsV2: true}},
+	}
+
+	for _, target := range targets {
+		called := false
+		handler := NewNotifyChannelHandler(&MockNotificationService{
+			NotifyChannelFunc: func(ctx context.Context, target *routingv1.NotificationTarget, templateID string, alert *routingv1.Alert) error {
+				called = true
+				return nil
+			},
+		})
+		action := &routingv1.RoutingAction{
+			Type:          routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL,
+			NotifyChannel: &routingv1.NotifyChannelAction{Target: target},
+		}
+
+		result, err := handler(context.Background(), &routingv1.Alert{Id: "alert-1"}, action)
+		if !errors.Is(err, ErrInvalidAction) {
+			t.Errorf("%s: expected ErrInvalidAction, got %v", target.Channel, err)
+		}
+		if result.Retryable {
+			t.Errorf("%s: expected invalid target not to be retryable", target.Channel)
+		}
+		if called {
+			t.Errorf("%s: expected the notification service not to be called", target.Channel)
+		}
+	}
+}
+
 func TestNewNotifyUserHandler(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -629,6 +730,203 @@ func TestNewCreateTicketHandler(t *testing.T) {
 	}
 }
 
+func TestNewForwardPagerDutyHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		action         *routingv1.RoutingAction
+		mockErr        error
+		expectedResult bool
+		expectedError  bool
+	}{
+		{
+			name: "successful forward",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY,
+				ForwardPagerduty: &routingv1.ForwardPagerDutyAction{
+					RoutingKey:    "R0UT1NGK3Y",
+					DedupKeyLabel: "incident_key",
+				},
+			},
+			expectedResult: true,
+			expectedError:  false,
+		},
+		{
+			name: "missing forward config",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY,
+			},
+			expectedResult: false,
+			expectedError:  true,
+		},
+		{
+			name: "empty routing key",
+			action: &routingv1.RoutingAction{
+				Type:             routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY,
+				ForwardPagerduty: &routingv1.ForwardPagerDutyAction{},
+			},
+			expectedResult: false,
+			expectedError:  true,
+		},
+		{
+			name: "forwarding error",
+			action: &routingv1.RoutingAction{
+				Type: routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY,
+				ForwardPagerduty: &routingv1.ForwardPagerDutyAction{
+					RoutingKey: "R0UT1NGK3Y",
+				},
+			},
+			mockErr:        errors.New("pagerduty unavailable"),
+			expectedResult: false,
+			expectedError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := &MockForwardingService{
+				ForwardPagerDutyFunc: func(ctx context.Context, config *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error {
+					return tt.mockErr
+				},
+			}
+			handler := NewForwardPagerDutyHandler(mockSvc)
+			alert := &routingv1.Alert{Id: "alert-1"}
+
+			result, err := handler(context.Background(), alert, tt.action)
+
+			if (err != nil) != tt.expectedError {
+				t.Errorf("handler error = %v, expected error = %v", err, tt.expectedError)
+			}
+
+			if result.Success != tt.expectedResult {
+				t.Errorf("result.Success = %v, expected %v", result.Success, tt.expectedResult)
+			}
+		})
+	}
+}
+
+func TestNewNotifySNSHandler(t *testing.T) {
+	const topicARN = "arn:aws:sns:eu-west-1:123456789012:alerts"
+
+	tests := []struct {
+		name            string
+		action          *routingv1.NotifySNSAction
+		mockErr         error
+		expectedResult  bool
+		expectedSubject string
+		expectedMessage string
+	}{
+		{
+			name: "templates",
+			action: &routingv1.NotifySNSAction{
+				TopicArn:        topicARN,
+				SubjectTemplate: `[{{ index .Labels "severity" }}] {{ .Summary }}`,
+				MessageTemplate: `{"alert":"{{ .Id }}","site":"{{ index .Labels "site" }}"}`,
+				Region:          "eu-west-1",
+			},
+			expectedResult:  true,
+			expectedSubject: "[critical] Disk full",
+			expectedMessage: `{"alert":"alert-1","site":"nyc1"}`,
+		},
+		{
+			name:            "defaults",
+			action:          &routingv1.NotifySNSAction{TopicArn: topicARN},
+			expectedResult:  true,
+			expectedSubject: "Disk full",
+		},
+		{
+			name:           "missing config",
+			expectedResult: false,
+		},
+		{
+			name:           "invalid topic arn",
+			action:         &routingv1.NotifySNSAction{TopicArn: "alerts"},
+			expectedResult: false,
+		},
+		{
+			name:           "region mismatch",
+			action:         &routingv1.NotifySNSAction{TopicArn: topicARN, Region: "us-east-1"},
+			expectedResult: false,
+		},
+		{
+			name:           "invalid template",
+			action:         &routingv1.NotifySNSAction{TopicArn: topicARN, SubjectTemplate: "{{ .Summary"},
+			expectedResult: false,
+		},
+		{
+			name:           "publish error",
+			action:         &routingv1.NotifySNSAction{TopicArn: topicARN},
+			mockErr:        errors.New("sns unavailable"),
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var published bool
+			var subject, message string
+			mockSvc := &MockSNSService{
+				PublishFunc: func(ctx context.Context, gotTopicARN, gotSubject, gotMessage string) error {
+					published = true
+					if gotTopicARN != topicARN {
+						t.Errorf("topic ARN = %q, expected %q", gotTopicARN, topicARN)
+					}
+					subject, message = gotSubject, gotMessage
+					return tt.mockErr
+				},
+			}
+			handler := NewNotifySNSHandler(mockSvc)
+			alert := &routingv1.Alert{
+				Id:      "alert-1",
+				Summary: "Disk full",
+				Labels:  map[string]string{"severity": "critical", "site": "nyc1"},
+			}
+			action := &routingv1.RoutingAction{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS, NotifySns: tt.action}
+
+			result, err := handler(context.Background(), alert, action)
+
+			if (err != nil) == tt.expectedResult {
+				t.Errorf("handler error = %v, expected success = %v", err, tt.expectedResult)
+			}
+			if result.Success != tt.expectedResult {
+				t.Errorf("result.Success = %v, expected %v", result.Success, tt.expectedResult)
+			}
+			if tt.mockErr == nil && !tt.expectedResult {
+				if !errors.Is(err, ErrInvalidAction) || published {
+					t.Errorf("expected invalid action without publishing, got err=%v published=%v", err, published)
+				}
+				return
+			}
+			if tt.mockErr != nil && !result.Retryable {
+				t.Error("expected publish errors to be retryable")
+			}
+			if !tt.expectedResult {
+				return
+			}
+			if subject != tt.expectedSubject {
+				t.Errorf("subject = %q, expected %q", subject, tt.expectedSubject)
+			}
+			if tt.expectedMessage != "" && message != tt.expectedMessage {
+				t.Errorf("message = %q, expected %q", message, tt.expectedMessage)
+			}
+			if tt.expectedMessage == "" {
+				var decoded map[string]interface{}
+				if err := json.Unmarshal([]byte(message), &decoded); err != nil || decoded["summary"] != "Disk full" {
+					t.Errorf("expected the alert as JSON by default, got %q", message)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncateSNSSubject(t *testing.T) {
+	if got := truncateSNSSubject("first line\nsecond line"); got != "first line" {
+		t.Errorf("expected only the first line, got %q", got)
+	}
+	if got := truncateSNSSubject(strings.Repeat("x", 150)); len(got) != MaxSNSSubjectLength {
+		t.Errorf("expected subject to be truncated to %d characters, got %d", MaxSNSSubjectLength, len(got))
+	}
+}
+
 func TestNewSetLabelHandler(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -701,6 +999,7 @@ func TestRegisterAllHandlers(t *testing.T) {
 		AlertService:        &MockAlertService{},
 		EscalationService:   &MockEscalationService{},
 		TicketService:       &MockTicketService{},
+		SNSService:          &MockSNSService{},
 	}
 
 	RegisterAllHandlers(executor, handlers)
@@ -708,8 +1007,149 @@ func TestRegisterAllHandlers(t *testing.T) {
 	registered := executor.GetRegisteredActions()
 
 	// Should have registered: notify_team, notify_channel, notify_user, notify_oncall,
-	// suppress, aggregate, set_label, escalate, create_ticket
-	if len(registered) != 9 {
-		t.Errorf("Expected 9 registered handlers, got %d", len(registered))
+	// suppress, aggregate, set_label, escalate, create_ticket, notify_sns
+	if len(registered) != 10 {
+		t.Errorf("Expected 10 registered handlers, got %d", len(registered))
+	}
+}
+
+func TestRegisterAllHandlers_OutageMode(t *testing.T) {
+	ctx := context.Background()
+	metrics := NewMetrics()
+	executor := NewDefaultExecutor(nil, zerolog.Nop(), metrics)
+	outageMode := outage.NewInMemoryStore()
+
+	notified := 0
+	RegisterAllHandlers(executor, &ActionHandlers{
+		NotificationService: &MockNotificationService{
+			NotifyTeamFunc: func(ctx context.Context, teamID string, scope routingv1.TeamNotifyScope, templateID string, alert *routingv1.Alert) error {
+				notified++
+				return nil
+			},
+		},
+		OutageMode: outageMode,
+	})
+
+	alert := &routingv1.Alert{Id: "alert-1"}
+	actions := []*routingv1.RoutingAction{
+		{
+			Type:       routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM,
+			NotifyTeam: &routingv1.NotifyTeamAction{TeamId: "team-1"},
+		},
+	}
+
+	if err := outageMode.SetOutageMode(ctx, "datacenter power loss", time.Hour); err != nil {
+		t.Fatalf("SetOutageMode failed: %v", err)
+	}
+
+	results, err := executor.Execute(ctx, alert, actions)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !results[0].Success || results[0].Message != OutageModeSkipMessage {
+		t.Errorf("expected skipped result, got success=%v message=%q", results[0].Success, results[0].Message)
+	}
+	if notified != 0 {
+		t.Errorf("expected no notifications during outage mode, got %d", notified)
+	}
+	if got := metrics.GetOutageModeSkippedTotal(routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM.String()); got != 1 {
+		t.Errorf("expected 1 skipped notification, got %d", got)
+	}
+
+	if err := outageMode.ClearOutageMode(ctx); err != nil {
+		t.Fatalf("ClearOutageMode failed: %v", err)
+	}
+
+	results, err = executor.Execute(ctx, alert, actions)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if results[0].Message == OutageModeSkipMessage {
+		t.Error("expected notification to be sent after outage mode was cleared")
+	}
+	if notified != 1 {
+		t.Errorf("expected 1 notification after clear, got %d", notified)
+	}
+}
+
+func TestRegisterAllHandlers_OutageModeSkipsSNS(t *testing.T) {
+	ctx := context.Background()
+	metrics := NewMetrics()
+	executor := NewDefaultExecutor(nil, zerolog.Nop(), metrics)
+	outageMode := outage.NewInMemoryStore()
+
+	published := 0
+	RegisterAllHandlers(executor, &ActionHandlers{
+		SNSService: &MockSNSService{
+			PublishFunc: func(ctx context.Context, topicARN, subject, message string) error {
+				published++
+				return nil
+			},
+		},
+		OutageMode: outageMode,
+	})
+
+	if err := outageMode.SetOutageMode(ctx, "datacenter power loss", time.Hour); err != nil {
+		t.Fatalf("SetOutageMode failed: %v", err)
+	}
+
+	results, err := executor.Execute(ctx, &routingv1.Alert{Id: "alert-1"}, []*routingv1.RoutingAction{
+		{
+			Type:      routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS,
+			NotifySns: &routingv1.NotifySNSAction{TopicArn: "arn:aws:sns:us-east-1:123456789012:alerts"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !results[0].Success || results[0].Message != OutageModeSkipMessage {
+		t.Errorf("expected skipped result, got success=%v message=%q", results[0].Success, results[0].Message)
+	}
+	if published != 0 {
+		t.Errorf("expected no SNS publish during outage mode, got %d", published)
+	}
+	if got := metrics.GetOutageModeSkippedTotal(routingv1.ActionType_ACTION_TYPE_NOTIFY_SNS.String()); got != 1 {
+		t.Errorf("expected 1 skipped SNS notification, got %d", got)
+	}
+}
+
+func TestRegisterAllHandlers_OutageModeSkipsPagerDutyForwarding(t *testing.T) {
+	ctx := context.Background()
+	metrics := NewMetrics()
+	executor := NewDefaultExecutor(nil, zerolog.Nop(), metrics)
+	outageMode := outage.NewInMemoryStore()
+
+	forwarded := 0
+	RegisterAllHandlers(executor, &ActionHandlers{
+		ForwardingService: &MockForwardingService{
+			ForwardPagerDutyFunc: func(ctx context.Context, config *routingv1.ForwardPagerDutyAction, alert *routingv1.Alert) error {
+				forwarded++
+				return nil
+			},
+		},
+		OutageMode: outageMode,
+	})
+
+	if err := outageMode.SetOutageMode(ctx, "datacenter power loss", time.Hour); err != nil {
+		t.Fatalf("SetOutageMode failed: %v", err)
+	}
+
+	results, err := executor.Execute(ctx, &routingv1.Alert{Id: "alert-1"}, []*routingv1.RoutingAction{
+		{
+			Type:             routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY,
+			ForwardPagerduty: &routingv1.ForwardPagerDutyAction{RoutingKey: "R0UT1NGK3Y"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !results[0].Success || results[0].Message != OutageModeSkipMessage {
+		t.Errorf("expected skipped result, got success=%v message=%q", results[0].Success, results[0].Message)
+	}
+	if forwarded != 0 {
+		t.Errorf("expected no PagerDuty forwarding during outage mode, got %d", forwarded)
+	}
+	if got := metrics.GetOutageModeSkippedTotal(routingv1.ActionType_ACTION_TYPE_FORWARD_PAGERDUTY.String()); got != 1 {
+		t.Errorf("expected 1 skipped PagerDuty forward, got %d", got)
 	}
 }
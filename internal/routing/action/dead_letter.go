@@ -0,0 +1,357 @@
+package action
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// ErrRetryNotFound is returned when a pending retry does not exist.
+var ErrRetryNotFound = errors.New("pending retry not found")
+
+// RetryLease is how long retries returned by DeadLetterQueue.Due are hidden
+// from other workers while they are executed.
+const RetryLease = 5 * time.Minute
+
+// PendingRetry is an action that failed with a retryable error and is waiting
+// in the dead-letter queue to be executed again.
+type PendingRetry struct {
+	ID string
+	// RuleID is the rule the action belongs to, empty when the action was not
+	// executed for a rule.
+	RuleID string
+	// ActionIndex is the position of the action in the executed action list.
+	ActionIndex int
+	AlertID     string
+	// Alert and Action are snapshots taken when the action first failed.
+	Alert  *routingv1.Alert
+	Action *routingv1.RoutingAction
+	// AttemptCount counts the executions so far, including the first one.
+	AttemptCount int
+	// NextRetryAt is when the action is retried next. It is zero once the
+	// retries are exhausted.
+	NextRetryAt time.Time
+	LastError   string
+	CreatedAt   time.Time
+}
+
+// DeadLetterQueue stores actions that failed with a retryable error until the
+// RetryWorker executes them again.
+type DeadLetterQueue interface {
+	// Enqueue adds a failed action to the queue.
+	Enqueue(ctx context.Context, retry *PendingRetry) error
+	// Due returns up to limit retries whose NextRetryAt is not after now, the
+	// longest overdue when more are due, and postpones them by RetryLease so
+	// concurrent workers do not execute them twice. Exhausted retries are never
+	// due.
+	Due(ctx context.Context, now time.Time, limit int) ([]*PendingRetry, error)
+	// Reschedule records a failed retry and when to try again.
+	Reschedule(ctx context.Context, id string, attemptCount int, nextRetryAt time.Time, lastError string) error
+	// MarkExhausted records the last failed retry and keeps the action as a
+	// dead letter that is not retried again.
+	MarkExhausted(ctx context.Context, id string, attemptCount int, lastError string) error
+	// Delete removes a retry that succeeded.
+	Delete(ctx context.Context, id string) error
+}
+
+// PostgresDeadLetterQueue implements DeadLetterQueue using the
+// pending_action_retries table.
+type PostgresDeadLetterQueue struct {
+	db *sql.DB
+}
+
+// NewPostgresDeadLetterQueue creates a new PostgresDeadLetterQueue.
+func NewPostgresDeadLetterQueue(db *sql.DB) *PostgresDeadLetterQueue {
+	return &PostgresDeadLetterQueue{db: db}
+}
+
+// Enqueue inserts a failed action.
+func (q *PostgresDeadLetterQueue) Enqueue(ctx context.Context, retry *PendingRetry) error {
+	if retry == nil || retry.Alert == nil || retry.Action == nil {
+		return fmt.Errorf("%w: pending retry needs an alert and an action", ErrInvalidAction)
+	}
+	if retry.ID == "" {
+		retry.ID = uuid.New().String()
+	}
+
+	alertJSON, err := protojson.Marshal(retry.Alert)
+	if err != nil {
+		return fmt.Errorf("marshal alert snapshot: %w", err)
+	}
+	actionJSON, err := protojson.Marshal(retry.Action)
+	if err != nil {
+		return fmt.Errorf("marshal action: %w", err)
+	}
+
+	var ruleID *string
+	if retry.RuleID != "" {
+		ruleID = &retry.RuleID
+	}
+
+	err = q.db.QueryRowContext(ctx, `
+		INSERT INTO pending_action_retries (id, rule_id, action_index, alert_id, alert_snapshot, action,
+			attempt_count, next_retry_at, last_error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at
+	`, retry.ID, ruleID, retry.ActionIndex, retry.AlertID, alertJSON, actionJSON,
+		retry.AttemptCount, retry.NextRetryAt, retry.LastError).Scan(&retry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert pending retry: %w", err)
+	}
+	return nil
+}
+
+// Due leases the retries that are due. Rows leased by another worker are skipped.
+func (q *PostgresDeadLetterQueue) Due(ctx context.Context, now time.Time, limit int) ([]*PendingRetry, error) {
+	rows, err := q.db.QueryContext(ctx, `
+		UPDATE pending_action_retries SET next_retry_at = $3, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM pending_action_retries
+			WHERE next_retry_at IS NOT NULL AND next_retry_at <= $1
+			ORDER BY next_retry_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, rule_id, action_index, alert_id, alert_snapshot, action,
+			attempt_count, next_retry_at, last_error, created_at
+	`, now, limit, now.Add(RetryLease))
+	if err != nil {
+		return nil, fmt.Errorf("query pending retries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var retries []*PendingRetry
+	for rows.Next() {
+		retry := &PendingRetry{Alert: &routingv1.Alert{}, Action: &routingv1.RoutingAction{}}
+		var ruleID, lastError sql.NullString
+		var nextRetryAt sql.NullTime
+		var alertJSON, actionJSON []byte
+
+		if err := rows.Scan(&retry.ID, &ruleID, &retry.ActionIndex, &retry.AlertID, &alertJSON, &actionJSON,
+			&retry.AttemptCount, &nextRetryAt, &lastError, &retry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan pending retry: %w", err)
+		}
+		if err := protojson.Unmarshal(alertJSON, retry.Alert); err != nil {
+			return nil, fmt.Errorf("unmarshal alert snapshot of retry %s: %w", retry.ID, err)
+		}
+		if err := protojson.Unmarshal(actionJSON, retry.Action); err != nil {
+			return nil, fmt.Errorf("unmarshal action of retry %s: %w", retry.ID, err)
+		}
+
+		retry.RuleID = ruleID.String
+		retry.NextRetryAt = nextRetryAt.Time
+		retry.LastError = lastError.String
+		retries = append(retries, retry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery
+	sort.Slice(retries, func(i, j int) bool {
+		return retries[i].CreatedAt.Before(retries[j].CreatedAt)
+	})
+	return retries, nil
+}
+
+// Reschedule records a failed retry.
+func (q *PostgresDeadLetterQueue) Reschedule(ctx context.Context, id string, attemptCount int, nextRetryAt time.Time, lastError string) error {
+	return q.update(ctx, id, attemptCount, &nextRetryAt, lastError)
+}
+
+// MarkExhausted stops retrying an action.
+func (q *PostgresDeadLetterQueue) MarkExhausted(ctx context.Context, id string, attemptCount int, lastError string) error {
+	return q.update(ctx, id, attemptCount, nil, lastError)
+}
+
+// update records a failed retry; a nil nextRetryAt exhausts the retry.
+func (q *PostgresDeadLetterQueue) update(ctx context.Context, id string, attemptCount int, nextRetryAt *time.Time, lastError string) error {
+	result, err := q.db.ExecContext(ctx, `
+		UPDATE pending_action_retries
+		SET attempt_count = $1, next_retry_at = $2, last_error = $3, updated_at = NOW()
+		WHERE id = $4
+	`, attemptCount, nextRetryAt, lastError, id)
+	if err != nil {
+		return fmt.Errorf("update pending retry: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrRetryNotFound
+	}
+	return nil
+}
+
+// Delete removes a retry.
+func (q *PostgresDeadLetterQueue) Delete(ctx context.Context, id string) error {
+	result, err := q.db.ExecContext(ctx, "DELETE FROM pending_action_retries WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete pending retry: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrRetryNotFound
+	}
+	return nil
+}
+
+// InMemoryDeadLetterQueue implements DeadLetterQueue in memory, for tests and
+// single-instance deployments without a database.
+type InMemoryDeadLetterQueue struct {
+	mu      sync.Mutex
+	retries map[string]*PendingRetry
+}
+
+// NewInMemoryDeadLetterQueue creates an empty in-memory dead-letter queue.
+func NewInMemoryDeadLetterQueue() *InMemoryDeadLetterQueue {
+	return &InMemoryDeadLetterQueue{retries: make(map[string]*PendingRetry)}
+}
+
+// Enqueue adds a failed action.
+func (q *InMemoryDeadLetterQueue) Enqueue(ctx context.Context, retry *PendingRetry) error {
+	if retry == nil || retry.Alert == nil || retry.Action == nil {
+		return fmt.Errorf("%w: pending retry needs an alert and an action", ErrInvalidAction)
+	}
+	if retry.ID == "" {
+		retry.ID = uuid.New().String()
+	}
+	retry.CreatedAt = time.Now()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.retries[retry.ID] = clonePendingRetry(retry)
+	return nil
+}
+
+// Due leases the retries that are due.
+func (q *InMemoryDeadLetterQueue) Due(ctx context.Context, now time.Time, limit int) ([]*PendingRetry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var due []*PendingRetry
+	for _, retry := range q.retries {
+		if !retry.NextRetryAt.IsZero() && !retry.NextRetryAt.After(now) {
+			due = append(due, retry)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].NextRetryAt.Before(due[j].NextRetryAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	leased := make([]*PendingRetry, 0, len(due))
+	for _, retry := range due {
+		retry.NextRetryAt = now.Add(RetryLease)
+		leased = append(leased, clonePendingRetry(retry))
+	}
+	return leased, nil
+}
+
+// Reschedule records a failed retry.
+func (q *InMemoryDeadLetterQueue) Reschedule(ctx context.Context, id string, attemptCount int, nextRetryAt time.Time, lastError string) error {
+	return q.update(id, attemptCount, nextRetryAt, lastError)
+}
+
+// MarkExhausted stops retrying an action.
+func (q *InMemoryDeadLetterQueue) MarkExhausted(ctx context.Context, id string, attemptCount int, lastError string) error {
+	return q.update(id, attemptCount, time.Time{}, lastError)
+}
+
+func (q *InMemoryDeadLetterQueue) update(id string, attemptCount int, nextRetryAt time.Time, lastError string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	retry, ok := q.retries[id]
+	if !ok {
+		return ErrRetryNotFound
+	}
+	retry.AttemptCount = attemptCount
+	retry.NextRetryAt = nextRetryAt
+	retry.LastError = lastError
+	return nil
+}
+
+// Delete removes a retry.
+func (q *InMemoryDeadLetterQueue) Delete(ctx context.Context, id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.retries[id]; !ok {
+		return ErrRetryNotFound
+	}
+	delete(q.retries, id)
+	return nil
+}
+
+// Get returns a copy of the retry with the given ID.
+func (q *InMemoryDeadLetterQueue) Get(id string) (*PendingRetry, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	retry, ok := q.retries[id]
+	if !ok {
+		return nil, false
+	}
+	return clonePendingRetry(retry), true
+}
+
+// Len returns the number of queued retries, including exhausted ones.
+func (q *InMemoryDeadLetterQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.retries)
+}
+
+// clonePendingRetry copies a retry so callers cannot modify the stored one.
+func clonePendingRetry(retry *PendingRetry) *PendingRetry {
+	clone := *retry
+	clone.Alert = proto.Clone(retry.Alert).(*routingv1.Alert)
+	clone.Action = proto.Clone(retry.Action).(*routingv1.RoutingAction)
+	return &clone
+}
+
+var (
+	_ DeadLetterQueue = (*PostgresDeadLetterQueue)(nil)
+	_ DeadLetterQueue = (*InMemoryDeadLetterQueue)(nil)
+)
+
+// ruleIDContextKey is the context key of the rule whose actions are executed.
+type ruleIDContextKey struct{}
+
+// WithRuleID returns a copy of ctx recording that the executed actions belong
+// to ruleID, so failed actions are dead-lettered with their rule.
+func WithRuleID(ctx context.Context, ruleID string) context.Context {
+	return context.WithValue(ctx, ruleIDContextKey{}, ruleID)
+}
+
+// RuleIDFromContext returns the rule set by WithRuleID, or an empty string.
+func RuleIDFromContext(ctx context.Context) string {
+	ruleID, _ := ctx.Value(ruleIDContextKey{}).(string)
+	return ruleID
+}
+
+// retryExecutionKey marks the contexts of RetryWorker executions, whose
+// failures are handled by the worker instead of being enqueued again.
+type retryExecutionKey struct{}
+
+func withRetryExecution(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryExecutionKey{}, true)
+}
+
+func isRetryExecution(ctx context.Context) bool {
+	retry, _ := ctx.Value(retryExecutionKey{}).(bool)
+	return retry
+}
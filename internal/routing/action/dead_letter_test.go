@@ -0,0 +1,156 @@
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func newTestPendingRetry(alertID string, nextRetryAt time.Time) *PendingRetry {
+	return &PendingRetry{
+		RuleID:       "rule-1",
+		AlertID:      alertID,
+		Alert:        &routingv1.Alert{Id: alertID, Summary: "disk full"},
+		Action:       &routingv1.RoutingAction{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+		AttemptCount: 1,
+		NextRetryAt:  nextRetryAt,
+		LastError:    "connection refused",
+	}
+}
+
+func TestPostgresDeadLetterQueue_Enqueue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	retry := newTestPendingRetry("alert-1", now.Add(RetryBackoff(1)))
+
+	mock.ExpectQuery("INSERT INTO pending_action_retries").
+		WithArgs(sqlmock.AnyArg(), "rule-1", 0, "alert-1", sqlmock.AnyArg(), sqlmock.AnyArg(),
+			1, now.Add(30*time.Second), "connection refused").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+
+	q := NewPostgresDeadLetterQueue(db)
+	if err := q.Enqueue(context.Background(), retry); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if retry.ID == "" {
+		t.Error("expected an ID to be assigned")
+	}
+	if !retry.CreatedAt.Equal(now) {
+		t.Errorf("CreatedAt = %v, want %v", retry.CreatedAt, now)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestPostgresDeadLetterQueue_Due(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	columns := []string{"id", "rule_id", "action_index", "alert_id", "alert_snapshot", "action",
+		"attempt_count", "next_retry_at", "last_error", "created_at"}
+
+	mock.ExpectQuery("UPDATE pending_action_retries SET next_retry_at = \\$3").
+		WithArgs(now, 10, now.Add(RetryLease)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("retry-2", nil, 1, "alert-2", []byte(`{"id":"alert-2"}`), []byte(`{"type":"ACTION_TYPE_NOTIFY_TEAM"}`),
+				2, now.Add(RetryLease), "timeout", now.Add(-time.Minute)).
+			AddRow("retry-1", "rule-1", 0, "alert-1", []byte(`{"id":"alert-1"}`), []byte(`{"type":"ACTION_TYPE_NOTIFY_TEAM"}`),
+				1, now.Add(RetryLease), "connection refused", now.Add(-2*time.Minute)))
+
+	q := NewPostgresDeadLetterQueue(db)
+	retries, err := q.Due(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("Due() error = %v", err)
+	}
+	if len(retries) != 2 {
+		t.Fatalf("expected 2 retries, got %d", len(retries))
+	}
+	if retries[0].ID != "retry-1" || retries[0].RuleID != "rule-1" || retries[0].Alert.GetId() != "alert-1" {
+		t.Errorf("unexpected first retry: %+v", retries[0])
+	}
+	if retries[1].RuleID != "" || retries[1].AttemptCount != 2 {
+		t.Errorf("unexpected second retry: %+v", retries[1])
+	}
+	if retries[1].Action.GetType() != routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM {
+		t.Errorf("expected the action to be restored, got %v", retries[1].Action.GetType())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestPostgresDeadLetterQueue_MarkExhausted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE pending_action_retries").
+		WithArgs(5, nil, "timeout", "retry-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	q := NewPostgresDeadLetterQueue(db)
+	if err := q.MarkExhausted(context.Background(), "retry-1", 5, "timeout"); err != ErrRetryNotFound {
+		t.Errorf("MarkExhausted() error = %v, want %v", err, ErrRetryNotFound)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestInMemoryDeadLetterQueue_DueLeasesRetries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	q := NewInMemoryDeadLetterQueue()
+
+	for _, retry := range []*PendingRetry{
+		newTestPendingRetry("later", now.Add(time.Minute)),
+		newTestPendingRetry("overdue", now.Add(-time.Minute)),
+		newTestPendingRetry("due", now),
+	} {
+		if err := q.Enqueue(ctx, retry); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	due, err := q.Due(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("Due() error = %v", err)
+	}
+	if len(due) != 2 || due[0].AlertID != "overdue" || due[1].AlertID != "due" {
+		t.Fatalf("expected the overdue and due retries in order, got %d retries", len(due))
+	}
+
+	// Leased retries are not returned again until the lease expires
+	if again, _ := q.Due(ctx, now, 10); len(again) != 0 {
+		t.Errorf("expected leased retries to be skipped, got %d", len(again))
+	}
+	if again, _ := q.Due(ctx, now.Add(RetryLease), 10); len(again) != 3 {
+		t.Errorf("expected all retries after the lease, got %d", len(again))
+	}
+
+	if err := q.MarkExhausted(ctx, due[0].ID, MaxRetryAttempts, "timeout"); err != nil {
+		t.Fatalf("MarkExhausted() error = %v", err)
+	}
+	if again, _ := q.Due(ctx, now.Add(time.Hour), 10); len(again) != 2 {
+		t.Errorf("expected exhausted retries to be kept out of Due, got %d", len(again))
+	}
+	if q.Len() != 3 {
+		t.Errorf("expected exhausted retries to be kept, got %d", q.Len())
+	}
+}
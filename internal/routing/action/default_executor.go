@@ -8,6 +8,7 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/kneutral-org/alerting-system/internal/metrics"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -19,10 +20,34 @@ type DefaultExecutor struct {
 	mu       sync.RWMutex
 	logger   zerolog.Logger
 	metrics  *Metrics
+
+	// registry exports action durations to Prometheus (optional)
+	registry *metrics.Registry
+
+	// deadLetters receives actions that failed with a retryable error (optional)
+	deadLetters DeadLetterQueue
+}
+
+// ExecutorOption configures optional DefaultExecutor dependencies.
+type ExecutorOption func(*DefaultExecutor)
+
+// WithMetricsRegistry exports action durations to the Prometheus registry.
+func WithMetricsRegistry(registry *metrics.Registry) ExecutorOption {
+	return func(e *DefaultExecutor) {
+		e.registry = registry
+	}
+}
+
+// WithDeadLetterQueue enqueues actions that still fail with a retryable error
+// after the in-process retries, for the RetryWorker to execute again later.
+func WithDeadLetterQueue(queue DeadLetterQueue) ExecutorOption {
+	return func(e *DefaultExecutor) {
+		e.deadLetters = queue
+	}
 }
 
 // NewDefaultExecutor creates a new DefaultExecutor with the provided configuration.
-func NewDefaultExecutor(config *ExecutorConfig, logger zerolog.Logger, metrics *Metrics) *DefaultExecutor {
+func NewDefaultExecutor(config *ExecutorConfig, logger zerolog.Logger, metrics *Metrics, opts ...ExecutorOption) *DefaultExecutor {
 	if config == nil {
 		config = DefaultExecutorConfig()
 	}
@@ -33,6 +58,9 @@ func NewDefaultExecutor(config *ExecutorConfig, logger zerolog.Logger, metrics *
 		logger:   logger.With().Str("component", "action_executor").Logger(),
 		metrics:  metrics,
 	}
+	for _, opt := range opts {
+		opt(executor)
+	}
 
 	return executor
 }
@@ -67,6 +95,11 @@ func (e *DefaultExecutor) Execute(ctx context.Context, alert *routingv1.Alert, a
 			}
 			e.metrics.RecordActionExecution(result.ActionType, status, result.Duration)
 		}
+		e.registry.ObserveRoutingAction(result.ActionType, result.Duration)
+
+		if !result.Success && result.Retryable {
+			e.deadLetter(ctx, alert, action, i, result)
+		}
 
 		if !result.Success {
 			lastError = result.Error
@@ -174,6 +207,45 @@ func (e *DefaultExecutor) executeAction(ctx context.Context, alert *routingv1.Al
 	return result
 }
 
+// deadLetter enqueues a failed retryable action. Actions executed by the
+// RetryWorker are not enqueued again, the worker reschedules them.
+func (e *DefaultExecutor) deadLetter(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction, index int, result *Result) {
+	if e.deadLetters == nil || isRetryExecution(ctx) {
+		return
+	}
+
+	lastError := result.Message
+	if result.Error != nil {
+		lastError = result.Error.Error()
+	}
+
+	retry := &PendingRetry{
+		RuleID:       RuleIDFromContext(ctx),
+		ActionIndex:  index,
+		AlertID:      alert.Id,
+		Alert:        alert,
+		Action:       action,
+		AttemptCount: 1,
+		NextRetryAt:  time.Now().Add(RetryBackoff(1)),
+		LastError:    lastError,
+	}
+	if err := e.deadLetters.Enqueue(ctx, retry); err != nil {
+		e.logger.Error().
+			Err(err).
+			Str("alert_id", alert.Id).
+			Str("action_type", result.ActionType).
+			Msg("failed to enqueue action for retry")
+		return
+	}
+
+	e.logger.Info().
+		Str("alert_id", alert.Id).
+		Str("action_type", result.ActionType).
+		Str("retry_id", retry.ID).
+		Time("next_retry_at", retry.NextRetryAt).
+		Msg("enqueued failed action for retry")
+}
+
 // GetRegisteredActions returns the list of registered action types.
 func (e *DefaultExecutor) GetRegisteredActions() []routingv1.ActionType {
 	e.mu.RLock()
@@ -1,6 +1,7 @@
 package action
 
 import (
+	"strconv"
 	"sync"
 	"time"
 )
@@ -16,13 +17,32 @@ type Metrics struct {
 
 	// actionDuration tracks action execution durations by type.
 	actionDuration map[string][]time.Duration
+
+	// outageModeSkipped tracks notifications skipped during outage mode by action type.
+	// Exposed as the outage_mode_notifications_skipped_total counter.
+	outageModeSkipped map[string]int64
+
+	// actionRetries tracks dead-letter retries by action type and attempt.
+	// Exposed as the action_retry_total counter.
+	actionRetries map[actionRetryKey]int64
+
+	// deadLetterExhausted tracks actions whose retries were exhausted.
+	// Exposed as the action_dlq_exhausted_total counter.
+	deadLetterExhausted int64
+}
+
+type actionRetryKey struct {
+	actionType string
+	attempt    string
 }
 
 // NewMetrics creates a new Metrics instance.
 func NewMetrics() *Metrics {
 	return &Metrics{
-		actionTotal:    make(map[string]map[string]int64),
-		actionDuration: make(map[string][]time.Duration),
+		actionTotal:       make(map[string]map[string]int64),
+		actionDuration:    make(map[string][]time.Duration),
+		outageModeSkipped: make(map[string]int64),
+		actionRetries:     make(map[actionRetryKey]int64),
 	}
 }
 
@@ -80,6 +100,56 @@ func (m *Metrics) GetActionDurations(actionType string) []time.Duration {
 	return result
 }
 
+// RecordOutageModeSkipped records a notification skipped because outage mode is active.
+func (m *Metrics) RecordOutageModeSkipped(actionType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.outageModeSkipped[actionType]++
+}
+
+// GetOutageModeSkippedTotal returns the number of notifications skipped during
+// outage mode for an action type.
+func (m *Metrics) GetOutageModeSkippedTotal(actionType string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.outageModeSkipped[actionType]
+}
+
+// RecordActionRetry records a retry of a dead-lettered action. attempt counts
+// the executions of the action including the retry.
+func (m *Metrics) RecordActionRetry(actionType string, attempt int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.actionRetries[actionRetryKey{actionType: actionType, attempt: strconv.Itoa(attempt)}]++
+}
+
+// GetActionRetryTotal returns the number of retries of an action type at an attempt.
+func (m *Metrics) GetActionRetryTotal(actionType string, attempt int) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.actionRetries[actionRetryKey{actionType: actionType, attempt: strconv.Itoa(attempt)}]
+}
+
+// RecordDeadLetterExhausted records an action whose retries were exhausted.
+func (m *Metrics) RecordDeadLetterExhausted() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deadLetterExhausted++
+}
+
+// GetDeadLetterExhaustedTotal returns the number of actions whose retries were exhausted.
+func (m *Metrics) GetDeadLetterExhaustedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.deadLetterExhausted
+}
+
 // GetAverageDuration calculates the average duration for an action type.
 func (m *Metrics) GetAverageDuration(actionType string) time.Duration {
 	m.mu.RLock()
@@ -104,6 +174,9 @@ func (m *Metrics) Reset() {
 
 	m.actionTotal = make(map[string]map[string]int64)
 	m.actionDuration = make(map[string][]time.Duration)
+	m.outageModeSkipped = make(map[string]int64)
+	m.actionRetries = make(map[actionRetryKey]int64)
+	m.deadLetterExhausted = 0
 }
 
 // PrometheusMetrics provides Prometheus-compatible metric names and labels.
@@ -117,6 +190,17 @@ type PrometheusMetrics struct {
 	// Labels: action_type
 	ActionDurationName string
 
+	// OutageModeSkippedName is the metric name for the outage mode skipped notifications counter.
+	// Labels: action_type
+	OutageModeSkippedName string
+
+	// ActionRetryName is the metric name for the dead-letter retry counter.
+	// Labels: action_type, attempt
+	ActionRetryName string
+
+	// DeadLetterExhaustedName is the metric name for the exhausted dead-letter counter.
+	DeadLetterExhaustedName string
+
 	// Buckets defines the histogram buckets for action duration.
 	Buckets []float64
 }
@@ -124,8 +208,11 @@ type PrometheusMetrics struct {
 // DefaultPrometheusMetrics returns the default Prometheus metric configuration.
 func DefaultPrometheusMetrics() *PrometheusMetrics {
 	return &PrometheusMetrics{
-		ActionTotalName:    "routing_action_total",
-		ActionDurationName: "routing_action_duration_seconds",
+		ActionTotalName:         "routing_action_total",
+		ActionDurationName:      "routing_action_duration_seconds",
+		OutageModeSkippedName:   "outage_mode_notifications_skipped_total",
+		ActionRetryName:         "action_retry_total",
+		DeadLetterExhaustedName: "action_dlq_exhausted_total",
 		Buckets: []float64{
 			0.001, // 1ms
 			0.005, // 5ms
@@ -0,0 +1,204 @@
+package action
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultRetryInterval is how often the retry worker polls the dead-letter
+	// queue, and the delay before the first retry of a failed action.
+	DefaultRetryInterval = 30 * time.Second
+	// MaxRetryAttempts is how many times an action is executed, including the
+	// first execution, before it is kept as an exhausted dead letter.
+	MaxRetryAttempts = 5
+
+	// retryBatchSize is the number of due retries executed per poll.
+	retryBatchSize = 100
+)
+
+// RetryBackoff returns how long to wait before executing an action again after
+// its attempt-th failed execution: DefaultRetryInterval, doubled after every
+// further failure.
+func RetryBackoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	return DefaultRetryInterval * time.Duration(1<<uint(attempt-1))
+}
+
+// RetryWorker periodically executes the actions in a dead-letter queue that
+// are due for a retry, rescheduling them with exponential backoff until they
+// succeed or MaxRetryAttempts is reached.
+type RetryWorker struct {
+	queue    DeadLetterQueue
+	executor Executor
+	interval time.Duration
+	metrics  *Metrics
+	logger   zerolog.Logger
+
+	// registry exports retry counts to Prometheus (optional)
+	registry *metrics.Registry
+
+	// now returns the time retries are due against
+	now func() time.Time
+
+	// afterFunc schedules the next tick and returns a function that cancels
+	// it; tests replace it to tick on demand
+	afterFunc func(d time.Duration, f func()) (stop func() bool)
+}
+
+// RetryWorkerOption configures optional RetryWorker dependencies.
+type RetryWorkerOption func(*RetryWorker)
+
+// WithRetryMetricsRegistry exports retry counts to the Prometheus registry.
+func WithRetryMetricsRegistry(registry *metrics.Registry) RetryWorkerOption {
+	return func(w *RetryWorker) {
+		w.registry = registry
+	}
+}
+
+// NewRetryWorker creates a worker that retries due actions of queue with
+// executor every interval. A non-positive interval uses DefaultRetryInterval.
+func NewRetryWorker(queue DeadLetterQueue, executor Executor, interval time.Duration, metrics *Metrics, logger zerolog.Logger, opts ...RetryWorkerOption) *RetryWorker {
+	if interval <= 0 {
+		interval = DefaultRetryInterval
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	w := &RetryWorker{
+		queue:    queue,
+		executor: executor,
+		interval: interval,
+		metrics:  metrics,
+		logger:   logger.With().Str("component", "action_retry_worker").Logger(),
+		now:      time.Now,
+		afterFunc: func(d time.Duration, f func()) func() bool {
+			return time.AfterFunc(d, f).Stop
+		},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Metrics returns the metrics recorder for this worker.
+func (w *RetryWorker) Metrics() *Metrics {
+	return w.metrics
+}
+
+// Start retries due actions immediately and then every interval until the
+// context is cancelled. A poll in progress when the context is cancelled is
+// finished before Start returns.
+func (w *RetryWorker) Start(ctx context.Context) {
+	w.logger.Info().Dur("interval", w.interval).Msg("starting action retry worker")
+
+	ticks := make(chan struct{}, 1)
+	schedule := func() func() bool {
+		return w.afterFunc(w.interval, func() {
+			select {
+			case ticks <- struct{}{}:
+			default:
+			}
+		})
+	}
+
+	w.RetryDue(ctx)
+	stop := schedule()
+	for {
+		select {
+		case <-ctx.Done():
+			stop()
+			w.logger.Info().Msg("action retry worker stopped")
+			return
+		case <-ticks:
+			w.RetryDue(ctx)
+			stop = schedule()
+		}
+	}
+}
+
+// RetryDue executes the actions that are due for a retry. Failures to read or
+// update the queue are logged and retried on the next tick.
+func (w *RetryWorker) RetryDue(ctx context.Context) {
+	retries, err := w.queue.Due(ctx, w.now(), retryBatchSize)
+	if err != nil {
+		w.logger.Error().Err(err).Msg("failed to load due action retries")
+		return
+	}
+
+	for _, retry := range retries {
+		if ctx.Err() != nil {
+			return
+		}
+		w.retry(ctx, retry)
+	}
+}
+
+// retry executes one dead-lettered action and records the outcome in the queue.
+func (w *RetryWorker) retry(ctx context.Context, retry *PendingRetry) {
+	attempt := retry.AttemptCount + 1
+	actionType := retry.Action.GetType().String()
+
+	execCtx := withRetryExecution(ctx)
+	if retry.RuleID != "" {
+		execCtx = WithRuleID(execCtx, retry.RuleID)
+	}
+	results, err := w.executor.Execute(execCtx, retry.Alert, []*routingv1.RoutingAction{retry.Action})
+
+	w.metrics.RecordActionRetry(actionType, attempt)
+	w.registry.RecordActionRetry(actionType, attempt)
+
+	logger := w.logger.With().
+		Str("retry_id", retry.ID).
+		Str("alert_id", retry.AlertID).
+		Str("action_type", actionType).
+		Int("attempt", attempt).
+		Logger()
+
+	var result *Result
+	if len(results) > 0 {
+		result = results[0]
+	}
+	if err == nil && result != nil && result.Success {
+		if err := w.queue.Delete(ctx, retry.ID); err != nil {
+			logger.Error().Err(err).Msg("failed to remove succeeded action retry")
+			return
+		}
+		logger.Info().Msg("retried action succeeded")
+		return
+	}
+
+	lastError := "action execution failed"
+	if result != nil && result.Error != nil {
+		lastError = result.Error.Error()
+	} else if err != nil {
+		lastError = err.Error()
+	}
+
+	if result != nil && result.Retryable && attempt < MaxRetryAttempts {
+		nextRetryAt := w.now().Add(RetryBackoff(attempt))
+		if err := w.queue.Reschedule(ctx, retry.ID, attempt, nextRetryAt, lastError); err != nil {
+			logger.Error().Err(err).Msg("failed to reschedule action retry")
+			return
+		}
+		logger.Warn().Str("error", lastError).Time("next_retry_at", nextRetryAt).Msg("retried action failed")
+		return
+	}
+
+	if err := w.queue.MarkExhausted(ctx, retry.ID, attempt, lastError); err != nil {
+		logger.Error().Err(err).Msg("failed to mark action retry exhausted")
+		return
+	}
+	w.metrics.RecordDeadLetterExhausted()
+	w.registry.RecordActionDeadLetterExhausted()
+	logger.Error().Str("error", lastError).Msg("action retries exhausted")
+}
@@ -0,0 +1,186 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// flakyExecutor fails the first failures executions with a retryable error.
+type flakyExecutor struct {
+	failures  int
+	calls     int
+	retryable bool
+	ruleIDs   []string
+}
+
+func (e *flakyExecutor) Execute(ctx context.Context, alert *routingv1.Alert, actions []*routingv1.RoutingAction) ([]*Result, error) {
+	e.calls++
+	e.ruleIDs = append(e.ruleIDs, RuleIDFromContext(ctx))
+	if e.calls <= e.failures {
+		err := errors.New("connection refused")
+		return []*Result{{ActionType: actions[0].Type.String(), Error: err, Retryable: e.retryable}}, err
+	}
+	return []*Result{{ActionType: actions[0].Type.String(), Success: true}}, nil
+}
+
+func (e *flakyExecutor) RegisterAction(actionType routingv1.ActionType, handler ActionHandler) {}
+
+func newTestRetryWorker(t *testing.T, executor Executor, now *time.Time) (*RetryWorker, *InMemoryDeadLetterQueue, *PendingRetry) {
+	t.Helper()
+
+	queue := NewInMemoryDeadLetterQueue()
+	retry := newTestPendingRetry("alert-1", now.Add(RetryBackoff(1)))
+	if err := queue.Enqueue(context.Background(), retry); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	w := NewRetryWorker(queue, executor, 0, nil, zerolog.Nop())
+	w.now = func() time.Time { return *now }
+	return w, queue, retry
+}
+
+func TestRetryBackoff(t *testing.T) {
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute}
+	for i, d := range want {
+		if got := RetryBackoff(i + 1); got != d {
+			t.Errorf("RetryBackoff(%d) = %v, want %v", i+1, got, d)
+		}
+	}
+}
+
+func TestRetryWorker_SucceededRetryIsRemoved(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	executor := &flakyExecutor{}
+	w, queue, _ := newTestRetryWorker(t, executor, &now)
+
+	// Not due yet
+	w.RetryDue(context.Background())
+	if executor.calls != 0 {
+		t.Fatalf("expected no execution before the retry is due, got %d", executor.calls)
+	}
+
+	now = now.Add(30 * time.Second)
+	w.RetryDue(context.Background())
+	if executor.calls != 1 {
+		t.Fatalf("expected 1 execution, got %d", executor.calls)
+	}
+	if executor.ruleIDs[0] != "rule-1" {
+		t.Errorf("expected the rule ID in the execution context, got %q", executor.ruleIDs[0])
+	}
+	if queue.Len() != 0 {
+		t.Errorf("expected the succeeded retry to be removed, got %d", queue.Len())
+	}
+	if got := w.Metrics().GetActionRetryTotal("ACTION_TYPE_NOTIFY_TEAM", 2); got != 1 {
+		t.Errorf("expected 1 retry at attempt 2, got %d", got)
+	}
+}
+
+func TestRetryWorker_BackoffUntilExhausted(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	executor := &flakyExecutor{failures: 10, retryable: true}
+	w, queue, retry := newTestRetryWorker(t, executor, &now)
+
+	// The first execution happened before enqueueing; attempts 2-5 are retries
+	for attempt := 2; attempt <= MaxRetryAttempts; attempt++ {
+		now = now.Add(RetryBackoff(attempt - 1))
+		w.RetryDue(context.Background())
+
+		if executor.calls != attempt-1 {
+			t.Fatalf("attempt %d: expected %d executions, got %d", attempt, attempt-1, executor.calls)
+		}
+		stored, ok := queue.Get(retry.ID)
+		if !ok {
+			t.Fatalf("attempt %d: expected the retry to be kept", attempt)
+		}
+		if stored.AttemptCount != attempt {
+			t.Errorf("attempt %d: AttemptCount = %d", attempt, stored.AttemptCount)
+		}
+		if stored.LastError != "connection refused" {
+			t.Errorf("attempt %d: LastError = %q", attempt, stored.LastError)
+		}
+		if attempt < MaxRetryAttempts && !stored.NextRetryAt.Equal(now.Add(RetryBackoff(attempt))) {
+			t.Errorf("attempt %d: NextRetryAt = %v, want %v", attempt, stored.NextRetryAt, now.Add(RetryBackoff(attempt)))
+		}
+	}
+
+	stored, _ := queue.Get(retry.ID)
+	if !stored.NextRetryAt.IsZero() {
+		t.Errorf("expected the retry to be exhausted, next retry at %v", stored.NextRetryAt)
+	}
+	if got := w.Metrics().GetDeadLetterExhaustedTotal(); got != 1 {
+		t.Errorf("expected 1 exhausted retry, got %d", got)
+	}
+
+	now = now.Add(24 * time.Hour)
+	w.RetryDue(context.Background())
+	if executor.calls != MaxRetryAttempts-1 {
+		t.Errorf("expected exhausted retries not to execute again, got %d executions", executor.calls)
+	}
+}
+
+func TestRetryWorker_PermanentFailureExhausts(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	executor := &flakyExecutor{failures: 1, retryable: false}
+	w, queue, retry := newTestRetryWorker(t, executor, &now)
+
+	now = now.Add(time.Minute)
+	w.RetryDue(context.Background())
+
+	stored, ok := queue.Get(retry.ID)
+	if !ok || !stored.NextRetryAt.IsZero() || stored.AttemptCount != 2 {
+		t.Fatalf("expected the retry to be exhausted after attempt 2, got %+v", stored)
+	}
+	if got := w.Metrics().GetDeadLetterExhaustedTotal(); got != 1 {
+		t.Errorf("expected 1 exhausted retry, got %d", got)
+	}
+}
+
+func TestDefaultExecutor_DeadLettersRetryableFailures(t *testing.T) {
+	queue := NewInMemoryDeadLetterQueue()
+	config := &ExecutorConfig{ContinueOnError: true, Timeout: time.Second}
+	executor := NewDefaultExecutor(config, zerolog.Nop(), NewMetrics(), WithDeadLetterQueue(queue))
+
+	executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM, func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
+		err := errors.New("connection refused")
+		return &Result{ActionType: "ACTION_TYPE_NOTIFY_TEAM", Error: err, Retryable: true}, err
+	})
+	executor.RegisterAction(routingv1.ActionType_ACTION_TYPE_SUPPRESS, func(ctx context.Context, alert *routingv1.Alert, action *routingv1.RoutingAction) (*Result, error) {
+		err := errors.New("invalid config")
+		return &Result{ActionType: "ACTION_TYPE_SUPPRESS", Error: err}, err
+	})
+
+	alert := &routingv1.Alert{Id: "alert-1"}
+	actions := []*routingv1.RoutingAction{
+		{Type: routingv1.ActionType_ACTION_TYPE_SUPPRESS},
+		{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+	}
+	before := time.Now()
+	_, _ = executor.Execute(WithRuleID(context.Background(), "rule-1"), alert, actions)
+
+	if queue.Len() != 1 {
+		t.Fatalf("expected only the retryable failure to be enqueued, got %d", queue.Len())
+	}
+	due, _ := queue.Due(context.Background(), before.Add(time.Hour), 10)
+	if len(due) != 1 {
+		t.Fatalf("expected 1 due retry, got %d", len(due))
+	}
+	retry := due[0]
+	if retry.RuleID != "rule-1" || retry.ActionIndex != 1 || retry.AlertID != "alert-1" || retry.AttemptCount != 1 {
+		t.Errorf("unexpected retry: %+v", retry)
+	}
+	if retry.LastError != "connection refused" {
+		t.Errorf("LastError = %q", retry.LastError)
+	}
+
+	// Executions by the retry worker are not enqueued again
+	_, _ = executor.Execute(withRetryExecution(context.Background()), alert, actions)
+	if queue.Len() != 1 {
+		t.Errorf("expected retry executions not to be enqueued, got %d", queue.Len())
+	}
+}
@@ -0,0 +1,136 @@
+package routing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// DefaultRuleCacheTTL is how long CachingStore serves the enabled rules from its cache.
+const DefaultRuleCacheTTL = 30 * time.Second
+
+// CachingStore wraps a Store, typically the PostgresStore, and caches the
+// enabled rules returned by GetEnabledRulesByPriority for a TTL, separately for
+// each tenant. Rule writes made through the CachingStore invalidate the cache
+// immediately; writes made to the inner store directly are picked up when the
+// cached rules expire.
+type CachingStore struct {
+	Store
+
+	ttl     time.Duration
+	now     func() time.Time
+	metrics *Metrics
+
+	mu sync.Mutex
+	// cached holds the enabled rules of each tenant
+	cached map[string]cachedRules
+	// generation changes on every invalidation so that rules loaded before
+	// a write are not cached after it
+	generation uint64
+}
+
+// cachedRules are the enabled rules of one tenant.
+type cachedRules struct {
+	rules     []*routingv1.RoutingRule
+	expiresAt time.Time
+}
+
+// NewCachingStore creates a CachingStore in front of inner. A non-positive TTL
+// uses DefaultRuleCacheTTL.
+func NewCachingStore(inner Store, ttl time.Duration) *CachingStore {
+	if ttl <= 0 {
+		ttl = DefaultRuleCacheTTL
+	}
+	return &CachingStore{
+		Store:   inner,
+		ttl:     ttl,
+		now:     time.Now,
+		metrics: NewMetrics(),
+	}
+}
+
+// Metrics returns the metrics recorder for this store.
+func (s *CachingStore) Metrics() *Metrics {
+	return s.metrics
+}
+
+// GetEnabledRulesByPriority returns the cached enabled rules, loading them from
+// the inner store when the cache is empty or expired.
+func (s *CachingStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	cached, generation := s.cached[tenantID], s.generation
+	s.mu.Unlock()
+	if cached.rules != nil && s.now().Before(cached.expiresAt) {
+		s.metrics.RecordRuleCacheHit()
+		return cached.rules, nil
+	}
+
+	s.metrics.RecordRuleCacheMiss()
+	rules, err := s.Store.GetEnabledRulesByPriority(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		rules = []*routingv1.RoutingRule{}
+	}
+
+	s.mu.Lock()
+	if s.generation == generation {
+		if s.cached == nil {
+			s.cached = make(map[string]cachedRules)
+		}
+		s.cached[tenantID] = cachedRules{rules: rules, expiresAt: s.now().Add(s.ttl)}
+	}
+	s.mu.Unlock()
+
+	return rules, nil
+}
+
+// CreateRule creates a rule in the inner store and invalidates the cache.
+func (s *CachingStore) CreateRule(ctx context.Context, rule *routingv1.RoutingRule) (*routingv1.RoutingRule, error) {
+	defer s.Invalidate()
+	return s.Store.CreateRule(ctx, rule)
+}
+
+// UpdateRule updates a rule in the inner store and invalidates the cache.
+func (s *CachingStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingRule) (*routingv1.RoutingRule, error) {
+	defer s.Invalidate()
+	return s.Store.UpdateRule(ctx, rule)
+}
+
+// DeleteRule deletes a rule from the inner store and invalidates the cache.
+func (s *CachingStore) DeleteRule(ctx context.Context, id string) error {
+	defer s.Invalidate()
+	return s.Store.DeleteRule(ctx, id)
+}
+
+// ReorderRules reorders rules in the inner store and invalidates the cache.
+func (s *CachingStore) ReorderRules(ctx context.Context, priorities map[string]int32) ([]*routingv1.RoutingRule, error) {
+	defer s.Invalidate()
+	return s.Store.ReorderRules(ctx, priorities)
+}
+
+// CompactPriorities compacts rule priorities in the inner store and invalidates the cache.
+func (s *CachingStore) CompactPriorities(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	defer s.Invalidate()
+	return s.Store.CompactPriorities(ctx)
+}
+
+// Invalidate drops the cached rules of all tenants.
+func (s *CachingStore) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = nil
+	s.generation++
+}
+
+// Ensure CachingStore implements Store
+var _ Store = (*CachingStore)(nil)
@@ -0,0 +1,154 @@
+package routing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func newTestCachingStore(t *testing.T) (*countingStore, *CachingStore, *time.Time) {
+	t.Helper()
+
+	inner := newCountingStore(t)
+	cache := NewCachingStore(inner, 0)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	return inner, cache, &now
+}
+
+func TestCachingStore_ServesFromCacheUntilExpiry(t *testing.T) {
+	inner, cache, now := newTestCachingStore(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		rules, err := cache.GetEnabledRulesByPriority(ctx)
+		if err != nil {
+			t.Fatalf("GetEnabledRulesByPriority failed: %v", err)
+		}
+		if len(rules) != 1 {
+			t.Fatalf("expected 1 rule, got %d", len(rules))
+		}
+	}
+	if inner.enabledRuleCalls != 1 {
+		t.Errorf("expected 1 inner lookup, got %d", inner.enabledRuleCalls)
+	}
+	if got := cache.Metrics().RuleCacheMissesTotal(); got != 1 {
+		t.Errorf("expected 1 cache miss, got %d", got)
+	}
+	if got := cache.Metrics().RuleCacheHitsTotal(); got != 2 {
+		t.Errorf("expected 2 cache hits, got %d", got)
+	}
+
+	*now = now.Add(DefaultRuleCacheTTL)
+	if _, err := cache.GetEnabledRulesByPriority(ctx); err != nil {
+		t.Fatalf("GetEnabledRulesByPriority failed: %v", err)
+	}
+	if inner.enabledRuleCalls != 2 {
+		t.Errorf("expected expired cache to be reloaded, got %d inner lookups", inner.enabledRuleCalls)
+	}
+}
+
+func TestCachingStore_WritesInvalidate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		write func(t *testing.T, store Store, ruleID string)
+		want  int
+	}{
+		{
+			name: "create",
+			write: func(t *testing.T, store Store, _ string) {
+				_, err := store.CreateRule(ctx, &routingv1.RoutingRule{Name: "Second", Priority: 2, Enabled: true})
+				if err != nil {
+					t.Fatalf("CreateRule failed: %v", err)
+				}
+			},
+			want: 2,
+		},
+		{
+			name: "update",
+			write: func(t *testing.T, store Store, ruleID string) {
+				rule, err := store.GetRule(ctx, ruleID)
+				if err != nil {
+					t.Fatalf("GetRule failed: %v", err)
+				}
+				rule.Enabled = false
+				if _, err := store.UpdateRule(ctx, rule); err != nil {
+					t.Fatalf("UpdateRule failed: %v", err)
+				}
+			},
+			want: 0,
+		},
+		{
+			name: "delete",
+			write: func(t *testing.T, store Store, ruleID string) {
+				if err := store.DeleteRule(ctx, ruleID); err != nil {
+					t.Fatalf("DeleteRule failed: %v", err)
+				}
+			},
+			want: 0,
+		},
+		{
+			name: "reorder",
+			write: func(t *testing.T, store Store, ruleID string) {
+				rules, err := store.ReorderRules(ctx, map[string]int32{ruleID: 5})
+				if err != nil {
+					t.Fatalf("ReorderRules failed: %v", err)
+				}
+				if len(rules) != 1 || rules[0].Priority != 5 {
+					t.Fatalf("unexpected reordered rules: %v", rules)
+				}
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner, cache, _ := newTestCachingStore(t)
+
+			rules, err := cache.GetEnabledRulesByPriority(ctx)
+			if err != nil || len(rules) != 1 {
+				t.Fatalf("expected 1 cached rule, got %d (err %v)", len(rules), err)
+			}
+
+			tt.write(t, cache, rules[0].Id)
+
+			rules, err = cache.GetEnabledRulesByPriority(ctx)
+			if err != nil {
+				t.Fatalf("GetEnabledRulesByPriority failed: %v", err)
+			}
+			if len(rules) != tt.want {
+				t.Errorf("expected %d rules after %s, got %d", tt.want, tt.name, len(rules))
+			}
+			if inner.enabledRuleCalls != 2 {
+				t.Errorf("expected the write to invalidate the cache, got %d inner lookups", inner.enabledRuleCalls)
+			}
+		})
+	}
+}
+
+func TestCachingStore_ErrorsAreNotCached(t *testing.T) {
+	inner, cache, _ := newTestCachingStore(t)
+	ctx := context.Background()
+
+	inner.err = context.DeadlineExceeded
+	if _, err := cache.GetEnabledRulesByPriority(ctx); err == nil {
+		t.Fatal("expected inner store error")
+	}
+
+	inner.err = nil
+	rules, err := cache.GetEnabledRulesByPriority(ctx)
+	if err != nil {
+		t.Fatalf("GetEnabledRulesByPriority failed: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Errorf("expected 1 rule, got %d", len(rules))
+	}
+	if inner.enabledRuleCalls != 2 {
+		t.Errorf("expected 2 inner lookups, got %d", inner.enabledRuleCalls)
+	}
+}
@@ -2,6 +2,7 @@ package cel
 
 import (
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -280,3 +281,49 @@ func BenchmarkBuildActivation(b *testing.B) {
 		_ = BuildActivation(alert, ctx)
 	}
 }
+
+func BenchmarkEvaluator_AlertVariable(b *testing.B) {
+	eval, err := NewEvaluator()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	alert := &routingv1.Alert{
+		Status: routingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels: map[string]string{"severity": "critical", "env": "prod"},
+	}
+
+	expression := `alert.labels["env"] == "prod" && alert.status == "ALERT_STATUS_TRIGGERED"`
+
+	// Warm up cache
+	_, _ = eval.EvaluateExpression(expression, alert, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := eval.EvaluateExpression(expression, alert, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestEvaluator_CacheSpeedup checks that evaluating a cached expression is at
+// least 10x faster than compiling it on every evaluation. Timings depend on the
+// machine and its load, so it only runs with CEL_BENCH_SPEEDUP set.
+func TestEvaluator_CacheSpeedup(t *testing.T) {
+	if os.Getenv("CEL_BENCH_SPEEDUP") == "" {
+		t.Skip("CEL_BENCH_SPEEDUP not set")
+	}
+
+	hit := testing.Benchmark(BenchmarkEvaluator_SimpleExpression)
+	miss := testing.Benchmark(BenchmarkEvaluator_CacheMiss)
+	if hit.N == 0 || miss.N == 0 {
+		t.Fatal("benchmarks did not run")
+	}
+
+	speedup := float64(miss.NsPerOp()) / float64(hit.NsPerOp())
+	t.Logf("cache hit %d ns/op, cache miss %d ns/op, speedup %.1fx", hit.NsPerOp(), miss.NsPerOp(), speedup)
+	if speedup < 10 {
+		t.Errorf("cached evaluation speedup = %.1fx, want at least 10x", speedup)
+	}
+}
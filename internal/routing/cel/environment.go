@@ -97,6 +97,9 @@ func NewStandardEnvironment() (*cel.Env, error) {
 		cel.Variable("alert_source", cel.StringType),
 		cel.Variable("alert_fingerprint", cel.StringType),
 
+		// Alert object with labels, summary, severity, source and status fields
+		cel.Variable("alert", cel.MapType(cel.StringType, cel.DynType)),
+
 		// Site fields (optional)
 		cel.Variable("site_id", cel.StringType),
 		cel.Variable("site_name", cel.StringType),
@@ -148,6 +151,13 @@ func BuildActivation(alert *routingv1.Alert, ctx *EvalContext) map[string]interf
 		}
 		activation["alert_ends_at"] = types.Timestamp{Time: time.Time{}}
 		activation["alert_generator_url"] = getGeneratorURL(alert)
+		activation["alert"] = map[string]interface{}{
+			"labels":   convertToRefMap(alert.Labels),
+			"summary":  alert.Summary,
+			"severity": getSeverityString(alert),
+			"source":   alert.Source.String(),
+			"status":   alert.Status.String(),
+		}
 	} else {
 		activation["alert_labels"] = map[string]string{}
 		activation["alert_annotations"] = map[string]string{}
@@ -161,6 +171,13 @@ func BuildActivation(alert *routingv1.Alert, ctx *EvalContext) map[string]interf
 		activation["alert_starts_at"] = types.Timestamp{Time: time.Time{}}
 		activation["alert_ends_at"] = types.Timestamp{Time: time.Time{}}
 		activation["alert_generator_url"] = ""
+		activation["alert"] = map[string]interface{}{
+			"labels":   convertToRefMap(nil),
+			"summary":  "",
+			"severity": "",
+			"source":   "",
+			"status":   "",
+		}
 	}
 
 	// Context timestamp
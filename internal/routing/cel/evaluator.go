@@ -202,6 +202,23 @@ func (e *Evaluator) Validate(expression string) error {
 	return nil
 }
 
+// Precompile compiles an expression into the cache so that its first
+// evaluation does not pay for compilation.
+func (e *Evaluator) Precompile(expression string) error {
+	if expression == "" {
+		return ErrEmptyExpression
+	}
+
+	entry, err := e.cache.GetOrCompile(expression)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCompilationFailed, err)
+	}
+	if entry.AST.OutputType() != cel.BoolType {
+		return fmt.Errorf("%w: expression returns %s", ErrNotBoolean, entry.AST.OutputType())
+	}
+	return nil
+}
+
 // Cache returns the expression cache.
 func (e *Evaluator) Cache() *Cache {
 	return e.cache
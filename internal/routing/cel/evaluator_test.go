@@ -464,3 +464,51 @@ func TestEvaluator_CacheHits(t *testing.T) {
 	// Cache should have one entry
 	assert.Equal(t, 1, eval.Cache().Size())
 }
+
+func TestEvaluator_AlertVariable(t *testing.T) {
+	eval, err := NewEvaluator()
+	require.NoError(t, err)
+
+	alert := &routingv1.Alert{
+		Summary: "Disk full on db-01",
+		Source:  routingv1.AlertSource_ALERT_SOURCE_PROMETHEUS,
+		Status:  routingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:  map[string]string{"severity": "critical", "env": "prod"},
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{`alert.labels["env"] == "prod"`, true},
+		{`"team" in alert.labels`, false},
+		{`alert.summary.startsWith("Disk full")`, true},
+		{`alert.severity == "critical"`, true},
+		{`alert.source == "ALERT_SOURCE_PROMETHEUS"`, true},
+		{`alert.status == "ALERT_STATUS_RESOLVED"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := eval.EvaluateExpression(tt.expression, alert, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	result, err := eval.EvaluateExpression(`alert.summary == ""`, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestEvaluator_Precompile(t *testing.T) {
+	eval, err := NewEvaluator()
+	require.NoError(t, err)
+
+	require.NoError(t, eval.Precompile(`alert.severity == "critical"`))
+	assert.NotNil(t, eval.Cache().Get(`alert.severity == "critical"`))
+
+	assert.ErrorIs(t, eval.Precompile(""), ErrEmptyExpression)
+	assert.ErrorIs(t, eval.Precompile(`alert.severity ==`), ErrCompilationFailed)
+	assert.ErrorIs(t, eval.Precompile(`alert.summary`), ErrNotBoolean)
+}
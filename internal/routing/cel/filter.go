@@ -0,0 +1,99 @@
+package cel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// NewAlertFilterEnvironment creates a CEL environment for filtering stored alerts.
+// It exposes the alert fields without the alert_ prefix used by routing conditions
+// and registers the same custom functions.
+func NewAlertFilterEnvironment() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("id", cel.StringType),
+		cel.Variable("fingerprint", cel.StringType),
+		cel.Variable("summary", cel.StringType),
+		cel.Variable("details", cel.StringType),
+		cel.Variable("severity", cel.StringType),
+		cel.Variable("status", cel.StringType),
+		cel.Variable("source", cel.StringType),
+		cel.Variable("service_id", cel.StringType),
+		cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("annotations", cel.MapType(cel.StringType, cel.StringType)),
+
+		RegisterCustomFunctions(),
+	)
+}
+
+// AlertFilter evaluates list filter expressions against alerts.
+type AlertFilter struct {
+	cache *Cache
+}
+
+// NewAlertFilter creates an alert filter caching up to capacity compiled expressions.
+func NewAlertFilter(capacity int) (*AlertFilter, error) {
+	env, err := NewAlertFilterEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return &AlertFilter{cache: NewCacheWithEnv(capacity, env)}, nil
+}
+
+// Compile compiles (or retrieves from cache) a filter expression.
+func (f *AlertFilter) Compile(expression string) (*CacheEntry, error) {
+	if expression == "" {
+		return nil, ErrEmptyExpression
+	}
+
+	entry, err := f.cache.GetOrCompile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCompilationFailed, err)
+	}
+	if outputType := entry.AST.OutputType(); outputType != cel.BoolType {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBoolean, outputType)
+	}
+
+	return entry, nil
+}
+
+// Match reports whether an alert satisfies a compiled filter.
+// Evaluation errors, such as indexing a label the alert does not have, count as no match.
+func (f *AlertFilter) Match(compiled *CacheEntry, alert *alertingv1.Alert) bool {
+	out, _, err := compiled.Program.Eval(alertFilterActivation(alert))
+	if err != nil {
+		return false
+	}
+	matched, ok := out.Value().(bool)
+	return ok && matched
+}
+
+// Cache returns the expression cache.
+func (f *AlertFilter) Cache() *Cache {
+	return f.cache
+}
+
+// alertFilterActivation builds the filter variables for an alert. Enum values are
+// lowercased without their prefix, so severity reads "critical" and works with severityAtLeast.
+func alertFilterActivation(alert *alertingv1.Alert) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          alert.Id,
+		"fingerprint": alert.Fingerprint,
+		"summary":     alert.Summary,
+		"details":     alert.Details,
+		"severity":    enumName(alert.Severity.String(), "SEVERITY_"),
+		"status":      enumName(alert.Status.String(), "ALERT_STATUS_"),
+		"source":      enumName(alert.Source.String(), "ALERT_SOURCE_"),
+		"service_id":  alert.ServiceId,
+		"labels":      convertToRefMap(alert.Labels),
+		"annotations": convertToRefMap(alert.Annotations),
+	}
+}
+
+// enumName converts a proto enum name such as SEVERITY_CRITICAL to critical.
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
@@ -0,0 +1,77 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestAlertFilter_Match(t *testing.T) {
+	filter, err := NewAlertFilter(10)
+	require.NoError(t, err)
+
+	prodCritical := &alertingv1.Alert{
+		Severity: alertingv1.Severity_SEVERITY_CRITICAL,
+		Status:   alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:   map[string]string{"env": "production"},
+	}
+	stagingLow := &alertingv1.Alert{
+		Severity: alertingv1.Severity_SEVERITY_LOW,
+		Status:   alertingv1.AlertStatus_ALERT_STATUS_RESOLVED,
+		Labels:   map[string]string{"env": "staging"},
+	}
+	unlabeled := &alertingv1.Alert{Severity: alertingv1.Severity_SEVERITY_HIGH}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       []bool
+	}{
+		{"label equality", `labels['env'] == 'production'`, []bool{true, false, false}},
+		{"severity comparison", `severityAtLeast(severity, 'high')`, []bool{true, false, true}},
+		{"severity equality", `severity == 'low'`, []bool{false, true, false}},
+		{"status", `status == 'triggered'`, []bool{true, false, false}},
+		{"label presence", `'env' in labels`, []bool{true, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := filter.Compile(tt.expression)
+			require.NoError(t, err)
+
+			got := []bool{
+				filter.Match(compiled, prodCritical),
+				filter.Match(compiled, stagingLow),
+				filter.Match(compiled, unlabeled),
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAlertFilter_Compile(t *testing.T) {
+	filter, err := NewAlertFilter(10)
+	require.NoError(t, err)
+
+	_, err = filter.Compile("")
+	assert.ErrorIs(t, err, ErrEmptyExpression)
+
+	_, err = filter.Compile(`labels['env'] ==`)
+	assert.ErrorIs(t, err, ErrCompilationFailed)
+
+	_, err = filter.Compile(`alert_labels['env'] == 'production'`)
+	assert.ErrorIs(t, err, ErrCompilationFailed, "routing variables are not part of the filter environment")
+
+	_, err = filter.Compile(`severity`)
+	assert.ErrorIs(t, err, ErrNotBoolean)
+
+	_, err = filter.Compile(`severity == 'critical'`)
+	require.NoError(t, err)
+	size := filter.Cache().Size()
+	_, err = filter.Compile(`severity == 'critical'`)
+	require.NoError(t, err)
+	assert.Equal(t, size, filter.Cache().Size(), "compiled expressions should be reused")
+}
@@ -0,0 +1,237 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// ConflictReport describes an enabled rule that fires together with the
+// analyzed rule for some alerts, causing duplicate notifications.
+type ConflictReport struct {
+	RuleID      string
+	RuleName    string
+	Priority    int32
+	Description string
+}
+
+// ConflictDetector finds routing rules with overlapping conditions.
+type ConflictDetector struct {
+	store Store
+}
+
+// NewConflictDetector creates a conflict detector for the rules in the store.
+func NewConflictDetector(store Store) *ConflictDetector {
+	return &ConflictDetector{store: store}
+}
+
+// DetectConflicts returns the enabled rules with an equal or lower priority than
+// the rule (evaluated at the same time or after it) whose conditions can match
+// the same alerts, unless the rule evaluated first stops processing. Equality
+// conditions (equals, not equals, in, not in, exists, not exists) are checked
+// for satisfiability per field; any other condition is assumed to match, so a
+// report may be a false positive. Time conditions and site scopes are ignored.
+func (d *ConflictDetector) DetectConflicts(ctx context.Context, ruleID string) ([]*ConflictReport, error) {
+	rule, err := d.store.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("get rule: %w", err)
+	}
+
+	rules, err := d.store.GetEnabledRulesByPriority(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get enabled rules: %w", err)
+	}
+
+	var reports []*ConflictReport
+	for _, other := range rules {
+		if other.Id == rule.Id || other.Priority < rule.Priority {
+			continue
+		}
+		if stopsProcessing(rule) || (other.Priority == rule.Priority && stopsProcessing(other)) {
+			continue
+		}
+
+		overlap, ok := overlapConditions(rule.Conditions, other.Conditions)
+		if !ok {
+			continue
+		}
+		reports = append(reports, &ConflictReport{
+			RuleID:      other.Id,
+			RuleName:    other.Name,
+			Priority:    other.Priority,
+			Description: overlap.describe(other),
+		})
+	}
+
+	return reports, nil
+}
+
+// stopsProcessing reports whether a matching rule skips the rules after it.
+func stopsProcessing(rule *routingv1.RoutingRule) bool {
+	return rule.Terminal || rule.StopProcessing
+}
+
+// valueConstraint is the set of values a field may take for all conditions on
+// it to match. The empty string stands for a missing value.
+type valueConstraint struct {
+	// allowed is nil when any value not excluded is allowed
+	allowed  map[string]bool
+	excluded map[string]bool
+}
+
+// allow restricts the field to the values.
+func (c *valueConstraint) allow(values ...string) {
+	next := make(map[string]bool, len(values))
+	for _, v := range values {
+		if c.allowed == nil || c.allowed[v] {
+			next[v] = true
+		}
+	}
+	c.allowed = next
+}
+
+// exclude forbids the values for the field.
+func (c *valueConstraint) exclude(values ...string) {
+	if c.excluded == nil {
+		c.excluded = make(map[string]bool)
+	}
+	for _, v := range values {
+		c.excluded[v] = true
+	}
+}
+
+// example returns the smallest allowed value, or false if no value is allowed.
+// An unrestricted field returns an empty example.
+func (c *valueConstraint) example() (string, bool) {
+	if c.allowed == nil {
+		return "", true
+	}
+
+	var candidates []string
+	for v := range c.allowed {
+		if !c.excluded[v] {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Strings(candidates)
+	return candidates[0], true
+}
+
+// conditionOverlap holds the combined constraints of two rules' conditions.
+type conditionOverlap struct {
+	fields map[string]*valueConstraint
+	// unanalyzed counts conditions assumed to match
+	unanalyzed int
+}
+
+// overlapConditions combines the conditions of two rules and reports whether an
+// alert can satisfy all of them.
+func overlapConditions(a, b []*routingv1.RoutingCondition) (*conditionOverlap, bool) {
+	overlap := &conditionOverlap{fields: make(map[string]*valueConstraint)}
+	for _, cond := range append(append([]*routingv1.RoutingCondition{}, a...), b...) {
+		overlap.add(cond)
+	}
+
+	for _, constraint := range overlap.fields {
+		if _, ok := constraint.example(); !ok {
+			return nil, false
+		}
+	}
+	return overlap, true
+}
+
+// add merges a condition into the field constraints.
+func (o *conditionOverlap) add(cond *routingv1.RoutingCondition) {
+	field, present := conditionField(cond)
+	if field == "" {
+		o.unanalyzed++
+		return
+	}
+
+	constraint, ok := o.fields[field]
+	if !ok {
+		constraint = &valueConstraint{}
+		o.fields[field] = constraint
+	}
+
+	// Label and annotation conditions only match when the key is present
+	if present && cond.Operator != routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS {
+		constraint.exclude("")
+	}
+
+	switch cond.Operator {
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS:
+		constraint.allow(cond.StringValue)
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_IN:
+		constraint.allow(cond.StringList...)
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
+		constraint.exclude(cond.StringValue)
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_IN:
+		constraint.exclude(cond.StringList...)
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS:
+		constraint.exclude("")
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS:
+		constraint.allow("")
+	default:
+		o.unanalyzed++
+	}
+}
+
+// conditionField returns the alert field a condition reads, as evaluated by the
+// Evaluator, and whether the condition requires the field to be present.
+// CEL conditions have no single field and return "".
+func conditionField(cond *routingv1.RoutingCondition) (string, bool) {
+	switch cond.Type {
+	case routingv1.ConditionType_CONDITION_TYPE_LABEL:
+		return "label " + cond.Field, true
+	case routingv1.ConditionType_CONDITION_TYPE_ANNOTATION:
+		return "annotation " + cond.Field, true
+	case routingv1.ConditionType_CONDITION_TYPE_SEVERITY:
+		return "severity", false
+	case routingv1.ConditionType_CONDITION_TYPE_SOURCE:
+		return "source", false
+	case routingv1.ConditionType_CONDITION_TYPE_SERVICE:
+		return "service", false
+	case routingv1.ConditionType_CONDITION_TYPE_SITE:
+		return "site", false
+	case routingv1.ConditionType_CONDITION_TYPE_POP:
+		return "pop", false
+	case routingv1.ConditionType_CONDITION_TYPE_CUSTOMER_TIER:
+		return "customer tier", false
+	case routingv1.ConditionType_CONDITION_TYPE_EQUIPMENT_TYPE:
+		return "equipment type", false
+	case routingv1.ConditionType_CONDITION_TYPE_CARRIER:
+		return "carrier", false
+	default:
+		return "", false
+	}
+}
+
+// describe explains the conflict with the other rule, including an example of
+// the field values both rules match.
+func (o *conditionOverlap) describe(other *routingv1.RoutingRule) string {
+	var examples []string
+	for field, constraint := range o.fields {
+		if constraint.allowed == nil {
+			continue
+		}
+		value, _ := constraint.example()
+		examples = append(examples, fmt.Sprintf("%s=%q", field, value))
+	}
+	sort.Strings(examples)
+
+	desc := fmt.Sprintf("rule %q (priority %d) fires for the same alerts", other.Name, other.Priority)
+	if len(examples) > 0 {
+		desc += ", e.g. " + strings.Join(examples, ", ")
+	}
+	if o.unanalyzed > 0 {
+		desc += fmt.Sprintf(" (%d conditions assumed to match)", o.unanalyzed)
+	}
+	return desc
+}
@@ -0,0 +1,197 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func labelCondition(field string, op routingv1.ConditionOperator, values ...string) *routingv1.RoutingCondition {
+	cond := &routingv1.RoutingCondition{
+		Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+		Field:    field,
+		Operator: op,
+	}
+	if op == routingv1.ConditionOperator_CONDITION_OPERATOR_IN || op == routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_IN {
+		cond.StringList = values
+	} else if len(values) > 0 {
+		cond.StringValue = values[0]
+	}
+	return cond
+}
+
+func seedConflictRules(t *testing.T, rules ...*routingv1.RoutingRule) *InMemoryStore {
+	t.Helper()
+	store := NewInMemoryStore()
+	for _, rule := range rules {
+		if _, err := store.CreateRule(context.Background(), rule); err != nil {
+			t.Fatalf("CreateRule(%s) error = %v", rule.Id, err)
+		}
+	}
+	return store
+}
+
+func TestDetectConflicts(t *testing.T) {
+	const (
+		equals    = routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS
+		notEquals = routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS
+		in        = routingv1.ConditionOperator_CONDITION_OPERATOR_IN
+		notExists = routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS
+		regex     = routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX
+	)
+
+	base := &routingv1.RoutingRule{
+		Id:       "db-critical",
+		Name:     "DB critical",
+		Priority: 10,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			labelCondition("team", equals, "db"),
+			labelCondition("severity", in, "critical", "high"),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		other    *routingv1.RoutingRule
+		conflict bool
+	}{
+		{
+			name: "same team and overlapping severity",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", in, "db", "storage"),
+				labelCondition("severity", equals, "critical"),
+			}},
+			conflict: true,
+		},
+		{
+			name: "condition on another label",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("region", equals, "eu"),
+			}},
+			conflict: true,
+		},
+		{
+			name:     "no conditions",
+			other:    &routingv1.RoutingRule{Priority: 20, Enabled: true},
+			conflict: true,
+		},
+		{
+			name: "regex assumed to match",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", regex, "^n.*"),
+			}},
+			conflict: true,
+		},
+		{
+			name: "different team",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", equals, "network"),
+			}},
+		},
+		{
+			name: "excluded team",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", notEquals, "db"),
+			}},
+		},
+		{
+			name: "disjoint severities",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("severity", in, "low", "info"),
+			}},
+		},
+		{
+			name: "team label must be missing",
+			other: &routingv1.RoutingRule{Priority: 20, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", notExists),
+			}},
+		},
+		{
+			name: "higher priority rule",
+			other: &routingv1.RoutingRule{Priority: 5, Enabled: true, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", equals, "db"),
+			}},
+		},
+		{
+			name: "disabled rule",
+			other: &routingv1.RoutingRule{Priority: 20, Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", equals, "db"),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.other.Id = "other"
+			tt.other.Name = "Other"
+			detector := NewConflictDetector(seedConflictRules(t, base, tt.other))
+
+			reports, err := detector.DetectConflicts(context.Background(), "db-critical")
+			if err != nil {
+				t.Fatalf("DetectConflicts() error = %v", err)
+			}
+			if got := len(reports) == 1; got != tt.conflict {
+				t.Fatalf("DetectConflicts() = %d reports, want conflict %v", len(reports), tt.conflict)
+			}
+			if tt.conflict && reports[0].RuleID != "other" {
+				t.Errorf("DetectConflicts() rule ID = %q, want other", reports[0].RuleID)
+			}
+		})
+	}
+}
+
+func TestDetectConflicts_Description(t *testing.T) {
+	store := seedConflictRules(t,
+		&routingv1.RoutingRule{
+			Id: "page-db", Name: "Page DB", Priority: 1, Enabled: true,
+			Conditions: []*routingv1.RoutingCondition{
+				labelCondition("team", routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS, "db"),
+			},
+		},
+		&routingv1.RoutingRule{
+			Id: "critical", Name: "All critical", Priority: 2, Enabled: true,
+			Conditions: []*routingv1.RoutingCondition{
+				labelCondition("severity", routingv1.ConditionOperator_CONDITION_OPERATOR_IN, "critical", "high"),
+				{Type: routingv1.ConditionType_CONDITION_TYPE_CEL, CelExpression: `labels.env == "prod"`},
+			},
+		},
+	)
+
+	reports, err := NewConflictDetector(store).DetectConflicts(context.Background(), "page-db")
+	if err != nil {
+		t.Fatalf("DetectConflicts() error = %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("DetectConflicts() = %d reports, want 1", len(reports))
+	}
+
+	want := `rule "All critical" (priority 2) fires for the same alerts, e.g. label severity="critical", label team="db" (1 conditions assumed to match)`
+	if reports[0].Description != want {
+		t.Errorf("Description = %q, want %q", reports[0].Description, want)
+	}
+}
+
+func TestDetectConflicts_TerminalRule(t *testing.T) {
+	store := seedConflictRules(t,
+		&routingv1.RoutingRule{Id: "first", Name: "First", Priority: 1, Enabled: true, Terminal: true},
+		&routingv1.RoutingRule{Id: "second", Name: "Second", Priority: 2, Enabled: true},
+	)
+
+	reports, err := NewConflictDetector(store).DetectConflicts(context.Background(), "first")
+	if err != nil {
+		t.Fatalf("DetectConflicts() error = %v", err)
+	}
+	if len(reports) != 0 {
+		t.Errorf("DetectConflicts() = %d reports, want none after a terminal rule", len(reports))
+	}
+}
+
+func TestDetectConflicts_NotFound(t *testing.T) {
+	_, err := NewConflictDetector(NewInMemoryStore()).DetectConflicts(context.Background(), "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("DetectConflicts() error = %v, want ErrNotFound", err)
+	}
+}
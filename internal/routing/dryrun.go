@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// DryRunMaxAlerts is the maximum number of triggered alerts a dry run evaluates.
+	DryRunMaxAlerts = 1000
+	// DryRunSampleSize is the number of matched alerts returned as samples.
+	DryRunSampleSize = 5
+)
+
+// DryRunResult summarizes how a rule matches the currently triggered alerts.
+type DryRunResult struct {
+	MatchedCount   int
+	UnmatchedCount int
+	// Samples holds up to DryRunSampleSize of the matched alerts.
+	Samples []DryRunMatch
+}
+
+// DryRunMatch is a matched alert and the rule conditions it matched.
+type DryRunMatch struct {
+	AlertID           string
+	Summary           string
+	MatchedConditions []*routingv1.ConditionResult
+}
+
+// DryRunner evaluates routing rules against live alerts without executing actions.
+type DryRunner struct {
+	alerts    store.AlertStore
+	evaluator *Evaluator
+	now       func() time.Time
+}
+
+// NewDryRunner creates a dry runner reading triggered alerts from the alert store.
+func NewDryRunner(alerts store.AlertStore, evaluator *Evaluator) *DryRunner {
+	return &DryRunner{
+		alerts:    alerts,
+		evaluator: evaluator,
+		now:       time.Now,
+	}
+}
+
+// DryRunRule evaluates the rule against up to DryRunMaxAlerts triggered alerts.
+func (d *DryRunner) DryRunRule(ctx context.Context, rule *routingv1.RoutingRule) (*DryRunResult, error) {
+	resp, err := d.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+		PageSize: DryRunMaxAlerts,
+		Statuses: []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list triggered alerts: %w", err)
+	}
+
+	result := &DryRunResult{}
+	evaluateAt := d.now()
+	evaluated := 0
+
+	for _, alert := range resp.Alerts {
+		if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+			continue
+		}
+		if evaluated >= DryRunMaxAlerts {
+			break
+		}
+		evaluated++
+
+		eval := d.evaluator.EvaluateRule(ctx, rule, AlertFromStore(alert), evaluateAt)
+		if !eval.Matched {
+			result.UnmatchedCount++
+			continue
+		}
+
+		result.MatchedCount++
+		if len(result.Samples) < DryRunSampleSize {
+			result.Samples = append(result.Samples, DryRunMatch{
+				AlertID:           alert.Id,
+				Summary:           alert.Summary,
+				MatchedConditions: eval.ConditionResults,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// AlertFromStore converts a stored alert to the routing representation. The severity
+// is added as the severity label when the alert does not already carry one.
+func AlertFromStore(alert *alertingv1.Alert) *routingv1.Alert {
+	labels := make(map[string]string, len(alert.Labels)+1)
+	for k, v := range alert.Labels {
+		labels[k] = v
+	}
+	if _, ok := labels["severity"]; !ok && alert.Severity != alertingv1.Severity_SEVERITY_UNSPECIFIED {
+		labels["severity"] = strings.ToLower(strings.TrimPrefix(alert.Severity.String(), "SEVERITY_"))
+	}
+
+	return &routingv1.Alert{
+		Id:          alert.Id,
+		Summary:     alert.Summary,
+		Details:     alert.Details,
+		Status:      routingv1.AlertStatus(routingv1.AlertStatus_value[alert.Status.String()]),
+		Source:      routingv1.AlertSource(routingv1.AlertSource_value[alert.Source.String()]),
+		Fingerprint: alert.Fingerprint,
+		Labels:      labels,
+		Annotations: alert.Annotations,
+		CreatedAt:   alert.CreatedAt,
+		ServiceId:   alert.ServiceId,
+		GroupId:     alert.GroupId,
+	}
+}
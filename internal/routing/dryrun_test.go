@@ -0,0 +1,173 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// listAlertStore serves a fixed set of alerts from List.
+type listAlertStore struct {
+	alerts []*alertingv1.Alert
+	req    *alertingv1.ListAlertsRequest
+	err    error
+}
+
+func (s *listAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (s *listAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (s *listAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (s *listAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	return alert, nil
+}
+
+func (s *listAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	return alert, true, nil
+}
+
+func (s *listAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	s.req = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &alertingv1.ListAlertsResponse{Alerts: s.alerts, TotalCount: int32(len(s.alerts))}, nil
+}
+
+func seedDryRunAlerts() *listAlertStore {
+	store := &listAlertStore{}
+	for i := 0; i < 8; i++ {
+		store.alerts = append(store.alerts, &alertingv1.Alert{
+			Id:       fmt.Sprintf("crit-%d", i),
+			Summary:  fmt.Sprintf("Link down %d", i),
+			Severity: alertingv1.Severity_SEVERITY_CRITICAL,
+			Status:   alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+			Labels:   map[string]string{"team": "network"},
+		})
+	}
+	for i := 0; i < 3; i++ {
+		store.alerts = append(store.alerts, &alertingv1.Alert{
+			Id:       fmt.Sprintf("low-%d", i),
+			Severity: alertingv1.Severity_SEVERITY_LOW,
+			Status:   alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+			Labels:   map[string]string{"team": "network"},
+		})
+	}
+	// Acknowledged alerts are not part of the dry run
+	store.alerts = append(store.alerts, &alertingv1.Alert{
+		Id:       "acked",
+		Severity: alertingv1.Severity_SEVERITY_CRITICAL,
+		Status:   alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED,
+		Labels:   map[string]string{"team": "network"},
+	})
+	return store
+}
+
+func criticalNetworkRule() *routingv1.RoutingRule {
+	return &routingv1.RoutingRule{
+		Name:    "Critical network",
+		Enabled: true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_SEVERITY,
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "critical",
+			},
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "team",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "network",
+			},
+		},
+		Actions: []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+		},
+	}
+}
+
+func TestDryRunner_DryRunRule(t *testing.T) {
+	store := seedDryRunAlerts()
+	runner := NewDryRunner(store, NewEvaluator())
+
+	result, err := runner.DryRunRule(context.Background(), criticalNetworkRule())
+	if err != nil {
+		t.Fatalf("DryRunRule() error = %v", err)
+	}
+
+	if result.MatchedCount != 8 {
+		t.Errorf("MatchedCount = %d, want 8", result.MatchedCount)
+	}
+	if result.UnmatchedCount != 3 {
+		t.Errorf("UnmatchedCount = %d, want 3", result.UnmatchedCount)
+	}
+	if len(result.Samples) != DryRunSampleSize {
+		t.Fatalf("Samples = %d, want %d", len(result.Samples), DryRunSampleSize)
+	}
+
+	sample := result.Samples[0]
+	if sample.AlertID != "crit-0" || sample.Summary != "Link down 0" {
+		t.Errorf("Samples[0] = %s %q, want crit-0 %q", sample.AlertID, sample.Summary, "Link down 0")
+	}
+	if len(sample.MatchedConditions) != 2 {
+		t.Fatalf("Samples[0] conditions = %d, want 2", len(sample.MatchedConditions))
+	}
+	if sample.MatchedConditions[0].Actual != "critical" || !sample.MatchedConditions[0].Matched {
+		t.Errorf("Samples[0] severity condition = %+v", sample.MatchedConditions[0])
+	}
+
+	if store.req.PageSize != DryRunMaxAlerts {
+		t.Errorf("List() page size = %d, want %d", store.req.PageSize, DryRunMaxAlerts)
+	}
+	if len(store.req.Statuses) != 1 || store.req.Statuses[0] != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("List() statuses = %v, want triggered", store.req.Statuses)
+	}
+}
+
+func TestDryRunner_DryRunRule_StoreError(t *testing.T) {
+	store := &listAlertStore{err: errors.New("database unavailable")}
+	runner := NewDryRunner(store, NewEvaluator())
+
+	if _, err := runner.DryRunRule(context.Background(), criticalNetworkRule()); err == nil {
+		t.Fatal("DryRunRule() expected error")
+	}
+}
+
+func TestAlertFromStore(t *testing.T) {
+	alert := AlertFromStore(&alertingv1.Alert{
+		Id:       "alert-1",
+		Severity: alertingv1.Severity_SEVERITY_HIGH,
+		Status:   alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Source:   alertingv1.AlertSource_ALERT_SOURCE_GRAFANA,
+		Labels:   map[string]string{"team": "network"},
+	})
+
+	if alert.Labels["severity"] != "high" {
+		t.Errorf("severity label = %q, want high", alert.Labels["severity"])
+	}
+	if alert.Status != routingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("Status = %v, want triggered", alert.Status)
+	}
+	if alert.Source != routingv1.AlertSource_ALERT_SOURCE_GRAFANA {
+		t.Errorf("Source = %v, want grafana", alert.Source)
+	}
+
+	labelled := AlertFromStore(&alertingv1.Alert{
+		Severity: alertingv1.Severity_SEVERITY_HIGH,
+		Labels:   map[string]string{"severity": "p1"},
+	})
+	if labelled.Labels["severity"] != "p1" {
+		t.Errorf("severity label = %q, want the existing p1 label", labelled.Labels["severity"])
+	}
+}
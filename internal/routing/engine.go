@@ -0,0 +1,383 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// DefaultWarmupTimeout bounds how long Warmup may take during server start.
+const DefaultWarmupTimeout = 30 * time.Second
+
+// tracerName identifies the spans started by the routing engine.
+const tracerName = "github.com/kneutral-org/alerting-system/internal/routing"
+
+// Engine routes alerts against the enabled routing rules of the tenant of the
+// evaluation context. Rules are loaded from the store on first use, or ahead of
+// time by Warmup, and cached per tenant until Invalidate.
+type Engine struct {
+	store     Store
+	evaluator *Evaluator
+	metrics   *Metrics
+	logger    zerolog.Logger
+
+	// registry exports rule evaluation statistics to Prometheus (optional)
+	registry *metrics.Registry
+
+	// tracer starts a span for each evaluation and a child span for each rule
+	tracer trace.Tracer
+
+	mu sync.RWMutex
+	// rules holds the loaded rules of each tenant
+	rules map[string][]*routingv1.RoutingRule
+}
+
+// EngineOption configures optional Engine dependencies.
+type EngineOption func(*Engine)
+
+// WithMetricsRegistry exports rule evaluation statistics to the Prometheus registry.
+func WithMetricsRegistry(registry *metrics.Registry) EngineOption {
+	return func(e *Engine) {
+		e.registry = registry
+	}
+}
+
+// WithTracerProvider sets the provider of evaluation spans instead of the global one.
+func WithTracerProvider(provider trace.TracerProvider) EngineOption {
+	return func(e *Engine) {
+		e.tracer = provider.Tracer(tracerName)
+	}
+}
+
+// NewEngine creates a new routing engine.
+func NewEngine(store Store, evaluator *Evaluator, logger zerolog.Logger, opts ...EngineOption) *Engine {
+	e := &Engine{
+		store:     store,
+		evaluator: evaluator,
+		metrics:   NewMetrics(),
+		logger:    logger.With().Str("component", "routing_engine").Logger(),
+		tracer:    otel.Tracer(tracerName),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Metrics returns the metrics recorder for this engine.
+func (e *Engine) Metrics() *Metrics {
+	return e.metrics
+}
+
+// Warmup loads and caches the enabled rules of the tenant of ctx so the first
+// alert does not pay for the store round trip.
+func (e *Engine) Warmup(ctx context.Context) error {
+	start := time.Now()
+
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("warm up routing engine: %w", err)
+	}
+	rules, err := e.load(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("warm up routing engine: %w", err)
+	}
+
+	duration := time.Since(start)
+	e.metrics.RecordWarmup(duration, len(rules))
+	e.logger.Info().
+		Int("rules", len(rules)).
+		Dur("duration", duration).
+		Msg("routing engine warmed up")
+
+	return nil
+}
+
+// Rules returns the cached enabled rules ordered by priority, loading them
+// from the store if they are not cached yet.
+func (e *Engine) Rules(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	rules, loaded := e.rules[tenantID]
+	e.mu.RUnlock()
+	if loaded {
+		return rules, nil
+	}
+
+	return e.load(ctx, tenantID)
+}
+
+// Invalidate drops the cached rules. It must be called whenever rules change.
+func (e *Engine) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rules = nil
+	e.evaluator.resetRegexCache()
+}
+
+// Evaluate evaluates the enabled rules against an alert. If a matching rule
+// applies tier routing, the rules are evaluated again with the alert severity
+// boosted by the customer tier of its account_id label. If no rule matches,
+// the alert is escalated with the default escalation policy of the site in its
+// site_code label, when the site has one.
+func (e *Engine) Evaluate(ctx context.Context, alert *routingv1.Alert, evaluateAt time.Time) ([]*routingv1.RuleEvaluation, []*routingv1.RoutingAction, error) {
+	ctx, span := e.tracer.Start(ctx, "routing.Evaluate", trace.WithAttributes(attribute.String("alert.id", alert.Id)))
+	defer span.End()
+
+	rules, err := e.Rules(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	evaluations, actions := e.evaluateRules(ctx, rules, alert, evaluateAt)
+	if hasActionType(actions, routingv1.ActionType_ACTION_TYPE_APPLY_TIER_ROUTING) {
+		if boosted := e.tierBoostedAlert(ctx, alert); boosted != nil {
+			evaluations, actions = e.evaluateRules(ctx, rules, boosted, evaluateAt)
+		}
+		actions = withoutActionType(actions, routingv1.ActionType_ACTION_TYPE_APPLY_TIER_ROUTING)
+	}
+	for _, evaluation := range evaluations {
+		e.registry.RecordRuleEvaluated(evaluation.Matched)
+	}
+	if len(actions) == 0 {
+		if fallback := e.siteFallbackAction(ctx, alert); fallback != nil {
+			actions = []*routingv1.RoutingAction{fallback}
+		}
+	}
+	return evaluations, actions, nil
+}
+
+// Decision summarizes how an alert was routed, see EvaluateAndDescribe.
+type Decision struct {
+	// RulesEvaluated and RulesMatched count the rule evaluations, including
+	// those of the re-evaluation with a tier boosted severity
+	RulesEvaluated int
+	RulesMatched   int
+	// Actions holds the types of the actions the alert was routed to
+	Actions []string
+	// ProcessingTime is how long the evaluation took
+	ProcessingTime time.Duration
+}
+
+// EvaluateAndDescribe evaluates the enabled rules against an alert like
+// Evaluate and returns the audit log of the evaluation along with a summary of
+// the routing decision. The audit log is not stored and its executions list the
+// routed actions, which are not executed by the engine.
+func (e *Engine) EvaluateAndDescribe(ctx context.Context, alert *routingv1.Alert, evaluateAt time.Time) (*routingv1.RoutingAuditLog, *Decision, error) {
+	start := time.Now()
+
+	evaluations, actions, err := e.Evaluate(ctx, alert, evaluateAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auditLog := &routingv1.RoutingAuditLog{
+		AlertId:         alert.Id,
+		Timestamp:       timestamppb.New(evaluateAt),
+		Evaluations:     evaluations,
+		Executions:      make([]*routingv1.ActionExecution, 0, len(actions)),
+		StoppedAtRuleId: StoppedAtRuleID(evaluations),
+	}
+	decision := &Decision{
+		RulesEvaluated: len(evaluations),
+		Actions:        make([]string, 0, len(actions)),
+	}
+	for _, evaluation := range evaluations {
+		if evaluation.Matched {
+			decision.RulesMatched++
+		}
+	}
+	for _, action := range actions {
+		auditLog.Executions = append(auditLog.Executions, &routingv1.ActionExecution{ActionType: action.Type})
+		decision.Actions = append(decision.Actions, action.Type.String())
+	}
+	decision.ProcessingTime = time.Since(start)
+
+	return auditLog, decision, nil
+}
+
+// evaluateRules evaluates rules against an alert like Evaluator.EvaluateRules,
+// in a child span of ctx for each rule.
+func (e *Engine) evaluateRules(ctx context.Context, rules []*routingv1.RoutingRule, alert *routingv1.Alert, evaluateAt time.Time) ([]*routingv1.RuleEvaluation, []*routingv1.RoutingAction) {
+	return evaluateRules(rules, alert, func(rule *routingv1.RoutingRule) *routingv1.RuleEvaluation {
+		ruleCtx, span := e.tracer.Start(ctx, "routing.EvaluateRule", trace.WithAttributes(
+			attribute.String("rule.id", rule.Id),
+			attribute.String("rule.name", rule.Name),
+		))
+		defer span.End()
+
+		evaluation := e.evaluator.EvaluateRule(ruleCtx, rule, alert, evaluateAt)
+		span.SetAttributes(attribute.Bool("rule.matched", evaluation.Matched))
+		return evaluation
+	})
+}
+
+// siteFallbackAction returns an escalate action using the default escalation
+// policy of the alert's site, or nil if the alert has no site_code label, the
+// site cannot be resolved or it has no default policy.
+func (e *Engine) siteFallbackAction(ctx context.Context, alert *routingv1.Alert) *routingv1.RoutingAction {
+	siteCode := alert.Labels["site_code"]
+	if siteCode == "" || e.evaluator.siteStore == nil {
+		return nil
+	}
+
+	s, err := e.evaluator.siteStore.GetByCode(ctx, siteCode)
+	if err != nil {
+		e.logger.Warn().Err(err).
+			Str("alert_id", alert.Id).
+			Str("site_code", siteCode).
+			Msg("failed to resolve site for fallback escalation policy")
+		return nil
+	}
+	if s.DefaultEscalationPolicyID == nil || *s.DefaultEscalationPolicyID == "" {
+		return nil
+	}
+
+	e.metrics.RecordFallbackSitePolicyUsed(s.ID)
+	e.logger.Debug().
+		Str("alert_id", alert.Id).
+		Str("site_id", s.ID).
+		Str("escalation_policy_id", *s.DefaultEscalationPolicyID).
+		Msg("no routing rule matched, using site default escalation policy")
+
+	return &routingv1.RoutingAction{
+		Type: routingv1.ActionType_ACTION_TYPE_ESCALATE,
+		Escalate: &routingv1.EscalateAction{
+			EscalationPolicyId: *s.DefaultEscalationPolicyID,
+		},
+	}
+}
+
+// tierBoostedAlert returns a copy of the alert with its severity label raised by
+// the severity boost of the customer tier of its account_id label, capped at
+// critical. It returns nil if the alert has no account_id or severity label,
+// the customer or tier cannot be resolved or the tier does not boost severity.
+func (e *Engine) tierBoostedAlert(ctx context.Context, alert *routingv1.Alert) *routingv1.Alert {
+	accountID := alert.Labels["account_id"]
+	if accountID == "" || e.evaluator.customerStore == nil || e.evaluator.tierStore == nil {
+		return nil
+	}
+
+	c, err := e.evaluator.customerStore.GetByAccountID(ctx, accountID)
+	if err != nil {
+		e.logger.Warn().Err(err).
+			Str("alert_id", alert.Id).
+			Str("account_id", accountID).
+			Msg("failed to resolve customer for tier routing")
+		return nil
+	}
+	tier, err := e.evaluator.tierStore.GetByID(ctx, c.TierID)
+	if err != nil {
+		e.logger.Warn().Err(err).
+			Str("alert_id", alert.Id).
+			Str("customer_id", c.ID).
+			Str("tier_id", c.TierID).
+			Msg("failed to resolve customer tier for tier routing")
+		return nil
+	}
+
+	severity, ok := BoostSeverity(alert.Labels["severity"], tier.SeverityBoost)
+	if !ok {
+		return nil
+	}
+
+	e.metrics.RecordTierSeverityBoost(tier.ID)
+	e.logger.Debug().
+		Str("alert_id", alert.Id).
+		Str("tier_id", tier.ID).
+		Str("severity", alert.Labels["severity"]).
+		Str("boosted_severity", severity).
+		Msg("boosted alert severity by customer tier")
+
+	boosted := proto.Clone(alert).(*routingv1.Alert)
+	boosted.Labels["severity"] = severity
+	return boosted
+}
+
+// BoostSeverity raises a severity label by boost levels of the Severity enum,
+// capped at critical; a boost of 1 turns "medium" into "high". It returns false
+// if the boost is not positive or the severity is not a Severity name.
+func BoostSeverity(severity string, boost int) (string, bool) {
+	level, ok := alertingv1.Severity_value["SEVERITY_"+strings.ToUpper(severity)]
+	if !ok || level == int32(alertingv1.Severity_SEVERITY_UNSPECIFIED) || boost <= 0 {
+		return "", false
+	}
+
+	// Lower Severity values are more severe
+	boosted := max(int(level)-boost, int(alertingv1.Severity_SEVERITY_CRITICAL))
+	name := alertingv1.Severity_name[int32(boosted)]
+	return strings.ToLower(strings.TrimPrefix(name, "SEVERITY_")), true
+}
+
+// hasActionType reports whether actions contain an action of the given type.
+func hasActionType(actions []*routingv1.RoutingAction, actionType routingv1.ActionType) bool {
+	for _, action := range actions {
+		if action.Type == actionType {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutActionType returns actions without those of the given type.
+func withoutActionType(actions []*routingv1.RoutingAction, actionType routingv1.ActionType) []*routingv1.RoutingAction {
+	filtered := actions[:0:0]
+	for _, action := range actions {
+		if action.Type != actionType {
+			filtered = append(filtered, action)
+		}
+	}
+	return filtered
+}
+
+// GetSlowConditionReport returns up to topN rule conditions ordered by their
+// average evaluation time, slowest first, or nil if condition profiling is disabled.
+func (e *Engine) GetSlowConditionReport(topN int) []ConditionStat {
+	profiler := e.evaluator.Profiler()
+	if profiler == nil {
+		return nil
+	}
+	return profiler.GetSlowConditionReport(topN)
+}
+
+// load fetches the enabled rules of tenantID from the store and caches them.
+func (e *Engine) load(ctx context.Context, tenantID string) ([]*routingv1.RoutingRule, error) {
+	rules, err := e.store.GetEnabledRulesByPriority(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compile CEL conditions now rather than on the first alert; invalid
+	// expressions still load and simply never match
+	for _, err := range e.evaluator.PrecompileCEL(rules) {
+		e.logger.Warn().Err(err).Msg("invalid CEL expression in routing rule")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.rules == nil {
+		e.rules = make(map[string][]*routingv1.RoutingRule)
+	}
+	e.rules[tenantID] = rules
+
+	return rules, nil
+}
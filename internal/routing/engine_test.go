@@ -0,0 +1,557 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+	"github.com/kneutral-org/alerting-system/internal/site"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// countingStore counts GetEnabledRulesByPriority calls and can fail them.
+type countingStore struct {
+	*InMemoryStore
+	enabledRuleCalls int
+	err              error
+}
+
+func (s *countingStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	s.enabledRuleCalls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.InMemoryStore.GetEnabledRulesByPriority(ctx)
+}
+
+func newCountingStore(t *testing.T) *countingStore {
+	t.Helper()
+
+	store := &countingStore{InMemoryStore: NewInMemoryStore()}
+	_, err := store.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Name:     "Critical alerts",
+		Priority: 1,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "severity",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "critical",
+			},
+		},
+		Actions: []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	return store
+}
+
+func TestEngine_WarmupCachesRules(t *testing.T) {
+	store := newCountingStore(t)
+	engine := NewEngine(store, NewEvaluator(), zerolog.Nop())
+	ctx := context.Background()
+
+	if err := engine.Warmup(ctx); err != nil {
+		t.Fatalf("Warmup() error = %v", err)
+	}
+	if store.enabledRuleCalls != 1 {
+		t.Fatalf("Warmup() store calls = %d, want 1", store.enabledRuleCalls)
+	}
+
+	alert := &routingv1.Alert{Labels: map[string]string{"severity": "critical"}}
+	_, actions, err := engine.Evaluate(ctx, alert, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(actions) != 1 {
+		t.Errorf("Evaluate() actions = %d, want 1", len(actions))
+	}
+	if store.enabledRuleCalls != 1 {
+		t.Errorf("first evaluation after warmup called GetEnabledRulesByPriority, calls = %d", store.enabledRuleCalls)
+	}
+
+	if got := engine.Metrics().WarmupRulesLoaded(); got != 1 {
+		t.Errorf("WarmupRulesLoaded() = %d, want 1", got)
+	}
+	if got := len(engine.Metrics().GetWarmupDurations()); got != 1 {
+		t.Errorf("GetWarmupDurations() count = %d, want 1", got)
+	}
+}
+
+func TestEngine_LoadsLazilyWithoutWarmup(t *testing.T) {
+	store := newCountingStore(t)
+	engine := NewEngine(store, NewEvaluator(), zerolog.Nop())
+	ctx := context.Background()
+
+	alert := &routingv1.Alert{Labels: map[string]string{"severity": "critical"}}
+	for i := 0; i < 2; i++ {
+		if _, _, err := engine.Evaluate(ctx, alert, time.Now()); err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+	}
+
+	if store.enabledRuleCalls != 1 {
+		t.Errorf("GetEnabledRulesByPriority() calls = %d, want 1", store.enabledRuleCalls)
+	}
+}
+
+func TestEngine_Invalidate(t *testing.T) {
+	store := newCountingStore(t)
+	engine := NewEngine(store, NewEvaluator(), zerolog.Nop())
+	ctx := context.Background()
+
+	_ = engine.Warmup(ctx)
+	engine.Invalidate()
+
+	if _, err := engine.Rules(ctx); err != nil {
+		t.Fatalf("Rules() error = %v", err)
+	}
+	if store.enabledRuleCalls != 2 {
+		t.Errorf("GetEnabledRulesByPriority() calls = %d, want 2 after invalidation", store.enabledRuleCalls)
+	}
+}
+
+func TestEngine_WarmupFailure(t *testing.T) {
+	store := newCountingStore(t)
+	store.err = errors.New("database unavailable")
+	engine := NewEngine(store, NewEvaluator(), zerolog.Nop())
+	ctx := context.Background()
+
+	if err := engine.Warmup(ctx); err == nil {
+		t.Fatal("Warmup() expected error")
+	}
+	if got := len(engine.Metrics().GetWarmupDurations()); got != 0 {
+		t.Errorf("GetWarmupDurations() count = %d, want 0 after failure", got)
+	}
+
+	// The engine falls back to loading on the next evaluation
+	store.err = nil
+	rules, err := engine.Rules(ctx)
+	if err != nil {
+		t.Fatalf("Rules() error = %v", err)
+	}
+	if len(rules) != 1 {
+		t.Errorf("Rules() count = %d, want 1", len(rules))
+	}
+}
+
+func TestEngine_SiteDefaultEscalationPolicyFallback(t *testing.T) {
+	policyID := "policy-iad"
+	sites := &mockSiteStore{
+		sites: map[string]*site.Site{
+			"IAD1": {ID: "site-iad1", Code: "IAD1", DefaultEscalationPolicyID: &policyID},
+			"FRA1": {ID: "site-fra1", Code: "FRA1"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		labels     map[string]string
+		wantPolicy string
+	}{
+		{
+			name:       "site with default policy",
+			labels:     map[string]string{"severity": "warning", "site_code": "IAD1"},
+			wantPolicy: policyID,
+		},
+		{
+			name:   "site without default policy",
+			labels: map[string]string{"severity": "warning", "site_code": "FRA1"},
+		},
+		{
+			name:   "no site code",
+			labels: map[string]string{"severity": "warning"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewEngine(newCountingStore(t), NewEvaluator(WithSiteStore(sites)), zerolog.Nop())
+
+			_, actions, err := engine.Evaluate(context.Background(), &routingv1.Alert{Labels: tt.labels}, time.Now())
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+
+			if tt.wantPolicy == "" {
+				if len(actions) != 0 {
+					t.Errorf("Evaluate() actions = %d, want 0", len(actions))
+				}
+				if got := engine.Metrics().FallbackSitePolicyUsedTotal("site-fra1"); got != 0 {
+					t.Errorf("FallbackSitePolicyUsedTotal() = %d, want 0", got)
+				}
+				return
+			}
+
+			if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_ESCALATE {
+				t.Fatalf("Evaluate() actions = %v, want a single escalate action", actions)
+			}
+			if got := actions[0].GetEscalate().GetEscalationPolicyId(); got != tt.wantPolicy {
+				t.Errorf("escalation policy = %q, want %q", got, tt.wantPolicy)
+			}
+			if got := engine.Metrics().FallbackSitePolicyUsedTotal("site-iad1"); got != 1 {
+				t.Errorf("FallbackSitePolicyUsedTotal() = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestEngine_SiteFallbackSkippedWhenRuleMatches(t *testing.T) {
+	policyID := "policy-iad"
+	sites := &mockSiteStore{
+		sites: map[string]*site.Site{
+			"IAD1": {ID: "site-iad1", Code: "IAD1", DefaultEscalationPolicyID: &policyID},
+		},
+	}
+	engine := NewEngine(newCountingStore(t), NewEvaluator(WithSiteStore(sites)), zerolog.Nop())
+
+	alert := &routingv1.Alert{Labels: map[string]string{"severity": "critical", "site_code": "IAD1"}}
+	_, actions, err := engine.Evaluate(context.Background(), alert, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM {
+		t.Errorf("Evaluate() actions = %v, want the matched rule's action", actions)
+	}
+	if got := engine.Metrics().FallbackSitePolicyUsedTotal("site-iad1"); got != 0 {
+		t.Errorf("FallbackSitePolicyUsedTotal() = %d, want 0", got)
+	}
+}
+
+func TestEngine_PrecompilesCELConditions(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	for i, expr := range []string{`alert.labels["env"] == "prod"`, `alert.labels[`} {
+		_, err := store.CreateRule(ctx, &routingv1.RoutingRule{
+			Name:     expr,
+			Priority: int32(i + 1),
+			Enabled:  true,
+			Conditions: []*routingv1.RoutingCondition{
+				{Type: routingv1.ConditionType_CONDITION_TYPE_CEL, CelExpression: expr},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateRule() error = %v", err)
+		}
+	}
+
+	evaluator := NewEvaluator()
+	engine := NewEngine(store, evaluator, zerolog.Nop())
+	if err := engine.Warmup(ctx); err != nil {
+		t.Fatalf("Warmup() error = %v", err)
+	}
+	if got := evaluator.CELEvaluator().Cache().Keys(); len(got) != 1 || got[0] != `alert.labels["env"] == "prod"` {
+		t.Errorf("cached expressions after warmup = %v, want only the valid expression", got)
+	}
+
+	alert := &routingv1.Alert{Labels: map[string]string{"env": "prod"}}
+	_, actions, err := engine.Evaluate(ctx, alert, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(actions) != 1 {
+		t.Errorf("Evaluate() actions = %d, want 1 from the CEL rule", len(actions))
+	}
+}
+
+// mockTierStore resolves customer tiers by ID. Other TierStore methods are not used.
+type mockTierStore struct {
+	customer.TierStore
+	tiers map[string]*customer.CustomerTier
+}
+
+func (m *mockTierStore) GetByID(ctx context.Context, id string) (*customer.CustomerTier, error) {
+	tier, ok := m.tiers[id]
+	if !ok {
+		return nil, customer.ErrTierNotFound
+	}
+	return tier, nil
+}
+
+// mockCustomerGetter resolves customers by account ID.
+type mockCustomerGetter struct {
+	customers map[string]*customer.Customer
+}
+
+func (m *mockCustomerGetter) GetByAccountID(ctx context.Context, accountID string) (*customer.Customer, error) {
+	c, ok := m.customers[accountID]
+	if !ok {
+		return nil, customer.ErrCustomerNotFound
+	}
+	return c, nil
+}
+
+func TestBoostSeverity(t *testing.T) {
+	tests := []struct {
+		severity string
+		boost    int
+		want     string
+		wantOK   bool
+	}{
+		{severity: "medium", boost: 1, want: "high", wantOK: true},
+		{severity: "info", boost: 2, want: "medium", wantOK: true},
+		{severity: "LOW", boost: 1, want: "medium", wantOK: true},
+		{severity: "medium", boost: 5, want: "critical", wantOK: true},
+		{severity: "critical", boost: 1, want: "critical", wantOK: true},
+		{severity: "medium", boost: 0},
+		{severity: "medium", boost: -1},
+		{severity: "warning", boost: 1},
+		{severity: "", boost: 1},
+		{severity: "unspecified", boost: 1},
+	}
+
+	for _, tt := range tests {
+		got, ok := BoostSeverity(tt.severity, tt.boost)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("BoostSeverity(%q, %d) = %q, %v, want %q, %v", tt.severity, tt.boost, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestEngine_TierRouting(t *testing.T) {
+	customers := &mockCustomerGetter{
+		customers: map[string]*customer.Customer{
+			"acct-enterprise": {ID: "cust-1", TierID: "tier-enterprise"},
+			"acct-standard":   {ID: "cust-2", TierID: "tier-standard"},
+			"acct-orphan":     {ID: "cust-3", TierID: "tier-missing"},
+		},
+	}
+	tiers := &mockTierStore{
+		tiers: map[string]*customer.CustomerTier{
+			"tier-enterprise": {ID: "tier-enterprise", SeverityBoost: 10},
+			"tier-standard":   {ID: "tier-standard"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		labels     map[string]string
+		wantNotify bool
+	}{
+		{
+			name:       "boost clamped at critical",
+			labels:     map[string]string{"severity": "medium", "account_id": "acct-enterprise"},
+			wantNotify: true,
+		},
+		{
+			name:   "tier without boost",
+			labels: map[string]string{"severity": "medium", "account_id": "acct-standard"},
+		},
+		{
+			name:   "tier not found",
+			labels: map[string]string{"severity": "medium", "account_id": "acct-orphan"},
+		},
+		{
+			name:   "unknown account",
+			labels: map[string]string{"severity": "medium", "account_id": "acct-unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newCountingStore(t)
+			_, err := store.CreateRule(context.Background(), &routingv1.RoutingRule{
+				Name:     "Customer tier routing",
+				Priority: 0,
+				Enabled:  true,
+				Conditions: []*routingv1.RoutingCondition{
+					{
+						Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+						Field:    "account_id",
+						Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
+					},
+				},
+				Actions: []*routingv1.RoutingAction{
+					{Type: routingv1.ActionType_ACTION_TYPE_APPLY_TIER_ROUTING},
+				},
+			})
+			if err != nil {
+				t.Fatalf("CreateRule() error = %v", err)
+			}
+			engine := NewEngine(store, NewEvaluator(WithCustomerTiers(customers, tiers)), zerolog.Nop())
+
+			alert := &routingv1.Alert{Id: "alert-1", Labels: tt.labels}
+			_, actions, err := engine.Evaluate(context.Background(), alert, time.Now())
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+
+			for _, action := range actions {
+				if action.Type == routingv1.ActionType_ACTION_TYPE_APPLY_TIER_ROUTING {
+					t.Errorf("Evaluate() actions = %v, want the tier routing action removed", actions)
+				}
+			}
+			notified := len(actions) == 1 && actions[0].Type == routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM
+			if notified != tt.wantNotify {
+				t.Errorf("Evaluate() actions = %v, want critical rule matched = %v", actions, tt.wantNotify)
+			}
+			if alert.Labels["severity"] != "medium" {
+				t.Errorf("alert severity = %q, want the alert left unchanged", alert.Labels["severity"])
+			}
+
+			wantBoosts := int64(0)
+			if tt.wantNotify {
+				wantBoosts = 1
+			}
+			if got := engine.Metrics().TierSeverityBoostsTotal("tier-enterprise"); got != wantBoosts {
+				t.Errorf("TierSeverityBoostsTotal() = %d, want %d", got, wantBoosts)
+			}
+		})
+	}
+}
+
+func TestEngine_TierRoutingRequiresAction(t *testing.T) {
+	customers := &mockCustomerGetter{
+		customers: map[string]*customer.Customer{"acct-1": {ID: "cust-1", TierID: "tier-1"}},
+	}
+	tiers := &mockTierStore{
+		tiers: map[string]*customer.CustomerTier{"tier-1": {ID: "tier-1", SeverityBoost: 3}},
+	}
+	engine := NewEngine(newCountingStore(t), NewEvaluator(WithCustomerTiers(customers, tiers)), zerolog.Nop())
+
+	alert := &routingv1.Alert{Labels: map[string]string{"severity": "medium", "account_id": "acct-1"}}
+	_, actions, err := engine.Evaluate(context.Background(), alert, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(actions) != 0 {
+		t.Errorf("Evaluate() actions = %v, want none without a tier routing rule", actions)
+	}
+}
+
+func TestEngine_RecordsRuleEvaluations(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	engine := NewEngine(newCountingStore(t), NewEvaluator(), zerolog.Nop(), WithMetricsRegistry(metrics.NewRegistry(reg)))
+
+	for _, severity := range []string{"critical", "warning", "info"} {
+		alert := &routingv1.Alert{Labels: map[string]string{"severity": severity}}
+		if _, _, err := engine.Evaluate(context.Background(), alert, time.Now()); err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+	}
+
+	expected := `
+# HELP routing_rules_evaluated_total Routing rules evaluated against alerts, by whether they matched.
+# TYPE routing_rules_evaluated_total counter
+routing_rules_evaluated_total{matched="false"} 2
+routing_rules_evaluated_total{matched="true"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "routing_rules_evaluated_total"); err != nil {
+		t.Errorf("unexpected routing_rules_evaluated_total: %v", err)
+	}
+}
+
+func TestEngine_EvaluateAndDescribe(t *testing.T) {
+	engine := NewEngine(newCountingStore(t), NewEvaluator(), zerolog.Nop())
+	evaluateAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	alert := &routingv1.Alert{Id: "alert-1", Labels: map[string]string{"severity": "critical"}}
+	auditLog, decision, err := engine.EvaluateAndDescribe(context.Background(), alert, evaluateAt)
+	if err != nil {
+		t.Fatalf("EvaluateAndDescribe() error = %v", err)
+	}
+
+	if auditLog.AlertId != "alert-1" || !auditLog.Timestamp.AsTime().Equal(evaluateAt) {
+		t.Errorf("unexpected audit log: %v", auditLog)
+	}
+	if len(auditLog.Evaluations) != 1 || !auditLog.Evaluations[0].Matched {
+		t.Errorf("expected 1 matched evaluation, got %v", auditLog.Evaluations)
+	}
+	if len(auditLog.Executions) != 1 || auditLog.Executions[0].ActionType != routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM {
+		t.Errorf("expected the notify team action, got %v", auditLog.Executions)
+	}
+	if decision.RulesEvaluated != 1 || decision.RulesMatched != 1 {
+		t.Errorf("expected 1 rule evaluated and matched, got %d and %d", decision.RulesEvaluated, decision.RulesMatched)
+	}
+	if len(decision.Actions) != 1 || decision.Actions[0] != "ACTION_TYPE_NOTIFY_TEAM" {
+		t.Errorf("unexpected actions: %v", decision.Actions)
+	}
+
+	alert = &routingv1.Alert{Id: "alert-2", Labels: map[string]string{"severity": "info"}}
+	_, decision, err = engine.EvaluateAndDescribe(context.Background(), alert, evaluateAt)
+	if err != nil {
+		t.Fatalf("EvaluateAndDescribe() error = %v", err)
+	}
+	if decision.RulesEvaluated != 1 || decision.RulesMatched != 0 || len(decision.Actions) != 0 {
+		t.Errorf("expected an unmatched decision without actions, got %+v", decision)
+	}
+}
+
+func TestEngine_RuleEvaluationSpans(t *testing.T) {
+	store := newCountingStore(t)
+	_, err := store.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Name:     "Warnings",
+		Priority: 2,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "severity",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "warning",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	engine := NewEngine(store, NewEvaluator(), zerolog.Nop(),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))))
+
+	alert := &routingv1.Alert{Id: "alert-1", Labels: map[string]string{"severity": "warning"}}
+	if _, _, err := engine.Evaluate(context.Background(), alert, time.Now()); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	// Spans are exported as they end, so the parent span is last
+	parent := spans[2]
+	if parent.Name != "routing.Evaluate" {
+		t.Fatalf("last span = %q, want routing.Evaluate", parent.Name)
+	}
+
+	matched := make(map[string]bool)
+	for _, span := range spans[:2] {
+		if span.Name != "routing.EvaluateRule" {
+			t.Errorf("span name = %q, want routing.EvaluateRule", span.Name)
+		}
+		if span.Parent.SpanID() != parent.SpanContext.SpanID() {
+			t.Errorf("rule span %q is not a child of the evaluation span", span.Name)
+		}
+		var name string
+		for _, attr := range span.Attributes {
+			switch attr.Key {
+			case "rule.name":
+				name = attr.Value.AsString()
+			case "rule.matched":
+				matched[name] = attr.Value.AsBool()
+			}
+		}
+	}
+	if matched["Critical alerts"] || !matched["Warnings"] {
+		t.Errorf("rule.matched attributes = %v, want only Warnings matched", matched)
+	}
+}
@@ -2,33 +2,175 @@
 package routing
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
 	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	"github.com/kneutral-org/alerting-system/internal/site"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
+// ErrInvalidCondition is returned when a condition cannot be evaluated, such as
+// a numeric comparison of a value that is not a number. The condition does not match.
+var ErrInvalidCondition = errors.New("invalid routing condition")
+
+// labelFieldPrefix prefixes the label key in the field of numeric conditions.
+const labelFieldPrefix = "labels."
+
 // Evaluator evaluates routing conditions against alerts.
 type Evaluator struct {
 	// celEvaluator handles CEL expression evaluation
 	celEvaluator *cel.Evaluator
+
+	// siteStore resolves alert site codes for site-scoped rules
+	siteStore site.Store
+
+	// profiler records per-condition evaluation time (optional)
+	profiler *ConditionProfiler
+
+	// logger reports conditions that cannot be evaluated
+	logger zerolog.Logger
+
+	// clock returns the time TIME_WINDOW conditions are evaluated at
+	clock func() time.Time
+
+	// scheduleStore resolves the timezone of TIME_WINDOW conditions from the
+	// schedule a rule notifies (optional)
+	scheduleStore ScheduleGetter
+
+	// customerStore and tierStore resolve the customer tier of an alert for
+	// APPLY_TIER_ROUTING actions (optional)
+	customerStore CustomerGetter
+	tierStore     customer.TierStore
+
+	// groupSizer counts the open alerts in the correlation group of an alert
+	// for GROUP_SIZE conditions (optional)
+	groupSizer GroupSizer
+
+	// regexes caches the compiled patterns of REGEX_MATCH and REGEX_NOT_MATCH
+	// conditions by rule condition
+	regexMu sync.RWMutex
+	regexes map[ruleConditionKey]*conditionRegex
+}
+
+// ruleConditionKey identifies a condition by its rule and position in the rule.
+// The zero key identifies a condition evaluated outside of a rule.
+type ruleConditionKey struct {
+	ruleID string
+	index  int
+}
+
+// conditionRegex is a compiled condition pattern.
+type conditionRegex struct {
+	pattern string
+	re      *regexp.Regexp
+	err     error
+}
+
+// EvaluatorOption configures an Evaluator.
+type EvaluatorOption func(*Evaluator)
+
+// WithSiteStore sets the site store used to resolve the site scope of rules
+// with affected regions or site types.
+func WithSiteStore(store site.Store) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.siteStore = store
+	}
+}
+
+// ScheduleGetter looks up on-call schedules. It is implemented by schedule.Store.
+type ScheduleGetter interface {
+	GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error)
+}
+
+// WithScheduleStore sets the store used to resolve the timezone of TIME_WINDOW
+// conditions in rules that notify an on-call schedule.
+func WithScheduleStore(store ScheduleGetter) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.scheduleStore = store
+	}
+}
+
+// CustomerGetter looks up customers by account ID. It is implemented by customer.Store.
+type CustomerGetter interface {
+	GetByAccountID(ctx context.Context, accountID string) (*customer.Customer, error)
+}
+
+// WithCustomerTiers sets the stores used to resolve the customer tier of an
+// alert's account_id label when a rule applies tier routing.
+func WithCustomerTiers(customers CustomerGetter, tiers customer.TierStore) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.customerStore = customers
+		e.tierStore = tiers
+	}
+}
+
+// GroupSizer counts the open alerts in a correlation group. It is implemented
+// by correlation.Correlator.
+type GroupSizer interface {
+	GroupSize(ctx context.Context, groupID string) (int, error)
+}
+
+// WithGroupSizer sets the correlator used to evaluate GROUP_SIZE conditions.
+// Without one, GROUP_SIZE conditions never match.
+func WithGroupSizer(sizer GroupSizer) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.groupSizer = sizer
+	}
+}
+
+// WithClock sets the clock TIME_WINDOW conditions are evaluated with.
+func WithClock(clock func() time.Time) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.clock = clock
+	}
+}
+
+// WithLogger sets the logger used to report conditions that cannot be evaluated.
+func WithLogger(logger zerolog.Logger) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.logger = logger.With().Str("component", "routing_evaluator").Logger()
+	}
+}
+
+// WithConditionProfiler records how long each rule condition takes to evaluate.
+func WithConditionProfiler(profiler *ConditionProfiler) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.profiler = profiler
+	}
 }
 
 // NewEvaluator creates a new condition evaluator.
-func NewEvaluator() *Evaluator {
+func NewEvaluator(opts ...EvaluatorOption) *Evaluator {
 	celEval, _ := cel.NewEvaluator()
-	return &Evaluator{
+	e := &Evaluator{
 		celEvaluator: celEval,
+		logger:       zerolog.Nop(),
+		clock:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(e)
 	}
+	return e
 }
 
 // NewEvaluatorWithCEL creates a new evaluator with a custom CEL evaluator.
 func NewEvaluatorWithCEL(celEval *cel.Evaluator) *Evaluator {
 	return &Evaluator{
 		celEvaluator: celEval,
+		logger:       zerolog.Nop(),
+		clock:        time.Now,
 	}
 }
 
@@ -37,6 +179,11 @@ func (e *Evaluator) CELEvaluator() *cel.Evaluator {
 	return e.celEvaluator
 }
 
+// Profiler returns the condition profiler, or nil if profiling is disabled.
+func (e *Evaluator) Profiler() *ConditionProfiler {
+	return e.profiler
+}
+
 // EvaluateResult represents the result of evaluating a single condition.
 type EvaluateResult struct {
 	Matched  bool
@@ -46,48 +193,70 @@ type EvaluateResult struct {
 }
 
 // EvaluateCondition evaluates a single condition against an alert.
-func (e *Evaluator) EvaluateCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) *routingv1.ConditionResult {
+func (e *Evaluator) EvaluateCondition(ctx context.Context, cond *routingv1.RoutingCondition, alert *routingv1.Alert) *routingv1.ConditionResult {
+	return e.evaluateCondition(ctx, ruleConditionKey{}, cond, alert, time.UTC)
+}
+
+// evaluateCondition evaluates the condition identified by key against an alert.
+// TIME_WINDOW conditions are evaluated in loc. Stores are queried with ctx,
+// which carries the tenant of the alert.
+func (e *Evaluator) evaluateCondition(ctx context.Context, key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert, loc *time.Location) *routingv1.ConditionResult {
 	result := &routingv1.ConditionResult{
 		Type:     cond.Type,
 		Field:    cond.Field,
+		Operator: cond.Operator,
 		Expected: e.getExpectedValue(cond),
 		Matched:  false,
 	}
 
+	// GROUP_SIZE compares against int_value whatever the operator
+	if cond.Type == routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE {
+		result.Actual, result.Matched = e.evaluateGroupSizeCondition(ctx, cond, alert)
+		return result
+	}
+
+	if isNumericOperator(cond.Operator) {
+		result.Actual, result.Matched = e.evaluateNumericCondition(key, cond, alert)
+		return result
+	}
+
 	switch cond.Type {
 	case routingv1.ConditionType_CONDITION_TYPE_LABEL:
-		result.Actual, result.Matched = e.evaluateLabelCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateLabelCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_ANNOTATION:
-		result.Actual, result.Matched = e.evaluateAnnotationCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateAnnotationCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_SEVERITY:
-		result.Actual, result.Matched = e.evaluateSeverityCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateSeverityCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_SOURCE:
-		result.Actual, result.Matched = e.evaluateSourceCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateSourceCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_SERVICE:
-		result.Actual, result.Matched = e.evaluateServiceCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateServiceCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_SITE:
-		result.Actual, result.Matched = e.evaluateSiteCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateSiteCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_POP:
-		result.Actual, result.Matched = e.evaluatePOPCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluatePOPCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_CUSTOMER_TIER:
-		result.Actual, result.Matched = e.evaluateCustomerTierCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateCustomerTierCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_EQUIPMENT_TYPE:
-		result.Actual, result.Matched = e.evaluateEquipmentTypeCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateEquipmentTypeCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_CARRIER:
-		result.Actual, result.Matched = e.evaluateCarrierCondition(cond, alert)
+		result.Actual, result.Matched = e.evaluateCarrierCondition(key, cond, alert)
 
 	case routingv1.ConditionType_CONDITION_TYPE_CEL:
 		result.Actual, result.Matched = e.evaluateCELCondition(cond, alert)
 
+	case routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW:
+		result.Actual, result.Matched = e.evaluateTimeWindowCondition(cond, loc)
+
 	default:
 		result.Actual = "unknown condition type"
 		result.Matched = false
@@ -98,7 +267,7 @@ func (e *Evaluator) EvaluateCondition(cond *routingv1.RoutingCondition, alert *r
 
 // EvaluateRule evaluates all conditions of a rule against an alert.
 // All conditions must match (AND logic).
-func (e *Evaluator) EvaluateRule(rule *routingv1.RoutingRule, alert *routingv1.Alert, evaluateAt time.Time) *routingv1.RuleEvaluation {
+func (e *Evaluator) EvaluateRule(ctx context.Context, rule *routingv1.RoutingRule, alert *routingv1.Alert, evaluateAt time.Time) *routingv1.RuleEvaluation {
 	eval := &routingv1.RuleEvaluation{
 		RuleId:   rule.Id,
 		RuleName: rule.Name,
@@ -107,6 +276,13 @@ func (e *Evaluator) EvaluateRule(rule *routingv1.RoutingRule, alert *routingv1.A
 		Terminal: rule.Terminal,
 	}
 
+	// Skip the rule entirely if the alert's site is outside the rule's scope
+	eval.SiteScopeMatched, eval.SiteScopeReason = e.evaluateSiteScope(ctx, rule, alert)
+	if !eval.SiteScopeMatched {
+		eval.Matched = false
+		return eval
+	}
+
 	// Check time condition first
 	if rule.TimeCondition != nil {
 		eval.TimeConditionMatched, eval.TimeConditionReason = e.evaluateTimeCondition(rule.TimeCondition, evaluateAt)
@@ -120,8 +296,9 @@ func (e *Evaluator) EvaluateRule(rule *routingv1.RoutingRule, alert *routingv1.A
 	}
 
 	// Evaluate all conditions (AND logic)
+	loc := e.ruleLocation(ctx, rule)
 	for i, cond := range rule.Conditions {
-		condResult := e.EvaluateCondition(cond, alert)
+		condResult := e.evaluateProfiledCondition(ctx, ruleConditionKey{ruleID: rule.Id, index: i}, cond, alert, loc)
 		condResult.ConditionIndex = int32(i)
 		eval.ConditionResults = append(eval.ConditionResults, condResult)
 
@@ -133,24 +310,68 @@ func (e *Evaluator) EvaluateRule(rule *routingv1.RoutingRule, alert *routingv1.A
 	return eval
 }
 
+// evaluateProfiledCondition evaluates a rule condition, recording its evaluation
+// time when profiling is enabled.
+func (e *Evaluator) evaluateProfiledCondition(ctx context.Context, key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert, loc *time.Location) *routingv1.ConditionResult {
+	if e.profiler == nil {
+		return e.evaluateCondition(ctx, key, cond, alert, loc)
+	}
+
+	start := time.Now()
+	result := e.evaluateCondition(ctx, key, cond, alert, loc)
+	e.profiler.Observe(key.ruleID, cond.Type, time.Since(start))
+	return result
+}
+
 // EvaluateRules evaluates multiple rules against an alert and returns matching rules.
-func (e *Evaluator) EvaluateRules(rules []*routingv1.RoutingRule, alert *routingv1.Alert, evaluateAt time.Time) ([]*routingv1.RuleEvaluation, []*routingv1.RoutingAction) {
+// Rules that list the alert's integration key in forced_integration_keys are
+// evaluated first; the first forced rule that matches stops evaluation. A
+// matching stop_processing or terminal rule skips all lower-priority rules.
+func (e *Evaluator) EvaluateRules(ctx context.Context, rules []*routingv1.RoutingRule, alert *routingv1.Alert, evaluateAt time.Time) ([]*routingv1.RuleEvaluation, []*routingv1.RoutingAction) {
+	return evaluateRules(rules, alert, func(rule *routingv1.RoutingRule) *routingv1.RuleEvaluation {
+		return e.EvaluateRule(ctx, rule, alert, evaluateAt)
+	})
+}
+
+// evaluateRules implements EvaluateRules, evaluating each rule with evaluate.
+func evaluateRules(rules []*routingv1.RoutingRule, alert *routingv1.Alert, evaluate func(*routingv1.RoutingRule) *routingv1.RuleEvaluation) ([]*routingv1.RuleEvaluation, []*routingv1.RoutingAction) {
 	var evaluations []*routingv1.RuleEvaluation
 	var matchedActions []*routingv1.RoutingAction
 
+	// Evaluate rules forced by the alert's integration key first
+	forced := make(map[string]bool)
+	if alert.IntegrationKey != "" {
+		for _, rule := range rules {
+			if !rule.Enabled || !containsString(rule.ForcedIntegrationKeys, alert.IntegrationKey) {
+				continue
+			}
+
+			forced[rule.Id] = true
+			eval := evaluate(rule)
+			evaluations = append(evaluations, eval)
+
+			// A forced match implicitly stops evaluation
+			if eval.Matched {
+				eval.StoppedProcessing = true
+				return evaluations, rule.Actions
+			}
+		}
+	}
+
 	for _, rule := range rules {
-		if !rule.Enabled {
+		if !rule.Enabled || forced[rule.Id] {
 			continue
 		}
 
-		eval := e.EvaluateRule(rule, alert, evaluateAt)
+		eval := evaluate(rule)
 		evaluations = append(evaluations, eval)
 
 		if eval.Matched {
 			matchedActions = append(matchedActions, rule.Actions...)
 
-			// If terminal, stop evaluating more rules
-			if rule.Terminal {
+			// Skip lower-priority rules once a stop rule has contributed its actions
+			if rule.StopProcessing || rule.Terminal {
+				eval.StoppedProcessing = true
 				break
 			}
 		}
@@ -159,14 +380,76 @@ func (e *Evaluator) EvaluateRules(rules []*routingv1.RoutingRule, alert *routing
 	return evaluations, matchedActions
 }
 
+// StoppedAtRuleID returns the ID of the rule that stopped evaluation, or an
+// empty string if no rule did.
+func StoppedAtRuleID(evaluations []*routingv1.RuleEvaluation) string {
+	for _, eval := range evaluations {
+		if eval.StoppedProcessing {
+			return eval.RuleId
+		}
+	}
+	return ""
+}
+
+// evaluateSiteScope checks whether the site referenced by the alert's site_code
+// label is within the rule's affected regions and site types.
+func (e *Evaluator) evaluateSiteScope(ctx context.Context, rule *routingv1.RoutingRule, alert *routingv1.Alert) (bool, string) {
+	if len(rule.AffectedRegions) == 0 && len(rule.AffectedSiteTypes) == 0 {
+		return true, "no site scope"
+	}
+
+	siteCode := alert.Labels["site_code"]
+	if siteCode == "" {
+		return false, "alert has no site_code label"
+	}
+	if e.siteStore == nil {
+		return false, "no site store configured"
+	}
+
+	s, err := e.siteStore.GetByCode(ctx, siteCode)
+	if err != nil {
+		return false, "site " + siteCode + " not resolved: " + err.Error()
+	}
+
+	if len(rule.AffectedRegions) > 0 && !containsStringFold(rule.AffectedRegions, s.Region) {
+		return false, "site region " + s.Region + " not in affected regions"
+	}
+
+	if len(rule.AffectedSiteTypes) > 0 {
+		siteType := s.SiteType.ToProto()
+		inScope := false
+		for _, t := range rule.AffectedSiteTypes {
+			if t == siteType {
+				inScope = true
+				break
+			}
+		}
+		if !inScope {
+			return false, "site type " + string(s.SiteType) + " not in affected site types"
+		}
+	}
+
+	return true, "site " + siteCode + " in scope"
+}
+
 // getExpectedValue returns a string representation of the expected value for logging.
 func (e *Evaluator) getExpectedValue(cond *routingv1.RoutingCondition) string {
+	if cond.Type == routingv1.ConditionType_CONDITION_TYPE_CEL {
+		return cond.CelExpression
+	}
+	if cond.Type == routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE {
+		return ">= " + strconv.FormatInt(cond.IntValue, 10)
+	}
+
 	switch cond.Operator {
 	case routingv1.ConditionOperator_CONDITION_OPERATOR_IN,
 		routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_IN:
 		return strings.Join(cond.StringList, ", ")
 	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX:
 		return cond.RegexPattern
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH:
+		return cond.StringValue
 	case routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
 		routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS:
 		return "exists"
@@ -176,7 +459,7 @@ func (e *Evaluator) getExpectedValue(cond *routingv1.RoutingCondition) string {
 }
 
 // evaluateLabelCondition evaluates a label-based condition.
-func (e *Evaluator) evaluateLabelCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateLabelCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	labelValue, exists := alert.Labels[cond.Field]
 	if !exists {
 		if cond.Operator == routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS {
@@ -188,11 +471,11 @@ func (e *Evaluator) evaluateLabelCondition(cond *routingv1.RoutingCondition, ale
 		return "", false
 	}
 
-	return labelValue, e.compareValue(cond.Operator, labelValue, cond)
+	return labelValue, e.compareValue(key, cond.Operator, labelValue, cond)
 }
 
 // evaluateAnnotationCondition evaluates an annotation-based condition.
-func (e *Evaluator) evaluateAnnotationCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateAnnotationCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	annotationValue, exists := alert.Annotations[cond.Field]
 	if !exists {
 		if cond.Operator == routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS {
@@ -204,71 +487,272 @@ func (e *Evaluator) evaluateAnnotationCondition(cond *routingv1.RoutingCondition
 		return "", false
 	}
 
-	return annotationValue, e.compareValue(cond.Operator, annotationValue, cond)
+	return annotationValue, e.compareValue(key, cond.Operator, annotationValue, cond)
 }
 
 // evaluateSeverityCondition evaluates a severity-based condition.
-func (e *Evaluator) evaluateSeverityCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateSeverityCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	// Severity is typically stored as a label
 	severity := alert.Labels["severity"]
 	if severity == "" {
 		severity = "unknown"
 	}
 
-	return severity, e.compareValue(cond.Operator, severity, cond)
+	return severity, e.compareValue(key, cond.Operator, severity, cond)
 }
 
 // evaluateSourceCondition evaluates a source-based condition.
-func (e *Evaluator) evaluateSourceCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateSourceCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	source := alert.Source.String()
-	return source, e.compareValue(cond.Operator, source, cond)
+	return source, e.compareValue(key, cond.Operator, source, cond)
 }
 
 // evaluateServiceCondition evaluates a service-based condition.
-func (e *Evaluator) evaluateServiceCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
-	return alert.ServiceId, e.compareValue(cond.Operator, alert.ServiceId, cond)
+func (e *Evaluator) evaluateServiceCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+	return alert.ServiceId, e.compareValue(key, cond.Operator, alert.ServiceId, cond)
+}
+
+// RuleReferencesService reports whether a rule has a service condition naming the service.
+func RuleReferencesService(rule *routingv1.RoutingRule, serviceID string) bool {
+	for _, cond := range rule.Conditions {
+		if cond.Type != routingv1.ConditionType_CONDITION_TYPE_SERVICE {
+			continue
+		}
+		if cond.StringValue == serviceID || containsString(cond.StringList, serviceID) {
+			return true
+		}
+	}
+	return false
 }
 
 // evaluateSiteCondition evaluates a site-based condition.
-func (e *Evaluator) evaluateSiteCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateSiteCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	site := alert.Labels["site"]
 	if site == "" {
 		site = alert.Labels["datacenter"]
 	}
-	return site, e.compareValue(cond.Operator, site, cond)
+	return site, e.compareValue(key, cond.Operator, site, cond)
 }
 
 // evaluatePOPCondition evaluates a POP-based condition.
-func (e *Evaluator) evaluatePOPCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluatePOPCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	pop := alert.Labels["pop"]
-	return pop, e.compareValue(cond.Operator, pop, cond)
+	return pop, e.compareValue(key, cond.Operator, pop, cond)
 }
 
 // evaluateCustomerTierCondition evaluates a customer tier-based condition.
-func (e *Evaluator) evaluateCustomerTierCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateCustomerTierCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	tier := alert.Labels["customer_tier"]
 	if tier == "" {
 		tier = alert.Labels["tier"]
 	}
-	return tier, e.compareValue(cond.Operator, tier, cond)
+	return tier, e.compareValue(key, cond.Operator, tier, cond)
 }
 
 // evaluateEquipmentTypeCondition evaluates an equipment type-based condition.
-func (e *Evaluator) evaluateEquipmentTypeCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateEquipmentTypeCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	equipType := alert.Labels["equipment_type"]
 	if equipType == "" {
 		equipType = alert.Labels["device_type"]
 	}
-	return equipType, e.compareValue(cond.Operator, equipType, cond)
+	return equipType, e.compareValue(key, cond.Operator, equipType, cond)
 }
 
 // evaluateCarrierCondition evaluates a carrier-based condition.
-func (e *Evaluator) evaluateCarrierCondition(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+func (e *Evaluator) evaluateCarrierCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
 	carrier := alert.Labels["carrier"]
 	if carrier == "" {
 		carrier = alert.Labels["asn"]
 	}
-	return carrier, e.compareValue(cond.Operator, carrier, cond)
+	return carrier, e.compareValue(key, cond.Operator, carrier, cond)
+}
+
+// isNumericOperator reports whether op compares values as numbers.
+func isNumericOperator(op routingv1.ConditionOperator) bool {
+	switch op {
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GTE,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LTE,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_EQ:
+		return true
+	default:
+		return false
+	}
+}
+
+// evaluateNumericCondition evaluates a numeric comparison. An alert without the
+// value does not match; a value that is not a number also fails the condition
+// and is logged as ErrInvalidCondition.
+func (e *Evaluator) evaluateNumericCondition(key ruleConditionKey, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+	actualStr, ok := numericConditionValue(cond, alert)
+	if !ok {
+		return "", false
+	}
+
+	actual, err := parseNumericOperand(cond, actualStr)
+	if err == nil {
+		var expected float64
+		if expected, err = parseNumericOperand(cond, cond.StringValue); err == nil {
+			return actualStr, compareNumbers(cond.Operator, actual, expected)
+		}
+	}
+
+	e.logger.Warn().
+		Err(err).
+		Str("rule_id", key.ruleID).
+		Int("condition_index", key.index).
+		Str("field", cond.Field).
+		Str("alert_id", alert.Id).
+		Msg("numeric routing condition not evaluated")
+	return err.Error(), false
+}
+
+// numericConditionValue returns the alert value compared by a numeric condition:
+// the severity label for the severity field or condition type, and the label
+// named by a labels.<key> field or a label condition. It returns false if the
+// alert does not have the value.
+func numericConditionValue(cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+	var value string
+	var ok bool
+	switch {
+	case isSeverityField(cond):
+		value, ok = alert.Labels["severity"]
+	case strings.HasPrefix(cond.Field, labelFieldPrefix):
+		value, ok = alert.Labels[strings.TrimPrefix(cond.Field, labelFieldPrefix)]
+	case cond.Type == routingv1.ConditionType_CONDITION_TYPE_LABEL:
+		value, ok = alert.Labels[cond.Field]
+	}
+	return value, ok
+}
+
+// parseNumericOperand parses one side of a numeric condition. Severities are
+// compared by their Severity enum ordinal and may be given by name or number.
+func parseNumericOperand(cond *routingv1.RoutingCondition, value string) (float64, error) {
+	if isSeverityField(cond) {
+		if v, ok := alertingv1.Severity_value["SEVERITY_"+strings.ToUpper(value)]; ok {
+			return float64(v), nil
+		}
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidCondition, value)
+	}
+	return n, nil
+}
+
+// isSeverityField reports whether a condition compares the alert severity.
+func isSeverityField(cond *routingv1.RoutingCondition) bool {
+	return cond.Field == "severity" || cond.Type == routingv1.ConditionType_CONDITION_TYPE_SEVERITY
+}
+
+// compareNumbers compares two numbers using a numeric operator.
+func compareNumbers(op routingv1.ConditionOperator, actual, expected float64) bool {
+	switch op {
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT:
+		return actual > expected
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GTE:
+		return actual >= expected
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT:
+		return actual < expected
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LTE:
+		return actual <= expected
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_EQ:
+		return actual == expected
+	default:
+		return false
+	}
+}
+
+// ruleLocation returns the timezone TIME_WINDOW conditions of a rule are
+// evaluated in: that of the first schedule the rule notifies, or UTC.
+func (e *Evaluator) ruleLocation(ctx context.Context, rule *routingv1.RoutingRule) *time.Location {
+	if e.scheduleStore == nil || !hasTimeWindowCondition(rule) {
+		return time.UTC
+	}
+
+	for _, action := range rule.Actions {
+		if action.NotifyOncall == nil || action.NotifyOncall.ScheduleId == "" {
+			continue
+		}
+		schedule, err := e.scheduleStore.GetSchedule(ctx, action.NotifyOncall.ScheduleId)
+		if err != nil {
+			e.logger.Warn().Err(err).
+				Str("rule_id", rule.Id).
+				Str("schedule_id", action.NotifyOncall.ScheduleId).
+				Msg("failed to resolve schedule timezone for time window condition")
+			continue
+		}
+		if schedule.Timezone == "" {
+			continue
+		}
+		if loc, err := time.LoadLocation(schedule.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// hasTimeWindowCondition reports whether a rule has a TIME_WINDOW condition.
+func hasTimeWindowCondition(rule *routingv1.RoutingRule) bool {
+	for _, cond := range rule.Conditions {
+		if cond.Type == routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeWindow decodes the JSON-encoded TimeWindow of a TIME_WINDOW condition.
+func parseTimeWindow(value string) (*routingv1.TimeWindow, error) {
+	window := &routingv1.TimeWindow{}
+	if err := protojson.Unmarshal([]byte(value), window); err != nil {
+		return nil, fmt.Errorf("%w: time window: %v", ErrInvalidCondition, err)
+	}
+	for _, t := range []string{window.StartTime, window.EndTime} {
+		if _, err := time.Parse("15:04", t); err != nil {
+			return nil, fmt.Errorf("%w: time window: %q is not HH:MM", ErrInvalidCondition, t)
+		}
+	}
+	return window, nil
+}
+
+// evaluateTimeWindowCondition evaluates a TIME_WINDOW condition at the current
+// time of the evaluator's clock in loc.
+func (e *Evaluator) evaluateTimeWindowCondition(cond *routingv1.RoutingCondition, loc *time.Location) (string, bool) {
+	window, err := parseTimeWindow(cond.StringValue)
+	if err != nil {
+		return err.Error(), false
+	}
+
+	now := e.clock().In(loc)
+	matched := e.isInTimeWindow(now, window)
+	if window.Invert {
+		matched = !matched
+	}
+	return now.Format("Mon 15:04 MST"), matched
+}
+
+// evaluateGroupSizeCondition evaluates a GROUP_SIZE condition: it matches once
+// the correlation group of the alert has at least int_value open alerts.
+func (e *Evaluator) evaluateGroupSizeCondition(ctx context.Context, cond *routingv1.RoutingCondition, alert *routingv1.Alert) (string, bool) {
+	if alert.GroupId == "" {
+		return "no correlation group", false
+	}
+	if e.groupSizer == nil {
+		return "group sizes not available", false
+	}
+
+	size, err := e.groupSizer.GroupSize(ctx, alert.GroupId)
+	if err != nil {
+		e.logger.Warn().Err(err).
+			Str("alert_id", alert.Id).
+			Str("group_id", alert.GroupId).
+			Msg("failed to count correlation group for group size condition")
+		return err.Error(), false
+	}
+	return strconv.Itoa(size), int64(size) >= cond.IntValue
 }
 
 // evaluateCELCondition evaluates a CEL expression condition.
@@ -298,6 +782,27 @@ func (e *Evaluator) evaluateCELCondition(cond *routingv1.RoutingCondition, alert
 	return "CEL expression did not match", false
 }
 
+// PrecompileCEL compiles the CEL expressions of the rules' conditions ahead of
+// evaluation. It returns an error naming the rule for each invalid expression.
+func (e *Evaluator) PrecompileCEL(rules []*routingv1.RoutingRule) []error {
+	if e.celEvaluator == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		for _, cond := range rule.Conditions {
+			if cond.Type != routingv1.ConditionType_CONDITION_TYPE_CEL || cond.CelExpression == "" {
+				continue
+			}
+			if err := e.celEvaluator.Precompile(cond.CelExpression); err != nil {
+				errs = append(errs, fmt.Errorf("rule %s: %w", rule.Id, err))
+			}
+		}
+	}
+	return errs
+}
+
 // EvaluateCELWithContext evaluates a CEL expression with full context.
 func (e *Evaluator) EvaluateCELWithContext(expression string, alert *routingv1.Alert, ctx *cel.EvalContext) (bool, error) {
 	if e.celEvaluator == nil {
@@ -315,7 +820,7 @@ func (e *Evaluator) ValidateCELExpression(expression string) error {
 }
 
 // compareValue compares a value using the specified operator.
-func (e *Evaluator) compareValue(op routingv1.ConditionOperator, actual string, cond *routingv1.RoutingCondition) bool {
+func (e *Evaluator) compareValue(key ruleConditionKey, op routingv1.ConditionOperator, actual string, cond *routingv1.RoutingCondition) bool {
 	expected := cond.StringValue
 
 	switch op {
@@ -345,6 +850,15 @@ func (e *Evaluator) compareValue(op routingv1.ConditionOperator, actual string,
 		matched, err := regexp.MatchString(pattern, actual)
 		return err == nil && matched
 
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH:
+		re, err := e.conditionRegex(key, expected)
+		return err == nil && re.MatchString(actual)
+
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH:
+		// An invalid pattern matches nothing, including its negation
+		re, err := e.conditionRegex(key, expected)
+		return err == nil && !re.MatchString(actual)
+
 	case routingv1.ConditionOperator_CONDITION_OPERATOR_IN:
 		for _, v := range cond.StringList {
 			if actual == v {
@@ -388,6 +902,40 @@ func (e *Evaluator) compareValue(op routingv1.ConditionOperator, actual string,
 	}
 }
 
+// conditionRegex returns the compiled pattern of a condition, compiling it on
+// first use. Patterns are cached by rule condition and recompiled when the
+// condition's pattern changes; conditions outside of a rule are not cached.
+func (e *Evaluator) conditionRegex(key ruleConditionKey, pattern string) (*regexp.Regexp, error) {
+	if key.ruleID == "" {
+		return regexp.Compile(pattern)
+	}
+
+	e.regexMu.RLock()
+	cached, ok := e.regexes[key]
+	e.regexMu.RUnlock()
+	if ok && cached.pattern == pattern {
+		return cached.re, cached.err
+	}
+
+	re, err := regexp.Compile(pattern)
+
+	e.regexMu.Lock()
+	if e.regexes == nil {
+		e.regexes = make(map[ruleConditionKey]*conditionRegex)
+	}
+	e.regexes[key] = &conditionRegex{pattern: pattern, re: re, err: err}
+	e.regexMu.Unlock()
+
+	return re, err
+}
+
+// resetRegexCache drops the compiled condition patterns.
+func (e *Evaluator) resetRegexCache() {
+	e.regexMu.Lock()
+	defer e.regexMu.Unlock()
+	e.regexes = nil
+}
+
 // evaluateTimeCondition evaluates time-based conditions.
 func (e *Evaluator) evaluateTimeCondition(tc *routingv1.TimeCondition, evaluateAt time.Time) (bool, string) {
 	if len(tc.Windows) == 0 {
@@ -415,27 +963,10 @@ func (e *Evaluator) evaluateTimeCondition(tc *routingv1.TimeCondition, evaluateA
 	return false, "no time window matched"
 }
 
-// isInTimeWindow checks if a time falls within a time window.
+// isInTimeWindow checks if a time falls within a time window. The part of an
+// overnight window after midnight belongs to the day the window started, so a
+// Friday 22:00-06:00 window includes Saturday 02:00.
 func (e *Evaluator) isInTimeWindow(t time.Time, window *routingv1.TimeWindow) bool {
-	// Check day of week
-	dayOfWeek := int32(t.Weekday())
-	dayMatches := false
-
-	if len(window.DaysOfWeek) == 0 {
-		dayMatches = true
-	} else {
-		for _, day := range window.DaysOfWeek {
-			if day == dayOfWeek {
-				dayMatches = true
-				break
-			}
-		}
-	}
-
-	if !dayMatches {
-		return false
-	}
-
 	// Parse start and end times
 	startParts := strings.Split(window.StartTime, ":")
 	endParts := strings.Split(window.EndTime, ":")
@@ -453,12 +984,31 @@ func (e *Evaluator) isInTimeWindow(t time.Time, window *routingv1.TimeWindow) bo
 	startMinutes := startHour*60 + startMin
 	endMinutes := endHour*60 + endMin
 
-	// Handle overnight windows (e.g., 22:00 - 06:00)
+	dayOfWeek := int32(t.Weekday())
+	var inWindow bool
 	if endMinutes < startMinutes {
-		return currentMinutes >= startMinutes || currentMinutes < endMinutes
+		// Handle overnight windows (e.g., 22:00 - 06:00)
+		inWindow = currentMinutes >= startMinutes || currentMinutes < endMinutes
+		if currentMinutes < endMinutes {
+			dayOfWeek = (dayOfWeek + 6) % 7
+		}
+	} else {
+		inWindow = currentMinutes >= startMinutes && currentMinutes < endMinutes
+	}
+	if !inWindow {
+		return false
 	}
 
-	return currentMinutes >= startMinutes && currentMinutes < endMinutes
+	// Check day of week
+	if len(window.DaysOfWeek) == 0 {
+		return true
+	}
+	for _, day := range window.DaysOfWeek {
+		if day == dayOfWeek {
+			return true
+		}
+	}
+	return false
 }
 
 // SeverityLevel converts a severity string to a numeric level for comparison.
@@ -493,3 +1043,23 @@ func CompareSeverity(a, b string) int {
 	}
 	return 0
 }
+
+// containsString reports whether values contains s.
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsStringFold reports whether values contains s, ignoring case.
+func containsStringFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,9 +1,18 @@
 package routing
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/site"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -161,7 +170,7 @@ func TestEvaluator_EvaluateCondition_Label(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := evaluator.EvaluateCondition(tt.condition, tt.alert)
+			result := evaluator.EvaluateCondition(context.Background(), tt.condition, tt.alert)
 			if result.Matched != tt.wantMatch {
 				t.Errorf("EvaluateCondition() matched = %v, want %v", result.Matched, tt.wantMatch)
 			}
@@ -206,7 +215,7 @@ func TestEvaluator_EvaluateCondition_Severity(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := evaluator.EvaluateCondition(tt.condition, tt.alert)
+			result := evaluator.EvaluateCondition(context.Background(), tt.condition, tt.alert)
 			if result.Matched != tt.wantMatch {
 				t.Errorf("EvaluateCondition() matched = %v, want %v", result.Matched, tt.wantMatch)
 			}
@@ -263,7 +272,7 @@ func TestEvaluator_EvaluateCondition_Site(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := evaluator.EvaluateCondition(tt.condition, tt.alert)
+			result := evaluator.EvaluateCondition(context.Background(), tt.condition, tt.alert)
 			if result.Matched != tt.wantMatch {
 				t.Errorf("EvaluateCondition() matched = %v, want %v", result.Matched, tt.wantMatch)
 			}
@@ -379,7 +388,7 @@ func TestEvaluator_EvaluateRule(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := evaluator.EvaluateRule(tt.rule, tt.alert, time.Now())
+			result := evaluator.EvaluateRule(context.Background(), tt.rule, tt.alert, time.Now())
 			if result.Matched != tt.wantMatch {
 				t.Errorf("EvaluateRule() matched = %v, want %v", result.Matched, tt.wantMatch)
 			}
@@ -512,7 +521,7 @@ func TestEvaluator_EvaluateRules_TerminalRule(t *testing.T) {
 		Labels: map[string]string{"severity": "critical"},
 	}
 
-	evaluations, actions := evaluator.EvaluateRules(rules, alert, time.Now())
+	evaluations, actions := evaluator.EvaluateRules(context.Background(), rules, alert, time.Now())
 
 	// Should have evaluated first rule and stopped (terminal)
 	if len(evaluations) != 1 {
@@ -528,6 +537,388 @@ func TestEvaluator_EvaluateRules_TerminalRule(t *testing.T) {
 	}
 }
 
+func TestEvaluator_EvaluateRules_StopProcessing(t *testing.T) {
+	stopRule := func(enabled bool, severity string) *routingv1.RoutingRule {
+		return &routingv1.RoutingRule{
+			Id:             "rule-stop",
+			Name:           "Critical Handler",
+			Enabled:        enabled,
+			Priority:       1,
+			StopProcessing: true,
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "severity",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+					StringValue: severity,
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+			},
+		}
+	}
+	catchAll := &routingv1.RoutingRule{
+		Id:       "rule-catch-all",
+		Name:     "All Alerts Logger",
+		Enabled:  true,
+		Priority: 2,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:    "severity",
+				Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
+			},
+		},
+		Actions: []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_SET_LABEL},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		stopRule          *routingv1.RoutingRule
+		wantEvaluated     []string
+		wantActions       int
+		wantStoppedByRule bool
+	}{
+		{
+			name:              "stop rule matches",
+			stopRule:          stopRule(true, "critical"),
+			wantEvaluated:     []string{"rule-stop"},
+			wantActions:       1,
+			wantStoppedByRule: true,
+		},
+		{
+			name:          "stop rule does not match",
+			stopRule:      stopRule(true, "warning"),
+			wantEvaluated: []string{"rule-stop", "rule-catch-all"},
+			wantActions:   1,
+		},
+		{
+			name:          "stop rule disabled",
+			stopRule:      stopRule(false, "critical"),
+			wantEvaluated: []string{"rule-catch-all"},
+			wantActions:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := NewEvaluator()
+			alert := &routingv1.Alert{
+				Labels: map[string]string{"severity": "critical"},
+			}
+
+			evaluations, actions := evaluator.EvaluateRules(context.Background(), []*routingv1.RoutingRule{tt.stopRule, catchAll}, alert, time.Now())
+
+			var evaluated []string
+			for _, eval := range evaluations {
+				evaluated = append(evaluated, eval.RuleId)
+			}
+			if strings.Join(evaluated, ",") != strings.Join(tt.wantEvaluated, ",") {
+				t.Errorf("evaluated rules = %v, want %v", evaluated, tt.wantEvaluated)
+			}
+
+			if len(actions) != tt.wantActions {
+				t.Errorf("Expected %d actions, got %d", tt.wantActions, len(actions))
+			}
+
+			if evaluations[0].StoppedProcessing != tt.wantStoppedByRule {
+				t.Errorf("StoppedProcessing = %v, want %v", evaluations[0].StoppedProcessing, tt.wantStoppedByRule)
+			}
+			wantStoppedAt := ""
+			if tt.wantStoppedByRule {
+				wantStoppedAt = "rule-stop"
+			}
+			if got := StoppedAtRuleID(evaluations); got != wantStoppedAt {
+				t.Errorf("StoppedAtRuleID() = %q, want %q", got, wantStoppedAt)
+			}
+			if last := evaluations[len(evaluations)-1]; last.RuleId == "rule-catch-all" && last.StoppedProcessing {
+				t.Error("Expected catch-all rule not to stop processing")
+			}
+		})
+	}
+}
+
+func forcedKeyTestRules() []*routingv1.RoutingRule {
+	return []*routingv1.RoutingRule{
+		{
+			Id:       "rule-default",
+			Name:     "Default Handler",
+			Enabled:  true,
+			Priority: 1,
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:    "severity",
+					Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+			},
+		},
+		{
+			Id:                    "rule-forced-critical",
+			Name:                  "Forced Critical Handler",
+			Enabled:               true,
+			Priority:              10,
+			ForcedIntegrationKeys: []string{"key-db"},
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:       "severity",
+					Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+					StringValue: "critical",
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_ESCALATE},
+			},
+		},
+		{
+			Id:                    "rule-forced-any",
+			Name:                  "Forced Catch-all Handler",
+			Enabled:               true,
+			Priority:              20,
+			ForcedIntegrationKeys: []string{"key-db", "key-net"},
+			Conditions: []*routingv1.RoutingCondition{
+				{
+					Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+					Field:    "severity",
+					Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
+				},
+			},
+			Actions: []*routingv1.RoutingAction{
+				{Type: routingv1.ActionType_ACTION_TYPE_CREATE_TICKET},
+			},
+		},
+	}
+}
+
+func TestEvaluator_EvaluateRules_ForcedIntegrationKeyMatches(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	alert := &routingv1.Alert{
+		IntegrationKey: "key-net",
+		Labels:         map[string]string{"severity": "warning"},
+	}
+
+	evaluations, actions := evaluator.EvaluateRules(context.Background(), forcedKeyTestRules(), alert, time.Now())
+
+	if len(evaluations) != 1 {
+		t.Fatalf("Expected 1 evaluation (forced rule matched), got %d", len(evaluations))
+	}
+	if evaluations[0].RuleId != "rule-forced-any" {
+		t.Errorf("Expected forced rule to be evaluated first, got %s", evaluations[0].RuleId)
+	}
+	if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_CREATE_TICKET {
+		t.Errorf("Expected only the forced rule action, got %v", actions)
+	}
+}
+
+func TestEvaluator_EvaluateRules_ForcedIntegrationKeyFallsThrough(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	rules := forcedKeyTestRules()[:2]
+	alert := &routingv1.Alert{
+		IntegrationKey: "key-db",
+		Labels:         map[string]string{"severity": "warning"},
+	}
+
+	evaluations, actions := evaluator.EvaluateRules(context.Background(), rules, alert, time.Now())
+
+	if len(evaluations) != 2 {
+		t.Fatalf("Expected 2 evaluations, got %d", len(evaluations))
+	}
+	if evaluations[0].RuleId != "rule-forced-critical" || evaluations[0].Matched {
+		t.Errorf("Expected unmatched forced rule first, got %s (matched=%v)", evaluations[0].RuleId, evaluations[0].Matched)
+	}
+	if evaluations[1].RuleId != "rule-default" || !evaluations[1].Matched {
+		t.Errorf("Expected default rule to match in normal order, got %s (matched=%v)", evaluations[1].RuleId, evaluations[1].Matched)
+	}
+	if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM {
+		t.Errorf("Expected default rule action, got %v", actions)
+	}
+}
+
+func TestEvaluator_EvaluateRules_MultipleForcedRules(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	alert := &routingv1.Alert{
+		IntegrationKey: "key-db",
+		Labels:         map[string]string{"severity": "critical"},
+	}
+
+	evaluations, actions := evaluator.EvaluateRules(context.Background(), forcedKeyTestRules(), alert, time.Now())
+
+	if len(evaluations) != 1 {
+		t.Fatalf("Expected 1 evaluation (first forced rule matched), got %d", len(evaluations))
+	}
+	if evaluations[0].RuleId != "rule-forced-critical" {
+		t.Errorf("Expected first matching forced rule, got %s", evaluations[0].RuleId)
+	}
+	if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_ESCALATE {
+		t.Errorf("Expected only the first forced rule action, got %v", actions)
+	}
+}
+
+// mockSiteStore is a site.Store that resolves sites from a fixed map keyed by code.
+type mockSiteStore struct {
+	sites map[string]*site.Site
+}
+
+func (m *mockSiteStore) GetByCode(ctx context.Context, code string) (*site.Site, error) {
+	s, ok := m.sites[code]
+	if !ok {
+		return nil, site.ErrSiteNotFound
+	}
+	return s, nil
+}
+
+func (m *mockSiteStore) GetByID(ctx context.Context, id string) (*site.Site, error) {
+	return nil, site.ErrSiteNotFound
+}
+
+func (m *mockSiteStore) List(ctx context.Context, filter *site.ListSitesFilter) ([]*site.Site, string, error) {
+	return nil, "", nil
+}
+
+func (m *mockSiteStore) Create(ctx context.Context, s *site.Site) (*site.Site, error) {
+	return s, nil
+}
+
+func (m *mockSiteStore) Update(ctx context.Context, s *site.Site) (*site.Site, error) {
+	return s, nil
+}
+
+func (m *mockSiteStore) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockSiteStore) GetTeamByID(ctx context.Context, id string) (*site.Team, error) {
+	return nil, site.ErrTeamNotFound
+}
+
+func (m *mockSiteStore) UpdateSiteCapacity(ctx context.Context, siteID string, metrics *site.CapacityMetrics) error {
+	return nil
+}
+
+func newSiteScopeTestStore() *mockSiteStore {
+	return &mockSiteStore{
+		sites: map[string]*site.Site{
+			"IAD1": {Code: "IAD1", Region: "us-east", SiteType: site.SiteTypeDatacenter},
+			"IAD2": {Code: "IAD2", Region: "us-east", SiteType: site.SiteTypePOP},
+			"FRA1": {Code: "FRA1", Region: "eu-central", SiteType: site.SiteTypeDatacenter},
+		},
+	}
+}
+
+func TestEvaluator_EvaluateRule_SiteScope(t *testing.T) {
+	evaluator := NewEvaluator(WithSiteStore(newSiteScopeTestStore()))
+
+	rule := &routingv1.RoutingRule{
+		Id:                "rule-us-east-dc",
+		Name:              "US East Datacenters",
+		Enabled:           true,
+		AffectedRegions:   []string{"us-east"},
+		AffectedSiteTypes: []routingv1.SiteType{routingv1.SiteType_SITE_TYPE_DATACENTER},
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:     routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:    "severity",
+				Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "matching region and type",
+			labels:   map[string]string{"severity": "critical", "site_code": "IAD1"},
+			expected: true,
+		},
+		{
+			name:     "non-matching region",
+			labels:   map[string]string{"severity": "critical", "site_code": "FRA1"},
+			expected: false,
+		},
+		{
+			name:     "non-matching site type",
+			labels:   map[string]string{"severity": "critical", "site_code": "IAD2"},
+			expected: false,
+		},
+		{
+			name:     "unknown site",
+			labels:   map[string]string{"severity": "critical", "site_code": "SJC9"},
+			expected: false,
+		},
+		{
+			name:     "missing site_code label",
+			labels:   map[string]string{"severity": "critical"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval := evaluator.EvaluateRule(context.Background(), rule, &routingv1.Alert{Labels: tt.labels}, time.Now())
+
+			if eval.Matched != tt.expected {
+				t.Errorf("Expected matched=%v, got %v (%s)", tt.expected, eval.Matched, eval.SiteScopeReason)
+			}
+			if eval.SiteScopeMatched != tt.expected {
+				t.Errorf("Expected site scope matched=%v, got %v", tt.expected, eval.SiteScopeMatched)
+			}
+			// Out-of-scope rules are skipped before any condition is evaluated
+			if !tt.expected && len(eval.ConditionResults) != 0 {
+				t.Errorf("Expected no condition results for skipped rule, got %d", len(eval.ConditionResults))
+			}
+		})
+	}
+}
+
+func TestEvaluator_EvaluateRules_SiteScopeSkipsRule(t *testing.T) {
+	evaluator := NewEvaluator(WithSiteStore(newSiteScopeTestStore()))
+
+	rules := []*routingv1.RoutingRule{
+		{
+			Id:              "rule-eu",
+			Name:            "EU Team",
+			Enabled:         true,
+			Priority:        1,
+			Terminal:        true,
+			AffectedRegions: []string{"eu-central"},
+			Actions:         []*routingv1.RoutingAction{{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM}},
+		},
+		{
+			Id:       "rule-global",
+			Name:     "Global Fallback",
+			Enabled:  true,
+			Priority: 2,
+			Actions:  []*routingv1.RoutingAction{{Type: routingv1.ActionType_ACTION_TYPE_CREATE_TICKET}},
+		},
+	}
+
+	alert := &routingv1.Alert{Labels: map[string]string{"site_code": "IAD1"}}
+	evaluations, actions := evaluator.EvaluateRules(context.Background(), rules, alert, time.Now())
+
+	if len(evaluations) != 2 {
+		t.Fatalf("Expected 2 evaluations, got %d", len(evaluations))
+	}
+	if evaluations[0].Matched {
+		t.Error("Expected EU-scoped rule to be skipped for a US site")
+	}
+	if len(actions) != 1 || actions[0].Type != routingv1.ActionType_ACTION_TYPE_CREATE_TICKET {
+		t.Errorf("Expected only the global rule action, got %v", actions)
+	}
+}
+
 func TestSeverityLevel(t *testing.T) {
 	tests := []struct {
 		severity string
@@ -584,3 +975,423 @@ func TestCompareSeverity(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleReferencesService(t *testing.T) {
+	rule := &routingv1.RoutingRule{
+		Conditions: []*routingv1.RoutingCondition{
+			{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "service", StringValue: "svc-label"},
+			{Type: routingv1.ConditionType_CONDITION_TYPE_SERVICE, StringValue: "svc-api"},
+			{Type: routingv1.ConditionType_CONDITION_TYPE_SERVICE, StringList: []string{"svc-db", "svc-cache"}},
+		},
+	}
+
+	tests := []struct {
+		serviceID string
+		want      bool
+	}{
+		{"svc-api", true},
+		{"svc-cache", true},
+		{"svc-label", false},
+		{"svc-other", false},
+	}
+
+	for _, tt := range tests {
+		if got := RuleReferencesService(rule, tt.serviceID); got != tt.want {
+			t.Errorf("RuleReferencesService(%q) = %v, want %v", tt.serviceID, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluator_RegexMatchOperators(t *testing.T) {
+	evaluator := NewEvaluator()
+	alert := &routingv1.Alert{Labels: map[string]string{"host": "web-prod-01"}}
+
+	tests := []struct {
+		name      string
+		operator  routingv1.ConditionOperator
+		pattern   string
+		wantMatch bool
+	}{
+		{"match", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH, `^web-prod-\d+$`, true},
+		{"match fails", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH, `^db-`, false},
+		{"not match", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH, `^db-`, true},
+		{"not match fails", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH, `prod`, false},
+		{"invalid pattern never matches", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH, `web-(`, false},
+		{"invalid pattern never not-matches", routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH, `web-(`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := evaluator.EvaluateCondition(context.Background(), &routingv1.RoutingCondition{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "host",
+				Operator:    tt.operator,
+				StringValue: tt.pattern,
+			}, alert)
+			if result.Matched != tt.wantMatch {
+				t.Errorf("EvaluateCondition() matched = %v, want %v", result.Matched, tt.wantMatch)
+			}
+			if result.Expected != tt.pattern {
+				t.Errorf("EvaluateCondition() expected = %q, want the pattern %q", result.Expected, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestEvaluator_RegexCachedPerRuleCondition(t *testing.T) {
+	evaluator := NewEvaluator()
+	alert := &routingv1.Alert{Labels: map[string]string{"host": "web-prod-01"}}
+	rule := &routingv1.RoutingRule{
+		Id: "rule-1",
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "host",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH,
+				StringValue: `^web-`,
+			},
+		},
+	}
+
+	if !evaluator.EvaluateRule(context.Background(), rule, alert, time.Now()).Matched {
+		t.Fatal("EvaluateRule() should match ^web-")
+	}
+	cached := evaluator.regexes[ruleConditionKey{ruleID: "rule-1", index: 0}]
+	if cached == nil || cached.pattern != `^web-` {
+		t.Fatalf("compiled pattern not cached by rule condition, got %+v", cached)
+	}
+
+	evaluator.EvaluateRule(context.Background(), rule, alert, time.Now())
+	if evaluator.regexes[ruleConditionKey{ruleID: "rule-1", index: 0}] != cached {
+		t.Error("second evaluation should reuse the cached pattern")
+	}
+
+	// An updated rule with the same ID must not use the stale pattern
+	rule.Conditions[0].StringValue = `^db-`
+	if evaluator.EvaluateRule(context.Background(), rule, alert, time.Now()).Matched {
+		t.Error("EvaluateRule() should recompile the changed pattern and not match")
+	}
+
+	evaluator.resetRegexCache()
+	if len(evaluator.regexes) != 0 {
+		t.Errorf("resetRegexCache() left %d patterns", len(evaluator.regexes))
+	}
+}
+
+func FuzzEvaluator_RegexMatch(f *testing.F) {
+	f.Add(`^web-\d+$`, "web-01")
+	f.Add(`(a+)+$`, "aaaaaaaaaaaaaaaaaaaaaaaaaaaa!")
+	f.Add(`web-(`, "web-")
+	f.Add(``, "")
+	f.Add(`(?i)PROD`, "prod")
+
+	evaluator := NewEvaluator()
+	f.Fuzz(func(t *testing.T, pattern, value string) {
+		alert := &routingv1.Alert{Labels: map[string]string{"host": value}}
+		cond := func(op routingv1.ConditionOperator) *routingv1.RoutingCondition {
+			return &routingv1.RoutingCondition{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "host",
+				Operator:    op,
+				StringValue: pattern,
+			}
+		}
+		rule := &routingv1.RoutingRule{
+			Id: "fuzz",
+			Conditions: []*routingv1.RoutingCondition{
+				cond(routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH),
+				cond(routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH),
+			},
+		}
+
+		eval := evaluator.EvaluateRule(context.Background(), rule, alert, time.Now())
+		match, notMatch := eval.ConditionResults[0].Matched, eval.ConditionResults[1].Matched
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			if match || notMatch {
+				t.Fatalf("invalid pattern %q matched: match=%v notMatch=%v", pattern, match, notMatch)
+			}
+			if validateConditions(rule.Conditions) == nil {
+				t.Fatalf("validateConditions() accepted invalid pattern %q", pattern)
+			}
+			return
+		}
+
+		if match != re.MatchString(value) {
+			t.Fatalf("REGEX_MATCH %q on %q = %v, want %v", pattern, value, match, !match)
+		}
+		if match == notMatch {
+			t.Fatalf("REGEX_MATCH and REGEX_NOT_MATCH agree (%v) for %q on %q", match, pattern, value)
+		}
+		if err := validateConditions(rule.Conditions); err != nil {
+			t.Fatalf("validateConditions() rejected valid pattern %q: %v", pattern, err)
+		}
+	})
+}
+
+func TestEvaluator_NumericOperators(t *testing.T) {
+	evaluator := NewEvaluator()
+	alert := &routingv1.Alert{Labels: map[string]string{"severity": "high", "cpu": "92.5"}}
+
+	tests := []struct {
+		name      string
+		condition *routingv1.RoutingCondition
+		wantMatch bool
+	}{
+		{
+			name:      "severity ordinal by name",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_SEVERITY, Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LTE, StringValue: "high"},
+			wantMatch: true,
+		},
+		{
+			name:      "severity ordinal by number",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "severity", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT, StringValue: "2"},
+			wantMatch: false,
+		},
+		{
+			name:      "severity equals",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_SEVERITY, Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_EQ, StringValue: "2"},
+			wantMatch: true,
+		},
+		{
+			name:      "label greater than",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "labels.cpu", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT, StringValue: "90"},
+			wantMatch: true,
+		},
+		{
+			name:      "label at least",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "labels.cpu", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GTE, StringValue: "92.5"},
+			wantMatch: true,
+		},
+		{
+			name:      "label field without prefix",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "cpu", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT, StringValue: "50"},
+			wantMatch: false,
+		},
+		{
+			name:      "missing label",
+			condition: &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "labels.memory", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT, StringValue: "0"},
+			wantMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := evaluator.EvaluateCondition(context.Background(), tt.condition, alert)
+			if result.Matched != tt.wantMatch {
+				t.Errorf("EvaluateCondition() matched = %v (actual %q), want %v", result.Matched, result.Actual, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestEvaluator_NumericOperatorInvalidValue(t *testing.T) {
+	var logs bytes.Buffer
+	evaluator := NewEvaluator(WithLogger(zerolog.New(&logs)))
+	alert := &routingv1.Alert{Id: "alert-1", Labels: map[string]string{"cpu": "high"}}
+
+	result := evaluator.EvaluateCondition(context.Background(), &routingv1.RoutingCondition{
+		Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+		Field:       "labels.cpu",
+		Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT,
+		StringValue: "90",
+	}, alert)
+
+	if result.Matched {
+		t.Error("EvaluateCondition() should not match a value that is not a number")
+	}
+	if !strings.Contains(result.Actual, ErrInvalidCondition.Error()) {
+		t.Errorf("EvaluateCondition() actual = %q, want it to report %v", result.Actual, ErrInvalidCondition)
+	}
+	if !strings.Contains(logs.String(), "numeric routing condition not evaluated") || !strings.Contains(logs.String(), "alert-1") {
+		t.Errorf("expected the invalid condition to be logged, got %q", logs.String())
+	}
+}
+
+// scheduleGetter returns schedules from a map.
+type scheduleGetter map[string]*routingv1.Schedule
+
+func (g scheduleGetter) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	if s, ok := g[id]; ok {
+		return s, nil
+	}
+	return nil, errors.New("schedule not found")
+}
+
+func timeWindowRule(window string, scheduleID string) *routingv1.RoutingRule {
+	rule := &routingv1.RoutingRule{
+		Id: "rule-hours",
+		Conditions: []*routingv1.RoutingCondition{
+			{Type: routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW, StringValue: window},
+		},
+	}
+	if scheduleID != "" {
+		rule.Actions = []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL, NotifyOncall: &routingv1.NotifyOnCallAction{ScheduleId: scheduleID}},
+		}
+	}
+	return rule
+}
+
+func TestEvaluator_TimeWindowCondition(t *testing.T) {
+	businessHours := `{"daysOfWeek":[1,2,3,4,5],"startTime":"09:00","endTime":"17:00"}`
+	overnight := `{"days_of_week":[5],"start_time":"22:00","end_time":"06:00"}`
+	schedules := scheduleGetter{
+		"sched-nyc": {Id: "sched-nyc", Timezone: "America/New_York"},
+		"sched-bad": {Id: "sched-bad", Timezone: "Mars/Olympus_Mons"},
+	}
+
+	tests := []struct {
+		name       string
+		window     string
+		scheduleID string
+		now        time.Time
+		wantMatch  bool
+	}{
+		{"business hours in UTC", businessHours, "", time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC), true},
+		{"weekend in UTC", businessHours, "", time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC), false},
+		{"end time is exclusive", businessHours, "", time.Date(2026, 3, 6, 17, 0, 0, 0, time.UTC), false},
+		{"schedule timezone before opening", businessHours, "sched-nyc", time.Date(2026, 3, 6, 13, 30, 0, 0, time.UTC), false},
+		{"schedule timezone during EST", businessHours, "sched-nyc", time.Date(2026, 3, 6, 14, 30, 0, 0, time.UTC), true},
+		// 13:30 UTC is 08:30 EST on Friday but 09:30 EDT on Monday
+		{"schedule timezone after DST starts", businessHours, "sched-nyc", time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC), true},
+		{"unknown schedule timezone falls back to UTC", businessHours, "sched-bad", time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC), true},
+		{"missing schedule falls back to UTC", businessHours, "sched-missing", time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC), true},
+		// Clocks jump from 02:00 EST to 03:00 EDT on 2026-03-08
+		{"before spring forward", `{"startTime":"01:00","endTime":"03:00"}`, "sched-nyc", time.Date(2026, 3, 8, 6, 30, 0, 0, time.UTC), true},
+		{"after spring forward", `{"startTime":"01:00","endTime":"03:00"}`, "sched-nyc", time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), false},
+		// Clocks fall back from 02:00 EDT to 01:00 EST on 2026-11-01, so 01:30 happens twice
+		{"first 01:30 on fall back", `{"startTime":"01:00","endTime":"02:00"}`, "sched-nyc", time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), true},
+		{"second 01:30 on fall back", `{"startTime":"01:00","endTime":"02:00"}`, "sched-nyc", time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC), true},
+		{"overnight window before midnight", overnight, "", time.Date(2026, 3, 13, 23, 0, 0, 0, time.UTC), true},
+		{"overnight window after midnight belongs to the start day", overnight, "", time.Date(2026, 3, 14, 2, 0, 0, 0, time.UTC), true},
+		{"overnight window after midnight of another day", overnight, "", time.Date(2026, 3, 13, 2, 0, 0, 0, time.UTC), false},
+		{"overnight window end", overnight, "", time.Date(2026, 3, 14, 6, 0, 0, 0, time.UTC), false},
+		{"inverted window", `{"startTime":"09:00","endTime":"17:00","invert":true}`, "", time.Date(2026, 3, 6, 20, 0, 0, 0, time.UTC), true},
+		{"invalid window", `{"startTime":"9am"}`, "", time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := NewEvaluator(
+				WithScheduleStore(schedules),
+				WithClock(func() time.Time { return tt.now }),
+			)
+
+			eval := evaluator.EvaluateRule(context.Background(), timeWindowRule(tt.window, tt.scheduleID), &routingv1.Alert{}, tt.now)
+			if eval.Matched != tt.wantMatch {
+				t.Errorf("EvaluateRule() matched = %v (actual %q), want %v", eval.Matched, eval.ConditionResults[0].Actual, tt.wantMatch)
+			}
+		})
+	}
+}
+
+// tenantScheduleGetter returns schedules of the tenant of the context, like
+// the tenant-scoped schedule stores.
+type tenantScheduleGetter map[string]scheduleGetter
+
+func (g tenantScheduleGetter) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return g[tenantID].GetSchedule(ctx, id)
+}
+
+func TestEvaluator_TimeWindowCondition_TenantSchedule(t *testing.T) {
+	const tenantID = "6f1c2a52-8d3e-4b7a-9f10-2c5e8a7b4d31"
+	schedules := tenantScheduleGetter{
+		tenantID: {"sched-nyc": {Id: "sched-nyc", Timezone: "America/New_York"}},
+	}
+	// 09:30 UTC is 04:30 in New York, outside business hours there
+	now := time.Date(2026, 3, 6, 9, 30, 0, 0, time.UTC)
+	evaluator := NewEvaluator(
+		WithScheduleStore(schedules),
+		WithClock(func() time.Time { return now }),
+	)
+	rule := timeWindowRule(`{"startTime":"09:00","endTime":"17:00"}`, "sched-nyc")
+
+	ctx := auth.WithTenant(context.Background(), tenantID)
+	if eval := evaluator.EvaluateRule(ctx, rule, &routingv1.Alert{}, now); eval.Matched {
+		t.Errorf("expected the window in the tenant's schedule timezone not to match, got %q", eval.ConditionResults[0].Actual)
+	}
+}
+
+func TestValidateConditions_TimeWindow(t *testing.T) {
+	valid := &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW, StringValue: `{"startTime":"22:00","endTime":"06:00"}`}
+	if err := validateConditions([]*routingv1.RoutingCondition{valid}); err != nil {
+		t.Errorf("validateConditions() error = %v", err)
+	}
+
+	for _, value := range []string{"", "business hours", `{"startTime":"25:00","endTime":"06:00"}`} {
+		invalid := &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW, StringValue: value}
+		if err := validateConditions([]*routingv1.RoutingCondition{invalid}); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("validateConditions(%q) error = %v, want %v", value, err, ErrInvalidRule)
+		}
+	}
+}
+
+// groupSizer returns group sizes from a map.
+type groupSizer map[string]int
+
+func (g groupSizer) GroupSize(ctx context.Context, groupID string) (int, error) {
+	if groupID == "grp-broken" {
+		return 0, errors.New("alert store unavailable")
+	}
+	return g[groupID], nil
+}
+
+func TestEvaluator_GroupSizeCondition(t *testing.T) {
+	cond := &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE, IntValue: 3}
+	sizes := groupSizer{"grp-small": 2, "grp-large": 3}
+
+	tests := []struct {
+		name       string
+		sizer      GroupSizer
+		groupID    string
+		wantMatch  bool
+		wantActual string
+	}{
+		{"group reaches threshold", sizes, "grp-large", true, "3"},
+		{"group below threshold", sizes, "grp-small", false, "2"},
+		{"alert without group", sizes, "", false, "no correlation group"},
+		{"group sizes not configured", nil, "grp-large", false, "group sizes not available"},
+		{"group size error", sizes, "grp-broken", false, "alert store unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []EvaluatorOption
+			if tt.sizer != nil {
+				opts = append(opts, WithGroupSizer(tt.sizer))
+			}
+			evaluator := NewEvaluator(opts...)
+
+			result := evaluator.EvaluateCondition(context.Background(), cond, &routingv1.Alert{Id: "alert-1", GroupId: tt.groupID})
+			if result.Matched != tt.wantMatch {
+				t.Errorf("EvaluateCondition() matched = %v, want %v", result.Matched, tt.wantMatch)
+			}
+			if result.Actual != tt.wantActual {
+				t.Errorf("EvaluateCondition() actual = %q, want %q", result.Actual, tt.wantActual)
+			}
+			if result.Expected != ">= 3" {
+				t.Errorf("EvaluateCondition() expected = %q, want %q", result.Expected, ">= 3")
+			}
+		})
+	}
+}
+
+func TestValidateConditions_GroupSize(t *testing.T) {
+	valid := &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE, IntValue: 5}
+	if err := validateConditions([]*routingv1.RoutingCondition{valid}); err != nil {
+		t.Errorf("validateConditions() error = %v", err)
+	}
+
+	for _, threshold := range []int64{0, -1} {
+		invalid := &routingv1.RoutingCondition{Type: routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE, IntValue: threshold}
+		if err := validateConditions([]*routingv1.RoutingCondition{invalid}); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("validateConditions(%d) error = %v, want %v", threshold, err, ErrInvalidRule)
+		}
+	}
+}
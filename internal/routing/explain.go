@@ -0,0 +1,151 @@
+package routing
+
+import (
+	"fmt"
+	"strings"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// AnnotatedAuditLog is a routing audit log with a human-readable explanation of
+// each rule evaluation, in the order of the log's evaluations.
+type AnnotatedAuditLog struct {
+	Log                  *routingv1.RoutingAuditLog `json:"log"`
+	ExplainedEvaluations []string                   `json:"explainedEvaluations"`
+}
+
+// ExplainLog explains every rule evaluation of an audit log.
+func ExplainLog(log *routingv1.RoutingAuditLog) *AnnotatedAuditLog {
+	annotated := &AnnotatedAuditLog{
+		Log:                  log,
+		ExplainedEvaluations: make([]string, 0, len(log.GetEvaluations())),
+	}
+	for _, eval := range log.GetEvaluations() {
+		annotated.ExplainedEvaluations = append(annotated.ExplainedEvaluations, ExplainEvaluation(eval))
+	}
+	return annotated
+}
+
+// ExplainEvaluation describes a rule evaluation for incident reviews, e.g.
+//
+//	Rule 'High Severity Pager' MATCHED: field 'severity' equals 'critical' ✓, label 'env' contains 'prod' ✓
+//
+// Failed conditions include the actual value. A rule skipped because of its
+// site scope or time condition is explained by that reason instead.
+func ExplainEvaluation(eval *routingv1.RuleEvaluation) string {
+	outcome := "DID NOT MATCH"
+	if eval.Matched {
+		outcome = "MATCHED"
+	}
+	prefix := fmt.Sprintf("Rule '%s' %s", eval.RuleName, outcome)
+
+	// Scope and time are checked before the conditions, which are then not evaluated
+	if !eval.Matched && len(eval.ConditionResults) == 0 {
+		if eval.SiteScopeReason != "" && !eval.SiteScopeMatched {
+			return fmt.Sprintf("%s: site scope %s ✗", prefix, eval.SiteScopeReason)
+		}
+		if eval.TimeConditionReason != "" && !eval.TimeConditionMatched {
+			return fmt.Sprintf("%s: time condition %s ✗", prefix, eval.TimeConditionReason)
+		}
+	}
+
+	explanation := prefix + ": no conditions"
+	if len(eval.ConditionResults) > 0 {
+		parts := make([]string, 0, len(eval.ConditionResults))
+		for _, result := range eval.ConditionResults {
+			parts = append(parts, explainCondition(result))
+		}
+		explanation = prefix + ": " + strings.Join(parts, ", ")
+	}
+
+	if eval.StoppedProcessing {
+		explanation += " (stopped processing)"
+	}
+	return explanation
+}
+
+// explainCondition describes a condition result, e.g. "label 'env' contains 'prod' ✓".
+func explainCondition(result *routingv1.ConditionResult) string {
+	if result.Type == routingv1.ConditionType_CONDITION_TYPE_CEL {
+		if result.Matched {
+			return fmt.Sprintf("CEL '%s' ✓", result.Expected)
+		}
+		return fmt.Sprintf("CEL '%s' ✗ (%s)", result.Expected, result.Actual)
+	}
+
+	var desc string
+	switch result.Operator {
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_EXISTS:
+		desc = conditionSubject(result) + " exists"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS:
+		desc = conditionSubject(result) + " does not exist"
+	default:
+		desc = fmt.Sprintf("%s %s '%s'", conditionSubject(result), operatorPhrase(result.Operator), result.Expected)
+	}
+
+	if result.Matched {
+		return desc + " ✓"
+	}
+	return fmt.Sprintf("%s ✗ (actual '%s')", desc, result.Actual)
+}
+
+// conditionSubject names the alert field a condition result refers to.
+func conditionSubject(result *routingv1.ConditionResult) string {
+	switch result.Type {
+	case routingv1.ConditionType_CONDITION_TYPE_LABEL:
+		return fmt.Sprintf("label '%s'", result.Field)
+	case routingv1.ConditionType_CONDITION_TYPE_ANNOTATION:
+		return fmt.Sprintf("annotation '%s'", result.Field)
+	case routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE:
+		return "group size"
+	}
+
+	// Only label and annotation conditions carry a field name
+	field, _ := conditionField(&routingv1.RoutingCondition{Type: result.Type})
+	if field == "" {
+		field = result.Field
+	}
+	return fmt.Sprintf("field '%s'", field)
+}
+
+// operatorPhrase returns the verb phrase for a comparison operator. Audit logs
+// recorded before operators were stored fall back to "expected".
+func operatorPhrase(op routingv1.ConditionOperator) string {
+	switch op {
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS:
+		return "equals"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS:
+		return "does not equal"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_CONTAINS:
+		return "contains"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_CONTAINS:
+		return "does not contain"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_STARTS_WITH:
+		return "starts with"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_ENDS_WITH:
+		return "ends with"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH:
+		return "matches"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH:
+		return "does not match"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_IN:
+		return "is one of"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NOT_IN:
+		return "is not one of"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_GREATER_THAN,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT:
+		return "is greater than"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GTE:
+		return "is at least"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_LESS_THAN,
+		routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT:
+		return "is less than"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_LTE:
+		return "is at most"
+	case routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_EQ:
+		return "equals"
+	default:
+		return "expected"
+	}
+}
@@ -0,0 +1,154 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// explainTestLog evaluates two rules against a critical production alert: one
+// that matches and one that fails on a label and a CEL condition.
+func explainTestLog() *routingv1.RoutingAuditLog {
+	evaluator := NewEvaluator()
+	alert := &routingv1.Alert{
+		Id:     "alert-1",
+		Labels: map[string]string{"severity": "critical", "env": "production", "team": "network"},
+	}
+	now := time.Now()
+
+	pager := &routingv1.RoutingRule{
+		Id:   "pager",
+		Name: "High Severity Pager",
+		Conditions: []*routingv1.RoutingCondition{
+			{Type: routingv1.ConditionType_CONDITION_TYPE_SEVERITY, Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS, StringValue: "critical"},
+			{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "env", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_CONTAINS, StringValue: "prod"},
+		},
+	}
+	db := &routingv1.RoutingRule{
+		Id:   "db",
+		Name: "DB Team",
+		Conditions: []*routingv1.RoutingCondition{
+			{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "team", Operator: routingv1.ConditionOperator_CONDITION_OPERATOR_IN, StringList: []string{"db", "storage"}},
+			{Type: routingv1.ConditionType_CONDITION_TYPE_CEL, CelExpression: `alert_labels["env"] == "staging"`},
+			{Type: routingv1.ConditionType_CONDITION_TYPE_CEL, CelExpression: `"severity" in alert_labels`},
+		},
+	}
+
+	return &routingv1.RoutingAuditLog{
+		Id:        "log-1",
+		AlertId:   alert.Id,
+		Timestamp: timestamppb.New(now),
+		Evaluations: []*routingv1.RuleEvaluation{
+			evaluator.EvaluateRule(context.Background(), pager, alert, now),
+			evaluator.EvaluateRule(context.Background(), db, alert, now),
+		},
+	}
+}
+
+func TestExplainLog(t *testing.T) {
+	annotated := ExplainLog(explainTestLog())
+
+	want := []string{
+		"Rule 'High Severity Pager' MATCHED: field 'severity' equals 'critical' ✓, label 'env' contains 'prod' ✓",
+		`Rule 'DB Team' DID NOT MATCH: label 'team' is one of 'db, storage' ✗ (actual 'network'), ` +
+			`CEL 'alert_labels["env"] == "staging"' ✗ (CEL expression did not match), CEL '"severity" in alert_labels' ✓`,
+	}
+	if len(annotated.ExplainedEvaluations) != len(want) {
+		t.Fatalf("expected %d explanations, got %v", len(want), annotated.ExplainedEvaluations)
+	}
+	for i := range want {
+		if annotated.ExplainedEvaluations[i] != want[i] {
+			t.Errorf("explanation %d:\n got %s\nwant %s", i, annotated.ExplainedEvaluations[i], want[i])
+		}
+	}
+}
+
+func TestExplainEvaluation_SkippedRule(t *testing.T) {
+	tests := []struct {
+		name string
+		eval *routingv1.RuleEvaluation
+		want string
+	}{
+		{
+			name: "outside site scope",
+			eval: &routingv1.RuleEvaluation{RuleName: "EU sites", SiteScopeReason: "site US-1 not in scope"},
+			want: "Rule 'EU sites' DID NOT MATCH: site scope site US-1 not in scope ✗",
+		},
+		{
+			name: "outside time window",
+			eval: &routingv1.RuleEvaluation{
+				RuleName:            "Business hours",
+				SiteScopeMatched:    true,
+				TimeConditionReason: "outside business hours",
+			},
+			want: "Rule 'Business hours' DID NOT MATCH: time condition outside business hours ✗",
+		},
+		{
+			name: "catch-all",
+			eval: &routingv1.RuleEvaluation{RuleName: "Default", Matched: true, StoppedProcessing: true},
+			want: "Rule 'Default' MATCHED: no conditions (stopped processing)",
+		},
+		{
+			name: "logged without operator",
+			eval: &routingv1.RuleEvaluation{
+				RuleName: "Legacy",
+				Matched:  true,
+				ConditionResults: []*routingv1.ConditionResult{
+					{Type: routingv1.ConditionType_CONDITION_TYPE_LABEL, Field: "team", Expected: "db", Actual: "db", Matched: true},
+				},
+				StoppedProcessing: true,
+			},
+			want: "Rule 'Legacy' MATCHED: label 'team' expected 'db' ✓ (stopped processing)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExplainEvaluation(tt.eval); got != tt.want {
+				t.Errorf("ExplainEvaluation() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_ExplainAuditLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := NewInMemoryStore()
+	if err := store.CreateAuditLog(context.Background(), explainTestLog()); err != nil {
+		t.Fatalf("CreateAuditLog() error = %v", err)
+	}
+
+	router := gin.New()
+	NewHandler(store, nil, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/routing/audit-logs/log-1/explain", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		ExplainedEvaluations []string `json:"explainedEvaluations"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.ExplainedEvaluations) != 2 {
+		t.Errorf("expected 2 explanations, got %v", resp.ExplainedEvaluations)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/routing/audit-logs/missing/explain", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,97 @@
+package routing
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// Handler serves the routing HTTP endpoints.
+type Handler struct {
+	store    Store
+	replayer *Replayer
+	logger   zerolog.Logger
+}
+
+// NewHandler creates a new routing HTTP handler.
+func NewHandler(store Store, replayer *Replayer, logger zerolog.Logger) *Handler {
+	return &Handler{
+		store:    store,
+		replayer: replayer,
+		logger:   logger.With().Str("component", "routing_handler").Logger(),
+	}
+}
+
+// RegisterRoutes registers the routing routes on the provided router group.
+func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/routing/replay", h.Replay)
+	router.GET("/routing/audit-logs/:id/explain", h.ExplainAuditLog)
+}
+
+// ReplayHTTPRequest is the body of POST /routing/replay.
+type ReplayHTTPRequest struct {
+	RuleID    string    `json:"ruleId" binding:"required"`
+	StartTime time.Time `json:"startTime" binding:"required"`
+	EndTime   time.Time `json:"endTime" binding:"required"`
+	// DryRun defaults to true so actions only fire when explicitly requested.
+	DryRun *bool `json:"dryRun"`
+}
+
+// Replay handles POST /api/v1/routing/replay
+func (h *Handler) Replay(c *gin.Context) {
+	var req ReplayHTTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request: " + err.Error()})
+		return
+	}
+
+	dryRun := true
+	if req.DryRun != nil {
+		dryRun = *req.DryRun
+	}
+
+	result, err := h.replayer.Replay(c.Request.Context(), ReplayRequest{
+		RuleID:    req.RuleID,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		DryRun:    dryRun,
+	})
+	switch {
+	case errors.Is(err, ErrInvalidReplay):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "routing rule not found"})
+		return
+	case errors.Is(err, ErrReplayActionsDisabled):
+		c.JSON(http.StatusNotImplemented, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.logger.Error().Err(err).Str("ruleId", req.RuleID).Msg("failed to replay routing rule")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to replay routing rule"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ExplainAuditLog handles GET /api/v1/routing/audit-logs/:id/explain
+func (h *Handler) ExplainAuditLog(c *gin.Context) {
+	id := c.Param("id")
+
+	log, err := h.store.GetAuditLog(c.Request.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "audit log not found"})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("auditLogId", id).Msg("failed to get audit log")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get audit log"})
+		return
+	}
+
+	c.JSON(http.StatusOK, ExplainLog(log))
+}
@@ -0,0 +1,209 @@
+package routing
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics tracks routing engine metrics.
+// Exposed as the routing_warmup_duration_seconds and
+// routing_condition_duration_seconds{rule_id, condition_type} histograms, the
+// routing_warmup_rules_loaded gauge and the
+// routing_slow_conditions_total{rule_id, condition_type},
+// routing_fallback_site_policy_used_total{site_id},
+// routing_rule_cache_hits_total and routing_rule_cache_misses_total counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// warmupDuration tracks the duration of routing engine warmups.
+	warmupDuration []time.Duration
+	// warmupRulesLoaded is the number of rules loaded by the last warmup.
+	warmupRulesLoaded int64
+
+	// conditionDuration tracks condition evaluation durations by rule and condition type.
+	conditionDuration map[conditionKey][]time.Duration
+	// slowConditions counts evaluations over the slow condition threshold by rule and condition type.
+	slowConditions map[conditionKey]int64
+
+	// fallbackSitePolicyUsed counts unmatched alerts escalated with their site's default policy by site.
+	fallbackSitePolicyUsed map[string]int64
+
+	// tierSeverityBoosts counts alerts re-evaluated with a tier-boosted severity by tier.
+	tierSeverityBoosts map[string]int64
+
+	// ruleCacheHits counts enabled rule lookups served from the CachingStore cache.
+	ruleCacheHits int64
+	// ruleCacheMisses counts enabled rule lookups that went to the inner store.
+	ruleCacheMisses int64
+}
+
+type conditionKey struct {
+	ruleID        string
+	conditionType string
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		conditionDuration:      make(map[conditionKey][]time.Duration),
+		slowConditions:         make(map[conditionKey]int64),
+		fallbackSitePolicyUsed: make(map[string]int64),
+		tierSeverityBoosts:     make(map[string]int64),
+	}
+}
+
+// RecordWarmup records the duration of a warmup and the number of rules it loaded.
+func (m *Metrics) RecordWarmup(duration time.Duration, rulesLoaded int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.warmupDuration = append(m.warmupDuration, duration)
+	m.warmupRulesLoaded = int64(rulesLoaded)
+}
+
+// GetWarmupDurations returns the recorded warmup durations.
+func (m *Metrics) GetWarmupDurations() []time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]time.Duration, len(m.warmupDuration))
+	copy(result, m.warmupDuration)
+	return result
+}
+
+// WarmupRulesLoaded returns the number of rules loaded by the last warmup.
+func (m *Metrics) WarmupRulesLoaded() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.warmupRulesLoaded
+}
+
+// RecordConditionDuration records how long a condition of a rule took to evaluate.
+func (m *Metrics) RecordConditionDuration(ruleID, conditionType string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := conditionKey{ruleID: ruleID, conditionType: conditionType}
+	m.conditionDuration[key] = append(m.conditionDuration[key], duration)
+}
+
+// GetConditionDurations returns the recorded evaluation durations of a rule's conditions of a type.
+func (m *Metrics) GetConditionDurations(ruleID, conditionType string) []time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	durations := m.conditionDuration[conditionKey{ruleID: ruleID, conditionType: conditionType}]
+	result := make([]time.Duration, len(durations))
+	copy(result, durations)
+	return result
+}
+
+// RecordSlowCondition increments the slow condition counter for a rule and condition type.
+func (m *Metrics) RecordSlowCondition(ruleID, conditionType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.slowConditions[conditionKey{ruleID: ruleID, conditionType: conditionType}]++
+}
+
+// SlowConditionsTotal returns the number of slow evaluations for a rule and condition type.
+func (m *Metrics) SlowConditionsTotal(ruleID, conditionType string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.slowConditions[conditionKey{ruleID: ruleID, conditionType: conditionType}]
+}
+
+// RecordFallbackSitePolicyUsed increments the site default policy fallback counter for a site.
+func (m *Metrics) RecordFallbackSitePolicyUsed(siteID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fallbackSitePolicyUsed[siteID]++
+}
+
+// FallbackSitePolicyUsedTotal returns how often a site's default policy was used as the fallback.
+func (m *Metrics) FallbackSitePolicyUsedTotal(siteID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.fallbackSitePolicyUsed[siteID]
+}
+
+// RecordTierSeverityBoost increments the tier severity boost counter for a tier.
+func (m *Metrics) RecordTierSeverityBoost(tierID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tierSeverityBoosts[tierID]++
+}
+
+// TierSeverityBoostsTotal returns how often alerts were routed with a severity boosted by a tier.
+func (m *Metrics) TierSeverityBoostsTotal(tierID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tierSeverityBoosts[tierID]
+}
+
+// RecordRuleCacheHit increments the rule cache hits counter.
+func (m *Metrics) RecordRuleCacheHit() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ruleCacheHits++
+}
+
+// RuleCacheHitsTotal returns the number of enabled rule lookups served from the cache.
+func (m *Metrics) RuleCacheHitsTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ruleCacheHits
+}
+
+// RecordRuleCacheMiss increments the rule cache misses counter.
+func (m *Metrics) RecordRuleCacheMiss() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ruleCacheMisses++
+}
+
+// RuleCacheMissesTotal returns the number of enabled rule lookups that missed the cache.
+func (m *Metrics) RuleCacheMissesTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ruleCacheMisses
+}
+
+// conditionStats aggregates the recorded condition durations by rule and condition type.
+func (m *Metrics) conditionStats() []ConditionStat {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make([]ConditionStat, 0, len(m.conditionDuration))
+	for key, durations := range m.conditionDuration {
+		stat := ConditionStat{
+			RuleID:        key.ruleID,
+			ConditionType: key.conditionType,
+			Evaluations:   int64(len(durations)),
+			SlowCount:     m.slowConditions[key],
+		}
+		var total time.Duration
+		for _, d := range durations {
+			total += d
+			if d > stat.MaxDuration {
+				stat.MaxDuration = d
+			}
+		}
+		if len(durations) > 0 {
+			stat.AverageDuration = total / time.Duration(len(durations))
+		}
+		stats = append(stats, stat)
+	}
+	return stats
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.warmupDuration = nil
+	m.warmupRulesLoaded = 0
+	m.conditionDuration = make(map[conditionKey][]time.Duration)
+	m.slowConditions = make(map[conditionKey]int64)
+	m.fallbackSitePolicyUsed = make(map[string]int64)
+	m.tierSeverityBoosts = make(map[string]int64)
+	m.ruleCacheHits = 0
+	m.ruleCacheMisses = 0
+}
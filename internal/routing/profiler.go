@@ -0,0 +1,116 @@
+package routing
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// DefaultSlowConditionThresholdMs is the evaluation time above which a condition is logged as slow.
+const DefaultSlowConditionThresholdMs = 10
+
+// ProfilerConfig configures the condition profiler.
+type ProfilerConfig struct {
+	// SlowConditionThresholdMs is the evaluation time in milliseconds above which a
+	// condition is logged as slow. Non-positive values use DefaultSlowConditionThresholdMs.
+	SlowConditionThresholdMs int64
+}
+
+// ProfilerConfigFromEnv returns a configuration read from ROUTING_SLOW_CONDITION_THRESHOLD_MS.
+func ProfilerConfigFromEnv() ProfilerConfig {
+	config := ProfilerConfig{SlowConditionThresholdMs: DefaultSlowConditionThresholdMs}
+	if ms, err := strconv.ParseInt(os.Getenv("ROUTING_SLOW_CONDITION_THRESHOLD_MS"), 10, 64); err == nil && ms > 0 {
+		config.SlowConditionThresholdMs = ms
+	}
+	return config
+}
+
+// ConditionStat summarizes the evaluation time of a rule's conditions of one type.
+type ConditionStat struct {
+	RuleID          string
+	ConditionType   string
+	Evaluations     int64
+	AverageDuration time.Duration
+	MaxDuration     time.Duration
+	// SlowCount is how many evaluations exceeded the slow condition threshold.
+	SlowCount int64
+}
+
+// ConditionProfiler records how long each routing condition takes to evaluate and
+// warns about conditions, typically CEL expressions or regexes, slower than the threshold.
+type ConditionProfiler struct {
+	threshold time.Duration
+	metrics   *Metrics
+	logger    zerolog.Logger
+}
+
+// NewConditionProfiler creates a new condition profiler.
+func NewConditionProfiler(config ProfilerConfig, logger zerolog.Logger, metrics *Metrics) *ConditionProfiler {
+	thresholdMs := config.SlowConditionThresholdMs
+	if thresholdMs <= 0 {
+		thresholdMs = DefaultSlowConditionThresholdMs
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &ConditionProfiler{
+		threshold: time.Duration(thresholdMs) * time.Millisecond,
+		metrics:   metrics,
+		logger:    logger.With().Str("component", "routing_condition_profiler").Logger(),
+	}
+}
+
+// Metrics returns the metrics recorder for this profiler.
+func (p *ConditionProfiler) Metrics() *Metrics {
+	return p.metrics
+}
+
+// Observe records the evaluation time of a rule condition and warns if it was slow.
+func (p *ConditionProfiler) Observe(ruleID string, conditionType routingv1.ConditionType, duration time.Duration) {
+	condType := conditionTypeLabel(conditionType)
+	p.metrics.RecordConditionDuration(ruleID, condType, duration)
+
+	if duration <= p.threshold {
+		return
+	}
+
+	p.metrics.RecordSlowCondition(ruleID, condType)
+	p.logger.Warn().
+		Str("ruleId", ruleID).
+		Str("conditionType", condType).
+		Dur("duration", duration).
+		Dur("threshold", p.threshold).
+		Msg("slow routing condition")
+}
+
+// GetSlowConditionReport returns up to topN rule conditions ordered by their
+// average evaluation time, slowest first. A non-positive topN returns all of them.
+func (p *ConditionProfiler) GetSlowConditionReport(topN int) []ConditionStat {
+	stats := p.metrics.conditionStats()
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].AverageDuration != stats[j].AverageDuration {
+			return stats[i].AverageDuration > stats[j].AverageDuration
+		}
+		if stats[i].RuleID != stats[j].RuleID {
+			return stats[i].RuleID < stats[j].RuleID
+		}
+		return stats[i].ConditionType < stats[j].ConditionType
+	})
+
+	if topN > 0 && len(stats) > topN {
+		stats = stats[:topN]
+	}
+	return stats
+}
+
+// conditionTypeLabel returns the metric label of a condition type, e.g. "cel".
+func conditionTypeLabel(conditionType routingv1.ConditionType) string {
+	return strings.ToLower(strings.TrimPrefix(conditionType.String(), "CONDITION_TYPE_"))
+}
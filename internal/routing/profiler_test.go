@@ -0,0 +1,136 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestConditionProfiler_LogsSlowConditions(t *testing.T) {
+	var logs bytes.Buffer
+	profiler := NewConditionProfiler(ProfilerConfig{SlowConditionThresholdMs: 10}, zerolog.New(&logs), nil)
+
+	profiler.Observe("rule-1", routingv1.ConditionType_CONDITION_TYPE_CEL, 15*time.Millisecond)
+	profiler.Observe("rule-1", routingv1.ConditionType_CONDITION_TYPE_CEL, 10*time.Millisecond)
+	profiler.Observe("rule-1", routingv1.ConditionType_CONDITION_TYPE_LABEL, time.Millisecond)
+
+	metrics := profiler.Metrics()
+	if got := metrics.SlowConditionsTotal("rule-1", "cel"); got != 1 {
+		t.Errorf("expected 1 slow cel condition, got %d", got)
+	}
+	if got := metrics.SlowConditionsTotal("rule-1", "label"); got != 0 {
+		t.Errorf("expected no slow label conditions, got %d", got)
+	}
+	if got := len(metrics.GetConditionDurations("rule-1", "cel")); got != 2 {
+		t.Errorf("expected 2 cel durations, got %d", got)
+	}
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 warning, got %d: %s", len(lines), logs.String())
+	}
+	for _, want := range []string{`"ruleId":"rule-1"`, `"conditionType":"cel"`, `"level":"warn"`} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("expected warning to contain %s, got %s", want, lines[0])
+		}
+	}
+}
+
+func TestConditionProfiler_GetSlowConditionReport(t *testing.T) {
+	profiler := NewConditionProfiler(ProfilerConfig{}, zerolog.Nop(), nil)
+
+	profiler.Observe("rule-fast", routingv1.ConditionType_CONDITION_TYPE_LABEL, time.Millisecond)
+	profiler.Observe("rule-regex", routingv1.ConditionType_CONDITION_TYPE_LABEL, 4*time.Millisecond)
+	profiler.Observe("rule-regex", routingv1.ConditionType_CONDITION_TYPE_LABEL, 8*time.Millisecond)
+	profiler.Observe("rule-cel", routingv1.ConditionType_CONDITION_TYPE_CEL, 30*time.Millisecond)
+	profiler.Observe("rule-cel", routingv1.ConditionType_CONDITION_TYPE_CEL, 2*time.Millisecond)
+
+	report := profiler.GetSlowConditionReport(2)
+	if len(report) != 2 {
+		t.Fatalf("expected 2 stats, got %d", len(report))
+	}
+
+	slowest := report[0]
+	if slowest.RuleID != "rule-cel" || slowest.ConditionType != "cel" {
+		t.Errorf("expected rule-cel/cel to be slowest, got %s/%s", slowest.RuleID, slowest.ConditionType)
+	}
+	if slowest.Evaluations != 2 || slowest.AverageDuration != 16*time.Millisecond ||
+		slowest.MaxDuration != 30*time.Millisecond || slowest.SlowCount != 1 {
+		t.Errorf("unexpected stat: %+v", slowest)
+	}
+	if report[1].RuleID != "rule-regex" || report[1].AverageDuration != 6*time.Millisecond {
+		t.Errorf("expected rule-regex second, got %+v", report[1])
+	}
+
+	if got := len(profiler.GetSlowConditionReport(0)); got != 3 {
+		t.Errorf("expected all 3 stats for topN 0, got %d", got)
+	}
+}
+
+func TestEngine_GetSlowConditionReport(t *testing.T) {
+	store := NewInMemoryStore()
+	_, err := store.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Id:       "rule-1",
+		Name:     "Database alerts",
+		Priority: 1,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "team",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "database",
+			},
+			{
+				Type:          routingv1.ConditionType_CONDITION_TYPE_CEL,
+				CelExpression: `alert_labels["env"] == "prod"`,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	profiler := NewConditionProfiler(ProfilerConfig{}, zerolog.Nop(), nil)
+	engine := NewEngine(store, NewEvaluator(WithConditionProfiler(profiler)), zerolog.Nop())
+
+	alert := &routingv1.Alert{Labels: map[string]string{"team": "database", "env": "prod"}}
+	for i := 0; i < 3; i++ {
+		if _, _, err := engine.Evaluate(context.Background(), alert, time.Now()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	report := engine.GetSlowConditionReport(10)
+	if len(report) != 2 {
+		t.Fatalf("expected stats for 2 conditions, got %d", len(report))
+	}
+	for _, stat := range report {
+		if stat.Evaluations != 3 {
+			t.Errorf("expected 3 evaluations of %s/%s, got %d", stat.RuleID, stat.ConditionType, stat.Evaluations)
+		}
+	}
+
+	unprofiled := NewEngine(store, NewEvaluator(), zerolog.Nop())
+	if report := unprofiled.GetSlowConditionReport(10); report != nil {
+		t.Errorf("expected no report without a profiler, got %v", report)
+	}
+}
+
+func TestProfilerConfigFromEnv(t *testing.T) {
+	t.Setenv("ROUTING_SLOW_CONDITION_THRESHOLD_MS", "")
+	if got := ProfilerConfigFromEnv().SlowConditionThresholdMs; got != DefaultSlowConditionThresholdMs {
+		t.Errorf("expected default threshold, got %d", got)
+	}
+
+	t.Setenv("ROUTING_SLOW_CONDITION_THRESHOLD_MS", "50")
+	if got := ProfilerConfigFromEnv().SlowConditionThresholdMs; got != 50 {
+		t.Errorf("expected 50ms threshold, got %d", got)
+	}
+}
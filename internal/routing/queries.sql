@@ -1,52 +1,53 @@
 -- name: CreateRoutingRule :one
-INSERT INTO routing_rules (id, name, description, priority, enabled, created_by, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO routing_rules (id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
 
 -- name: GetRoutingRule :one
-SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
+SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
 FROM routing_rules
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $2;
 
 -- name: ListRoutingRules :many
-SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
+SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
 FROM routing_rules
+WHERE tenant_id = $1
 ORDER BY priority ASC
-LIMIT $1 OFFSET $2;
+LIMIT $2 OFFSET $3;
 
 -- name: ListEnabledRoutingRules :many
-SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
+SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
 FROM routing_rules
-WHERE enabled = true
+WHERE enabled = true AND tenant_id = $1
 ORDER BY priority ASC;
 
 -- name: ListRoutingRulesByName :many
-SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
+SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
 FROM routing_rules
-WHERE name ILIKE '%' || $1 || '%'
+WHERE name ILIKE '%' || $1 || '%' AND tenant_id = $2
 ORDER BY priority ASC
-LIMIT $2 OFFSET $3;
+LIMIT $3 OFFSET $4;
 
 -- name: UpdateRoutingRule :one
 UPDATE routing_rules
-SET name = $2, description = $3, priority = $4, enabled = $5, updated_at = $6
-WHERE id = $1
+SET name = $2, description = $3, priority = $4, enabled = $5, forced_integration_keys = $6, affected_regions = $7, affected_site_types = $8, stop_processing = $9, updated_at = $10
+WHERE id = $1 AND tenant_id = $11
 RETURNING *;
 
 -- name: UpdateRoutingRulePriority :exec
 UPDATE routing_rules
 SET priority = $2, updated_at = $3
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $4;
 
 -- name: DeleteRoutingRule :exec
 DELETE FROM routing_rules
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $2;
 
 -- name: CountRoutingRules :one
-SELECT COUNT(*) FROM routing_rules;
+SELECT COUNT(*) FROM routing_rules WHERE tenant_id = $1;
 
 -- name: CountEnabledRoutingRules :one
-SELECT COUNT(*) FROM routing_rules WHERE enabled = true;
+SELECT COUNT(*) FROM routing_rules WHERE enabled = true AND tenant_id = $1;
 
 -- name: CreateRoutingCondition :one
 INSERT INTO routing_conditions (id, rule_id, condition_type, field, operator, value, values, cel_expression, position, created_at)
@@ -79,12 +80,12 @@ DELETE FROM routing_actions
 WHERE rule_id = $1;
 
 -- name: CreateRoutingAuditLog :one
-INSERT INTO routing_audit_logs (id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO routing_audit_logs (id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version, stopped_at_rule_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: GetRoutingAuditLogs :many
-SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version
+SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version, stopped_at_rule_id
 FROM routing_audit_logs
 WHERE ($1::uuid IS NULL OR alert_id = $1)
   AND ($2::timestamptz IS NULL OR timestamp >= $2)
@@ -93,14 +94,14 @@ ORDER BY timestamp DESC
 LIMIT $4 OFFSET $5;
 
 -- name: GetRoutingAuditLogsByAlertID :many
-SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version
+SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version, stopped_at_rule_id
 FROM routing_audit_logs
 WHERE alert_id = $1
 ORDER BY timestamp DESC
 LIMIT $2 OFFSET $3;
 
 -- name: GetRoutingAuditLogsByFingerprint :many
-SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version
+SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, routing_engine_version, stopped_at_rule_id
 FROM routing_audit_logs
 WHERE alert_fingerprint = $1
 ORDER BY timestamp DESC
@@ -0,0 +1,158 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// ReplayMaxAlerts is the maximum number of historical alerts a replay evaluates.
+	ReplayMaxAlerts = 10000
+	// ReplayedLabel marks alerts whose actions were re-executed by a replay, so
+	// notifications can be told apart from live alerts.
+	ReplayedLabel = "replayed"
+)
+
+var (
+	// ErrInvalidReplay is returned when a replay request is invalid.
+	ErrInvalidReplay = errors.New("invalid replay request")
+	// ErrReplayActionsDisabled is returned when a non-dry-run replay is requested
+	// but no action executor is configured.
+	ErrReplayActionsDisabled = errors.New("replay action execution is not configured")
+)
+
+// ReplayRequest selects the rule and the alert trigger time range to replay.
+type ReplayRequest struct {
+	RuleID    string
+	StartTime time.Time
+	EndTime   time.Time
+	// DryRun evaluates the rule without executing its actions.
+	DryRun bool
+}
+
+// ReplayResult summarizes a replay.
+type ReplayResult struct {
+	Evaluated       int  `json:"evaluated"`
+	Matched         int  `json:"matched"`
+	ActionsExecuted int  `json:"actionsExecuted"`
+	DryRun          bool `json:"dryRun"`
+}
+
+// Replayer evaluates a routing rule against historical alerts and, outside of
+// dry runs, re-executes the rule's actions for the alerts it matches.
+type Replayer struct {
+	rules     Store
+	alerts    store.AlertStore
+	evaluator *Evaluator
+	executor  action.Executor
+	logger    zerolog.Logger
+}
+
+// NewReplayer creates a replayer. A nil executor only allows dry runs.
+func NewReplayer(rules Store, alerts store.AlertStore, evaluator *Evaluator, executor action.Executor, logger zerolog.Logger) *Replayer {
+	return &Replayer{
+		rules:     rules,
+		alerts:    alerts,
+		evaluator: evaluator,
+		executor:  executor,
+		logger:    logger.With().Str("component", "routing_replayer").Logger(),
+	}
+}
+
+// Replay evaluates the rule against up to ReplayMaxAlerts alerts triggered within
+// the request's time range. Each alert is evaluated at its trigger time so time
+// conditions apply as they would have when the alert fired. Returns ErrNotFound
+// if the rule does not exist.
+func (r *Replayer) Replay(ctx context.Context, req ReplayRequest) (*ReplayResult, error) {
+	if req.RuleID == "" {
+		return nil, fmt.Errorf("%w: rule id is required", ErrInvalidReplay)
+	}
+	if req.StartTime.IsZero() || req.EndTime.IsZero() || !req.EndTime.After(req.StartTime) {
+		return nil, fmt.Errorf("%w: end time must be after start time", ErrInvalidReplay)
+	}
+	if !req.DryRun && r.executor == nil {
+		return nil, ErrReplayActionsDisabled
+	}
+
+	rule, err := r.rules.GetRule(ctx, req.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+		PageSize:        ReplayMaxAlerts,
+		TriggeredAfter:  timestamppb.New(req.StartTime),
+		TriggeredBefore: timestamppb.New(req.EndTime),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list alerts: %w", err)
+	}
+
+	result := &ReplayResult{DryRun: req.DryRun}
+	for _, alert := range resp.Alerts {
+		if alert.TriggeredAt == nil {
+			continue
+		}
+		triggeredAt := alert.TriggeredAt.AsTime()
+		if triggeredAt.Before(req.StartTime) || !triggeredAt.Before(req.EndTime) {
+			continue
+		}
+		if result.Evaluated >= ReplayMaxAlerts {
+			break
+		}
+		result.Evaluated++
+
+		routingAlert := AlertFromStore(alert)
+		if !r.evaluator.EvaluateRule(ctx, rule, routingAlert, triggeredAt).Matched {
+			continue
+		}
+		result.Matched++
+
+		if req.DryRun {
+			continue
+		}
+		result.ActionsExecuted += r.executeActions(ctx, rule, routingAlert)
+	}
+
+	r.logger.Info().
+		Str("ruleId", rule.Id).
+		Bool("dryRun", req.DryRun).
+		Int("evaluated", result.Evaluated).
+		Int("matched", result.Matched).
+		Int("actionsExecuted", result.ActionsExecuted).
+		Msg("routing rule replayed")
+
+	return result, nil
+}
+
+// executeActions runs the rule's actions for a replayed alert and returns how
+// many succeeded. Failures are logged and do not stop the replay.
+func (r *Replayer) executeActions(ctx context.Context, rule *routingv1.RoutingRule, alert *routingv1.Alert) int {
+	alert.Labels[ReplayedLabel] = "true"
+
+	results, err := r.executor.Execute(action.WithRuleID(ctx, rule.Id), alert, rule.Actions)
+	if err != nil {
+		r.logger.Warn().Err(err).
+			Str("ruleId", rule.Id).
+			Str("alertId", alert.Id).
+			Msg("failed to execute replayed actions")
+	}
+
+	executed := 0
+	for _, res := range results {
+		if res != nil && res.Success {
+			executed++
+		}
+	}
+	return executed
+}
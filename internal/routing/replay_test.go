@@ -0,0 +1,201 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// recordingExecutor records the alerts whose actions were executed.
+type recordingExecutor struct {
+	alerts []*routingv1.Alert
+}
+
+func (e *recordingExecutor) Execute(ctx context.Context, alert *routingv1.Alert, actions []*routingv1.RoutingAction) ([]*action.Result, error) {
+	e.alerts = append(e.alerts, alert)
+	results := make([]*action.Result, 0, len(actions))
+	for _, a := range actions {
+		results = append(results, &action.Result{ActionType: a.Type.String(), Success: true})
+	}
+	return results, nil
+}
+
+func (e *recordingExecutor) RegisterAction(actionType routingv1.ActionType, handler action.ActionHandler) {
+}
+
+var replayStart = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func newReplayTestRouter(t *testing.T, executor action.Executor) (*gin.Engine, string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	rules := NewInMemoryStore()
+	rule, err := rules.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Name:     "Critical network",
+		Priority: 1,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "severity",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "critical",
+			},
+		},
+		Actions: []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+			{Type: routingv1.ActionType_ACTION_TYPE_SET_LABEL},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	alerts := &listAlertStore{}
+	for i, severity := range []alertingv1.Severity{
+		alertingv1.Severity_SEVERITY_CRITICAL,
+		alertingv1.Severity_SEVERITY_CRITICAL,
+		alertingv1.Severity_SEVERITY_LOW,
+	} {
+		alerts.alerts = append(alerts.alerts, &alertingv1.Alert{
+			Id:          "alert-" + string(rune('a'+i)),
+			Severity:    severity,
+			Status:      alertingv1.AlertStatus_ALERT_STATUS_RESOLVED,
+			TriggeredAt: timestamppb.New(replayStart.Add(time.Duration(i+1) * time.Hour)),
+		})
+	}
+	// Triggered outside the replayed range
+	alerts.alerts = append(alerts.alerts, &alertingv1.Alert{
+		Id:          "too-old",
+		Severity:    alertingv1.Severity_SEVERITY_CRITICAL,
+		TriggeredAt: timestamppb.New(replayStart.Add(-time.Hour)),
+	})
+
+	replayer := NewReplayer(rules, alerts, NewEvaluator(), executor, zerolog.Nop())
+	router := gin.New()
+	NewHandler(rules, replayer, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+	return router, rule.Id
+}
+
+func postReplay(router *gin.Engine, body map[string]any) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/routing/replay", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func replayBody(ruleID string, dryRun *bool) map[string]any {
+	body := map[string]any{
+		"ruleId":    ruleID,
+		"startTime": replayStart,
+		"endTime":   replayStart.Add(24 * time.Hour),
+	}
+	if dryRun != nil {
+		body["dryRun"] = *dryRun
+	}
+	return body
+}
+
+func TestReplay_DryRunDoesNotExecuteActions(t *testing.T) {
+	executor := &recordingExecutor{}
+	router, ruleID := newReplayTestRouter(t, executor)
+
+	dryRun := true
+	w := postReplay(router, replayBody(ruleID, &dryRun))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var result ReplayResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if result.Evaluated != 3 || result.Matched != 2 || result.ActionsExecuted != 0 || !result.DryRun {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(executor.alerts) != 0 {
+		t.Errorf("expected no actions to be executed, got %d", len(executor.alerts))
+	}
+}
+
+func TestReplay_DefaultsToDryRun(t *testing.T) {
+	executor := &recordingExecutor{}
+	router, ruleID := newReplayTestRouter(t, executor)
+
+	w := postReplay(router, replayBody(ruleID, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(executor.alerts) != 0 {
+		t.Errorf("expected no actions to be executed, got %d", len(executor.alerts))
+	}
+}
+
+func TestReplay_ExecutesActionsWithReplayedLabel(t *testing.T) {
+	executor := &recordingExecutor{}
+	router, ruleID := newReplayTestRouter(t, executor)
+
+	dryRun := false
+	w := postReplay(router, replayBody(ruleID, &dryRun))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var result ReplayResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if result.Evaluated != 3 || result.Matched != 2 || result.ActionsExecuted != 4 || result.DryRun {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	if len(executor.alerts) != 2 {
+		t.Fatalf("expected actions for 2 alerts, got %d", len(executor.alerts))
+	}
+	for _, alert := range executor.alerts {
+		if alert.Labels[ReplayedLabel] != "true" {
+			t.Errorf("expected alert %s to carry the replayed label, got %v", alert.Id, alert.Labels)
+		}
+	}
+}
+
+func TestReplay_Errors(t *testing.T) {
+	router, ruleID := newReplayTestRouter(t, &recordingExecutor{})
+	noExecutor, noExecutorRuleID := newReplayTestRouter(t, nil)
+	dryRun := false
+
+	tests := []struct {
+		name     string
+		router   *gin.Engine
+		body     map[string]any
+		expected int
+	}{
+		{"unknown rule", router, replayBody("missing", nil), http.StatusNotFound},
+		{"missing rule id", router, map[string]any{"startTime": replayStart, "endTime": replayStart}, http.StatusBadRequest},
+		{"end before start", router, map[string]any{"ruleId": ruleID, "startTime": replayStart, "endTime": replayStart.Add(-time.Hour)}, http.StatusBadRequest},
+		{"actions without executor", noExecutor, replayBody(noExecutorRuleID, &dryRun), http.StatusNotImplemented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postReplay(tt.router, tt.body)
+			if w.Code != tt.expected {
+				t.Errorf("expected status %d, got %d: %s", tt.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+}
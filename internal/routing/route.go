@@ -0,0 +1,33 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// RouteAlert evaluates the routing rules against a stored alert and executes
+// the actions of the rules it matches. Only triggered and resolved alerts are
+// routed: acknowledged alerts are already being handled and suppressed alerts
+// were silenced or inhibited, so neither must notify anyone.
+func RouteAlert(ctx context.Context, engine *Engine, executor action.Executor, alert *alertingv1.Alert) error {
+	switch alert.Status {
+	case alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+	default:
+		return nil
+	}
+
+	routed := AlertFromStore(alert)
+	_, actions, err := engine.Evaluate(ctx, routed, time.Now())
+	if err != nil {
+		return fmt.Errorf("evaluate routing rules: %w", err)
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	_, err = executor.Execute(ctx, routed, actions)
+	return err
+}
@@ -0,0 +1,42 @@
+package routing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestRouteAlert(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       alertingv1.AlertStatus
+		wantExecuted int
+	}{
+		{"triggered", alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, 1},
+		{"resolved", alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, 1},
+		{"acknowledged", alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, 0},
+		{"silenced", alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewEngine(newCountingStore(t), NewEvaluator(), zerolog.Nop())
+			executor := &recordingExecutor{}
+			alert := &alertingv1.Alert{
+				Id:     "alert-1",
+				Status: tt.status,
+				Labels: map[string]string{"severity": "critical"},
+			}
+
+			if err := RouteAlert(context.Background(), engine, executor, alert); err != nil {
+				t.Fatalf("RouteAlert() error = %v", err)
+			}
+			if len(executor.alerts) != tt.wantExecuted {
+				t.Errorf("executed actions for %d alerts, want %d", len(executor.alerts), tt.wantExecuted)
+			}
+		})
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/auth"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -25,7 +29,9 @@ var (
 	ErrInvalidRule = errors.New("invalid routing rule")
 )
 
-// Store defines the interface for routing rule persistence.
+// Store defines the interface for routing rule persistence. Rules belong to
+// the tenant of the context they are created with, see auth.TenantFromContext,
+// and are only visible to that tenant.
 type Store interface {
 	// CreateRule creates a new routing rule.
 	CreateRule(ctx context.Context, rule *routingv1.RoutingRule) (*routingv1.RoutingRule, error)
@@ -45,12 +51,27 @@ type Store interface {
 	// ReorderRules updates the priorities of multiple rules.
 	ReorderRules(ctx context.Context, priorities map[string]int32) ([]*routingv1.RoutingRule, error)
 
+	// CompactPriorities renumbers the priorities of all rules sequentially
+	// (1, 2, 3...) keeping their order, and returns the rules by priority.
+	CompactPriorities(ctx context.Context) ([]*routingv1.RoutingRule, error)
+
 	// GetAuditLogs retrieves routing audit logs.
 	GetAuditLogs(ctx context.Context, req *routingv1.GetRoutingAuditLogsRequest) (*routingv1.GetRoutingAuditLogsResponse, error)
 
+	// GetAuditLog retrieves a routing audit log by ID, including its condition results.
+	GetAuditLog(ctx context.Context, id string) (*routingv1.RoutingAuditLog, error)
+
 	// CreateAuditLog creates a new audit log entry.
 	CreateAuditLog(ctx context.Context, log *routingv1.RoutingAuditLog) error
 
+	// PurgeAuditLogs deletes audit logs recorded before olderThan and returns
+	// the number of logs deleted.
+	PurgeAuditLogs(ctx context.Context, olderThan time.Time) (int64, error)
+
+	// ListAuditLogTenants returns the tenants that have audit logs, whatever
+	// the tenant of ctx, for background jobs that process every tenant.
+	ListAuditLogTenants(ctx context.Context) ([]string, error)
+
 	// GetEnabledRulesByPriority retrieves all enabled rules ordered by priority.
 	GetEnabledRulesByPriority(ctx context.Context) ([]*routingv1.RoutingRule, error)
 }
@@ -70,6 +91,13 @@ func (s *PostgresStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 	if rule == nil {
 		return nil, ErrInvalidRule
 	}
+	if err := validateConditions(rule.Conditions); err != nil {
+		return nil, err
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -85,12 +113,15 @@ func (s *PostgresStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 	now := time.Now()
 	rule.CreatedAt = timestamppb.New(now)
 	rule.UpdatedAt = timestamppb.New(now)
+	forcedKeys := marshalStringList(rule.ForcedIntegrationKeys)
+	affectedRegions := marshalStringList(rule.AffectedRegions)
+	affectedSiteTypes := marshalSiteTypes(rule.AffectedSiteTypes)
 
 	// Insert the rule
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO routing_rules (id, name, description, priority, enabled, created_by, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-	`, rule.Id, rule.Name, rule.Description, rule.Priority, rule.Enabled, rule.CreatedBy, now, now)
+		INSERT INTO routing_rules (id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, rule.Id, rule.Name, rule.Description, rule.Priority, rule.Enabled, forcedKeys, affectedRegions, affectedSiteTypes, rule.StopProcessing, rule.CreatedBy, now, now, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("insert rule: %w", err)
 	}
@@ -103,7 +134,7 @@ func (s *PostgresStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO routing_conditions (id, rule_id, condition_type, field, operator, value, values, cel_expression, position, created_at)
 			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		`, condID, rule.Id, cond.Type.String(), cond.Field, cond.Operator.String(), cond.StringValue, values, cond.CelExpression, i, now)
+		`, condID, rule.Id, cond.Type.String(), cond.Field, cond.Operator.String(), conditionValue(cond), values, cond.CelExpression, i, now)
 		if err != nil {
 			return nil, fmt.Errorf("insert condition: %w", err)
 		}
@@ -132,16 +163,22 @@ func (s *PostgresStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 
 // GetRule retrieves a routing rule by ID.
 func (s *PostgresStore) GetRule(ctx context.Context, id string) (*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	rule := &routingv1.RoutingRule{}
 
 	var createdAt, updatedAt time.Time
 	var description sql.NullString
 	var createdBy sql.NullString
+	var forcedKeysJSON, affectedRegionsJSON, affectedSiteTypesJSON []byte
 
-	err := s.db.QueryRowContext(ctx, `
-		SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
-		FROM routing_rules WHERE id = $1
-	`, id).Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &createdBy, &createdAt, &updatedAt)
+	err = s.db.QueryRowContext(ctx, `
+		SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
+		FROM routing_rules WHERE id = $1 AND tenant_id = $2
+	`, id, tenantID).Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &forcedKeysJSON, &affectedRegionsJSON, &affectedSiteTypesJSON, &rule.StopProcessing, &createdBy, &createdAt, &updatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
@@ -153,6 +190,9 @@ func (s *PostgresStore) GetRule(ctx context.Context, id string) (*routingv1.Rout
 	rule.CreatedBy = createdBy.String
 	rule.CreatedAt = timestamppb.New(createdAt)
 	rule.UpdatedAt = timestamppb.New(updatedAt)
+	rule.ForcedIntegrationKeys = unmarshalStringList(forcedKeysJSON)
+	rule.AffectedRegions = unmarshalStringList(affectedRegionsJSON)
+	rule.AffectedSiteTypes = unmarshalSiteTypes(affectedSiteTypesJSON)
 
 	// Load conditions
 	conditions, err := s.loadConditions(ctx, id)
@@ -203,6 +243,10 @@ func (s *PostgresStore) loadConditions(ctx context.Context, ruleID string) ([]*r
 		if valuesJSON != nil {
 			_ = json.Unmarshal(valuesJSON, &cond.StringList)
 		}
+		if cond.Type == routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE {
+			cond.IntValue, _ = strconv.ParseInt(cond.StringValue, 10, 64)
+			cond.StringValue = ""
+		}
 
 		conditions = append(conditions, cond)
 	}
@@ -210,6 +254,15 @@ func (s *PostgresStore) loadConditions(ctx context.Context, ruleID string) ([]*r
 	return conditions, rows.Err()
 }
 
+// conditionValue returns the value column of a condition. GROUP_SIZE
+// thresholds are stored in it as they have no column of their own.
+func conditionValue(cond *routingv1.RoutingCondition) string {
+	if cond.Type == routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE {
+		return strconv.FormatInt(cond.IntValue, 10)
+	}
+	return cond.StringValue
+}
+
 // loadActions loads actions for a rule.
 func (s *PostgresStore) loadActions(ctx context.Context, ruleID string) ([]*routingv1.RoutingAction, error) {
 	rows, err := s.db.QueryContext(ctx, `
@@ -247,9 +300,14 @@ func (s *PostgresStore) loadActions(ctx context.Context, ruleID string) ([]*rout
 
 // ListRules retrieves routing rules with optional filters.
 func (s *PostgresStore) ListRules(ctx context.Context, req *routingv1.ListRoutingRulesRequest) (*routingv1.ListRoutingRulesResponse, error) {
-	query := `SELECT id, name, description, priority, enabled, created_by, created_at, updated_at FROM routing_rules WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at FROM routing_rules WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIndex := 2
 
 	if req.EnabledOnly {
 		query += fmt.Sprintf(" AND enabled = $%d", argIndex)
@@ -301,8 +359,9 @@ func (s *PostgresStore) ListRules(ctx context.Context, req *routingv1.ListRoutin
 		var rule routingv1.RoutingRule
 		var createdAt, updatedAt time.Time
 		var description, createdBy sql.NullString
+		var forcedKeysJSON, affectedRegionsJSON, affectedSiteTypesJSON []byte
 
-		if err := rows.Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &createdBy, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &forcedKeysJSON, &affectedRegionsJSON, &affectedSiteTypesJSON, &rule.StopProcessing, &createdBy, &createdAt, &updatedAt); err != nil {
 			return nil, fmt.Errorf("scan rule: %w", err)
 		}
 
@@ -310,6 +369,9 @@ func (s *PostgresStore) ListRules(ctx context.Context, req *routingv1.ListRoutin
 		rule.CreatedBy = createdBy.String
 		rule.CreatedAt = timestamppb.New(createdAt)
 		rule.UpdatedAt = timestamppb.New(updatedAt)
+		rule.ForcedIntegrationKeys = unmarshalStringList(forcedKeysJSON)
+		rule.AffectedRegions = unmarshalStringList(affectedRegionsJSON)
+		rule.AffectedSiteTypes = unmarshalSiteTypes(affectedSiteTypesJSON)
 
 		// Load conditions and actions
 		conditions, err := s.loadConditions(ctx, rule.Id)
@@ -351,6 +413,13 @@ func (s *PostgresStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 	if rule == nil || rule.Id == "" {
 		return nil, ErrInvalidRule
 	}
+	if err := validateConditions(rule.Conditions); err != nil {
+		return nil, err
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -363,9 +432,11 @@ func (s *PostgresStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 
 	// Update the rule
 	result, err := tx.ExecContext(ctx, `
-		UPDATE routing_rules SET name = $1, description = $2, priority = $3, enabled = $4, updated_at = $5
-		WHERE id = $6
-	`, rule.Name, rule.Description, rule.Priority, rule.Enabled, now, rule.Id)
+		UPDATE routing_rules SET name = $1, description = $2, priority = $3, enabled = $4, forced_integration_keys = $5,
+			affected_regions = $6, affected_site_types = $7, stop_processing = $8, updated_at = $9
+		WHERE id = $10 AND tenant_id = $11
+	`, rule.Name, rule.Description, rule.Priority, rule.Enabled, marshalStringList(rule.ForcedIntegrationKeys),
+		marshalStringList(rule.AffectedRegions), marshalSiteTypes(rule.AffectedSiteTypes), rule.StopProcessing, now, rule.Id, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("update rule: %w", err)
 	}
@@ -394,7 +465,7 @@ func (s *PostgresStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO routing_conditions (id, rule_id, condition_type, field, operator, value, values, cel_expression, position, created_at)
 			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		`, condID, rule.Id, cond.Type.String(), cond.Field, cond.Operator.String(), cond.StringValue, values, cond.CelExpression, i, now)
+		`, condID, rule.Id, cond.Type.String(), cond.Field, cond.Operator.String(), conditionValue(cond), values, cond.CelExpression, i, now)
 		if err != nil {
 			return nil, fmt.Errorf("insert condition: %w", err)
 		}
@@ -423,7 +494,12 @@ func (s *PostgresStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 
 // DeleteRule deletes a routing rule by ID.
 func (s *PostgresStore) DeleteRule(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM routing_rules WHERE id = $1", id)
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM routing_rules WHERE id = $1 AND tenant_id = $2", id, tenantID)
 	if err != nil {
 		return fmt.Errorf("delete rule: %w", err)
 	}
@@ -438,6 +514,11 @@ func (s *PostgresStore) DeleteRule(ctx context.Context, id string) error {
 
 // ReorderRules updates the priorities of multiple rules.
 func (s *PostgresStore) ReorderRules(ctx context.Context, priorities map[string]int32) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("begin transaction: %w", err)
@@ -449,8 +530,8 @@ func (s *PostgresStore) ReorderRules(ctx context.Context, priorities map[string]
 
 	for id, priority := range priorities {
 		_, err := tx.ExecContext(ctx, `
-			UPDATE routing_rules SET priority = $1, updated_at = $2 WHERE id = $3
-		`, priority, now, id)
+			UPDATE routing_rules SET priority = $1, updated_at = $2 WHERE id = $3 AND tenant_id = $4
+		`, priority, now, id, tenantID)
 		if err != nil {
 			return nil, fmt.Errorf("update priority for %s: %w", id, err)
 		}
@@ -472,11 +553,92 @@ func (s *PostgresStore) ReorderRules(ctx context.Context, priorities map[string]
 	return updatedRules, nil
 }
 
+// CompactPriorities renumbers the priorities of all rules sequentially within
+// a transaction. Renumbered rules are first moved to negative priorities, so
+// the unique priority constraint, which is checked for each updated row, does
+// not reject a rule taking the priority another rule has not given up yet.
+func (s *PostgresStore) CompactPriorities(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Rule changes of other transactions wait until the priorities are compacted
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM routing_rules WHERE tenant_id = $1 FOR UPDATE`, tenantID); err != nil {
+		return nil, fmt.Errorf("lock rules: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		WITH ranked AS (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY priority ASC) AS priority
+			FROM routing_rules WHERE tenant_id = $1
+		)
+		UPDATE routing_rules SET priority = -ranked.priority, updated_at = $2
+		FROM ranked
+		WHERE routing_rules.id = ranked.id AND routing_rules.priority <> ranked.priority
+	`, tenantID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("renumber priorities: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE routing_rules SET priority = -priority WHERE tenant_id = $1 AND priority < 0
+	`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("renumber priorities: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM routing_rules WHERE tenant_id = $1 ORDER BY priority ASC`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query rules: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan rule: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Fetch compacted rules
+	rules := make([]*routingv1.RoutingRule, 0, len(ids))
+	for _, id := range ids {
+		rule, err := s.GetRule(ctx, id)
+		if err != nil {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 // GetAuditLogs retrieves routing audit logs.
 func (s *PostgresStore) GetAuditLogs(ctx context.Context, req *routingv1.GetRoutingAuditLogsRequest) (*routingv1.GetRoutingAuditLogsResponse, error) {
-	query := `SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms FROM routing_audit_logs WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, timestamp, alert_id, alert_fingerprint, evaluations, final_actions, processing_time_ms, stopped_at_rule_id FROM routing_audit_logs WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIndex := 2
 
 	if req.AlertId != "" {
 		query += fmt.Sprintf(" AND alert_id = $%d", argIndex)
@@ -526,15 +688,16 @@ func (s *PostgresStore) GetAuditLogs(ctx context.Context, req *routingv1.GetRout
 	for rows.Next() {
 		var log routingv1.RoutingAuditLog
 		var timestamp time.Time
-		var alertID, fingerprint sql.NullString
+		var alertID, fingerprint, stoppedAtRuleID sql.NullString
 		var evaluationsJSON, actionsJSON []byte
 		var processingTimeMs sql.NullInt64
 
-		if err := rows.Scan(&log.Id, &timestamp, &alertID, &fingerprint, &evaluationsJSON, &actionsJSON, &processingTimeMs); err != nil {
+		if err := rows.Scan(&log.Id, &timestamp, &alertID, &fingerprint, &evaluationsJSON, &actionsJSON, &processingTimeMs, &stoppedAtRuleID); err != nil {
 			return nil, fmt.Errorf("scan audit log: %w", err)
 		}
 
 		log.AlertId = alertID.String
+		log.StoppedAtRuleId = stoppedAtRuleID.String
 		log.Timestamp = timestamppb.New(timestamp)
 
 		// Parse evaluations
@@ -598,8 +761,55 @@ func (s *PostgresStore) GetAuditLogs(ctx context.Context, req *routingv1.GetRout
 	return resp, nil
 }
 
+// GetAuditLog retrieves a routing audit log by ID. Unlike GetAuditLogs it
+// decodes the full evaluations, including condition results.
+func (s *PostgresStore) GetAuditLog(ctx context.Context, id string) (*routingv1.RoutingAuditLog, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var log routingv1.RoutingAuditLog
+	var timestamp time.Time
+	var alertID, stoppedAtRuleID sql.NullString
+	var evaluationsJSON, actionsJSON []byte
+
+	err = s.db.QueryRowContext(ctx, `
+		SELECT id, timestamp, alert_id, evaluations, final_actions, stopped_at_rule_id FROM routing_audit_logs WHERE id = $1 AND tenant_id = $2
+	`, id, tenantID).Scan(&log.Id, &timestamp, &alertID, &evaluationsJSON, &actionsJSON, &stoppedAtRuleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query audit log: %w", err)
+	}
+
+	log.AlertId = alertID.String
+	log.StoppedAtRuleId = stoppedAtRuleID.String
+	log.Timestamp = timestamppb.New(timestamp)
+
+	// Evaluations and actions are stored as encoded by CreateAuditLog
+	if evaluationsJSON != nil {
+		if err := json.Unmarshal(evaluationsJSON, &log.Evaluations); err != nil {
+			return nil, fmt.Errorf("unmarshal evaluations: %w", err)
+		}
+	}
+	if actionsJSON != nil {
+		if err := json.Unmarshal(actionsJSON, &log.Executions); err != nil {
+			return nil, fmt.Errorf("unmarshal actions: %w", err)
+		}
+	}
+
+	return &log, nil
+}
+
 // CreateAuditLog creates a new audit log entry.
 func (s *PostgresStore) CreateAuditLog(ctx context.Context, log *routingv1.RoutingAuditLog) error {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	if log.Id == "" {
 		log.Id = uuid.New().String()
 	}
@@ -612,10 +822,10 @@ func (s *PostgresStore) CreateAuditLog(ctx context.Context, log *routingv1.Routi
 		alertSnapshot, _ = log.AlertSnapshot.MarshalJSON()
 	}
 
-	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO routing_audit_logs (id, timestamp, alert_id, evaluations, final_actions)
-		VALUES ($1, $2, $3, $4, $5)
-	`, log.Id, log.Timestamp.AsTime(), log.AlertId, evaluationsJSON, actionsJSON)
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO routing_audit_logs (id, timestamp, alert_id, evaluations, final_actions, stopped_at_rule_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, log.Id, log.Timestamp.AsTime(), log.AlertId, evaluationsJSON, actionsJSON, sql.NullString{String: log.StoppedAtRuleId, Valid: log.StoppedAtRuleId != ""}, tenantID)
 	if err != nil {
 		return fmt.Errorf("insert audit log: %w", err)
 	}
@@ -626,12 +836,77 @@ func (s *PostgresStore) CreateAuditLog(ctx context.Context, log *routingv1.Routi
 	return nil
 }
 
+// PurgeAuditLogs deletes audit logs recorded before olderThan.
+func (s *PostgresStore) PurgeAuditLogs(ctx context.Context, olderThan time.Time) (int64, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM routing_audit_logs WHERE tenant_id = $1 AND timestamp < $2`, tenantID, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("purge audit logs: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("purge audit logs: %w", err)
+	}
+	return deleted, nil
+}
+
+// ListAuditLogTenants returns the tenants that have audit logs.
+func (s *PostgresStore) ListAuditLogTenants(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT tenant_id FROM routing_audit_logs ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query audit log tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []string
+	for rows.Next() {
+		var tenantID string
+		if err := rows.Scan(&tenantID); err != nil {
+			return nil, fmt.Errorf("scan audit log tenant: %w", err)
+		}
+		tenants = append(tenants, tenantID)
+	}
+	return tenants, rows.Err()
+}
+
+// PurgeAllAuditLogs returns a function that deletes the audit logs of every
+// tenant recorded before olderThan, for the retention purger which runs
+// without a tenant.
+func PurgeAllAuditLogs(store Store) func(ctx context.Context, olderThan time.Time) (int64, error) {
+	return func(ctx context.Context, olderThan time.Time) (int64, error) {
+		tenants, err := store.ListAuditLogTenants(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		var deleted int64
+		for _, tenantID := range tenants {
+			n, err := store.PurgeAuditLogs(auth.WithTenant(ctx, tenantID), olderThan)
+			deleted += n
+			if err != nil {
+				return deleted, fmt.Errorf("tenant %s: %w", tenantID, err)
+			}
+		}
+		return deleted, nil
+	}
+}
+
 // GetEnabledRulesByPriority retrieves all enabled rules ordered by priority.
 func (s *PostgresStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, name, description, priority, enabled, created_by, created_at, updated_at
-		FROM routing_rules WHERE enabled = true ORDER BY priority ASC
-	`)
+		SELECT id, name, description, priority, enabled, forced_integration_keys, affected_regions, affected_site_types, stop_processing, created_by, created_at, updated_at
+		FROM routing_rules WHERE enabled = true AND tenant_id = $1 ORDER BY priority ASC
+	`, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("query enabled rules: %w", err)
 	}
@@ -642,8 +917,9 @@ func (s *PostgresStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routi
 		var rule routingv1.RoutingRule
 		var createdAt, updatedAt time.Time
 		var description, createdBy sql.NullString
+		var forcedKeysJSON, affectedRegionsJSON, affectedSiteTypesJSON []byte
 
-		if err := rows.Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &createdBy, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&rule.Id, &rule.Name, &description, &rule.Priority, &rule.Enabled, &forcedKeysJSON, &affectedRegionsJSON, &affectedSiteTypesJSON, &rule.StopProcessing, &createdBy, &createdAt, &updatedAt); err != nil {
 			return nil, fmt.Errorf("scan rule: %w", err)
 		}
 
@@ -651,6 +927,9 @@ func (s *PostgresStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routi
 		rule.CreatedBy = createdBy.String
 		rule.CreatedAt = timestamppb.New(createdAt)
 		rule.UpdatedAt = timestamppb.New(updatedAt)
+		rule.ForcedIntegrationKeys = unmarshalStringList(forcedKeysJSON)
+		rule.AffectedRegions = unmarshalStringList(affectedRegionsJSON)
+		rule.AffectedSiteTypes = unmarshalSiteTypes(affectedSiteTypesJSON)
 
 		// Load conditions and actions
 		conditions, err := s.loadConditions(ctx, rule.Id)
@@ -682,6 +961,83 @@ func decodePageToken(token string) (int, error) {
 	return offset, err
 }
 
+// marshalStringList encodes a string slice as a JSON array, using an empty array for nil.
+func marshalStringList(values []string) []byte {
+	if values == nil {
+		values = []string{}
+	}
+	data, _ := json.Marshal(values)
+	return data
+}
+
+// unmarshalStringList decodes a JSON array into a string slice.
+func unmarshalStringList(data []byte) []string {
+	if data == nil {
+		return nil
+	}
+	var values []string
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
+// marshalSiteTypes encodes site types as a JSON array of enum names.
+func marshalSiteTypes(types []routingv1.SiteType) []byte {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.String())
+	}
+	return marshalStringList(names)
+}
+
+// unmarshalSiteTypes decodes a JSON array of enum names into site types.
+func unmarshalSiteTypes(data []byte) []routingv1.SiteType {
+	names := unmarshalStringList(data)
+	if names == nil {
+		return nil
+	}
+	types := make([]routingv1.SiteType, 0, len(names))
+	for _, name := range names {
+		if v, ok := routingv1.SiteType_value[name]; ok {
+			types = append(types, routingv1.SiteType(v))
+		}
+	}
+	return types
+}
+
+// validateConditions checks that the patterns of regex conditions compile,
+// that numeric conditions compare against a number and that time window
+// conditions hold a valid time window.
+func validateConditions(conditions []*routingv1.RoutingCondition) error {
+	for i, cond := range conditions {
+		if cond.Type == routingv1.ConditionType_CONDITION_TYPE_TIME_WINDOW {
+			if _, err := parseTimeWindow(cond.StringValue); err != nil {
+				return fmt.Errorf("%w: condition %d: %v", ErrInvalidRule, i, err)
+			}
+			continue
+		}
+		if cond.Type == routingv1.ConditionType_CONDITION_TYPE_GROUP_SIZE {
+			if cond.IntValue < 1 {
+				return fmt.Errorf("%w: condition %d: group size threshold must be at least 1", ErrInvalidRule, i)
+			}
+			continue
+		}
+		if isNumericOperator(cond.Operator) {
+			if _, err := parseNumericOperand(cond, cond.StringValue); err != nil {
+				return fmt.Errorf("%w: condition %d: %v", ErrInvalidRule, i, err)
+			}
+			continue
+		}
+		if cond.Operator != routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH &&
+			cond.Operator != routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH {
+			continue
+		}
+		if _, err := regexp.Compile(cond.StringValue); err != nil {
+			return fmt.Errorf("%w: condition %d has invalid regex %q: %v", ErrInvalidRule, i, cond.StringValue, err)
+		}
+	}
+	return nil
+}
+
 // Helper functions to parse enum types from strings
 func parseConditionType(s string) routingv1.ConditionType {
 	if v, ok := routingv1.ConditionType_value[s]; ok {
@@ -706,16 +1062,22 @@ func parseActionType(s string) routingv1.ActionType {
 
 // InMemoryStore is an in-memory implementation of Store for testing.
 type InMemoryStore struct {
-	rules     map[string]*routingv1.RoutingRule
+	rules map[string]*routingv1.RoutingRule
+	// tenants holds the tenant of each rule
+	tenants   map[string]string
 	auditLogs []*routingv1.RoutingAuditLog
-	counter   int64
+	// auditLogTenants holds the tenant of each audit log
+	auditLogTenants map[string]string
+	counter         int64
 }
 
 // NewInMemoryStore creates a new in-memory store.
 func NewInMemoryStore() *InMemoryStore {
 	return &InMemoryStore{
-		rules:     make(map[string]*routingv1.RoutingRule),
-		auditLogs: make([]*routingv1.RoutingAuditLog, 0),
+		rules:           make(map[string]*routingv1.RoutingRule),
+		tenants:         make(map[string]string),
+		auditLogs:       make([]*routingv1.RoutingAuditLog, 0),
+		auditLogTenants: make(map[string]string),
 	}
 }
 
@@ -724,6 +1086,13 @@ func (s *InMemoryStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 	if rule == nil {
 		return nil, ErrInvalidRule
 	}
+	if err := validateConditions(rule.Conditions); err != nil {
+		return nil, err
+	}
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if rule.Id == "" {
 		s.counter++
@@ -735,20 +1104,26 @@ func (s *InMemoryStore) CreateRule(ctx context.Context, rule *routingv1.RoutingR
 	rule.UpdatedAt = timestamppb.New(now)
 
 	// Check for duplicate priority
-	for _, r := range s.rules {
+	for _, r := range s.tenantRules(tenantID) {
 		if r.Priority == rule.Priority {
 			return nil, ErrDuplicatePriority
 		}
 	}
 
 	s.rules[rule.Id] = rule
+	s.tenants[rule.Id] = tenantID
 	return rule, nil
 }
 
 // GetRule retrieves a routing rule by ID.
 func (s *InMemoryStore) GetRule(ctx context.Context, id string) (*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	rule, ok := s.rules[id]
-	if !ok {
+	if !ok || s.tenants[id] != tenantID {
 		return nil, ErrNotFound
 	}
 	return rule, nil
@@ -756,9 +1131,14 @@ func (s *InMemoryStore) GetRule(ctx context.Context, id string) (*routingv1.Rout
 
 // ListRules retrieves routing rules with optional filters.
 func (s *InMemoryStore) ListRules(ctx context.Context, req *routingv1.ListRoutingRulesRequest) (*routingv1.ListRoutingRulesResponse, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var rules []*routingv1.RoutingRule
 
-	for _, rule := range s.rules {
+	for _, rule := range s.tenantRules(tenantID) {
 		if req.EnabledOnly && !rule.Enabled {
 			continue
 		}
@@ -785,14 +1165,22 @@ func (s *InMemoryStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 	if rule == nil || rule.Id == "" {
 		return nil, ErrInvalidRule
 	}
+	if err := validateConditions(rule.Conditions); err != nil {
+		return nil, err
+	}
+
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	existing, ok := s.rules[rule.Id]
-	if !ok {
+	if !ok || s.tenants[rule.Id] != tenantID {
 		return nil, ErrNotFound
 	}
 
 	// Check for duplicate priority (excluding this rule)
-	for _, r := range s.rules {
+	for _, r := range s.tenantRules(tenantID) {
 		if r.Id != rule.Id && r.Priority == rule.Priority {
 			return nil, ErrDuplicatePriority
 		}
@@ -807,20 +1195,31 @@ func (s *InMemoryStore) UpdateRule(ctx context.Context, rule *routingv1.RoutingR
 
 // DeleteRule deletes a routing rule by ID.
 func (s *InMemoryStore) DeleteRule(ctx context.Context, id string) error {
-	if _, ok := s.rules[id]; !ok {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.rules[id]; !ok || s.tenants[id] != tenantID {
 		return ErrNotFound
 	}
 	delete(s.rules, id)
+	delete(s.tenants, id)
 	return nil
 }
 
 // ReorderRules updates the priorities of multiple rules.
 func (s *InMemoryStore) ReorderRules(ctx context.Context, priorities map[string]int32) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var updatedRules []*routingv1.RoutingRule
 
 	for id, priority := range priorities {
 		rule, ok := s.rules[id]
-		if !ok {
+		if !ok || s.tenants[id] != tenantID {
 			continue
 		}
 		rule.Priority = priority
@@ -831,11 +1230,42 @@ func (s *InMemoryStore) ReorderRules(ctx context.Context, priorities map[string]
 	return updatedRules, nil
 }
 
+// CompactPriorities renumbers the priorities of all rules sequentially.
+func (s *InMemoryStore) CompactPriorities(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := s.tenantRules(tenantID)
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	now := timestamppb.Now()
+	for i, rule := range rules {
+		if priority := int32(i + 1); rule.Priority != priority {
+			rule.Priority = priority
+			rule.UpdatedAt = now
+		}
+	}
+
+	return rules, nil
+}
+
 // GetAuditLogs retrieves routing audit logs.
 func (s *InMemoryStore) GetAuditLogs(ctx context.Context, req *routingv1.GetRoutingAuditLogsRequest) (*routingv1.GetRoutingAuditLogsResponse, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var logs []*routingv1.RoutingAuditLog
 
 	for _, log := range s.auditLogs {
+		if s.auditLogTenants[log.Id] != tenantID {
+			continue
+		}
 		if req.AlertId != "" && log.AlertId != req.AlertId {
 			continue
 		}
@@ -854,20 +1284,81 @@ func (s *InMemoryStore) GetAuditLogs(ctx context.Context, req *routingv1.GetRout
 	}, nil
 }
 
+// GetAuditLog retrieves a routing audit log by ID.
+func (s *InMemoryStore) GetAuditLog(ctx context.Context, id string) (*routingv1.RoutingAuditLog, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range s.auditLogs {
+		if log.Id == id && s.auditLogTenants[id] == tenantID {
+			return log, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
 // CreateAuditLog creates a new audit log entry.
 func (s *InMemoryStore) CreateAuditLog(ctx context.Context, log *routingv1.RoutingAuditLog) error {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	if log.Id == "" {
 		log.Id = uuid.New().String()
 	}
 	s.auditLogs = append(s.auditLogs, log)
+	s.auditLogTenants[log.Id] = tenantID
 	return nil
 }
 
+// PurgeAuditLogs deletes audit logs recorded before olderThan.
+func (s *InMemoryStore) PurgeAuditLogs(ctx context.Context, olderThan time.Time) (int64, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	kept := s.auditLogs[:0]
+	for _, log := range s.auditLogs {
+		if s.auditLogTenants[log.Id] == tenantID && log.Timestamp.AsTime().Before(olderThan) {
+			delete(s.auditLogTenants, log.Id)
+			continue
+		}
+		kept = append(kept, log)
+	}
+
+	deleted := int64(len(s.auditLogs) - len(kept))
+	s.auditLogs = kept
+	return deleted, nil
+}
+
+// ListAuditLogTenants returns the tenants that have audit logs.
+func (s *InMemoryStore) ListAuditLogTenants(ctx context.Context) ([]string, error) {
+	seen := make(map[string]bool)
+	var tenants []string
+	for _, log := range s.auditLogs {
+		tenantID := s.auditLogTenants[log.Id]
+		if !seen[tenantID] {
+			seen[tenantID] = true
+			tenants = append(tenants, tenantID)
+		}
+	}
+	return tenants, nil
+}
+
 // GetEnabledRulesByPriority retrieves all enabled rules ordered by priority.
 func (s *InMemoryStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routingv1.RoutingRule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var rules []*routingv1.RoutingRule
 
-	for _, rule := range s.rules {
+	for _, rule := range s.tenantRules(tenantID) {
 		if rule.Enabled {
 			rules = append(rules, rule)
 		}
@@ -885,6 +1376,17 @@ func (s *InMemoryStore) GetEnabledRulesByPriority(ctx context.Context) ([]*routi
 	return rules, nil
 }
 
+// tenantRules returns the rules of tenantID.
+func (s *InMemoryStore) tenantRules(tenantID string) []*routingv1.RoutingRule {
+	var rules []*routingv1.RoutingRule
+	for id, rule := range s.rules {
+		if s.tenants[id] == tenantID {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
 // Ensure InMemoryStore satisfies the Store interface
 var _ Store = (*InMemoryStore)(nil)
 
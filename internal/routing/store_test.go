@@ -2,8 +2,16 @@ package routing
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -78,6 +86,96 @@ func TestInMemoryStore_CreateRule_DuplicatePriority(t *testing.T) {
 	}
 }
 
+func TestInMemoryStore_TenantIsolation(t *testing.T) {
+	store := NewInMemoryStore()
+	tenantA := auth.WithTenant(context.Background(), "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01")
+	tenantB := auth.WithTenant(context.Background(), "0b3e7c1d-5a2f-4c8e-9d6b-1e4f7a2c3b5d")
+
+	created, err := store.CreateRule(tenantA, &routingv1.RoutingRule{Name: "Rule A", Priority: 1, Enabled: true})
+	if err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	// Priorities are unique per tenant only
+	if _, err := store.CreateRule(tenantB, &routingv1.RoutingRule{Name: "Rule B", Priority: 1, Enabled: true}); err != nil {
+		t.Fatalf("CreateRule() in another tenant error = %v", err)
+	}
+
+	if _, err := store.GetRule(tenantB, created.Id); err != ErrNotFound {
+		t.Errorf("GetRule() from another tenant error = %v, want %v", err, ErrNotFound)
+	}
+	if err := store.DeleteRule(tenantB, created.Id); err != ErrNotFound {
+		t.Errorf("DeleteRule() from another tenant error = %v, want %v", err, ErrNotFound)
+	}
+
+	rules, err := store.GetEnabledRulesByPriority(tenantB)
+	if err != nil {
+		t.Fatalf("GetEnabledRulesByPriority() error = %v", err)
+	}
+	if len(rules) != 1 || rules[0].Name != "Rule B" {
+		t.Errorf("GetEnabledRulesByPriority() = %v, want only Rule B", rules)
+	}
+}
+
+func TestStore_CreateRule_InvalidRegex(t *testing.T) {
+	rule := &routingv1.RoutingRule{
+		Name:     "Bad regex",
+		Priority: 1,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "host",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH,
+				StringValue: "web-(",
+			},
+		},
+	}
+
+	// Validation runs before any query, so the Postgres store needs no database
+	stores := map[string]Store{
+		"in-memory": NewInMemoryStore(),
+		"postgres":  NewPostgresStore(nil),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			_, err := store.CreateRule(context.Background(), rule)
+			if !errors.Is(err, ErrInvalidRule) {
+				t.Fatalf("CreateRule() error = %v, want %v", err, ErrInvalidRule)
+			}
+			if !strings.Contains(err.Error(), "web-(") {
+				t.Errorf("CreateRule() error = %q, want it to name the pattern", err)
+			}
+
+			rule.Id = "rule-1"
+			_, err = store.UpdateRule(context.Background(), rule)
+			rule.Id = ""
+			if !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("UpdateRule() error = %v, want %v", err, ErrInvalidRule)
+			}
+		})
+	}
+}
+
+func TestInMemoryStore_CreateRule_InvalidNumericValue(t *testing.T) {
+	store := NewInMemoryStore()
+
+	_, err := store.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Name:     "Bad threshold",
+		Priority: 1,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "labels.cpu",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT,
+				StringValue: "ninety",
+			},
+		},
+	})
+	if !errors.Is(err, ErrInvalidRule) {
+		t.Errorf("CreateRule() error = %v, want %v", err, ErrInvalidRule)
+	}
+}
+
 func TestInMemoryStore_GetRule(t *testing.T) {
 	store := NewInMemoryStore()
 	ctx := context.Background()
@@ -367,6 +465,62 @@ func TestInMemoryStore_AuditLogs(t *testing.T) {
 	}
 }
 
+func TestInMemoryStore_AuditLogs_OtherTenant(t *testing.T) {
+	store := NewInMemoryStore()
+	tenantCtx := auth.WithTenant(context.Background(), "6f1c2a52-8d3e-4b7a-9f10-2c5e8a7b4d31")
+
+	log := &routingv1.RoutingAuditLog{AlertId: "alert-1", Timestamp: timestamppb.Now()}
+	if err := store.CreateAuditLog(tenantCtx, log); err != nil {
+		t.Fatalf("CreateAuditLog() error = %v", err)
+	}
+
+	resp, err := store.GetAuditLogs(context.Background(), &routingv1.GetRoutingAuditLogsRequest{})
+	if err != nil {
+		t.Fatalf("GetAuditLogs() error = %v", err)
+	}
+	if len(resp.Logs) != 0 {
+		t.Errorf("GetAuditLogs() returned %d logs of another tenant", len(resp.Logs))
+	}
+	if _, err := store.GetAuditLog(context.Background(), log.Id); err != ErrNotFound {
+		t.Errorf("GetAuditLog() error = %v, want %v", err, ErrNotFound)
+	}
+	if deleted, _ := store.PurgeAuditLogs(context.Background(), time.Now().Add(time.Hour)); deleted != 0 {
+		t.Errorf("PurgeAuditLogs() deleted %d logs of another tenant", deleted)
+	}
+
+	if _, err := store.GetAuditLog(tenantCtx, log.Id); err != nil {
+		t.Errorf("GetAuditLog() error = %v", err)
+	}
+}
+
+func TestPurgeAllAuditLogs(t *testing.T) {
+	store := NewInMemoryStore()
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tenantCtx := auth.WithTenant(context.Background(), "6f1c2a52-8d3e-4b7a-9f10-2c5e8a7b4d31")
+	for _, ctx := range []context.Context{context.Background(), tenantCtx} {
+		for _, ts := range []time.Time{cutoff.Add(-time.Hour), cutoff.Add(time.Hour)} {
+			if err := store.CreateAuditLog(ctx, &routingv1.RoutingAuditLog{AlertId: "alert-1", Timestamp: timestamppb.New(ts)}); err != nil {
+				t.Fatalf("CreateAuditLog() error = %v", err)
+			}
+		}
+	}
+
+	deleted, err := PurgeAllAuditLogs(store)(context.Background(), cutoff)
+	if err != nil {
+		t.Fatalf("PurgeAllAuditLogs() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("PurgeAllAuditLogs() deleted = %d, want 2", deleted)
+	}
+	for _, ctx := range []context.Context{context.Background(), tenantCtx} {
+		resp, _ := store.GetAuditLogs(ctx, &routingv1.GetRoutingAuditLogsRequest{})
+		if len(resp.Logs) != 1 {
+			t.Errorf("GetAuditLogs() returned %d logs, want 1", len(resp.Logs))
+		}
+	}
+}
+
 func TestInMemoryStore_CreateRule_Nil(t *testing.T) {
 	store := NewInMemoryStore()
 	ctx := context.Background()
@@ -396,3 +550,173 @@ func TestInMemoryStore_UpdateRule_NoID(t *testing.T) {
 		t.Errorf("UpdateRule(no id) error = %v, want %v", err, ErrInvalidRule)
 	}
 }
+
+func TestInMemoryStore_PurgeAuditLogs(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, ts := range []time.Time{cutoff.Add(-48 * time.Hour), cutoff.Add(-time.Second), cutoff, cutoff.Add(time.Hour)} {
+		if err := store.CreateAuditLog(ctx, &routingv1.RoutingAuditLog{AlertId: string(rune('a' + i)), Timestamp: timestamppb.New(ts)}); err != nil {
+			t.Fatalf("CreateAuditLog() error = %v", err)
+		}
+	}
+
+	deleted, err := store.PurgeAuditLogs(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("PurgeAuditLogs() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("PurgeAuditLogs() deleted = %d, want 2", deleted)
+	}
+
+	resp, _ := store.GetAuditLogs(ctx, &routingv1.GetRoutingAuditLogsRequest{})
+	if len(resp.Logs) != 2 {
+		t.Fatalf("GetAuditLogs() returned %d logs, want 2", len(resp.Logs))
+	}
+	for _, log := range resp.Logs {
+		if log.Timestamp.AsTime().Before(cutoff) {
+			t.Errorf("audit log %s at %v survived the purge", log.AlertId, log.Timestamp.AsTime())
+		}
+	}
+}
+
+func TestInMemoryStore_CompactPriorities(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	var ids []string
+	for _, priority := range []int32{20, 1, 8, 5, 12} {
+		rule, err := store.CreateRule(ctx, &routingv1.RoutingRule{Name: "Rule", Priority: priority, Enabled: true})
+		if err != nil {
+			t.Fatalf("CreateRule() error = %v", err)
+		}
+		ids = append(ids, rule.Id)
+	}
+	// Leave another gap by deleting the rule with priority 12
+	if err := store.DeleteRule(ctx, ids[4]); err != nil {
+		t.Fatalf("DeleteRule() error = %v", err)
+	}
+
+	rules, err := store.CompactPriorities(ctx)
+	if err != nil {
+		t.Fatalf("CompactPriorities() error = %v", err)
+	}
+
+	// Rules keep their relative order: 1, 5, 8, 20
+	wantOrder := []string{ids[1], ids[3], ids[2], ids[0]}
+	if len(rules) != len(wantOrder) {
+		t.Fatalf("CompactPriorities() returned %d rules, want %d", len(rules), len(wantOrder))
+	}
+	for i, id := range wantOrder {
+		if rules[i].Id != id || rules[i].Priority != int32(i+1) {
+			t.Errorf("rules[%d] = %s with priority %d, want %s with priority %d", i, rules[i].Id, rules[i].Priority, id, i+1)
+		}
+		got, _ := store.GetRule(ctx, id)
+		if got.Priority != int32(i+1) {
+			t.Errorf("stored priority of %s = %d, want %d", id, got.Priority, i+1)
+		}
+	}
+
+	// New rules can take the priorities freed at the end
+	if _, err := store.CreateRule(ctx, &routingv1.RoutingRule{Name: "Rule", Priority: 5, Enabled: true}); err != nil {
+		t.Errorf("CreateRule() after compaction error = %v", err)
+	}
+}
+
+func TestInMemoryStore_CompactPriorities_TenantIsolation(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	otherCtx := auth.WithTenant(ctx, "0b3e7c1d-4a5f-4e8b-9c2d-7f6a1b3e5d90")
+
+	if _, err := store.CreateRule(ctx, &routingv1.RoutingRule{Name: "Rule A", Priority: 10}); err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+	other, err := store.CreateRule(otherCtx, &routingv1.RoutingRule{Name: "Rule B", Priority: 30})
+	if err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	rules, err := store.CompactPriorities(ctx)
+	if err != nil {
+		t.Fatalf("CompactPriorities() error = %v", err)
+	}
+	if len(rules) != 1 || rules[0].Priority != 1 {
+		t.Errorf("expected only the tenant's rule to be compacted, got %v", rules)
+	}
+	if other.Priority != 30 {
+		t.Errorf("other tenant's rule priority = %d, want 30", other.Priority)
+	}
+}
+
+func TestPostgresStore_CompactPriorities(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	ruleColumns := []string{"id", "name", "description", "priority", "enabled", "forced_integration_keys",
+		"affected_regions", "affected_site_types", "stop_processing", "created_by", "created_at", "updated_at"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT id FROM routing_rules WHERE tenant_id = $1 FOR UPDATE")).
+		WithArgs(auth.DefaultTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`WITH ranked AS \(\s*SELECT id, ROW_NUMBER\(\) OVER \(ORDER BY priority ASC\) AS priority`).
+		WithArgs(auth.DefaultTenantID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE routing_rules SET priority = -priority WHERE tenant_id = $1 AND priority < 0")).
+		WithArgs(auth.DefaultTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM routing_rules WHERE tenant_id = $1 ORDER BY priority ASC")).
+		WithArgs(auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("rule-a").AddRow("rule-b"))
+	mock.ExpectCommit()
+	for i, id := range []string{"rule-a", "rule-b"} {
+		mock.ExpectQuery("FROM routing_rules WHERE id = \\$1 AND tenant_id = \\$2").
+			WithArgs(id, auth.DefaultTenantID).
+			WillReturnRows(sqlmock.NewRows(ruleColumns).
+				AddRow(id, "Rule", nil, i+1, true, nil, nil, nil, false, nil, now, now))
+		mock.ExpectQuery("FROM routing_conditions").WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"condition_type", "field", "operator", "value", "values", "cel_expression"}))
+		mock.ExpectQuery("FROM routing_actions").WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"action_type", "parameters"}))
+	}
+
+	rules, err := NewPostgresStore(db).CompactPriorities(context.Background())
+	if err != nil {
+		t.Fatalf("CompactPriorities() error = %v", err)
+	}
+	if len(rules) != 2 || rules[0].Id != "rule-a" || rules[0].Priority != 1 || rules[1].Priority != 2 {
+		t.Errorf("unexpected compacted rules: %v", rules)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_PurgeAuditLogs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	cutoff := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM routing_audit_logs WHERE tenant_id = $1 AND timestamp < $2")).
+		WithArgs(auth.DefaultTenantID, cutoff).
+		WillReturnResult(sqlmock.NewResult(0, 7))
+
+	deleted, err := NewPostgresStore(db).PurgeAuditLogs(context.Background(), cutoff)
+	if err != nil {
+		t.Fatalf("PurgeAuditLogs() error = %v", err)
+	}
+	if deleted != 7 {
+		t.Errorf("PurgeAuditLogs() deleted = %d, want 7", deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -2,6 +2,7 @@
 package schedule
 
 import (
+	"math"
 	"sort"
 	"time"
 
@@ -152,6 +153,124 @@ func (c *Calculator) ListUpcomingShifts(schedule *routingv1.Schedule, overrides
 	return shifts
 }
 
+// CoverageDepthEntry is the on-call coverage of a one-hour slot.
+type CoverageDepthEntry struct {
+	TimeSlot time.Time
+	// UserCount is the number of active rotation layers plus active overrides.
+	UserCount int
+	// UserIDs lists the on-call user of each active layer, followed by the user
+	// of each active override.
+	UserIDs []string
+}
+
+// GetCoverageDepth returns the coverage of each hour from from, truncated to the
+// hour, until until, so slots covered by a single person stand out.
+func (c *Calculator) GetCoverageDepth(schedule *routingv1.Schedule, overrides []*routingv1.ScheduleOverride, from, until time.Time) []*CoverageDepthEntry {
+	if schedule == nil {
+		return nil
+	}
+
+	loc := c.loadTimezone(schedule.Timezone)
+	var entries []*CoverageDepthEntry
+
+	for slot := from.Truncate(time.Hour); slot.Before(until); slot = slot.Add(time.Hour) {
+		entry := &CoverageDepthEntry{TimeSlot: slot}
+		localTime := slot.In(loc)
+
+		for _, rotation := range schedule.Rotations {
+			if !c.isRotationActive(rotation, localTime) {
+				continue
+			}
+			if userID, _, _ := c.calculateRotationOnCall(schedule.Id, rotation, slot, loc); userID != "" {
+				entry.UserIDs = append(entry.UserIDs, userID)
+			}
+		}
+
+		for _, override := range overrides {
+			if c.isOverrideActive(override, slot) {
+				entry.UserIDs = append(entry.UserIDs, override.UserId)
+			}
+		}
+
+		entry.UserCount = len(entry.UserIDs)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// LoadBalanceReport summarizes how evenly rotation shifts are spread across users.
+type LoadBalanceReport struct {
+	// UserShiftHours is the total rotation shift hours of each rotation member,
+	// including members without a shift in the range.
+	UserShiftHours map[string]float64
+	// UserDeviationPercent is how far each user's hours are from the mean, in
+	// percent of the mean; negative values are below the mean.
+	UserDeviationPercent map[string]float64
+	// MeanHours is the mean of UserShiftHours.
+	MeanHours float64
+	// MaxDeviationPercent is the largest absolute value of UserDeviationPercent.
+	MaxDeviationPercent float64
+}
+
+// AnalyzeLoadBalance totals the rotation shift hours of each user between from
+// and until, clipping shifts to the range, and reports their deviation from the
+// mean. Overrides are not counted, so the report reflects the rotation design.
+func (c *Calculator) AnalyzeLoadBalance(schedule *routingv1.Schedule, from, until time.Time) *LoadBalanceReport {
+	report := &LoadBalanceReport{
+		UserShiftHours:       make(map[string]float64),
+		UserDeviationPercent: make(map[string]float64),
+	}
+	if schedule == nil {
+		return report
+	}
+
+	loc := c.loadTimezone(schedule.Timezone)
+	for _, rotation := range schedule.Rotations {
+		for _, member := range rotation.Members {
+			if _, ok := report.UserShiftHours[member.UserId]; !ok {
+				report.UserShiftHours[member.UserId] = 0
+			}
+		}
+
+		for _, shift := range c.generateRotationShifts(schedule.Id, rotation, from, until, loc, "") {
+			start, end := shift.StartTime.AsTime(), shift.EndTime.AsTime()
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(until) {
+				end = until
+			}
+			if end.After(start) {
+				report.UserShiftHours[shift.UserId] += end.Sub(start).Hours()
+			}
+		}
+	}
+
+	if len(report.UserShiftHours) == 0 {
+		return report
+	}
+
+	var total float64
+	for _, hours := range report.UserShiftHours {
+		total += hours
+	}
+	report.MeanHours = total / float64(len(report.UserShiftHours))
+
+	for userID, hours := range report.UserShiftHours {
+		var deviation float64
+		if report.MeanHours > 0 {
+			deviation = (hours - report.MeanHours) / report.MeanHours * 100
+		}
+		report.UserDeviationPercent[userID] = deviation
+		if math.Abs(deviation) > report.MaxDeviationPercent {
+			report.MaxDeviationPercent = math.Abs(deviation)
+		}
+	}
+
+	return report
+}
+
 // calculateRotationOnCall calculates who is on-call for a specific rotation at a given time.
 func (c *Calculator) calculateRotationOnCall(scheduleID string, rotation *routingv1.Rotation, at time.Time, loc *time.Location) (string, *routingv1.Shift, time.Time) {
 	if len(rotation.Members) == 0 {
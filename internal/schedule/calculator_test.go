@@ -626,3 +626,173 @@ func TestCalculator_IsOverrideActive(t *testing.T) {
 		})
 	}
 }
+
+// twoLayerSchedule has a round-the-clock primary layer and a secondary layer
+// restricted to weekday business hours.
+func twoLayerSchedule() *routingv1.Schedule {
+	rotationStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &routingv1.Schedule{
+		Id:       "test-schedule",
+		Timezone: "UTC",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "primary",
+				Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				Layer:     1,
+				StartTime: timestamppb.New(rotationStart),
+				Members:   []*routingv1.RotationMember{{UserId: "primary-user", Position: 0}},
+			},
+			{
+				Id:        "secondary",
+				Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				Layer:     2,
+				StartTime: timestamppb.New(rotationStart),
+				Members:   []*routingv1.RotationMember{{UserId: "secondary-user", Position: 0}},
+				Restrictions: []*routingv1.TimeWindow{
+					{DaysOfWeek: []int32{1, 2, 3, 4, 5}, StartTime: "09:00", EndTime: "17:00"},
+				},
+			},
+		},
+	}
+}
+
+func TestCalculator_GetCoverageDepth(t *testing.T) {
+	calc := NewCalculator()
+
+	// Monday 07:30 to 10:00 UTC, truncated to the 07:00 slot
+	from := time.Date(2024, 1, 15, 7, 30, 0, 0, time.UTC)
+	until := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	entries := calc.GetCoverageDepth(twoLayerSchedule(), nil, from, until)
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 hourly entries, got %d", len(entries))
+	}
+
+	expected := []struct {
+		hour  int
+		count int
+	}{
+		{7, 1}, // secondary layer restricted
+		{8, 1},
+		{9, 2}, // both layers during business hours
+	}
+	for i, want := range expected {
+		entry := entries[i]
+		if entry.TimeSlot.Hour() != want.hour {
+			t.Errorf("entry %d: expected slot %02d:00, got %v", i, want.hour, entry.TimeSlot)
+		}
+		if entry.UserCount != want.count || len(entry.UserIDs) != want.count {
+			t.Errorf("slot %02d:00: expected depth %d, got %d (%v)", want.hour, want.count, entry.UserCount, entry.UserIDs)
+		}
+	}
+	if entries[2].UserIDs[0] != "primary-user" || entries[2].UserIDs[1] != "secondary-user" {
+		t.Errorf("expected primary and secondary users at 09:00, got %v", entries[2].UserIDs)
+	}
+}
+
+func TestCalculator_GetCoverageDepth_CountsOverrides(t *testing.T) {
+	calc := NewCalculator()
+
+	// Saturday, when only the primary layer is active
+	from := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	overrides := []*routingv1.ScheduleOverride{
+		{
+			Id:        "override-1",
+			UserId:    "override-user",
+			StartTime: timestamppb.New(from),
+			EndTime:   timestamppb.New(from.Add(time.Hour)),
+		},
+	}
+
+	entries := calc.GetCoverageDepth(twoLayerSchedule(), overrides, from, from.Add(2*time.Hour))
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 hourly entries, got %d", len(entries))
+	}
+	if entries[0].UserCount != 2 || entries[0].UserIDs[1] != "override-user" {
+		t.Errorf("expected override to add coverage at 12:00, got %d (%v)", entries[0].UserCount, entries[0].UserIDs)
+	}
+	if entries[1].UserCount != 1 {
+		t.Errorf("expected single coverage at 13:00, got %d (%v)", entries[1].UserCount, entries[1].UserIDs)
+	}
+}
+
+func TestCalculator_AnalyzeLoadBalance(t *testing.T) {
+	calc := NewCalculator()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := &routingv1.Schedule{
+		Id: "daily",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "daily",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(from),
+				Members: []*routingv1.RotationMember{
+					{UserId: "user-1", Position: 0},
+					{UserId: "user-2", Position: 1},
+					{UserId: "user-3", Position: 2},
+				},
+			},
+		},
+	}
+
+	report := calc.AnalyzeLoadBalance(schedule, from, from.Add(9*24*time.Hour))
+	if len(report.UserShiftHours) != 3 {
+		t.Fatalf("expected 3 users, got %v", report.UserShiftHours)
+	}
+	for userID, hours := range report.UserShiftHours {
+		if hours != 72 {
+			t.Errorf("%s: expected 72 hours, got %v", userID, hours)
+		}
+	}
+	if report.MeanHours != 72 {
+		t.Errorf("expected mean of 72 hours, got %v", report.MeanHours)
+	}
+	if report.MaxDeviationPercent != 0 {
+		t.Errorf("expected zero deviation, got %v", report.MaxDeviationPercent)
+	}
+}
+
+func TestCalculator_AnalyzeLoadBalance_Uneven(t *testing.T) {
+	calc := NewCalculator()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := &routingv1.Schedule{
+		Id: "daily",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "daily",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(from),
+				Members: []*routingv1.RotationMember{
+					{UserId: "user-1", Position: 0},
+					{UserId: "user-2", Position: 1},
+				},
+			},
+			{
+				// user-3 joined a separate rotation that only starts after the range
+				Id:        "late",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(from.Add(30 * 24 * time.Hour)),
+				Members:   []*routingv1.RotationMember{{UserId: "user-3", Position: 0}},
+			},
+		},
+	}
+
+	// Three days plus twelve hours: user-1 covers days 1 and 3, user-2 day 2 and half of day 4
+	report := calc.AnalyzeLoadBalance(schedule, from, from.Add(84*time.Hour))
+
+	expected := map[string]float64{"user-1": 48, "user-2": 36, "user-3": 0}
+	for userID, want := range expected {
+		if got := report.UserShiftHours[userID]; got != want {
+			t.Errorf("%s: expected %v hours, got %v", userID, want, got)
+		}
+	}
+	if report.MeanHours != 28 {
+		t.Errorf("expected mean of 28 hours, got %v", report.MeanHours)
+	}
+	if got := report.UserDeviationPercent["user-3"]; got != -100 {
+		t.Errorf("expected user-3 deviation of -100%%, got %v", got)
+	}
+	if report.MaxDeviationPercent != 100 {
+		t.Errorf("expected max deviation of 100%%, got %v", report.MaxDeviationPercent)
+	}
+}
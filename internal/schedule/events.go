@@ -0,0 +1,136 @@
+package schedule
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// EventType identifies the kind of schedule change.
+type EventType string
+
+const (
+	EventScheduleUpdated EventType = "schedule_updated"
+	EventRotationAdded   EventType = "rotation_added"
+	EventRotationUpdated EventType = "rotation_updated"
+	EventRotationRemoved EventType = "rotation_removed"
+	EventOverrideCreated EventType = "override_created"
+	EventOverrideDeleted EventType = "override_deleted"
+)
+
+// DefaultEventHistorySize is the number of events retained for reconnecting subscribers.
+const DefaultEventHistorySize = 256
+
+// eventSubscriberBuffer is the channel buffer for each subscriber.
+const eventSubscriberBuffer = 16
+
+// Event is a change published on the schedule event bus.
+type Event struct {
+	// ID is a monotonically increasing identifier used for Last-Event-ID resumption.
+	ID uint64
+	// Type is the kind of change.
+	Type EventType
+	// ScheduleID is the schedule the change applies to.
+	ScheduleID string
+	// Data is the changed resource (schedule or override).
+	Data proto.Message
+	// Timestamp is when the event was published.
+	Timestamp time.Time
+}
+
+// EventBus fans out schedule changes to subscribers.
+// A bounded history is kept so that subscribers can resume from a previous event ID.
+type EventBus struct {
+	mu          sync.Mutex
+	nextID      uint64
+	history     []Event
+	historySize int
+	subscribers map[string]map[chan Event]struct{}
+}
+
+// NewEventBus creates a new event bus retaining up to historySize events.
+func NewEventBus(historySize int) *EventBus {
+	if historySize <= 0 {
+		historySize = DefaultEventHistorySize
+	}
+	return &EventBus{
+		historySize: historySize,
+		subscribers: make(map[string]map[chan Event]struct{}),
+	}
+}
+
+// Publish records an event and delivers it to the schedule's subscribers.
+// Subscribers that are not keeping up miss the event rather than blocking the publisher.
+func (b *EventBus) Publish(scheduleID string, eventType EventType, data proto.Message) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	event := Event{
+		ID:         b.nextID,
+		Type:       eventType,
+		ScheduleID: scheduleID,
+		Data:       data,
+		Timestamp:  time.Now(),
+	}
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for ch := range b.subscribers[scheduleID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+
+	return event
+}
+
+// Subscribe registers a subscriber for a schedule's events.
+// Events for the schedule published after lastEventID that are still in the history
+// are returned for replay; pass 0 to skip replay. The returned function unsubscribes.
+func (b *EventBus) Subscribe(scheduleID string, lastEventID uint64) ([]Event, <-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var missed []Event
+	if lastEventID > 0 {
+		for _, event := range b.history {
+			if event.ID > lastEventID && event.ScheduleID == scheduleID {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	ch := make(chan Event, eventSubscriberBuffer)
+	if b.subscribers[scheduleID] == nil {
+		b.subscribers[scheduleID] = make(map[chan Event]struct{})
+	}
+	b.subscribers[scheduleID][ch] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers[scheduleID], ch)
+			if len(b.subscribers[scheduleID]) == 0 {
+				delete(b.subscribers, scheduleID)
+			}
+			close(ch)
+		})
+	}
+
+	return missed, ch, unsubscribe
+}
+
+// SubscriberCount returns the number of active subscribers for a schedule.
+func (b *EventBus) SubscriberCount(scheduleID string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers[scheduleID])
+}
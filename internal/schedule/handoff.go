@@ -0,0 +1,266 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/notification"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// HandoffOutgoingTemplate is the template used to notify the user going off-call.
+	HandoffOutgoingTemplate = "handoff_outgoing"
+	// HandoffIncomingTemplate is the template used to notify the user coming on-call.
+	HandoffIncomingTemplate = "handoff_incoming"
+
+	// DefaultHandoffCheckInterval is how often schedules are checked for upcoming handoffs.
+	DefaultHandoffCheckInterval = time.Minute
+
+	handoffListPageSize = 100
+)
+
+// HandoffNotifier notifies the outgoing and incoming users of a rotation ahead
+// of the handoff configured in ShiftConfig.HandoffTime. Schedules with a
+// HandoffReminderConfig are notified AdvanceNotice before the handoff on its
+// channel, others shortly before the handoff on the users' preferred channel.
+type HandoffNotifier struct {
+	store      Store
+	reminders  HandoffReminderStore
+	calculator *Calculator
+	notifier   notification.UserNotifier
+	metrics    *Metrics
+	logger     zerolog.Logger
+	interval   time.Duration
+	now        func() time.Time
+}
+
+// HandoffNotifierOption configures a HandoffNotifier.
+type HandoffNotifierOption func(*HandoffNotifier)
+
+// WithHandoffReminderStore records sent reminders in the given store instead
+// of in memory, so they survive restarts and are shared between replicas.
+func WithHandoffReminderStore(reminders HandoffReminderStore) HandoffNotifierOption {
+	return func(n *HandoffNotifier) {
+		n.reminders = reminders
+	}
+}
+
+// WithHandoffMetrics sets the metrics recorder for the notifier.
+func WithHandoffMetrics(metrics *Metrics) HandoffNotifierOption {
+	return func(n *HandoffNotifier) {
+		n.metrics = metrics
+	}
+}
+
+// NewHandoffNotifier creates a notifier that checks for upcoming handoffs every minute.
+func NewHandoffNotifier(store Store, notifier notification.UserNotifier, logger zerolog.Logger, opts ...HandoffNotifierOption) *HandoffNotifier {
+	n := &HandoffNotifier{
+		store:      store,
+		reminders:  NewInMemoryHandoffReminderStore(),
+		calculator: NewCalculator(),
+		notifier:   notifier,
+		metrics:    NewMetrics(),
+		logger:     logger.With().Str("component", "handoff_notifier").Logger(),
+		interval:   DefaultHandoffCheckInterval,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+// Metrics returns the metrics recorder for this notifier.
+func (n *HandoffNotifier) Metrics() *Metrics {
+	return n.metrics
+}
+
+// Run checks for upcoming handoffs every interval until the context is cancelled.
+func (n *HandoffNotifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := n.CheckHandoffs(ctx); err != nil {
+				n.logger.Error().Err(err).Msg("failed to check upcoming handoffs")
+			}
+		}
+	}
+}
+
+// CheckHandoffs notifies users of every rotation handoff that is due within
+// the schedule's advance notice, or within the next interval without one.
+func (n *HandoffNotifier) CheckHandoffs(ctx context.Context) error {
+	now := n.now()
+	// Handoffs in the past are never notified again
+	if _, err := n.reminders.PurgeReminders(ctx, now.Add(-n.interval)); err != nil {
+		n.logger.Warn().Err(err).Msg("failed to purge handoff reminders")
+	}
+
+	// Background checks have no tenant, so the schedules of each tenant are
+	// listed with a context of that tenant
+	tenants, err := n.store.ListTenants(ctx)
+	if err != nil {
+		return fmt.Errorf("list schedule tenants: %w", err)
+	}
+	for _, tenantID := range tenants {
+		if err := n.checkTenant(auth.WithTenant(ctx, tenantID), now); err != nil {
+			return fmt.Errorf("tenant %s: %w", tenantID, err)
+		}
+	}
+	return nil
+}
+
+// checkTenant notifies upcoming handoffs of the schedules of the tenant of ctx.
+func (n *HandoffNotifier) checkTenant(ctx context.Context, now time.Time) error {
+	pageToken := ""
+	for {
+		resp, err := n.store.ListSchedules(ctx, &routingv1.ListSchedulesRequest{
+			PageSize:  handoffListPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("list schedules: %w", err)
+		}
+
+		for _, schedule := range resp.Schedules {
+			n.checkSchedule(ctx, schedule, now)
+		}
+
+		if resp.NextPageToken == "" {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// checkSchedule notifies users of the schedule's rotations with an upcoming handoff.
+func (n *HandoffNotifier) checkSchedule(ctx context.Context, schedule *routingv1.Schedule, now time.Time) {
+	loc := n.calculator.loadTimezone(schedule.Timezone)
+
+	notice := n.interval
+	channel := routingv1.ChannelType_CHANNEL_TYPE_UNSPECIFIED
+	if reminder := schedule.HandoffReminder; reminder != nil {
+		if reminder.AdvanceNotice != nil && reminder.AdvanceNotice.AsDuration() > notice {
+			notice = reminder.AdvanceNotice.AsDuration()
+		}
+		channel = reminder.Channel
+	}
+
+	for _, rotation := range schedule.Rotations {
+		handoffAt, ok := n.upcomingHandoff(rotation, now, notice, loc)
+		if !ok {
+			continue
+		}
+
+		first, err := n.reminders.MarkReminderSent(ctx, schedule.Id, rotation.Id, handoffAt)
+		if err != nil {
+			n.logger.Error().
+				Err(err).
+				Str("scheduleId", schedule.Id).
+				Str("rotationId", rotation.Id).
+				Msg("failed to record handoff reminder")
+			continue
+		}
+		if !first {
+			continue
+		}
+
+		outgoing, _, _ := n.calculator.calculateRotationOnCall(schedule.Id, rotation, handoffAt.Add(-time.Second), loc)
+		incoming, _, _ := n.calculator.calculateRotationOnCall(schedule.Id, rotation, handoffAt, loc)
+		if outgoing == incoming {
+			continue
+		}
+
+		alert := handoffAlert(schedule, rotation, handoffAt, outgoing, incoming)
+		if outgoing != "" {
+			n.notify(ctx, outgoing, HandoffRoleOutgoing, HandoffOutgoingTemplate, channel, alert)
+		}
+		if incoming != "" {
+			n.notify(ctx, incoming, HandoffRoleIncoming, HandoffIncomingTemplate, channel, alert)
+		}
+	}
+}
+
+// upcomingHandoff returns the rotation's next handoff if it falls within notice of now.
+func (n *HandoffNotifier) upcomingHandoff(rotation *routingv1.Rotation, now time.Time, notice time.Duration, loc *time.Location) (time.Time, bool) {
+	if rotation.ShiftConfig == nil || rotation.ShiftConfig.HandoffTime == "" || len(rotation.Members) == 0 {
+		return time.Time{}, false
+	}
+
+	clock, err := time.Parse("15:04", rotation.ShiftConfig.HandoffTime)
+	if err != nil {
+		n.logger.Warn().
+			Str("rotationId", rotation.Id).
+			Str("handoffTime", rotation.ShiftConfig.HandoffTime).
+			Msg("invalid handoff time, expected HH:MM")
+		return time.Time{}, false
+	}
+
+	local := now.In(loc)
+	handoffAt := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	if handoffAt.Before(now) {
+		handoffAt = handoffAt.AddDate(0, 0, 1)
+	}
+
+	// An advance notice longer than a day can reach past the next day's handoff
+	// when it is not on one of the handoff days
+	for ; !handoffAt.After(now.Add(notice)); handoffAt = handoffAt.AddDate(0, 0, 1) {
+		if isHandoffDay(rotation.ShiftConfig.HandoffDays, handoffAt.Weekday()) {
+			return handoffAt, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// isHandoffDay reports whether handoffs happen on the weekday; no days means every day.
+func isHandoffDay(days []int32, weekday time.Weekday) bool {
+	if len(days) == 0 {
+		return true
+	}
+	for _, day := range days {
+		if day == int32(weekday) {
+			return true
+		}
+	}
+	return false
+}
+
+// notify sends a handoff notification to a single user, logging failures.
+func (n *HandoffNotifier) notify(ctx context.Context, userID, role, templateID string, channel routingv1.ChannelType, alert *routingv1.Alert) {
+	err := n.notifier.NotifyUser(ctx, userID, templateID, channel, alert)
+	if err != nil {
+		n.logger.Error().
+			Err(err).
+			Str("userId", userID).
+			Str("templateId", templateID).
+			Str("scheduleId", alert.Labels["schedule_id"]).
+			Msg("failed to send handoff notification")
+		return
+	}
+	n.metrics.RecordHandoffReminderSent(role)
+}
+
+// handoffAlert builds the alert payload rendered by the handoff templates.
+func handoffAlert(schedule *routingv1.Schedule, rotation *routingv1.Rotation, handoffAt time.Time, outgoing, incoming string) *routingv1.Alert {
+	return &routingv1.Alert{
+		Summary: fmt.Sprintf("On-call handoff for %s (%s) at %s", schedule.Name, rotation.Name, handoffAt.Format(time.RFC3339)),
+		Labels: map[string]string{
+			"schedule_id":   schedule.Id,
+			"rotation_id":   rotation.Id,
+			"handoff_time":  handoffAt.Format(time.RFC3339),
+			"outgoing_user": outgoing,
+			"incoming_user": incoming,
+		},
+	}
+}
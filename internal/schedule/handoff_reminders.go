@@ -0,0 +1,104 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HandoffReminderStore records the handoffs reminders were sent for, so every
+// handoff is announced once even across restarts and multiple replicas.
+type HandoffReminderStore interface {
+	// MarkReminderSent records the reminder for a rotation handoff. It returns
+	// false if the reminder was already recorded.
+	MarkReminderSent(ctx context.Context, scheduleID, rotationID string, handoffAt time.Time) (bool, error)
+	// PurgeReminders deletes reminders for handoffs before the cutoff and
+	// returns the number deleted.
+	PurgeReminders(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PostgresHandoffReminderStore implements HandoffReminderStore using the
+// handoff_reminders table.
+type PostgresHandoffReminderStore struct {
+	db *sql.DB
+}
+
+// NewPostgresHandoffReminderStore creates a new PostgresHandoffReminderStore.
+func NewPostgresHandoffReminderStore(db *sql.DB) *PostgresHandoffReminderStore {
+	return &PostgresHandoffReminderStore{db: db}
+}
+
+// MarkReminderSent inserts the reminder, relying on the primary key to detect duplicates.
+func (s *PostgresHandoffReminderStore) MarkReminderSent(ctx context.Context, scheduleID, rotationID string, handoffAt time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO handoff_reminders (schedule_id, rotation_id, handoff_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (schedule_id, rotation_id, handoff_at) DO NOTHING
+	`, scheduleID, rotationID, handoffAt)
+	if err != nil {
+		return false, fmt.Errorf("insert handoff reminder: %w", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("insert handoff reminder: %w", err)
+	}
+	return inserted > 0, nil
+}
+
+// PurgeReminders deletes reminders for handoffs before the cutoff.
+func (s *PostgresHandoffReminderStore) PurgeReminders(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM handoff_reminders WHERE handoff_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge handoff reminders: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// Ensure PostgresHandoffReminderStore implements HandoffReminderStore
+var _ HandoffReminderStore = (*PostgresHandoffReminderStore)(nil)
+
+// InMemoryHandoffReminderStore implements HandoffReminderStore in memory.
+type InMemoryHandoffReminderStore struct {
+	mu sync.Mutex
+	// sent maps schedule, rotation and handoff time to the handoff time.
+	sent map[string]time.Time
+}
+
+// NewInMemoryHandoffReminderStore creates a new InMemoryHandoffReminderStore.
+func NewInMemoryHandoffReminderStore() *InMemoryHandoffReminderStore {
+	return &InMemoryHandoffReminderStore{sent: make(map[string]time.Time)}
+}
+
+// MarkReminderSent records the reminder unless it is already recorded.
+func (s *InMemoryHandoffReminderStore) MarkReminderSent(ctx context.Context, scheduleID, rotationID string, handoffAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%s/%d", scheduleID, rotationID, handoffAt.Unix())
+	if _, ok := s.sent[key]; ok {
+		return false, nil
+	}
+	s.sent[key] = handoffAt
+	return true, nil
+}
+
+// PurgeReminders forgets reminders for handoffs before the cutoff.
+func (s *InMemoryHandoffReminderStore) PurgeReminders(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	for key, handoffAt := range s.sent {
+		if handoffAt.Before(before) {
+			delete(s.sent, key)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Ensure InMemoryHandoffReminderStore implements HandoffReminderStore
+var _ HandoffReminderStore = (*InMemoryHandoffReminderStore)(nil)
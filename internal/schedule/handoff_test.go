@@ -0,0 +1,263 @@
+package schedule
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+type handoffNotification struct {
+	userID     string
+	templateID string
+	channel    routingv1.ChannelType
+}
+
+// mockUserNotifier records NotifyUser calls.
+type mockUserNotifier struct {
+	mu    sync.Mutex
+	calls []handoffNotification
+}
+
+func (m *mockUserNotifier) NotifyUser(ctx context.Context, userID string, templateID string, channelOverride routingv1.ChannelType, alert *routingv1.Alert) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, handoffNotification{userID: userID, templateID: templateID, channel: channelOverride})
+	return nil
+}
+
+func setupHandoffTest(t *testing.T, handoffTime string, now time.Time, opts ...HandoffNotifierOption) (*HandoffNotifier, *mockUserNotifier) {
+	t.Helper()
+	return setupHandoffReminderTest(t, handoffTime, nil, now, opts...)
+}
+
+func setupHandoffReminderTest(t *testing.T, handoffTime string, reminder *routingv1.HandoffReminderConfig, now time.Time, opts ...HandoffNotifierOption) (*HandoffNotifier, *mockUserNotifier) {
+	t.Helper()
+
+	store := NewInMemoryStore()
+	_, err := store.CreateSchedule(context.Background(), &routingv1.Schedule{
+		Id:              "schedule-1",
+		Name:            "Primary",
+		Timezone:        "UTC",
+		HandoffReminder: reminder,
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "rotation-1",
+				Name:      "Daily",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Members: []*routingv1.RotationMember{
+					{UserId: "alice", Position: 0},
+					{UserId: "bob", Position: 1},
+				},
+				ShiftConfig: &routingv1.ShiftConfig{HandoffTime: handoffTime},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	notifier := &mockUserNotifier{}
+	handoff := NewHandoffNotifier(store, notifier, zerolog.Nop(), opts...)
+	handoff.now = func() time.Time { return now }
+
+	return handoff, notifier
+}
+
+func TestHandoffNotifier_NotifiesBeforeHandoff(t *testing.T) {
+	// One minute before the 09:00 handoff on the second day: alice hands off to bob
+	now := time.Date(2026, 1, 2, 8, 59, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffTest(t, "09:00", now)
+
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+
+	if len(notifier.calls) != 2 {
+		t.Fatalf("expected 2 notifications, got %d: %v", len(notifier.calls), notifier.calls)
+	}
+	if notifier.calls[0] != (handoffNotification{userID: "alice", templateID: HandoffOutgoingTemplate}) {
+		t.Errorf("expected outgoing notification for alice, got %v", notifier.calls[0])
+	}
+	if notifier.calls[1] != (handoffNotification{userID: "bob", templateID: HandoffIncomingTemplate}) {
+		t.Errorf("expected incoming notification for bob, got %v", notifier.calls[1])
+	}
+}
+
+func TestHandoffNotifier_NotifiesEveryTenant(t *testing.T) {
+	now := time.Date(2026, 1, 2, 8, 59, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffTest(t, "09:00", now)
+
+	tenantCtx := auth.WithTenant(context.Background(), "6f1c2a52-8d3e-4b7a-9f10-2c5e8a7b4d31")
+	_, err := handoff.store.CreateSchedule(tenantCtx, &routingv1.Schedule{
+		Id:       "schedule-2",
+		Name:     "Tenant",
+		Timezone: "UTC",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "rotation-2",
+				Name:      "Daily",
+				Type:      routingv1.RotationType_ROTATION_TYPE_DAILY,
+				StartTime: timestamppb.New(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Members: []*routingv1.RotationMember{
+					{UserId: "carol", Position: 0},
+					{UserId: "dave", Position: 1},
+				},
+				ShiftConfig: &routingv1.ShiftConfig{HandoffTime: "09:00"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+
+	users := make(map[string]bool)
+	for _, call := range notifier.calls {
+		users[call.userID] = true
+	}
+	for _, user := range []string{"alice", "bob", "carol", "dave"} {
+		if !users[user] {
+			t.Errorf("expected handoff notification for %s, got %v", user, notifier.calls)
+		}
+	}
+}
+
+func TestHandoffNotifier_NoDuplicates(t *testing.T) {
+	now := time.Date(2026, 1, 2, 8, 59, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffTest(t, "09:00", now)
+
+	// Checks at T-1 minute and at the handoff itself both see the same handoff
+	_ = handoff.CheckHandoffs(context.Background())
+	_ = handoff.CheckHandoffs(context.Background())
+	handoff.now = func() time.Time { return now.Add(time.Minute) }
+	_ = handoff.CheckHandoffs(context.Background())
+
+	if len(notifier.calls) != 2 {
+		t.Errorf("expected 2 notifications without duplicates, got %d", len(notifier.calls))
+	}
+}
+
+func TestHandoffNotifier_NoUpcomingHandoff(t *testing.T) {
+	now := time.Date(2026, 1, 2, 8, 30, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffTest(t, "09:00", now)
+
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+
+	if len(notifier.calls) != 0 {
+		t.Errorf("expected no notifications 30 minutes before handoff, got %d", len(notifier.calls))
+	}
+}
+
+func TestHandoffNotifier_InvalidHandoffTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 8, 59, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffTest(t, "9am", now)
+
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+
+	if len(notifier.calls) != 0 {
+		t.Errorf("expected no notifications for invalid handoff time, got %d", len(notifier.calls))
+	}
+}
+
+func TestHandoffNotifier_AdvanceNotice(t *testing.T) {
+	reminder := &routingv1.HandoffReminderConfig{
+		AdvanceNotice: durationpb.New(time.Hour),
+		Channel:       routingv1.ChannelType_CHANNEL_TYPE_SLACK,
+	}
+
+	// 61 minutes before the handoff is outside the advance notice
+	now := time.Date(2026, 1, 2, 7, 59, 0, 0, time.UTC)
+	handoff, notifier := setupHandoffReminderTest(t, "09:00", reminder, now)
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+	if len(notifier.calls) != 0 {
+		t.Fatalf("expected no notifications before the advance notice, got %d", len(notifier.calls))
+	}
+
+	handoff.now = func() time.Time { return now.Add(time.Minute) }
+	if err := handoff.CheckHandoffs(context.Background()); err != nil {
+		t.Fatalf("CheckHandoffs failed: %v", err)
+	}
+	expected := []handoffNotification{
+		{userID: "alice", templateID: HandoffOutgoingTemplate, channel: routingv1.ChannelType_CHANNEL_TYPE_SLACK},
+		{userID: "bob", templateID: HandoffIncomingTemplate, channel: routingv1.ChannelType_CHANNEL_TYPE_SLACK},
+	}
+	if len(notifier.calls) != len(expected) {
+		t.Fatalf("expected %d notifications, got %d: %v", len(expected), len(notifier.calls), notifier.calls)
+	}
+	for i, want := range expected {
+		if notifier.calls[i] != want {
+			t.Errorf("notification %d: expected %v, got %v", i, want, notifier.calls[i])
+		}
+	}
+
+	metrics := handoff.Metrics()
+	if metrics.HandoffRemindersSentTotal(HandoffRoleOutgoing) != 1 || metrics.HandoffRemindersSentTotal(HandoffRoleIncoming) != 1 {
+		t.Errorf("expected one reminder per role, got outgoing=%d incoming=%d",
+			metrics.HandoffRemindersSentTotal(HandoffRoleOutgoing), metrics.HandoffRemindersSentTotal(HandoffRoleIncoming))
+	}
+}
+
+func TestHandoffNotifier_SharedReminderStore(t *testing.T) {
+	// Two replicas sharing a reminder store announce the handoff once
+	now := time.Date(2026, 1, 2, 8, 59, 0, 0, time.UTC)
+	reminders := NewInMemoryHandoffReminderStore()
+	first, firstNotifier := setupHandoffTest(t, "09:00", now, WithHandoffReminderStore(reminders))
+	second, secondNotifier := setupHandoffTest(t, "09:00", now, WithHandoffReminderStore(reminders))
+
+	_ = first.CheckHandoffs(context.Background())
+	_ = second.CheckHandoffs(context.Background())
+
+	if len(firstNotifier.calls) != 2 || len(secondNotifier.calls) != 0 {
+		t.Errorf("expected only the first replica to notify, got %d and %d", len(firstNotifier.calls), len(secondNotifier.calls))
+	}
+}
+
+func TestPostgresHandoffReminderStore_MarkReminderSent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	reminders := NewPostgresHandoffReminderStore(db)
+	handoffAt := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO handoff_reminders")).
+		WithArgs("schedule-1", "rotation-1", handoffAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO handoff_reminders")).
+		WithArgs("schedule-1", "rotation-1", handoffAt).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	sent, err := reminders.MarkReminderSent(context.Background(), "schedule-1", "rotation-1", handoffAt)
+	if err != nil || !sent {
+		t.Errorf("expected first reminder to be recorded, got %v (err %v)", sent, err)
+	}
+	sent, err = reminders.MarkReminderSent(context.Background(), "schedule-1", "rotation-1", handoffAt)
+	if err != nil || sent {
+		t.Errorf("expected duplicate reminder to be rejected, got %v (err %v)", sent, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
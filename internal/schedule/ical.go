@@ -0,0 +1,184 @@
+package schedule
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultICalWindow is how far ahead shifts are exported to calendar feeds.
+	DefaultICalWindow = 90 * 24 * time.Hour
+
+	// icalTimeFormat is the RFC 5545 UTC date-time format.
+	icalTimeFormat = "20060102T150405Z"
+	// icalLineLimit is the maximum length of a content line in octets, excluding the CRLF.
+	icalLineLimit = 75
+)
+
+// ICalHandler serves a user's upcoming shifts as an RFC 5545 calendar feed so
+// they can subscribe to them from Google Calendar or Outlook.
+type ICalHandler struct {
+	store      Store
+	calculator *Calculator
+	signingKey []byte
+	window     time.Duration
+	now        func() time.Time
+	logger     zerolog.Logger
+}
+
+// NewICalHandler creates a new calendar feed handler. Feed tokens are verified
+// against signingKey; with an empty key every request is rejected.
+func NewICalHandler(store Store, signingKey []byte, logger zerolog.Logger) *ICalHandler {
+	return &ICalHandler{
+		store:      store,
+		calculator: NewCalculator(),
+		signingKey: signingKey,
+		window:     DefaultICalWindow,
+		now:        time.Now,
+		logger:     logger.With().Str("component", "schedule_ical").Logger(),
+	}
+}
+
+// RegisterRoutes registers the calendar feed routes on the provided router group.
+func (h *ICalHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/schedules/:id/ical", h.ExportICal)
+}
+
+// ExportICal handles GET /schedules/:id/ical?user_id=<uid>&token=<tok>.
+// Calendar clients cannot send headers, so the feed token is passed in the query.
+func (h *ICalHandler) ExportICal(c *gin.Context) {
+	scheduleID := c.Param("id")
+	userID := c.Query("user_id")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id is required"})
+		return
+	}
+
+	now := h.now()
+	claims, err := VerifyICalToken(h.signingKey, c.Query("token"), userID, scheduleID, now)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("schedule_id", scheduleID).Str("user_id", userID).Msg("rejected ical feed request")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		return
+	}
+
+	// Feed requests are unauthenticated, so the schedule is read from the
+	// tenant the token was issued for
+	tenantID := claims.TenantID
+	if tenantID == "" {
+		tenantID = auth.DefaultTenantID
+	}
+	ctx := auth.WithTenant(c.Request.Context(), tenantID)
+	sched, err := h.store.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+			return
+		}
+		h.logger.Error().Err(err).Str("schedule_id", scheduleID).Msg("failed to get schedule")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get schedule"})
+		return
+	}
+
+	until := now.Add(h.window)
+	overrides, err := h.store.ListOverrides(ctx, scheduleID, timestamppb.New(now), timestamppb.New(until), 100, "")
+	if err != nil {
+		h.logger.Warn().Err(err).Msg("failed to get overrides, continuing without")
+		overrides = &routingv1.ListOverridesResponse{}
+	}
+	shifts := h.calculator.ListUpcomingShifts(sched, overrides.Overrides, now, until, userID)
+
+	c.Header("Content-Disposition", `attachment; filename="`+scheduleID+`.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(RenderICal(sched, shifts, now)))
+}
+
+// RenderICal renders shifts of a schedule as an RFC 5545 calendar with one
+// VEVENT per shift. now is used as the DTSTAMP of every event.
+func RenderICal(sched *routingv1.Schedule, shifts []*routingv1.Shift, now time.Time) string {
+	rotationNames := make(map[string]string, len(sched.Rotations))
+	for _, rotation := range sched.Rotations {
+		rotationNames[rotation.Id] = rotation.Name
+	}
+
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//kneutral//alerting-system//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	writeICalLine(&b, "X-WR-CALNAME:"+escapeICalText(sched.Name+" on-call"))
+
+	for _, shift := range shifts {
+		rotationName := rotationNames[shift.RotationId]
+		if shift.Type == routingv1.ShiftType_SHIFT_TYPE_OVERRIDE {
+			rotationName = "Override"
+		}
+		description := sched.Name
+		if rotationName != "" {
+			description += " - " + rotationName
+		}
+
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+shiftFingerprint(shift)+"@alerting-system")
+		writeICalLine(&b, "DTSTAMP:"+now.UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "DTSTART:"+shift.StartTime.AsTime().UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "DTEND:"+shift.EndTime.AsTime().UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "SUMMARY:"+escapeICalText("On-call: "+sched.Name))
+		writeICalLine(&b, "DESCRIPTION:"+escapeICalText(description))
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// shiftFingerprint identifies a shift by its schedule, rotation, user and time
+// range. Shift IDs are generated on every calculation, so they cannot be used
+// as a UID that calendar clients track between refreshes.
+func shiftFingerprint(shift *routingv1.Shift) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		shift.ScheduleId,
+		shift.RotationId,
+		shift.UserId,
+		shift.Type.String(),
+		shift.StartTime.AsTime().UTC().Format(time.RFC3339),
+		shift.EndTime.AsTime().UTC().Format(time.RFC3339),
+	}, "|")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// escapeICalText escapes a TEXT property value.
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICalLine writes a content line terminated by CRLF, folding it into
+// continuation lines of at most icalLineLimit octets without splitting UTF-8
+// characters.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space, which counts towards the limit
+		limit = icalLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,240 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+var icalTestKey = []byte("test-signing-key")
+
+func setupICalTest(t *testing.T) (*gin.Engine, time.Time) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	now := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	store := NewInMemoryStore()
+	_, err := store.CreateSchedule(context.Background(), &routingv1.Schedule{
+		Id:   "primary",
+		Name: "Platform, Primary",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:        "weekly",
+				Name:      "Weekly",
+				Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				StartTime: timestamppb.New(now),
+				Members: []*routingv1.RotationMember{
+					{UserId: "user-1", Position: 0},
+					{UserId: "user-2", Position: 1},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	handler := NewICalHandler(store, icalTestKey, zerolog.Nop())
+	handler.now = func() time.Time { return now }
+
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	return router, now
+}
+
+func signTestICalToken(t *testing.T, claims ICalClaims) string {
+	t.Helper()
+	token, err := SignICalToken(icalTestKey, claims)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestICalHandler_ExportICal(t *testing.T) {
+	router, _ := setupICalTest(t)
+	token := signTestICalToken(t, ICalClaims{Subject: "user-1", ScheduleID: "primary"})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/schedules/primary/ical?user_id=user-1&token="+token, nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/calendar") {
+		t.Errorf("expected text/calendar content type, got %q", ct)
+	}
+
+	body := w.Body.String()
+	if !strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(body, "END:VCALENDAR\r\n") {
+		t.Errorf("expected a CRLF delimited VCALENDAR, got:\n%s", body)
+	}
+
+	// user-1 is on call every other week of the 90 day window
+	if got := strings.Count(body, "BEGIN:VEVENT"); got != 7 {
+		t.Errorf("expected 7 events, got %d", got)
+	}
+	for _, want := range []string{
+		"DTSTART:20260302T000000Z\r\n",
+		"DTEND:20260309T000000Z\r\n",
+		"DTSTART:20260316T000000Z\r\n",
+		"SUMMARY:On-call: Platform\\, Primary\r\n",
+		"DESCRIPTION:Platform\\, Primary - Weekly\r\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected feed to contain %q", want)
+		}
+	}
+	if strings.Contains(body, "DTSTART:20260309T000000Z") {
+		t.Error("expected shifts of other users to be filtered out")
+	}
+
+	// UIDs must be stable between refreshes
+	w2 := httptest.NewRecorder()
+	router.ServeHTTP(w2, httptest.NewRequest(http.MethodGet, "/api/v1/schedules/primary/ical?user_id=user-1&token="+token, nil))
+	if w2.Body.String() != body {
+		t.Error("expected identical feeds for identical requests")
+	}
+}
+
+func TestICalHandler_ExportICal_Errors(t *testing.T) {
+	router, now := setupICalTest(t)
+
+	tests := []struct {
+		name  string
+		path  string
+		token string
+		code  int
+	}{
+		{"missing user", "/api/v1/schedules/primary/ical", signTestICalToken(t, ICalClaims{Subject: "user-1"}), http.StatusBadRequest},
+		{"missing token", "/api/v1/schedules/primary/ical?user_id=user-1", "", http.StatusUnauthorized},
+		{"other user", "/api/v1/schedules/primary/ical?user_id=user-2", signTestICalToken(t, ICalClaims{Subject: "user-1"}), http.StatusUnauthorized},
+		{"other schedule", "/api/v1/schedules/primary/ical?user_id=user-1", signTestICalToken(t, ICalClaims{Subject: "user-1", ScheduleID: "secondary"}), http.StatusUnauthorized},
+		{"expired", "/api/v1/schedules/primary/ical?user_id=user-1", signTestICalToken(t, ICalClaims{Subject: "user-1", ExpiresAt: now.Unix()}), http.StatusUnauthorized},
+		{"unknown schedule", "/api/v1/schedules/missing/ical?user_id=user-1", signTestICalToken(t, ICalClaims{Subject: "user-1"}), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if tt.token != "" {
+				sep := "?"
+				if strings.Contains(path, "?") {
+					sep = "&"
+				}
+				path += sep + "token=" + tt.token
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+			if w.Code != tt.code {
+				t.Errorf("expected %d, got %d: %s", tt.code, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+// tenantRecordingStore records the tenant schedules are read from.
+type tenantRecordingStore struct {
+	Store
+	tenantID string
+}
+
+func (s *tenantRecordingStore) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.tenantID = tenantID
+	return s.Store.GetSchedule(ctx, id)
+}
+
+func TestICalHandler_ExportICal_TenantFromToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const tenantID = "6f1c2a8e-3b4d-4e5f-8a9b-0c1d2e3f4a5b"
+
+	tests := []struct {
+		name   string
+		claims ICalClaims
+		want   string
+	}{
+		{"token tenant", ICalClaims{Subject: "user-1", TenantID: tenantID}, tenantID},
+		{"default tenant", ICalClaims{Subject: "user-1"}, auth.DefaultTenantID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &tenantRecordingStore{Store: NewInMemoryStore()}
+			router := gin.New()
+			NewICalHandler(store, icalTestKey, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/schedules/primary/ical?user_id=user-1&token="+signTestICalToken(t, tt.claims), nil))
+			if w.Code != http.StatusNotFound {
+				t.Fatalf("expected 404 for an empty store, got %d: %s", w.Code, w.Body.String())
+			}
+			if store.tenantID != tt.want {
+				t.Errorf("expected schedule to be read from tenant %q, got %q", tt.want, store.tenantID)
+			}
+		})
+	}
+}
+
+func TestVerifyICalToken(t *testing.T) {
+	now := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	token := signTestICalToken(t, ICalClaims{Subject: "user-1", ExpiresAt: now.Add(time.Hour).Unix()})
+
+	claims, err := VerifyICalToken(icalTestKey, token, "user-1", "primary", now)
+	if err != nil {
+		t.Errorf("expected valid token, got %v", err)
+	} else if claims.Subject != "user-1" {
+		t.Errorf("expected claims of user-1, got %+v", claims)
+	}
+	if _, err := VerifyICalToken([]byte("other-key"), token, "user-1", "primary", now); !errors.Is(err, ErrInvalidICalToken) {
+		t.Errorf("expected ErrInvalidICalToken for another key, got %v", err)
+	}
+	if _, err := VerifyICalToken(nil, token, "user-1", "primary", now); !errors.Is(err, ErrInvalidICalToken) {
+		t.Errorf("expected ErrInvalidICalToken without a signing key, got %v", err)
+	}
+
+	// Claims of another user with the signature of this token
+	parts := strings.Split(token, ".")
+	other := strings.Split(signTestICalToken(t, ICalClaims{Subject: "user-2"}), ".")
+	tampered := parts[0] + "." + other[1] + "." + parts[2]
+	if _, err := VerifyICalToken(icalTestKey, tampered, "user-2", "primary", now); !errors.Is(err, ErrInvalidICalToken) {
+		t.Errorf("expected ErrInvalidICalToken for a tampered token, got %v", err)
+	}
+	if _, err := VerifyICalToken(icalTestKey, "not-a-token", "user-1", "primary", now); !errors.Is(err, ErrInvalidICalToken) {
+		t.Errorf("expected ErrInvalidICalToken for a malformed token, got %v", err)
+	}
+}
+
+func TestRenderICal_FoldsLongLines(t *testing.T) {
+	now := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	sched := &routingv1.Schedule{Id: "s", Name: strings.Repeat("Ünïcödé ", 20)}
+	shifts := []*routingv1.Shift{{
+		ScheduleId: "s",
+		UserId:     "user-1",
+		StartTime:  timestamppb.New(now),
+		EndTime:    timestamppb.New(now.Add(time.Hour)),
+	}}
+
+	for _, line := range strings.Split(strings.TrimSuffix(RenderICal(sched, shifts, now), "\r\n"), "\r\n") {
+		if len(line) > icalLineLimit {
+			t.Errorf("line exceeds %d octets: %q", icalLineLimit, line)
+		}
+		if !utf8.ValidString(strings.TrimPrefix(line, " ")) {
+			t.Errorf("line splits a UTF-8 character: %q", line)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidICalToken is returned when a calendar feed token is malformed,
+// badly signed, expired or issued for another user or schedule.
+var ErrInvalidICalToken = errors.New("invalid ical token")
+
+// icalTokenHeader is the JOSE header of every calendar feed token.
+var icalTokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// ICalClaims are the claims of a calendar feed token. Feed tokens are HS256
+// JWTs signed with the service's signing key.
+type ICalClaims struct {
+	// Subject is the user whose shifts the token grants access to.
+	Subject string `json:"sub"`
+	// ScheduleID restricts the token to one schedule; empty allows every schedule.
+	ScheduleID string `json:"schedule_id,omitempty"`
+	// TenantID is the tenant the schedules are read from; empty selects
+	// auth.DefaultTenantID.
+	TenantID string `json:"tenant_id,omitempty"`
+	// ExpiresAt is the Unix time the token expires; zero never expires, since
+	// calendar clients keep polling a subscription URL indefinitely.
+	ExpiresAt int64 `json:"exp,omitempty"`
+}
+
+// SignICalToken creates a calendar feed token for the claims.
+func SignICalToken(signingKey []byte, claims ICalClaims) (string, error) {
+	if len(signingKey) == 0 {
+		return "", errors.New("signing key is required")
+	}
+	if claims.Subject == "" {
+		return "", errors.New("subject is required")
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal ical claims: %w", err)
+	}
+
+	signed := icalTokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signICalToken(signingKey, signed)), nil
+}
+
+// VerifyICalToken checks the token signature and expiry and that it was issued
+// for the user and schedule, and returns its claims.
+func VerifyICalToken(signingKey []byte, token, userID, scheduleID string, now time.Time) (*ICalClaims, error) {
+	if len(signingKey) == 0 {
+		return nil, fmt.Errorf("%w: no signing key configured", ErrInvalidICalToken)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidICalToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, signICalToken(signingKey, parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidICalToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeICalTokenSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm", ErrInvalidICalToken)
+	}
+
+	var claims ICalClaims
+	if err := decodeICalTokenSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidICalToken)
+	}
+	if claims.ExpiresAt != 0 && !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, fmt.Errorf("%w: expired", ErrInvalidICalToken)
+	}
+	if claims.Subject != userID {
+		return nil, fmt.Errorf("%w: issued for another user", ErrInvalidICalToken)
+	}
+	if claims.ScheduleID != "" && claims.ScheduleID != scheduleID {
+		return nil, fmt.Errorf("%w: issued for another schedule", ErrInvalidICalToken)
+	}
+
+	return &claims, nil
+}
+
+// signICalToken returns the HS256 signature of the signed token segments.
+func signICalToken(signingKey []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// decodeICalTokenSegment decodes a base64url JSON token segment into v.
+func decodeICalTokenSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,48 @@
+package schedule
+
+import (
+	"sync"
+)
+
+// Handoff reminder recipient roles.
+const (
+	HandoffRoleOutgoing = "outgoing"
+	HandoffRoleIncoming = "incoming"
+)
+
+// Metrics tracks schedule metrics.
+// Exposed as the handoff_reminders_sent_total{role} counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// handoffRemindersSent counts delivered handoff reminders by recipient role.
+	handoffRemindersSent map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		handoffRemindersSent: make(map[string]int64),
+	}
+}
+
+// RecordHandoffReminderSent increments the handoff reminders counter for a role.
+func (m *Metrics) RecordHandoffReminderSent(role string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handoffRemindersSent[role]++
+}
+
+// HandoffRemindersSentTotal returns the number of handoff reminders sent to a role.
+func (m *Metrics) HandoffRemindersSentTotal(role string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.handoffRemindersSent[role]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handoffRemindersSent = make(map[string]int64)
+}
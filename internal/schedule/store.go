@@ -4,15 +4,21 @@ package schedule
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/auth"
+
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
@@ -25,6 +31,13 @@ var (
 	ErrInvalidRotation = errors.New("invalid rotation")
 	// ErrInvalidOverride is returned when an override is invalid.
 	ErrInvalidOverride = errors.New("invalid override")
+	// ErrInvalidHandoffNote is returned when a handoff note is invalid.
+	ErrInvalidHandoffNote = errors.New("invalid handoff note")
+	// ErrInvalidPageToken is returned when a page token cannot be decoded.
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrMemberMismatch is returned when a member order does not list exactly the
+	// rotation's current members.
+	ErrMemberMismatch = errors.New("ordered users do not match rotation members")
 )
 
 // Store defines the interface for schedule persistence.
@@ -35,20 +48,34 @@ type Store interface {
 	ListSchedules(ctx context.Context, req *routingv1.ListSchedulesRequest) (*routingv1.ListSchedulesResponse, error)
 	UpdateSchedule(ctx context.Context, schedule *routingv1.Schedule) (*routingv1.Schedule, error)
 	DeleteSchedule(ctx context.Context, id string) error
+	// ListTenants returns the tenants that have schedules, whatever the tenant
+	// of ctx, for background jobs that process the schedules of every tenant.
+	ListTenants(ctx context.Context) ([]string, error)
 
 	// Rotation management
 	AddRotation(ctx context.Context, scheduleID string, rotation *routingv1.Rotation) (*routingv1.Schedule, error)
 	UpdateRotation(ctx context.Context, scheduleID string, rotation *routingv1.Rotation) (*routingv1.Schedule, error)
 	RemoveRotation(ctx context.Context, scheduleID, rotationID string) (*routingv1.Schedule, error)
+	// ReorderRotationMembers assigns positions 0, 1, 2... in the order of orderedUserIDs,
+	// which must list every current member exactly once.
+	ReorderRotationMembers(ctx context.Context, scheduleID, rotationID string, orderedUserIDs []string) (*routingv1.Rotation, error)
 
 	// Override management
 	CreateOverride(ctx context.Context, scheduleID string, override *routingv1.ScheduleOverride) (*routingv1.ScheduleOverride, error)
+	// BulkCreateOverrides validates every override and creates them all atomically.
+	// Identical overrides within the batch are created once.
+	BulkCreateOverrides(ctx context.Context, scheduleID string, overrides []*routingv1.ScheduleOverride) ([]*routingv1.ScheduleOverride, error)
 	DeleteOverride(ctx context.Context, scheduleID, overrideID string) error
+	// SplitOverride atomically replaces an override with one for its user ending
+	// at splitAt and one for newUserID starting at splitAt.
+	SplitOverride(ctx context.Context, scheduleID, overrideID string, splitAt time.Time, newUserID string) ([]*routingv1.ScheduleOverride, error)
 	ListOverrides(ctx context.Context, scheduleID string, startTime, endTime *timestamppb.Timestamp, pageSize int, pageToken string) (*routingv1.ListOverridesResponse, error)
 	GetActiveOverrides(ctx context.Context, scheduleID string, at time.Time) ([]*routingv1.ScheduleOverride, error)
 
 	// Handoff
 	RecordHandoffAck(ctx context.Context, scheduleID, userID string) error
+	CreateHandoffNote(ctx context.Context, note *routingv1.HandoffNote) (*routingv1.HandoffNote, error)
+	GetHandoffNotes(ctx context.Context, scheduleID string, limit int) ([]*routingv1.HandoffNote, error)
 }
 
 // PostgresStore implements Store using PostgreSQL.
@@ -67,6 +94,11 @@ func (s *PostgresStore) CreateSchedule(ctx context.Context, schedule *routingv1.
 		return nil, ErrInvalidSchedule
 	}
 
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("begin transaction: %w", err)
@@ -93,10 +125,13 @@ func (s *PostgresStore) CreateSchedule(ctx context.Context, schedule *routingv1.
 		teamID = &schedule.TeamId
 	}
 
+	reminderNotice, reminderChannel := handoffReminderColumns(schedule.HandoffReminder)
+
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO schedules (id, name, description, timezone, team_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-	`, schedule.Id, schedule.Name, schedule.Description, schedule.Timezone, teamID, now, now)
+		INSERT INTO schedules (id, name, description, timezone, team_id, created_at, updated_at,
+			handoff_reminder_notice_seconds, handoff_reminder_channel, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, schedule.Id, schedule.Name, schedule.Description, schedule.Timezone, teamID, now, now, reminderNotice, reminderChannel, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("insert schedule: %w", err)
 	}
@@ -122,6 +157,29 @@ func (s *PostgresStore) CreateSchedule(ctx context.Context, schedule *routingv1.
 	return schedule, nil
 }
 
+// handoffReminderColumns converts a handoff reminder config to the nullable
+// schedules columns; a nil config stores NULLs.
+func handoffReminderColumns(reminder *routingv1.HandoffReminderConfig) (*int64, *string) {
+	if reminder == nil {
+		return nil, nil
+	}
+	seconds := int64(reminder.AdvanceNotice.AsDuration().Seconds())
+	channel := reminder.Channel.String()
+	return &seconds, &channel
+}
+
+// handoffReminderFromColumns builds a handoff reminder config from the schedules
+// columns, returning nil if the schedule has none.
+func handoffReminderFromColumns(notice sql.NullInt64, channel sql.NullString) *routingv1.HandoffReminderConfig {
+	if !notice.Valid {
+		return nil
+	}
+	return &routingv1.HandoffReminderConfig{
+		AdvanceNotice: durationpb.New(time.Duration(notice.Int64) * time.Second),
+		Channel:       routingv1.ChannelType(routingv1.ChannelType_value[channel.String]),
+	}
+}
+
 // insertRotation inserts a rotation and its members into the database.
 func (s *PostgresStore) insertRotation(ctx context.Context, tx *sql.Tx, scheduleID string, rotation *routingv1.Rotation) error {
 	if rotation.Id == "" {
@@ -222,16 +280,25 @@ func (s *PostgresStore) insertOverride(ctx context.Context, tx *sql.Tx, schedule
 
 // GetSchedule retrieves a schedule by ID with all related data.
 func (s *PostgresStore) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	schedule := &routingv1.Schedule{}
 
 	var createdAt, updatedAt time.Time
 	var description sql.NullString
 	var teamID sql.NullString
-
-	err := s.db.QueryRowContext(ctx, `
-		SELECT id, name, description, timezone, team_id, created_at, updated_at
-		FROM schedules WHERE id = $1
-	`, id).Scan(&schedule.Id, &schedule.Name, &description, &schedule.Timezone, &teamID, &createdAt, &updatedAt)
+	var reminderNotice sql.NullInt64
+	var reminderChannel sql.NullString
+
+	err = s.db.QueryRowContext(ctx, `
+		SELECT id, name, description, timezone, team_id, created_at, updated_at,
+			handoff_reminder_notice_seconds, handoff_reminder_channel
+		FROM schedules WHERE id = $1 AND tenant_id = $2
+	`, id, tenantID).Scan(&schedule.Id, &schedule.Name, &description, &schedule.Timezone, &teamID, &createdAt, &updatedAt,
+		&reminderNotice, &reminderChannel)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
@@ -243,6 +310,7 @@ func (s *PostgresStore) GetSchedule(ctx context.Context, id string) (*routingv1.
 	schedule.TeamId = teamID.String
 	schedule.CreatedAt = timestamppb.New(createdAt)
 	schedule.UpdatedAt = timestamppb.New(updatedAt)
+	schedule.HandoffReminder = handoffReminderFromColumns(reminderNotice, reminderChannel)
 
 	// Load rotations
 	rotations, err := s.loadRotations(ctx, id)
@@ -389,11 +457,36 @@ func (s *PostgresStore) loadOverrides(ctx context.Context, scheduleID string) ([
 	return overrides, rows.Err()
 }
 
+// ListTenants returns the tenants that have schedules.
+func (s *PostgresStore) ListTenants(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT tenant_id FROM schedules ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query schedule tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []string
+	for rows.Next() {
+		var tenantID string
+		if err := rows.Scan(&tenantID); err != nil {
+			return nil, fmt.Errorf("scan schedule tenant: %w", err)
+		}
+		tenants = append(tenants, tenantID)
+	}
+	return tenants, rows.Err()
+}
+
 // ListSchedules retrieves schedules with optional filters.
 func (s *PostgresStore) ListSchedules(ctx context.Context, req *routingv1.ListSchedulesRequest) (*routingv1.ListSchedulesResponse, error) {
-	query := `SELECT id, name, description, timezone, team_id, created_at, updated_at FROM schedules WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT id, name, description, timezone, team_id, created_at, updated_at,
+		handoff_reminder_notice_seconds, handoff_reminder_channel FROM schedules WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+	argIndex := 2
 
 	if req.TeamId != "" {
 		query += fmt.Sprintf(" AND team_id = $%d", argIndex)
@@ -427,9 +520,11 @@ func (s *PostgresStore) ListSchedules(ctx context.Context, req *routingv1.ListSc
 	for rows.Next() {
 		schedule := &routingv1.Schedule{}
 		var createdAt, updatedAt time.Time
-		var description, teamID sql.NullString
+		var description, teamID, reminderChannel sql.NullString
+		var reminderNotice sql.NullInt64
 
-		if err := rows.Scan(&schedule.Id, &schedule.Name, &description, &schedule.Timezone, &teamID, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&schedule.Id, &schedule.Name, &description, &schedule.Timezone, &teamID, &createdAt, &updatedAt,
+			&reminderNotice, &reminderChannel); err != nil {
 			return nil, fmt.Errorf("scan schedule: %w", err)
 		}
 
@@ -437,6 +532,7 @@ func (s *PostgresStore) ListSchedules(ctx context.Context, req *routingv1.ListSc
 		schedule.TeamId = teamID.String
 		schedule.CreatedAt = timestamppb.New(createdAt)
 		schedule.UpdatedAt = timestamppb.New(updatedAt)
+		schedule.HandoffReminder = handoffReminderFromColumns(reminderNotice, reminderChannel)
 
 		// Load rotations
 		rotations, err := s.loadRotations(ctx, schedule.Id)
@@ -472,6 +568,11 @@ func (s *PostgresStore) UpdateSchedule(ctx context.Context, schedule *routingv1.
 		return nil, ErrInvalidSchedule
 	}
 
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("begin transaction: %w", err)
@@ -486,10 +587,13 @@ func (s *PostgresStore) UpdateSchedule(ctx context.Context, schedule *routingv1.
 		teamID = &schedule.TeamId
 	}
 
+	reminderNotice, reminderChannel := handoffReminderColumns(schedule.HandoffReminder)
+
 	result, err := tx.ExecContext(ctx, `
-		UPDATE schedules SET name = $1, description = $2, timezone = $3, team_id = $4, updated_at = $5
-		WHERE id = $6
-	`, schedule.Name, schedule.Description, schedule.Timezone, teamID, now, schedule.Id)
+		UPDATE schedules SET name = $1, description = $2, timezone = $3, team_id = $4, updated_at = $5,
+			handoff_reminder_notice_seconds = $6, handoff_reminder_channel = $7
+		WHERE id = $8 AND tenant_id = $9
+	`, schedule.Name, schedule.Description, schedule.Timezone, teamID, now, reminderNotice, reminderChannel, schedule.Id, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("update schedule: %w", err)
 	}
@@ -508,7 +612,12 @@ func (s *PostgresStore) UpdateSchedule(ctx context.Context, schedule *routingv1.
 
 // DeleteSchedule deletes a schedule by ID.
 func (s *PostgresStore) DeleteSchedule(ctx context.Context, id string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM schedules WHERE id = $1", id)
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM schedules WHERE id = $1 AND tenant_id = $2", id, tenantID)
 	if err != nil {
 		return fmt.Errorf("delete schedule: %w", err)
 	}
@@ -567,6 +676,10 @@ func (s *PostgresStore) UpdateRotation(ctx context.Context, scheduleID string, r
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	if err := lockSchedule(ctx, tx, scheduleID); err != nil {
+		return nil, err
+	}
+
 	// Delete existing rotation and members
 	_, err = tx.ExecContext(ctx, "DELETE FROM rotations WHERE id = $1 AND schedule_id = $2", rotation.Id, scheduleID)
 	if err != nil {
@@ -599,6 +712,10 @@ func (s *PostgresStore) RemoveRotation(ctx context.Context, scheduleID, rotation
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	if err := lockSchedule(ctx, tx, scheduleID); err != nil {
+		return nil, err
+	}
+
 	result, err := tx.ExecContext(ctx, "DELETE FROM rotations WHERE id = $1 AND schedule_id = $2", rotationID, scheduleID)
 	if err != nil {
 		return nil, fmt.Errorf("delete rotation: %w", err)
@@ -622,6 +739,124 @@ func (s *PostgresStore) RemoveRotation(ctx context.Context, scheduleID, rotation
 	return s.GetSchedule(ctx, scheduleID)
 }
 
+// ReorderRotationMembers reassigns member positions within a transaction.
+func (s *PostgresStore) ReorderRotationMembers(ctx context.Context, scheduleID, rotationID string, orderedUserIDs []string) (*routingv1.Rotation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := lockSchedule(ctx, tx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM rotations WHERE id = $1 AND schedule_id = $2)
+	`, rotationID, scheduleID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("query rotation: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id FROM rotation_members WHERE rotation_id = $1 FOR UPDATE
+	`, rotationID)
+	if err != nil {
+		return nil, fmt.Errorf("query rotation members: %w", err)
+	}
+	var current []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan rotation member: %w", err)
+		}
+		current = append(current, userID)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query rotation members: %w", err)
+	}
+
+	if err := validateMemberOrder(current, orderedUserIDs); err != nil {
+		return nil, err
+	}
+
+	for position, userID := range orderedUserIDs {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE rotation_members SET position = $1 WHERE rotation_id = $2 AND user_id = $3
+		`, position, rotationID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("update rotation member position: %w", err)
+		}
+	}
+
+	// Update schedule timestamp
+	_, err = tx.ExecContext(ctx, "UPDATE schedules SET updated_at = $1 WHERE id = $2", time.Now(), scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("update schedule timestamp: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	sched, err := s.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rotation := range sched.Rotations {
+		if rotation.Id == rotationID {
+			return rotation, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// lockSchedule locks the schedule row of scheduleID for the rest of tx, or
+// returns ErrNotFound when the schedule does not belong to the tenant of ctx.
+func lockSchedule(ctx context.Context, tx *sql.Tx, scheduleID string) error {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM schedules WHERE id = $1 AND tenant_id = $2 FOR UPDATE
+	`, scheduleID, tenantID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("lock schedule: %w", err)
+	}
+	return nil
+}
+
+// validateMemberOrder checks that ordered lists every current member exactly once.
+func validateMemberOrder(current, ordered []string) error {
+	if len(ordered) != len(current) {
+		return fmt.Errorf("%w: expected %d users, got %d", ErrMemberMismatch, len(current), len(ordered))
+	}
+
+	members := make(map[string]bool, len(current))
+	for _, userID := range current {
+		members[userID] = true
+	}
+	for _, userID := range ordered {
+		if !members[userID] {
+			return fmt.Errorf("%w: %s is not a member or is listed twice", ErrMemberMismatch, userID)
+		}
+		delete(members, userID)
+	}
+	return nil
+}
+
 // CreateOverride creates a schedule override.
 func (s *PostgresStore) CreateOverride(ctx context.Context, scheduleID string, override *routingv1.ScheduleOverride) (*routingv1.ScheduleOverride, error) {
 	if override == nil {
@@ -651,9 +886,124 @@ func (s *PostgresStore) CreateOverride(ctx context.Context, scheduleID string, o
 	return override, nil
 }
 
+// BulkCreateOverrides creates overrides with a single multi-row INSERT.
+func (s *PostgresStore) BulkCreateOverrides(ctx context.Context, scheduleID string, overrides []*routingv1.ScheduleOverride) ([]*routingv1.ScheduleOverride, error) {
+	overrides, err := PrepareBulkOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify schedule exists
+	if _, err := s.GetSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	const columns = 8
+	placeholders := make([]string, 0, len(overrides))
+	args := make([]interface{}, 0, len(overrides)*columns)
+	for i, override := range overrides {
+		if override.Id == "" {
+			override.Id = uuid.New().String()
+		}
+
+		n := i * columns
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			n+1, n+2, n+3, n+4, n+5, n+6, n+7, n+8))
+		args = append(args, override.Id, scheduleID, override.UserId, override.StartTime.AsTime(),
+			override.EndTime.AsTime(), override.Reason, override.CreatedBy, now)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO schedule_overrides (id, schedule_id, user_id, start_time, end_time, reason, created_by, created_at)
+		VALUES `+strings.Join(placeholders, ", "), args...)
+	if err != nil {
+		return nil, fmt.Errorf("insert overrides: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	for _, override := range overrides {
+		override.CreatedAt = timestamppb.New(now)
+	}
+	return overrides, nil
+}
+
+// SplitOverride deletes the override and inserts its two halves in one transaction.
+func (s *PostgresStore) SplitOverride(ctx context.Context, scheduleID, overrideID string, splitAt time.Time, newUserID string) ([]*routingv1.ScheduleOverride, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := lockSchedule(ctx, tx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	original := &routingv1.ScheduleOverride{Id: overrideID}
+	var startT, endT time.Time
+	var reason, createdBy sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT user_id, start_time, end_time, reason, created_by
+		FROM schedule_overrides WHERE id = $1 AND schedule_id = $2
+		FOR UPDATE
+	`, overrideID, scheduleID).Scan(&original.UserId, &startT, &endT, &reason, &createdBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query override: %w", err)
+	}
+	original.StartTime = timestamppb.New(startT)
+	original.EndTime = timestamppb.New(endT)
+	original.Reason = reason.String
+	original.CreatedBy = createdBy.String
+
+	halves, err := SplitOverrideAt(original, splitAt, newUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schedule_overrides WHERE id = $1", overrideID); err != nil {
+		return nil, fmt.Errorf("delete override: %w", err)
+	}
+	for _, half := range halves {
+		if err := s.insertOverride(ctx, tx, scheduleID, half); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	now := timestamppb.Now()
+	for _, half := range halves {
+		half.CreatedAt = now
+	}
+	return halves, nil
+}
+
 // DeleteOverride deletes a schedule override.
 func (s *PostgresStore) DeleteOverride(ctx context.Context, scheduleID, overrideID string) error {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM schedule_overrides WHERE id = $1 AND schedule_id = $2", overrideID, scheduleID)
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM schedule_overrides
+		WHERE id = $1 AND schedule_id = $2 AND schedule_id IN (SELECT id FROM schedules WHERE tenant_id = $3)
+	`, overrideID, scheduleID, tenantID)
 	if err != nil {
 		return fmt.Errorf("delete override: %w", err)
 	}
@@ -668,10 +1018,16 @@ func (s *PostgresStore) DeleteOverride(ctx context.Context, scheduleID, override
 
 // ListOverrides lists overrides for a schedule within a time range.
 func (s *PostgresStore) ListOverrides(ctx context.Context, scheduleID string, startTime, endTime *timestamppb.Timestamp, pageSize int, pageToken string) (*routingv1.ListOverridesResponse, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `SELECT id, user_id, start_time, end_time, reason, created_by, created_at
-		FROM schedule_overrides WHERE schedule_id = $1`
-	args := []interface{}{scheduleID}
-	argIndex := 2
+		FROM schedule_overrides
+		WHERE schedule_id = $1 AND schedule_id IN (SELECT id FROM schedules WHERE tenant_id = $2)`
+	args := []interface{}{scheduleID, tenantID}
+	argIndex := 3
 
 	if startTime != nil {
 		query += fmt.Sprintf(" AND end_time >= $%d", argIndex)
@@ -685,17 +1041,24 @@ func (s *PostgresStore) ListOverrides(ctx context.Context, scheduleID string, st
 		argIndex++
 	}
 
-	query += " ORDER BY start_time"
-
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 50
+	cursor, offset, err := decodeOverridePageToken(pageToken)
+	if err != nil {
+		return nil, err
 	}
+	if cursor != nil {
+		query += fmt.Sprintf(" AND (start_time, id) > ($%d, $%d)", argIndex, argIndex+1)
+		args = append(args, cursor.StartTime, cursor.ID)
+		argIndex += 2
+	}
+
+	query += " ORDER BY start_time, id"
+
+	pageSize = normalizeOverridePageSize(pageSize)
 	query += fmt.Sprintf(" LIMIT $%d", argIndex)
 	args = append(args, pageSize+1)
 	argIndex++
 
-	if pageToken != "" {
-		offset := decodePageToken(pageToken)
+	if offset > 0 {
 		query += fmt.Sprintf(" OFFSET $%d", argIndex)
 		args = append(args, offset)
 	}
@@ -729,22 +1092,143 @@ func (s *PostgresStore) ListOverrides(ctx context.Context, scheduleID string, st
 
 	if len(overrides) > pageSize {
 		overrides = overrides[:pageSize]
-		offset := decodePageToken(pageToken)
-		resp.NextPageToken = encodePageToken(offset + pageSize)
+		resp.NextPageToken = encodeOverridePageToken(overrides[pageSize-1])
 	}
 
 	resp.Overrides = overrides
 	return resp, rows.Err()
 }
 
+// MaxBulkOverrides is the largest number of overrides accepted by BulkCreateOverrides.
+const MaxBulkOverrides = 100
+
+// ValidateOverride checks that an override names a user and a non-empty period.
+func ValidateOverride(override *routingv1.ScheduleOverride) error {
+	if override == nil {
+		return ErrInvalidOverride
+	}
+	if override.UserId == "" {
+		return fmt.Errorf("%w: user_id is required", ErrInvalidOverride)
+	}
+	if override.StartTime == nil || override.EndTime == nil {
+		return fmt.Errorf("%w: start_time and end_time are required", ErrInvalidOverride)
+	}
+	if !override.StartTime.AsTime().Before(override.EndTime.AsTime()) {
+		return fmt.Errorf("%w: start_time must be before end_time", ErrInvalidOverride)
+	}
+	return nil
+}
+
+// PrepareBulkOverrides validates a BulkCreateOverrides batch and drops overrides
+// identical to an earlier one (same user, start and end). A single invalid
+// override rejects the whole batch.
+func PrepareBulkOverrides(overrides []*routingv1.ScheduleOverride) ([]*routingv1.ScheduleOverride, error) {
+	if len(overrides) == 0 {
+		return nil, fmt.Errorf("%w: at least one override is required", ErrInvalidOverride)
+	}
+	if len(overrides) > MaxBulkOverrides {
+		return nil, fmt.Errorf("%w: at most %d overrides can be created at once", ErrInvalidOverride, MaxBulkOverrides)
+	}
+
+	seen := make(map[string]bool, len(overrides))
+	unique := make([]*routingv1.ScheduleOverride, 0, len(overrides))
+	for i, override := range overrides {
+		if err := ValidateOverride(override); err != nil {
+			return nil, fmt.Errorf("override %d: %w", i, err)
+		}
+
+		key := fmt.Sprintf("%s/%d/%d", override.UserId, override.StartTime.AsTime().UnixNano(), override.EndTime.AsTime().UnixNano())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, override)
+	}
+
+	return unique, nil
+}
+
+// SplitOverrideAt returns the two overrides replacing override when it is split
+// at splitAt: the first keeps its user until splitAt, the second assigns
+// newUserID from splitAt to its end. Both keep its reason and creator and get
+// new IDs. splitAt must fall strictly inside the override.
+func SplitOverrideAt(override *routingv1.ScheduleOverride, splitAt time.Time, newUserID string) ([]*routingv1.ScheduleOverride, error) {
+	if newUserID == "" {
+		return nil, fmt.Errorf("%w: new user is required", ErrInvalidOverride)
+	}
+	if !splitAt.After(override.StartTime.AsTime()) || !splitAt.Before(override.EndTime.AsTime()) {
+		return nil, fmt.Errorf("%w: split time must be after the override start and before its end", ErrInvalidOverride)
+	}
+
+	split := timestamppb.New(splitAt)
+	return []*routingv1.ScheduleOverride{
+		{
+			Id:        uuid.New().String(),
+			UserId:    override.UserId,
+			StartTime: override.StartTime,
+			EndTime:   split,
+			Reason:    override.Reason,
+			CreatedBy: override.CreatedBy,
+		},
+		{
+			Id:        uuid.New().String(),
+			UserId:    newUserID,
+			StartTime: split,
+			EndTime:   override.EndTime,
+			Reason:    override.Reason,
+			CreatedBy: override.CreatedBy,
+		},
+	}, nil
+}
+
+// PageOverrides applies ListOverrides pagination to overrides already filtered
+// by schedule and time range. It is used by in-memory stores.
+func PageOverrides(overrides []*routingv1.ScheduleOverride, pageSize int, pageToken string) (*routingv1.ListOverridesResponse, error) {
+	cursor, offset, err := decodeOverridePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*routingv1.ScheduleOverride, 0, len(overrides))
+	for _, o := range overrides {
+		if cursor == nil || cursor.precedes(o) {
+			sorted = append(sorted, o)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return overrideLess(sorted[i], sorted[j])
+	})
+
+	if offset >= len(sorted) {
+		return &routingv1.ListOverridesResponse{}, nil
+	}
+	sorted = sorted[offset:]
+
+	resp := &routingv1.ListOverridesResponse{}
+	pageSize = normalizeOverridePageSize(pageSize)
+	if len(sorted) > pageSize {
+		sorted = sorted[:pageSize]
+		resp.NextPageToken = encodeOverridePageToken(sorted[pageSize-1])
+	}
+
+	resp.Overrides = sorted
+	return resp, nil
+}
+
 // GetActiveOverrides returns overrides active at a given time.
 func (s *PostgresStore) GetActiveOverrides(ctx context.Context, scheduleID string, at time.Time) ([]*routingv1.ScheduleOverride, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, user_id, start_time, end_time, reason, created_by, created_at
 		FROM schedule_overrides
 		WHERE schedule_id = $1 AND start_time <= $2 AND end_time > $2
+			AND schedule_id IN (SELECT id FROM schedules WHERE tenant_id = $3)
 		ORDER BY created_at DESC
-	`, scheduleID, at)
+	`, scheduleID, at, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -780,6 +1264,76 @@ func (s *PostgresStore) RecordHandoffAck(ctx context.Context, scheduleID, userID
 	return err
 }
 
+// CreateHandoffNote stores a handoff note for a schedule.
+func (s *PostgresStore) CreateHandoffNote(ctx context.Context, note *routingv1.HandoffNote) (*routingv1.HandoffNote, error) {
+	if note == nil || note.ScheduleId == "" || note.Content == "" {
+		return nil, ErrInvalidHandoffNote
+	}
+
+	// Verify schedule exists
+	if _, err := s.GetSchedule(ctx, note.ScheduleId); err != nil {
+		return nil, err
+	}
+
+	if note.Id == "" {
+		note.Id = uuid.New().String()
+	}
+
+	var createdAt time.Time
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO handoff_notes (id, schedule_id, author_user_id, content)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`, note.Id, note.ScheduleId, sql.NullString{String: note.AuthorUserId, Valid: note.AuthorUserId != ""}, note.Content).Scan(&createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert handoff note: %w", err)
+	}
+
+	note.CreatedAt = timestamppb.New(createdAt)
+	return note, nil
+}
+
+// GetHandoffNotes returns the most recent handoff notes for a schedule, newest first.
+func (s *PostgresStore) GetHandoffNotes(ctx context.Context, scheduleID string, limit int) ([]*routingv1.HandoffNote, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, schedule_id, author_user_id, content, created_at
+		FROM handoff_notes
+		WHERE schedule_id = $1 AND schedule_id IN (SELECT id FROM schedules WHERE tenant_id = $3)
+		ORDER BY created_at DESC
+		LIMIT $2
+	`, scheduleID, limit, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query handoff notes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	notes := []*routingv1.HandoffNote{}
+	for rows.Next() {
+		note := &routingv1.HandoffNote{}
+		var authorUserID sql.NullString
+		var createdAt time.Time
+
+		if err := rows.Scan(&note.Id, &note.ScheduleId, &authorUserID, &note.Content, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan handoff note: %w", err)
+		}
+
+		note.AuthorUserId = authorUserID.String
+		note.CreatedAt = timestamppb.New(createdAt)
+		notes = append(notes, note)
+	}
+
+	return notes, rows.Err()
+}
+
 // Helper functions
 func encodePageToken(offset int) string {
 	return fmt.Sprintf("%d", offset)
@@ -791,6 +1345,72 @@ func decodePageToken(token string) int {
 	return offset
 }
 
+// overrideCursor is the keyset position encoded in ListOverrides page tokens.
+type overrideCursor struct {
+	StartTime time.Time `json:"start_time"`
+	ID        string    `json:"id"`
+}
+
+// precedes reports whether the override sorts after the cursor position.
+func (c *overrideCursor) precedes(o *routingv1.ScheduleOverride) bool {
+	start := o.StartTime.AsTime()
+	if !start.Equal(c.StartTime) {
+		return start.After(c.StartTime)
+	}
+	return o.Id > c.ID
+}
+
+func overrideLess(a, b *routingv1.ScheduleOverride) bool {
+	aStart, bStart := a.StartTime.AsTime(), b.StartTime.AsTime()
+	if !aStart.Equal(bStart) {
+		return aStart.Before(bStart)
+	}
+	return a.Id < b.Id
+}
+
+func normalizeOverridePageSize(pageSize int) int {
+	if pageSize <= 0 || pageSize > 100 {
+		return 50
+	}
+	return pageSize
+}
+
+// encodeOverridePageToken returns a page token positioned after the override.
+func encodeOverridePageToken(o *routingv1.ScheduleOverride) string {
+	data, _ := json.Marshal(overrideCursor{StartTime: o.StartTime.AsTime().UTC(), ID: o.Id})
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// decodeOverridePageToken parses a ListOverrides page token. Plain integer
+// tokens issued before keyset pagination are returned as a legacy offset.
+func decodeOverridePageToken(token string) (*overrideCursor, int, error) {
+	if token == "" {
+		return nil, 0, nil
+	}
+
+	if offset, err := strconv.Atoi(token); err == nil {
+		if offset < 0 {
+			return nil, 0, fmt.Errorf("%w: negative offset", ErrInvalidPageToken)
+		}
+		return nil, offset, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+
+	var cursor overrideCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+	if cursor.ID == "" || cursor.StartTime.IsZero() {
+		return nil, 0, fmt.Errorf("%w: missing start_time or id", ErrInvalidPageToken)
+	}
+
+	return &cursor, 0, nil
+}
+
 func parseRotationType(s string) routingv1.RotationType {
 	if v, ok := routingv1.RotationType_value[s]; ok {
 		return routingv1.RotationType(v)
@@ -2,19 +2,27 @@ package schedule
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/auth"
 	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
 )
 
 // InMemoryStore is an in-memory implementation of Store for testing.
 type InMemoryStore struct {
 	schedules map[string]*routingv1.Schedule
+	tenants   map[string]string
 	overrides map[string][]*routingv1.ScheduleOverride
+	notes     map[string][]*routingv1.HandoffNote
 	counter   int64
 }
 
@@ -22,16 +30,37 @@ type InMemoryStore struct {
 func NewInMemoryStore() *InMemoryStore {
 	return &InMemoryStore{
 		schedules: make(map[string]*routingv1.Schedule),
+		tenants:   make(map[string]string),
 		overrides: make(map[string][]*routingv1.ScheduleOverride),
+		notes:     make(map[string][]*routingv1.HandoffNote),
 	}
 }
 
+// tenantSchedule returns the schedule with the given ID if it belongs to the
+// tenant of ctx.
+func (s *InMemoryStore) tenantSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, ok := s.schedules[id]
+	if !ok || s.tenants[id] != tenantID {
+		return nil, ErrNotFound
+	}
+	return schedule, nil
+}
+
 // CreateSchedule creates a new schedule in memory.
 func (s *InMemoryStore) CreateSchedule(ctx context.Context, schedule *routingv1.Schedule) (*routingv1.Schedule, error) {
 	if schedule == nil {
 		return nil, ErrInvalidSchedule
 	}
 
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if schedule.Id == "" {
 		s.counter++
 		schedule.Id = "schedule-" + string(rune(s.counter))
@@ -46,6 +75,7 @@ func (s *InMemoryStore) CreateSchedule(ctx context.Context, schedule *routingv1.
 	}
 
 	s.schedules[schedule.Id] = schedule
+	s.tenants[schedule.Id] = tenantID
 	s.overrides[schedule.Id] = schedule.Overrides
 
 	return schedule, nil
@@ -53,18 +83,22 @@ func (s *InMemoryStore) CreateSchedule(ctx context.Context, schedule *routingv1.
 
 // GetSchedule retrieves a schedule by ID.
 func (s *InMemoryStore) GetSchedule(ctx context.Context, id string) (*routingv1.Schedule, error) {
-	schedule, ok := s.schedules[id]
-	if !ok {
-		return nil, ErrNotFound
-	}
-	return schedule, nil
+	return s.tenantSchedule(ctx, id)
 }
 
 // ListSchedules retrieves schedules with optional filters.
 func (s *InMemoryStore) ListSchedules(ctx context.Context, req *routingv1.ListSchedulesRequest) (*routingv1.ListSchedulesResponse, error) {
+	tenantID, err := auth.TenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var schedules []*routingv1.Schedule
 
-	for _, schedule := range s.schedules {
+	for id, schedule := range s.schedules {
+		if s.tenants[id] != tenantID {
+			continue
+		}
 		if req.TeamId != "" && schedule.TeamId != req.TeamId {
 			continue
 		}
@@ -77,15 +111,29 @@ func (s *InMemoryStore) ListSchedules(ctx context.Context, req *routingv1.ListSc
 	}, nil
 }
 
+// ListTenants returns the tenants that have schedules.
+func (s *InMemoryStore) ListTenants(ctx context.Context) ([]string, error) {
+	seen := make(map[string]bool)
+	var tenants []string
+	for _, tenantID := range s.tenants {
+		if !seen[tenantID] {
+			seen[tenantID] = true
+			tenants = append(tenants, tenantID)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants, nil
+}
+
 // UpdateSchedule updates an existing schedule.
 func (s *InMemoryStore) UpdateSchedule(ctx context.Context, schedule *routingv1.Schedule) (*routingv1.Schedule, error) {
 	if schedule == nil || schedule.Id == "" {
 		return nil, ErrInvalidSchedule
 	}
 
-	existing, ok := s.schedules[schedule.Id]
-	if !ok {
-		return nil, ErrNotFound
+	existing, err := s.tenantSchedule(ctx, schedule.Id)
+	if err != nil {
+		return nil, err
 	}
 
 	schedule.CreatedAt = existing.CreatedAt
@@ -97,19 +145,20 @@ func (s *InMemoryStore) UpdateSchedule(ctx context.Context, schedule *routingv1.
 
 // DeleteSchedule deletes a schedule by ID.
 func (s *InMemoryStore) DeleteSchedule(ctx context.Context, id string) error {
-	if _, ok := s.schedules[id]; !ok {
-		return ErrNotFound
+	if _, err := s.tenantSchedule(ctx, id); err != nil {
+		return err
 	}
 	delete(s.schedules, id)
+	delete(s.tenants, id)
 	delete(s.overrides, id)
 	return nil
 }
 
 // AddRotation adds a rotation to a schedule.
 func (s *InMemoryStore) AddRotation(ctx context.Context, scheduleID string, rotation *routingv1.Rotation) (*routingv1.Schedule, error) {
-	schedule, ok := s.schedules[scheduleID]
-	if !ok {
-		return nil, ErrNotFound
+	schedule, err := s.tenantSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
 	}
 
 	if rotation.Id == "" {
@@ -125,9 +174,9 @@ func (s *InMemoryStore) AddRotation(ctx context.Context, scheduleID string, rota
 
 // UpdateRotation updates a rotation within a schedule.
 func (s *InMemoryStore) UpdateRotation(ctx context.Context, scheduleID string, rotation *routingv1.Rotation) (*routingv1.Schedule, error) {
-	schedule, ok := s.schedules[scheduleID]
-	if !ok {
-		return nil, ErrNotFound
+	schedule, err := s.tenantSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
 	}
 
 	found := false
@@ -147,11 +196,48 @@ func (s *InMemoryStore) UpdateRotation(ctx context.Context, scheduleID string, r
 	return schedule, nil
 }
 
+// ReorderRotationMembers reassigns member positions in the order of orderedUserIDs.
+func (s *InMemoryStore) ReorderRotationMembers(ctx context.Context, scheduleID, rotationID string, orderedUserIDs []string) (*routingv1.Rotation, error) {
+	schedule, err := s.tenantSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rotation := range schedule.Rotations {
+		if rotation.Id != rotationID {
+			continue
+		}
+
+		byUser := make(map[string]*routingv1.RotationMember, len(rotation.Members))
+		current := make([]string, 0, len(rotation.Members))
+		for _, member := range rotation.Members {
+			byUser[member.UserId] = member
+			current = append(current, member.UserId)
+		}
+		if err := validateMemberOrder(current, orderedUserIDs); err != nil {
+			return nil, err
+		}
+
+		members := make([]*routingv1.RotationMember, 0, len(orderedUserIDs))
+		for position, userID := range orderedUserIDs {
+			member := byUser[userID]
+			member.Position = int32(position)
+			members = append(members, member)
+		}
+		rotation.Members = members
+
+		schedule.UpdatedAt = timestamppb.Now()
+		return rotation, nil
+	}
+
+	return nil, ErrNotFound
+}
+
 // RemoveRotation removes a rotation from a schedule.
 func (s *InMemoryStore) RemoveRotation(ctx context.Context, scheduleID, rotationID string) (*routingv1.Schedule, error) {
-	schedule, ok := s.schedules[scheduleID]
-	if !ok {
-		return nil, ErrNotFound
+	schedule, err := s.tenantSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
 	}
 
 	found := false
@@ -175,8 +261,8 @@ func (s *InMemoryStore) RemoveRotation(ctx context.Context, scheduleID, rotation
 
 // CreateOverride creates a schedule override.
 func (s *InMemoryStore) CreateOverride(ctx context.Context, scheduleID string, override *routingv1.ScheduleOverride) (*routingv1.ScheduleOverride, error) {
-	if _, ok := s.schedules[scheduleID]; !ok {
-		return nil, ErrNotFound
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return nil, err
 	}
 
 	if override.Id == "" {
@@ -190,8 +276,35 @@ func (s *InMemoryStore) CreateOverride(ctx context.Context, scheduleID string, o
 	return override, nil
 }
 
+// BulkCreateOverrides creates a batch of schedule overrides.
+func (s *InMemoryStore) BulkCreateOverrides(ctx context.Context, scheduleID string, overrides []*routingv1.ScheduleOverride) ([]*routingv1.ScheduleOverride, error) {
+	overrides, err := PrepareBulkOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		if override.Id == "" {
+			s.counter++
+			override.Id = fmt.Sprintf("override-%d", s.counter)
+		}
+		override.CreatedAt = timestamppb.Now()
+	}
+	s.overrides[scheduleID] = append(s.overrides[scheduleID], overrides...)
+
+	return overrides, nil
+}
+
 // DeleteOverride deletes a schedule override.
 func (s *InMemoryStore) DeleteOverride(ctx context.Context, scheduleID, overrideID string) error {
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return err
+	}
+
 	overrides, ok := s.overrides[scheduleID]
 	if !ok {
 		return ErrNotFound
@@ -215,8 +328,41 @@ func (s *InMemoryStore) DeleteOverride(ctx context.Context, scheduleID, override
 	return nil
 }
 
+// SplitOverride replaces an override with its two halves.
+func (s *InMemoryStore) SplitOverride(ctx context.Context, scheduleID, overrideID string, splitAt time.Time, newUserID string) ([]*routingv1.ScheduleOverride, error) {
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+
+	overrides := s.overrides[scheduleID]
+	for i, o := range overrides {
+		if o.Id != overrideID {
+			continue
+		}
+
+		halves, err := SplitOverrideAt(o, splitAt, newUserID)
+		if err != nil {
+			return nil, err
+		}
+		for _, half := range halves {
+			half.CreatedAt = timestamppb.Now()
+		}
+
+		updated := append([]*routingv1.ScheduleOverride{}, overrides[:i]...)
+		updated = append(updated, halves...)
+		s.overrides[scheduleID] = append(updated, overrides[i+1:]...)
+		return halves, nil
+	}
+
+	return nil, ErrNotFound
+}
+
 // ListOverrides lists overrides for a schedule within a time range.
 func (s *InMemoryStore) ListOverrides(ctx context.Context, scheduleID string, startTime, endTime *timestamppb.Timestamp, pageSize int, pageToken string) (*routingv1.ListOverridesResponse, error) {
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return &routingv1.ListOverridesResponse{}, nil
+	}
+
 	overrides := s.overrides[scheduleID]
 
 	var filtered []*routingv1.ScheduleOverride
@@ -230,13 +376,15 @@ func (s *InMemoryStore) ListOverrides(ctx context.Context, scheduleID string, st
 		filtered = append(filtered, o)
 	}
 
-	return &routingv1.ListOverridesResponse{
-		Overrides: filtered,
-	}, nil
+	return PageOverrides(filtered, pageSize, pageToken)
 }
 
 // GetActiveOverrides returns overrides active at a given time.
 func (s *InMemoryStore) GetActiveOverrides(ctx context.Context, scheduleID string, at time.Time) ([]*routingv1.ScheduleOverride, error) {
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return nil, nil
+	}
+
 	overrides := s.overrides[scheduleID]
 
 	var active []*routingv1.ScheduleOverride
@@ -251,10 +399,41 @@ func (s *InMemoryStore) GetActiveOverrides(ctx context.Context, scheduleID strin
 
 // RecordHandoffAck records a handoff acknowledgment.
 func (s *InMemoryStore) RecordHandoffAck(ctx context.Context, scheduleID, userID string) error {
-	if _, ok := s.schedules[scheduleID]; !ok {
-		return ErrNotFound
+	_, err := s.tenantSchedule(ctx, scheduleID)
+	return err
+}
+
+// CreateHandoffNote stores a handoff note in memory.
+func (s *InMemoryStore) CreateHandoffNote(ctx context.Context, note *routingv1.HandoffNote) (*routingv1.HandoffNote, error) {
+	if note == nil || note.ScheduleId == "" || note.Content == "" {
+		return nil, ErrInvalidHandoffNote
 	}
-	return nil
+	if _, err := s.tenantSchedule(ctx, note.ScheduleId); err != nil {
+		return nil, err
+	}
+
+	if note.Id == "" {
+		s.counter++
+		note.Id = fmt.Sprintf("note-%d", s.counter)
+	}
+	note.CreatedAt = timestamppb.Now()
+
+	s.notes[note.ScheduleId] = append(s.notes[note.ScheduleId], note)
+	return note, nil
+}
+
+// GetHandoffNotes returns the most recent handoff notes, newest first.
+func (s *InMemoryStore) GetHandoffNotes(ctx context.Context, scheduleID string, limit int) ([]*routingv1.HandoffNote, error) {
+	notes := []*routingv1.HandoffNote{}
+	if _, err := s.tenantSchedule(ctx, scheduleID); err != nil {
+		return notes, nil
+	}
+
+	stored := s.notes[scheduleID]
+	for i := len(stored) - 1; i >= 0 && len(notes) < limit; i-- {
+		notes = append(notes, stored[i])
+	}
+	return notes, nil
 }
 
 // Ensure InMemoryStore implements Store
@@ -568,3 +747,612 @@ func TestInMemoryStore_GetActiveOverrides(t *testing.T) {
 		t.Errorf("expected active override for user-1, got '%s'", active[0].UserId)
 	}
 }
+
+func createTestOverride(t *testing.T, store *InMemoryStore, id string, start time.Time) {
+	t.Helper()
+
+	_, err := store.CreateOverride(context.Background(), "test-schedule", &routingv1.ScheduleOverride{
+		Id:        id,
+		UserId:    "user-" + id,
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("failed to create override %s: %v", id, err)
+	}
+}
+
+func overrideIDs(overrides []*routingv1.ScheduleOverride) []string {
+	ids := make([]string, 0, len(overrides))
+	for _, o := range overrides {
+		ids = append(ids, o.Id)
+	}
+	return ids
+}
+
+func TestInMemoryStore_ListOverrides_CursorPagination(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	createTestOverride(t, store, "o3", base.Add(2*time.Hour))
+	createTestOverride(t, store, "o1", base)
+	createTestOverride(t, store, "o2b", base.Add(time.Hour))
+	createTestOverride(t, store, "o2a", base.Add(time.Hour))
+
+	first, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(overrideIDs(first.Overrides)); got != "[o1 o2a]" {
+		t.Fatalf("expected first page [o1 o2a], got %s", got)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+
+	// An insert before the cursor must not shift the next page; one after it must appear
+	createTestOverride(t, store, "o0", base.Add(-time.Hour))
+	createTestOverride(t, store, "o4", base.Add(3*time.Hour))
+
+	second, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 2, first.NextPageToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(overrideIDs(second.Overrides)); got != "[o2b o3]" {
+		t.Fatalf("expected second page [o2b o3], got %s", got)
+	}
+
+	third, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 2, second.NextPageToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(overrideIDs(third.Overrides)); got != "[o4]" {
+		t.Fatalf("expected third page [o4], got %s", got)
+	}
+	if third.NextPageToken != "" {
+		t.Errorf("expected no next page token on the last page, got %q", third.NextPageToken)
+	}
+}
+
+func TestInMemoryStore_ListOverrides_LegacyOffsetToken(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		createTestOverride(t, store, fmt.Sprintf("o%d", i), base.Add(time.Duration(i)*time.Hour))
+	}
+
+	resp, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 1, "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(overrideIDs(resp.Overrides)); got != "[o1]" {
+		t.Fatalf("expected legacy offset page [o1], got %s", got)
+	}
+
+	// Pages after a legacy token continue with a keyset cursor
+	next, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 1, resp.NextPageToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(overrideIDs(next.Overrides)); got != "[o2]" {
+		t.Fatalf("expected page [o2], got %s", got)
+	}
+}
+
+func TestInMemoryStore_ListOverrides_InvalidToken(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	for _, token := range []string{"not-a-token", "-1", "e30="} {
+		_, err := store.ListOverrides(ctx, "test-schedule", nil, nil, 10, token)
+		if !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("token %q: expected ErrInvalidPageToken, got %v", token, err)
+		}
+	}
+}
+
+func TestPostgresStore_ListOverrides_CursorQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "start_time", "end_time", "reason", "created_by", "created_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY start_time, id LIMIT $3")).
+		WithArgs("schedule-1", auth.DefaultTenantID, 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("o1", "user-1", start, start.Add(time.Hour), nil, nil, start).
+			AddRow("o2", "user-2", start, start.Add(time.Hour), nil, nil, start))
+
+	first, err := store.ListOverrides(ctx, "schedule-1", nil, nil, 1, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Overrides) != 1 || first.NextPageToken == "" {
+		t.Fatalf("expected one override and a next page token, got %d and %q", len(first.Overrides), first.NextPageToken)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("AND (start_time, id) > ($3, $4) ORDER BY start_time, id LIMIT $5")).
+		WithArgs("schedule-1", auth.DefaultTenantID, start, "o1", 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("o2", "user-2", start, start.Add(time.Hour), nil, nil, start))
+
+	second, err := store.ListOverrides(ctx, "schedule-1", nil, nil, 1, first.NextPageToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second.Overrides) != 1 || second.NextPageToken != "" {
+		t.Fatalf("expected the last override without a next page token, got %d and %q", len(second.Overrides), second.NextPageToken)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY start_time, id LIMIT $3 OFFSET $4")).
+		WithArgs("schedule-1", auth.DefaultTenantID, 2, 5).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	if _, err := store.ListOverrides(ctx, "schedule-1", nil, nil, 1, "5"); err != nil {
+		t.Fatalf("unexpected error for legacy token: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func vacationOverrides(start time.Time, days int) []*routingv1.ScheduleOverride {
+	overrides := make([]*routingv1.ScheduleOverride, 0, days)
+	for i := 0; i < days; i++ {
+		day := start.AddDate(0, 0, i)
+		overrides = append(overrides, &routingv1.ScheduleOverride{
+			UserId:    "user-cover",
+			StartTime: timestamppb.New(day),
+			EndTime:   timestamppb.New(day.Add(24 * time.Hour)),
+			Reason:    "Vacation cover",
+		})
+	}
+	return overrides
+}
+
+func TestInMemoryStore_BulkCreateOverrides(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	created, err := store.BulkCreateOverrides(ctx, "test-schedule", vacationOverrides(start, 10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(created) != 10 {
+		t.Fatalf("expected 10 overrides, got %d", len(created))
+	}
+	ids := make(map[string]bool)
+	for _, o := range created {
+		if o.Id == "" || o.CreatedAt == nil {
+			t.Errorf("expected ID and created_at to be set, got %+v", o)
+		}
+		ids[o.Id] = true
+	}
+	if len(ids) != 10 {
+		t.Errorf("expected 10 distinct IDs, got %d", len(ids))
+	}
+
+	resp, _ := store.ListOverrides(ctx, "test-schedule", nil, nil, 100, "")
+	if len(resp.Overrides) != 10 {
+		t.Errorf("expected 10 stored overrides, got %d", len(resp.Overrides))
+	}
+}
+
+func TestInMemoryStore_BulkCreateOverrides_ValidationAbortsBatch(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		mutate func(o *routingv1.ScheduleOverride)
+	}{
+		{"missing user_id", func(o *routingv1.ScheduleOverride) { o.UserId = "" }},
+		{"start after end", func(o *routingv1.ScheduleOverride) { o.StartTime, o.EndTime = o.EndTime, o.StartTime }},
+		{"missing end_time", func(o *routingv1.ScheduleOverride) { o.EndTime = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrides := vacationOverrides(start, 5)
+			tt.mutate(overrides[3])
+
+			_, err := store.BulkCreateOverrides(ctx, "test-schedule", overrides)
+			if !errors.Is(err, ErrInvalidOverride) {
+				t.Fatalf("expected ErrInvalidOverride, got %v", err)
+			}
+
+			resp, _ := store.ListOverrides(ctx, "test-schedule", nil, nil, 100, "")
+			if len(resp.Overrides) != 0 {
+				t.Errorf("expected no overrides to be created, got %d", len(resp.Overrides))
+			}
+		})
+	}
+}
+
+func TestInMemoryStore_BulkCreateOverrides_Deduplicates(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	overrides := vacationOverrides(start, 3)
+	// Same user and period as the first override, different reason
+	duplicate := vacationOverrides(start, 1)[0]
+	duplicate.Reason = "Submitted twice"
+	overrides = append(overrides, duplicate)
+
+	created, err := store.BulkCreateOverrides(ctx, "test-schedule", overrides)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created) != 3 {
+		t.Fatalf("expected 3 overrides after deduplication, got %d", len(created))
+	}
+	if created[0].Reason != "Vacation cover" {
+		t.Errorf("expected the first occurrence to be kept, got reason %q", created[0].Reason)
+	}
+}
+
+func TestInMemoryStore_BulkCreateOverrides_Limits(t *testing.T) {
+	store := NewInMemoryStore()
+	ctx := context.Background()
+	_, _ = store.CreateSchedule(ctx, &routingv1.Schedule{Id: "test-schedule", Name: "Test Schedule"})
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := store.BulkCreateOverrides(ctx, "test-schedule", nil); !errors.Is(err, ErrInvalidOverride) {
+		t.Errorf("expected ErrInvalidOverride for an empty batch, got %v", err)
+	}
+	if _, err := store.BulkCreateOverrides(ctx, "test-schedule", vacationOverrides(start, MaxBulkOverrides+1)); !errors.Is(err, ErrInvalidOverride) {
+		t.Errorf("expected ErrInvalidOverride for an oversized batch, got %v", err)
+	}
+	if _, err := store.BulkCreateOverrides(ctx, "missing", vacationOverrides(start, 1)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown schedule, got %v", err)
+	}
+}
+
+func TestPostgresStore_BulkCreateOverrides_SingleInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectQuery("FROM schedules WHERE id").
+		WithArgs("schedule-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "timezone", "team_id", "created_at", "updated_at",
+			"handoff_reminder_notice_seconds", "handoff_reminder_channel"}).
+			AddRow("schedule-1", "Primary", nil, "UTC", nil, now, now, nil, nil))
+	mock.ExpectQuery("FROM rotations").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("FROM schedule_overrides").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("VALUES ($1, $2, $3, $4, $5, $6, $7, $8), ($9, $10, $11, $12, $13, $14, $15, $16), ($17,")).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	start := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	created, err := store.BulkCreateOverrides(ctx, "schedule-1", vacationOverrides(start, 3))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created) != 3 {
+		t.Errorf("expected 3 overrides, got %d", len(created))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func newReorderTestStore(t *testing.T) *InMemoryStore {
+	t.Helper()
+
+	store := NewInMemoryStore()
+	_, err := store.CreateSchedule(context.Background(), &routingv1.Schedule{
+		Id:   "test-schedule",
+		Name: "Test Schedule",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:   "rotation-1",
+				Name: "Primary",
+				Members: []*routingv1.RotationMember{
+					{UserId: "alice", Position: 0},
+					{UserId: "bob", Position: 1},
+					{UserId: "carol", Position: 2},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return store
+}
+
+func TestInMemoryStore_ReorderRotationMembers(t *testing.T) {
+	store := newReorderTestStore(t)
+
+	rotation, err := store.ReorderRotationMembers(context.Background(), "test-schedule", "rotation-1", []string{"carol", "alice", "bob"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"carol", "alice", "bob"}
+	for i, member := range rotation.Members {
+		if member.UserId != want[i] || member.Position != int32(i) {
+			t.Errorf("member %d: expected %s at position %d, got %s at %d", i, want[i], i, member.UserId, member.Position)
+		}
+	}
+}
+
+func TestInMemoryStore_ReorderRotationMembers_Mismatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ordered []string
+	}{
+		{"missing user", []string{"carol", "alice"}},
+		{"extra user", []string{"carol", "alice", "bob", "dave"}},
+		{"unknown user", []string{"carol", "alice", "dave"}},
+		{"duplicate user", []string{"carol", "alice", "alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newReorderTestStore(t)
+
+			_, err := store.ReorderRotationMembers(context.Background(), "test-schedule", "rotation-1", tt.ordered)
+			if !errors.Is(err, ErrMemberMismatch) {
+				t.Fatalf("expected ErrMemberMismatch, got %v", err)
+			}
+
+			sched, _ := store.GetSchedule(context.Background(), "test-schedule")
+			if sched.Rotations[0].Members[0].UserId != "alice" {
+				t.Error("expected member order to be unchanged")
+			}
+		})
+	}
+}
+
+func TestInMemoryStore_ReorderRotationMembers_NotFound(t *testing.T) {
+	store := newReorderTestStore(t)
+
+	if _, err := store.ReorderRotationMembers(context.Background(), "test-schedule", "missing", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing rotation, got %v", err)
+	}
+	if _, err := store.ReorderRotationMembers(context.Background(), "missing", "rotation-1", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing schedule, got %v", err)
+	}
+}
+
+func TestPostgresStore_ReorderRotationMembers_MismatchRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedules WHERE id = $1 AND tenant_id = $2 FOR UPDATE")).
+		WithArgs("schedule-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("schedule-1"))
+	mock.ExpectQuery("FROM rotations WHERE id").
+		WithArgs("rotation-1", "schedule-1").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("FROM rotation_members WHERE rotation_id").
+		WithArgs("rotation-1").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("alice").AddRow("bob"))
+	mock.ExpectRollback()
+
+	_, err = NewPostgresStore(db).ReorderRotationMembers(context.Background(), "schedule-1", "rotation-1", []string{"bob", "carol"})
+	if !errors.Is(err, ErrMemberMismatch) {
+		t.Fatalf("expected ErrMemberMismatch, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_GetSchedule_HandoffReminder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	now := time.Now()
+
+	mock.ExpectQuery("FROM schedules WHERE id").
+		WithArgs("schedule-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "timezone", "team_id", "created_at", "updated_at",
+			"handoff_reminder_notice_seconds", "handoff_reminder_channel"}).
+			AddRow("schedule-1", "Primary", nil, "UTC", nil, now, now, 3600, "CHANNEL_TYPE_SLACK"))
+	mock.ExpectQuery("FROM rotations").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("FROM schedule_overrides").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	schedule, err := store.GetSchedule(context.Background(), "schedule-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reminder := schedule.HandoffReminder
+	if reminder == nil {
+		t.Fatal("expected handoff reminder config")
+	}
+	if reminder.AdvanceNotice.AsDuration() != time.Hour || reminder.Channel != routingv1.ChannelType_CHANNEL_TYPE_SLACK {
+		t.Errorf("expected 1h Slack reminders, got %v on %v", reminder.AdvanceNotice.AsDuration(), reminder.Channel)
+	}
+}
+
+func TestPostgresStore_SplitOverride_SingleTransaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(8 * time.Hour)
+	split := start.Add(4 * time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedules WHERE id = $1 AND tenant_id = $2 FOR UPDATE")).
+		WithArgs("schedule-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("schedule-1"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedule_overrides WHERE id = $1 AND schedule_id = $2")).
+		WithArgs("override-1", "schedule-1").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "start_time", "end_time", "reason", "created_by"}).
+			AddRow("user-1", start, end, "Vacation cover", nil))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM schedule_overrides WHERE id = $1")).
+		WithArgs("override-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO schedule_overrides").
+		WithArgs(sqlmock.AnyArg(), "schedule-1", "user-1", start, split, "Vacation cover", "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO schedule_overrides").
+		WithArgs(sqlmock.AnyArg(), "schedule-1", "user-2", split, end, "Vacation cover", "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	halves, err := store.SplitOverride(context.Background(), "schedule-1", "override-1", split, "user-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(halves) != 2 || halves[0].UserId != "user-1" || halves[1].UserId != "user-2" {
+		t.Errorf("unexpected halves: %v", halves)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_SplitOverride_InvalidSplitRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedules WHERE id = $1 AND tenant_id = $2 FOR UPDATE")).
+		WithArgs("schedule-1", auth.DefaultTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("schedule-1"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedule_overrides WHERE id = $1 AND schedule_id = $2")).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "start_time", "end_time", "reason", "created_by"}).
+			AddRow("user-1", start, start.Add(8*time.Hour), nil, nil))
+	mock.ExpectRollback()
+
+	_, err = store.SplitOverride(context.Background(), "schedule-1", "override-1", start, "user-2")
+	if !errors.Is(err, ErrInvalidOverride) {
+		t.Errorf("expected ErrInvalidOverride, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestInMemoryStore_TenantIsolation(t *testing.T) {
+	store := NewInMemoryStore()
+	tenantA := auth.WithTenant(context.Background(), "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01")
+	tenantB := auth.WithTenant(context.Background(), "0b3e7c1d-5a2f-4c8e-9d6b-1e4f7a2c3b5d")
+
+	if _, err := store.CreateSchedule(tenantA, &routingv1.Schedule{Id: "primary", Name: "Primary"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := store.GetSchedule(tenantB, "primary"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound from another tenant, got %v", err)
+	}
+	resp, err := store.ListSchedules(tenantB, &routingv1.ListSchedulesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Schedules) != 0 {
+		t.Errorf("expected no schedules for another tenant, got %d", len(resp.Schedules))
+	}
+	if _, err := store.CreateOverride(tenantB, "primary", &routingv1.ScheduleOverride{UserId: "user-1"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound creating an override from another tenant, got %v", err)
+	}
+	if err := store.DeleteSchedule(tenantB, "primary"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting from another tenant, got %v", err)
+	}
+
+	if _, err := store.GetSchedule(tenantA, "primary"); err != nil {
+		t.Errorf("expected the owning tenant to keep its schedule, got %v", err)
+	}
+}
+
+func TestPostgresStore_RemoveRotation_OtherTenant(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	tenantID := "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01"
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM schedules WHERE id = $1 AND tenant_id = $2 FOR UPDATE")).
+		WithArgs("schedule-1", tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	ctx := auth.WithTenant(context.Background(), tenantID)
+	if _, err := NewPostgresStore(db).RemoveRotation(ctx, "schedule-1", "rotation-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestPostgresStore_ListTenants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	tenantID := "6f1c2a52-93d4-4d1e-8d8e-2f3c1b7a9e01"
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT tenant_id FROM schedules")).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}).
+			AddRow(auth.DefaultTenantID).
+			AddRow(tenantID))
+
+	tenants, err := NewPostgresStore(db).ListTenants(context.Background())
+	if err != nil {
+		t.Fatalf("ListTenants failed: %v", err)
+	}
+	if len(tenants) != 2 || tenants[0] != auth.DefaultTenantID || tenants[1] != tenantID {
+		t.Errorf("expected both tenants, got %v", tenants)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// EventUpcomingShifts is the event sent on connect with the current upcoming shifts.
+	EventUpcomingShifts EventType = "upcoming_shifts"
+
+	// DefaultStreamShiftWindow is how far ahead upcoming shifts are calculated.
+	DefaultStreamShiftWindow = 30 * 24 * time.Hour
+
+	// DefaultStreamKeepAlive is the interval between keep-alive comments.
+	DefaultStreamKeepAlive = 30 * time.Second
+)
+
+// StreamHandler serves schedule changes to subscribers as Server-Sent Events.
+type StreamHandler struct {
+	store       Store
+	bus         *EventBus
+	calculator  *Calculator
+	shiftWindow time.Duration
+	keepAlive   time.Duration
+	logger      zerolog.Logger
+}
+
+// NewStreamHandler creates a new schedule event stream handler.
+func NewStreamHandler(store Store, bus *EventBus, logger zerolog.Logger) *StreamHandler {
+	return &StreamHandler{
+		store:       store,
+		bus:         bus,
+		calculator:  NewCalculator(),
+		shiftWindow: DefaultStreamShiftWindow,
+		keepAlive:   DefaultStreamKeepAlive,
+		logger:      logger.With().Str("component", "schedule_stream").Logger(),
+	}
+}
+
+// RegisterRoutes registers the stream routes on the provided router group.
+func (h *StreamHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/schedules/:id/events", h.StreamEvents)
+}
+
+// StreamEvents handles GET /schedules/:id/events.
+// The current upcoming shifts are sent immediately, followed by any events missed since
+// the Last-Event-ID header and then live schedule changes until the client disconnects.
+func (h *StreamHandler) StreamEvents(c *gin.Context) {
+	scheduleID := c.Param("id")
+	ctx := c.Request.Context()
+
+	var lastEventID uint64
+	if header := c.GetHeader("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid Last-Event-ID"})
+			return
+		}
+		lastEventID = id
+	}
+
+	sched, err := h.store.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "schedule not found"})
+			return
+		}
+		h.logger.Error().Err(err).Str("schedule_id", scheduleID).Msg("failed to get schedule")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get schedule"})
+		return
+	}
+
+	// Subscribe before building the snapshot so no change is lost in between.
+	missed, events, unsubscribe := h.bus.Subscribe(scheduleID, lastEventID)
+	defer unsubscribe()
+
+	from := time.Now()
+	until := from.Add(h.shiftWindow)
+	overrides, err := h.store.ListOverrides(ctx, scheduleID, timestamppb.New(from), timestamppb.New(until), 100, "")
+	if err != nil {
+		h.logger.Warn().Err(err).Msg("failed to get overrides, continuing without")
+		overrides = &routingv1.ListOverridesResponse{}
+	}
+	snapshot := &routingv1.ListUpcomingShiftsResponse{
+		Shifts: h.calculator.ListUpcomingShifts(sched, overrides.Overrides, from, until, ""),
+	}
+
+	// The stream outlives the server's write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	if err := writeSSE(c.Writer, 0, EventUpcomingShifts, snapshot); err != nil {
+		h.logger.Warn().Err(err).Str("schedule_id", scheduleID).Msg("failed to write upcoming shifts")
+		return
+	}
+	for _, event := range missed {
+		if err := writeSSE(c.Writer, event.ID, event.Type, event.Data); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	h.logger.Debug().
+		Str("schedule_id", scheduleID).
+		Uint64("last_event_id", lastEventID).
+		Int("replayed", len(missed)).
+		Msg("schedule stream subscriber connected")
+
+	keepAlive := time.NewTicker(h.keepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeSSE(c.Writer, event.ID, event.Type, event.Data); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-keepAlive.C:
+			if _, err := io.WriteString(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeSSE writes a single Server-Sent Event. An id of 0 omits the id field.
+func writeSSE(w io.Writer, id uint64, eventType EventType, data proto.Message) error {
+	payload := []byte("{}")
+	if data != nil {
+		encoded, err := protojson.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode event data: %w", err)
+		}
+		payload = encoded
+	}
+
+	if id > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, payload)
+	return err
+}
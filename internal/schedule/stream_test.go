@@ -0,0 +1,235 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// flushRecorder is an httptest.ResponseRecorder that can be read while the
+// handler is still streaming and records calls to Flush.
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	mu      sync.Mutex
+	flushes int
+}
+
+func newFlushRecorder() *flushRecorder {
+	return &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+}
+
+func (r *flushRecorder) Write(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ResponseRecorder.Write(b)
+}
+
+func (r *flushRecorder) WriteString(s string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ResponseRecorder.WriteString(s)
+}
+
+func (r *flushRecorder) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flushes++
+}
+
+func (r *flushRecorder) snapshot() (string, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.Body.String(), r.flushes
+}
+
+// waitForBody waits until the streamed body contains substr and has been flushed.
+func (r *flushRecorder) waitForBody(t *testing.T, substr string) string {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		body, flushes := r.snapshot()
+		if flushes > 0 && strings.Contains(body, substr) {
+			return body
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %q in stream body:\n%s", substr, body)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func setupStreamTest(t *testing.T) (*gin.Engine, *InMemoryStore, *EventBus) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	store := NewInMemoryStore()
+	_, _ = store.CreateSchedule(context.Background(), &routingv1.Schedule{
+		Id:   "test-schedule",
+		Name: "Test Schedule",
+		Rotations: []*routingv1.Rotation{
+			{
+				Id:   "rotation-1",
+				Name: "Primary",
+				Type: routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				Members: []*routingv1.RotationMember{
+					{UserId: "user-1", Position: 0},
+					{UserId: "user-2", Position: 1},
+				},
+				StartTime: timestamppb.New(time.Now().Add(-24 * time.Hour)),
+				ShiftConfig: &routingv1.ShiftConfig{
+					ShiftLength: durationpb.New(7 * 24 * time.Hour),
+				},
+			},
+		},
+	})
+
+	bus := NewEventBus(0)
+	router := gin.New()
+	NewStreamHandler(store, bus, zerolog.Nop()).RegisterRoutes(router.Group("/api/v1"))
+
+	return router, store, bus
+}
+
+// startStream serves a stream request in the background and returns a function
+// that disconnects the client and waits for the handler to return.
+func startStream(router *gin.Engine, rec *flushRecorder, lastEventID string) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/test-schedule/events", nil).WithContext(ctx)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(rec, req)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func TestStreamHandler_SendsUpcomingShiftsOnConnect(t *testing.T) {
+	router, _, _ := setupStreamTest(t)
+	rec := newFlushRecorder()
+
+	stop := startStream(router, rec, "")
+	body := rec.waitForBody(t, "event: upcoming_shifts\n")
+	stop()
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected Content-Type text/event-stream, got %s", ct)
+	}
+	if !strings.Contains(body, "user-1") {
+		t.Errorf("expected upcoming shifts to include user-1, got:\n%s", body)
+	}
+	if strings.Contains(body, "id: ") {
+		t.Errorf("expected snapshot event without id, got:\n%s", body)
+	}
+}
+
+func TestStreamHandler_PushesScheduleChanges(t *testing.T) {
+	router, _, bus := setupStreamTest(t)
+	rec := newFlushRecorder()
+
+	stop := startStream(router, rec, "")
+	rec.waitForBody(t, "event: upcoming_shifts\n")
+
+	bus.Publish("other-schedule", EventOverrideCreated, &routingv1.ScheduleOverride{Id: "override-other"})
+	bus.Publish("test-schedule", EventOverrideCreated, &routingv1.ScheduleOverride{Id: "override-1", UserId: "user-3"})
+
+	body := rec.waitForBody(t, "id: 2\nevent: override_created\n")
+	stop()
+
+	if !strings.Contains(body, "override-1") {
+		t.Errorf("expected override-1 in stream, got:\n%s", body)
+	}
+	if strings.Contains(body, "override-other") {
+		t.Errorf("expected events for other schedules to be filtered, got:\n%s", body)
+	}
+	if n := bus.SubscriberCount("test-schedule"); n != 0 {
+		t.Errorf("expected subscriber to be removed after disconnect, got %d", n)
+	}
+}
+
+func TestStreamHandler_ReplaysFromLastEventID(t *testing.T) {
+	router, _, bus := setupStreamTest(t)
+
+	bus.Publish("test-schedule", EventRotationUpdated, &routingv1.Schedule{Id: "test-schedule", Name: "v1"})
+	bus.Publish("test-schedule", EventRotationUpdated, &routingv1.Schedule{Id: "test-schedule", Name: "v2"})
+	bus.Publish("test-schedule", EventOverrideCreated, &routingv1.ScheduleOverride{Id: "override-1"})
+
+	rec := newFlushRecorder()
+	stop := startStream(router, rec, "1")
+	body := rec.waitForBody(t, "id: 3\n")
+	stop()
+
+	if strings.Contains(body, "id: 1\n") {
+		t.Errorf("expected event 1 not to be replayed, got:\n%s", body)
+	}
+	if !strings.Contains(body, "id: 2\nevent: rotation_updated\n") {
+		t.Errorf("expected event 2 to be replayed, got:\n%s", body)
+	}
+	if strings.Index(body, "event: upcoming_shifts") > strings.Index(body, "id: 2\n") {
+		t.Errorf("expected upcoming shifts before replayed events, got:\n%s", body)
+	}
+}
+
+func TestStreamHandler_Errors(t *testing.T) {
+	router, _, _ := setupStreamTest(t)
+
+	tests := []struct {
+		name        string
+		path        string
+		lastEventID string
+		wantStatus  int
+	}{
+		{"schedule not found", "/api/v1/schedules/missing/events", "", http.StatusNotFound},
+		{"invalid last event id", "/api/v1/schedules/test-schedule/events", "abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestEventBus_HistoryIsBounded(t *testing.T) {
+	bus := NewEventBus(2)
+	for i := 0; i < 4; i++ {
+		bus.Publish("test-schedule", EventScheduleUpdated, nil)
+	}
+
+	missed, _, unsubscribe := bus.Subscribe("test-schedule", 1)
+	defer unsubscribe()
+
+	if len(missed) != 2 {
+		t.Fatalf("expected 2 retained events, got %d", len(missed))
+	}
+	if missed[0].ID != 3 || missed[1].ID != 4 {
+		t.Errorf("expected events 3 and 4, got %d and %d", missed[0].ID, missed[1].ID)
+	}
+}
@@ -0,0 +1,84 @@
+package silence
+
+import (
+	"fmt"
+	"regexp"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// operatorSymbols maps match operators to the symbols they are stored and
+// displayed as.
+var operatorSymbols = map[alertingv1.MatchOperator]string{
+	alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL:     "=",
+	alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL: "!=",
+	alertingv1.MatchOperator_MATCH_OPERATOR_REGEX:     "=~",
+	alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX: "!~",
+}
+
+// OperatorSymbol returns the symbol of a match operator, e.g. "=~", or "" if
+// the operator is not specified.
+func OperatorSymbol(op alertingv1.MatchOperator) string {
+	return operatorSymbols[op]
+}
+
+// ParseOperator returns the match operator of a symbol, e.g. "!=".
+func ParseOperator(symbol string) (alertingv1.MatchOperator, bool) {
+	for op, s := range operatorSymbols {
+		if s == symbol {
+			return op, true
+		}
+	}
+	return alertingv1.MatchOperator_MATCH_OPERATOR_UNSPECIFIED, false
+}
+
+// compileMatcher compiles the regular expression of a regex matcher, anchored
+// at both ends so that "ams" does not match "ams1". Other matchers return nil.
+func compileMatcher(m *alertingv1.LabelMatcher) (*regexp.Regexp, error) {
+	switch m.Operator {
+	case alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX:
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("matcher %s%s%q: %w", m.Name, OperatorSymbol(m.Operator), m.Value, err)
+		}
+		return re, nil
+	}
+	return nil, nil
+}
+
+// matchValue reports whether a label value satisfies a matcher, with re the
+// compiled expression of regex matchers.
+func matchValue(m *alertingv1.LabelMatcher, re *regexp.Regexp, value string) bool {
+	switch m.Operator {
+	case alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL:
+		return value == m.Value
+	case alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL:
+		return value != m.Value
+	case alertingv1.MatchOperator_MATCH_OPERATOR_REGEX:
+		return re.MatchString(value)
+	case alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX:
+		return !re.MatchString(value)
+	}
+	return false
+}
+
+// Matches reports whether labels satisfy every matcher of a silence. A label
+// missing from labels has the empty value, so env!="prod" matches alerts
+// without an env label. Silences with an invalid regular expression match
+// nothing.
+func Matches(silence *alertingv1.SilenceRule, labels map[string]string) bool {
+	if silence == nil || len(silence.Matchers) == 0 {
+		return false
+	}
+
+	for _, m := range silence.Matchers {
+		re, err := compileMatcher(m)
+		if err != nil {
+			return false
+		}
+		if !matchValue(m, re, labels[m.Name]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package silence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func matcher(name string, op alertingv1.MatchOperator, value string) *alertingv1.LabelMatcher {
+	return &alertingv1.LabelMatcher{Name: name, Value: value, Operator: op}
+}
+
+func TestMatches_Operators(t *testing.T) {
+	labels := map[string]string{"env": "staging", "cluster": "ams1", "service": "checkout-api"}
+
+	tests := []struct {
+		name    string
+		matcher *alertingv1.LabelMatcher
+		want    bool
+	}{
+		{"equal", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL, "staging"), true},
+		{"equal other value", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL, "production"), false},
+		{"not equal", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL, "production"), true},
+		{"not equal same value", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL, "staging"), false},
+		{"not equal missing label", matcher("team", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL, "network"), true},
+		{"unspecified operator", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_UNSPECIFIED, "staging"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence := &alertingv1.SilenceRule{Matchers: []*alertingv1.LabelMatcher{tt.matcher}}
+			assert.Equal(t, tt.want, Matches(silence, labels))
+		})
+	}
+}
+
+func TestMatches_RegexMatchers(t *testing.T) {
+	labels := map[string]string{"env": "staging", "cluster": "ams1", "service": "checkout-api"}
+
+	tests := []struct {
+		name    string
+		matcher *alertingv1.LabelMatcher
+		want    bool
+	}{
+		{"regex", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams[0-9]+"), true},
+		{"regex alternation", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "dev|staging"), true},
+		{"regex is anchored at the end", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams"), false},
+		{"regex is anchored at the start", matcher("service", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "api"), false},
+		{"regex prefix", matcher("service", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "checkout-.*"), true},
+		{"regex alternation is anchored", matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "stag|prod"), false},
+		{"regex missing label", matcher("team", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, ".+"), false},
+		{"regex empty value matches missing label", matcher("team", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "|network"), true},
+		{"not regex", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, "fra[0-9]+"), true},
+		{"not regex matching value", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, "ams.*"), false},
+		{"not regex is anchored", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, "ams"), true},
+		{"invalid regex", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams[0-9"), false},
+		{"invalid negated regex", matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, "("), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence := &alertingv1.SilenceRule{Matchers: []*alertingv1.LabelMatcher{tt.matcher}}
+			assert.Equal(t, tt.want, Matches(silence, labels))
+		})
+	}
+}
+
+func TestMatches_AllMatchersMustMatch(t *testing.T) {
+	silence := &alertingv1.SilenceRule{Matchers: []*alertingv1.LabelMatcher{
+		matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL, "staging"),
+		matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams.*"),
+	}}
+
+	assert.True(t, Matches(silence, map[string]string{"env": "staging", "cluster": "ams2"}))
+	assert.False(t, Matches(silence, map[string]string{"env": "staging", "cluster": "fra1"}))
+	assert.False(t, Matches(silence, map[string]string{"cluster": "ams2"}))
+
+	assert.False(t, Matches(&alertingv1.SilenceRule{}, map[string]string{"env": "staging"}), "silences without matchers match nothing")
+	assert.False(t, Matches(nil, map[string]string{"env": "staging"}))
+}
+
+func TestParseOperator(t *testing.T) {
+	for _, symbol := range []string{"=", "!=", "=~", "!~"} {
+		op, ok := ParseOperator(symbol)
+		assert.True(t, ok, symbol)
+		assert.Equal(t, symbol, OperatorSymbol(op))
+	}
+
+	_, ok := ParseOperator("==")
+	assert.False(t, ok)
+	assert.Empty(t, OperatorSymbol(alertingv1.MatchOperator_MATCH_OPERATOR_UNSPECIFIED))
+}
@@ -0,0 +1,139 @@
+package silence
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// InMemoryStore is an in-memory implementation of Store for testing.
+type InMemoryStore struct {
+	mu       sync.RWMutex
+	silences map[string]*alertingv1.SilenceRule
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		silences: make(map[string]*alertingv1.SilenceRule),
+	}
+}
+
+// Create creates a new silence in memory.
+func (s *InMemoryStore) Create(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error) {
+	if err := Validate(silence); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if silence.Id == "" {
+		silence.Id = uuid.New().String()
+	}
+
+	now := timestamppb.Now()
+	silence.CreatedAt = now
+	silence.UpdatedAt = now
+
+	s.silences[silence.Id] = cloneSilence(silence)
+	return silence, nil
+}
+
+// Get retrieves a silence by ID.
+func (s *InMemoryStore) Get(ctx context.Context, id string) (*alertingv1.SilenceRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	silence, ok := s.silences[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return cloneSilence(silence), nil
+}
+
+// List retrieves all silences, most recently started first.
+func (s *InMemoryStore) List(ctx context.Context) ([]*alertingv1.SilenceRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	silences := make([]*alertingv1.SilenceRule, 0, len(s.silences))
+	for _, silence := range s.silences {
+		silences = append(silences, cloneSilence(silence))
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		a, b := silences[i].StartsAt.AsTime(), silences[j].StartsAt.AsTime()
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return silences[i].CreatedAt.AsTime().After(silences[j].CreatedAt.AsTime())
+	})
+	return silences, nil
+}
+
+// Update updates an existing silence.
+func (s *InMemoryStore) Update(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error) {
+	if silence == nil || silence.Id == "" {
+		return nil, ErrInvalidSilence
+	}
+	if err := Validate(silence); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.silences[silence.Id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	silence.CreatedAt = existing.CreatedAt
+	silence.UpdatedAt = timestamppb.Now()
+	s.silences[silence.Id] = cloneSilence(silence)
+	return silence, nil
+}
+
+// Delete deletes a silence by ID.
+func (s *InMemoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.silences[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.silences, id)
+	return nil
+}
+
+// ListActive retrieves the silences active at a time, oldest first.
+func (s *InMemoryStore) ListActive(ctx context.Context, at time.Time) ([]*alertingv1.SilenceRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var silences []*alertingv1.SilenceRule
+	for _, silence := range s.silences {
+		if IsActive(silence, at) {
+			silences = append(silences, cloneSilence(silence))
+		}
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].CreatedAt.AsTime().Before(silences[j].CreatedAt.AsTime())
+	})
+	return silences, nil
+}
+
+// cloneSilence returns a deep copy of silence to avoid external modifications.
+func cloneSilence(silence *alertingv1.SilenceRule) *alertingv1.SilenceRule {
+	return proto.Clone(silence).(*alertingv1.SilenceRule)
+}
+
+// Ensure InMemoryStore implements Store
+var _ Store = (*InMemoryStore)(nil)
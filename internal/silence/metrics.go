@@ -0,0 +1,42 @@
+package silence
+
+import (
+	"sync"
+)
+
+// Metrics tracks silence metrics.
+// Exposed as the silenced_alerts_total{silence_id} counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// silencedAlerts counts incoming alerts suppressed by each silence.
+	silencedAlerts map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		silencedAlerts: make(map[string]int64),
+	}
+}
+
+// RecordSilenced increments the silenced alerts counter of a silence.
+func (m *Metrics) RecordSilenced(silenceID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencedAlerts[silenceID]++
+}
+
+// SilencedAlertsTotal returns the number of alerts suppressed by a silence.
+func (m *Metrics) SilencedAlertsTotal(silenceID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.silencedAlerts[silenceID]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silencedAlerts = make(map[string]int64)
+}
@@ -0,0 +1,92 @@
+package silence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// silencedByPrefix prefixes the silence ID in the suppression reason.
+const silencedByPrefix = "silenced_by:"
+
+// Silencer checks incoming alerts against the active silences.
+type Silencer struct {
+	silences Store
+	metrics  *Metrics
+	logger   zerolog.Logger
+
+	// now returns the time silences are checked at
+	now func() time.Time
+}
+
+// NewSilencer creates a new Silencer.
+func NewSilencer(silences Store, logger zerolog.Logger, metrics *Metrics) *Silencer {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &Silencer{
+		silences: silences,
+		metrics:  metrics,
+		logger:   logger.With().Str("component", "silencer").Logger(),
+		now:      time.Now,
+	}
+}
+
+// Metrics returns the metrics recorder for this silencer.
+func (s *Silencer) Metrics() *Metrics {
+	return s.metrics
+}
+
+// Check returns the first active silence matching the alert's labels, or nil
+// if the alert is not silenced.
+func (s *Silencer) Check(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.SilenceRule, error) {
+	if alert == nil {
+		return nil, nil
+	}
+
+	silences, err := s.silences.ListActive(ctx, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("list active silences: %w", err)
+	}
+
+	for _, silence := range silences {
+		if Matches(silence, alert.Labels) {
+			return silence, nil
+		}
+	}
+	return nil, nil
+}
+
+// Apply suppresses the alert if an active silence matches it, recording the
+// silence in the same suppression_reason annotation as inhibited alerts.
+// Returns the matching silence, or nil if the alert is not silenced.
+func (s *Silencer) Apply(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.SilenceRule, error) {
+	if alert == nil || alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		return nil, nil
+	}
+
+	silence, err := s.Check(ctx, alert)
+	if err != nil || silence == nil {
+		return nil, err
+	}
+
+	alert.Status = alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED
+	if alert.Annotations == nil {
+		alert.Annotations = make(map[string]string)
+	}
+	alert.Annotations[inhibition.AnnotationSuppressionReason] = silencedByPrefix + silence.Id
+	s.metrics.RecordSilenced(silence.Id)
+
+	s.logger.Info().
+		Str("fingerprint", alert.Fingerprint).
+		Str("silenceId", silence.Id).
+		Msg("alert silenced")
+
+	return silence, nil
+}
@@ -0,0 +1,84 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// failingStore is a Store whose ListActive fails.
+type failingStore struct {
+	Store
+}
+
+func (failingStore) ListActive(ctx context.Context, at time.Time) ([]*alertingv1.SilenceRule, error) {
+	return nil, errors.New("database unavailable")
+}
+
+func triggeredAlert(labels map[string]string) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Fingerprint: "fp-1",
+		Status:      alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		Labels:      labels,
+	}
+}
+
+func TestSilencer_Apply(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Now()
+
+	silence := newTestSilence(now.Add(-time.Hour))
+	silence.Matchers = append(silence.Matchers, matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams[0-9]+"))
+	created, err := store.Create(context.Background(), silence)
+	require.NoError(t, err)
+
+	silencer := NewSilencer(store, zerolog.Nop(), nil)
+	silencer.now = func() time.Time { return now }
+
+	alert := triggeredAlert(map[string]string{"env": "staging", "cluster": "ams2"})
+	result, err := silencer.Apply(context.Background(), alert)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, created.Id, result.Id)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED, alert.Status)
+	assert.Equal(t, "silenced_by:"+created.Id, alert.Annotations[inhibition.AnnotationSuppressionReason])
+	assert.Equal(t, int64(1), silencer.Metrics().SilencedAlertsTotal(created.Id))
+
+	unmatched := triggeredAlert(map[string]string{"env": "staging", "cluster": "fra1"})
+	result, err = silencer.Apply(context.Background(), unmatched)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, unmatched.Status)
+
+	// Resolved alerts are stored as they are
+	resolved := triggeredAlert(map[string]string{"env": "staging", "cluster": "ams2"})
+	resolved.Status = alertingv1.AlertStatus_ALERT_STATUS_RESOLVED
+	result, err = silencer.Apply(context.Background(), resolved)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, resolved.Status)
+
+	// The silence no longer applies once it has ended
+	silencer.now = func() time.Time { return now.Add(2 * time.Hour) }
+	expired := triggeredAlert(map[string]string{"env": "staging", "cluster": "ams2"})
+	result, err = silencer.Apply(context.Background(), expired)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestSilencer_StoreError(t *testing.T) {
+	silencer := NewSilencer(failingStore{}, zerolog.Nop(), nil)
+
+	alert := triggeredAlert(map[string]string{"env": "staging"})
+	_, err := silencer.Apply(context.Background(), alert)
+	assert.Error(t, err)
+	assert.Equal(t, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alert.Status)
+}
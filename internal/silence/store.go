@@ -0,0 +1,314 @@
+// Package silence provides silence rules, which suppress every incoming alert
+// matching a set of label matchers for a period of time.
+package silence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+var (
+	// ErrNotFound is returned when a silence is not found.
+	ErrNotFound = errors.New("silence not found")
+	// ErrInvalidSilence is returned when a silence is invalid.
+	ErrInvalidSilence = errors.New("invalid silence")
+)
+
+// Validate checks that a silence has a valid time range, an author and valid
+// matchers, at least one of which does not match the empty value so that a
+// silence cannot suppress every alert.
+func Validate(silence *alertingv1.SilenceRule) error {
+	if silence == nil {
+		return ErrInvalidSilence
+	}
+	if silence.CreatedBy == "" {
+		return fmt.Errorf("%w: created_by is required", ErrInvalidSilence)
+	}
+	if silence.StartsAt == nil || silence.EndsAt == nil {
+		return fmt.Errorf("%w: starts_at and ends_at are required", ErrInvalidSilence)
+	}
+	if !silence.EndsAt.AsTime().After(silence.StartsAt.AsTime()) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidSilence)
+	}
+	if len(silence.Matchers) == 0 {
+		return fmt.Errorf("%w: at least one matcher is required", ErrInvalidSilence)
+	}
+
+	matchesEverything := true
+	for _, m := range silence.Matchers {
+		if m.Name == "" {
+			return fmt.Errorf("%w: matcher name is required", ErrInvalidSilence)
+		}
+		if OperatorSymbol(m.Operator) == "" {
+			return fmt.Errorf("%w: matcher %s: operator is required", ErrInvalidSilence, m.Name)
+		}
+		re, err := compileMatcher(m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSilence, err)
+		}
+		if !matchValue(m, re, "") {
+			matchesEverything = false
+		}
+	}
+	if matchesEverything {
+		return fmt.Errorf("%w: at least one matcher must not match an empty label", ErrInvalidSilence)
+	}
+	return nil
+}
+
+// IsActive reports whether a silence is active at a time. Silences are active
+// from starts_at until, but not including, ends_at.
+func IsActive(silence *alertingv1.SilenceRule, at time.Time) bool {
+	return !at.Before(silence.StartsAt.AsTime()) && at.Before(silence.EndsAt.AsTime())
+}
+
+// Store defines the interface for silence persistence.
+type Store interface {
+	// Create creates a new silence.
+	Create(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error)
+
+	// Get retrieves a silence by ID.
+	Get(ctx context.Context, id string) (*alertingv1.SilenceRule, error)
+
+	// List retrieves all silences, most recently started first.
+	List(ctx context.Context) ([]*alertingv1.SilenceRule, error)
+
+	// Update updates an existing silence.
+	Update(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error)
+
+	// Delete deletes a silence by ID.
+	Delete(ctx context.Context, id string) error
+
+	// ListActive retrieves the silences active at a time.
+	ListActive(ctx context.Context, at time.Time) ([]*alertingv1.SilenceRule, error)
+}
+
+// PostgresStore implements Store using PostgreSQL.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// matcherRecord is the JSON form of a LabelMatcher in the matchers column,
+// with the operator stored as its symbol.
+type matcherRecord struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Operator string `json:"operator"`
+}
+
+// Create creates a new silence in the database.
+func (s *PostgresStore) Create(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error) {
+	if err := Validate(silence); err != nil {
+		return nil, err
+	}
+
+	if silence.Id == "" {
+		silence.Id = uuid.New().String()
+	}
+
+	now := time.Now()
+	silence.CreatedAt = timestamppb.New(now)
+	silence.UpdatedAt = timestamppb.New(now)
+
+	matchersJSON, err := marshalMatchers(silence.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO silence_rules (id, matchers, starts_at, ends_at, comment, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, silence.Id, matchersJSON, silence.StartsAt.AsTime(), silence.EndsAt.AsTime(),
+		nullableString(silence.Comment), silence.CreatedBy, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert silence: %w", err)
+	}
+
+	return silence, nil
+}
+
+// Get retrieves a silence by ID.
+func (s *PostgresStore) Get(ctx context.Context, id string) (*alertingv1.SilenceRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectSilenceColumns+` WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query silence: %w", err)
+	}
+	defer rows.Close()
+
+	silences, err := scanSilences(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(silences) == 0 {
+		return nil, ErrNotFound
+	}
+	return silences[0], nil
+}
+
+// List retrieves all silences, most recently started first.
+func (s *PostgresStore) List(ctx context.Context) ([]*alertingv1.SilenceRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectSilenceColumns+` ORDER BY starts_at DESC, created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("list silences: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSilences(rows)
+}
+
+// Update updates an existing silence.
+func (s *PostgresStore) Update(ctx context.Context, silence *alertingv1.SilenceRule) (*alertingv1.SilenceRule, error) {
+	if silence == nil || silence.Id == "" {
+		return nil, ErrInvalidSilence
+	}
+	if err := Validate(silence); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	matchersJSON, err := marshalMatchers(silence.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt time.Time
+	err = s.db.QueryRowContext(ctx, `
+		UPDATE silence_rules SET
+			matchers = $1, starts_at = $2, ends_at = $3, comment = $4, created_by = $5, updated_at = $6
+		WHERE id = $7
+		RETURNING created_at
+	`, matchersJSON, silence.StartsAt.AsTime(), silence.EndsAt.AsTime(),
+		nullableString(silence.Comment), silence.CreatedBy, now, silence.Id).Scan(&createdAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update silence: %w", err)
+	}
+
+	silence.CreatedAt = timestamppb.New(createdAt)
+	silence.UpdatedAt = timestamppb.New(now)
+	return silence, nil
+}
+
+// Delete deletes a silence by ID.
+func (s *PostgresStore) Delete(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM silence_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete silence: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListActive retrieves the silences active at a time, oldest first.
+func (s *PostgresStore) ListActive(ctx context.Context, at time.Time) ([]*alertingv1.SilenceRule, error) {
+	rows, err := s.db.QueryContext(ctx, selectSilenceColumns+`
+		WHERE starts_at <= $1 AND ends_at > $1
+		ORDER BY created_at`, at)
+	if err != nil {
+		return nil, fmt.Errorf("list active silences: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSilences(rows)
+}
+
+const selectSilenceColumns = `
+	SELECT id, matchers, starts_at, ends_at, comment, created_by, created_at, updated_at
+	FROM silence_rules`
+
+// scanSilences scans silences from query rows.
+func scanSilences(rows *sql.Rows) ([]*alertingv1.SilenceRule, error) {
+	silences := []*alertingv1.SilenceRule{}
+	for rows.Next() {
+		silence := &alertingv1.SilenceRule{}
+		var matchersJSON []byte
+		var comment sql.NullString
+		var startsAt, endsAt, createdAt, updatedAt time.Time
+
+		if err := rows.Scan(
+			&silence.Id, &matchersJSON, &startsAt, &endsAt, &comment,
+			&silence.CreatedBy, &createdAt, &updatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan silence: %w", err)
+		}
+
+		matchers, err := unmarshalMatchers(matchersJSON)
+		if err != nil {
+			return nil, err
+		}
+		silence.Matchers = matchers
+		silence.Comment = comment.String
+		silence.StartsAt = timestamppb.New(startsAt)
+		silence.EndsAt = timestamppb.New(endsAt)
+		silence.CreatedAt = timestamppb.New(createdAt)
+		silence.UpdatedAt = timestamppb.New(updatedAt)
+
+		silences = append(silences, silence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate silences: %w", err)
+	}
+	return silences, nil
+}
+
+// Helper functions
+
+func marshalMatchers(matchers []*alertingv1.LabelMatcher) ([]byte, error) {
+	records := make([]matcherRecord, len(matchers))
+	for i, m := range matchers {
+		records[i] = matcherRecord{Name: m.Name, Value: m.Value, Operator: OperatorSymbol(m.Operator)}
+	}
+
+	matchersJSON, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("marshal matchers: %w", err)
+	}
+	return matchersJSON, nil
+}
+
+func unmarshalMatchers(matchersJSON []byte) ([]*alertingv1.LabelMatcher, error) {
+	var records []matcherRecord
+	if err := json.Unmarshal(matchersJSON, &records); err != nil {
+		return nil, fmt.Errorf("unmarshal matchers: %w", err)
+	}
+
+	matchers := make([]*alertingv1.LabelMatcher, len(records))
+	for i, record := range records {
+		op, _ := ParseOperator(record.Operator)
+		matchers[i] = &alertingv1.LabelMatcher{Name: record.Name, Value: record.Value, Operator: op}
+	}
+	return matchers, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// Ensure PostgresStore implements Store
+var _ Store = (*PostgresStore)(nil)
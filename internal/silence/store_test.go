@@ -0,0 +1,210 @@
+package silence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// newTestSilence returns a silence of env=staging from start for two hours.
+func newTestSilence(start time.Time) *alertingv1.SilenceRule {
+	return &alertingv1.SilenceRule{
+		Matchers: []*alertingv1.LabelMatcher{
+			matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL, "staging"),
+		},
+		StartsAt:  timestamppb.New(start),
+		EndsAt:    timestamppb.New(start.Add(2 * time.Hour)),
+		Comment:   "Staging load test",
+		CreatedBy: "user-1",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name   string
+		modify func(s *alertingv1.SilenceRule)
+		valid  bool
+	}{
+		{name: "valid silence", modify: func(s *alertingv1.SilenceRule) {}, valid: true},
+		{name: "missing created_by", modify: func(s *alertingv1.SilenceRule) { s.CreatedBy = "" }},
+		{name: "missing ends_at", modify: func(s *alertingv1.SilenceRule) { s.EndsAt = nil }},
+		{name: "ends before it starts", modify: func(s *alertingv1.SilenceRule) { s.EndsAt = timestamppb.New(start) }},
+		{name: "no matchers", modify: func(s *alertingv1.SilenceRule) { s.Matchers = nil }},
+		{name: "matcher without name", modify: func(s *alertingv1.SilenceRule) { s.Matchers[0].Name = "" }},
+		{name: "matcher without operator", modify: func(s *alertingv1.SilenceRule) {
+			s.Matchers[0].Operator = alertingv1.MatchOperator_MATCH_OPERATOR_UNSPECIFIED
+		}},
+		{name: "invalid regex", modify: func(s *alertingv1.SilenceRule) {
+			s.Matchers[0] = matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams[")
+		}},
+		{name: "only matchers of empty labels", modify: func(s *alertingv1.SilenceRule) {
+			s.Matchers = []*alertingv1.LabelMatcher{
+				matcher("env", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_EQUAL, "production"),
+				matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, ".*"),
+			}
+		}},
+		{name: "negated matcher with a required label", valid: true, modify: func(s *alertingv1.SilenceRule) {
+			s.Matchers = append(s.Matchers, matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, "ams.*"))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence := newTestSilence(start)
+			tt.modify(silence)
+
+			err := Validate(silence)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidSilence)
+			}
+		})
+	}
+
+	assert.ErrorIs(t, Validate(nil), ErrInvalidSilence)
+}
+
+func TestInMemoryStore_CRUD(t *testing.T) {
+	s := NewInMemoryStore()
+	ctx := context.Background()
+	start := time.Now()
+
+	created, err := s.Create(ctx, newTestSilence(start))
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Id)
+	assert.NotNil(t, created.CreatedAt)
+
+	_, err = s.Create(ctx, &alertingv1.SilenceRule{CreatedBy: "user-1"})
+	assert.ErrorIs(t, err, ErrInvalidSilence)
+
+	got, err := s.Get(ctx, created.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Staging load test", got.Comment)
+
+	got.EndsAt = timestamppb.New(start.Add(4 * time.Hour))
+	updated, err := s.Update(ctx, got)
+	require.NoError(t, err)
+	assert.True(t, updated.CreatedAt.AsTime().Equal(created.CreatedAt.AsTime()))
+
+	later, err := s.Create(ctx, newTestSilence(start.Add(time.Hour)))
+	require.NoError(t, err)
+
+	all, err := s.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, later.Id, all[0].Id, "most recently started silence first")
+
+	require.NoError(t, s.Delete(ctx, created.Id))
+	_, err = s.Get(ctx, created.Id)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, s.Delete(ctx, created.Id), ErrNotFound)
+
+	_, err = s.Update(ctx, newTestSilence(start))
+	assert.ErrorIs(t, err, ErrInvalidSilence)
+	missing := newTestSilence(start)
+	missing.Id = "missing"
+	_, err = s.Update(ctx, missing)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestInMemoryStore_ListActive(t *testing.T) {
+	s := NewInMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	active, err := s.Create(ctx, newTestSilence(now.Add(-time.Hour)))
+	require.NoError(t, err)
+	_, err = s.Create(ctx, newTestSilence(now.Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = s.Create(ctx, newTestSilence(now.Add(-3*time.Hour)))
+	require.NoError(t, err)
+
+	silences, err := s.ListActive(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, silences, 1)
+	assert.Equal(t, active.Id, silences[0].Id)
+
+	// ends_at is exclusive
+	silences, err = s.ListActive(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, silences, 1)
+	assert.NotEqual(t, active.Id, silences[0].Id)
+}
+
+func TestPostgresStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	silence := newTestSilence(start)
+	silence.Matchers = append(silence.Matchers, matcher("cluster", alertingv1.MatchOperator_MATCH_OPERATOR_REGEX, "ams[0-9]+"))
+
+	mock.ExpectExec("INSERT INTO silence_rules").
+		WithArgs(sqlmock.AnyArg(),
+			[]byte(`[{"name":"env","value":"staging","operator":"="},{"name":"cluster","value":"ams[0-9]+","operator":"=~"}]`),
+			start, start.Add(2*time.Hour), "Staging load test", "user-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	created, err := s.Create(context.Background(), silence)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.Id)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_ListActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"id", "matchers", "starts_at", "ends_at", "comment", "created_by", "created_at", "updated_at"}).
+		AddRow("silence-1", []byte(`[{"name":"cluster","value":"ams.*","operator":"!~"}]`),
+			now.Add(-time.Hour), now.Add(time.Hour), nil, "user-1", now, now)
+	mock.ExpectQuery("SELECT (.+) FROM silence_rules WHERE starts_at <= \\$1 AND ends_at > \\$1").
+		WithArgs(now).
+		WillReturnRows(rows)
+
+	silences, err := s.ListActive(context.Background(), now)
+	require.NoError(t, err)
+	require.Len(t, silences, 1)
+	assert.Empty(t, silences[0].Comment)
+	require.Len(t, silences[0].Matchers, 1)
+	assert.Equal(t, alertingv1.MatchOperator_MATCH_OPERATOR_NOT_REGEX, silences[0].Matchers[0].Operator)
+	assert.True(t, Matches(silences[0], map[string]string{"cluster": "fra1"}))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStore_UpdateNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	s := NewPostgresStore(db)
+
+	mock.ExpectQuery("UPDATE silence_rules SET").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+	silence := newTestSilence(time.Now())
+	silence.Id = "missing"
+	_, err = s.Update(context.Background(), silence)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,44 @@
+package site
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics tracks site service metrics.
+// Exposed as the site_capacity_update_latency_seconds histogram.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// capacityUpdateLatency tracks the duration of site capacity updates.
+	capacityUpdateLatency []time.Duration
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordCapacityUpdateLatency records the duration of a site capacity update.
+func (m *Metrics) RecordCapacityUpdateLatency(duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.capacityUpdateLatency = append(m.capacityUpdateLatency, duration)
+}
+
+// GetCapacityUpdateLatencies returns the recorded capacity update durations.
+func (m *Metrics) GetCapacityUpdateLatencies() []time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]time.Duration, len(m.capacityUpdateLatency))
+	copy(result, m.capacityUpdateLatency)
+	return result
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.capacityUpdateLatency = nil
+}
@@ -17,6 +17,22 @@ const (
 	SiteTypeCustomerPremise SiteType = "customer_premise"
 )
 
+// ToProto converts the site type to its proto SiteType.
+func (t SiteType) ToProto() routingv1.SiteType {
+	switch t {
+	case SiteTypeDatacenter:
+		return routingv1.SiteType_SITE_TYPE_DATACENTER
+	case SiteTypePOP:
+		return routingv1.SiteType_SITE_TYPE_POP
+	case SiteTypeHub:
+		return routingv1.SiteType_SITE_TYPE_COLOCATION
+	case SiteTypeCustomerPremise:
+		return routingv1.SiteType_SITE_TYPE_EDGE
+	default:
+		return routingv1.SiteType_SITE_TYPE_UNSPECIFIED
+	}
+}
+
 // Site represents a physical or logical location.
 type Site struct {
 	ID                        string            `json:"id"`
@@ -35,6 +51,7 @@ type Site struct {
 	ParentSiteID              *string           `json:"parentSiteId,omitempty"`
 	Labels                    map[string]string `json:"labels"`
 	BusinessHours             *BusinessHours    `json:"businessHours,omitempty"`
+	Capacity                  *CapacityMetrics  `json:"capacity,omitempty"`
 	CreatedAt                 time.Time         `json:"createdAt"`
 	UpdatedAt                 time.Time         `json:"updatedAt"`
 }
@@ -46,6 +63,13 @@ type BusinessHours struct {
 	Days  []int  `json:"days"`  // 0=Sunday, 1=Monday, ..., 6=Saturday
 }
 
+// CapacityMetrics represents the current utilization of a site.
+type CapacityMetrics struct {
+	TotalServers       int       `json:"totalServers"`
+	CurrentLoadPercent float64   `json:"currentLoadPercent"`
+	LastUpdated        time.Time `json:"lastUpdated"`
+}
+
 // Team represents an on-call team.
 type Team struct {
 	ID                          string    `json:"id"`
@@ -75,6 +99,7 @@ type EnrichedAlert struct {
 	CustomerTier     string            `json:"customerTier,omitempty"`
 	ResolvedSiteCode string            `json:"resolvedSiteCode,omitempty"`
 	ResolutionMethod string            `json:"resolutionMethod,omitempty"`
+	PriorityBoost    int               `json:"priorityBoost,omitempty"`
 }
 
 // ListSitesFilter defines filters for listing sites.
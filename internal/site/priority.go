@@ -0,0 +1,23 @@
+package site
+
+const (
+	// HighLoadThresholdPercent is the load above which a site is considered highly utilized.
+	HighLoadThresholdPercent = 80.0
+	// HighLoadPriorityBoost is added to the priority score of alerts from highly utilized sites.
+	HighLoadPriorityBoost = 2
+)
+
+// ComputeAlertPriority returns the priority score for an alert originating at site.
+// Sites whose current load exceeds HighLoadThresholdPercent add HighLoadPriorityBoost
+// to the base priority so their alerts escalate faster.
+func ComputeAlertPriority(basePriority int, site *Site) int {
+	if site == nil || site.Capacity == nil {
+		return basePriority
+	}
+
+	if site.Capacity.CurrentLoadPercent > HighLoadThresholdPercent {
+		return basePriority + HighLoadPriorityBoost
+	}
+
+	return basePriority
+}
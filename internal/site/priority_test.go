@@ -0,0 +1,34 @@
+package site
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeAlertPriority(t *testing.T) {
+	tests := []struct {
+		name        string
+		loadPercent float64
+		expected    int
+	}{
+		{name: "75% load", loadPercent: 75, expected: 3},
+		{name: "80% load is not boosted", loadPercent: 80, expected: 3},
+		{name: "95% load", loadPercent: 95, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Site{
+				ID:       "site-1",
+				Capacity: &CapacityMetrics{TotalServers: 100, CurrentLoadPercent: tt.loadPercent},
+			}
+			assert.Equal(t, tt.expected, ComputeAlertPriority(3, s))
+		})
+	}
+
+	t.Run("no capacity metrics", func(t *testing.T) {
+		assert.Equal(t, 3, ComputeAlertPriority(3, &Site{ID: "site-1"}))
+		assert.Equal(t, 3, ComputeAlertPriority(3, nil))
+	})
+}
@@ -221,6 +221,7 @@ func (r *DefaultResolver) Enrich(ctx context.Context, alert *routingv1.Alert) (*
 	enriched.Site = site
 	enriched.ResolvedSiteCode = site.Code
 	enriched.ResolutionMethod = r.getResolutionMethod(alert.Labels)
+	enriched.PriorityBoost = ComputeAlertPriority(0, site)
 
 	// Load primary team if configured
 	if site.PrimaryTeamID != nil && *site.PrimaryTeamID != "" {
@@ -82,6 +82,16 @@ func (m *mockStore) GetTeamByID(ctx context.Context, id string) (*Team, error) {
 	return team, nil
 }
 
+func (m *mockStore) UpdateSiteCapacity(ctx context.Context, siteID string, metrics *CapacityMetrics) error {
+	for _, site := range m.sites {
+		if site.ID == siteID {
+			site.Capacity = metrics
+			return nil
+		}
+	}
+	return ErrSiteNotFound
+}
+
 func TestResolver_Resolve(t *testing.T) {
 	store := newMockStore()
 
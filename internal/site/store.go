@@ -45,6 +45,9 @@ type Store interface {
 
 	// GetTeamByID retrieves a team by its ID.
 	GetTeamByID(ctx context.Context, id string) (*Team, error)
+
+	// UpdateSiteCapacity replaces the capacity metrics of a site.
+	UpdateSiteCapacity(ctx context.Context, siteID string, metrics *CapacityMetrics) error
 }
 
 // PostgresStore implements Store using PostgreSQL.
@@ -63,18 +66,18 @@ func (s *PostgresStore) GetByCode(ctx context.Context, code string) (*Site, erro
 	var tier sql.NullInt32
 	var region, country, city, address sql.NullString
 	var primaryTeamID, secondaryTeamID, defaultEscPolicyID, parentSiteID sql.NullString
-	var labelsJSON, bhJSON []byte
+	var labelsJSON, bhJSON, capacityJSON []byte
 
 	err := s.db.QueryRowContext(ctx, `
 		SELECT id, name, code, site_type, tier, region, country, city, address, timezone,
 			   primary_team_id, secondary_team_id, default_escalation_policy_id, parent_site_id,
-			   labels, business_hours, created_at, updated_at
+			   labels, business_hours, capacity, created_at, updated_at
 		FROM sites WHERE code = $1
 	`, code).Scan(
 		&site.ID, &site.Name, &site.Code, &site.SiteType, &tier,
 		&region, &country, &city, &address, &site.Timezone,
 		&primaryTeamID, &secondaryTeamID, &defaultEscPolicyID, &parentSiteID,
-		&labelsJSON, &bhJSON, &site.CreatedAt, &site.UpdatedAt,
+		&labelsJSON, &bhJSON, &capacityJSON, &site.CreatedAt, &site.UpdatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -129,6 +132,13 @@ func (s *PostgresStore) GetByCode(ctx context.Context, code string) (*Site, erro
 		}
 	}
 
+	if capacityJSON != nil {
+		var capacity CapacityMetrics
+		if err := json.Unmarshal(capacityJSON, &capacity); err == nil {
+			site.Capacity = &capacity
+		}
+	}
+
 	return site, nil
 }
 
@@ -138,18 +148,18 @@ func (s *PostgresStore) GetByID(ctx context.Context, id string) (*Site, error) {
 	var tier sql.NullInt32
 	var region, country, city, address sql.NullString
 	var primaryTeamID, secondaryTeamID, defaultEscPolicyID, parentSiteID sql.NullString
-	var labelsJSON, bhJSON []byte
+	var labelsJSON, bhJSON, capacityJSON []byte
 
 	err := s.db.QueryRowContext(ctx, `
 		SELECT id, name, code, site_type, tier, region, country, city, address, timezone,
 			   primary_team_id, secondary_team_id, default_escalation_policy_id, parent_site_id,
-			   labels, business_hours, created_at, updated_at
+			   labels, business_hours, capacity, created_at, updated_at
 		FROM sites WHERE id = $1
 	`, id).Scan(
 		&site.ID, &site.Name, &site.Code, &site.SiteType, &tier,
 		&region, &country, &city, &address, &site.Timezone,
 		&primaryTeamID, &secondaryTeamID, &defaultEscPolicyID, &parentSiteID,
-		&labelsJSON, &bhJSON, &site.CreatedAt, &site.UpdatedAt,
+		&labelsJSON, &bhJSON, &capacityJSON, &site.CreatedAt, &site.UpdatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -203,6 +213,13 @@ func (s *PostgresStore) GetByID(ctx context.Context, id string) (*Site, error) {
 		}
 	}
 
+	if capacityJSON != nil {
+		var capacity CapacityMetrics
+		if err := json.Unmarshal(capacityJSON, &capacity); err == nil {
+			site.Capacity = &capacity
+		}
+	}
+
 	return site, nil
 }
 
@@ -210,7 +227,7 @@ func (s *PostgresStore) GetByID(ctx context.Context, id string) (*Site, error) {
 func (s *PostgresStore) List(ctx context.Context, filter *ListSitesFilter) ([]*Site, string, error) {
 	query := `SELECT id, name, code, site_type, tier, region, country, city, address, timezone,
 			   primary_team_id, secondary_team_id, default_escalation_policy_id, parent_site_id,
-			   labels, business_hours, created_at, updated_at
+			   labels, business_hours, capacity, created_at, updated_at
 			   FROM sites WHERE 1=1`
 	args := []interface{}{}
 	argIndex := 1
@@ -271,13 +288,13 @@ func (s *PostgresStore) List(ctx context.Context, filter *ListSitesFilter) ([]*S
 		var tier sql.NullInt32
 		var region, country, city, address sql.NullString
 		var primaryTeamID, secondaryTeamID, defaultEscPolicyID, parentSiteID sql.NullString
-		var labelsJSON, bhJSON []byte
+		var labelsJSON, bhJSON, capacityJSON []byte
 
 		if err := rows.Scan(
 			&site.ID, &site.Name, &site.Code, &site.SiteType, &tier,
 			&region, &country, &city, &address, &site.Timezone,
 			&primaryTeamID, &secondaryTeamID, &defaultEscPolicyID, &parentSiteID,
-			&labelsJSON, &bhJSON, &site.CreatedAt, &site.UpdatedAt,
+			&labelsJSON, &bhJSON, &capacityJSON, &site.CreatedAt, &site.UpdatedAt,
 		); err != nil {
 			return nil, "", fmt.Errorf("scan site: %w", err)
 		}
@@ -327,6 +344,13 @@ func (s *PostgresStore) List(ctx context.Context, filter *ListSitesFilter) ([]*S
 			}
 		}
 
+		if capacityJSON != nil {
+			var capacity CapacityMetrics
+			if err := json.Unmarshal(capacityJSON, &capacity); err == nil {
+				site.Capacity = &capacity
+			}
+		}
+
 		sites = append(sites, site)
 	}
 
@@ -439,6 +463,36 @@ func (s *PostgresStore) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// UpdateSiteCapacity replaces the capacity metrics of a site.
+func (s *PostgresStore) UpdateSiteCapacity(ctx context.Context, siteID string, metrics *CapacityMetrics) error {
+	if siteID == "" || metrics == nil {
+		return ErrInvalidSite
+	}
+
+	if metrics.LastUpdated.IsZero() {
+		metrics.LastUpdated = time.Now()
+	}
+
+	capacityJSON, err := json.Marshal(metrics)
+	if err != nil {
+		return fmt.Errorf("marshal capacity: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE sites SET capacity = $1, updated_at = $2 WHERE id = $3
+	`, capacityJSON, time.Now(), siteID)
+	if err != nil {
+		return fmt.Errorf("update site capacity: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrSiteNotFound
+	}
+
+	return nil
+}
+
 // GetTeamByID retrieves a team by its ID.
 func (s *PostgresStore) GetTeamByID(ctx context.Context, id string) (*Team, error) {
 	team := &Team{}
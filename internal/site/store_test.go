@@ -23,11 +23,11 @@ func TestPostgresStore_GetByCode(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		}).AddRow(
 			"site-123", "NYC Datacenter", "NYC-DC1", "datacenter", 1, "us-east-1", "USA", "New York", "123 Main St", "America/New_York",
 			nil, nil, nil, nil,
-			[]byte(`{"env":"production"}`), []byte(`{"start":"09:00","end":"17:00","days":[1,2,3,4,5]}`), now, now,
+			[]byte(`{"env":"production"}`), []byte(`{"start":"09:00","end":"17:00","days":[1,2,3,4,5]}`), nil, now, now,
 		)
 
 		mock.ExpectQuery(`SELECT (.+) FROM sites WHERE code = \$1`).
@@ -76,11 +76,11 @@ func TestPostgresStore_GetByID(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		}).AddRow(
 			"site-123", "NYC Datacenter", "NYC-DC1", "datacenter", 2, "us-east-1", "USA", "New York", "123 Main St", "America/New_York",
 			"team-1", "team-2", "policy-1", nil,
-			[]byte(`{}`), nil, now, now,
+			[]byte(`{}`), nil, nil, now, now,
 		)
 
 		mock.ExpectQuery(`SELECT (.+) FROM sites WHERE id = \$1`).
@@ -125,15 +125,15 @@ func TestPostgresStore_List(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		}).AddRow(
 			"site-1", "NYC Datacenter", "NYC-DC1", "datacenter", 1, "us-east-1", "USA", "New York", "", "America/New_York",
 			nil, nil, nil, nil,
-			[]byte(`{}`), nil, now, now,
+			[]byte(`{}`), nil, nil, now, now,
 		).AddRow(
 			"site-2", "LAX POP", "LAX-POP1", "pop", nil, "us-west-2", "USA", "Los Angeles", "", "America/Los_Angeles",
 			nil, nil, nil, nil,
-			[]byte(`{}`), nil, now, now,
+			[]byte(`{}`), nil, nil, now, now,
 		)
 
 		mock.ExpectQuery(`SELECT (.+) FROM sites WHERE 1=1 ORDER BY name ASC LIMIT \$1`).
@@ -155,11 +155,11 @@ func TestPostgresStore_List(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		}).AddRow(
 			"site-1", "NYC Datacenter", "NYC-DC1", "datacenter", 1, "us-east-1", "USA", "New York", "", "America/New_York",
 			nil, nil, nil, nil,
-			[]byte(`{}`), nil, now, now,
+			[]byte(`{}`), nil, nil, now, now,
 		)
 
 		mock.ExpectQuery(`SELECT (.+) FROM sites WHERE 1=1 AND site_type = \$1 ORDER BY name ASC LIMIT \$2`).
@@ -179,11 +179,11 @@ func TestPostgresStore_List(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		}).AddRow(
 			"site-1", "NYC Datacenter", "NYC-DC1", "datacenter", 1, "us-east-1", "USA", "New York", "", "America/New_York",
 			nil, nil, nil, nil,
-			[]byte(`{}`), nil, now, now,
+			[]byte(`{}`), nil, nil, now, now,
 		)
 
 		mock.ExpectQuery(`SELECT (.+) FROM sites WHERE 1=1 AND region = \$1 ORDER BY name ASC LIMIT \$2`).
@@ -203,13 +203,13 @@ func TestPostgresStore_List(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "code", "site_type", "tier", "region", "country", "city", "address", "timezone",
 			"primary_team_id", "secondary_team_id", "default_escalation_policy_id", "parent_site_id",
-			"labels", "business_hours", "created_at", "updated_at",
+			"labels", "business_hours", "capacity", "created_at", "updated_at",
 		})
 		for i := 0; i < 11; i++ {
 			rows.AddRow(
 				"site-"+string(rune('0'+i)), "Site "+string(rune('0'+i)), "CODE-"+string(rune('0'+i)), "datacenter", 1, "us-east-1", "USA", "City", "", "UTC",
 				nil, nil, nil, nil,
-				[]byte(`{}`), nil, now, now,
+				[]byte(`{}`), nil, nil, now, now,
 			)
 		}
 
@@ -348,6 +348,43 @@ func TestPostgresStore_Update(t *testing.T) {
 	})
 }
 
+func TestPostgresStore_UpdateSiteCapacity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE sites SET capacity = \$1`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "site-123").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		metrics := &CapacityMetrics{TotalServers: 120, CurrentLoadPercent: 85.5}
+		err := store.UpdateSiteCapacity(ctx, "site-123", metrics)
+		require.NoError(t, err)
+		assert.False(t, metrics.LastUpdated.IsZero())
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid - nil metrics", func(t *testing.T) {
+		err := store.UpdateSiteCapacity(ctx, "site-123", nil)
+		assert.ErrorIs(t, err, ErrInvalidSite)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE sites SET capacity = \$1`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := store.UpdateSiteCapacity(ctx, "nonexistent", &CapacityMetrics{TotalServers: 10})
+		assert.ErrorIs(t, err, ErrSiteNotFound)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestPostgresStore_Delete(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -0,0 +1,201 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	"github.com/kneutral-org/alerting-system/internal/webhook"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// MetaAlertSource is the source recorded on SLA breach meta-alerts.
+	MetaAlertSource = "sla_monitor"
+	// MetaAlertSeverity is the severity of SLA breach meta-alerts.
+	MetaAlertSeverity = "high"
+
+	// DefaultWindow is the SLA window used when a breach event does not carry one.
+	DefaultWindow = time.Hour
+)
+
+// ErrServiceIDRequired is returned when the alerter has no service to raise meta-alerts for.
+var ErrServiceIDRequired = errors.New("sla breach alerter requires a service ID")
+
+// Ingester ingests generic payloads through the standard webhook ingestion path.
+type Ingester interface {
+	IngestGeneric(ctx context.Context, service *store.Service, payload *webhook.GenericPayload) (*alertingv1.Alert, bool, error)
+}
+
+var _ Ingester = (*webhook.Handler)(nil)
+
+// SLABreachAlerter raises one meta-alert per team per SLA window when the team's
+// alerts breach their SLA, however many individual alerts breached.
+type SLABreachAlerter struct {
+	bus       *EventBus
+	ingester  Ingester
+	serviceID string
+	logger    zerolog.Logger
+	metrics   *Metrics
+
+	mu sync.Mutex
+	// alerted holds the end of the last window a meta-alert was raised for, by team ID.
+	alerted map[string]time.Time
+}
+
+// NewSLABreachAlerter creates an alerter that ingests meta-alerts for serviceID.
+func NewSLABreachAlerter(bus *EventBus, ingester Ingester, serviceID string, logger zerolog.Logger, metrics *Metrics) *SLABreachAlerter {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &SLABreachAlerter{
+		bus:       bus,
+		ingester:  ingester,
+		serviceID: serviceID,
+		logger:    logger.With().Str("component", "sla_breach_alerter").Logger(),
+		metrics:   metrics,
+		alerted:   make(map[string]time.Time),
+	}
+}
+
+// Metrics returns the metrics recorder for this alerter.
+func (a *SLABreachAlerter) Metrics() *Metrics {
+	return a.metrics
+}
+
+// Run handles breach events from the bus until the context is cancelled.
+func (a *SLABreachAlerter) Run(ctx context.Context) {
+	events, unsubscribe := a.bus.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			// Meta-alerts are raised per team, so breaches nobody owns are skipped
+			if event.TeamID == "" {
+				continue
+			}
+			if _, err := a.HandleBreach(ctx, event); err != nil {
+				a.logger.Error().
+					Err(err).
+					Str("teamId", event.TeamID).
+					Str("alertId", event.AlertID).
+					Msg("failed to raise sla breach meta-alert")
+			}
+		}
+	}
+}
+
+// HandleBreach raises the meta-alert for a breach unless one was already raised
+// for the team in the same SLA window. Returns whether a meta-alert was raised,
+// or ErrServiceIDRequired when the alerter has no service.
+func (a *SLABreachAlerter) HandleBreach(ctx context.Context, event SLABreachEvent) (bool, error) {
+	if a.serviceID == "" {
+		return false, ErrServiceIDRequired
+	}
+	if event.TeamID == "" {
+		return false, fmt.Errorf("sla breach event for alert %s has no team", event.AlertID)
+	}
+
+	start, end := breachWindow(event)
+
+	if !a.claimWindow(event.TeamID, end) {
+		return false, nil
+	}
+
+	payload := metaAlertPayload(event, start, end)
+	alert, _, err := a.ingester.IngestGeneric(ctx, &store.Service{ID: a.serviceID}, payload)
+	if err != nil {
+		a.releaseWindow(event.TeamID, end)
+		return false, fmt.Errorf("ingest sla breach meta-alert: %w", err)
+	}
+
+	a.metrics.RecordMetaAlertCreated(event.TeamID, event.Tier)
+
+	a.logger.Info().
+		Str("alertId", alert.Id).
+		Str("teamId", event.TeamID).
+		Str("tier", event.Tier).
+		Time("windowStart", start).
+		Msg("sla breach meta-alert raised")
+
+	return true, nil
+}
+
+// claimWindow records that the team's window ending at end has been alerted.
+// It returns false when a meta-alert was already raised for that window.
+func (a *SLABreachAlerter) claimWindow(teamID string, end time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if last, ok := a.alerted[teamID]; ok && !last.Before(end) {
+		return false
+	}
+	a.alerted[teamID] = end
+	return true
+}
+
+// releaseWindow forgets a claimed window so a failed meta-alert can be retried.
+func (a *SLABreachAlerter) releaseWindow(teamID string, end time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.alerted[teamID].Equal(end) {
+		delete(a.alerted, teamID)
+	}
+}
+
+// breachWindow returns the SLA window of a breach, falling back to the
+// DefaultWindow containing the breach time.
+func breachWindow(event SLABreachEvent) (time.Time, time.Time) {
+	if !event.WindowStart.IsZero() && event.WindowEnd.After(event.WindowStart) {
+		return event.WindowStart, event.WindowEnd
+	}
+
+	breachedAt := event.BreachedAt
+	if breachedAt.IsZero() {
+		breachedAt = time.Now()
+	}
+	start := breachedAt.Truncate(DefaultWindow)
+	return start, start.Add(DefaultWindow)
+}
+
+// metaAlertPayload builds the generic payload for a team's breach meta-alert.
+// The fingerprint is derived from the team and window so that retries update
+// the same alert.
+func metaAlertPayload(event SLABreachEvent, start, end time.Time) *webhook.GenericPayload {
+	breachedAt := event.BreachedAt
+	if breachedAt.IsZero() {
+		breachedAt = time.Now()
+	}
+
+	return &webhook.GenericPayload{
+		Summary:  fmt.Sprintf("SLA breach for team %s", event.TeamID),
+		Details:  fmt.Sprintf("Alert %s breached the %s SLA in the window %s to %s.", event.AlertID, event.Tier, start.Format(time.RFC3339), end.Format(time.RFC3339)),
+		Severity: MetaAlertSeverity,
+		Source:   MetaAlertSource,
+		Labels: map[string]string{
+			"team_id": event.TeamID,
+			"tier":    event.Tier,
+			"source":  MetaAlertSource,
+		},
+		Annotations: map[string]string{
+			"breached_alert_id": event.AlertID,
+			"window_start":      start.Format(time.RFC3339),
+			"window_end":        end.Format(time.RFC3339),
+		},
+		Fingerprint: fmt.Sprintf("%s:%s:%d", MetaAlertSource, event.TeamID, start.Unix()),
+		Timestamp:   &breachedAt,
+	}
+}
@@ -0,0 +1,210 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	"github.com/kneutral-org/alerting-system/internal/webhook"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// recordingIngester records ingested payloads.
+type recordingIngester struct {
+	mu       sync.Mutex
+	services []string
+	payloads []*webhook.GenericPayload
+	err      error
+}
+
+func (r *recordingIngester) IngestGeneric(_ context.Context, service *store.Service, payload *webhook.GenericPayload) (*alertingv1.Alert, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return nil, false, r.err
+	}
+	r.services = append(r.services, service.ID)
+	r.payloads = append(r.payloads, payload)
+	return &alertingv1.Alert{Id: "meta-alert", Fingerprint: payload.Fingerprint}, true, nil
+}
+
+func (r *recordingIngester) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.payloads)
+}
+
+func breach(teamID, alertID string, windowStart time.Time) SLABreachEvent {
+	return SLABreachEvent{
+		TeamID:      teamID,
+		Tier:        "gold",
+		AlertID:     alertID,
+		BreachedAt:  windowStart.Add(10 * time.Minute),
+		WindowStart: windowStart,
+		WindowEnd:   windowStart.Add(time.Hour),
+	}
+}
+
+func TestSLABreachAlerter_HandleBreach(t *testing.T) {
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "sla-service", zerolog.Nop(), nil)
+	windowStart := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	raised, err := alerter.HandleBreach(context.Background(), breach("team-a", "alert-1", windowStart))
+	require.NoError(t, err)
+	assert.True(t, raised)
+
+	require.Len(t, ingester.payloads, 1)
+	payload := ingester.payloads[0]
+	assert.Equal(t, "sla-service", ingester.services[0])
+	assert.Equal(t, "SLA breach for team team-a", payload.Summary)
+	assert.Equal(t, "high", payload.Severity)
+	assert.Equal(t, "sla_monitor", payload.Source)
+	assert.Equal(t, "team-a", payload.Labels["team_id"])
+	assert.Equal(t, "gold", payload.Labels["tier"])
+	assert.Equal(t, "alert-1", payload.Annotations["breached_alert_id"])
+	assert.NotEmpty(t, payload.Fingerprint)
+
+	assert.Equal(t, int64(1), alerter.Metrics().MetaAlertsCreatedTotal("team-a", "gold"))
+}
+
+func TestSLABreachAlerter_DeduplicatesPerTeamWindow(t *testing.T) {
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "sla-service", zerolog.Nop(), nil)
+	ctx := context.Background()
+	window := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	raised, err := alerter.HandleBreach(ctx, breach("team-a", "alert-1", window))
+	require.NoError(t, err)
+	assert.True(t, raised)
+
+	// A second alert breaching in the same window does not raise another meta-alert.
+	raised, err = alerter.HandleBreach(ctx, breach("team-a", "alert-2", window))
+	require.NoError(t, err)
+	assert.False(t, raised)
+
+	// Another team in the same window is alerted independently.
+	raised, err = alerter.HandleBreach(ctx, breach("team-b", "alert-3", window))
+	require.NoError(t, err)
+	assert.True(t, raised)
+
+	// The next window raises a new meta-alert for the first team.
+	next := window.Add(time.Hour)
+	raised, err = alerter.HandleBreach(ctx, breach("team-a", "alert-4", next))
+	require.NoError(t, err)
+	assert.True(t, raised)
+
+	require.Len(t, ingester.payloads, 3)
+	assert.NotEqual(t, ingester.payloads[0].Fingerprint, ingester.payloads[2].Fingerprint)
+	assert.Equal(t, int64(2), alerter.Metrics().MetaAlertsCreatedTotal("team-a", "gold"))
+	assert.Equal(t, int64(1), alerter.Metrics().MetaAlertsCreatedTotal("team-b", "gold"))
+}
+
+func TestSLABreachAlerter_DefaultWindow(t *testing.T) {
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "sla-service", zerolog.Nop(), nil)
+	ctx := context.Background()
+	hour := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, minutes := range []int{5, 30, 59} {
+		_, err := alerter.HandleBreach(ctx, SLABreachEvent{
+			TeamID:     "team-a",
+			Tier:       "silver",
+			AlertID:    "alert",
+			BreachedAt: hour.Add(time.Duration(minutes) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, ingester.count())
+
+	raised, err := alerter.HandleBreach(ctx, SLABreachEvent{
+		TeamID:     "team-a",
+		Tier:       "silver",
+		AlertID:    "alert",
+		BreachedAt: hour.Add(61 * time.Minute),
+	})
+	require.NoError(t, err)
+	assert.True(t, raised)
+}
+
+func TestSLABreachAlerter_IngestFailureAllowsRetry(t *testing.T) {
+	ingester := &recordingIngester{err: errors.New("store unavailable")}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "sla-service", zerolog.Nop(), nil)
+	ctx := context.Background()
+	window := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	raised, err := alerter.HandleBreach(ctx, breach("team-a", "alert-1", window))
+	require.Error(t, err)
+	assert.False(t, raised)
+	assert.Equal(t, int64(0), alerter.Metrics().MetaAlertsCreatedTotal("team-a", "gold"))
+
+	ingester.err = nil
+	raised, err = alerter.HandleBreach(ctx, breach("team-a", "alert-2", window))
+	require.NoError(t, err)
+	assert.True(t, raised)
+}
+
+func TestSLABreachAlerter_RejectsMissingTeam(t *testing.T) {
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "sla-service", zerolog.Nop(), nil)
+
+	_, err := alerter.HandleBreach(context.Background(), SLABreachEvent{AlertID: "alert-1"})
+	require.Error(t, err)
+	assert.Zero(t, ingester.count())
+}
+
+func TestSLABreachAlerter_RequiresServiceID(t *testing.T) {
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(NewEventBus(), ingester, "", zerolog.Nop(), nil)
+
+	_, err := alerter.HandleBreach(context.Background(), breach("team-a", "alert-1", time.Now()))
+	assert.ErrorIs(t, err, ErrServiceIDRequired)
+	assert.Zero(t, ingester.count())
+}
+
+func TestSLABreachAlerter_Run(t *testing.T) {
+	bus := NewEventBus()
+	ingester := &recordingIngester{}
+	alerter := NewSLABreachAlerter(bus, ingester, "sla-service", zerolog.Nop(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		alerter.Run(ctx)
+		close(done)
+	}()
+
+	window := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.Eventually(t, func() bool {
+		bus.Publish(breach("team-a", "alert-1", window))
+		return ingester.count() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	assert.Equal(t, 1, ingester.count())
+}
+
+func TestEventBus_Unsubscribe(t *testing.T) {
+	bus := NewEventBus()
+	events, unsubscribe := bus.Subscribe()
+
+	bus.Publish(SLABreachEvent{TeamID: "team-a"})
+	event := <-events
+	assert.Equal(t, "team-a", event.TeamID)
+
+	unsubscribe()
+	unsubscribe()
+	bus.Publish(SLABreachEvent{TeamID: "team-b"})
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
@@ -0,0 +1,74 @@
+// Package sla tracks alert response SLAs and raises meta-alerts when teams
+// breach them.
+package sla
+
+import (
+	"sync"
+	"time"
+)
+
+// breachSubscriberBuffer is the channel buffer for each subscriber.
+const breachSubscriberBuffer = 16
+
+// SLABreachEvent reports that an alert owned by a team breached its SLA.
+type SLABreachEvent struct {
+	// TeamID is the team responsible for the breached alert.
+	TeamID string
+	// Tier is the SLA tier the breach was measured against.
+	Tier string
+	// AlertID is the alert that breached the SLA.
+	AlertID string
+	// BreachedAt is when the breach was detected.
+	BreachedAt time.Time
+	// WindowStart and WindowEnd bound the SLA window the breach counts against.
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+// EventBus fans out SLA breach events to subscribers.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[chan SLABreachEvent]struct{}
+}
+
+// NewEventBus creates a new SLA breach event bus.
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[chan SLABreachEvent]struct{}),
+	}
+}
+
+// Publish delivers a breach event to all subscribers.
+// Subscribers that are not keeping up miss the event rather than blocking the publisher.
+func (b *EventBus) Publish(event SLABreachEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe registers a subscriber for breach events. The returned function unsubscribes.
+func (b *EventBus) Subscribe() (<-chan SLABreachEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan SLABreachEvent, breachSubscriberBuffer)
+	b.subscribers[ch] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers, ch)
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
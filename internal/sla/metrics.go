@@ -0,0 +1,72 @@
+package sla
+
+import (
+	"sync"
+)
+
+// Metrics tracks SLA breach metrics.
+// Exposed as the sla_breach_meta_alerts_created_total{team_id, tier} and
+// sla_breach_total{severity, tier} counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// metaAlertsCreated counts breach meta-alerts raised, by team ID and tier.
+	metaAlertsCreated map[metaAlertKey]int64
+
+	// breaches counts alerts that passed their SLA deadline, by severity and tier.
+	breaches map[breachKey]int64
+}
+
+type metaAlertKey struct {
+	teamID string
+	tier   string
+}
+
+type breachKey struct {
+	severity string
+	tier     string
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		metaAlertsCreated: make(map[metaAlertKey]int64),
+		breaches:          make(map[breachKey]int64),
+	}
+}
+
+// RecordMetaAlertCreated increments the meta-alerts created counter for a team and tier.
+func (m *Metrics) RecordMetaAlertCreated(teamID, tier string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metaAlertsCreated[metaAlertKey{teamID: teamID, tier: tier}]++
+}
+
+// MetaAlertsCreatedTotal returns the number of meta-alerts created for a team and tier.
+func (m *Metrics) MetaAlertsCreatedTotal(teamID, tier string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.metaAlertsCreated[metaAlertKey{teamID: teamID, tier: tier}]
+}
+
+// RecordBreach increments the SLA breach counter for a severity and tier.
+func (m *Metrics) RecordBreach(severity, tier string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.breaches[breachKey{severity: severity, tier: tier}]++
+}
+
+// BreachesTotal returns the number of alerts of a severity and tier that breached their SLA.
+func (m *Metrics) BreachesTotal(severity, tier string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.breaches[breachKey{severity: severity, tier: tier}]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metaAlertsCreated = make(map[metaAlertKey]int64)
+	m.breaches = make(map[breachKey]int64)
+}
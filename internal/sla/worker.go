@@ -0,0 +1,202 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const (
+	// DefaultScanInterval is how often the breach worker looks for alerts past
+	// their SLA deadline.
+	DefaultScanInterval = time.Minute
+
+	// unknownTier is the tier label of breaches whose customer tier cannot be resolved.
+	unknownTier = "unknown"
+)
+
+// SLABreachWorker periodically marks triggered alerts past their SLA deadline
+// as breached and notifies the dedicated team of the customer's tier.
+type SLABreachWorker struct {
+	alerts    store.AlertStore
+	customers customer.Store
+	tiers     customer.TierStore
+	executor  action.Executor
+	interval  time.Duration
+	metrics   *Metrics
+	logger    zerolog.Logger
+
+	// events receives every breach for meta-alerting (optional)
+	events *EventBus
+
+	// now returns the time deadlines are compared against
+	now func() time.Time
+}
+
+// SLABreachWorkerOption configures optional SLABreachWorker dependencies.
+type SLABreachWorkerOption func(*SLABreachWorker)
+
+// WithBreachEvents publishes every breach to the event bus, on which the
+// SLABreachAlerter raises meta-alerts.
+func WithBreachEvents(bus *EventBus) SLABreachWorkerOption {
+	return func(w *SLABreachWorker) {
+		w.events = bus
+	}
+}
+
+// NewSLABreachWorker creates a worker that scans for breached alerts every
+// interval. A non-positive interval uses DefaultScanInterval. The executor
+// runs the notify_team action for the tier's dedicated team; without one,
+// breaches are only recorded.
+func NewSLABreachWorker(alerts store.AlertStore, customers customer.Store, tiers customer.TierStore, executor action.Executor, interval time.Duration, metrics *Metrics, logger zerolog.Logger, opts ...SLABreachWorkerOption) *SLABreachWorker {
+	if interval <= 0 {
+		interval = DefaultScanInterval
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	w := &SLABreachWorker{
+		alerts:    alerts,
+		customers: customers,
+		tiers:     tiers,
+		executor:  executor,
+		interval:  interval,
+		metrics:   metrics,
+		logger:    logger.With().Str("component", "sla_breach_worker").Logger(),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Metrics returns the metrics recorder for this worker.
+func (w *SLABreachWorker) Metrics() *Metrics {
+	return w.metrics
+}
+
+// Run scans for breached alerts every interval until the context is cancelled.
+func (w *SLABreachWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.logger.Info().Dur("interval", w.interval).Msg("starting sla breach worker")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.Scan(ctx); err != nil {
+				w.logger.Error().Err(err).Msg("failed to scan for sla breaches")
+			}
+		}
+	}
+}
+
+// Scan marks every triggered alert past its SLA deadline as breached and
+// returns how many alerts breached. Alerts are only reported once.
+func (w *SLABreachWorker) Scan(ctx context.Context) (int, error) {
+	resp, err := w.alerts.List(ctx, &alertingv1.ListAlertsRequest{
+		Statuses: []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list triggered alerts: %w", err)
+	}
+
+	now := w.now()
+	breached := 0
+	for _, alert := range resp.Alerts {
+		if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED || alert.SlaBreached ||
+			alert.SlaDeadline == nil || !alert.SlaDeadline.AsTime().Before(now) {
+			continue
+		}
+
+		alert.SlaBreached = true
+		if _, err := w.alerts.Update(ctx, alert); err != nil {
+			w.logger.Warn().Err(err).Str("alertId", alert.Id).Msg("failed to mark alert as sla breached")
+			continue
+		}
+		breached++
+		w.handleBreach(ctx, alert)
+	}
+
+	return breached, nil
+}
+
+// handleBreach records a breached alert, publishes it and notifies the
+// dedicated team of its customer's tier, if the tier has one.
+func (w *SLABreachWorker) handleBreach(ctx context.Context, alert *alertingv1.Alert) {
+	severity := strings.ToLower(strings.TrimPrefix(alert.Severity.String(), "SEVERITY_"))
+
+	tier, err := customer.ResolveTier(ctx, w.customers, w.tiers, alert.Labels)
+	if err != nil {
+		if !errors.Is(err, customer.ErrCustomerNotFound) && !errors.Is(err, customer.ErrTierNotFound) {
+			w.logger.Warn().Err(err).Str("alertId", alert.Id).Msg("failed to resolve customer tier of sla breach")
+		}
+		w.metrics.RecordBreach(severity, unknownTier)
+		w.publishBreach(alert, unknownTier, "")
+		return
+	}
+	w.metrics.RecordBreach(severity, tier.Name)
+
+	var teamID string
+	if tier.DedicatedTeamID != nil {
+		teamID = *tier.DedicatedTeamID
+	}
+	w.publishBreach(alert, tier.Name, teamID)
+
+	w.logger.Info().
+		Str("alertId", alert.Id).
+		Str("severity", severity).
+		Str("tier", tier.Name).
+		Time("deadline", alert.SlaDeadline.AsTime()).
+		Msg("alert breached its sla")
+
+	if teamID == "" || w.executor == nil {
+		return
+	}
+
+	notify := &routingv1.RoutingAction{
+		Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM,
+		NotifyTeam: &routingv1.NotifyTeamAction{
+			TeamId: teamID,
+			Scope:  routingv1.TeamNotifyScope_TEAM_NOTIFY_SCOPE_ONCALL,
+		},
+	}
+	if _, err := w.executor.Execute(ctx, routing.AlertFromStore(alert), []*routingv1.RoutingAction{notify}); err != nil {
+		w.logger.Warn().
+			Err(err).
+			Str("alertId", alert.Id).
+			Str("teamId", teamID).
+			Msg("failed to notify dedicated team of sla breach")
+	}
+}
+
+// publishBreach publishes a breach of the team's alert to the event bus. The
+// team is empty when the customer tier has no dedicated team.
+func (w *SLABreachWorker) publishBreach(alert *alertingv1.Alert, tier, teamID string) {
+	if w.events == nil {
+		return
+	}
+
+	w.events.Publish(SLABreachEvent{
+		TeamID:     teamID,
+		Tier:       tier,
+		AlertID:    alert.Id,
+		BreachedAt: w.now(),
+	})
+}
@@ -0,0 +1,210 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	"github.com/kneutral-org/alerting-system/internal/routing/action"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// memoryAlertStore is a minimal store.AlertStore holding alerts by ID.
+type memoryAlertStore struct {
+	alerts    map[string]*alertingv1.Alert
+	updateErr error
+}
+
+func (s *memoryAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	s.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (s *memoryAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return s.alerts[id], nil
+}
+
+func (s *memoryAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (s *memoryAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	if s.updateErr != nil {
+		return nil, s.updateErr
+	}
+	s.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (s *memoryAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	s.alerts[alert.Id] = alert
+	return alert, true, nil
+}
+
+func (s *memoryAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	resp := &alertingv1.ListAlertsResponse{}
+	for _, alert := range s.alerts {
+		resp.Alerts = append(resp.Alerts, alert)
+	}
+	return resp, nil
+}
+
+// recordingExecutor records the actions it is asked to execute.
+type recordingExecutor struct {
+	alerts  []*routingv1.Alert
+	actions []*routingv1.RoutingAction
+}
+
+func (e *recordingExecutor) Execute(ctx context.Context, alert *routingv1.Alert, actions []*routingv1.RoutingAction) ([]*action.Result, error) {
+	e.alerts = append(e.alerts, alert)
+	e.actions = append(e.actions, actions...)
+	return nil, nil
+}
+
+func (e *recordingExecutor) RegisterAction(actionType routingv1.ActionType, handler action.ActionHandler) {
+}
+
+func newBreachTestWorker(t *testing.T, alerts *memoryAlertStore, executor action.Executor, now time.Time) *SLABreachWorker {
+	t.Helper()
+	ctx := context.Background()
+
+	teamID := "team-enterprise"
+	tiers := customer.NewInMemoryTierStore()
+	enterprise, err := tiers.Create(ctx, &customer.CustomerTier{Name: "Enterprise", Level: 1, DedicatedTeamID: &teamID})
+	require.NoError(t, err)
+	standard, err := tiers.Create(ctx, &customer.CustomerTier{Name: "Standard", Level: 3})
+	require.NoError(t, err)
+
+	customers := customer.NewInMemoryStore()
+	_, err = customers.Create(ctx, &customer.Customer{Name: "Acme Corp", AccountID: "acme-001", TierID: enterprise.ID})
+	require.NoError(t, err)
+	_, err = customers.Create(ctx, &customer.Customer{Name: "Globex", AccountID: "globex-001", TierID: standard.ID})
+	require.NoError(t, err)
+
+	worker := NewSLABreachWorker(alerts, customers, tiers, executor, time.Minute, nil, zerolog.Nop())
+	worker.now = func() time.Time { return now }
+	return worker
+}
+
+func slaAlert(id, accountID string, severity alertingv1.Severity, status alertingv1.AlertStatus, deadline time.Time) *alertingv1.Alert {
+	return &alertingv1.Alert{
+		Id:          id,
+		Severity:    severity,
+		Status:      status,
+		Labels:      map[string]string{"account_id": accountID},
+		SlaDeadline: timestamppb.New(deadline),
+	}
+}
+
+func TestSLABreachWorker_Scan(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	triggered := alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED
+	alerts := &memoryAlertStore{alerts: map[string]*alertingv1.Alert{
+		"enterprise-breached": slaAlert("enterprise-breached", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, triggered, now.Add(-time.Minute)),
+		"standard-breached":   slaAlert("standard-breached", "globex-001", alertingv1.Severity_SEVERITY_HIGH, triggered, now.Add(-time.Hour)),
+		"unknown-breached":    slaAlert("unknown-breached", "unknown", alertingv1.Severity_SEVERITY_LOW, triggered, now.Add(-time.Hour)),
+		"within-sla":          slaAlert("within-sla", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, triggered, now.Add(time.Minute)),
+		"acknowledged":        slaAlert("acknowledged", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, now.Add(-time.Hour)),
+		"no-deadline":         {Id: "no-deadline", Status: triggered, Labels: map[string]string{"account_id": "acme-001"}},
+	}}
+	executor := &recordingExecutor{}
+	worker := newBreachTestWorker(t, alerts, executor, now)
+
+	breached, err := worker.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, breached)
+
+	for id, want := range map[string]bool{
+		"enterprise-breached": true,
+		"standard-breached":   true,
+		"unknown-breached":    true,
+		"within-sla":          false,
+		"acknowledged":        false,
+		"no-deadline":         false,
+	} {
+		assert.Equal(t, want, alerts.alerts[id].SlaBreached, "alert %s", id)
+	}
+
+	metrics := worker.Metrics()
+	assert.Equal(t, int64(1), metrics.BreachesTotal("critical", "Enterprise"))
+	assert.Equal(t, int64(1), metrics.BreachesTotal("high", "Standard"))
+	assert.Equal(t, int64(1), metrics.BreachesTotal("low", "unknown"))
+
+	// Only the enterprise tier has a dedicated team
+	require.Len(t, executor.actions, 1)
+	assert.Equal(t, routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM, executor.actions[0].Type)
+	assert.Equal(t, "team-enterprise", executor.actions[0].GetNotifyTeam().GetTeamId())
+	assert.Equal(t, "enterprise-breached", executor.alerts[0].Id)
+
+	// Breaches are reported once
+	breached, err = worker.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, breached)
+	assert.Len(t, executor.actions, 1)
+	assert.Equal(t, int64(1), metrics.BreachesTotal("critical", "Enterprise"))
+}
+
+func TestSLABreachWorker_Scan_UpdateFailure(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	alerts := &memoryAlertStore{
+		alerts: map[string]*alertingv1.Alert{
+			"breached": slaAlert("breached", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, now.Add(-time.Minute)),
+		},
+		updateErr: errors.New("database unavailable"),
+	}
+	executor := &recordingExecutor{}
+	worker := newBreachTestWorker(t, alerts, executor, now)
+
+	breached, err := worker.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, breached)
+	assert.Empty(t, executor.actions)
+	assert.Zero(t, worker.Metrics().BreachesTotal("critical", "Enterprise"))
+}
+
+func TestSLABreachWorker_NoExecutor(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	alerts := &memoryAlertStore{alerts: map[string]*alertingv1.Alert{
+		"breached": slaAlert("breached", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, now.Add(-time.Minute)),
+	}}
+	worker := newBreachTestWorker(t, alerts, nil, now)
+
+	breached, err := worker.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, breached)
+	assert.Equal(t, int64(1), worker.Metrics().BreachesTotal("critical", "Enterprise"))
+}
+
+func TestSLABreachWorker_PublishesBreaches(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	triggered := alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED
+	alerts := &memoryAlertStore{alerts: map[string]*alertingv1.Alert{
+		"enterprise-breached": slaAlert("enterprise-breached", "acme-001", alertingv1.Severity_SEVERITY_CRITICAL, triggered, now.Add(-time.Minute)),
+		"standard-breached":   slaAlert("standard-breached", "globex-001", alertingv1.Severity_SEVERITY_HIGH, triggered, now.Add(-time.Hour)),
+	}}
+	bus := NewEventBus()
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+	worker := newBreachTestWorker(t, alerts, nil, now)
+	WithBreachEvents(bus)(worker)
+
+	breached, err := worker.Scan(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, breached)
+
+	published := make(map[string]SLABreachEvent)
+	for i := 0; i < breached; i++ {
+		event := <-events
+		published[event.AlertID] = event
+	}
+	assert.Equal(t, SLABreachEvent{TeamID: "team-enterprise", Tier: "Enterprise", AlertID: "enterprise-breached", BreachedAt: now}, published["enterprise-breached"])
+	assert.Equal(t, SLABreachEvent{Tier: "Standard", AlertID: "standard-breached", BreachedAt: now}, published["standard-breached"])
+}
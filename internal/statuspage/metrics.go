@@ -0,0 +1,54 @@
+package statuspage
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics tracks StatusPage incident sync metrics.
+// Exposed as the statuspage_sync_last_success_timestamp gauge and the
+// statuspage_incidents_synced_total counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// lastSuccess is when the last sync completed without error.
+	lastSuccess time.Time
+	// incidentsSynced counts incidents processed by successful syncs.
+	incidentsSynced int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// RecordSuccess records a successful sync of count incidents at the given time.
+func (m *Metrics) RecordSuccess(at time.Time, count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastSuccess = at
+	m.incidentsSynced += int64(count)
+}
+
+// LastSuccessTimestamp returns when the last successful sync completed, or the
+// zero time if none has.
+func (m *Metrics) LastSuccessTimestamp() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastSuccess
+}
+
+// IncidentsSyncedTotal returns the number of incidents synced.
+func (m *Metrics) IncidentsSyncedTotal() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.incidentsSynced
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastSuccess = time.Time{}
+	m.incidentsSynced = 0
+}
@@ -0,0 +1,397 @@
+// Package statuspage mirrors Atlassian StatusPage incidents as maintenance windows.
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultSyncInterval is how often incidents are fetched from StatusPage.
+	DefaultSyncInterval = 60 * time.Second
+	// DefaultRequestTimeout is the HTTP timeout for StatusPage API requests.
+	DefaultRequestTimeout = 10 * time.Second
+	// OpenIncidentExtension is how far past the current sync the window of an
+	// unresolved incident reaches. Each sync pushes the end out again while the
+	// incident stays open.
+	OpenIncidentExtension = time.Hour
+
+	// SourceLabel is the maintenance window label recording where a window came from.
+	SourceLabel = "source"
+	// SourceStatusPage is the SourceLabel value of windows synced from incidents.
+	SourceStatusPage = "statuspage"
+	// IncidentIDLabel is the maintenance window label holding the incident ID.
+	IncidentIDLabel = "statuspage_incident_id"
+	// ImpactLabel is the maintenance window label holding the incident impact.
+	ImpactLabel = "statuspage_impact"
+
+	incidentsPath      = "/api/v2/incidents.json"
+	windowListPageSize = 100
+)
+
+// Incident statuses reported by the StatusPage API. Realtime incidents move
+// from investigating to resolved; scheduled maintenances from scheduled to completed.
+const (
+	IncidentStatusInvestigating = "investigating"
+	IncidentStatusIdentified    = "identified"
+	IncidentStatusMonitoring    = "monitoring"
+	IncidentStatusResolved      = "resolved"
+	IncidentStatusPostmortem    = "postmortem"
+	IncidentStatusScheduled     = "scheduled"
+	IncidentStatusInProgress    = "in_progress"
+	IncidentStatusVerifying     = "verifying"
+	IncidentStatusCompleted     = "completed"
+)
+
+// Config configures the StatusPage syncer.
+type Config struct {
+	// APIKey authenticates requests to the StatusPage API.
+	APIKey string
+	// PageID identifies the status page whose incidents are synced.
+	PageID string
+	// BaseURL overrides the page URL, https://<PageID>.statuspage.io by default.
+	BaseURL string
+}
+
+// ConfigFromEnv returns a configuration read from STATUSPAGE_API_KEY and STATUSPAGE_PAGE_ID.
+func ConfigFromEnv() Config {
+	return Config{
+		APIKey: os.Getenv("STATUSPAGE_API_KEY"),
+		PageID: os.Getenv("STATUSPAGE_PAGE_ID"),
+	}
+}
+
+// Enabled reports whether both the API key and page ID are configured.
+func (c Config) Enabled() bool {
+	return c.APIKey != "" && c.PageID != ""
+}
+
+// Incident is an incident returned by the StatusPage v2 API.
+type Incident struct {
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Status         string      `json:"status"`
+	Impact         string      `json:"impact"`
+	Shortlink      string      `json:"shortlink"`
+	CreatedAt      time.Time   `json:"created_at"`
+	ResolvedAt     *time.Time  `json:"resolved_at"`
+	ScheduledFor   *time.Time  `json:"scheduled_for"`
+	ScheduledUntil *time.Time  `json:"scheduled_until"`
+	Components     []Component `json:"components"`
+}
+
+// Component is a status page component affected by an incident.
+type Component struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// incidentsResponse is the body of GET /api/v2/incidents.json.
+type incidentsResponse struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// Syncer mirrors StatusPage incidents as maintenance windows labelled
+// source=statuspage. Open incidents create or update a window that annotates
+// alerts from the affected components, and windows whose incident was resolved
+// or disappeared are completed.
+type Syncer struct {
+	baseURL  string
+	apiKey   string
+	client   *http.Client
+	store    maintenance.Store
+	logger   zerolog.Logger
+	metrics  *Metrics
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewSyncer creates a syncer polling the configured status page every minute.
+func NewSyncer(config Config, store maintenance.Store, logger zerolog.Logger, metrics *Metrics) *Syncer {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("https://%s.statuspage.io", config.PageID)
+	}
+
+	return &Syncer{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		apiKey:   config.APIKey,
+		client:   &http.Client{Timeout: DefaultRequestTimeout},
+		store:    store,
+		logger:   logger.With().Str("component", "statuspage_syncer").Logger(),
+		metrics:  metrics,
+		interval: DefaultSyncInterval,
+		now:      time.Now,
+	}
+}
+
+// Metrics returns the metrics recorder for this syncer.
+func (s *Syncer) Metrics() *Metrics {
+	return s.metrics
+}
+
+// Run syncs incidents immediately and then every interval until the context is cancelled.
+func (s *Syncer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.logger.Error().Err(err).Msg("failed to sync statuspage incidents")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync reconciles the synced maintenance windows with the current StatusPage incidents.
+func (s *Syncer) Sync(ctx context.Context) error {
+	incidents, err := s.fetchIncidents(ctx)
+	if err != nil {
+		return err
+	}
+
+	windows, err := s.syncedWindows(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	seen := make(map[string]bool, len(incidents))
+
+	for _, incident := range incidents {
+		status := MaintenanceStatus(incident.Status)
+		if status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED {
+			s.logger.Warn().
+				Str("incidentId", incident.ID).
+				Str("status", incident.Status).
+				Msg("skipping incident with unknown status")
+			continue
+		}
+		seen[incident.ID] = true
+
+		existing, ok := windows[incident.ID]
+		if !ok {
+			// Incidents that ended before they were first seen need no window
+			if status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED {
+				continue
+			}
+			if _, err := s.store.Create(ctx, windowFromIncident(incident, status, now)); err != nil {
+				return fmt.Errorf("create maintenance window for incident %s: %w", incident.ID, err)
+			}
+			continue
+		}
+
+		if windowFinished(existing) {
+			continue
+		}
+
+		desired := windowFromIncident(incident, status, now)
+		// Keep the end of an open incident until it is due to be pushed out again
+		if isOpen(incident) && existing.EndTime.AsTime().After(now.Add(s.interval)) {
+			desired.EndTime = existing.EndTime
+		}
+		if windowMatches(existing, desired) {
+			continue
+		}
+		desired.Id = existing.Id
+		desired.CreatedAt = existing.CreatedAt
+		if _, err := s.store.Update(ctx, desired); err != nil {
+			return fmt.Errorf("update maintenance window for incident %s: %w", incident.ID, err)
+		}
+	}
+
+	for incidentID, window := range windows {
+		if seen[incidentID] || windowFinished(window) {
+			continue
+		}
+		if err := s.completeWindow(ctx, window, now); err != nil {
+			return fmt.Errorf("complete maintenance window for incident %s: %w", incidentID, err)
+		}
+	}
+
+	s.metrics.RecordSuccess(now, len(incidents))
+	return nil
+}
+
+// fetchIncidents calls GET /api/v2/incidents.json.
+func (s *Syncer) fetchIncidents(ctx context.Context) ([]Incident, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+incidentsPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build statuspage request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "OAuth "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("statuspage request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("statuspage returned status %d", resp.StatusCode)
+	}
+
+	var body incidentsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode statuspage incidents: %w", err)
+	}
+	return body.Incidents, nil
+}
+
+// syncedWindows returns the maintenance windows created from incidents, keyed by incident ID.
+func (s *Syncer) syncedWindows(ctx context.Context) (map[string]*routingv1.MaintenanceWindow, error) {
+	windows := make(map[string]*routingv1.MaintenanceWindow)
+
+	pageToken := ""
+	for {
+		resp, err := s.store.List(ctx, &routingv1.ListMaintenanceWindowsRequest{
+			PageSize:  windowListPageSize,
+			PageToken: pageToken,
+			Labels:    map[string]string{SourceLabel: SourceStatusPage},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list maintenance windows: %w", err)
+		}
+
+		for _, window := range resp.Windows {
+			incidentID := window.Labels[IncidentIDLabel]
+			if window.Labels[SourceLabel] != SourceStatusPage || incidentID == "" {
+				continue
+			}
+			windows[incidentID] = window
+		}
+
+		if resp.NextPageToken == "" {
+			return windows, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// completeWindow ends a window whose incident is no longer listed.
+func (s *Syncer) completeWindow(ctx context.Context, window *routingv1.MaintenanceWindow, now time.Time) error {
+	if window.EndTime == nil || window.EndTime.AsTime().After(now) {
+		window.EndTime = timestamppb.New(now)
+	}
+	if window.StartTime == nil || window.StartTime.AsTime().After(now) {
+		window.StartTime = window.EndTime
+	}
+	window.Status = routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+
+	_, err := s.store.Update(ctx, window)
+	return err
+}
+
+// MaintenanceStatus maps a StatusPage incident status to a maintenance window
+// status. Unknown statuses map to MAINTENANCE_STATUS_UNSPECIFIED.
+func MaintenanceStatus(status string) routingv1.MaintenanceStatus {
+	switch status {
+	case IncidentStatusScheduled:
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+	case IncidentStatusInvestigating, IncidentStatusIdentified, IncidentStatusMonitoring,
+		IncidentStatusInProgress, IncidentStatusVerifying:
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS
+	case IncidentStatusResolved, IncidentStatusPostmortem, IncidentStatusCompleted:
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+	default:
+		return routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
+	}
+}
+
+// windowFromIncident builds the maintenance window for an incident. Windows
+// annotate rather than suppress alerts, since an incident on the public status
+// page is no reason to stop paging the responders working it.
+func windowFromIncident(incident Incident, status routingv1.MaintenanceStatus, now time.Time) *routingv1.MaintenanceWindow {
+	start := incident.CreatedAt
+	if incident.ScheduledFor != nil {
+		start = *incident.ScheduledFor
+	}
+
+	var end time.Time
+	switch {
+	case incident.ResolvedAt != nil:
+		end = *incident.ResolvedAt
+	case incident.ScheduledUntil != nil:
+		end = *incident.ScheduledUntil
+	case status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED:
+		end = now
+	default:
+		end = now.Add(OpenIncidentExtension)
+	}
+
+	affectedLabels := make([]string, 0, len(incident.Components))
+	for _, c := range incident.Components {
+		affectedLabels = append(affectedLabels, fmt.Sprintf("component=%s", c.Name))
+	}
+	slices.Sort(affectedLabels)
+
+	labels := map[string]string{
+		SourceLabel:     SourceStatusPage,
+		IncidentIDLabel: incident.ID,
+	}
+	if incident.Impact != "" {
+		labels[ImpactLabel] = incident.Impact
+	}
+
+	return &routingv1.MaintenanceWindow{
+		Name:           fmt.Sprintf("StatusPage incident: %s", incident.Name),
+		Description:    incident.Shortlink,
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+		AffectedLabels: affectedLabels,
+		Action:         routingv1.MaintenanceAction_MAINTENANCE_ACTION_ANNOTATE,
+		Status:         status,
+		Labels:         labels,
+	}
+}
+
+// isOpen reports whether an incident has no known end yet.
+func isOpen(incident Incident) bool {
+	return incident.ResolvedAt == nil && incident.ScheduledUntil == nil &&
+		MaintenanceStatus(incident.Status) != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+}
+
+// windowMatches reports whether an existing window already reflects the desired one.
+func windowMatches(existing, desired *routingv1.MaintenanceWindow) bool {
+	affectedLabels := slices.Clone(existing.AffectedLabels)
+	slices.Sort(affectedLabels)
+
+	return existing.Name == desired.Name &&
+		existing.Description == desired.Description &&
+		existing.Action == desired.Action &&
+		existing.Status == desired.Status &&
+		existing.StartTime.AsTime().Equal(desired.StartTime.AsTime()) &&
+		existing.EndTime.AsTime().Equal(desired.EndTime.AsTime()) &&
+		slices.Equal(affectedLabels, desired.AffectedLabels) &&
+		existing.Labels[ImpactLabel] == desired.Labels[ImpactLabel]
+}
+
+// windowFinished reports whether a window no longer needs syncing.
+func windowFinished(window *routingv1.MaintenanceWindow) bool {
+	return window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED ||
+		window.Status == routingv1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED
+}
@@ -0,0 +1,378 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// windowStore is an in-memory maintenance.Store.
+type windowStore struct {
+	windows []*routingv1.MaintenanceWindow
+	counter int
+	updates int
+}
+
+func (s *windowStore) Create(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	s.counter++
+	window.Id = fmt.Sprintf("mw-%d", s.counter)
+	s.windows = append(s.windows, window)
+	return window, nil
+}
+
+func (s *windowStore) Get(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	for _, w := range s.windows {
+		if w.Id == id {
+			return w, nil
+		}
+	}
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) List(ctx context.Context, req *routingv1.ListMaintenanceWindowsRequest) (*routingv1.ListMaintenanceWindowsResponse, error) {
+	var windows []*routingv1.MaintenanceWindow
+	for _, w := range s.windows {
+		matches := true
+		for k, v := range req.Labels {
+			if w.Labels[k] != v {
+				matches = false
+			}
+		}
+		if matches {
+			windows = append(windows, w)
+		}
+	}
+	return &routingv1.ListMaintenanceWindowsResponse{Windows: windows, TotalCount: int32(len(windows))}, nil
+}
+
+func (s *windowStore) Update(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	for i, w := range s.windows {
+		if w.Id == window.Id {
+			s.updates++
+			s.windows[i] = window
+			return window, nil
+		}
+	}
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (s *windowStore) ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (s *windowStore) ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (s *windowStore) UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error {
+	return nil
+}
+
+func (s *windowStore) TransitionStatuses(ctx context.Context) ([]maintenance.StatusTransition, error) {
+	return nil, nil
+}
+
+func (s *windowStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	return nil, maintenance.ErrNotFound
+}
+
+func (s *windowStore) byIncident(incidentID string) *routingv1.MaintenanceWindow {
+	for _, w := range s.windows {
+		if w.Labels[IncidentIDLabel] == incidentID {
+			return w
+		}
+	}
+	return nil
+}
+
+// fakeStatusPage serves a mutable list of incidents from GET /api/v2/incidents.json.
+type fakeStatusPage struct {
+	mu        sync.Mutex
+	incidents []Incident
+	status    int
+	auth      string
+}
+
+func (f *fakeStatusPage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method != http.MethodGet || r.URL.Path != "/api/v2/incidents.json" {
+		http.NotFound(w, r)
+		return
+	}
+	f.auth = r.Header.Get("Authorization")
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"page":      map[string]string{"id": "page-1", "name": "Acme Status"},
+		"incidents": f.incidents,
+	})
+}
+
+func (f *fakeStatusPage) setIncidents(incidents ...Incident) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.incidents = incidents
+}
+
+func newTestSyncer(t *testing.T, now time.Time) (*Syncer, *fakeStatusPage, *windowStore) {
+	t.Helper()
+
+	sp := &fakeStatusPage{}
+	server := httptest.NewServer(sp)
+	t.Cleanup(server.Close)
+
+	store := &windowStore{}
+	syncer := NewSyncer(Config{APIKey: "secret", PageID: "page-1", BaseURL: server.URL}, store, zerolog.Nop(), nil)
+	syncer.now = func() time.Time { return now }
+	return syncer, sp, store
+}
+
+func incident(id, status string, created time.Time, components ...string) Incident {
+	inc := Incident{
+		ID:        id,
+		Name:      "Elevated API errors",
+		Status:    status,
+		Impact:    "major",
+		Shortlink: "https://stspg.io/" + id,
+		CreatedAt: created,
+	}
+	for _, name := range components {
+		inc.Components = append(inc.Components, Component{ID: "c-" + name, Name: name})
+	}
+	return inc
+}
+
+func TestSyncer_CreatesWindows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, sp, store := newTestSyncer(t, now)
+
+	scheduledFor := now.Add(2 * time.Hour)
+	scheduledUntil := now.Add(4 * time.Hour)
+	scheduled := incident("inc-scheduled", IncidentStatusScheduled, now.Add(-time.Hour), "Database")
+	scheduled.ScheduledFor = &scheduledFor
+	scheduled.ScheduledUntil = &scheduledUntil
+
+	resolvedAt := now.Add(-time.Hour)
+	resolved := incident("inc-resolved", IncidentStatusResolved, now.Add(-2*time.Hour))
+	resolved.ResolvedAt = &resolvedAt
+
+	sp.setIncidents(
+		incident("inc-open", IncidentStatusInvestigating, now.Add(-30*time.Minute), "API", "Dashboard"),
+		scheduled,
+		resolved,
+		incident("inc-unknown", "exploded", now),
+	)
+
+	if err := syncer.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	if sp.auth != "OAuth secret" {
+		t.Errorf("expected OAuth authorization header, got %q", sp.auth)
+	}
+	if len(store.windows) != 2 {
+		t.Fatalf("expected 2 windows, got %d", len(store.windows))
+	}
+
+	open := store.byIncident("inc-open")
+	if open == nil {
+		t.Fatal("expected a window for inc-open")
+	}
+	if open.Labels[SourceLabel] != SourceStatusPage || open.Labels[ImpactLabel] != "major" {
+		t.Errorf("unexpected labels %v", open.Labels)
+	}
+	if open.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS {
+		t.Errorf("expected in progress status, got %v", open.Status)
+	}
+	if open.Action != routingv1.MaintenanceAction_MAINTENANCE_ACTION_ANNOTATE {
+		t.Errorf("expected annotate action, got %v", open.Action)
+	}
+	if !open.EndTime.AsTime().Equal(now.Add(OpenIncidentExtension)) {
+		t.Errorf("expected open incident to end at %v, got %v", now.Add(OpenIncidentExtension), open.EndTime.AsTime())
+	}
+	if got := open.AffectedLabels; len(got) != 2 || got[0] != "component=API" || got[1] != "component=Dashboard" {
+		t.Errorf("unexpected affected labels %v", got)
+	}
+
+	window := store.byIncident("inc-scheduled")
+	if window == nil || window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED {
+		t.Fatalf("expected a scheduled window for inc-scheduled, got %v", window)
+	}
+	if !window.StartTime.AsTime().Equal(scheduledFor) || !window.EndTime.AsTime().Equal(scheduledUntil) {
+		t.Errorf("expected window to span the scheduled maintenance, got %v - %v", window.StartTime.AsTime(), window.EndTime.AsTime())
+	}
+
+	metrics := syncer.Metrics()
+	if metrics.IncidentsSyncedTotal() != 4 {
+		t.Errorf("expected 4 incidents synced, got %d", metrics.IncidentsSyncedTotal())
+	}
+	if !metrics.LastSuccessTimestamp().Equal(now) {
+		t.Errorf("expected last success at %v, got %v", now, metrics.LastSuccessTimestamp())
+	}
+}
+
+func TestSyncer_UpdatesAndResolvesIncidents(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, sp, store := newTestSyncer(t, now)
+	ctx := context.Background()
+
+	inc := incident("inc-1", IncidentStatusInvestigating, now.Add(-time.Hour), "API")
+	sp.setIncidents(inc)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	windowID := store.windows[0].Id
+
+	// An unchanged open incident keeps its end time until it is due
+	syncer.now = func() time.Time { return now.Add(5 * time.Minute) }
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if store.updates != 0 {
+		t.Errorf("expected no updates for an unchanged incident, got %d", store.updates)
+	}
+
+	// The end is pushed out as it approaches
+	later := now.Add(OpenIncidentExtension - time.Minute)
+	syncer.now = func() time.Time { return later }
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if got := store.windows[0].EndTime.AsTime(); !got.Equal(later.Add(OpenIncidentExtension)) {
+		t.Errorf("expected end pushed out to %v, got %v", later.Add(OpenIncidentExtension), got)
+	}
+
+	resolvedAt := later.Add(-10 * time.Minute)
+	inc.Status = IncidentStatusResolved
+	inc.ResolvedAt = &resolvedAt
+	sp.setIncidents(inc)
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	if len(store.windows) != 1 {
+		t.Fatalf("expected the window to be updated in place, got %d windows", len(store.windows))
+	}
+	window := store.windows[0]
+	if window.Id != windowID {
+		t.Errorf("expected window %s to be kept, got %s", windowID, window.Id)
+	}
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED {
+		t.Errorf("expected completed status, got %v", window.Status)
+	}
+	if !window.EndTime.AsTime().Equal(resolvedAt) {
+		t.Errorf("expected window to end at %v, got %v", resolvedAt, window.EndTime.AsTime())
+	}
+}
+
+func TestSyncer_CompletesRemovedIncidents(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, sp, store := newTestSyncer(t, now)
+	ctx := context.Background()
+
+	sp.setIncidents(incident("inc-1", IncidentStatusMonitoring, now.Add(-time.Hour)))
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	sp.setIncidents()
+	if err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	window := store.byIncident("inc-1")
+	if window.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED {
+		t.Errorf("expected window to be completed, got %v", window.Status)
+	}
+	if !window.EndTime.AsTime().Equal(now) {
+		t.Errorf("expected window to end at %v, got %v", now, window.EndTime.AsTime())
+	}
+}
+
+func TestSyncer_IgnoresOtherWindows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	syncer, _, store := newTestSyncer(t, now)
+
+	manual := &routingv1.MaintenanceWindow{
+		Id:     "manual",
+		Status: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		Labels: map[string]string{IncidentIDLabel: "inc-1"},
+	}
+	store.windows = append(store.windows, manual)
+
+	if err := syncer.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if manual.Status != routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS || store.updates != 0 {
+		t.Error("expected windows not created from incidents to be left alone")
+	}
+}
+
+func TestSyncer_StatusPageError(t *testing.T) {
+	syncer, sp, store := newTestSyncer(t, time.Now())
+	sp.status = http.StatusUnauthorized
+
+	if err := syncer.Sync(context.Background()); err == nil {
+		t.Fatal("expected an error when statuspage rejects the request")
+	}
+	if len(store.windows) != 0 || !syncer.Metrics().LastSuccessTimestamp().IsZero() {
+		t.Error("expected nothing to be synced")
+	}
+}
+
+func TestMaintenanceStatus(t *testing.T) {
+	tests := map[string]routingv1.MaintenanceStatus{
+		IncidentStatusScheduled:     routingv1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED,
+		IncidentStatusInvestigating: routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		IncidentStatusIdentified:    routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		IncidentStatusMonitoring:    routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		IncidentStatusInProgress:    routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		IncidentStatusVerifying:     routingv1.MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS,
+		IncidentStatusResolved:      routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		IncidentStatusPostmortem:    routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		IncidentStatusCompleted:     routingv1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED,
+		"":                          routingv1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED,
+	}
+	for status, want := range tests {
+		if got := MaintenanceStatus(status); got != want {
+			t.Errorf("MaintenanceStatus(%q) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("STATUSPAGE_API_KEY", "")
+	t.Setenv("STATUSPAGE_PAGE_ID", "page-1")
+	if ConfigFromEnv().Enabled() {
+		t.Error("expected config without an API key to be disabled")
+	}
+
+	t.Setenv("STATUSPAGE_API_KEY", "secret")
+	config := ConfigFromEnv()
+	if !config.Enabled() || config.APIKey != "secret" || config.PageID != "page-1" {
+		t.Errorf("unexpected config %+v", config)
+	}
+}
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// ApplyCELFilter keeps the alerts matching req.CelFilter and applies req.PageSize
+// to the result. It returns a codes.InvalidArgument error if the filter does not compile.
+func ApplyCELFilter(filter *cel.AlertFilter, req *alertingv1.ListAlertsRequest, alerts []*alertingv1.Alert) ([]*alertingv1.Alert, error) {
+	if req.CelFilter == "" {
+		return alerts, nil
+	}
+
+	compiled, err := filter.Compile(req.CelFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cel_filter: %v", err)
+	}
+
+	filtered := make([]*alertingv1.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if req.PageSize > 0 && int32(len(filtered)) >= req.PageSize {
+			break
+		}
+		if filter.Match(compiled, alert) {
+			filtered = append(filtered, alert)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func filterTestAlerts() []*alertingv1.Alert {
+	return []*alertingv1.Alert{
+		{Id: "a1", Severity: alertingv1.Severity_SEVERITY_CRITICAL, Labels: map[string]string{"env": "production"}},
+		{Id: "a2", Severity: alertingv1.Severity_SEVERITY_LOW, Labels: map[string]string{"env": "production"}},
+		{Id: "a3", Severity: alertingv1.Severity_SEVERITY_HIGH, Labels: map[string]string{"env": "staging"}},
+		{Id: "a4", Severity: alertingv1.Severity_SEVERITY_CRITICAL, Labels: map[string]string{"env": "production"}},
+	}
+}
+
+func alertIDs(alerts []*alertingv1.Alert) []string {
+	ids := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		ids = append(ids, alert.Id)
+	}
+	return ids
+}
+
+func TestApplyCELFilter(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *alertingv1.ListAlertsRequest
+		want []string
+	}{
+		{"no filter", &alertingv1.ListAlertsRequest{}, []string{"a1", "a2", "a3", "a4"}},
+		{"label filter", &alertingv1.ListAlertsRequest{CelFilter: `labels['env'] == 'production'`}, []string{"a1", "a2", "a4"}},
+		{"severity comparison", &alertingv1.ListAlertsRequest{CelFilter: `severityLevel(severity) >= severityLevel('high')`}, []string{"a1", "a3", "a4"}},
+		{"page size applies to filtered alerts", &alertingv1.ListAlertsRequest{CelFilter: `severity == 'critical'`, PageSize: 1}, []string{"a1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyCELFilter(filter, tt.req, filterTestAlerts())
+			if err != nil {
+				t.Fatalf("ApplyCELFilter() error = %v", err)
+			}
+			if ids := alertIDs(got); !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("ApplyCELFilter() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyCELFilter_InvalidExpression(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+
+	_, err = ApplyCELFilter(filter, &alertingv1.ListAlertsRequest{CelFilter: `labels['env'] = 'production'`}, filterTestAlerts())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ApplyCELFilter() error = %v, want InvalidArgument", err)
+	}
+}
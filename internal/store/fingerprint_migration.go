@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// FingerprintMigrationBatchSize is the number of alerts re-fingerprinted per batch.
+const FingerprintMigrationBatchSize = 100
+
+// Fingerprint migration states for FingerprintMigrationStatus.State.
+const (
+	FingerprintMigrationIdle      = "idle"
+	FingerprintMigrationRunning   = "running"
+	FingerprintMigrationCompleted = "completed"
+	FingerprintMigrationFailed    = "failed"
+)
+
+// ErrFingerprintMigrationRunning is returned when a migration is started while
+// another one is still running.
+var ErrFingerprintMigrationRunning = errors.New("fingerprint migration already running")
+
+// FingerprintStrategy derives the deduplication fingerprint of an alert.
+type FingerprintStrategy interface {
+	// Compute returns the fingerprint for an alert from its labels, source and
+	// any source-specific identifying fields.
+	Compute(labels map[string]string, source string, extraFields ...string) string
+}
+
+// FingerprintMigrationStatus reports the progress of a fingerprint migration.
+type FingerprintMigrationStatus struct {
+	State string `json:"state"`
+	// Total is the number of alerts in the store when the current run started.
+	Total int64 `json:"total"`
+	// Processed is the number of alerts checked, including earlier runs that were resumed.
+	Processed int64 `json:"processed"`
+	// Migrated is the number of alerts whose fingerprint changed.
+	Migrated int64 `json:"migrated"`
+	// Skipped is the number of alerts that kept their fingerprint because the
+	// new one already belongs to another alert, e.g. alerts fingerprinted from
+	// a source ID whose labels match.
+	Skipped int64 `json:"skipped"`
+	// Checkpoint is the ID of the last alert of the last completed batch.
+	Checkpoint  string     `json:"checkpoint,omitempty"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// FingerprintMigrator recomputes the fingerprints of stored alerts after the
+// fingerprint algorithm changes.
+type FingerprintMigrator struct {
+	alerts AlertStore
+
+	mu     sync.RWMutex
+	status FingerprintMigrationStatus
+}
+
+// NewFingerprintMigrator creates a migrator for the alerts in the store.
+func NewFingerprintMigrator(alerts AlertStore) *FingerprintMigrator {
+	return &FingerprintMigrator{
+		alerts: alerts,
+		status: FingerprintMigrationStatus{State: FingerprintMigrationIdle},
+	}
+}
+
+// Status returns the progress of the current or last migration.
+func (m *FingerprintMigrator) Status() FingerprintMigrationStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.status
+}
+
+// MigrateFingerprints recomputes the fingerprint of every alert with newStrategy
+// from its stored labels, source and service ID, in batches of
+// FingerprintMigrationBatchSize ordered by alert ID. Alerts are updated in place
+// by ID, so none are created or removed. Alerts whose new fingerprint already
+// belongs to another alert keep their fingerprint and are counted as skipped,
+// so that neither alert drops out of the fingerprint index. If the previous
+// migration failed, it resumes after the last completed batch. Returns the
+// number of alerts whose fingerprint changed during this run.
+func (m *FingerprintMigrator) MigrateFingerprints(ctx context.Context, newStrategy FingerprintStrategy) (int64, error) {
+	checkpoint, err := m.start()
+	if err != nil {
+		return 0, err
+	}
+
+	ids, err := m.alertIDs(ctx)
+	if err != nil {
+		return 0, m.fail(err)
+	}
+	m.mu.Lock()
+	m.status.Total = int64(len(ids))
+	m.mu.Unlock()
+
+	// Skip the alerts handled by the batches completed before the checkpoint
+	pending := ids[sort.SearchStrings(ids, checkpoint):]
+	if len(pending) > 0 && pending[0] == checkpoint {
+		pending = pending[1:]
+	}
+
+	var migrated int64
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return migrated, m.fail(err)
+		}
+
+		batch := pending[:min(FingerprintMigrationBatchSize, len(pending))]
+		pending = pending[len(batch):]
+
+		changed, skipped, err := m.migrateBatch(ctx, batch, newStrategy)
+		migrated += changed
+
+		m.mu.Lock()
+		m.status.Migrated += changed
+		m.status.Skipped += skipped
+		if err == nil {
+			m.status.Processed += int64(len(batch))
+			m.status.Checkpoint = batch[len(batch)-1]
+		}
+		m.mu.Unlock()
+
+		if err != nil {
+			return migrated, m.fail(err)
+		}
+	}
+
+	m.mu.Lock()
+	now := time.Now()
+	m.status.State = FingerprintMigrationCompleted
+	m.status.CompletedAt = &now
+	m.mu.Unlock()
+
+	return migrated, nil
+}
+
+// start marks the migration as running and returns the checkpoint to resume
+// from, which is empty unless the previous migration failed.
+func (m *FingerprintMigrator) start() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch m.status.State {
+	case FingerprintMigrationRunning:
+		return "", ErrFingerprintMigrationRunning
+	case FingerprintMigrationFailed:
+		m.status.State = FingerprintMigrationRunning
+		m.status.Error = ""
+		return m.status.Checkpoint, nil
+	}
+
+	now := time.Now()
+	m.status = FingerprintMigrationStatus{
+		State:     FingerprintMigrationRunning,
+		StartedAt: &now,
+	}
+	return "", nil
+}
+
+// fail marks the migration as failed and returns err.
+func (m *FingerprintMigrator) fail(err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status.State = FingerprintMigrationFailed
+	m.status.Error = err.Error()
+	return err
+}
+
+// alertIDs returns the IDs of every stored alert in ascending order.
+func (m *FingerprintMigrator) alertIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for {
+		resp, err := m.alerts.List(ctx, &alertingv1.ListAlertsRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, fmt.Errorf("list alerts: %w", err)
+		}
+		for _, alert := range resp.Alerts {
+			ids = append(ids, alert.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// migrateBatch recomputes the fingerprints of a batch of alerts and returns how
+// many changed and how many were skipped because their new fingerprint belongs
+// to another alert. Each alert is re-read so updates since the listing are kept.
+func (m *FingerprintMigrator) migrateBatch(ctx context.Context, ids []string, strategy FingerprintStrategy) (int64, int64, error) {
+	var changed, skipped int64
+	for _, id := range ids {
+		alert, err := m.alerts.GetByID(ctx, id)
+		if err != nil {
+			return changed, skipped, fmt.Errorf("get alert %s: %w", id, err)
+		}
+		if alert == nil {
+			// Deleted since the listing
+			continue
+		}
+
+		fingerprint := strategy.Compute(alert.Labels, FingerprintSource(alert.Source), alert.ServiceId)
+		if fingerprint == alert.Fingerprint {
+			continue
+		}
+
+		holder, err := m.alerts.GetByFingerprint(ctx, fingerprint)
+		if err != nil {
+			return changed, skipped, fmt.Errorf("get alert by fingerprint %s: %w", fingerprint, err)
+		}
+		if holder != nil && holder.Id != alert.Id {
+			skipped++
+			continue
+		}
+
+		updated := proto.Clone(alert).(*alertingv1.Alert)
+		updated.Fingerprint = fingerprint
+		if _, err := m.alerts.Update(ctx, updated); err != nil {
+			return changed, skipped, fmt.Errorf("update alert %s: %w", id, err)
+		}
+		changed++
+	}
+	return changed, skipped, nil
+}
+
+// FingerprintSource returns the source name hashed into fingerprints, e.g.
+// "alertmanager" for ALERT_SOURCE_ALERTMANAGER.
+func FingerprintSource(source alertingv1.AlertSource) string {
+	return strings.ToLower(strings.TrimPrefix(source.String(), "ALERT_SOURCE_"))
+}
@@ -0,0 +1,208 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// migrationAlertStore keeps alerts by ID and can fail updates after a number of calls.
+type migrationAlertStore struct {
+	alerts      map[string]*alertingv1.Alert
+	updates     int
+	failUpdates int // fail every update after this many, if > 0
+}
+
+func newMigrationAlertStore(n int) *migrationAlertStore {
+	s := &migrationAlertStore{alerts: make(map[string]*alertingv1.Alert)}
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("alert-%04d", i)
+		s.alerts[id] = &alertingv1.Alert{
+			Id:          id,
+			ServiceId:   "svc-1",
+			Source:      alertingv1.AlertSource_ALERT_SOURCE_GENERIC,
+			Fingerprint: "legacy-" + id,
+			Labels:      map[string]string{"host": id},
+		}
+	}
+	return s
+}
+
+func (s *migrationAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	s.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (s *migrationAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return s.alerts[id], nil
+}
+
+func (s *migrationAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	for _, alert := range s.alerts {
+		if alert.Fingerprint == fingerprint {
+			return alert, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *migrationAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	if s.failUpdates > 0 && s.updates >= s.failUpdates {
+		return nil, errors.New("database unavailable")
+	}
+	s.updates++
+	s.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (s *migrationAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	s.alerts[alert.Id] = alert
+	return alert, true, nil
+}
+
+func (s *migrationAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	alerts := make([]*alertingv1.Alert, 0, len(s.alerts))
+	for _, alert := range s.alerts {
+		alerts = append(alerts, alert)
+	}
+	return &alertingv1.ListAlertsResponse{Alerts: alerts}, nil
+}
+
+// serviceHostStrategy fingerprints alerts by source, service and host label.
+type serviceHostStrategy struct{}
+
+func (serviceHostStrategy) Compute(labels map[string]string, source string, extraFields ...string) string {
+	return source + ":" + strings.Join(extraFields, ":") + ":" + labels["host"]
+}
+
+// assertMigrated checks that every alert is kept once with its new fingerprint.
+func assertMigrated(t *testing.T, s *migrationAlertStore, want int) {
+	t.Helper()
+	if len(s.alerts) != want {
+		t.Fatalf("alerts = %d, want %d", len(s.alerts), want)
+	}
+	seen := make(map[string]bool)
+	for id, alert := range s.alerts {
+		if expected := "generic:svc-1:" + id; alert.Fingerprint != expected {
+			t.Errorf("alert %s fingerprint = %q, want %q", id, alert.Fingerprint, expected)
+		}
+		if seen[alert.Fingerprint] {
+			t.Errorf("fingerprint %q is duplicated", alert.Fingerprint)
+		}
+		seen[alert.Fingerprint] = true
+	}
+}
+
+func TestMigrateFingerprints(t *testing.T) {
+	alerts := newMigrationAlertStore(250)
+	migrator := NewFingerprintMigrator(alerts)
+
+	migrated, err := migrator.MigrateFingerprints(context.Background(), serviceHostStrategy{})
+	if err != nil {
+		t.Fatalf("MigrateFingerprints() error = %v", err)
+	}
+	if migrated != 250 {
+		t.Errorf("MigrateFingerprints() = %d, want 250", migrated)
+	}
+	assertMigrated(t, alerts, 250)
+
+	status := migrator.Status()
+	if status.State != FingerprintMigrationCompleted || status.Processed != 250 || status.Migrated != 250 {
+		t.Errorf("Status() = %+v, want completed with 250 processed and migrated", status)
+	}
+	if status.Checkpoint != "alert-0249" {
+		t.Errorf("Status().Checkpoint = %q, want alert-0249", status.Checkpoint)
+	}
+
+	// Fingerprints already computed with the strategy are left untouched
+	migrated, err = migrator.MigrateFingerprints(context.Background(), serviceHostStrategy{})
+	if err != nil {
+		t.Fatalf("second MigrateFingerprints() error = %v", err)
+	}
+	if migrated != 0 {
+		t.Errorf("second MigrateFingerprints() = %d, want 0", migrated)
+	}
+}
+
+func TestMigrateFingerprints_ResumesFromCheckpoint(t *testing.T) {
+	alerts := newMigrationAlertStore(250)
+	alerts.failUpdates = 150
+	migrator := NewFingerprintMigrator(alerts)
+
+	migrated, err := migrator.MigrateFingerprints(context.Background(), serviceHostStrategy{})
+	if err == nil {
+		t.Fatal("MigrateFingerprints() expected error")
+	}
+	if migrated != 150 {
+		t.Errorf("MigrateFingerprints() = %d, want 150", migrated)
+	}
+
+	// The first batch completed, the failed second batch is retried on resume
+	status := migrator.Status()
+	if status.State != FingerprintMigrationFailed || status.Error == "" {
+		t.Errorf("Status() = %+v, want failed with an error", status)
+	}
+	if status.Checkpoint != "alert-0099" || status.Processed != 100 {
+		t.Errorf("Status() checkpoint = %q, processed = %d, want alert-0099 and 100", status.Checkpoint, status.Processed)
+	}
+
+	alerts.failUpdates = 0
+	migrated, err = migrator.MigrateFingerprints(context.Background(), serviceHostStrategy{})
+	if err != nil {
+		t.Fatalf("resumed MigrateFingerprints() error = %v", err)
+	}
+	if migrated != 100 {
+		t.Errorf("resumed MigrateFingerprints() = %d, want 100", migrated)
+	}
+	assertMigrated(t, alerts, 250)
+
+	status = migrator.Status()
+	if status.State != FingerprintMigrationCompleted || status.Processed != 250 || status.Migrated != 250 {
+		t.Errorf("Status() = %+v, want completed with 250 processed and migrated", status)
+	}
+}
+
+func TestMigrateFingerprints_SkipsCollisions(t *testing.T) {
+	// Sentry alerts fingerprinted from their issue IDs with the same labels
+	// recompute to the same label-only fingerprint
+	alerts := &migrationAlertStore{alerts: make(map[string]*alertingv1.Alert)}
+	for _, id := range []string{"alert-1", "alert-2"} {
+		alerts.alerts[id] = &alertingv1.Alert{
+			Id:          id,
+			ServiceId:   "svc-1",
+			Source:      alertingv1.AlertSource_ALERT_SOURCE_SENTRY,
+			Fingerprint: "sentry-issue-" + id,
+			Labels:      map[string]string{"host": "web-1"},
+		}
+	}
+	migrator := NewFingerprintMigrator(alerts)
+
+	migrated, err := migrator.MigrateFingerprints(context.Background(), serviceHostStrategy{})
+	if err != nil {
+		t.Fatalf("MigrateFingerprints() error = %v", err)
+	}
+	if migrated != 1 {
+		t.Errorf("MigrateFingerprints() = %d, want 1", migrated)
+	}
+	if got := alerts.alerts["alert-1"].Fingerprint; got != "sentry:svc-1:web-1" {
+		t.Errorf("alert-1 fingerprint = %q, want sentry:svc-1:web-1", got)
+	}
+	if got := alerts.alerts["alert-2"].Fingerprint; got != "sentry-issue-alert-2" {
+		t.Errorf("colliding alert-2 fingerprint = %q, want it kept", got)
+	}
+
+	status := migrator.Status()
+	if status.State != FingerprintMigrationCompleted || status.Migrated != 1 || status.Skipped != 1 {
+		t.Errorf("Status() = %+v, want completed with 1 migrated and 1 skipped", status)
+	}
+}
+
+func TestFingerprintSource(t *testing.T) {
+	if got := FingerprintSource(alertingv1.AlertSource_ALERT_SOURCE_ALERTMANAGER); got != "alertmanager" {
+		t.Errorf("FingerprintSource() = %q, want alertmanager", got)
+	}
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// Supported values of ListAlertsRequest.OrderBy.
+const (
+	OrderByCreatedAtAsc  = "created_at asc"
+	OrderByCreatedAtDesc = "created_at desc"
+)
+
+// ListAlerts applies the filters, ordering and pagination of req to alerts.
+// Alerts are ordered by created_at, then ID, and req.PageToken encodes both of
+// the last alert of the previous page, so pages stay consistent while alerts
+// are added or deleted. Invalid requests return a codes.InvalidArgument error.
+func ListAlerts(filter *cel.AlertFilter, req *alertingv1.ListAlertsRequest, alerts []*alertingv1.Alert) (*alertingv1.ListAlertsResponse, error) {
+	descending, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeAlertPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// before reports whether a is listed before b
+	before := func(a, b *alertingv1.Alert) bool {
+		if descending {
+			return alertBefore(b, a)
+		}
+		return alertBefore(a, b)
+	}
+
+	sorted := make([]*alertingv1.Alert, len(alerts))
+	copy(sorted, alerts)
+	sort.Slice(sorted, func(i, j int) bool {
+		return before(sorted[i], sorted[j])
+	})
+
+	matched := make([]*alertingv1.Alert, 0, len(sorted))
+	for _, alert := range sorted {
+		if matchesListFilters(req, alert) {
+			matched = append(matched, alert)
+		}
+	}
+	matched, err = ApplyCELFilter(filter, &alertingv1.ListAlertsRequest{CelFilter: req.CelFilter}, matched)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seek past the cursor position, which need not be a listed alert
+	page := matched
+	if cursor != nil {
+		last := cursor.alert()
+		page = make([]*alertingv1.Alert, 0, len(matched))
+		for _, alert := range matched {
+			if before(last, alert) {
+				page = append(page, alert)
+			}
+		}
+	}
+
+	resp := &alertingv1.ListAlertsResponse{TotalCount: int32(len(matched))}
+	if req.PageSize > 0 && int32(len(page)) > req.PageSize {
+		page = page[:req.PageSize]
+		resp.NextPageToken = encodeAlertPageToken(page[len(page)-1])
+	}
+	resp.Alerts = page
+
+	return resp, nil
+}
+
+// alertCursor is the keyset position encoded in ListAlerts page tokens.
+type alertCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// alert returns an alert at the cursor position, for comparison with alertBefore.
+func (c *alertCursor) alert() *alertingv1.Alert {
+	return &alertingv1.Alert{Id: c.ID, CreatedAt: timestamppb.New(c.CreatedAt)}
+}
+
+// encodeAlertPageToken returns the page token continuing after alert.
+func encodeAlertPageToken(alert *alertingv1.Alert) string {
+	data, _ := json.Marshal(alertCursor{CreatedAt: alert.GetCreatedAt().AsTime().UTC(), ID: alert.Id})
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// decodeAlertPageToken parses a ListAlerts page token, returning nil for an
+// empty token.
+func decodeAlertPageToken(token string) (*alertCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	var cursor alertCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return &cursor, nil
+}
+
+// parseOrderBy reports whether orderBy sorts alerts in descending order.
+func parseOrderBy(orderBy string) (bool, error) {
+	switch strings.ToLower(strings.Join(strings.Fields(orderBy), " ")) {
+	case "", "created_at", OrderByCreatedAtAsc:
+		return false, nil
+	case OrderByCreatedAtDesc:
+		return true, nil
+	default:
+		return false, status.Errorf(codes.InvalidArgument, "unsupported order_by %q", orderBy)
+	}
+}
+
+// alertBefore reports whether a was created before b, comparing IDs on ties.
+func alertBefore(a, b *alertingv1.Alert) bool {
+	at, bt := a.GetCreatedAt().AsTime(), b.GetCreatedAt().AsTime()
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	return a.Id < b.Id
+}
+
+// matchesListFilters reports whether alert matches the status, severity and
+// service filters of req. Empty filters match every alert.
+func matchesListFilters(req *alertingv1.ListAlertsRequest, alert *alertingv1.Alert) bool {
+	if len(req.Statuses) > 0 && !slices.Contains(req.Statuses, alert.Status) {
+		return false
+	}
+	if len(req.Severities) > 0 && !slices.Contains(req.Severities, alert.Severity) {
+		return false
+	}
+	if req.ServiceId != "" && alert.ServiceId != req.ServiceId {
+		return false
+	}
+	return true
+}
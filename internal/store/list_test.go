@@ -0,0 +1,254 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/routing/cel"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// listTestAlerts returns n alerts created a minute apart, cycling through
+// statuses, severities and services. Every fifth alert shares the creation
+// time of the previous one to exercise the ID tie-break.
+func listTestAlerts(n int) []*alertingv1.Alert {
+	statuses := []alertingv1.AlertStatus{
+		alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED,
+		alertingv1.AlertStatus_ALERT_STATUS_RESOLVED,
+	}
+	severities := []alertingv1.Severity{
+		alertingv1.Severity_SEVERITY_CRITICAL,
+		alertingv1.Severity_SEVERITY_HIGH,
+		alertingv1.Severity_SEVERITY_LOW,
+		alertingv1.Severity_SEVERITY_INFO,
+	}
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	alerts := make([]*alertingv1.Alert, 0, n)
+	created := base
+	for i := 0; i < n; i++ {
+		if i%5 != 4 {
+			created = base.Add(time.Duration(i) * time.Minute)
+		}
+		alerts = append(alerts, &alertingv1.Alert{
+			Id:        fmt.Sprintf("alert-%d", i+1),
+			Status:    statuses[i%len(statuses)],
+			Severity:  severities[i%len(severities)],
+			ServiceId: fmt.Sprintf("svc-%d", i%2),
+			Labels:    map[string]string{"env": []string{"production", "staging"}[i%2]},
+			CreatedAt: timestamppb.New(created),
+		})
+	}
+
+	// Stores return alerts in no particular order
+	for i, j := 0, len(alerts)-1; i < j; i, j = i+1, j-1 {
+		alerts[i], alerts[j] = alerts[j], alerts[i]
+	}
+	return alerts
+}
+
+// listAllPages pages through alerts and returns the IDs in the order returned.
+func listAllPages(t *testing.T, filter *cel.AlertFilter, req *alertingv1.ListAlertsRequest, alerts []*alertingv1.Alert) []string {
+	t.Helper()
+
+	var ids []string
+	for pages := 0; ; pages++ {
+		if pages > len(alerts)+1 {
+			t.Fatal("pagination did not terminate")
+		}
+		resp, err := ListAlerts(filter, req, alerts)
+		if err != nil {
+			t.Fatalf("ListAlerts() error = %v", err)
+		}
+		if req.PageSize > 0 && int32(len(resp.Alerts)) > req.PageSize {
+			t.Fatalf("ListAlerts() returned %d alerts, page size is %d", len(resp.Alerts), req.PageSize)
+		}
+		ids = append(ids, alertIDs(resp.Alerts)...)
+		if resp.NextPageToken == "" {
+			return ids
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+func TestListAlerts_PaginationReturnsEachAlertOnce(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+	alerts := listTestAlerts(23)
+
+	tests := []struct {
+		name string
+		req  *alertingv1.ListAlertsRequest
+		want int
+	}{
+		{"single page", &alertingv1.ListAlertsRequest{}, 23},
+		{"page size 1", &alertingv1.ListAlertsRequest{PageSize: 1}, 23},
+		{"page size 5", &alertingv1.ListAlertsRequest{PageSize: 5}, 23},
+		{"page size divides total", &alertingv1.ListAlertsRequest{PageSize: 23}, 23},
+		{"page size larger than total", &alertingv1.ListAlertsRequest{PageSize: 100}, 23},
+		{"descending", &alertingv1.ListAlertsRequest{PageSize: 4, OrderBy: "created_at DESC"}, 23},
+		{"status filter", &alertingv1.ListAlertsRequest{PageSize: 3, Statuses: []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED}}, 8},
+		{"severity filter", &alertingv1.ListAlertsRequest{PageSize: 2, Severities: []alertingv1.Severity{alertingv1.Severity_SEVERITY_CRITICAL, alertingv1.Severity_SEVERITY_LOW}}, 12},
+		{"service filter", &alertingv1.ListAlertsRequest{PageSize: 4, ServiceId: "svc-1"}, 11},
+		{"cel filter", &alertingv1.ListAlertsRequest{PageSize: 3, CelFilter: `labels['env'] == 'staging'`}, 11},
+		{"combined filters", &alertingv1.ListAlertsRequest{
+			PageSize:   2,
+			OrderBy:    OrderByCreatedAtDesc,
+			Statuses:   []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED},
+			ServiceId:  "svc-0",
+			Severities: []alertingv1.Severity{alertingv1.Severity_SEVERITY_CRITICAL, alertingv1.Severity_SEVERITY_LOW},
+		}, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := listAllPages(t, filter, tt.req, alerts)
+			if len(ids) != tt.want {
+				t.Fatalf("got %d alerts, want %d: %v", len(ids), tt.want, ids)
+			}
+
+			seen := make(map[string]bool, len(ids))
+			for _, id := range ids {
+				if seen[id] {
+					t.Errorf("alert %s returned more than once", id)
+				}
+				seen[id] = true
+			}
+
+			// Every page together must equal the unpaginated listing
+			unpaginated, err := ListAlerts(filter, &alertingv1.ListAlertsRequest{
+				OrderBy:    tt.req.OrderBy,
+				Statuses:   tt.req.Statuses,
+				Severities: tt.req.Severities,
+				ServiceId:  tt.req.ServiceId,
+				CelFilter:  tt.req.CelFilter,
+			}, alerts)
+			if err != nil {
+				t.Fatalf("ListAlerts() error = %v", err)
+			}
+			if want := alertIDs(unpaginated.Alerts); !reflect.DeepEqual(ids, want) {
+				t.Errorf("paginated = %v, want %v", ids, want)
+			}
+			if unpaginated.TotalCount != int32(tt.want) {
+				t.Errorf("TotalCount = %d, want %d", unpaginated.TotalCount, tt.want)
+			}
+		})
+	}
+}
+
+func TestListAlerts_Ordering(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+	alerts := listTestAlerts(6)
+
+	tests := []struct {
+		orderBy string
+		want    []string
+	}{
+		{"", []string{"alert-1", "alert-2", "alert-3", "alert-4", "alert-5", "alert-6"}},
+		{"created_at", []string{"alert-1", "alert-2", "alert-3", "alert-4", "alert-5", "alert-6"}},
+		{"created_at asc", []string{"alert-1", "alert-2", "alert-3", "alert-4", "alert-5", "alert-6"}},
+		{"created_at desc", []string{"alert-6", "alert-5", "alert-4", "alert-3", "alert-2", "alert-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			resp, err := ListAlerts(filter, &alertingv1.ListAlertsRequest{OrderBy: tt.orderBy}, alerts)
+			if err != nil {
+				t.Fatalf("ListAlerts() error = %v", err)
+			}
+			if ids := alertIDs(resp.Alerts); !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("ListAlerts() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestListAlerts_StablePagesWhileAlertsAreAdded(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+	alerts := listTestAlerts(10)
+
+	first, err := ListAlerts(filter, &alertingv1.ListAlertsRequest{PageSize: 4}, alerts)
+	if err != nil {
+		t.Fatalf("ListAlerts() error = %v", err)
+	}
+
+	alerts = append(alerts, &alertingv1.Alert{
+		Id:        "alert-new",
+		CreatedAt: timestamppb.New(time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)),
+	})
+	rest := listAllPages(t, filter, &alertingv1.ListAlertsRequest{PageSize: 4, PageToken: first.NextPageToken}, alerts)
+
+	// alert-10 shares the creation time of alert-9 and sorts first by ID
+	want := []string{"alert-5", "alert-6", "alert-7", "alert-8", "alert-10", "alert-9", "alert-new"}
+	if !reflect.DeepEqual(rest, want) {
+		t.Errorf("remaining pages = %v, want %v", rest, want)
+	}
+}
+
+func TestListAlerts_ContinuesAfterLastAlertIsDeleted(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+	alerts := listTestAlerts(10)
+
+	first, err := ListAlerts(filter, &alertingv1.ListAlertsRequest{PageSize: 4}, alerts)
+	if err != nil {
+		t.Fatalf("ListAlerts() error = %v", err)
+	}
+
+	// Delete the last alert of the first page before fetching the next one
+	last := first.Alerts[len(first.Alerts)-1].Id
+	remaining := make([]*alertingv1.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if alert.Id != last {
+			remaining = append(remaining, alert)
+		}
+	}
+	rest := listAllPages(t, filter, &alertingv1.ListAlertsRequest{PageSize: 4, PageToken: first.NextPageToken}, remaining)
+
+	want := []string{"alert-5", "alert-6", "alert-7", "alert-8", "alert-10", "alert-9"}
+	if !reflect.DeepEqual(rest, want) {
+		t.Errorf("remaining pages = %v, want %v", rest, want)
+	}
+}
+
+func TestListAlerts_InvalidRequest(t *testing.T) {
+	filter, err := cel.NewAlertFilter(0)
+	if err != nil {
+		t.Fatalf("NewAlertFilter() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *alertingv1.ListAlertsRequest
+	}{
+		{"malformed page token", &alertingv1.ListAlertsRequest{PageToken: "missing"}},
+		{"unsupported order_by", &alertingv1.ListAlertsRequest{OrderBy: "severity asc"}},
+		{"invalid cel filter", &alertingv1.ListAlertsRequest{CelFilter: `labels['env'] = 'production'`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ListAlerts(filter, tt.req, listTestAlerts(3))
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("ListAlerts() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
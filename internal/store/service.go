@@ -3,14 +3,96 @@ package store
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrServiceNotFound is returned when a service does not exist.
+var ErrServiceNotFound = errors.New("service not found")
+
+// Fingerprint strategy names for Service.FingerprintStrategy.
+const (
+	FingerprintStrategySHA256      = "sha256"
+	FingerprintStrategyMD5         = "md5"
+	FingerprintStrategyCustomLabel = "custom_label"
+)
+
+// LabelType is the value type of a label key in a service's LabelSchema.
+type LabelType string
+
+// Label types for LabelSpec.Type.
+const (
+	// LabelTypeString accepts any value.
+	LabelTypeString LabelType = "STRING"
+	// LabelTypeNumber accepts integer and decimal values, e.g. "42" or "0.5".
+	LabelTypeNumber LabelType = "NUMBER"
+	// LabelTypeBoolean accepts "true" and "false", as parsed by strconv.ParseBool.
+	LabelTypeBoolean LabelType = "BOOLEAN"
+	// LabelTypeEnum accepts only the spec's AllowedValues.
+	LabelTypeEnum LabelType = "ENUM"
+)
+
+// LabelSpec describes the values allowed for a label key.
+type LabelSpec struct {
+	Type LabelType
+	// Required rejects alerts that do not carry the label.
+	Required bool
+	// AllowedValues restricts the label to these values. Required for ENUM labels
+	// and optional for the other types.
+	AllowedValues []string
+}
+
+// DeletedServiceID is the sentinel service that active alerts are moved to
+// when their service is force-deleted.
+const DeletedServiceID = "deleted-service"
+
 // Service represents a service/integration that can send alerts.
 type Service struct {
 	ID             string
 	Name           string
 	IntegrationKey string
 	Description    string
+	// TenantID is the tenant owning the service. Its alerts are ingested and
+	// routed in this tenant; empty selects the default tenant.
+	TenantID string
+
+	// FingerprintStrategy selects how alert fingerprints are derived for this service.
+	// Empty uses the source's default behaviour.
+	FingerprintStrategy string
+	// FingerprintLabelKeys are the label keys hashed by the custom_label strategy.
+	FingerprintLabelKeys []string
+	// RequiredLabels are label keys that every generic webhook alert must carry.
+	RequiredLabels []string
+	// ForbiddenLabels are label keys that generic webhook alerts must not carry.
+	ForbiddenLabels []string
+	// WebhookSigningSecret verifies signed webhook payloads, such as Sentry's
+	// X-Sentry-Hook-Signature or PagerDuty's X-PagerDuty-Signature, and is the
+	// bearer token GCP Cloud Monitoring push requests must carry. Empty
+	// disables signature verification.
+	WebhookSigningSecret string
+	// SourceIPLabel is the alert label holding the source IP address used for
+	// geolocation enrichment. Empty disables enrichment.
+	SourceIPLabel string
+	// AlertQuotaPerHour caps the alerts the service may create per hour.
+	// Zero disables the quota.
+	AlertQuotaPerHour int32
+	// LabelSchema declares the type and allowed values of label keys that generic
+	// webhook alerts are validated against. Labels not in the schema are not checked.
+	LabelSchema map[string]LabelSpec
+	// BodyTemplate is a Go text/template rendering alert details from the parsed
+	// webhook payload. Empty or invalid templates use the source's default.
+	BodyTemplate string
+	// RateLimit limits the webhook requests accepted for the integration key.
+	RateLimit RateLimit
+}
+
+// RateLimit is a token bucket limit on the webhook requests of a service.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket refills. Zero disables
+	// rate limiting.
+	RequestsPerSecond float64
+	// Burst is the size of the bucket, the number of requests accepted at once.
+	// Zero allows one second worth of requests.
+	Burst int
 }
 
 // ServiceStore defines the interface for service/integration persistence operations.
@@ -24,4 +106,22 @@ type ServiceStore interface {
 
 	// GetByID retrieves a service by its ID.
 	GetByID(ctx context.Context, id string) (*Service, error)
+
+	// CanDeleteService reports what deleting the service would affect.
+	// Returns ErrServiceNotFound if the service does not exist.
+	CanDeleteService(ctx context.Context, serviceID string) (*DeletionImpact, error)
+
+	// Delete deletes a service. With force, the service's active alerts are
+	// moved to DeletedServiceID first.
+	Delete(ctx context.Context, serviceID string, force bool) error
+}
+
+// DeletionImpact describes the objects that reference a service.
+type DeletionImpact struct {
+	// ActiveAlertCount counts the service's triggered and acknowledged alerts.
+	ActiveAlertCount int `json:"active_alert_count"`
+	// RoutingRulesCount counts routing rules with a service condition on the service.
+	RoutingRulesCount int `json:"routing_rules_count"`
+	// EscalationPoliciesCount counts escalation policies used by the service.
+	EscalationPoliciesCount int `json:"escalation_policies_count"`
 }
@@ -0,0 +1,133 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// ValidateAvailability checks that an availability has a valid period and only
+// gives a reason when the user is unavailable.
+func ValidateAvailability(availability *routingv1.UserAvailability) error {
+	if availability == nil {
+		return fmt.Errorf("%w: availability is required", ErrInvalidAvailability)
+	}
+	if availability.PeriodStart == nil || availability.PeriodEnd == nil {
+		return fmt.Errorf("%w: period_start and period_end are required", ErrInvalidAvailability)
+	}
+	if !availability.PeriodEnd.AsTime().After(availability.PeriodStart.AsTime()) {
+		return fmt.Errorf("%w: period_end must be after period_start", ErrInvalidAvailability)
+	}
+	if availability.Available && availability.UnavailableReason != routingv1.UnavailableReason_UNAVAILABLE_REASON_UNSPECIFIED {
+		return fmt.Errorf("%w: unavailable_reason requires available to be false", ErrInvalidAvailability)
+	}
+	return nil
+}
+
+// FindUnavailability returns the first period in availabilities during which
+// the user is marked unavailable and that overlaps [from, until), or nil.
+func FindUnavailability(availabilities []*routingv1.UserAvailability, userID string, from, until time.Time) *routingv1.UserAvailability {
+	for _, availability := range availabilities {
+		if availability.UserId != userID || availability.Available {
+			continue
+		}
+		if availability.PeriodStart.AsTime().Before(until) && availability.PeriodEnd.AsTime().After(from) {
+			return availability
+		}
+	}
+	return nil
+}
+
+// SetAvailability records the user's availability for a period, replacing any
+// availability set for exactly the same period.
+func (s *PostgresStore) SetAvailability(ctx context.Context, userID string, availability *routingv1.UserAvailability) error {
+	if userID == "" {
+		return fmt.Errorf("%w: user_id is required", ErrInvalidAvailability)
+	}
+	if err := ValidateAvailability(availability); err != nil {
+		return err
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO user_availabilities (user_id, available, unavailable_reason, period_start, period_end)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, period_start, period_end)
+		DO UPDATE SET available = EXCLUDED.available, unavailable_reason = EXCLUDED.unavailable_reason, updated_at = NOW()
+	`, userID, availability.Available, reasonToString(availability.UnavailableReason),
+		availability.PeriodStart.AsTime(), availability.PeriodEnd.AsTime())
+	if err != nil {
+		return fmt.Errorf("upsert user availability: %w", err)
+	}
+
+	availability.UserId = userID
+	return nil
+}
+
+// GetTeamAvailability returns the availability periods of the team's members
+// overlapping [from, until), ordered by user and period start.
+func (s *PostgresStore) GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error) {
+	// Verify team exists
+	if _, err := s.Get(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT ua.user_id, ua.available, ua.unavailable_reason, ua.period_start, ua.period_end
+		FROM user_availabilities ua
+		INNER JOIN team_members tm ON tm.user_id = ua.user_id
+		WHERE tm.team_id = $1 AND ua.period_start < $3 AND ua.period_end > $2
+		ORDER BY ua.user_id, ua.period_start
+	`, teamID, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("query team availability: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var availabilities []*routingv1.UserAvailability
+	for rows.Next() {
+		availability := &routingv1.UserAvailability{}
+		var reason string
+		var periodStart, periodEnd time.Time
+
+		if err := rows.Scan(&availability.UserId, &availability.Available, &reason, &periodStart, &periodEnd); err != nil {
+			return nil, fmt.Errorf("scan user availability: %w", err)
+		}
+
+		availability.UnavailableReason = parseReason(reason)
+		availability.PeriodStart = timestamppb.New(periodStart)
+		availability.PeriodEnd = timestamppb.New(periodEnd)
+		availabilities = append(availabilities, availability)
+	}
+
+	return availabilities, rows.Err()
+}
+
+func reasonToString(reason routingv1.UnavailableReason) string {
+	switch reason {
+	case routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION:
+		return "vacation"
+	case routingv1.UnavailableReason_UNAVAILABLE_REASON_SICK:
+		return "sick"
+	case routingv1.UnavailableReason_UNAVAILABLE_REASON_TRAINING:
+		return "training"
+	default:
+		return ""
+	}
+}
+
+func parseReason(s string) routingv1.UnavailableReason {
+	switch s {
+	case "vacation":
+		return routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION
+	case "sick":
+		return routingv1.UnavailableReason_UNAVAILABLE_REASON_SICK
+	case "training":
+		return routingv1.UnavailableReason_UNAVAILABLE_REASON_TRAINING
+	default:
+		return routingv1.UnavailableReason_UNAVAILABLE_REASON_UNSPECIFIED
+	}
+}
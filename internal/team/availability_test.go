@@ -0,0 +1,82 @@
+package team
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func TestValidateAvailability(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		availability *routingv1.UserAvailability
+		valid        bool
+	}{
+		{"nil", nil, false},
+		{"missing period", &routingv1.UserAvailability{}, false},
+		{"empty period", &routingv1.UserAvailability{
+			PeriodStart: timestamppb.New(now),
+			PeriodEnd:   timestamppb.New(now),
+		}, false},
+		{"available with reason", &routingv1.UserAvailability{
+			Available:         true,
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_SICK,
+			PeriodStart:       timestamppb.New(now),
+			PeriodEnd:         timestamppb.New(now.Add(time.Hour)),
+		}, false},
+		{"unavailable with reason", &routingv1.UserAvailability{
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_VACATION,
+			PeriodStart:       timestamppb.New(now),
+			PeriodEnd:         timestamppb.New(now.Add(time.Hour)),
+		}, true},
+		{"available", &routingv1.UserAvailability{
+			Available:   true,
+			PeriodStart: timestamppb.New(now),
+			PeriodEnd:   timestamppb.New(now.Add(time.Hour)),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAvailability(tt.availability)
+			if tt.valid && err != nil {
+				t.Errorf("expected valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidAvailability) {
+				t.Errorf("expected ErrInvalidAvailability, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFindUnavailability(t *testing.T) {
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	availabilities := []*routingv1.UserAvailability{
+		{UserId: "user-1", Available: true, PeriodStart: timestamppb.New(start), PeriodEnd: timestamppb.New(start.Add(48 * time.Hour))},
+		{
+			UserId:            "user-1",
+			UnavailableReason: routingv1.UnavailableReason_UNAVAILABLE_REASON_SICK,
+			PeriodStart:       timestamppb.New(start.Add(72 * time.Hour)),
+			PeriodEnd:         timestamppb.New(start.Add(96 * time.Hour)),
+		},
+	}
+
+	if got := FindUnavailability(availabilities, "user-1", start, start.Add(24*time.Hour)); got != nil {
+		t.Errorf("expected user available on the first day, got %v", got)
+	}
+	if got := FindUnavailability(availabilities, "user-1", start.Add(60*time.Hour), start.Add(80*time.Hour)); got != availabilities[1] {
+		t.Errorf("expected sick period, got %v", got)
+	}
+	if got := FindUnavailability(availabilities, "user-1", start.Add(96*time.Hour), start.Add(120*time.Hour)); got != nil {
+		t.Errorf("expected period end to be exclusive, got %v", got)
+	}
+	if got := FindUnavailability(availabilities, "user-2", start, start.Add(120*time.Hour)); got != nil {
+		t.Errorf("expected no period for another user, got %v", got)
+	}
+}
@@ -0,0 +1,42 @@
+package team
+
+import (
+	"sync"
+)
+
+// Metrics tracks team provisioning metrics.
+// Exposed as the schedule_auto_created_total{team_id} counter.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// schedulesAutoCreated counts default schedules created with a team, by team ID.
+	schedulesAutoCreated map[string]int64
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		schedulesAutoCreated: make(map[string]int64),
+	}
+}
+
+// RecordScheduleAutoCreated increments the auto-created schedule counter for a team.
+func (m *Metrics) RecordScheduleAutoCreated(teamID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.schedulesAutoCreated[teamID]++
+}
+
+// ScheduleAutoCreatedTotal returns the number of schedules auto-created for a team.
+func (m *Metrics) ScheduleAutoCreatedTotal(teamID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.schedulesAutoCreated[teamID]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.schedulesAutoCreated = make(map[string]int64)
+}
@@ -0,0 +1,54 @@
+package team
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+const (
+	// DefaultScheduleTimezone is the timezone of auto-created schedules when none is given.
+	DefaultScheduleTimezone = "UTC"
+	// DefaultHandoffTime is the weekly handoff time of auto-created schedules.
+	DefaultHandoffTime = "09:00"
+)
+
+// DefaultSchedule returns a weekly on-call schedule for the team that rotates
+// through its members in order, handing off at DefaultHandoffTime. The rotation
+// starts on the day of start.
+func DefaultSchedule(t *routingv1.Team, timezone string, start time.Time) *routingv1.Schedule {
+	if timezone == "" {
+		timezone = DefaultScheduleTimezone
+	}
+
+	members := make([]*routingv1.RotationMember, 0, len(t.Members))
+	for _, member := range t.Members {
+		members = append(members, &routingv1.RotationMember{
+			UserId:   member.UserId,
+			Position: int32(len(members)),
+		})
+	}
+
+	return &routingv1.Schedule{
+		Name:        fmt.Sprintf("%s on-call", t.Name),
+		Description: fmt.Sprintf("Default weekly on-call schedule for %s", t.Name),
+		TeamId:      t.Id,
+		Timezone:    timezone,
+		Rotations: []*routingv1.Rotation{
+			{
+				Name:      "Weekly",
+				Type:      routingv1.RotationType_ROTATION_TYPE_WEEKLY,
+				Members:   members,
+				StartTime: timestamppb.New(start.Truncate(24 * time.Hour)),
+				ShiftConfig: &routingv1.ShiftConfig{
+					ShiftLength: durationpb.New(7 * 24 * time.Hour),
+					HandoffTime: DefaultHandoffTime,
+				},
+			},
+		},
+	}
+}
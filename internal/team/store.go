@@ -26,6 +26,8 @@ var (
 	ErrMemberNotFound = errors.New("team member not found")
 	// ErrMemberExists is returned when a team member already exists.
 	ErrMemberExists = errors.New("team member already exists")
+	// ErrInvalidAvailability is returned when a user availability is invalid.
+	ErrInvalidAvailability = errors.New("invalid user availability")
 )
 
 // Store defines the interface for team persistence.
@@ -44,6 +46,14 @@ type Store interface {
 
 	// Get teams by user
 	GetByUser(ctx context.Context, userID string) ([]*routingv1.Team, error)
+
+	// Member availability
+	// SetAvailability records the user's availability for a period, replacing
+	// any availability set for exactly the same period.
+	SetAvailability(ctx context.Context, userID string, availability *routingv1.UserAvailability) error
+	// GetTeamAvailability returns the availability periods of the team's members
+	// overlapping [from, until), ordered by user and period start.
+	GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error)
 }
 
 // PostgresStore implements Store using PostgreSQL.
@@ -10,8 +10,9 @@ import (
 
 // InMemoryStore is an in-memory implementation for testing.
 type InMemoryStore struct {
-	teams   map[string]*routingv1.Team
-	counter int64
+	teams          map[string]*routingv1.Team
+	availabilities []*routingv1.UserAvailability
+	counter        int64
 }
 
 // NewInMemoryStore creates a new InMemoryStore.
@@ -169,6 +170,43 @@ func (s *InMemoryStore) GetByUser(ctx context.Context, userID string) ([]*routin
 	return teams, nil
 }
 
+func (s *InMemoryStore) SetAvailability(ctx context.Context, userID string, availability *routingv1.UserAvailability) error {
+	if userID == "" {
+		return ErrInvalidAvailability
+	}
+	if err := ValidateAvailability(availability); err != nil {
+		return err
+	}
+
+	availability.UserId = userID
+	for i, existing := range s.availabilities {
+		if existing.UserId == userID && existing.PeriodStart.AsTime().Equal(availability.PeriodStart.AsTime()) &&
+			existing.PeriodEnd.AsTime().Equal(availability.PeriodEnd.AsTime()) {
+			s.availabilities[i] = availability
+			return nil
+		}
+	}
+	s.availabilities = append(s.availabilities, availability)
+	return nil
+}
+
+func (s *InMemoryStore) GetTeamAvailability(ctx context.Context, teamID string, from, until time.Time) ([]*routingv1.UserAvailability, error) {
+	t, ok := s.teams[teamID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	var availabilities []*routingv1.UserAvailability
+	for _, m := range t.Members {
+		for _, availability := range s.availabilities {
+			if availability.UserId == m.UserId && availability.PeriodStart.AsTime().Before(until) && availability.PeriodEnd.AsTime().After(from) {
+				availabilities = append(availabilities, availability)
+			}
+		}
+	}
+	return availabilities, nil
+}
+
 // Ensure InMemoryStore implements Store
 var _ Store = (*InMemoryStore)(nil)
 
@@ -0,0 +1,115 @@
+package timeline
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// auditLogPageSize is the page size used when loading an alert's routing audit logs.
+const auditLogPageSize = 100
+
+// AuditLogStore provides routing audit logs. It is satisfied by routing.Store.
+type AuditLogStore interface {
+	GetAuditLogs(ctx context.Context, req *routingv1.GetRoutingAuditLogsRequest) (*routingv1.GetRoutingAuditLogsResponse, error)
+}
+
+// Handler serves alert timelines.
+type Handler struct {
+	alertStore    store.AlertStore
+	auditLogStore AuditLogStore
+	logger        zerolog.Logger
+}
+
+// HandlerOption configures optional Handler dependencies.
+type HandlerOption func(*Handler)
+
+// WithAuditLogStore adds routing decisions and notification dispatches to timelines.
+func WithAuditLogStore(auditLogStore AuditLogStore) HandlerOption {
+	return func(h *Handler) {
+		h.auditLogStore = auditLogStore
+	}
+}
+
+// NewHandler creates a new alert timeline handler.
+func NewHandler(alertStore store.AlertStore, logger zerolog.Logger, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		alertStore: alertStore,
+		logger:     logger.With().Str("component", "alert_timeline").Logger(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// RegisterRoutes registers the timeline routes on the provided router group.
+func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/alerts/:id/timeline", h.GetTimeline)
+}
+
+// TimelineResponse is the body of GET /alerts/:id/timeline.
+type TimelineResponse struct {
+	AlertID string          `json:"alertId"`
+	Events  []TimelineEvent `json:"events"`
+}
+
+// GetTimeline handles GET /api/v1/alerts/:id/timeline
+func (h *Handler) GetTimeline(c *gin.Context) {
+	ctx := c.Request.Context()
+	alertID := c.Param("id")
+
+	alert, err := h.alertStore.GetByID(ctx, alertID)
+	if err != nil {
+		h.logger.Error().Err(err).Str("alertId", alertID).Msg("failed to get alert")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get alert"})
+		return
+	}
+	if alert == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "alert not found"})
+		return
+	}
+
+	auditLogs, err := h.auditLogs(ctx, alertID)
+	if err != nil {
+		h.logger.Error().Err(err).Str("alertId", alertID).Msg("failed to get routing audit logs")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get routing audit logs"})
+		return
+	}
+
+	c.JSON(http.StatusOK, TimelineResponse{
+		AlertID: alert.Id,
+		Events:  Build(alert, auditLogs),
+	})
+}
+
+// auditLogs loads every routing audit log recorded for an alert.
+func (h *Handler) auditLogs(ctx context.Context, alertID string) ([]*routingv1.RoutingAuditLog, error) {
+	if h.auditLogStore == nil {
+		return nil, nil
+	}
+
+	var logs []*routingv1.RoutingAuditLog
+	pageToken := ""
+	for {
+		resp, err := h.auditLogStore.GetAuditLogs(ctx, &routingv1.GetRoutingAuditLogsRequest{
+			AlertId:   alertID,
+			PageSize:  auditLogPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, resp.Logs...)
+
+		if resp.NextPageToken == "" {
+			return logs, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
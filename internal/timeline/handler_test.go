@@ -0,0 +1,175 @@
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// mockAlertStore serves alerts by ID.
+type mockAlertStore struct {
+	alerts map[string]*alertingv1.Alert
+}
+
+func (m *mockAlertStore) Create(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	m.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (m *mockAlertStore) GetByID(ctx context.Context, id string) (*alertingv1.Alert, error) {
+	return m.alerts[id], nil
+}
+
+func (m *mockAlertStore) GetByFingerprint(ctx context.Context, fingerprint string) (*alertingv1.Alert, error) {
+	return nil, nil
+}
+
+func (m *mockAlertStore) Update(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, error) {
+	m.alerts[alert.Id] = alert
+	return alert, nil
+}
+
+func (m *mockAlertStore) CreateOrUpdate(ctx context.Context, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	m.alerts[alert.Id] = alert
+	return alert, true, nil
+}
+
+func (m *mockAlertStore) List(ctx context.Context, req *alertingv1.ListAlertsRequest) (*alertingv1.ListAlertsResponse, error) {
+	return &alertingv1.ListAlertsResponse{}, nil
+}
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *mockAlertStore, *routing.InMemoryStore) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	alerts := &mockAlertStore{alerts: make(map[string]*alertingv1.Alert)}
+	auditLogs := routing.NewInMemoryStore()
+
+	router := gin.New()
+	NewHandler(alerts, zerolog.Nop(), WithAuditLogStore(auditLogs)).RegisterRoutes(router.Group("/api/v1"))
+
+	return router, alerts, auditLogs
+}
+
+func getTimeline(t *testing.T, router *gin.Engine, alertID string) (*httptest.ResponseRecorder, TimelineResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts/"+alertID+"/timeline", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var resp TimelineResponse
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	}
+	return w, resp
+}
+
+func TestHandler_GetTimeline_MergesAndSortsSources(t *testing.T) {
+	router, alerts, auditLogs := setupTestRouter(t)
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *timestamppb.Timestamp {
+		return timestamppb.New(base.Add(time.Duration(minutes) * time.Minute))
+	}
+
+	// Sources are seeded out of chronological order
+	_, err := alerts.Create(ctx, &alertingv1.Alert{
+		Id:                    "alert-1",
+		Summary:               "Disk full on db-1",
+		CreatedAt:             at(0),
+		Annotations:           map[string]string{"runbook": "https://runbooks/disk"},
+		MaintenanceWindowId:   "mw-1",
+		MaintenanceWindowName: "Storage upgrade",
+		Notes: []*alertingv1.AlertNote{
+			{Id: "note-1", Content: "Looking into it", CreatedBy: "alice", CreatedAt: at(5)},
+		},
+		Events: []*alertingv1.AlertEvent{
+			{Id: "event-1", Type: alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED, Description: "Acknowledged", ActorId: "alice", Timestamp: at(4)},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, auditLogs.CreateAuditLog(ctx, &routingv1.RoutingAuditLog{
+		AlertId:   "alert-1",
+		Timestamp: at(1),
+		Evaluations: []*routingv1.RuleEvaluation{
+			{RuleId: "rule-1", Matched: true},
+			{RuleId: "rule-2", Matched: false},
+		},
+		Executions: []*routingv1.ActionExecution{
+			{RuleId: "rule-1", ActionType: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM, Success: true, NotificationIds: []string{"notif-1"}, ExecutedAt: at(2)},
+			{RuleId: "rule-1", ActionType: routingv1.ActionType_ACTION_TYPE_SET_LABEL, Success: true, ExecutedAt: at(2)},
+		},
+	}))
+	require.NoError(t, auditLogs.CreateAuditLog(ctx, &routingv1.RoutingAuditLog{
+		AlertId:   "alert-2",
+		Timestamp: at(3),
+	}))
+
+	w, resp := getTimeline(t, router, "alert-1")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "alert-1", resp.AlertID)
+
+	var types []EventType
+	for _, event := range resp.Events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []EventType{
+		EventTypeAlertCreated,
+		EventTypeMaintenanceWindow,
+		EventTypeAnnotation,
+		EventTypeRoutingDecision,
+		EventTypeNotificationDispatched,
+		EventTypeStatusChanged,
+		EventTypeComment,
+	}, types)
+
+	for i := 1; i < len(resp.Events); i++ {
+		assert.False(t, resp.Events[i].Timestamp.Before(resp.Events[i-1].Timestamp), "events must be ordered by timestamp")
+	}
+
+	assert.Equal(t, "Matched routing rules: rule-1", resp.Events[3].Summary)
+	assert.Equal(t, "notif-1", resp.Events[4].Details["notificationIds"])
+	assert.Equal(t, "alice", resp.Events[5].Actor)
+	assert.Equal(t, "Looking into it", resp.Events[6].Summary)
+}
+
+func TestHandler_GetTimeline_LifecycleTimestamps(t *testing.T) {
+	router, alerts, _ := setupTestRouter(t)
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Webhook-resolved alerts have a resolved_at timestamp but no event
+	_, err := alerts.Create(context.Background(), &alertingv1.Alert{
+		Id:         "alert-1",
+		CreatedAt:  timestamppb.New(created),
+		ResolvedAt: timestamppb.New(created.Add(time.Hour)),
+	})
+	require.NoError(t, err)
+
+	w, resp := getTimeline(t, router, "alert-1")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, EventTypeStatusChanged, resp.Events[1].Type)
+	assert.Equal(t, alertingv1.AlertEventType_ALERT_EVENT_TYPE_RESOLVED.String(), resp.Events[1].Details["eventType"])
+}
+
+func TestHandler_GetTimeline_NotFound(t *testing.T) {
+	router, _, _ := setupTestRouter(t)
+
+	w, _ := getTimeline(t, router, "missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,249 @@
+// Package timeline assembles the chronological history of an alert for visualization.
+package timeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// EventType identifies the source of a timeline event.
+type EventType string
+
+const (
+	EventTypeAlertCreated           EventType = "alert_created"
+	EventTypeStatusChanged          EventType = "status_changed"
+	EventTypeAnnotation             EventType = "annotation"
+	EventTypeComment                EventType = "comment"
+	EventTypeRoutingDecision        EventType = "routing_decision"
+	EventTypeNotificationDispatched EventType = "notification_dispatched"
+	EventTypeMaintenanceWindow      EventType = "maintenance_window"
+)
+
+// TimelineEvent is a single entry in an alert's timeline.
+type TimelineEvent struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Type      EventType         `json:"type"`
+	Summary   string            `json:"summary"`
+	Actor     string            `json:"actor,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// Build merges the alert's own history with its routing audit logs and returns
+// the events ordered by timestamp. Events with equal timestamps keep their
+// source order, so an alert's creation always precedes its annotations.
+func Build(alert *alertingv1.Alert, auditLogs []*routingv1.RoutingAuditLog) []TimelineEvent {
+	events := alertEvents(alert)
+	for _, log := range auditLogs {
+		events = append(events, auditLogEvents(alert, log)...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	return events
+}
+
+// alertEvents returns the creation, status change, annotation, comment and
+// maintenance window events recorded on the alert itself.
+func alertEvents(alert *alertingv1.Alert) []TimelineEvent {
+	createdAt := alert.CreatedAt
+	if createdAt == nil {
+		createdAt = alert.TriggeredAt
+	}
+
+	events := []TimelineEvent{{
+		Timestamp: asTime(createdAt),
+		Type:      EventTypeAlertCreated,
+		Summary:   alert.Summary,
+		Details: map[string]string{
+			"severity": alert.Severity.String(),
+			"source":   alert.Source.String(),
+		},
+	}}
+
+	if alert.MaintenanceWindowId != "" {
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(createdAt),
+			Type:      EventTypeMaintenanceWindow,
+			Summary:   fmt.Sprintf("Maintenance window %s active", maintenanceWindowLabel(alert.MaintenanceWindowId, alert.MaintenanceWindowName)),
+			Details:   map[string]string{"maintenanceWindowId": alert.MaintenanceWindowId},
+		})
+	}
+
+	// Annotations carry no timestamp of their own and arrive with the alert
+	keys := make([]string, 0, len(alert.Annotations))
+	for key := range alert.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(createdAt),
+			Type:      EventTypeAnnotation,
+			Summary:   fmt.Sprintf("%s: %s", key, alert.Annotations[key]),
+			Details:   map[string]string{"key": key, "value": alert.Annotations[key]},
+		})
+	}
+
+	seen := make(map[alertingv1.AlertEventType]bool)
+	for _, event := range alert.Events {
+		// Creation and notes are reported from the alert and its notes
+		if event.Type == alertingv1.AlertEventType_ALERT_EVENT_TYPE_CREATED ||
+			event.Type == alertingv1.AlertEventType_ALERT_EVENT_TYPE_NOTE_ADDED {
+			continue
+		}
+		seen[event.Type] = true
+
+		details := map[string]string{"eventType": event.Type.String()}
+		for key, value := range event.Metadata {
+			details[key] = value
+		}
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(event.Timestamp),
+			Type:      EventTypeStatusChanged,
+			Summary:   event.Description,
+			Actor:     event.ActorId,
+			Details:   details,
+		})
+	}
+
+	// Webhooks set lifecycle timestamps without recording an event
+	if alert.AcknowledgedAt != nil && !seen[alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED] {
+		events = append(events, statusChange(alert.AcknowledgedAt, alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED, "Alert acknowledged", alert.AcknowledgedBy))
+	}
+	if alert.ResolvedAt != nil && !seen[alertingv1.AlertEventType_ALERT_EVENT_TYPE_RESOLVED] {
+		events = append(events, statusChange(alert.ResolvedAt, alertingv1.AlertEventType_ALERT_EVENT_TYPE_RESOLVED, "Alert resolved", alert.ResolvedBy))
+	}
+
+	for _, note := range alert.Notes {
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(note.CreatedAt),
+			Type:      EventTypeComment,
+			Summary:   note.Content,
+			Actor:     note.CreatedBy,
+			Details:   map[string]string{"noteId": note.Id},
+		})
+	}
+
+	return events
+}
+
+// auditLogEvents returns the routing decision and notification dispatch events
+// of a routing audit log.
+func auditLogEvents(alert *alertingv1.Alert, log *routingv1.RoutingAuditLog) []TimelineEvent {
+	var matched []string
+	for _, evaluation := range log.Evaluations {
+		if evaluation.Matched {
+			matched = append(matched, evaluation.RuleId)
+		}
+	}
+
+	summary := "No routing rules matched"
+	if len(matched) > 0 {
+		summary = fmt.Sprintf("Matched routing rules: %s", strings.Join(matched, ", "))
+	}
+
+	events := []TimelineEvent{{
+		Timestamp: asTime(log.Timestamp),
+		Type:      EventTypeRoutingDecision,
+		Summary:   summary,
+		Details: map[string]string{
+			"auditLogId":     log.Id,
+			"rulesEvaluated": fmt.Sprintf("%d", len(log.Evaluations)),
+		},
+	}}
+
+	// The alert already reports the window it was created in
+	if result := log.MaintenanceResult; result.GetInMaintenance() && result.GetWindow().GetId() != alert.MaintenanceWindowId {
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(log.Timestamp),
+			Type:      EventTypeMaintenanceWindow,
+			Summary:   fmt.Sprintf("Maintenance window %s active", maintenanceWindowLabel(result.Window.GetId(), result.Window.GetName())),
+			Details: map[string]string{
+				"maintenanceWindowId": result.Window.GetId(),
+				"action":              result.Action.String(),
+			},
+		})
+	}
+
+	for _, execution := range log.Executions {
+		if !isNotifyAction(execution.ActionType) {
+			continue
+		}
+
+		executedAt := execution.ExecutedAt
+		if executedAt == nil {
+			executedAt = log.Timestamp
+		}
+
+		details := map[string]string{
+			"ruleId":     execution.RuleId,
+			"actionType": execution.ActionType.String(),
+			"success":    fmt.Sprintf("%t", execution.Success),
+		}
+		if len(execution.NotificationIds) > 0 {
+			details["notificationIds"] = strings.Join(execution.NotificationIds, ",")
+		}
+		if execution.ErrorMessage != "" {
+			details["error"] = execution.ErrorMessage
+		}
+
+		summary := fmt.Sprintf("Notification dispatched via %s", execution.ActionType.String())
+		if !execution.Success {
+			summary = fmt.Sprintf("Notification via %s failed", execution.ActionType.String())
+		}
+
+		events = append(events, TimelineEvent{
+			Timestamp: asTime(executedAt),
+			Type:      EventTypeNotificationDispatched,
+			Summary:   summary,
+			Details:   details,
+		})
+	}
+
+	return events
+}
+
+func statusChange(ts *timestamppb.Timestamp, eventType alertingv1.AlertEventType, summary, actor string) TimelineEvent {
+	return TimelineEvent{
+		Timestamp: asTime(ts),
+		Type:      EventTypeStatusChanged,
+		Summary:   summary,
+		Actor:     actor,
+		Details:   map[string]string{"eventType": eventType.String()},
+	}
+}
+
+func isNotifyAction(actionType routingv1.ActionType) bool {
+	switch actionType {
+	case routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM,
+		routingv1.ActionType_ACTION_TYPE_NOTIFY_CHANNEL,
+		routingv1.ActionType_ACTION_TYPE_NOTIFY_USER,
+		routingv1.ActionType_ACTION_TYPE_NOTIFY_ONCALL,
+		routingv1.ActionType_ACTION_TYPE_NOTIFY_WEBHOOK:
+		return true
+	}
+	return false
+}
+
+func maintenanceWindowLabel(id, name string) string {
+	if name == "" {
+		return id
+	}
+	return fmt.Sprintf("%q (%s)", name, id)
+}
+
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,48 @@
+// Package tracing configures OpenTelemetry tracing.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NewProvider creates a tracer provider that batches spans of serviceName to
+// the OTLP gRPC collector at exporterEndpoint (host:port), and installs it as
+// the global provider along with the W3C trace context propagator. Transport
+// security follows the OTEL_EXPORTER_OTLP_* environment variables. Callers
+// must Shutdown the provider to flush pending spans.
+func NewProvider(serviceName, exporterEndpoint string) (*sdktrace.TracerProvider, error) {
+	if serviceName == "" {
+		return nil, errors.New("service name is required")
+	}
+	if exporterEndpoint == "" {
+		return nil, errors.New("exporter endpoint is required")
+	}
+
+	// The exporter connects lazily, so an unreachable collector does not fail startup
+	exporter, err := otlptracegrpc.New(context.Background(), otlptracegrpc.WithEndpoint(exporterEndpoint))
+	if err != nil {
+		return nil, fmt.Errorf("create otlp trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider, nil
+}
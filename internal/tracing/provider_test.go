@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewProvider(t *testing.T) {
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	provider, err := NewProvider("alerting-system", "localhost:4317")
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	if otel.GetTracerProvider() != provider {
+		t.Error("expected the provider to be installed globally")
+	}
+
+	// A remote span context is injected, as spans of the provider would be
+	// exported on shutdown
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa},
+		TraceFlags: trace.FlagsSampled,
+	})
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(trace.ContextWithRemoteSpanContext(context.Background(), sc), carrier)
+	if carrier.Get("traceparent") == "" {
+		t.Error("expected the trace context propagator to be installed")
+	}
+}
+
+func TestNewProvider_RequiresArguments(t *testing.T) {
+	if _, err := NewProvider("", "localhost:4317"); err == nil {
+		t.Error("expected an error without a service name")
+	}
+	if _, err := NewProvider("alerting-system", ""); err == nil {
+		t.Error("expected an error without an exporter endpoint")
+	}
+}
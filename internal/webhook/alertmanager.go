@@ -1,16 +1,33 @@
 package webhook
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/store"
 	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
+// Alertmanager webhook payload versions.
+const (
+	AlertmanagerPayloadV4 = "4"
+	AlertmanagerPayloadV5 = "5"
+)
+
+// AlertmanagerRunbookAnnotation is the v5 annotation copied to Alert.RunbookUrl.
+const AlertmanagerRunbookAnnotation = "runbook_url"
+
+// AlertmanagerGroupLabelPrefix prefixes the group labels added to v5 alerts.
+const AlertmanagerGroupLabelPrefix = "group_"
+
 // AlertmanagerPayload represents the webhook payload from Alertmanager.
 type AlertmanagerPayload struct {
 	Version           string                 `json:"version"`
@@ -36,6 +53,61 @@ type AlertmanagerAlert struct {
 	Fingerprint  string            `json:"fingerprint"`
 }
 
+// alertmanagerV5Payload is the v5 webhook payload, whose alerts spell
+// generatorUrl in camel case.
+type alertmanagerV5Payload struct {
+	AlertmanagerPayload
+	Alerts []alertmanagerV5Alert `json:"alerts"`
+}
+
+type alertmanagerV5Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorUrl,omitempty"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// parseAlertmanagerPayload parses a webhook payload according to its version.
+// Payloads without a known version are parsed as v4.
+func parseAlertmanagerPayload(body []byte) (*AlertmanagerPayload, error) {
+	var versioned struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &versioned); err != nil {
+		return nil, err
+	}
+
+	if versioned.Version == AlertmanagerPayloadV5 {
+		return parseAlertmanagerV5(body)
+	}
+	return parseAlertmanagerV4(body)
+}
+
+func parseAlertmanagerV4(body []byte) (*AlertmanagerPayload, error) {
+	var payload AlertmanagerPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
+
+func parseAlertmanagerV5(body []byte) (*AlertmanagerPayload, error) {
+	var v5 alertmanagerV5Payload
+	if err := json.Unmarshal(body, &v5); err != nil {
+		return nil, err
+	}
+
+	payload := v5.AlertmanagerPayload
+	payload.Alerts = make([]AlertmanagerAlert, len(v5.Alerts))
+	for i, a := range v5.Alerts {
+		payload.Alerts[i] = AlertmanagerAlert(a)
+	}
+	return &payload, nil
+}
+
 // AlertmanagerWebhook handles POST /api/v1/webhook/alertmanager/:integration_key
 func (h *Handler) AlertmanagerWebhook(c *gin.Context) {
 	// Validate integration key
@@ -44,9 +116,19 @@ func (h *Handler) AlertmanagerWebhook(c *gin.Context) {
 		return
 	}
 
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "failed to read request body",
+		})
+		return
+	}
+	c.Set(gin.BodyBytesKey, body)
+
 	// Parse payload
-	var payload AlertmanagerPayload
-	if err := c.ShouldBindJSON(&payload); err != nil {
+	payload, err := parseAlertmanagerPayload(body)
+	if err != nil {
 		h.logger.Error().Err(err).Msg("failed to parse alertmanager payload")
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "badRequest",
@@ -71,11 +153,19 @@ func (h *Handler) AlertmanagerWebhook(c *gin.Context) {
 		Msg("processing alertmanager webhook")
 
 	var alertIds []string
+	var alerts []*alertingv1.Alert
 	var created, updated int
 
 	// Process each alert
 	for _, amAlert := range payload.Alerts {
-		alert, wasCreated, err := h.processAlertmanagerAlert(c, service.ID, &amAlert, &payload)
+		alert, wasCreated, err := h.processAlertmanagerAlert(c, service, &amAlert, payload)
+		if errors.Is(err, ErrAlertQuotaExceeded) {
+			// Alertmanager retries the whole group, so alerts already processed
+			// are deduplicated on the next attempt.
+			h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+			respondQuotaExceeded(c)
+			return
+		}
 		if err != nil {
 			h.logger.Error().
 				Err(err).
@@ -84,6 +174,7 @@ func (h *Handler) AlertmanagerWebhook(c *gin.Context) {
 			continue
 		}
 		alertIds = append(alertIds, alert.Id)
+		alerts = append(alerts, alert)
 		if wasCreated {
 			created++
 		} else {
@@ -92,14 +183,15 @@ func (h *Handler) AlertmanagerWebhook(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, WebhookResponse{
-		Message:  "alerts processed successfully",
-		AlertIds: alertIds,
-		Created:  created,
-		Updated:  updated,
+		Message:          "alerts processed successfully",
+		AlertIds:         alertIds,
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alerts...),
 	})
 }
 
-func (h *Handler) processAlertmanagerAlert(c *gin.Context, serviceID string, amAlert *AlertmanagerAlert, payload *AlertmanagerPayload) (*alertingv1.Alert, bool, error) {
+func (h *Handler) processAlertmanagerAlert(c *gin.Context, service *store.Service, amAlert *AlertmanagerAlert, payload *AlertmanagerPayload) (*alertingv1.Alert, bool, error) {
 	// Map Alertmanager status to internal status
 	status := mapAlertmanagerStatus(amAlert.Status)
 
@@ -109,12 +201,15 @@ func (h *Handler) processAlertmanagerAlert(c *gin.Context, serviceID string, amA
 	// Build summary from alertname and annotations
 	summary := buildAlertmanagerSummary(amAlert)
 
-	// Build details from annotations
-	details := ""
-	if desc, ok := amAlert.Annotations["description"]; ok {
-		details = desc
+	// Use the Alertmanager fingerprint unless the service configures its own strategy
+	fingerprint := amAlert.Fingerprint
+	if fingerprint == "" || service.FingerprintStrategy != "" {
+		fingerprint = h.fingerprintStrategy(service).Compute(amAlert.Labels, "alertmanager")
 	}
 
+	// Build details from the service's body template, the description annotation by default
+	details := h.renderBody(service, bodySourceAlertmanager, &AlertmanagerBodyData{AlertmanagerAlert: amAlert, Group: payload})
+
 	// Create raw payload for storage
 	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
 		"version":     payload.Version,
@@ -128,18 +223,35 @@ func (h *Handler) processAlertmanagerAlert(c *gin.Context, serviceID string, amA
 		},
 	})
 
+	labels := amAlert.Labels
+	var runbookURL string
+	if payload.Version == AlertmanagerPayloadV5 {
+		// Group labels are added after fingerprinting so they do not change it
+		labels = make(map[string]string, len(amAlert.Labels)+len(payload.GroupLabels))
+		for k, v := range amAlert.Labels {
+			labels[k] = v
+		}
+		for k, v := range payload.GroupLabels {
+			labels[AlertmanagerGroupLabelPrefix+k] = v
+		}
+		runbookURL = amAlert.Annotations[AlertmanagerRunbookAnnotation]
+	}
+
 	alert := &alertingv1.Alert{
-		Fingerprint:  amAlert.Fingerprint,
+		Fingerprint:  fingerprint,
 		Summary:      summary,
 		Details:      details,
 		Severity:     severity,
 		Source:       alertingv1.AlertSource_ALERT_SOURCE_ALERTMANAGER,
-		ServiceId:    serviceID,
-		Labels:       amAlert.Labels,
+		ServiceId:    service.ID,
+		Labels:       labels,
 		Annotations:  amAlert.Annotations,
+		RunbookUrl:   runbookURL,
 		Status:       status,
 		TriggeredAt:  timestamppb.New(amAlert.StartsAt),
 		RawPayload:   rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_ALERTMANAGER),
 	}
 
 	// Set resolved_at if the alert is resolved
@@ -147,7 +259,7 @@ func (h *Handler) processAlertmanagerAlert(c *gin.Context, serviceID string, amA
 		alert.ResolvedAt = timestamppb.New(amAlert.EndsAt)
 	}
 
-	return h.alertStore.CreateOrUpdate(c.Request.Context(), alert)
+	return h.ingestAlert(c.Request.Context(), service, alert)
 }
 
 func mapAlertmanagerStatus(status string) alertingv1.AlertStatus {
@@ -161,8 +273,14 @@ func mapAlertmanagerStatus(status string) alertingv1.AlertStatus {
 	}
 }
 
+// extractSeverity maps the severity label to a severity. Without one, the
+// VictorOps message type (CRITICAL, WARNING or INFO) is used as a hint.
 func extractSeverity(labels map[string]string) alertingv1.Severity {
 	severityStr, ok := labels["severity"]
+	if !ok {
+		severityStr, ok = labels[VictorOpsMessageTypeLabel]
+		severityStr = strings.ToLower(severityStr)
+	}
 	if !ok {
 		return alertingv1.Severity_SEVERITY_MEDIUM
 	}
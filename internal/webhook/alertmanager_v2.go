@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AlertmanagerCompatVersion is the Alertmanager version reported by the
+// compatibility status endpoint, matching the v2 API it emulates.
+const AlertmanagerCompatVersion = "0.27.0"
+
+// AlertmanagerV2Alert is an alert posted to the Alertmanager v2 API
+// (POST /api/v2/alerts), where the status is derived from EndsAt.
+type AlertmanagerV2Alert struct {
+	Labels       map[string]string `json:"labels" binding:"required"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+}
+
+// toAlertmanagerAlert converts the alert to the webhook representation. As in
+// Alertmanager, a missing StartsAt defaults to now and an EndsAt in the past
+// resolves the alert.
+func (a *AlertmanagerV2Alert) toAlertmanagerAlert(now time.Time) AlertmanagerAlert {
+	alert := AlertmanagerAlert{
+		Status:       "firing",
+		Labels:       a.Labels,
+		Annotations:  a.Annotations,
+		StartsAt:     a.StartsAt,
+		EndsAt:       a.EndsAt,
+		GeneratorURL: a.GeneratorURL,
+	}
+	if alert.Annotations == nil {
+		alert.Annotations = map[string]string{}
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = now
+	}
+	if !a.EndsAt.IsZero() && !a.EndsAt.After(now) {
+		alert.Status = "resolved"
+	}
+	return alert
+}
+
+// AlertmanagerStatusResponse is the minimal Alertmanager v2 status returned by
+// GET /api/alertmanager/v2/status.
+type AlertmanagerStatusResponse struct {
+	Cluster     AlertmanagerClusterStatus `json:"cluster"`
+	VersionInfo map[string]string         `json:"versionInfo"`
+	Config      AlertmanagerConfigStatus  `json:"config"`
+	Uptime      time.Time                 `json:"uptime"`
+}
+
+// AlertmanagerClusterStatus reports clustering as disabled.
+type AlertmanagerClusterStatus struct {
+	Status string   `json:"status"`
+	Peers  []string `json:"peers"`
+}
+
+// AlertmanagerConfigStatus holds the original Alertmanager configuration, which is always empty.
+type AlertmanagerConfigStatus struct {
+	Original string `json:"original"`
+}
+
+// RegisterAlertmanagerRoutes registers the Alertmanager v2 compatible routes on
+// the provided router group, which is expected to be mounted at /api. Alerts are
+// ingested for the service of the default integration key, so the routes are
+// only registered when WithDefaultIntegrationKey is set.
+func (h *Handler) RegisterAlertmanagerRoutes(router *gin.RouterGroup) {
+	if h.defaultIntegrationKey == "" {
+		return
+	}
+
+	alertmanager := router.Group("/alertmanager/v2")
+	alertmanager.Use(h.traceRequests())
+	alertmanager.POST("/alerts", h.instrument("alertmanager_v2"), h.rateLimit(), h.PostAlertmanagerV2Alerts)
+	alertmanager.GET("/status", h.GetAlertmanagerV2Status)
+}
+
+// PostAlertmanagerV2Alerts handles POST /api/alertmanager/v2/alerts
+func (h *Handler) PostAlertmanagerV2Alerts(c *gin.Context) {
+	service := h.serviceForIntegrationKey(c, h.defaultIntegrationKey)
+	if service == nil {
+		return
+	}
+
+	var alerts []AlertmanagerV2Alert
+	if err := c.ShouldBindJSON(&alerts); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse alertmanager v2 alerts")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid alertmanager v2 alerts: " + err.Error(),
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Int("alertCount", len(alerts)).
+		Msg("processing alertmanager v2 alerts")
+
+	now := time.Now()
+	payload := &AlertmanagerPayload{}
+	for _, v2Alert := range alerts {
+		if len(v2Alert.Labels) == 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "badRequest",
+				Message: "alert labels are required",
+			})
+			return
+		}
+
+		amAlert := v2Alert.toAlertmanagerAlert(now)
+		_, _, err := h.processAlertmanagerAlert(c, service, &amAlert, payload)
+		if errors.Is(err, ErrAlertQuotaExceeded) {
+			h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+			respondQuotaExceeded(c)
+			return
+		}
+		if err != nil {
+			h.logger.Error().
+				Err(err).
+				Str("alertname", amAlert.Labels["alertname"]).
+				Msg("failed to process alertmanager v2 alert")
+		}
+	}
+
+	// Alertmanager responds to accepted alerts with an empty body
+	c.Status(http.StatusOK)
+}
+
+// GetAlertmanagerV2Status handles GET /api/alertmanager/v2/status
+func (h *Handler) GetAlertmanagerV2Status(c *gin.Context) {
+	c.JSON(http.StatusOK, AlertmanagerStatusResponse{
+		Cluster: AlertmanagerClusterStatus{Status: "disabled", Peers: []string{}},
+		VersionInfo: map[string]string{
+			"version":   AlertmanagerCompatVersion,
+			"goVersion": runtime.Version(),
+		},
+		Uptime: h.startedAt,
+	})
+}
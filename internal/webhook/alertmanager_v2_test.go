@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func setupAlertmanagerV2Handler(defaultKey string) (*gin.Engine, *mockAlertStore) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithDefaultIntegrationKey(defaultKey))
+
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	handler.RegisterAlertmanagerRoutes(router.Group("/api"))
+	return router, alertStore
+}
+
+func postJSON(router *gin.Engine, path string, payload any) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestAlertmanagerV2Alerts_ParsedLikeWebhook verifies that alerts posted to the
+// v2 API are stored exactly as the same alerts posted to the webhook.
+func TestAlertmanagerV2Alerts_ParsedLikeWebhook(t *testing.T) {
+	startsAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)
+
+	v2Alerts := []AlertmanagerV2Alert{
+		{
+			Labels:       map[string]string{"alertname": "HighCPU", "severity": "critical", "instance": "web-1"},
+			Annotations:  map[string]string{"summary": "CPU is high", "description": "CPU above 90%"},
+			StartsAt:     startsAt,
+			GeneratorURL: "http://prometheus/graph",
+		},
+		{
+			Labels:      map[string]string{"alertname": "DiskFull", "severity": "warning"},
+			Annotations: map[string]string{"description": "Disk usage above 95%"},
+			StartsAt:    startsAt,
+			EndsAt:      endsAt,
+		},
+	}
+	webhookPayload := AlertmanagerPayload{
+		Version: "4",
+		Status:  "firing",
+		Alerts: []AlertmanagerAlert{
+			{
+				Status:       "firing",
+				Labels:       v2Alerts[0].Labels,
+				Annotations:  v2Alerts[0].Annotations,
+				StartsAt:     startsAt,
+				GeneratorURL: "http://prometheus/graph",
+			},
+			{
+				Status:      "resolved",
+				Labels:      v2Alerts[1].Labels,
+				Annotations: v2Alerts[1].Annotations,
+				StartsAt:    startsAt,
+				EndsAt:      endsAt,
+			},
+		},
+	}
+
+	v2Router, v2Store := setupAlertmanagerV2Handler("valid-key")
+	if w := postJSON(v2Router, "/api/alertmanager/v2/alerts", v2Alerts); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 from v2 API, got %d: %s", w.Code, w.Body.String())
+	}
+
+	webhookRouter, webhookStore := setupAlertmanagerV2Handler("valid-key")
+	if w := postJSON(webhookRouter, "/api/v1/webhook/alertmanager/valid-key", webhookPayload); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 from webhook, got %d: %s", w.Code, w.Body.String())
+	}
+
+	if len(v2Store.alertsByFP) != 2 || len(webhookStore.alertsByFP) != 2 {
+		t.Fatalf("expected 2 alerts in each store, got %d and %d", len(v2Store.alertsByFP), len(webhookStore.alertsByFP))
+	}
+	for fingerprint, want := range webhookStore.alertsByFP {
+		got, ok := v2Store.alertsByFP[fingerprint]
+		if !ok {
+			t.Errorf("alert with fingerprint %s not ingested by v2 API", fingerprint)
+			continue
+		}
+		if got.Summary != want.Summary || got.Details != want.Details {
+			t.Errorf("summary/details = %q/%q, want %q/%q", got.Summary, got.Details, want.Summary, want.Details)
+		}
+		if got.Severity != want.Severity || got.Status != want.Status || got.Source != want.Source {
+			t.Errorf("severity/status/source = %v/%v/%v, want %v/%v/%v",
+				got.Severity, got.Status, got.Source, want.Severity, want.Status, want.Source)
+		}
+		if got.ServiceId != want.ServiceId {
+			t.Errorf("service ID = %q, want %q", got.ServiceId, want.ServiceId)
+		}
+		if !got.TriggeredAt.AsTime().Equal(want.TriggeredAt.AsTime()) {
+			t.Errorf("triggered at = %v, want %v", got.TriggeredAt.AsTime(), want.TriggeredAt.AsTime())
+		}
+		if got.GetResolvedAt().AsTime() != want.GetResolvedAt().AsTime() {
+			t.Errorf("resolved at = %v, want %v", got.GetResolvedAt().AsTime(), want.GetResolvedAt().AsTime())
+		}
+		if len(got.Labels) != len(want.Labels) || len(got.Annotations) != len(want.Annotations) {
+			t.Errorf("labels/annotations = %v/%v, want %v/%v", got.Labels, got.Annotations, want.Labels, want.Annotations)
+		}
+	}
+
+	resolved := v2Store.alertsByFP[fingerprintOf(v2Store, "DiskFull")]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected alert with past endsAt to be resolved, got %v", resolved.Status)
+	}
+}
+
+// fingerprintOf returns the fingerprint of the stored alert with the given alertname.
+func fingerprintOf(s *mockAlertStore, alertname string) string {
+	for fingerprint, alert := range s.alertsByFP {
+		if alert.Labels["alertname"] == alertname {
+			return fingerprint
+		}
+	}
+	return ""
+}
+
+func TestAlertmanagerV2Alerts_InvalidPayload(t *testing.T) {
+	router, alertStore := setupAlertmanagerV2Handler("valid-key")
+
+	tests := []struct {
+		name    string
+		payload any
+	}{
+		{"not an array", map[string]string{"alertname": "Test"}},
+		{"missing labels", []map[string]any{{"annotations": map[string]string{"summary": "x"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := postJSON(router, "/api/alertmanager/v2/alerts", tt.payload); w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+	if len(alertStore.alerts) != 0 {
+		t.Errorf("expected no alerts stored, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestAlertmanagerV2Alerts_UnknownDefaultKey(t *testing.T) {
+	router, _ := setupAlertmanagerV2Handler("unknown-key")
+
+	w := postJSON(router, "/api/alertmanager/v2/alerts", []AlertmanagerV2Alert{{Labels: map[string]string{"alertname": "Test"}}})
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAlertmanagerV2Routes_RequireDefaultKey(t *testing.T) {
+	router, _ := setupAlertmanagerV2Handler("")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/alertmanager/v2/status", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404 without a default integration key, got %d", w.Code)
+	}
+}
+
+func TestAlertmanagerV2Status(t *testing.T) {
+	router, _ := setupAlertmanagerV2Handler("valid-key")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/alertmanager/v2/status", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp AlertmanagerStatusResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.Cluster.Status != "disabled" {
+		t.Errorf("expected cluster status disabled, got %q", resp.Cluster.Status)
+	}
+	if resp.VersionInfo["version"] != AlertmanagerCompatVersion {
+		t.Errorf("expected version %s, got %q", AlertmanagerCompatVersion, resp.VersionInfo["version"])
+	}
+	if resp.Uptime.IsZero() {
+		t.Error("expected uptime to be set")
+	}
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+const alertmanagerV4Sample = `{
+  "version": "4",
+  "groupKey": "{}:{alertname=\"HighLatency\"}",
+  "truncatedAlerts": 0,
+  "status": "firing",
+  "receiver": "oncall",
+  "groupLabels": {"alertname": "HighLatency"},
+  "commonLabels": {"alertname": "HighLatency", "severity": "critical"},
+  "commonAnnotations": {},
+  "externalURL": "http://alertmanager:9093",
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {"alertname": "HighLatency", "severity": "critical", "instance": "api-1:8080"},
+      "annotations": {"summary": "p99 latency above 2s", "runbook_url": "https://runbooks.example.com/high-latency"},
+      "startsAt": "2026-03-01T12:00:00Z",
+      "endsAt": "0001-01-01T00:00:00Z",
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=latency",
+      "fingerprint": "a1b2c3d4e5f60718"
+    }
+  ]
+}`
+
+const alertmanagerV5Sample = `{
+  "version": "5",
+  "groupKey": "{}:{alertname=\"HighLatency\", cluster=\"eu-1\"}",
+  "truncatedAlerts": 0,
+  "status": "firing",
+  "receiver": "oncall",
+  "groupLabels": {"alertname": "HighLatency", "cluster": "eu-1"},
+  "commonLabels": {"alertname": "HighLatency", "severity": "critical"},
+  "commonAnnotations": {},
+  "externalURL": "http://alertmanager:9093",
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {"alertname": "HighLatency", "severity": "critical", "instance": "api-1:8080"},
+      "annotations": {"summary": "p99 latency above 2s", "runbook_url": "https://runbooks.example.com/high-latency"},
+      "startsAt": "2026-03-01T12:00:00Z",
+      "endsAt": "0001-01-01T00:00:00Z",
+      "generatorUrl": "http://prometheus:9090/graph?g0.expr=latency",
+      "fingerprint": "a1b2c3d4e5f60718"
+    }
+  ]
+}`
+
+func postAlertmanagerSample(router *gin.Engine, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/alertmanager/valid-key", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestParseAlertmanagerPayload_Versions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		body    string
+		version string
+	}{
+		{"v4", alertmanagerV4Sample, AlertmanagerPayloadV4},
+		{"v5", alertmanagerV5Sample, AlertmanagerPayloadV5},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := parseAlertmanagerPayload([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("failed to parse payload: %v", err)
+			}
+			if payload.Version != tt.version {
+				t.Errorf("expected version %s, got %q", tt.version, payload.Version)
+			}
+			if len(payload.Alerts) != 1 {
+				t.Fatalf("expected 1 alert, got %d", len(payload.Alerts))
+			}
+			if payload.Alerts[0].GeneratorURL != "http://prometheus:9090/graph?g0.expr=latency" {
+				t.Errorf("expected generator URL, got %q", payload.Alerts[0].GeneratorURL)
+			}
+			if payload.GroupLabels["alertname"] != "HighLatency" {
+				t.Errorf("expected group labels, got %v", payload.GroupLabels)
+			}
+		})
+	}
+
+	if _, err := parseAlertmanagerPayload([]byte(`{"version":`)); err == nil {
+		t.Error("expected an error for malformed json")
+	}
+}
+
+func TestAlertmanagerWebhook_V4(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postAlertmanagerSample(router, alertmanagerV4Sample)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP["a1b2c3d4e5f60718"]
+	if alert == nil {
+		t.Fatal("expected alert to be stored")
+	}
+	if alert.RunbookUrl != "" {
+		t.Errorf("expected no runbook URL for v4 payloads, got %q", alert.RunbookUrl)
+	}
+	if _, ok := alert.Labels["group_alertname"]; ok {
+		t.Errorf("expected no group labels for v4 payloads, got %v", alert.Labels)
+	}
+}
+
+func TestAlertmanagerWebhook_V5(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postAlertmanagerSample(router, alertmanagerV5Sample)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP["a1b2c3d4e5f60718"]
+	if alert == nil {
+		t.Fatal("expected alert to be stored")
+	}
+	if alert.RunbookUrl != "https://runbooks.example.com/high-latency" {
+		t.Errorf("expected runbook URL from annotations, got %q", alert.RunbookUrl)
+	}
+	if alert.Labels["group_alertname"] != "HighLatency" || alert.Labels["group_cluster"] != "eu-1" {
+		t.Errorf("expected prefixed group labels, got %v", alert.Labels)
+	}
+	if alert.Labels["instance"] != "api-1:8080" {
+		t.Errorf("expected alert labels to be kept, got %v", alert.Labels)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_CRITICAL {
+		t.Errorf("expected severity CRITICAL, got %v", alert.Severity)
+	}
+	if alert.RawPayload.GetFields()["alert"].GetStructValue().GetFields()["generatorURL"].GetStringValue() != "http://prometheus:9090/graph?g0.expr=latency" {
+		t.Errorf("expected the v5 generatorUrl in the raw payload")
+	}
+}
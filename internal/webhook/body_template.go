@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+// Default body templates reproduce the alert details each source produced
+// before services could configure a BodyTemplate.
+const (
+	DefaultAlertmanagerBodyTemplate  = `{{ index .Annotations "description" }}`
+	DefaultGrafanaBodyTemplate       = `{{ .Message }}`
+	DefaultGenericBodyTemplate       = `{{ .Details }}`
+	DefaultSentryBodyTemplate        = `{{ .Data.Issue.Culprit }}`
+	DefaultGCPMonitoringBodyTemplate = `{{ .Summary }}`
+	DefaultNewRelicBodyTemplate      = `{{ range $i, $entity := .ImpactedEntities }}{{ if $i }}, {{ end }}{{ $entity }}{{ end }}`
+	DefaultOpsgenieBodyTemplate      = `{{ .Alert.Description }}`
+	DefaultZabbixBodyTemplate        = `{{ .Event.Opdata }}`
+	DefaultVictorOpsBodyTemplate     = `{{ .StateMessage }}`
+)
+
+// Webhook sources with a default body template.
+const (
+	bodySourceAlertmanager  = "alertmanager"
+	bodySourceGrafana       = "grafana"
+	bodySourceGeneric       = "generic"
+	bodySourceSentry        = "sentry"
+	bodySourceGCPMonitoring = "gcp-monitoring"
+	bodySourceNewRelic      = "newrelic"
+	bodySourceOpsgenie      = "opsgenie"
+	bodySourceZabbix        = "zabbix"
+	bodySourceVictorOps     = "victorops"
+)
+
+var defaultBodyTemplates = map[string]*template.Template{
+	bodySourceAlertmanager:  mustParseBodyTemplate(bodySourceAlertmanager, DefaultAlertmanagerBodyTemplate),
+	bodySourceGrafana:       mustParseBodyTemplate(bodySourceGrafana, DefaultGrafanaBodyTemplate),
+	bodySourceGeneric:       mustParseBodyTemplate(bodySourceGeneric, DefaultGenericBodyTemplate),
+	bodySourceSentry:        mustParseBodyTemplate(bodySourceSentry, DefaultSentryBodyTemplate),
+	bodySourceGCPMonitoring: mustParseBodyTemplate(bodySourceGCPMonitoring, DefaultGCPMonitoringBodyTemplate),
+	bodySourceNewRelic:      mustParseBodyTemplate(bodySourceNewRelic, DefaultNewRelicBodyTemplate),
+	bodySourceOpsgenie:      mustParseBodyTemplate(bodySourceOpsgenie, DefaultOpsgenieBodyTemplate),
+	bodySourceZabbix:        mustParseBodyTemplate(bodySourceZabbix, DefaultZabbixBodyTemplate),
+	bodySourceVictorOps:     mustParseBodyTemplate(bodySourceVictorOps, DefaultVictorOpsBodyTemplate),
+}
+
+// AlertmanagerBodyData is the data a body template is rendered with for each
+// alert of an Alertmanager webhook: the alert's fields plus the group it was sent in.
+type AlertmanagerBodyData struct {
+	*AlertmanagerAlert
+	Group *AlertmanagerPayload
+}
+
+func parseBodyTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=zero").Parse(text)
+}
+
+func mustParseBodyTemplate(name, text string) *template.Template {
+	return template.Must(parseBodyTemplate(name, text))
+}
+
+// compiledBodyTemplate is a service's parsed BodyTemplate.
+type compiledBodyTemplate struct {
+	text string
+	tmpl *template.Template
+}
+
+// bodyTemplateCache holds the parsed BodyTemplate of each service. A template
+// is parsed again whenever the service's BodyTemplate changes, so updates to a
+// service take effect on its next webhook.
+type bodyTemplateCache struct {
+	mu        sync.Mutex
+	templates map[string]*compiledBodyTemplate
+	logger    zerolog.Logger
+}
+
+func newBodyTemplateCache(logger zerolog.Logger) *bodyTemplateCache {
+	return &bodyTemplateCache{
+		templates: make(map[string]*compiledBodyTemplate),
+		logger:    logger,
+	}
+}
+
+// get returns the parsed BodyTemplate of a service, or nil if it is invalid.
+func (c *bodyTemplateCache) get(service *store.Service) *template.Template {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if compiled, ok := c.templates[service.ID]; ok && compiled.text == service.BodyTemplate {
+		return compiled.tmpl
+	}
+
+	tmpl, err := parseBodyTemplate(service.ID, service.BodyTemplate)
+	if err != nil {
+		c.logger.Warn().
+			Err(err).
+			Str("serviceId", service.ID).
+			Msg("invalid body template, using the source default")
+		tmpl = nil
+	}
+	c.templates[service.ID] = &compiledBodyTemplate{text: service.BodyTemplate, tmpl: tmpl}
+	return tmpl
+}
+
+// renderBody renders the alert details for a webhook payload with the service's
+// BodyTemplate. Services without a valid template use the source's default.
+func (h *Handler) renderBody(service *store.Service, source string, data any) string {
+	if service.BodyTemplate != "" {
+		if tmpl := h.bodyTemplates.get(service); tmpl != nil {
+			var body strings.Builder
+			err := tmpl.Execute(&body, data)
+			if err == nil {
+				return body.String()
+			}
+			h.logger.Warn().
+				Err(err).
+				Str("serviceId", service.ID).
+				Msg("failed to render body template, using the source default")
+		}
+	}
+
+	var body strings.Builder
+	if err := defaultBodyTemplates[source].Execute(&body, data); err != nil {
+		h.logger.Error().Err(err).Str("source", source).Msg("failed to render default body template")
+	}
+	return body.String()
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// postWebhook posts a payload and fails the test unless it is accepted.
+func postWebhook(t *testing.T, router *gin.Engine, path string, payload any) {
+	t.Helper()
+	if w := postJSON(router, path, payload); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func storedAlert(t *testing.T, alertStore *mockAlertStore, fingerprint string) *alertingv1.Alert {
+	t.Helper()
+	for _, alert := range alertStore.alerts {
+		if alert.Fingerprint == fingerprint {
+			return alert
+		}
+	}
+	t.Fatalf("expected an alert with fingerprint %s", fingerprint)
+	return nil
+}
+
+func alertmanagerBodyPayload(fingerprint string) AlertmanagerPayload {
+	return AlertmanagerPayload{
+		Version:     "4",
+		GroupKey:    "test-group",
+		Status:      "firing",
+		ExternalURL: "http://alertmanager:9093",
+		Alerts: []AlertmanagerAlert{{
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "DiskFull", "instance": "db-1"},
+			Annotations: map[string]string{"description": "Disk is 95% full", "runbook": "https://runbooks/disk"},
+			StartsAt:    time.Now(),
+			Fingerprint: fingerprint,
+		}},
+	}
+}
+
+func TestBodyTemplate_AlertmanagerCustomTemplate(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].BodyTemplate =
+		`{{ .Labels.alertname }} on {{ .Labels.instance }}: {{ .Annotations.description }} (runbook {{ .Annotations.runbook }}, {{ .Group.ExternalURL }})`
+
+	postWebhook(t, router, "/api/v1/webhook/alertmanager/valid-key", alertmanagerBodyPayload("fp-1"))
+
+	want := "DiskFull on db-1: Disk is 95% full (runbook https://runbooks/disk, http://alertmanager:9093)"
+	if got := storedAlert(t, alertStore, "fp-1").Details; got != want {
+		t.Errorf("expected details %q, got %q", want, got)
+	}
+}
+
+func TestBodyTemplate_GrafanaCustomTemplate(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].BodyTemplate =
+		`[{{ .State }}] {{ .Message }}{{ range .EvalMatches }} {{ .Metric }}={{ .Value }}{{ end }}`
+
+	postWebhook(t, router, "/api/v1/webhook/grafana/valid-key", GrafanaPayload{
+		Title:       "High CPU Usage",
+		RuleID:      123,
+		RuleName:    "CPU Alert",
+		State:       "alerting",
+		Message:     "CPU usage is above 90%",
+		EvalMatches: []GrafanaMatch{{Metric: "cpu", Value: 97}},
+	})
+
+	if len(alertStore.alerts) != 1 {
+		t.Fatalf("expected 1 alert in store, got %d", len(alertStore.alerts))
+	}
+	for _, alert := range alertStore.alerts {
+		if want := "[alerting] CPU usage is above 90% cpu=97"; alert.Details != want {
+			t.Errorf("expected details %q, got %q", want, alert.Details)
+		}
+	}
+}
+
+func TestBodyTemplate_SyntaxErrorFallsBackToDefault(t *testing.T) {
+	handler, router, alertStore, serviceStore := setupTestHandler()
+	service := serviceStore.services["valid-key"]
+	service.BodyTemplate = `{{ .Annotations.description`
+
+	postWebhook(t, router, "/api/v1/webhook/alertmanager/valid-key", alertmanagerBodyPayload("fp-1"))
+	if got := storedAlert(t, alertStore, "fp-1").Details; got != "Disk is 95% full" {
+		t.Errorf("expected the default body, got %q", got)
+	}
+
+	// Fixing the template on the service takes effect on the next webhook
+	service.BodyTemplate = `{{ .Labels.instance }}: {{ .Annotations.description }}`
+	postWebhook(t, router, "/api/v1/webhook/alertmanager/valid-key", alertmanagerBodyPayload("fp-2"))
+	if got := storedAlert(t, alertStore, "fp-2").Details; got != "db-1: Disk is 95% full" {
+		t.Errorf("expected the updated template to be used, got %q", got)
+	}
+
+	// Templates that fail to execute fall back too
+	service.BodyTemplate = `{{ .Labels.instance.Missing }}`
+	if got := handler.renderBody(service, bodySourceAlertmanager, &AlertmanagerBodyData{AlertmanagerAlert: &alertmanagerBodyPayload("fp-3").Alerts[0]}); got != "Disk is 95% full" {
+		t.Errorf("expected the default body, got %q", got)
+	}
+}
+
+func TestBodyTemplate_Defaults(t *testing.T) {
+	handler, _, _, _ := setupTestHandler()
+	service := newMockServiceStore().services["valid-key"]
+
+	tests := []struct {
+		name   string
+		source string
+		data   any
+		want   string
+	}{
+		{
+			name:   "alertmanager",
+			source: bodySourceAlertmanager,
+			data:   &AlertmanagerBodyData{AlertmanagerAlert: &AlertmanagerAlert{Annotations: map[string]string{"description": "disk full"}}},
+			want:   "disk full",
+		},
+		{
+			name:   "alertmanager without annotations",
+			source: bodySourceAlertmanager,
+			data:   &AlertmanagerBodyData{AlertmanagerAlert: &AlertmanagerAlert{}},
+			want:   "",
+		},
+		{name: "grafana", source: bodySourceGrafana, data: &GrafanaPayload{Message: "CPU high"}, want: "CPU high"},
+		{name: "generic", source: bodySourceGeneric, data: &GenericPayload{Details: "details"}, want: "details"},
+		{
+			name:   "sentry",
+			source: bodySourceSentry,
+			data:   &SentryPayload{Data: SentryData{Issue: SentryIssue{Culprit: "app/checkout.js"}}},
+			want:   "app/checkout.js",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := handler.renderBody(service, tt.source, tt.data); got != tt.want {
+				t.Errorf("renderBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
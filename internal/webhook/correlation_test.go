@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/correlation"
+)
+
+func TestIngestAlert_CorrelatesWithExistingGroup(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	engine := correlation.NewEngine()
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithCorrelationEngine(engine, DefaultCorrelationWindow))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	for _, payload := range []GenericPayload{
+		{Summary: "Link down", Severity: "critical", Fingerprint: "fp-link", Labels: map[string]string{"site": "ams1", "rack": "r12"}},
+		{Summary: "Host down", Severity: "critical", Fingerprint: "fp-host-1", Labels: map[string]string{"site": "ams1", "rack": "r12", "host": "db-1"}},
+		{Summary: "Host down", Severity: "critical", Fingerprint: "fp-host-2", Labels: map[string]string{"site": "ams1", "rack": "r12", "host": "db-2"}},
+		{Summary: "Disk full", Severity: "warning", Fingerprint: "fp-other", Labels: map[string]string{"site": "fra1"}},
+	} {
+		postGenericAlert(t, router, payload)
+	}
+
+	root := alertStore.alertsByFP["fp-link"]
+	groupID := root.Annotations[correlation.AnnotationGroupID]
+	if groupID == "" {
+		t.Fatal("expected the first alert to join the group created by the second")
+	}
+	for _, fp := range []string{"fp-host-1", "fp-host-2"} {
+		annotations := alertStore.alertsByFP[fp].Annotations
+		if annotations[correlation.AnnotationGroupID] != groupID {
+			t.Errorf("%s: expected group %q, got %q", fp, groupID, annotations[correlation.AnnotationGroupID])
+		}
+		if annotations[correlation.AnnotationRootCause] != root.Id {
+			t.Errorf("%s: expected root cause %q, got %q", fp, root.Id, annotations[correlation.AnnotationRootCause])
+		}
+	}
+	if got := alertStore.alertsByFP["fp-other"].Annotations[correlation.AnnotationGroupID]; got != "" {
+		t.Errorf("expected an unrelated alert not to be grouped, got %q", got)
+	}
+
+	if got := engine.Metrics().GroupsCreatedTotal(); got != 1 {
+		t.Errorf("expected 1 correlation group created, got %d", got)
+	}
+}
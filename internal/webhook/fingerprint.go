@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+// FingerprintStrategy derives the deduplication fingerprint of an alert. It is
+// shared with store.FingerprintMigrator, which re-fingerprints stored alerts.
+type FingerprintStrategy = store.FingerprintStrategy
+
+// SHA256Strategy hashes the source, extra fields and all labels with SHA-256.
+// This is the default strategy.
+type SHA256Strategy struct{}
+
+// Compute implements FingerprintStrategy.
+func (SHA256Strategy) Compute(labels map[string]string, source string, extraFields ...string) string {
+	hash := sha256.Sum256([]byte(fingerprintData(labels, nil, source, extraFields)))
+	return hex.EncodeToString(hash[:16]) // Use first 16 bytes (32 hex chars)
+}
+
+// MD5Strategy hashes the same input as SHA256Strategy with MD5.
+// Kept for services migrated from systems that used MD5 fingerprints.
+type MD5Strategy struct{}
+
+// Compute implements FingerprintStrategy.
+func (MD5Strategy) Compute(labels map[string]string, source string, extraFields ...string) string {
+	hash := md5.Sum([]byte(fingerprintData(labels, nil, source, extraFields)))
+	return hex.EncodeToString(hash[:])
+}
+
+// CustomLabelStrategy hashes the source and only the configured label keys, so
+// alerts that differ in other labels or fields share a fingerprint.
+type CustomLabelStrategy struct {
+	labelKeys []string
+}
+
+// NewCustomLabelStrategy creates a strategy that hashes the given label keys.
+func NewCustomLabelStrategy(labelKeys []string) *CustomLabelStrategy {
+	keys := make([]string, len(labelKeys))
+	copy(keys, labelKeys)
+	sort.Strings(keys)
+	return &CustomLabelStrategy{labelKeys: keys}
+}
+
+// Compute implements FingerprintStrategy. Extra fields are ignored.
+func (s *CustomLabelStrategy) Compute(labels map[string]string, source string, _ ...string) string {
+	hash := sha256.Sum256([]byte(fingerprintData(labels, s.labelKeys, source, nil)))
+	return hex.EncodeToString(hash[:16])
+}
+
+// fingerprintData builds the deterministic string hashed by the strategies.
+// If keys is nil, all labels are included in sorted key order.
+func fingerprintData(labels map[string]string, keys []string, source string, extraFields []string) string {
+	var b strings.Builder
+	b.WriteString(source)
+	b.WriteString(":")
+	for _, field := range extraFields {
+		b.WriteString(field)
+		b.WriteString(":")
+	}
+
+	if keys == nil {
+		keys = make([]string, 0, len(labels))
+		for k := range labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s=%s,", k, labels[k])
+	}
+
+	return b.String()
+}
+
+// NewFingerprintStrategy returns the strategy registered under name.
+// An empty name selects SHA256Strategy.
+func NewFingerprintStrategy(name string, labelKeys []string) (FingerprintStrategy, error) {
+	switch name {
+	case "", store.FingerprintStrategySHA256:
+		return SHA256Strategy{}, nil
+	case store.FingerprintStrategyMD5:
+		return MD5Strategy{}, nil
+	case store.FingerprintStrategyCustomLabel:
+		if len(labelKeys) == 0 {
+			return nil, fmt.Errorf("fingerprint strategy %q requires label keys", name)
+		}
+		return NewCustomLabelStrategy(labelKeys), nil
+	default:
+		return nil, fmt.Errorf("unknown fingerprint strategy %q", name)
+	}
+}
+
+// fingerprintStrategy returns the strategy configured for a service.
+// Invalid configurations fall back to SHA256Strategy.
+func (h *Handler) fingerprintStrategy(service *store.Service) FingerprintStrategy {
+	strategy, err := NewFingerprintStrategy(service.FingerprintStrategy, service.FingerprintLabelKeys)
+	if err != nil {
+		h.logger.Warn().
+			Err(err).
+			Str("serviceId", service.ID).
+			Msg("invalid fingerprint strategy, using sha256")
+		return SHA256Strategy{}
+	}
+	return strategy
+}
+
+// GetFingerprintMigrationStatus handles GET /api/v1/admin/fingerprint-migration-status
+func (h *Handler) GetFingerprintMigrationStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, h.fingerprintMigrator.Status())
+}
+
+// startFingerprintMigrationRequest is the body of a fingerprint migration request.
+type startFingerprintMigrationRequest struct {
+	Strategy  string   `json:"strategy"`
+	LabelKeys []string `json:"labelKeys"`
+}
+
+// StartFingerprintMigration handles POST /api/v1/admin/fingerprint-migration.
+// The migration runs in the background; its progress is reported by
+// GetFingerprintMigrationStatus.
+func (h *Handler) StartFingerprintMigration(c *gin.Context) {
+	var req startFingerprintMigrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	strategy, err := NewFingerprintStrategy(req.Strategy, req.LabelKeys)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid strategy", "message": err.Error()})
+		return
+	}
+
+	if h.fingerprintMigrator.Status().State == store.FingerprintMigrationRunning {
+		c.JSON(http.StatusConflict, gin.H{"error": "conflict", "message": store.ErrFingerprintMigrationRunning.Error()})
+		return
+	}
+
+	ctx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		if _, err := h.fingerprintMigrator.MigrateFingerprints(ctx, strategy); err != nil {
+			h.logger.Error().Err(err).Msg("fingerprint migration failed")
+		}
+	}()
+
+	c.JSON(http.StatusAccepted, h.fingerprintMigrator.Status())
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestCustomLabelStrategy_IgnoresOtherLabels(t *testing.T) {
+	strategy := NewCustomLabelStrategy([]string{"alertname", "env"})
+
+	fp1 := strategy.Compute(map[string]string{
+		"alertname": "HighCPU",
+		"env":       "production",
+		"severity":  "warning",
+	}, "generic", "svc-123", "CPU high")
+	fp2 := strategy.Compute(map[string]string{
+		"alertname": "HighCPU",
+		"env":       "production",
+		"severity":  "critical",
+	}, "generic", "svc-123", "CPU very high")
+
+	if fp1 != fp2 {
+		t.Errorf("expected same fingerprint for alerts differing only in severity, got %s and %s", fp1, fp2)
+	}
+
+	fp3 := strategy.Compute(map[string]string{
+		"alertname": "HighCPU",
+		"env":       "staging",
+		"severity":  "warning",
+	}, "generic", "svc-123", "CPU high")
+
+	if fp1 == fp3 {
+		t.Error("expected different fingerprint for different env")
+	}
+}
+
+func TestSHA256Strategy_MatchesLegacyFingerprint(t *testing.T) {
+	labels := map[string]string{"team": "platform", "env": "production"}
+
+	// Format used by the generic webhook before strategies were pluggable
+	legacy := sha256.Sum256([]byte("generic:svc-123:Disk full:env=production,team=platform,"))
+	want := hex.EncodeToString(legacy[:16])
+
+	if got := (SHA256Strategy{}).Compute(labels, "generic", "svc-123", "Disk full"); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestMD5Strategy_Compute(t *testing.T) {
+	labels := map[string]string{"env": "production"}
+
+	fp := MD5Strategy{}.Compute(labels, "generic", "svc-123")
+	if len(fp) != 32 {
+		t.Errorf("expected 32 character fingerprint, got %d", len(fp))
+	}
+	if fp == (SHA256Strategy{}).Compute(labels, "generic", "svc-123") {
+		t.Error("expected md5 and sha256 fingerprints to differ")
+	}
+}
+
+func TestNewFingerprintStrategy(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  string
+		labelKeys []string
+		wantErr   bool
+	}{
+		{"default", "", nil, false},
+		{"sha256", store.FingerprintStrategySHA256, nil, false},
+		{"md5", store.FingerprintStrategyMD5, nil, false},
+		{"custom label", store.FingerprintStrategyCustomLabel, []string{"alertname"}, false},
+		{"custom label without keys", store.FingerprintStrategyCustomLabel, nil, true},
+		{"unknown", "crc32", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFingerprintStrategy(tt.strategy, tt.labelKeys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGenericWebhook_ServiceFingerprintStrategy(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["custom-key"] = &store.Service{
+		ID:                   "svc-custom",
+		Name:                 "Custom Fingerprint Service",
+		IntegrationKey:       "custom-key",
+		FingerprintStrategy:  store.FingerprintStrategyCustomLabel,
+		FingerprintLabelKeys: []string{"alertname", "env"},
+	}
+
+	for _, severity := range []string{"warning", "critical"} {
+		payload := GenericPayload{
+			Summary:  "CPU high",
+			Severity: severity,
+			Labels:   map[string]string{"alertname": "HighCPU", "env": "production", "severity": severity},
+		}
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/custom-key", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	if len(alertStore.alerts) != 1 {
+		t.Errorf("expected alerts to be deduplicated into 1, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestGetFingerprintMigrationStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	_, _ = alertStore.Create(context.Background(), &alertingv1.Alert{
+		Fingerprint: "legacy",
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_GENERIC,
+		ServiceId:   "svc-123",
+		Labels:      map[string]string{"host": "db-1"},
+	})
+	migrator := store.NewFingerprintMigrator(alertStore)
+	if _, err := migrator.MigrateFingerprints(context.Background(), SHA256Strategy{}); err != nil {
+		t.Fatalf("MigrateFingerprints() error = %v", err)
+	}
+
+	router := gin.New()
+	NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithFingerprintMigrator(migrator)).RegisterAdminRoutes(router.Group("/api/v1"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/fingerprint-migration-status", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var status store.FingerprintMigrationStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if status.State != store.FingerprintMigrationCompleted || status.Processed != 1 || status.Migrated != 1 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
+
+func TestStartFingerprintMigration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	_, _ = alertStore.Create(context.Background(), &alertingv1.Alert{
+		Fingerprint: "legacy",
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_GENERIC,
+		ServiceId:   "svc-123",
+		Labels:      map[string]string{"host": "db-1"},
+	})
+	migrator := store.NewFingerprintMigrator(alertStore)
+
+	router := gin.New()
+	NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithFingerprintMigrator(migrator)).RegisterAdminRoutes(router.Group("/api/v1"))
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/fingerprint-migration", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post(`{"strategy": "custom_label"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("custom_label without label keys: expected status 400, got %d", w.Code)
+	}
+
+	if w := post(`{"strategy": "sha256"}`); w.Code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for migrator.Status().State != store.FingerprintMigrationCompleted {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for migration, status: %+v", migrator.Status())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if status := migrator.Status(); status.Processed != 1 || status.Migrated != 1 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
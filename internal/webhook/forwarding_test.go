@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/forwarding"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// TestGenericWebhook_ForwardsToReplica checks that an alert ingested by one instance
+// is forwarded to and ingested by the generic webhook of a replica instance.
+func TestGenericWebhook_ForwardsToReplica(t *testing.T) {
+	_, replicaRouter, replicaAlerts, _ := setupTestHandler()
+	replica := httptest.NewServer(replicaRouter)
+	defer replica.Close()
+
+	rules := forwarding.NewInMemoryStore()
+	_, err := rules.Create(context.Background(), &forwarding.ForwardingRule{
+		Name:           "dr replica",
+		DestinationURL: replica.URL + "/api/v1/webhook/generic/valid-key",
+		Enabled:        true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create forwarding rule: %v", err)
+	}
+	forwarder := forwarding.NewForwarder(rules, zerolog.Nop(), nil)
+
+	gin.SetMode(gin.TestMode)
+	primaryAlerts := newMockAlertStore()
+	handler := NewHandler(primaryAlerts, newMockServiceStore(), zerolog.Nop(), WithForwarder(forwarder))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	w := postQuotaAlert(router, "Replicated alert")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	forwarder.Wait()
+
+	if len(replicaAlerts.alerts) != 1 {
+		t.Fatalf("expected 1 replicated alert, got %d", len(replicaAlerts.alerts))
+	}
+
+	var primary *alertingv1.Alert
+	for _, alert := range primaryAlerts.alerts {
+		primary = alert
+	}
+	for _, alert := range replicaAlerts.alerts {
+		if alert.Summary != "Replicated alert" || alert.Fingerprint != primary.Fingerprint {
+			t.Errorf("unexpected replicated alert: %v", alert)
+		}
+	}
+	if got := forwarder.Metrics().AttemptsTotal(replica.URL + "/api/v1/webhook/generic/valid-key"); got != 1 {
+		t.Errorf("expected 1 forwarding attempt, got %d", got)
+	}
+}
@@ -0,0 +1,269 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// GCP Cloud Monitoring incident states.
+const (
+	GCPIncidentStateOpen   = "open"
+	GCPIncidentStateClosed = "closed"
+)
+
+// GCPMonitoringPayload represents a Pub/Sub push request carrying a Cloud
+// Monitoring notification.
+type GCPMonitoringPayload struct {
+	Message      GCPPubSubMessage `json:"message"`
+	Subscription string           `json:"subscription"`
+}
+
+// GCPPubSubMessage is the Pub/Sub message of a push request. Data is the
+// base64-encoded JSON notification.
+type GCPPubSubMessage struct {
+	Data        string            `json:"data"`
+	MessageID   string            `json:"messageId"`
+	PublishTime string            `json:"publishTime,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+}
+
+// GCPMonitoringNotification is the decoded message data.
+type GCPMonitoringNotification struct {
+	Incident GCPMonitoringIncident `json:"incident"`
+	Version  string                `json:"version"`
+}
+
+// GCPMonitoringIncident represents a Cloud Monitoring incident.
+type GCPMonitoringIncident struct {
+	IncidentID    string               `json:"incident_id"`
+	Resource      GCPMonitoredResource `json:"resource"`
+	ResourceName  string               `json:"resource_name,omitempty"`
+	PolicyName    string               `json:"policy_name"`
+	ConditionName string               `json:"condition_name"`
+	State         string               `json:"state"`
+	Summary       string               `json:"summary"`
+	URL           string               `json:"url,omitempty"`
+}
+
+// GCPMonitoredResource identifies the resource an incident was raised for.
+type GCPMonitoredResource struct {
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DecodeNotification base64-decodes and parses the message data.
+func (p *GCPMonitoringPayload) DecodeNotification() (*GCPMonitoringNotification, error) {
+	if p.Message.Data == "" {
+		return nil, errors.New("message data is required")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(p.Message.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode message data: %w", err)
+	}
+
+	var notification GCPMonitoringNotification
+	if err := json.Unmarshal(data, &notification); err != nil {
+		return nil, fmt.Errorf("parse message data: %w", err)
+	}
+	return &notification, nil
+}
+
+// GCPMonitoringWebhook handles POST /api/v1/webhook/gcp-monitoring/:integration_key
+func (h *Handler) GCPMonitoringWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	// Verify the bearer token when the service has a signing secret
+	if service.WebhookSigningSecret != "" && !validBearerToken(service.WebhookSigningSecret, c.GetHeader("Authorization")) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("invalid gcp monitoring bearer token")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "unauthorized",
+			Message: "invalid Authorization bearer token",
+		})
+		return
+	}
+
+	// Parse payload
+	var payload GCPMonitoringPayload
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse gcp monitoring payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid gcp monitoring payload: " + err.Error(),
+		})
+		return
+	}
+
+	notification, err := payload.DecodeNotification()
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to decode gcp monitoring message")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid gcp monitoring message: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	incident := &notification.Incident
+	if incident.IncidentID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "incident id is required",
+		})
+		return
+	}
+
+	status, ok := mapGCPIncidentState(incident.State)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported gcp incident state: " + incident.State,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("incidentId", incident.IncidentID).
+		Str("state", incident.State).
+		Msg("processing gcp monitoring webhook")
+
+	alert, wasCreated, err := h.processGCPIncident(c, service, incident, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("incidentId", incident.IncidentID).
+			Msg("failed to process gcp monitoring incident")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processGCPIncident(c *gin.Context, service *store.Service, incident *GCPMonitoringIncident, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	labels := map[string]string{
+		"incidentId":    incident.IncidentID,
+		"policyName":    incident.PolicyName,
+		"conditionName": incident.ConditionName,
+		"resourceType":  incident.Resource.Type,
+	}
+	for k, v := range incident.Resource.Labels {
+		if _, exists := labels[k]; !exists {
+			labels[k] = v
+		}
+	}
+
+	annotations := make(map[string]string)
+	if incident.URL != "" {
+		annotations["url"] = incident.URL
+	}
+	if incident.ResourceName != "" {
+		annotations["resourceName"] = incident.ResourceName
+	}
+
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"incidentId":    incident.IncidentID,
+		"policyName":    incident.PolicyName,
+		"conditionName": incident.ConditionName,
+		"state":         incident.State,
+		"summary":       incident.Summary,
+		"resourceType":  incident.Resource.Type,
+	})
+
+	summary := incident.PolicyName
+	if incident.ConditionName != "" {
+		summary += ": " + incident.ConditionName
+	}
+	if summary == "" {
+		summary = "GCP incident " + incident.IncidentID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: generateGCPMonitoringFingerprint(incident.IncidentID),
+		Summary:     summary,
+		Details:     h.renderBody(service, bodySourceGCPMonitoring, incident),
+		Severity:    alertingv1.Severity_SEVERITY_MEDIUM,
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_GCP_MONITORING,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: annotations,
+		Status:      status,
+		TriggeredAt: timestamppb.New(time.Now()),
+		RawPayload:  rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_GCP_MONITORING),
+	}
+
+	if status == alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		alert.ResolvedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// validBearerToken reports whether header is "Bearer <token>" with the expected token.
+func validBearerToken(token, header string) bool {
+	got, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || got == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+// mapGCPIncidentState maps a Cloud Monitoring incident state to an alert status.
+func mapGCPIncidentState(state string) (alertingv1.AlertStatus, bool) {
+	switch state {
+	case GCPIncidentStateOpen:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case GCPIncidentStateClosed:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+func generateGCPMonitoringFingerprint(incidentID string) string {
+	// The incident ID is shared by the open and closed notifications of an incident
+	return SHA256Strategy{}.Compute(nil, "gcp-monitoring", incidentID)
+}
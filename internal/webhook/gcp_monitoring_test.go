@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func gcpMonitoringPayload(state string) []byte {
+	data, _ := json.Marshal(GCPMonitoringNotification{
+		Version: "1.2",
+		Incident: GCPMonitoringIncident{
+			IncidentID:    "0.nqw3vdbt3bkg",
+			Resource:      GCPMonitoredResource{Type: "gce_instance", Labels: map[string]string{"instance_id": "4711", "zone": "europe-west1-b"}},
+			PolicyName:    "High CPU",
+			ConditionName: "CPU utilization above 90%",
+			State:         state,
+			Summary:       "CPU utilization for web-1 is above the threshold of 0.9 with a value of 0.97.",
+			URL:           "https://console.cloud.google.com/monitoring/alerting/incidents/0.nqw3vdbt3bkg",
+		},
+	})
+	return gcpPushRequest(base64.StdEncoding.EncodeToString(data))
+}
+
+func gcpPushRequest(data string) []byte {
+	body, _ := json.Marshal(GCPMonitoringPayload{
+		Message:      GCPPubSubMessage{Data: data, MessageID: "123"},
+		Subscription: "projects/acme/subscriptions/alerting",
+	})
+	return body
+}
+
+func postGCPMonitoring(router *gin.Engine, body []byte, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/gcp-monitoring/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestGCPMonitoringWebhook_OpenAndClosed(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postGCPMonitoring(router, gcpMonitoringPayload(GCPIncidentStateOpen), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	if len(alertStore.alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+	var alert *alertingv1.Alert
+	for _, a := range alertStore.alerts {
+		alert = a
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_GCP_MONITORING {
+		t.Errorf("expected source GCP_MONITORING, got %v", alert.Source)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Summary != "High CPU: CPU utilization above 90%" {
+		t.Errorf("unexpected summary %q", alert.Summary)
+	}
+	if alert.Details != "CPU utilization for web-1 is above the threshold of 0.9 with a value of 0.97." {
+		t.Errorf("expected the incident summary as details, got %q", alert.Details)
+	}
+	if alert.Labels["resourceType"] != "gce_instance" || alert.Labels["zone"] != "europe-west1-b" {
+		t.Errorf("expected resource labels, got %v", alert.Labels)
+	}
+	if alert.IngestionMetadata.GetSourceFormat() != alertingv1.SourceFormat_SOURCE_FORMAT_GCP_MONITORING {
+		t.Errorf("expected source format GCP_MONITORING, got %v", alert.IngestionMetadata.GetSourceFormat())
+	}
+
+	w = postGCPMonitoring(router, gcpMonitoringPayload(GCPIncidentStateClosed), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Created != 0 {
+		t.Errorf("expected the closed incident to update the alert, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+
+	resolved := alertStore.alertsByFP[alert.Fingerprint]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected status RESOLVED, got %v", resolved.Status)
+	}
+	if resolved.ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestGCPMonitoringWebhook_BearerToken(t *testing.T) {
+	body := gcpMonitoringPayload(GCPIncidentStateOpen)
+
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+	}{
+		{name: "valid token", authorization: "Bearer gcp-token", expectedStatus: http.StatusOK},
+		{name: "wrong token", authorization: "Bearer other-token", expectedStatus: http.StatusUnauthorized},
+		{name: "not bearer", authorization: "Basic gcp-token", expectedStatus: http.StatusUnauthorized},
+		{name: "missing header", authorization: "", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, serviceStore := setupTestHandler()
+			serviceStore.services["valid-key"].WebhookSigningSecret = "gcp-token"
+
+			w := postGCPMonitoring(router, body, tt.authorization)
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			expectedAlerts := 0
+			if tt.expectedStatus == http.StatusOK {
+				expectedAlerts = 1
+			}
+			if len(alertStore.alerts) != expectedAlerts {
+				t.Errorf("expected %d alerts, got %d", expectedAlerts, len(alertStore.alerts))
+			}
+		})
+	}
+}
+
+func TestGCPMonitoringWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"message":`)},
+		{name: "missing data", body: gcpPushRequest("")},
+		{name: "invalid base64", body: gcpPushRequest("not base64!")},
+		{name: "data is not json", body: gcpPushRequest(base64.StdEncoding.EncodeToString([]byte("plain text")))},
+		{name: "missing incident id", body: gcpPushRequest(base64.StdEncoding.EncodeToString([]byte(`{"incident":{"state":"open"}}`)))},
+		{name: "unsupported state", body: gcpMonitoringPayload("acknowledged")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, _ := setupTestHandler()
+
+			w := postGCPMonitoring(router, tt.body, "")
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+			if len(alertStore.alerts) != 0 {
+				t.Errorf("expected no alerts, got %d", len(alertStore.alerts))
+			}
+		})
+	}
+}
@@ -1,17 +1,16 @@
 package webhook
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
-	"sort"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/store"
 	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
@@ -38,7 +37,7 @@ func (h *Handler) GenericWebhook(c *gin.Context) {
 
 	// Parse payload
 	var payload GenericPayload
-	if err := c.ShouldBindJSON(&payload); err != nil {
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
 		h.logger.Error().Err(err).Msg("failed to parse generic payload")
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "badRequest",
@@ -56,12 +55,31 @@ func (h *Handler) GenericWebhook(c *gin.Context) {
 		return
 	}
 
+	// Validate labels against the service's label requirements
+	if validationErr := validateLabels(service, payload.Labels); validationErr != nil {
+		h.recordValidationFailure(service.ID, validationErr)
+		h.logger.Warn().
+			Str("serviceId", service.ID).
+			Strs("missingLabels", validationErr.MissingLabels).
+			Strs("forbiddenLabels", validationErr.ForbiddenLabels).
+			Int("schemaViolations", len(validationErr.SchemaViolations)).
+			Msg("generic payload failed label validation")
+		c.JSON(http.StatusUnprocessableEntity, validationErr)
+		return
+	}
+
 	h.logger.Info().
 		Str("serviceId", service.ID).
 		Str("summary", payload.Summary).
 		Msg("processing generic webhook")
 
-	alert, wasCreated, err := h.processGenericAlert(c, service.ID, &payload)
+	metadata := requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_GENERIC)
+	alert, wasCreated, err := h.processGenericAlert(c.Request.Context(), service, &payload, metadata)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
 	if err != nil {
 		h.logger.Error().
 			Err(err).
@@ -83,14 +101,23 @@ func (h *Handler) GenericWebhook(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, WebhookResponse{
-		Message:  "alert processed successfully",
-		AlertIds: []string{alert.Id},
-		Created:  created,
-		Updated:  updated,
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
 	})
 }
 
-func (h *Handler) processGenericAlert(c *gin.Context, serviceID string, payload *GenericPayload) (*alertingv1.Alert, bool, error) {
+// IngestGeneric ingests a generic payload for a service through the standard
+// ingestion path. It is used by internal producers that raise alerts without an
+// HTTP request. Returns the stored alert and whether it was newly created.
+func (h *Handler) IngestGeneric(ctx context.Context, service *store.Service, payload *GenericPayload) (*alertingv1.Alert, bool, error) {
+	return h.processGenericAlert(ctx, service, payload,
+		internalIngestionMetadata(alertingv1.SourceFormat_SOURCE_FORMAT_INTERNAL, payload))
+}
+
+func (h *Handler) processGenericAlert(ctx context.Context, service *store.Service, payload *GenericPayload, metadata *alertingv1.IngestionMetadata) (*alertingv1.Alert, bool, error) {
 	// Parse or default status
 	status := parseGenericStatus(payload.Status)
 
@@ -100,7 +127,7 @@ func (h *Handler) processGenericAlert(c *gin.Context, serviceID string, payload
 	// Use provided fingerprint or generate one
 	fingerprint := payload.Fingerprint
 	if fingerprint == "" {
-		fingerprint = generateGenericFingerprint(serviceID, payload)
+		fingerprint = generateGenericFingerprint(h.fingerprintStrategy(service), service.ID, payload)
 	}
 
 	// Set timestamp
@@ -142,15 +169,17 @@ func (h *Handler) processGenericAlert(c *gin.Context, serviceID string, payload
 	alert := &alertingv1.Alert{
 		Fingerprint:  fingerprint,
 		Summary:      payload.Summary,
-		Details:      payload.Details,
+		Details:      h.renderBody(service, bodySourceGeneric, payload),
 		Severity:     severity,
 		Source:       alertingv1.AlertSource_ALERT_SOURCE_GENERIC,
-		ServiceId:    serviceID,
+		ServiceId:    service.ID,
 		Labels:       labels,
 		Annotations:  annotations,
 		Status:       status,
 		TriggeredAt:  timestamppb.New(triggeredAt),
 		RawPayload:   rawPayload,
+
+		IngestionMetadata: metadata,
 	}
 
 	// Set resolved_at if the alert is resolved
@@ -158,7 +187,7 @@ func (h *Handler) processGenericAlert(c *gin.Context, serviceID string, payload
 		alert.ResolvedAt = timestamppb.Now()
 	}
 
-	return h.alertStore.CreateOrUpdate(c.Request.Context(), alert)
+	return h.ingestAlert(ctx, service, alert)
 }
 
 func parseGenericStatus(status string) alertingv1.AlertStatus {
@@ -194,22 +223,7 @@ func parseGenericSeverity(severity string) alertingv1.Severity {
 	}
 }
 
-func generateGenericFingerprint(serviceID string, payload *GenericPayload) string {
-	// Create a deterministic string from service, summary, and sorted labels
-	data := fmt.Sprintf("generic:%s:%s:", serviceID, payload.Summary)
-
-	if payload.Labels != nil && len(payload.Labels) > 0 {
-		keys := make([]string, 0, len(payload.Labels))
-		for k := range payload.Labels {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-
-		for _, k := range keys {
-			data += fmt.Sprintf("%s=%s,", k, payload.Labels[k])
-		}
-	}
-
-	hash := sha256.Sum256([]byte(data))
-	return hex.EncodeToString(hash[:16]) // Use first 16 bytes (32 hex chars)
+func generateGenericFingerprint(strategy FingerprintStrategy, serviceID string, payload *GenericPayload) string {
+	// Fingerprint from service, summary, and labels
+	return strategy.Compute(payload.Labels, "generic", serviceID, payload.Summary)
 }
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/geo"
+)
+
+// staticResolver resolves every IP to the same location.
+type staticResolver struct{}
+
+func (staticResolver) ResolveIPToRegion(ip string) (string, string, error) {
+	return "Bavaria", "DE", nil
+}
+
+func TestGenericWebhook_GeoEnrichment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	serviceStore := newMockServiceStore()
+	serviceStore.services["valid-key"].SourceIPLabel = "client_ip"
+
+	handler := NewHandler(alertStore, serviceStore, zerolog.Nop(),
+		WithGeoEnricher(geo.NewGeoEnricher(staticResolver{}, zerolog.Nop())),
+	)
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	body, _ := json.Marshal(GenericPayload{
+		Summary: "Login failures",
+		Labels:  map[string]string{"client_ip": "192.0.2.44"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	for _, alert := range alertStore.alerts {
+		if alert.Labels["region"] != "Bavaria" || alert.Labels["country"] != "DE" {
+			t.Errorf("expected geo labels on the stored alert, got %v", alert.Labels)
+		}
+	}
+}
@@ -1,17 +1,16 @@
 package webhook
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
-	"sort"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/store"
 	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
@@ -48,7 +47,7 @@ func (h *Handler) GrafanaWebhook(c *gin.Context) {
 
 	// Parse payload
 	var payload GrafanaPayload
-	if err := c.ShouldBindJSON(&payload); err != nil {
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
 		h.logger.Error().Err(err).Msg("failed to parse grafana payload")
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "badRequest",
@@ -72,7 +71,12 @@ func (h *Handler) GrafanaWebhook(c *gin.Context) {
 		Str("state", payload.State).
 		Msg("processing grafana webhook")
 
-	alert, wasCreated, err := h.processGrafanaAlert(c, service.ID, &payload)
+	alert, wasCreated, err := h.processGrafanaAlert(c, service, &payload)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
 	if err != nil {
 		h.logger.Error().
 			Err(err).
@@ -94,14 +98,15 @@ func (h *Handler) GrafanaWebhook(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, WebhookResponse{
-		Message:  "alert processed successfully",
-		AlertIds: []string{alert.Id},
-		Created:  created,
-		Updated:  updated,
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
 	})
 }
 
-func (h *Handler) processGrafanaAlert(c *gin.Context, serviceID string, payload *GrafanaPayload) (*alertingv1.Alert, bool, error) {
+func (h *Handler) processGrafanaAlert(c *gin.Context, service *store.Service, payload *GrafanaPayload) (*alertingv1.Alert, bool, error) {
 	// Map Grafana state to internal status
 	status := mapGrafanaState(payload.State)
 
@@ -109,7 +114,7 @@ func (h *Handler) processGrafanaAlert(c *gin.Context, serviceID string, payload
 	severity := extractGrafanaSeverity(payload.Tags)
 
 	// Generate fingerprint from ruleId + tags
-	fingerprint := generateGrafanaFingerprint(payload)
+	fingerprint := generateGrafanaFingerprint(h.fingerprintStrategy(service), payload)
 
 	// Build summary
 	summary := payload.Title
@@ -160,15 +165,17 @@ func (h *Handler) processGrafanaAlert(c *gin.Context, serviceID string, payload
 	alert := &alertingv1.Alert{
 		Fingerprint:  fingerprint,
 		Summary:      summary,
-		Details:      payload.Message,
+		Details:      h.renderBody(service, bodySourceGrafana, payload),
 		Severity:     severity,
 		Source:       alertingv1.AlertSource_ALERT_SOURCE_GRAFANA,
-		ServiceId:    serviceID,
+		ServiceId:    service.ID,
 		Labels:       labels,
 		Annotations:  annotations,
 		Status:       status,
 		TriggeredAt:  timestamppb.New(time.Now()),
 		RawPayload:   rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_GRAFANA),
 	}
 
 	// Set resolved_at if the alert is resolved
@@ -176,7 +183,7 @@ func (h *Handler) processGrafanaAlert(c *gin.Context, serviceID string, payload
 		alert.ResolvedAt = timestamppb.Now()
 	}
 
-	return h.alertStore.CreateOrUpdate(c.Request.Context(), alert)
+	return h.ingestAlert(c.Request.Context(), service, alert)
 }
 
 func mapGrafanaState(state string) alertingv1.AlertStatus {
@@ -220,22 +227,7 @@ func extractGrafanaSeverity(tags map[string]string) alertingv1.Severity {
 	}
 }
 
-func generateGrafanaFingerprint(payload *GrafanaPayload) string {
-	// Create a deterministic string from ruleId and sorted tags
-	data := fmt.Sprintf("grafana:%d:", payload.RuleID)
-
-	if payload.Tags != nil && len(payload.Tags) > 0 {
-		keys := make([]string, 0, len(payload.Tags))
-		for k := range payload.Tags {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-
-		for _, k := range keys {
-			data += fmt.Sprintf("%s=%s,", k, payload.Tags[k])
-		}
-	}
-
-	hash := sha256.Sum256([]byte(data))
-	return hex.EncodeToString(hash[:16]) // Use first 16 bytes (32 hex chars)
+func generateGrafanaFingerprint(strategy FingerprintStrategy, payload *GrafanaPayload) string {
+	// Fingerprint from ruleId and tags
+	return strategy.Compute(payload.Tags, "grafana", fmt.Sprintf("%d", payload.RuleID))
 }
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/correlation"
+)
+
+func TestIngestAlert_SetsGroupID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	correlator, err := correlation.NewCorrelator(alertStore, []string{"cluster"}, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("failed to create correlator: %v", err)
+	}
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithLabelCorrelator(correlator))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	for _, payload := range []GenericPayload{
+		{Summary: "Host down", Severity: "critical", Fingerprint: "fp-host-1", Labels: map[string]string{"cluster": "ams1", "host": "db-1"}},
+		{Summary: "Host down", Severity: "critical", Fingerprint: "fp-host-2", Labels: map[string]string{"cluster": "ams1", "host": "db-2"}},
+		{Summary: "Host down", Severity: "critical", Fingerprint: "fp-other", Labels: map[string]string{"cluster": "fra1", "host": "db-1"}},
+		{Summary: "Disk full", Severity: "warning", Fingerprint: "fp-unlabeled"},
+	} {
+		postGenericAlert(t, router, payload)
+	}
+
+	first := alertStore.alertsByFP["fp-host-1"]
+	if first.GroupId == "" {
+		t.Fatal("expected a group id")
+	}
+	if got := alertStore.alertsByFP["fp-host-2"].GroupId; got != first.GroupId {
+		t.Errorf("expected alerts of the same cluster to share group %q, got %q", first.GroupId, got)
+	}
+	if got := alertStore.alertsByFP["fp-other"].GroupId; got == first.GroupId {
+		t.Errorf("expected alerts of another cluster not to share group %q", got)
+	}
+	if got := alertStore.alertsByFP["fp-unlabeled"].GroupId; got != "" {
+		t.Errorf("expected no group id for an alert without the group_by labels, got %q", got)
+	}
+}
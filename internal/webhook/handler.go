@@ -2,42 +2,638 @@
 package webhook
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/analytics"
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/correlation"
+	"github.com/kneutral-org/alerting-system/internal/customer"
+	"github.com/kneutral-org/alerting-system/internal/forwarding"
+	"github.com/kneutral-org/alerting-system/internal/geo"
+	"github.com/kneutral-org/alerting-system/internal/ingestion"
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	"github.com/kneutral-org/alerting-system/internal/silence"
 	"github.com/kneutral-org/alerting-system/internal/store"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
 
+// DefaultCorrelationWindow is the window used to correlate newly ingested alerts.
+const DefaultCorrelationWindow = 5 * time.Minute
+
 // Handler handles webhook requests for alert ingestion.
 type Handler struct {
 	alertStore   store.AlertStore
 	serviceStore store.ServiceStore
 	logger       zerolog.Logger
+	metrics      *Metrics
+
+	// registry exports ingestion statistics to Prometheus (optional)
+	registry *metrics.Registry
+
+	// tracer starts a span for each webhook request
+	tracer trace.Tracer
+
+	// correlator enriches newly created alerts with correlation metadata (optional)
+	correlator        *correlation.Engine
+	correlationWindow time.Duration
+
+	// labelCorrelator stores the label group of newly created alerts (optional)
+	labelCorrelator *correlation.Correlator
+
+	// silencer suppresses alerts matching an active silence (optional)
+	silencer *silence.Silencer
+
+	// inhibitor suppresses alerts inhibited by a triggered parent alert (optional)
+	inhibitor *inhibition.Inhibitor
+
+	// maintenanceStore tags new alerts with the active maintenance window (optional)
+	maintenanceStore maintenance.Store
+
+	// customerStore and tierStore set the SLA deadline of new alerts from
+	// their customer tier (optional)
+	customerStore customer.Store
+	tierStore     customer.TierStore
+
+	// receiptStore logs every received alert for deduplication analytics (optional)
+	receiptStore analytics.ReceiptStore
+
+	// summaryCache is invalidated whenever an alert is created or updated (optional)
+	summaryCache *analytics.SummaryCache
+
+	// geoEnricher adds region and country labels from the alert source IP (optional)
+	geoEnricher *geo.GeoEnricher
+
+	// quota enforces each service's AlertQuotaPerHour
+	quota *AlertQuota
+
+	// rateLimiter enforces each service's webhook RateLimit
+	rateLimiter *RateLimiter
+
+	// bodyTemplates caches each service's parsed BodyTemplate
+	bodyTemplates *bodyTemplateCache
+
+	// forwarder replicates stored alerts to other instances via forwarding rules (optional)
+	forwarder *forwarding.Forwarder
+
+	// fingerprintMigrator serves the fingerprint migration status endpoint (optional)
+	fingerprintMigrator *store.FingerprintMigrator
+
+	// routingEngine describes how processed alerts are routed when requested (optional)
+	routingEngine *routing.Engine
+
+	// workerPool routes stored alerts asynchronously in priority order (optional)
+	workerPool *ingestion.WorkerPool
+
+	// defaultIntegrationKey selects the service for the Alertmanager v2 compatible API (optional)
+	defaultIntegrationKey string
+
+	// startedAt is reported as the uptime by the Alertmanager v2 compatible status endpoint
+	startedAt time.Time
+}
+
+// HandlerOption configures optional Handler dependencies.
+type HandlerOption func(*Handler)
+
+// WithCorrelationEngine enables post-ingestion alert correlation.
+func WithCorrelationEngine(engine *correlation.Engine, window time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.correlator = engine
+		if window > 0 {
+			h.correlationWindow = window
+		}
+	}
+}
+
+// WithLabelCorrelator records the group_id of newly created alerts grouped by label values.
+func WithLabelCorrelator(correlator *correlation.Correlator) HandlerOption {
+	return func(h *Handler) {
+		h.labelCorrelator = correlator
+	}
+}
+
+// WithSilencer enables silence checks before alerts are stored.
+func WithSilencer(silencer *silence.Silencer) HandlerOption {
+	return func(h *Handler) {
+		h.silencer = silencer
+	}
+}
+
+// WithInhibitor enables inhibition rule checks before alerts are stored.
+func WithInhibitor(inhibitor *inhibition.Inhibitor) HandlerOption {
+	return func(h *Handler) {
+		h.inhibitor = inhibitor
+	}
+}
+
+// WithMaintenanceStore records the active maintenance window on newly created alerts.
+func WithMaintenanceStore(maintenanceStore maintenance.Store) HandlerOption {
+	return func(h *Handler) {
+		h.maintenanceStore = maintenanceStore
+	}
+}
+
+// WithCustomerTiers sets the SLA deadline of newly created alerts from the
+// response times of the tier of the customer identified by their labels.
+func WithCustomerTiers(customers customer.Store, tiers customer.TierStore) HandlerOption {
+	return func(h *Handler) {
+		h.customerStore = customers
+		h.tierStore = tiers
+	}
+}
+
+// WithReceiptStore records every received alert in the deduplication receipt log.
+func WithReceiptStore(receiptStore analytics.ReceiptStore) HandlerOption {
+	return func(h *Handler) {
+		h.receiptStore = receiptStore
+	}
+}
+
+// WithSummaryCache invalidates the alert summary cache on every ingested alert.
+func WithSummaryCache(cache *analytics.SummaryCache) HandlerOption {
+	return func(h *Handler) {
+		h.summaryCache = cache
+	}
+}
+
+// WithGeoEnricher adds geolocation labels to alerts of services with a SourceIPLabel.
+func WithGeoEnricher(enricher *geo.GeoEnricher) HandlerOption {
+	return func(h *Handler) {
+		h.geoEnricher = enricher
+	}
+}
+
+// WithAlertQuota replaces the in-memory per-service alert quota counter.
+func WithAlertQuota(quota *AlertQuota) HandlerOption {
+	return func(h *Handler) {
+		h.quota = quota
+	}
+}
+
+// WithRateLimiter replaces the in-memory per-integration key webhook rate limiter.
+func WithRateLimiter(limiter *RateLimiter) HandlerOption {
+	return func(h *Handler) {
+		h.rateLimiter = limiter
+	}
+}
+
+// WithForwarder forwards every stored alert matching a forwarding rule.
+func WithForwarder(forwarder *forwarding.Forwarder) HandlerOption {
+	return func(h *Handler) {
+		h.forwarder = forwarder
+	}
+}
+
+// WithFingerprintMigrator enables the fingerprint migration admin routes for the migrator.
+func WithFingerprintMigrator(migrator *store.FingerprintMigrator) HandlerOption {
+	return func(h *Handler) {
+		h.fingerprintMigrator = migrator
+	}
+}
+
+// WithRoutingEngine lets webhook requests with include_routing=true get the
+// routing decisions of the processed alerts in the response.
+func WithRoutingEngine(engine *routing.Engine) HandlerOption {
+	return func(h *Handler) {
+		h.routingEngine = engine
+	}
+}
+
+// WithWorkerPool submits new alerts and alerts whose status changed to the
+// worker pool, which routes alerts of critical severity and high-tier customers
+// first.
+func WithWorkerPool(pool *ingestion.WorkerPool) HandlerOption {
+	return func(h *Handler) {
+		h.workerPool = pool
+	}
+}
+
+// WithDefaultIntegrationKey enables the Alertmanager v2 compatible API, which
+// ingests alerts for the service with the given integration key.
+func WithDefaultIntegrationKey(key string) HandlerOption {
+	return func(h *Handler) {
+		h.defaultIntegrationKey = key
+	}
+}
+
+// WithMetricsRegistry exports ingestion statistics to the Prometheus registry.
+func WithMetricsRegistry(registry *metrics.Registry) HandlerOption {
+	return func(h *Handler) {
+		h.registry = registry
+	}
+}
+
+// WithTracerProvider sets the provider of request spans instead of the global one.
+func WithTracerProvider(provider trace.TracerProvider) HandlerOption {
+	return func(h *Handler) {
+		h.tracer = provider.Tracer(tracerName)
+	}
 }
 
 // NewHandler creates a new webhook handler with the provided dependencies.
-func NewHandler(alertStore store.AlertStore, serviceStore store.ServiceStore, logger zerolog.Logger) *Handler {
-	return &Handler{
-		alertStore:   alertStore,
-		serviceStore: serviceStore,
-		logger:       logger.With().Str("component", "webhook").Logger(),
+func NewHandler(alertStore store.AlertStore, serviceStore store.ServiceStore, logger zerolog.Logger, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		alertStore:        alertStore,
+		serviceStore:      serviceStore,
+		logger:            logger.With().Str("component", "webhook").Logger(),
+		metrics:           NewMetrics(),
+		correlationWindow: DefaultCorrelationWindow,
+		quota:             NewAlertQuota(),
+		rateLimiter:       NewRateLimiter(),
+		startedAt:         time.Now(),
+		tracer:            otel.Tracer(tracerName),
+	}
+	h.bodyTemplates = newBodyTemplateCache(h.logger)
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
+}
+
+// Metrics returns the metrics recorder for this handler.
+func (h *Handler) Metrics() *Metrics {
+	return h.metrics
 }
 
-// RegisterRoutes registers all webhook routes on the provided router group.
+// RegisterRoutes registers the webhook ingestion routes on the provided router
+// group. Webhooks authenticate with their integration key, so the group must not
+// require a user.
 func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
 	webhooks := router.Group("/webhook")
-	webhooks.POST("/alertmanager/:integration_key", h.AlertmanagerWebhook)
-	webhooks.POST("/grafana/:integration_key", h.GrafanaWebhook)
-	webhooks.POST("/generic/:integration_key", h.GenericWebhook)
+	webhooks.Use(h.traceRequests())
+	webhooks.POST("/alertmanager/:integration_key", h.instrument("alertmanager"), h.rateLimit(), h.AlertmanagerWebhook)
+	webhooks.POST("/grafana/:integration_key", h.instrument("grafana"), h.rateLimit(), h.GrafanaWebhook)
+	webhooks.POST("/generic/:integration_key", h.instrument("generic"), h.rateLimit(), h.GenericWebhook)
+	webhooks.POST("/sentry/:integration_key", h.instrument("sentry"), h.rateLimit(), h.SentryWebhook)
+	webhooks.POST("/gcp-monitoring/:integration_key", h.instrument("gcp_monitoring"), h.rateLimit(), h.GCPMonitoringWebhook)
+	webhooks.POST("/newrelic/:integration_key", h.instrument("newrelic"), h.rateLimit(), h.NewRelicWebhook)
+	webhooks.POST("/pagerduty/:integration_key", h.instrument("pagerduty"), h.rateLimit(), h.PagerDutyWebhook)
+	webhooks.POST("/opsgenie/:integration_key", h.instrument("opsgenie"), h.rateLimit(), h.OpsgenieWebhook)
+	webhooks.POST("/zabbix/:integration_key", h.instrument("zabbix"), h.rateLimit(), h.ZabbixWebhook)
+	webhooks.POST("/victorops/:integration_key", h.instrument("victorops"), h.rateLimit(), h.VictorOpsWebhook)
+}
+
+// RegisterUserRoutes registers the alert read routes on the provided router
+// group, which is expected to require an authenticated user.
+func (h *Handler) RegisterUserRoutes(router *gin.RouterGroup) {
+	router.GET("/alerts/:id/ingest-metadata", h.GetIngestMetadata)
+}
+
+// RegisterAdminRoutes registers the fingerprint migration routes on the provided
+// router group, which is expected to require an administrator. The routes are
+// only registered when WithFingerprintMigrator is set.
+func (h *Handler) RegisterAdminRoutes(router *gin.RouterGroup) {
+	if h.fingerprintMigrator == nil {
+		return
+	}
+	router.POST("/admin/fingerprint-migration", h.StartFingerprintMigration)
+	router.GET("/admin/fingerprint-migration-status", h.GetFingerprintMigrationStatus)
+}
+
+// ingestAlert enriches and persists an alert and runs post-ingestion enrichment steps.
+// Returns the stored alert and whether it was newly created, or ErrAlertQuotaExceeded
+// when the alert is new and the service has used its hourly alert quota.
+func (h *Handler) ingestAlert(ctx context.Context, service *store.Service, alert *alertingv1.Alert) (*alertingv1.Alert, bool, error) {
+	// Silences, maintenance windows and routing rules are looked up, and the
+	// alert is routed, in the tenant of its service
+	ctx = withServiceTenant(ctx, service)
+
+	existing, err := h.alertStore.GetByFingerprint(ctx, alert.Fingerprint)
+	if err != nil {
+		return nil, false, err
+	}
+	// Updates and resolves of existing alerts do not count against the quota
+	if existing == nil && !h.quota.Allow(service.ID, service.AlertQuotaPerHour) {
+		h.metrics.RecordAlertQuotaExceeded(service.ID)
+		return nil, false, ErrAlertQuotaExceeded
+	}
+	var previousStatus alertingv1.AlertStatus
+	if existing != nil {
+		previousStatus = existing.Status
+	}
+
+	if h.geoEnricher != nil {
+		h.geoEnricher.Enrich(service, alert)
+	}
+	h.silenceAlert(ctx, alert)
+	h.inhibitAlert(ctx, alert)
+
+	if existing != nil {
+		mergeExistingAlert(existing, alert)
+	}
+
+	stored, wasCreated, err := h.alertStore.CreateOrUpdate(ctx, alert)
+	if err != nil {
+		return nil, false, err
+	}
+
+	h.recordReceipt(ctx, stored, wasCreated)
+	h.recordAlertMetrics(ctx, stored)
+	h.forwardAlert(ctx, stored)
+	if h.summaryCache != nil {
+		h.summaryCache.Invalidate()
+	}
+
+	if wasCreated {
+		h.quota.Record(service.ID)
+		h.setSLADeadline(ctx, stored)
+		h.tagMaintenanceWindow(ctx, stored)
+		h.correlateAlert(ctx, stored)
+		h.groupAlert(ctx, stored)
+	}
+	// Repeat webhooks for an alert are only routed again when its status changed
+	if wasCreated || stored.Status != previousStatus {
+		h.submitAlert(ctx, stored)
+	}
+
+	return stored, wasCreated, nil
+}
+
+// submitAlert queues a copy of the stored alert for routing by the worker pool.
+// Failures are logged and never fail ingestion.
+func (h *Handler) submitAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.workerPool == nil {
+		return
+	}
+
+	if err := h.workerPool.Submit(ctx, proto.Clone(alert).(*alertingv1.Alert)); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to queue alert for routing")
+	}
+}
+
+// mergeExistingAlert carries the state recorded on the stored alert over to an
+// update of it, since updates replace the stored alert: its SLA deadline and
+// breach, maintenance window, group, correlation and history. An acknowledged
+// alert that is still firing stays acknowledged.
+func mergeExistingAlert(existing, alert *alertingv1.Alert) {
+	if existing.Status == alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED &&
+		alert.Status == alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		alert.Status = existing.Status
+		alert.AcknowledgedAt = existing.AcknowledgedAt
+		alert.AcknowledgedBy = existing.AcknowledgedBy
+	}
+	alert.Events = existing.Events
+	alert.Notes = existing.Notes
+
+	if alert.CreatedAt == nil {
+		alert.CreatedAt = existing.CreatedAt
+	}
+	alert.SlaDeadline = existing.SlaDeadline
+	alert.SlaBreached = existing.SlaBreached
+	alert.MaintenanceWindowId = existing.MaintenanceWindowId
+	alert.MaintenanceWindowName = existing.MaintenanceWindowName
+	alert.GroupId = existing.GroupId
+
+	for _, key := range []string{correlation.AnnotationGroupID, correlation.AnnotationRootCause} {
+		value, ok := existing.Annotations[key]
+		if !ok {
+			continue
+		}
+		if _, ok := alert.Annotations[key]; ok {
+			continue
+		}
+		if alert.Annotations == nil {
+			alert.Annotations = make(map[string]string)
+		}
+		alert.Annotations[key] = value
+	}
+}
+
+// recordReceipt logs the received alert for deduplication analytics.
+// Failures are logged and never fail ingestion.
+func (h *Handler) recordReceipt(ctx context.Context, alert *alertingv1.Alert, wasNew bool) {
+	if h.receiptStore == nil {
+		return
+	}
+
+	err := h.receiptStore.Record(ctx, &analytics.Receipt{
+		Fingerprint: alert.Fingerprint,
+		ServiceID:   alert.ServiceId,
+		WasNew:      wasNew,
+	})
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("fingerprint", alert.Fingerprint).Msg("failed to record alert receipt")
+	}
+}
+
+// forwardAlert starts forwarding the stored alert to matching forwarding rule destinations.
+// Failures are logged and never fail ingestion.
+func (h *Handler) forwardAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.forwarder == nil {
+		return
+	}
+
+	if err := h.forwarder.Forward(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("fingerprint", alert.Fingerprint).Msg("failed to forward alert")
+	}
+}
+
+// silenceAlert suppresses the alert if an active silence matches its labels.
+// Failures are logged and never fail ingestion.
+func (h *Handler) silenceAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.silencer == nil {
+		return
+	}
+
+	if _, err := h.silencer.Apply(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("fingerprint", alert.Fingerprint).Msg("failed to check silences")
+	}
+}
+
+// inhibitAlert suppresses the alert if an inhibition rule matches a triggered source alert.
+// Failures are logged and never fail ingestion.
+func (h *Handler) inhibitAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.inhibitor == nil {
+		return
+	}
+
+	if _, err := h.inhibitor.Apply(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("fingerprint", alert.Fingerprint).Msg("failed to check inhibition rules")
+	}
+}
+
+// setSLADeadline records the response deadline of a newly created alert from
+// the SLA of its customer's tier. Alerts without a customer, or whose tier has
+// no response time for their severity, get no deadline.
+// Failures are logged and never fail ingestion.
+func (h *Handler) setSLADeadline(ctx context.Context, alert *alertingv1.Alert) {
+	if h.customerStore == nil || h.tierStore == nil {
+		return
+	}
+
+	tier, err := customer.ResolveTier(ctx, h.customerStore, h.tierStore, alert.Labels)
+	if err != nil {
+		if !errors.Is(err, customer.ErrCustomerNotFound) {
+			h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to resolve customer tier for sla deadline")
+		}
+		return
+	}
+
+	triggeredAt := time.Now()
+	if alert.TriggeredAt != nil {
+		triggeredAt = alert.TriggeredAt.AsTime()
+	}
+	deadline, ok := tier.SLADeadline(alert.Severity, triggeredAt)
+	if !ok {
+		return
+	}
+
+	alert.SlaDeadline = timestamppb.New(deadline)
+	if _, err := h.alertStore.Update(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to store sla deadline")
+	}
+}
+
+// tagMaintenanceWindow records the active maintenance window covering a newly created alert.
+// Failures are logged and never fail ingestion.
+func (h *Handler) tagMaintenanceWindow(ctx context.Context, alert *alertingv1.Alert) {
+	if h.maintenanceStore == nil {
+		return
+	}
+
+	var siteIDs []string
+	if site := maintenance.SiteFromLabels(alert.Labels); site != "" {
+		siteIDs = []string{site}
+	}
+	var serviceIDs []string
+	if alert.ServiceId != "" {
+		serviceIDs = []string{alert.ServiceId}
+	}
+
+	windows, err := h.maintenanceStore.ListActive(ctx, siteIDs, serviceIDs)
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to list active maintenance windows")
+		return
+	}
+
+	// ListActive only filters by site and service; label scopes such as
+	// affected_label_patterns are matched against the alert here
+	scoped := &routingv1.Alert{Labels: alert.Labels, ServiceId: alert.ServiceId}
+	var window *routingv1.MaintenanceWindow
+	for _, candidate := range windows {
+		if maintenance.MatchesAlert(candidate, scoped) {
+			window = candidate
+			break
+		}
+	}
+	if window == nil {
+		return
+	}
+
+	alert.MaintenanceWindowId = window.Id
+	alert.MaintenanceWindowName = window.Name
+	h.metrics.RecordAlertDuringMaintenance(window.Id)
+
+	if _, err := h.alertStore.Update(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to store maintenance window metadata")
+	}
+}
+
+// correlateAlert annotates a newly created alert, and the recent alerts that
+// join its correlation group with it, with the group.
+// Failures are logged and never fail ingestion.
+func (h *Handler) correlateAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.correlator == nil || alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		return
+	}
+
+	since := time.Now().Add(-h.correlationWindow)
+
+	recent, err := h.alertStore.List(ctx, &alertingv1.ListAlertsRequest{
+		Statuses:       []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED},
+		TriggeredAfter: timestamppb.New(since),
+	})
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to list alerts for correlation")
+		return
+	}
+
+	group, _, err := h.correlator.CorrelateAlert(ctx, alert, recent.Alerts, h.correlationWindow)
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to correlate alerts")
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	// Annotate the alert and the recent alerts that joined the group with it
+	for _, member := range group.Alerts {
+		if member.Id != alert.Id && member.Annotations[correlation.AnnotationGroupID] == group.ID {
+			continue
+		}
+		if member.Annotations == nil {
+			member.Annotations = make(map[string]string)
+		}
+		member.Annotations[correlation.AnnotationGroupID] = group.ID
+		member.Annotations[correlation.AnnotationRootCause] = group.RootCause.Id
+		if _, err := h.alertStore.Update(ctx, member); err != nil {
+			h.requestLogger(ctx).Warn().Err(err).Str("alertId", member.Id).Msg("failed to store correlation metadata")
+		}
+	}
+}
+
+// groupAlert records the label group of a newly created alert as its group_id.
+// Failures are logged and never fail ingestion.
+func (h *Handler) groupAlert(ctx context.Context, alert *alertingv1.Alert) {
+	if h.labelCorrelator == nil {
+		return
+	}
+
+	group, err := h.labelCorrelator.Correlate(ctx, alert)
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to group alert")
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	alert.GroupId = group.ID
+	if _, err := h.alertStore.Update(ctx, alert); err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to store alert group")
+	}
 }
 
 // validateIntegrationKey validates the integration key and returns the associated service.
 // Returns the service if valid, or sends an error response and returns nil if invalid.
 func (h *Handler) validateIntegrationKey(c *gin.Context) *store.Service {
-	integrationKey := c.Param("integration_key")
+	return h.serviceForIntegrationKey(c, c.Param("integration_key"))
+}
+
+// withServiceTenant returns a copy of ctx scoped to the tenant of service.
+// Services without a tenant belong to the default tenant.
+func withServiceTenant(ctx context.Context, service *store.Service) context.Context {
+	if service.TenantID == "" {
+		return auth.WithTenant(ctx, auth.DefaultTenantID)
+	}
+	return auth.WithTenant(ctx, service.TenantID)
+}
+
+// serviceForIntegrationKey looks up the service of an integration key, responding
+// with 401 and returning nil if the key is missing or unknown. The rest of the
+// request runs in the tenant of the service.
+func (h *Handler) serviceForIntegrationKey(c *gin.Context, integrationKey string) *store.Service {
+	if value, ok := c.Get(rateLimitedServiceKey); ok {
+		if service, ok := value.(*store.Service); ok && service.IntegrationKey == integrationKey {
+			c.Request = c.Request.WithContext(withServiceTenant(c.Request.Context(), service))
+			return service
+		}
+	}
+
 	if integrationKey == "" {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error:   "unauthorized",
@@ -59,6 +655,7 @@ func (h *Handler) validateIntegrationKey(c *gin.Context) *store.Service {
 		return nil
 	}
 
+	c.Request = c.Request.WithContext(withServiceTenant(c.Request.Context(), service))
 	return service
 }
 
@@ -70,8 +667,10 @@ type ErrorResponse struct {
 
 // WebhookResponse represents a successful webhook response.
 type WebhookResponse struct {
-	Message   string   `json:"message"`
-	AlertIds  []string `json:"alertIds"`
-	Created   int      `json:"created"`
-	Updated   int      `json:"updated"`
+	Message  string   `json:"message"`
+	AlertIds []string `json:"alertIds"`
+	Created  int      `json:"created"`
+	Updated  int      `json:"updated"`
+	// RoutingDecisions is only set for requests with include_routing=true
+	RoutingDecisions []RoutingDecision `json:"routingDecisions,omitempty"`
 }
@@ -11,7 +11,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
 	"github.com/kneutral-org/alerting-system/internal/store"
 	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 )
@@ -129,6 +131,14 @@ func (m *mockServiceStore) GetByID(ctx context.Context, id string) (*store.Servi
 	return nil, nil
 }
 
+func (m *mockServiceStore) CanDeleteService(ctx context.Context, serviceID string) (*store.DeletionImpact, error) {
+	return &store.DeletionImpact{}, nil
+}
+
+func (m *mockServiceStore) Delete(ctx context.Context, serviceID string, force bool) error {
+	return nil
+}
+
 func setupTestHandler() (*Handler, *gin.Engine, *mockAlertStore, *mockServiceStore) {
 	gin.SetMode(gin.TestMode)
 
@@ -602,6 +612,11 @@ func TestExtractSeverity(t *testing.T) {
 		{"low", map[string]string{"severity": "low"}, alertingv1.Severity_SEVERITY_LOW},
 		{"info", map[string]string{"severity": "info"}, alertingv1.Severity_SEVERITY_INFO},
 		{"no severity", map[string]string{"alertname": "test"}, alertingv1.Severity_SEVERITY_MEDIUM},
+		{"victorops critical", map[string]string{"message_type": "CRITICAL"}, alertingv1.Severity_SEVERITY_CRITICAL},
+		{"victorops warning", map[string]string{"message_type": "WARNING"}, alertingv1.Severity_SEVERITY_HIGH},
+		{"victorops info", map[string]string{"message_type": "INFO"}, alertingv1.Severity_SEVERITY_INFO},
+		{"victorops recovery", map[string]string{"message_type": "RECOVERY"}, alertingv1.Severity_SEVERITY_MEDIUM},
+		{"severity over message type", map[string]string{"severity": "low", "message_type": "CRITICAL"}, alertingv1.Severity_SEVERITY_LOW},
 		{"empty labels", nil, alertingv1.Severity_SEVERITY_MEDIUM},
 	}
 
@@ -692,9 +707,9 @@ func TestGenerateGrafanaFingerprint(t *testing.T) {
 		Tags:   map[string]string{"env": "prod", "team": "platform"},
 	}
 
-	fp1 := generateGrafanaFingerprint(payload1)
-	fp2 := generateGrafanaFingerprint(payload2)
-	fp3 := generateGrafanaFingerprint(payload3)
+	fp1 := generateGrafanaFingerprint(SHA256Strategy{}, payload1)
+	fp2 := generateGrafanaFingerprint(SHA256Strategy{}, payload2)
+	fp3 := generateGrafanaFingerprint(SHA256Strategy{}, payload3)
 
 	// Same rule and tags should produce same fingerprint
 	if fp1 != fp2 {
@@ -706,3 +721,94 @@ func TestGenerateGrafanaFingerprint(t *testing.T) {
 		t.Errorf("fingerprints should differ for different rule IDs")
 	}
 }
+
+func TestGenericWebhook_Inhibition(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	ruleStore := inhibition.NewInMemoryStore()
+	_, _ = ruleStore.Create(context.Background(), &inhibition.InhibitionRule{
+		Name:           "node down inhibits warnings",
+		SourceMatchers: map[string]string{"alertname": "NodeDown"},
+		TargetMatchers: map[string]string{"severity": "warning"},
+		Equal:          []string{"instance"},
+		Enabled:        true,
+	})
+	inhibitor := inhibition.NewInhibitor(ruleStore, alertStore, zerolog.Nop(), nil)
+
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithInhibitor(inhibitor))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	post := func(payload GenericPayload) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	post(GenericPayload{
+		Summary:     "Node down",
+		Fingerprint: "fp-node-down",
+		Labels:      map[string]string{"alertname": "NodeDown", "instance": "node-1"},
+	})
+	post(GenericPayload{
+		Summary:     "High latency",
+		Fingerprint: "fp-latency",
+		Labels:      map[string]string{"alertname": "HighLatency", "severity": "warning", "instance": "node-1"},
+	})
+
+	source := alertStore.alertsByFP["fp-node-down"]
+	target := alertStore.alertsByFP["fp-latency"]
+	if source.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected source alert to stay triggered, got %v", source.Status)
+	}
+	if target.Status != alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED {
+		t.Errorf("expected target alert to be suppressed, got %v", target.Status)
+	}
+	if got := target.Annotations[inhibition.AnnotationSuppressionReason]; got != "inhibited_by:"+source.Id {
+		t.Errorf("expected suppression reason 'inhibited_by:%s', got '%s'", source.Id, got)
+	}
+	if got := inhibitor.Metrics().InhibitedAlertsTotal(); got != 1 {
+		t.Errorf("expected 1 inhibited alert, got %d", got)
+	}
+}
+
+// TestGenericWebhook_RepeatKeepsAcknowledgement tests that a repeat webhook for
+// an acknowledged alert keeps it acknowledged.
+func TestGenericWebhook_RepeatKeepsAcknowledgement(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+	payload := GenericPayload{Summary: "Disk full", Fingerprint: "fp-disk"}
+
+	postGenericAlert(t, router, payload)
+	acknowledgedAt := timestamppb.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	acked := alertStore.alertsByFP["fp-disk"]
+	acked.Status = alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED
+	acked.AcknowledgedBy = "user-1"
+	acked.AcknowledgedAt = acknowledgedAt
+	acked.Events = []*alertingv1.AlertEvent{{Type: alertingv1.AlertEventType_ALERT_EVENT_TYPE_ACKNOWLEDGED, ActorId: "user-1"}}
+
+	postGenericAlert(t, router, payload)
+
+	alert := alertStore.alertsByFP["fp-disk"]
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED {
+		t.Errorf("expected the alert to stay acknowledged, got %v", alert.Status)
+	}
+	if alert.AcknowledgedBy != "user-1" || !alert.AcknowledgedAt.AsTime().Equal(acknowledgedAt.AsTime()) {
+		t.Errorf("expected the acknowledgement to be kept, got %q at %v", alert.AcknowledgedBy, alert.AcknowledgedAt)
+	}
+	if len(alert.Events) != 1 {
+		t.Errorf("expected the alert events to be kept, got %d", len(alert.Events))
+	}
+
+	// Resolving the alert at the source still resolves it
+	payload.Status = "resolved"
+	postGenericAlert(t, router, payload)
+	if got := alertStore.alertsByFP["fp-disk"].Status; got != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected the alert to be resolved, got %v", got)
+	}
+}
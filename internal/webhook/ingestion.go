@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// newIngestionMetadata records where an alert was received. The endpoint is the
+// route path rather than the request path so integration keys are not stored.
+func newIngestionMetadata(format alertingv1.SourceFormat, endpoint string, body []byte) *alertingv1.IngestionMetadata {
+	sum := sha256.Sum256(body)
+	return &alertingv1.IngestionMetadata{
+		SourceFormat:    format,
+		IngestEndpoint:  endpoint,
+		IngestTimestamp: timestamppb.Now(),
+		RawPayloadHash:  hex.EncodeToString(sum[:]),
+	}
+}
+
+// requestIngestionMetadata returns the ingestion metadata of a webhook request
+// whose body was bound with ShouldBindBodyWithJSON.
+func requestIngestionMetadata(c *gin.Context, format alertingv1.SourceFormat) *alertingv1.IngestionMetadata {
+	var body []byte
+	if cached, ok := c.Get(gin.BodyBytesKey); ok {
+		body, _ = cached.([]byte)
+	}
+	return newIngestionMetadata(format, c.FullPath(), body)
+}
+
+// internalIngestionMetadata returns the ingestion metadata of an alert raised
+// without an HTTP request, hashing the JSON encoding of its source object.
+func internalIngestionMetadata(format alertingv1.SourceFormat, source any) *alertingv1.IngestionMetadata {
+	body, _ := json.Marshal(source)
+	return newIngestionMetadata(format, "", body)
+}
+
+// IngestMetadataResponse is the body of GET /alerts/:id/ingest-metadata.
+type IngestMetadataResponse struct {
+	AlertID         string    `json:"alertId"`
+	SourceFormat    string    `json:"sourceFormat"`
+	IngestEndpoint  string    `json:"ingestEndpoint,omitempty"`
+	IngestTimestamp time.Time `json:"ingestTimestamp"`
+	RawPayloadHash  string    `json:"rawPayloadHash"`
+}
+
+// GetIngestMetadata handles GET /api/v1/alerts/:id/ingest-metadata
+func (h *Handler) GetIngestMetadata(c *gin.Context) {
+	alertID := c.Param("id")
+
+	alert, err := h.alertStore.GetByID(c.Request.Context(), alertID)
+	if err != nil {
+		h.logger.Error().Err(err).Str("alertId", alertID).Msg("failed to get alert")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to get alert",
+		})
+		return
+	}
+	if alert == nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "notFound",
+			Message: "alert not found",
+		})
+		return
+	}
+
+	metadata := alert.IngestionMetadata
+	if metadata == nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "notFound",
+			Message: "alert has no ingestion metadata",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, IngestMetadataResponse{
+		AlertID:         alert.Id,
+		SourceFormat:    metadata.SourceFormat.String(),
+		IngestEndpoint:  metadata.IngestEndpoint,
+		IngestTimestamp: metadata.IngestTimestamp.AsTime(),
+		RawPayloadHash:  metadata.RawPayloadHash,
+	})
+}
@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestWebhooks_IngestionMetadata(t *testing.T) {
+	alertmanagerBody, _ := json.Marshal(AlertmanagerPayload{
+		Version: "4",
+		Status:  "firing",
+		Alerts: []AlertmanagerAlert{{
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "DiskFull"},
+			StartsAt:    time.Now(),
+			Fingerprint: "am-1",
+		}},
+	})
+	grafanaBody, _ := json.Marshal(GrafanaPayload{Title: "High CPU", RuleID: 7, State: "alerting"})
+	genericBody, _ := json.Marshal(GenericPayload{Summary: "Queue backlog"})
+
+	tests := []struct {
+		name     string
+		source   string
+		body     []byte
+		format   alertingv1.SourceFormat
+		endpoint string
+	}{
+		{"alertmanager", "alertmanager", alertmanagerBody, alertingv1.SourceFormat_SOURCE_FORMAT_ALERTMANAGER, "/api/v1/webhook/alertmanager/:integration_key"},
+		{"grafana", "grafana", grafanaBody, alertingv1.SourceFormat_SOURCE_FORMAT_GRAFANA, "/api/v1/webhook/grafana/:integration_key"},
+		{"generic", "generic", genericBody, alertingv1.SourceFormat_SOURCE_FORMAT_GENERIC, "/api/v1/webhook/generic/:integration_key"},
+		{"sentry", "sentry", sentryPayload("created", "error"), alertingv1.SourceFormat_SOURCE_FORMAT_SENTRY, "/api/v1/webhook/sentry/:integration_key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, _ := setupTestHandler()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/"+tt.source+"/valid-key", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			if len(alertStore.alerts) != 1 {
+				t.Fatalf("expected 1 alert, got %d", len(alertStore.alerts))
+			}
+
+			sum := sha256.Sum256(tt.body)
+			for _, alert := range alertStore.alerts {
+				metadata := alert.IngestionMetadata
+				if metadata == nil {
+					t.Fatal("expected ingestion metadata on the stored alert")
+				}
+				if metadata.SourceFormat != tt.format {
+					t.Errorf("expected source format %v, got %v", tt.format, metadata.SourceFormat)
+				}
+				if metadata.IngestEndpoint != tt.endpoint {
+					t.Errorf("expected endpoint %q, got %q", tt.endpoint, metadata.IngestEndpoint)
+				}
+				if metadata.RawPayloadHash != hex.EncodeToString(sum[:]) {
+					t.Errorf("expected raw payload hash of the request body, got %q", metadata.RawPayloadHash)
+				}
+				if metadata.IngestTimestamp == nil {
+					t.Error("expected ingest timestamp to be set")
+				}
+			}
+		})
+	}
+}
+
+func TestKubernetesEventWatcher_IngestionMetadata(t *testing.T) {
+	handler, _, alertStore, _ := setupTestHandler()
+	watcher := NewKubernetesEventWatcher(handler, fake.NewSimpleClientset(), KubernetesEventConfig{
+		Reasons:   DefaultKubernetesEventReasons,
+		ServiceID: "svc-123",
+	}, zerolog.Nop())
+
+	watcher.handleEvent(context.Background(), newTestKubernetesEvent("api.1", corev1.EventTypeWarning, "BackOff", time.Now()))
+
+	if len(alertStore.alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+	for _, alert := range alertStore.alerts {
+		if alert.IngestionMetadata.GetSourceFormat() != alertingv1.SourceFormat_SOURCE_FORMAT_KUBERNETES_EVENT {
+			t.Errorf("expected kubernetes event source format, got %v", alert.IngestionMetadata.GetSourceFormat())
+		}
+	}
+}
+
+func TestGetIngestMetadata(t *testing.T) {
+	handler, router, alertStore, _ := setupTestHandler()
+	handler.RegisterUserRoutes(router.Group("/api/v1"))
+
+	ingestedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	alertStore.alerts["alert-1"] = &alertingv1.Alert{
+		Id: "alert-1",
+		IngestionMetadata: &alertingv1.IngestionMetadata{
+			SourceFormat:    alertingv1.SourceFormat_SOURCE_FORMAT_GRAFANA,
+			IngestEndpoint:  "/api/v1/webhook/grafana/:integration_key",
+			IngestTimestamp: timestamppb.New(ingestedAt),
+			RawPayloadHash:  "abc123",
+		},
+	}
+	alertStore.alerts["alert-2"] = &alertingv1.Alert{Id: "alert-2"}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts/alert-1/ingest-metadata", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp IngestMetadataResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.SourceFormat != "SOURCE_FORMAT_GRAFANA" || resp.RawPayloadHash != "abc123" || !resp.IngestTimestamp.Equal(ingestedAt) {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	for _, id := range []string{"alert-2", "missing"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts/"+id+"/ingest-metadata", nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: expected status 404, got %d", id, w.Code)
+		}
+	}
+}
+
+func TestRegisterRoutes_ExcludesUserAndAdminRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	migrator := store.NewFingerprintMigrator(newMockAlertStore())
+	router := gin.New()
+	NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithFingerprintMigrator(migrator)).RegisterRoutes(router.Group("/api/v1"))
+
+	for _, path := range []string{"/api/v1/alerts/alert-1/ingest-metadata", "/api/v1/admin/fingerprint-migration-status"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("GET %s: expected status 404 on the webhook routes, got %d", path, w.Code)
+		}
+	}
+}
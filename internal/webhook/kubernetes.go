@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// DefaultKubernetesEventReasons are the Warning event reasons turned into alerts by default.
+var DefaultKubernetesEventReasons = []string{"OOMKilling", "BackOff", "FailedScheduling"}
+
+var (
+	// ErrKubernetesCacheSync is returned when the event informer cache fails to sync.
+	ErrKubernetesCacheSync = errors.New("failed to sync kubernetes event cache")
+	// ErrKubernetesServiceIDRequired is returned when the watcher has no service to raise alerts for.
+	ErrKubernetesServiceIDRequired = errors.New("kubernetes event watcher requires a service ID")
+)
+
+// KubernetesEventConfig configures the Kubernetes event watcher.
+type KubernetesEventConfig struct {
+	// Namespace restricts the watch to a single namespace. Empty watches all namespaces.
+	Namespace string
+	// Reasons is the blocklist of Warning event reasons that raise alerts.
+	Reasons []string
+	// ServiceID is the service that owns alerts raised from Kubernetes events.
+	ServiceID string
+	// ResyncPeriod is the informer resync period. Zero disables resyncs.
+	ResyncPeriod time.Duration
+}
+
+// KubernetesEventConfigFromEnv returns a configuration read from KUBERNETES_NAMESPACE,
+// KUBERNETES_EVENT_REASONS (comma-separated) and KUBERNETES_SERVICE_ID. Missing
+// reasons fall back to DefaultKubernetesEventReasons.
+func KubernetesEventConfigFromEnv() KubernetesEventConfig {
+	config := KubernetesEventConfig{
+		Namespace: os.Getenv("KUBERNETES_NAMESPACE"),
+		Reasons:   DefaultKubernetesEventReasons,
+		ServiceID: os.Getenv("KUBERNETES_SERVICE_ID"),
+	}
+
+	if raw := os.Getenv("KUBERNETES_EVENT_REASONS"); raw != "" {
+		var reasons []string
+		for _, reason := range strings.Split(raw, ",") {
+			if reason = strings.TrimSpace(reason); reason != "" {
+				reasons = append(reasons, reason)
+			}
+		}
+		if len(reasons) > 0 {
+			config.Reasons = reasons
+		}
+	}
+
+	return config
+}
+
+// KubernetesEventWatcher raises alerts from Kubernetes Warning events.
+type KubernetesEventWatcher struct {
+	handler   *Handler
+	client    kubernetes.Interface
+	config    KubernetesEventConfig
+	reasons   map[string]bool
+	startedAt time.Time
+	logger    zerolog.Logger
+}
+
+// NewKubernetesEventWatcher creates a watcher that feeds matching events into the handler.
+func NewKubernetesEventWatcher(handler *Handler, client kubernetes.Interface, config KubernetesEventConfig, logger zerolog.Logger) *KubernetesEventWatcher {
+	reasons := make(map[string]bool, len(config.Reasons))
+	for _, reason := range config.Reasons {
+		reasons[reason] = true
+	}
+
+	return &KubernetesEventWatcher{
+		handler: handler,
+		client:  client,
+		config:  config,
+		reasons: reasons,
+		logger:  logger.With().Str("component", "kubernetes_events").Logger(),
+	}
+}
+
+// Run watches events until the context is cancelled. It returns
+// ErrKubernetesServiceIDRequired without watching when ServiceID is unset.
+// Events last seen before Run was called are ignored so that the initial list
+// does not replay historical warnings.
+func (w *KubernetesEventWatcher) Run(ctx context.Context) error {
+	if w.config.ServiceID == "" {
+		return ErrKubernetesServiceIDRequired
+	}
+	w.startedAt = time.Now()
+
+	factory := informers.NewSharedInformerFactoryWithOptions(w.client, w.config.ResyncPeriod,
+		informers.WithNamespace(w.config.Namespace))
+	informer := factory.Core().V1().Events().Informer()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if event, ok := obj.(*corev1.Event); ok {
+				w.handleEvent(ctx, event)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEvent, _ := oldObj.(*corev1.Event)
+			event, ok := newObj.(*corev1.Event)
+			if !ok || (oldEvent != nil && oldEvent.ResourceVersion == event.ResourceVersion) {
+				return
+			}
+			w.handleEvent(ctx, event)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register event handler: %w", err)
+	}
+
+	factory.Start(ctx.Done())
+	defer factory.Shutdown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return ErrKubernetesCacheSync
+	}
+
+	w.logger.Info().
+		Str("namespace", w.config.Namespace).
+		Strs("reasons", w.config.Reasons).
+		Msg("watching kubernetes events")
+
+	<-ctx.Done()
+	return nil
+}
+
+// handleEvent raises an alert for Warning events whose reason is in the blocklist.
+func (w *KubernetesEventWatcher) handleEvent(ctx context.Context, event *corev1.Event) {
+	if event.Type != corev1.EventTypeWarning || !w.reasons[event.Reason] {
+		return
+	}
+
+	lastSeen := kubernetesEventTime(event)
+	if !w.startedAt.IsZero() && lastSeen.Before(w.startedAt.Truncate(time.Second)) {
+		return
+	}
+
+	payload := kubernetesEventPayload(event, lastSeen)
+
+	metadata := internalIngestionMetadata(alertingv1.SourceFormat_SOURCE_FORMAT_KUBERNETES_EVENT, event)
+	alert, _, err := w.handler.processGenericAlert(ctx, &store.Service{ID: w.config.ServiceID}, payload, metadata)
+	if err != nil {
+		w.logger.Error().
+			Err(err).
+			Str("namespace", event.Namespace).
+			Str("object", event.InvolvedObject.Name).
+			Str("reason", event.Reason).
+			Msg("failed to process kubernetes event")
+		return
+	}
+
+	w.handler.metrics.RecordKubernetesEventProcessed(event.Reason)
+
+	w.logger.Info().
+		Str("alertId", alert.Id).
+		Str("fingerprint", alert.Fingerprint).
+		Str("reason", event.Reason).
+		Msg("kubernetes event processed")
+}
+
+// kubernetesEventPayload converts a Kubernetes event into a generic webhook payload.
+func kubernetesEventPayload(event *corev1.Event, lastSeen time.Time) *GenericPayload {
+	object := event.InvolvedObject
+
+	labels := map[string]string{
+		"namespace": event.Namespace,
+		"kind":      object.Kind,
+		"name":      object.Name,
+		"reason":    event.Reason,
+	}
+	if event.Source.Component != "" {
+		labels["component"] = event.Source.Component
+	}
+	if event.Source.Host != "" {
+		labels["node"] = event.Source.Host
+	}
+
+	annotations := map[string]string{
+		"event_name": event.Name,
+		"count":      strconv.Itoa(int(event.Count)),
+	}
+
+	return &GenericPayload{
+		Summary:     fmt.Sprintf("%s: %s %s/%s", event.Reason, object.Kind, event.Namespace, object.Name),
+		Details:     event.Message,
+		Severity:    "warning",
+		Status:      "triggered",
+		Labels:      labels,
+		Annotations: annotations,
+		Fingerprint: generateKubernetesFingerprint(event.Namespace, object.Name, event.Reason),
+		Source:      "kubernetes",
+		Timestamp:   &lastSeen,
+	}
+}
+
+// kubernetesEventTime returns the most recent time the event was observed.
+func kubernetesEventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// generateKubernetesFingerprint derives a fingerprint from namespace/object_name/reason,
+// so repeated events for the same object and reason update a single alert.
+func generateKubernetesFingerprint(namespace, objectName, reason string) string {
+	data := fmt.Sprintf("kubernetes:%s/%s/%s", namespace, objectName, reason)
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:16])
+}
@@ -0,0 +1,182 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func newTestKubernetesEvent(name, eventType, reason string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "payments",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: "payments",
+			Name:      "api-7d9f",
+		},
+		Type:          eventType,
+		Reason:        reason,
+		Message:       "Back-off restarting failed container",
+		Count:         3,
+		Source:        corev1.EventSource{Component: "kubelet", Host: "node-1"},
+		LastTimestamp: metav1.NewTime(lastSeen),
+	}
+}
+
+func TestKubernetesEventWatcher_HandleEvent(t *testing.T) {
+	handler, _, alertStore, _ := setupTestHandler()
+	config := KubernetesEventConfig{
+		Namespace: "payments",
+		Reasons:   DefaultKubernetesEventReasons,
+		ServiceID: "svc-123",
+	}
+	watcher := NewKubernetesEventWatcher(handler, fake.NewSimpleClientset(), config, zerolog.Nop())
+
+	watcher.handleEvent(context.Background(), newTestKubernetesEvent("api.1", corev1.EventTypeWarning, "BackOff", time.Now()))
+
+	fingerprint := generateKubernetesFingerprint("payments", "api-7d9f", "BackOff")
+	alert, ok := alertStore.alertsByFP[fingerprint]
+	if !ok {
+		t.Fatalf("expected alert with fingerprint %s", fingerprint)
+	}
+	if alert.ServiceId != "svc-123" {
+		t.Errorf("expected service svc-123, got %s", alert.ServiceId)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_HIGH {
+		t.Errorf("expected severity HIGH, got %v", alert.Severity)
+	}
+	if alert.Labels["reason"] != "BackOff" || alert.Labels["namespace"] != "payments" || alert.Labels["name"] != "api-7d9f" {
+		t.Errorf("unexpected labels: %v", alert.Labels)
+	}
+	if alert.Details != "Back-off restarting failed container" {
+		t.Errorf("unexpected details: %s", alert.Details)
+	}
+	if got := handler.Metrics().KubernetesEventsProcessedTotal("BackOff"); got != 1 {
+		t.Errorf("expected 1 processed BackOff event, got %d", got)
+	}
+
+	// A repeated event for the same object and reason updates the same alert
+	watcher.handleEvent(context.Background(), newTestKubernetesEvent("api.2", corev1.EventTypeWarning, "BackOff", time.Now()))
+	if len(alertStore.alerts) != 1 {
+		t.Errorf("expected 1 alert after repeated event, got %d", len(alertStore.alerts))
+	}
+	if got := handler.Metrics().KubernetesEventsProcessedTotal("BackOff"); got != 2 {
+		t.Errorf("expected 2 processed BackOff events, got %d", got)
+	}
+}
+
+func TestKubernetesEventWatcher_IgnoresUnmatchedEvents(t *testing.T) {
+	handler, _, alertStore, _ := setupTestHandler()
+	config := KubernetesEventConfig{Reasons: []string{"OOMKilling"}, ServiceID: "svc-123"}
+	watcher := NewKubernetesEventWatcher(handler, fake.NewSimpleClientset(), config, zerolog.Nop())
+
+	tests := []struct {
+		name  string
+		event *corev1.Event
+	}{
+		{"normal event", newTestKubernetesEvent("e1", corev1.EventTypeNormal, "OOMKilling", time.Now())},
+		{"reason not in blocklist", newTestKubernetesEvent("e2", corev1.EventTypeWarning, "BackOff", time.Now())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher.handleEvent(context.Background(), tt.event)
+		})
+	}
+
+	if len(alertStore.alerts) != 0 {
+		t.Errorf("expected no alerts, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestKubernetesEventWatcher_Run(t *testing.T) {
+	handler, _, alertStore, _ := setupTestHandler()
+
+	// Events seen before the watcher starts are not replayed
+	stale := newTestKubernetesEvent("stale", corev1.EventTypeWarning, "OOMKilling", time.Now().Add(-time.Hour))
+	client := fake.NewSimpleClientset(stale)
+
+	config := KubernetesEventConfig{
+		Namespace: "payments",
+		Reasons:   DefaultKubernetesEventReasons,
+		ServiceID: "svc-123",
+	}
+	watcher := NewKubernetesEventWatcher(handler, client, config, zerolog.Nop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	// Wait for the watch to be established before creating the event
+	time.Sleep(100 * time.Millisecond)
+
+	event := newTestKubernetesEvent("fresh", corev1.EventTypeWarning, "FailedScheduling", time.Now().Add(time.Second))
+	if _, err := client.CoreV1().Events("payments").Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for handler.Metrics().KubernetesEventsProcessedTotal("FailedScheduling") == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for kubernetes event to be processed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error from Run: %v", err)
+	}
+
+	if got := handler.Metrics().KubernetesEventsProcessedTotal("OOMKilling"); got != 0 {
+		t.Errorf("expected stale event to be ignored, got %d processed", got)
+	}
+	if len(alertStore.alerts) != 1 {
+		t.Errorf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestKubernetesEventWatcher_RunRequiresServiceID(t *testing.T) {
+	handler, _, _, _ := setupTestHandler()
+	watcher := NewKubernetesEventWatcher(handler, fake.NewSimpleClientset(), KubernetesEventConfig{Reasons: DefaultKubernetesEventReasons}, zerolog.Nop())
+
+	if err := watcher.Run(context.Background()); !errors.Is(err, ErrKubernetesServiceIDRequired) {
+		t.Errorf("expected ErrKubernetesServiceIDRequired, got %v", err)
+	}
+}
+
+func TestKubernetesEventConfigFromEnv(t *testing.T) {
+	t.Setenv("KUBERNETES_NAMESPACE", "payments")
+	t.Setenv("KUBERNETES_EVENT_REASONS", "OOMKilling, Evicted,")
+	t.Setenv("KUBERNETES_SERVICE_ID", "svc-k8s")
+
+	config := KubernetesEventConfigFromEnv()
+	if config.Namespace != "payments" {
+		t.Errorf("expected namespace payments, got %s", config.Namespace)
+	}
+	if config.ServiceID != "svc-k8s" {
+		t.Errorf("expected service svc-k8s, got %s", config.ServiceID)
+	}
+	if len(config.Reasons) != 2 || config.Reasons[0] != "OOMKilling" || config.Reasons[1] != "Evicted" {
+		t.Errorf("unexpected reasons: %v", config.Reasons)
+	}
+
+	t.Setenv("KUBERNETES_EVENT_REASONS", "")
+	config = KubernetesEventConfigFromEnv()
+	if len(config.Reasons) != len(DefaultKubernetesEventReasons) {
+		t.Errorf("expected default reasons, got %v", config.Reasons)
+	}
+}
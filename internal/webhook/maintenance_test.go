@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/maintenance"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+// mockMaintenanceStore implements maintenance.Store, returning fixed active windows.
+type mockMaintenanceStore struct {
+	active         []*routingv1.MaintenanceWindow
+	lastSiteIDs    []string
+	lastServiceIDs []string
+}
+
+func (m *mockMaintenanceStore) Create(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	return window, nil
+}
+
+func (m *mockMaintenanceStore) Get(ctx context.Context, id string) (*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (m *mockMaintenanceStore) List(ctx context.Context, req *routingv1.ListMaintenanceWindowsRequest) (*routingv1.ListMaintenanceWindowsResponse, error) {
+	return &routingv1.ListMaintenanceWindowsResponse{}, nil
+}
+
+func (m *mockMaintenanceStore) Update(ctx context.Context, window *routingv1.MaintenanceWindow) (*routingv1.MaintenanceWindow, error) {
+	return window, nil
+}
+
+func (m *mockMaintenanceStore) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockMaintenanceStore) ListActive(ctx context.Context, siteIDs, serviceIDs []string) ([]*routingv1.MaintenanceWindow, error) {
+	m.lastSiteIDs = siteIDs
+	m.lastServiceIDs = serviceIDs
+	return m.active, nil
+}
+
+func (m *mockMaintenanceStore) ListUpcoming(ctx context.Context, duration time.Duration) ([]*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (m *mockMaintenanceStore) UpdateStatus(ctx context.Context, id string, status routingv1.MaintenanceStatus) error {
+	return nil
+}
+
+func (m *mockMaintenanceStore) TransitionStatuses(ctx context.Context) ([]maintenance.StatusTransition, error) {
+	return nil, nil
+}
+
+func (m *mockMaintenanceStore) ExpandMaintenanceWindow(ctx context.Context, windowID string, additionalSites, additionalServices []string, extendBy time.Duration) (*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func (m *mockMaintenanceStore) ApproveMaintenanceWindow(ctx context.Context, windowID, approvedBy string) (*routingv1.MaintenanceWindow, error) {
+	return nil, nil
+}
+
+func setupMaintenanceTestHandler(maintenanceStore *mockMaintenanceStore) (*Handler, *gin.Engine, *mockAlertStore) {
+	gin.SetMode(gin.TestMode)
+
+	alertStore := newMockAlertStore()
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithMaintenanceStore(maintenanceStore))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	return handler, router, alertStore
+}
+
+func postGenericAlert(t *testing.T, router *gin.Engine, payload GenericPayload) {
+	t.Helper()
+	body, _ := json.Marshal(payload)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestIngestAlert_DuringMaintenanceWindow(t *testing.T) {
+	maintenanceStore := &mockMaintenanceStore{
+		active: []*routingv1.MaintenanceWindow{
+			{Id: "mw-1", Name: "NYC-DC1 network upgrade"},
+		},
+	}
+	handler, router, alertStore := setupMaintenanceTestHandler(maintenanceStore)
+
+	postGenericAlert(t, router, GenericPayload{
+		Summary:     "Link down",
+		Fingerprint: "fp-link-down",
+		Labels:      map[string]string{"site": "NYC-DC1"},
+	})
+
+	alert := alertStore.alertsByFP["fp-link-down"]
+	if alert.MaintenanceWindowId != "mw-1" {
+		t.Errorf("expected maintenance window id 'mw-1', got '%s'", alert.MaintenanceWindowId)
+	}
+	if alert.MaintenanceWindowName != "NYC-DC1 network upgrade" {
+		t.Errorf("expected maintenance window name, got '%s'", alert.MaintenanceWindowName)
+	}
+	if len(maintenanceStore.lastSiteIDs) != 1 || maintenanceStore.lastSiteIDs[0] != "NYC-DC1" {
+		t.Errorf("expected site filter [NYC-DC1], got %v", maintenanceStore.lastSiteIDs)
+	}
+	if len(maintenanceStore.lastServiceIDs) != 1 || maintenanceStore.lastServiceIDs[0] != "svc-123" {
+		t.Errorf("expected service filter [svc-123], got %v", maintenanceStore.lastServiceIDs)
+	}
+	if got := handler.Metrics().AlertsDuringMaintenanceTotal("mw-1"); got != 1 {
+		t.Errorf("expected 1 alert during maintenance, got %d", got)
+	}
+}
+
+func TestIngestAlert_OutsideMaintenanceWindow(t *testing.T) {
+	handler, router, alertStore := setupMaintenanceTestHandler(&mockMaintenanceStore{})
+
+	postGenericAlert(t, router, GenericPayload{
+		Summary:     "Link down",
+		Fingerprint: "fp-link-down",
+		Labels:      map[string]string{"site": "NYC-DC1"},
+	})
+
+	alert := alertStore.alertsByFP["fp-link-down"]
+	if alert.MaintenanceWindowId != "" || alert.MaintenanceWindowName != "" {
+		t.Errorf("expected no maintenance window, got '%s' (%s)", alert.MaintenanceWindowId, alert.MaintenanceWindowName)
+	}
+	if got := handler.Metrics().AlertsDuringMaintenanceTotal("mw-1"); got != 0 {
+		t.Errorf("expected 0 alerts during maintenance, got %d", got)
+	}
+}
+
+func TestIngestAlert_MaintenanceWindowLabelPatterns(t *testing.T) {
+	maintenanceStore := &mockMaintenanceStore{
+		active: []*routingv1.MaintenanceWindow{
+			{Id: "mw-db", Name: "Database patching", AffectedLabelPatterns: []string{"app=db-.*"}},
+			{Id: "mw-frontend", Name: "Frontend deploy", AffectedLabelPatterns: []string{"app=frontend-.*"}},
+		},
+	}
+	_, router, alertStore := setupMaintenanceTestHandler(maintenanceStore)
+
+	postGenericAlert(t, router, GenericPayload{
+		Summary:     "High latency",
+		Fingerprint: "fp-frontend",
+		Labels:      map[string]string{"app": "frontend-web"},
+	})
+	postGenericAlert(t, router, GenericPayload{
+		Summary:     "High latency",
+		Fingerprint: "fp-api",
+		Labels:      map[string]string{"app": "api"},
+	})
+
+	if got := alertStore.alertsByFP["fp-frontend"].MaintenanceWindowId; got != "mw-frontend" {
+		t.Errorf("expected maintenance window id 'mw-frontend', got '%s'", got)
+	}
+	if got := alertStore.alertsByFP["fp-api"].MaintenanceWindowId; got != "" {
+		t.Errorf("expected no maintenance window, got '%s'", got)
+	}
+}
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"sync"
+)
+
+// Metrics tracks alert ingestion metrics.
+// Exposed as the kubernetes_events_processed_total{reason},
+// alerts_during_maintenance_total{window_id},
+// webhook_validation_failures_total{service_id, reason},
+// alert_quota_exceeded_total{service_id},
+// label_validation_errors_total{service_id, label_key} and
+// webhook_rate_limited_total{integration_key} counters.
+type Metrics struct {
+	mu sync.RWMutex
+
+	// kubernetesEventsProcessed counts Kubernetes events turned into alerts, by reason.
+	kubernetesEventsProcessed map[string]int64
+	// alertsDuringMaintenance counts alerts created during a maintenance window, by window ID.
+	alertsDuringMaintenance map[string]int64
+	// validationFailures counts rejected webhook payloads, by service ID and reason.
+	validationFailures map[validationFailureKey]int64
+	// alertQuotaExceeded counts alerts rejected by the hourly quota, by service ID.
+	alertQuotaExceeded map[string]int64
+	// labelValidationErrors counts label schema violations, by service ID and label key.
+	labelValidationErrors map[labelValidationErrorKey]int64
+	// webhookRateLimited counts requests rejected by the rate limiter, by integration key.
+	webhookRateLimited map[string]int64
+}
+
+type validationFailureKey struct {
+	serviceID string
+	reason    string
+}
+
+type labelValidationErrorKey struct {
+	serviceID string
+	labelKey  string
+}
+
+// NewMetrics creates a new Metrics instance.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		kubernetesEventsProcessed: make(map[string]int64),
+		alertsDuringMaintenance:   make(map[string]int64),
+		validationFailures:        make(map[validationFailureKey]int64),
+		alertQuotaExceeded:        make(map[string]int64),
+		labelValidationErrors:     make(map[labelValidationErrorKey]int64),
+		webhookRateLimited:        make(map[string]int64),
+	}
+}
+
+// RecordKubernetesEventProcessed increments the processed counter for an event reason.
+func (m *Metrics) RecordKubernetesEventProcessed(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.kubernetesEventsProcessed[reason]++
+}
+
+// KubernetesEventsProcessedTotal returns the number of processed events for a reason.
+func (m *Metrics) KubernetesEventsProcessedTotal(reason string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.kubernetesEventsProcessed[reason]
+}
+
+// RecordAlertDuringMaintenance increments the counter for alerts created during a window.
+func (m *Metrics) RecordAlertDuringMaintenance(windowID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alertsDuringMaintenance[windowID]++
+}
+
+// AlertsDuringMaintenanceTotal returns the number of alerts created during a window.
+func (m *Metrics) AlertsDuringMaintenanceTotal(windowID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.alertsDuringMaintenance[windowID]
+}
+
+// RecordValidationFailure increments the validation failure counter for a service and reason.
+func (m *Metrics) RecordValidationFailure(serviceID, reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.validationFailures[validationFailureKey{serviceID: serviceID, reason: reason}]++
+}
+
+// ValidationFailuresTotal returns the number of validation failures for a service and reason.
+func (m *Metrics) ValidationFailuresTotal(serviceID, reason string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.validationFailures[validationFailureKey{serviceID: serviceID, reason: reason}]
+}
+
+// RecordAlertQuotaExceeded increments the quota exceeded counter for a service.
+func (m *Metrics) RecordAlertQuotaExceeded(serviceID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alertQuotaExceeded[serviceID]++
+}
+
+// AlertQuotaExceededTotal returns the number of alerts rejected by a service's quota.
+func (m *Metrics) AlertQuotaExceededTotal(serviceID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.alertQuotaExceeded[serviceID]
+}
+
+// RecordLabelValidationError increments the label schema violation counter for a service and label key.
+func (m *Metrics) RecordLabelValidationError(serviceID, labelKey string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.labelValidationErrors[labelValidationErrorKey{serviceID: serviceID, labelKey: labelKey}]++
+}
+
+// LabelValidationErrorsTotal returns the number of label schema violations for a service and label key.
+func (m *Metrics) LabelValidationErrorsTotal(serviceID, labelKey string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.labelValidationErrors[labelValidationErrorKey{serviceID: serviceID, labelKey: labelKey}]
+}
+
+// RecordWebhookRateLimited increments the rate limited counter for an integration key.
+func (m *Metrics) RecordWebhookRateLimited(integrationKey string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.webhookRateLimited[integrationKey]++
+}
+
+// WebhookRateLimitedTotal returns the number of requests rejected by an integration key's rate limit.
+func (m *Metrics) WebhookRateLimitedTotal(integrationKey string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.webhookRateLimited[integrationKey]
+}
+
+// Reset clears all recorded metrics.
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.kubernetesEventsProcessed = make(map[string]int64)
+	m.alertsDuringMaintenance = make(map[string]int64)
+	m.validationFailures = make(map[validationFailureKey]int64)
+	m.alertQuotaExceeded = make(map[string]int64)
+	m.labelValidationErrors = make(map[labelValidationErrorKey]int64)
+	m.webhookRateLimited = make(map[string]int64)
+}
@@ -0,0 +1,201 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// New Relic issue states.
+const (
+	NewRelicStateActivated = "ACTIVATED"
+	NewRelicStateClosed    = "CLOSED"
+)
+
+// NewRelicPayload represents the issue webhook payload from New Relic Alerts.
+type NewRelicPayload struct {
+	IssueID          string   `json:"issueId"`
+	ConditionName    string   `json:"conditionName"`
+	PolicyName       string   `json:"policyName"`
+	Priority         string   `json:"priority"`
+	State            string   `json:"state"`
+	ImpactedEntities []string `json:"impactedEntities,omitempty"`
+	DeepLinkURL      string   `json:"deepLinkUrl,omitempty"`
+}
+
+// NewRelicWebhook handles POST /api/v1/webhook/newrelic/:integration_key
+func (h *Handler) NewRelicWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	// Parse payload
+	var payload NewRelicPayload
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse new relic payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid new relic payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if payload.IssueID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "issueId is required",
+		})
+		return
+	}
+
+	status, ok := mapNewRelicState(payload.State)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported new relic state: " + payload.State,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("issueId", payload.IssueID).
+		Str("state", payload.State).
+		Msg("processing new relic webhook")
+
+	alert, wasCreated, err := h.processNewRelicIssue(c, service, &payload, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("issueId", payload.IssueID).
+			Msg("failed to process new relic issue")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processNewRelicIssue(c *gin.Context, service *store.Service, payload *NewRelicPayload, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	labels := map[string]string{
+		"issueId":       payload.IssueID,
+		"conditionName": payload.ConditionName,
+		"policyName":    payload.PolicyName,
+		"priority":      payload.Priority,
+	}
+
+	annotations := make(map[string]string)
+	if payload.DeepLinkURL != "" {
+		annotations["deepLinkUrl"] = payload.DeepLinkURL
+	}
+	if len(payload.ImpactedEntities) > 0 {
+		annotations["impactedEntities"] = strings.Join(payload.ImpactedEntities, ",")
+	}
+
+	impactedEntities := make([]interface{}, len(payload.ImpactedEntities))
+	for i, entity := range payload.ImpactedEntities {
+		impactedEntities[i] = entity
+	}
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"issueId":          payload.IssueID,
+		"conditionName":    payload.ConditionName,
+		"policyName":       payload.PolicyName,
+		"priority":         payload.Priority,
+		"state":            payload.State,
+		"impactedEntities": impactedEntities,
+		"deepLinkUrl":      payload.DeepLinkURL,
+	})
+
+	summary := payload.ConditionName
+	if summary == "" {
+		summary = "New Relic issue " + payload.IssueID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: generateNewRelicFingerprint(service.ID, payload.IssueID),
+		Summary:     summary,
+		Details:     h.renderBody(service, bodySourceNewRelic, payload),
+		Severity:    mapNewRelicPriority(payload.Priority),
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_NEW_RELIC,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: annotations,
+		Status:      status,
+		TriggeredAt: timestamppb.New(time.Now()),
+		RawPayload:  rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_NEW_RELIC),
+	}
+
+	if status == alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		alert.ResolvedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// mapNewRelicState maps a New Relic issue state to an alert status.
+func mapNewRelicState(state string) (alertingv1.AlertStatus, bool) {
+	switch state {
+	case NewRelicStateActivated:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case NewRelicStateClosed:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+func mapNewRelicPriority(priority string) alertingv1.Severity {
+	switch priority {
+	case "CRITICAL":
+		return alertingv1.Severity_SEVERITY_CRITICAL
+	case "HIGH":
+		return alertingv1.Severity_SEVERITY_HIGH
+	case "MEDIUM":
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	case "LOW":
+		return alertingv1.Severity_SEVERITY_LOW
+	default:
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	}
+}
+
+func generateNewRelicFingerprint(serviceID, issueID string) string {
+	// New Relic already groups incidents into issues, so the issue ID
+	// identifies the alert within the service
+	return SHA256Strategy{}.Compute(nil, "newrelic", serviceID, issueID)
+}
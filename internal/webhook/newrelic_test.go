@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func newRelicPayload(state, priority string) []byte {
+	body, _ := json.Marshal(NewRelicPayload{
+		IssueID:          "f8b1c4e2-7d3a-4b6e-9c1f-2a5d8e0b3c71",
+		ConditionName:    "Error rate above 5%",
+		PolicyName:       "checkout-service",
+		Priority:         priority,
+		State:            state,
+		ImpactedEntities: []string{"checkout-api", "checkout-worker"},
+		DeepLinkURL:      "https://one.newrelic.com/alerts-ai/issues/f8b1c4e2",
+	})
+	return body
+}
+
+func postNewRelic(router *gin.Engine, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/newrelic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestNewRelicWebhook_Activated(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postNewRelic(router, newRelicPayload(NewRelicStateActivated, "CRITICAL"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP[generateNewRelicFingerprint("svc-123", "f8b1c4e2-7d3a-4b6e-9c1f-2a5d8e0b3c71")]
+	if alert == nil {
+		t.Fatal("expected the issue id to be used as the fingerprint")
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_NEW_RELIC {
+		t.Errorf("expected source NEW_RELIC, got %v", alert.Source)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_CRITICAL {
+		t.Errorf("expected severity CRITICAL, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Summary != "Error rate above 5%" {
+		t.Errorf("expected the condition name as summary, got %q", alert.Summary)
+	}
+	if alert.Details != "checkout-api, checkout-worker" {
+		t.Errorf("expected the impacted entities as details, got %q", alert.Details)
+	}
+	if alert.Labels["policyName"] != "checkout-service" {
+		t.Errorf("expected policyName label 'checkout-service', got '%s'", alert.Labels["policyName"])
+	}
+	if alert.Annotations["deepLinkUrl"] != "https://one.newrelic.com/alerts-ai/issues/f8b1c4e2" {
+		t.Errorf("expected deepLinkUrl annotation, got '%s'", alert.Annotations["deepLinkUrl"])
+	}
+	if alert.IngestionMetadata.GetSourceFormat() != alertingv1.SourceFormat_SOURCE_FORMAT_NEW_RELIC {
+		t.Errorf("expected source format NEW_RELIC, got %v", alert.IngestionMetadata.GetSourceFormat())
+	}
+}
+
+func TestNewRelicWebhook_StatusTransitionDeduplication(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	steps := []struct {
+		state          string
+		expectedStatus alertingv1.AlertStatus
+		expectCreated  bool
+	}{
+		{NewRelicStateActivated, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true},
+		{NewRelicStateActivated, alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, false},
+		{NewRelicStateClosed, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, false},
+	}
+
+	for i, step := range steps {
+		w := postNewRelic(router, newRelicPayload(step.state, "HIGH"))
+		if w.Code != http.StatusOK {
+			t.Fatalf("step %d: expected status 200, got %d: %s", i, w.Code, w.Body.String())
+		}
+
+		var resp WebhookResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("step %d: failed to parse response: %v", i, err)
+		}
+		if (resp.Created == 1) != step.expectCreated {
+			t.Errorf("step %d: expected created=%v, got created=%d updated=%d", i, step.expectCreated, resp.Created, resp.Updated)
+		}
+
+		if len(alertStore.alerts) != 1 {
+			t.Fatalf("step %d: expected 1 alert, got %d", i, len(alertStore.alerts))
+		}
+		alert := alertStore.alertsByFP[generateNewRelicFingerprint("svc-123", "f8b1c4e2-7d3a-4b6e-9c1f-2a5d8e0b3c71")]
+		if alert.Status != step.expectedStatus {
+			t.Errorf("step %d: expected status %v, got %v", i, step.expectedStatus, alert.Status)
+		}
+	}
+
+	if alertStore.alertsByFP[generateNewRelicFingerprint("svc-123", "f8b1c4e2-7d3a-4b6e-9c1f-2a5d8e0b3c71")].ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestNewRelicWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"issueId":`)},
+		{name: "missing issue id", body: []byte(`{"state":"ACTIVATED","conditionName":"boom"}`)},
+		{name: "unsupported state", body: newRelicPayload("ACKNOWLEDGED", "HIGH")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, _ := setupTestHandler()
+
+			w := postNewRelic(router, tt.body)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+			if len(alertStore.alerts) != 0 {
+				t.Errorf("expected no alerts, got %d", len(alertStore.alerts))
+			}
+		})
+	}
+}
+
+func TestNewRelicWebhook_InvalidIntegrationKey(t *testing.T) {
+	_, router, _, _ := setupTestHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/newrelic/invalid-key", bytes.NewReader(newRelicPayload(NewRelicStateActivated, "LOW")))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", w.Code)
+	}
+}
+
+func TestMapNewRelicPriority(t *testing.T) {
+	tests := []struct {
+		priority string
+		expected alertingv1.Severity
+	}{
+		{"CRITICAL", alertingv1.Severity_SEVERITY_CRITICAL},
+		{"HIGH", alertingv1.Severity_SEVERITY_HIGH},
+		{"MEDIUM", alertingv1.Severity_SEVERITY_MEDIUM},
+		{"LOW", alertingv1.Severity_SEVERITY_LOW},
+		{"", alertingv1.Severity_SEVERITY_MEDIUM},
+	}
+
+	for _, tt := range tests {
+		if got := mapNewRelicPriority(tt.priority); got != tt.expected {
+			t.Errorf("mapNewRelicPriority(%q) = %v, expected %v", tt.priority, got, tt.expected)
+		}
+	}
+}
+
+func TestGenerateNewRelicFingerprint_ScopedToService(t *testing.T) {
+	if generateNewRelicFingerprint("svc-1", "issue-1") == generateNewRelicFingerprint("svc-2", "issue-1") {
+		t.Error("expected the same issue id of different services to have different fingerprints")
+	}
+	if generateNewRelicFingerprint("svc-1", "issue-1") == "issue-1" {
+		t.Error("expected the issue id to be hashed")
+	}
+}
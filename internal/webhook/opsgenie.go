@@ -0,0 +1,214 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// Opsgenie alert webhook actions.
+const (
+	OpsgenieActionCreate      = "Create"
+	OpsgenieActionAcknowledge = "Acknowledge"
+	OpsgenieActionClose       = "Close"
+)
+
+// OpsgeniePayload represents the alert webhook payload from Opsgenie.
+type OpsgeniePayload struct {
+	Action string        `json:"action"`
+	Alert  OpsgenieAlert `json:"alert"`
+}
+
+// OpsgenieAlert represents an Opsgenie alert.
+type OpsgenieAlert struct {
+	AlertID     string   `json:"alertId"`
+	Message     string   `json:"message"`
+	Description string   `json:"description,omitempty"`
+	Priority    string   `json:"priority"`
+	Tags        []string `json:"tags,omitempty"`
+	Source      string   `json:"source,omitempty"`
+}
+
+// OpsgenieWebhook handles POST /api/v1/webhook/opsgenie/:integration_key
+func (h *Handler) OpsgenieWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	// Parse payload
+	var payload OpsgeniePayload
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse opsgenie payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid opsgenie payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if payload.Alert.AlertID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "alert id is required",
+		})
+		return
+	}
+
+	status, ok := mapOpsgenieAction(payload.Action)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported opsgenie action: " + payload.Action,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("alertId", payload.Alert.AlertID).
+		Str("action", payload.Action).
+		Msg("processing opsgenie webhook")
+
+	alert, wasCreated, err := h.processOpsgenieAlert(c, service, &payload, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("alertId", payload.Alert.AlertID).
+			Msg("failed to process opsgenie alert")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processOpsgenieAlert(c *gin.Context, service *store.Service, payload *OpsgeniePayload, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	og := payload.Alert
+
+	labels := map[string]string{
+		"alertId":  og.AlertID,
+		"priority": og.Priority,
+	}
+	if og.Source != "" {
+		labels["source"] = og.Source
+	}
+
+	annotations := make(map[string]string)
+	if len(og.Tags) > 0 {
+		annotations["tags"] = strings.Join(og.Tags, ",")
+	}
+
+	tags := make([]interface{}, len(og.Tags))
+	for i, tag := range og.Tags {
+		tags[i] = tag
+	}
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"action":   payload.Action,
+		"alertId":  og.AlertID,
+		"message":  og.Message,
+		"priority": og.Priority,
+		"tags":     tags,
+		"source":   og.Source,
+	})
+
+	summary := og.Message
+	if summary == "" {
+		summary = "Opsgenie alert " + og.AlertID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint:    generateOpsgenieFingerprint(og.AlertID),
+		Summary:        summary,
+		Details:        h.renderBody(service, bodySourceOpsgenie, payload),
+		Severity:       mapOpsgeniePriority(og.Priority),
+		Source:         alertingv1.AlertSource_ALERT_SOURCE_OPSGENIE,
+		SourceInstance: og.Source,
+		SourceAlertId:  og.AlertID,
+		ServiceId:      service.ID,
+		Labels:         labels,
+		Annotations:    annotations,
+		Status:         status,
+		TriggeredAt:    timestamppb.New(time.Now()),
+		RawPayload:     rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_OPSGENIE),
+	}
+
+	switch status {
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		alert.ResolvedAt = timestamppb.Now()
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		alert.AcknowledgedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// mapOpsgenieAction maps an Opsgenie alert action to an alert status.
+func mapOpsgenieAction(action string) (alertingv1.AlertStatus, bool) {
+	switch action {
+	case OpsgenieActionCreate:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case OpsgenieActionAcknowledge:
+		return alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, true
+	case OpsgenieActionClose:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+func mapOpsgeniePriority(priority string) alertingv1.Severity {
+	switch priority {
+	case "P1":
+		return alertingv1.Severity_SEVERITY_CRITICAL
+	case "P2":
+		return alertingv1.Severity_SEVERITY_HIGH
+	case "P3":
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	case "P4":
+		return alertingv1.Severity_SEVERITY_LOW
+	case "P5":
+		return alertingv1.Severity_SEVERITY_INFO
+	default:
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	}
+}
+
+func generateOpsgenieFingerprint(alertID string) string {
+	// Opsgenie keeps the alertId across every action on an alert
+	return SHA256Strategy{}.Compute(nil, "opsgenie", alertID)
+}
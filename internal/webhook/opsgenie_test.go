@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func opsgeniePayload(action, priority string) []byte {
+	body, _ := json.Marshal(OpsgeniePayload{
+		Action: action,
+		Alert: OpsgenieAlert{
+			AlertID:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			Message:     "Disk usage above 90% on db-1",
+			Description: "/var/lib/postgresql is 93% full",
+			Priority:    priority,
+			Tags:        []string{"database", "disk"},
+			Source:      "zabbix",
+		},
+	})
+	return body
+}
+
+func postOpsgenie(router *gin.Engine, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/opsgenie/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestOpsgenieWebhook_AlertLifecycle(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postOpsgenie(router, opsgeniePayload(OpsgenieActionCreate, "P1"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	if len(alertStore.alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+	var alert *alertingv1.Alert
+	for _, a := range alertStore.alerts {
+		alert = a
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_OPSGENIE {
+		t.Errorf("expected source OPSGENIE, got %v", alert.Source)
+	}
+	if alert.SourceAlertId != "70413a06-38d6-4c85-92b8-5ebc900d42e2" {
+		t.Errorf("expected the opsgenie alertId as source alert id, got %q", alert.SourceAlertId)
+	}
+	if alert.Fingerprint != generateOpsgenieFingerprint("70413a06-38d6-4c85-92b8-5ebc900d42e2") {
+		t.Errorf("unexpected fingerprint %q", alert.Fingerprint)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_CRITICAL {
+		t.Errorf("expected severity CRITICAL, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Details != "/var/lib/postgresql is 93% full" {
+		t.Errorf("expected the description as details, got %q", alert.Details)
+	}
+	if alert.Annotations["tags"] != "database,disk" {
+		t.Errorf("expected tags annotation, got '%s'", alert.Annotations["tags"])
+	}
+
+	for _, step := range []struct {
+		action   string
+		expected alertingv1.AlertStatus
+	}{
+		{OpsgenieActionAcknowledge, alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED},
+		{OpsgenieActionClose, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED},
+	} {
+		w = postOpsgenie(router, opsgeniePayload(step.action, "P1"))
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d: %s", step.action, w.Code, w.Body.String())
+		}
+
+		var resp WebhookResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s: failed to parse response: %v", step.action, err)
+		}
+		if resp.Updated != 1 || resp.Created != 0 {
+			t.Errorf("%s: expected the alert to be updated, got created=%d updated=%d", step.action, resp.Created, resp.Updated)
+		}
+		if got := alertStore.alertsByFP[alert.Fingerprint].Status; got != step.expected {
+			t.Errorf("%s: expected status %v, got %v", step.action, step.expected, got)
+		}
+	}
+
+	if alertStore.alertsByFP[alert.Fingerprint].ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestOpsgenieWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"action":`)},
+		{name: "missing alert id", body: []byte(`{"action":"Create","alert":{"message":"boom"}}`)},
+		{name: "unsupported action", body: opsgeniePayload("AddNote", "P3")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, _, _ := setupTestHandler()
+
+			w := postOpsgenie(router, tt.body)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestMapOpsgeniePriority(t *testing.T) {
+	tests := []struct {
+		priority string
+		expected alertingv1.Severity
+	}{
+		{"P1", alertingv1.Severity_SEVERITY_CRITICAL},
+		{"P2", alertingv1.Severity_SEVERITY_HIGH},
+		{"P3", alertingv1.Severity_SEVERITY_MEDIUM},
+		{"P4", alertingv1.Severity_SEVERITY_LOW},
+		{"P5", alertingv1.Severity_SEVERITY_INFO},
+		{"", alertingv1.Severity_SEVERITY_MEDIUM},
+	}
+
+	for _, tt := range tests {
+		if got := mapOpsgeniePriority(tt.priority); got != tt.expected {
+			t.Errorf("mapOpsgeniePriority(%q) = %v, expected %v", tt.priority, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// PagerDutySignatureHeader carries comma-separated v1=<hex HMAC-SHA256> signatures
+// of the request body, one per active signing secret.
+const PagerDutySignatureHeader = "X-PagerDuty-Signature"
+
+// PagerDuty webhook event types.
+const (
+	PagerDutyEventTrigger     = "incident.trigger"
+	PagerDutyEventAcknowledge = "incident.acknowledge"
+	PagerDutyEventResolve     = "incident.resolve"
+)
+
+// PagerDutyWebhookPayload represents a PagerDuty webhook. PagerDuty may batch
+// several messages into one request.
+type PagerDutyWebhookPayload struct {
+	Messages []PagerDutyWebhookMessage `json:"messages"`
+}
+
+// PagerDutyWebhookMessage is one event on a PagerDuty incident.
+type PagerDutyWebhookMessage struct {
+	ID       string            `json:"id"`
+	Event    PagerDutyEvent    `json:"event"`
+	Incident PagerDutyIncident `json:"incident"`
+}
+
+// PagerDutyEvent describes what happened to the incident.
+type PagerDutyEvent struct {
+	EventType  string    `json:"event_type"`
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+}
+
+// PagerDutyIncident represents a PagerDuty incident. The incident key is the
+// dedup key the incident was triggered with.
+type PagerDutyIncident struct {
+	ID          string `json:"id"`
+	IncidentKey string `json:"incident_key"`
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	Urgency     string `json:"urgency,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+}
+
+// PagerDutyWebhook handles POST /api/v1/webhook/pagerduty/:integration_key
+//
+// Triggered incidents are ingested as alerts. Acknowledged and resolved
+// incidents update the service's alert with the same fingerprint, so that state
+// changes made in PagerDuty are reflected here; events for unknown alerts or
+// alerts of other services are ignored. Acknowledge and resolve events are only
+// accepted from services with a WebhookSigningSecret, so that their payloads
+// are signed.
+func (h *Handler) PagerDutyWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "failed to read request body",
+		})
+		return
+	}
+	c.Set(gin.BodyBytesKey, body)
+
+	// Verify the payload signature when the service has a signing secret
+	if service.WebhookSigningSecret != "" && !validPagerDutySignature(service.WebhookSigningSecret, body, c.GetHeader(PagerDutySignatureHeader)) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("invalid pagerduty webhook signature")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "unauthorized",
+			Message: "invalid " + PagerDutySignatureHeader + " header",
+		})
+		return
+	}
+
+	// Parse payload
+	var payload PagerDutyWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse pagerduty payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid pagerduty payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if len(payload.Messages) == 0 {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "at least one message is required",
+		})
+		return
+	}
+	for _, msg := range payload.Messages {
+		if msg.Incident.IncidentKey == "" {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "badRequest",
+				Message: "incident_key is required",
+			})
+			return
+		}
+		if service.WebhookSigningSecret == "" && isPagerDutyStateChange(msg.Event.EventType) {
+			h.logger.Warn().Str("serviceId", service.ID).Str("eventType", msg.Event.EventType).Msg("unsigned pagerduty state change rejected")
+			c.JSON(http.StatusForbidden, ErrorResponse{
+				Error:   "forbidden",
+				Message: "a webhook signing secret is required for " + msg.Event.EventType + " events",
+			})
+			return
+		}
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Int("messageCount", len(payload.Messages)).
+		Msg("processing pagerduty webhook")
+
+	var alertIDs []string
+	var alerts []*alertingv1.Alert
+	created := 0
+	updated := 0
+
+	for i := range payload.Messages {
+		msg := &payload.Messages[i]
+
+		var alert *alertingv1.Alert
+		var wasCreated bool
+		var err error
+		switch msg.Event.EventType {
+		case PagerDutyEventTrigger:
+			alert, wasCreated, err = h.processPagerDutyTrigger(c, service, msg)
+		case PagerDutyEventAcknowledge:
+			alert, err = h.updatePagerDutyIncident(c.Request.Context(), service, &msg.Incident, alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED)
+		case PagerDutyEventResolve:
+			alert, err = h.updatePagerDutyIncident(c.Request.Context(), service, &msg.Incident, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED)
+		default:
+			h.logger.Debug().Str("eventType", msg.Event.EventType).Msg("ignoring pagerduty event")
+			continue
+		}
+
+		if errors.Is(err, ErrAlertQuotaExceeded) {
+			h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+			respondQuotaExceeded(c)
+			return
+		}
+		if err != nil {
+			h.logger.Error().
+				Err(err).
+				Str("incidentKey", msg.Incident.IncidentKey).
+				Msg("failed to process pagerduty incident")
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "internalError",
+				Message: "failed to process alert: " + err.Error(),
+			})
+			return
+		}
+		if alert == nil {
+			continue
+		}
+
+		alertIDs = append(alertIDs, alert.Id)
+		alerts = append(alerts, alert)
+		if wasCreated {
+			created++
+		} else {
+			updated++
+		}
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alerts processed successfully",
+		AlertIds:         alertIDs,
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alerts...),
+	})
+}
+
+func (h *Handler) processPagerDutyTrigger(c *gin.Context, service *store.Service, msg *PagerDutyWebhookMessage) (*alertingv1.Alert, bool, error) {
+	incident := msg.Incident
+
+	labels := map[string]string{
+		"incidentId":  incident.ID,
+		"incidentKey": incident.IncidentKey,
+	}
+
+	annotations := make(map[string]string)
+	if incident.HTMLURL != "" {
+		annotations["url"] = incident.HTMLURL
+	}
+
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"eventType":   msg.Event.EventType,
+		"incidentId":  incident.ID,
+		"incidentKey": incident.IncidentKey,
+		"title":       incident.Title,
+		"status":      incident.Status,
+		"urgency":     incident.Urgency,
+	})
+
+	summary := incident.Title
+	if summary == "" {
+		summary = "PagerDuty incident " + incident.IncidentKey
+	}
+
+	triggeredAt := msg.Event.OccurredAt
+	if triggeredAt.IsZero() {
+		triggeredAt = time.Now()
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: incident.IncidentKey,
+		Summary:     summary,
+		Severity:    mapPagerDutyUrgency(incident.Urgency),
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_PAGERDUTY,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: annotations,
+		Status:      alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+		TriggeredAt: timestamppb.New(triggeredAt),
+		RawPayload:  rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_PAGERDUTY),
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// updatePagerDutyIncident sets the status of the service's alert fingerprinted
+// with the incident key. It returns nil if the service has no such alert.
+func (h *Handler) updatePagerDutyIncident(ctx context.Context, service *store.Service, incident *PagerDutyIncident, status alertingv1.AlertStatus) (*alertingv1.Alert, error) {
+	alert, err := h.alertStore.GetByFingerprint(ctx, incident.IncidentKey)
+	if err != nil {
+		return nil, err
+	}
+	if alert == nil || alert.ServiceId != service.ID {
+		h.logger.Debug().Str("serviceId", service.ID).Str("incidentKey", incident.IncidentKey).Msg("no alert for pagerduty incident")
+		return nil, nil
+	}
+
+	alert.Status = status
+	switch status {
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		alert.AcknowledgedAt = timestamppb.Now()
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		alert.ResolvedAt = timestamppb.Now()
+	}
+	alert.UpdatedAt = timestamppb.Now()
+
+	updated, err := h.alertStore.Update(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+	if h.summaryCache != nil {
+		h.summaryCache.Invalidate()
+	}
+	return updated, nil
+}
+
+// isPagerDutyStateChange reports whether a PagerDuty event changes the state of
+// an existing alert.
+func isPagerDutyStateChange(eventType string) bool {
+	return eventType == PagerDutyEventAcknowledge || eventType == PagerDutyEventResolve
+}
+
+// validPagerDutySignature reports whether any of the v1 signatures in header is
+// the hex HMAC-SHA256 of body. PagerDuty sends one signature per active secret
+// while a secret is being rotated.
+func validPagerDutySignature(secret string, body []byte, header string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	for _, signature := range strings.Split(header, ",") {
+		hexSignature, ok := strings.CutPrefix(strings.TrimSpace(signature), "v1=")
+		if !ok {
+			continue
+		}
+		got, err := hex.DecodeString(hexSignature)
+		if err == nil && hmac.Equal(got, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func mapPagerDutyUrgency(urgency string) alertingv1.Severity {
+	switch urgency {
+	case "high":
+		return alertingv1.Severity_SEVERITY_HIGH
+	case "low":
+		return alertingv1.Severity_SEVERITY_LOW
+	default:
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	}
+}
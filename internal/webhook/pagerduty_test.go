@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func pagerDutyPayload(eventTypes ...string) []byte {
+	payload := PagerDutyWebhookPayload{}
+	for _, eventType := range eventTypes {
+		payload.Messages = append(payload.Messages, PagerDutyWebhookMessage{
+			ID:    "msg-" + eventType,
+			Event: PagerDutyEvent{EventType: eventType},
+			Incident: PagerDutyIncident{
+				ID:          "PT4KHLK",
+				IncidentKey: "db-primary-down",
+				Title:       "Primary database is down",
+				Urgency:     "high",
+				HTMLURL:     "https://acme.pagerduty.com/incidents/PT4KHLK",
+			},
+		})
+	}
+	body, _ := json.Marshal(payload)
+	return body
+}
+
+func signPagerDutyPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func postPagerDuty(router *gin.Engine, body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/pagerduty/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if signature != "" {
+		req.Header.Set(PagerDutySignatureHeader, signature)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestPagerDutyWebhook_IncidentLifecycle(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].WebhookSigningSecret = "pd-secret"
+
+	body := pagerDutyPayload(PagerDutyEventTrigger)
+	w := postPagerDuty(router, body, signPagerDutyPayload("pd-secret", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP["db-primary-down"]
+	if alert == nil {
+		t.Fatal("expected the incident key to be used as the fingerprint")
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_PAGERDUTY {
+		t.Errorf("expected source PAGERDUTY, got %v", alert.Source)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_HIGH {
+		t.Errorf("expected severity HIGH, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+
+	body = pagerDutyPayload(PagerDutyEventAcknowledge)
+	w = postPagerDuty(router, body, signPagerDutyPayload("pd-secret", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	acked := alertStore.alertsByFP["db-primary-down"]
+	if acked.Status != alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED {
+		t.Errorf("expected status ACKNOWLEDGED, got %v", acked.Status)
+	}
+	if acked.AcknowledgedAt == nil {
+		t.Error("expected acknowledged_at to be set")
+	}
+
+	body = pagerDutyPayload(PagerDutyEventResolve)
+	w = postPagerDuty(router, body, signPagerDutyPayload("pd-secret", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Created != 0 {
+		t.Errorf("expected the resolve to update the alert, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+
+	resolved := alertStore.alertsByFP["db-primary-down"]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected status RESOLVED, got %v", resolved.Status)
+	}
+	if resolved.ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+	if len(alertStore.alerts) != 1 {
+		t.Errorf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestPagerDutyWebhook_UnknownIncidentIgnored(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].WebhookSigningSecret = "pd-secret"
+
+	body := pagerDutyPayload(PagerDutyEventAcknowledge, "incident.assign")
+	w := postPagerDuty(router, body, signPagerDutyPayload("pd-secret", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Created != 0 || resp.Updated != 0 {
+		t.Errorf("expected no alerts to change, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+	if len(alertStore.alerts) != 0 {
+		t.Errorf("expected no alerts, got %d", len(alertStore.alerts))
+	}
+}
+
+func TestPagerDutyWebhook_OtherServiceAlertIgnored(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].WebhookSigningSecret = "pd-secret"
+	alertStore.alertsByFP["db-primary-down"] = &alertingv1.Alert{
+		Id:          "alert-other",
+		Fingerprint: "db-primary-down",
+		ServiceId:   "svc-other",
+		Status:      alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+	}
+
+	body := pagerDutyPayload(PagerDutyEventResolve)
+	w := postPagerDuty(router, body, signPagerDutyPayload("pd-secret", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if got := alertStore.alertsByFP["db-primary-down"].Status; got != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected the other service's alert to stay triggered, got %v", got)
+	}
+}
+
+func TestPagerDutyWebhook_StateChangeRequiresSigningSecret(t *testing.T) {
+	for _, eventType := range []string{PagerDutyEventAcknowledge, PagerDutyEventResolve} {
+		t.Run(eventType, func(t *testing.T) {
+			_, router, alertStore, _ := setupTestHandler()
+
+			w := postPagerDuty(router, pagerDutyPayload(PagerDutyEventTrigger), "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+
+			w = postPagerDuty(router, pagerDutyPayload(eventType), "")
+			if w.Code != http.StatusForbidden {
+				t.Fatalf("expected status 403, got %d: %s", w.Code, w.Body.String())
+			}
+			if got := alertStore.alertsByFP["db-primary-down"].Status; got != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+				t.Errorf("expected the alert to stay triggered, got %v", got)
+			}
+		})
+	}
+}
+
+func TestPagerDutyWebhook_Signature(t *testing.T) {
+	body := pagerDutyPayload(PagerDutyEventTrigger)
+
+	tests := []struct {
+		name           string
+		signature      string
+		expectedStatus int
+	}{
+		{name: "valid signature", signature: signPagerDutyPayload("pd-secret", body), expectedStatus: http.StatusOK},
+		{name: "rotated secret", signature: signPagerDutyPayload("old-secret", body) + ", " + signPagerDutyPayload("pd-secret", body), expectedStatus: http.StatusOK},
+		{name: "wrong secret", signature: signPagerDutyPayload("other-secret", body), expectedStatus: http.StatusUnauthorized},
+		{name: "missing version", signature: signPagerDutyPayload("pd-secret", body)[3:], expectedStatus: http.StatusUnauthorized},
+		{name: "missing signature", signature: "", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, serviceStore := setupTestHandler()
+			serviceStore.services["valid-key"].WebhookSigningSecret = "pd-secret"
+
+			w := postPagerDuty(router, body, tt.signature)
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			expectedAlerts := 0
+			if tt.expectedStatus == http.StatusOK {
+				expectedAlerts = 1
+			}
+			if len(alertStore.alerts) != expectedAlerts {
+				t.Errorf("expected %d alerts, got %d", expectedAlerts, len(alertStore.alerts))
+			}
+		})
+	}
+}
+
+func TestPagerDutyWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"messages":`)},
+		{name: "no messages", body: []byte(`{"messages":[]}`)},
+		{name: "missing incident key", body: []byte(`{"messages":[{"event":{"event_type":"incident.trigger"},"incident":{"id":"PT4KHLK"}}]}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, _, _ := setupTestHandler()
+
+			w := postPagerDuty(router, tt.body, "")
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
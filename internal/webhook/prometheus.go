@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// activeStatuses are the alert statuses counted by the alerts_active gauge.
+var activeStatuses = []alertingv1.AlertStatus{
+	alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED,
+	alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED,
+}
+
+// instrument returns a middleware observing the duration of webhook requests
+// from source.
+func (h *Handler) instrument(source string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if h.registry == nil {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+		h.registry.ObserveWebhookRequest(source, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// recordAlertMetrics counts the stored alert and refreshes the number of active
+// alerts. Failures are logged and never fail ingestion.
+func (h *Handler) recordAlertMetrics(ctx context.Context, alert *alertingv1.Alert) {
+	if h.registry == nil {
+		return
+	}
+
+	h.registry.RecordAlertReceived(
+		strings.ToLower(strings.TrimPrefix(alert.Source.String(), "ALERT_SOURCE_")),
+		strings.ToLower(strings.TrimPrefix(alert.Status.String(), "ALERT_STATUS_")),
+	)
+
+	// Only the total count is needed, so a single alert is requested
+	active, err := h.alertStore.List(ctx, &alertingv1.ListAlertsRequest{PageSize: 1, Statuses: activeStatuses})
+	if err != nil {
+		h.requestLogger(ctx).Warn().Err(err).Msg("failed to count active alerts")
+		return
+	}
+	h.registry.SetAlertsActive(int(active.TotalCount))
+}
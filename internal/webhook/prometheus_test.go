@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/metrics"
+)
+
+func TestGenericWebhook_RecordsPrometheusMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	reg := prometheus.NewRegistry()
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithMetricsRegistry(metrics.NewRegistry(reg)))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	postGenericAlert(t, router, GenericPayload{Summary: "Disk full", Severity: "warning", Fingerprint: "fp-disk-1"})
+	postGenericAlert(t, router, GenericPayload{Summary: "Disk full", Severity: "warning", Fingerprint: "fp-disk-2"})
+
+	expected := `
+# HELP alerts_received_total Alerts received by webhooks, by source and resulting status.
+# TYPE alerts_received_total counter
+alerts_received_total{source="generic",status="triggered"} 2
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "alerts_received_total"); err != nil {
+		t.Errorf("unexpected alerts_received_total: %v", err)
+	}
+
+	count, err := testutil.GatherAndCount(reg, "webhook_request_duration_seconds")
+	if err != nil {
+		t.Fatalf("GatherAndCount() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected one webhook_request_duration_seconds series, got %d", count)
+	}
+}
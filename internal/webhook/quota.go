@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AlertQuotaWindow is the sliding window over which per-service alert quotas apply.
+const AlertQuotaWindow = time.Hour
+
+// ErrAlertQuotaExceeded is returned when a service has created its hourly quota of alerts.
+var ErrAlertQuotaExceeded = errors.New("alert quota exceeded")
+
+// AlertQuota counts alerts created per service over a sliding AlertQuotaWindow.
+// Counts are kept in memory, so each server instance enforces the quota separately.
+type AlertQuota struct {
+	mu sync.Mutex
+	// created holds the creation times within the window, oldest first, by service ID.
+	created map[string][]time.Time
+	now     func() time.Time
+}
+
+// NewAlertQuota creates an in-memory alert quota counter.
+func NewAlertQuota() *AlertQuota {
+	return &AlertQuota{
+		created: make(map[string][]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Allow reports whether the service may create another alert under limit.
+// A limit of zero or less disables the quota.
+func (q *AlertQuota) Allow(serviceID string, limit int32) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.prune(serviceID)) < int(limit)
+}
+
+// Record counts an alert created for the service.
+func (q *AlertQuota) Record(serviceID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.created[serviceID] = append(q.prune(serviceID), q.now())
+}
+
+// prune drops creation times that fell out of the window. The caller must hold q.mu.
+func (q *AlertQuota) prune(serviceID string) []time.Time {
+	times := q.created[serviceID]
+	cutoff := q.now().Add(-AlertQuotaWindow)
+
+	expired := 0
+	for expired < len(times) && !times[expired].After(cutoff) {
+		expired++
+	}
+	if expired == len(times) {
+		delete(q.created, serviceID)
+		return nil
+	}
+
+	times = times[expired:]
+	q.created[serviceID] = times
+	return times
+}
+
+// respondQuotaExceeded writes the 429 response for a service over its alert quota.
+func respondQuotaExceeded(c *gin.Context) {
+	c.JSON(http.StatusTooManyRequests, ErrorResponse{
+		Error:   "quotaExceeded",
+		Message: "hourly alert quota exceeded for this service",
+	})
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+func postQuotaAlert(router *gin.Engine, summary string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(GenericPayload{Summary: summary})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func newQuotaTestRouter(quotaPerHour int32, quota *AlertQuota) (*gin.Engine, *Handler) {
+	gin.SetMode(gin.TestMode)
+
+	serviceStore := newMockServiceStore()
+	serviceStore.services["valid-key"].AlertQuotaPerHour = quotaPerHour
+
+	handler := NewHandler(newMockAlertStore(), serviceStore, zerolog.Nop(), WithAlertQuota(quota))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	return router, handler
+}
+
+func TestGenericWebhook_AlertQuotaExceeded(t *testing.T) {
+	router, handler := newQuotaTestRouter(2, NewAlertQuota())
+
+	for i := 0; i < 2; i++ {
+		if w := postQuotaAlert(router, fmt.Sprintf("alert %d", i)); w.Code != http.StatusOK {
+			t.Fatalf("alert %d: expected status 200, got %d: %s", i, w.Code, w.Body.String())
+		}
+	}
+
+	w := postQuotaAlert(router, "alert 2")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Error != "quotaExceeded" {
+		t.Errorf("expected quotaExceeded error, got %q", resp.Error)
+	}
+	if got := handler.Metrics().AlertQuotaExceededTotal("svc-123"); got != 1 {
+		t.Errorf("expected 1 quota exceeded, got %d", got)
+	}
+}
+
+func TestGenericWebhook_AlertQuotaResetsAfterWindow(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	quota := NewAlertQuota()
+	quota.now = func() time.Time { return now }
+	router, _ := newQuotaTestRouter(1, quota)
+
+	if w := postQuotaAlert(router, "first"); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	now = now.Add(30 * time.Minute)
+	if w := postQuotaAlert(router, "second"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429 within the window, got %d", w.Code)
+	}
+
+	now = now.Add(31 * time.Minute)
+	if w := postQuotaAlert(router, "third"); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 after the window, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGenericWebhook_AlertQuotaAllowsResolve(t *testing.T) {
+	router, _ := newQuotaTestRouter(1, NewAlertQuota())
+
+	post := func(payload GenericPayload) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post(GenericPayload{Summary: "disk full", Fingerprint: "fp-disk"}); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := post(GenericPayload{Summary: "cpu high", Fingerprint: "fp-cpu"}); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected a new alert to exceed the quota, got %d", w.Code)
+	}
+
+	w := post(GenericPayload{Summary: "disk full", Fingerprint: "fp-disk", Status: "resolved"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected the resolve of an existing alert to succeed over quota, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGenericWebhook_AlertQuotaDisabled(t *testing.T) {
+	router, handler := newQuotaTestRouter(0, NewAlertQuota())
+
+	for i := 0; i < 20; i++ {
+		if w := postQuotaAlert(router, fmt.Sprintf("alert %d", i)); w.Code != http.StatusOK {
+			t.Fatalf("alert %d: expected status 200, got %d", i, w.Code)
+		}
+	}
+	if got := handler.Metrics().AlertQuotaExceededTotal("svc-123"); got != 0 {
+		t.Errorf("expected no quota exceeded, got %d", got)
+	}
+}
+
+func TestAlertQuota_CountsOnlyRecordedAlerts(t *testing.T) {
+	quota := NewAlertQuota()
+
+	if !quota.Allow("svc", 1) {
+		t.Fatal("expected the first alert to be allowed")
+	}
+	if !quota.Allow("svc", 1) {
+		t.Fatal("expected Allow alone not to consume quota")
+	}
+
+	quota.Record("svc")
+	if quota.Allow("svc", 1) {
+		t.Error("expected quota to be exhausted after a recorded alert")
+	}
+	if !quota.Allow("other", 1) {
+		t.Error("expected quotas to be tracked per service")
+	}
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+// rateLimitedServiceKey is the gin context key of the service looked up by rateLimit.
+const rateLimitedServiceKey = "webhook.service"
+
+// RateLimiter limits webhook requests per integration key with token buckets.
+// Buckets are kept in memory, so each server instance enforces the limits separately.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	now      func() time.Time
+}
+
+// NewRateLimiter creates an in-memory webhook rate limiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limiters: make(map[string]*rate.Limiter),
+		now:      time.Now,
+	}
+}
+
+// Allow reports whether a request for the integration key is within limit.
+// Otherwise it returns how long to wait until the next request is accepted.
+// Changes to the limit apply to the key's existing bucket.
+func (l *RateLimiter) Allow(integrationKey string, limit store.RateLimit) (bool, time.Duration) {
+	if limit.RequestsPerSecond <= 0 {
+		return true, 0
+	}
+
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(limit.RequestsPerSecond))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	limiter, ok := l.limiters[integrationKey]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+		l.limiters[integrationKey] = limiter
+	} else {
+		if limiter.Limit() != rate.Limit(limit.RequestsPerSecond) {
+			limiter.SetLimitAt(now, rate.Limit(limit.RequestsPerSecond))
+		}
+		if limiter.Burst() != burst {
+			limiter.SetBurstAt(now, burst)
+		}
+	}
+
+	reservation := limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// rateLimit returns a middleware that rejects requests beyond the RateLimit of
+// the service of the integration_key path parameter, or of the default
+// integration key on routes without one. Requests with a missing or unknown
+// key are passed on so the handler rejects them.
+func (h *Handler) rateLimit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		integrationKey := c.Param("integration_key")
+		if integrationKey == "" {
+			integrationKey = h.defaultIntegrationKey
+		}
+		if integrationKey == "" {
+			c.Next()
+			return
+		}
+
+		service, err := h.serviceStore.GetByIntegrationKey(c.Request.Context(), integrationKey)
+		if err != nil {
+			c.Next()
+			return
+		}
+		// The handler reuses the service instead of looking it up again
+		c.Set(rateLimitedServiceKey, service)
+
+		allowed, retryAfter := h.rateLimiter.Allow(integrationKey, service.RateLimit)
+		if !allowed {
+			h.metrics.RecordWebhookRateLimited(integrationKey)
+			h.registry.RecordWebhookRateLimited(integrationKey)
+			h.requestLogger(c.Request.Context()).Warn().Str("serviceId", service.ID).Msg("webhook rate limit exceeded")
+			respondRateLimited(c, retryAfter)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// respondRateLimited writes the 429 response for a request beyond its service's
+// rate limit, with a Retry-After header in whole seconds.
+func respondRateLimited(c *gin.Context, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, ErrorResponse{
+		Error:   "rateLimited",
+		Message: "webhook rate limit exceeded for this integration key",
+	})
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+func newRateLimitTestRouter(limit store.RateLimit, limiter *RateLimiter) (*gin.Engine, *Handler) {
+	gin.SetMode(gin.TestMode)
+
+	serviceStore := newMockServiceStore()
+	serviceStore.services["valid-key"].RateLimit = limit
+
+	handler := NewHandler(newMockAlertStore(), serviceStore, zerolog.Nop(), WithRateLimiter(limiter))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	return router, handler
+}
+
+func TestGenericWebhook_RateLimitBurst(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter()
+	limiter.now = func() time.Time { return now }
+	router, handler := newRateLimitTestRouter(store.RateLimit{RequestsPerSecond: 2, Burst: 5}, limiter)
+
+	accepted, rejected := 0, 0
+	var last *http.Response
+	for i := 0; i < 50; i++ {
+		w := postQuotaAlert(router, fmt.Sprintf("alert %d", i))
+		switch w.Code {
+		case http.StatusOK:
+			accepted++
+		case http.StatusTooManyRequests:
+			rejected++
+			last = w.Result()
+		default:
+			t.Fatalf("alert %d: unexpected status %d: %s", i, w.Code, w.Body.String())
+		}
+	}
+
+	if accepted != 5 || rejected != 45 {
+		t.Fatalf("expected the burst of 5 to be accepted and 45 rejected, got %d and %d", accepted, rejected)
+	}
+	if got := last.Header.Get("Retry-After"); got != "1" {
+		t.Errorf("expected Retry-After 1, got %q", got)
+	}
+	var resp ErrorResponse
+	if err := json.NewDecoder(last.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Error != "rateLimited" {
+		t.Errorf("expected rateLimited error, got %q", resp.Error)
+	}
+	if got := handler.Metrics().WebhookRateLimitedTotal("valid-key"); got != 45 {
+		t.Errorf("expected 45 rate limited requests, got %d", got)
+	}
+
+	// Tokens refill at the configured rate
+	now = now.Add(time.Second)
+	for i := 0; i < 2; i++ {
+		if w := postQuotaAlert(router, fmt.Sprintf("refilled %d", i)); w.Code != http.StatusOK {
+			t.Fatalf("refilled %d: expected status 200, got %d", i, w.Code)
+		}
+	}
+	if w := postQuotaAlert(router, "over"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429 once the refill is used, got %d", w.Code)
+	}
+}
+
+func TestGenericWebhook_RateLimitDisabled(t *testing.T) {
+	router, handler := newRateLimitTestRouter(store.RateLimit{}, NewRateLimiter())
+
+	for i := 0; i < 20; i++ {
+		if w := postQuotaAlert(router, fmt.Sprintf("alert %d", i)); w.Code != http.StatusOK {
+			t.Fatalf("alert %d: expected status 200, got %d", i, w.Code)
+		}
+	}
+	if got := handler.Metrics().WebhookRateLimitedTotal("valid-key"); got != 0 {
+		t.Errorf("expected no rate limited requests, got %d", got)
+	}
+}
+
+func TestRateLimiter_RetryAfter(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter()
+	limiter.now = func() time.Time { return now }
+	limit := store.RateLimit{RequestsPerSecond: 0.1}
+
+	if allowed, _ := limiter.Allow("key", limit); !allowed {
+		t.Fatal("expected the first request to be allowed")
+	}
+	allowed, retryAfter := limiter.Allow("key", limit)
+	if allowed {
+		t.Fatal("expected the second request to be rejected")
+	}
+	if retryAfter != 10*time.Second {
+		t.Errorf("expected to retry after 10s, got %v", retryAfter)
+	}
+	if allowed, _ := limiter.Allow("other", limit); !allowed {
+		t.Error("expected limits to be tracked per integration key")
+	}
+
+	// Rejected requests do not consume tokens
+	now = now.Add(10 * time.Second)
+	if allowed, _ := limiter.Allow("key", limit); !allowed {
+		t.Error("expected a request to be allowed after the retry delay")
+	}
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/analytics"
+)
+
+func TestGenericWebhook_RecordsReceipts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	receipts := analytics.NewInMemoryStore()
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithReceiptStore(receipts))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	// The same alert twice is deduplicated on its fingerprint
+	for i := 0; i < 2; i++ {
+		body, _ := json.Marshal(GenericPayload{Summary: "Link down", Labels: map[string]string{"site": "NYC-DC1"}})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK && w.Code != http.StatusCreated {
+			t.Fatalf("expected success, got %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	stats, err := receipts.Stats(context.Background(), "svc-123", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.TotalReceived != 2 {
+		t.Errorf("expected 2 receipts, got %d", stats.TotalReceived)
+	}
+	if stats.UniqueFingerprints != 1 {
+		t.Errorf("expected 1 unique fingerprint, got %d", stats.UniqueFingerprints)
+	}
+}
+
+// countingSummaryStore counts how often summaries are computed.
+type countingSummaryStore struct {
+	calls int
+}
+
+func (s *countingSummaryStore) Summarize(ctx context.Context, filter analytics.SummaryFilter) ([]analytics.AlertSummary, error) {
+	s.calls++
+	return nil, nil
+}
+
+func TestGenericWebhook_InvalidatesSummaryCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	summaries := &countingSummaryStore{}
+	cache := analytics.NewSummaryCache(summaries, time.Hour)
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithSummaryCache(cache))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	ctx := context.Background()
+	_, _ = cache.Summarize(ctx, analytics.SummaryFilter{})
+	_, _ = cache.Summarize(ctx, analytics.SummaryFilter{})
+	if summaries.calls != 1 {
+		t.Fatalf("expected cached summary, got %d computations", summaries.calls)
+	}
+
+	body, _ := json.Marshal(GenericPayload{Summary: "Link down"})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK && w.Code != http.StatusCreated {
+		t.Fatalf("expected success, got %d: %s", w.Code, w.Body.String())
+	}
+
+	_, _ = cache.Summarize(ctx, analytics.SummaryFilter{})
+	if summaries.calls != 2 {
+		t.Errorf("expected ingestion to invalidate the summary cache, got %d computations", summaries.calls)
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// includeRoutingParam is the query parameter that adds the routing decisions
+// of the processed alerts to the webhook response.
+const includeRoutingParam = "include_routing"
+
+// RoutingDecision describes how a processed alert was routed.
+type RoutingDecision struct {
+	AlertID        string `json:"alertId"`
+	RulesEvaluated int    `json:"rulesEvaluated"`
+	RulesMatched   int    `json:"rulesMatched"`
+	// ActionsExecuted holds the types of the actions the alert was routed to
+	ActionsExecuted  []string `json:"actionsExecuted"`
+	ProcessingTimeMs int64    `json:"processingTimeMs"`
+}
+
+// routingDecisions evaluates the routing rules against the processed alerts
+// when the request sets include_routing=true, so operators can see how alerts
+// are routed without querying the audit log. Returns nil otherwise or without
+// a routing engine. Alerts whose evaluation fails are logged and left out.
+func (h *Handler) routingDecisions(c *gin.Context, alerts ...*alertingv1.Alert) []RoutingDecision {
+	if h.routingEngine == nil {
+		return nil
+	}
+	if include, _ := strconv.ParseBool(c.Query(includeRoutingParam)); !include {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+	decisions := make([]RoutingDecision, 0, len(alerts))
+	for _, alert := range alerts {
+		_, decision, err := h.routingEngine.EvaluateAndDescribe(ctx, routing.AlertFromStore(alert), time.Now())
+		if err != nil {
+			h.requestLogger(ctx).Warn().Err(err).Str("alertId", alert.Id).Msg("failed to evaluate routing")
+			continue
+		}
+		decisions = append(decisions, RoutingDecision{
+			AlertID:          alert.Id,
+			RulesEvaluated:   decision.RulesEvaluated,
+			RulesMatched:     decision.RulesMatched,
+			ActionsExecuted:  decision.Actions,
+			ProcessingTimeMs: decision.ProcessingTime.Milliseconds(),
+		})
+	}
+	return decisions
+}
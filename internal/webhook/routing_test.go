@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/routing"
+	routingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/routing/v1"
+)
+
+func newRoutingTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	rules := routing.NewInMemoryStore()
+	_, err := rules.CreateRule(context.Background(), &routingv1.RoutingRule{
+		Name:     "Critical alerts",
+		Priority: 1,
+		Enabled:  true,
+		Conditions: []*routingv1.RoutingCondition{
+			{
+				Type:        routingv1.ConditionType_CONDITION_TYPE_LABEL,
+				Field:       "severity",
+				Operator:    routingv1.ConditionOperator_CONDITION_OPERATOR_EQUALS,
+				StringValue: "critical",
+			},
+		},
+		Actions: []*routingv1.RoutingAction{
+			{Type: routingv1.ActionType_ACTION_TYPE_NOTIFY_TEAM},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	engine := routing.NewEngine(rules, routing.NewEvaluator(), zerolog.Nop())
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithRoutingEngine(engine))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func postAlertmanagerAlerts(t *testing.T, router *gin.Engine, query string, alerts ...AlertmanagerAlert) WebhookResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(AlertmanagerPayload{Version: "4", GroupKey: "test-group", Status: "firing", Alerts: alerts})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/alertmanager/valid-key"+query, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return resp
+}
+
+func TestAlertmanagerWebhook_IncludeRouting(t *testing.T) {
+	router := newRoutingTestRouter(t)
+
+	resp := postAlertmanagerAlerts(t, router, "?include_routing=true",
+		AlertmanagerAlert{
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "DiskFull", "severity": "critical"},
+			StartsAt:    time.Now(),
+			Fingerprint: "critical-1",
+		},
+		AlertmanagerAlert{
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "HighLatency", "severity": "warning"},
+			StartsAt:    time.Now(),
+			Fingerprint: "warning-1",
+		},
+	)
+
+	if len(resp.RoutingDecisions) != 2 {
+		t.Fatalf("expected 2 routing decisions, got %d", len(resp.RoutingDecisions))
+	}
+
+	critical := resp.RoutingDecisions[0]
+	if critical.AlertID != resp.AlertIds[0] {
+		t.Errorf("expected the decision of alert %s, got %s", resp.AlertIds[0], critical.AlertID)
+	}
+	if critical.RulesEvaluated != 1 || critical.RulesMatched != 1 {
+		t.Errorf("expected 1 rule evaluated and matched, got %d and %d", critical.RulesEvaluated, critical.RulesMatched)
+	}
+	if len(critical.ActionsExecuted) != 1 || critical.ActionsExecuted[0] != "ACTION_TYPE_NOTIFY_TEAM" {
+		t.Errorf("unexpected actions: %v", critical.ActionsExecuted)
+	}
+
+	warning := resp.RoutingDecisions[1]
+	if warning.RulesEvaluated != 1 || warning.RulesMatched != 0 || len(warning.ActionsExecuted) != 0 {
+		t.Errorf("expected an unmatched decision, got %+v", warning)
+	}
+}
+
+func TestAlertmanagerWebhook_RoutingOmittedByDefault(t *testing.T) {
+	router := newRoutingTestRouter(t)
+
+	alert := AlertmanagerAlert{
+		Status:      "firing",
+		Labels:      map[string]string{"alertname": "DiskFull", "severity": "critical"},
+		StartsAt:    time.Now(),
+		Fingerprint: "critical-1",
+	}
+	for _, query := range []string{"", "?include_routing=false"} {
+		if resp := postAlertmanagerAlerts(t, router, query, alert); resp.RoutingDecisions != nil {
+			t.Errorf("query %q: expected no routing decisions, got %v", query, resp.RoutingDecisions)
+		}
+	}
+}
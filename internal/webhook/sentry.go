@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// SentrySignatureHeader carries the hex HMAC-SHA256 of the request body.
+const SentrySignatureHeader = "X-Sentry-Hook-Signature"
+
+// Sentry issue webhook actions.
+const (
+	SentryActionCreated  = "created"
+	SentryActionResolved = "resolved"
+	SentryActionAssigned = "assigned"
+)
+
+// SentryPayload represents the issue webhook payload from Sentry.
+type SentryPayload struct {
+	Action string     `json:"action"`
+	Data   SentryData `json:"data"`
+}
+
+// SentryData wraps the issue a Sentry webhook refers to.
+type SentryData struct {
+	Issue SentryIssue `json:"issue"`
+}
+
+// SentryIssue represents a Sentry issue.
+type SentryIssue struct {
+	ID      string        `json:"id"`
+	Title   string        `json:"title"`
+	Level   string        `json:"level"`
+	Culprit string        `json:"culprit,omitempty"`
+	Project SentryProject `json:"project"`
+}
+
+// SentryProject identifies the project an issue belongs to.
+type SentryProject struct {
+	Slug string `json:"slug"`
+}
+
+// SentryWebhook handles POST /api/v1/webhook/sentry/:integration_key
+func (h *Handler) SentryWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "failed to read request body",
+		})
+		return
+	}
+	c.Set(gin.BodyBytesKey, body)
+
+	// Verify the payload signature when the service has a signing secret
+	if service.WebhookSigningSecret != "" && !validSentrySignature(service.WebhookSigningSecret, body, c.GetHeader(SentrySignatureHeader)) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("invalid sentry webhook signature")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error:   "unauthorized",
+			Message: "invalid " + SentrySignatureHeader + " header",
+		})
+		return
+	}
+
+	// Parse payload
+	var payload SentryPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse sentry payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid sentry payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if payload.Data.Issue.ID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "issue id is required",
+		})
+		return
+	}
+
+	status, ok := mapSentryAction(payload.Action)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported sentry action: " + payload.Action,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("issueId", payload.Data.Issue.ID).
+		Str("action", payload.Action).
+		Msg("processing sentry webhook")
+
+	alert, wasCreated, err := h.processSentryIssue(c, service, &payload, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("issueId", payload.Data.Issue.ID).
+			Msg("failed to process sentry issue")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processSentryIssue(c *gin.Context, service *store.Service, payload *SentryPayload, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	issue := payload.Data.Issue
+
+	labels := map[string]string{
+		"issueId": issue.ID,
+		"project": issue.Project.Slug,
+		"level":   issue.Level,
+	}
+
+	annotations := make(map[string]string)
+	if issue.Culprit != "" {
+		annotations["culprit"] = issue.Culprit
+	}
+
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"action":  payload.Action,
+		"issueId": issue.ID,
+		"title":   issue.Title,
+		"level":   issue.Level,
+		"culprit": issue.Culprit,
+		"project": issue.Project.Slug,
+	})
+
+	summary := issue.Title
+	if summary == "" {
+		summary = "Sentry issue " + issue.ID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: generateSentryFingerprint(issue.ID),
+		Summary:     summary,
+		Details:     h.renderBody(service, bodySourceSentry, payload),
+		Severity:    mapSentryLevel(issue.Level),
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_SENTRY,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: annotations,
+		Status:      status,
+		TriggeredAt: timestamppb.New(time.Now()),
+		RawPayload:  rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_SENTRY),
+	}
+
+	switch status {
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		alert.ResolvedAt = timestamppb.Now()
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		alert.AcknowledgedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// validSentrySignature reports whether signature is the hex HMAC-SHA256 of body.
+func validSentrySignature(secret string, body []byte, signature string) bool {
+	if signature == "" {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// mapSentryAction maps a Sentry issue action to an alert status. An assigned
+// issue has an owner and is treated as acknowledged.
+func mapSentryAction(action string) (alertingv1.AlertStatus, bool) {
+	switch action {
+	case SentryActionCreated:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case SentryActionResolved:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	case SentryActionAssigned:
+		return alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+func mapSentryLevel(level string) alertingv1.Severity {
+	switch level {
+	case "fatal":
+		return alertingv1.Severity_SEVERITY_CRITICAL
+	case "error":
+		return alertingv1.Severity_SEVERITY_HIGH
+	case "warning":
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	case "info":
+		return alertingv1.Severity_SEVERITY_INFO
+	default:
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	}
+}
+
+func generateSentryFingerprint(issueID string) string {
+	// Sentry already groups events into issues, so the issue ID alone identifies
+	// the alert regardless of the service's fingerprint strategy
+	return SHA256Strategy{}.Compute(nil, "sentry", issueID)
+}
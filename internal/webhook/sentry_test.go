@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func sentryPayload(action, level string) []byte {
+	body, _ := json.Marshal(SentryPayload{
+		Action: action,
+		Data: SentryData{Issue: SentryIssue{
+			ID:      "4210",
+			Title:   "TypeError: cannot read property 'id' of undefined",
+			Level:   level,
+			Culprit: "app/checkout.js in submitOrder",
+			Project: SentryProject{Slug: "web-frontend"},
+		}},
+	})
+	return body
+}
+
+func signSentryPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func postSentry(router *gin.Engine, body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/sentry/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if signature != "" {
+		req.Header.Set(SentrySignatureHeader, signature)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestSentryWebhook_IssueLifecycle(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postSentry(router, sentryPayload(SentryActionCreated, "fatal"), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	if len(alertStore.alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alertStore.alerts))
+	}
+	var alert *alertingv1.Alert
+	for _, a := range alertStore.alerts {
+		alert = a
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_SENTRY {
+		t.Errorf("expected source SENTRY, got %v", alert.Source)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_CRITICAL {
+		t.Errorf("expected severity CRITICAL, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Labels["project"] != "web-frontend" {
+		t.Errorf("expected project label 'web-frontend', got '%s'", alert.Labels["project"])
+	}
+	if alert.Annotations["culprit"] != "app/checkout.js in submitOrder" {
+		t.Errorf("expected culprit annotation, got '%s'", alert.Annotations["culprit"])
+	}
+
+	// Later actions on the same issue update the same alert, even if the level changed
+	w = postSentry(router, sentryPayload(SentryActionResolved, "error"), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Created != 0 {
+		t.Errorf("expected the resolved issue to update the alert, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+
+	resolved := alertStore.alertsByFP[alert.Fingerprint]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected status RESOLVED, got %v", resolved.Status)
+	}
+	if resolved.ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestSentryWebhook_Signature(t *testing.T) {
+	body := sentryPayload(SentryActionCreated, "error")
+
+	tests := []struct {
+		name           string
+		signature      string
+		expectedStatus int
+	}{
+		{name: "valid signature", signature: signSentryPayload("sentry-secret", body), expectedStatus: http.StatusOK},
+		{name: "wrong secret", signature: signSentryPayload("other-secret", body), expectedStatus: http.StatusUnauthorized},
+		{name: "not hex", signature: "not-a-signature", expectedStatus: http.StatusUnauthorized},
+		{name: "missing signature", signature: "", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, alertStore, serviceStore := setupTestHandler()
+			serviceStore.services["valid-key"].WebhookSigningSecret = "sentry-secret"
+
+			w := postSentry(router, body, tt.signature)
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			expectedAlerts := 0
+			if tt.expectedStatus == http.StatusOK {
+				expectedAlerts = 1
+			}
+			if len(alertStore.alerts) != expectedAlerts {
+				t.Errorf("expected %d alerts, got %d", expectedAlerts, len(alertStore.alerts))
+			}
+		})
+	}
+}
+
+func TestSentryWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"action":`)},
+		{name: "missing issue id", body: []byte(`{"action":"created","data":{"issue":{"title":"boom"}}}`)},
+		{name: "unsupported action", body: sentryPayload("archived", "error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, _, _ := setupTestHandler()
+
+			w := postSentry(router, tt.body, "")
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestMapSentryLevel(t *testing.T) {
+	tests := []struct {
+		level    string
+		expected alertingv1.Severity
+	}{
+		{"fatal", alertingv1.Severity_SEVERITY_CRITICAL},
+		{"error", alertingv1.Severity_SEVERITY_HIGH},
+		{"warning", alertingv1.Severity_SEVERITY_MEDIUM},
+		{"info", alertingv1.Severity_SEVERITY_INFO},
+		{"", alertingv1.Severity_SEVERITY_MEDIUM},
+	}
+
+	for _, tt := range tests {
+		if got := mapSentryLevel(tt.level); got != tt.expected {
+			t.Errorf("mapSentryLevel(%q) = %v, expected %v", tt.level, got, tt.expected)
+		}
+	}
+}
+
+func TestMapSentryAction_Assigned(t *testing.T) {
+	status, ok := mapSentryAction(SentryActionAssigned)
+	if !ok || status != alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED {
+		t.Errorf("expected assigned to map to ACKNOWLEDGED, got %v (ok=%v)", status, ok)
+	}
+}
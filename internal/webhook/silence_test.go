@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/inhibition"
+	"github.com/kneutral-org/alerting-system/internal/silence"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestIngestAlert_AppliesSilences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	silences := silence.NewInMemoryStore()
+	created, err := silences.Create(context.Background(), &alertingv1.SilenceRule{
+		Matchers: []*alertingv1.LabelMatcher{
+			{Name: "env", Value: "staging", Operator: alertingv1.MatchOperator_MATCH_OPERATOR_EQUAL},
+			{Name: "cluster", Value: "ams[0-9]+", Operator: alertingv1.MatchOperator_MATCH_OPERATOR_REGEX},
+		},
+		StartsAt:  timestamppb.New(time.Now().Add(-time.Hour)),
+		EndsAt:    timestamppb.New(time.Now().Add(time.Hour)),
+		CreatedBy: "user-1",
+	})
+	if err != nil {
+		t.Fatalf("failed to create silence: %v", err)
+	}
+
+	alertStore := newMockAlertStore()
+	silencer := silence.NewSilencer(silences, zerolog.Nop(), nil)
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithSilencer(silencer))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	postGenericAlert(t, router, GenericPayload{Summary: "Load test", Severity: "warning", Fingerprint: "fp-silenced", Labels: map[string]string{"env": "staging", "cluster": "ams2"}})
+	postGenericAlert(t, router, GenericPayload{Summary: "Load test", Severity: "warning", Fingerprint: "fp-production", Labels: map[string]string{"env": "production", "cluster": "ams2"}})
+
+	silenced := alertStore.alertsByFP["fp-silenced"]
+	if silenced == nil {
+		t.Fatal("expected the silenced alert to be stored")
+	}
+	if silenced.Status != alertingv1.AlertStatus_ALERT_STATUS_SUPPRESSED {
+		t.Errorf("expected status SUPPRESSED, got %v", silenced.Status)
+	}
+	if got := silenced.Annotations[inhibition.AnnotationSuppressionReason]; got != "silenced_by:"+created.Id {
+		t.Errorf("expected suppression reason %q, got %q", "silenced_by:"+created.Id, got)
+	}
+	if got := silencer.Metrics().SilencedAlertsTotal(created.Id); got != 1 {
+		t.Errorf("expected 1 silenced alert, got %d", got)
+	}
+
+	if got := alertStore.alertsByFP["fp-production"].Status; got != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED for an alert outside the silence, got %v", got)
+	}
+}
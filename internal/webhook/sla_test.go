@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/customer"
+)
+
+func setupSLATestHandler(t *testing.T) (*gin.Engine, *mockAlertStore) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	tiers := customer.NewInMemoryTierStore()
+	tier, err := tiers.Create(ctx, &customer.CustomerTier{
+		Name:                 "Enterprise",
+		Level:                1,
+		CriticalResponseTime: 5 * time.Minute,
+		HighResponseTime:     15 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("failed to create tier: %v", err)
+	}
+	customers := customer.NewInMemoryStore()
+	if _, err := customers.Create(ctx, &customer.Customer{Name: "Acme Corp", AccountID: "acme-001", TierID: tier.ID}); err != nil {
+		t.Fatalf("failed to create customer: %v", err)
+	}
+
+	alertStore := newMockAlertStore()
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithCustomerTiers(customers, tiers))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	return router, alertStore
+}
+
+func TestIngestAlert_SetsSLADeadline(t *testing.T) {
+	router, alertStore := setupSLATestHandler(t)
+	triggeredAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	postGenericAlert(t, router, GenericPayload{
+		Summary:     "Checkout failing",
+		Severity:    "critical",
+		Fingerprint: "fp-checkout",
+		Labels:      map[string]string{"account_id": "acme-001"},
+		Timestamp:   &triggeredAt,
+	})
+
+	alert := alertStore.alertsByFP["fp-checkout"]
+	if alert.SlaDeadline == nil {
+		t.Fatal("expected an sla deadline")
+	}
+	if got, want := alert.SlaDeadline.AsTime(), triggeredAt.Add(5*time.Minute); !got.Equal(want) {
+		t.Errorf("expected sla deadline %v, got %v", want, got)
+	}
+	if alert.SlaBreached {
+		t.Error("expected a new alert not to be breached")
+	}
+}
+
+func TestIngestAlert_KeepsSLADeadlineOnUpdate(t *testing.T) {
+	router, alertStore := setupSLATestHandler(t)
+	triggeredAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	payload := GenericPayload{
+		Summary:     "Checkout failing",
+		Severity:    "critical",
+		Fingerprint: "fp-checkout",
+		Labels:      map[string]string{"account_id": "acme-001"},
+		Timestamp:   &triggeredAt,
+	}
+
+	postGenericAlert(t, router, payload)
+	alertStore.alertsByFP["fp-checkout"].SlaBreached = true
+
+	payload.Summary = "Checkout still failing"
+	postGenericAlert(t, router, payload)
+
+	alert := alertStore.alertsByFP["fp-checkout"]
+	if alert.Summary != "Checkout still failing" {
+		t.Errorf("expected the alert to be updated, got summary %q", alert.Summary)
+	}
+	if alert.SlaDeadline == nil {
+		t.Fatal("expected the sla deadline to survive the update")
+	}
+	if got, want := alert.SlaDeadline.AsTime(), triggeredAt.Add(5*time.Minute); !got.Equal(want) {
+		t.Errorf("expected sla deadline %v, got %v", want, got)
+	}
+	if !alert.SlaBreached {
+		t.Error("expected the sla breach to survive the update")
+	}
+}
+
+func TestIngestAlert_NoSLADeadline(t *testing.T) {
+	router, alertStore := setupSLATestHandler(t)
+
+	tests := []struct {
+		name    string
+		payload GenericPayload
+	}{
+		{
+			name: "unknown customer",
+			payload: GenericPayload{
+				Summary:     "Checkout failing",
+				Severity:    "critical",
+				Fingerprint: "fp-unknown",
+				Labels:      map[string]string{"account_id": "unknown"},
+			},
+		},
+		{
+			name: "severity without response time",
+			payload: GenericPayload{
+				Summary:     "Slow queries",
+				Severity:    "low",
+				Fingerprint: "fp-low",
+				Labels:      map[string]string{"account_id": "acme-001"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postGenericAlert(t, router, tt.payload)
+
+			if alert := alertStore.alertsByFP[tt.payload.Fingerprint]; alert.SlaDeadline != nil {
+				t.Errorf("expected no sla deadline, got %v", alert.SlaDeadline.AsTime())
+			}
+		})
+	}
+}
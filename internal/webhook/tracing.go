@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans started by the webhook handler.
+const tracerName = "github.com/kneutral-org/alerting-system/internal/webhook"
+
+// requestLoggerKey is the context key of the request logger set by traceRequests.
+type requestLoggerKey struct{}
+
+// traceRequests returns a middleware that starts a span for each request,
+// continuing the caller's trace when it sent trace context headers. The
+// request context carries a logger with the trace_id and span_id fields, used
+// by requestLogger.
+func (h *Handler) traceRequests() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := h.tracer.Start(ctx, c.Request.Method+" "+c.FullPath(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", c.FullPath()),
+			),
+		)
+		defer span.End()
+
+		if sc := span.SpanContext(); sc.IsValid() {
+			logger := h.logger.With().
+				Str("trace_id", sc.TraceID().String()).
+				Str("span_id", sc.SpanID().String()).
+				Logger()
+			ctx = context.WithValue(ctx, requestLoggerKey{}, &logger)
+		}
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		statusCode := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+		if statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+	}
+}
+
+// requestLogger returns the logger of the traced request, or the handler
+// logger outside of a traced request.
+func (h *Handler) requestLogger(ctx context.Context) *zerolog.Logger {
+	if logger, ok := ctx.Value(requestLoggerKey{}).(*zerolog.Logger); ok {
+		return logger
+	}
+	return &h.logger
+}
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/kneutral-org/alerting-system/internal/analytics"
+)
+
+// failingReceiptStore is a ReceiptStore whose Record fails, so ingestion logs a warning.
+type failingReceiptStore struct {
+	analytics.ReceiptStore
+}
+
+func (failingReceiptStore) Record(ctx context.Context, receipt *analytics.Receipt) error {
+	return errors.New("receipt log unavailable")
+}
+
+func TestTracingPropagation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	var logs bytes.Buffer
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.New(&logs),
+		WithTracerProvider(provider),
+		WithReceiptStore(failingReceiptStore{}),
+	)
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	body, _ := json.Marshal(GenericPayload{Summary: "Disk full", Severity: "warning", Fingerprint: "fp-disk-1"})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "POST /api/v1/webhook/generic/:integration_key" {
+		t.Errorf("unexpected span name %q", span.Name)
+	}
+	if got := span.SpanContext.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the caller's trace id, got %s", got)
+	}
+	if got := span.Parent.SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("expected the caller's span as parent, got %s", got)
+	}
+
+	// Ingestion warnings carry the trace and span IDs of the request
+	var entry struct {
+		Message string `json:"message"`
+		TraceID string `json:"trace_id"`
+		SpanID  string `json:"span_id"`
+	}
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("failed to parse log line %q: %v", line, err)
+		}
+		if entry.Message == "failed to record alert receipt" {
+			break
+		}
+	}
+	if entry.Message != "failed to record alert receipt" {
+		t.Fatalf("expected a receipt warning, got logs %s", logs.String())
+	}
+	if entry.TraceID != span.SpanContext.TraceID().String() {
+		t.Errorf("expected trace_id %s, got %q", span.SpanContext.TraceID(), entry.TraceID)
+	}
+	if entry.SpanID != span.SpanContext.SpanID().String() {
+		t.Errorf("expected span_id %s, got %q", span.SpanContext.SpanID(), entry.SpanID)
+	}
+}
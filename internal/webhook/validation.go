@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+// Validation failure reasons recorded in webhook_validation_failures_total.
+const (
+	ValidationReasonMissingLabel    = "missing_required_label"
+	ValidationReasonForbiddenLabel  = "forbidden_label"
+	ValidationReasonSchemaViolation = "label_schema_violation"
+)
+
+// Label schema violation reasons reported in ValidationError.Reason.
+const (
+	SchemaViolationRequired        = "required"
+	SchemaViolationTypeMismatch    = "type_mismatch"
+	SchemaViolationValueNotAllowed = "value_not_allowed"
+)
+
+// ValidationError describes a label that does not satisfy the service's label schema.
+type ValidationError struct {
+	Label   string `json:"label"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// LabelValidationErrorResponse is returned with HTTP 422 when payload labels
+// do not satisfy the service's label requirements.
+type LabelValidationErrorResponse struct {
+	Error           string   `json:"error"`
+	Message         string   `json:"message"`
+	MissingLabels   []string `json:"missingLabels,omitempty"`
+	ForbiddenLabels []string `json:"forbiddenLabels,omitempty"`
+	// SchemaViolations lists the labels violating the service's LabelSchema.
+	SchemaViolations []ValidationError `json:"schemaViolations,omitempty"`
+}
+
+// validateLabels checks labels against the service's required and forbidden label
+// keys and its label schema. It returns nil when the labels are valid.
+func validateLabels(service *store.Service, labels map[string]string) *LabelValidationErrorResponse {
+	var missing, forbidden []string
+	for _, key := range service.RequiredLabels {
+		if _, ok := labels[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	for _, key := range service.ForbiddenLabels {
+		if _, ok := labels[key]; ok {
+			forbidden = append(forbidden, key)
+		}
+	}
+
+	violations := validateLabelSchema(service.LabelSchema, labels)
+
+	if len(missing) == 0 && len(forbidden) == 0 && len(violations) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	sort.Strings(forbidden)
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing required labels: "+strings.Join(missing, ", "))
+	}
+	if len(forbidden) > 0 {
+		problems = append(problems, "forbidden labels present: "+strings.Join(forbidden, ", "))
+	}
+	for _, violation := range violations {
+		problems = append(problems, violation.Message)
+	}
+
+	return &LabelValidationErrorResponse{
+		Error:            "validationFailed",
+		Message:          strings.Join(problems, "; "),
+		MissingLabels:    missing,
+		ForbiddenLabels:  forbidden,
+		SchemaViolations: violations,
+	}
+}
+
+// validateLabelSchema checks labels against a label schema, returning one
+// violation per invalid label ordered by label key.
+func validateLabelSchema(schema map[string]store.LabelSpec, labels map[string]string) []ValidationError {
+	keys := make([]string, 0, len(schema))
+	for key := range schema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []ValidationError
+	for _, key := range keys {
+		spec := schema[key]
+		value, ok := labels[key]
+		if !ok {
+			if spec.Required {
+				violations = append(violations, ValidationError{
+					Label:   key,
+					Reason:  SchemaViolationRequired,
+					Message: fmt.Sprintf("label %s is required", key),
+				})
+			}
+			continue
+		}
+
+		if !labelValueHasType(spec.Type, value) {
+			violations = append(violations, ValidationError{
+				Label:   key,
+				Reason:  SchemaViolationTypeMismatch,
+				Message: fmt.Sprintf("label %s must be a %s, got %q", key, strings.ToLower(string(spec.Type)), value),
+			})
+			continue
+		}
+
+		if (spec.Type == store.LabelTypeEnum || len(spec.AllowedValues) > 0) && !slices.Contains(spec.AllowedValues, value) {
+			violations = append(violations, ValidationError{
+				Label:   key,
+				Reason:  SchemaViolationValueNotAllowed,
+				Message: fmt.Sprintf("label %s must be one of %s, got %q", key, strings.Join(spec.AllowedValues, ", "), value),
+			})
+		}
+	}
+	return violations
+}
+
+// labelValueHasType reports whether a label value parses as the label type.
+func labelValueHasType(labelType store.LabelType, value string) bool {
+	switch labelType {
+	case store.LabelTypeNumber:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case store.LabelTypeBoolean:
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// recordValidationFailure records a validation failure metric for each failed check.
+func (h *Handler) recordValidationFailure(serviceID string, resp *LabelValidationErrorResponse) {
+	if len(resp.MissingLabels) > 0 {
+		h.metrics.RecordValidationFailure(serviceID, ValidationReasonMissingLabel)
+	}
+	if len(resp.ForbiddenLabels) > 0 {
+		h.metrics.RecordValidationFailure(serviceID, ValidationReasonForbiddenLabel)
+	}
+	if len(resp.SchemaViolations) > 0 {
+		h.metrics.RecordValidationFailure(serviceID, ValidationReasonSchemaViolation)
+	}
+	for _, violation := range resp.SchemaViolations {
+		h.metrics.RecordLabelValidationError(serviceID, violation.Label)
+	}
+}
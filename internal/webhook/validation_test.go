@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+)
+
+func TestGenericWebhook_LabelValidation(t *testing.T) {
+	tests := []struct {
+		name                      string
+		labels                    map[string]string
+		expectedMissing           []string
+		expectedForbidden         []string
+		expectedMissingFailures   int64
+		expectedForbiddenFailures int64
+	}{
+		{
+			name:                    "missing required label",
+			labels:                  map[string]string{"team": "network"},
+			expectedMissing:         []string{"site"},
+			expectedMissingFailures: 1,
+		},
+		{
+			name:                      "present forbidden label",
+			labels:                    map[string]string{"team": "network", "site": "NYC-DC1", "debug": "true"},
+			expectedForbidden:         []string{"debug"},
+			expectedForbiddenFailures: 1,
+		},
+		{
+			name:                      "missing and forbidden labels",
+			labels:                    map[string]string{"debug": "true"},
+			expectedMissing:           []string{"site", "team"},
+			expectedForbidden:         []string{"debug"},
+			expectedMissingFailures:   1,
+			expectedForbiddenFailures: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, router, alertStore, serviceStore := setupTestHandler()
+			service := serviceStore.services["valid-key"]
+			service.RequiredLabels = []string{"team", "site"}
+			service.ForbiddenLabels = []string{"debug"}
+
+			body, _ := json.Marshal(GenericPayload{Summary: "Link down", Labels: tt.labels})
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusUnprocessableEntity {
+				t.Fatalf("expected status 422, got %d: %s", w.Code, w.Body.String())
+			}
+
+			var resp LabelValidationErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to parse response: %v", err)
+			}
+			if !reflect.DeepEqual(resp.MissingLabels, tt.expectedMissing) {
+				t.Errorf("expected missing labels %v, got %v", tt.expectedMissing, resp.MissingLabels)
+			}
+			if !reflect.DeepEqual(resp.ForbiddenLabels, tt.expectedForbidden) {
+				t.Errorf("expected forbidden labels %v, got %v", tt.expectedForbidden, resp.ForbiddenLabels)
+			}
+			if len(alertStore.alerts) != 0 {
+				t.Errorf("expected no alerts to be stored, got %d", len(alertStore.alerts))
+			}
+
+			metrics := handler.Metrics()
+			if got := metrics.ValidationFailuresTotal("svc-123", ValidationReasonMissingLabel); got != tt.expectedMissingFailures {
+				t.Errorf("expected %d missing label failures, got %d", tt.expectedMissingFailures, got)
+			}
+			if got := metrics.ValidationFailuresTotal("svc-123", ValidationReasonForbiddenLabel); got != tt.expectedForbiddenFailures {
+				t.Errorf("expected %d forbidden label failures, got %d", tt.expectedForbiddenFailures, got)
+			}
+		})
+	}
+}
+
+func TestGenericWebhook_LabelSchemaValidation(t *testing.T) {
+	schema := map[string]store.LabelSpec{
+		"env":      {Type: store.LabelTypeEnum, Required: true, AllowedValues: []string{"prod", "staging"}},
+		"port":     {Type: store.LabelTypeNumber},
+		"customer": {Type: store.LabelTypeBoolean},
+		"region":   {Type: store.LabelTypeString, AllowedValues: []string{"eu", "us"}},
+	}
+
+	tests := []struct {
+		name               string
+		labels             map[string]string
+		expectedViolations []ValidationError
+	}{
+		{
+			name:   "type mismatch",
+			labels: map[string]string{"env": "prod", "port": "http", "customer": "maybe"},
+			expectedViolations: []ValidationError{
+				{Label: "customer", Reason: SchemaViolationTypeMismatch, Message: `label customer must be a boolean, got "maybe"`},
+				{Label: "port", Reason: SchemaViolationTypeMismatch, Message: `label port must be a number, got "http"`},
+			},
+		},
+		{
+			name:   "missing required label",
+			labels: map[string]string{"port": "443"},
+			expectedViolations: []ValidationError{
+				{Label: "env", Reason: SchemaViolationRequired, Message: "label env is required"},
+			},
+		},
+		{
+			name:   "disallowed enum value",
+			labels: map[string]string{"env": "dev", "region": "apac"},
+			expectedViolations: []ValidationError{
+				{Label: "env", Reason: SchemaViolationValueNotAllowed, Message: `label env must be one of prod, staging, got "dev"`},
+				{Label: "region", Reason: SchemaViolationValueNotAllowed, Message: `label region must be one of eu, us, got "apac"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, router, alertStore, serviceStore := setupTestHandler()
+			serviceStore.services["valid-key"].LabelSchema = schema
+
+			body, _ := json.Marshal(GenericPayload{Summary: "Link down", Labels: tt.labels})
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusUnprocessableEntity {
+				t.Fatalf("expected status 422, got %d: %s", w.Code, w.Body.String())
+			}
+
+			var resp LabelValidationErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to parse response: %v", err)
+			}
+			if !reflect.DeepEqual(resp.SchemaViolations, tt.expectedViolations) {
+				t.Errorf("expected violations %v, got %v", tt.expectedViolations, resp.SchemaViolations)
+			}
+			if len(alertStore.alerts) != 0 {
+				t.Errorf("expected no alerts to be stored, got %d", len(alertStore.alerts))
+			}
+
+			metrics := handler.Metrics()
+			for _, violation := range tt.expectedViolations {
+				if got := metrics.LabelValidationErrorsTotal("svc-123", violation.Label); got != 1 {
+					t.Errorf("expected 1 validation error for label %s, got %d", violation.Label, got)
+				}
+			}
+			if got := metrics.ValidationFailuresTotal("svc-123", ValidationReasonSchemaViolation); got != 1 {
+				t.Errorf("expected 1 schema violation failure, got %d", got)
+			}
+		})
+	}
+}
+
+func TestGenericWebhook_LabelSchemaAcceptsValidLabels(t *testing.T) {
+	_, router, alertStore, serviceStore := setupTestHandler()
+	serviceStore.services["valid-key"].LabelSchema = map[string]store.LabelSpec{
+		"env":  {Type: store.LabelTypeEnum, Required: true, AllowedValues: []string{"prod"}},
+		"port": {Type: store.LabelTypeNumber},
+	}
+
+	body, _ := json.Marshal(GenericPayload{Summary: "Link down", Labels: map[string]string{"env": "prod", "port": "8443", "team": "network"}})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/generic/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(alertStore.alerts) != 1 {
+		t.Errorf("expected 1 alert to be stored, got %d", len(alertStore.alerts))
+	}
+}
@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// VictorOps message types.
+const (
+	VictorOpsMessageTypeCritical        = "CRITICAL"
+	VictorOpsMessageTypeWarning         = "WARNING"
+	VictorOpsMessageTypeInfo            = "INFO"
+	VictorOpsMessageTypeRecovery        = "RECOVERY"
+	VictorOpsMessageTypeAcknowledgement = "ACKNOWLEDGEMENT"
+)
+
+// Alert labels set from a VictorOps payload.
+const (
+	// VictorOpsMessageTypeLabel holds the message type, which extractSeverity
+	// uses as a severity hint.
+	VictorOpsMessageTypeLabel    = "message_type"
+	VictorOpsHostLabel           = "host_name"
+	VictorOpsMonitoringToolLabel = "monitoring_tool"
+)
+
+// VictorOpsPayload represents a VictorOps (Splunk On-Call) REST endpoint alert.
+type VictorOpsPayload struct {
+	MessageType       string `json:"message_type"`
+	EntityID          string `json:"entity_id"`
+	EntityDisplayName string `json:"entity_display_name,omitempty"`
+	StateMessage      string `json:"state_message,omitempty"`
+	MonitoringTool    string `json:"monitoring_tool,omitempty"`
+	HostName          string `json:"host_name,omitempty"`
+}
+
+// VictorOpsWebhook handles POST /api/v1/webhook/victorops/:integration_key
+func (h *Handler) VictorOpsWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	// Parse payload
+	var payload VictorOpsPayload
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse victorops payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid victorops payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if payload.EntityID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "entity_id is required",
+		})
+		return
+	}
+
+	status, ok := mapVictorOpsMessageType(payload.MessageType)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported victorops message_type: " + payload.MessageType,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("entityId", payload.EntityID).
+		Str("messageType", payload.MessageType).
+		Msg("processing victorops webhook")
+
+	alert, wasCreated, err := h.processVictorOpsAlert(c, service, &payload, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("entityId", payload.EntityID).
+			Msg("failed to process victorops alert")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processVictorOpsAlert(c *gin.Context, service *store.Service, payload *VictorOpsPayload, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	labels := map[string]string{
+		VictorOpsMessageTypeLabel: payload.MessageType,
+	}
+	if payload.HostName != "" {
+		labels[VictorOpsHostLabel] = payload.HostName
+	}
+	if payload.MonitoringTool != "" {
+		labels[VictorOpsMonitoringToolLabel] = payload.MonitoringTool
+	}
+
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"messageType":       payload.MessageType,
+		"entityId":          payload.EntityID,
+		"entityDisplayName": payload.EntityDisplayName,
+		"stateMessage":      payload.StateMessage,
+		"monitoringTool":    payload.MonitoringTool,
+		"hostName":          payload.HostName,
+	})
+
+	summary := payload.EntityDisplayName
+	if summary == "" {
+		summary = payload.EntityID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: generateVictorOpsFingerprint(service.ID, payload.EntityID),
+		Summary:     summary,
+		Details:     h.renderBody(service, bodySourceVictorOps, payload),
+		Severity:    extractSeverity(labels),
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_VICTOROPS,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: map[string]string{},
+		Status:      status,
+		TriggeredAt: timestamppb.New(time.Now()),
+		RawPayload:  rawPayload,
+
+		SourceInstance: payload.MonitoringTool,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_VICTOROPS),
+	}
+
+	switch status {
+	case alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED:
+		alert.AcknowledgedAt = timestamppb.Now()
+	case alertingv1.AlertStatus_ALERT_STATUS_RESOLVED:
+		alert.ResolvedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// mapVictorOpsMessageType maps a VictorOps message type to an alert status.
+func mapVictorOpsMessageType(messageType string) (alertingv1.AlertStatus, bool) {
+	switch messageType {
+	case VictorOpsMessageTypeCritical, VictorOpsMessageTypeWarning, VictorOpsMessageTypeInfo:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case VictorOpsMessageTypeAcknowledgement:
+		return alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED, true
+	case VictorOpsMessageTypeRecovery:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+func generateVictorOpsFingerprint(serviceID, entityID string) string {
+	// VictorOps correlates incidents by entity_id, so recoveries and
+	// acknowledgements carry the ID of the alert they refer to. Entity IDs are
+	// chosen by the sender, so they are scoped to the service
+	return SHA256Strategy{}.Compute(nil, "victorops", serviceID, entityID)
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func victorOpsPayload(messageType string) []byte {
+	body, _ := json.Marshal(VictorOpsPayload{
+		MessageType:       messageType,
+		EntityID:          "disk-space/db-01",
+		EntityDisplayName: "Disk space low on db-01",
+		StateMessage:      "/var is 94% full",
+		MonitoringTool:    "nagios",
+		HostName:          "db-01",
+	})
+	return body
+}
+
+func postVictorOps(router *gin.Engine, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/victorops/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestVictorOpsWebhook_Lifecycle(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postVictorOps(router, victorOpsPayload(VictorOpsMessageTypeCritical))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP[generateVictorOpsFingerprint("svc-123", "disk-space/db-01")]
+	if alert == nil {
+		t.Fatal("expected the entity id to be used as the fingerprint")
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_VICTOROPS {
+		t.Errorf("expected source VICTOROPS, got %v", alert.Source)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_CRITICAL {
+		t.Errorf("expected severity CRITICAL, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Summary != "Disk space low on db-01" {
+		t.Errorf("expected the entity display name as summary, got %q", alert.Summary)
+	}
+	if alert.Details != "/var is 94% full" {
+		t.Errorf("expected the state message as details, got %q", alert.Details)
+	}
+	if alert.Labels[VictorOpsHostLabel] != "db-01" {
+		t.Errorf("expected host_name label 'db-01', got '%s'", alert.Labels[VictorOpsHostLabel])
+	}
+	if alert.Labels[VictorOpsMonitoringToolLabel] != "nagios" {
+		t.Errorf("expected monitoring_tool label 'nagios', got '%s'", alert.Labels[VictorOpsMonitoringToolLabel])
+	}
+
+	w = postVictorOps(router, victorOpsPayload(VictorOpsMessageTypeAcknowledgement))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	acked := alertStore.alertsByFP[generateVictorOpsFingerprint("svc-123", "disk-space/db-01")]
+	if acked.Status != alertingv1.AlertStatus_ALERT_STATUS_ACKNOWLEDGED {
+		t.Errorf("expected status ACKNOWLEDGED, got %v", acked.Status)
+	}
+	if acked.AcknowledgedAt == nil {
+		t.Error("expected acknowledged_at to be set")
+	}
+
+	w = postVictorOps(router, victorOpsPayload(VictorOpsMessageTypeRecovery))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Created != 0 {
+		t.Errorf("expected the recovery to update the alert, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+
+	resolved := alertStore.alertsByFP[generateVictorOpsFingerprint("svc-123", "disk-space/db-01")]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected status RESOLVED, got %v", resolved.Status)
+	}
+	if resolved.ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestVictorOpsWebhook_InvalidPayload(t *testing.T) {
+	_, router, _, _ := setupTestHandler()
+
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{"missing entity id", []byte(`{"message_type":"CRITICAL"}`)},
+		{"unknown message type", []byte(`{"message_type":"PANIC","entity_id":"x"}`)},
+		{"malformed json", []byte(`{`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postVictorOps(router, tt.body)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d", w.Code)
+			}
+		})
+	}
+}
+
+func TestGenerateVictorOpsFingerprint_ScopedToService(t *testing.T) {
+	if generateVictorOpsFingerprint("svc-1", "disk-space/db-01") == generateVictorOpsFingerprint("svc-2", "disk-space/db-01") {
+		t.Error("expected the same entity id of different services to have different fingerprints")
+	}
+	if generateVictorOpsFingerprint("svc-1", "disk-space/db-01") == "disk-space/db-01" {
+		t.Error("expected the entity id to be hashed")
+	}
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/kneutral-org/alerting-system/internal/auth"
+	"github.com/kneutral-org/alerting-system/internal/ingestion"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func TestIngestAlert_SubmitsToWorkerPool(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	processed := make(chan *alertingv1.Alert, 1)
+	pool := ingestion.NewWorkerPool(nil, func(ctx context.Context, alert *alertingv1.Alert) error {
+		processed <- alert
+		return nil
+	}, ingestion.WorkerPoolConfig{}, zerolog.Nop())
+	pool.Start()
+	defer func() { _ = pool.Shutdown(context.Background()) }()
+
+	alertStore := newMockAlertStore()
+	handler := NewHandler(alertStore, newMockServiceStore(), zerolog.Nop(), WithWorkerPool(pool))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	postGenericAlert(t, router, GenericPayload{Summary: "Link down", Severity: "critical", Fingerprint: "fp-link"})
+
+	select {
+	case alert := <-processed:
+		stored := alertStore.alertsByFP["fp-link"]
+		if alert.Id != stored.Id {
+			t.Errorf("expected alert %q to be processed, got %q", stored.Id, alert.Id)
+		}
+		if alert == stored {
+			t.Error("expected the worker pool to process a copy of the stored alert")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the worker pool to process the alert")
+	}
+}
+
+func TestIngestAlert_SubmitsRepeatsOnlyOnStatusChange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	processed := make(chan *alertingv1.Alert, 3)
+	pool := ingestion.NewWorkerPool(nil, func(ctx context.Context, alert *alertingv1.Alert) error {
+		processed <- alert
+		return nil
+	}, ingestion.WorkerPoolConfig{}, zerolog.Nop())
+	pool.Start()
+
+	handler := NewHandler(newMockAlertStore(), newMockServiceStore(), zerolog.Nop(), WithWorkerPool(pool))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	postGenericAlert(t, router, GenericPayload{Summary: "Link down", Fingerprint: "fp-link"})
+	postGenericAlert(t, router, GenericPayload{Summary: "Link down", Fingerprint: "fp-link"})
+	postGenericAlert(t, router, GenericPayload{Summary: "Link down", Fingerprint: "fp-link", Status: "resolved"})
+
+	if err := pool.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	close(processed)
+
+	var statuses []alertingv1.AlertStatus
+	for alert := range processed {
+		statuses = append(statuses, alert.Status)
+	}
+	// Workers route the queued alerts concurrently
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i] < statuses[j] })
+	want := []alertingv1.AlertStatus{alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, alertingv1.AlertStatus_ALERT_STATUS_RESOLVED}
+	if len(statuses) != len(want) {
+		t.Fatalf("processed statuses = %v, want %v", statuses, want)
+	}
+	for i := range want {
+		if statuses[i] != want[i] {
+			t.Errorf("processed statuses = %v, want %v", statuses, want)
+		}
+	}
+}
+
+func TestIngestAlert_RoutesInServiceTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const tenantID = "6f1c2a8e-3b4d-4e5f-8a9b-0c1d2e3f4a5b"
+
+	tenants := make(chan string, 1)
+	pool := ingestion.NewWorkerPool(nil, func(ctx context.Context, alert *alertingv1.Alert) error {
+		tenant, err := auth.TenantFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		tenants <- tenant
+		return nil
+	}, ingestion.WorkerPoolConfig{}, zerolog.Nop())
+	pool.Start()
+	defer func() { _ = pool.Shutdown(context.Background()) }()
+
+	serviceStore := newMockServiceStore()
+	serviceStore.services["valid-key"].TenantID = tenantID
+	handler := NewHandler(newMockAlertStore(), serviceStore, zerolog.Nop(), WithWorkerPool(pool))
+	router := gin.New()
+	handler.RegisterRoutes(router.Group("/api/v1"))
+
+	postGenericAlert(t, router, GenericPayload{Summary: "Link down", Fingerprint: "fp-link"})
+
+	select {
+	case tenant := <-tenants:
+		if tenant != tenantID {
+			t.Errorf("expected the alert to be routed in tenant %q, got %q", tenantID, tenant)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the worker pool to process the alert")
+	}
+}
@@ -0,0 +1,210 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kneutral-org/alerting-system/internal/store"
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+// Zabbix trigger statuses.
+const (
+	ZabbixStatusProblem = "PROBLEM"
+	ZabbixStatusOK      = "OK"
+)
+
+// ZabbixHostLabel is the alert label holding the Zabbix host name.
+const ZabbixHostLabel = "zabbix_host"
+
+// ZabbixPayload represents the payload of a Zabbix webhook media type.
+type ZabbixPayload struct {
+	Event   ZabbixEvent   `json:"event"`
+	Trigger ZabbixTrigger `json:"trigger"`
+	Host    ZabbixHost    `json:"host"`
+}
+
+// ZabbixEvent represents the problem event. A recovery is sent with the ID of
+// the problem event it resolves.
+type ZabbixEvent struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Opdata string `json:"opdata,omitempty"`
+}
+
+// ZabbixTrigger represents the trigger that raised the event. Severity ranges
+// from 0 (not classified) to 5 (disaster).
+type ZabbixTrigger struct {
+	Severity int    `json:"severity"`
+	Status   string `json:"status"`
+}
+
+// ZabbixHost identifies the host the event was raised for.
+type ZabbixHost struct {
+	Name string `json:"name"`
+}
+
+// ZabbixWebhook handles POST /api/v1/webhook/zabbix/:integration_key
+func (h *Handler) ZabbixWebhook(c *gin.Context) {
+	// Validate integration key
+	service := h.validateIntegrationKey(c)
+	if service == nil {
+		return
+	}
+
+	// Parse payload
+	var payload ZabbixPayload
+	if err := c.ShouldBindBodyWithJSON(&payload); err != nil {
+		h.logger.Error().Err(err).Msg("failed to parse zabbix payload")
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "invalid zabbix payload: " + err.Error(),
+		})
+		return
+	}
+
+	// Validate payload
+	if payload.Event.ID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "event id is required",
+		})
+		return
+	}
+
+	status, ok := mapZabbixStatus(payload.Trigger.Status)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "badRequest",
+			Message: "unsupported zabbix trigger status: " + payload.Trigger.Status,
+		})
+		return
+	}
+
+	h.logger.Info().
+		Str("serviceId", service.ID).
+		Str("eventId", payload.Event.ID).
+		Str("status", payload.Trigger.Status).
+		Msg("processing zabbix webhook")
+
+	alert, wasCreated, err := h.processZabbixEvent(c, service, &payload, status)
+	if errors.Is(err, ErrAlertQuotaExceeded) {
+		h.logger.Warn().Str("serviceId", service.ID).Msg("alert quota exceeded")
+		respondQuotaExceeded(c)
+		return
+	}
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Str("eventId", payload.Event.ID).
+			Msg("failed to process zabbix event")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "internalError",
+			Message: "failed to process alert: " + err.Error(),
+		})
+		return
+	}
+
+	created := 0
+	updated := 0
+	if wasCreated {
+		created = 1
+	} else {
+		updated = 1
+	}
+
+	c.JSON(http.StatusOK, WebhookResponse{
+		Message:          "alert processed successfully",
+		AlertIds:         []string{alert.Id},
+		Created:          created,
+		Updated:          updated,
+		RoutingDecisions: h.routingDecisions(c, alert),
+	})
+}
+
+func (h *Handler) processZabbixEvent(c *gin.Context, service *store.Service, payload *ZabbixPayload, status alertingv1.AlertStatus) (*alertingv1.Alert, bool, error) {
+	labels := map[string]string{
+		"eventId": payload.Event.ID,
+	}
+	if payload.Host.Name != "" {
+		labels[ZabbixHostLabel] = payload.Host.Name
+	}
+
+	rawPayload, _ := structpb.NewStruct(map[string]interface{}{
+		"eventId":  payload.Event.ID,
+		"name":     payload.Event.Name,
+		"opdata":   payload.Event.Opdata,
+		"severity": payload.Trigger.Severity,
+		"status":   payload.Trigger.Status,
+		"host":     payload.Host.Name,
+	})
+
+	summary := payload.Event.Name
+	if summary == "" {
+		summary = "Zabbix event " + payload.Event.ID
+	}
+
+	alert := &alertingv1.Alert{
+		Fingerprint: generateZabbixFingerprint(service.ID, payload.Event.ID),
+		Summary:     summary,
+		Details:     h.renderBody(service, bodySourceZabbix, payload),
+		Severity:    mapZabbixSeverity(payload.Trigger.Severity),
+		Source:      alertingv1.AlertSource_ALERT_SOURCE_ZABBIX,
+		ServiceId:   service.ID,
+		Labels:      labels,
+		Annotations: map[string]string{},
+		Status:      status,
+		TriggeredAt: timestamppb.New(time.Now()),
+		RawPayload:  rawPayload,
+
+		IngestionMetadata: requestIngestionMetadata(c, alertingv1.SourceFormat_SOURCE_FORMAT_ZABBIX),
+	}
+
+	if status == alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		alert.ResolvedAt = timestamppb.Now()
+	}
+
+	return h.ingestAlert(c.Request.Context(), service, alert)
+}
+
+// mapZabbixStatus maps a Zabbix trigger status to an alert status.
+func mapZabbixStatus(status string) (alertingv1.AlertStatus, bool) {
+	switch status {
+	case ZabbixStatusProblem:
+		return alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED, true
+	case ZabbixStatusOK:
+		return alertingv1.AlertStatus_ALERT_STATUS_RESOLVED, true
+	default:
+		return alertingv1.AlertStatus_ALERT_STATUS_UNSPECIFIED, false
+	}
+}
+
+// mapZabbixSeverity maps a Zabbix trigger severity to an alert severity.
+func mapZabbixSeverity(severity int) alertingv1.Severity {
+	switch severity {
+	case 5: // Disaster
+		return alertingv1.Severity_SEVERITY_CRITICAL
+	case 4: // High
+		return alertingv1.Severity_SEVERITY_HIGH
+	case 3: // Average
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	case 2: // Warning
+		return alertingv1.Severity_SEVERITY_LOW
+	case 1: // Information
+		return alertingv1.Severity_SEVERITY_INFO
+	default: // Not classified
+		return alertingv1.Severity_SEVERITY_MEDIUM
+	}
+}
+
+func generateZabbixFingerprint(serviceID, eventID string) string {
+	// Zabbix sends the problem event ID with the recovery, so it identifies the
+	// alert. Event IDs are only unique per Zabbix server, so they are scoped to
+	// the service
+	return SHA256Strategy{}.Compute(nil, "zabbix", serviceID, eventID)
+}
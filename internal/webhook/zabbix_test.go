@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	alertingv1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
+)
+
+func zabbixPayload(status string, severity int) []byte {
+	body, _ := json.Marshal(ZabbixPayload{
+		Event:   ZabbixEvent{ID: "884211", Name: "High CPU utilization on web-01", Opdata: "Current utilization: 97 %"},
+		Trigger: ZabbixTrigger{Severity: severity, Status: status},
+		Host:    ZabbixHost{Name: "web-01"},
+	})
+	return body
+}
+
+func postZabbix(router *gin.Engine, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/zabbix/valid-key", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestZabbixWebhook_ProblemAndRecovery(t *testing.T) {
+	_, router, alertStore, _ := setupTestHandler()
+
+	w := postZabbix(router, zabbixPayload(ZabbixStatusProblem, 4))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	alert := alertStore.alertsByFP[generateZabbixFingerprint("svc-123", "884211")]
+	if alert == nil {
+		t.Fatal("expected the event id to be used as the fingerprint")
+	}
+	if alert.Source != alertingv1.AlertSource_ALERT_SOURCE_ZABBIX {
+		t.Errorf("expected source ZABBIX, got %v", alert.Source)
+	}
+	if alert.Severity != alertingv1.Severity_SEVERITY_HIGH {
+		t.Errorf("expected severity HIGH, got %v", alert.Severity)
+	}
+	if alert.Status != alertingv1.AlertStatus_ALERT_STATUS_TRIGGERED {
+		t.Errorf("expected status TRIGGERED, got %v", alert.Status)
+	}
+	if alert.Labels[ZabbixHostLabel] != "web-01" {
+		t.Errorf("expected zabbix_host label 'web-01', got '%s'", alert.Labels[ZabbixHostLabel])
+	}
+	if alert.Details != "Current utilization: 97 %" {
+		t.Errorf("expected the operational data as details, got %q", alert.Details)
+	}
+
+	w = postZabbix(router, zabbixPayload(ZabbixStatusOK, 4))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp WebhookResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Created != 0 {
+		t.Errorf("expected the recovery to update the alert, got created=%d updated=%d", resp.Created, resp.Updated)
+	}
+
+	resolved := alertStore.alertsByFP[generateZabbixFingerprint("svc-123", "884211")]
+	if resolved.Status != alertingv1.AlertStatus_ALERT_STATUS_RESOLVED {
+		t.Errorf("expected status RESOLVED, got %v", resolved.Status)
+	}
+	if resolved.ResolvedAt == nil {
+		t.Error("expected resolved_at to be set")
+	}
+}
+
+func TestZabbixWebhook_InvalidPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"event":`)},
+		{name: "missing event id", body: []byte(`{"event":{"name":"boom"},"trigger":{"status":"PROBLEM"}}`)},
+		{name: "unsupported status", body: zabbixPayload("UNKNOWN", 3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, router, _, _ := setupTestHandler()
+
+			w := postZabbix(router, tt.body)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestZabbixSeverityMapping(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity int
+		expected alertingv1.Severity
+	}{
+		{"not classified", 0, alertingv1.Severity_SEVERITY_MEDIUM},
+		{"information", 1, alertingv1.Severity_SEVERITY_INFO},
+		{"warning", 2, alertingv1.Severity_SEVERITY_LOW},
+		{"average", 3, alertingv1.Severity_SEVERITY_MEDIUM},
+		{"high", 4, alertingv1.Severity_SEVERITY_HIGH},
+		{"disaster", 5, alertingv1.Severity_SEVERITY_CRITICAL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapZabbixSeverity(tt.severity); got != tt.expected {
+				t.Errorf("mapZabbixSeverity(%d) = %v, expected %v", tt.severity, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateZabbixFingerprint_ScopedToService(t *testing.T) {
+	if generateZabbixFingerprint("svc-1", "884211") == generateZabbixFingerprint("svc-2", "884211") {
+		t.Error("expected the same event id of different services to have different fingerprints")
+	}
+	if generateZabbixFingerprint("svc-1", "884211") == "884211" {
+		t.Error("expected the event id to be hashed")
+	}
+}
@@ -0,0 +1,6 @@
+-- Migration: Drop handoff_notes table
+-- This migration removes the handoff notes table
+
+DROP INDEX IF EXISTS idx_handoff_notes_schedule_created;
+
+DROP TABLE IF EXISTS handoff_notes;
@@ -0,0 +1,24 @@
+-- Migration: Create handoff_notes table for shift handoff context
+-- Handoff notes are left by the outgoing on-call user for the incoming user
+
+CREATE TABLE IF NOT EXISTS handoff_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Schedule the note belongs to
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+
+    -- User who wrote the note
+    author_user_id UUID,
+
+    -- Free-form note content
+    content TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for fetching the most recent notes of a schedule
+CREATE INDEX IF NOT EXISTS idx_handoff_notes_schedule_created ON handoff_notes(schedule_id, created_at DESC);
+
+-- Comments for documentation
+COMMENT ON TABLE handoff_notes IS
+    'Notes left by the outgoing on-call user, included in handoff summaries';
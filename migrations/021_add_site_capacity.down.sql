@@ -0,0 +1,3 @@
+-- Migration: Remove capacity metrics from sites
+
+ALTER TABLE sites DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,7 @@
+-- Migration: Add capacity metrics to sites
+-- Capacity utilization is used to boost alert priority at heavily loaded sites
+
+ALTER TABLE sites ADD COLUMN IF NOT EXISTS capacity JSONB;
+
+COMMENT ON COLUMN sites.capacity IS
+    'JSON object with capacity metrics: {"totalServers": 120, "currentLoadPercent": 85.5, "lastUpdated": "..."}';
@@ -0,0 +1,5 @@
+-- Migration: Remove forced integration keys from routing rules
+
+DROP INDEX IF EXISTS idx_routing_rules_forced_keys;
+
+ALTER TABLE routing_rules DROP COLUMN IF EXISTS forced_integration_keys;
@@ -0,0 +1,10 @@
+-- Migration: Add forced integration keys to routing rules
+-- Alerts from a forced integration key evaluate the rule before priority ordering
+
+ALTER TABLE routing_rules ADD COLUMN IF NOT EXISTS forced_integration_keys JSONB NOT NULL DEFAULT '[]';
+
+-- GIN index for looking up rules forced by an integration key
+CREATE INDEX IF NOT EXISTS idx_routing_rules_forced_keys ON routing_rules USING GIN(forced_integration_keys);
+
+COMMENT ON COLUMN routing_rules.forced_integration_keys IS
+    'Integration keys whose alerts always evaluate this rule first; a match stops further rule evaluation';
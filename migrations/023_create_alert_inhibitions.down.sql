@@ -0,0 +1,6 @@
+-- Migration: Drop alert_inhibitions table
+-- This migration removes alert inhibition rules
+
+DROP INDEX IF EXISTS idx_alert_inhibitions_service;
+
+DROP TABLE IF EXISTS alert_inhibitions;
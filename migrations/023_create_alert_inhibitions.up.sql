@@ -0,0 +1,47 @@
+-- Migration: Create alert_inhibitions table for inhibition rules
+-- Inhibition rules suppress target alerts while a matching source alert is firing
+
+-- Alert inhibitions table
+CREATE TABLE IF NOT EXISTS alert_inhibitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Service the rule applies to (NULL applies to all services)
+    service_id VARCHAR(255),
+
+    -- Human-readable rule name
+    name VARCHAR(255) NOT NULL,
+
+    -- Labels the firing (source) alert must have
+    -- Example: {"alertname": "NodeDown"}
+    source_matchers JSONB NOT NULL DEFAULT '{}',
+
+    -- Labels the incoming (target) alert must have to be inhibited
+    -- Example: {"severity": "warning"}
+    target_matchers JSONB NOT NULL DEFAULT '{}',
+
+    -- Label names whose values must be equal on source and target
+    -- Example: ["cluster", "instance"]
+    equal JSONB NOT NULL DEFAULT '[]',
+
+    -- Disabled rules are ignored during ingestion
+    enabled BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for looking up enabled rules by service during ingestion
+CREATE INDEX IF NOT EXISTS idx_alert_inhibitions_service ON alert_inhibitions(service_id) WHERE enabled = true;
+
+-- Comments for documentation
+COMMENT ON TABLE alert_inhibitions IS
+    'Inhibition rules that suppress target alerts while a matching source alert is triggered';
+
+COMMENT ON COLUMN alert_inhibitions.source_matchers IS
+    'JSON object of label equality matchers the triggered source alert must satisfy';
+
+COMMENT ON COLUMN alert_inhibitions.target_matchers IS
+    'JSON object of label equality matchers the incoming target alert must satisfy';
+
+COMMENT ON COLUMN alert_inhibitions.equal IS
+    'JSON array of label names that must have equal values on source and target alerts';
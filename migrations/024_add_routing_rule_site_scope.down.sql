@@ -0,0 +1,4 @@
+-- Migration: Remove site scope from routing rules
+
+ALTER TABLE routing_rules DROP COLUMN IF EXISTS affected_site_types;
+ALTER TABLE routing_rules DROP COLUMN IF EXISTS affected_regions;
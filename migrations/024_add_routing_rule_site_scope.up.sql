@@ -0,0 +1,10 @@
+-- Migration: Add site scope to routing rules
+-- Scoped rules only apply to alerts whose site_code resolves to a site in the listed regions/types
+
+ALTER TABLE routing_rules ADD COLUMN IF NOT EXISTS affected_regions JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE routing_rules ADD COLUMN IF NOT EXISTS affected_site_types JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN routing_rules.affected_regions IS
+    'Site regions this rule applies to; empty applies to all regions';
+COMMENT ON COLUMN routing_rules.affected_site_types IS
+    'Site types (SiteType enum names) this rule applies to; empty applies to all site types';
@@ -0,0 +1,17 @@
+-- Migration: Restore customer IP ranges as a JSON array of CIDR strings
+
+DROP INDEX IF EXISTS idx_customers_ip_ranges;
+
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS ip_ranges_json JSONB NOT NULL DEFAULT '[]';
+
+UPDATE customers
+SET ip_ranges_json = (SELECT COALESCE(jsonb_agg(text(r)), '[]'::jsonb) FROM unnest(ip_ranges) AS r)
+WHERE cardinality(ip_ranges) > 0;
+
+ALTER TABLE customers DROP COLUMN ip_ranges;
+ALTER TABLE customers RENAME COLUMN ip_ranges_json TO ip_ranges;
+
+CREATE INDEX IF NOT EXISTS idx_customers_ip_ranges ON customers USING GIN (ip_ranges);
+
+COMMENT ON COLUMN customers.ip_ranges IS
+    'JSON array of CIDR ranges for IP-based customer lookup';
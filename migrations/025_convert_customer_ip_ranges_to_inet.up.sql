@@ -0,0 +1,19 @@
+-- Migration: Store customer IP ranges as a native inet array
+-- Matches CIDRs in SQL instead of parsing the ranges of every customer in the application
+
+DROP INDEX IF EXISTS idx_customers_ip_ranges;
+
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS ip_ranges_inet INET[] NOT NULL DEFAULT '{}';
+
+UPDATE customers
+SET ip_ranges_inet = ARRAY(SELECT jsonb_array_elements_text(ip_ranges)::inet)
+WHERE jsonb_array_length(ip_ranges) > 0;
+
+ALTER TABLE customers DROP COLUMN ip_ranges;
+ALTER TABLE customers RENAME COLUMN ip_ranges_inet TO ip_ranges;
+
+-- No index: GIN has no inet operator class for <<= ANY(ip_ranges), so the
+-- lookup scans the customers of the tenant
+
+COMMENT ON COLUMN customers.ip_ranges IS
+    'CIDR ranges for IP-based customer lookup, matched with $1::inet <<= ANY(ip_ranges)';
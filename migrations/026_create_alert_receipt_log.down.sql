@@ -0,0 +1,7 @@
+-- Migration: Drop alert_receipt_log table
+-- This migration removes deduplication analytics receipts
+
+DROP INDEX IF EXISTS idx_alert_receipt_log_created;
+DROP INDEX IF EXISTS idx_alert_receipt_log_service_created;
+
+DROP TABLE IF EXISTS alert_receipt_log;
@@ -0,0 +1,30 @@
+-- Migration: Create alert_receipt_log table for deduplication analytics
+-- Every webhook hit is recorded, whether it created a new alert or was deduplicated
+
+CREATE TABLE IF NOT EXISTS alert_receipt_log (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- Fingerprint the received alert was deduplicated on
+    fingerprint VARCHAR(255) NOT NULL,
+
+    -- Service whose integration received the alert
+    service_id VARCHAR(255) NOT NULL,
+
+    -- Whether the receipt created a new alert (false when deduplicated)
+    was_new BOOLEAN NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for windowed statistics per service
+CREATE INDEX IF NOT EXISTS idx_alert_receipt_log_service_created ON alert_receipt_log(service_id, created_at);
+
+-- Index for windowed statistics across all services and retention management
+CREATE INDEX IF NOT EXISTS idx_alert_receipt_log_created ON alert_receipt_log(created_at);
+
+-- Comments for documentation
+COMMENT ON TABLE alert_receipt_log IS
+    'One row per alert received by a webhook, used to measure fingerprint deduplication';
+
+COMMENT ON COLUMN alert_receipt_log.was_new IS
+    'True when the receipt created a new alert, false when it matched an existing fingerprint';
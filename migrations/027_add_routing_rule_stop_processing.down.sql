@@ -0,0 +1,3 @@
+-- Migration: Remove stop_processing flag from routing rules
+
+ALTER TABLE routing_rules DROP COLUMN IF EXISTS stop_processing;
@@ -0,0 +1,7 @@
+-- Migration: Add stop_processing flag to routing rules
+-- A matching stop_processing rule skips all lower-priority rules
+
+ALTER TABLE routing_rules ADD COLUMN IF NOT EXISTS stop_processing BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN routing_rules.stop_processing IS
+    'When true, a match stops evaluation of all lower-priority rules';
@@ -0,0 +1,5 @@
+-- Migration: Remove metadata labels from maintenance windows
+
+DROP INDEX IF EXISTS idx_maint_labels;
+
+ALTER TABLE maintenance_windows DROP COLUMN IF EXISTS labels;
@@ -0,0 +1,9 @@
+-- Migration: Add metadata labels to maintenance windows
+-- Labels record where a window came from, e.g. source=alertmanager with the silence ID as external_id
+
+ALTER TABLE maintenance_windows ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_maint_labels ON maintenance_windows USING GIN(labels);
+
+COMMENT ON COLUMN maintenance_windows.labels IS
+    'Metadata labels, e.g. {"source": "alertmanager", "external_id": "<silence id>"}';
@@ -0,0 +1,3 @@
+-- Migration: Remove ingestion metadata from alerts
+
+ALTER TABLE IF EXISTS alerts DROP COLUMN IF EXISTS ingestion_metadata;
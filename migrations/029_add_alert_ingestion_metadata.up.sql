@@ -0,0 +1,6 @@
+-- Migration: Add ingestion metadata to alerts
+-- Records the webhook format and route an alert was received on and the SHA-256 of the raw body,
+-- e.g. {"source_format": "SOURCE_FORMAT_GRAFANA", "ingest_endpoint": "...", "ingest_timestamp": "...", "raw_payload_hash": "..."}
+-- The alerts table is not created by these migrations, so the column is only added where it exists.
+
+ALTER TABLE IF EXISTS alerts ADD COLUMN IF NOT EXISTS ingestion_metadata JSONB;
@@ -0,0 +1,6 @@
+-- Migration: Drop alert_forwarding_rules table
+-- This migration removes alert forwarding rules
+
+DROP INDEX IF EXISTS idx_alert_forwarding_rules_enabled;
+
+DROP TABLE IF EXISTS alert_forwarding_rules;
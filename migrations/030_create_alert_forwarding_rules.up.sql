@@ -0,0 +1,38 @@
+-- Migration: Create alert_forwarding_rules table for cross-system alert forwarding
+-- Forwarding rules replicate matching alerts to another instance for DR or multi-region setups
+
+CREATE TABLE IF NOT EXISTS alert_forwarding_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Human-readable rule name
+    name VARCHAR(255) NOT NULL,
+
+    -- Labels an ingested alert must have to be forwarded (empty forwards every alert)
+    -- Example: {"region": "eu-west-1"}
+    source_label_matchers JSONB NOT NULL DEFAULT '{}',
+
+    -- URL the alert is POSTed to, typically another instance's generic webhook
+    destination_url TEXT NOT NULL,
+
+    -- Secret used to sign the forwarded payload with HMAC-SHA256 (empty disables signing)
+    hmac_secret TEXT NOT NULL DEFAULT '',
+
+    -- Timeout of each forwarding request in milliseconds (0 uses the default)
+    max_latency_ms BIGINT NOT NULL DEFAULT 0,
+
+    -- Disabled rules are ignored during ingestion
+    enabled BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for looking up enabled rules during ingestion
+CREATE INDEX IF NOT EXISTS idx_alert_forwarding_rules_enabled ON alert_forwarding_rules(created_at) WHERE enabled = true;
+
+-- Comments for documentation
+COMMENT ON TABLE alert_forwarding_rules IS
+    'Rules that forward matching ingested alerts to another alerting system instance';
+
+COMMENT ON COLUMN alert_forwarding_rules.source_label_matchers IS
+    'JSON object of label equality matchers the ingested alert must satisfy';
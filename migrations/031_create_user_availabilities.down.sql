@@ -0,0 +1,6 @@
+-- Migration: Drop user_availabilities table
+-- This migration removes user on-call availability periods
+
+DROP INDEX IF EXISTS idx_user_availabilities_user_period;
+
+DROP TABLE IF EXISTS user_availabilities;
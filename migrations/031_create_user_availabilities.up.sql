@@ -0,0 +1,35 @@
+-- Migration: Create user_availabilities table for on-call availability
+-- Users mark periods when they are unavailable (vacation, sick, training) so
+-- managers can check availability before assigning shifts
+
+CREATE TABLE IF NOT EXISTS user_availabilities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- User ID references users in kneutral-api (external reference)
+    user_id UUID NOT NULL,
+
+    -- Whether the user can take on-call shifts during the period
+    available BOOLEAN NOT NULL,
+
+    -- Why the user is unavailable: vacation, sick, training (empty when available)
+    unavailable_reason VARCHAR(50) NOT NULL DEFAULT ''
+        CHECK (unavailable_reason IN ('', 'vacation', 'sick', 'training')),
+
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CHECK (period_end > period_start),
+
+    -- Setting the same period again replaces it
+    UNIQUE (user_id, period_start, period_end)
+);
+
+-- Index for finding the periods of users overlapping a time range
+CREATE INDEX IF NOT EXISTS idx_user_availabilities_user_period ON user_availabilities(user_id, period_start, period_end);
+
+-- Comments for documentation
+COMMENT ON TABLE user_availabilities IS
+    'Periods during which users are available or unavailable for on-call shifts';
@@ -0,0 +1,3 @@
+-- Migration: Remove stopped_at_rule_id from routing audit logs
+
+ALTER TABLE routing_audit_logs DROP COLUMN IF EXISTS stopped_at_rule_id;
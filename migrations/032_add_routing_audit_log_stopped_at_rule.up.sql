@@ -0,0 +1,7 @@
+-- Migration: Record the rule that stopped routing evaluation
+-- Set when a stop_processing, terminal or forced rule matched
+
+ALTER TABLE routing_audit_logs ADD COLUMN IF NOT EXISTS stopped_at_rule_id UUID;
+
+COMMENT ON COLUMN routing_audit_logs.stopped_at_rule_id IS
+    'Rule whose match stopped evaluation of lower-priority rules, NULL if all rules were evaluated';
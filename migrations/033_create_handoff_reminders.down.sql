@@ -0,0 +1,8 @@
+-- Migration: Drop handoff_reminders table and schedule handoff reminder settings
+
+DROP INDEX IF EXISTS idx_handoff_reminders_handoff_at;
+
+DROP TABLE IF EXISTS handoff_reminders;
+
+ALTER TABLE schedules DROP COLUMN IF EXISTS handoff_reminder_channel;
+ALTER TABLE schedules DROP COLUMN IF EXISTS handoff_reminder_notice_seconds;
@@ -0,0 +1,30 @@
+-- Migration: Create handoff_reminders table and schedule handoff reminder settings
+-- Reminders are sent to the outgoing and incoming users ahead of a rotation handoff
+
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS handoff_reminder_notice_seconds INTEGER;
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS handoff_reminder_channel VARCHAR(50);
+
+COMMENT ON COLUMN schedules.handoff_reminder_notice_seconds IS
+    'How long before a handoff reminders are sent, NULL if the schedule has no reminder config';
+COMMENT ON COLUMN schedules.handoff_reminder_channel IS
+    'ChannelType name the reminders are delivered on';
+
+CREATE TABLE IF NOT EXISTS handoff_reminders (
+    -- Schedule and rotation the handoff belongs to
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    rotation_id UUID NOT NULL REFERENCES rotations(id) ON DELETE CASCADE,
+
+    -- Time of the handoff the reminder was sent for
+    handoff_at TIMESTAMPTZ NOT NULL,
+
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (schedule_id, rotation_id, handoff_at)
+);
+
+-- Index for purging reminders of past handoffs
+CREATE INDEX IF NOT EXISTS idx_handoff_reminders_handoff_at ON handoff_reminders(handoff_at);
+
+-- Comments for documentation
+COMMENT ON TABLE handoff_reminders IS
+    'Handoffs that reminders were sent for, so each handoff is announced once across replicas';
@@ -0,0 +1,3 @@
+-- Migration: Remove recurrence rules from maintenance windows
+
+ALTER TABLE maintenance_windows DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,7 @@
+-- Migration: Add recurrence rules to maintenance windows
+-- start_time and end_time hold the first occurrence; List expands the rest on read
+
+ALTER TABLE maintenance_windows ADD COLUMN IF NOT EXISTS recurrence JSONB;
+
+COMMENT ON COLUMN maintenance_windows.recurrence IS
+    'Recurrence rule, e.g. {"frequency": "RECURRENCE_FREQUENCY_WEEKLY", "daysOfWeek": [0]}; NULL for one-off windows';
@@ -0,0 +1,12 @@
+-- Migration: Remove change-board approval from maintenance windows
+
+DROP TABLE IF EXISTS maintenance_window_audit_log;
+
+UPDATE maintenance_windows SET status = 'cancelled' WHERE status = 'pending_approval';
+
+ALTER TABLE maintenance_windows DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE maintenance_windows ADD CONSTRAINT valid_status
+    CHECK (status IN ('scheduled', 'active', 'completed', 'cancelled'));
+
+ALTER TABLE maintenance_windows DROP COLUMN IF EXISTS approved_at;
+ALTER TABLE maintenance_windows DROP COLUMN IF EXISTS requires_approval;
@@ -0,0 +1,27 @@
+-- Migration: Add change-board approval to maintenance windows
+-- Windows that require approval stay pending_approval until approved and are never started before
+
+ALTER TABLE maintenance_windows ADD COLUMN IF NOT EXISTS requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE maintenance_windows ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ;
+
+ALTER TABLE maintenance_windows DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE maintenance_windows ADD CONSTRAINT valid_status
+    CHECK (status IN ('pending_approval', 'scheduled', 'active', 'completed', 'cancelled'));
+
+-- Audit trail of maintenance window events such as approvals.
+-- Entries are kept when the window is deleted.
+CREATE TABLE IF NOT EXISTS maintenance_window_audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    window_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_maint_audit_window ON maintenance_window_audit_log(window_id, created_at);
+
+COMMENT ON COLUMN maintenance_windows.requires_approval IS
+    'Window must be approved before it is scheduled, e.g. for high-severity services';
+
+COMMENT ON TABLE maintenance_window_audit_log IS
+    'Audit trail of maintenance window events, e.g. action=approved with the approver as actor';
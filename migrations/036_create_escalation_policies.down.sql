@@ -0,0 +1,6 @@
+-- Migration: Drop escalation_policies table
+
+ALTER TABLE sites DROP CONSTRAINT IF EXISTS fk_sites_default_escalation_policy;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_teams_default_escalation_policy;
+
+DROP TABLE IF EXISTS escalation_policies;
@@ -0,0 +1,40 @@
+-- Migration: Create escalation_policies table
+-- Escalation policies define who is notified, and when, until an alert is acknowledged
+
+CREATE TABLE IF NOT EXISTS escalation_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Human-readable policy name (e.g., "Network Critical", "DC Ops Business Hours")
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT,
+
+    -- Ordered escalation steps as JSON array
+    -- Example: [{"delayMs": 0, "targets": [{"type": "schedule", "id": "uuid"}]},
+    --           {"delayMs": 900000, "targets": [{"type": "team", "id": "uuid"}]}]
+    steps JSONB NOT NULL DEFAULT '[]',
+
+    -- Number of times the policy repeats once its last step has run
+    repeat_count INTEGER NOT NULL DEFAULT 0,
+
+    -- Wait between repeats of the policy in milliseconds
+    repeat_interval_ms BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT valid_repeat CHECK (repeat_count >= 0 AND repeat_interval_ms >= 0)
+);
+
+-- Teams and sites reference their default escalation policy
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_teams_default_escalation_policy;
+ALTER TABLE teams ADD CONSTRAINT fk_teams_default_escalation_policy
+    FOREIGN KEY (default_escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE SET NULL NOT VALID;
+
+ALTER TABLE sites DROP CONSTRAINT IF EXISTS fk_sites_default_escalation_policy;
+ALTER TABLE sites ADD CONSTRAINT fk_sites_default_escalation_policy
+    FOREIGN KEY (default_escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE SET NULL NOT VALID;
+
+COMMENT ON TABLE escalation_policies IS
+    'Escalation policies: ordered steps of targets (user, team or schedule) notified after a delay';
+COMMENT ON COLUMN escalation_policies.steps IS
+    'Ordered steps, each with delayMs after the previous step and its targets';
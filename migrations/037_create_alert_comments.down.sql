@@ -0,0 +1,3 @@
+-- Migration: Drop alert_comments table
+
+DROP TABLE IF EXISTS alert_comments;
@@ -0,0 +1,40 @@
+-- Migration: Create alert_comments table
+-- Comments are notes engineers leave on an alert during an incident
+
+CREATE TABLE IF NOT EXISTS alert_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Alert the comment was left on
+    alert_id UUID NOT NULL,
+
+    -- User who wrote the comment
+    author_id VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Set when the comment is deleted; deleted comments are hidden but kept
+    deleted_at TIMESTAMPTZ
+);
+
+-- The alerts table is not created by these migrations, so the foreign key is only added where it exists
+DO $$
+BEGIN
+    IF to_regclass('alerts') IS NOT NULL THEN
+        ALTER TABLE alert_comments DROP CONSTRAINT IF EXISTS fk_alert_comments_alert;
+        ALTER TABLE alert_comments ADD CONSTRAINT fk_alert_comments_alert
+            FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE;
+    END IF;
+END $$;
+
+-- Index for listing the comments of an alert in order
+CREATE INDEX IF NOT EXISTS idx_alert_comments_alert ON alert_comments(alert_id, created_at, id) WHERE deleted_at IS NULL;
+
+-- Index for the recent comments of handoff summaries
+CREATE INDEX IF NOT EXISTS idx_alert_comments_created_at ON alert_comments(created_at) WHERE deleted_at IS NULL;
+
+-- Comments for documentation
+COMMENT ON TABLE alert_comments IS
+    'Notes left on alerts by engineers, shown in the alert and in handoff summaries';
+COMMENT ON COLUMN alert_comments.deleted_at IS
+    'When the comment was deleted, NULL for visible comments';
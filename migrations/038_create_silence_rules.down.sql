@@ -0,0 +1,3 @@
+-- Migration: Drop silence_rules table
+
+DROP TABLE IF EXISTS silence_rules;
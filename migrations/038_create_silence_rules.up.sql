@@ -0,0 +1,33 @@
+-- Migration: Create silence_rules table
+-- Silence rules suppress every incoming alert matching their label matchers while active
+
+CREATE TABLE IF NOT EXISTS silence_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Label matchers an alert must all satisfy as JSON array
+    -- Example: [{"name": "env", "value": "staging", "operator": "="},
+    --           {"name": "cluster", "value": "ams[0-9]+", "operator": "=~"}]
+    matchers JSONB NOT NULL DEFAULT '[]',
+
+    -- When the silence is active
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+
+    comment TEXT,
+    created_by VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT valid_silence_time_range CHECK (ends_at > starts_at)
+);
+
+-- Index for looking up active silences during ingestion
+CREATE INDEX IF NOT EXISTS idx_silence_rules_active ON silence_rules(starts_at, ends_at);
+
+-- Comments for documentation
+COMMENT ON TABLE silence_rules IS
+    'Silence rules that suppress incoming alerts matching all of their label matchers between starts_at and ends_at';
+
+COMMENT ON COLUMN silence_rules.matchers IS
+    'JSON array of label matchers with an operator of =, !=, =~ or !~; regular expressions are anchored';
@@ -0,0 +1,17 @@
+-- Migration: Remove tenants from routing rules, routing audit logs, schedules, maintenance windows and customers
+-- Fails when two tenants share a rule priority or customer account ID
+
+DROP INDEX IF EXISTS idx_maint_tenant;
+DROP INDEX IF EXISTS idx_schedules_tenant;
+DROP INDEX IF EXISTS idx_routing_audit_tenant;
+
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_tenant_account_id_key;
+ALTER TABLE customers ADD CONSTRAINT customers_account_id_key UNIQUE (account_id);
+ALTER TABLE routing_rules DROP CONSTRAINT IF EXISTS routing_rules_tenant_priority_key;
+ALTER TABLE routing_rules ADD CONSTRAINT routing_rules_priority_key UNIQUE (priority);
+
+ALTER TABLE customers DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE maintenance_windows DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE schedules DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE routing_audit_logs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE routing_rules DROP COLUMN IF EXISTS tenant_id;
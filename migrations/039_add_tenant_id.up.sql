@@ -0,0 +1,37 @@
+-- Migration: Scope routing rules, routing audit logs, schedules, maintenance windows and customers to tenants
+-- Existing rows belong to the default tenant, the tenant of unauthenticated callers
+
+ALTER TABLE routing_rules ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE routing_audit_logs ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE maintenance_windows ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+-- The default only backfills existing rows; new rows must name their tenant
+ALTER TABLE routing_rules ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE routing_audit_logs ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE schedules ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE maintenance_windows ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customers ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- Rule priorities and customer account IDs are unique within a tenant
+ALTER TABLE routing_rules DROP CONSTRAINT IF EXISTS routing_rules_priority_key;
+ALTER TABLE routing_rules ADD CONSTRAINT routing_rules_tenant_priority_key UNIQUE (tenant_id, priority);
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_account_id_key;
+ALTER TABLE customers ADD CONSTRAINT customers_tenant_account_id_key UNIQUE (tenant_id, account_id);
+
+-- Indexes for tenant-scoped lookups; the unique constraints above index rules and customers
+CREATE INDEX IF NOT EXISTS idx_routing_audit_tenant ON routing_audit_logs(tenant_id, timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_schedules_tenant ON schedules(tenant_id, name);
+CREATE INDEX IF NOT EXISTS idx_maint_tenant ON maintenance_windows(tenant_id, start_time);
+
+COMMENT ON COLUMN routing_rules.tenant_id IS
+    'Tenant owning the rule, 00000000-0000-0000-0000-000000000000 for the default tenant';
+COMMENT ON COLUMN routing_audit_logs.tenant_id IS
+    'Tenant whose alert was routed';
+COMMENT ON COLUMN schedules.tenant_id IS
+    'Tenant owning the schedule; rotations, overrides and handoff notes belong to the tenant of their schedule';
+COMMENT ON COLUMN maintenance_windows.tenant_id IS
+    'Tenant owning the maintenance window';
+COMMENT ON COLUMN customers.tenant_id IS
+    'Tenant owning the customer';
@@ -0,0 +1,3 @@
+-- Migration: Drop pending_action_retries table
+
+DROP TABLE IF EXISTS pending_action_retries;
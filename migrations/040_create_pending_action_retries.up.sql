@@ -0,0 +1,37 @@
+-- Migration: Create pending_action_retries table
+-- Dead-letter queue of routing actions that failed with a retryable error
+
+CREATE TABLE IF NOT EXISTS pending_action_retries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Rule the action belongs to, if the action was executed for a rule
+    rule_id VARCHAR(255),
+    -- Position of the action in the executed action list
+    action_index INTEGER NOT NULL,
+
+    alert_id VARCHAR(255) NOT NULL,
+
+    -- The alert and the action as they were when the action first failed
+    alert_snapshot JSONB NOT NULL,
+    action JSONB NOT NULL,
+
+    -- Executions so far, including the first one
+    attempt_count INTEGER NOT NULL DEFAULT 1,
+    -- When the action is retried next, NULL once retries are exhausted
+    next_retry_at TIMESTAMPTZ,
+    last_error TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for polling the retries that are due
+CREATE INDEX IF NOT EXISTS idx_pending_action_retries_due ON pending_action_retries(next_retry_at)
+    WHERE next_retry_at IS NOT NULL;
+
+-- Comments for documentation
+COMMENT ON TABLE pending_action_retries IS
+    'Routing actions that failed with a retryable error, retried with exponential backoff by the retry worker';
+
+COMMENT ON COLUMN pending_action_retries.next_retry_at IS
+    'When the action is retried next; NULL for dead letters whose retries are exhausted';
@@ -49,6 +49,12 @@ const (
 	ConditionType_CONDITION_TYPE_CARRIER        ConditionType = 10
 	// Advanced CEL expression
 	ConditionType_CONDITION_TYPE_CEL ConditionType = 11
+	// JSON-encoded TimeWindow in string_value, evaluated in the timezone of the
+	// schedule the rule notifies, or UTC
+	ConditionType_CONDITION_TYPE_TIME_WINDOW ConditionType = 12
+	// Matches when the correlation group of the alert has at least int_value
+	// open alerts
+	ConditionType_CONDITION_TYPE_GROUP_SIZE ConditionType = 13
 )
 
 // Enum value maps for ConditionType.
@@ -66,6 +72,8 @@ var (
 		9:  "CONDITION_TYPE_EQUIPMENT_TYPE",
 		10: "CONDITION_TYPE_CARRIER",
 		11: "CONDITION_TYPE_CEL",
+		12: "CONDITION_TYPE_TIME_WINDOW",
+		13: "CONDITION_TYPE_GROUP_SIZE",
 	}
 	ConditionType_value = map[string]int32{
 		"CONDITION_TYPE_UNSPECIFIED":    0,
@@ -80,6 +88,8 @@ var (
 		"CONDITION_TYPE_EQUIPMENT_TYPE": 9,
 		"CONDITION_TYPE_CARRIER":        10,
 		"CONDITION_TYPE_CEL":            11,
+		"CONDITION_TYPE_TIME_WINDOW":    12,
+		"CONDITION_TYPE_GROUP_SIZE":     13,
 	}
 )
 
@@ -113,20 +123,29 @@ func (ConditionType) EnumDescriptor() ([]byte, []int) {
 type ConditionOperator int32
 
 const (
-	ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED  ConditionOperator = 0
-	ConditionOperator_CONDITION_OPERATOR_EQUALS       ConditionOperator = 1
-	ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS   ConditionOperator = 2
-	ConditionOperator_CONDITION_OPERATOR_CONTAINS     ConditionOperator = 3
-	ConditionOperator_CONDITION_OPERATOR_NOT_CONTAINS ConditionOperator = 4
-	ConditionOperator_CONDITION_OPERATOR_STARTS_WITH  ConditionOperator = 5
-	ConditionOperator_CONDITION_OPERATOR_ENDS_WITH    ConditionOperator = 6
-	ConditionOperator_CONDITION_OPERATOR_REGEX        ConditionOperator = 7
-	ConditionOperator_CONDITION_OPERATOR_IN           ConditionOperator = 8
-	ConditionOperator_CONDITION_OPERATOR_NOT_IN       ConditionOperator = 9
-	ConditionOperator_CONDITION_OPERATOR_EXISTS       ConditionOperator = 10
-	ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS   ConditionOperator = 11
-	ConditionOperator_CONDITION_OPERATOR_GREATER_THAN ConditionOperator = 12
-	ConditionOperator_CONDITION_OPERATOR_LESS_THAN    ConditionOperator = 13
+	ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED     ConditionOperator = 0
+	ConditionOperator_CONDITION_OPERATOR_EQUALS          ConditionOperator = 1
+	ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS      ConditionOperator = 2
+	ConditionOperator_CONDITION_OPERATOR_CONTAINS        ConditionOperator = 3
+	ConditionOperator_CONDITION_OPERATOR_NOT_CONTAINS    ConditionOperator = 4
+	ConditionOperator_CONDITION_OPERATOR_STARTS_WITH     ConditionOperator = 5
+	ConditionOperator_CONDITION_OPERATOR_ENDS_WITH       ConditionOperator = 6
+	ConditionOperator_CONDITION_OPERATOR_REGEX           ConditionOperator = 7
+	ConditionOperator_CONDITION_OPERATOR_IN              ConditionOperator = 8
+	ConditionOperator_CONDITION_OPERATOR_NOT_IN          ConditionOperator = 9
+	ConditionOperator_CONDITION_OPERATOR_EXISTS          ConditionOperator = 10
+	ConditionOperator_CONDITION_OPERATOR_NOT_EXISTS      ConditionOperator = 11
+	ConditionOperator_CONDITION_OPERATOR_GREATER_THAN    ConditionOperator = 12
+	ConditionOperator_CONDITION_OPERATOR_LESS_THAN       ConditionOperator = 13
+	ConditionOperator_CONDITION_OPERATOR_REGEX_MATCH     ConditionOperator = 14 // Pattern in string_value
+	ConditionOperator_CONDITION_OPERATOR_REGEX_NOT_MATCH ConditionOperator = 15 // Pattern in string_value
+	// Numeric comparisons of the severity ordinal (field "severity") or a
+	// label parsed as a float (field "labels.<key>")
+	ConditionOperator_CONDITION_OPERATOR_NUMERIC_GT  ConditionOperator = 16
+	ConditionOperator_CONDITION_OPERATOR_NUMERIC_GTE ConditionOperator = 17
+	ConditionOperator_CONDITION_OPERATOR_NUMERIC_LT  ConditionOperator = 18
+	ConditionOperator_CONDITION_OPERATOR_NUMERIC_LTE ConditionOperator = 19
+	ConditionOperator_CONDITION_OPERATOR_NUMERIC_EQ  ConditionOperator = 20
 )
 
 // Enum value maps for ConditionOperator.
@@ -146,22 +165,36 @@ var (
 		11: "CONDITION_OPERATOR_NOT_EXISTS",
 		12: "CONDITION_OPERATOR_GREATER_THAN",
 		13: "CONDITION_OPERATOR_LESS_THAN",
+		14: "CONDITION_OPERATOR_REGEX_MATCH",
+		15: "CONDITION_OPERATOR_REGEX_NOT_MATCH",
+		16: "CONDITION_OPERATOR_NUMERIC_GT",
+		17: "CONDITION_OPERATOR_NUMERIC_GTE",
+		18: "CONDITION_OPERATOR_NUMERIC_LT",
+		19: "CONDITION_OPERATOR_NUMERIC_LTE",
+		20: "CONDITION_OPERATOR_NUMERIC_EQ",
 	}
 	ConditionOperator_value = map[string]int32{
-		"CONDITION_OPERATOR_UNSPECIFIED":  0,
-		"CONDITION_OPERATOR_EQUALS":       1,
-		"CONDITION_OPERATOR_NOT_EQUALS":   2,
-		"CONDITION_OPERATOR_CONTAINS":     3,
-		"CONDITION_OPERATOR_NOT_CONTAINS": 4,
-		"CONDITION_OPERATOR_STARTS_WITH":  5,
-		"CONDITION_OPERATOR_ENDS_WITH":    6,
-		"CONDITION_OPERATOR_REGEX":        7,
-		"CONDITION_OPERATOR_IN":           8,
-		"CONDITION_OPERATOR_NOT_IN":       9,
-		"CONDITION_OPERATOR_EXISTS":       10,
-		"CONDITION_OPERATOR_NOT_EXISTS":   11,
-		"CONDITION_OPERATOR_GREATER_THAN": 12,
-		"CONDITION_OPERATOR_LESS_THAN":    13,
+		"CONDITION_OPERATOR_UNSPECIFIED":     0,
+		"CONDITION_OPERATOR_EQUALS":          1,
+		"CONDITION_OPERATOR_NOT_EQUALS":      2,
+		"CONDITION_OPERATOR_CONTAINS":        3,
+		"CONDITION_OPERATOR_NOT_CONTAINS":    4,
+		"CONDITION_OPERATOR_STARTS_WITH":     5,
+		"CONDITION_OPERATOR_ENDS_WITH":       6,
+		"CONDITION_OPERATOR_REGEX":           7,
+		"CONDITION_OPERATOR_IN":              8,
+		"CONDITION_OPERATOR_NOT_IN":          9,
+		"CONDITION_OPERATOR_EXISTS":          10,
+		"CONDITION_OPERATOR_NOT_EXISTS":      11,
+		"CONDITION_OPERATOR_GREATER_THAN":    12,
+		"CONDITION_OPERATOR_LESS_THAN":       13,
+		"CONDITION_OPERATOR_REGEX_MATCH":     14,
+		"CONDITION_OPERATOR_REGEX_NOT_MATCH": 15,
+		"CONDITION_OPERATOR_NUMERIC_GT":      16,
+		"CONDITION_OPERATOR_NUMERIC_GTE":     17,
+		"CONDITION_OPERATOR_NUMERIC_LT":      18,
+		"CONDITION_OPERATOR_NUMERIC_LTE":     19,
+		"CONDITION_OPERATOR_NUMERIC_EQ":      20,
 	}
 )
 
@@ -195,17 +228,22 @@ func (ConditionOperator) EnumDescriptor() ([]byte, []int) {
 type ActionType int32
 
 const (
-	ActionType_ACTION_TYPE_UNSPECIFIED    ActionType = 0
-	ActionType_ACTION_TYPE_NOTIFY_TEAM    ActionType = 1
-	ActionType_ACTION_TYPE_NOTIFY_CHANNEL ActionType = 2
-	ActionType_ACTION_TYPE_NOTIFY_USER    ActionType = 3
-	ActionType_ACTION_TYPE_NOTIFY_ONCALL  ActionType = 4
-	ActionType_ACTION_TYPE_NOTIFY_WEBHOOK ActionType = 5
-	ActionType_ACTION_TYPE_SUPPRESS       ActionType = 6
-	ActionType_ACTION_TYPE_AGGREGATE      ActionType = 7
-	ActionType_ACTION_TYPE_ESCALATE       ActionType = 8
-	ActionType_ACTION_TYPE_CREATE_TICKET  ActionType = 9
-	ActionType_ACTION_TYPE_SET_LABEL      ActionType = 10
+	ActionType_ACTION_TYPE_UNSPECIFIED       ActionType = 0
+	ActionType_ACTION_TYPE_NOTIFY_TEAM       ActionType = 1
+	ActionType_ACTION_TYPE_NOTIFY_CHANNEL    ActionType = 2
+	ActionType_ACTION_TYPE_NOTIFY_USER       ActionType = 3
+	ActionType_ACTION_TYPE_NOTIFY_ONCALL     ActionType = 4
+	ActionType_ACTION_TYPE_NOTIFY_WEBHOOK    ActionType = 5
+	ActionType_ACTION_TYPE_SUPPRESS          ActionType = 6
+	ActionType_ACTION_TYPE_AGGREGATE         ActionType = 7
+	ActionType_ACTION_TYPE_ESCALATE          ActionType = 8
+	ActionType_ACTION_TYPE_CREATE_TICKET     ActionType = 9
+	ActionType_ACTION_TYPE_SET_LABEL         ActionType = 10
+	ActionType_ACTION_TYPE_FORWARD_PAGERDUTY ActionType = 11
+	ActionType_ACTION_TYPE_NOTIFY_SNS        ActionType = 12
+	// Re-evaluates the rules with the alert severity boosted by the customer
+	// tier of the alert's account_id label
+	ActionType_ACTION_TYPE_APPLY_TIER_ROUTING ActionType = 13
 )
 
 // Enum value maps for ActionType.
@@ -222,19 +260,25 @@ var (
 		8:  "ACTION_TYPE_ESCALATE",
 		9:  "ACTION_TYPE_CREATE_TICKET",
 		10: "ACTION_TYPE_SET_LABEL",
+		11: "ACTION_TYPE_FORWARD_PAGERDUTY",
+		12: "ACTION_TYPE_NOTIFY_SNS",
+		13: "ACTION_TYPE_APPLY_TIER_ROUTING",
 	}
 	ActionType_value = map[string]int32{
-		"ACTION_TYPE_UNSPECIFIED":    0,
-		"ACTION_TYPE_NOTIFY_TEAM":    1,
-		"ACTION_TYPE_NOTIFY_CHANNEL": 2,
-		"ACTION_TYPE_NOTIFY_USER":    3,
-		"ACTION_TYPE_NOTIFY_ONCALL":  4,
-		"ACTION_TYPE_NOTIFY_WEBHOOK": 5,
-		"ACTION_TYPE_SUPPRESS":       6,
-		"ACTION_TYPE_AGGREGATE":      7,
-		"ACTION_TYPE_ESCALATE":       8,
-		"ACTION_TYPE_CREATE_TICKET":  9,
-		"ACTION_TYPE_SET_LABEL":      10,
+		"ACTION_TYPE_UNSPECIFIED":        0,
+		"ACTION_TYPE_NOTIFY_TEAM":        1,
+		"ACTION_TYPE_NOTIFY_CHANNEL":     2,
+		"ACTION_TYPE_NOTIFY_USER":        3,
+		"ACTION_TYPE_NOTIFY_ONCALL":      4,
+		"ACTION_TYPE_NOTIFY_WEBHOOK":     5,
+		"ACTION_TYPE_SUPPRESS":           6,
+		"ACTION_TYPE_AGGREGATE":          7,
+		"ACTION_TYPE_ESCALATE":           8,
+		"ACTION_TYPE_CREATE_TICKET":      9,
+		"ACTION_TYPE_SET_LABEL":          10,
+		"ACTION_TYPE_FORWARD_PAGERDUTY":  11,
+		"ACTION_TYPE_NOTIFY_SNS":         12,
+		"ACTION_TYPE_APPLY_TIER_ROUTING": 13,
 	}
 )
 
@@ -384,20 +428,24 @@ const (
 	ChannelType_CHANNEL_TYPE_WEBHOOK     ChannelType = 6
 	ChannelType_CHANNEL_TYPE_PAGER       ChannelType = 7
 	ChannelType_CHANNEL_TYPE_PUSH        ChannelType = 8
+	ChannelType_CHANNEL_TYPE_DISCORD     ChannelType = 9
+	ChannelType_CHANNEL_TYPE_GOOGLE_CHAT ChannelType = 10
 )
 
 // Enum value maps for ChannelType.
 var (
 	ChannelType_name = map[int32]string{
-		0: "CHANNEL_TYPE_UNSPECIFIED",
-		1: "CHANNEL_TYPE_SLACK",
-		2: "CHANNEL_TYPE_TEAMS",
-		3: "CHANNEL_TYPE_EMAIL",
-		4: "CHANNEL_TYPE_SMS",
-		5: "CHANNEL_TYPE_VOICE",
-		6: "CHANNEL_TYPE_WEBHOOK",
-		7: "CHANNEL_TYPE_PAGER",
-		8: "CHANNEL_TYPE_PUSH",
+		0:  "CHANNEL_TYPE_UNSPECIFIED",
+		1:  "CHANNEL_TYPE_SLACK",
+		2:  "CHANNEL_TYPE_TEAMS",
+		3:  "CHANNEL_TYPE_EMAIL",
+		4:  "CHANNEL_TYPE_SMS",
+		5:  "CHANNEL_TYPE_VOICE",
+		6:  "CHANNEL_TYPE_WEBHOOK",
+		7:  "CHANNEL_TYPE_PAGER",
+		8:  "CHANNEL_TYPE_PUSH",
+		9:  "CHANNEL_TYPE_DISCORD",
+		10: "CHANNEL_TYPE_GOOGLE_CHAT",
 	}
 	ChannelType_value = map[string]int32{
 		"CHANNEL_TYPE_UNSPECIFIED": 0,
@@ -409,6 +457,8 @@ var (
 		"CHANNEL_TYPE_WEBHOOK":     6,
 		"CHANNEL_TYPE_PAGER":       7,
 		"CHANNEL_TYPE_PUSH":        8,
+		"CHANNEL_TYPE_DISCORD":     9,
+		"CHANNEL_TYPE_GOOGLE_CHAT": 10,
 	}
 )
 
@@ -491,6 +541,58 @@ func (TeamRole) EnumDescriptor() ([]byte, []int) {
 	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{6}
 }
 
+type UnavailableReason int32
+
+const (
+	UnavailableReason_UNAVAILABLE_REASON_UNSPECIFIED UnavailableReason = 0
+	UnavailableReason_UNAVAILABLE_REASON_VACATION    UnavailableReason = 1
+	UnavailableReason_UNAVAILABLE_REASON_SICK        UnavailableReason = 2
+	UnavailableReason_UNAVAILABLE_REASON_TRAINING    UnavailableReason = 3
+)
+
+// Enum value maps for UnavailableReason.
+var (
+	UnavailableReason_name = map[int32]string{
+		0: "UNAVAILABLE_REASON_UNSPECIFIED",
+		1: "UNAVAILABLE_REASON_VACATION",
+		2: "UNAVAILABLE_REASON_SICK",
+		3: "UNAVAILABLE_REASON_TRAINING",
+	}
+	UnavailableReason_value = map[string]int32{
+		"UNAVAILABLE_REASON_UNSPECIFIED": 0,
+		"UNAVAILABLE_REASON_VACATION":    1,
+		"UNAVAILABLE_REASON_SICK":        2,
+		"UNAVAILABLE_REASON_TRAINING":    3,
+	}
+)
+
+func (x UnavailableReason) Enum() *UnavailableReason {
+	p := new(UnavailableReason)
+	*p = x
+	return p
+}
+
+func (x UnavailableReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnavailableReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_alerting_routing_v1_routing_proto_enumTypes[7].Descriptor()
+}
+
+func (UnavailableReason) Type() protoreflect.EnumType {
+	return &file_alerting_routing_v1_routing_proto_enumTypes[7]
+}
+
+func (x UnavailableReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnavailableReason.Descriptor instead.
+func (UnavailableReason) EnumDescriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{7}
+}
+
 type RotationType int32
 
 const (
@@ -530,11 +632,11 @@ func (x RotationType) String() string {
 }
 
 func (RotationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[7].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[8].Descriptor()
 }
 
 func (RotationType) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[7]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[8]
 }
 
 func (x RotationType) Number() protoreflect.EnumNumber {
@@ -543,7 +645,7 @@ func (x RotationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RotationType.Descriptor instead.
 func (RotationType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{7}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{8}
 }
 
 type ShiftType int32
@@ -582,11 +684,11 @@ func (x ShiftType) String() string {
 }
 
 func (ShiftType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[8].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[9].Descriptor()
 }
 
 func (ShiftType) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[8]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[9]
 }
 
 func (x ShiftType) Number() protoreflect.EnumNumber {
@@ -595,7 +697,7 @@ func (x ShiftType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ShiftType.Descriptor instead.
 func (ShiftType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{8}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{9}
 }
 
 type SiteType int32
@@ -640,11 +742,11 @@ func (x SiteType) String() string {
 }
 
 func (SiteType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[9].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[10].Descriptor()
 }
 
 func (SiteType) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[9]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[10]
 }
 
 func (x SiteType) Number() protoreflect.EnumNumber {
@@ -653,7 +755,59 @@ func (x SiteType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SiteType.Descriptor instead.
 func (SiteType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{9}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{10}
+}
+
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY       RecurrenceFrequency = 1
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY      RecurrenceFrequency = 2
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY     RecurrenceFrequency = 3
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKLY",
+		3: "RECURRENCE_FREQUENCY_MONTHLY",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKLY":      2,
+		"RECURRENCE_FREQUENCY_MONTHLY":     3,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_alerting_routing_v1_routing_proto_enumTypes[11].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_alerting_routing_v1_routing_proto_enumTypes[11]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{11}
 }
 
 type MaintenanceAction int32
@@ -692,11 +846,11 @@ func (x MaintenanceAction) String() string {
 }
 
 func (MaintenanceAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[10].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[12].Descriptor()
 }
 
 func (MaintenanceAction) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[10]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[12]
 }
 
 func (x MaintenanceAction) Number() protoreflect.EnumNumber {
@@ -705,17 +859,18 @@ func (x MaintenanceAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MaintenanceAction.Descriptor instead.
 func (MaintenanceAction) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{10}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{12}
 }
 
 type MaintenanceStatus int32
 
 const (
-	MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED MaintenanceStatus = 0
-	MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED   MaintenanceStatus = 1
-	MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS MaintenanceStatus = 2
-	MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED   MaintenanceStatus = 3
-	MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED   MaintenanceStatus = 4
+	MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED      MaintenanceStatus = 0
+	MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED        MaintenanceStatus = 1
+	MaintenanceStatus_MAINTENANCE_STATUS_IN_PROGRESS      MaintenanceStatus = 2
+	MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED        MaintenanceStatus = 3
+	MaintenanceStatus_MAINTENANCE_STATUS_CANCELLED        MaintenanceStatus = 4
+	MaintenanceStatus_MAINTENANCE_STATUS_PENDING_APPROVAL MaintenanceStatus = 5 // waiting for change-board approval
 )
 
 // Enum value maps for MaintenanceStatus.
@@ -726,13 +881,15 @@ var (
 		2: "MAINTENANCE_STATUS_IN_PROGRESS",
 		3: "MAINTENANCE_STATUS_COMPLETED",
 		4: "MAINTENANCE_STATUS_CANCELLED",
+		5: "MAINTENANCE_STATUS_PENDING_APPROVAL",
 	}
 	MaintenanceStatus_value = map[string]int32{
-		"MAINTENANCE_STATUS_UNSPECIFIED": 0,
-		"MAINTENANCE_STATUS_SCHEDULED":   1,
-		"MAINTENANCE_STATUS_IN_PROGRESS": 2,
-		"MAINTENANCE_STATUS_COMPLETED":   3,
-		"MAINTENANCE_STATUS_CANCELLED":   4,
+		"MAINTENANCE_STATUS_UNSPECIFIED":      0,
+		"MAINTENANCE_STATUS_SCHEDULED":        1,
+		"MAINTENANCE_STATUS_IN_PROGRESS":      2,
+		"MAINTENANCE_STATUS_COMPLETED":        3,
+		"MAINTENANCE_STATUS_CANCELLED":        4,
+		"MAINTENANCE_STATUS_PENDING_APPROVAL": 5,
 	}
 )
 
@@ -747,11 +904,11 @@ func (x MaintenanceStatus) String() string {
 }
 
 func (MaintenanceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[11].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[13].Descriptor()
 }
 
 func (MaintenanceStatus) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[11]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[13]
 }
 
 func (x MaintenanceStatus) Number() protoreflect.EnumNumber {
@@ -760,7 +917,7 @@ func (x MaintenanceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MaintenanceStatus.Descriptor instead.
 func (MaintenanceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{11}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{13}
 }
 
 type EscalationTargetType int32
@@ -802,11 +959,11 @@ func (x EscalationTargetType) String() string {
 }
 
 func (EscalationTargetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[12].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[14].Descriptor()
 }
 
 func (EscalationTargetType) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[12]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[14]
 }
 
 func (x EscalationTargetType) Number() protoreflect.EnumNumber {
@@ -815,7 +972,7 @@ func (x EscalationTargetType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EscalationTargetType.Descriptor instead.
 func (EscalationTargetType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{12}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{14}
 }
 
 type ExhaustedActionType int32
@@ -857,11 +1014,11 @@ func (x ExhaustedActionType) String() string {
 }
 
 func (ExhaustedActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_routing_v1_routing_proto_enumTypes[13].Descriptor()
+	return file_alerting_routing_v1_routing_proto_enumTypes[15].Descriptor()
 }
 
 func (ExhaustedActionType) Type() protoreflect.EnumType {
-	return &file_alerting_routing_v1_routing_proto_enumTypes[13]
+	return &file_alerting_routing_v1_routing_proto_enumTypes[15]
 }
 
 func (x ExhaustedActionType) Number() protoreflect.EnumNumber {
@@ -870,7 +1027,7 @@ func (x ExhaustedActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExhaustedActionType.Descriptor instead.
 func (ExhaustedActionType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{13}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{15}
 }
 
 // RoutingRule defines how alerts are routed to notification targets
@@ -897,9 +1054,19 @@ type RoutingRule struct {
 	UpdatedBy string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Tags for organization
-	Tags          []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Tags []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Alerts from these integration keys evaluate this rule before all others.
+	// A forced match implicitly stops evaluation of further rules.
+	ForcedIntegrationKeys []string `protobuf:"bytes,15,rep,name=forced_integration_keys,json=forcedIntegrationKeys,proto3" json:"forced_integration_keys,omitempty"`
+	// Site scope (optional). When set, the rule only applies to alerts whose
+	// site_code label resolves to a site in one of these regions / of one of these types.
+	AffectedRegions   []string   `protobuf:"bytes,16,rep,name=affected_regions,json=affectedRegions,proto3" json:"affected_regions,omitempty"`
+	AffectedSiteTypes []SiteType `protobuf:"varint,17,rep,packed,name=affected_site_types,json=affectedSiteTypes,proto3,enum=alerting.routing.v1.SiteType" json:"affected_site_types,omitempty"`
+	// If true, a match skips all lower-priority rules. Unlike terminal, this is
+	// persisted with the rule.
+	StopProcessing bool `protobuf:"varint,18,opt,name=stop_processing,json=stopProcessing,proto3" json:"stop_processing,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RoutingRule) Reset() {
@@ -1030,6 +1197,34 @@ func (x *RoutingRule) GetTags() []string {
 	return nil
 }
 
+func (x *RoutingRule) GetForcedIntegrationKeys() []string {
+	if x != nil {
+		return x.ForcedIntegrationKeys
+	}
+	return nil
+}
+
+func (x *RoutingRule) GetAffectedRegions() []string {
+	if x != nil {
+		return x.AffectedRegions
+	}
+	return nil
+}
+
+func (x *RoutingRule) GetAffectedSiteTypes() []SiteType {
+	if x != nil {
+		return x.AffectedSiteTypes
+	}
+	return nil
+}
+
+func (x *RoutingRule) GetStopProcessing() bool {
+	if x != nil {
+		return x.StopProcessing
+	}
+	return false
+}
+
 // RoutingCondition defines a single match condition
 type RoutingCondition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1149,18 +1344,20 @@ type RoutingAction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Type  ActionType             `protobuf:"varint,1,opt,name=type,proto3,enum=alerting.routing.v1.ActionType" json:"type,omitempty"`
 	// Action-specific configuration (use appropriate field based on type)
-	NotifyTeam    *NotifyTeamAction    `protobuf:"bytes,2,opt,name=notify_team,json=notifyTeam,proto3" json:"notify_team,omitempty"`
-	NotifyChannel *NotifyChannelAction `protobuf:"bytes,3,opt,name=notify_channel,json=notifyChannel,proto3" json:"notify_channel,omitempty"`
-	NotifyUser    *NotifyUserAction    `protobuf:"bytes,4,opt,name=notify_user,json=notifyUser,proto3" json:"notify_user,omitempty"`
-	NotifyOncall  *NotifyOnCallAction  `protobuf:"bytes,5,opt,name=notify_oncall,json=notifyOncall,proto3" json:"notify_oncall,omitempty"`
-	NotifyWebhook *NotifyWebhookAction `protobuf:"bytes,6,opt,name=notify_webhook,json=notifyWebhook,proto3" json:"notify_webhook,omitempty"`
-	Suppress      *SuppressAction      `protobuf:"bytes,7,opt,name=suppress,proto3" json:"suppress,omitempty"`
-	Aggregate     *AggregateAction     `protobuf:"bytes,8,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
-	Escalate      *EscalateAction      `protobuf:"bytes,9,opt,name=escalate,proto3" json:"escalate,omitempty"`
-	CreateTicket  *CreateTicketAction  `protobuf:"bytes,10,opt,name=create_ticket,json=createTicket,proto3" json:"create_ticket,omitempty"`
-	SetLabel      *SetLabelAction      `protobuf:"bytes,11,opt,name=set_label,json=setLabel,proto3" json:"set_label,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	NotifyTeam       *NotifyTeamAction       `protobuf:"bytes,2,opt,name=notify_team,json=notifyTeam,proto3" json:"notify_team,omitempty"`
+	NotifyChannel    *NotifyChannelAction    `protobuf:"bytes,3,opt,name=notify_channel,json=notifyChannel,proto3" json:"notify_channel,omitempty"`
+	NotifyUser       *NotifyUserAction       `protobuf:"bytes,4,opt,name=notify_user,json=notifyUser,proto3" json:"notify_user,omitempty"`
+	NotifyOncall     *NotifyOnCallAction     `protobuf:"bytes,5,opt,name=notify_oncall,json=notifyOncall,proto3" json:"notify_oncall,omitempty"`
+	NotifyWebhook    *NotifyWebhookAction    `protobuf:"bytes,6,opt,name=notify_webhook,json=notifyWebhook,proto3" json:"notify_webhook,omitempty"`
+	Suppress         *SuppressAction         `protobuf:"bytes,7,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	Aggregate        *AggregateAction        `protobuf:"bytes,8,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Escalate         *EscalateAction         `protobuf:"bytes,9,opt,name=escalate,proto3" json:"escalate,omitempty"`
+	CreateTicket     *CreateTicketAction     `protobuf:"bytes,10,opt,name=create_ticket,json=createTicket,proto3" json:"create_ticket,omitempty"`
+	SetLabel         *SetLabelAction         `protobuf:"bytes,11,opt,name=set_label,json=setLabel,proto3" json:"set_label,omitempty"`
+	ForwardPagerduty *ForwardPagerDutyAction `protobuf:"bytes,12,opt,name=forward_pagerduty,json=forwardPagerduty,proto3" json:"forward_pagerduty,omitempty"`
+	NotifySns        *NotifySNSAction        `protobuf:"bytes,13,opt,name=notify_sns,json=notifySns,proto3" json:"notify_sns,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RoutingAction) Reset() {
@@ -1270,6 +1467,20 @@ func (x *RoutingAction) GetSetLabel() *SetLabelAction {
 	return nil
 }
 
+func (x *RoutingAction) GetForwardPagerduty() *ForwardPagerDutyAction {
+	if x != nil {
+		return x.ForwardPagerduty
+	}
+	return nil
+}
+
+func (x *RoutingAction) GetNotifySns() *NotifySNSAction {
+	if x != nil {
+		return x.NotifySns
+	}
+	return nil
+}
+
 // NotifyTeamAction - sends to all team members or subset
 type NotifyTeamAction struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
@@ -1923,6 +2134,142 @@ func (x *SetLabelAction) GetOverwriteExisting() bool {
 	return false
 }
 
+// ForwardPagerDutyAction - forward to a customer's PagerDuty service
+type ForwardPagerDutyAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PagerDuty Events API v2 integration key
+	RoutingKey string `protobuf:"bytes,1,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// Alert label used as the dedup key (alert fingerprint if unset or missing)
+	DedupKeyLabel string `protobuf:"bytes,2,opt,name=dedup_key_label,json=dedupKeyLabel,proto3" json:"dedup_key_label,omitempty"`
+	// Alert labels included in the event's custom_details
+	PayloadCustomDetailsLabels []string `protobuf:"bytes,3,rep,name=payload_custom_details_labels,json=payloadCustomDetailsLabels,proto3" json:"payload_custom_details_labels,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ForwardPagerDutyAction) Reset() {
+	*x = ForwardPagerDutyAction{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardPagerDutyAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardPagerDutyAction) ProtoMessage() {}
+
+func (x *ForwardPagerDutyAction) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardPagerDutyAction.ProtoReflect.Descriptor instead.
+func (*ForwardPagerDutyAction) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ForwardPagerDutyAction) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *ForwardPagerDutyAction) GetDedupKeyLabel() string {
+	if x != nil {
+		return x.DedupKeyLabel
+	}
+	return ""
+}
+
+func (x *ForwardPagerDutyAction) GetPayloadCustomDetailsLabels() []string {
+	if x != nil {
+		return x.PayloadCustomDetailsLabels
+	}
+	return nil
+}
+
+// NotifySNSAction - publish to an AWS SNS topic
+type NotifySNSAction struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TopicArn string                 `protobuf:"bytes,1,opt,name=topic_arn,json=topicArn,proto3" json:"topic_arn,omitempty"`
+	// Go templates rendered with the alert; the subject defaults to the alert
+	// summary and the message to the alert as JSON
+	SubjectTemplate string `protobuf:"bytes,2,opt,name=subject_template,json=subjectTemplate,proto3" json:"subject_template,omitempty"`
+	MessageTemplate string `protobuf:"bytes,3,opt,name=message_template,json=messageTemplate,proto3" json:"message_template,omitempty"`
+	// Region of the topic, which must match the region in topic_arn if set
+	Region        string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySNSAction) Reset() {
+	*x = NotifySNSAction{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySNSAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySNSAction) ProtoMessage() {}
+
+func (x *NotifySNSAction) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySNSAction.ProtoReflect.Descriptor instead.
+func (*NotifySNSAction) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NotifySNSAction) GetTopicArn() string {
+	if x != nil {
+		return x.TopicArn
+	}
+	return ""
+}
+
+func (x *NotifySNSAction) GetSubjectTemplate() string {
+	if x != nil {
+		return x.SubjectTemplate
+	}
+	return ""
+}
+
+func (x *NotifySNSAction) GetMessageTemplate() string {
+	if x != nil {
+		return x.MessageTemplate
+	}
+	return ""
+}
+
+func (x *NotifySNSAction) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 // TimeCondition for time-based routing
 type TimeCondition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1936,7 +2283,7 @@ type TimeCondition struct {
 
 func (x *TimeCondition) Reset() {
 	*x = TimeCondition{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[13]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1948,7 +2295,7 @@ func (x *TimeCondition) String() string {
 func (*TimeCondition) ProtoMessage() {}
 
 func (x *TimeCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[13]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,7 +2308,7 @@ func (x *TimeCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeCondition.ProtoReflect.Descriptor instead.
 func (*TimeCondition) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{13}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TimeCondition) GetTimezone() string {
@@ -1994,7 +2341,7 @@ type TimeWindow struct {
 
 func (x *TimeWindow) Reset() {
 	*x = TimeWindow{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[14]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2006,7 +2353,7 @@ func (x *TimeWindow) String() string {
 func (*TimeWindow) ProtoMessage() {}
 
 func (x *TimeWindow) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[14]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,7 +2366,7 @@ func (x *TimeWindow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
 func (*TimeWindow) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{14}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TimeWindow) GetDaysOfWeek() []int32 {
@@ -2055,19 +2402,21 @@ type NotificationTarget struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Channel ChannelType            `protobuf:"varint,1,opt,name=channel,proto3,enum=alerting.routing.v1.ChannelType" json:"channel,omitempty"`
 	// Channel-specific target configuration (use appropriate field based on channel)
-	Slack         *SlackTarget   `protobuf:"bytes,2,opt,name=slack,proto3" json:"slack,omitempty"`
-	Teams         *TeamsTarget   `protobuf:"bytes,3,opt,name=teams,proto3" json:"teams,omitempty"`
-	Email         *EmailTarget   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Sms           *SMSTarget     `protobuf:"bytes,5,opt,name=sms,proto3" json:"sms,omitempty"`
-	Webhook       *WebhookTarget `protobuf:"bytes,6,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	Pager         *PagerTarget   `protobuf:"bytes,7,opt,name=pager,proto3" json:"pager,omitempty"`
+	Slack         *SlackTarget      `protobuf:"bytes,2,opt,name=slack,proto3" json:"slack,omitempty"`
+	Teams         *TeamsTarget      `protobuf:"bytes,3,opt,name=teams,proto3" json:"teams,omitempty"`
+	Email         *EmailTarget      `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Sms           *SMSTarget        `protobuf:"bytes,5,opt,name=sms,proto3" json:"sms,omitempty"`
+	Webhook       *WebhookTarget    `protobuf:"bytes,6,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Pager         *PagerTarget      `protobuf:"bytes,7,opt,name=pager,proto3" json:"pager,omitempty"`
+	Discord       *DiscordTarget    `protobuf:"bytes,8,opt,name=discord,proto3" json:"discord,omitempty"`
+	GoogleChat    *GoogleChatTarget `protobuf:"bytes,9,opt,name=google_chat,json=googleChat,proto3" json:"google_chat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NotificationTarget) Reset() {
 	*x = NotificationTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[15]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2079,7 +2428,7 @@ func (x *NotificationTarget) String() string {
 func (*NotificationTarget) ProtoMessage() {}
 
 func (x *NotificationTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[15]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,7 +2441,7 @@ func (x *NotificationTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationTarget.ProtoReflect.Descriptor instead.
 func (*NotificationTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{15}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NotificationTarget) GetChannel() ChannelType {
@@ -2144,6 +2493,20 @@ func (x *NotificationTarget) GetPager() *PagerTarget {
 	return nil
 }
 
+func (x *NotificationTarget) GetDiscord() *DiscordTarget {
+	if x != nil {
+		return x.Discord
+	}
+	return nil
+}
+
+func (x *NotificationTarget) GetGoogleChat() *GoogleChatTarget {
+	if x != nil {
+		return x.GoogleChat
+	}
+	return nil
+}
+
 type SlackTarget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Channel ID (preferred) or channel name
@@ -2157,7 +2520,7 @@ type SlackTarget struct {
 
 func (x *SlackTarget) Reset() {
 	*x = SlackTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[16]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2169,7 +2532,7 @@ func (x *SlackTarget) String() string {
 func (*SlackTarget) ProtoMessage() {}
 
 func (x *SlackTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[16]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,7 +2545,7 @@ func (x *SlackTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SlackTarget.ProtoReflect.Descriptor instead.
 func (*SlackTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{16}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SlackTarget) GetChannelId() string {
@@ -2218,7 +2581,7 @@ type TeamsTarget struct {
 
 func (x *TeamsTarget) Reset() {
 	*x = TeamsTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[17]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2230,7 +2593,7 @@ func (x *TeamsTarget) String() string {
 func (*TeamsTarget) ProtoMessage() {}
 
 func (x *TeamsTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[17]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2243,7 +2606,7 @@ func (x *TeamsTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamsTarget.ProtoReflect.Descriptor instead.
 func (*TeamsTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{17}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TeamsTarget) GetChannelId() string {
@@ -2279,7 +2642,7 @@ type EmailTarget struct {
 
 func (x *EmailTarget) Reset() {
 	*x = EmailTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[18]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +2654,7 @@ func (x *EmailTarget) String() string {
 func (*EmailTarget) ProtoMessage() {}
 
 func (x *EmailTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[18]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +2667,7 @@ func (x *EmailTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailTarget.ProtoReflect.Descriptor instead.
 func (*EmailTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{18}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EmailTarget) GetAddresses() []string {
@@ -2330,7 +2693,7 @@ type SMSTarget struct {
 
 func (x *SMSTarget) Reset() {
 	*x = SMSTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[19]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2342,7 +2705,7 @@ func (x *SMSTarget) String() string {
 func (*SMSTarget) ProtoMessage() {}
 
 func (x *SMSTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[19]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,7 +2718,7 @@ func (x *SMSTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMSTarget.ProtoReflect.Descriptor instead.
 func (*SMSTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{19}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SMSTarget) GetPhoneNumbers() []string {
@@ -2376,7 +2739,7 @@ type WebhookTarget struct {
 
 func (x *WebhookTarget) Reset() {
 	*x = WebhookTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[20]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2388,7 +2751,7 @@ func (x *WebhookTarget) String() string {
 func (*WebhookTarget) ProtoMessage() {}
 
 func (x *WebhookTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[20]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2401,7 +2764,7 @@ func (x *WebhookTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookTarget.ProtoReflect.Descriptor instead.
 func (*WebhookTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{20}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WebhookTarget) GetUrl() string {
@@ -2434,7 +2797,7 @@ type PagerTarget struct {
 
 func (x *PagerTarget) Reset() {
 	*x = PagerTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[21]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2446,7 +2809,7 @@ func (x *PagerTarget) String() string {
 func (*PagerTarget) ProtoMessage() {}
 
 func (x *PagerTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[21]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2459,7 +2822,7 @@ func (x *PagerTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PagerTarget.ProtoReflect.Descriptor instead.
 func (*PagerTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{21}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PagerTarget) GetServiceKey() string {
@@ -2469,6 +2832,122 @@ func (x *PagerTarget) GetServiceKey() string {
 	return ""
 }
 
+type DiscordTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Discord channel webhook URL
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Optional overrides of the webhook's default name and avatar
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl     string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscordTarget) Reset() {
+	*x = DiscordTarget{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscordTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscordTarget) ProtoMessage() {}
+
+func (x *DiscordTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscordTarget.ProtoReflect.Descriptor instead.
+func (*DiscordTarget) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DiscordTarget) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *DiscordTarget) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DiscordTarget) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type GoogleChatTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Google Chat space incoming webhook URL
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Send cardsV2 instead of the legacy cards format
+	UseCardsV2    bool `protobuf:"varint,2,opt,name=use_cards_v2,json=useCardsV2,proto3" json:"use_cards_v2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoogleChatTarget) Reset() {
+	*x = GoogleChatTarget{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleChatTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleChatTarget) ProtoMessage() {}
+
+func (x *GoogleChatTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleChatTarget.ProtoReflect.Descriptor instead.
+func (*GoogleChatTarget) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GoogleChatTarget) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *GoogleChatTarget) GetUseCardsV2() bool {
+	if x != nil {
+		return x.UseCardsV2
+	}
+	return false
+}
+
 // Team represents a group of users with shared on-call responsibilities
 type Team struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -2498,7 +2977,7 @@ type Team struct {
 
 func (x *Team) Reset() {
 	*x = Team{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[22]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2510,7 +2989,7 @@ func (x *Team) String() string {
 func (*Team) ProtoMessage() {}
 
 func (x *Team) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[22]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2523,7 +3002,7 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Team.ProtoReflect.Descriptor instead.
 func (*Team) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{22}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Team) GetId() string {
@@ -2630,7 +3109,7 @@ type TeamMember struct {
 
 func (x *TeamMember) Reset() {
 	*x = TeamMember{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[23]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2642,7 +3121,7 @@ func (x *TeamMember) String() string {
 func (*TeamMember) ProtoMessage() {}
 
 func (x *TeamMember) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[23]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2655,7 +3134,7 @@ func (x *TeamMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
 func (*TeamMember) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{23}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TeamMember) GetUserId() string {
@@ -2700,7 +3179,7 @@ type NotificationPreferences struct {
 
 func (x *NotificationPreferences) Reset() {
 	*x = NotificationPreferences{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[24]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2712,7 +3191,7 @@ func (x *NotificationPreferences) String() string {
 func (*NotificationPreferences) ProtoMessage() {}
 
 func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[24]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2725,7 +3204,7 @@ func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
 func (*NotificationPreferences) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{24}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *NotificationPreferences) GetPreferredChannels() []ChannelType {
@@ -2749,6 +3228,85 @@ func (x *NotificationPreferences) GetEscalationDelay() *durationpb.Duration {
 	return nil
 }
 
+// UserAvailability marks a user as available or unavailable for on-call
+// shifts during a period
+type UserAvailability struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Available bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// Why the user is unavailable (unset when available)
+	UnavailableReason UnavailableReason      `protobuf:"varint,3,opt,name=unavailable_reason,json=unavailableReason,proto3,enum=alerting.routing.v1.UnavailableReason" json:"unavailable_reason,omitempty"`
+	PeriodStart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserAvailability) Reset() {
+	*x = UserAvailability{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAvailability) ProtoMessage() {}
+
+func (x *UserAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAvailability.ProtoReflect.Descriptor instead.
+func (*UserAvailability) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserAvailability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *UserAvailability) GetUnavailableReason() UnavailableReason {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return UnavailableReason_UNAVAILABLE_REASON_UNSPECIFIED
+}
+
+func (x *UserAvailability) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UserAvailability) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
 // Schedule defines on-call rotation for a team
 type Schedule struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -2766,15 +3324,17 @@ type Schedule struct {
 	// Handoff configuration
 	Handoff *HandoffConfig `protobuf:"bytes,8,opt,name=handoff,proto3" json:"handoff,omitempty"`
 	// Metadata
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Advance notice of rotation handoffs to the outgoing and incoming users
+	HandoffReminder *HandoffReminderConfig `protobuf:"bytes,11,opt,name=handoff_reminder,json=handoffReminder,proto3" json:"handoff_reminder,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Schedule) Reset() {
 	*x = Schedule{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[25]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2786,7 +3346,7 @@ func (x *Schedule) String() string {
 func (*Schedule) ProtoMessage() {}
 
 func (x *Schedule) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[25]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +3359,7 @@ func (x *Schedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
 func (*Schedule) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{25}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Schedule) GetId() string {
@@ -2872,6 +3432,13 @@ func (x *Schedule) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Schedule) GetHandoffReminder() *HandoffReminderConfig {
+	if x != nil {
+		return x.HandoffReminder
+	}
+	return nil
+}
+
 // Rotation defines a repeating on-call pattern
 type Rotation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2895,7 +3462,7 @@ type Rotation struct {
 
 func (x *Rotation) Reset() {
 	*x = Rotation{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[26]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2907,7 +3474,7 @@ func (x *Rotation) String() string {
 func (*Rotation) ProtoMessage() {}
 
 func (x *Rotation) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[26]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,7 +3487,7 @@ func (x *Rotation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rotation.ProtoReflect.Descriptor instead.
 func (*Rotation) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{26}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Rotation) GetId() string {
@@ -2989,7 +3556,7 @@ type RotationMember struct {
 
 func (x *RotationMember) Reset() {
 	*x = RotationMember{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[27]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3001,7 +3568,7 @@ func (x *RotationMember) String() string {
 func (*RotationMember) ProtoMessage() {}
 
 func (x *RotationMember) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[27]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3014,7 +3581,7 @@ func (x *RotationMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotationMember.ProtoReflect.Descriptor instead.
 func (*RotationMember) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{27}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RotationMember) GetUserId() string {
@@ -3045,7 +3612,7 @@ type ShiftConfig struct {
 
 func (x *ShiftConfig) Reset() {
 	*x = ShiftConfig{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[28]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3057,7 +3624,7 @@ func (x *ShiftConfig) String() string {
 func (*ShiftConfig) ProtoMessage() {}
 
 func (x *ShiftConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[28]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,7 +3637,7 @@ func (x *ShiftConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShiftConfig.ProtoReflect.Descriptor instead.
 func (*ShiftConfig) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{28}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ShiftConfig) GetShiftLength() *durationpb.Duration {
@@ -3114,7 +3681,7 @@ type ScheduleOverride struct {
 
 func (x *ScheduleOverride) Reset() {
 	*x = ScheduleOverride{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[29]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3126,7 +3693,7 @@ func (x *ScheduleOverride) String() string {
 func (*ScheduleOverride) ProtoMessage() {}
 
 func (x *ScheduleOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[29]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,7 +3706,7 @@ func (x *ScheduleOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleOverride.ProtoReflect.Descriptor instead.
 func (*ScheduleOverride) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{29}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ScheduleOverride) GetId() string {
@@ -3212,7 +3779,7 @@ type Shift struct {
 
 func (x *Shift) Reset() {
 	*x = Shift{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[30]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3224,7 +3791,7 @@ func (x *Shift) String() string {
 func (*Shift) ProtoMessage() {}
 
 func (x *Shift) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[30]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3237,7 +3804,7 @@ func (x *Shift) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Shift.ProtoReflect.Descriptor instead.
 func (*Shift) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{30}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Shift) GetId() string {
@@ -3317,7 +3884,7 @@ type HandoffConfig struct {
 
 func (x *HandoffConfig) Reset() {
 	*x = HandoffConfig{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[31]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3329,7 +3896,7 @@ func (x *HandoffConfig) String() string {
 func (*HandoffConfig) ProtoMessage() {}
 
 func (x *HandoffConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[31]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3342,7 +3909,7 @@ func (x *HandoffConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandoffConfig.ProtoReflect.Descriptor instead.
 func (*HandoffConfig) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{31}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *HandoffConfig) GetOutgoingReminderMinutes() int32 {
@@ -3370,21 +3937,155 @@ func (x *HandoffConfig) GetHandoffChannel() *NotificationTarget {
 	if x != nil {
 		return x.HandoffChannel
 	}
-	return nil
+	return nil
+}
+
+func (x *HandoffConfig) GetRequireAck() bool {
+	if x != nil {
+		return x.RequireAck
+	}
+	return false
+}
+
+func (x *HandoffConfig) GetEscalateIfNoAckMinutes() int32 {
+	if x != nil {
+		return x.EscalateIfNoAckMinutes
+	}
+	return 0
+}
+
+// HandoffReminderConfig controls the reminders sent ahead of rotation handoffs
+type HandoffReminderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long before the handoff the reminders are sent; unset sends them just before it
+	AdvanceNotice *durationpb.Duration `protobuf:"bytes,1,opt,name=advance_notice,json=advanceNotice,proto3" json:"advance_notice,omitempty"`
+	// Channel to deliver the reminders on; unspecified uses the user's preferred channel
+	Channel       ChannelType `protobuf:"varint,2,opt,name=channel,proto3,enum=alerting.routing.v1.ChannelType" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandoffReminderConfig) Reset() {
+	*x = HandoffReminderConfig{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoffReminderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffReminderConfig) ProtoMessage() {}
+
+func (x *HandoffReminderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffReminderConfig.ProtoReflect.Descriptor instead.
+func (*HandoffReminderConfig) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HandoffReminderConfig) GetAdvanceNotice() *durationpb.Duration {
+	if x != nil {
+		return x.AdvanceNotice
+	}
+	return nil
+}
+
+func (x *HandoffReminderConfig) GetChannel() ChannelType {
+	if x != nil {
+		return x.Channel
+	}
+	return ChannelType_CHANNEL_TYPE_UNSPECIFIED
+}
+
+// HandoffNote is a note left by the outgoing on-call user for the next shift
+type HandoffNote struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Author of the note
+	AuthorUserId string `protobuf:"bytes,3,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	// Note content
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandoffNote) Reset() {
+	*x = HandoffNote{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoffNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffNote) ProtoMessage() {}
+
+func (x *HandoffNote) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffNote.ProtoReflect.Descriptor instead.
+func (*HandoffNote) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HandoffNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HandoffNote) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *HandoffNote) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
 }
 
-func (x *HandoffConfig) GetRequireAck() bool {
+func (x *HandoffNote) GetContent() string {
 	if x != nil {
-		return x.RequireAck
+		return x.Content
 	}
-	return false
+	return ""
 }
 
-func (x *HandoffConfig) GetEscalateIfNoAckMinutes() int32 {
+func (x *HandoffNote) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EscalateIfNoAckMinutes
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
 // Site represents a physical location (datacenter, POP)
@@ -3410,16 +4111,18 @@ type Site struct {
 	// Business hours
 	BusinessHours []*TimeWindow `protobuf:"bytes,13,rep,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
 	// Metadata
-	Metadata      map[string]string      `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata  map[string]string      `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Capacity utilization, used to boost alert priority at busy sites
+	Capacity      *CapacityMetrics `protobuf:"bytes,17,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Site) Reset() {
 	*x = Site{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[32]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3431,7 +4134,7 @@ func (x *Site) String() string {
 func (*Site) ProtoMessage() {}
 
 func (x *Site) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[32]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3444,7 +4147,7 @@ func (x *Site) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Site.ProtoReflect.Descriptor instead.
 func (*Site) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{32}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Site) GetId() string {
@@ -3559,6 +4262,74 @@ func (x *Site) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Site) GetCapacity() *CapacityMetrics {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+// CapacityMetrics describes the current utilization of a site
+type CapacityMetrics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalServers       int32                  `protobuf:"varint,1,opt,name=total_servers,json=totalServers,proto3" json:"total_servers,omitempty"`
+	CurrentLoadPercent float32                `protobuf:"fixed32,2,opt,name=current_load_percent,json=currentLoadPercent,proto3" json:"current_load_percent,omitempty"`
+	LastUpdated        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CapacityMetrics) Reset() {
+	*x = CapacityMetrics{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapacityMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityMetrics) ProtoMessage() {}
+
+func (x *CapacityMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityMetrics.ProtoReflect.Descriptor instead.
+func (*CapacityMetrics) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CapacityMetrics) GetTotalServers() int32 {
+	if x != nil {
+		return x.TotalServers
+	}
+	return 0
+}
+
+func (x *CapacityMetrics) GetCurrentLoadPercent() float32 {
+	if x != nil {
+		return x.CurrentLoadPercent
+	}
+	return 0
+}
+
+func (x *CapacityMetrics) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
 // CustomerTier for prioritization
 type CustomerTier struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3582,7 +4353,7 @@ type CustomerTier struct {
 
 func (x *CustomerTier) Reset() {
 	*x = CustomerTier{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[33]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3594,7 +4365,7 @@ func (x *CustomerTier) String() string {
 func (*CustomerTier) ProtoMessage() {}
 
 func (x *CustomerTier) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[33]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3607,7 +4378,7 @@ func (x *CustomerTier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomerTier.ProtoReflect.Descriptor instead.
 func (*CustomerTier) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{33}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CustomerTier) GetId() string {
@@ -3691,7 +4462,7 @@ type EquipmentType struct {
 
 func (x *EquipmentType) Reset() {
 	*x = EquipmentType{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[34]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3703,7 +4474,7 @@ func (x *EquipmentType) String() string {
 func (*EquipmentType) ProtoMessage() {}
 
 func (x *EquipmentType) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[34]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3716,7 +4487,7 @@ func (x *EquipmentType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EquipmentType.ProtoReflect.Descriptor instead.
 func (*EquipmentType) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{34}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *EquipmentType) GetId() string {
@@ -3782,7 +4553,7 @@ type CarrierConfig struct {
 
 func (x *CarrierConfig) Reset() {
 	*x = CarrierConfig{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[35]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3794,7 +4565,7 @@ func (x *CarrierConfig) String() string {
 func (*CarrierConfig) ProtoMessage() {}
 
 func (x *CarrierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[35]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,7 +4578,7 @@ func (x *CarrierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarrierConfig.ProtoReflect.Descriptor instead.
 func (*CarrierConfig) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{35}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CarrierConfig) GetId() string {
@@ -3894,14 +4665,25 @@ type MaintenanceWindow struct {
 	// Change ticket reference
 	ChangeTicketId string `protobuf:"bytes,12,opt,name=change_ticket_id,json=changeTicketId,proto3" json:"change_ticket_id,omitempty"`
 	// Status
-	Status        MaintenanceStatus `protobuf:"varint,13,opt,name=status,proto3,enum=alerting.routing.v1.MaintenanceStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Status MaintenanceStatus `protobuf:"varint,13,opt,name=status,proto3,enum=alerting.routing.v1.MaintenanceStatus" json:"status,omitempty"`
+	// Metadata labels, e.g. source=alertmanager for windows synced from silences
+	Labels map[string]string `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Repeats the window; start_time and end_time are the first occurrence
+	Recurrence *RecurrenceRule `protobuf:"bytes,15,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Change-board approval; windows requiring approval stay pending until approved
+	RequiresApproval bool                   `protobuf:"varint,16,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	ApprovedBy       string                 `protobuf:"bytes,17,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	// "key=regex" label matchers, e.g. "app=frontend-.*"; the regex must match
+	// the whole label value and all patterns must match
+	AffectedLabelPatterns []string `protobuf:"bytes,19,rep,name=affected_label_patterns,json=affectedLabelPatterns,proto3" json:"affected_label_patterns,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *MaintenanceWindow) Reset() {
 	*x = MaintenanceWindow{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[36]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3913,7 +4695,7 @@ func (x *MaintenanceWindow) String() string {
 func (*MaintenanceWindow) ProtoMessage() {}
 
 func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[36]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3926,7 +4708,7 @@ func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
 func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{36}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MaintenanceWindow) GetId() string {
@@ -4020,6 +4802,129 @@ func (x *MaintenanceWindow) GetStatus() MaintenanceStatus {
 	return MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
 }
 
+func (x *MaintenanceWindow) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *MaintenanceWindow) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetAffectedLabelPatterns() []string {
+	if x != nil {
+		return x.AffectedLabelPatterns
+	}
+	return nil
+}
+
+// RecurrenceRule repeats a maintenance window, modelled on an iCalendar RRULE
+type RecurrenceRule struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Frequency RecurrenceFrequency    `protobuf:"varint,1,opt,name=frequency,proto3,enum=alerting.routing.v1.RecurrenceFrequency" json:"frequency,omitempty"`
+	// Repeat every interval days, weeks or months; 0 is treated as 1
+	Interval int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Weekdays a WEEKLY rule repeats on (0 = Sunday); empty uses the weekday of start_time
+	DaysOfWeek []int32 `protobuf:"varint,3,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	// Total number of occurrences including the first; 0 is unlimited
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// No occurrence starts after until; unset repeats until count is reached
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurrenceRule) Reset() {
+	*x = RecurrenceRule{}
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurrenceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurrenceRule) ProtoMessage() {}
+
+func (x *RecurrenceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurrenceRule.ProtoReflect.Descriptor instead.
+func (*RecurrenceRule) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RecurrenceRule) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RecurrenceRule) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurrenceRule) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *RecurrenceRule) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RecurrenceRule) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
 // EscalationPolicy defines how alerts escalate over time
 type EscalationPolicy struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -4033,15 +4938,17 @@ type EscalationPolicy struct {
 	// What to do if escalation exhausted
 	ExhaustedAction *EscalationExhaustedAction `protobuf:"bytes,6,opt,name=exhausted_action,json=exhaustedAction,proto3" json:"exhausted_action,omitempty"`
 	// Metadata
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Wait between repeats of the policy
+	RepeatInterval *durationpb.Duration `protobuf:"bytes,9,opt,name=repeat_interval,json=repeatInterval,proto3" json:"repeat_interval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *EscalationPolicy) Reset() {
 	*x = EscalationPolicy{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[37]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4053,7 +4960,7 @@ func (x *EscalationPolicy) String() string {
 func (*EscalationPolicy) ProtoMessage() {}
 
 func (x *EscalationPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[37]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4066,7 +4973,7 @@ func (x *EscalationPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationPolicy.ProtoReflect.Descriptor instead.
 func (*EscalationPolicy) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{37}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *EscalationPolicy) GetId() string {
@@ -4125,6 +5032,13 @@ func (x *EscalationPolicy) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EscalationPolicy) GetRepeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RepeatInterval
+	}
+	return nil
+}
+
 type EscalationStep struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	StepNumber int32                  `protobuf:"varint,1,opt,name=step_number,json=stepNumber,proto3" json:"step_number,omitempty"`
@@ -4140,7 +5054,7 @@ type EscalationStep struct {
 
 func (x *EscalationStep) Reset() {
 	*x = EscalationStep{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[38]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4152,7 +5066,7 @@ func (x *EscalationStep) String() string {
 func (*EscalationStep) ProtoMessage() {}
 
 func (x *EscalationStep) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[38]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4165,7 +5079,7 @@ func (x *EscalationStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
 func (*EscalationStep) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{38}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EscalationStep) GetStepNumber() int32 {
@@ -4210,7 +5124,7 @@ type EscalationTarget struct {
 
 func (x *EscalationTarget) Reset() {
 	*x = EscalationTarget{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[39]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4222,7 +5136,7 @@ func (x *EscalationTarget) String() string {
 func (*EscalationTarget) ProtoMessage() {}
 
 func (x *EscalationTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[39]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,7 +5149,7 @@ func (x *EscalationTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationTarget.ProtoReflect.Descriptor instead.
 func (*EscalationTarget) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{39}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *EscalationTarget) GetType() EscalationTargetType {
@@ -4287,7 +5201,7 @@ type EscalationExhaustedAction struct {
 
 func (x *EscalationExhaustedAction) Reset() {
 	*x = EscalationExhaustedAction{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[40]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4299,7 +5213,7 @@ func (x *EscalationExhaustedAction) String() string {
 func (*EscalationExhaustedAction) ProtoMessage() {}
 
 func (x *EscalationExhaustedAction) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[40]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4312,7 +5226,7 @@ func (x *EscalationExhaustedAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationExhaustedAction.ProtoReflect.Descriptor instead.
 func (*EscalationExhaustedAction) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{40}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *EscalationExhaustedAction) GetType() ExhaustedActionType {
@@ -4351,13 +5265,16 @@ type RoutingAuditLog struct {
 	AlertSnapshot *structpb.Struct `protobuf:"bytes,6,opt,name=alert_snapshot,json=alertSnapshot,proto3" json:"alert_snapshot,omitempty"`
 	// Maintenance window result
 	MaintenanceResult *MaintenanceResult `protobuf:"bytes,7,opt,name=maintenance_result,json=maintenanceResult,proto3" json:"maintenance_result,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Rule that stopped evaluation (stop_processing, terminal or forced match),
+	// empty if every enabled rule was evaluated
+	StoppedAtRuleId string `protobuf:"bytes,8,opt,name=stopped_at_rule_id,json=stoppedAtRuleId,proto3" json:"stopped_at_rule_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RoutingAuditLog) Reset() {
 	*x = RoutingAuditLog{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[41]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4369,7 +5286,7 @@ func (x *RoutingAuditLog) String() string {
 func (*RoutingAuditLog) ProtoMessage() {}
 
 func (x *RoutingAuditLog) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[41]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4382,7 +5299,7 @@ func (x *RoutingAuditLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutingAuditLog.ProtoReflect.Descriptor instead.
 func (*RoutingAuditLog) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{41}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RoutingAuditLog) GetId() string {
@@ -4434,6 +5351,13 @@ func (x *RoutingAuditLog) GetMaintenanceResult() *MaintenanceResult {
 	return nil
 }
 
+func (x *RoutingAuditLog) GetStoppedAtRuleId() string {
+	if x != nil {
+		return x.StoppedAtRuleId
+	}
+	return ""
+}
+
 type RuleEvaluation struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	RuleId   string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
@@ -4448,13 +5372,18 @@ type RuleEvaluation struct {
 	// Time condition result
 	TimeConditionMatched bool   `protobuf:"varint,7,opt,name=time_condition_matched,json=timeConditionMatched,proto3" json:"time_condition_matched,omitempty"`
 	TimeConditionReason  string `protobuf:"bytes,8,opt,name=time_condition_reason,json=timeConditionReason,proto3" json:"time_condition_reason,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Site scope result
+	SiteScopeMatched bool   `protobuf:"varint,9,opt,name=site_scope_matched,json=siteScopeMatched,proto3" json:"site_scope_matched,omitempty"`
+	SiteScopeReason  string `protobuf:"bytes,10,opt,name=site_scope_reason,json=siteScopeReason,proto3" json:"site_scope_reason,omitempty"`
+	// Did evaluation stop after this rule (stop_processing, terminal or forced match)?
+	StoppedProcessing bool `protobuf:"varint,11,opt,name=stopped_processing,json=stoppedProcessing,proto3" json:"stopped_processing,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RuleEvaluation) Reset() {
 	*x = RuleEvaluation{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[42]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4466,7 +5395,7 @@ func (x *RuleEvaluation) String() string {
 func (*RuleEvaluation) ProtoMessage() {}
 
 func (x *RuleEvaluation) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[42]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4479,7 +5408,7 @@ func (x *RuleEvaluation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleEvaluation.ProtoReflect.Descriptor instead.
 func (*RuleEvaluation) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{42}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *RuleEvaluation) GetRuleId() string {
@@ -4538,6 +5467,27 @@ func (x *RuleEvaluation) GetTimeConditionReason() string {
 	return ""
 }
 
+func (x *RuleEvaluation) GetSiteScopeMatched() bool {
+	if x != nil {
+		return x.SiteScopeMatched
+	}
+	return false
+}
+
+func (x *RuleEvaluation) GetSiteScopeReason() string {
+	if x != nil {
+		return x.SiteScopeReason
+	}
+	return ""
+}
+
+func (x *RuleEvaluation) GetStoppedProcessing() bool {
+	if x != nil {
+		return x.StoppedProcessing
+	}
+	return false
+}
+
 type ConditionResult struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConditionIndex int32                  `protobuf:"varint,1,opt,name=condition_index,json=conditionIndex,proto3" json:"condition_index,omitempty"`
@@ -4546,13 +5496,14 @@ type ConditionResult struct {
 	Expected       string                 `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
 	Actual         string                 `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
 	Matched        bool                   `protobuf:"varint,6,opt,name=matched,proto3" json:"matched,omitempty"`
+	Operator       ConditionOperator      `protobuf:"varint,7,opt,name=operator,proto3,enum=alerting.routing.v1.ConditionOperator" json:"operator,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ConditionResult) Reset() {
 	*x = ConditionResult{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[43]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4564,7 +5515,7 @@ func (x *ConditionResult) String() string {
 func (*ConditionResult) ProtoMessage() {}
 
 func (x *ConditionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[43]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4577,7 +5528,7 @@ func (x *ConditionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionResult.ProtoReflect.Descriptor instead.
 func (*ConditionResult) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{43}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ConditionResult) GetConditionIndex() int32 {
@@ -4622,6 +5573,13 @@ func (x *ConditionResult) GetMatched() bool {
 	return false
 }
 
+func (x *ConditionResult) GetOperator() ConditionOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return ConditionOperator_CONDITION_OPERATOR_UNSPECIFIED
+}
+
 type ActionExecution struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	RuleId     string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
@@ -4641,7 +5599,7 @@ type ActionExecution struct {
 
 func (x *ActionExecution) Reset() {
 	*x = ActionExecution{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[44]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4653,7 +5611,7 @@ func (x *ActionExecution) String() string {
 func (*ActionExecution) ProtoMessage() {}
 
 func (x *ActionExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[44]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4666,7 +5624,7 @@ func (x *ActionExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionExecution.ProtoReflect.Descriptor instead.
 func (*ActionExecution) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{44}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ActionExecution) GetRuleId() string {
@@ -4729,7 +5687,7 @@ type MaintenanceResult struct {
 
 func (x *MaintenanceResult) Reset() {
 	*x = MaintenanceResult{}
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[45]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4741,7 +5699,7 @@ func (x *MaintenanceResult) String() string {
 func (*MaintenanceResult) ProtoMessage() {}
 
 func (x *MaintenanceResult) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_proto_msgTypes[45]
+	mi := &file_alerting_routing_v1_routing_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4754,7 +5712,7 @@ func (x *MaintenanceResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceResult.ProtoReflect.Descriptor instead.
 func (*MaintenanceResult) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{45}
+	return file_alerting_routing_v1_routing_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *MaintenanceResult) GetInMaintenance() bool {
@@ -4782,7 +5740,7 @@ var File_alerting_routing_v1_routing_proto protoreflect.FileDescriptor
 
 const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
-	"!alerting/routing/v1/routing.proto\x12\x13alerting.routing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xbd\x04\n" +
+	"!alerting/routing/v1/routing.proto\x12\x13alerting.routing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x98\x06\n" +
 	"\vRoutingRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4804,7 +5762,11 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"updated_by\x18\f \x01(\tR\tupdatedBy\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
-	"\x04tags\x18\x0e \x03(\tR\x04tags\"\xf0\x02\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x126\n" +
+	"\x17forced_integration_keys\x18\x0f \x03(\tR\x15forcedIntegrationKeys\x12)\n" +
+	"\x10affected_regions\x18\x10 \x03(\tR\x0faffectedRegions\x12M\n" +
+	"\x13affected_site_types\x18\x11 \x03(\x0e2\x1d.alerting.routing.v1.SiteTypeR\x11affectedSiteTypes\x12'\n" +
+	"\x0fstop_processing\x18\x12 \x01(\bR\x0estopProcessing\"\xf0\x02\n" +
 	"\x10RoutingCondition\x126\n" +
 	"\x04type\x18\x01 \x01(\x0e2\".alerting.routing.v1.ConditionTypeR\x04type\x12\x14\n" +
 	"\x05field\x18\x02 \x01(\tR\x05field\x12B\n" +
@@ -4816,7 +5778,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
 	"bool_value\x18\a \x01(\bR\tboolValue\x12#\n" +
 	"\rregex_pattern\x18\b \x01(\tR\fregexPattern\x12%\n" +
-	"\x0ecel_expression\x18\t \x01(\tR\rcelExpression\"\x9a\x06\n" +
+	"\x0ecel_expression\x18\t \x01(\tR\rcelExpression\"\xb9\a\n" +
 	"\rRoutingAction\x123\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1f.alerting.routing.v1.ActionTypeR\x04type\x12F\n" +
 	"\vnotify_team\x18\x02 \x01(\v2%.alerting.routing.v1.NotifyTeamActionR\n" +
@@ -4831,7 +5793,10 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\bescalate\x18\t \x01(\v2#.alerting.routing.v1.EscalateActionR\bescalate\x12L\n" +
 	"\rcreate_ticket\x18\n" +
 	" \x01(\v2'.alerting.routing.v1.CreateTicketActionR\fcreateTicket\x12@\n" +
-	"\tset_label\x18\v \x01(\v2#.alerting.routing.v1.SetLabelActionR\bsetLabel\"\x88\x01\n" +
+	"\tset_label\x18\v \x01(\v2#.alerting.routing.v1.SetLabelActionR\bsetLabel\x12X\n" +
+	"\x11forward_pagerduty\x18\f \x01(\v2+.alerting.routing.v1.ForwardPagerDutyActionR\x10forwardPagerduty\x12C\n" +
+	"\n" +
+	"notify_sns\x18\r \x01(\v2$.alerting.routing.v1.NotifySNSActionR\tnotifySns\"\x88\x01\n" +
 	"\x10NotifyTeamAction\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12:\n" +
 	"\x05scope\x18\x02 \x01(\x0e2$.alerting.routing.v1.TeamNotifyScopeR\x05scope\x12\x1f\n" +
@@ -4896,7 +5861,17 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x12overwrite_existing\x18\x02 \x01(\bR\x11overwriteExisting\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
+	"\x16ForwardPagerDutyAction\x12\x1f\n" +
+	"\vrouting_key\x18\x01 \x01(\tR\n" +
+	"routingKey\x12&\n" +
+	"\x0fdedup_key_label\x18\x02 \x01(\tR\rdedupKeyLabel\x12A\n" +
+	"\x1dpayload_custom_details_labels\x18\x03 \x03(\tR\x1apayloadCustomDetailsLabels\"\x9c\x01\n" +
+	"\x0fNotifySNSAction\x12\x1b\n" +
+	"\ttopic_arn\x18\x01 \x01(\tR\btopicArn\x12)\n" +
+	"\x10subject_template\x18\x02 \x01(\tR\x0fsubjectTemplate\x12)\n" +
+	"\x10message_template\x18\x03 \x01(\tR\x0fmessageTemplate\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\"f\n" +
 	"\rTimeCondition\x12\x1a\n" +
 	"\btimezone\x18\x01 \x01(\tR\btimezone\x129\n" +
 	"\awindows\x18\x02 \x03(\v2\x1f.alerting.routing.v1.TimeWindowR\awindows\"\x80\x01\n" +
@@ -4907,7 +5882,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
 	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x16\n" +
-	"\x06invert\x18\x04 \x01(\bR\x06invert\"\xa0\x03\n" +
+	"\x06invert\x18\x04 \x01(\bR\x06invert\"\xa6\x04\n" +
 	"\x12NotificationTarget\x12:\n" +
 	"\achannel\x18\x01 \x01(\x0e2 .alerting.routing.v1.ChannelTypeR\achannel\x126\n" +
 	"\x05slack\x18\x02 \x01(\v2 .alerting.routing.v1.SlackTargetR\x05slack\x126\n" +
@@ -4915,7 +5890,10 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x05email\x18\x04 \x01(\v2 .alerting.routing.v1.EmailTargetR\x05email\x120\n" +
 	"\x03sms\x18\x05 \x01(\v2\x1e.alerting.routing.v1.SMSTargetR\x03sms\x12<\n" +
 	"\awebhook\x18\x06 \x01(\v2\".alerting.routing.v1.WebhookTargetR\awebhook\x126\n" +
-	"\x05pager\x18\a \x01(\v2 .alerting.routing.v1.PagerTargetR\x05pager\"r\n" +
+	"\x05pager\x18\a \x01(\v2 .alerting.routing.v1.PagerTargetR\x05pager\x12<\n" +
+	"\adiscord\x18\b \x01(\v2\".alerting.routing.v1.DiscordTargetR\adiscord\x12F\n" +
+	"\vgoogle_chat\x18\t \x01(\v2%.alerting.routing.v1.GoogleChatTargetR\n" +
+	"googleChat\"r\n" +
 	"\vSlackTarget\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
@@ -4941,7 +5919,18 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
 	"\vPagerTarget\x12\x1f\n" +
 	"\vservice_key\x18\x01 \x01(\tR\n" +
-	"serviceKey\"\xab\x05\n" +
+	"serviceKey\"k\n" +
+	"\rDiscordTarget\x12\x1f\n" +
+	"\vwebhook_url\x18\x01 \x01(\tR\n" +
+	"webhookUrl\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"U\n" +
+	"\x10GoogleChatTarget\x12\x1f\n" +
+	"\vwebhook_url\x18\x01 \x01(\tR\n" +
+	"webhookUrl\x12 \n" +
+	"\fuse_cards_v2\x18\x02 \x01(\bR\n" +
+	"useCardsV2\"\xab\x05\n" +
 	"\x04Team\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4972,7 +5961,14 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x12preferred_channels\x18\x01 \x03(\x0e2 .alerting.routing.v1.ChannelTypeR\x11preferredChannels\x12@\n" +
 	"\vquiet_hours\x18\x02 \x03(\v2\x1f.alerting.routing.v1.TimeWindowR\n" +
 	"quietHours\x12D\n" +
-	"\x10escalation_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0fescalationDelay\"\xbb\x03\n" +
+	"\x10escalation_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0fescalationDelay\"\x9a\x02\n" +
+	"\x10UserAvailability\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12U\n" +
+	"\x12unavailable_reason\x18\x03 \x01(\x0e2&.alerting.routing.v1.UnavailableReasonR\x11unavailableReason\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"\x92\x04\n" +
 	"\bSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4986,7 +5982,8 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xff\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12U\n" +
+	"\x10handoff_reminder\x18\v \x01(\v2*.alerting.routing.v1.HandoffReminderConfigR\x0fhandoffReminder\"\xff\x02\n" +
 	"\bRotation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
@@ -5035,7 +6032,18 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x0fhandoff_channel\x18\x04 \x01(\v2'.alerting.routing.v1.NotificationTargetR\x0ehandoffChannel\x12\x1f\n" +
 	"\vrequire_ack\x18\x05 \x01(\bR\n" +
 	"requireAck\x12:\n" +
-	"\x1aescalate_if_no_ack_minutes\x18\x06 \x01(\x05R\x16escalateIfNoAckMinutes\"\xad\x05\n" +
+	"\x1aescalate_if_no_ack_minutes\x18\x06 \x01(\x05R\x16escalateIfNoAckMinutes\"\x95\x01\n" +
+	"\x15HandoffReminderConfig\x12@\n" +
+	"\x0eadvance_notice\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\radvanceNotice\x12:\n" +
+	"\achannel\x18\x02 \x01(\x0e2 .alerting.routing.v1.ChannelTypeR\achannel\"\xb9\x01\n" +
+	"\vHandoffNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12$\n" +
+	"\x0eauthor_user_id\x18\x03 \x01(\tR\fauthorUserId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xef\x05\n" +
 	"\x04Site\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -5055,10 +6063,15 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\bcapacity\x18\x11 \x01(\v2$.alerting.routing.v1.CapacityMetricsR\bcapacity\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa7\x01\n" +
+	"\x0fCapacityMetrics\x12#\n" +
+	"\rtotal_servers\x18\x01 \x01(\x05R\ftotalServers\x120\n" +
+	"\x14current_load_percent\x18\x02 \x01(\x02R\x12currentLoadPercent\x12=\n" +
+	"\flast_updated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\xff\x03\n" +
 	"\fCustomerTier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -5089,7 +6102,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\ateam_id\x18\a \x01(\tR\x06teamId\x12\x1f\n" +
 	"\vauto_ticket\x18\b \x01(\bR\n" +
 	"autoTicket\x12,\n" +
-	"\x12ticket_provider_id\x18\t \x01(\tR\x10ticketProviderId\"\xcc\x04\n" +
+	"\x12ticket_provider_id\x18\t \x01(\tR\x10ticketProviderId\"\xdb\a\n" +
 	"\x11MaintenanceWindow\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -5107,7 +6120,27 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12(\n" +
 	"\x10change_ticket_id\x18\f \x01(\tR\x0echangeTicketId\x12>\n" +
-	"\x06status\x18\r \x01(\x0e2&.alerting.routing.v1.MaintenanceStatusR\x06status\"\x87\x03\n" +
+	"\x06status\x18\r \x01(\x0e2&.alerting.routing.v1.MaintenanceStatusR\x06status\x12J\n" +
+	"\x06labels\x18\x0e \x03(\v22.alerting.routing.v1.MaintenanceWindow.LabelsEntryR\x06labels\x12C\n" +
+	"\n" +
+	"recurrence\x18\x0f \x01(\v2#.alerting.routing.v1.RecurrenceRuleR\n" +
+	"recurrence\x12+\n" +
+	"\x11requires_approval\x18\x10 \x01(\bR\x10requiresApproval\x12\x1f\n" +
+	"\vapproved_by\x18\x11 \x01(\tR\n" +
+	"approvedBy\x12;\n" +
+	"\vapproved_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x126\n" +
+	"\x17affected_label_patterns\x18\x13 \x03(\tR\x15affectedLabelPatterns\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
+	"\x0eRecurrenceRule\x12F\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2(.alerting.routing.v1.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\x12 \n" +
+	"\fdays_of_week\x18\x03 \x03(\x05R\n" +
+	"daysOfWeek\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x120\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\xcb\x03\n" +
 	"\x10EscalationPolicy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -5118,7 +6151,8 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd1\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0frepeat_interval\x18\t \x01(\v2\x19.google.protobuf.DurationR\x0erepeatInterval\"\xd1\x01\n" +
 	"\x0eEscalationStep\x12\x1f\n" +
 	"\vstep_number\x18\x01 \x01(\x05R\n" +
 	"stepNumber\x12/\n" +
@@ -5135,7 +6169,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x19EscalationExhaustedAction\x12<\n" +
 	"\x04type\x18\x01 \x01(\x0e2(.alerting.routing.v1.ExhaustedActionTypeR\x04type\x12P\n" +
 	"\x0ffallback_target\x18\x02 \x01(\v2'.alerting.routing.v1.NotificationTargetR\x0efallbackTarget\x12+\n" +
-	"\x11incident_severity\x18\x03 \x01(\tR\x10incidentSeverity\"\x9a\x03\n" +
+	"\x11incident_severity\x18\x03 \x01(\tR\x10incidentSeverity\"\xc7\x03\n" +
 	"\x0fRoutingAuditLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\balert_id\x18\x02 \x01(\tR\aalertId\x128\n" +
@@ -5145,7 +6179,8 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"executions\x18\x05 \x03(\v2$.alerting.routing.v1.ActionExecutionR\n" +
 	"executions\x12>\n" +
 	"\x0ealert_snapshot\x18\x06 \x01(\v2\x17.google.protobuf.StructR\ralertSnapshot\x12U\n" +
-	"\x12maintenance_result\x18\a \x01(\v2&.alerting.routing.v1.MaintenanceResultR\x11maintenanceResult\"\xd5\x02\n" +
+	"\x12maintenance_result\x18\a \x01(\v2&.alerting.routing.v1.MaintenanceResultR\x11maintenanceResult\x12+\n" +
+	"\x12stopped_at_rule_id\x18\b \x01(\tR\x0fstoppedAtRuleId\"\xde\x03\n" +
 	"\x0eRuleEvaluation\x12\x17\n" +
 	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
 	"\trule_name\x18\x02 \x01(\tR\bruleName\x12\x1a\n" +
@@ -5154,14 +6189,19 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x11condition_results\x18\x05 \x03(\v2$.alerting.routing.v1.ConditionResultR\x10conditionResults\x12\x1a\n" +
 	"\bterminal\x18\x06 \x01(\bR\bterminal\x124\n" +
 	"\x16time_condition_matched\x18\a \x01(\bR\x14timeConditionMatched\x122\n" +
-	"\x15time_condition_reason\x18\b \x01(\tR\x13timeConditionReason\"\xd6\x01\n" +
+	"\x15time_condition_reason\x18\b \x01(\tR\x13timeConditionReason\x12,\n" +
+	"\x12site_scope_matched\x18\t \x01(\bR\x10siteScopeMatched\x12*\n" +
+	"\x11site_scope_reason\x18\n" +
+	" \x01(\tR\x0fsiteScopeReason\x12-\n" +
+	"\x12stopped_processing\x18\v \x01(\bR\x11stoppedProcessing\"\x9a\x02\n" +
 	"\x0fConditionResult\x12'\n" +
 	"\x0fcondition_index\x18\x01 \x01(\x05R\x0econditionIndex\x126\n" +
 	"\x04type\x18\x02 \x01(\x0e2\".alerting.routing.v1.ConditionTypeR\x04type\x12\x14\n" +
 	"\x05field\x18\x03 \x01(\tR\x05field\x12\x1a\n" +
 	"\bexpected\x18\x04 \x01(\tR\bexpected\x12\x16\n" +
 	"\x06actual\x18\x05 \x01(\tR\x06actual\x12\x18\n" +
-	"\amatched\x18\x06 \x01(\bR\amatched\"\xd3\x02\n" +
+	"\amatched\x18\x06 \x01(\bR\amatched\x12B\n" +
+	"\boperator\x18\a \x01(\x0e2&.alerting.routing.v1.ConditionOperatorR\boperator\"\xd3\x02\n" +
 	"\x0fActionExecution\x12\x17\n" +
 	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12@\n" +
 	"\vaction_type\x18\x02 \x01(\x0e2\x1f.alerting.routing.v1.ActionTypeR\n" +
@@ -5175,7 +6215,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x11MaintenanceResult\x12%\n" +
 	"\x0ein_maintenance\x18\x01 \x01(\bR\rinMaintenance\x12>\n" +
 	"\x06window\x18\x02 \x01(\v2&.alerting.routing.v1.MaintenanceWindowR\x06window\x12>\n" +
-	"\x06action\x18\x03 \x01(\x0e2&.alerting.routing.v1.MaintenanceActionR\x06action*\xe6\x02\n" +
+	"\x06action\x18\x03 \x01(\x0e2&.alerting.routing.v1.MaintenanceActionR\x06action*\xa5\x03\n" +
 	"\rConditionType\x12\x1e\n" +
 	"\x1aCONDITION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CONDITION_TYPE_LABEL\x10\x01\x12\x1d\n" +
@@ -5189,7 +6229,9 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x1dCONDITION_TYPE_EQUIPMENT_TYPE\x10\t\x12\x1a\n" +
 	"\x16CONDITION_TYPE_CARRIER\x10\n" +
 	"\x12\x16\n" +
-	"\x12CONDITION_TYPE_CEL\x10\v*\xe6\x03\n" +
+	"\x12CONDITION_TYPE_CEL\x10\v\x12\x1e\n" +
+	"\x1aCONDITION_TYPE_TIME_WINDOW\x10\f\x12\x1d\n" +
+	"\x19CONDITION_TYPE_GROUP_SIZE\x10\r*\xe3\x05\n" +
 	"\x11ConditionOperator\x12\"\n" +
 	"\x1eCONDITION_OPERATOR_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19CONDITION_OPERATOR_EQUALS\x10\x01\x12!\n" +
@@ -5205,7 +6247,14 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x12!\n" +
 	"\x1dCONDITION_OPERATOR_NOT_EXISTS\x10\v\x12#\n" +
 	"\x1fCONDITION_OPERATOR_GREATER_THAN\x10\f\x12 \n" +
-	"\x1cCONDITION_OPERATOR_LESS_THAN\x10\r*\xcb\x02\n" +
+	"\x1cCONDITION_OPERATOR_LESS_THAN\x10\r\x12\"\n" +
+	"\x1eCONDITION_OPERATOR_REGEX_MATCH\x10\x0e\x12&\n" +
+	"\"CONDITION_OPERATOR_REGEX_NOT_MATCH\x10\x0f\x12!\n" +
+	"\x1dCONDITION_OPERATOR_NUMERIC_GT\x10\x10\x12\"\n" +
+	"\x1eCONDITION_OPERATOR_NUMERIC_GTE\x10\x11\x12!\n" +
+	"\x1dCONDITION_OPERATOR_NUMERIC_LT\x10\x12\x12\"\n" +
+	"\x1eCONDITION_OPERATOR_NUMERIC_LTE\x10\x13\x12!\n" +
+	"\x1dCONDITION_OPERATOR_NUMERIC_EQ\x10\x14*\xae\x03\n" +
 	"\n" +
 	"ActionType\x12\x1b\n" +
 	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
@@ -5219,7 +6268,10 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x14ACTION_TYPE_ESCALATE\x10\b\x12\x1d\n" +
 	"\x19ACTION_TYPE_CREATE_TICKET\x10\t\x12\x19\n" +
 	"\x15ACTION_TYPE_SET_LABEL\x10\n" +
-	"*\xb3\x01\n" +
+	"\x12!\n" +
+	"\x1dACTION_TYPE_FORWARD_PAGERDUTY\x10\v\x12\x1a\n" +
+	"\x16ACTION_TYPE_NOTIFY_SNS\x10\f\x12\"\n" +
+	"\x1eACTION_TYPE_APPLY_TIER_ROUTING\x10\r*\xb3\x01\n" +
 	"\x0fTeamNotifyScope\x12!\n" +
 	"\x1dTEAM_NOTIFY_SCOPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15TEAM_NOTIFY_SCOPE_ALL\x10\x01\x12\x1c\n" +
@@ -5230,7 +6282,7 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x18ONCALL_LEVEL_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ONCALL_LEVEL_PRIMARY\x10\x01\x12\x1a\n" +
 	"\x16ONCALL_LEVEL_SECONDARY\x10\x02\x12\x15\n" +
-	"\x11ONCALL_LEVEL_BOTH\x10\x03*\xea\x01\n" +
+	"\x11ONCALL_LEVEL_BOTH\x10\x03*\xa2\x02\n" +
 	"\vChannelType\x12\x1c\n" +
 	"\x18CHANNEL_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12CHANNEL_TYPE_SLACK\x10\x01\x12\x16\n" +
@@ -5240,12 +6292,20 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\x12CHANNEL_TYPE_VOICE\x10\x05\x12\x18\n" +
 	"\x14CHANNEL_TYPE_WEBHOOK\x10\x06\x12\x16\n" +
 	"\x12CHANNEL_TYPE_PAGER\x10\a\x12\x15\n" +
-	"\x11CHANNEL_TYPE_PUSH\x10\b*f\n" +
+	"\x11CHANNEL_TYPE_PUSH\x10\b\x12\x18\n" +
+	"\x14CHANNEL_TYPE_DISCORD\x10\t\x12\x1c\n" +
+	"\x18CHANNEL_TYPE_GOOGLE_CHAT\x10\n" +
+	"*f\n" +
 	"\bTeamRole\x12\x19\n" +
 	"\x15TEAM_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10TEAM_ROLE_MEMBER\x10\x01\x12\x12\n" +
 	"\x0eTEAM_ROLE_LEAD\x10\x02\x12\x15\n" +
 	"\x11TEAM_ROLE_MANAGER\x10\x03*\x96\x01\n" +
+	"\x11UnavailableReason\x12\"\n" +
+	"\x1eUNAVAILABLE_REASON_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bUNAVAILABLE_REASON_VACATION\x10\x01\x12\x1b\n" +
+	"\x17UNAVAILABLE_REASON_SICK\x10\x02\x12\x1f\n" +
+	"\x1bUNAVAILABLE_REASON_TRAINING\x10\x03*\x96\x01\n" +
 	"\fRotationType\x12\x1d\n" +
 	"\x19ROTATION_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13ROTATION_TYPE_DAILY\x10\x01\x12\x18\n" +
@@ -5263,18 +6323,24 @@ const file_alerting_routing_v1_routing_proto_rawDesc = "" +
 	"\rSITE_TYPE_POP\x10\x02\x12\x18\n" +
 	"\x14SITE_TYPE_COLOCATION\x10\x03\x12\x12\n" +
 	"\x0eSITE_TYPE_EDGE\x10\x04\x12\x14\n" +
-	"\x10SITE_TYPE_OFFICE\x10\x05*\xa1\x01\n" +
+	"\x10SITE_TYPE_OFFICE\x10\x05*\x9e\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x02\x12 \n" +
+	"\x1cRECURRENCE_FREQUENCY_MONTHLY\x10\x03*\xa1\x01\n" +
 	"\x11MaintenanceAction\x12\"\n" +
 	"\x1eMAINTENANCE_ACTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bMAINTENANCE_ACTION_SUPPRESS\x10\x01\x12\x1f\n" +
 	"\x1bMAINTENANCE_ACTION_ANNOTATE\x10\x02\x12&\n" +
-	"\"MAINTENANCE_ACTION_REDUCE_SEVERITY\x10\x03*\xc1\x01\n" +
+	"\"MAINTENANCE_ACTION_REDUCE_SEVERITY\x10\x03*\xea\x01\n" +
 	"\x11MaintenanceStatus\x12\"\n" +
 	"\x1eMAINTENANCE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cMAINTENANCE_STATUS_SCHEDULED\x10\x01\x12\"\n" +
 	"\x1eMAINTENANCE_STATUS_IN_PROGRESS\x10\x02\x12 \n" +
 	"\x1cMAINTENANCE_STATUS_COMPLETED\x10\x03\x12 \n" +
-	"\x1cMAINTENANCE_STATUS_CANCELLED\x10\x04*\xc9\x01\n" +
+	"\x1cMAINTENANCE_STATUS_CANCELLED\x10\x04\x12'\n" +
+	"#MAINTENANCE_STATUS_PENDING_APPROVAL\x10\x05*\xc9\x01\n" +
 	"\x14EscalationTargetType\x12&\n" +
 	"\"ESCALATION_TARGET_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bESCALATION_TARGET_TYPE_USER\x10\x01\x12#\n" +
@@ -5301,8 +6367,8 @@ func file_alerting_routing_v1_routing_proto_rawDescGZIP() []byte {
 	return file_alerting_routing_v1_routing_proto_rawDescData
 }
 
-var file_alerting_routing_v1_routing_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_alerting_routing_v1_routing_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_alerting_routing_v1_routing_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_alerting_routing_v1_routing_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_alerting_routing_v1_routing_proto_goTypes = []any{
 	(ConditionType)(0),                // 0: alerting.routing.v1.ConditionType
 	(ConditionOperator)(0),            // 1: alerting.routing.v1.ConditionOperator
@@ -5311,178 +6377,211 @@ var file_alerting_routing_v1_routing_proto_goTypes = []any{
 	(OnCallLevel)(0),                  // 4: alerting.routing.v1.OnCallLevel
 	(ChannelType)(0),                  // 5: alerting.routing.v1.ChannelType
 	(TeamRole)(0),                     // 6: alerting.routing.v1.TeamRole
-	(RotationType)(0),                 // 7: alerting.routing.v1.RotationType
-	(ShiftType)(0),                    // 8: alerting.routing.v1.ShiftType
-	(SiteType)(0),                     // 9: alerting.routing.v1.SiteType
-	(MaintenanceAction)(0),            // 10: alerting.routing.v1.MaintenanceAction
-	(MaintenanceStatus)(0),            // 11: alerting.routing.v1.MaintenanceStatus
-	(EscalationTargetType)(0),         // 12: alerting.routing.v1.EscalationTargetType
-	(ExhaustedActionType)(0),          // 13: alerting.routing.v1.ExhaustedActionType
-	(*RoutingRule)(nil),               // 14: alerting.routing.v1.RoutingRule
-	(*RoutingCondition)(nil),          // 15: alerting.routing.v1.RoutingCondition
-	(*RoutingAction)(nil),             // 16: alerting.routing.v1.RoutingAction
-	(*NotifyTeamAction)(nil),          // 17: alerting.routing.v1.NotifyTeamAction
-	(*NotifyChannelAction)(nil),       // 18: alerting.routing.v1.NotifyChannelAction
-	(*NotifyUserAction)(nil),          // 19: alerting.routing.v1.NotifyUserAction
-	(*NotifyOnCallAction)(nil),        // 20: alerting.routing.v1.NotifyOnCallAction
-	(*NotifyWebhookAction)(nil),       // 21: alerting.routing.v1.NotifyWebhookAction
-	(*SuppressAction)(nil),            // 22: alerting.routing.v1.SuppressAction
-	(*AggregateAction)(nil),           // 23: alerting.routing.v1.AggregateAction
-	(*EscalateAction)(nil),            // 24: alerting.routing.v1.EscalateAction
-	(*CreateTicketAction)(nil),        // 25: alerting.routing.v1.CreateTicketAction
-	(*SetLabelAction)(nil),            // 26: alerting.routing.v1.SetLabelAction
-	(*TimeCondition)(nil),             // 27: alerting.routing.v1.TimeCondition
-	(*TimeWindow)(nil),                // 28: alerting.routing.v1.TimeWindow
-	(*NotificationTarget)(nil),        // 29: alerting.routing.v1.NotificationTarget
-	(*SlackTarget)(nil),               // 30: alerting.routing.v1.SlackTarget
-	(*TeamsTarget)(nil),               // 31: alerting.routing.v1.TeamsTarget
-	(*EmailTarget)(nil),               // 32: alerting.routing.v1.EmailTarget
-	(*SMSTarget)(nil),                 // 33: alerting.routing.v1.SMSTarget
-	(*WebhookTarget)(nil),             // 34: alerting.routing.v1.WebhookTarget
-	(*PagerTarget)(nil),               // 35: alerting.routing.v1.PagerTarget
-	(*Team)(nil),                      // 36: alerting.routing.v1.Team
-	(*TeamMember)(nil),                // 37: alerting.routing.v1.TeamMember
-	(*NotificationPreferences)(nil),   // 38: alerting.routing.v1.NotificationPreferences
-	(*Schedule)(nil),                  // 39: alerting.routing.v1.Schedule
-	(*Rotation)(nil),                  // 40: alerting.routing.v1.Rotation
-	(*RotationMember)(nil),            // 41: alerting.routing.v1.RotationMember
-	(*ShiftConfig)(nil),               // 42: alerting.routing.v1.ShiftConfig
-	(*ScheduleOverride)(nil),          // 43: alerting.routing.v1.ScheduleOverride
-	(*Shift)(nil),                     // 44: alerting.routing.v1.Shift
-	(*HandoffConfig)(nil),             // 45: alerting.routing.v1.HandoffConfig
-	(*Site)(nil),                      // 46: alerting.routing.v1.Site
-	(*CustomerTier)(nil),              // 47: alerting.routing.v1.CustomerTier
-	(*EquipmentType)(nil),             // 48: alerting.routing.v1.EquipmentType
-	(*CarrierConfig)(nil),             // 49: alerting.routing.v1.CarrierConfig
-	(*MaintenanceWindow)(nil),         // 50: alerting.routing.v1.MaintenanceWindow
-	(*EscalationPolicy)(nil),          // 51: alerting.routing.v1.EscalationPolicy
-	(*EscalationStep)(nil),            // 52: alerting.routing.v1.EscalationStep
-	(*EscalationTarget)(nil),          // 53: alerting.routing.v1.EscalationTarget
-	(*EscalationExhaustedAction)(nil), // 54: alerting.routing.v1.EscalationExhaustedAction
-	(*RoutingAuditLog)(nil),           // 55: alerting.routing.v1.RoutingAuditLog
-	(*RuleEvaluation)(nil),            // 56: alerting.routing.v1.RuleEvaluation
-	(*ConditionResult)(nil),           // 57: alerting.routing.v1.ConditionResult
-	(*ActionExecution)(nil),           // 58: alerting.routing.v1.ActionExecution
-	(*MaintenanceResult)(nil),         // 59: alerting.routing.v1.MaintenanceResult
-	nil,                               // 60: alerting.routing.v1.NotifyWebhookAction.HeadersEntry
-	nil,                               // 61: alerting.routing.v1.CreateTicketAction.FieldsEntry
-	nil,                               // 62: alerting.routing.v1.SetLabelAction.LabelsEntry
-	nil,                               // 63: alerting.routing.v1.WebhookTarget.HeadersEntry
-	nil,                               // 64: alerting.routing.v1.Team.MetadataEntry
-	nil,                               // 65: alerting.routing.v1.Site.MetadataEntry
-	nil,                               // 66: alerting.routing.v1.CustomerTier.MetadataEntry
-	(*timestamppb.Timestamp)(nil),     // 67: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),       // 68: google.protobuf.Duration
-	(*structpb.Struct)(nil),           // 69: google.protobuf.Struct
+	(UnavailableReason)(0),            // 7: alerting.routing.v1.UnavailableReason
+	(RotationType)(0),                 // 8: alerting.routing.v1.RotationType
+	(ShiftType)(0),                    // 9: alerting.routing.v1.ShiftType
+	(SiteType)(0),                     // 10: alerting.routing.v1.SiteType
+	(RecurrenceFrequency)(0),          // 11: alerting.routing.v1.RecurrenceFrequency
+	(MaintenanceAction)(0),            // 12: alerting.routing.v1.MaintenanceAction
+	(MaintenanceStatus)(0),            // 13: alerting.routing.v1.MaintenanceStatus
+	(EscalationTargetType)(0),         // 14: alerting.routing.v1.EscalationTargetType
+	(ExhaustedActionType)(0),          // 15: alerting.routing.v1.ExhaustedActionType
+	(*RoutingRule)(nil),               // 16: alerting.routing.v1.RoutingRule
+	(*RoutingCondition)(nil),          // 17: alerting.routing.v1.RoutingCondition
+	(*RoutingAction)(nil),             // 18: alerting.routing.v1.RoutingAction
+	(*NotifyTeamAction)(nil),          // 19: alerting.routing.v1.NotifyTeamAction
+	(*NotifyChannelAction)(nil),       // 20: alerting.routing.v1.NotifyChannelAction
+	(*NotifyUserAction)(nil),          // 21: alerting.routing.v1.NotifyUserAction
+	(*NotifyOnCallAction)(nil),        // 22: alerting.routing.v1.NotifyOnCallAction
+	(*NotifyWebhookAction)(nil),       // 23: alerting.routing.v1.NotifyWebhookAction
+	(*SuppressAction)(nil),            // 24: alerting.routing.v1.SuppressAction
+	(*AggregateAction)(nil),           // 25: alerting.routing.v1.AggregateAction
+	(*EscalateAction)(nil),            // 26: alerting.routing.v1.EscalateAction
+	(*CreateTicketAction)(nil),        // 27: alerting.routing.v1.CreateTicketAction
+	(*SetLabelAction)(nil),            // 28: alerting.routing.v1.SetLabelAction
+	(*ForwardPagerDutyAction)(nil),    // 29: alerting.routing.v1.ForwardPagerDutyAction
+	(*NotifySNSAction)(nil),           // 30: alerting.routing.v1.NotifySNSAction
+	(*TimeCondition)(nil),             // 31: alerting.routing.v1.TimeCondition
+	(*TimeWindow)(nil),                // 32: alerting.routing.v1.TimeWindow
+	(*NotificationTarget)(nil),        // 33: alerting.routing.v1.NotificationTarget
+	(*SlackTarget)(nil),               // 34: alerting.routing.v1.SlackTarget
+	(*TeamsTarget)(nil),               // 35: alerting.routing.v1.TeamsTarget
+	(*EmailTarget)(nil),               // 36: alerting.routing.v1.EmailTarget
+	(*SMSTarget)(nil),                 // 37: alerting.routing.v1.SMSTarget
+	(*WebhookTarget)(nil),             // 38: alerting.routing.v1.WebhookTarget
+	(*PagerTarget)(nil),               // 39: alerting.routing.v1.PagerTarget
+	(*DiscordTarget)(nil),             // 40: alerting.routing.v1.DiscordTarget
+	(*GoogleChatTarget)(nil),          // 41: alerting.routing.v1.GoogleChatTarget
+	(*Team)(nil),                      // 42: alerting.routing.v1.Team
+	(*TeamMember)(nil),                // 43: alerting.routing.v1.TeamMember
+	(*NotificationPreferences)(nil),   // 44: alerting.routing.v1.NotificationPreferences
+	(*UserAvailability)(nil),          // 45: alerting.routing.v1.UserAvailability
+	(*Schedule)(nil),                  // 46: alerting.routing.v1.Schedule
+	(*Rotation)(nil),                  // 47: alerting.routing.v1.Rotation
+	(*RotationMember)(nil),            // 48: alerting.routing.v1.RotationMember
+	(*ShiftConfig)(nil),               // 49: alerting.routing.v1.ShiftConfig
+	(*ScheduleOverride)(nil),          // 50: alerting.routing.v1.ScheduleOverride
+	(*Shift)(nil),                     // 51: alerting.routing.v1.Shift
+	(*HandoffConfig)(nil),             // 52: alerting.routing.v1.HandoffConfig
+	(*HandoffReminderConfig)(nil),     // 53: alerting.routing.v1.HandoffReminderConfig
+	(*HandoffNote)(nil),               // 54: alerting.routing.v1.HandoffNote
+	(*Site)(nil),                      // 55: alerting.routing.v1.Site
+	(*CapacityMetrics)(nil),           // 56: alerting.routing.v1.CapacityMetrics
+	(*CustomerTier)(nil),              // 57: alerting.routing.v1.CustomerTier
+	(*EquipmentType)(nil),             // 58: alerting.routing.v1.EquipmentType
+	(*CarrierConfig)(nil),             // 59: alerting.routing.v1.CarrierConfig
+	(*MaintenanceWindow)(nil),         // 60: alerting.routing.v1.MaintenanceWindow
+	(*RecurrenceRule)(nil),            // 61: alerting.routing.v1.RecurrenceRule
+	(*EscalationPolicy)(nil),          // 62: alerting.routing.v1.EscalationPolicy
+	(*EscalationStep)(nil),            // 63: alerting.routing.v1.EscalationStep
+	(*EscalationTarget)(nil),          // 64: alerting.routing.v1.EscalationTarget
+	(*EscalationExhaustedAction)(nil), // 65: alerting.routing.v1.EscalationExhaustedAction
+	(*RoutingAuditLog)(nil),           // 66: alerting.routing.v1.RoutingAuditLog
+	(*RuleEvaluation)(nil),            // 67: alerting.routing.v1.RuleEvaluation
+	(*ConditionResult)(nil),           // 68: alerting.routing.v1.ConditionResult
+	(*ActionExecution)(nil),           // 69: alerting.routing.v1.ActionExecution
+	(*MaintenanceResult)(nil),         // 70: alerting.routing.v1.MaintenanceResult
+	nil,                               // 71: alerting.routing.v1.NotifyWebhookAction.HeadersEntry
+	nil,                               // 72: alerting.routing.v1.CreateTicketAction.FieldsEntry
+	nil,                               // 73: alerting.routing.v1.SetLabelAction.LabelsEntry
+	nil,                               // 74: alerting.routing.v1.WebhookTarget.HeadersEntry
+	nil,                               // 75: alerting.routing.v1.Team.MetadataEntry
+	nil,                               // 76: alerting.routing.v1.Site.MetadataEntry
+	nil,                               // 77: alerting.routing.v1.CustomerTier.MetadataEntry
+	nil,                               // 78: alerting.routing.v1.MaintenanceWindow.LabelsEntry
+	(*timestamppb.Timestamp)(nil),     // 79: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 80: google.protobuf.Duration
+	(*structpb.Struct)(nil),           // 81: google.protobuf.Struct
 }
 var file_alerting_routing_v1_routing_proto_depIdxs = []int32{
-	15,  // 0: alerting.routing.v1.RoutingRule.conditions:type_name -> alerting.routing.v1.RoutingCondition
-	16,  // 1: alerting.routing.v1.RoutingRule.actions:type_name -> alerting.routing.v1.RoutingAction
-	27,  // 2: alerting.routing.v1.RoutingRule.time_condition:type_name -> alerting.routing.v1.TimeCondition
-	67,  // 3: alerting.routing.v1.RoutingRule.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 4: alerting.routing.v1.RoutingRule.updated_at:type_name -> google.protobuf.Timestamp
-	0,   // 5: alerting.routing.v1.RoutingCondition.type:type_name -> alerting.routing.v1.ConditionType
-	1,   // 6: alerting.routing.v1.RoutingCondition.operator:type_name -> alerting.routing.v1.ConditionOperator
-	2,   // 7: alerting.routing.v1.RoutingAction.type:type_name -> alerting.routing.v1.ActionType
-	17,  // 8: alerting.routing.v1.RoutingAction.notify_team:type_name -> alerting.routing.v1.NotifyTeamAction
-	18,  // 9: alerting.routing.v1.RoutingAction.notify_channel:type_name -> alerting.routing.v1.NotifyChannelAction
-	19,  // 10: alerting.routing.v1.RoutingAction.notify_user:type_name -> alerting.routing.v1.NotifyUserAction
-	20,  // 11: alerting.routing.v1.RoutingAction.notify_oncall:type_name -> alerting.routing.v1.NotifyOnCallAction
-	21,  // 12: alerting.routing.v1.RoutingAction.notify_webhook:type_name -> alerting.routing.v1.NotifyWebhookAction
-	22,  // 13: alerting.routing.v1.RoutingAction.suppress:type_name -> alerting.routing.v1.SuppressAction
-	23,  // 14: alerting.routing.v1.RoutingAction.aggregate:type_name -> alerting.routing.v1.AggregateAction
-	24,  // 15: alerting.routing.v1.RoutingAction.escalate:type_name -> alerting.routing.v1.EscalateAction
-	25,  // 16: alerting.routing.v1.RoutingAction.create_ticket:type_name -> alerting.routing.v1.CreateTicketAction
-	26,  // 17: alerting.routing.v1.RoutingAction.set_label:type_name -> alerting.routing.v1.SetLabelAction
-	3,   // 18: alerting.routing.v1.NotifyTeamAction.scope:type_name -> alerting.routing.v1.TeamNotifyScope
-	29,  // 19: alerting.routing.v1.NotifyChannelAction.target:type_name -> alerting.routing.v1.NotificationTarget
-	5,   // 20: alerting.routing.v1.NotifyUserAction.channel_override:type_name -> alerting.routing.v1.ChannelType
-	4,   // 21: alerting.routing.v1.NotifyOnCallAction.level:type_name -> alerting.routing.v1.OnCallLevel
-	60,  // 22: alerting.routing.v1.NotifyWebhookAction.headers:type_name -> alerting.routing.v1.NotifyWebhookAction.HeadersEntry
-	68,  // 23: alerting.routing.v1.SuppressAction.duration:type_name -> google.protobuf.Duration
-	68,  // 24: alerting.routing.v1.AggregateAction.window:type_name -> google.protobuf.Duration
-	29,  // 25: alerting.routing.v1.AggregateAction.target:type_name -> alerting.routing.v1.NotificationTarget
-	61,  // 26: alerting.routing.v1.CreateTicketAction.fields:type_name -> alerting.routing.v1.CreateTicketAction.FieldsEntry
-	62,  // 27: alerting.routing.v1.SetLabelAction.labels:type_name -> alerting.routing.v1.SetLabelAction.LabelsEntry
-	28,  // 28: alerting.routing.v1.TimeCondition.windows:type_name -> alerting.routing.v1.TimeWindow
-	5,   // 29: alerting.routing.v1.NotificationTarget.channel:type_name -> alerting.routing.v1.ChannelType
-	30,  // 30: alerting.routing.v1.NotificationTarget.slack:type_name -> alerting.routing.v1.SlackTarget
-	31,  // 31: alerting.routing.v1.NotificationTarget.teams:type_name -> alerting.routing.v1.TeamsTarget
-	32,  // 32: alerting.routing.v1.NotificationTarget.email:type_name -> alerting.routing.v1.EmailTarget
-	33,  // 33: alerting.routing.v1.NotificationTarget.sms:type_name -> alerting.routing.v1.SMSTarget
-	34,  // 34: alerting.routing.v1.NotificationTarget.webhook:type_name -> alerting.routing.v1.WebhookTarget
-	35,  // 35: alerting.routing.v1.NotificationTarget.pager:type_name -> alerting.routing.v1.PagerTarget
-	63,  // 36: alerting.routing.v1.WebhookTarget.headers:type_name -> alerting.routing.v1.WebhookTarget.HeadersEntry
-	37,  // 37: alerting.routing.v1.Team.members:type_name -> alerting.routing.v1.TeamMember
-	29,  // 38: alerting.routing.v1.Team.default_channel:type_name -> alerting.routing.v1.NotificationTarget
-	64,  // 39: alerting.routing.v1.Team.metadata:type_name -> alerting.routing.v1.Team.MetadataEntry
-	67,  // 40: alerting.routing.v1.Team.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 41: alerting.routing.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
-	6,   // 42: alerting.routing.v1.TeamMember.role:type_name -> alerting.routing.v1.TeamRole
-	38,  // 43: alerting.routing.v1.TeamMember.preferences:type_name -> alerting.routing.v1.NotificationPreferences
-	67,  // 44: alerting.routing.v1.TeamMember.joined_at:type_name -> google.protobuf.Timestamp
-	5,   // 45: alerting.routing.v1.NotificationPreferences.preferred_channels:type_name -> alerting.routing.v1.ChannelType
-	28,  // 46: alerting.routing.v1.NotificationPreferences.quiet_hours:type_name -> alerting.routing.v1.TimeWindow
-	68,  // 47: alerting.routing.v1.NotificationPreferences.escalation_delay:type_name -> google.protobuf.Duration
-	40,  // 48: alerting.routing.v1.Schedule.rotations:type_name -> alerting.routing.v1.Rotation
-	43,  // 49: alerting.routing.v1.Schedule.overrides:type_name -> alerting.routing.v1.ScheduleOverride
-	45,  // 50: alerting.routing.v1.Schedule.handoff:type_name -> alerting.routing.v1.HandoffConfig
-	67,  // 51: alerting.routing.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 52: alerting.routing.v1.Schedule.updated_at:type_name -> google.protobuf.Timestamp
-	7,   // 53: alerting.routing.v1.Rotation.type:type_name -> alerting.routing.v1.RotationType
-	41,  // 54: alerting.routing.v1.Rotation.members:type_name -> alerting.routing.v1.RotationMember
-	67,  // 55: alerting.routing.v1.Rotation.start_time:type_name -> google.protobuf.Timestamp
-	42,  // 56: alerting.routing.v1.Rotation.shift_config:type_name -> alerting.routing.v1.ShiftConfig
-	28,  // 57: alerting.routing.v1.Rotation.restrictions:type_name -> alerting.routing.v1.TimeWindow
-	68,  // 58: alerting.routing.v1.ShiftConfig.shift_length:type_name -> google.protobuf.Duration
-	67,  // 59: alerting.routing.v1.ScheduleOverride.start_time:type_name -> google.protobuf.Timestamp
-	67,  // 60: alerting.routing.v1.ScheduleOverride.end_time:type_name -> google.protobuf.Timestamp
-	67,  // 61: alerting.routing.v1.ScheduleOverride.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 62: alerting.routing.v1.Shift.start_time:type_name -> google.protobuf.Timestamp
-	67,  // 63: alerting.routing.v1.Shift.end_time:type_name -> google.protobuf.Timestamp
-	8,   // 64: alerting.routing.v1.Shift.type:type_name -> alerting.routing.v1.ShiftType
-	29,  // 65: alerting.routing.v1.HandoffConfig.handoff_channel:type_name -> alerting.routing.v1.NotificationTarget
-	9,   // 66: alerting.routing.v1.Site.type:type_name -> alerting.routing.v1.SiteType
-	28,  // 67: alerting.routing.v1.Site.business_hours:type_name -> alerting.routing.v1.TimeWindow
-	65,  // 68: alerting.routing.v1.Site.metadata:type_name -> alerting.routing.v1.Site.MetadataEntry
-	67,  // 69: alerting.routing.v1.Site.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 70: alerting.routing.v1.Site.updated_at:type_name -> google.protobuf.Timestamp
-	68,  // 71: alerting.routing.v1.CustomerTier.critical_response:type_name -> google.protobuf.Duration
-	68,  // 72: alerting.routing.v1.CustomerTier.high_response:type_name -> google.protobuf.Duration
-	68,  // 73: alerting.routing.v1.CustomerTier.medium_response:type_name -> google.protobuf.Duration
-	66,  // 74: alerting.routing.v1.CustomerTier.metadata:type_name -> alerting.routing.v1.CustomerTier.MetadataEntry
-	67,  // 75: alerting.routing.v1.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
-	67,  // 76: alerting.routing.v1.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
-	10,  // 77: alerting.routing.v1.MaintenanceWindow.action:type_name -> alerting.routing.v1.MaintenanceAction
-	67,  // 78: alerting.routing.v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
-	11,  // 79: alerting.routing.v1.MaintenanceWindow.status:type_name -> alerting.routing.v1.MaintenanceStatus
-	52,  // 80: alerting.routing.v1.EscalationPolicy.steps:type_name -> alerting.routing.v1.EscalationStep
-	54,  // 81: alerting.routing.v1.EscalationPolicy.exhausted_action:type_name -> alerting.routing.v1.EscalationExhaustedAction
-	67,  // 82: alerting.routing.v1.EscalationPolicy.created_at:type_name -> google.protobuf.Timestamp
-	67,  // 83: alerting.routing.v1.EscalationPolicy.updated_at:type_name -> google.protobuf.Timestamp
-	68,  // 84: alerting.routing.v1.EscalationStep.delay:type_name -> google.protobuf.Duration
-	53,  // 85: alerting.routing.v1.EscalationStep.targets:type_name -> alerting.routing.v1.EscalationTarget
-	12,  // 86: alerting.routing.v1.EscalationTarget.type:type_name -> alerting.routing.v1.EscalationTargetType
-	29,  // 87: alerting.routing.v1.EscalationTarget.channel:type_name -> alerting.routing.v1.NotificationTarget
-	13,  // 88: alerting.routing.v1.EscalationExhaustedAction.type:type_name -> alerting.routing.v1.ExhaustedActionType
-	29,  // 89: alerting.routing.v1.EscalationExhaustedAction.fallback_target:type_name -> alerting.routing.v1.NotificationTarget
-	67,  // 90: alerting.routing.v1.RoutingAuditLog.timestamp:type_name -> google.protobuf.Timestamp
-	56,  // 91: alerting.routing.v1.RoutingAuditLog.evaluations:type_name -> alerting.routing.v1.RuleEvaluation
-	58,  // 92: alerting.routing.v1.RoutingAuditLog.executions:type_name -> alerting.routing.v1.ActionExecution
-	69,  // 93: alerting.routing.v1.RoutingAuditLog.alert_snapshot:type_name -> google.protobuf.Struct
-	59,  // 94: alerting.routing.v1.RoutingAuditLog.maintenance_result:type_name -> alerting.routing.v1.MaintenanceResult
-	57,  // 95: alerting.routing.v1.RuleEvaluation.condition_results:type_name -> alerting.routing.v1.ConditionResult
-	0,   // 96: alerting.routing.v1.ConditionResult.type:type_name -> alerting.routing.v1.ConditionType
-	2,   // 97: alerting.routing.v1.ActionExecution.action_type:type_name -> alerting.routing.v1.ActionType
-	69,  // 98: alerting.routing.v1.ActionExecution.action_details:type_name -> google.protobuf.Struct
-	67,  // 99: alerting.routing.v1.ActionExecution.executed_at:type_name -> google.protobuf.Timestamp
-	50,  // 100: alerting.routing.v1.MaintenanceResult.window:type_name -> alerting.routing.v1.MaintenanceWindow
-	10,  // 101: alerting.routing.v1.MaintenanceResult.action:type_name -> alerting.routing.v1.MaintenanceAction
-	102, // [102:102] is the sub-list for method output_type
-	102, // [102:102] is the sub-list for method input_type
-	102, // [102:102] is the sub-list for extension type_name
-	102, // [102:102] is the sub-list for extension extendee
-	0,   // [0:102] is the sub-list for field type_name
+	17,  // 0: alerting.routing.v1.RoutingRule.conditions:type_name -> alerting.routing.v1.RoutingCondition
+	18,  // 1: alerting.routing.v1.RoutingRule.actions:type_name -> alerting.routing.v1.RoutingAction
+	31,  // 2: alerting.routing.v1.RoutingRule.time_condition:type_name -> alerting.routing.v1.TimeCondition
+	79,  // 3: alerting.routing.v1.RoutingRule.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 4: alerting.routing.v1.RoutingRule.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 5: alerting.routing.v1.RoutingRule.affected_site_types:type_name -> alerting.routing.v1.SiteType
+	0,   // 6: alerting.routing.v1.RoutingCondition.type:type_name -> alerting.routing.v1.ConditionType
+	1,   // 7: alerting.routing.v1.RoutingCondition.operator:type_name -> alerting.routing.v1.ConditionOperator
+	2,   // 8: alerting.routing.v1.RoutingAction.type:type_name -> alerting.routing.v1.ActionType
+	19,  // 9: alerting.routing.v1.RoutingAction.notify_team:type_name -> alerting.routing.v1.NotifyTeamAction
+	20,  // 10: alerting.routing.v1.RoutingAction.notify_channel:type_name -> alerting.routing.v1.NotifyChannelAction
+	21,  // 11: alerting.routing.v1.RoutingAction.notify_user:type_name -> alerting.routing.v1.NotifyUserAction
+	22,  // 12: alerting.routing.v1.RoutingAction.notify_oncall:type_name -> alerting.routing.v1.NotifyOnCallAction
+	23,  // 13: alerting.routing.v1.RoutingAction.notify_webhook:type_name -> alerting.routing.v1.NotifyWebhookAction
+	24,  // 14: alerting.routing.v1.RoutingAction.suppress:type_name -> alerting.routing.v1.SuppressAction
+	25,  // 15: alerting.routing.v1.RoutingAction.aggregate:type_name -> alerting.routing.v1.AggregateAction
+	26,  // 16: alerting.routing.v1.RoutingAction.escalate:type_name -> alerting.routing.v1.EscalateAction
+	27,  // 17: alerting.routing.v1.RoutingAction.create_ticket:type_name -> alerting.routing.v1.CreateTicketAction
+	28,  // 18: alerting.routing.v1.RoutingAction.set_label:type_name -> alerting.routing.v1.SetLabelAction
+	29,  // 19: alerting.routing.v1.RoutingAction.forward_pagerduty:type_name -> alerting.routing.v1.ForwardPagerDutyAction
+	30,  // 20: alerting.routing.v1.RoutingAction.notify_sns:type_name -> alerting.routing.v1.NotifySNSAction
+	3,   // 21: alerting.routing.v1.NotifyTeamAction.scope:type_name -> alerting.routing.v1.TeamNotifyScope
+	33,  // 22: alerting.routing.v1.NotifyChannelAction.target:type_name -> alerting.routing.v1.NotificationTarget
+	5,   // 23: alerting.routing.v1.NotifyUserAction.channel_override:type_name -> alerting.routing.v1.ChannelType
+	4,   // 24: alerting.routing.v1.NotifyOnCallAction.level:type_name -> alerting.routing.v1.OnCallLevel
+	71,  // 25: alerting.routing.v1.NotifyWebhookAction.headers:type_name -> alerting.routing.v1.NotifyWebhookAction.HeadersEntry
+	80,  // 26: alerting.routing.v1.SuppressAction.duration:type_name -> google.protobuf.Duration
+	80,  // 27: alerting.routing.v1.AggregateAction.window:type_name -> google.protobuf.Duration
+	33,  // 28: alerting.routing.v1.AggregateAction.target:type_name -> alerting.routing.v1.NotificationTarget
+	72,  // 29: alerting.routing.v1.CreateTicketAction.fields:type_name -> alerting.routing.v1.CreateTicketAction.FieldsEntry
+	73,  // 30: alerting.routing.v1.SetLabelAction.labels:type_name -> alerting.routing.v1.SetLabelAction.LabelsEntry
+	32,  // 31: alerting.routing.v1.TimeCondition.windows:type_name -> alerting.routing.v1.TimeWindow
+	5,   // 32: alerting.routing.v1.NotificationTarget.channel:type_name -> alerting.routing.v1.ChannelType
+	34,  // 33: alerting.routing.v1.NotificationTarget.slack:type_name -> alerting.routing.v1.SlackTarget
+	35,  // 34: alerting.routing.v1.NotificationTarget.teams:type_name -> alerting.routing.v1.TeamsTarget
+	36,  // 35: alerting.routing.v1.NotificationTarget.email:type_name -> alerting.routing.v1.EmailTarget
+	37,  // 36: alerting.routing.v1.NotificationTarget.sms:type_name -> alerting.routing.v1.SMSTarget
+	38,  // 37: alerting.routing.v1.NotificationTarget.webhook:type_name -> alerting.routing.v1.WebhookTarget
+	39,  // 38: alerting.routing.v1.NotificationTarget.pager:type_name -> alerting.routing.v1.PagerTarget
+	40,  // 39: alerting.routing.v1.NotificationTarget.discord:type_name -> alerting.routing.v1.DiscordTarget
+	41,  // 40: alerting.routing.v1.NotificationTarget.google_chat:type_name -> alerting.routing.v1.GoogleChatTarget
+	74,  // 41: alerting.routing.v1.WebhookTarget.headers:type_name -> alerting.routing.v1.WebhookTarget.HeadersEntry
+	43,  // 42: alerting.routing.v1.Team.members:type_name -> alerting.routing.v1.TeamMember
+	33,  // 43: alerting.routing.v1.Team.default_channel:type_name -> alerting.routing.v1.NotificationTarget
+	75,  // 44: alerting.routing.v1.Team.metadata:type_name -> alerting.routing.v1.Team.MetadataEntry
+	79,  // 45: alerting.routing.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 46: alerting.routing.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 47: alerting.routing.v1.TeamMember.role:type_name -> alerting.routing.v1.TeamRole
+	44,  // 48: alerting.routing.v1.TeamMember.preferences:type_name -> alerting.routing.v1.NotificationPreferences
+	79,  // 49: alerting.routing.v1.TeamMember.joined_at:type_name -> google.protobuf.Timestamp
+	5,   // 50: alerting.routing.v1.NotificationPreferences.preferred_channels:type_name -> alerting.routing.v1.ChannelType
+	32,  // 51: alerting.routing.v1.NotificationPreferences.quiet_hours:type_name -> alerting.routing.v1.TimeWindow
+	80,  // 52: alerting.routing.v1.NotificationPreferences.escalation_delay:type_name -> google.protobuf.Duration
+	7,   // 53: alerting.routing.v1.UserAvailability.unavailable_reason:type_name -> alerting.routing.v1.UnavailableReason
+	79,  // 54: alerting.routing.v1.UserAvailability.period_start:type_name -> google.protobuf.Timestamp
+	79,  // 55: alerting.routing.v1.UserAvailability.period_end:type_name -> google.protobuf.Timestamp
+	47,  // 56: alerting.routing.v1.Schedule.rotations:type_name -> alerting.routing.v1.Rotation
+	50,  // 57: alerting.routing.v1.Schedule.overrides:type_name -> alerting.routing.v1.ScheduleOverride
+	52,  // 58: alerting.routing.v1.Schedule.handoff:type_name -> alerting.routing.v1.HandoffConfig
+	79,  // 59: alerting.routing.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 60: alerting.routing.v1.Schedule.updated_at:type_name -> google.protobuf.Timestamp
+	53,  // 61: alerting.routing.v1.Schedule.handoff_reminder:type_name -> alerting.routing.v1.HandoffReminderConfig
+	8,   // 62: alerting.routing.v1.Rotation.type:type_name -> alerting.routing.v1.RotationType
+	48,  // 63: alerting.routing.v1.Rotation.members:type_name -> alerting.routing.v1.RotationMember
+	79,  // 64: alerting.routing.v1.Rotation.start_time:type_name -> google.protobuf.Timestamp
+	49,  // 65: alerting.routing.v1.Rotation.shift_config:type_name -> alerting.routing.v1.ShiftConfig
+	32,  // 66: alerting.routing.v1.Rotation.restrictions:type_name -> alerting.routing.v1.TimeWindow
+	80,  // 67: alerting.routing.v1.ShiftConfig.shift_length:type_name -> google.protobuf.Duration
+	79,  // 68: alerting.routing.v1.ScheduleOverride.start_time:type_name -> google.protobuf.Timestamp
+	79,  // 69: alerting.routing.v1.ScheduleOverride.end_time:type_name -> google.protobuf.Timestamp
+	79,  // 70: alerting.routing.v1.ScheduleOverride.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 71: alerting.routing.v1.Shift.start_time:type_name -> google.protobuf.Timestamp
+	79,  // 72: alerting.routing.v1.Shift.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 73: alerting.routing.v1.Shift.type:type_name -> alerting.routing.v1.ShiftType
+	33,  // 74: alerting.routing.v1.HandoffConfig.handoff_channel:type_name -> alerting.routing.v1.NotificationTarget
+	80,  // 75: alerting.routing.v1.HandoffReminderConfig.advance_notice:type_name -> google.protobuf.Duration
+	5,   // 76: alerting.routing.v1.HandoffReminderConfig.channel:type_name -> alerting.routing.v1.ChannelType
+	79,  // 77: alerting.routing.v1.HandoffNote.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 78: alerting.routing.v1.Site.type:type_name -> alerting.routing.v1.SiteType
+	32,  // 79: alerting.routing.v1.Site.business_hours:type_name -> alerting.routing.v1.TimeWindow
+	76,  // 80: alerting.routing.v1.Site.metadata:type_name -> alerting.routing.v1.Site.MetadataEntry
+	79,  // 81: alerting.routing.v1.Site.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 82: alerting.routing.v1.Site.updated_at:type_name -> google.protobuf.Timestamp
+	56,  // 83: alerting.routing.v1.Site.capacity:type_name -> alerting.routing.v1.CapacityMetrics
+	79,  // 84: alerting.routing.v1.CapacityMetrics.last_updated:type_name -> google.protobuf.Timestamp
+	80,  // 85: alerting.routing.v1.CustomerTier.critical_response:type_name -> google.protobuf.Duration
+	80,  // 86: alerting.routing.v1.CustomerTier.high_response:type_name -> google.protobuf.Duration
+	80,  // 87: alerting.routing.v1.CustomerTier.medium_response:type_name -> google.protobuf.Duration
+	77,  // 88: alerting.routing.v1.CustomerTier.metadata:type_name -> alerting.routing.v1.CustomerTier.MetadataEntry
+	79,  // 89: alerting.routing.v1.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
+	79,  // 90: alerting.routing.v1.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
+	12,  // 91: alerting.routing.v1.MaintenanceWindow.action:type_name -> alerting.routing.v1.MaintenanceAction
+	79,  // 92: alerting.routing.v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 93: alerting.routing.v1.MaintenanceWindow.status:type_name -> alerting.routing.v1.MaintenanceStatus
+	78,  // 94: alerting.routing.v1.MaintenanceWindow.labels:type_name -> alerting.routing.v1.MaintenanceWindow.LabelsEntry
+	61,  // 95: alerting.routing.v1.MaintenanceWindow.recurrence:type_name -> alerting.routing.v1.RecurrenceRule
+	79,  // 96: alerting.routing.v1.MaintenanceWindow.approved_at:type_name -> google.protobuf.Timestamp
+	11,  // 97: alerting.routing.v1.RecurrenceRule.frequency:type_name -> alerting.routing.v1.RecurrenceFrequency
+	79,  // 98: alerting.routing.v1.RecurrenceRule.until:type_name -> google.protobuf.Timestamp
+	63,  // 99: alerting.routing.v1.EscalationPolicy.steps:type_name -> alerting.routing.v1.EscalationStep
+	65,  // 100: alerting.routing.v1.EscalationPolicy.exhausted_action:type_name -> alerting.routing.v1.EscalationExhaustedAction
+	79,  // 101: alerting.routing.v1.EscalationPolicy.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 102: alerting.routing.v1.EscalationPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	80,  // 103: alerting.routing.v1.EscalationPolicy.repeat_interval:type_name -> google.protobuf.Duration
+	80,  // 104: alerting.routing.v1.EscalationStep.delay:type_name -> google.protobuf.Duration
+	64,  // 105: alerting.routing.v1.EscalationStep.targets:type_name -> alerting.routing.v1.EscalationTarget
+	14,  // 106: alerting.routing.v1.EscalationTarget.type:type_name -> alerting.routing.v1.EscalationTargetType
+	33,  // 107: alerting.routing.v1.EscalationTarget.channel:type_name -> alerting.routing.v1.NotificationTarget
+	15,  // 108: alerting.routing.v1.EscalationExhaustedAction.type:type_name -> alerting.routing.v1.ExhaustedActionType
+	33,  // 109: alerting.routing.v1.EscalationExhaustedAction.fallback_target:type_name -> alerting.routing.v1.NotificationTarget
+	79,  // 110: alerting.routing.v1.RoutingAuditLog.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 111: alerting.routing.v1.RoutingAuditLog.evaluations:type_name -> alerting.routing.v1.RuleEvaluation
+	69,  // 112: alerting.routing.v1.RoutingAuditLog.executions:type_name -> alerting.routing.v1.ActionExecution
+	81,  // 113: alerting.routing.v1.RoutingAuditLog.alert_snapshot:type_name -> google.protobuf.Struct
+	70,  // 114: alerting.routing.v1.RoutingAuditLog.maintenance_result:type_name -> alerting.routing.v1.MaintenanceResult
+	68,  // 115: alerting.routing.v1.RuleEvaluation.condition_results:type_name -> alerting.routing.v1.ConditionResult
+	0,   // 116: alerting.routing.v1.ConditionResult.type:type_name -> alerting.routing.v1.ConditionType
+	1,   // 117: alerting.routing.v1.ConditionResult.operator:type_name -> alerting.routing.v1.ConditionOperator
+	2,   // 118: alerting.routing.v1.ActionExecution.action_type:type_name -> alerting.routing.v1.ActionType
+	81,  // 119: alerting.routing.v1.ActionExecution.action_details:type_name -> google.protobuf.Struct
+	79,  // 120: alerting.routing.v1.ActionExecution.executed_at:type_name -> google.protobuf.Timestamp
+	60,  // 121: alerting.routing.v1.MaintenanceResult.window:type_name -> alerting.routing.v1.MaintenanceWindow
+	12,  // 122: alerting.routing.v1.MaintenanceResult.action:type_name -> alerting.routing.v1.MaintenanceAction
+	123, // [123:123] is the sub-list for method output_type
+	123, // [123:123] is the sub-list for method input_type
+	123, // [123:123] is the sub-list for extension type_name
+	123, // [123:123] is the sub-list for extension extendee
+	0,   // [0:123] is the sub-list for field type_name
 }
 
 func init() { file_alerting_routing_v1_routing_proto_init() }
@@ -5495,8 +6594,8 @@ func file_alerting_routing_v1_routing_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alerting_routing_v1_routing_proto_rawDesc), len(file_alerting_routing_v1_routing_proto_rawDesc)),
-			NumEnums:      14,
-			NumMessages:   53,
+			NumEnums:      16,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
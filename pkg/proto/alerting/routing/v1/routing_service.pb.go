@@ -10,8 +10,10 @@
 package routingv1
 
 import (
+	v1 "github.com/kneutral-org/alerting-system/pkg/proto/alerting/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -655,6 +657,87 @@ func (x *ReorderRoutingRulesResponse) GetUpdatedRules() []*RoutingRule {
 	return nil
 }
 
+type CompactRulePrioritiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactRulePrioritiesRequest) Reset() {
+	*x = CompactRulePrioritiesRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactRulePrioritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactRulePrioritiesRequest) ProtoMessage() {}
+
+func (x *CompactRulePrioritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactRulePrioritiesRequest.ProtoReflect.Descriptor instead.
+func (*CompactRulePrioritiesRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{9}
+}
+
+type CompactRulePrioritiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All rules ordered by their new priority
+	Rules         []*RoutingRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactRulePrioritiesResponse) Reset() {
+	*x = CompactRulePrioritiesResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactRulePrioritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactRulePrioritiesResponse) ProtoMessage() {}
+
+func (x *CompactRulePrioritiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactRulePrioritiesResponse.ProtoReflect.Descriptor instead.
+func (*CompactRulePrioritiesResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompactRulePrioritiesResponse) GetRules() []*RoutingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 // Test a single rule against a sample alert
 type TestRoutingRuleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -670,7 +753,7 @@ type TestRoutingRuleRequest struct {
 
 func (x *TestRoutingRuleRequest) Reset() {
 	*x = TestRoutingRuleRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[9]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +765,7 @@ func (x *TestRoutingRuleRequest) String() string {
 func (*TestRoutingRuleRequest) ProtoMessage() {}
 
 func (x *TestRoutingRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[9]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +778,7 @@ func (x *TestRoutingRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestRoutingRuleRequest.ProtoReflect.Descriptor instead.
 func (*TestRoutingRuleRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{9}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TestRoutingRuleRequest) GetRule() *RoutingRule {
@@ -736,7 +819,7 @@ type TestRoutingRuleResponse struct {
 
 func (x *TestRoutingRuleResponse) Reset() {
 	*x = TestRoutingRuleResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[10]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +831,7 @@ func (x *TestRoutingRuleResponse) String() string {
 func (*TestRoutingRuleResponse) ProtoMessage() {}
 
 func (x *TestRoutingRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[10]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +844,7 @@ func (x *TestRoutingRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestRoutingRuleResponse.ProtoReflect.Descriptor instead.
 func (*TestRoutingRuleResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{10}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TestRoutingRuleResponse) GetMatched() bool {
@@ -799,33 +882,29 @@ func (x *TestRoutingRuleResponse) GetTimeConditionReason() string {
 	return ""
 }
 
-// Simulate full routing pipeline
-type SimulateRoutingRequest struct {
+type DryRunRoutingRuleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Alert *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
-	// Optional: simulate at a specific time
-	SimulateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=simulate_time,json=simulateTime,proto3" json:"simulate_time,omitempty"`
-	// Include disabled rules in simulation
-	IncludeDisabled bool `protobuf:"varint,3,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Rule to test (can be new or existing)
+	Rule          *RoutingRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateRoutingRequest) Reset() {
-	*x = SimulateRoutingRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[11]
+func (x *DryRunRoutingRuleRequest) Reset() {
+	*x = DryRunRoutingRuleRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateRoutingRequest) String() string {
+func (x *DryRunRoutingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateRoutingRequest) ProtoMessage() {}
+func (*DryRunRoutingRuleRequest) ProtoMessage() {}
 
-func (x *SimulateRoutingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[11]
+func (x *DryRunRoutingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,61 +915,44 @@ func (x *SimulateRoutingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateRoutingRequest.ProtoReflect.Descriptor instead.
-func (*SimulateRoutingRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SimulateRoutingRequest) GetAlert() *Alert {
-	if x != nil {
-		return x.Alert
-	}
-	return nil
+// Deprecated: Use DryRunRoutingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DryRunRoutingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SimulateRoutingRequest) GetSimulateTime() *timestamppb.Timestamp {
+func (x *DryRunRoutingRuleRequest) GetRule() *RoutingRule {
 	if x != nil {
-		return x.SimulateTime
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *SimulateRoutingRequest) GetIncludeDisabled() bool {
-	if x != nil {
-		return x.IncludeDisabled
-	}
-	return false
-}
-
-type SimulateRoutingResponse struct {
+type DryRunRoutingRuleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// All rules evaluated
-	Evaluations []*RuleEvaluation `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
-	// Final actions that would be executed
-	Actions []*ActionExecution `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
-	// Maintenance window status
-	MaintenanceResult *MaintenanceResult `protobuf:"bytes,3,opt,name=maintenance_result,json=maintenanceResult,proto3" json:"maintenance_result,omitempty"`
-	// Warnings/suggestions
-	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Number of triggered alerts the rule matched and did not match
+	MatchedCount   int32 `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	UnmatchedCount int32 `protobuf:"varint,2,opt,name=unmatched_count,json=unmatchedCount,proto3" json:"unmatched_count,omitempty"`
+	// Up to 5 of the matched alerts
+	Samples       []*DryRunMatch `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateRoutingResponse) Reset() {
-	*x = SimulateRoutingResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[12]
+func (x *DryRunRoutingRuleResponse) Reset() {
+	*x = DryRunRoutingRuleResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateRoutingResponse) String() string {
+func (x *DryRunRoutingRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateRoutingResponse) ProtoMessage() {}
+func (*DryRunRoutingRuleResponse) ProtoMessage() {}
 
-func (x *SimulateRoutingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[12]
+func (x *DryRunRoutingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,71 +963,57 @@ func (x *SimulateRoutingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateRoutingResponse.ProtoReflect.Descriptor instead.
-func (*SimulateRoutingResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *SimulateRoutingResponse) GetEvaluations() []*RuleEvaluation {
-	if x != nil {
-		return x.Evaluations
-	}
-	return nil
+// Deprecated: Use DryRunRoutingRuleResponse.ProtoReflect.Descriptor instead.
+func (*DryRunRoutingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SimulateRoutingResponse) GetActions() []*ActionExecution {
+func (x *DryRunRoutingRuleResponse) GetMatchedCount() int32 {
 	if x != nil {
-		return x.Actions
+		return x.MatchedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulateRoutingResponse) GetMaintenanceResult() *MaintenanceResult {
+func (x *DryRunRoutingRuleResponse) GetUnmatchedCount() int32 {
 	if x != nil {
-		return x.MaintenanceResult
+		return x.UnmatchedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulateRoutingResponse) GetWarnings() []string {
+func (x *DryRunRoutingRuleResponse) GetSamples() []*DryRunMatch {
 	if x != nil {
-		return x.Warnings
+		return x.Samples
 	}
 	return nil
 }
 
-// Audit log request
-type GetRoutingAuditLogsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Filter by alert ID
-	AlertId string `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
-	// Filter by rule ID
-	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	// Time range
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Pagination
-	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DryRunMatch struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AlertId string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Summary string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Conditions of the rule that matched the alert
+	MatchedConditions []*ConditionResult `protobuf:"bytes,3,rep,name=matched_conditions,json=matchedConditions,proto3" json:"matched_conditions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetRoutingAuditLogsRequest) Reset() {
-	*x = GetRoutingAuditLogsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[13]
+func (x *DryRunMatch) Reset() {
+	*x = DryRunMatch{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutingAuditLogsRequest) String() string {
+func (x *DryRunMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutingAuditLogsRequest) ProtoMessage() {}
+func (*DryRunMatch) ProtoMessage() {}
 
-func (x *GetRoutingAuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[13]
+func (x *DryRunMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,77 +1024,54 @@ func (x *GetRoutingAuditLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutingAuditLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetRoutingAuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DryRunMatch.ProtoReflect.Descriptor instead.
+func (*DryRunMatch) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetRoutingAuditLogsRequest) GetAlertId() string {
+func (x *DryRunMatch) GetAlertId() string {
 	if x != nil {
 		return x.AlertId
 	}
 	return ""
 }
 
-func (x *GetRoutingAuditLogsRequest) GetRuleId() string {
+func (x *DryRunMatch) GetSummary() string {
 	if x != nil {
-		return x.RuleId
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *GetRoutingAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *GetRoutingAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *DryRunMatch) GetMatchedConditions() []*ConditionResult {
 	if x != nil {
-		return x.EndTime
+		return x.MatchedConditions
 	}
 	return nil
 }
 
-func (x *GetRoutingAuditLogsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetRoutingAuditLogsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-type GetRoutingAuditLogsResponse struct {
+type DetectRuleConflictsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*RoutingAuditLog     `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutingAuditLogsResponse) Reset() {
-	*x = GetRoutingAuditLogsResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[14]
+func (x *DetectRuleConflictsRequest) Reset() {
+	*x = DetectRuleConflictsRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutingAuditLogsResponse) String() string {
+func (x *DetectRuleConflictsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutingAuditLogsResponse) ProtoMessage() {}
+func (*DetectRuleConflictsRequest) ProtoMessage() {}
 
-func (x *GetRoutingAuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[14]
+func (x *DetectRuleConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,55 +1082,1241 @@ func (x *GetRoutingAuditLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutingAuditLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetRoutingAuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetRoutingAuditLogsResponse) GetLogs() []*RoutingAuditLog {
-	if x != nil {
-		return x.Logs
-	}
-	return nil
+// Deprecated: Use DetectRuleConflictsRequest.ProtoReflect.Descriptor instead.
+func (*DetectRuleConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetRoutingAuditLogsResponse) GetNextPageToken() string {
+func (x *DetectRuleConflictsRequest) GetRuleId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *GetRoutingAuditLogsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-// Internal: Execute routing for an alert
-type RouteAlertRequest struct {
+type DetectRuleConflictsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Alert         *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	Conflicts     []*RuleConflict        `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteAlertRequest) Reset() {
-	*x = RouteAlertRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[15]
+func (x *DetectRuleConflictsResponse) Reset() {
+	*x = DetectRuleConflictsResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteAlertRequest) String() string {
+func (x *DetectRuleConflictsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteAlertRequest) ProtoMessage() {}
+func (*DetectRuleConflictsResponse) ProtoMessage() {}
 
-func (x *RouteAlertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[15]
+func (x *DetectRuleConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRuleConflictsResponse.ProtoReflect.Descriptor instead.
+func (*DetectRuleConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DetectRuleConflictsResponse) GetConflicts() []*RuleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// RuleConflict is an enabled rule that fires together with the requested rule
+type RuleConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleConflict) Reset() {
+	*x = RuleConflict{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConflict) ProtoMessage() {}
+
+func (x *RuleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConflict.ProtoReflect.Descriptor instead.
+func (*RuleConflict) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RuleConflict) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleConflict) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleConflict) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RuleConflict) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Simulate full routing pipeline
+type SimulateRoutingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Alert *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	// Optional: simulate at a specific time
+	SimulateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=simulate_time,json=simulateTime,proto3" json:"simulate_time,omitempty"`
+	// Include disabled rules in simulation
+	IncludeDisabled bool `protobuf:"varint,3,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SimulateRoutingRequest) Reset() {
+	*x = SimulateRoutingRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateRoutingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRoutingRequest) ProtoMessage() {}
+
+func (x *SimulateRoutingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRoutingRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRoutingRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SimulateRoutingRequest) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *SimulateRoutingRequest) GetSimulateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SimulateTime
+	}
+	return nil
+}
+
+func (x *SimulateRoutingRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type SimulateRoutingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All rules evaluated
+	Evaluations []*RuleEvaluation `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// Final actions that would be executed
+	Actions []*ActionExecution `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Maintenance window status
+	MaintenanceResult *MaintenanceResult `protobuf:"bytes,3,opt,name=maintenance_result,json=maintenanceResult,proto3" json:"maintenance_result,omitempty"`
+	// Warnings/suggestions
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateRoutingResponse) Reset() {
+	*x = SimulateRoutingResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateRoutingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRoutingResponse) ProtoMessage() {}
+
+func (x *SimulateRoutingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRoutingResponse.ProtoReflect.Descriptor instead.
+func (*SimulateRoutingResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SimulateRoutingResponse) GetEvaluations() []*RuleEvaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
+func (x *SimulateRoutingResponse) GetActions() []*ActionExecution {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *SimulateRoutingResponse) GetMaintenanceResult() *MaintenanceResult {
+	if x != nil {
+		return x.MaintenanceResult
+	}
+	return nil
+}
+
+func (x *SimulateRoutingResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// Audit log request
+type GetRoutingAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter by alert ID
+	AlertId string `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	// Filter by rule ID
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Time range
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Pagination
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutingAuditLogsRequest) Reset() {
+	*x = GetRoutingAuditLogsRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutingAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutingAuditLogsRequest) ProtoMessage() {}
+
+func (x *GetRoutingAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutingAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutingAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRoutingAuditLogsRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *GetRoutingAuditLogsRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *GetRoutingAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetRoutingAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetRoutingAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRoutingAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetRoutingAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*RoutingAuditLog     `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutingAuditLogsResponse) Reset() {
+	*x = GetRoutingAuditLogsResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutingAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutingAuditLogsResponse) ProtoMessage() {}
+
+func (x *GetRoutingAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutingAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetRoutingAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRoutingAuditLogsResponse) GetLogs() []*RoutingAuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *GetRoutingAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetRoutingAuditLogsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Internal: Execute routing for an alert
+type RouteAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteAlertRequest) Reset() {
+	*x = RouteAlertRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteAlertRequest) ProtoMessage() {}
+
+func (x *RouteAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteAlertRequest.ProtoReflect.Descriptor instead.
+func (*RouteAlertRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RouteAlertRequest) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+type RouteAlertResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Routing audit log
+	AuditLog *RoutingAuditLog `protobuf:"bytes,1,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	// Notification IDs created
+	NotificationIds []string `protobuf:"bytes,2,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	// Escalation started
+	EscalationStarted bool   `protobuf:"varint,3,opt,name=escalation_started,json=escalationStarted,proto3" json:"escalation_started,omitempty"`
+	EscalationId      string `protobuf:"bytes,4,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	// Alert was suppressed
+	Suppressed        bool   `protobuf:"varint,5,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	SuppressionReason string `protobuf:"bytes,6,opt,name=suppression_reason,json=suppressionReason,proto3" json:"suppression_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RouteAlertResponse) Reset() {
+	*x = RouteAlertResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteAlertResponse) ProtoMessage() {}
+
+func (x *RouteAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteAlertResponse.ProtoReflect.Descriptor instead.
+func (*RouteAlertResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RouteAlertResponse) GetAuditLog() *RoutingAuditLog {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (x *RouteAlertResponse) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *RouteAlertResponse) GetEscalationStarted() bool {
+	if x != nil {
+		return x.EscalationStarted
+	}
+	return false
+}
+
+func (x *RouteAlertResponse) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+func (x *RouteAlertResponse) GetSuppressed() bool {
+	if x != nil {
+		return x.Suppressed
+	}
+	return false
+}
+
+func (x *RouteAlertResponse) GetSuppressionReason() string {
+	if x != nil {
+		return x.SuppressionReason
+	}
+	return ""
+}
+
+// Alert message for routing (simplified from alerting.v1.Alert)
+type Alert struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Summary        string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Details        string                 `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	Status         AlertStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=alerting.routing.v1.AlertStatus" json:"status,omitempty"`
+	Source         AlertSource            `protobuf:"varint,5,opt,name=source,proto3,enum=alerting.routing.v1.AlertSource" json:"source,omitempty"`
+	Fingerprint    string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Labels         map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations    map[string]string      `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ServiceId      string                 `protobuf:"bytes,10,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	IntegrationKey string                 `protobuf:"bytes,11,opt,name=integration_key,json=integrationKey,proto3" json:"integration_key,omitempty"`
+	GroupId        string                 `protobuf:"bytes,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // Correlation group, see CONDITION_TYPE_GROUP_SIZE
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Alert) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Alert) GetStatus() AlertStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
+}
+
+func (x *Alert) GetSource() AlertSource {
+	if x != nil {
+		return x.Source
+	}
+	return AlertSource_ALERT_SOURCE_UNSPECIFIED
+}
+
+func (x *Alert) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *Alert) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Alert) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *Alert) GetIntegrationKey() string {
+	if x != nil {
+		return x.IntegrationKey
+	}
+	return ""
+}
+
+func (x *Alert) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type CreateTeamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Team  *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// Create a default weekly on-call schedule rotating through the team members
+	AutoCreateSchedule bool `protobuf:"varint,2,opt,name=auto_create_schedule,json=autoCreateSchedule,proto3" json:"auto_create_schedule,omitempty"`
+	// Timezone of the auto-created schedule (defaults to UTC)
+	DefaultTimezone string `protobuf:"bytes,3,opt,name=default_timezone,json=defaultTimezone,proto3" json:"default_timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *CreateTeamRequest) GetAutoCreateSchedule() bool {
+	if x != nil {
+		return x.AutoCreateSchedule
+	}
+	return false
+}
+
+func (x *CreateTeamRequest) GetDefaultTimezone() string {
+	if x != nil {
+		return x.DefaultTimezone
+	}
+	return ""
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	NameContains  string                 `protobuf:"bytes,3,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	SiteId        string                 `protobuf:"bytes,4,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Filter by assigned site
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTeamsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTeamsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ListTeamsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTeamsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Member        *TeamMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberRequest) ProtoMessage() {}
+
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *AddTeamMemberRequest) GetMember() *TeamMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *RemoveTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Member        *TeamMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMemberRequest) Reset() {
+	*x = UpdateTeamMemberRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMemberRequest) ProtoMessage() {}
+
+func (x *UpdateTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *UpdateTeamMemberRequest) GetMember() *TeamMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *UpdateTeamMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type GetUserTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTeamsRequest) Reset() {
+	*x = GetUserTeamsRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTeamsRequest) ProtoMessage() {}
+
+func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,49 +2327,87 @@ func (x *RouteAlertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteAlertRequest.ProtoReflect.Descriptor instead.
-func (*RouteAlertRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetUserTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RouteAlertRequest) GetAlert() *Alert {
+func (x *GetUserTeamsRequest) GetUserId() string {
 	if x != nil {
-		return x.Alert
+		return x.UserId
+	}
+	return ""
+}
+
+type SetUserAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Availability  *UserAvailability      `protobuf:"bytes,1,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAvailabilityRequest) Reset() {
+	*x = SetUserAvailabilityRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetUserAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetUserAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetUserAvailabilityRequest) GetAvailability() *UserAvailability {
+	if x != nil {
+		return x.Availability
 	}
 	return nil
 }
 
-type RouteAlertResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Routing audit log
-	AuditLog *RoutingAuditLog `protobuf:"bytes,1,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
-	// Notification IDs created
-	NotificationIds []string `protobuf:"bytes,2,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
-	// Escalation started
-	EscalationStarted bool   `protobuf:"varint,3,opt,name=escalation_started,json=escalationStarted,proto3" json:"escalation_started,omitempty"`
-	EscalationId      string `protobuf:"bytes,4,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
-	// Alert was suppressed
-	Suppressed        bool   `protobuf:"varint,5,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
-	SuppressionReason string `protobuf:"bytes,6,opt,name=suppression_reason,json=suppressionReason,proto3" json:"suppression_reason,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetTeamAvailabilityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TeamId string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Returns the availability periods of team members overlapping [from, until)
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteAlertResponse) Reset() {
-	*x = RouteAlertResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[16]
+func (x *GetTeamAvailabilityRequest) Reset() {
+	*x = GetTeamAvailabilityRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteAlertResponse) String() string {
+func (x *GetTeamAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteAlertResponse) ProtoMessage() {}
+func (*GetTeamAvailabilityRequest) ProtoMessage() {}
 
-func (x *RouteAlertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[16]
+func (x *GetTeamAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,85 +2418,142 @@ func (x *RouteAlertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteAlertResponse.ProtoReflect.Descriptor instead.
-func (*RouteAlertResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetTeamAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RouteAlertResponse) GetAuditLog() *RoutingAuditLog {
+func (x *GetTeamAvailabilityRequest) GetTeamId() string {
 	if x != nil {
-		return x.AuditLog
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetTeamAvailabilityRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-func (x *RouteAlertResponse) GetNotificationIds() []string {
+func (x *GetTeamAvailabilityRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NotificationIds
+		return x.Until
 	}
 	return nil
 }
 
-func (x *RouteAlertResponse) GetEscalationStarted() bool {
+type GetTeamAvailabilityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Availabilities []*UserAvailability    `protobuf:"bytes,1,rep,name=availabilities,proto3" json:"availabilities,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTeamAvailabilityResponse) Reset() {
+	*x = GetTeamAvailabilityResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetTeamAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[39]
 	if x != nil {
-		return x.EscalationStarted
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RouteAlertResponse) GetEscalationId() string {
+// Deprecated: Use GetTeamAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTeamAvailabilityResponse) GetAvailabilities() []*UserAvailability {
 	if x != nil {
-		return x.EscalationId
+		return x.Availabilities
 	}
-	return ""
+	return nil
 }
 
-func (x *RouteAlertResponse) GetSuppressed() bool {
+type CreateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[40]
 	if x != nil {
-		return x.Suppressed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RouteAlertResponse) GetSuppressionReason() string {
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateScheduleRequest) GetSchedule() *Schedule {
 	if x != nil {
-		return x.SuppressionReason
+		return x.Schedule
 	}
-	return ""
+	return nil
 }
 
-// Alert message for routing (simplified from alerting.v1.Alert)
-type Alert struct {
+type GetScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	Details       string                 `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
-	Status        AlertStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=alerting.routing.v1.AlertStatus" json:"status,omitempty"`
-	Source        AlertSource            `protobuf:"varint,5,opt,name=source,proto3,enum=alerting.routing.v1.AlertSource" json:"source,omitempty"`
-	Fingerprint   string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Annotations   map[string]string      `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ServiceId     string                 `protobuf:"bytes,10,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Alert) Reset() {
-	*x = Alert{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[17]
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Alert) String() string {
+func (x *GetScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Alert) ProtoMessage() {}
+func (*GetScheduleRequest) ProtoMessage() {}
 
-func (x *Alert) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[17]
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,103 +2564,161 @@ func (x *Alert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
-func (*Alert) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Alert) GetId() string {
+func (x *GetScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Filter by team
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSchedulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *Alert) GetSummary() string {
+func (x *ListSchedulesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Summary
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Alert) GetDetails() string {
+func (x *ListSchedulesRequest) GetTeamId() string {
 	if x != nil {
-		return x.Details
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *Alert) GetStatus() AlertStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AlertStatus_ALERT_STATUS_UNSPECIFIED
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Alert) GetSource() AlertSource {
-	if x != nil {
-		return x.Source
-	}
-	return AlertSource_ALERT_SOURCE_UNSPECIFIED
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Alert) GetFingerprint() string {
-	if x != nil {
-		return x.Fingerprint
-	}
-	return ""
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Alert) GetLabels() map[string]string {
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[43]
 	if x != nil {
-		return x.Labels
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Alert) GetAnnotations() map[string]string {
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
 	if x != nil {
-		return x.Annotations
+		return x.Schedules
 	}
 	return nil
 }
 
-func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListSchedulesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Alert) GetServiceId() string {
+func (x *ListSchedulesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.ServiceId
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type CreateTeamRequest struct {
+type UpdateScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTeamRequest) Reset() {
-	*x = CreateTeamRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[18]
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTeamRequest) String() string {
+func (x *UpdateScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTeamRequest) ProtoMessage() {}
+func (*UpdateScheduleRequest) ProtoMessage() {}
 
-func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[18]
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,40 +2729,47 @@ func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
-func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateTeamRequest) GetTeam() *Team {
+func (x *UpdateScheduleRequest) GetSchedule() *Schedule {
 	if x != nil {
-		return x.Team
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetTeamRequest struct {
+func (x *UpdateScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamRequest) Reset() {
-	*x = GetTeamRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[19]
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamRequest) String() string {
+func (x *DeleteScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamRequest) ProtoMessage() {}
+func (*DeleteScheduleRequest) ProtoMessage() {}
 
-func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[19]
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,43 +2780,40 @@ func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetTeamRequest) GetId() string {
+func (x *DeleteScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListTeamsRequest struct {
+type DeleteScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	NameContains  string                 `protobuf:"bytes,3,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
-	SiteId        string                 `protobuf:"bytes,4,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Filter by assigned site
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTeamsRequest) Reset() {
-	*x = ListTeamsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[20]
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamsRequest) String() string {
+func (x *DeleteScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamsRequest) ProtoMessage() {}
+func (*DeleteScheduleResponse) ProtoMessage() {}
 
-func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[20]
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,63 +2824,41 @@ func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
-func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ListTeamsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListTeamsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListTeamsRequest) GetNameContains() string {
-	if x != nil {
-		return x.NameContains
-	}
-	return ""
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListTeamsRequest) GetSiteId() string {
+func (x *DeleteScheduleResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SiteId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type ListTeamsResponse struct {
+type AddRotationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Rotation      *Rotation              `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTeamsResponse) Reset() {
-	*x = ListTeamsResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[21]
+func (x *AddRotationRequest) Reset() {
+	*x = AddRotationRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamsResponse) String() string {
+func (x *AddRotationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamsResponse) ProtoMessage() {}
+func (*AddRotationRequest) ProtoMessage() {}
 
-func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[21]
+func (x *AddRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,55 +2869,49 @@ func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
-func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ListTeamsResponse) GetTeams() []*Team {
-	if x != nil {
-		return x.Teams
-	}
-	return nil
+// Deprecated: Use AddRotationRequest.ProtoReflect.Descriptor instead.
+func (*AddRotationRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListTeamsResponse) GetNextPageToken() string {
+func (x *AddRotationRequest) GetScheduleId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *ListTeamsResponse) GetTotalCount() int32 {
+func (x *AddRotationRequest) GetRotation() *Rotation {
 	if x != nil {
-		return x.TotalCount
+		return x.Rotation
 	}
-	return 0
+	return nil
 }
 
-type UpdateTeamRequest struct {
+type UpdateRotationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Rotation      *Rotation              `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTeamRequest) Reset() {
-	*x = UpdateTeamRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[22]
+func (x *UpdateRotationRequest) Reset() {
+	*x = UpdateRotationRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTeamRequest) String() string {
+func (x *UpdateRotationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamRequest) ProtoMessage() {}
+func (*UpdateRotationRequest) ProtoMessage() {}
 
-func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[22]
+func (x *UpdateRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,47 +2922,55 @@ func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateRotationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRotationRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateRotationRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
 }
 
-func (x *UpdateTeamRequest) GetTeam() *Team {
+func (x *UpdateRotationRequest) GetRotation() *Rotation {
 	if x != nil {
-		return x.Team
+		return x.Rotation
 	}
 	return nil
 }
 
-func (x *UpdateTeamRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateRotationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-type DeleteTeamRequest struct {
+type RemoveRotationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	RotationId    string                 `protobuf:"bytes,2,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeamRequest) Reset() {
-	*x = DeleteTeamRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[23]
+func (x *RemoveRotationRequest) Reset() {
+	*x = RemoveRotationRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamRequest) String() string {
+func (x *RemoveRotationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamRequest) ProtoMessage() {}
+func (*RemoveRotationRequest) ProtoMessage() {}
 
-func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[23]
+func (x *RemoveRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,40 +2981,50 @@ func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RemoveRotationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRotationRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeleteTeamRequest) GetId() string {
+func (x *RemoveRotationRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Id
+		return x.ScheduleId
 	}
 	return ""
 }
 
-type DeleteTeamResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RemoveRotationRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
 }
 
-func (x *DeleteTeamResponse) Reset() {
-	*x = DeleteTeamResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[24]
+type ReorderRotationMembersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	RotationId string                 `protobuf:"bytes,2,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	// Every current member of the rotation, in the new rotation order
+	OrderedUserIds []string `protobuf:"bytes,3,rep,name=ordered_user_ids,json=orderedUserIds,proto3" json:"ordered_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReorderRotationMembersRequest) Reset() {
+	*x = ReorderRotationMembersRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamResponse) String() string {
+func (x *ReorderRotationMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamResponse) ProtoMessage() {}
+func (*ReorderRotationMembersRequest) ProtoMessage() {}
 
-func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[24]
+func (x *ReorderRotationMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,41 +3035,60 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ReorderRotationMembersRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRotationMembersRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteTeamResponse) GetSuccess() bool {
+func (x *ReorderRotationMembersRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Success
+		return x.ScheduleId
 	}
-	return false
+	return ""
 }
 
-type AddTeamMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Member        *TeamMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+func (x *ReorderRotationMembersRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+func (x *ReorderRotationMembersRequest) GetOrderedUserIds() []string {
+	if x != nil {
+		return x.OrderedUserIds
+	}
+	return nil
+}
+
+type CreateOverrideRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Override   *ScheduleOverride      `protobuf:"bytes,2,opt,name=override,proto3" json:"override,omitempty"`
+	// Reject the override with ALREADY_EXISTS and OverrideConflictDetails when it
+	// overlaps existing overrides of the schedule
+	DetectConflicts bool `protobuf:"varint,3,opt,name=detect_conflicts,json=detectConflicts,proto3" json:"detect_conflicts,omitempty"`
+	// Create the override even if detect_conflicts finds overlapping overrides
+	Force         bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTeamMemberRequest) Reset() {
-	*x = AddTeamMemberRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[25]
+func (x *CreateOverrideRequest) Reset() {
+	*x = CreateOverrideRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTeamMemberRequest) String() string {
+func (x *CreateOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTeamMemberRequest) ProtoMessage() {}
+func (*CreateOverrideRequest) ProtoMessage() {}
 
-func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[25]
+func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,48 +3099,63 @@ func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
-func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CreateOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AddTeamMemberRequest) GetTeamId() string {
+func (x *CreateOverrideRequest) GetScheduleId() string {
 	if x != nil {
-		return x.TeamId
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *AddTeamMemberRequest) GetMember() *TeamMember {
+func (x *CreateOverrideRequest) GetOverride() *ScheduleOverride {
 	if x != nil {
-		return x.Member
+		return x.Override
 	}
 	return nil
 }
 
-type RemoveTeamMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateOverrideRequest) GetDetectConflicts() bool {
+	if x != nil {
+		return x.DetectConflicts
+	}
+	return false
 }
 
-func (x *RemoveTeamMemberRequest) Reset() {
-	*x = RemoveTeamMemberRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[26]
+func (x *CreateOverrideRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// OverrideConflictDetails is attached to the ALREADY_EXISTS status of a
+// conflicting CreateOverride request
+type OverrideConflictDetails struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ConflictingOverrideIds []string               `protobuf:"bytes,1,rep,name=conflicting_override_ids,json=conflictingOverrideIds,proto3" json:"conflicting_override_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OverrideConflictDetails) Reset() {
+	*x = OverrideConflictDetails{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTeamMemberRequest) String() string {
+func (x *OverrideConflictDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTeamMemberRequest) ProtoMessage() {}
+func (*OverrideConflictDetails) ProtoMessage() {}
 
-func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[26]
+func (x *OverrideConflictDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,49 +3166,42 @@ func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *RemoveTeamMemberRequest) GetTeamId() string {
-	if x != nil {
-		return x.TeamId
-	}
-	return ""
+// Deprecated: Use OverrideConflictDetails.ProtoReflect.Descriptor instead.
+func (*OverrideConflictDetails) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *RemoveTeamMemberRequest) GetUserId() string {
+func (x *OverrideConflictDetails) GetConflictingOverrideIds() []string {
 	if x != nil {
-		return x.UserId
+		return x.ConflictingOverrideIds
 	}
-	return ""
+	return nil
 }
 
-type UpdateTeamMemberRequest struct {
+// Creates all overrides or none, e.g. one per day of a vacation
+type BulkCreateOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Member        *TeamMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Overrides     []*ScheduleOverride    `protobuf:"bytes,2,rep,name=overrides,proto3" json:"overrides,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTeamMemberRequest) Reset() {
-	*x = UpdateTeamMemberRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[27]
+func (x *BulkCreateOverridesRequest) Reset() {
+	*x = BulkCreateOverridesRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTeamMemberRequest) String() string {
+func (x *BulkCreateOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamMemberRequest) ProtoMessage() {}
+func (*BulkCreateOverridesRequest) ProtoMessage() {}
 
-func (x *UpdateTeamMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[27]
+func (x *BulkCreateOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,54 +3212,47 @@ func (x *UpdateTeamMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTeamMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTeamMemberRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use BulkCreateOverridesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateTeamMemberRequest) GetTeamId() string {
+func (x *BulkCreateOverridesRequest) GetScheduleId() string {
 	if x != nil {
-		return x.TeamId
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *UpdateTeamMemberRequest) GetMember() *TeamMember {
-	if x != nil {
-		return x.Member
-	}
-	return nil
-}
-
-func (x *UpdateTeamMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *BulkCreateOverridesRequest) GetOverrides() []*ScheduleOverride {
 	if x != nil {
-		return x.UpdateMask
+		return x.Overrides
 	}
 	return nil
 }
 
-type GetUserTeamsRequest struct {
+type BulkCreateOverridesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Overrides     []*ScheduleOverride    `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTeamsRequest) Reset() {
-	*x = GetUserTeamsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[28]
+func (x *BulkCreateOverridesResponse) Reset() {
+	*x = BulkCreateOverridesResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTeamsRequest) String() string {
+func (x *BulkCreateOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTeamsRequest) ProtoMessage() {}
+func (*BulkCreateOverridesResponse) ProtoMessage() {}
 
-func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[28]
+func (x *BulkCreateOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,40 +3263,41 @@ func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTeamsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use BulkCreateOverridesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetUserTeamsRequest) GetUserId() string {
+func (x *BulkCreateOverridesResponse) GetOverrides() []*ScheduleOverride {
 	if x != nil {
-		return x.UserId
+		return x.Overrides
 	}
-	return ""
+	return nil
 }
 
-type CreateScheduleRequest struct {
+type DeleteOverrideRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	OverrideId    string                 `protobuf:"bytes,2,opt,name=override_id,json=overrideId,proto3" json:"override_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateScheduleRequest) Reset() {
-	*x = CreateScheduleRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[29]
+func (x *DeleteOverrideRequest) Reset() {
+	*x = DeleteOverrideRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateScheduleRequest) String() string {
+func (x *DeleteOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateScheduleRequest) ProtoMessage() {}
+func (*DeleteOverrideRequest) ProtoMessage() {}
 
-func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[29]
+func (x *DeleteOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,40 +3308,47 @@ func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
-func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DeleteOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateScheduleRequest) GetSchedule() *Schedule {
+func (x *DeleteOverrideRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Schedule
+		return x.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-type GetScheduleRequest struct {
+func (x *DeleteOverrideRequest) GetOverrideId() string {
+	if x != nil {
+		return x.OverrideId
+	}
+	return ""
+}
+
+type DeleteOverrideResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScheduleRequest) Reset() {
-	*x = GetScheduleRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[30]
+func (x *DeleteOverrideResponse) Reset() {
+	*x = DeleteOverrideResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScheduleRequest) String() string {
+func (x *DeleteOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScheduleRequest) ProtoMessage() {}
+func (*DeleteOverrideResponse) ProtoMessage() {}
 
-func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[30]
+func (x *DeleteOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,42 +3359,47 @@ func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
-func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DeleteOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetScheduleRequest) GetId() string {
+func (x *DeleteOverrideResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type ListSchedulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Filter by team
+// Hands the part of an override after split_time to another user, e.g. when
+// someone covers only half of a shift
+type SplitOverrideRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	OverrideId string                 `protobuf:"bytes,2,opt,name=override_id,json=overrideId,proto3" json:"override_id,omitempty"`
+	// Must fall strictly between the override's start and end time
+	SplitTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=split_time,json=splitTime,proto3" json:"split_time,omitempty"`
+	// User covering the override from split_time to its end
+	NewUserId     string `protobuf:"bytes,4,opt,name=new_user_id,json=newUserId,proto3" json:"new_user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSchedulesRequest) Reset() {
-	*x = ListSchedulesRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[31]
+func (x *SplitOverrideRequest) Reset() {
+	*x = SplitOverrideRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSchedulesRequest) String() string {
+func (x *SplitOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSchedulesRequest) ProtoMessage() {}
+func (*SplitOverrideRequest) ProtoMessage() {}
 
-func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[31]
+func (x *SplitOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,56 +3410,64 @@ func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
-func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SplitOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SplitOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListSchedulesRequest) GetPageSize() int32 {
+func (x *SplitOverrideRequest) GetScheduleId() string {
 	if x != nil {
-		return x.PageSize
+		return x.ScheduleId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSchedulesRequest) GetPageToken() string {
+func (x *SplitOverrideRequest) GetOverrideId() string {
 	if x != nil {
-		return x.PageToken
+		return x.OverrideId
 	}
 	return ""
 }
 
-func (x *ListSchedulesRequest) GetTeamId() string {
+func (x *SplitOverrideRequest) GetSplitTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TeamId
+		return x.SplitTime
+	}
+	return nil
+}
+
+func (x *SplitOverrideRequest) GetNewUserId() string {
+	if x != nil {
+		return x.NewUserId
 	}
 	return ""
 }
 
-type ListSchedulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+type SplitOverrideResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part kept by the original user, ending at split_time
+	First *ScheduleOverride `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	// Part assigned to new_user_id, starting at split_time
+	Second        *ScheduleOverride `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSchedulesResponse) Reset() {
-	*x = ListSchedulesResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[32]
+func (x *SplitOverrideResponse) Reset() {
+	*x = SplitOverrideResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSchedulesResponse) String() string {
+func (x *SplitOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSchedulesResponse) ProtoMessage() {}
+func (*SplitOverrideResponse) ProtoMessage() {}
 
-func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[32]
+func (x *SplitOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,55 +3478,51 @@ func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
-func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SplitOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SplitOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+func (x *SplitOverrideResponse) GetFirst() *ScheduleOverride {
 	if x != nil {
-		return x.Schedules
+		return x.First
 	}
 	return nil
 }
 
-func (x *ListSchedulesResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-func (x *ListSchedulesResponse) GetTotalCount() int32 {
+func (x *SplitOverrideResponse) GetSecond() *ScheduleOverride {
 	if x != nil {
-		return x.TotalCount
+		return x.Second
 	}
-	return 0
+	return nil
 }
 
-type UpdateScheduleRequest struct {
+type ListOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScheduleRequest) Reset() {
-	*x = UpdateScheduleRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[33]
+func (x *ListOverridesRequest) Reset() {
+	*x = ListOverridesRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScheduleRequest) String() string {
+func (x *ListOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateScheduleRequest) ProtoMessage() {}
+func (*ListOverridesRequest) ProtoMessage() {}
 
-func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[33]
+func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2138,91 +3533,69 @@ func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateScheduleRequest) GetSchedule() *Schedule {
+func (x *ListOverridesRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Schedule
+		return x.ScheduleId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListOverridesRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateMask
+		return x.StartTime
 	}
 	return nil
 }
 
-type DeleteScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteScheduleRequest) Reset() {
-	*x = DeleteScheduleRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteScheduleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteScheduleRequest) ProtoMessage() {}
-
-func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[34]
+func (x *ListOverridesRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{34}
+func (x *ListOverridesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *DeleteScheduleRequest) GetId() string {
+func (x *ListOverridesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Id
+		return x.PageToken
 	}
 	return ""
 }
 
-type DeleteScheduleResponse struct {
+type ListOverridesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Overrides     []*ScheduleOverride    `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteScheduleResponse) Reset() {
-	*x = DeleteScheduleResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[35]
+func (x *ListOverridesResponse) Reset() {
+	*x = ListOverridesResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteScheduleResponse) String() string {
+func (x *ListOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteScheduleResponse) ProtoMessage() {}
+func (*ListOverridesResponse) ProtoMessage() {}
 
-func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[35]
+func (x *ListOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,41 +3606,47 @@ func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteScheduleResponse) GetSuccess() bool {
+func (x *ListOverridesResponse) GetOverrides() []*ScheduleOverride {
 	if x != nil {
-		return x.Success
+		return x.Overrides
 	}
-	return false
+	return nil
 }
 
-type AddRotationRequest struct {
+func (x *ListOverridesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCurrentOnCallRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	Rotation      *Rotation              `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddRotationRequest) Reset() {
-	*x = AddRotationRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[36]
+func (x *GetCurrentOnCallRequest) Reset() {
+	*x = GetCurrentOnCallRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRotationRequest) String() string {
+func (x *GetCurrentOnCallRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRotationRequest) ProtoMessage() {}
+func (*GetCurrentOnCallRequest) ProtoMessage() {}
 
-func (x *AddRotationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[36]
+func (x *GetCurrentOnCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,49 +3657,43 @@ func (x *AddRotationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRotationRequest.ProtoReflect.Descriptor instead.
-func (*AddRotationRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetCurrentOnCallRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentOnCallRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AddRotationRequest) GetScheduleId() string {
+func (x *GetCurrentOnCallRequest) GetScheduleId() string {
 	if x != nil {
 		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *AddRotationRequest) GetRotation() *Rotation {
-	if x != nil {
-		return x.Rotation
-	}
-	return nil
-}
-
-type UpdateRotationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	Rotation      *Rotation              `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateRotationRequest) Reset() {
-	*x = UpdateRotationRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[37]
+type GetCurrentOnCallResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryUserId   string                 `protobuf:"bytes,1,opt,name=primary_user_id,json=primaryUserId,proto3" json:"primary_user_id,omitempty"`
+	SecondaryUserId string                 `protobuf:"bytes,2,opt,name=secondary_user_id,json=secondaryUserId,proto3" json:"secondary_user_id,omitempty"`
+	CurrentShift    *Shift                 `protobuf:"bytes,3,opt,name=current_shift,json=currentShift,proto3" json:"current_shift,omitempty"`
+	NextHandoff     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_handoff,json=nextHandoff,proto3" json:"next_handoff,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCurrentOnCallResponse) Reset() {
+	*x = GetCurrentOnCallResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRotationRequest) String() string {
+func (x *GetCurrentOnCallResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRotationRequest) ProtoMessage() {}
+func (*GetCurrentOnCallResponse) ProtoMessage() {}
 
-func (x *UpdateRotationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[37]
+func (x *GetCurrentOnCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,55 +3704,62 @@ func (x *UpdateRotationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRotationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRotationRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetCurrentOnCallResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentOnCallResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateRotationRequest) GetScheduleId() string {
+func (x *GetCurrentOnCallResponse) GetPrimaryUserId() string {
 	if x != nil {
-		return x.ScheduleId
+		return x.PrimaryUserId
 	}
 	return ""
 }
 
-func (x *UpdateRotationRequest) GetRotation() *Rotation {
+func (x *GetCurrentOnCallResponse) GetSecondaryUserId() string {
 	if x != nil {
-		return x.Rotation
+		return x.SecondaryUserId
+	}
+	return ""
+}
+
+func (x *GetCurrentOnCallResponse) GetCurrentShift() *Shift {
+	if x != nil {
+		return x.CurrentShift
 	}
 	return nil
 }
 
-func (x *UpdateRotationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetCurrentOnCallResponse) GetNextHandoff() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateMask
+		return x.NextHandoff
 	}
 	return nil
 }
 
-type RemoveRotationRequest struct {
+type GetOnCallAtTimeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	RotationId    string                 `protobuf:"bytes,2,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRotationRequest) Reset() {
-	*x = RemoveRotationRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[38]
+func (x *GetOnCallAtTimeRequest) Reset() {
+	*x = GetOnCallAtTimeRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRotationRequest) String() string {
+func (x *GetOnCallAtTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRotationRequest) ProtoMessage() {}
+func (*GetOnCallAtTimeRequest) ProtoMessage() {}
 
-func (x *RemoveRotationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[38]
+func (x *GetOnCallAtTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,48 +3770,49 @@ func (x *RemoveRotationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRotationRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRotationRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetOnCallAtTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetOnCallAtTimeRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RemoveRotationRequest) GetScheduleId() string {
+func (x *GetOnCallAtTimeRequest) GetScheduleId() string {
 	if x != nil {
 		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *RemoveRotationRequest) GetRotationId() string {
+func (x *GetOnCallAtTimeRequest) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RotationId
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-type CreateOverrideRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	Override      *ScheduleOverride      `protobuf:"bytes,2,opt,name=override,proto3" json:"override,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOnCallAtTimeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryUserId   string                 `protobuf:"bytes,1,opt,name=primary_user_id,json=primaryUserId,proto3" json:"primary_user_id,omitempty"`
+	SecondaryUserId string                 `protobuf:"bytes,2,opt,name=secondary_user_id,json=secondaryUserId,proto3" json:"secondary_user_id,omitempty"`
+	Shift           *Shift                 `protobuf:"bytes,3,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateOverrideRequest) Reset() {
-	*x = CreateOverrideRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[39]
+func (x *GetOnCallAtTimeResponse) Reset() {
+	*x = GetOnCallAtTimeResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOverrideRequest) String() string {
+func (x *GetOnCallAtTimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOverrideRequest) ProtoMessage() {}
+func (*GetOnCallAtTimeResponse) ProtoMessage() {}
 
-func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[39]
+func (x *GetOnCallAtTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,48 +3823,60 @@ func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOverrideRequest.ProtoReflect.Descriptor instead.
-func (*CreateOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetOnCallAtTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetOnCallAtTimeResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CreateOverrideRequest) GetScheduleId() string {
+func (x *GetOnCallAtTimeResponse) GetPrimaryUserId() string {
 	if x != nil {
-		return x.ScheduleId
+		return x.PrimaryUserId
 	}
 	return ""
 }
 
-func (x *CreateOverrideRequest) GetOverride() *ScheduleOverride {
+func (x *GetOnCallAtTimeResponse) GetSecondaryUserId() string {
 	if x != nil {
-		return x.Override
+		return x.SecondaryUserId
+	}
+	return ""
+}
+
+func (x *GetOnCallAtTimeResponse) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
 	}
 	return nil
 }
 
-type DeleteOverrideRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	OverrideId    string                 `protobuf:"bytes,2,opt,name=override_id,json=overrideId,proto3" json:"override_id,omitempty"`
+type ListUpcomingShiftsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// How far ahead to look
+	Until *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	// Filter by user
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOverrideRequest) Reset() {
-	*x = DeleteOverrideRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[40]
+func (x *ListUpcomingShiftsRequest) Reset() {
+	*x = ListUpcomingShiftsRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOverrideRequest) String() string {
+func (x *ListUpcomingShiftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOverrideRequest) ProtoMessage() {}
+func (*ListUpcomingShiftsRequest) ProtoMessage() {}
 
-func (x *DeleteOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[40]
+func (x *ListUpcomingShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,95 +3887,69 @@ func (x *DeleteOverrideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOverrideRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListUpcomingShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *DeleteOverrideRequest) GetScheduleId() string {
+func (x *ListUpcomingShiftsRequest) GetScheduleId() string {
 	if x != nil {
 		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *DeleteOverrideRequest) GetOverrideId() string {
+func (x *ListUpcomingShiftsRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OverrideId
+		return x.Until
 	}
-	return ""
-}
-
-type DeleteOverrideResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteOverrideResponse) Reset() {
-	*x = DeleteOverrideResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteOverrideResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*DeleteOverrideResponse) ProtoMessage() {}
-
-func (x *DeleteOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[41]
+func (x *ListUpcomingShiftsRequest) GetUserId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteOverrideResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{41}
+func (x *ListUpcomingShiftsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *DeleteOverrideResponse) GetSuccess() bool {
+func (x *ListUpcomingShiftsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Success
+		return x.PageToken
 	}
-	return false
+	return ""
 }
 
-type ListOverridesRequest struct {
+type ListUpcomingShiftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Shifts        []*Shift               `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOverridesRequest) Reset() {
-	*x = ListOverridesRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[42]
+func (x *ListUpcomingShiftsResponse) Reset() {
+	*x = ListUpcomingShiftsResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOverridesRequest) String() string {
+func (x *ListUpcomingShiftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOverridesRequest) ProtoMessage() {}
+func (*ListUpcomingShiftsResponse) ProtoMessage() {}
 
-func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[42]
+func (x *ListUpcomingShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,72 +3957,55 @@ func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListOverridesRequest.ProtoReflect.Descriptor instead.
-func (*ListOverridesRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *ListOverridesRequest) GetScheduleId() string {
-	if x != nil {
-		return x.ScheduleId
-	}
-	return ""
-}
-
-func (x *ListOverridesRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListOverridesRequest) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+// Deprecated: Use ListUpcomingShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListOverridesRequest) GetPageSize() int32 {
+func (x *ListUpcomingShiftsResponse) GetShifts() []*Shift {
 	if x != nil {
-		return x.PageSize
+		return x.Shifts
 	}
-	return 0
+	return nil
 }
 
-func (x *ListOverridesRequest) GetPageToken() string {
+func (x *ListUpcomingShiftsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.PageToken
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListOverridesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Overrides     []*ScheduleOverride    `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+// PreviewRotationRequest generates the shifts of a schedule that has not been saved,
+// so rotation changes can be previewed before they are committed.
+type PreviewRotationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unsaved schedule whose rotations are previewed; overrides are not applied
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOverridesResponse) Reset() {
-	*x = ListOverridesResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[43]
+func (x *PreviewRotationRequest) Reset() {
+	*x = PreviewRotationRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOverridesResponse) String() string {
+func (x *PreviewRotationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOverridesResponse) ProtoMessage() {}
+func (*PreviewRotationRequest) ProtoMessage() {}
 
-func (x *ListOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[43]
+func (x *PreviewRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2666,47 +4016,57 @@ func (x *ListOverridesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOverridesResponse.ProtoReflect.Descriptor instead.
-func (*ListOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use PreviewRotationRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRotationRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListOverridesResponse) GetOverrides() []*ScheduleOverride {
+func (x *PreviewRotationRequest) GetSchedule() *Schedule {
 	if x != nil {
-		return x.Overrides
+		return x.Schedule
 	}
 	return nil
 }
 
-func (x *ListOverridesResponse) GetNextPageToken() string {
+func (x *PreviewRotationRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextPageToken
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-type GetCurrentOnCallRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+func (x *PreviewRotationRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetCoverageDepthRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Hourly range to report; from is truncated to the hour
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentOnCallRequest) Reset() {
-	*x = GetCurrentOnCallRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[44]
+func (x *GetCoverageDepthRequest) Reset() {
+	*x = GetCoverageDepthRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentOnCallRequest) String() string {
+func (x *GetCoverageDepthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentOnCallRequest) ProtoMessage() {}
+func (*GetCoverageDepthRequest) ProtoMessage() {}
 
-func (x *GetCurrentOnCallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[44]
+func (x *GetCoverageDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2717,43 +4077,59 @@ func (x *GetCurrentOnCallRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentOnCallRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentOnCallRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetCoverageDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetCoverageDepthRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetCurrentOnCallRequest) GetScheduleId() string {
+func (x *GetCoverageDepthRequest) GetScheduleId() string {
 	if x != nil {
 		return x.ScheduleId
 	}
 	return ""
 }
 
-type GetCurrentOnCallResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PrimaryUserId   string                 `protobuf:"bytes,1,opt,name=primary_user_id,json=primaryUserId,proto3" json:"primary_user_id,omitempty"`
-	SecondaryUserId string                 `protobuf:"bytes,2,opt,name=secondary_user_id,json=secondaryUserId,proto3" json:"secondary_user_id,omitempty"`
-	CurrentShift    *Shift                 `protobuf:"bytes,3,opt,name=current_shift,json=currentShift,proto3" json:"current_shift,omitempty"`
-	NextHandoff     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_handoff,json=nextHandoff,proto3" json:"next_handoff,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetCoverageDepthRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (x *GetCurrentOnCallResponse) Reset() {
-	*x = GetCurrentOnCallResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[45]
+func (x *GetCoverageDepthRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// CoverageDepthEntry is the on-call coverage of a one-hour slot
+type CoverageDepthEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TimeSlot *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_slot,json=timeSlot,proto3" json:"time_slot,omitempty"`
+	// Number of active rotation layers plus active overrides
+	UserCount int32 `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// On-call user of each active layer, followed by each active override's user
+	UserIds       []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverageDepthEntry) Reset() {
+	*x = CoverageDepthEntry{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentOnCallResponse) String() string {
+func (x *CoverageDepthEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentOnCallResponse) ProtoMessage() {}
+func (*CoverageDepthEntry) ProtoMessage() {}
 
-func (x *GetCurrentOnCallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[45]
+func (x *CoverageDepthEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2764,62 +4140,54 @@ func (x *GetCurrentOnCallResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentOnCallResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentOnCallResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *GetCurrentOnCallResponse) GetPrimaryUserId() string {
-	if x != nil {
-		return x.PrimaryUserId
-	}
-	return ""
+// Deprecated: Use CoverageDepthEntry.ProtoReflect.Descriptor instead.
+func (*CoverageDepthEntry) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCurrentOnCallResponse) GetSecondaryUserId() string {
+func (x *CoverageDepthEntry) GetTimeSlot() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SecondaryUserId
+		return x.TimeSlot
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCurrentOnCallResponse) GetCurrentShift() *Shift {
+func (x *CoverageDepthEntry) GetUserCount() int32 {
 	if x != nil {
-		return x.CurrentShift
+		return x.UserCount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetCurrentOnCallResponse) GetNextHandoff() *timestamppb.Timestamp {
+func (x *CoverageDepthEntry) GetUserIds() []string {
 	if x != nil {
-		return x.NextHandoff
+		return x.UserIds
 	}
 	return nil
 }
 
-type GetOnCallAtTimeRequest struct {
+type GetCoverageDepthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Entries       []*CoverageDepthEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallAtTimeRequest) Reset() {
-	*x = GetOnCallAtTimeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[46]
+func (x *GetCoverageDepthResponse) Reset() {
+	*x = GetCoverageDepthResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallAtTimeRequest) String() string {
+func (x *GetCoverageDepthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallAtTimeRequest) ProtoMessage() {}
+func (*GetCoverageDepthResponse) ProtoMessage() {}
 
-func (x *GetOnCallAtTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[46]
+func (x *GetCoverageDepthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2830,49 +4198,43 @@ func (x *GetOnCallAtTimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallAtTimeRequest.ProtoReflect.Descriptor instead.
-func (*GetOnCallAtTimeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *GetOnCallAtTimeRequest) GetScheduleId() string {
-	if x != nil {
-		return x.ScheduleId
-	}
-	return ""
+// Deprecated: Use GetCoverageDepthResponse.ProtoReflect.Descriptor instead.
+func (*GetCoverageDepthResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetOnCallAtTimeRequest) GetTime() *timestamppb.Timestamp {
+func (x *GetCoverageDepthResponse) GetEntries() []*CoverageDepthEntry {
 	if x != nil {
-		return x.Time
+		return x.Entries
 	}
 	return nil
 }
 
-type GetOnCallAtTimeResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PrimaryUserId   string                 `protobuf:"bytes,1,opt,name=primary_user_id,json=primaryUserId,proto3" json:"primary_user_id,omitempty"`
-	SecondaryUserId string                 `protobuf:"bytes,2,opt,name=secondary_user_id,json=secondaryUserId,proto3" json:"secondary_user_id,omitempty"`
-	Shift           *Shift                 `protobuf:"bytes,3,opt,name=shift,proto3" json:"shift,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetLoadBalanceRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Range of rotation shifts to analyze; shifts are clipped to it
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallAtTimeResponse) Reset() {
-	*x = GetOnCallAtTimeResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[47]
+func (x *GetLoadBalanceRequest) Reset() {
+	*x = GetLoadBalanceRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallAtTimeResponse) String() string {
+func (x *GetLoadBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallAtTimeResponse) ProtoMessage() {}
+func (*GetLoadBalanceRequest) ProtoMessage() {}
 
-func (x *GetOnCallAtTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[47]
+func (x *GetLoadBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2883,60 +4245,58 @@ func (x *GetOnCallAtTimeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallAtTimeResponse.ProtoReflect.Descriptor instead.
-func (*GetOnCallAtTimeResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetLoadBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetLoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetOnCallAtTimeResponse) GetPrimaryUserId() string {
+func (x *GetLoadBalanceRequest) GetScheduleId() string {
 	if x != nil {
-		return x.PrimaryUserId
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *GetOnCallAtTimeResponse) GetSecondaryUserId() string {
+func (x *GetLoadBalanceRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SecondaryUserId
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOnCallAtTimeResponse) GetShift() *Shift {
+func (x *GetLoadBalanceRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Shift
+		return x.Until
 	}
 	return nil
 }
 
-type ListUpcomingShiftsRequest struct {
+// UserLoad is the rotation shift load of one user
+type UserLoad struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	// How far ahead to look
-	Until *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
-	// Filter by user
-	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ShiftHours float64                `protobuf:"fixed64,2,opt,name=shift_hours,json=shiftHours,proto3" json:"shift_hours,omitempty"`
+	// Deviation from the mean in percent of the mean; negative is below the mean
+	DeviationPercent float64 `protobuf:"fixed64,3,opt,name=deviation_percent,json=deviationPercent,proto3" json:"deviation_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListUpcomingShiftsRequest) Reset() {
-	*x = ListUpcomingShiftsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[48]
+func (x *UserLoad) Reset() {
+	*x = UserLoad{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUpcomingShiftsRequest) String() string {
+func (x *UserLoad) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUpcomingShiftsRequest) ProtoMessage() {}
+func (*UserLoad) ProtoMessage() {}
 
-func (x *ListUpcomingShiftsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[48]
+func (x *UserLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2947,69 +4307,57 @@ func (x *ListUpcomingShiftsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUpcomingShiftsRequest.ProtoReflect.Descriptor instead.
-func (*ListUpcomingShiftsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *ListUpcomingShiftsRequest) GetScheduleId() string {
-	if x != nil {
-		return x.ScheduleId
-	}
-	return ""
-}
-
-func (x *ListUpcomingShiftsRequest) GetUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Until
-	}
-	return nil
+// Deprecated: Use UserLoad.ProtoReflect.Descriptor instead.
+func (*UserLoad) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListUpcomingShiftsRequest) GetUserId() string {
+func (x *UserLoad) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListUpcomingShiftsRequest) GetPageSize() int32 {
+func (x *UserLoad) GetShiftHours() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.ShiftHours
 	}
 	return 0
 }
 
-func (x *ListUpcomingShiftsRequest) GetPageToken() string {
+func (x *UserLoad) GetDeviationPercent() float64 {
 	if x != nil {
-		return x.PageToken
+		return x.DeviationPercent
 	}
-	return ""
+	return 0
 }
 
-type ListUpcomingShiftsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shifts        []*Shift               `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetLoadBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users sorted by user ID
+	Users               []*UserLoad `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	MeanHours           float64     `protobuf:"fixed64,2,opt,name=mean_hours,json=meanHours,proto3" json:"mean_hours,omitempty"`
+	MaxDeviationPercent float64     `protobuf:"fixed64,3,opt,name=max_deviation_percent,json=maxDeviationPercent,proto3" json:"max_deviation_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ListUpcomingShiftsResponse) Reset() {
-	*x = ListUpcomingShiftsResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[49]
+func (x *GetLoadBalanceResponse) Reset() {
+	*x = GetLoadBalanceResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUpcomingShiftsResponse) String() string {
+func (x *GetLoadBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUpcomingShiftsResponse) ProtoMessage() {}
+func (*GetLoadBalanceResponse) ProtoMessage() {}
 
-func (x *ListUpcomingShiftsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[49]
+func (x *GetLoadBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3020,23 +4368,30 @@ func (x *ListUpcomingShiftsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUpcomingShiftsResponse.ProtoReflect.Descriptor instead.
-func (*ListUpcomingShiftsResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetLoadBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetLoadBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListUpcomingShiftsResponse) GetShifts() []*Shift {
+func (x *GetLoadBalanceResponse) GetUsers() []*UserLoad {
 	if x != nil {
-		return x.Shifts
+		return x.Users
 	}
 	return nil
 }
 
-func (x *ListUpcomingShiftsResponse) GetNextPageToken() string {
+func (x *GetLoadBalanceResponse) GetMeanHours() float64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.MeanHours
+	}
+	return 0
+}
+
+func (x *GetLoadBalanceResponse) GetMaxDeviationPercent() float64 {
+	if x != nil {
+		return x.MaxDeviationPercent
 	}
-	return ""
+	return 0
 }
 
 type AcknowledgeHandoffRequest struct {
@@ -3049,7 +4404,7 @@ type AcknowledgeHandoffRequest struct {
 
 func (x *AcknowledgeHandoffRequest) Reset() {
 	*x = AcknowledgeHandoffRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[50]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3061,7 +4416,7 @@ func (x *AcknowledgeHandoffRequest) String() string {
 func (*AcknowledgeHandoffRequest) ProtoMessage() {}
 
 func (x *AcknowledgeHandoffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[50]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3074,7 +4429,7 @@ func (x *AcknowledgeHandoffRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcknowledgeHandoffRequest.ProtoReflect.Descriptor instead.
 func (*AcknowledgeHandoffRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{50}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *AcknowledgeHandoffRequest) GetScheduleId() string {
@@ -3101,7 +4456,7 @@ type AcknowledgeHandoffResponse struct {
 
 func (x *AcknowledgeHandoffResponse) Reset() {
 	*x = AcknowledgeHandoffResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[51]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3113,7 +4468,7 @@ func (x *AcknowledgeHandoffResponse) String() string {
 func (*AcknowledgeHandoffResponse) ProtoMessage() {}
 
 func (x *AcknowledgeHandoffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[51]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3126,7 +4481,7 @@ func (x *AcknowledgeHandoffResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcknowledgeHandoffResponse.ProtoReflect.Descriptor instead.
 func (*AcknowledgeHandoffResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{51}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *AcknowledgeHandoffResponse) GetSuccess() bool {
@@ -3152,7 +4507,7 @@ type GetHandoffSummaryRequest struct {
 
 func (x *GetHandoffSummaryRequest) Reset() {
 	*x = GetHandoffSummaryRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[52]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3164,7 +4519,7 @@ func (x *GetHandoffSummaryRequest) String() string {
 func (*GetHandoffSummaryRequest) ProtoMessage() {}
 
 func (x *GetHandoffSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[52]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3177,7 +4532,7 @@ func (x *GetHandoffSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHandoffSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetHandoffSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{52}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetHandoffSummaryRequest) GetScheduleId() string {
@@ -3200,14 +4555,18 @@ type HandoffSummary struct {
 	// Recent notable events
 	RecentEvents []*Event `protobuf:"bytes,7,rep,name=recent_events,json=recentEvents,proto3" json:"recent_events,omitempty"`
 	// Handoff notes from outgoing user
-	HandoffNotes  string `protobuf:"bytes,8,opt,name=handoff_notes,json=handoffNotes,proto3" json:"handoff_notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	HandoffNotes string `protobuf:"bytes,8,opt,name=handoff_notes,json=handoffNotes,proto3" json:"handoff_notes,omitempty"`
+	// Most recent handoff notes, newest first
+	RecentHandoffNotes []*HandoffNote `protobuf:"bytes,9,rep,name=recent_handoff_notes,json=recentHandoffNotes,proto3" json:"recent_handoff_notes,omitempty"`
+	// Comments left on alerts during the outgoing shift, newest first
+	RecentAlertComments []*v1.AlertComment `protobuf:"bytes,10,rep,name=recent_alert_comments,json=recentAlertComments,proto3" json:"recent_alert_comments,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *HandoffSummary) Reset() {
 	*x = HandoffSummary{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[53]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3219,7 +4578,7 @@ func (x *HandoffSummary) String() string {
 func (*HandoffSummary) ProtoMessage() {}
 
 func (x *HandoffSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[53]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,7 +4591,7 @@ func (x *HandoffSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandoffSummary.ProtoReflect.Descriptor instead.
 func (*HandoffSummary) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{53}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *HandoffSummary) GetScheduleId() string {
@@ -3291,6 +4650,20 @@ func (x *HandoffSummary) GetHandoffNotes() string {
 	return ""
 }
 
+func (x *HandoffSummary) GetRecentHandoffNotes() []*HandoffNote {
+	if x != nil {
+		return x.RecentHandoffNotes
+	}
+	return nil
+}
+
+func (x *HandoffSummary) GetRecentAlertComments() []*v1.AlertComment {
+	if x != nil {
+		return x.RecentAlertComments
+	}
+	return nil
+}
+
 type TicketSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3306,7 +4679,7 @@ type TicketSummary struct {
 
 func (x *TicketSummary) Reset() {
 	*x = TicketSummary{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[54]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3318,7 +4691,7 @@ func (x *TicketSummary) String() string {
 func (*TicketSummary) ProtoMessage() {}
 
 func (x *TicketSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[54]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3331,7 +4704,7 @@ func (x *TicketSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TicketSummary.ProtoReflect.Descriptor instead.
 func (*TicketSummary) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{54}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *TicketSummary) GetId() string {
@@ -3397,7 +4770,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[55]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3409,7 +4782,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[55]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3422,7 +4795,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{55}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *Event) GetId() string {
@@ -3476,7 +4849,7 @@ type CreateSiteRequest struct {
 
 func (x *CreateSiteRequest) Reset() {
 	*x = CreateSiteRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[56]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3488,7 +4861,7 @@ func (x *CreateSiteRequest) String() string {
 func (*CreateSiteRequest) ProtoMessage() {}
 
 func (x *CreateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[56]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3501,7 +4874,7 @@ func (x *CreateSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSiteRequest.ProtoReflect.Descriptor instead.
 func (*CreateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{56}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *CreateSiteRequest) GetSite() *Site {
@@ -3520,7 +4893,7 @@ type GetSiteRequest struct {
 
 func (x *GetSiteRequest) Reset() {
 	*x = GetSiteRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[57]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3532,7 +4905,7 @@ func (x *GetSiteRequest) String() string {
 func (*GetSiteRequest) ProtoMessage() {}
 
 func (x *GetSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[57]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3545,7 +4918,7 @@ func (x *GetSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteRequest.ProtoReflect.Descriptor instead.
 func (*GetSiteRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{57}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetSiteRequest) GetId() string {
@@ -3564,7 +4937,7 @@ type GetSiteByCodeRequest struct {
 
 func (x *GetSiteByCodeRequest) Reset() {
 	*x = GetSiteByCodeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[58]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3576,7 +4949,7 @@ func (x *GetSiteByCodeRequest) String() string {
 func (*GetSiteByCodeRequest) ProtoMessage() {}
 
 func (x *GetSiteByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[58]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3589,7 +4962,7 @@ func (x *GetSiteByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetSiteByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{58}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetSiteByCodeRequest) GetCode() string {
@@ -3612,7 +4985,7 @@ type ListSitesRequest struct {
 
 func (x *ListSitesRequest) Reset() {
 	*x = ListSitesRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[59]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3624,7 +4997,7 @@ func (x *ListSitesRequest) String() string {
 func (*ListSitesRequest) ProtoMessage() {}
 
 func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[59]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3637,7 +5010,7 @@ func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSitesRequest.ProtoReflect.Descriptor instead.
 func (*ListSitesRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{59}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ListSitesRequest) GetPageSize() int32 {
@@ -3686,7 +5059,7 @@ type ListSitesResponse struct {
 
 func (x *ListSitesResponse) Reset() {
 	*x = ListSitesResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[60]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3698,7 +5071,7 @@ func (x *ListSitesResponse) String() string {
 func (*ListSitesResponse) ProtoMessage() {}
 
 func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[60]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3711,7 +5084,7 @@ func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSitesResponse.ProtoReflect.Descriptor instead.
 func (*ListSitesResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{60}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ListSitesResponse) GetSites() []*Site {
@@ -3745,7 +5118,7 @@ type UpdateSiteRequest struct {
 
 func (x *UpdateSiteRequest) Reset() {
 	*x = UpdateSiteRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[61]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3757,7 +5130,7 @@ func (x *UpdateSiteRequest) String() string {
 func (*UpdateSiteRequest) ProtoMessage() {}
 
 func (x *UpdateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[61]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3770,7 +5143,7 @@ func (x *UpdateSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSiteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{61}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *UpdateSiteRequest) GetSite() *Site {
@@ -3796,7 +5169,7 @@ type DeleteSiteRequest struct {
 
 func (x *DeleteSiteRequest) Reset() {
 	*x = DeleteSiteRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[62]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3808,7 +5181,7 @@ func (x *DeleteSiteRequest) String() string {
 func (*DeleteSiteRequest) ProtoMessage() {}
 
 func (x *DeleteSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[62]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3821,7 +5194,7 @@ func (x *DeleteSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSiteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSiteRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{62}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *DeleteSiteRequest) GetId() string {
@@ -3840,7 +5213,7 @@ type DeleteSiteResponse struct {
 
 func (x *DeleteSiteResponse) Reset() {
 	*x = DeleteSiteResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[63]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3852,7 +5225,7 @@ func (x *DeleteSiteResponse) String() string {
 func (*DeleteSiteResponse) ProtoMessage() {}
 
 func (x *DeleteSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[63]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3865,7 +5238,7 @@ func (x *DeleteSiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSiteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSiteResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{63}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *DeleteSiteResponse) GetSuccess() bool {
@@ -3875,6 +5248,58 @@ func (x *DeleteSiteResponse) GetSuccess() bool {
 	return false
 }
 
+type UpdateSiteCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Capacity      *CapacityMetrics       `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteCapacityRequest) Reset() {
+	*x = UpdateSiteCapacityRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteCapacityRequest) ProtoMessage() {}
+
+func (x *UpdateSiteCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteCapacityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateSiteCapacityRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteCapacityRequest) GetCapacity() *CapacityMetrics {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
 type CreateMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
@@ -3884,7 +5309,7 @@ type CreateMaintenanceWindowRequest struct {
 
 func (x *CreateMaintenanceWindowRequest) Reset() {
 	*x = CreateMaintenanceWindowRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[64]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3896,7 +5321,7 @@ func (x *CreateMaintenanceWindowRequest) String() string {
 func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
 
 func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[64]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3909,7 +5334,7 @@ func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
 func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{64}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
@@ -3928,7 +5353,7 @@ type GetMaintenanceWindowRequest struct {
 
 func (x *GetMaintenanceWindowRequest) Reset() {
 	*x = GetMaintenanceWindowRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[65]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3940,7 +5365,7 @@ func (x *GetMaintenanceWindowRequest) String() string {
 func (*GetMaintenanceWindowRequest) ProtoMessage() {}
 
 func (x *GetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[65]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3953,7 +5378,7 @@ func (x *GetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
 func (*GetMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{65}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *GetMaintenanceWindowRequest) GetId() string {
@@ -3971,13 +5396,14 @@ type ListMaintenanceWindowsRequest struct {
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	Status        MaintenanceStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=alerting.routing.v1.MaintenanceStatus" json:"status,omitempty"`
 	SiteId        string                 `protobuf:"bytes,6,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // only windows carrying all of these labels
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMaintenanceWindowsRequest) Reset() {
 	*x = ListMaintenanceWindowsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[66]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3989,7 +5415,7 @@ func (x *ListMaintenanceWindowsRequest) String() string {
 func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
 
 func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[66]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4002,7 +5428,7 @@ func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
 func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{66}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ListMaintenanceWindowsRequest) GetPageSize() int32 {
@@ -4047,6 +5473,13 @@ func (x *ListMaintenanceWindowsRequest) GetSiteId() string {
 	return ""
 }
 
+func (x *ListMaintenanceWindowsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type ListMaintenanceWindowsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Windows       []*MaintenanceWindow   `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
@@ -4058,7 +5491,7 @@ type ListMaintenanceWindowsResponse struct {
 
 func (x *ListMaintenanceWindowsResponse) Reset() {
 	*x = ListMaintenanceWindowsResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[67]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4070,7 +5503,7 @@ func (x *ListMaintenanceWindowsResponse) String() string {
 func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
 
 func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[67]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4083,7 +5516,7 @@ func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
 func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{67}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
@@ -4117,7 +5550,7 @@ type UpdateMaintenanceWindowRequest struct {
 
 func (x *UpdateMaintenanceWindowRequest) Reset() {
 	*x = UpdateMaintenanceWindowRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[68]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4129,7 +5562,7 @@ func (x *UpdateMaintenanceWindowRequest) String() string {
 func (*UpdateMaintenanceWindowRequest) ProtoMessage() {}
 
 func (x *UpdateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[68]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4142,7 +5575,7 @@ func (x *UpdateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{68}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *UpdateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
@@ -4166,21 +5599,112 @@ type DeleteMaintenanceWindowRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMaintenanceWindowRequest) Reset() {
-	*x = DeleteMaintenanceWindowRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[69]
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowResponse) Reset() {
+	*x = DeleteMaintenanceWindowResponse{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ExpandMaintenanceWindowRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdditionalSites    []string               `protobuf:"bytes,2,rep,name=additional_sites,json=additionalSites,proto3" json:"additional_sites,omitempty"`
+	AdditionalServices []string               `protobuf:"bytes,3,rep,name=additional_services,json=additionalServices,proto3" json:"additional_services,omitempty"`
+	ExtendBy           *durationpb.Duration   `protobuf:"bytes,4,opt,name=extend_by,json=extendBy,proto3" json:"extend_by,omitempty"` // added to end_time when positive
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExpandMaintenanceWindowRequest) Reset() {
+	*x = ExpandMaintenanceWindowRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMaintenanceWindowRequest) String() string {
+func (x *ExpandMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+func (*ExpandMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[69]
+func (x *ExpandMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4191,40 +5715,62 @@ func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ExpandMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*ExpandMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *DeleteMaintenanceWindowRequest) GetId() string {
+func (x *ExpandMaintenanceWindowRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteMaintenanceWindowResponse struct {
+func (x *ExpandMaintenanceWindowRequest) GetAdditionalSites() []string {
+	if x != nil {
+		return x.AdditionalSites
+	}
+	return nil
+}
+
+func (x *ExpandMaintenanceWindowRequest) GetAdditionalServices() []string {
+	if x != nil {
+		return x.AdditionalServices
+	}
+	return nil
+}
+
+func (x *ExpandMaintenanceWindowRequest) GetExtendBy() *durationpb.Duration {
+	if x != nil {
+		return x.ExtendBy
+	}
+	return nil
+}
+
+type ApproveMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMaintenanceWindowResponse) Reset() {
-	*x = DeleteMaintenanceWindowResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[70]
+func (x *ApproveMaintenanceWindowRequest) Reset() {
+	*x = ApproveMaintenanceWindowRequest{}
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMaintenanceWindowResponse) String() string {
+func (x *ApproveMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+func (*ApproveMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[70]
+func (x *ApproveMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,16 +5781,23 @@ func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ApproveMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*ApproveMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *DeleteMaintenanceWindowResponse) GetSuccess() bool {
+func (x *ApproveMaintenanceWindowRequest) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return ""
+}
+
+func (x *ApproveMaintenanceWindowRequest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
 }
 
 type ListActiveMaintenanceWindowsRequest struct {
@@ -4258,7 +5811,7 @@ type ListActiveMaintenanceWindowsRequest struct {
 
 func (x *ListActiveMaintenanceWindowsRequest) Reset() {
 	*x = ListActiveMaintenanceWindowsRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[71]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4270,7 +5823,7 @@ func (x *ListActiveMaintenanceWindowsRequest) String() string {
 func (*ListActiveMaintenanceWindowsRequest) ProtoMessage() {}
 
 func (x *ListActiveMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[71]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4283,7 +5836,7 @@ func (x *ListActiveMaintenanceWindowsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListActiveMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
 func (*ListActiveMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{71}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ListActiveMaintenanceWindowsRequest) GetSiteIds() []string {
@@ -4309,7 +5862,7 @@ type CheckAlertMaintenanceRequest struct {
 
 func (x *CheckAlertMaintenanceRequest) Reset() {
 	*x = CheckAlertMaintenanceRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[72]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4321,7 +5874,7 @@ func (x *CheckAlertMaintenanceRequest) String() string {
 func (*CheckAlertMaintenanceRequest) ProtoMessage() {}
 
 func (x *CheckAlertMaintenanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[72]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4334,7 +5887,7 @@ func (x *CheckAlertMaintenanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAlertMaintenanceRequest.ProtoReflect.Descriptor instead.
 func (*CheckAlertMaintenanceRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{72}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *CheckAlertMaintenanceRequest) GetAlert() *Alert {
@@ -4355,7 +5908,7 @@ type CheckAlertMaintenanceResponse struct {
 
 func (x *CheckAlertMaintenanceResponse) Reset() {
 	*x = CheckAlertMaintenanceResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[73]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4367,7 +5920,7 @@ func (x *CheckAlertMaintenanceResponse) String() string {
 func (*CheckAlertMaintenanceResponse) ProtoMessage() {}
 
 func (x *CheckAlertMaintenanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[73]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,7 +5933,7 @@ func (x *CheckAlertMaintenanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAlertMaintenanceResponse.ProtoReflect.Descriptor instead.
 func (*CheckAlertMaintenanceResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{73}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CheckAlertMaintenanceResponse) GetInMaintenance() bool {
@@ -4413,7 +5966,7 @@ type CreateEscalationPolicyRequest struct {
 
 func (x *CreateEscalationPolicyRequest) Reset() {
 	*x = CreateEscalationPolicyRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[74]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4425,7 +5978,7 @@ func (x *CreateEscalationPolicyRequest) String() string {
 func (*CreateEscalationPolicyRequest) ProtoMessage() {}
 
 func (x *CreateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[74]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4438,7 +5991,7 @@ func (x *CreateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*CreateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{74}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *CreateEscalationPolicyRequest) GetPolicy() *EscalationPolicy {
@@ -4457,7 +6010,7 @@ type GetEscalationPolicyRequest struct {
 
 func (x *GetEscalationPolicyRequest) Reset() {
 	*x = GetEscalationPolicyRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[75]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4469,7 +6022,7 @@ func (x *GetEscalationPolicyRequest) String() string {
 func (*GetEscalationPolicyRequest) ProtoMessage() {}
 
 func (x *GetEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[75]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4482,7 +6035,7 @@ func (x *GetEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEscalationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetEscalationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{75}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetEscalationPolicyRequest) GetId() string {
@@ -4502,7 +6055,7 @@ type ListEscalationPoliciesRequest struct {
 
 func (x *ListEscalationPoliciesRequest) Reset() {
 	*x = ListEscalationPoliciesRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[76]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4514,7 +6067,7 @@ func (x *ListEscalationPoliciesRequest) String() string {
 func (*ListEscalationPoliciesRequest) ProtoMessage() {}
 
 func (x *ListEscalationPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[76]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4527,7 +6080,7 @@ func (x *ListEscalationPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEscalationPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*ListEscalationPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{76}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ListEscalationPoliciesRequest) GetPageSize() int32 {
@@ -4555,7 +6108,7 @@ type ListEscalationPoliciesResponse struct {
 
 func (x *ListEscalationPoliciesResponse) Reset() {
 	*x = ListEscalationPoliciesResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[77]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4567,7 +6120,7 @@ func (x *ListEscalationPoliciesResponse) String() string {
 func (*ListEscalationPoliciesResponse) ProtoMessage() {}
 
 func (x *ListEscalationPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[77]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4580,7 +6133,7 @@ func (x *ListEscalationPoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEscalationPoliciesResponse.ProtoReflect.Descriptor instead.
 func (*ListEscalationPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{77}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ListEscalationPoliciesResponse) GetPolicies() []*EscalationPolicy {
@@ -4614,7 +6167,7 @@ type UpdateEscalationPolicyRequest struct {
 
 func (x *UpdateEscalationPolicyRequest) Reset() {
 	*x = UpdateEscalationPolicyRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[78]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4626,7 +6179,7 @@ func (x *UpdateEscalationPolicyRequest) String() string {
 func (*UpdateEscalationPolicyRequest) ProtoMessage() {}
 
 func (x *UpdateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[78]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4639,7 +6192,7 @@ func (x *UpdateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{78}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *UpdateEscalationPolicyRequest) GetPolicy() *EscalationPolicy {
@@ -4665,7 +6218,7 @@ type DeleteEscalationPolicyRequest struct {
 
 func (x *DeleteEscalationPolicyRequest) Reset() {
 	*x = DeleteEscalationPolicyRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[79]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4677,7 +6230,7 @@ func (x *DeleteEscalationPolicyRequest) String() string {
 func (*DeleteEscalationPolicyRequest) ProtoMessage() {}
 
 func (x *DeleteEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[79]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4690,7 +6243,7 @@ func (x *DeleteEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEscalationPolicyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEscalationPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{79}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *DeleteEscalationPolicyRequest) GetId() string {
@@ -4709,7 +6262,7 @@ type DeleteEscalationPolicyResponse struct {
 
 func (x *DeleteEscalationPolicyResponse) Reset() {
 	*x = DeleteEscalationPolicyResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[80]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4721,7 +6274,7 @@ func (x *DeleteEscalationPolicyResponse) String() string {
 func (*DeleteEscalationPolicyResponse) ProtoMessage() {}
 
 func (x *DeleteEscalationPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[80]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4734,7 +6287,7 @@ func (x *DeleteEscalationPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEscalationPolicyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEscalationPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{80}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *DeleteEscalationPolicyResponse) GetSuccess() bool {
@@ -4756,7 +6309,7 @@ type StartEscalationRequest struct {
 
 func (x *StartEscalationRequest) Reset() {
 	*x = StartEscalationRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[81]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4768,7 +6321,7 @@ func (x *StartEscalationRequest) String() string {
 func (*StartEscalationRequest) ProtoMessage() {}
 
 func (x *StartEscalationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[81]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4781,7 +6334,7 @@ func (x *StartEscalationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartEscalationRequest.ProtoReflect.Descriptor instead.
 func (*StartEscalationRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{81}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *StartEscalationRequest) GetPolicyId() string {
@@ -4823,7 +6376,7 @@ type StartEscalationResponse struct {
 
 func (x *StartEscalationResponse) Reset() {
 	*x = StartEscalationResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[82]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4835,7 +6388,7 @@ func (x *StartEscalationResponse) String() string {
 func (*StartEscalationResponse) ProtoMessage() {}
 
 func (x *StartEscalationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[82]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4848,7 +6401,7 @@ func (x *StartEscalationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartEscalationResponse.ProtoReflect.Descriptor instead.
 func (*StartEscalationResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{82}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *StartEscalationResponse) GetEscalationId() string {
@@ -4881,7 +6434,7 @@ type GetEscalationStatusRequest struct {
 
 func (x *GetEscalationStatusRequest) Reset() {
 	*x = GetEscalationStatusRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[83]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4893,7 +6446,7 @@ func (x *GetEscalationStatusRequest) String() string {
 func (*GetEscalationStatusRequest) ProtoMessage() {}
 
 func (x *GetEscalationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[83]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4906,7 +6459,7 @@ func (x *GetEscalationStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEscalationStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetEscalationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{83}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetEscalationStatusRequest) GetEscalationId() string {
@@ -4933,7 +6486,7 @@ type EscalationStatus struct {
 
 func (x *EscalationStatus) Reset() {
 	*x = EscalationStatus{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[84]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4945,7 +6498,7 @@ func (x *EscalationStatus) String() string {
 func (*EscalationStatus) ProtoMessage() {}
 
 func (x *EscalationStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[84]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,7 +6511,7 @@ func (x *EscalationStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationStatus.ProtoReflect.Descriptor instead.
 func (*EscalationStatus) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{84}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *EscalationStatus) GetEscalationId() string {
@@ -5037,7 +6590,7 @@ type EscalationStepResult struct {
 
 func (x *EscalationStepResult) Reset() {
 	*x = EscalationStepResult{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[85]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5049,7 +6602,7 @@ func (x *EscalationStepResult) String() string {
 func (*EscalationStepResult) ProtoMessage() {}
 
 func (x *EscalationStepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[85]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5062,7 +6615,7 @@ func (x *EscalationStepResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EscalationStepResult.ProtoReflect.Descriptor instead.
 func (*EscalationStepResult) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{85}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *EscalationStepResult) GetStepNumber() int32 {
@@ -5111,7 +6664,7 @@ type StopEscalationRequest struct {
 
 func (x *StopEscalationRequest) Reset() {
 	*x = StopEscalationRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[86]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5123,7 +6676,7 @@ func (x *StopEscalationRequest) String() string {
 func (*StopEscalationRequest) ProtoMessage() {}
 
 func (x *StopEscalationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[86]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5136,7 +6689,7 @@ func (x *StopEscalationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEscalationRequest.ProtoReflect.Descriptor instead.
 func (*StopEscalationRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{86}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *StopEscalationRequest) GetEscalationId() string {
@@ -5169,7 +6722,7 @@ type StopEscalationResponse struct {
 
 func (x *StopEscalationResponse) Reset() {
 	*x = StopEscalationResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[87]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5181,7 +6734,7 @@ func (x *StopEscalationResponse) String() string {
 func (*StopEscalationResponse) ProtoMessage() {}
 
 func (x *StopEscalationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[87]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5194,7 +6747,7 @@ func (x *StopEscalationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEscalationResponse.ProtoReflect.Descriptor instead.
 func (*StopEscalationResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{87}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *StopEscalationResponse) GetSuccess() bool {
@@ -5213,7 +6766,7 @@ type CreateCustomerTierRequest struct {
 
 func (x *CreateCustomerTierRequest) Reset() {
 	*x = CreateCustomerTierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[88]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5225,7 +6778,7 @@ func (x *CreateCustomerTierRequest) String() string {
 func (*CreateCustomerTierRequest) ProtoMessage() {}
 
 func (x *CreateCustomerTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[88]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5238,7 +6791,7 @@ func (x *CreateCustomerTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomerTierRequest.ProtoReflect.Descriptor instead.
 func (*CreateCustomerTierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{88}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *CreateCustomerTierRequest) GetTier() *CustomerTier {
@@ -5257,7 +6810,7 @@ type GetCustomerTierRequest struct {
 
 func (x *GetCustomerTierRequest) Reset() {
 	*x = GetCustomerTierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[89]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5269,7 +6822,7 @@ func (x *GetCustomerTierRequest) String() string {
 func (*GetCustomerTierRequest) ProtoMessage() {}
 
 func (x *GetCustomerTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[89]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5282,7 +6835,7 @@ func (x *GetCustomerTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerTierRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerTierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{89}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetCustomerTierRequest) GetId() string {
@@ -5302,7 +6855,7 @@ type ListCustomerTiersRequest struct {
 
 func (x *ListCustomerTiersRequest) Reset() {
 	*x = ListCustomerTiersRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[90]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5314,7 +6867,7 @@ func (x *ListCustomerTiersRequest) String() string {
 func (*ListCustomerTiersRequest) ProtoMessage() {}
 
 func (x *ListCustomerTiersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[90]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5327,7 +6880,7 @@ func (x *ListCustomerTiersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCustomerTiersRequest.ProtoReflect.Descriptor instead.
 func (*ListCustomerTiersRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{90}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *ListCustomerTiersRequest) GetPageSize() int32 {
@@ -5354,7 +6907,7 @@ type ListCustomerTiersResponse struct {
 
 func (x *ListCustomerTiersResponse) Reset() {
 	*x = ListCustomerTiersResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[91]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5366,7 +6919,7 @@ func (x *ListCustomerTiersResponse) String() string {
 func (*ListCustomerTiersResponse) ProtoMessage() {}
 
 func (x *ListCustomerTiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[91]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5379,7 +6932,7 @@ func (x *ListCustomerTiersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCustomerTiersResponse.ProtoReflect.Descriptor instead.
 func (*ListCustomerTiersResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{91}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *ListCustomerTiersResponse) GetTiers() []*CustomerTier {
@@ -5406,7 +6959,7 @@ type UpdateCustomerTierRequest struct {
 
 func (x *UpdateCustomerTierRequest) Reset() {
 	*x = UpdateCustomerTierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[92]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5418,7 +6971,7 @@ func (x *UpdateCustomerTierRequest) String() string {
 func (*UpdateCustomerTierRequest) ProtoMessage() {}
 
 func (x *UpdateCustomerTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[92]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5431,7 +6984,7 @@ func (x *UpdateCustomerTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCustomerTierRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerTierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{92}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *UpdateCustomerTierRequest) GetTier() *CustomerTier {
@@ -5457,7 +7010,7 @@ type DeleteCustomerTierRequest struct {
 
 func (x *DeleteCustomerTierRequest) Reset() {
 	*x = DeleteCustomerTierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[93]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5469,7 +7022,7 @@ func (x *DeleteCustomerTierRequest) String() string {
 func (*DeleteCustomerTierRequest) ProtoMessage() {}
 
 func (x *DeleteCustomerTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[93]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5482,7 +7035,7 @@ func (x *DeleteCustomerTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCustomerTierRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCustomerTierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{93}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *DeleteCustomerTierRequest) GetId() string {
@@ -5501,7 +7054,7 @@ type DeleteCustomerTierResponse struct {
 
 func (x *DeleteCustomerTierResponse) Reset() {
 	*x = DeleteCustomerTierResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[94]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5513,7 +7066,7 @@ func (x *DeleteCustomerTierResponse) String() string {
 func (*DeleteCustomerTierResponse) ProtoMessage() {}
 
 func (x *DeleteCustomerTierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[94]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +7079,7 @@ func (x *DeleteCustomerTierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCustomerTierResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCustomerTierResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{94}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *DeleteCustomerTierResponse) GetSuccess() bool {
@@ -5548,7 +7101,7 @@ type ResolveCustomerTierRequest struct {
 
 func (x *ResolveCustomerTierRequest) Reset() {
 	*x = ResolveCustomerTierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[95]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5560,7 +7113,7 @@ func (x *ResolveCustomerTierRequest) String() string {
 func (*ResolveCustomerTierRequest) ProtoMessage() {}
 
 func (x *ResolveCustomerTierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[95]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5573,7 +7126,7 @@ func (x *ResolveCustomerTierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveCustomerTierRequest.ProtoReflect.Descriptor instead.
 func (*ResolveCustomerTierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{95}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ResolveCustomerTierRequest) GetCustomerId() string {
@@ -5600,7 +7153,7 @@ type ResolveCustomerTierResponse struct {
 
 func (x *ResolveCustomerTierResponse) Reset() {
 	*x = ResolveCustomerTierResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[96]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5612,7 +7165,7 @@ func (x *ResolveCustomerTierResponse) String() string {
 func (*ResolveCustomerTierResponse) ProtoMessage() {}
 
 func (x *ResolveCustomerTierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[96]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,7 +7178,7 @@ func (x *ResolveCustomerTierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveCustomerTierResponse.ProtoReflect.Descriptor instead.
 func (*ResolveCustomerTierResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{96}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *ResolveCustomerTierResponse) GetTier() *CustomerTier {
@@ -5651,7 +7204,7 @@ type CreateCarrierRequest struct {
 
 func (x *CreateCarrierRequest) Reset() {
 	*x = CreateCarrierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[97]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5663,7 +7216,7 @@ func (x *CreateCarrierRequest) String() string {
 func (*CreateCarrierRequest) ProtoMessage() {}
 
 func (x *CreateCarrierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[97]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5676,7 +7229,7 @@ func (x *CreateCarrierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarrierRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarrierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{97}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *CreateCarrierRequest) GetCarrier() *CarrierConfig {
@@ -5695,7 +7248,7 @@ type GetCarrierRequest struct {
 
 func (x *GetCarrierRequest) Reset() {
 	*x = GetCarrierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[98]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5707,7 +7260,7 @@ func (x *GetCarrierRequest) String() string {
 func (*GetCarrierRequest) ProtoMessage() {}
 
 func (x *GetCarrierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[98]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5720,7 +7273,7 @@ func (x *GetCarrierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarrierRequest.ProtoReflect.Descriptor instead.
 func (*GetCarrierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{98}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GetCarrierRequest) GetId() string {
@@ -5739,7 +7292,7 @@ type GetCarrierByASNRequest struct {
 
 func (x *GetCarrierByASNRequest) Reset() {
 	*x = GetCarrierByASNRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[99]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5751,7 +7304,7 @@ func (x *GetCarrierByASNRequest) String() string {
 func (*GetCarrierByASNRequest) ProtoMessage() {}
 
 func (x *GetCarrierByASNRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[99]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5764,7 +7317,7 @@ func (x *GetCarrierByASNRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarrierByASNRequest.ProtoReflect.Descriptor instead.
 func (*GetCarrierByASNRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{99}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *GetCarrierByASNRequest) GetAsn() string {
@@ -5784,7 +7337,7 @@ type ListCarriersRequest struct {
 
 func (x *ListCarriersRequest) Reset() {
 	*x = ListCarriersRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[100]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5796,7 +7349,7 @@ func (x *ListCarriersRequest) String() string {
 func (*ListCarriersRequest) ProtoMessage() {}
 
 func (x *ListCarriersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[100]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5809,7 +7362,7 @@ func (x *ListCarriersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarriersRequest.ProtoReflect.Descriptor instead.
 func (*ListCarriersRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{100}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ListCarriersRequest) GetPageSize() int32 {
@@ -5836,7 +7389,7 @@ type ListCarriersResponse struct {
 
 func (x *ListCarriersResponse) Reset() {
 	*x = ListCarriersResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[101]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5848,7 +7401,7 @@ func (x *ListCarriersResponse) String() string {
 func (*ListCarriersResponse) ProtoMessage() {}
 
 func (x *ListCarriersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[101]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5861,7 +7414,7 @@ func (x *ListCarriersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarriersResponse.ProtoReflect.Descriptor instead.
 func (*ListCarriersResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{101}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ListCarriersResponse) GetCarriers() []*CarrierConfig {
@@ -5888,7 +7441,7 @@ type UpdateCarrierRequest struct {
 
 func (x *UpdateCarrierRequest) Reset() {
 	*x = UpdateCarrierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[102]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5900,7 +7453,7 @@ func (x *UpdateCarrierRequest) String() string {
 func (*UpdateCarrierRequest) ProtoMessage() {}
 
 func (x *UpdateCarrierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[102]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5913,7 +7466,7 @@ func (x *UpdateCarrierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarrierRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarrierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{102}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *UpdateCarrierRequest) GetCarrier() *CarrierConfig {
@@ -5939,7 +7492,7 @@ type DeleteCarrierRequest struct {
 
 func (x *DeleteCarrierRequest) Reset() {
 	*x = DeleteCarrierRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[103]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5951,7 +7504,7 @@ func (x *DeleteCarrierRequest) String() string {
 func (*DeleteCarrierRequest) ProtoMessage() {}
 
 func (x *DeleteCarrierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[103]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5964,7 +7517,7 @@ func (x *DeleteCarrierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarrierRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarrierRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{103}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *DeleteCarrierRequest) GetId() string {
@@ -5983,7 +7536,7 @@ type DeleteCarrierResponse struct {
 
 func (x *DeleteCarrierResponse) Reset() {
 	*x = DeleteCarrierResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[104]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5995,7 +7548,7 @@ func (x *DeleteCarrierResponse) String() string {
 func (*DeleteCarrierResponse) ProtoMessage() {}
 
 func (x *DeleteCarrierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[104]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6008,7 +7561,7 @@ func (x *DeleteCarrierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarrierResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarrierResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{104}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *DeleteCarrierResponse) GetSuccess() bool {
@@ -6027,7 +7580,7 @@ type CreateEquipmentTypeRequest struct {
 
 func (x *CreateEquipmentTypeRequest) Reset() {
 	*x = CreateEquipmentTypeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[105]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6039,7 +7592,7 @@ func (x *CreateEquipmentTypeRequest) String() string {
 func (*CreateEquipmentTypeRequest) ProtoMessage() {}
 
 func (x *CreateEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[105]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6052,7 +7605,7 @@ func (x *CreateEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEquipmentTypeRequest.ProtoReflect.Descriptor instead.
 func (*CreateEquipmentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{105}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *CreateEquipmentTypeRequest) GetEquipmentType() *EquipmentType {
@@ -6071,7 +7624,7 @@ type GetEquipmentTypeRequest struct {
 
 func (x *GetEquipmentTypeRequest) Reset() {
 	*x = GetEquipmentTypeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[106]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6083,7 +7636,7 @@ func (x *GetEquipmentTypeRequest) String() string {
 func (*GetEquipmentTypeRequest) ProtoMessage() {}
 
 func (x *GetEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[106]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6096,7 +7649,7 @@ func (x *GetEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEquipmentTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetEquipmentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{106}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GetEquipmentTypeRequest) GetId() string {
@@ -6115,7 +7668,7 @@ type GetEquipmentTypeByNameRequest struct {
 
 func (x *GetEquipmentTypeByNameRequest) Reset() {
 	*x = GetEquipmentTypeByNameRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[107]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6127,7 +7680,7 @@ func (x *GetEquipmentTypeByNameRequest) String() string {
 func (*GetEquipmentTypeByNameRequest) ProtoMessage() {}
 
 func (x *GetEquipmentTypeByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[107]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6140,7 +7693,7 @@ func (x *GetEquipmentTypeByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEquipmentTypeByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetEquipmentTypeByNameRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{107}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetEquipmentTypeByNameRequest) GetName() string {
@@ -6164,7 +7717,7 @@ type ListEquipmentTypesRequest struct {
 
 func (x *ListEquipmentTypesRequest) Reset() {
 	*x = ListEquipmentTypesRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[108]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6176,7 +7729,7 @@ func (x *ListEquipmentTypesRequest) String() string {
 func (*ListEquipmentTypesRequest) ProtoMessage() {}
 
 func (x *ListEquipmentTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[108]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6189,7 +7742,7 @@ func (x *ListEquipmentTypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEquipmentTypesRequest.ProtoReflect.Descriptor instead.
 func (*ListEquipmentTypesRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{108}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ListEquipmentTypesRequest) GetPageSize() int32 {
@@ -6238,7 +7791,7 @@ type ListEquipmentTypesResponse struct {
 
 func (x *ListEquipmentTypesResponse) Reset() {
 	*x = ListEquipmentTypesResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[109]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6250,7 +7803,7 @@ func (x *ListEquipmentTypesResponse) String() string {
 func (*ListEquipmentTypesResponse) ProtoMessage() {}
 
 func (x *ListEquipmentTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[109]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6263,7 +7816,7 @@ func (x *ListEquipmentTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEquipmentTypesResponse.ProtoReflect.Descriptor instead.
 func (*ListEquipmentTypesResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{109}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *ListEquipmentTypesResponse) GetEquipmentTypes() []*EquipmentType {
@@ -6297,7 +7850,7 @@ type UpdateEquipmentTypeRequest struct {
 
 func (x *UpdateEquipmentTypeRequest) Reset() {
 	*x = UpdateEquipmentTypeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[110]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6309,7 +7862,7 @@ func (x *UpdateEquipmentTypeRequest) String() string {
 func (*UpdateEquipmentTypeRequest) ProtoMessage() {}
 
 func (x *UpdateEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[110]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6322,7 +7875,7 @@ func (x *UpdateEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEquipmentTypeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEquipmentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{110}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *UpdateEquipmentTypeRequest) GetEquipmentType() *EquipmentType {
@@ -6348,7 +7901,7 @@ type DeleteEquipmentTypeRequest struct {
 
 func (x *DeleteEquipmentTypeRequest) Reset() {
 	*x = DeleteEquipmentTypeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[111]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6360,7 +7913,7 @@ func (x *DeleteEquipmentTypeRequest) String() string {
 func (*DeleteEquipmentTypeRequest) ProtoMessage() {}
 
 func (x *DeleteEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[111]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6373,7 +7926,7 @@ func (x *DeleteEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEquipmentTypeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEquipmentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{111}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *DeleteEquipmentTypeRequest) GetId() string {
@@ -6392,7 +7945,7 @@ type DeleteEquipmentTypeResponse struct {
 
 func (x *DeleteEquipmentTypeResponse) Reset() {
 	*x = DeleteEquipmentTypeResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[112]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6404,7 +7957,7 @@ func (x *DeleteEquipmentTypeResponse) String() string {
 func (*DeleteEquipmentTypeResponse) ProtoMessage() {}
 
 func (x *DeleteEquipmentTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[112]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6417,7 +7970,7 @@ func (x *DeleteEquipmentTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEquipmentTypeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEquipmentTypeResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{112}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *DeleteEquipmentTypeResponse) GetSuccess() bool {
@@ -6437,7 +7990,7 @@ type ResolveEquipmentTypeRequest struct {
 
 func (x *ResolveEquipmentTypeRequest) Reset() {
 	*x = ResolveEquipmentTypeRequest{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[113]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6449,7 +8002,7 @@ func (x *ResolveEquipmentTypeRequest) String() string {
 func (*ResolveEquipmentTypeRequest) ProtoMessage() {}
 
 func (x *ResolveEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[113]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6462,7 +8015,7 @@ func (x *ResolveEquipmentTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveEquipmentTypeRequest.ProtoReflect.Descriptor instead.
 func (*ResolveEquipmentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{113}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *ResolveEquipmentTypeRequest) GetLabels() map[string]string {
@@ -6484,7 +8037,7 @@ type ResolveEquipmentTypeResponse struct {
 
 func (x *ResolveEquipmentTypeResponse) Reset() {
 	*x = ResolveEquipmentTypeResponse{}
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[114]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6496,7 +8049,7 @@ func (x *ResolveEquipmentTypeResponse) String() string {
 func (*ResolveEquipmentTypeResponse) ProtoMessage() {}
 
 func (x *ResolveEquipmentTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[114]
+	mi := &file_alerting_routing_v1_routing_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6509,7 +8062,7 @@ func (x *ResolveEquipmentTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveEquipmentTypeResponse.ProtoReflect.Descriptor instead.
 func (*ResolveEquipmentTypeResponse) Descriptor() ([]byte, []int) {
-	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{114}
+	return file_alerting_routing_v1_routing_service_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *ResolveEquipmentTypeResponse) GetEquipmentType() *EquipmentType {
@@ -6544,7 +8097,7 @@ var File_alerting_routing_v1_routing_service_proto protoreflect.FileDescriptor
 
 const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\n" +
-	")alerting/routing/v1/routing_service.proto\x12\x13alerting.routing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a!alerting/routing/v1/routing.proto\"P\n" +
+	")alerting/routing/v1/routing_service.proto\x12\x13alerting.routing.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a!alerting/routing/v1/routing.proto\x1a\x17alerting/v1/alert.proto\"P\n" +
 	"\x18CreateRoutingRuleRequest\x124\n" +
 	"\x04rule\x18\x01 \x01(\v2 .alerting.routing.v1.RoutingRuleR\x04rule\"'\n" +
 	"\x15GetRoutingRuleRequest\x12\x0e\n" +
@@ -6576,7 +8129,10 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"d\n" +
 	"\x1bReorderRoutingRulesResponse\x12E\n" +
-	"\rupdated_rules\x18\x01 \x03(\v2 .alerting.routing.v1.RoutingRuleR\fupdatedRules\"\xce\x01\n" +
+	"\rupdated_rules\x18\x01 \x03(\v2 .alerting.routing.v1.RoutingRuleR\fupdatedRules\"\x1e\n" +
+	"\x1cCompactRulePrioritiesRequest\"W\n" +
+	"\x1dCompactRulePrioritiesResponse\x126\n" +
+	"\x05rules\x18\x01 \x03(\v2 .alerting.routing.v1.RoutingRuleR\x05rules\"\xce\x01\n" +
 	"\x16TestRoutingRuleRequest\x124\n" +
 	"\x04rule\x18\x01 \x01(\v2 .alerting.routing.v1.RoutingRuleR\x04rule\x12=\n" +
 	"\fsample_alert\x18\x02 \x01(\v2\x1a.alerting.routing.v1.AlertR\vsampleAlert\x12?\n" +
@@ -6586,7 +8142,26 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x11condition_results\x18\x02 \x03(\v2$.alerting.routing.v1.ConditionResultR\x10conditionResults\x12K\n" +
 	"\x0fmatched_actions\x18\x03 \x03(\v2\".alerting.routing.v1.RoutingActionR\x0ematchedActions\x124\n" +
 	"\x16time_condition_matched\x18\x04 \x01(\bR\x14timeConditionMatched\x122\n" +
-	"\x15time_condition_reason\x18\x05 \x01(\tR\x13timeConditionReason\"\xb6\x01\n" +
+	"\x15time_condition_reason\x18\x05 \x01(\tR\x13timeConditionReason\"P\n" +
+	"\x18DryRunRoutingRuleRequest\x124\n" +
+	"\x04rule\x18\x01 \x01(\v2 .alerting.routing.v1.RoutingRuleR\x04rule\"\xa5\x01\n" +
+	"\x19DryRunRoutingRuleResponse\x12#\n" +
+	"\rmatched_count\x18\x01 \x01(\x05R\fmatchedCount\x12'\n" +
+	"\x0funmatched_count\x18\x02 \x01(\x05R\x0eunmatchedCount\x12:\n" +
+	"\asamples\x18\x03 \x03(\v2 .alerting.routing.v1.DryRunMatchR\asamples\"\x97\x01\n" +
+	"\vDryRunMatch\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12S\n" +
+	"\x12matched_conditions\x18\x03 \x03(\v2$.alerting.routing.v1.ConditionResultR\x11matchedConditions\"5\n" +
+	"\x1aDetectRuleConflictsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"^\n" +
+	"\x1bDetectRuleConflictsResponse\x12?\n" +
+	"\tconflicts\x18\x01 \x03(\v2!.alerting.routing.v1.RuleConflictR\tconflicts\"\x82\x01\n" +
+	"\fRuleConflict\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xb6\x01\n" +
 	"\x16SimulateRoutingRequest\x120\n" +
 	"\x05alert\x18\x01 \x01(\v2\x1a.alerting.routing.v1.AlertR\x05alert\x12?\n" +
 	"\rsimulate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fsimulateTime\x12)\n" +
@@ -6620,7 +8195,7 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\n" +
 	"suppressed\x18\x05 \x01(\bR\n" +
 	"suppressed\x12-\n" +
-	"\x12suppression_reason\x18\x06 \x01(\tR\x11suppressionReason\"\xc5\x04\n" +
+	"\x12suppression_reason\x18\x06 \x01(\tR\x11suppressionReason\"\x89\x05\n" +
 	"\x05Alert\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\asummary\x18\x02 \x01(\tR\asummary\x12\x18\n" +
@@ -6634,15 +8209,19 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\n" +
-	" \x01(\tR\tserviceId\x1a9\n" +
+	" \x01(\tR\tserviceId\x12'\n" +
+	"\x0fintegration_key\x18\v \x01(\tR\x0eintegrationKey\x12\x19\n" +
+	"\bgroup_id\x18\f \x01(\tR\agroupId\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x01\n" +
 	"\x11CreateTeamRequest\x12-\n" +
-	"\x04team\x18\x01 \x01(\v2\x19.alerting.routing.v1.TeamR\x04team\" \n" +
+	"\x04team\x18\x01 \x01(\v2\x19.alerting.routing.v1.TeamR\x04team\x120\n" +
+	"\x14auto_create_schedule\x18\x02 \x01(\bR\x12autoCreateSchedule\x12)\n" +
+	"\x10default_timezone\x18\x03 \x01(\tR\x0fdefaultTimezone\" \n" +
 	"\x0eGetTeamRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x8c\x01\n" +
 	"\x10ListTeamsRequest\x12\x1b\n" +
@@ -6676,7 +8255,15 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\".\n" +
 	"\x13GetUserTeamsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"g\n" +
+	"\x1aSetUserAvailabilityRequest\x12I\n" +
+	"\favailability\x18\x01 \x01(\v2%.alerting.routing.v1.UserAvailabilityR\favailability\"\x97\x01\n" +
+	"\x1aGetTeamAvailabilityRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"l\n" +
+	"\x1bGetTeamAvailabilityResponse\x12M\n" +
+	"\x0eavailabilities\x18\x01 \x03(\v2%.alerting.routing.v1.UserAvailabilityR\x0eavailabilities\"R\n" +
 	"\x15CreateScheduleRequest\x129\n" +
 	"\bschedule\x18\x01 \x01(\v2\x1d.alerting.routing.v1.ScheduleR\bschedule\"$\n" +
 	"\x12GetScheduleRequest\x12\x0e\n" +
@@ -6713,18 +8300,45 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12\x1f\n" +
 	"\vrotation_id\x18\x02 \x01(\tR\n" +
-	"rotationId\"{\n" +
+	"rotationId\"\x8b\x01\n" +
+	"\x1dReorderRotationMembersRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1f\n" +
+	"\vrotation_id\x18\x02 \x01(\tR\n" +
+	"rotationId\x12(\n" +
+	"\x10ordered_user_ids\x18\x03 \x03(\tR\x0eorderedUserIds\"\xbc\x01\n" +
 	"\x15CreateOverrideRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12A\n" +
-	"\boverride\x18\x02 \x01(\v2%.alerting.routing.v1.ScheduleOverrideR\boverride\"Y\n" +
+	"\boverride\x18\x02 \x01(\v2%.alerting.routing.v1.ScheduleOverrideR\boverride\x12)\n" +
+	"\x10detect_conflicts\x18\x03 \x01(\bR\x0fdetectConflicts\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"S\n" +
+	"\x17OverrideConflictDetails\x128\n" +
+	"\x18conflicting_override_ids\x18\x01 \x03(\tR\x16conflictingOverrideIds\"\x82\x01\n" +
+	"\x1aBulkCreateOverridesRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12C\n" +
+	"\toverrides\x18\x02 \x03(\v2%.alerting.routing.v1.ScheduleOverrideR\toverrides\"b\n" +
+	"\x1bBulkCreateOverridesResponse\x12C\n" +
+	"\toverrides\x18\x01 \x03(\v2%.alerting.routing.v1.ScheduleOverrideR\toverrides\"Y\n" +
 	"\x15DeleteOverrideRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12\x1f\n" +
 	"\voverride_id\x18\x02 \x01(\tR\n" +
 	"overrideId\"2\n" +
 	"\x16DeleteOverrideResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe5\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb3\x01\n" +
+	"\x14SplitOverrideRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1f\n" +
+	"\voverride_id\x18\x02 \x01(\tR\n" +
+	"overrideId\x129\n" +
+	"\n" +
+	"split_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tsplitTime\x12\x1e\n" +
+	"\vnew_user_id\x18\x04 \x01(\tR\tnewUserId\"\x93\x01\n" +
+	"\x15SplitOverrideResponse\x12;\n" +
+	"\x05first\x18\x01 \x01(\v2%.alerting.routing.v1.ScheduleOverrideR\x05first\x12=\n" +
+	"\x06second\x18\x02 \x01(\v2%.alerting.routing.v1.ScheduleOverrideR\x06second\"\xe5\x01\n" +
 	"\x14ListOverridesRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x129\n" +
@@ -6763,7 +8377,38 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"page_token\x18\x05 \x01(\tR\tpageToken\"x\n" +
 	"\x1aListUpcomingShiftsResponse\x122\n" +
 	"\x06shifts\x18\x01 \x03(\v2\x1a.alerting.routing.v1.ShiftR\x06shifts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"U\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb5\x01\n" +
+	"\x16PreviewRotationRequest\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.alerting.routing.v1.ScheduleR\bschedule\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x9c\x01\n" +
+	"\x17GetCoverageDepthRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x87\x01\n" +
+	"\x12CoverageDepthEntry\x127\n" +
+	"\ttime_slot\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\btimeSlot\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x02 \x01(\x05R\tuserCount\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\tR\auserIds\"]\n" +
+	"\x18GetCoverageDepthResponse\x12A\n" +
+	"\aentries\x18\x01 \x03(\v2'.alerting.routing.v1.CoverageDepthEntryR\aentries\"\x9a\x01\n" +
+	"\x15GetLoadBalanceRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"q\n" +
+	"\bUserLoad\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vshift_hours\x18\x02 \x01(\x01R\n" +
+	"shiftHours\x12+\n" +
+	"\x11deviation_percent\x18\x03 \x01(\x01R\x10deviationPercent\"\xa0\x01\n" +
+	"\x16GetLoadBalanceResponse\x123\n" +
+	"\x05users\x18\x01 \x03(\v2\x1d.alerting.routing.v1.UserLoadR\x05users\x12\x1d\n" +
+	"\n" +
+	"mean_hours\x18\x02 \x01(\x01R\tmeanHours\x122\n" +
+	"\x15max_deviation_percent\x18\x03 \x01(\x01R\x13maxDeviationPercent\"U\n" +
 	"\x19AcknowledgeHandoffRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12\x17\n" +
@@ -6773,7 +8418,7 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x05shift\x18\x02 \x01(\v2\x1a.alerting.routing.v1.ShiftR\x05shift\";\n" +
 	"\x18GetHandoffSummaryRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
-	"scheduleId\"\xb2\x03\n" +
+	"scheduleId\"\xd5\x04\n" +
 	"\x0eHandoffSummary\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
 	"scheduleId\x12(\n" +
@@ -6783,7 +8428,10 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\ractive_alerts\x18\x05 \x03(\v2\x1a.alerting.routing.v1.AlertR\factiveAlerts\x12E\n" +
 	"\fopen_tickets\x18\x06 \x03(\v2\".alerting.routing.v1.TicketSummaryR\vopenTickets\x12?\n" +
 	"\rrecent_events\x18\a \x03(\v2\x1a.alerting.routing.v1.EventR\frecentEvents\x12#\n" +
-	"\rhandoff_notes\x18\b \x01(\tR\fhandoffNotes\"\xe1\x01\n" +
+	"\rhandoff_notes\x18\b \x01(\tR\fhandoffNotes\x12R\n" +
+	"\x14recent_handoff_notes\x18\t \x03(\v2 .alerting.routing.v1.HandoffNoteR\x12recentHandoffNotes\x12M\n" +
+	"\x15recent_alert_comments\x18\n" +
+	" \x03(\v2\x19.alerting.v1.AlertCommentR\x13recentAlertComments\"\xe1\x01\n" +
 	"\rTicketSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vexternal_id\x18\x02 \x01(\tR\n" +
@@ -6829,11 +8477,14 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x11DeleteSiteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
 	"\x12DeleteSiteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"`\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"v\n" +
+	"\x19UpdateSiteCapacityRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12@\n" +
+	"\bcapacity\x18\x02 \x01(\v2$.alerting.routing.v1.CapacityMetricsR\bcapacity\"`\n" +
 	"\x1eCreateMaintenanceWindowRequest\x12>\n" +
 	"\x06window\x18\x01 \x01(\v2&.alerting.routing.v1.MaintenanceWindowR\x06window\"-\n" +
 	"\x1bGetMaintenanceWindowRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb9\x03\n" +
 	"\x1dListMaintenanceWindowsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -6842,7 +8493,11 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12>\n" +
 	"\x06status\x18\x05 \x01(\x0e2&.alerting.routing.v1.MaintenanceStatusR\x06status\x12\x17\n" +
-	"\asite_id\x18\x06 \x01(\tR\x06siteId\"\xab\x01\n" +
+	"\asite_id\x18\x06 \x01(\tR\x06siteId\x12V\n" +
+	"\x06labels\x18\a \x03(\v2>.alerting.routing.v1.ListMaintenanceWindowsRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xab\x01\n" +
 	"\x1eListMaintenanceWindowsResponse\x12@\n" +
 	"\awindows\x18\x01 \x03(\v2&.alerting.routing.v1.MaintenanceWindowR\awindows\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -6855,7 +8510,16 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x1eDeleteMaintenanceWindowRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
 	"\x1fDeleteMaintenanceWindowResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"a\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc4\x01\n" +
+	"\x1eExpandMaintenanceWindowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10additional_sites\x18\x02 \x03(\tR\x0fadditionalSites\x12/\n" +
+	"\x13additional_services\x18\x03 \x03(\tR\x12additionalServices\x126\n" +
+	"\textend_by\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bextendBy\"R\n" +
+	"\x1fApproveMaintenanceWindowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vapproved_by\x18\x02 \x01(\tR\n" +
+	"approvedBy\"a\n" +
 	"#ListActiveMaintenanceWindowsRequest\x12\x19\n" +
 	"\bsite_ids\x18\x01 \x03(\tR\asiteIds\x12\x1f\n" +
 	"\vservice_ids\x18\x02 \x03(\tR\n" +
@@ -7029,19 +8693,22 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x1dESCALATION_STATE_ACKNOWLEDGED\x10\x02\x12\x1d\n" +
 	"\x19ESCALATION_STATE_RESOLVED\x10\x03\x12\x1e\n" +
 	"\x1aESCALATION_STATE_EXHAUSTED\x10\x04\x12\x1c\n" +
-	"\x18ESCALATION_STATE_STOPPED\x10\x052\xd0\b\n" +
+	"\x18ESCALATION_STATE_STOPPED\x10\x052\xbe\v\n" +
 	"\x0eRoutingService\x12d\n" +
 	"\x11CreateRoutingRule\x12-.alerting.routing.v1.CreateRoutingRuleRequest\x1a .alerting.routing.v1.RoutingRule\x12^\n" +
 	"\x0eGetRoutingRule\x12*.alerting.routing.v1.GetRoutingRuleRequest\x1a .alerting.routing.v1.RoutingRule\x12o\n" +
 	"\x10ListRoutingRules\x12,.alerting.routing.v1.ListRoutingRulesRequest\x1a-.alerting.routing.v1.ListRoutingRulesResponse\x12d\n" +
 	"\x11UpdateRoutingRule\x12-.alerting.routing.v1.UpdateRoutingRuleRequest\x1a .alerting.routing.v1.RoutingRule\x12r\n" +
 	"\x11DeleteRoutingRule\x12-.alerting.routing.v1.DeleteRoutingRuleRequest\x1a..alerting.routing.v1.DeleteRoutingRuleResponse\x12x\n" +
-	"\x13ReorderRoutingRules\x12/.alerting.routing.v1.ReorderRoutingRulesRequest\x1a0.alerting.routing.v1.ReorderRoutingRulesResponse\x12l\n" +
-	"\x0fTestRoutingRule\x12+.alerting.routing.v1.TestRoutingRuleRequest\x1a,.alerting.routing.v1.TestRoutingRuleResponse\x12l\n" +
+	"\x13ReorderRoutingRules\x12/.alerting.routing.v1.ReorderRoutingRulesRequest\x1a0.alerting.routing.v1.ReorderRoutingRulesResponse\x12~\n" +
+	"\x15CompactRulePriorities\x121.alerting.routing.v1.CompactRulePrioritiesRequest\x1a2.alerting.routing.v1.CompactRulePrioritiesResponse\x12l\n" +
+	"\x0fTestRoutingRule\x12+.alerting.routing.v1.TestRoutingRuleRequest\x1a,.alerting.routing.v1.TestRoutingRuleResponse\x12r\n" +
+	"\x11DryRunRoutingRule\x12-.alerting.routing.v1.DryRunRoutingRuleRequest\x1a..alerting.routing.v1.DryRunRoutingRuleResponse\x12x\n" +
+	"\x13DetectRuleConflicts\x12/.alerting.routing.v1.DetectRuleConflictsRequest\x1a0.alerting.routing.v1.DetectRuleConflictsResponse\x12l\n" +
 	"\x0fSimulateRouting\x12+.alerting.routing.v1.SimulateRoutingRequest\x1a,.alerting.routing.v1.SimulateRoutingResponse\x12x\n" +
 	"\x13GetRoutingAuditLogs\x12/.alerting.routing.v1.GetRoutingAuditLogsRequest\x1a0.alerting.routing.v1.GetRoutingAuditLogsResponse\x12]\n" +
 	"\n" +
-	"RouteAlert\x12&.alerting.routing.v1.RouteAlertRequest\x1a'.alerting.routing.v1.RouteAlertResponse2\xa8\x06\n" +
+	"RouteAlert\x12&.alerting.routing.v1.RouteAlertRequest\x1a'.alerting.routing.v1.RouteAlertResponse2\x91\b\n" +
 	"\vTeamService\x12O\n" +
 	"\n" +
 	"CreateTeam\x12&.alerting.routing.v1.CreateTeamRequest\x1a\x19.alerting.routing.v1.Team\x12I\n" +
@@ -7054,7 +8721,9 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\rAddTeamMember\x12).alerting.routing.v1.AddTeamMemberRequest\x1a\x19.alerting.routing.v1.Team\x12[\n" +
 	"\x10RemoveTeamMember\x12,.alerting.routing.v1.RemoveTeamMemberRequest\x1a\x19.alerting.routing.v1.Team\x12[\n" +
 	"\x10UpdateTeamMember\x12,.alerting.routing.v1.UpdateTeamMemberRequest\x1a\x19.alerting.routing.v1.Team\x12`\n" +
-	"\fGetUserTeams\x12(.alerting.routing.v1.GetUserTeamsRequest\x1a&.alerting.routing.v1.ListTeamsResponse2\xf4\f\n" +
+	"\fGetUserTeams\x12(.alerting.routing.v1.GetUserTeamsRequest\x1a&.alerting.routing.v1.ListTeamsResponse\x12m\n" +
+	"\x13SetUserAvailability\x12/.alerting.routing.v1.SetUserAvailabilityRequest\x1a%.alerting.routing.v1.UserAvailability\x12x\n" +
+	"\x13GetTeamAvailability\x12/.alerting.routing.v1.GetTeamAvailabilityRequest\x1a0.alerting.routing.v1.GetTeamAvailabilityResponse2\x90\x12\n" +
 	"\x0fScheduleService\x12[\n" +
 	"\x0eCreateSchedule\x12*.alerting.routing.v1.CreateScheduleRequest\x1a\x1d.alerting.routing.v1.Schedule\x12U\n" +
 	"\vGetSchedule\x12'.alerting.routing.v1.GetScheduleRequest\x1a\x1d.alerting.routing.v1.Schedule\x12f\n" +
@@ -7063,15 +8732,21 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x0eDeleteSchedule\x12*.alerting.routing.v1.DeleteScheduleRequest\x1a+.alerting.routing.v1.DeleteScheduleResponse\x12U\n" +
 	"\vAddRotation\x12'.alerting.routing.v1.AddRotationRequest\x1a\x1d.alerting.routing.v1.Schedule\x12[\n" +
 	"\x0eUpdateRotation\x12*.alerting.routing.v1.UpdateRotationRequest\x1a\x1d.alerting.routing.v1.Schedule\x12[\n" +
-	"\x0eRemoveRotation\x12*.alerting.routing.v1.RemoveRotationRequest\x1a\x1d.alerting.routing.v1.Schedule\x12c\n" +
-	"\x0eCreateOverride\x12*.alerting.routing.v1.CreateOverrideRequest\x1a%.alerting.routing.v1.ScheduleOverride\x12i\n" +
+	"\x0eRemoveRotation\x12*.alerting.routing.v1.RemoveRotationRequest\x1a\x1d.alerting.routing.v1.Schedule\x12k\n" +
+	"\x16ReorderRotationMembers\x122.alerting.routing.v1.ReorderRotationMembersRequest\x1a\x1d.alerting.routing.v1.Rotation\x12c\n" +
+	"\x0eCreateOverride\x12*.alerting.routing.v1.CreateOverrideRequest\x1a%.alerting.routing.v1.ScheduleOverride\x12x\n" +
+	"\x13BulkCreateOverrides\x12/.alerting.routing.v1.BulkCreateOverridesRequest\x1a0.alerting.routing.v1.BulkCreateOverridesResponse\x12i\n" +
 	"\x0eDeleteOverride\x12*.alerting.routing.v1.DeleteOverrideRequest\x1a+.alerting.routing.v1.DeleteOverrideResponse\x12f\n" +
+	"\rSplitOverride\x12).alerting.routing.v1.SplitOverrideRequest\x1a*.alerting.routing.v1.SplitOverrideResponse\x12f\n" +
 	"\rListOverrides\x12).alerting.routing.v1.ListOverridesRequest\x1a*.alerting.routing.v1.ListOverridesResponse\x12o\n" +
 	"\x10GetCurrentOnCall\x12,.alerting.routing.v1.GetCurrentOnCallRequest\x1a-.alerting.routing.v1.GetCurrentOnCallResponse\x12l\n" +
 	"\x0fGetOnCallAtTime\x12+.alerting.routing.v1.GetOnCallAtTimeRequest\x1a,.alerting.routing.v1.GetOnCallAtTimeResponse\x12u\n" +
-	"\x12ListUpcomingShifts\x12..alerting.routing.v1.ListUpcomingShiftsRequest\x1a/.alerting.routing.v1.ListUpcomingShiftsResponse\x12u\n" +
+	"\x12ListUpcomingShifts\x12..alerting.routing.v1.ListUpcomingShiftsRequest\x1a/.alerting.routing.v1.ListUpcomingShiftsResponse\x12o\n" +
+	"\x10GetCoverageDepth\x12,.alerting.routing.v1.GetCoverageDepthRequest\x1a-.alerting.routing.v1.GetCoverageDepthResponse\x12o\n" +
+	"\x0fPreviewRotation\x12+.alerting.routing.v1.PreviewRotationRequest\x1a/.alerting.routing.v1.ListUpcomingShiftsResponse\x12i\n" +
+	"\x0eGetLoadBalance\x12*.alerting.routing.v1.GetLoadBalanceRequest\x1a+.alerting.routing.v1.GetLoadBalanceResponse\x12u\n" +
 	"\x12AcknowledgeHandoff\x12..alerting.routing.v1.AcknowledgeHandoffRequest\x1a/.alerting.routing.v1.AcknowledgeHandoffResponse\x12g\n" +
-	"\x11GetHandoffSummary\x12-.alerting.routing.v1.GetHandoffSummaryRequest\x1a#.alerting.routing.v1.HandoffSummary2\x8c\x04\n" +
+	"\x11GetHandoffSummary\x12-.alerting.routing.v1.GetHandoffSummaryRequest\x1a#.alerting.routing.v1.HandoffSummary2\xed\x04\n" +
 	"\vSiteService\x12O\n" +
 	"\n" +
 	"CreateSite\x12&.alerting.routing.v1.CreateSiteRequest\x1a\x19.alerting.routing.v1.Site\x12I\n" +
@@ -7081,7 +8756,8 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"UpdateSite\x12&.alerting.routing.v1.UpdateSiteRequest\x1a\x19.alerting.routing.v1.Site\x12]\n" +
 	"\n" +
 	"DeleteSite\x12&.alerting.routing.v1.DeleteSiteRequest\x1a'.alerting.routing.v1.DeleteSiteResponse\x12U\n" +
-	"\rGetSiteByCode\x12).alerting.routing.v1.GetSiteByCodeRequest\x1a\x19.alerting.routing.v1.Site2\x91\a\n" +
+	"\rGetSiteByCode\x12).alerting.routing.v1.GetSiteByCodeRequest\x1a\x19.alerting.routing.v1.Site\x12_\n" +
+	"\x12UpdateSiteCapacity\x12..alerting.routing.v1.UpdateSiteCapacityRequest\x1a\x19.alerting.routing.v1.Site2\x83\t\n" +
 	"\x12MaintenanceService\x12v\n" +
 	"\x17CreateMaintenanceWindow\x123.alerting.routing.v1.CreateMaintenanceWindowRequest\x1a&.alerting.routing.v1.MaintenanceWindow\x12p\n" +
 	"\x14GetMaintenanceWindow\x120.alerting.routing.v1.GetMaintenanceWindowRequest\x1a&.alerting.routing.v1.MaintenanceWindow\x12\x81\x01\n" +
@@ -7089,7 +8765,9 @@ const file_alerting_routing_v1_routing_service_proto_rawDesc = "" +
 	"\x17UpdateMaintenanceWindow\x123.alerting.routing.v1.UpdateMaintenanceWindowRequest\x1a&.alerting.routing.v1.MaintenanceWindow\x12\x84\x01\n" +
 	"\x17DeleteMaintenanceWindow\x123.alerting.routing.v1.DeleteMaintenanceWindowRequest\x1a4.alerting.routing.v1.DeleteMaintenanceWindowResponse\x12\x8d\x01\n" +
 	"\x1cListActiveMaintenanceWindows\x128.alerting.routing.v1.ListActiveMaintenanceWindowsRequest\x1a3.alerting.routing.v1.ListMaintenanceWindowsResponse\x12~\n" +
-	"\x15CheckAlertMaintenance\x121.alerting.routing.v1.CheckAlertMaintenanceRequest\x1a2.alerting.routing.v1.CheckAlertMaintenanceResponse2\xbc\a\n" +
+	"\x15CheckAlertMaintenance\x121.alerting.routing.v1.CheckAlertMaintenanceRequest\x1a2.alerting.routing.v1.CheckAlertMaintenanceResponse\x12v\n" +
+	"\x17ExpandMaintenanceWindow\x123.alerting.routing.v1.ExpandMaintenanceWindowRequest\x1a&.alerting.routing.v1.MaintenanceWindow\x12x\n" +
+	"\x18ApproveMaintenanceWindow\x124.alerting.routing.v1.ApproveMaintenanceWindowRequest\x1a&.alerting.routing.v1.MaintenanceWindow2\xbc\a\n" +
 	"\x11EscalationService\x12s\n" +
 	"\x16CreateEscalationPolicy\x122.alerting.routing.v1.CreateEscalationPolicyRequest\x1a%.alerting.routing.v1.EscalationPolicy\x12m\n" +
 	"\x13GetEscalationPolicy\x12/.alerting.routing.v1.GetEscalationPolicyRequest\x1a%.alerting.routing.v1.EscalationPolicy\x12\x81\x01\n" +
@@ -7137,7 +8815,7 @@ func file_alerting_routing_v1_routing_service_proto_rawDescGZIP() []byte {
 }
 
 var file_alerting_routing_v1_routing_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_alerting_routing_v1_routing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
+var file_alerting_routing_v1_routing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 149)
 var file_alerting_routing_v1_routing_service_proto_goTypes = []any{
 	(AlertStatus)(0),                            // 0: alerting.routing.v1.AlertStatus
 	(AlertSource)(0),                            // 1: alerting.routing.v1.AlertSource
@@ -7151,398 +8829,488 @@ var file_alerting_routing_v1_routing_service_proto_goTypes = []any{
 	(*DeleteRoutingRuleResponse)(nil),           // 9: alerting.routing.v1.DeleteRoutingRuleResponse
 	(*ReorderRoutingRulesRequest)(nil),          // 10: alerting.routing.v1.ReorderRoutingRulesRequest
 	(*ReorderRoutingRulesResponse)(nil),         // 11: alerting.routing.v1.ReorderRoutingRulesResponse
-	(*TestRoutingRuleRequest)(nil),              // 12: alerting.routing.v1.TestRoutingRuleRequest
-	(*TestRoutingRuleResponse)(nil),             // 13: alerting.routing.v1.TestRoutingRuleResponse
-	(*SimulateRoutingRequest)(nil),              // 14: alerting.routing.v1.SimulateRoutingRequest
-	(*SimulateRoutingResponse)(nil),             // 15: alerting.routing.v1.SimulateRoutingResponse
-	(*GetRoutingAuditLogsRequest)(nil),          // 16: alerting.routing.v1.GetRoutingAuditLogsRequest
-	(*GetRoutingAuditLogsResponse)(nil),         // 17: alerting.routing.v1.GetRoutingAuditLogsResponse
-	(*RouteAlertRequest)(nil),                   // 18: alerting.routing.v1.RouteAlertRequest
-	(*RouteAlertResponse)(nil),                  // 19: alerting.routing.v1.RouteAlertResponse
-	(*Alert)(nil),                               // 20: alerting.routing.v1.Alert
-	(*CreateTeamRequest)(nil),                   // 21: alerting.routing.v1.CreateTeamRequest
-	(*GetTeamRequest)(nil),                      // 22: alerting.routing.v1.GetTeamRequest
-	(*ListTeamsRequest)(nil),                    // 23: alerting.routing.v1.ListTeamsRequest
-	(*ListTeamsResponse)(nil),                   // 24: alerting.routing.v1.ListTeamsResponse
-	(*UpdateTeamRequest)(nil),                   // 25: alerting.routing.v1.UpdateTeamRequest
-	(*DeleteTeamRequest)(nil),                   // 26: alerting.routing.v1.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),                  // 27: alerting.routing.v1.DeleteTeamResponse
-	(*AddTeamMemberRequest)(nil),                // 28: alerting.routing.v1.AddTeamMemberRequest
-	(*RemoveTeamMemberRequest)(nil),             // 29: alerting.routing.v1.RemoveTeamMemberRequest
-	(*UpdateTeamMemberRequest)(nil),             // 30: alerting.routing.v1.UpdateTeamMemberRequest
-	(*GetUserTeamsRequest)(nil),                 // 31: alerting.routing.v1.GetUserTeamsRequest
-	(*CreateScheduleRequest)(nil),               // 32: alerting.routing.v1.CreateScheduleRequest
-	(*GetScheduleRequest)(nil),                  // 33: alerting.routing.v1.GetScheduleRequest
-	(*ListSchedulesRequest)(nil),                // 34: alerting.routing.v1.ListSchedulesRequest
-	(*ListSchedulesResponse)(nil),               // 35: alerting.routing.v1.ListSchedulesResponse
-	(*UpdateScheduleRequest)(nil),               // 36: alerting.routing.v1.UpdateScheduleRequest
-	(*DeleteScheduleRequest)(nil),               // 37: alerting.routing.v1.DeleteScheduleRequest
-	(*DeleteScheduleResponse)(nil),              // 38: alerting.routing.v1.DeleteScheduleResponse
-	(*AddRotationRequest)(nil),                  // 39: alerting.routing.v1.AddRotationRequest
-	(*UpdateRotationRequest)(nil),               // 40: alerting.routing.v1.UpdateRotationRequest
-	(*RemoveRotationRequest)(nil),               // 41: alerting.routing.v1.RemoveRotationRequest
-	(*CreateOverrideRequest)(nil),               // 42: alerting.routing.v1.CreateOverrideRequest
-	(*DeleteOverrideRequest)(nil),               // 43: alerting.routing.v1.DeleteOverrideRequest
-	(*DeleteOverrideResponse)(nil),              // 44: alerting.routing.v1.DeleteOverrideResponse
-	(*ListOverridesRequest)(nil),                // 45: alerting.routing.v1.ListOverridesRequest
-	(*ListOverridesResponse)(nil),               // 46: alerting.routing.v1.ListOverridesResponse
-	(*GetCurrentOnCallRequest)(nil),             // 47: alerting.routing.v1.GetCurrentOnCallRequest
-	(*GetCurrentOnCallResponse)(nil),            // 48: alerting.routing.v1.GetCurrentOnCallResponse
-	(*GetOnCallAtTimeRequest)(nil),              // 49: alerting.routing.v1.GetOnCallAtTimeRequest
-	(*GetOnCallAtTimeResponse)(nil),             // 50: alerting.routing.v1.GetOnCallAtTimeResponse
-	(*ListUpcomingShiftsRequest)(nil),           // 51: alerting.routing.v1.ListUpcomingShiftsRequest
-	(*ListUpcomingShiftsResponse)(nil),          // 52: alerting.routing.v1.ListUpcomingShiftsResponse
-	(*AcknowledgeHandoffRequest)(nil),           // 53: alerting.routing.v1.AcknowledgeHandoffRequest
-	(*AcknowledgeHandoffResponse)(nil),          // 54: alerting.routing.v1.AcknowledgeHandoffResponse
-	(*GetHandoffSummaryRequest)(nil),            // 55: alerting.routing.v1.GetHandoffSummaryRequest
-	(*HandoffSummary)(nil),                      // 56: alerting.routing.v1.HandoffSummary
-	(*TicketSummary)(nil),                       // 57: alerting.routing.v1.TicketSummary
-	(*Event)(nil),                               // 58: alerting.routing.v1.Event
-	(*CreateSiteRequest)(nil),                   // 59: alerting.routing.v1.CreateSiteRequest
-	(*GetSiteRequest)(nil),                      // 60: alerting.routing.v1.GetSiteRequest
-	(*GetSiteByCodeRequest)(nil),                // 61: alerting.routing.v1.GetSiteByCodeRequest
-	(*ListSitesRequest)(nil),                    // 62: alerting.routing.v1.ListSitesRequest
-	(*ListSitesResponse)(nil),                   // 63: alerting.routing.v1.ListSitesResponse
-	(*UpdateSiteRequest)(nil),                   // 64: alerting.routing.v1.UpdateSiteRequest
-	(*DeleteSiteRequest)(nil),                   // 65: alerting.routing.v1.DeleteSiteRequest
-	(*DeleteSiteResponse)(nil),                  // 66: alerting.routing.v1.DeleteSiteResponse
-	(*CreateMaintenanceWindowRequest)(nil),      // 67: alerting.routing.v1.CreateMaintenanceWindowRequest
-	(*GetMaintenanceWindowRequest)(nil),         // 68: alerting.routing.v1.GetMaintenanceWindowRequest
-	(*ListMaintenanceWindowsRequest)(nil),       // 69: alerting.routing.v1.ListMaintenanceWindowsRequest
-	(*ListMaintenanceWindowsResponse)(nil),      // 70: alerting.routing.v1.ListMaintenanceWindowsResponse
-	(*UpdateMaintenanceWindowRequest)(nil),      // 71: alerting.routing.v1.UpdateMaintenanceWindowRequest
-	(*DeleteMaintenanceWindowRequest)(nil),      // 72: alerting.routing.v1.DeleteMaintenanceWindowRequest
-	(*DeleteMaintenanceWindowResponse)(nil),     // 73: alerting.routing.v1.DeleteMaintenanceWindowResponse
-	(*ListActiveMaintenanceWindowsRequest)(nil), // 74: alerting.routing.v1.ListActiveMaintenanceWindowsRequest
-	(*CheckAlertMaintenanceRequest)(nil),        // 75: alerting.routing.v1.CheckAlertMaintenanceRequest
-	(*CheckAlertMaintenanceResponse)(nil),       // 76: alerting.routing.v1.CheckAlertMaintenanceResponse
-	(*CreateEscalationPolicyRequest)(nil),       // 77: alerting.routing.v1.CreateEscalationPolicyRequest
-	(*GetEscalationPolicyRequest)(nil),          // 78: alerting.routing.v1.GetEscalationPolicyRequest
-	(*ListEscalationPoliciesRequest)(nil),       // 79: alerting.routing.v1.ListEscalationPoliciesRequest
-	(*ListEscalationPoliciesResponse)(nil),      // 80: alerting.routing.v1.ListEscalationPoliciesResponse
-	(*UpdateEscalationPolicyRequest)(nil),       // 81: alerting.routing.v1.UpdateEscalationPolicyRequest
-	(*DeleteEscalationPolicyRequest)(nil),       // 82: alerting.routing.v1.DeleteEscalationPolicyRequest
-	(*DeleteEscalationPolicyResponse)(nil),      // 83: alerting.routing.v1.DeleteEscalationPolicyResponse
-	(*StartEscalationRequest)(nil),              // 84: alerting.routing.v1.StartEscalationRequest
-	(*StartEscalationResponse)(nil),             // 85: alerting.routing.v1.StartEscalationResponse
-	(*GetEscalationStatusRequest)(nil),          // 86: alerting.routing.v1.GetEscalationStatusRequest
-	(*EscalationStatus)(nil),                    // 87: alerting.routing.v1.EscalationStatus
-	(*EscalationStepResult)(nil),                // 88: alerting.routing.v1.EscalationStepResult
-	(*StopEscalationRequest)(nil),               // 89: alerting.routing.v1.StopEscalationRequest
-	(*StopEscalationResponse)(nil),              // 90: alerting.routing.v1.StopEscalationResponse
-	(*CreateCustomerTierRequest)(nil),           // 91: alerting.routing.v1.CreateCustomerTierRequest
-	(*GetCustomerTierRequest)(nil),              // 92: alerting.routing.v1.GetCustomerTierRequest
-	(*ListCustomerTiersRequest)(nil),            // 93: alerting.routing.v1.ListCustomerTiersRequest
-	(*ListCustomerTiersResponse)(nil),           // 94: alerting.routing.v1.ListCustomerTiersResponse
-	(*UpdateCustomerTierRequest)(nil),           // 95: alerting.routing.v1.UpdateCustomerTierRequest
-	(*DeleteCustomerTierRequest)(nil),           // 96: alerting.routing.v1.DeleteCustomerTierRequest
-	(*DeleteCustomerTierResponse)(nil),          // 97: alerting.routing.v1.DeleteCustomerTierResponse
-	(*ResolveCustomerTierRequest)(nil),          // 98: alerting.routing.v1.ResolveCustomerTierRequest
-	(*ResolveCustomerTierResponse)(nil),         // 99: alerting.routing.v1.ResolveCustomerTierResponse
-	(*CreateCarrierRequest)(nil),                // 100: alerting.routing.v1.CreateCarrierRequest
-	(*GetCarrierRequest)(nil),                   // 101: alerting.routing.v1.GetCarrierRequest
-	(*GetCarrierByASNRequest)(nil),              // 102: alerting.routing.v1.GetCarrierByASNRequest
-	(*ListCarriersRequest)(nil),                 // 103: alerting.routing.v1.ListCarriersRequest
-	(*ListCarriersResponse)(nil),                // 104: alerting.routing.v1.ListCarriersResponse
-	(*UpdateCarrierRequest)(nil),                // 105: alerting.routing.v1.UpdateCarrierRequest
-	(*DeleteCarrierRequest)(nil),                // 106: alerting.routing.v1.DeleteCarrierRequest
-	(*DeleteCarrierResponse)(nil),               // 107: alerting.routing.v1.DeleteCarrierResponse
-	(*CreateEquipmentTypeRequest)(nil),          // 108: alerting.routing.v1.CreateEquipmentTypeRequest
-	(*GetEquipmentTypeRequest)(nil),             // 109: alerting.routing.v1.GetEquipmentTypeRequest
-	(*GetEquipmentTypeByNameRequest)(nil),       // 110: alerting.routing.v1.GetEquipmentTypeByNameRequest
-	(*ListEquipmentTypesRequest)(nil),           // 111: alerting.routing.v1.ListEquipmentTypesRequest
-	(*ListEquipmentTypesResponse)(nil),          // 112: alerting.routing.v1.ListEquipmentTypesResponse
-	(*UpdateEquipmentTypeRequest)(nil),          // 113: alerting.routing.v1.UpdateEquipmentTypeRequest
-	(*DeleteEquipmentTypeRequest)(nil),          // 114: alerting.routing.v1.DeleteEquipmentTypeRequest
-	(*DeleteEquipmentTypeResponse)(nil),         // 115: alerting.routing.v1.DeleteEquipmentTypeResponse
-	(*ResolveEquipmentTypeRequest)(nil),         // 116: alerting.routing.v1.ResolveEquipmentTypeRequest
-	(*ResolveEquipmentTypeResponse)(nil),        // 117: alerting.routing.v1.ResolveEquipmentTypeResponse
-	nil,                                         // 118: alerting.routing.v1.ReorderRoutingRulesRequest.RulePrioritiesEntry
-	nil,                                         // 119: alerting.routing.v1.Alert.LabelsEntry
-	nil,                                         // 120: alerting.routing.v1.Alert.AnnotationsEntry
-	nil,                                         // 121: alerting.routing.v1.Event.MetadataEntry
-	nil,                                         // 122: alerting.routing.v1.ResolveCustomerTierRequest.LabelsEntry
-	nil,                                         // 123: alerting.routing.v1.ResolveEquipmentTypeRequest.LabelsEntry
-	(*RoutingRule)(nil),                         // 124: alerting.routing.v1.RoutingRule
-	(*fieldmaskpb.FieldMask)(nil),               // 125: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),               // 126: google.protobuf.Timestamp
-	(*ConditionResult)(nil),                     // 127: alerting.routing.v1.ConditionResult
-	(*RoutingAction)(nil),                       // 128: alerting.routing.v1.RoutingAction
-	(*RuleEvaluation)(nil),                      // 129: alerting.routing.v1.RuleEvaluation
-	(*ActionExecution)(nil),                     // 130: alerting.routing.v1.ActionExecution
-	(*MaintenanceResult)(nil),                   // 131: alerting.routing.v1.MaintenanceResult
-	(*RoutingAuditLog)(nil),                     // 132: alerting.routing.v1.RoutingAuditLog
-	(*Team)(nil),                                // 133: alerting.routing.v1.Team
-	(*TeamMember)(nil),                          // 134: alerting.routing.v1.TeamMember
-	(*Schedule)(nil),                            // 135: alerting.routing.v1.Schedule
-	(*Rotation)(nil),                            // 136: alerting.routing.v1.Rotation
-	(*ScheduleOverride)(nil),                    // 137: alerting.routing.v1.ScheduleOverride
-	(*Shift)(nil),                               // 138: alerting.routing.v1.Shift
-	(*Site)(nil),                                // 139: alerting.routing.v1.Site
-	(SiteType)(0),                               // 140: alerting.routing.v1.SiteType
-	(*MaintenanceWindow)(nil),                   // 141: alerting.routing.v1.MaintenanceWindow
-	(MaintenanceStatus)(0),                      // 142: alerting.routing.v1.MaintenanceStatus
-	(MaintenanceAction)(0),                      // 143: alerting.routing.v1.MaintenanceAction
-	(*EscalationPolicy)(nil),                    // 144: alerting.routing.v1.EscalationPolicy
-	(*CustomerTier)(nil),                        // 145: alerting.routing.v1.CustomerTier
-	(*CarrierConfig)(nil),                       // 146: alerting.routing.v1.CarrierConfig
-	(*EquipmentType)(nil),                       // 147: alerting.routing.v1.EquipmentType
+	(*CompactRulePrioritiesRequest)(nil),        // 12: alerting.routing.v1.CompactRulePrioritiesRequest
+	(*CompactRulePrioritiesResponse)(nil),       // 13: alerting.routing.v1.CompactRulePrioritiesResponse
+	(*TestRoutingRuleRequest)(nil),              // 14: alerting.routing.v1.TestRoutingRuleRequest
+	(*TestRoutingRuleResponse)(nil),             // 15: alerting.routing.v1.TestRoutingRuleResponse
+	(*DryRunRoutingRuleRequest)(nil),            // 16: alerting.routing.v1.DryRunRoutingRuleRequest
+	(*DryRunRoutingRuleResponse)(nil),           // 17: alerting.routing.v1.DryRunRoutingRuleResponse
+	(*DryRunMatch)(nil),                         // 18: alerting.routing.v1.DryRunMatch
+	(*DetectRuleConflictsRequest)(nil),          // 19: alerting.routing.v1.DetectRuleConflictsRequest
+	(*DetectRuleConflictsResponse)(nil),         // 20: alerting.routing.v1.DetectRuleConflictsResponse
+	(*RuleConflict)(nil),                        // 21: alerting.routing.v1.RuleConflict
+	(*SimulateRoutingRequest)(nil),              // 22: alerting.routing.v1.SimulateRoutingRequest
+	(*SimulateRoutingResponse)(nil),             // 23: alerting.routing.v1.SimulateRoutingResponse
+	(*GetRoutingAuditLogsRequest)(nil),          // 24: alerting.routing.v1.GetRoutingAuditLogsRequest
+	(*GetRoutingAuditLogsResponse)(nil),         // 25: alerting.routing.v1.GetRoutingAuditLogsResponse
+	(*RouteAlertRequest)(nil),                   // 26: alerting.routing.v1.RouteAlertRequest
+	(*RouteAlertResponse)(nil),                  // 27: alerting.routing.v1.RouteAlertResponse
+	(*Alert)(nil),                               // 28: alerting.routing.v1.Alert
+	(*CreateTeamRequest)(nil),                   // 29: alerting.routing.v1.CreateTeamRequest
+	(*GetTeamRequest)(nil),                      // 30: alerting.routing.v1.GetTeamRequest
+	(*ListTeamsRequest)(nil),                    // 31: alerting.routing.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),                   // 32: alerting.routing.v1.ListTeamsResponse
+	(*UpdateTeamRequest)(nil),                   // 33: alerting.routing.v1.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),                   // 34: alerting.routing.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                  // 35: alerting.routing.v1.DeleteTeamResponse
+	(*AddTeamMemberRequest)(nil),                // 36: alerting.routing.v1.AddTeamMemberRequest
+	(*RemoveTeamMemberRequest)(nil),             // 37: alerting.routing.v1.RemoveTeamMemberRequest
+	(*UpdateTeamMemberRequest)(nil),             // 38: alerting.routing.v1.UpdateTeamMemberRequest
+	(*GetUserTeamsRequest)(nil),                 // 39: alerting.routing.v1.GetUserTeamsRequest
+	(*SetUserAvailabilityRequest)(nil),          // 40: alerting.routing.v1.SetUserAvailabilityRequest
+	(*GetTeamAvailabilityRequest)(nil),          // 41: alerting.routing.v1.GetTeamAvailabilityRequest
+	(*GetTeamAvailabilityResponse)(nil),         // 42: alerting.routing.v1.GetTeamAvailabilityResponse
+	(*CreateScheduleRequest)(nil),               // 43: alerting.routing.v1.CreateScheduleRequest
+	(*GetScheduleRequest)(nil),                  // 44: alerting.routing.v1.GetScheduleRequest
+	(*ListSchedulesRequest)(nil),                // 45: alerting.routing.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),               // 46: alerting.routing.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil),               // 47: alerting.routing.v1.UpdateScheduleRequest
+	(*DeleteScheduleRequest)(nil),               // 48: alerting.routing.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),              // 49: alerting.routing.v1.DeleteScheduleResponse
+	(*AddRotationRequest)(nil),                  // 50: alerting.routing.v1.AddRotationRequest
+	(*UpdateRotationRequest)(nil),               // 51: alerting.routing.v1.UpdateRotationRequest
+	(*RemoveRotationRequest)(nil),               // 52: alerting.routing.v1.RemoveRotationRequest
+	(*ReorderRotationMembersRequest)(nil),       // 53: alerting.routing.v1.ReorderRotationMembersRequest
+	(*CreateOverrideRequest)(nil),               // 54: alerting.routing.v1.CreateOverrideRequest
+	(*OverrideConflictDetails)(nil),             // 55: alerting.routing.v1.OverrideConflictDetails
+	(*BulkCreateOverridesRequest)(nil),          // 56: alerting.routing.v1.BulkCreateOverridesRequest
+	(*BulkCreateOverridesResponse)(nil),         // 57: alerting.routing.v1.BulkCreateOverridesResponse
+	(*DeleteOverrideRequest)(nil),               // 58: alerting.routing.v1.DeleteOverrideRequest
+	(*DeleteOverrideResponse)(nil),              // 59: alerting.routing.v1.DeleteOverrideResponse
+	(*SplitOverrideRequest)(nil),                // 60: alerting.routing.v1.SplitOverrideRequest
+	(*SplitOverrideResponse)(nil),               // 61: alerting.routing.v1.SplitOverrideResponse
+	(*ListOverridesRequest)(nil),                // 62: alerting.routing.v1.ListOverridesRequest
+	(*ListOverridesResponse)(nil),               // 63: alerting.routing.v1.ListOverridesResponse
+	(*GetCurrentOnCallRequest)(nil),             // 64: alerting.routing.v1.GetCurrentOnCallRequest
+	(*GetCurrentOnCallResponse)(nil),            // 65: alerting.routing.v1.GetCurrentOnCallResponse
+	(*GetOnCallAtTimeRequest)(nil),              // 66: alerting.routing.v1.GetOnCallAtTimeRequest
+	(*GetOnCallAtTimeResponse)(nil),             // 67: alerting.routing.v1.GetOnCallAtTimeResponse
+	(*ListUpcomingShiftsRequest)(nil),           // 68: alerting.routing.v1.ListUpcomingShiftsRequest
+	(*ListUpcomingShiftsResponse)(nil),          // 69: alerting.routing.v1.ListUpcomingShiftsResponse
+	(*PreviewRotationRequest)(nil),              // 70: alerting.routing.v1.PreviewRotationRequest
+	(*GetCoverageDepthRequest)(nil),             // 71: alerting.routing.v1.GetCoverageDepthRequest
+	(*CoverageDepthEntry)(nil),                  // 72: alerting.routing.v1.CoverageDepthEntry
+	(*GetCoverageDepthResponse)(nil),            // 73: alerting.routing.v1.GetCoverageDepthResponse
+	(*GetLoadBalanceRequest)(nil),               // 74: alerting.routing.v1.GetLoadBalanceRequest
+	(*UserLoad)(nil),                            // 75: alerting.routing.v1.UserLoad
+	(*GetLoadBalanceResponse)(nil),              // 76: alerting.routing.v1.GetLoadBalanceResponse
+	(*AcknowledgeHandoffRequest)(nil),           // 77: alerting.routing.v1.AcknowledgeHandoffRequest
+	(*AcknowledgeHandoffResponse)(nil),          // 78: alerting.routing.v1.AcknowledgeHandoffResponse
+	(*GetHandoffSummaryRequest)(nil),            // 79: alerting.routing.v1.GetHandoffSummaryRequest
+	(*HandoffSummary)(nil),                      // 80: alerting.routing.v1.HandoffSummary
+	(*TicketSummary)(nil),                       // 81: alerting.routing.v1.TicketSummary
+	(*Event)(nil),                               // 82: alerting.routing.v1.Event
+	(*CreateSiteRequest)(nil),                   // 83: alerting.routing.v1.CreateSiteRequest
+	(*GetSiteRequest)(nil),                      // 84: alerting.routing.v1.GetSiteRequest
+	(*GetSiteByCodeRequest)(nil),                // 85: alerting.routing.v1.GetSiteByCodeRequest
+	(*ListSitesRequest)(nil),                    // 86: alerting.routing.v1.ListSitesRequest
+	(*ListSitesResponse)(nil),                   // 87: alerting.routing.v1.ListSitesResponse
+	(*UpdateSiteRequest)(nil),                   // 88: alerting.routing.v1.UpdateSiteRequest
+	(*DeleteSiteRequest)(nil),                   // 89: alerting.routing.v1.DeleteSiteRequest
+	(*DeleteSiteResponse)(nil),                  // 90: alerting.routing.v1.DeleteSiteResponse
+	(*UpdateSiteCapacityRequest)(nil),           // 91: alerting.routing.v1.UpdateSiteCapacityRequest
+	(*CreateMaintenanceWindowRequest)(nil),      // 92: alerting.routing.v1.CreateMaintenanceWindowRequest
+	(*GetMaintenanceWindowRequest)(nil),         // 93: alerting.routing.v1.GetMaintenanceWindowRequest
+	(*ListMaintenanceWindowsRequest)(nil),       // 94: alerting.routing.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),      // 95: alerting.routing.v1.ListMaintenanceWindowsResponse
+	(*UpdateMaintenanceWindowRequest)(nil),      // 96: alerting.routing.v1.UpdateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),      // 97: alerting.routing.v1.DeleteMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowResponse)(nil),     // 98: alerting.routing.v1.DeleteMaintenanceWindowResponse
+	(*ExpandMaintenanceWindowRequest)(nil),      // 99: alerting.routing.v1.ExpandMaintenanceWindowRequest
+	(*ApproveMaintenanceWindowRequest)(nil),     // 100: alerting.routing.v1.ApproveMaintenanceWindowRequest
+	(*ListActiveMaintenanceWindowsRequest)(nil), // 101: alerting.routing.v1.ListActiveMaintenanceWindowsRequest
+	(*CheckAlertMaintenanceRequest)(nil),        // 102: alerting.routing.v1.CheckAlertMaintenanceRequest
+	(*CheckAlertMaintenanceResponse)(nil),       // 103: alerting.routing.v1.CheckAlertMaintenanceResponse
+	(*CreateEscalationPolicyRequest)(nil),       // 104: alerting.routing.v1.CreateEscalationPolicyRequest
+	(*GetEscalationPolicyRequest)(nil),          // 105: alerting.routing.v1.GetEscalationPolicyRequest
+	(*ListEscalationPoliciesRequest)(nil),       // 106: alerting.routing.v1.ListEscalationPoliciesRequest
+	(*ListEscalationPoliciesResponse)(nil),      // 107: alerting.routing.v1.ListEscalationPoliciesResponse
+	(*UpdateEscalationPolicyRequest)(nil),       // 108: alerting.routing.v1.UpdateEscalationPolicyRequest
+	(*DeleteEscalationPolicyRequest)(nil),       // 109: alerting.routing.v1.DeleteEscalationPolicyRequest
+	(*DeleteEscalationPolicyResponse)(nil),      // 110: alerting.routing.v1.DeleteEscalationPolicyResponse
+	(*StartEscalationRequest)(nil),              // 111: alerting.routing.v1.StartEscalationRequest
+	(*StartEscalationResponse)(nil),             // 112: alerting.routing.v1.StartEscalationResponse
+	(*GetEscalationStatusRequest)(nil),          // 113: alerting.routing.v1.GetEscalationStatusRequest
+	(*EscalationStatus)(nil),                    // 114: alerting.routing.v1.EscalationStatus
+	(*EscalationStepResult)(nil),                // 115: alerting.routing.v1.EscalationStepResult
+	(*StopEscalationRequest)(nil),               // 116: alerting.routing.v1.StopEscalationRequest
+	(*StopEscalationResponse)(nil),              // 117: alerting.routing.v1.StopEscalationResponse
+	(*CreateCustomerTierRequest)(nil),           // 118: alerting.routing.v1.CreateCustomerTierRequest
+	(*GetCustomerTierRequest)(nil),              // 119: alerting.routing.v1.GetCustomerTierRequest
+	(*ListCustomerTiersRequest)(nil),            // 120: alerting.routing.v1.ListCustomerTiersRequest
+	(*ListCustomerTiersResponse)(nil),           // 121: alerting.routing.v1.ListCustomerTiersResponse
+	(*UpdateCustomerTierRequest)(nil),           // 122: alerting.routing.v1.UpdateCustomerTierRequest
+	(*DeleteCustomerTierRequest)(nil),           // 123: alerting.routing.v1.DeleteCustomerTierRequest
+	(*DeleteCustomerTierResponse)(nil),          // 124: alerting.routing.v1.DeleteCustomerTierResponse
+	(*ResolveCustomerTierRequest)(nil),          // 125: alerting.routing.v1.ResolveCustomerTierRequest
+	(*ResolveCustomerTierResponse)(nil),         // 126: alerting.routing.v1.ResolveCustomerTierResponse
+	(*CreateCarrierRequest)(nil),                // 127: alerting.routing.v1.CreateCarrierRequest
+	(*GetCarrierRequest)(nil),                   // 128: alerting.routing.v1.GetCarrierRequest
+	(*GetCarrierByASNRequest)(nil),              // 129: alerting.routing.v1.GetCarrierByASNRequest
+	(*ListCarriersRequest)(nil),                 // 130: alerting.routing.v1.ListCarriersRequest
+	(*ListCarriersResponse)(nil),                // 131: alerting.routing.v1.ListCarriersResponse
+	(*UpdateCarrierRequest)(nil),                // 132: alerting.routing.v1.UpdateCarrierRequest
+	(*DeleteCarrierRequest)(nil),                // 133: alerting.routing.v1.DeleteCarrierRequest
+	(*DeleteCarrierResponse)(nil),               // 134: alerting.routing.v1.DeleteCarrierResponse
+	(*CreateEquipmentTypeRequest)(nil),          // 135: alerting.routing.v1.CreateEquipmentTypeRequest
+	(*GetEquipmentTypeRequest)(nil),             // 136: alerting.routing.v1.GetEquipmentTypeRequest
+	(*GetEquipmentTypeByNameRequest)(nil),       // 137: alerting.routing.v1.GetEquipmentTypeByNameRequest
+	(*ListEquipmentTypesRequest)(nil),           // 138: alerting.routing.v1.ListEquipmentTypesRequest
+	(*ListEquipmentTypesResponse)(nil),          // 139: alerting.routing.v1.ListEquipmentTypesResponse
+	(*UpdateEquipmentTypeRequest)(nil),          // 140: alerting.routing.v1.UpdateEquipmentTypeRequest
+	(*DeleteEquipmentTypeRequest)(nil),          // 141: alerting.routing.v1.DeleteEquipmentTypeRequest
+	(*DeleteEquipmentTypeResponse)(nil),         // 142: alerting.routing.v1.DeleteEquipmentTypeResponse
+	(*ResolveEquipmentTypeRequest)(nil),         // 143: alerting.routing.v1.ResolveEquipmentTypeRequest
+	(*ResolveEquipmentTypeResponse)(nil),        // 144: alerting.routing.v1.ResolveEquipmentTypeResponse
+	nil,                                         // 145: alerting.routing.v1.ReorderRoutingRulesRequest.RulePrioritiesEntry
+	nil,                                         // 146: alerting.routing.v1.Alert.LabelsEntry
+	nil,                                         // 147: alerting.routing.v1.Alert.AnnotationsEntry
+	nil,                                         // 148: alerting.routing.v1.Event.MetadataEntry
+	nil,                                         // 149: alerting.routing.v1.ListMaintenanceWindowsRequest.LabelsEntry
+	nil,                                         // 150: alerting.routing.v1.ResolveCustomerTierRequest.LabelsEntry
+	nil,                                         // 151: alerting.routing.v1.ResolveEquipmentTypeRequest.LabelsEntry
+	(*RoutingRule)(nil),                         // 152: alerting.routing.v1.RoutingRule
+	(*fieldmaskpb.FieldMask)(nil),               // 153: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),               // 154: google.protobuf.Timestamp
+	(*ConditionResult)(nil),                     // 155: alerting.routing.v1.ConditionResult
+	(*RoutingAction)(nil),                       // 156: alerting.routing.v1.RoutingAction
+	(*RuleEvaluation)(nil),                      // 157: alerting.routing.v1.RuleEvaluation
+	(*ActionExecution)(nil),                     // 158: alerting.routing.v1.ActionExecution
+	(*MaintenanceResult)(nil),                   // 159: alerting.routing.v1.MaintenanceResult
+	(*RoutingAuditLog)(nil),                     // 160: alerting.routing.v1.RoutingAuditLog
+	(*Team)(nil),                                // 161: alerting.routing.v1.Team
+	(*TeamMember)(nil),                          // 162: alerting.routing.v1.TeamMember
+	(*UserAvailability)(nil),                    // 163: alerting.routing.v1.UserAvailability
+	(*Schedule)(nil),                            // 164: alerting.routing.v1.Schedule
+	(*Rotation)(nil),                            // 165: alerting.routing.v1.Rotation
+	(*ScheduleOverride)(nil),                    // 166: alerting.routing.v1.ScheduleOverride
+	(*Shift)(nil),                               // 167: alerting.routing.v1.Shift
+	(*HandoffNote)(nil),                         // 168: alerting.routing.v1.HandoffNote
+	(*v1.AlertComment)(nil),                     // 169: alerting.v1.AlertComment
+	(*Site)(nil),                                // 170: alerting.routing.v1.Site
+	(SiteType)(0),                               // 171: alerting.routing.v1.SiteType
+	(*CapacityMetrics)(nil),                     // 172: alerting.routing.v1.CapacityMetrics
+	(*MaintenanceWindow)(nil),                   // 173: alerting.routing.v1.MaintenanceWindow
+	(MaintenanceStatus)(0),                      // 174: alerting.routing.v1.MaintenanceStatus
+	(*durationpb.Duration)(nil),                 // 175: google.protobuf.Duration
+	(MaintenanceAction)(0),                      // 176: alerting.routing.v1.MaintenanceAction
+	(*EscalationPolicy)(nil),                    // 177: alerting.routing.v1.EscalationPolicy
+	(*CustomerTier)(nil),                        // 178: alerting.routing.v1.CustomerTier
+	(*CarrierConfig)(nil),                       // 179: alerting.routing.v1.CarrierConfig
+	(*EquipmentType)(nil),                       // 180: alerting.routing.v1.EquipmentType
 }
 var file_alerting_routing_v1_routing_service_proto_depIdxs = []int32{
-	124, // 0: alerting.routing.v1.CreateRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
-	124, // 1: alerting.routing.v1.ListRoutingRulesResponse.rules:type_name -> alerting.routing.v1.RoutingRule
-	124, // 2: alerting.routing.v1.UpdateRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
-	125, // 3: alerting.routing.v1.UpdateRoutingRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	118, // 4: alerting.routing.v1.ReorderRoutingRulesRequest.rule_priorities:type_name -> alerting.routing.v1.ReorderRoutingRulesRequest.RulePrioritiesEntry
-	124, // 5: alerting.routing.v1.ReorderRoutingRulesResponse.updated_rules:type_name -> alerting.routing.v1.RoutingRule
-	124, // 6: alerting.routing.v1.TestRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
-	20,  // 7: alerting.routing.v1.TestRoutingRuleRequest.sample_alert:type_name -> alerting.routing.v1.Alert
-	126, // 8: alerting.routing.v1.TestRoutingRuleRequest.simulate_time:type_name -> google.protobuf.Timestamp
-	127, // 9: alerting.routing.v1.TestRoutingRuleResponse.condition_results:type_name -> alerting.routing.v1.ConditionResult
-	128, // 10: alerting.routing.v1.TestRoutingRuleResponse.matched_actions:type_name -> alerting.routing.v1.RoutingAction
-	20,  // 11: alerting.routing.v1.SimulateRoutingRequest.alert:type_name -> alerting.routing.v1.Alert
-	126, // 12: alerting.routing.v1.SimulateRoutingRequest.simulate_time:type_name -> google.protobuf.Timestamp
-	129, // 13: alerting.routing.v1.SimulateRoutingResponse.evaluations:type_name -> alerting.routing.v1.RuleEvaluation
-	130, // 14: alerting.routing.v1.SimulateRoutingResponse.actions:type_name -> alerting.routing.v1.ActionExecution
-	131, // 15: alerting.routing.v1.SimulateRoutingResponse.maintenance_result:type_name -> alerting.routing.v1.MaintenanceResult
-	126, // 16: alerting.routing.v1.GetRoutingAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	126, // 17: alerting.routing.v1.GetRoutingAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
-	132, // 18: alerting.routing.v1.GetRoutingAuditLogsResponse.logs:type_name -> alerting.routing.v1.RoutingAuditLog
-	20,  // 19: alerting.routing.v1.RouteAlertRequest.alert:type_name -> alerting.routing.v1.Alert
-	132, // 20: alerting.routing.v1.RouteAlertResponse.audit_log:type_name -> alerting.routing.v1.RoutingAuditLog
-	0,   // 21: alerting.routing.v1.Alert.status:type_name -> alerting.routing.v1.AlertStatus
-	1,   // 22: alerting.routing.v1.Alert.source:type_name -> alerting.routing.v1.AlertSource
-	119, // 23: alerting.routing.v1.Alert.labels:type_name -> alerting.routing.v1.Alert.LabelsEntry
-	120, // 24: alerting.routing.v1.Alert.annotations:type_name -> alerting.routing.v1.Alert.AnnotationsEntry
-	126, // 25: alerting.routing.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
-	133, // 26: alerting.routing.v1.CreateTeamRequest.team:type_name -> alerting.routing.v1.Team
-	133, // 27: alerting.routing.v1.ListTeamsResponse.teams:type_name -> alerting.routing.v1.Team
-	133, // 28: alerting.routing.v1.UpdateTeamRequest.team:type_name -> alerting.routing.v1.Team
-	125, // 29: alerting.routing.v1.UpdateTeamRequest.update_mask:type_name -> google.protobuf.FieldMask
-	134, // 30: alerting.routing.v1.AddTeamMemberRequest.member:type_name -> alerting.routing.v1.TeamMember
-	134, // 31: alerting.routing.v1.UpdateTeamMemberRequest.member:type_name -> alerting.routing.v1.TeamMember
-	125, // 32: alerting.routing.v1.UpdateTeamMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
-	135, // 33: alerting.routing.v1.CreateScheduleRequest.schedule:type_name -> alerting.routing.v1.Schedule
-	135, // 34: alerting.routing.v1.ListSchedulesResponse.schedules:type_name -> alerting.routing.v1.Schedule
-	135, // 35: alerting.routing.v1.UpdateScheduleRequest.schedule:type_name -> alerting.routing.v1.Schedule
-	125, // 36: alerting.routing.v1.UpdateScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	136, // 37: alerting.routing.v1.AddRotationRequest.rotation:type_name -> alerting.routing.v1.Rotation
-	136, // 38: alerting.routing.v1.UpdateRotationRequest.rotation:type_name -> alerting.routing.v1.Rotation
-	125, // 39: alerting.routing.v1.UpdateRotationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	137, // 40: alerting.routing.v1.CreateOverrideRequest.override:type_name -> alerting.routing.v1.ScheduleOverride
-	126, // 41: alerting.routing.v1.ListOverridesRequest.start_time:type_name -> google.protobuf.Timestamp
-	126, // 42: alerting.routing.v1.ListOverridesRequest.end_time:type_name -> google.protobuf.Timestamp
-	137, // 43: alerting.routing.v1.ListOverridesResponse.overrides:type_name -> alerting.routing.v1.ScheduleOverride
-	138, // 44: alerting.routing.v1.GetCurrentOnCallResponse.current_shift:type_name -> alerting.routing.v1.Shift
-	126, // 45: alerting.routing.v1.GetCurrentOnCallResponse.next_handoff:type_name -> google.protobuf.Timestamp
-	126, // 46: alerting.routing.v1.GetOnCallAtTimeRequest.time:type_name -> google.protobuf.Timestamp
-	138, // 47: alerting.routing.v1.GetOnCallAtTimeResponse.shift:type_name -> alerting.routing.v1.Shift
-	126, // 48: alerting.routing.v1.ListUpcomingShiftsRequest.until:type_name -> google.protobuf.Timestamp
-	138, // 49: alerting.routing.v1.ListUpcomingShiftsResponse.shifts:type_name -> alerting.routing.v1.Shift
-	138, // 50: alerting.routing.v1.AcknowledgeHandoffResponse.shift:type_name -> alerting.routing.v1.Shift
-	126, // 51: alerting.routing.v1.HandoffSummary.handoff_time:type_name -> google.protobuf.Timestamp
-	20,  // 52: alerting.routing.v1.HandoffSummary.active_alerts:type_name -> alerting.routing.v1.Alert
-	57,  // 53: alerting.routing.v1.HandoffSummary.open_tickets:type_name -> alerting.routing.v1.TicketSummary
-	58,  // 54: alerting.routing.v1.HandoffSummary.recent_events:type_name -> alerting.routing.v1.Event
-	126, // 55: alerting.routing.v1.TicketSummary.created_at:type_name -> google.protobuf.Timestamp
-	126, // 56: alerting.routing.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
-	121, // 57: alerting.routing.v1.Event.metadata:type_name -> alerting.routing.v1.Event.MetadataEntry
-	139, // 58: alerting.routing.v1.CreateSiteRequest.site:type_name -> alerting.routing.v1.Site
-	140, // 59: alerting.routing.v1.ListSitesRequest.type:type_name -> alerting.routing.v1.SiteType
-	139, // 60: alerting.routing.v1.ListSitesResponse.sites:type_name -> alerting.routing.v1.Site
-	139, // 61: alerting.routing.v1.UpdateSiteRequest.site:type_name -> alerting.routing.v1.Site
-	125, // 62: alerting.routing.v1.UpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
-	141, // 63: alerting.routing.v1.CreateMaintenanceWindowRequest.window:type_name -> alerting.routing.v1.MaintenanceWindow
-	126, // 64: alerting.routing.v1.ListMaintenanceWindowsRequest.start_time:type_name -> google.protobuf.Timestamp
-	126, // 65: alerting.routing.v1.ListMaintenanceWindowsRequest.end_time:type_name -> google.protobuf.Timestamp
-	142, // 66: alerting.routing.v1.ListMaintenanceWindowsRequest.status:type_name -> alerting.routing.v1.MaintenanceStatus
-	141, // 67: alerting.routing.v1.ListMaintenanceWindowsResponse.windows:type_name -> alerting.routing.v1.MaintenanceWindow
-	141, // 68: alerting.routing.v1.UpdateMaintenanceWindowRequest.window:type_name -> alerting.routing.v1.MaintenanceWindow
-	125, // 69: alerting.routing.v1.UpdateMaintenanceWindowRequest.update_mask:type_name -> google.protobuf.FieldMask
-	20,  // 70: alerting.routing.v1.CheckAlertMaintenanceRequest.alert:type_name -> alerting.routing.v1.Alert
-	141, // 71: alerting.routing.v1.CheckAlertMaintenanceResponse.matching_windows:type_name -> alerting.routing.v1.MaintenanceWindow
-	143, // 72: alerting.routing.v1.CheckAlertMaintenanceResponse.recommended_action:type_name -> alerting.routing.v1.MaintenanceAction
-	144, // 73: alerting.routing.v1.CreateEscalationPolicyRequest.policy:type_name -> alerting.routing.v1.EscalationPolicy
-	144, // 74: alerting.routing.v1.ListEscalationPoliciesResponse.policies:type_name -> alerting.routing.v1.EscalationPolicy
-	144, // 75: alerting.routing.v1.UpdateEscalationPolicyRequest.policy:type_name -> alerting.routing.v1.EscalationPolicy
-	125, // 76: alerting.routing.v1.UpdateEscalationPolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
-	126, // 77: alerting.routing.v1.StartEscalationResponse.next_step_at:type_name -> google.protobuf.Timestamp
-	2,   // 78: alerting.routing.v1.EscalationStatus.state:type_name -> alerting.routing.v1.EscalationState
-	126, // 79: alerting.routing.v1.EscalationStatus.started_at:type_name -> google.protobuf.Timestamp
-	126, // 80: alerting.routing.v1.EscalationStatus.next_step_at:type_name -> google.protobuf.Timestamp
-	88,  // 81: alerting.routing.v1.EscalationStatus.step_results:type_name -> alerting.routing.v1.EscalationStepResult
-	126, // 82: alerting.routing.v1.EscalationStepResult.executed_at:type_name -> google.protobuf.Timestamp
-	145, // 83: alerting.routing.v1.CreateCustomerTierRequest.tier:type_name -> alerting.routing.v1.CustomerTier
-	145, // 84: alerting.routing.v1.ListCustomerTiersResponse.tiers:type_name -> alerting.routing.v1.CustomerTier
-	145, // 85: alerting.routing.v1.UpdateCustomerTierRequest.tier:type_name -> alerting.routing.v1.CustomerTier
-	125, // 86: alerting.routing.v1.UpdateCustomerTierRequest.update_mask:type_name -> google.protobuf.FieldMask
-	122, // 87: alerting.routing.v1.ResolveCustomerTierRequest.labels:type_name -> alerting.routing.v1.ResolveCustomerTierRequest.LabelsEntry
-	145, // 88: alerting.routing.v1.ResolveCustomerTierResponse.tier:type_name -> alerting.routing.v1.CustomerTier
-	146, // 89: alerting.routing.v1.CreateCarrierRequest.carrier:type_name -> alerting.routing.v1.CarrierConfig
-	146, // 90: alerting.routing.v1.ListCarriersResponse.carriers:type_name -> alerting.routing.v1.CarrierConfig
-	146, // 91: alerting.routing.v1.UpdateCarrierRequest.carrier:type_name -> alerting.routing.v1.CarrierConfig
-	125, // 92: alerting.routing.v1.UpdateCarrierRequest.update_mask:type_name -> google.protobuf.FieldMask
-	147, // 93: alerting.routing.v1.CreateEquipmentTypeRequest.equipment_type:type_name -> alerting.routing.v1.EquipmentType
-	147, // 94: alerting.routing.v1.ListEquipmentTypesResponse.equipment_types:type_name -> alerting.routing.v1.EquipmentType
-	147, // 95: alerting.routing.v1.UpdateEquipmentTypeRequest.equipment_type:type_name -> alerting.routing.v1.EquipmentType
-	125, // 96: alerting.routing.v1.UpdateEquipmentTypeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	123, // 97: alerting.routing.v1.ResolveEquipmentTypeRequest.labels:type_name -> alerting.routing.v1.ResolveEquipmentTypeRequest.LabelsEntry
-	147, // 98: alerting.routing.v1.ResolveEquipmentTypeResponse.equipment_type:type_name -> alerting.routing.v1.EquipmentType
-	3,   // 99: alerting.routing.v1.RoutingService.CreateRoutingRule:input_type -> alerting.routing.v1.CreateRoutingRuleRequest
-	4,   // 100: alerting.routing.v1.RoutingService.GetRoutingRule:input_type -> alerting.routing.v1.GetRoutingRuleRequest
-	5,   // 101: alerting.routing.v1.RoutingService.ListRoutingRules:input_type -> alerting.routing.v1.ListRoutingRulesRequest
-	7,   // 102: alerting.routing.v1.RoutingService.UpdateRoutingRule:input_type -> alerting.routing.v1.UpdateRoutingRuleRequest
-	8,   // 103: alerting.routing.v1.RoutingService.DeleteRoutingRule:input_type -> alerting.routing.v1.DeleteRoutingRuleRequest
-	10,  // 104: alerting.routing.v1.RoutingService.ReorderRoutingRules:input_type -> alerting.routing.v1.ReorderRoutingRulesRequest
-	12,  // 105: alerting.routing.v1.RoutingService.TestRoutingRule:input_type -> alerting.routing.v1.TestRoutingRuleRequest
-	14,  // 106: alerting.routing.v1.RoutingService.SimulateRouting:input_type -> alerting.routing.v1.SimulateRoutingRequest
-	16,  // 107: alerting.routing.v1.RoutingService.GetRoutingAuditLogs:input_type -> alerting.routing.v1.GetRoutingAuditLogsRequest
-	18,  // 108: alerting.routing.v1.RoutingService.RouteAlert:input_type -> alerting.routing.v1.RouteAlertRequest
-	21,  // 109: alerting.routing.v1.TeamService.CreateTeam:input_type -> alerting.routing.v1.CreateTeamRequest
-	22,  // 110: alerting.routing.v1.TeamService.GetTeam:input_type -> alerting.routing.v1.GetTeamRequest
-	23,  // 111: alerting.routing.v1.TeamService.ListTeams:input_type -> alerting.routing.v1.ListTeamsRequest
-	25,  // 112: alerting.routing.v1.TeamService.UpdateTeam:input_type -> alerting.routing.v1.UpdateTeamRequest
-	26,  // 113: alerting.routing.v1.TeamService.DeleteTeam:input_type -> alerting.routing.v1.DeleteTeamRequest
-	28,  // 114: alerting.routing.v1.TeamService.AddTeamMember:input_type -> alerting.routing.v1.AddTeamMemberRequest
-	29,  // 115: alerting.routing.v1.TeamService.RemoveTeamMember:input_type -> alerting.routing.v1.RemoveTeamMemberRequest
-	30,  // 116: alerting.routing.v1.TeamService.UpdateTeamMember:input_type -> alerting.routing.v1.UpdateTeamMemberRequest
-	31,  // 117: alerting.routing.v1.TeamService.GetUserTeams:input_type -> alerting.routing.v1.GetUserTeamsRequest
-	32,  // 118: alerting.routing.v1.ScheduleService.CreateSchedule:input_type -> alerting.routing.v1.CreateScheduleRequest
-	33,  // 119: alerting.routing.v1.ScheduleService.GetSchedule:input_type -> alerting.routing.v1.GetScheduleRequest
-	34,  // 120: alerting.routing.v1.ScheduleService.ListSchedules:input_type -> alerting.routing.v1.ListSchedulesRequest
-	36,  // 121: alerting.routing.v1.ScheduleService.UpdateSchedule:input_type -> alerting.routing.v1.UpdateScheduleRequest
-	37,  // 122: alerting.routing.v1.ScheduleService.DeleteSchedule:input_type -> alerting.routing.v1.DeleteScheduleRequest
-	39,  // 123: alerting.routing.v1.ScheduleService.AddRotation:input_type -> alerting.routing.v1.AddRotationRequest
-	40,  // 124: alerting.routing.v1.ScheduleService.UpdateRotation:input_type -> alerting.routing.v1.UpdateRotationRequest
-	41,  // 125: alerting.routing.v1.ScheduleService.RemoveRotation:input_type -> alerting.routing.v1.RemoveRotationRequest
-	42,  // 126: alerting.routing.v1.ScheduleService.CreateOverride:input_type -> alerting.routing.v1.CreateOverrideRequest
-	43,  // 127: alerting.routing.v1.ScheduleService.DeleteOverride:input_type -> alerting.routing.v1.DeleteOverrideRequest
-	45,  // 128: alerting.routing.v1.ScheduleService.ListOverrides:input_type -> alerting.routing.v1.ListOverridesRequest
-	47,  // 129: alerting.routing.v1.ScheduleService.GetCurrentOnCall:input_type -> alerting.routing.v1.GetCurrentOnCallRequest
-	49,  // 130: alerting.routing.v1.ScheduleService.GetOnCallAtTime:input_type -> alerting.routing.v1.GetOnCallAtTimeRequest
-	51,  // 131: alerting.routing.v1.ScheduleService.ListUpcomingShifts:input_type -> alerting.routing.v1.ListUpcomingShiftsRequest
-	53,  // 132: alerting.routing.v1.ScheduleService.AcknowledgeHandoff:input_type -> alerting.routing.v1.AcknowledgeHandoffRequest
-	55,  // 133: alerting.routing.v1.ScheduleService.GetHandoffSummary:input_type -> alerting.routing.v1.GetHandoffSummaryRequest
-	59,  // 134: alerting.routing.v1.SiteService.CreateSite:input_type -> alerting.routing.v1.CreateSiteRequest
-	60,  // 135: alerting.routing.v1.SiteService.GetSite:input_type -> alerting.routing.v1.GetSiteRequest
-	62,  // 136: alerting.routing.v1.SiteService.ListSites:input_type -> alerting.routing.v1.ListSitesRequest
-	64,  // 137: alerting.routing.v1.SiteService.UpdateSite:input_type -> alerting.routing.v1.UpdateSiteRequest
-	65,  // 138: alerting.routing.v1.SiteService.DeleteSite:input_type -> alerting.routing.v1.DeleteSiteRequest
-	61,  // 139: alerting.routing.v1.SiteService.GetSiteByCode:input_type -> alerting.routing.v1.GetSiteByCodeRequest
-	67,  // 140: alerting.routing.v1.MaintenanceService.CreateMaintenanceWindow:input_type -> alerting.routing.v1.CreateMaintenanceWindowRequest
-	68,  // 141: alerting.routing.v1.MaintenanceService.GetMaintenanceWindow:input_type -> alerting.routing.v1.GetMaintenanceWindowRequest
-	69,  // 142: alerting.routing.v1.MaintenanceService.ListMaintenanceWindows:input_type -> alerting.routing.v1.ListMaintenanceWindowsRequest
-	71,  // 143: alerting.routing.v1.MaintenanceService.UpdateMaintenanceWindow:input_type -> alerting.routing.v1.UpdateMaintenanceWindowRequest
-	72,  // 144: alerting.routing.v1.MaintenanceService.DeleteMaintenanceWindow:input_type -> alerting.routing.v1.DeleteMaintenanceWindowRequest
-	74,  // 145: alerting.routing.v1.MaintenanceService.ListActiveMaintenanceWindows:input_type -> alerting.routing.v1.ListActiveMaintenanceWindowsRequest
-	75,  // 146: alerting.routing.v1.MaintenanceService.CheckAlertMaintenance:input_type -> alerting.routing.v1.CheckAlertMaintenanceRequest
-	77,  // 147: alerting.routing.v1.EscalationService.CreateEscalationPolicy:input_type -> alerting.routing.v1.CreateEscalationPolicyRequest
-	78,  // 148: alerting.routing.v1.EscalationService.GetEscalationPolicy:input_type -> alerting.routing.v1.GetEscalationPolicyRequest
-	79,  // 149: alerting.routing.v1.EscalationService.ListEscalationPolicies:input_type -> alerting.routing.v1.ListEscalationPoliciesRequest
-	81,  // 150: alerting.routing.v1.EscalationService.UpdateEscalationPolicy:input_type -> alerting.routing.v1.UpdateEscalationPolicyRequest
-	82,  // 151: alerting.routing.v1.EscalationService.DeleteEscalationPolicy:input_type -> alerting.routing.v1.DeleteEscalationPolicyRequest
-	84,  // 152: alerting.routing.v1.EscalationService.StartEscalation:input_type -> alerting.routing.v1.StartEscalationRequest
-	86,  // 153: alerting.routing.v1.EscalationService.GetEscalationStatus:input_type -> alerting.routing.v1.GetEscalationStatusRequest
-	89,  // 154: alerting.routing.v1.EscalationService.StopEscalation:input_type -> alerting.routing.v1.StopEscalationRequest
-	91,  // 155: alerting.routing.v1.CustomerTierService.CreateCustomerTier:input_type -> alerting.routing.v1.CreateCustomerTierRequest
-	92,  // 156: alerting.routing.v1.CustomerTierService.GetCustomerTier:input_type -> alerting.routing.v1.GetCustomerTierRequest
-	93,  // 157: alerting.routing.v1.CustomerTierService.ListCustomerTiers:input_type -> alerting.routing.v1.ListCustomerTiersRequest
-	95,  // 158: alerting.routing.v1.CustomerTierService.UpdateCustomerTier:input_type -> alerting.routing.v1.UpdateCustomerTierRequest
-	96,  // 159: alerting.routing.v1.CustomerTierService.DeleteCustomerTier:input_type -> alerting.routing.v1.DeleteCustomerTierRequest
-	98,  // 160: alerting.routing.v1.CustomerTierService.ResolveCustomerTier:input_type -> alerting.routing.v1.ResolveCustomerTierRequest
-	100, // 161: alerting.routing.v1.CarrierService.CreateCarrier:input_type -> alerting.routing.v1.CreateCarrierRequest
-	101, // 162: alerting.routing.v1.CarrierService.GetCarrier:input_type -> alerting.routing.v1.GetCarrierRequest
-	103, // 163: alerting.routing.v1.CarrierService.ListCarriers:input_type -> alerting.routing.v1.ListCarriersRequest
-	105, // 164: alerting.routing.v1.CarrierService.UpdateCarrier:input_type -> alerting.routing.v1.UpdateCarrierRequest
-	106, // 165: alerting.routing.v1.CarrierService.DeleteCarrier:input_type -> alerting.routing.v1.DeleteCarrierRequest
-	102, // 166: alerting.routing.v1.CarrierService.GetCarrierByASN:input_type -> alerting.routing.v1.GetCarrierByASNRequest
-	108, // 167: alerting.routing.v1.EquipmentTypeService.CreateEquipmentType:input_type -> alerting.routing.v1.CreateEquipmentTypeRequest
-	109, // 168: alerting.routing.v1.EquipmentTypeService.GetEquipmentType:input_type -> alerting.routing.v1.GetEquipmentTypeRequest
-	110, // 169: alerting.routing.v1.EquipmentTypeService.GetEquipmentTypeByName:input_type -> alerting.routing.v1.GetEquipmentTypeByNameRequest
-	111, // 170: alerting.routing.v1.EquipmentTypeService.ListEquipmentTypes:input_type -> alerting.routing.v1.ListEquipmentTypesRequest
-	113, // 171: alerting.routing.v1.EquipmentTypeService.UpdateEquipmentType:input_type -> alerting.routing.v1.UpdateEquipmentTypeRequest
-	114, // 172: alerting.routing.v1.EquipmentTypeService.DeleteEquipmentType:input_type -> alerting.routing.v1.DeleteEquipmentTypeRequest
-	116, // 173: alerting.routing.v1.EquipmentTypeService.ResolveEquipmentType:input_type -> alerting.routing.v1.ResolveEquipmentTypeRequest
-	124, // 174: alerting.routing.v1.RoutingService.CreateRoutingRule:output_type -> alerting.routing.v1.RoutingRule
-	124, // 175: alerting.routing.v1.RoutingService.GetRoutingRule:output_type -> alerting.routing.v1.RoutingRule
-	6,   // 176: alerting.routing.v1.RoutingService.ListRoutingRules:output_type -> alerting.routing.v1.ListRoutingRulesResponse
-	124, // 177: alerting.routing.v1.RoutingService.UpdateRoutingRule:output_type -> alerting.routing.v1.RoutingRule
-	9,   // 178: alerting.routing.v1.RoutingService.DeleteRoutingRule:output_type -> alerting.routing.v1.DeleteRoutingRuleResponse
-	11,  // 179: alerting.routing.v1.RoutingService.ReorderRoutingRules:output_type -> alerting.routing.v1.ReorderRoutingRulesResponse
-	13,  // 180: alerting.routing.v1.RoutingService.TestRoutingRule:output_type -> alerting.routing.v1.TestRoutingRuleResponse
-	15,  // 181: alerting.routing.v1.RoutingService.SimulateRouting:output_type -> alerting.routing.v1.SimulateRoutingResponse
-	17,  // 182: alerting.routing.v1.RoutingService.GetRoutingAuditLogs:output_type -> alerting.routing.v1.GetRoutingAuditLogsResponse
-	19,  // 183: alerting.routing.v1.RoutingService.RouteAlert:output_type -> alerting.routing.v1.RouteAlertResponse
-	133, // 184: alerting.routing.v1.TeamService.CreateTeam:output_type -> alerting.routing.v1.Team
-	133, // 185: alerting.routing.v1.TeamService.GetTeam:output_type -> alerting.routing.v1.Team
-	24,  // 186: alerting.routing.v1.TeamService.ListTeams:output_type -> alerting.routing.v1.ListTeamsResponse
-	133, // 187: alerting.routing.v1.TeamService.UpdateTeam:output_type -> alerting.routing.v1.Team
-	27,  // 188: alerting.routing.v1.TeamService.DeleteTeam:output_type -> alerting.routing.v1.DeleteTeamResponse
-	133, // 189: alerting.routing.v1.TeamService.AddTeamMember:output_type -> alerting.routing.v1.Team
-	133, // 190: alerting.routing.v1.TeamService.RemoveTeamMember:output_type -> alerting.routing.v1.Team
-	133, // 191: alerting.routing.v1.TeamService.UpdateTeamMember:output_type -> alerting.routing.v1.Team
-	24,  // 192: alerting.routing.v1.TeamService.GetUserTeams:output_type -> alerting.routing.v1.ListTeamsResponse
-	135, // 193: alerting.routing.v1.ScheduleService.CreateSchedule:output_type -> alerting.routing.v1.Schedule
-	135, // 194: alerting.routing.v1.ScheduleService.GetSchedule:output_type -> alerting.routing.v1.Schedule
-	35,  // 195: alerting.routing.v1.ScheduleService.ListSchedules:output_type -> alerting.routing.v1.ListSchedulesResponse
-	135, // 196: alerting.routing.v1.ScheduleService.UpdateSchedule:output_type -> alerting.routing.v1.Schedule
-	38,  // 197: alerting.routing.v1.ScheduleService.DeleteSchedule:output_type -> alerting.routing.v1.DeleteScheduleResponse
-	135, // 198: alerting.routing.v1.ScheduleService.AddRotation:output_type -> alerting.routing.v1.Schedule
-	135, // 199: alerting.routing.v1.ScheduleService.UpdateRotation:output_type -> alerting.routing.v1.Schedule
-	135, // 200: alerting.routing.v1.ScheduleService.RemoveRotation:output_type -> alerting.routing.v1.Schedule
-	137, // 201: alerting.routing.v1.ScheduleService.CreateOverride:output_type -> alerting.routing.v1.ScheduleOverride
-	44,  // 202: alerting.routing.v1.ScheduleService.DeleteOverride:output_type -> alerting.routing.v1.DeleteOverrideResponse
-	46,  // 203: alerting.routing.v1.ScheduleService.ListOverrides:output_type -> alerting.routing.v1.ListOverridesResponse
-	48,  // 204: alerting.routing.v1.ScheduleService.GetCurrentOnCall:output_type -> alerting.routing.v1.GetCurrentOnCallResponse
-	50,  // 205: alerting.routing.v1.ScheduleService.GetOnCallAtTime:output_type -> alerting.routing.v1.GetOnCallAtTimeResponse
-	52,  // 206: alerting.routing.v1.ScheduleService.ListUpcomingShifts:output_type -> alerting.routing.v1.ListUpcomingShiftsResponse
-	54,  // 207: alerting.routing.v1.ScheduleService.AcknowledgeHandoff:output_type -> alerting.routing.v1.AcknowledgeHandoffResponse
-	56,  // 208: alerting.routing.v1.ScheduleService.GetHandoffSummary:output_type -> alerting.routing.v1.HandoffSummary
-	139, // 209: alerting.routing.v1.SiteService.CreateSite:output_type -> alerting.routing.v1.Site
-	139, // 210: alerting.routing.v1.SiteService.GetSite:output_type -> alerting.routing.v1.Site
-	63,  // 211: alerting.routing.v1.SiteService.ListSites:output_type -> alerting.routing.v1.ListSitesResponse
-	139, // 212: alerting.routing.v1.SiteService.UpdateSite:output_type -> alerting.routing.v1.Site
-	66,  // 213: alerting.routing.v1.SiteService.DeleteSite:output_type -> alerting.routing.v1.DeleteSiteResponse
-	139, // 214: alerting.routing.v1.SiteService.GetSiteByCode:output_type -> alerting.routing.v1.Site
-	141, // 215: alerting.routing.v1.MaintenanceService.CreateMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
-	141, // 216: alerting.routing.v1.MaintenanceService.GetMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
-	70,  // 217: alerting.routing.v1.MaintenanceService.ListMaintenanceWindows:output_type -> alerting.routing.v1.ListMaintenanceWindowsResponse
-	141, // 218: alerting.routing.v1.MaintenanceService.UpdateMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
-	73,  // 219: alerting.routing.v1.MaintenanceService.DeleteMaintenanceWindow:output_type -> alerting.routing.v1.DeleteMaintenanceWindowResponse
-	70,  // 220: alerting.routing.v1.MaintenanceService.ListActiveMaintenanceWindows:output_type -> alerting.routing.v1.ListMaintenanceWindowsResponse
-	76,  // 221: alerting.routing.v1.MaintenanceService.CheckAlertMaintenance:output_type -> alerting.routing.v1.CheckAlertMaintenanceResponse
-	144, // 222: alerting.routing.v1.EscalationService.CreateEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
-	144, // 223: alerting.routing.v1.EscalationService.GetEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
-	80,  // 224: alerting.routing.v1.EscalationService.ListEscalationPolicies:output_type -> alerting.routing.v1.ListEscalationPoliciesResponse
-	144, // 225: alerting.routing.v1.EscalationService.UpdateEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
-	83,  // 226: alerting.routing.v1.EscalationService.DeleteEscalationPolicy:output_type -> alerting.routing.v1.DeleteEscalationPolicyResponse
-	85,  // 227: alerting.routing.v1.EscalationService.StartEscalation:output_type -> alerting.routing.v1.StartEscalationResponse
-	87,  // 228: alerting.routing.v1.EscalationService.GetEscalationStatus:output_type -> alerting.routing.v1.EscalationStatus
-	90,  // 229: alerting.routing.v1.EscalationService.StopEscalation:output_type -> alerting.routing.v1.StopEscalationResponse
-	145, // 230: alerting.routing.v1.CustomerTierService.CreateCustomerTier:output_type -> alerting.routing.v1.CustomerTier
-	145, // 231: alerting.routing.v1.CustomerTierService.GetCustomerTier:output_type -> alerting.routing.v1.CustomerTier
-	94,  // 232: alerting.routing.v1.CustomerTierService.ListCustomerTiers:output_type -> alerting.routing.v1.ListCustomerTiersResponse
-	145, // 233: alerting.routing.v1.CustomerTierService.UpdateCustomerTier:output_type -> alerting.routing.v1.CustomerTier
-	97,  // 234: alerting.routing.v1.CustomerTierService.DeleteCustomerTier:output_type -> alerting.routing.v1.DeleteCustomerTierResponse
-	99,  // 235: alerting.routing.v1.CustomerTierService.ResolveCustomerTier:output_type -> alerting.routing.v1.ResolveCustomerTierResponse
-	146, // 236: alerting.routing.v1.CarrierService.CreateCarrier:output_type -> alerting.routing.v1.CarrierConfig
-	146, // 237: alerting.routing.v1.CarrierService.GetCarrier:output_type -> alerting.routing.v1.CarrierConfig
-	104, // 238: alerting.routing.v1.CarrierService.ListCarriers:output_type -> alerting.routing.v1.ListCarriersResponse
-	146, // 239: alerting.routing.v1.CarrierService.UpdateCarrier:output_type -> alerting.routing.v1.CarrierConfig
-	107, // 240: alerting.routing.v1.CarrierService.DeleteCarrier:output_type -> alerting.routing.v1.DeleteCarrierResponse
-	146, // 241: alerting.routing.v1.CarrierService.GetCarrierByASN:output_type -> alerting.routing.v1.CarrierConfig
-	147, // 242: alerting.routing.v1.EquipmentTypeService.CreateEquipmentType:output_type -> alerting.routing.v1.EquipmentType
-	147, // 243: alerting.routing.v1.EquipmentTypeService.GetEquipmentType:output_type -> alerting.routing.v1.EquipmentType
-	147, // 244: alerting.routing.v1.EquipmentTypeService.GetEquipmentTypeByName:output_type -> alerting.routing.v1.EquipmentType
-	112, // 245: alerting.routing.v1.EquipmentTypeService.ListEquipmentTypes:output_type -> alerting.routing.v1.ListEquipmentTypesResponse
-	147, // 246: alerting.routing.v1.EquipmentTypeService.UpdateEquipmentType:output_type -> alerting.routing.v1.EquipmentType
-	115, // 247: alerting.routing.v1.EquipmentTypeService.DeleteEquipmentType:output_type -> alerting.routing.v1.DeleteEquipmentTypeResponse
-	117, // 248: alerting.routing.v1.EquipmentTypeService.ResolveEquipmentType:output_type -> alerting.routing.v1.ResolveEquipmentTypeResponse
-	174, // [174:249] is the sub-list for method output_type
-	99,  // [99:174] is the sub-list for method input_type
-	99,  // [99:99] is the sub-list for extension type_name
-	99,  // [99:99] is the sub-list for extension extendee
-	0,   // [0:99] is the sub-list for field type_name
+	152, // 0: alerting.routing.v1.CreateRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
+	152, // 1: alerting.routing.v1.ListRoutingRulesResponse.rules:type_name -> alerting.routing.v1.RoutingRule
+	152, // 2: alerting.routing.v1.UpdateRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
+	153, // 3: alerting.routing.v1.UpdateRoutingRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	145, // 4: alerting.routing.v1.ReorderRoutingRulesRequest.rule_priorities:type_name -> alerting.routing.v1.ReorderRoutingRulesRequest.RulePrioritiesEntry
+	152, // 5: alerting.routing.v1.ReorderRoutingRulesResponse.updated_rules:type_name -> alerting.routing.v1.RoutingRule
+	152, // 6: alerting.routing.v1.CompactRulePrioritiesResponse.rules:type_name -> alerting.routing.v1.RoutingRule
+	152, // 7: alerting.routing.v1.TestRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
+	28,  // 8: alerting.routing.v1.TestRoutingRuleRequest.sample_alert:type_name -> alerting.routing.v1.Alert
+	154, // 9: alerting.routing.v1.TestRoutingRuleRequest.simulate_time:type_name -> google.protobuf.Timestamp
+	155, // 10: alerting.routing.v1.TestRoutingRuleResponse.condition_results:type_name -> alerting.routing.v1.ConditionResult
+	156, // 11: alerting.routing.v1.TestRoutingRuleResponse.matched_actions:type_name -> alerting.routing.v1.RoutingAction
+	152, // 12: alerting.routing.v1.DryRunRoutingRuleRequest.rule:type_name -> alerting.routing.v1.RoutingRule
+	18,  // 13: alerting.routing.v1.DryRunRoutingRuleResponse.samples:type_name -> alerting.routing.v1.DryRunMatch
+	155, // 14: alerting.routing.v1.DryRunMatch.matched_conditions:type_name -> alerting.routing.v1.ConditionResult
+	21,  // 15: alerting.routing.v1.DetectRuleConflictsResponse.conflicts:type_name -> alerting.routing.v1.RuleConflict
+	28,  // 16: alerting.routing.v1.SimulateRoutingRequest.alert:type_name -> alerting.routing.v1.Alert
+	154, // 17: alerting.routing.v1.SimulateRoutingRequest.simulate_time:type_name -> google.protobuf.Timestamp
+	157, // 18: alerting.routing.v1.SimulateRoutingResponse.evaluations:type_name -> alerting.routing.v1.RuleEvaluation
+	158, // 19: alerting.routing.v1.SimulateRoutingResponse.actions:type_name -> alerting.routing.v1.ActionExecution
+	159, // 20: alerting.routing.v1.SimulateRoutingResponse.maintenance_result:type_name -> alerting.routing.v1.MaintenanceResult
+	154, // 21: alerting.routing.v1.GetRoutingAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	154, // 22: alerting.routing.v1.GetRoutingAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	160, // 23: alerting.routing.v1.GetRoutingAuditLogsResponse.logs:type_name -> alerting.routing.v1.RoutingAuditLog
+	28,  // 24: alerting.routing.v1.RouteAlertRequest.alert:type_name -> alerting.routing.v1.Alert
+	160, // 25: alerting.routing.v1.RouteAlertResponse.audit_log:type_name -> alerting.routing.v1.RoutingAuditLog
+	0,   // 26: alerting.routing.v1.Alert.status:type_name -> alerting.routing.v1.AlertStatus
+	1,   // 27: alerting.routing.v1.Alert.source:type_name -> alerting.routing.v1.AlertSource
+	146, // 28: alerting.routing.v1.Alert.labels:type_name -> alerting.routing.v1.Alert.LabelsEntry
+	147, // 29: alerting.routing.v1.Alert.annotations:type_name -> alerting.routing.v1.Alert.AnnotationsEntry
+	154, // 30: alerting.routing.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
+	161, // 31: alerting.routing.v1.CreateTeamRequest.team:type_name -> alerting.routing.v1.Team
+	161, // 32: alerting.routing.v1.ListTeamsResponse.teams:type_name -> alerting.routing.v1.Team
+	161, // 33: alerting.routing.v1.UpdateTeamRequest.team:type_name -> alerting.routing.v1.Team
+	153, // 34: alerting.routing.v1.UpdateTeamRequest.update_mask:type_name -> google.protobuf.FieldMask
+	162, // 35: alerting.routing.v1.AddTeamMemberRequest.member:type_name -> alerting.routing.v1.TeamMember
+	162, // 36: alerting.routing.v1.UpdateTeamMemberRequest.member:type_name -> alerting.routing.v1.TeamMember
+	153, // 37: alerting.routing.v1.UpdateTeamMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	163, // 38: alerting.routing.v1.SetUserAvailabilityRequest.availability:type_name -> alerting.routing.v1.UserAvailability
+	154, // 39: alerting.routing.v1.GetTeamAvailabilityRequest.from:type_name -> google.protobuf.Timestamp
+	154, // 40: alerting.routing.v1.GetTeamAvailabilityRequest.until:type_name -> google.protobuf.Timestamp
+	163, // 41: alerting.routing.v1.GetTeamAvailabilityResponse.availabilities:type_name -> alerting.routing.v1.UserAvailability
+	164, // 42: alerting.routing.v1.CreateScheduleRequest.schedule:type_name -> alerting.routing.v1.Schedule
+	164, // 43: alerting.routing.v1.ListSchedulesResponse.schedules:type_name -> alerting.routing.v1.Schedule
+	164, // 44: alerting.routing.v1.UpdateScheduleRequest.schedule:type_name -> alerting.routing.v1.Schedule
+	153, // 45: alerting.routing.v1.UpdateScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	165, // 46: alerting.routing.v1.AddRotationRequest.rotation:type_name -> alerting.routing.v1.Rotation
+	165, // 47: alerting.routing.v1.UpdateRotationRequest.rotation:type_name -> alerting.routing.v1.Rotation
+	153, // 48: alerting.routing.v1.UpdateRotationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	166, // 49: alerting.routing.v1.CreateOverrideRequest.override:type_name -> alerting.routing.v1.ScheduleOverride
+	166, // 50: alerting.routing.v1.BulkCreateOverridesRequest.overrides:type_name -> alerting.routing.v1.ScheduleOverride
+	166, // 51: alerting.routing.v1.BulkCreateOverridesResponse.overrides:type_name -> alerting.routing.v1.ScheduleOverride
+	154, // 52: alerting.routing.v1.SplitOverrideRequest.split_time:type_name -> google.protobuf.Timestamp
+	166, // 53: alerting.routing.v1.SplitOverrideResponse.first:type_name -> alerting.routing.v1.ScheduleOverride
+	166, // 54: alerting.routing.v1.SplitOverrideResponse.second:type_name -> alerting.routing.v1.ScheduleOverride
+	154, // 55: alerting.routing.v1.ListOverridesRequest.start_time:type_name -> google.protobuf.Timestamp
+	154, // 56: alerting.routing.v1.ListOverridesRequest.end_time:type_name -> google.protobuf.Timestamp
+	166, // 57: alerting.routing.v1.ListOverridesResponse.overrides:type_name -> alerting.routing.v1.ScheduleOverride
+	167, // 58: alerting.routing.v1.GetCurrentOnCallResponse.current_shift:type_name -> alerting.routing.v1.Shift
+	154, // 59: alerting.routing.v1.GetCurrentOnCallResponse.next_handoff:type_name -> google.protobuf.Timestamp
+	154, // 60: alerting.routing.v1.GetOnCallAtTimeRequest.time:type_name -> google.protobuf.Timestamp
+	167, // 61: alerting.routing.v1.GetOnCallAtTimeResponse.shift:type_name -> alerting.routing.v1.Shift
+	154, // 62: alerting.routing.v1.ListUpcomingShiftsRequest.until:type_name -> google.protobuf.Timestamp
+	167, // 63: alerting.routing.v1.ListUpcomingShiftsResponse.shifts:type_name -> alerting.routing.v1.Shift
+	164, // 64: alerting.routing.v1.PreviewRotationRequest.schedule:type_name -> alerting.routing.v1.Schedule
+	154, // 65: alerting.routing.v1.PreviewRotationRequest.from:type_name -> google.protobuf.Timestamp
+	154, // 66: alerting.routing.v1.PreviewRotationRequest.until:type_name -> google.protobuf.Timestamp
+	154, // 67: alerting.routing.v1.GetCoverageDepthRequest.from:type_name -> google.protobuf.Timestamp
+	154, // 68: alerting.routing.v1.GetCoverageDepthRequest.until:type_name -> google.protobuf.Timestamp
+	154, // 69: alerting.routing.v1.CoverageDepthEntry.time_slot:type_name -> google.protobuf.Timestamp
+	72,  // 70: alerting.routing.v1.GetCoverageDepthResponse.entries:type_name -> alerting.routing.v1.CoverageDepthEntry
+	154, // 71: alerting.routing.v1.GetLoadBalanceRequest.from:type_name -> google.protobuf.Timestamp
+	154, // 72: alerting.routing.v1.GetLoadBalanceRequest.until:type_name -> google.protobuf.Timestamp
+	75,  // 73: alerting.routing.v1.GetLoadBalanceResponse.users:type_name -> alerting.routing.v1.UserLoad
+	167, // 74: alerting.routing.v1.AcknowledgeHandoffResponse.shift:type_name -> alerting.routing.v1.Shift
+	154, // 75: alerting.routing.v1.HandoffSummary.handoff_time:type_name -> google.protobuf.Timestamp
+	28,  // 76: alerting.routing.v1.HandoffSummary.active_alerts:type_name -> alerting.routing.v1.Alert
+	81,  // 77: alerting.routing.v1.HandoffSummary.open_tickets:type_name -> alerting.routing.v1.TicketSummary
+	82,  // 78: alerting.routing.v1.HandoffSummary.recent_events:type_name -> alerting.routing.v1.Event
+	168, // 79: alerting.routing.v1.HandoffSummary.recent_handoff_notes:type_name -> alerting.routing.v1.HandoffNote
+	169, // 80: alerting.routing.v1.HandoffSummary.recent_alert_comments:type_name -> alerting.v1.AlertComment
+	154, // 81: alerting.routing.v1.TicketSummary.created_at:type_name -> google.protobuf.Timestamp
+	154, // 82: alerting.routing.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	148, // 83: alerting.routing.v1.Event.metadata:type_name -> alerting.routing.v1.Event.MetadataEntry
+	170, // 84: alerting.routing.v1.CreateSiteRequest.site:type_name -> alerting.routing.v1.Site
+	171, // 85: alerting.routing.v1.ListSitesRequest.type:type_name -> alerting.routing.v1.SiteType
+	170, // 86: alerting.routing.v1.ListSitesResponse.sites:type_name -> alerting.routing.v1.Site
+	170, // 87: alerting.routing.v1.UpdateSiteRequest.site:type_name -> alerting.routing.v1.Site
+	153, // 88: alerting.routing.v1.UpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	172, // 89: alerting.routing.v1.UpdateSiteCapacityRequest.capacity:type_name -> alerting.routing.v1.CapacityMetrics
+	173, // 90: alerting.routing.v1.CreateMaintenanceWindowRequest.window:type_name -> alerting.routing.v1.MaintenanceWindow
+	154, // 91: alerting.routing.v1.ListMaintenanceWindowsRequest.start_time:type_name -> google.protobuf.Timestamp
+	154, // 92: alerting.routing.v1.ListMaintenanceWindowsRequest.end_time:type_name -> google.protobuf.Timestamp
+	174, // 93: alerting.routing.v1.ListMaintenanceWindowsRequest.status:type_name -> alerting.routing.v1.MaintenanceStatus
+	149, // 94: alerting.routing.v1.ListMaintenanceWindowsRequest.labels:type_name -> alerting.routing.v1.ListMaintenanceWindowsRequest.LabelsEntry
+	173, // 95: alerting.routing.v1.ListMaintenanceWindowsResponse.windows:type_name -> alerting.routing.v1.MaintenanceWindow
+	173, // 96: alerting.routing.v1.UpdateMaintenanceWindowRequest.window:type_name -> alerting.routing.v1.MaintenanceWindow
+	153, // 97: alerting.routing.v1.UpdateMaintenanceWindowRequest.update_mask:type_name -> google.protobuf.FieldMask
+	175, // 98: alerting.routing.v1.ExpandMaintenanceWindowRequest.extend_by:type_name -> google.protobuf.Duration
+	28,  // 99: alerting.routing.v1.CheckAlertMaintenanceRequest.alert:type_name -> alerting.routing.v1.Alert
+	173, // 100: alerting.routing.v1.CheckAlertMaintenanceResponse.matching_windows:type_name -> alerting.routing.v1.MaintenanceWindow
+	176, // 101: alerting.routing.v1.CheckAlertMaintenanceResponse.recommended_action:type_name -> alerting.routing.v1.MaintenanceAction
+	177, // 102: alerting.routing.v1.CreateEscalationPolicyRequest.policy:type_name -> alerting.routing.v1.EscalationPolicy
+	177, // 103: alerting.routing.v1.ListEscalationPoliciesResponse.policies:type_name -> alerting.routing.v1.EscalationPolicy
+	177, // 104: alerting.routing.v1.UpdateEscalationPolicyRequest.policy:type_name -> alerting.routing.v1.EscalationPolicy
+	153, // 105: alerting.routing.v1.UpdateEscalationPolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	154, // 106: alerting.routing.v1.StartEscalationResponse.next_step_at:type_name -> google.protobuf.Timestamp
+	2,   // 107: alerting.routing.v1.EscalationStatus.state:type_name -> alerting.routing.v1.EscalationState
+	154, // 108: alerting.routing.v1.EscalationStatus.started_at:type_name -> google.protobuf.Timestamp
+	154, // 109: alerting.routing.v1.EscalationStatus.next_step_at:type_name -> google.protobuf.Timestamp
+	115, // 110: alerting.routing.v1.EscalationStatus.step_results:type_name -> alerting.routing.v1.EscalationStepResult
+	154, // 111: alerting.routing.v1.EscalationStepResult.executed_at:type_name -> google.protobuf.Timestamp
+	178, // 112: alerting.routing.v1.CreateCustomerTierRequest.tier:type_name -> alerting.routing.v1.CustomerTier
+	178, // 113: alerting.routing.v1.ListCustomerTiersResponse.tiers:type_name -> alerting.routing.v1.CustomerTier
+	178, // 114: alerting.routing.v1.UpdateCustomerTierRequest.tier:type_name -> alerting.routing.v1.CustomerTier
+	153, // 115: alerting.routing.v1.UpdateCustomerTierRequest.update_mask:type_name -> google.protobuf.FieldMask
+	150, // 116: alerting.routing.v1.ResolveCustomerTierRequest.labels:type_name -> alerting.routing.v1.ResolveCustomerTierRequest.LabelsEntry
+	178, // 117: alerting.routing.v1.ResolveCustomerTierResponse.tier:type_name -> alerting.routing.v1.CustomerTier
+	179, // 118: alerting.routing.v1.CreateCarrierRequest.carrier:type_name -> alerting.routing.v1.CarrierConfig
+	179, // 119: alerting.routing.v1.ListCarriersResponse.carriers:type_name -> alerting.routing.v1.CarrierConfig
+	179, // 120: alerting.routing.v1.UpdateCarrierRequest.carrier:type_name -> alerting.routing.v1.CarrierConfig
+	153, // 121: alerting.routing.v1.UpdateCarrierRequest.update_mask:type_name -> google.protobuf.FieldMask
+	180, // 122: alerting.routing.v1.CreateEquipmentTypeRequest.equipment_type:type_name -> alerting.routing.v1.EquipmentType
+	180, // 123: alerting.routing.v1.ListEquipmentTypesResponse.equipment_types:type_name -> alerting.routing.v1.EquipmentType
+	180, // 124: alerting.routing.v1.UpdateEquipmentTypeRequest.equipment_type:type_name -> alerting.routing.v1.EquipmentType
+	153, // 125: alerting.routing.v1.UpdateEquipmentTypeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	151, // 126: alerting.routing.v1.ResolveEquipmentTypeRequest.labels:type_name -> alerting.routing.v1.ResolveEquipmentTypeRequest.LabelsEntry
+	180, // 127: alerting.routing.v1.ResolveEquipmentTypeResponse.equipment_type:type_name -> alerting.routing.v1.EquipmentType
+	3,   // 128: alerting.routing.v1.RoutingService.CreateRoutingRule:input_type -> alerting.routing.v1.CreateRoutingRuleRequest
+	4,   // 129: alerting.routing.v1.RoutingService.GetRoutingRule:input_type -> alerting.routing.v1.GetRoutingRuleRequest
+	5,   // 130: alerting.routing.v1.RoutingService.ListRoutingRules:input_type -> alerting.routing.v1.ListRoutingRulesRequest
+	7,   // 131: alerting.routing.v1.RoutingService.UpdateRoutingRule:input_type -> alerting.routing.v1.UpdateRoutingRuleRequest
+	8,   // 132: alerting.routing.v1.RoutingService.DeleteRoutingRule:input_type -> alerting.routing.v1.DeleteRoutingRuleRequest
+	10,  // 133: alerting.routing.v1.RoutingService.ReorderRoutingRules:input_type -> alerting.routing.v1.ReorderRoutingRulesRequest
+	12,  // 134: alerting.routing.v1.RoutingService.CompactRulePriorities:input_type -> alerting.routing.v1.CompactRulePrioritiesRequest
+	14,  // 135: alerting.routing.v1.RoutingService.TestRoutingRule:input_type -> alerting.routing.v1.TestRoutingRuleRequest
+	16,  // 136: alerting.routing.v1.RoutingService.DryRunRoutingRule:input_type -> alerting.routing.v1.DryRunRoutingRuleRequest
+	19,  // 137: alerting.routing.v1.RoutingService.DetectRuleConflicts:input_type -> alerting.routing.v1.DetectRuleConflictsRequest
+	22,  // 138: alerting.routing.v1.RoutingService.SimulateRouting:input_type -> alerting.routing.v1.SimulateRoutingRequest
+	24,  // 139: alerting.routing.v1.RoutingService.GetRoutingAuditLogs:input_type -> alerting.routing.v1.GetRoutingAuditLogsRequest
+	26,  // 140: alerting.routing.v1.RoutingService.RouteAlert:input_type -> alerting.routing.v1.RouteAlertRequest
+	29,  // 141: alerting.routing.v1.TeamService.CreateTeam:input_type -> alerting.routing.v1.CreateTeamRequest
+	30,  // 142: alerting.routing.v1.TeamService.GetTeam:input_type -> alerting.routing.v1.GetTeamRequest
+	31,  // 143: alerting.routing.v1.TeamService.ListTeams:input_type -> alerting.routing.v1.ListTeamsRequest
+	33,  // 144: alerting.routing.v1.TeamService.UpdateTeam:input_type -> alerting.routing.v1.UpdateTeamRequest
+	34,  // 145: alerting.routing.v1.TeamService.DeleteTeam:input_type -> alerting.routing.v1.DeleteTeamRequest
+	36,  // 146: alerting.routing.v1.TeamService.AddTeamMember:input_type -> alerting.routing.v1.AddTeamMemberRequest
+	37,  // 147: alerting.routing.v1.TeamService.RemoveTeamMember:input_type -> alerting.routing.v1.RemoveTeamMemberRequest
+	38,  // 148: alerting.routing.v1.TeamService.UpdateTeamMember:input_type -> alerting.routing.v1.UpdateTeamMemberRequest
+	39,  // 149: alerting.routing.v1.TeamService.GetUserTeams:input_type -> alerting.routing.v1.GetUserTeamsRequest
+	40,  // 150: alerting.routing.v1.TeamService.SetUserAvailability:input_type -> alerting.routing.v1.SetUserAvailabilityRequest
+	41,  // 151: alerting.routing.v1.TeamService.GetTeamAvailability:input_type -> alerting.routing.v1.GetTeamAvailabilityRequest
+	43,  // 152: alerting.routing.v1.ScheduleService.CreateSchedule:input_type -> alerting.routing.v1.CreateScheduleRequest
+	44,  // 153: alerting.routing.v1.ScheduleService.GetSchedule:input_type -> alerting.routing.v1.GetScheduleRequest
+	45,  // 154: alerting.routing.v1.ScheduleService.ListSchedules:input_type -> alerting.routing.v1.ListSchedulesRequest
+	47,  // 155: alerting.routing.v1.ScheduleService.UpdateSchedule:input_type -> alerting.routing.v1.UpdateScheduleRequest
+	48,  // 156: alerting.routing.v1.ScheduleService.DeleteSchedule:input_type -> alerting.routing.v1.DeleteScheduleRequest
+	50,  // 157: alerting.routing.v1.ScheduleService.AddRotation:input_type -> alerting.routing.v1.AddRotationRequest
+	51,  // 158: alerting.routing.v1.ScheduleService.UpdateRotation:input_type -> alerting.routing.v1.UpdateRotationRequest
+	52,  // 159: alerting.routing.v1.ScheduleService.RemoveRotation:input_type -> alerting.routing.v1.RemoveRotationRequest
+	53,  // 160: alerting.routing.v1.ScheduleService.ReorderRotationMembers:input_type -> alerting.routing.v1.ReorderRotationMembersRequest
+	54,  // 161: alerting.routing.v1.ScheduleService.CreateOverride:input_type -> alerting.routing.v1.CreateOverrideRequest
+	56,  // 162: alerting.routing.v1.ScheduleService.BulkCreateOverrides:input_type -> alerting.routing.v1.BulkCreateOverridesRequest
+	58,  // 163: alerting.routing.v1.ScheduleService.DeleteOverride:input_type -> alerting.routing.v1.DeleteOverrideRequest
+	60,  // 164: alerting.routing.v1.ScheduleService.SplitOverride:input_type -> alerting.routing.v1.SplitOverrideRequest
+	62,  // 165: alerting.routing.v1.ScheduleService.ListOverrides:input_type -> alerting.routing.v1.ListOverridesRequest
+	64,  // 166: alerting.routing.v1.ScheduleService.GetCurrentOnCall:input_type -> alerting.routing.v1.GetCurrentOnCallRequest
+	66,  // 167: alerting.routing.v1.ScheduleService.GetOnCallAtTime:input_type -> alerting.routing.v1.GetOnCallAtTimeRequest
+	68,  // 168: alerting.routing.v1.ScheduleService.ListUpcomingShifts:input_type -> alerting.routing.v1.ListUpcomingShiftsRequest
+	71,  // 169: alerting.routing.v1.ScheduleService.GetCoverageDepth:input_type -> alerting.routing.v1.GetCoverageDepthRequest
+	70,  // 170: alerting.routing.v1.ScheduleService.PreviewRotation:input_type -> alerting.routing.v1.PreviewRotationRequest
+	74,  // 171: alerting.routing.v1.ScheduleService.GetLoadBalance:input_type -> alerting.routing.v1.GetLoadBalanceRequest
+	77,  // 172: alerting.routing.v1.ScheduleService.AcknowledgeHandoff:input_type -> alerting.routing.v1.AcknowledgeHandoffRequest
+	79,  // 173: alerting.routing.v1.ScheduleService.GetHandoffSummary:input_type -> alerting.routing.v1.GetHandoffSummaryRequest
+	83,  // 174: alerting.routing.v1.SiteService.CreateSite:input_type -> alerting.routing.v1.CreateSiteRequest
+	84,  // 175: alerting.routing.v1.SiteService.GetSite:input_type -> alerting.routing.v1.GetSiteRequest
+	86,  // 176: alerting.routing.v1.SiteService.ListSites:input_type -> alerting.routing.v1.ListSitesRequest
+	88,  // 177: alerting.routing.v1.SiteService.UpdateSite:input_type -> alerting.routing.v1.UpdateSiteRequest
+	89,  // 178: alerting.routing.v1.SiteService.DeleteSite:input_type -> alerting.routing.v1.DeleteSiteRequest
+	85,  // 179: alerting.routing.v1.SiteService.GetSiteByCode:input_type -> alerting.routing.v1.GetSiteByCodeRequest
+	91,  // 180: alerting.routing.v1.SiteService.UpdateSiteCapacity:input_type -> alerting.routing.v1.UpdateSiteCapacityRequest
+	92,  // 181: alerting.routing.v1.MaintenanceService.CreateMaintenanceWindow:input_type -> alerting.routing.v1.CreateMaintenanceWindowRequest
+	93,  // 182: alerting.routing.v1.MaintenanceService.GetMaintenanceWindow:input_type -> alerting.routing.v1.GetMaintenanceWindowRequest
+	94,  // 183: alerting.routing.v1.MaintenanceService.ListMaintenanceWindows:input_type -> alerting.routing.v1.ListMaintenanceWindowsRequest
+	96,  // 184: alerting.routing.v1.MaintenanceService.UpdateMaintenanceWindow:input_type -> alerting.routing.v1.UpdateMaintenanceWindowRequest
+	97,  // 185: alerting.routing.v1.MaintenanceService.DeleteMaintenanceWindow:input_type -> alerting.routing.v1.DeleteMaintenanceWindowRequest
+	101, // 186: alerting.routing.v1.MaintenanceService.ListActiveMaintenanceWindows:input_type -> alerting.routing.v1.ListActiveMaintenanceWindowsRequest
+	102, // 187: alerting.routing.v1.MaintenanceService.CheckAlertMaintenance:input_type -> alerting.routing.v1.CheckAlertMaintenanceRequest
+	99,  // 188: alerting.routing.v1.MaintenanceService.ExpandMaintenanceWindow:input_type -> alerting.routing.v1.ExpandMaintenanceWindowRequest
+	100, // 189: alerting.routing.v1.MaintenanceService.ApproveMaintenanceWindow:input_type -> alerting.routing.v1.ApproveMaintenanceWindowRequest
+	104, // 190: alerting.routing.v1.EscalationService.CreateEscalationPolicy:input_type -> alerting.routing.v1.CreateEscalationPolicyRequest
+	105, // 191: alerting.routing.v1.EscalationService.GetEscalationPolicy:input_type -> alerting.routing.v1.GetEscalationPolicyRequest
+	106, // 192: alerting.routing.v1.EscalationService.ListEscalationPolicies:input_type -> alerting.routing.v1.ListEscalationPoliciesRequest
+	108, // 193: alerting.routing.v1.EscalationService.UpdateEscalationPolicy:input_type -> alerting.routing.v1.UpdateEscalationPolicyRequest
+	109, // 194: alerting.routing.v1.EscalationService.DeleteEscalationPolicy:input_type -> alerting.routing.v1.DeleteEscalationPolicyRequest
+	111, // 195: alerting.routing.v1.EscalationService.StartEscalation:input_type -> alerting.routing.v1.StartEscalationRequest
+	113, // 196: alerting.routing.v1.EscalationService.GetEscalationStatus:input_type -> alerting.routing.v1.GetEscalationStatusRequest
+	116, // 197: alerting.routing.v1.EscalationService.StopEscalation:input_type -> alerting.routing.v1.StopEscalationRequest
+	118, // 198: alerting.routing.v1.CustomerTierService.CreateCustomerTier:input_type -> alerting.routing.v1.CreateCustomerTierRequest
+	119, // 199: alerting.routing.v1.CustomerTierService.GetCustomerTier:input_type -> alerting.routing.v1.GetCustomerTierRequest
+	120, // 200: alerting.routing.v1.CustomerTierService.ListCustomerTiers:input_type -> alerting.routing.v1.ListCustomerTiersRequest
+	122, // 201: alerting.routing.v1.CustomerTierService.UpdateCustomerTier:input_type -> alerting.routing.v1.UpdateCustomerTierRequest
+	123, // 202: alerting.routing.v1.CustomerTierService.DeleteCustomerTier:input_type -> alerting.routing.v1.DeleteCustomerTierRequest
+	125, // 203: alerting.routing.v1.CustomerTierService.ResolveCustomerTier:input_type -> alerting.routing.v1.ResolveCustomerTierRequest
+	127, // 204: alerting.routing.v1.CarrierService.CreateCarrier:input_type -> alerting.routing.v1.CreateCarrierRequest
+	128, // 205: alerting.routing.v1.CarrierService.GetCarrier:input_type -> alerting.routing.v1.GetCarrierRequest
+	130, // 206: alerting.routing.v1.CarrierService.ListCarriers:input_type -> alerting.routing.v1.ListCarriersRequest
+	132, // 207: alerting.routing.v1.CarrierService.UpdateCarrier:input_type -> alerting.routing.v1.UpdateCarrierRequest
+	133, // 208: alerting.routing.v1.CarrierService.DeleteCarrier:input_type -> alerting.routing.v1.DeleteCarrierRequest
+	129, // 209: alerting.routing.v1.CarrierService.GetCarrierByASN:input_type -> alerting.routing.v1.GetCarrierByASNRequest
+	135, // 210: alerting.routing.v1.EquipmentTypeService.CreateEquipmentType:input_type -> alerting.routing.v1.CreateEquipmentTypeRequest
+	136, // 211: alerting.routing.v1.EquipmentTypeService.GetEquipmentType:input_type -> alerting.routing.v1.GetEquipmentTypeRequest
+	137, // 212: alerting.routing.v1.EquipmentTypeService.GetEquipmentTypeByName:input_type -> alerting.routing.v1.GetEquipmentTypeByNameRequest
+	138, // 213: alerting.routing.v1.EquipmentTypeService.ListEquipmentTypes:input_type -> alerting.routing.v1.ListEquipmentTypesRequest
+	140, // 214: alerting.routing.v1.EquipmentTypeService.UpdateEquipmentType:input_type -> alerting.routing.v1.UpdateEquipmentTypeRequest
+	141, // 215: alerting.routing.v1.EquipmentTypeService.DeleteEquipmentType:input_type -> alerting.routing.v1.DeleteEquipmentTypeRequest
+	143, // 216: alerting.routing.v1.EquipmentTypeService.ResolveEquipmentType:input_type -> alerting.routing.v1.ResolveEquipmentTypeRequest
+	152, // 217: alerting.routing.v1.RoutingService.CreateRoutingRule:output_type -> alerting.routing.v1.RoutingRule
+	152, // 218: alerting.routing.v1.RoutingService.GetRoutingRule:output_type -> alerting.routing.v1.RoutingRule
+	6,   // 219: alerting.routing.v1.RoutingService.ListRoutingRules:output_type -> alerting.routing.v1.ListRoutingRulesResponse
+	152, // 220: alerting.routing.v1.RoutingService.UpdateRoutingRule:output_type -> alerting.routing.v1.RoutingRule
+	9,   // 221: alerting.routing.v1.RoutingService.DeleteRoutingRule:output_type -> alerting.routing.v1.DeleteRoutingRuleResponse
+	11,  // 222: alerting.routing.v1.RoutingService.ReorderRoutingRules:output_type -> alerting.routing.v1.ReorderRoutingRulesResponse
+	13,  // 223: alerting.routing.v1.RoutingService.CompactRulePriorities:output_type -> alerting.routing.v1.CompactRulePrioritiesResponse
+	15,  // 224: alerting.routing.v1.RoutingService.TestRoutingRule:output_type -> alerting.routing.v1.TestRoutingRuleResponse
+	17,  // 225: alerting.routing.v1.RoutingService.DryRunRoutingRule:output_type -> alerting.routing.v1.DryRunRoutingRuleResponse
+	20,  // 226: alerting.routing.v1.RoutingService.DetectRuleConflicts:output_type -> alerting.routing.v1.DetectRuleConflictsResponse
+	23,  // 227: alerting.routing.v1.RoutingService.SimulateRouting:output_type -> alerting.routing.v1.SimulateRoutingResponse
+	25,  // 228: alerting.routing.v1.RoutingService.GetRoutingAuditLogs:output_type -> alerting.routing.v1.GetRoutingAuditLogsResponse
+	27,  // 229: alerting.routing.v1.RoutingService.RouteAlert:output_type -> alerting.routing.v1.RouteAlertResponse
+	161, // 230: alerting.routing.v1.TeamService.CreateTeam:output_type -> alerting.routing.v1.Team
+	161, // 231: alerting.routing.v1.TeamService.GetTeam:output_type -> alerting.routing.v1.Team
+	32,  // 232: alerting.routing.v1.TeamService.ListTeams:output_type -> alerting.routing.v1.ListTeamsResponse
+	161, // 233: alerting.routing.v1.TeamService.UpdateTeam:output_type -> alerting.routing.v1.Team
+	35,  // 234: alerting.routing.v1.TeamService.DeleteTeam:output_type -> alerting.routing.v1.DeleteTeamResponse
+	161, // 235: alerting.routing.v1.TeamService.AddTeamMember:output_type -> alerting.routing.v1.Team
+	161, // 236: alerting.routing.v1.TeamService.RemoveTeamMember:output_type -> alerting.routing.v1.Team
+	161, // 237: alerting.routing.v1.TeamService.UpdateTeamMember:output_type -> alerting.routing.v1.Team
+	32,  // 238: alerting.routing.v1.TeamService.GetUserTeams:output_type -> alerting.routing.v1.ListTeamsResponse
+	163, // 239: alerting.routing.v1.TeamService.SetUserAvailability:output_type -> alerting.routing.v1.UserAvailability
+	42,  // 240: alerting.routing.v1.TeamService.GetTeamAvailability:output_type -> alerting.routing.v1.GetTeamAvailabilityResponse
+	164, // 241: alerting.routing.v1.ScheduleService.CreateSchedule:output_type -> alerting.routing.v1.Schedule
+	164, // 242: alerting.routing.v1.ScheduleService.GetSchedule:output_type -> alerting.routing.v1.Schedule
+	46,  // 243: alerting.routing.v1.ScheduleService.ListSchedules:output_type -> alerting.routing.v1.ListSchedulesResponse
+	164, // 244: alerting.routing.v1.ScheduleService.UpdateSchedule:output_type -> alerting.routing.v1.Schedule
+	49,  // 245: alerting.routing.v1.ScheduleService.DeleteSchedule:output_type -> alerting.routing.v1.DeleteScheduleResponse
+	164, // 246: alerting.routing.v1.ScheduleService.AddRotation:output_type -> alerting.routing.v1.Schedule
+	164, // 247: alerting.routing.v1.ScheduleService.UpdateRotation:output_type -> alerting.routing.v1.Schedule
+	164, // 248: alerting.routing.v1.ScheduleService.RemoveRotation:output_type -> alerting.routing.v1.Schedule
+	165, // 249: alerting.routing.v1.ScheduleService.ReorderRotationMembers:output_type -> alerting.routing.v1.Rotation
+	166, // 250: alerting.routing.v1.ScheduleService.CreateOverride:output_type -> alerting.routing.v1.ScheduleOverride
+	57,  // 251: alerting.routing.v1.ScheduleService.BulkCreateOverrides:output_type -> alerting.routing.v1.BulkCreateOverridesResponse
+	59,  // 252: alerting.routing.v1.ScheduleService.DeleteOverride:output_type -> alerting.routing.v1.DeleteOverrideResponse
+	61,  // 253: alerting.routing.v1.ScheduleService.SplitOverride:output_type -> alerting.routing.v1.SplitOverrideResponse
+	63,  // 254: alerting.routing.v1.ScheduleService.ListOverrides:output_type -> alerting.routing.v1.ListOverridesResponse
+	65,  // 255: alerting.routing.v1.ScheduleService.GetCurrentOnCall:output_type -> alerting.routing.v1.GetCurrentOnCallResponse
+	67,  // 256: alerting.routing.v1.ScheduleService.GetOnCallAtTime:output_type -> alerting.routing.v1.GetOnCallAtTimeResponse
+	69,  // 257: alerting.routing.v1.ScheduleService.ListUpcomingShifts:output_type -> alerting.routing.v1.ListUpcomingShiftsResponse
+	73,  // 258: alerting.routing.v1.ScheduleService.GetCoverageDepth:output_type -> alerting.routing.v1.GetCoverageDepthResponse
+	69,  // 259: alerting.routing.v1.ScheduleService.PreviewRotation:output_type -> alerting.routing.v1.ListUpcomingShiftsResponse
+	76,  // 260: alerting.routing.v1.ScheduleService.GetLoadBalance:output_type -> alerting.routing.v1.GetLoadBalanceResponse
+	78,  // 261: alerting.routing.v1.ScheduleService.AcknowledgeHandoff:output_type -> alerting.routing.v1.AcknowledgeHandoffResponse
+	80,  // 262: alerting.routing.v1.ScheduleService.GetHandoffSummary:output_type -> alerting.routing.v1.HandoffSummary
+	170, // 263: alerting.routing.v1.SiteService.CreateSite:output_type -> alerting.routing.v1.Site
+	170, // 264: alerting.routing.v1.SiteService.GetSite:output_type -> alerting.routing.v1.Site
+	87,  // 265: alerting.routing.v1.SiteService.ListSites:output_type -> alerting.routing.v1.ListSitesResponse
+	170, // 266: alerting.routing.v1.SiteService.UpdateSite:output_type -> alerting.routing.v1.Site
+	90,  // 267: alerting.routing.v1.SiteService.DeleteSite:output_type -> alerting.routing.v1.DeleteSiteResponse
+	170, // 268: alerting.routing.v1.SiteService.GetSiteByCode:output_type -> alerting.routing.v1.Site
+	170, // 269: alerting.routing.v1.SiteService.UpdateSiteCapacity:output_type -> alerting.routing.v1.Site
+	173, // 270: alerting.routing.v1.MaintenanceService.CreateMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
+	173, // 271: alerting.routing.v1.MaintenanceService.GetMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
+	95,  // 272: alerting.routing.v1.MaintenanceService.ListMaintenanceWindows:output_type -> alerting.routing.v1.ListMaintenanceWindowsResponse
+	173, // 273: alerting.routing.v1.MaintenanceService.UpdateMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
+	98,  // 274: alerting.routing.v1.MaintenanceService.DeleteMaintenanceWindow:output_type -> alerting.routing.v1.DeleteMaintenanceWindowResponse
+	95,  // 275: alerting.routing.v1.MaintenanceService.ListActiveMaintenanceWindows:output_type -> alerting.routing.v1.ListMaintenanceWindowsResponse
+	103, // 276: alerting.routing.v1.MaintenanceService.CheckAlertMaintenance:output_type -> alerting.routing.v1.CheckAlertMaintenanceResponse
+	173, // 277: alerting.routing.v1.MaintenanceService.ExpandMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
+	173, // 278: alerting.routing.v1.MaintenanceService.ApproveMaintenanceWindow:output_type -> alerting.routing.v1.MaintenanceWindow
+	177, // 279: alerting.routing.v1.EscalationService.CreateEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
+	177, // 280: alerting.routing.v1.EscalationService.GetEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
+	107, // 281: alerting.routing.v1.EscalationService.ListEscalationPolicies:output_type -> alerting.routing.v1.ListEscalationPoliciesResponse
+	177, // 282: alerting.routing.v1.EscalationService.UpdateEscalationPolicy:output_type -> alerting.routing.v1.EscalationPolicy
+	110, // 283: alerting.routing.v1.EscalationService.DeleteEscalationPolicy:output_type -> alerting.routing.v1.DeleteEscalationPolicyResponse
+	112, // 284: alerting.routing.v1.EscalationService.StartEscalation:output_type -> alerting.routing.v1.StartEscalationResponse
+	114, // 285: alerting.routing.v1.EscalationService.GetEscalationStatus:output_type -> alerting.routing.v1.EscalationStatus
+	117, // 286: alerting.routing.v1.EscalationService.StopEscalation:output_type -> alerting.routing.v1.StopEscalationResponse
+	178, // 287: alerting.routing.v1.CustomerTierService.CreateCustomerTier:output_type -> alerting.routing.v1.CustomerTier
+	178, // 288: alerting.routing.v1.CustomerTierService.GetCustomerTier:output_type -> alerting.routing.v1.CustomerTier
+	121, // 289: alerting.routing.v1.CustomerTierService.ListCustomerTiers:output_type -> alerting.routing.v1.ListCustomerTiersResponse
+	178, // 290: alerting.routing.v1.CustomerTierService.UpdateCustomerTier:output_type -> alerting.routing.v1.CustomerTier
+	124, // 291: alerting.routing.v1.CustomerTierService.DeleteCustomerTier:output_type -> alerting.routing.v1.DeleteCustomerTierResponse
+	126, // 292: alerting.routing.v1.CustomerTierService.ResolveCustomerTier:output_type -> alerting.routing.v1.ResolveCustomerTierResponse
+	179, // 293: alerting.routing.v1.CarrierService.CreateCarrier:output_type -> alerting.routing.v1.CarrierConfig
+	179, // 294: alerting.routing.v1.CarrierService.GetCarrier:output_type -> alerting.routing.v1.CarrierConfig
+	131, // 295: alerting.routing.v1.CarrierService.ListCarriers:output_type -> alerting.routing.v1.ListCarriersResponse
+	179, // 296: alerting.routing.v1.CarrierService.UpdateCarrier:output_type -> alerting.routing.v1.CarrierConfig
+	134, // 297: alerting.routing.v1.CarrierService.DeleteCarrier:output_type -> alerting.routing.v1.DeleteCarrierResponse
+	179, // 298: alerting.routing.v1.CarrierService.GetCarrierByASN:output_type -> alerting.routing.v1.CarrierConfig
+	180, // 299: alerting.routing.v1.EquipmentTypeService.CreateEquipmentType:output_type -> alerting.routing.v1.EquipmentType
+	180, // 300: alerting.routing.v1.EquipmentTypeService.GetEquipmentType:output_type -> alerting.routing.v1.EquipmentType
+	180, // 301: alerting.routing.v1.EquipmentTypeService.GetEquipmentTypeByName:output_type -> alerting.routing.v1.EquipmentType
+	139, // 302: alerting.routing.v1.EquipmentTypeService.ListEquipmentTypes:output_type -> alerting.routing.v1.ListEquipmentTypesResponse
+	180, // 303: alerting.routing.v1.EquipmentTypeService.UpdateEquipmentType:output_type -> alerting.routing.v1.EquipmentType
+	142, // 304: alerting.routing.v1.EquipmentTypeService.DeleteEquipmentType:output_type -> alerting.routing.v1.DeleteEquipmentTypeResponse
+	144, // 305: alerting.routing.v1.EquipmentTypeService.ResolveEquipmentType:output_type -> alerting.routing.v1.ResolveEquipmentTypeResponse
+	217, // [217:306] is the sub-list for method output_type
+	128, // [128:217] is the sub-list for method input_type
+	128, // [128:128] is the sub-list for extension type_name
+	128, // [128:128] is the sub-list for extension extendee
+	0,   // [0:128] is the sub-list for field type_name
 }
 
 func init() { file_alerting_routing_v1_routing_service_proto_init() }
@@ -7557,7 +9325,7 @@ func file_alerting_routing_v1_routing_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alerting_routing_v1_routing_service_proto_rawDesc), len(file_alerting_routing_v1_routing_service_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   121,
+			NumMessages:   149,
 			NumExtensions: 0,
 			NumServices:   9,
 		},
@@ -22,16 +22,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoutingService_CreateRoutingRule_FullMethodName   = "/alerting.routing.v1.RoutingService/CreateRoutingRule"
-	RoutingService_GetRoutingRule_FullMethodName      = "/alerting.routing.v1.RoutingService/GetRoutingRule"
-	RoutingService_ListRoutingRules_FullMethodName    = "/alerting.routing.v1.RoutingService/ListRoutingRules"
-	RoutingService_UpdateRoutingRule_FullMethodName   = "/alerting.routing.v1.RoutingService/UpdateRoutingRule"
-	RoutingService_DeleteRoutingRule_FullMethodName   = "/alerting.routing.v1.RoutingService/DeleteRoutingRule"
-	RoutingService_ReorderRoutingRules_FullMethodName = "/alerting.routing.v1.RoutingService/ReorderRoutingRules"
-	RoutingService_TestRoutingRule_FullMethodName     = "/alerting.routing.v1.RoutingService/TestRoutingRule"
-	RoutingService_SimulateRouting_FullMethodName     = "/alerting.routing.v1.RoutingService/SimulateRouting"
-	RoutingService_GetRoutingAuditLogs_FullMethodName = "/alerting.routing.v1.RoutingService/GetRoutingAuditLogs"
-	RoutingService_RouteAlert_FullMethodName          = "/alerting.routing.v1.RoutingService/RouteAlert"
+	RoutingService_CreateRoutingRule_FullMethodName     = "/alerting.routing.v1.RoutingService/CreateRoutingRule"
+	RoutingService_GetRoutingRule_FullMethodName        = "/alerting.routing.v1.RoutingService/GetRoutingRule"
+	RoutingService_ListRoutingRules_FullMethodName      = "/alerting.routing.v1.RoutingService/ListRoutingRules"
+	RoutingService_UpdateRoutingRule_FullMethodName     = "/alerting.routing.v1.RoutingService/UpdateRoutingRule"
+	RoutingService_DeleteRoutingRule_FullMethodName     = "/alerting.routing.v1.RoutingService/DeleteRoutingRule"
+	RoutingService_ReorderRoutingRules_FullMethodName   = "/alerting.routing.v1.RoutingService/ReorderRoutingRules"
+	RoutingService_CompactRulePriorities_FullMethodName = "/alerting.routing.v1.RoutingService/CompactRulePriorities"
+	RoutingService_TestRoutingRule_FullMethodName       = "/alerting.routing.v1.RoutingService/TestRoutingRule"
+	RoutingService_DryRunRoutingRule_FullMethodName     = "/alerting.routing.v1.RoutingService/DryRunRoutingRule"
+	RoutingService_DetectRuleConflicts_FullMethodName   = "/alerting.routing.v1.RoutingService/DetectRuleConflicts"
+	RoutingService_SimulateRouting_FullMethodName       = "/alerting.routing.v1.RoutingService/SimulateRouting"
+	RoutingService_GetRoutingAuditLogs_FullMethodName   = "/alerting.routing.v1.RoutingService/GetRoutingAuditLogs"
+	RoutingService_RouteAlert_FullMethodName            = "/alerting.routing.v1.RoutingService/RouteAlert"
 )
 
 // RoutingServiceClient is the client API for RoutingService service.
@@ -52,8 +55,14 @@ type RoutingServiceClient interface {
 	DeleteRoutingRule(ctx context.Context, in *DeleteRoutingRuleRequest, opts ...grpc.CallOption) (*DeleteRoutingRuleResponse, error)
 	// Reorder routing rules (update priorities)
 	ReorderRoutingRules(ctx context.Context, in *ReorderRoutingRulesRequest, opts ...grpc.CallOption) (*ReorderRoutingRulesResponse, error)
+	// Renumber routing rule priorities sequentially (1, 2, 3...) keeping their order
+	CompactRulePriorities(ctx context.Context, in *CompactRulePrioritiesRequest, opts ...grpc.CallOption) (*CompactRulePrioritiesResponse, error)
 	// Test a routing rule against sample alert (dry-run)
 	TestRoutingRule(ctx context.Context, in *TestRoutingRuleRequest, opts ...grpc.CallOption) (*TestRoutingRuleResponse, error)
+	// Test a routing rule against the currently triggered alerts (dry-run)
+	DryRunRoutingRule(ctx context.Context, in *DryRunRoutingRuleRequest, opts ...grpc.CallOption) (*DryRunRoutingRuleResponse, error)
+	// Find enabled rules that can fire for the same alerts as a rule
+	DetectRuleConflicts(ctx context.Context, in *DetectRuleConflictsRequest, opts ...grpc.CallOption) (*DetectRuleConflictsResponse, error)
 	// Simulate routing for an alert (shows which rules would match)
 	SimulateRouting(ctx context.Context, in *SimulateRoutingRequest, opts ...grpc.CallOption) (*SimulateRoutingResponse, error)
 	// Get routing audit logs
@@ -130,6 +139,16 @@ func (c *routingServiceClient) ReorderRoutingRules(ctx context.Context, in *Reor
 	return out, nil
 }
 
+func (c *routingServiceClient) CompactRulePriorities(ctx context.Context, in *CompactRulePrioritiesRequest, opts ...grpc.CallOption) (*CompactRulePrioritiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompactRulePrioritiesResponse)
+	err := c.cc.Invoke(ctx, RoutingService_CompactRulePriorities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routingServiceClient) TestRoutingRule(ctx context.Context, in *TestRoutingRuleRequest, opts ...grpc.CallOption) (*TestRoutingRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TestRoutingRuleResponse)
@@ -140,6 +159,26 @@ func (c *routingServiceClient) TestRoutingRule(ctx context.Context, in *TestRout
 	return out, nil
 }
 
+func (c *routingServiceClient) DryRunRoutingRule(ctx context.Context, in *DryRunRoutingRuleRequest, opts ...grpc.CallOption) (*DryRunRoutingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DryRunRoutingRuleResponse)
+	err := c.cc.Invoke(ctx, RoutingService_DryRunRoutingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routingServiceClient) DetectRuleConflicts(ctx context.Context, in *DetectRuleConflictsRequest, opts ...grpc.CallOption) (*DetectRuleConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectRuleConflictsResponse)
+	err := c.cc.Invoke(ctx, RoutingService_DetectRuleConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routingServiceClient) SimulateRouting(ctx context.Context, in *SimulateRoutingRequest, opts ...grpc.CallOption) (*SimulateRoutingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SimulateRoutingResponse)
@@ -188,8 +227,14 @@ type RoutingServiceServer interface {
 	DeleteRoutingRule(context.Context, *DeleteRoutingRuleRequest) (*DeleteRoutingRuleResponse, error)
 	// Reorder routing rules (update priorities)
 	ReorderRoutingRules(context.Context, *ReorderRoutingRulesRequest) (*ReorderRoutingRulesResponse, error)
+	// Renumber routing rule priorities sequentially (1, 2, 3...) keeping their order
+	CompactRulePriorities(context.Context, *CompactRulePrioritiesRequest) (*CompactRulePrioritiesResponse, error)
 	// Test a routing rule against sample alert (dry-run)
 	TestRoutingRule(context.Context, *TestRoutingRuleRequest) (*TestRoutingRuleResponse, error)
+	// Test a routing rule against the currently triggered alerts (dry-run)
+	DryRunRoutingRule(context.Context, *DryRunRoutingRuleRequest) (*DryRunRoutingRuleResponse, error)
+	// Find enabled rules that can fire for the same alerts as a rule
+	DetectRuleConflicts(context.Context, *DetectRuleConflictsRequest) (*DetectRuleConflictsResponse, error)
 	// Simulate routing for an alert (shows which rules would match)
 	SimulateRouting(context.Context, *SimulateRoutingRequest) (*SimulateRoutingResponse, error)
 	// Get routing audit logs
@@ -224,9 +269,18 @@ func (UnimplementedRoutingServiceServer) DeleteRoutingRule(context.Context, *Del
 func (UnimplementedRoutingServiceServer) ReorderRoutingRules(context.Context, *ReorderRoutingRulesRequest) (*ReorderRoutingRulesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReorderRoutingRules not implemented")
 }
+func (UnimplementedRoutingServiceServer) CompactRulePriorities(context.Context, *CompactRulePrioritiesRequest) (*CompactRulePrioritiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompactRulePriorities not implemented")
+}
 func (UnimplementedRoutingServiceServer) TestRoutingRule(context.Context, *TestRoutingRuleRequest) (*TestRoutingRuleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TestRoutingRule not implemented")
 }
+func (UnimplementedRoutingServiceServer) DryRunRoutingRule(context.Context, *DryRunRoutingRuleRequest) (*DryRunRoutingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DryRunRoutingRule not implemented")
+}
+func (UnimplementedRoutingServiceServer) DetectRuleConflicts(context.Context, *DetectRuleConflictsRequest) (*DetectRuleConflictsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetectRuleConflicts not implemented")
+}
 func (UnimplementedRoutingServiceServer) SimulateRouting(context.Context, *SimulateRoutingRequest) (*SimulateRoutingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SimulateRouting not implemented")
 }
@@ -365,6 +419,24 @@ func _RoutingService_ReorderRoutingRules_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoutingService_CompactRulePriorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactRulePrioritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutingServiceServer).CompactRulePriorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutingService_CompactRulePriorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutingServiceServer).CompactRulePriorities(ctx, req.(*CompactRulePrioritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoutingService_TestRoutingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestRoutingRuleRequest)
 	if err := dec(in); err != nil {
@@ -383,6 +455,42 @@ func _RoutingService_TestRoutingRule_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoutingService_DryRunRoutingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunRoutingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutingServiceServer).DryRunRoutingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutingService_DryRunRoutingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutingServiceServer).DryRunRoutingRule(ctx, req.(*DryRunRoutingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutingService_DetectRuleConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectRuleConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutingServiceServer).DetectRuleConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutingService_DetectRuleConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutingServiceServer).DetectRuleConflicts(ctx, req.(*DetectRuleConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoutingService_SimulateRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimulateRoutingRequest)
 	if err := dec(in); err != nil {
@@ -468,10 +576,22 @@ var RoutingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReorderRoutingRules",
 			Handler:    _RoutingService_ReorderRoutingRules_Handler,
 		},
+		{
+			MethodName: "CompactRulePriorities",
+			Handler:    _RoutingService_CompactRulePriorities_Handler,
+		},
 		{
 			MethodName: "TestRoutingRule",
 			Handler:    _RoutingService_TestRoutingRule_Handler,
 		},
+		{
+			MethodName: "DryRunRoutingRule",
+			Handler:    _RoutingService_DryRunRoutingRule_Handler,
+		},
+		{
+			MethodName: "DetectRuleConflicts",
+			Handler:    _RoutingService_DetectRuleConflicts_Handler,
+		},
 		{
 			MethodName: "SimulateRouting",
 			Handler:    _RoutingService_SimulateRouting_Handler,
@@ -490,15 +610,17 @@ var RoutingService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TeamService_CreateTeam_FullMethodName       = "/alerting.routing.v1.TeamService/CreateTeam"
-	TeamService_GetTeam_FullMethodName          = "/alerting.routing.v1.TeamService/GetTeam"
-	TeamService_ListTeams_FullMethodName        = "/alerting.routing.v1.TeamService/ListTeams"
-	TeamService_UpdateTeam_FullMethodName       = "/alerting.routing.v1.TeamService/UpdateTeam"
-	TeamService_DeleteTeam_FullMethodName       = "/alerting.routing.v1.TeamService/DeleteTeam"
-	TeamService_AddTeamMember_FullMethodName    = "/alerting.routing.v1.TeamService/AddTeamMember"
-	TeamService_RemoveTeamMember_FullMethodName = "/alerting.routing.v1.TeamService/RemoveTeamMember"
-	TeamService_UpdateTeamMember_FullMethodName = "/alerting.routing.v1.TeamService/UpdateTeamMember"
-	TeamService_GetUserTeams_FullMethodName     = "/alerting.routing.v1.TeamService/GetUserTeams"
+	TeamService_CreateTeam_FullMethodName          = "/alerting.routing.v1.TeamService/CreateTeam"
+	TeamService_GetTeam_FullMethodName             = "/alerting.routing.v1.TeamService/GetTeam"
+	TeamService_ListTeams_FullMethodName           = "/alerting.routing.v1.TeamService/ListTeams"
+	TeamService_UpdateTeam_FullMethodName          = "/alerting.routing.v1.TeamService/UpdateTeam"
+	TeamService_DeleteTeam_FullMethodName          = "/alerting.routing.v1.TeamService/DeleteTeam"
+	TeamService_AddTeamMember_FullMethodName       = "/alerting.routing.v1.TeamService/AddTeamMember"
+	TeamService_RemoveTeamMember_FullMethodName    = "/alerting.routing.v1.TeamService/RemoveTeamMember"
+	TeamService_UpdateTeamMember_FullMethodName    = "/alerting.routing.v1.TeamService/UpdateTeamMember"
+	TeamService_GetUserTeams_FullMethodName        = "/alerting.routing.v1.TeamService/GetUserTeams"
+	TeamService_SetUserAvailability_FullMethodName = "/alerting.routing.v1.TeamService/SetUserAvailability"
+	TeamService_GetTeamAvailability_FullMethodName = "/alerting.routing.v1.TeamService/GetTeamAvailability"
 )
 
 // TeamServiceClient is the client API for TeamService service.
@@ -517,6 +639,9 @@ type TeamServiceClient interface {
 	UpdateTeamMember(ctx context.Context, in *UpdateTeamMemberRequest, opts ...grpc.CallOption) (*Team, error)
 	// Get teams for a user
 	GetUserTeams(ctx context.Context, in *GetUserTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// Member availability for on-call shifts
+	SetUserAvailability(ctx context.Context, in *SetUserAvailabilityRequest, opts ...grpc.CallOption) (*UserAvailability, error)
+	GetTeamAvailability(ctx context.Context, in *GetTeamAvailabilityRequest, opts ...grpc.CallOption) (*GetTeamAvailabilityResponse, error)
 }
 
 type teamServiceClient struct {
@@ -617,6 +742,26 @@ func (c *teamServiceClient) GetUserTeams(ctx context.Context, in *GetUserTeamsRe
 	return out, nil
 }
 
+func (c *teamServiceClient) SetUserAvailability(ctx context.Context, in *SetUserAvailabilityRequest, opts ...grpc.CallOption) (*UserAvailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserAvailability)
+	err := c.cc.Invoke(ctx, TeamService_SetUserAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeamAvailability(ctx context.Context, in *GetTeamAvailabilityRequest, opts ...grpc.CallOption) (*GetTeamAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamAvailabilityResponse)
+	err := c.cc.Invoke(ctx, TeamService_GetTeamAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeamServiceServer is the server API for TeamService service.
 // All implementations must embed UnimplementedTeamServiceServer
 // for forward compatibility.
@@ -633,6 +778,9 @@ type TeamServiceServer interface {
 	UpdateTeamMember(context.Context, *UpdateTeamMemberRequest) (*Team, error)
 	// Get teams for a user
 	GetUserTeams(context.Context, *GetUserTeamsRequest) (*ListTeamsResponse, error)
+	// Member availability for on-call shifts
+	SetUserAvailability(context.Context, *SetUserAvailabilityRequest) (*UserAvailability, error)
+	GetTeamAvailability(context.Context, *GetTeamAvailabilityRequest) (*GetTeamAvailabilityResponse, error)
 	mustEmbedUnimplementedTeamServiceServer()
 }
 
@@ -670,6 +818,12 @@ func (UnimplementedTeamServiceServer) UpdateTeamMember(context.Context, *UpdateT
 func (UnimplementedTeamServiceServer) GetUserTeams(context.Context, *GetUserTeamsRequest) (*ListTeamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserTeams not implemented")
 }
+func (UnimplementedTeamServiceServer) SetUserAvailability(context.Context, *SetUserAvailabilityRequest) (*UserAvailability, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserAvailability not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeamAvailability(context.Context, *GetTeamAvailabilityRequest) (*GetTeamAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamAvailability not implemented")
+}
 func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
 func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
 
@@ -853,6 +1007,42 @@ func _TeamService_GetUserTeams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeamService_SetUserAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetUserAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetUserAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetUserAvailability(ctx, req.(*SetUserAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeamAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeamAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetTeamAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeamAvailability(ctx, req.(*GetTeamAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -896,28 +1086,42 @@ var TeamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserTeams",
 			Handler:    _TeamService_GetUserTeams_Handler,
 		},
+		{
+			MethodName: "SetUserAvailability",
+			Handler:    _TeamService_SetUserAvailability_Handler,
+		},
+		{
+			MethodName: "GetTeamAvailability",
+			Handler:    _TeamService_GetTeamAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alerting/routing/v1/routing_service.proto",
 }
 
 const (
-	ScheduleService_CreateSchedule_FullMethodName     = "/alerting.routing.v1.ScheduleService/CreateSchedule"
-	ScheduleService_GetSchedule_FullMethodName        = "/alerting.routing.v1.ScheduleService/GetSchedule"
-	ScheduleService_ListSchedules_FullMethodName      = "/alerting.routing.v1.ScheduleService/ListSchedules"
-	ScheduleService_UpdateSchedule_FullMethodName     = "/alerting.routing.v1.ScheduleService/UpdateSchedule"
-	ScheduleService_DeleteSchedule_FullMethodName     = "/alerting.routing.v1.ScheduleService/DeleteSchedule"
-	ScheduleService_AddRotation_FullMethodName        = "/alerting.routing.v1.ScheduleService/AddRotation"
-	ScheduleService_UpdateRotation_FullMethodName     = "/alerting.routing.v1.ScheduleService/UpdateRotation"
-	ScheduleService_RemoveRotation_FullMethodName     = "/alerting.routing.v1.ScheduleService/RemoveRotation"
-	ScheduleService_CreateOverride_FullMethodName     = "/alerting.routing.v1.ScheduleService/CreateOverride"
-	ScheduleService_DeleteOverride_FullMethodName     = "/alerting.routing.v1.ScheduleService/DeleteOverride"
-	ScheduleService_ListOverrides_FullMethodName      = "/alerting.routing.v1.ScheduleService/ListOverrides"
-	ScheduleService_GetCurrentOnCall_FullMethodName   = "/alerting.routing.v1.ScheduleService/GetCurrentOnCall"
-	ScheduleService_GetOnCallAtTime_FullMethodName    = "/alerting.routing.v1.ScheduleService/GetOnCallAtTime"
-	ScheduleService_ListUpcomingShifts_FullMethodName = "/alerting.routing.v1.ScheduleService/ListUpcomingShifts"
-	ScheduleService_AcknowledgeHandoff_FullMethodName = "/alerting.routing.v1.ScheduleService/AcknowledgeHandoff"
-	ScheduleService_GetHandoffSummary_FullMethodName  = "/alerting.routing.v1.ScheduleService/GetHandoffSummary"
+	ScheduleService_CreateSchedule_FullMethodName         = "/alerting.routing.v1.ScheduleService/CreateSchedule"
+	ScheduleService_GetSchedule_FullMethodName            = "/alerting.routing.v1.ScheduleService/GetSchedule"
+	ScheduleService_ListSchedules_FullMethodName          = "/alerting.routing.v1.ScheduleService/ListSchedules"
+	ScheduleService_UpdateSchedule_FullMethodName         = "/alerting.routing.v1.ScheduleService/UpdateSchedule"
+	ScheduleService_DeleteSchedule_FullMethodName         = "/alerting.routing.v1.ScheduleService/DeleteSchedule"
+	ScheduleService_AddRotation_FullMethodName            = "/alerting.routing.v1.ScheduleService/AddRotation"
+	ScheduleService_UpdateRotation_FullMethodName         = "/alerting.routing.v1.ScheduleService/UpdateRotation"
+	ScheduleService_RemoveRotation_FullMethodName         = "/alerting.routing.v1.ScheduleService/RemoveRotation"
+	ScheduleService_ReorderRotationMembers_FullMethodName = "/alerting.routing.v1.ScheduleService/ReorderRotationMembers"
+	ScheduleService_CreateOverride_FullMethodName         = "/alerting.routing.v1.ScheduleService/CreateOverride"
+	ScheduleService_BulkCreateOverrides_FullMethodName    = "/alerting.routing.v1.ScheduleService/BulkCreateOverrides"
+	ScheduleService_DeleteOverride_FullMethodName         = "/alerting.routing.v1.ScheduleService/DeleteOverride"
+	ScheduleService_SplitOverride_FullMethodName          = "/alerting.routing.v1.ScheduleService/SplitOverride"
+	ScheduleService_ListOverrides_FullMethodName          = "/alerting.routing.v1.ScheduleService/ListOverrides"
+	ScheduleService_GetCurrentOnCall_FullMethodName       = "/alerting.routing.v1.ScheduleService/GetCurrentOnCall"
+	ScheduleService_GetOnCallAtTime_FullMethodName        = "/alerting.routing.v1.ScheduleService/GetOnCallAtTime"
+	ScheduleService_ListUpcomingShifts_FullMethodName     = "/alerting.routing.v1.ScheduleService/ListUpcomingShifts"
+	ScheduleService_GetCoverageDepth_FullMethodName       = "/alerting.routing.v1.ScheduleService/GetCoverageDepth"
+	ScheduleService_PreviewRotation_FullMethodName        = "/alerting.routing.v1.ScheduleService/PreviewRotation"
+	ScheduleService_GetLoadBalance_FullMethodName         = "/alerting.routing.v1.ScheduleService/GetLoadBalance"
+	ScheduleService_AcknowledgeHandoff_FullMethodName     = "/alerting.routing.v1.ScheduleService/AcknowledgeHandoff"
+	ScheduleService_GetHandoffSummary_FullMethodName      = "/alerting.routing.v1.ScheduleService/GetHandoffSummary"
 )
 
 // ScheduleServiceClient is the client API for ScheduleService service.
@@ -934,14 +1138,20 @@ type ScheduleServiceClient interface {
 	AddRotation(ctx context.Context, in *AddRotationRequest, opts ...grpc.CallOption) (*Schedule, error)
 	UpdateRotation(ctx context.Context, in *UpdateRotationRequest, opts ...grpc.CallOption) (*Schedule, error)
 	RemoveRotation(ctx context.Context, in *RemoveRotationRequest, opts ...grpc.CallOption) (*Schedule, error)
+	ReorderRotationMembers(ctx context.Context, in *ReorderRotationMembersRequest, opts ...grpc.CallOption) (*Rotation, error)
 	// Override management
 	CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error)
+	BulkCreateOverrides(ctx context.Context, in *BulkCreateOverridesRequest, opts ...grpc.CallOption) (*BulkCreateOverridesResponse, error)
 	DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*DeleteOverrideResponse, error)
+	SplitOverride(ctx context.Context, in *SplitOverrideRequest, opts ...grpc.CallOption) (*SplitOverrideResponse, error)
 	ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error)
 	// On-call queries
 	GetCurrentOnCall(ctx context.Context, in *GetCurrentOnCallRequest, opts ...grpc.CallOption) (*GetCurrentOnCallResponse, error)
 	GetOnCallAtTime(ctx context.Context, in *GetOnCallAtTimeRequest, opts ...grpc.CallOption) (*GetOnCallAtTimeResponse, error)
 	ListUpcomingShifts(ctx context.Context, in *ListUpcomingShiftsRequest, opts ...grpc.CallOption) (*ListUpcomingShiftsResponse, error)
+	GetCoverageDepth(ctx context.Context, in *GetCoverageDepthRequest, opts ...grpc.CallOption) (*GetCoverageDepthResponse, error)
+	PreviewRotation(ctx context.Context, in *PreviewRotationRequest, opts ...grpc.CallOption) (*ListUpcomingShiftsResponse, error)
+	GetLoadBalance(ctx context.Context, in *GetLoadBalanceRequest, opts ...grpc.CallOption) (*GetLoadBalanceResponse, error)
 	// Handoff
 	AcknowledgeHandoff(ctx context.Context, in *AcknowledgeHandoffRequest, opts ...grpc.CallOption) (*AcknowledgeHandoffResponse, error)
 	GetHandoffSummary(ctx context.Context, in *GetHandoffSummaryRequest, opts ...grpc.CallOption) (*HandoffSummary, error)
@@ -1035,6 +1245,16 @@ func (c *scheduleServiceClient) RemoveRotation(ctx context.Context, in *RemoveRo
 	return out, nil
 }
 
+func (c *scheduleServiceClient) ReorderRotationMembers(ctx context.Context, in *ReorderRotationMembersRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, ScheduleService_ReorderRotationMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ScheduleOverride)
@@ -1045,6 +1265,16 @@ func (c *scheduleServiceClient) CreateOverride(ctx context.Context, in *CreateOv
 	return out, nil
 }
 
+func (c *scheduleServiceClient) BulkCreateOverrides(ctx context.Context, in *BulkCreateOverridesRequest, opts ...grpc.CallOption) (*BulkCreateOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateOverridesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_BulkCreateOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*DeleteOverrideResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteOverrideResponse)
@@ -1055,6 +1285,16 @@ func (c *scheduleServiceClient) DeleteOverride(ctx context.Context, in *DeleteOv
 	return out, nil
 }
 
+func (c *scheduleServiceClient) SplitOverride(ctx context.Context, in *SplitOverrideRequest, opts ...grpc.CallOption) (*SplitOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitOverrideResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_SplitOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListOverridesResponse)
@@ -1095,6 +1335,36 @@ func (c *scheduleServiceClient) ListUpcomingShifts(ctx context.Context, in *List
 	return out, nil
 }
 
+func (c *scheduleServiceClient) GetCoverageDepth(ctx context.Context, in *GetCoverageDepthRequest, opts ...grpc.CallOption) (*GetCoverageDepthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoverageDepthResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetCoverageDepth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) PreviewRotation(ctx context.Context, in *PreviewRotationRequest, opts ...grpc.CallOption) (*ListUpcomingShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingShiftsResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_PreviewRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetLoadBalance(ctx context.Context, in *GetLoadBalanceRequest, opts ...grpc.CallOption) (*GetLoadBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoadBalanceResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetLoadBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) AcknowledgeHandoff(ctx context.Context, in *AcknowledgeHandoffRequest, opts ...grpc.CallOption) (*AcknowledgeHandoffResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AcknowledgeHandoffResponse)
@@ -1129,14 +1399,20 @@ type ScheduleServiceServer interface {
 	AddRotation(context.Context, *AddRotationRequest) (*Schedule, error)
 	UpdateRotation(context.Context, *UpdateRotationRequest) (*Schedule, error)
 	RemoveRotation(context.Context, *RemoveRotationRequest) (*Schedule, error)
+	ReorderRotationMembers(context.Context, *ReorderRotationMembersRequest) (*Rotation, error)
 	// Override management
 	CreateOverride(context.Context, *CreateOverrideRequest) (*ScheduleOverride, error)
+	BulkCreateOverrides(context.Context, *BulkCreateOverridesRequest) (*BulkCreateOverridesResponse, error)
 	DeleteOverride(context.Context, *DeleteOverrideRequest) (*DeleteOverrideResponse, error)
+	SplitOverride(context.Context, *SplitOverrideRequest) (*SplitOverrideResponse, error)
 	ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error)
 	// On-call queries
 	GetCurrentOnCall(context.Context, *GetCurrentOnCallRequest) (*GetCurrentOnCallResponse, error)
 	GetOnCallAtTime(context.Context, *GetOnCallAtTimeRequest) (*GetOnCallAtTimeResponse, error)
 	ListUpcomingShifts(context.Context, *ListUpcomingShiftsRequest) (*ListUpcomingShiftsResponse, error)
+	GetCoverageDepth(context.Context, *GetCoverageDepthRequest) (*GetCoverageDepthResponse, error)
+	PreviewRotation(context.Context, *PreviewRotationRequest) (*ListUpcomingShiftsResponse, error)
+	GetLoadBalance(context.Context, *GetLoadBalanceRequest) (*GetLoadBalanceResponse, error)
 	// Handoff
 	AcknowledgeHandoff(context.Context, *AcknowledgeHandoffRequest) (*AcknowledgeHandoffResponse, error)
 	GetHandoffSummary(context.Context, *GetHandoffSummaryRequest) (*HandoffSummary, error)
@@ -1174,12 +1450,21 @@ func (UnimplementedScheduleServiceServer) UpdateRotation(context.Context, *Updat
 func (UnimplementedScheduleServiceServer) RemoveRotation(context.Context, *RemoveRotationRequest) (*Schedule, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveRotation not implemented")
 }
+func (UnimplementedScheduleServiceServer) ReorderRotationMembers(context.Context, *ReorderRotationMembersRequest) (*Rotation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderRotationMembers not implemented")
+}
 func (UnimplementedScheduleServiceServer) CreateOverride(context.Context, *CreateOverrideRequest) (*ScheduleOverride, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateOverride not implemented")
 }
+func (UnimplementedScheduleServiceServer) BulkCreateOverrides(context.Context, *BulkCreateOverridesRequest) (*BulkCreateOverridesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkCreateOverrides not implemented")
+}
 func (UnimplementedScheduleServiceServer) DeleteOverride(context.Context, *DeleteOverrideRequest) (*DeleteOverrideResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteOverride not implemented")
 }
+func (UnimplementedScheduleServiceServer) SplitOverride(context.Context, *SplitOverrideRequest) (*SplitOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SplitOverride not implemented")
+}
 func (UnimplementedScheduleServiceServer) ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListOverrides not implemented")
 }
@@ -1192,6 +1477,15 @@ func (UnimplementedScheduleServiceServer) GetOnCallAtTime(context.Context, *GetO
 func (UnimplementedScheduleServiceServer) ListUpcomingShifts(context.Context, *ListUpcomingShiftsRequest) (*ListUpcomingShiftsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUpcomingShifts not implemented")
 }
+func (UnimplementedScheduleServiceServer) GetCoverageDepth(context.Context, *GetCoverageDepthRequest) (*GetCoverageDepthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCoverageDepth not implemented")
+}
+func (UnimplementedScheduleServiceServer) PreviewRotation(context.Context, *PreviewRotationRequest) (*ListUpcomingShiftsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewRotation not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetLoadBalance(context.Context, *GetLoadBalanceRequest) (*GetLoadBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLoadBalance not implemented")
+}
 func (UnimplementedScheduleServiceServer) AcknowledgeHandoff(context.Context, *AcknowledgeHandoffRequest) (*AcknowledgeHandoffResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcknowledgeHandoff not implemented")
 }
@@ -1363,6 +1657,24 @@ func _ScheduleService_RemoveRotation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_ReorderRotationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRotationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ReorderRotationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ReorderRotationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ReorderRotationMembers(ctx, req.(*ReorderRotationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_CreateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOverrideRequest)
 	if err := dec(in); err != nil {
@@ -1381,6 +1693,24 @@ func _ScheduleService_CreateOverride_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_BulkCreateOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).BulkCreateOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_BulkCreateOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).BulkCreateOverrides(ctx, req.(*BulkCreateOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_DeleteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteOverrideRequest)
 	if err := dec(in); err != nil {
@@ -1399,6 +1729,24 @@ func _ScheduleService_DeleteOverride_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_SplitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).SplitOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_SplitOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).SplitOverride(ctx, req.(*SplitOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_ListOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOverridesRequest)
 	if err := dec(in); err != nil {
@@ -1471,6 +1819,60 @@ func _ScheduleService_ListUpcomingShifts_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_GetCoverageDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoverageDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetCoverageDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetCoverageDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetCoverageDepth(ctx, req.(*GetCoverageDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_PreviewRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).PreviewRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_PreviewRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).PreviewRotation(ctx, req.(*PreviewRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetLoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetLoadBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetLoadBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetLoadBalance(ctx, req.(*GetLoadBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_AcknowledgeHandoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AcknowledgeHandoffRequest)
 	if err := dec(in); err != nil {
@@ -1546,14 +1948,26 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRotation",
 			Handler:    _ScheduleService_RemoveRotation_Handler,
 		},
+		{
+			MethodName: "ReorderRotationMembers",
+			Handler:    _ScheduleService_ReorderRotationMembers_Handler,
+		},
 		{
 			MethodName: "CreateOverride",
 			Handler:    _ScheduleService_CreateOverride_Handler,
 		},
+		{
+			MethodName: "BulkCreateOverrides",
+			Handler:    _ScheduleService_BulkCreateOverrides_Handler,
+		},
 		{
 			MethodName: "DeleteOverride",
 			Handler:    _ScheduleService_DeleteOverride_Handler,
 		},
+		{
+			MethodName: "SplitOverride",
+			Handler:    _ScheduleService_SplitOverride_Handler,
+		},
 		{
 			MethodName: "ListOverrides",
 			Handler:    _ScheduleService_ListOverrides_Handler,
@@ -1570,6 +1984,18 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUpcomingShifts",
 			Handler:    _ScheduleService_ListUpcomingShifts_Handler,
 		},
+		{
+			MethodName: "GetCoverageDepth",
+			Handler:    _ScheduleService_GetCoverageDepth_Handler,
+		},
+		{
+			MethodName: "PreviewRotation",
+			Handler:    _ScheduleService_PreviewRotation_Handler,
+		},
+		{
+			MethodName: "GetLoadBalance",
+			Handler:    _ScheduleService_GetLoadBalance_Handler,
+		},
 		{
 			MethodName: "AcknowledgeHandoff",
 			Handler:    _ScheduleService_AcknowledgeHandoff_Handler,
@@ -1584,12 +2010,13 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	SiteService_CreateSite_FullMethodName    = "/alerting.routing.v1.SiteService/CreateSite"
-	SiteService_GetSite_FullMethodName       = "/alerting.routing.v1.SiteService/GetSite"
-	SiteService_ListSites_FullMethodName     = "/alerting.routing.v1.SiteService/ListSites"
-	SiteService_UpdateSite_FullMethodName    = "/alerting.routing.v1.SiteService/UpdateSite"
-	SiteService_DeleteSite_FullMethodName    = "/alerting.routing.v1.SiteService/DeleteSite"
-	SiteService_GetSiteByCode_FullMethodName = "/alerting.routing.v1.SiteService/GetSiteByCode"
+	SiteService_CreateSite_FullMethodName         = "/alerting.routing.v1.SiteService/CreateSite"
+	SiteService_GetSite_FullMethodName            = "/alerting.routing.v1.SiteService/GetSite"
+	SiteService_ListSites_FullMethodName          = "/alerting.routing.v1.SiteService/ListSites"
+	SiteService_UpdateSite_FullMethodName         = "/alerting.routing.v1.SiteService/UpdateSite"
+	SiteService_DeleteSite_FullMethodName         = "/alerting.routing.v1.SiteService/DeleteSite"
+	SiteService_GetSiteByCode_FullMethodName      = "/alerting.routing.v1.SiteService/GetSiteByCode"
+	SiteService_UpdateSiteCapacity_FullMethodName = "/alerting.routing.v1.SiteService/UpdateSiteCapacity"
 )
 
 // SiteServiceClient is the client API for SiteService service.
@@ -1603,6 +2030,8 @@ type SiteServiceClient interface {
 	DeleteSite(ctx context.Context, in *DeleteSiteRequest, opts ...grpc.CallOption) (*DeleteSiteResponse, error)
 	// Get site by code (e.g., "IAD1")
 	GetSiteByCode(ctx context.Context, in *GetSiteByCodeRequest, opts ...grpc.CallOption) (*Site, error)
+	// Update site capacity metrics
+	UpdateSiteCapacity(ctx context.Context, in *UpdateSiteCapacityRequest, opts ...grpc.CallOption) (*Site, error)
 }
 
 type siteServiceClient struct {
@@ -1673,6 +2102,16 @@ func (c *siteServiceClient) GetSiteByCode(ctx context.Context, in *GetSiteByCode
 	return out, nil
 }
 
+func (c *siteServiceClient) UpdateSiteCapacity(ctx context.Context, in *UpdateSiteCapacityRequest, opts ...grpc.CallOption) (*Site, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Site)
+	err := c.cc.Invoke(ctx, SiteService_UpdateSiteCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SiteServiceServer is the server API for SiteService service.
 // All implementations must embed UnimplementedSiteServiceServer
 // for forward compatibility.
@@ -1684,6 +2123,8 @@ type SiteServiceServer interface {
 	DeleteSite(context.Context, *DeleteSiteRequest) (*DeleteSiteResponse, error)
 	// Get site by code (e.g., "IAD1")
 	GetSiteByCode(context.Context, *GetSiteByCodeRequest) (*Site, error)
+	// Update site capacity metrics
+	UpdateSiteCapacity(context.Context, *UpdateSiteCapacityRequest) (*Site, error)
 	mustEmbedUnimplementedSiteServiceServer()
 }
 
@@ -1712,6 +2153,9 @@ func (UnimplementedSiteServiceServer) DeleteSite(context.Context, *DeleteSiteReq
 func (UnimplementedSiteServiceServer) GetSiteByCode(context.Context, *GetSiteByCodeRequest) (*Site, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSiteByCode not implemented")
 }
+func (UnimplementedSiteServiceServer) UpdateSiteCapacity(context.Context, *UpdateSiteCapacityRequest) (*Site, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSiteCapacity not implemented")
+}
 func (UnimplementedSiteServiceServer) mustEmbedUnimplementedSiteServiceServer() {}
 func (UnimplementedSiteServiceServer) testEmbeddedByValue()                     {}
 
@@ -1841,6 +2285,24 @@ func _SiteService_GetSiteByCode_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SiteService_UpdateSiteCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSiteCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).UpdateSiteCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_UpdateSiteCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).UpdateSiteCapacity(ctx, req.(*UpdateSiteCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SiteService_ServiceDesc is the grpc.ServiceDesc for SiteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1872,6 +2334,10 @@ var SiteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSiteByCode",
 			Handler:    _SiteService_GetSiteByCode_Handler,
 		},
+		{
+			MethodName: "UpdateSiteCapacity",
+			Handler:    _SiteService_UpdateSiteCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alerting/routing/v1/routing_service.proto",
@@ -1885,6 +2351,8 @@ const (
 	MaintenanceService_DeleteMaintenanceWindow_FullMethodName      = "/alerting.routing.v1.MaintenanceService/DeleteMaintenanceWindow"
 	MaintenanceService_ListActiveMaintenanceWindows_FullMethodName = "/alerting.routing.v1.MaintenanceService/ListActiveMaintenanceWindows"
 	MaintenanceService_CheckAlertMaintenance_FullMethodName        = "/alerting.routing.v1.MaintenanceService/CheckAlertMaintenance"
+	MaintenanceService_ExpandMaintenanceWindow_FullMethodName      = "/alerting.routing.v1.MaintenanceService/ExpandMaintenanceWindow"
+	MaintenanceService_ApproveMaintenanceWindow_FullMethodName     = "/alerting.routing.v1.MaintenanceService/ApproveMaintenanceWindow"
 )
 
 // MaintenanceServiceClient is the client API for MaintenanceService service.
@@ -1900,6 +2368,10 @@ type MaintenanceServiceClient interface {
 	ListActiveMaintenanceWindows(ctx context.Context, in *ListActiveMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
 	// Check if alert is in maintenance
 	CheckAlertMaintenance(ctx context.Context, in *CheckAlertMaintenanceRequest, opts ...grpc.CallOption) (*CheckAlertMaintenanceResponse, error)
+	// Add sites and services to an in-progress window and optionally extend it
+	ExpandMaintenanceWindow(ctx context.Context, in *ExpandMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	// Record change-board approval of a window pending approval and schedule it
+	ApproveMaintenanceWindow(ctx context.Context, in *ApproveMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
 }
 
 type maintenanceServiceClient struct {
@@ -1980,6 +2452,26 @@ func (c *maintenanceServiceClient) CheckAlertMaintenance(ctx context.Context, in
 	return out, nil
 }
 
+func (c *maintenanceServiceClient) ExpandMaintenanceWindow(ctx context.Context, in *ExpandMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, MaintenanceService_ExpandMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) ApproveMaintenanceWindow(ctx context.Context, in *ApproveMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, MaintenanceService_ApproveMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaintenanceServiceServer is the server API for MaintenanceService service.
 // All implementations must embed UnimplementedMaintenanceServiceServer
 // for forward compatibility.
@@ -1993,6 +2485,10 @@ type MaintenanceServiceServer interface {
 	ListActiveMaintenanceWindows(context.Context, *ListActiveMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
 	// Check if alert is in maintenance
 	CheckAlertMaintenance(context.Context, *CheckAlertMaintenanceRequest) (*CheckAlertMaintenanceResponse, error)
+	// Add sites and services to an in-progress window and optionally extend it
+	ExpandMaintenanceWindow(context.Context, *ExpandMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	// Record change-board approval of a window pending approval and schedule it
+	ApproveMaintenanceWindow(context.Context, *ApproveMaintenanceWindowRequest) (*MaintenanceWindow, error)
 	mustEmbedUnimplementedMaintenanceServiceServer()
 }
 
@@ -2024,6 +2520,12 @@ func (UnimplementedMaintenanceServiceServer) ListActiveMaintenanceWindows(contex
 func (UnimplementedMaintenanceServiceServer) CheckAlertMaintenance(context.Context, *CheckAlertMaintenanceRequest) (*CheckAlertMaintenanceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckAlertMaintenance not implemented")
 }
+func (UnimplementedMaintenanceServiceServer) ExpandMaintenanceWindow(context.Context, *ExpandMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExpandMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) ApproveMaintenanceWindow(context.Context, *ApproveMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveMaintenanceWindow not implemented")
+}
 func (UnimplementedMaintenanceServiceServer) mustEmbedUnimplementedMaintenanceServiceServer() {}
 func (UnimplementedMaintenanceServiceServer) testEmbeddedByValue()                            {}
 
@@ -2171,6 +2673,42 @@ func _MaintenanceService_CheckAlertMaintenance_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MaintenanceService_ExpandMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ExpandMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ExpandMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ExpandMaintenanceWindow(ctx, req.(*ExpandMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_ApproveMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ApproveMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ApproveMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ApproveMaintenanceWindow(ctx, req.(*ApproveMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MaintenanceService_ServiceDesc is the grpc.ServiceDesc for MaintenanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2206,6 +2744,14 @@ var MaintenanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAlertMaintenance",
 			Handler:    _MaintenanceService_CheckAlertMaintenance_Handler,
 		},
+		{
+			MethodName: "ExpandMaintenanceWindow",
+			Handler:    _MaintenanceService_ExpandMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ApproveMaintenanceWindow",
+			Handler:    _MaintenanceService_ApproveMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alerting/routing/v1/routing_service.proto",
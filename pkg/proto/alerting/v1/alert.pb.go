@@ -23,6 +23,85 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SourceFormat int32
+
+const (
+	SourceFormat_SOURCE_FORMAT_UNSPECIFIED      SourceFormat = 0
+	SourceFormat_SOURCE_FORMAT_ALERTMANAGER     SourceFormat = 1
+	SourceFormat_SOURCE_FORMAT_GRAFANA          SourceFormat = 2
+	SourceFormat_SOURCE_FORMAT_GENERIC          SourceFormat = 3
+	SourceFormat_SOURCE_FORMAT_SENTRY           SourceFormat = 4
+	SourceFormat_SOURCE_FORMAT_KUBERNETES_EVENT SourceFormat = 5
+	SourceFormat_SOURCE_FORMAT_INTERNAL         SourceFormat = 6 // Raised by the alerting system itself
+	SourceFormat_SOURCE_FORMAT_GCP_MONITORING   SourceFormat = 7
+	SourceFormat_SOURCE_FORMAT_NEW_RELIC        SourceFormat = 8
+	SourceFormat_SOURCE_FORMAT_PAGERDUTY        SourceFormat = 9
+	SourceFormat_SOURCE_FORMAT_OPSGENIE         SourceFormat = 10
+	SourceFormat_SOURCE_FORMAT_ZABBIX           SourceFormat = 11
+	SourceFormat_SOURCE_FORMAT_VICTOROPS        SourceFormat = 12
+)
+
+// Enum value maps for SourceFormat.
+var (
+	SourceFormat_name = map[int32]string{
+		0:  "SOURCE_FORMAT_UNSPECIFIED",
+		1:  "SOURCE_FORMAT_ALERTMANAGER",
+		2:  "SOURCE_FORMAT_GRAFANA",
+		3:  "SOURCE_FORMAT_GENERIC",
+		4:  "SOURCE_FORMAT_SENTRY",
+		5:  "SOURCE_FORMAT_KUBERNETES_EVENT",
+		6:  "SOURCE_FORMAT_INTERNAL",
+		7:  "SOURCE_FORMAT_GCP_MONITORING",
+		8:  "SOURCE_FORMAT_NEW_RELIC",
+		9:  "SOURCE_FORMAT_PAGERDUTY",
+		10: "SOURCE_FORMAT_OPSGENIE",
+		11: "SOURCE_FORMAT_ZABBIX",
+		12: "SOURCE_FORMAT_VICTOROPS",
+	}
+	SourceFormat_value = map[string]int32{
+		"SOURCE_FORMAT_UNSPECIFIED":      0,
+		"SOURCE_FORMAT_ALERTMANAGER":     1,
+		"SOURCE_FORMAT_GRAFANA":          2,
+		"SOURCE_FORMAT_GENERIC":          3,
+		"SOURCE_FORMAT_SENTRY":           4,
+		"SOURCE_FORMAT_KUBERNETES_EVENT": 5,
+		"SOURCE_FORMAT_INTERNAL":         6,
+		"SOURCE_FORMAT_GCP_MONITORING":   7,
+		"SOURCE_FORMAT_NEW_RELIC":        8,
+		"SOURCE_FORMAT_PAGERDUTY":        9,
+		"SOURCE_FORMAT_OPSGENIE":         10,
+		"SOURCE_FORMAT_ZABBIX":           11,
+		"SOURCE_FORMAT_VICTOROPS":        12,
+	}
+)
+
+func (x SourceFormat) Enum() *SourceFormat {
+	p := new(SourceFormat)
+	*p = x
+	return p
+}
+
+func (x SourceFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_alerting_v1_alert_proto_enumTypes[0].Descriptor()
+}
+
+func (SourceFormat) Type() protoreflect.EnumType {
+	return &file_alerting_v1_alert_proto_enumTypes[0]
+}
+
+func (x SourceFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceFormat.Descriptor instead.
+func (SourceFormat) EnumDescriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{0}
+}
+
 type AlertStatus int32
 
 const (
@@ -62,11 +141,11 @@ func (x AlertStatus) String() string {
 }
 
 func (AlertStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_v1_alert_proto_enumTypes[0].Descriptor()
+	return file_alerting_v1_alert_proto_enumTypes[1].Descriptor()
 }
 
 func (AlertStatus) Type() protoreflect.EnumType {
-	return &file_alerting_v1_alert_proto_enumTypes[0]
+	return &file_alerting_v1_alert_proto_enumTypes[1]
 }
 
 func (x AlertStatus) Number() protoreflect.EnumNumber {
@@ -75,37 +154,58 @@ func (x AlertStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertStatus.Descriptor instead.
 func (AlertStatus) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{0}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{1}
 }
 
 type AlertSource int32
 
 const (
-	AlertSource_ALERT_SOURCE_UNSPECIFIED  AlertSource = 0
-	AlertSource_ALERT_SOURCE_PROMETHEUS   AlertSource = 1
-	AlertSource_ALERT_SOURCE_ALERTMANAGER AlertSource = 2
-	AlertSource_ALERT_SOURCE_GRAFANA      AlertSource = 3
-	AlertSource_ALERT_SOURCE_GENERIC      AlertSource = 4
-	AlertSource_ALERT_SOURCE_MANUAL       AlertSource = 5
+	AlertSource_ALERT_SOURCE_UNSPECIFIED    AlertSource = 0
+	AlertSource_ALERT_SOURCE_PROMETHEUS     AlertSource = 1
+	AlertSource_ALERT_SOURCE_ALERTMANAGER   AlertSource = 2
+	AlertSource_ALERT_SOURCE_GRAFANA        AlertSource = 3
+	AlertSource_ALERT_SOURCE_GENERIC        AlertSource = 4
+	AlertSource_ALERT_SOURCE_MANUAL         AlertSource = 5
+	AlertSource_ALERT_SOURCE_SENTRY         AlertSource = 6
+	AlertSource_ALERT_SOURCE_GCP_MONITORING AlertSource = 7
+	AlertSource_ALERT_SOURCE_NEW_RELIC      AlertSource = 8
+	AlertSource_ALERT_SOURCE_PAGERDUTY      AlertSource = 9
+	AlertSource_ALERT_SOURCE_OPSGENIE       AlertSource = 10
+	AlertSource_ALERT_SOURCE_ZABBIX         AlertSource = 11
+	AlertSource_ALERT_SOURCE_VICTOROPS      AlertSource = 12
 )
 
 // Enum value maps for AlertSource.
 var (
 	AlertSource_name = map[int32]string{
-		0: "ALERT_SOURCE_UNSPECIFIED",
-		1: "ALERT_SOURCE_PROMETHEUS",
-		2: "ALERT_SOURCE_ALERTMANAGER",
-		3: "ALERT_SOURCE_GRAFANA",
-		4: "ALERT_SOURCE_GENERIC",
-		5: "ALERT_SOURCE_MANUAL",
+		0:  "ALERT_SOURCE_UNSPECIFIED",
+		1:  "ALERT_SOURCE_PROMETHEUS",
+		2:  "ALERT_SOURCE_ALERTMANAGER",
+		3:  "ALERT_SOURCE_GRAFANA",
+		4:  "ALERT_SOURCE_GENERIC",
+		5:  "ALERT_SOURCE_MANUAL",
+		6:  "ALERT_SOURCE_SENTRY",
+		7:  "ALERT_SOURCE_GCP_MONITORING",
+		8:  "ALERT_SOURCE_NEW_RELIC",
+		9:  "ALERT_SOURCE_PAGERDUTY",
+		10: "ALERT_SOURCE_OPSGENIE",
+		11: "ALERT_SOURCE_ZABBIX",
+		12: "ALERT_SOURCE_VICTOROPS",
 	}
 	AlertSource_value = map[string]int32{
-		"ALERT_SOURCE_UNSPECIFIED":  0,
-		"ALERT_SOURCE_PROMETHEUS":   1,
-		"ALERT_SOURCE_ALERTMANAGER": 2,
-		"ALERT_SOURCE_GRAFANA":      3,
-		"ALERT_SOURCE_GENERIC":      4,
-		"ALERT_SOURCE_MANUAL":       5,
+		"ALERT_SOURCE_UNSPECIFIED":    0,
+		"ALERT_SOURCE_PROMETHEUS":     1,
+		"ALERT_SOURCE_ALERTMANAGER":   2,
+		"ALERT_SOURCE_GRAFANA":        3,
+		"ALERT_SOURCE_GENERIC":        4,
+		"ALERT_SOURCE_MANUAL":         5,
+		"ALERT_SOURCE_SENTRY":         6,
+		"ALERT_SOURCE_GCP_MONITORING": 7,
+		"ALERT_SOURCE_NEW_RELIC":      8,
+		"ALERT_SOURCE_PAGERDUTY":      9,
+		"ALERT_SOURCE_OPSGENIE":       10,
+		"ALERT_SOURCE_ZABBIX":         11,
+		"ALERT_SOURCE_VICTOROPS":      12,
 	}
 )
 
@@ -120,11 +220,11 @@ func (x AlertSource) String() string {
 }
 
 func (AlertSource) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_v1_alert_proto_enumTypes[1].Descriptor()
+	return file_alerting_v1_alert_proto_enumTypes[2].Descriptor()
 }
 
 func (AlertSource) Type() protoreflect.EnumType {
-	return &file_alerting_v1_alert_proto_enumTypes[1]
+	return &file_alerting_v1_alert_proto_enumTypes[2]
 }
 
 func (x AlertSource) Number() protoreflect.EnumNumber {
@@ -133,7 +233,7 @@ func (x AlertSource) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertSource.Descriptor instead.
 func (AlertSource) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{1}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{2}
 }
 
 type Severity int32
@@ -178,11 +278,11 @@ func (x Severity) String() string {
 }
 
 func (Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_v1_alert_proto_enumTypes[2].Descriptor()
+	return file_alerting_v1_alert_proto_enumTypes[3].Descriptor()
 }
 
 func (Severity) Type() protoreflect.EnumType {
-	return &file_alerting_v1_alert_proto_enumTypes[2]
+	return &file_alerting_v1_alert_proto_enumTypes[3]
 }
 
 func (x Severity) Number() protoreflect.EnumNumber {
@@ -191,7 +291,62 @@ func (x Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity.Descriptor instead.
 func (Severity) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{2}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{3}
+}
+
+type MatchOperator int32
+
+const (
+	MatchOperator_MATCH_OPERATOR_UNSPECIFIED MatchOperator = 0
+	MatchOperator_MATCH_OPERATOR_EQUAL       MatchOperator = 1 // =
+	MatchOperator_MATCH_OPERATOR_NOT_EQUAL   MatchOperator = 2 // !=
+	MatchOperator_MATCH_OPERATOR_REGEX       MatchOperator = 3 // =~, anchored at both ends
+	MatchOperator_MATCH_OPERATOR_NOT_REGEX   MatchOperator = 4 // !~, anchored at both ends
+)
+
+// Enum value maps for MatchOperator.
+var (
+	MatchOperator_name = map[int32]string{
+		0: "MATCH_OPERATOR_UNSPECIFIED",
+		1: "MATCH_OPERATOR_EQUAL",
+		2: "MATCH_OPERATOR_NOT_EQUAL",
+		3: "MATCH_OPERATOR_REGEX",
+		4: "MATCH_OPERATOR_NOT_REGEX",
+	}
+	MatchOperator_value = map[string]int32{
+		"MATCH_OPERATOR_UNSPECIFIED": 0,
+		"MATCH_OPERATOR_EQUAL":       1,
+		"MATCH_OPERATOR_NOT_EQUAL":   2,
+		"MATCH_OPERATOR_REGEX":       3,
+		"MATCH_OPERATOR_NOT_REGEX":   4,
+	}
+)
+
+func (x MatchOperator) Enum() *MatchOperator {
+	p := new(MatchOperator)
+	*p = x
+	return p
+}
+
+func (x MatchOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_alerting_v1_alert_proto_enumTypes[4].Descriptor()
+}
+
+func (MatchOperator) Type() protoreflect.EnumType {
+	return &file_alerting_v1_alert_proto_enumTypes[4]
+}
+
+func (x MatchOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchOperator.Descriptor instead.
+func (MatchOperator) EnumDescriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{4}
 }
 
 type AlertEventType int32
@@ -245,11 +400,11 @@ func (x AlertEventType) String() string {
 }
 
 func (AlertEventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_alerting_v1_alert_proto_enumTypes[3].Descriptor()
+	return file_alerting_v1_alert_proto_enumTypes[5].Descriptor()
 }
 
 func (AlertEventType) Type() protoreflect.EnumType {
-	return &file_alerting_v1_alert_proto_enumTypes[3]
+	return &file_alerting_v1_alert_proto_enumTypes[5]
 }
 
 func (x AlertEventType) Number() protoreflect.EnumNumber {
@@ -258,7 +413,7 @@ func (x AlertEventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlertEventType.Descriptor instead.
 func (AlertEventType) EnumDescriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{3}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{5}
 }
 
 // Alert represents an alert in the system
@@ -273,10 +428,12 @@ type Alert struct {
 	// Source
 	Source         AlertSource `protobuf:"varint,6,opt,name=source,proto3,enum=alerting.v1.AlertSource" json:"source,omitempty"`
 	SourceInstance string      `protobuf:"bytes,7,opt,name=source_instance,json=sourceInstance,proto3" json:"source_instance,omitempty"` // e.g., prometheus server name
+	SourceAlertId  string      `protobuf:"bytes,26,opt,name=source_alert_id,json=sourceAlertId,proto3" json:"source_alert_id,omitempty"` // ID of the alert in the source system, e.g. the Opsgenie alertId
 	// Classification
 	ServiceId   string            `protobuf:"bytes,8,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // Service this alert belongs to
 	Labels      map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Annotations map[string]string `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RunbookUrl  string            `protobuf:"bytes,27,opt,name=runbook_url,json=runbookUrl,proto3" json:"runbook_url,omitempty"` // From the Alertmanager v5 runbook_url annotation
 	// Lifecycle
 	Status         AlertStatus            `protobuf:"varint,11,opt,name=status,proto3,enum=alerting.v1.AlertStatus" json:"status,omitempty"`
 	TriggeredAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
@@ -293,7 +450,17 @@ type Alert struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Webhook payload (original)
-	RawPayload    *structpb.Struct `protobuf:"bytes,22,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	RawPayload *structpb.Struct `protobuf:"bytes,22,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	// Maintenance window active when the alert was created
+	MaintenanceWindowId   string `protobuf:"bytes,23,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenance_window_id,omitempty"`
+	MaintenanceWindowName string `protobuf:"bytes,24,opt,name=maintenance_window_name,json=maintenanceWindowName,proto3" json:"maintenance_window_name,omitempty"`
+	// How and where the alert was received
+	IngestionMetadata *IngestionMetadata `protobuf:"bytes,25,opt,name=ingestion_metadata,json=ingestionMetadata,proto3" json:"ingestion_metadata,omitempty"`
+	// Response deadline from the customer tier SLA for the alert severity
+	SlaDeadline *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=sla_deadline,json=slaDeadline,proto3" json:"sla_deadline,omitempty"`
+	SlaBreached bool                   `protobuf:"varint,29,opt,name=sla_breached,json=slaBreached,proto3" json:"sla_breached,omitempty"` // Still triggered after sla_deadline
+	// Correlation group of the open alerts sharing its group_by label values
+	GroupId       string `protobuf:"bytes,30,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -377,6 +544,13 @@ func (x *Alert) GetSourceInstance() string {
 	return ""
 }
 
+func (x *Alert) GetSourceAlertId() string {
+	if x != nil {
+		return x.SourceAlertId
+	}
+	return ""
+}
+
 func (x *Alert) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
@@ -398,6 +572,13 @@ func (x *Alert) GetAnnotations() map[string]string {
 	return nil
 }
 
+func (x *Alert) GetRunbookUrl() string {
+	if x != nil {
+		return x.RunbookUrl
+	}
+	return ""
+}
+
 func (x *Alert) GetStatus() AlertStatus {
 	if x != nil {
 		return x.Status
@@ -482,6 +663,117 @@ func (x *Alert) GetRawPayload() *structpb.Struct {
 	return nil
 }
 
+func (x *Alert) GetMaintenanceWindowId() string {
+	if x != nil {
+		return x.MaintenanceWindowId
+	}
+	return ""
+}
+
+func (x *Alert) GetMaintenanceWindowName() string {
+	if x != nil {
+		return x.MaintenanceWindowName
+	}
+	return ""
+}
+
+func (x *Alert) GetIngestionMetadata() *IngestionMetadata {
+	if x != nil {
+		return x.IngestionMetadata
+	}
+	return nil
+}
+
+func (x *Alert) GetSlaDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaDeadline
+	}
+	return nil
+}
+
+func (x *Alert) GetSlaBreached() bool {
+	if x != nil {
+		return x.SlaBreached
+	}
+	return false
+}
+
+func (x *Alert) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+// IngestionMetadata records which webhook received an alert.
+type IngestionMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceFormat    SourceFormat           `protobuf:"varint,1,opt,name=source_format,json=sourceFormat,proto3,enum=alerting.v1.SourceFormat" json:"source_format,omitempty"`
+	IngestEndpoint  string                 `protobuf:"bytes,2,opt,name=ingest_endpoint,json=ingestEndpoint,proto3" json:"ingest_endpoint,omitempty"` // Route path, e.g. /api/v1/webhook/grafana/:integration_key
+	IngestTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ingest_timestamp,json=ingestTimestamp,proto3" json:"ingest_timestamp,omitempty"`
+	RawPayloadHash  string                 `protobuf:"bytes,4,opt,name=raw_payload_hash,json=rawPayloadHash,proto3" json:"raw_payload_hash,omitempty"` // Hex SHA-256 of the raw request body
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IngestionMetadata) Reset() {
+	*x = IngestionMetadata{}
+	mi := &file_alerting_v1_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionMetadata) ProtoMessage() {}
+
+func (x *IngestionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionMetadata.ProtoReflect.Descriptor instead.
+func (*IngestionMetadata) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IngestionMetadata) GetSourceFormat() SourceFormat {
+	if x != nil {
+		return x.SourceFormat
+	}
+	return SourceFormat_SOURCE_FORMAT_UNSPECIFIED
+}
+
+func (x *IngestionMetadata) GetIngestEndpoint() string {
+	if x != nil {
+		return x.IngestEndpoint
+	}
+	return ""
+}
+
+func (x *IngestionMetadata) GetIngestTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IngestTimestamp
+	}
+	return nil
+}
+
+func (x *IngestionMetadata) GetRawPayloadHash() string {
+	if x != nil {
+		return x.RawPayloadHash
+	}
+	return ""
+}
+
 type AlertNote struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -494,7 +786,7 @@ type AlertNote struct {
 
 func (x *AlertNote) Reset() {
 	*x = AlertNote{}
-	mi := &file_alerting_v1_alert_proto_msgTypes[1]
+	mi := &file_alerting_v1_alert_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +798,7 @@ func (x *AlertNote) String() string {
 func (*AlertNote) ProtoMessage() {}
 
 func (x *AlertNote) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_v1_alert_proto_msgTypes[1]
+	mi := &file_alerting_v1_alert_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +811,7 @@ func (x *AlertNote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlertNote.ProtoReflect.Descriptor instead.
 func (*AlertNote) Descriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{1}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AlertNote) GetId() string {
@@ -550,6 +842,245 @@ func (x *AlertNote) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// A note left on an alert by an engineer during an incident
+type AlertComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlertId       string                 `protobuf:"bytes,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // User ID
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertComment) Reset() {
+	*x = AlertComment{}
+	mi := &file_alerting_v1_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertComment) ProtoMessage() {}
+
+func (x *AlertComment) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertComment.ProtoReflect.Descriptor instead.
+func (*AlertComment) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AlertComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertComment) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *AlertComment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AlertComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AlertComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Matches alerts by the value of one label; a missing label has the empty value
+type LabelMatcher struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Operator      MatchOperator          `protobuf:"varint,3,opt,name=operator,proto3,enum=alerting.v1.MatchOperator" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelMatcher) Reset() {
+	*x = LabelMatcher{}
+	mi := &file_alerting_v1_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelMatcher) ProtoMessage() {}
+
+func (x *LabelMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelMatcher.ProtoReflect.Descriptor instead.
+func (*LabelMatcher) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LabelMatcher) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LabelMatcher) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LabelMatcher) GetOperator() MatchOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return MatchOperator_MATCH_OPERATOR_UNSPECIFIED
+}
+
+// Suppresses every incoming alert matching all of its matchers while active
+type SilenceRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Matchers      []*LabelMatcher        `protobuf:"bytes,2,rep,name=matchers,proto3" json:"matchers,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // User ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SilenceRule) Reset() {
+	*x = SilenceRule{}
+	mi := &file_alerting_v1_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SilenceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SilenceRule) ProtoMessage() {}
+
+func (x *SilenceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SilenceRule.ProtoReflect.Descriptor instead.
+func (*SilenceRule) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SilenceRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SilenceRule) GetMatchers() []*LabelMatcher {
+	if x != nil {
+		return x.Matchers
+	}
+	return nil
+}
+
+func (x *SilenceRule) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SilenceRule) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SilenceRule) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SilenceRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SilenceRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SilenceRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type AlertEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -564,7 +1095,7 @@ type AlertEvent struct {
 
 func (x *AlertEvent) Reset() {
 	*x = AlertEvent{}
-	mi := &file_alerting_v1_alert_proto_msgTypes[2]
+	mi := &file_alerting_v1_alert_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +1107,7 @@ func (x *AlertEvent) String() string {
 func (*AlertEvent) ProtoMessage() {}
 
 func (x *AlertEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_alerting_v1_alert_proto_msgTypes[2]
+	mi := &file_alerting_v1_alert_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +1120,7 @@ func (x *AlertEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
 func (*AlertEvent) Descriptor() ([]byte, []int) {
-	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{2}
+	return file_alerting_v1_alert_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AlertEvent) GetId() string {
@@ -638,7 +1169,7 @@ var File_alerting_v1_alert_proto protoreflect.FileDescriptor
 
 const file_alerting_v1_alert_proto_rawDesc = "" +
 	"\n" +
-	"\x17alerting/v1/alert.proto\x12\valerting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x83\t\n" +
+	"\x17alerting/v1/alert.proto\x12\valerting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x84\f\n" +
 	"\x05Alert\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x18\n" +
@@ -646,12 +1177,15 @@ const file_alerting_v1_alert_proto_rawDesc = "" +
 	"\adetails\x18\x04 \x01(\tR\adetails\x121\n" +
 	"\bseverity\x18\x05 \x01(\x0e2\x15.alerting.v1.SeverityR\bseverity\x120\n" +
 	"\x06source\x18\x06 \x01(\x0e2\x18.alerting.v1.AlertSourceR\x06source\x12'\n" +
-	"\x0fsource_instance\x18\a \x01(\tR\x0esourceInstance\x12\x1d\n" +
+	"\x0fsource_instance\x18\a \x01(\tR\x0esourceInstance\x12&\n" +
+	"\x0fsource_alert_id\x18\x1a \x01(\tR\rsourceAlertId\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\b \x01(\tR\tserviceId\x126\n" +
 	"\x06labels\x18\t \x03(\v2\x1e.alerting.v1.Alert.LabelsEntryR\x06labels\x12E\n" +
 	"\vannotations\x18\n" +
-	" \x03(\v2#.alerting.v1.Alert.AnnotationsEntryR\vannotations\x120\n" +
+	" \x03(\v2#.alerting.v1.Alert.AnnotationsEntryR\vannotations\x12\x1f\n" +
+	"\vrunbook_url\x18\x1b \x01(\tR\n" +
+	"runbookUrl\x120\n" +
 	"\x06status\x18\v \x01(\x0e2\x18.alerting.v1.AlertStatusR\x06status\x12=\n" +
 	"\ftriggered_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\x12C\n" +
 	"\x0facknowledged_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\x12;\n" +
@@ -668,20 +1202,54 @@ const file_alerting_v1_alert_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x128\n" +
 	"\vraw_payload\x18\x16 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"rawPayload\x1a9\n" +
+	"rawPayload\x122\n" +
+	"\x15maintenance_window_id\x18\x17 \x01(\tR\x13maintenanceWindowId\x126\n" +
+	"\x17maintenance_window_name\x18\x18 \x01(\tR\x15maintenanceWindowName\x12M\n" +
+	"\x12ingestion_metadata\x18\x19 \x01(\v2\x1e.alerting.v1.IngestionMetadataR\x11ingestionMetadata\x12=\n" +
+	"\fsla_deadline\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\vslaDeadline\x12!\n" +
+	"\fsla_breached\x18\x1d \x01(\bR\vslaBreached\x12\x19\n" +
+	"\bgroup_id\x18\x1e \x01(\tR\agroupId\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xed\x01\n" +
+	"\x11IngestionMetadata\x12>\n" +
+	"\rsource_format\x18\x01 \x01(\x0e2\x19.alerting.v1.SourceFormatR\fsourceFormat\x12'\n" +
+	"\x0fingest_endpoint\x18\x02 \x01(\tR\x0eingestEndpoint\x12E\n" +
+	"\x10ingest_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fingestTimestamp\x12(\n" +
+	"\x10raw_payload_hash\x18\x04 \x01(\tR\x0erawPayloadHash\"\x8f\x01\n" +
 	"\tAlertNote\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc4\x02\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa5\x01\n" +
+	"\fAlertComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\tR\aalertId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"p\n" +
+	"\fLabelMatcher\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x126\n" +
+	"\boperator\x18\x03 \x01(\x0e2\x1a.alerting.v1.MatchOperatorR\boperator\"\xf1\x02\n" +
+	"\vSilenceRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\bmatchers\x18\x02 \x03(\v2\x19.alerting.v1.LabelMatcherR\bmatchers\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc4\x02\n" +
 	"\n" +
 	"AlertEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
@@ -692,27 +1260,56 @@ const file_alerting_v1_alert_proto_rawDesc = "" +
 	"\bmetadata\x18\x06 \x03(\v2%.alerting.v1.AlertEvent.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x9e\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x8c\x03\n" +
+	"\fSourceFormat\x12\x1d\n" +
+	"\x19SOURCE_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSOURCE_FORMAT_ALERTMANAGER\x10\x01\x12\x19\n" +
+	"\x15SOURCE_FORMAT_GRAFANA\x10\x02\x12\x19\n" +
+	"\x15SOURCE_FORMAT_GENERIC\x10\x03\x12\x18\n" +
+	"\x14SOURCE_FORMAT_SENTRY\x10\x04\x12\"\n" +
+	"\x1eSOURCE_FORMAT_KUBERNETES_EVENT\x10\x05\x12\x1a\n" +
+	"\x16SOURCE_FORMAT_INTERNAL\x10\x06\x12 \n" +
+	"\x1cSOURCE_FORMAT_GCP_MONITORING\x10\a\x12\x1b\n" +
+	"\x17SOURCE_FORMAT_NEW_RELIC\x10\b\x12\x1b\n" +
+	"\x17SOURCE_FORMAT_PAGERDUTY\x10\t\x12\x1a\n" +
+	"\x16SOURCE_FORMAT_OPSGENIE\x10\n" +
+	"\x12\x18\n" +
+	"\x14SOURCE_FORMAT_ZABBIX\x10\v\x12\x1b\n" +
+	"\x17SOURCE_FORMAT_VICTOROPS\x10\f*\x9e\x01\n" +
 	"\vAlertStatus\x12\x1c\n" +
 	"\x18ALERT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ALERT_STATUS_TRIGGERED\x10\x01\x12\x1d\n" +
 	"\x19ALERT_STATUS_ACKNOWLEDGED\x10\x02\x12\x19\n" +
 	"\x15ALERT_STATUS_RESOLVED\x10\x03\x12\x1b\n" +
-	"\x17ALERT_STATUS_SUPPRESSED\x10\x04*\xb4\x01\n" +
+	"\x17ALERT_STATUS_SUPPRESSED\x10\x04*\xf6\x02\n" +
 	"\vAlertSource\x12\x1c\n" +
 	"\x18ALERT_SOURCE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17ALERT_SOURCE_PROMETHEUS\x10\x01\x12\x1d\n" +
 	"\x19ALERT_SOURCE_ALERTMANAGER\x10\x02\x12\x18\n" +
 	"\x14ALERT_SOURCE_GRAFANA\x10\x03\x12\x18\n" +
 	"\x14ALERT_SOURCE_GENERIC\x10\x04\x12\x17\n" +
-	"\x13ALERT_SOURCE_MANUAL\x10\x05*\x88\x01\n" +
+	"\x13ALERT_SOURCE_MANUAL\x10\x05\x12\x17\n" +
+	"\x13ALERT_SOURCE_SENTRY\x10\x06\x12\x1f\n" +
+	"\x1bALERT_SOURCE_GCP_MONITORING\x10\a\x12\x1a\n" +
+	"\x16ALERT_SOURCE_NEW_RELIC\x10\b\x12\x1a\n" +
+	"\x16ALERT_SOURCE_PAGERDUTY\x10\t\x12\x19\n" +
+	"\x15ALERT_SOURCE_OPSGENIE\x10\n" +
+	"\x12\x17\n" +
+	"\x13ALERT_SOURCE_ZABBIX\x10\v\x12\x1a\n" +
+	"\x16ALERT_SOURCE_VICTOROPS\x10\f*\x88\x01\n" +
 	"\bSeverity\x12\x18\n" +
 	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11SEVERITY_CRITICAL\x10\x01\x12\x11\n" +
 	"\rSEVERITY_HIGH\x10\x02\x12\x13\n" +
 	"\x0fSEVERITY_MEDIUM\x10\x03\x12\x10\n" +
 	"\fSEVERITY_LOW\x10\x04\x12\x11\n" +
-	"\rSEVERITY_INFO\x10\x05*\xb8\x02\n" +
+	"\rSEVERITY_INFO\x10\x05*\x9f\x01\n" +
+	"\rMatchOperator\x12\x1e\n" +
+	"\x1aMATCH_OPERATOR_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MATCH_OPERATOR_EQUAL\x10\x01\x12\x1c\n" +
+	"\x18MATCH_OPERATOR_NOT_EQUAL\x10\x02\x12\x18\n" +
+	"\x14MATCH_OPERATOR_REGEX\x10\x03\x12\x1c\n" +
+	"\x18MATCH_OPERATOR_NOT_REGEX\x10\x04*\xb8\x02\n" +
 	"\x0eAlertEventType\x12 \n" +
 	"\x1cALERT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18ALERT_EVENT_TYPE_CREATED\x10\x01\x12!\n" +
@@ -738,45 +1335,62 @@ func file_alerting_v1_alert_proto_rawDescGZIP() []byte {
 	return file_alerting_v1_alert_proto_rawDescData
 }
 
-var file_alerting_v1_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_alerting_v1_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_alerting_v1_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_alerting_v1_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_alerting_v1_alert_proto_goTypes = []any{
-	(AlertStatus)(0),              // 0: alerting.v1.AlertStatus
-	(AlertSource)(0),              // 1: alerting.v1.AlertSource
-	(Severity)(0),                 // 2: alerting.v1.Severity
-	(AlertEventType)(0),           // 3: alerting.v1.AlertEventType
-	(*Alert)(nil),                 // 4: alerting.v1.Alert
-	(*AlertNote)(nil),             // 5: alerting.v1.AlertNote
-	(*AlertEvent)(nil),            // 6: alerting.v1.AlertEvent
-	nil,                           // 7: alerting.v1.Alert.LabelsEntry
-	nil,                           // 8: alerting.v1.Alert.AnnotationsEntry
-	nil,                           // 9: alerting.v1.AlertEvent.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
+	(SourceFormat)(0),             // 0: alerting.v1.SourceFormat
+	(AlertStatus)(0),              // 1: alerting.v1.AlertStatus
+	(AlertSource)(0),              // 2: alerting.v1.AlertSource
+	(Severity)(0),                 // 3: alerting.v1.Severity
+	(MatchOperator)(0),            // 4: alerting.v1.MatchOperator
+	(AlertEventType)(0),           // 5: alerting.v1.AlertEventType
+	(*Alert)(nil),                 // 6: alerting.v1.Alert
+	(*IngestionMetadata)(nil),     // 7: alerting.v1.IngestionMetadata
+	(*AlertNote)(nil),             // 8: alerting.v1.AlertNote
+	(*AlertComment)(nil),          // 9: alerting.v1.AlertComment
+	(*LabelMatcher)(nil),          // 10: alerting.v1.LabelMatcher
+	(*SilenceRule)(nil),           // 11: alerting.v1.SilenceRule
+	(*AlertEvent)(nil),            // 12: alerting.v1.AlertEvent
+	nil,                           // 13: alerting.v1.Alert.LabelsEntry
+	nil,                           // 14: alerting.v1.Alert.AnnotationsEntry
+	nil,                           // 15: alerting.v1.AlertEvent.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 17: google.protobuf.Struct
 }
 var file_alerting_v1_alert_proto_depIdxs = []int32{
-	2,  // 0: alerting.v1.Alert.severity:type_name -> alerting.v1.Severity
-	1,  // 1: alerting.v1.Alert.source:type_name -> alerting.v1.AlertSource
-	7,  // 2: alerting.v1.Alert.labels:type_name -> alerting.v1.Alert.LabelsEntry
-	8,  // 3: alerting.v1.Alert.annotations:type_name -> alerting.v1.Alert.AnnotationsEntry
-	0,  // 4: alerting.v1.Alert.status:type_name -> alerting.v1.AlertStatus
-	10, // 5: alerting.v1.Alert.triggered_at:type_name -> google.protobuf.Timestamp
-	10, // 6: alerting.v1.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
-	10, // 7: alerting.v1.Alert.resolved_at:type_name -> google.protobuf.Timestamp
-	5,  // 8: alerting.v1.Alert.notes:type_name -> alerting.v1.AlertNote
-	6,  // 9: alerting.v1.Alert.events:type_name -> alerting.v1.AlertEvent
-	10, // 10: alerting.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
-	10, // 11: alerting.v1.Alert.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 12: alerting.v1.Alert.raw_payload:type_name -> google.protobuf.Struct
-	10, // 13: alerting.v1.AlertNote.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 14: alerting.v1.AlertEvent.type:type_name -> alerting.v1.AlertEventType
-	10, // 15: alerting.v1.AlertEvent.timestamp:type_name -> google.protobuf.Timestamp
-	9,  // 16: alerting.v1.AlertEvent.metadata:type_name -> alerting.v1.AlertEvent.MetadataEntry
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	3,  // 0: alerting.v1.Alert.severity:type_name -> alerting.v1.Severity
+	2,  // 1: alerting.v1.Alert.source:type_name -> alerting.v1.AlertSource
+	13, // 2: alerting.v1.Alert.labels:type_name -> alerting.v1.Alert.LabelsEntry
+	14, // 3: alerting.v1.Alert.annotations:type_name -> alerting.v1.Alert.AnnotationsEntry
+	1,  // 4: alerting.v1.Alert.status:type_name -> alerting.v1.AlertStatus
+	16, // 5: alerting.v1.Alert.triggered_at:type_name -> google.protobuf.Timestamp
+	16, // 6: alerting.v1.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	16, // 7: alerting.v1.Alert.resolved_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: alerting.v1.Alert.notes:type_name -> alerting.v1.AlertNote
+	12, // 9: alerting.v1.Alert.events:type_name -> alerting.v1.AlertEvent
+	16, // 10: alerting.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
+	16, // 11: alerting.v1.Alert.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 12: alerting.v1.Alert.raw_payload:type_name -> google.protobuf.Struct
+	7,  // 13: alerting.v1.Alert.ingestion_metadata:type_name -> alerting.v1.IngestionMetadata
+	16, // 14: alerting.v1.Alert.sla_deadline:type_name -> google.protobuf.Timestamp
+	0,  // 15: alerting.v1.IngestionMetadata.source_format:type_name -> alerting.v1.SourceFormat
+	16, // 16: alerting.v1.IngestionMetadata.ingest_timestamp:type_name -> google.protobuf.Timestamp
+	16, // 17: alerting.v1.AlertNote.created_at:type_name -> google.protobuf.Timestamp
+	16, // 18: alerting.v1.AlertComment.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 19: alerting.v1.LabelMatcher.operator:type_name -> alerting.v1.MatchOperator
+	10, // 20: alerting.v1.SilenceRule.matchers:type_name -> alerting.v1.LabelMatcher
+	16, // 21: alerting.v1.SilenceRule.starts_at:type_name -> google.protobuf.Timestamp
+	16, // 22: alerting.v1.SilenceRule.ends_at:type_name -> google.protobuf.Timestamp
+	16, // 23: alerting.v1.SilenceRule.created_at:type_name -> google.protobuf.Timestamp
+	16, // 24: alerting.v1.SilenceRule.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 25: alerting.v1.AlertEvent.type:type_name -> alerting.v1.AlertEventType
+	16, // 26: alerting.v1.AlertEvent.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 27: alerting.v1.AlertEvent.metadata:type_name -> alerting.v1.AlertEvent.MetadataEntry
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_alerting_v1_alert_proto_init() }
@@ -789,8 +1403,8 @@ func file_alerting_v1_alert_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alerting_v1_alert_proto_rawDesc), len(file_alerting_v1_alert_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
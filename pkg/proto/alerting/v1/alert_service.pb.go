@@ -181,7 +181,8 @@ func (x *GetAlertRequest) GetId() string {
 
 type ListAlertsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Pagination
+	// Pagination: page_token is the opaque next_page_token of the previous
+	// page. A page_size of 0 returns every matching alert.
 	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	// Filters
@@ -195,8 +196,12 @@ type ListAlertsRequest struct {
 	TriggeredBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=triggered_before,json=triggeredBefore,proto3" json:"triggered_before,omitempty"`
 	// Search
 	SearchQuery string `protobuf:"bytes,10,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"` // Full-text search in summary/details
-	// Sorting
-	OrderBy       string `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // e.g., "triggered_at desc", "severity asc"
+	// Sorting: "created_at asc" (default) or "created_at desc". Other orderings,
+	// such as "triggered_at desc" or "severity asc", are rejected with
+	// INVALID_ARGUMENT.
+	OrderBy string `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// CEL expression evaluated against each alert, e.g. "labels['env'] == 'production'"
+	CelFilter     string `protobuf:"bytes,12,opt,name=cel_filter,json=celFilter,proto3" json:"cel_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -308,6 +313,13 @@ func (x *ListAlertsRequest) GetOrderBy() string {
 	return ""
 }
 
+func (x *ListAlertsRequest) GetCelFilter() string {
+	if x != nil {
+		return x.CelFilter
+	}
+	return ""
+}
+
 type ListAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
@@ -421,12 +433,12 @@ func (x *UpdateAlertRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type AcknowledgeAlertRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // Optional acknowledgment note
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AlertId        string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,2,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"` // User ID
+	Comment        string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                                     // Optional acknowledgment comment
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AcknowledgeAlertRequest) Reset() {
@@ -459,34 +471,34 @@ func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
 	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AcknowledgeAlertRequest) GetId() string {
+func (x *AcknowledgeAlertRequest) GetAlertId() string {
 	if x != nil {
-		return x.Id
+		return x.AlertId
 	}
 	return ""
 }
 
-func (x *AcknowledgeAlertRequest) GetUserId() string {
+func (x *AcknowledgeAlertRequest) GetAcknowledgedBy() string {
 	if x != nil {
-		return x.UserId
+		return x.AcknowledgedBy
 	}
 	return ""
 }
 
-func (x *AcknowledgeAlertRequest) GetNote() string {
+func (x *AcknowledgeAlertRequest) GetComment() string {
 	if x != nil {
-		return x.Note
+		return x.Comment
 	}
 	return ""
 }
 
 type ResolveAlertRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ResolutionNote string                 `protobuf:"bytes,3,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,2,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // User ID
+	RootCause     string                 `protobuf:"bytes,3,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`    // Optional root cause of the alert
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResolveAlertRequest) Reset() {
@@ -519,23 +531,23 @@ func (*ResolveAlertRequest) Descriptor() ([]byte, []int) {
 	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ResolveAlertRequest) GetId() string {
+func (x *ResolveAlertRequest) GetAlertId() string {
 	if x != nil {
-		return x.Id
+		return x.AlertId
 	}
 	return ""
 }
 
-func (x *ResolveAlertRequest) GetUserId() string {
+func (x *ResolveAlertRequest) GetResolvedBy() string {
 	if x != nil {
-		return x.UserId
+		return x.ResolvedBy
 	}
 	return ""
 }
 
-func (x *ResolveAlertRequest) GetResolutionNote() string {
+func (x *ResolveAlertRequest) GetRootCause() string {
 	if x != nil {
-		return x.ResolutionNote
+		return x.RootCause
 	}
 	return ""
 }
@@ -1020,116 +1032,911 @@ func (x *BulkResolveAlertsResponse) GetFailureReasons() []string {
 	return nil
 }
 
-var File_alerting_v1_alert_service_proto protoreflect.FileDescriptor
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // User ID
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_alerting_v1_alert_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1falerting/v1/alert_service.proto\x12\valerting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17alerting/v1/alert.proto\"\xbc\x04\n" +
-	"\x12CreateAlertRequest\x12\x18\n" +
-	"\asummary\x18\x01 \x01(\tR\asummary\x12\x18\n" +
-	"\adetails\x18\x02 \x01(\tR\adetails\x121\n" +
-	"\bseverity\x18\x03 \x01(\x0e2\x15.alerting.v1.SeverityR\bseverity\x120\n" +
-	"\x06source\x18\x04 \x01(\x0e2\x18.alerting.v1.AlertSourceR\x06source\x12\x1d\n" +
-	"\n" +
-	"service_id\x18\x05 \x01(\tR\tserviceId\x12C\n" +
-	"\x06labels\x18\x06 \x03(\v2+.alerting.v1.CreateAlertRequest.LabelsEntryR\x06labels\x12R\n" +
-	"\vannotations\x18\a \x03(\v20.alerting.v1.CreateAlertRequest.AnnotationsEntryR\vannotations\x128\n" +
-	"\vraw_payload\x18\b \x01(\v2\x17.google.protobuf.StructR\n" +
-	"rawPayload\x12 \n" +
-	"\vfingerprint\x18\t \x01(\tR\vfingerprint\x1a9\n" +
-	"\vLabelsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
-	"\x10AnnotationsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"!\n" +
-	"\x0fGetAlertRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xf9\x04\n" +
-	"\x11ListAlertsRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
-	"\bstatuses\x18\x03 \x03(\x0e2\x18.alerting.v1.AlertStatusR\bstatuses\x125\n" +
-	"\n" +
-	"severities\x18\x04 \x03(\x0e2\x15.alerting.v1.SeverityR\n" +
-	"severities\x122\n" +
-	"\asources\x18\x05 \x03(\x0e2\x18.alerting.v1.AlertSourceR\asources\x12\x1d\n" +
-	"\n" +
-	"service_id\x18\x06 \x01(\tR\tserviceId\x12[\n" +
-	"\x0flabel_selectors\x18\a \x03(\v22.alerting.v1.ListAlertsRequest.LabelSelectorsEntryR\x0elabelSelectors\x12C\n" +
-	"\x0ftriggered_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0etriggeredAfter\x12E\n" +
-	"\x10triggered_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0ftriggeredBefore\x12!\n" +
-	"\fsearch_query\x18\n" +
-	" \x01(\tR\vsearchQuery\x12\x19\n" +
-	"\border_by\x18\v \x01(\tR\aorderBy\x1aA\n" +
-	"\x13LabelSelectorsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
-	"\x12ListAlertsResponse\x12*\n" +
-	"\x06alerts\x18\x01 \x03(\v2\x12.alerting.v1.AlertR\x06alerts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
-	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount\"{\n" +
-	"\x12UpdateAlertRequest\x12(\n" +
-	"\x05alert\x18\x01 \x01(\v2\x12.alerting.v1.AlertR\x05alert\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"V\n" +
-	"\x17AcknowledgeAlertRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04note\x18\x03 \x01(\tR\x04note\"g\n" +
-	"\x13ResolveAlertRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
-	"\x0fresolution_note\x18\x03 \x01(\tR\x0eresolutionNote\"\x9d\x01\n" +
-	"\x14EscalateAlertRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
-	"\x14escalation_policy_id\x18\x02 \x01(\tR\x12escalationPolicyId\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
-	"\x11skip_current_step\x18\x04 \x01(\bR\x0fskipCurrentStep\"^\n" +
-	"\x0eAddNoteRequest\x12\x19\n" +
-	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"n\n" +
-	"\x15GetAlertEventsRequest\x12\x19\n" +
-	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"q\n" +
-	"\x16GetAlertEventsResponse\x12/\n" +
-	"\x06events\x18\x01 \x03(\v2\x17.alerting.v1.AlertEventR\x06events\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
-	"\x1cBulkAcknowledgeAlertsRequest\x12\x1b\n" +
-	"\talert_ids\x18\x01 \x03(\tR\balertIds\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04note\x18\x03 \x01(\tR\x04note\"\x96\x01\n" +
-	"\x1dBulkAcknowledgeAlertsResponse\x12-\n" +
-	"\x12acknowledged_count\x18\x01 \x01(\x05R\x11acknowledgedCount\x12\x1d\n" +
-	"\n" +
-	"failed_ids\x18\x02 \x03(\tR\tfailedIds\x12'\n" +
-	"\x0ffailure_reasons\x18\x03 \x03(\tR\x0efailureReasons\"y\n" +
-	"\x18BulkResolveAlertsRequest\x12\x1b\n" +
-	"\talert_ids\x18\x01 \x03(\tR\balertIds\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
-	"\x0fresolution_note\x18\x03 \x01(\tR\x0eresolutionNote\"\x8a\x01\n" +
-	"\x19BulkResolveAlertsResponse\x12%\n" +
-	"\x0eresolved_count\x18\x01 \x01(\x05R\rresolvedCount\x12\x1d\n" +
-	"\n" +
-	"failed_ids\x18\x02 \x03(\tR\tfailedIds\x12'\n" +
-	"\x0ffailure_reasons\x18\x03 \x03(\tR\x0efailureReasons2\xea\x06\n" +
-	"\fAlertService\x12B\n" +
-	"\vCreateAlert\x12\x1f.alerting.v1.CreateAlertRequest\x1a\x12.alerting.v1.Alert\x12<\n" +
-	"\bGetAlert\x12\x1c.alerting.v1.GetAlertRequest\x1a\x12.alerting.v1.Alert\x12M\n" +
-	"\n" +
-	"ListAlerts\x12\x1e.alerting.v1.ListAlertsRequest\x1a\x1f.alerting.v1.ListAlertsResponse\x12B\n" +
-	"\vUpdateAlert\x12\x1f.alerting.v1.UpdateAlertRequest\x1a\x12.alerting.v1.Alert\x12L\n" +
-	"\x10AcknowledgeAlert\x12$.alerting.v1.AcknowledgeAlertRequest\x1a\x12.alerting.v1.Alert\x12D\n" +
-	"\fResolveAlert\x12 .alerting.v1.ResolveAlertRequest\x1a\x12.alerting.v1.Alert\x12F\n" +
-	"\rEscalateAlert\x12!.alerting.v1.EscalateAlertRequest\x1a\x12.alerting.v1.Alert\x12:\n" +
-	"\aAddNote\x12\x1b.alerting.v1.AddNoteRequest\x1a\x12.alerting.v1.Alert\x12Y\n" +
-	"\x0eGetAlertEvents\x12\".alerting.v1.GetAlertEventsRequest\x1a#.alerting.v1.GetAlertEventsResponse\x12n\n" +
-	"\x15BulkAcknowledgeAlerts\x12).alerting.v1.BulkAcknowledgeAlertsRequest\x1a*.alerting.v1.BulkAcknowledgeAlertsResponse\x12b\n" +
-	"\x11BulkResolveAlerts\x12%.alerting.v1.BulkResolveAlertsRequest\x1a&.alerting.v1.BulkResolveAlertsResponseB\xbb\x01\n" +
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateCommentRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCommentsRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*AlertComment        `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCommentsResponse) GetComments() []*AlertComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateSilenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Silence       *SilenceRule           `protobuf:"bytes,1,opt,name=silence,proto3" json:"silence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSilenceRequest) Reset() {
+	*x = CreateSilenceRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSilenceRequest) ProtoMessage() {}
+
+func (x *CreateSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSilenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateSilenceRequest) GetSilence() *SilenceRule {
+	if x != nil {
+		return x.Silence
+	}
+	return nil
+}
+
+type GetSilenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSilenceRequest) Reset() {
+	*x = GetSilenceRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSilenceRequest) ProtoMessage() {}
+
+func (x *GetSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSilenceRequest.ProtoReflect.Descriptor instead.
+func (*GetSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSilenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSilencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list silences active now
+	ActiveOnly    bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSilencesRequest) Reset() {
+	*x = ListSilencesRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSilencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSilencesRequest) ProtoMessage() {}
+
+func (x *ListSilencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSilencesRequest.ProtoReflect.Descriptor instead.
+func (*ListSilencesRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSilencesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListSilencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Silences      []*SilenceRule         `protobuf:"bytes,1,rep,name=silences,proto3" json:"silences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSilencesResponse) Reset() {
+	*x = ListSilencesResponse{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSilencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSilencesResponse) ProtoMessage() {}
+
+func (x *ListSilencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSilencesResponse.ProtoReflect.Descriptor instead.
+func (*ListSilencesResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSilencesResponse) GetSilences() []*SilenceRule {
+	if x != nil {
+		return x.Silences
+	}
+	return nil
+}
+
+type UpdateSilenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Silence       *SilenceRule           `protobuf:"bytes,1,opt,name=silence,proto3" json:"silence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSilenceRequest) Reset() {
+	*x = UpdateSilenceRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSilenceRequest) ProtoMessage() {}
+
+func (x *UpdateSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSilenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateSilenceRequest) GetSilence() *SilenceRule {
+	if x != nil {
+		return x.Silence
+	}
+	return nil
+}
+
+type DeleteSilenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSilenceRequest) Reset() {
+	*x = DeleteSilenceRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSilenceRequest) ProtoMessage() {}
+
+func (x *DeleteSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSilenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteSilenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSilenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSilenceResponse) Reset() {
+	*x = DeleteSilenceResponse{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSilenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSilenceResponse) ProtoMessage() {}
+
+func (x *DeleteSilenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSilenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSilenceResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteSilenceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// What deleting a service would affect
+type ServiceDeletionImpact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Triggered and acknowledged alerts of the service
+	ActiveAlertCount        int32 `protobuf:"varint,1,opt,name=active_alert_count,json=activeAlertCount,proto3" json:"active_alert_count,omitempty"`
+	RoutingRulesCount       int32 `protobuf:"varint,2,opt,name=routing_rules_count,json=routingRulesCount,proto3" json:"routing_rules_count,omitempty"`
+	EscalationPoliciesCount int32 `protobuf:"varint,3,opt,name=escalation_policies_count,json=escalationPoliciesCount,proto3" json:"escalation_policies_count,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ServiceDeletionImpact) Reset() {
+	*x = ServiceDeletionImpact{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDeletionImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDeletionImpact) ProtoMessage() {}
+
+func (x *ServiceDeletionImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDeletionImpact.ProtoReflect.Descriptor instead.
+func (*ServiceDeletionImpact) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ServiceDeletionImpact) GetActiveAlertCount() int32 {
+	if x != nil {
+		return x.ActiveAlertCount
+	}
+	return 0
+}
+
+func (x *ServiceDeletionImpact) GetRoutingRulesCount() int32 {
+	if x != nil {
+		return x.RoutingRulesCount
+	}
+	return 0
+}
+
+func (x *ServiceDeletionImpact) GetEscalationPoliciesCount() int32 {
+	if x != nil {
+		return x.EscalationPoliciesCount
+	}
+	return 0
+}
+
+type DeleteServiceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Delete even with active alerts, moving them to the deleted-service sentinel
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DeleteServiceRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Impact        *ServiceDeletionImpact `protobuf:"bytes,2,opt,name=impact,proto3" json:"impact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceResponse) Reset() {
+	*x = DeleteServiceResponse{}
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceResponse) ProtoMessage() {}
+
+func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerting_v1_alert_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
+	return file_alerting_v1_alert_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteServiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteServiceResponse) GetImpact() *ServiceDeletionImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+var File_alerting_v1_alert_service_proto protoreflect.FileDescriptor
+
+const file_alerting_v1_alert_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1falerting/v1/alert_service.proto\x12\valerting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17alerting/v1/alert.proto\"\xbc\x04\n" +
+	"\x12CreateAlertRequest\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12\x18\n" +
+	"\adetails\x18\x02 \x01(\tR\adetails\x121\n" +
+	"\bseverity\x18\x03 \x01(\x0e2\x15.alerting.v1.SeverityR\bseverity\x120\n" +
+	"\x06source\x18\x04 \x01(\x0e2\x18.alerting.v1.AlertSourceR\x06source\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x05 \x01(\tR\tserviceId\x12C\n" +
+	"\x06labels\x18\x06 \x03(\v2+.alerting.v1.CreateAlertRequest.LabelsEntryR\x06labels\x12R\n" +
+	"\vannotations\x18\a \x03(\v20.alerting.v1.CreateAlertRequest.AnnotationsEntryR\vannotations\x128\n" +
+	"\vraw_payload\x18\b \x01(\v2\x17.google.protobuf.StructR\n" +
+	"rawPayload\x12 \n" +
+	"\vfingerprint\x18\t \x01(\tR\vfingerprint\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"!\n" +
+	"\x0fGetAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x98\x05\n" +
+	"\x11ListAlertsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x18.alerting.v1.AlertStatusR\bstatuses\x125\n" +
+	"\n" +
+	"severities\x18\x04 \x03(\x0e2\x15.alerting.v1.SeverityR\n" +
+	"severities\x122\n" +
+	"\asources\x18\x05 \x03(\x0e2\x18.alerting.v1.AlertSourceR\asources\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x06 \x01(\tR\tserviceId\x12[\n" +
+	"\x0flabel_selectors\x18\a \x03(\v22.alerting.v1.ListAlertsRequest.LabelSelectorsEntryR\x0elabelSelectors\x12C\n" +
+	"\x0ftriggered_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0etriggeredAfter\x12E\n" +
+	"\x10triggered_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0ftriggeredBefore\x12!\n" +
+	"\fsearch_query\x18\n" +
+	" \x01(\tR\vsearchQuery\x12\x19\n" +
+	"\border_by\x18\v \x01(\tR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"cel_filter\x18\f \x01(\tR\tcelFilter\x1aA\n" +
+	"\x13LabelSelectorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\x12ListAlertsResponse\x12*\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x12.alerting.v1.AlertR\x06alerts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"{\n" +
+	"\x12UpdateAlertRequest\x12(\n" +
+	"\x05alert\x18\x01 \x01(\v2\x12.alerting.v1.AlertR\x05alert\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"w\n" +
+	"\x17AcknowledgeAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12'\n" +
+	"\x0facknowledged_by\x18\x02 \x01(\tR\x0eacknowledgedBy\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"p\n" +
+	"\x13ResolveAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x1f\n" +
+	"\vresolved_by\x18\x02 \x01(\tR\n" +
+	"resolvedBy\x12\x1d\n" +
+	"\n" +
+	"root_cause\x18\x03 \x01(\tR\trootCause\"\x9d\x01\n" +
+	"\x14EscalateAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14escalation_policy_id\x18\x02 \x01(\tR\x12escalationPolicyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
+	"\x11skip_current_step\x18\x04 \x01(\bR\x0fskipCurrentStep\"^\n" +
+	"\x0eAddNoteRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"n\n" +
+	"\x15GetAlertEventsRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"q\n" +
+	"\x16GetAlertEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.alerting.v1.AlertEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x1cBulkAcknowledgeAlertsRequest\x12\x1b\n" +
+	"\talert_ids\x18\x01 \x03(\tR\balertIds\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x96\x01\n" +
+	"\x1dBulkAcknowledgeAlertsResponse\x12-\n" +
+	"\x12acknowledged_count\x18\x01 \x01(\x05R\x11acknowledgedCount\x12\x1d\n" +
+	"\n" +
+	"failed_ids\x18\x02 \x03(\tR\tfailedIds\x12'\n" +
+	"\x0ffailure_reasons\x18\x03 \x03(\tR\x0efailureReasons\"y\n" +
+	"\x18BulkResolveAlertsRequest\x12\x1b\n" +
+	"\talert_ids\x18\x01 \x03(\tR\balertIds\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0fresolution_note\x18\x03 \x01(\tR\x0eresolutionNote\"\x8a\x01\n" +
+	"\x19BulkResolveAlertsResponse\x12%\n" +
+	"\x0eresolved_count\x18\x01 \x01(\x05R\rresolvedCount\x12\x1d\n" +
+	"\n" +
+	"failed_ids\x18\x02 \x03(\tR\tfailedIds\x12'\n" +
+	"\x0ffailure_reasons\x18\x03 \x03(\tR\x0efailureReasons\"b\n" +
+	"\x14CreateCommentRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"l\n" +
+	"\x13ListCommentsRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"u\n" +
+	"\x14ListCommentsResponse\x125\n" +
+	"\bcomments\x18\x01 \x03(\v2\x19.alerting.v1.AlertCommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"5\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\"1\n" +
+	"\x15DeleteCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
+	"\x14CreateSilenceRequest\x122\n" +
+	"\asilence\x18\x01 \x01(\v2\x18.alerting.v1.SilenceRuleR\asilence\"#\n" +
+	"\x11GetSilenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x13ListSilencesRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"L\n" +
+	"\x14ListSilencesResponse\x124\n" +
+	"\bsilences\x18\x01 \x03(\v2\x18.alerting.v1.SilenceRuleR\bsilences\"J\n" +
+	"\x14UpdateSilenceRequest\x122\n" +
+	"\asilence\x18\x01 \x01(\v2\x18.alerting.v1.SilenceRuleR\asilence\"&\n" +
+	"\x14DeleteSilenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x15DeleteSilenceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb1\x01\n" +
+	"\x15ServiceDeletionImpact\x12,\n" +
+	"\x12active_alert_count\x18\x01 \x01(\x05R\x10activeAlertCount\x12.\n" +
+	"\x13routing_rules_count\x18\x02 \x01(\x05R\x11routingRulesCount\x12:\n" +
+	"\x19escalation_policies_count\x18\x03 \x01(\x05R\x17escalationPoliciesCount\"K\n" +
+	"\x14DeleteServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"m\n" +
+	"\x15DeleteServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12:\n" +
+	"\x06impact\x18\x02 \x01(\v2\".alerting.v1.ServiceDeletionImpactR\x06impact2\xe6\b\n" +
+	"\fAlertService\x12B\n" +
+	"\vCreateAlert\x12\x1f.alerting.v1.CreateAlertRequest\x1a\x12.alerting.v1.Alert\x12<\n" +
+	"\bGetAlert\x12\x1c.alerting.v1.GetAlertRequest\x1a\x12.alerting.v1.Alert\x12M\n" +
+	"\n" +
+	"ListAlerts\x12\x1e.alerting.v1.ListAlertsRequest\x1a\x1f.alerting.v1.ListAlertsResponse\x12B\n" +
+	"\vUpdateAlert\x12\x1f.alerting.v1.UpdateAlertRequest\x1a\x12.alerting.v1.Alert\x12L\n" +
+	"\x10AcknowledgeAlert\x12$.alerting.v1.AcknowledgeAlertRequest\x1a\x12.alerting.v1.Alert\x12D\n" +
+	"\fResolveAlert\x12 .alerting.v1.ResolveAlertRequest\x1a\x12.alerting.v1.Alert\x12F\n" +
+	"\rEscalateAlert\x12!.alerting.v1.EscalateAlertRequest\x1a\x12.alerting.v1.Alert\x12:\n" +
+	"\aAddNote\x12\x1b.alerting.v1.AddNoteRequest\x1a\x12.alerting.v1.Alert\x12Y\n" +
+	"\x0eGetAlertEvents\x12\".alerting.v1.GetAlertEventsRequest\x1a#.alerting.v1.GetAlertEventsResponse\x12n\n" +
+	"\x15BulkAcknowledgeAlerts\x12).alerting.v1.BulkAcknowledgeAlertsRequest\x1a*.alerting.v1.BulkAcknowledgeAlertsResponse\x12b\n" +
+	"\x11BulkResolveAlerts\x12%.alerting.v1.BulkResolveAlertsRequest\x1a&.alerting.v1.BulkResolveAlertsResponse\x12M\n" +
+	"\rCreateComment\x12!.alerting.v1.CreateCommentRequest\x1a\x19.alerting.v1.AlertComment\x12S\n" +
+	"\fListComments\x12 .alerting.v1.ListCommentsRequest\x1a!.alerting.v1.ListCommentsResponse\x12V\n" +
+	"\rDeleteComment\x12!.alerting.v1.DeleteCommentRequest\x1a\".alerting.v1.DeleteCommentResponse2\xa1\x03\n" +
+	"\x0eSilenceService\x12L\n" +
+	"\rCreateSilence\x12!.alerting.v1.CreateSilenceRequest\x1a\x18.alerting.v1.SilenceRule\x12F\n" +
+	"\n" +
+	"GetSilence\x12\x1e.alerting.v1.GetSilenceRequest\x1a\x18.alerting.v1.SilenceRule\x12S\n" +
+	"\fListSilences\x12 .alerting.v1.ListSilencesRequest\x1a!.alerting.v1.ListSilencesResponse\x12L\n" +
+	"\rUpdateSilence\x12!.alerting.v1.UpdateSilenceRequest\x1a\x18.alerting.v1.SilenceRule\x12V\n" +
+	"\rDeleteSilence\x12!.alerting.v1.DeleteSilenceRequest\x1a\".alerting.v1.DeleteSilenceResponse2h\n" +
+	"\x0eServiceService\x12V\n" +
+	"\rDeleteService\x12!.alerting.v1.DeleteServiceRequest\x1a\".alerting.v1.DeleteServiceResponseB\xbb\x01\n" +
 	"\x0fcom.alerting.v1B\x11AlertServiceProtoP\x01ZHgithub.com/kneutral-org/alerting-system/pkg/proto/alerting/v1;alertingv1\xa2\x02\x03AXX\xaa\x02\vAlerting.V1\xca\x02\vAlerting\\V1\xe2\x02\x17Alerting\\V1\\GPBMetadata\xea\x02\fAlerting::V1b\x06proto3"
 
 var (
@@ -1144,7 +1951,7 @@ func file_alerting_v1_alert_service_proto_rawDescGZIP() []byte {
 	return file_alerting_v1_alert_service_proto_rawDescData
 }
 
-var file_alerting_v1_alert_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_alerting_v1_alert_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_alerting_v1_alert_service_proto_goTypes = []any{
 	(*CreateAlertRequest)(nil),            // 0: alerting.v1.CreateAlertRequest
 	(*GetAlertRequest)(nil),               // 1: alerting.v1.GetAlertRequest
@@ -1161,61 +1968,101 @@ var file_alerting_v1_alert_service_proto_goTypes = []any{
 	(*BulkAcknowledgeAlertsResponse)(nil), // 12: alerting.v1.BulkAcknowledgeAlertsResponse
 	(*BulkResolveAlertsRequest)(nil),      // 13: alerting.v1.BulkResolveAlertsRequest
 	(*BulkResolveAlertsResponse)(nil),     // 14: alerting.v1.BulkResolveAlertsResponse
-	nil,                                   // 15: alerting.v1.CreateAlertRequest.LabelsEntry
-	nil,                                   // 16: alerting.v1.CreateAlertRequest.AnnotationsEntry
-	nil,                                   // 17: alerting.v1.ListAlertsRequest.LabelSelectorsEntry
-	(Severity)(0),                         // 18: alerting.v1.Severity
-	(AlertSource)(0),                      // 19: alerting.v1.AlertSource
-	(*structpb.Struct)(nil),               // 20: google.protobuf.Struct
-	(AlertStatus)(0),                      // 21: alerting.v1.AlertStatus
-	(*timestamppb.Timestamp)(nil),         // 22: google.protobuf.Timestamp
-	(*Alert)(nil),                         // 23: alerting.v1.Alert
-	(*fieldmaskpb.FieldMask)(nil),         // 24: google.protobuf.FieldMask
-	(*AlertEvent)(nil),                    // 25: alerting.v1.AlertEvent
+	(*CreateCommentRequest)(nil),          // 15: alerting.v1.CreateCommentRequest
+	(*ListCommentsRequest)(nil),           // 16: alerting.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 17: alerting.v1.ListCommentsResponse
+	(*DeleteCommentRequest)(nil),          // 18: alerting.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),         // 19: alerting.v1.DeleteCommentResponse
+	(*CreateSilenceRequest)(nil),          // 20: alerting.v1.CreateSilenceRequest
+	(*GetSilenceRequest)(nil),             // 21: alerting.v1.GetSilenceRequest
+	(*ListSilencesRequest)(nil),           // 22: alerting.v1.ListSilencesRequest
+	(*ListSilencesResponse)(nil),          // 23: alerting.v1.ListSilencesResponse
+	(*UpdateSilenceRequest)(nil),          // 24: alerting.v1.UpdateSilenceRequest
+	(*DeleteSilenceRequest)(nil),          // 25: alerting.v1.DeleteSilenceRequest
+	(*DeleteSilenceResponse)(nil),         // 26: alerting.v1.DeleteSilenceResponse
+	(*ServiceDeletionImpact)(nil),         // 27: alerting.v1.ServiceDeletionImpact
+	(*DeleteServiceRequest)(nil),          // 28: alerting.v1.DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),         // 29: alerting.v1.DeleteServiceResponse
+	nil,                                   // 30: alerting.v1.CreateAlertRequest.LabelsEntry
+	nil,                                   // 31: alerting.v1.CreateAlertRequest.AnnotationsEntry
+	nil,                                   // 32: alerting.v1.ListAlertsRequest.LabelSelectorsEntry
+	(Severity)(0),                         // 33: alerting.v1.Severity
+	(AlertSource)(0),                      // 34: alerting.v1.AlertSource
+	(*structpb.Struct)(nil),               // 35: google.protobuf.Struct
+	(AlertStatus)(0),                      // 36: alerting.v1.AlertStatus
+	(*timestamppb.Timestamp)(nil),         // 37: google.protobuf.Timestamp
+	(*Alert)(nil),                         // 38: alerting.v1.Alert
+	(*fieldmaskpb.FieldMask)(nil),         // 39: google.protobuf.FieldMask
+	(*AlertEvent)(nil),                    // 40: alerting.v1.AlertEvent
+	(*AlertComment)(nil),                  // 41: alerting.v1.AlertComment
+	(*SilenceRule)(nil),                   // 42: alerting.v1.SilenceRule
 }
 var file_alerting_v1_alert_service_proto_depIdxs = []int32{
-	18, // 0: alerting.v1.CreateAlertRequest.severity:type_name -> alerting.v1.Severity
-	19, // 1: alerting.v1.CreateAlertRequest.source:type_name -> alerting.v1.AlertSource
-	15, // 2: alerting.v1.CreateAlertRequest.labels:type_name -> alerting.v1.CreateAlertRequest.LabelsEntry
-	16, // 3: alerting.v1.CreateAlertRequest.annotations:type_name -> alerting.v1.CreateAlertRequest.AnnotationsEntry
-	20, // 4: alerting.v1.CreateAlertRequest.raw_payload:type_name -> google.protobuf.Struct
-	21, // 5: alerting.v1.ListAlertsRequest.statuses:type_name -> alerting.v1.AlertStatus
-	18, // 6: alerting.v1.ListAlertsRequest.severities:type_name -> alerting.v1.Severity
-	19, // 7: alerting.v1.ListAlertsRequest.sources:type_name -> alerting.v1.AlertSource
-	17, // 8: alerting.v1.ListAlertsRequest.label_selectors:type_name -> alerting.v1.ListAlertsRequest.LabelSelectorsEntry
-	22, // 9: alerting.v1.ListAlertsRequest.triggered_after:type_name -> google.protobuf.Timestamp
-	22, // 10: alerting.v1.ListAlertsRequest.triggered_before:type_name -> google.protobuf.Timestamp
-	23, // 11: alerting.v1.ListAlertsResponse.alerts:type_name -> alerting.v1.Alert
-	23, // 12: alerting.v1.UpdateAlertRequest.alert:type_name -> alerting.v1.Alert
-	24, // 13: alerting.v1.UpdateAlertRequest.update_mask:type_name -> google.protobuf.FieldMask
-	25, // 14: alerting.v1.GetAlertEventsResponse.events:type_name -> alerting.v1.AlertEvent
-	0,  // 15: alerting.v1.AlertService.CreateAlert:input_type -> alerting.v1.CreateAlertRequest
-	1,  // 16: alerting.v1.AlertService.GetAlert:input_type -> alerting.v1.GetAlertRequest
-	2,  // 17: alerting.v1.AlertService.ListAlerts:input_type -> alerting.v1.ListAlertsRequest
-	4,  // 18: alerting.v1.AlertService.UpdateAlert:input_type -> alerting.v1.UpdateAlertRequest
-	5,  // 19: alerting.v1.AlertService.AcknowledgeAlert:input_type -> alerting.v1.AcknowledgeAlertRequest
-	6,  // 20: alerting.v1.AlertService.ResolveAlert:input_type -> alerting.v1.ResolveAlertRequest
-	7,  // 21: alerting.v1.AlertService.EscalateAlert:input_type -> alerting.v1.EscalateAlertRequest
-	8,  // 22: alerting.v1.AlertService.AddNote:input_type -> alerting.v1.AddNoteRequest
-	9,  // 23: alerting.v1.AlertService.GetAlertEvents:input_type -> alerting.v1.GetAlertEventsRequest
-	11, // 24: alerting.v1.AlertService.BulkAcknowledgeAlerts:input_type -> alerting.v1.BulkAcknowledgeAlertsRequest
-	13, // 25: alerting.v1.AlertService.BulkResolveAlerts:input_type -> alerting.v1.BulkResolveAlertsRequest
-	23, // 26: alerting.v1.AlertService.CreateAlert:output_type -> alerting.v1.Alert
-	23, // 27: alerting.v1.AlertService.GetAlert:output_type -> alerting.v1.Alert
-	3,  // 28: alerting.v1.AlertService.ListAlerts:output_type -> alerting.v1.ListAlertsResponse
-	23, // 29: alerting.v1.AlertService.UpdateAlert:output_type -> alerting.v1.Alert
-	23, // 30: alerting.v1.AlertService.AcknowledgeAlert:output_type -> alerting.v1.Alert
-	23, // 31: alerting.v1.AlertService.ResolveAlert:output_type -> alerting.v1.Alert
-	23, // 32: alerting.v1.AlertService.EscalateAlert:output_type -> alerting.v1.Alert
-	23, // 33: alerting.v1.AlertService.AddNote:output_type -> alerting.v1.Alert
-	10, // 34: alerting.v1.AlertService.GetAlertEvents:output_type -> alerting.v1.GetAlertEventsResponse
-	12, // 35: alerting.v1.AlertService.BulkAcknowledgeAlerts:output_type -> alerting.v1.BulkAcknowledgeAlertsResponse
-	14, // 36: alerting.v1.AlertService.BulkResolveAlerts:output_type -> alerting.v1.BulkResolveAlertsResponse
-	26, // [26:37] is the sub-list for method output_type
-	15, // [15:26] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	33, // 0: alerting.v1.CreateAlertRequest.severity:type_name -> alerting.v1.Severity
+	34, // 1: alerting.v1.CreateAlertRequest.source:type_name -> alerting.v1.AlertSource
+	30, // 2: alerting.v1.CreateAlertRequest.labels:type_name -> alerting.v1.CreateAlertRequest.LabelsEntry
+	31, // 3: alerting.v1.CreateAlertRequest.annotations:type_name -> alerting.v1.CreateAlertRequest.AnnotationsEntry
+	35, // 4: alerting.v1.CreateAlertRequest.raw_payload:type_name -> google.protobuf.Struct
+	36, // 5: alerting.v1.ListAlertsRequest.statuses:type_name -> alerting.v1.AlertStatus
+	33, // 6: alerting.v1.ListAlertsRequest.severities:type_name -> alerting.v1.Severity
+	34, // 7: alerting.v1.ListAlertsRequest.sources:type_name -> alerting.v1.AlertSource
+	32, // 8: alerting.v1.ListAlertsRequest.label_selectors:type_name -> alerting.v1.ListAlertsRequest.LabelSelectorsEntry
+	37, // 9: alerting.v1.ListAlertsRequest.triggered_after:type_name -> google.protobuf.Timestamp
+	37, // 10: alerting.v1.ListAlertsRequest.triggered_before:type_name -> google.protobuf.Timestamp
+	38, // 11: alerting.v1.ListAlertsResponse.alerts:type_name -> alerting.v1.Alert
+	38, // 12: alerting.v1.UpdateAlertRequest.alert:type_name -> alerting.v1.Alert
+	39, // 13: alerting.v1.UpdateAlertRequest.update_mask:type_name -> google.protobuf.FieldMask
+	40, // 14: alerting.v1.GetAlertEventsResponse.events:type_name -> alerting.v1.AlertEvent
+	41, // 15: alerting.v1.ListCommentsResponse.comments:type_name -> alerting.v1.AlertComment
+	42, // 16: alerting.v1.CreateSilenceRequest.silence:type_name -> alerting.v1.SilenceRule
+	42, // 17: alerting.v1.ListSilencesResponse.silences:type_name -> alerting.v1.SilenceRule
+	42, // 18: alerting.v1.UpdateSilenceRequest.silence:type_name -> alerting.v1.SilenceRule
+	27, // 19: alerting.v1.DeleteServiceResponse.impact:type_name -> alerting.v1.ServiceDeletionImpact
+	0,  // 20: alerting.v1.AlertService.CreateAlert:input_type -> alerting.v1.CreateAlertRequest
+	1,  // 21: alerting.v1.AlertService.GetAlert:input_type -> alerting.v1.GetAlertRequest
+	2,  // 22: alerting.v1.AlertService.ListAlerts:input_type -> alerting.v1.ListAlertsRequest
+	4,  // 23: alerting.v1.AlertService.UpdateAlert:input_type -> alerting.v1.UpdateAlertRequest
+	5,  // 24: alerting.v1.AlertService.AcknowledgeAlert:input_type -> alerting.v1.AcknowledgeAlertRequest
+	6,  // 25: alerting.v1.AlertService.ResolveAlert:input_type -> alerting.v1.ResolveAlertRequest
+	7,  // 26: alerting.v1.AlertService.EscalateAlert:input_type -> alerting.v1.EscalateAlertRequest
+	8,  // 27: alerting.v1.AlertService.AddNote:input_type -> alerting.v1.AddNoteRequest
+	9,  // 28: alerting.v1.AlertService.GetAlertEvents:input_type -> alerting.v1.GetAlertEventsRequest
+	11, // 29: alerting.v1.AlertService.BulkAcknowledgeAlerts:input_type -> alerting.v1.BulkAcknowledgeAlertsRequest
+	13, // 30: alerting.v1.AlertService.BulkResolveAlerts:input_type -> alerting.v1.BulkResolveAlertsRequest
+	15, // 31: alerting.v1.AlertService.CreateComment:input_type -> alerting.v1.CreateCommentRequest
+	16, // 32: alerting.v1.AlertService.ListComments:input_type -> alerting.v1.ListCommentsRequest
+	18, // 33: alerting.v1.AlertService.DeleteComment:input_type -> alerting.v1.DeleteCommentRequest
+	20, // 34: alerting.v1.SilenceService.CreateSilence:input_type -> alerting.v1.CreateSilenceRequest
+	21, // 35: alerting.v1.SilenceService.GetSilence:input_type -> alerting.v1.GetSilenceRequest
+	22, // 36: alerting.v1.SilenceService.ListSilences:input_type -> alerting.v1.ListSilencesRequest
+	24, // 37: alerting.v1.SilenceService.UpdateSilence:input_type -> alerting.v1.UpdateSilenceRequest
+	25, // 38: alerting.v1.SilenceService.DeleteSilence:input_type -> alerting.v1.DeleteSilenceRequest
+	28, // 39: alerting.v1.ServiceService.DeleteService:input_type -> alerting.v1.DeleteServiceRequest
+	38, // 40: alerting.v1.AlertService.CreateAlert:output_type -> alerting.v1.Alert
+	38, // 41: alerting.v1.AlertService.GetAlert:output_type -> alerting.v1.Alert
+	3,  // 42: alerting.v1.AlertService.ListAlerts:output_type -> alerting.v1.ListAlertsResponse
+	38, // 43: alerting.v1.AlertService.UpdateAlert:output_type -> alerting.v1.Alert
+	38, // 44: alerting.v1.AlertService.AcknowledgeAlert:output_type -> alerting.v1.Alert
+	38, // 45: alerting.v1.AlertService.ResolveAlert:output_type -> alerting.v1.Alert
+	38, // 46: alerting.v1.AlertService.EscalateAlert:output_type -> alerting.v1.Alert
+	38, // 47: alerting.v1.AlertService.AddNote:output_type -> alerting.v1.Alert
+	10, // 48: alerting.v1.AlertService.GetAlertEvents:output_type -> alerting.v1.GetAlertEventsResponse
+	12, // 49: alerting.v1.AlertService.BulkAcknowledgeAlerts:output_type -> alerting.v1.BulkAcknowledgeAlertsResponse
+	14, // 50: alerting.v1.AlertService.BulkResolveAlerts:output_type -> alerting.v1.BulkResolveAlertsResponse
+	41, // 51: alerting.v1.AlertService.CreateComment:output_type -> alerting.v1.AlertComment
+	17, // 52: alerting.v1.AlertService.ListComments:output_type -> alerting.v1.ListCommentsResponse
+	19, // 53: alerting.v1.AlertService.DeleteComment:output_type -> alerting.v1.DeleteCommentResponse
+	42, // 54: alerting.v1.SilenceService.CreateSilence:output_type -> alerting.v1.SilenceRule
+	42, // 55: alerting.v1.SilenceService.GetSilence:output_type -> alerting.v1.SilenceRule
+	23, // 56: alerting.v1.SilenceService.ListSilences:output_type -> alerting.v1.ListSilencesResponse
+	42, // 57: alerting.v1.SilenceService.UpdateSilence:output_type -> alerting.v1.SilenceRule
+	26, // 58: alerting.v1.SilenceService.DeleteSilence:output_type -> alerting.v1.DeleteSilenceResponse
+	29, // 59: alerting.v1.ServiceService.DeleteService:output_type -> alerting.v1.DeleteServiceResponse
+	40, // [40:60] is the sub-list for method output_type
+	20, // [20:40] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_alerting_v1_alert_service_proto_init() }
@@ -1230,9 +2077,9 @@ func file_alerting_v1_alert_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alerting_v1_alert_service_proto_rawDesc), len(file_alerting_v1_alert_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   33,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_alerting_v1_alert_service_proto_goTypes,
 		DependencyIndexes: file_alerting_v1_alert_service_proto_depIdxs,
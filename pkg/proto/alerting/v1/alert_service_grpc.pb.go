@@ -30,6 +30,9 @@ const (
 	AlertService_GetAlertEvents_FullMethodName        = "/alerting.v1.AlertService/GetAlertEvents"
 	AlertService_BulkAcknowledgeAlerts_FullMethodName = "/alerting.v1.AlertService/BulkAcknowledgeAlerts"
 	AlertService_BulkResolveAlerts_FullMethodName     = "/alerting.v1.AlertService/BulkResolveAlerts"
+	AlertService_CreateComment_FullMethodName         = "/alerting.v1.AlertService/CreateComment"
+	AlertService_ListComments_FullMethodName          = "/alerting.v1.AlertService/ListComments"
+	AlertService_DeleteComment_FullMethodName         = "/alerting.v1.AlertService/DeleteComment"
 )
 
 // AlertServiceClient is the client API for AlertService service.
@@ -60,6 +63,12 @@ type AlertServiceClient interface {
 	BulkAcknowledgeAlerts(ctx context.Context, in *BulkAcknowledgeAlertsRequest, opts ...grpc.CallOption) (*BulkAcknowledgeAlertsResponse, error)
 	// Bulk resolve alerts
 	BulkResolveAlerts(ctx context.Context, in *BulkResolveAlertsRequest, opts ...grpc.CallOption) (*BulkResolveAlertsResponse, error)
+	// Comment on an alert
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*AlertComment, error)
+	// List the comments of an alert, oldest first
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// Delete a comment; deleting a deleted comment succeeds
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 }
 
 type alertServiceClient struct {
@@ -180,6 +189,36 @@ func (c *alertServiceClient) BulkResolveAlerts(ctx context.Context, in *BulkReso
 	return out, nil
 }
 
+func (c *alertServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*AlertComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertComment)
+	err := c.cc.Invoke(ctx, AlertService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, AlertService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, AlertService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlertServiceServer is the server API for AlertService service.
 // All implementations must embed UnimplementedAlertServiceServer
 // for forward compatibility.
@@ -208,6 +247,12 @@ type AlertServiceServer interface {
 	BulkAcknowledgeAlerts(context.Context, *BulkAcknowledgeAlertsRequest) (*BulkAcknowledgeAlertsResponse, error)
 	// Bulk resolve alerts
 	BulkResolveAlerts(context.Context, *BulkResolveAlertsRequest) (*BulkResolveAlertsResponse, error)
+	// Comment on an alert
+	CreateComment(context.Context, *CreateCommentRequest) (*AlertComment, error)
+	// List the comments of an alert, oldest first
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// Delete a comment; deleting a deleted comment succeeds
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	mustEmbedUnimplementedAlertServiceServer()
 }
 
@@ -251,6 +296,15 @@ func (UnimplementedAlertServiceServer) BulkAcknowledgeAlerts(context.Context, *B
 func (UnimplementedAlertServiceServer) BulkResolveAlerts(context.Context, *BulkResolveAlertsRequest) (*BulkResolveAlertsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BulkResolveAlerts not implemented")
 }
+func (UnimplementedAlertServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*AlertComment, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedAlertServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedAlertServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteComment not implemented")
+}
 func (UnimplementedAlertServiceServer) mustEmbedUnimplementedAlertServiceServer() {}
 func (UnimplementedAlertServiceServer) testEmbeddedByValue()                      {}
 
@@ -470,6 +524,60 @@ func _AlertService_BulkResolveAlerts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AlertService_ServiceDesc is the grpc.ServiceDesc for AlertService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -521,6 +629,384 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkResolveAlerts",
 			Handler:    _AlertService_BulkResolveAlerts_Handler,
 		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _AlertService_CreateComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _AlertService_ListComments_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _AlertService_DeleteComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "alerting/v1/alert_service.proto",
+}
+
+const (
+	SilenceService_CreateSilence_FullMethodName = "/alerting.v1.SilenceService/CreateSilence"
+	SilenceService_GetSilence_FullMethodName    = "/alerting.v1.SilenceService/GetSilence"
+	SilenceService_ListSilences_FullMethodName  = "/alerting.v1.SilenceService/ListSilences"
+	SilenceService_UpdateSilence_FullMethodName = "/alerting.v1.SilenceService/UpdateSilence"
+	SilenceService_DeleteSilence_FullMethodName = "/alerting.v1.SilenceService/DeleteSilence"
+)
+
+// SilenceServiceClient is the client API for SilenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SilenceService manages silence rules, which suppress matching alerts for a time
+type SilenceServiceClient interface {
+	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error)
+	GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error)
+	ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error)
+	UpdateSilence(ctx context.Context, in *UpdateSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error)
+	DeleteSilence(ctx context.Context, in *DeleteSilenceRequest, opts ...grpc.CallOption) (*DeleteSilenceResponse, error)
+}
+
+type silenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSilenceServiceClient(cc grpc.ClientConnInterface) SilenceServiceClient {
+	return &silenceServiceClient{cc}
+}
+
+func (c *silenceServiceClient) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SilenceRule)
+	err := c.cc.Invoke(ctx, SilenceService_CreateSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceServiceClient) GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SilenceRule)
+	err := c.cc.Invoke(ctx, SilenceService_GetSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceServiceClient) ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSilencesResponse)
+	err := c.cc.Invoke(ctx, SilenceService_ListSilences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceServiceClient) UpdateSilence(ctx context.Context, in *UpdateSilenceRequest, opts ...grpc.CallOption) (*SilenceRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SilenceRule)
+	err := c.cc.Invoke(ctx, SilenceService_UpdateSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceServiceClient) DeleteSilence(ctx context.Context, in *DeleteSilenceRequest, opts ...grpc.CallOption) (*DeleteSilenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSilenceResponse)
+	err := c.cc.Invoke(ctx, SilenceService_DeleteSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SilenceServiceServer is the server API for SilenceService service.
+// All implementations must embed UnimplementedSilenceServiceServer
+// for forward compatibility.
+//
+// SilenceService manages silence rules, which suppress matching alerts for a time
+type SilenceServiceServer interface {
+	CreateSilence(context.Context, *CreateSilenceRequest) (*SilenceRule, error)
+	GetSilence(context.Context, *GetSilenceRequest) (*SilenceRule, error)
+	ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error)
+	UpdateSilence(context.Context, *UpdateSilenceRequest) (*SilenceRule, error)
+	DeleteSilence(context.Context, *DeleteSilenceRequest) (*DeleteSilenceResponse, error)
+	mustEmbedUnimplementedSilenceServiceServer()
+}
+
+// UnimplementedSilenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSilenceServiceServer struct{}
+
+func (UnimplementedSilenceServiceServer) CreateSilence(context.Context, *CreateSilenceRequest) (*SilenceRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSilence not implemented")
+}
+func (UnimplementedSilenceServiceServer) GetSilence(context.Context, *GetSilenceRequest) (*SilenceRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSilence not implemented")
+}
+func (UnimplementedSilenceServiceServer) ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSilences not implemented")
+}
+func (UnimplementedSilenceServiceServer) UpdateSilence(context.Context, *UpdateSilenceRequest) (*SilenceRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSilence not implemented")
+}
+func (UnimplementedSilenceServiceServer) DeleteSilence(context.Context, *DeleteSilenceRequest) (*DeleteSilenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSilence not implemented")
+}
+func (UnimplementedSilenceServiceServer) mustEmbedUnimplementedSilenceServiceServer() {}
+func (UnimplementedSilenceServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSilenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SilenceServiceServer will
+// result in compilation errors.
+type UnsafeSilenceServiceServer interface {
+	mustEmbedUnimplementedSilenceServiceServer()
+}
+
+func RegisterSilenceServiceServer(s grpc.ServiceRegistrar, srv SilenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedSilenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SilenceService_ServiceDesc, srv)
+}
+
+func _SilenceService_CreateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServiceServer).CreateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SilenceService_CreateSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServiceServer).CreateSilence(ctx, req.(*CreateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SilenceService_GetSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServiceServer).GetSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SilenceService_GetSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServiceServer).GetSilence(ctx, req.(*GetSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SilenceService_ListSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServiceServer).ListSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SilenceService_ListSilences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServiceServer).ListSilences(ctx, req.(*ListSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SilenceService_UpdateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServiceServer).UpdateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SilenceService_UpdateSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServiceServer).UpdateSilence(ctx, req.(*UpdateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SilenceService_DeleteSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServiceServer).DeleteSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SilenceService_DeleteSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServiceServer).DeleteSilence(ctx, req.(*DeleteSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SilenceService_ServiceDesc is the grpc.ServiceDesc for SilenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SilenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "alerting.v1.SilenceService",
+	HandlerType: (*SilenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSilence",
+			Handler:    _SilenceService_CreateSilence_Handler,
+		},
+		{
+			MethodName: "GetSilence",
+			Handler:    _SilenceService_GetSilence_Handler,
+		},
+		{
+			MethodName: "ListSilences",
+			Handler:    _SilenceService_ListSilences_Handler,
+		},
+		{
+			MethodName: "UpdateSilence",
+			Handler:    _SilenceService_UpdateSilence_Handler,
+		},
+		{
+			MethodName: "DeleteSilence",
+			Handler:    _SilenceService_DeleteSilence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "alerting/v1/alert_service.proto",
+}
+
+const (
+	ServiceService_DeleteService_FullMethodName = "/alerting.v1.ServiceService/DeleteService"
+)
+
+// ServiceServiceClient is the client API for ServiceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceService manages the services that alerts are ingested for
+type ServiceServiceClient interface {
+	// Delete a service; refused while it has active alerts unless forced
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+}
+
+type serviceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceServiceClient(cc grpc.ClientConnInterface) ServiceServiceClient {
+	return &serviceServiceClient{cc}
+}
+
+func (c *serviceServiceClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceResponse)
+	err := c.cc.Invoke(ctx, ServiceService_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServiceServer is the server API for ServiceService service.
+// All implementations must embed UnimplementedServiceServiceServer
+// for forward compatibility.
+//
+// ServiceService manages the services that alerts are ingested for
+type ServiceServiceServer interface {
+	// Delete a service; refused while it has active alerts unless forced
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	mustEmbedUnimplementedServiceServiceServer()
+}
+
+// UnimplementedServiceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceServiceServer struct{}
+
+func (UnimplementedServiceServiceServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedServiceServiceServer) mustEmbedUnimplementedServiceServiceServer() {}
+func (UnimplementedServiceServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeServiceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServiceServer will
+// result in compilation errors.
+type UnsafeServiceServiceServer interface {
+	mustEmbedUnimplementedServiceServiceServer()
+}
+
+func RegisterServiceServiceServer(s grpc.ServiceRegistrar, srv ServiceServiceServer) {
+	// If the following call panics, it indicates UnimplementedServiceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceService_ServiceDesc, srv)
+}
+
+func _ServiceService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServiceServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceService_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServiceServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceService_ServiceDesc is the grpc.ServiceDesc for ServiceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "alerting.v1.ServiceService",
+	HandlerType: (*ServiceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteService",
+			Handler:    _ServiceService_DeleteService_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alerting/v1/alert_service.proto",